@@ -0,0 +1,66 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/storj/satellite/metabase"
+)
+
+// generateAuditCoverageCSV creates a report of how many remote segments were
+// sampled for audit, ever and since the given time.
+func generateAuditCoverageCSV(ctx context.Context, auditedSince time.Time, output io.Writer) (err error) {
+	db, err := metabase.Open(ctx, zap.L().Named("metabase"), reportsAuditCoverageCfg.Database, metabase.Config{
+		ApplicationName: "satellite-audit-coverage",
+	})
+	if err != nil {
+		return errs.New("error connecting to metabase database on satellite: %+v", err)
+	}
+	defer func() {
+		err = errs.Combine(err, db.Close())
+	}()
+
+	coverage, err := db.GetAuditCoverage(ctx, metabase.GetAuditCoverage{
+		AuditedSince:       auditedSince,
+		AsOfSystemInterval: reportsAuditCoverageCfg.AsOfSystemInterval,
+	})
+	if err != nil {
+		return err
+	}
+
+	w := csv.NewWriter(output)
+	headers := []string{
+		"segments",
+		"audited",
+		"auditedSince",
+		"recentlyAudited",
+		"neverAudited",
+		"neverAuditedBytes",
+	}
+	if err := w.Write(headers); err != nil {
+		return err
+	}
+
+	row := []string{
+		strconv.FormatInt(coverage.Segments, 10),
+		strconv.FormatInt(coverage.AuditedSegments, 10),
+		auditedSince.UTC().Format(time.RFC3339),
+		strconv.FormatInt(coverage.RecentlyAudited, 10),
+		strconv.FormatInt(coverage.NeverAudited(), 10),
+		strconv.FormatInt(coverage.NeverAuditedBytes, 10),
+	}
+	if err := w.Write(row); err != nil {
+		return err
+	}
+	w.Flush()
+	return w.Error()
+}
@@ -40,10 +40,11 @@ func cmdAuditorRun(cmd *cobra.Command, args []string) (err error) {
 	}()
 
 	metabaseDB, err := metabase.Open(ctx, log.Named("metabase"), runCfg.Metainfo.DatabaseURL, metabase.Config{
-		ApplicationName:  "satellite-auditor",
-		MinPartSize:      runCfg.Config.Metainfo.MinPartSize,
-		MaxNumberOfParts: runCfg.Config.Metainfo.MaxNumberOfParts,
-		ServerSideCopy:   runCfg.Config.Metainfo.ServerSideCopy,
+		ApplicationName:    "satellite-auditor",
+		MinPartSize:        runCfg.Config.Metainfo.MinPartSize,
+		MaxNumberOfParts:   runCfg.Config.Metainfo.MaxNumberOfParts,
+		ServerSideCopy:     runCfg.Config.Metainfo.ServerSideCopy,
+		SlowQueryThreshold: runCfg.Config.Metainfo.MetabaseSlowQueryThreshold,
 	})
 	if err != nil {
 		return errs.New("Error creating metabase connection: %+v", err)
@@ -66,6 +67,7 @@ func cmdAuditorRun(cmd *cobra.Command, args []string) (err error) {
 	}()
 
 	peer, err := satellite.NewAuditor(
+		ctx,
 		log,
 		identity,
 		metabaseDB,
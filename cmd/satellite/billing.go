@@ -15,8 +15,10 @@ import (
 	"storj.io/common/uuid"
 	"storj.io/private/process"
 	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/events"
 	"storj.io/storj/satellite/payments/stripecoinpayments"
 	"storj.io/storj/satellite/satellitedb"
+	"storj.io/storj/satellite/secrets"
 )
 
 func runBillingCmd(ctx context.Context, cmdFunc func(context.Context, *stripecoinpayments.Service, satellite.DB) error) error {
@@ -30,7 +32,14 @@ func runBillingCmd(ctx context.Context, cmdFunc func(context.Context, *stripecoi
 		err = errs.Combine(err, db.Close())
 	}()
 
-	payments, err := setupPayments(logger, db)
+	eventBus, err := events.NewBus(logger.Named("events:bus"), runCfg.Events)
+	if err != nil {
+		return err
+	}
+	// the bus isn't running, so send the published events before exiting.
+	defer eventBus.Flush(ctx)
+
+	payments, err := setupPayments(ctx, logger, db, eventBus)
 	if err != nil {
 		return err
 	}
@@ -38,7 +47,7 @@ func runBillingCmd(ctx context.Context, cmdFunc func(context.Context, *stripecoi
 	return cmdFunc(ctx, payments, db)
 }
 
-func setupPayments(log *zap.Logger, db satellite.DB) (*stripecoinpayments.Service, error) {
+func setupPayments(ctx context.Context, log *zap.Logger, db satellite.DB, eventBus *events.Bus) (*stripecoinpayments.Service, error) {
 	pc := runCfg.Payments
 
 	var stripeClient stripecoinpayments.StripeClient
@@ -50,7 +59,17 @@ func setupPayments(log *zap.Logger, db satellite.DB) (*stripecoinpayments.Servic
 			db.Console().Users(),
 		)
 	case "stripecoinpayments":
-		stripeClient = stripecoinpayments.NewStripeClient(log, pc.StripeCoinPayments)
+		provider, err := secrets.NewProvider(ctx, runCfg.Secrets)
+		if err != nil {
+			return nil, err
+		}
+		secret, err := secrets.Load(ctx, provider, runCfg.Secrets.Names.StripeSecretKey, pc.StripeCoinPayments.StripeSecretKey)
+		if err != nil {
+			return nil, err
+		}
+		stripeConfig := pc.StripeCoinPayments
+		stripeConfig.StripeSecretKey = string(secret.Value)
+		stripeClient = stripecoinpayments.NewStripeClient(log, stripeConfig)
 	}
 
 	prices, err := pc.UsagePrice.ToModel()
@@ -74,7 +93,8 @@ func setupPayments(log *zap.Logger, db satellite.DB) (*stripecoinpayments.Servic
 		db.ProjectAccounting(),
 		prices,
 		priceOverrides,
-		pc.BonusRate)
+		pc.BonusRate,
+		eventBus)
 }
 
 // parseYearMonth parses year and month from the provided string and returns a corresponding time.Time for the first day
@@ -13,9 +13,11 @@ import (
 	"go.uber.org/zap"
 
 	"storj.io/common/storj"
+	"storj.io/common/uuid"
 	"storj.io/storj/satellite/accounting"
 	"storj.io/storj/satellite/compensation"
 	"storj.io/storj/satellite/overlay"
+	"storj.io/storj/satellite/payouts"
 	"storj.io/storj/satellite/satellitedb"
 )
 
@@ -196,3 +198,51 @@ func recordOneOffPayments(ctx context.Context, paymentsCSV string) (int, error)
 
 	return len(payments), nil
 }
+
+func exportPayoutBatch(ctx context.Context, period compensation.Period, out io.Writer) (_ payouts.Batch, _ int, err error) {
+	network, err := payouts.NetworkFromString(exportPayoutBatchCfg.Network)
+	if err != nil {
+		return payouts.Batch{}, 0, err
+	}
+
+	db, err := satellitedb.Open(ctx, zap.L().Named("db"), exportPayoutBatchCfg.Database, satellitedb.Options{ApplicationName: "satellite-compensation"})
+	if err != nil {
+		return payouts.Batch{}, 0, errs.New("error connecting to master database on satellite: %+v", err)
+	}
+	defer func() { err = errs.Combine(err, db.Close()) }()
+
+	if err := db.CheckVersion(ctx); err != nil {
+		zap.L().Fatal("Failed satellite database version check.", zap.Error(err))
+		return payouts.Batch{}, 0, errs.New("Error checking version for satellitedb: %+v", err)
+	}
+
+	service := payouts.NewBatchService(zap.L().Named("payouts"), db.PayoutBatches(), db.OverlayCache(), nil)
+	batch, entries, err := service.ExportBatch(ctx, period, network)
+	if err != nil {
+		return payouts.Batch{}, 0, err
+	}
+	if len(entries) == 0 {
+		return batch, 0, nil
+	}
+
+	if err := payouts.WriteBatchEntries(out, entries); err != nil {
+		return payouts.Batch{}, 0, err
+	}
+	return batch, len(entries), nil
+}
+
+func submitPayoutBatch(ctx context.Context, id uuid.UUID, transactionHash string) (err error) {
+	db, err := satellitedb.Open(ctx, zap.L().Named("db"), submitPayoutBatchCfg.Database, satellitedb.Options{ApplicationName: "satellite-compensation"})
+	if err != nil {
+		return errs.New("error connecting to master database on satellite: %+v", err)
+	}
+	defer func() { err = errs.Combine(err, db.Close()) }()
+
+	if err := db.CheckVersion(ctx); err != nil {
+		zap.L().Fatal("Failed satellite database version check.", zap.Error(err))
+		return errs.New("Error checking version for satellitedb: %+v", err)
+	}
+
+	service := payouts.NewBatchService(zap.L().Named("payouts"), db.PayoutBatches(), db.OverlayCache(), nil)
+	return service.SubmitBatch(ctx, id, transactionHash)
+}
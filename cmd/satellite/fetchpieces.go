@@ -82,6 +82,7 @@ func cmdFetchPieces(cmd *cobra.Command, args []string) (err error) {
 	}()
 
 	peer, err := satellite.NewRepairer(
+		ctx,
 		log,
 		identity,
 		metabaseDB,
@@ -29,6 +29,7 @@ import (
 	"storj.io/common/rpc"
 	"storj.io/common/storj"
 	"storj.io/common/sync2"
+	"storj.io/common/uuid"
 	"storj.io/private/cfgstruct"
 	"storj.io/private/process"
 	_ "storj.io/private/process/googleprofiler" // This attaches google cloud profiler.
@@ -44,12 +45,13 @@ import (
 	"storj.io/storj/satellite/orders"
 	"storj.io/storj/satellite/overlay"
 	"storj.io/storj/satellite/payments/stripecoinpayments"
+	"storj.io/storj/satellite/payouts"
 	"storj.io/storj/satellite/satellitedb"
 )
 
 // Satellite defines satellite configuration.
 type Satellite struct {
-	Database string `help:"satellite database connection string" releaseDefault:"postgres://" devDefault:"postgres://"`
+	Database string `help:"satellite database connection string, optionally followed by comma separated name:url entries for subsystems with their own connection pool (overlaycache, console, projectaccounting, storagenodeaccounting). the connection pool is sized with the max_open_conns, max_idle_conns and conn_max_lifetime url parameters, transaction_pooling=true makes it compatible with transaction pooling proxies" releaseDefault:"postgres://" devDefault:"postgres://"`
 
 	DatabaseOptions struct {
 		APIKeysCache struct {
@@ -60,7 +62,8 @@ type Satellite struct {
 			Expiration time.Duration `help:"macaroon revocation cache expiration" default:"5m"`
 			Capacity   int           `help:"macaroon revocation cache capacity" default:"10000"`
 		}
-		MigrationUnsafe string `help:"comma separated migration types to run during every startup (none: no migration, snapshot: creating db from latest test snapshot (for testing only), testdata: create testuser in addition to a migration, full: do the normal migration (equals to 'satellite run migration'" default:"none" hidden:"true"`
+		MigrationMaxLockedRows int64  `help:"refuse migration steps, which lock tables with more estimated rows (0 disables the check)" default:"0"`
+		MigrationUnsafe        string `help:"comma separated migration types to run during every startup (none: no migration, snapshot: creating db from latest test snapshot (for testing only), testdata: create testuser in addition to a migration, full: do the normal migration (equals to 'satellite run migration'" default:"none" hidden:"true"`
 	}
 
 	satellite.Config
@@ -97,6 +100,11 @@ var (
 		Short: "Run the satellite database migration",
 		RunE:  cmdMigrationRun,
 	}
+	runMigrationPlanCmd = &cobra.Command{
+		Use:   "migration-plan",
+		Short: "Show the pending satellite database migration steps without applying them",
+		RunE:  cmdMigrationPlan,
+	}
 	runAPICmd = &cobra.Command{
 		Use:   "api",
 		Short: "Run the satellite API",
@@ -168,6 +176,20 @@ var (
 		Args:  cobra.MinimumNArgs(2),
 		RunE:  cmdReportsGracefulExit,
 	}
+	reportsOrdersDiscrepanciesCmd = &cobra.Command{
+		Use:   "orders-discrepancies [start] [end]",
+		Short: "Generate an orders windows discrepancy report",
+		Long:  "Generate a report of the orders windows, whose amounts claimed by the storage nodes differ from the settled amounts, for a given period. Format dates using YYYY-MM-DD. The end date is exclusive.",
+		Args:  cobra.ExactArgs(2),
+		RunE:  cmdReportsOrdersDiscrepancies,
+	}
+	reportsAuditCoverageCmd = &cobra.Command{
+		Use:   "audit-coverage",
+		Short: "Generate an audit coverage report",
+		Long:  "Generate a report of how many remote segments were sampled for audit at least once, and within the recent period.",
+		Args:  cobra.NoArgs,
+		RunE:  cmdReportsAuditCoverage,
+	}
 	reportsVerifyGEReceiptCmd = &cobra.Command{
 		Use:   "verify-exit-receipt [storage node ID] [receipt]",
 		Short: "Verify a graceful exit receipt",
@@ -200,6 +222,20 @@ var (
 		Args:  cobra.ExactArgs(1),
 		RunE:  cmdRecordOneOffPayments,
 	}
+	exportPayoutBatchCmd = &cobra.Command{
+		Use:   "export-payout-batch [period]",
+		Short: "Export storage node payout batch",
+		Long:  "Export the payments of a pay period to the storage nodes, which opted in to the layer 2 network, as a batch payment file. Period is a UTC date formatted like YYYY-MM.",
+		Args:  cobra.ExactArgs(1),
+		RunE:  cmdExportPayoutBatch,
+	}
+	submitPayoutBatchCmd = &cobra.Command{
+		Use:   "submit-payout-batch [batch-id] [transaction-hash]",
+		Short: "Submit storage node payout batch",
+		Long:  "Record the layer 2 transaction, which pays an exported payout batch. The payments are recorded, once the transaction is confirmed.",
+		Args:  cobra.ExactArgs(2),
+		RunE:  cmdSubmitPayoutBatch,
+	}
 	billingCmd = &cobra.Command{
 		Use:   "billing",
 		Short: "Customer billing commands",
@@ -275,6 +311,21 @@ var (
 			"If node ids aren't provided, *all* nodes are used.",
 		RunE: cmdRestoreTrash,
 	}
+	restoreRollupsCmd = &cobra.Command{
+		Use:   "restore-rollups [YYYY-MM ...]",
+		Short: "Restore exported rollups",
+		Long: "Import the rollups of the given months, which were exported to the rollup export bucket, back into the database. " +
+			"The months are pinned, so they aren't exported again until they're unpinned with unpin-rollups.",
+		Args: cobra.MinimumNArgs(1),
+		RunE: cmdRestoreRollups,
+	}
+	unpinRollupsCmd = &cobra.Command{
+		Use:   "unpin-rollups [YYYY-MM ...]",
+		Short: "Unpin restored rollups",
+		Long:  "Allow the rollup archiver to export the rollups of the given months, which were restored, again.",
+		Args:  cobra.MinimumNArgs(1),
+		RunE:  cmdUnpinRollups,
+	}
 	registerLostSegments = &cobra.Command{
 		Use:   "register-lost-segments [number_of_segments_lost]",
 		Short: "Register permanently lost segments for our statistics",
@@ -294,6 +345,39 @@ var (
 		Args:  cobra.RangeArgs(1, 2),
 		RunE:  cmdRepairSegment,
 	}
+	ordersKeysCmd = &cobra.Command{
+		Use:   "orders-keys",
+		Short: "Manage the order encryption keys",
+		Long: "Manage the order encryption keys. The commands print the updated value of " +
+			ordersKeysConfigName + ", which needs to be deployed to all satellite instances.",
+	}
+	ordersKeysListCmd = &cobra.Command{
+		Use:   "list",
+		Short: "List the order encryption keys and their state",
+		Args:  cobra.NoArgs,
+		RunE:  cmdOrdersKeysList,
+	}
+	ordersKeysAddCmd = &cobra.Command{
+		Use:   "add",
+		Short: "Add a new order encryption key",
+		Long:  "Add a new randomly generated order encryption key, which is used for encrypting new orders after the activation delay.",
+		Args:  cobra.NoArgs,
+		RunE:  cmdOrdersKeysAdd,
+	}
+	ordersKeysRetireCmd = &cobra.Command{
+		Use:   "retire <key-id>",
+		Short: "Retire an order encryption key",
+		Long:  "Stop using the order encryption key for new orders. The key is still used for decrypting orders, until they have expired.",
+		Args:  cobra.ExactArgs(1),
+		RunE:  cmdOrdersKeysRetire,
+	}
+	ordersKeysPruneCmd = &cobra.Command{
+		Use:   "prune",
+		Short: "Remove retired order encryption keys",
+		Long:  "Remove the retired order encryption keys, which aren't needed for decrypting orders anymore.",
+		Args:  cobra.NoArgs,
+		RunE:  cmdOrdersKeysPrune,
+	}
 
 	runCfg   Satellite
 	setupCfg Satellite
@@ -318,6 +402,14 @@ var (
 	recordOneOffPaymentsCfg struct {
 		Database string `help:"satellite database connection string" releaseDefault:"postgres://" devDefault:"postgres://"`
 	}
+	exportPayoutBatchCfg struct {
+		Database string `help:"satellite database connection string" releaseDefault:"postgres://" devDefault:"postgres://"`
+		Output   string `help:"destination of batch payment file" default:""`
+		Network  string `help:"layer 2 network, which the batch is paid on" default:"zksync-era"`
+	}
+	submitPayoutBatchCfg struct {
+		Database string `help:"satellite database connection string" releaseDefault:"postgres://" devDefault:"postgres://"`
+	}
 	partnerAttribtionCfg struct {
 		Database string `help:"satellite database connection string" releaseDefault:"postgres://" devDefault:"postgres://"`
 		Output   string `help:"destination of report output" default:""`
@@ -329,6 +421,31 @@ var (
 	}
 	reportsVerifyGracefulExitReceiptCfg struct {
 	}
+	reportsOrdersDiscrepanciesCfg struct {
+		Database string `help:"satellite database connection string" releaseDefault:"postgres://" devDefault:"postgres://"`
+		Output   string `help:"destination of report output" default:""`
+		Reopen   bool   `help:"whether to settle the claimed amounts of the windows, which were rejected or failed to settle" default:"false"`
+	}
+	reportsAuditCoverageCfg struct {
+		Database           string        `help:"metabase database connection string" releaseDefault:"postgres://" devDefault:"postgres://"`
+		Output             string        `help:"destination of report output" default:""`
+		RecentPeriod       time.Duration `help:"the period, in which sampled segments count as recently audited" default:"720h"`
+		AsOfSystemInterval time.Duration `help:"as of system interval" releaseDefault:"-5m" devDefault:"-1us" testDefault:"-1us"`
+	}
+	ordersKeysListCfg struct {
+		Orders orders.Config
+	}
+	ordersKeysAddCfg struct {
+		Orders          orders.Config
+		ActivationDelay time.Duration `help:"how long until the new key is used for encrypting orders" default:"1h"`
+	}
+	ordersKeysRetireCfg struct {
+		Orders      orders.Config
+		RetireDelay time.Duration `help:"how long until the key is not used for encrypting orders anymore" default:"0s"`
+	}
+	ordersKeysPruneCfg struct {
+		Orders orders.Config
+	}
 	consistencyGECleanupCfg struct {
 		Database string `help:"satellite database connection string" releaseDefault:"postgres://" devDefault:"postgres://"`
 		Before   string `help:"select only exited nodes before this UTC date formatted like YYYY-MM. Date cannot be newer than the current time (required)"`
@@ -346,6 +463,7 @@ func init() {
 	defaults := cfgstruct.DefaultsFlag(rootCmd)
 	rootCmd.AddCommand(runCmd)
 	runCmd.AddCommand(runMigrationCmd)
+	runCmd.AddCommand(runMigrationPlanCmd)
 	runCmd.AddCommand(runAPICmd)
 	runCmd.AddCommand(runAdminCmd)
 	runCmd.AddCommand(runRepairerCmd)
@@ -360,16 +478,23 @@ func init() {
 	rootCmd.AddCommand(billingCmd)
 	rootCmd.AddCommand(consistencyCmd)
 	rootCmd.AddCommand(restoreTrashCmd)
+	rootCmd.AddCommand(restoreRollupsCmd)
+	rootCmd.AddCommand(unpinRollupsCmd)
 	rootCmd.AddCommand(registerLostSegments)
 	rootCmd.AddCommand(fetchPiecesCmd)
 	rootCmd.AddCommand(repairSegmentCmd)
+	rootCmd.AddCommand(ordersKeysCmd)
 	reportsCmd.AddCommand(nodeUsageCmd)
 	reportsCmd.AddCommand(partnerAttributionCmd)
 	reportsCmd.AddCommand(reportsGracefulExitCmd)
 	reportsCmd.AddCommand(reportsVerifyGEReceiptCmd)
+	reportsCmd.AddCommand(reportsOrdersDiscrepanciesCmd)
+	reportsCmd.AddCommand(reportsAuditCoverageCmd)
 	compensationCmd.AddCommand(generateInvoicesCmd)
 	compensationCmd.AddCommand(recordPeriodCmd)
 	compensationCmd.AddCommand(recordOneOffPaymentsCmd)
+	compensationCmd.AddCommand(exportPayoutBatchCmd)
+	compensationCmd.AddCommand(submitPayoutBatchCmd)
 	billingCmd.AddCommand(applyFreeTierCouponsCmd)
 	billingCmd.AddCommand(prepareCustomerInvoiceRecordsCmd)
 	billingCmd.AddCommand(createCustomerProjectInvoiceItemsCmd)
@@ -378,9 +503,14 @@ func init() {
 	billingCmd.AddCommand(finalizeCustomerInvoicesCmd)
 	billingCmd.AddCommand(payCustomerInvoicesCmd)
 	billingCmd.AddCommand(stripeCustomerCmd)
+	ordersKeysCmd.AddCommand(ordersKeysListCmd)
+	ordersKeysCmd.AddCommand(ordersKeysAddCmd)
+	ordersKeysCmd.AddCommand(ordersKeysRetireCmd)
+	ordersKeysCmd.AddCommand(ordersKeysPruneCmd)
 	consistencyCmd.AddCommand(consistencyGECleanupCmd)
 	process.Bind(runCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(runMigrationCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
+	process.Bind(runMigrationPlanCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(runAPICmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(runAdminCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(runRepairerCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
@@ -389,6 +519,8 @@ func init() {
 	process.Bind(runGCBloomFilterCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(runRangedLoopCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(restoreTrashCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
+	process.Bind(restoreRollupsCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
+	process.Bind(unpinRollupsCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(registerLostSegments, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(fetchPiecesCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(repairSegmentCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
@@ -398,8 +530,12 @@ func init() {
 	process.Bind(generateInvoicesCmd, &generateInvoicesCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(recordPeriodCmd, &recordPeriodCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(recordOneOffPaymentsCmd, &recordOneOffPaymentsCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
+	process.Bind(exportPayoutBatchCmd, &exportPayoutBatchCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
+	process.Bind(submitPayoutBatchCmd, &submitPayoutBatchCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(reportsGracefulExitCmd, &reportsGracefulExitCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(reportsVerifyGEReceiptCmd, &reportsVerifyGracefulExitReceiptCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
+	process.Bind(reportsOrdersDiscrepanciesCmd, &reportsOrdersDiscrepanciesCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
+	process.Bind(reportsAuditCoverageCmd, &reportsAuditCoverageCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(partnerAttributionCmd, &partnerAttribtionCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(applyFreeTierCouponsCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(prepareCustomerInvoiceRecordsCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
@@ -409,6 +545,10 @@ func init() {
 	process.Bind(finalizeCustomerInvoicesCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(payCustomerInvoicesCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(stripeCustomerCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
+	process.Bind(ordersKeysListCmd, &ordersKeysListCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
+	process.Bind(ordersKeysAddCmd, &ordersKeysAddCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
+	process.Bind(ordersKeysRetireCmd, &ordersKeysRetireCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
+	process.Bind(ordersKeysPruneCmd, &ordersKeysPruneCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(consistencyGECleanupCmd, &consistencyGECleanupCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 
 	if err := consistencyGECleanupCmd.MarkFlagRequired("before"); err != nil {
@@ -478,7 +618,7 @@ func cmdRun(cmd *cobra.Command, args []string) (err error) {
 		err = errs.Combine(err, rollupsWriteCache.CloseAndFlush(context2.WithoutCancellation(ctx)))
 	}()
 
-	peer, err := satellite.New(log, identity, db, metabaseDB, revocationDB, liveAccounting, rollupsWriteCache, version.Build, &runCfg.Config, process.AtomicLevel(cmd))
+	peer, err := satellite.New(ctx, log, identity, db, metabaseDB, revocationDB, liveAccounting, rollupsWriteCache, version.Build, &runCfg.Config, process.AtomicLevel(cmd))
 	if err != nil {
 		return err
 	}
@@ -514,7 +654,10 @@ func cmdMigrationRun(cmd *cobra.Command, args []string) (err error) {
 	ctx, _ := process.Ctx(cmd)
 	log := zap.L()
 
-	db, err := satellitedb.Open(ctx, log.Named("migration"), runCfg.Database, satellitedb.Options{ApplicationName: "satellite-migration"})
+	db, err := satellitedb.Open(ctx, log.Named("migration"), runCfg.Database, satellitedb.Options{
+		ApplicationName:        "satellite-migration",
+		MigrationMaxLockedRows: runCfg.DatabaseOptions.MigrationMaxLockedRows,
+	})
 	if err != nil {
 		return errs.New("Error creating new master database connection for satellitedb migration: %+v", err)
 	}
@@ -639,6 +782,28 @@ func cmdReportsGracefulExit(cmd *cobra.Command, args []string) (err error) {
 	return generateGracefulExitCSV(ctx, reportsGracefulExitCfg.Completed, start, end, file)
 }
 
+func cmdReportsOrdersDiscrepancies(cmd *cobra.Command, args []string) (err error) {
+	ctx, _ := process.Ctx(cmd)
+
+	start, end, err := reports.ParseRange(args[0], args[1])
+	if err != nil {
+		return err
+	}
+
+	return runWithOutput(reportsOrdersDiscrepanciesCfg.Output, func(out io.Writer) error {
+		return generateOrdersDiscrepanciesCSV(ctx, start, end, out)
+	})
+}
+
+func cmdReportsAuditCoverage(cmd *cobra.Command, args []string) (err error) {
+	ctx, _ := process.Ctx(cmd)
+
+	auditedSince := time.Now().Add(-reportsAuditCoverageCfg.RecentPeriod)
+	return runWithOutput(reportsAuditCoverageCfg.Output, func(out io.Writer) error {
+		return generateAuditCoverageCSV(ctx, auditedSince, out)
+	})
+}
+
 func cmdNodeUsage(cmd *cobra.Command, args []string) (err error) {
 	ctx, _ := process.Ctx(cmd)
 
@@ -708,6 +873,46 @@ func cmdRecordOneOffPayments(cmd *cobra.Command, args []string) (err error) {
 	return nil
 }
 
+func cmdExportPayoutBatch(cmd *cobra.Command, args []string) (err error) {
+	ctx, _ := process.Ctx(cmd)
+
+	period, err := compensation.PeriodFromString(args[0])
+	if err != nil {
+		return err
+	}
+
+	var batch payouts.Batch
+	var count int
+	if err := runWithOutput(exportPayoutBatchCfg.Output, func(out io.Writer) error {
+		batch, count, err = exportPayoutBatch(ctx, period, out)
+		return err
+	}); err != nil {
+		return err
+	}
+
+	if count == 0 {
+		fmt.Fprintln(os.Stderr, "No payments to export")
+		return nil
+	}
+	fmt.Fprintln(os.Stderr, "Exported batch", batch.ID, "with", count, "payments")
+	return nil
+}
+
+func cmdSubmitPayoutBatch(cmd *cobra.Command, args []string) (err error) {
+	ctx, _ := process.Ctx(cmd)
+
+	id, err := uuid.FromString(args[0])
+	if err != nil {
+		return err
+	}
+
+	if err := submitPayoutBatch(ctx, id, args[1]); err != nil {
+		return err
+	}
+	fmt.Println("Submitted batch", id)
+	return nil
+}
+
 func cmdValueAttribution(cmd *cobra.Command, args []string) (err error) {
 	ctx, _ := process.Ctx(cmd)
 	log := zap.L().Named("satellite-cli")
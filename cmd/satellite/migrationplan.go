@@ -0,0 +1,91 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/private/process"
+	"storj.io/storj/private/migrate"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/satellitedb"
+)
+
+func cmdMigrationPlan(cmd *cobra.Command, args []string) (err error) {
+	ctx, _ := process.Ctx(cmd)
+	log := zap.L()
+
+	db, err := satellitedb.Open(ctx, log.Named("migration"), runCfg.Database, satellitedb.Options{
+		ApplicationName:        "satellite-migration",
+		MigrationMaxLockedRows: runCfg.DatabaseOptions.MigrationMaxLockedRows,
+	})
+	if err != nil {
+		return errs.New("Error creating new master database connection for satellitedb migration: %+v", err)
+	}
+	defer func() {
+		err = errs.Combine(err, db.Close())
+	}()
+
+	planned, err := db.MigrationPlan(ctx)
+	if err != nil {
+		return errs.New("Error planning satellitedb migration: %+v", err)
+	}
+	printMigrationPlan(cmd.OutOrStdout(), "satellitedb", planned)
+
+	metabaseDB, err := metabase.Open(ctx, log.Named("metabase"), runCfg.Metainfo.DatabaseURL,
+		runCfg.Config.Metainfo.Metabase("satellite-migration"))
+	if err != nil {
+		return errs.New("Error creating metabase connection: %+v", err)
+	}
+	defer func() {
+		err = errs.Combine(err, metabaseDB.Close())
+	}()
+
+	planned, err = metabaseDB.MigrationPlan(ctx)
+	if err != nil {
+		return errs.New("Error planning metabase migration: %+v", err)
+	}
+	printMigrationPlan(cmd.OutOrStdout(), "metabase", planned)
+
+	return nil
+}
+
+// printMigrationPlan prints the pending steps and their statements.
+func printMigrationPlan(w io.Writer, database string, planned []migrate.PlannedStep) {
+	if len(planned) == 0 {
+		_, _ = fmt.Fprintf(w, "%s: up to date\n", database)
+		return
+	}
+
+	_, _ = fmt.Fprintf(w, "%s: %d pending steps\n", database, len(planned))
+	for _, step := range planned {
+		_, _ = fmt.Fprintf(w, "\n-- version %d: %s\n", step.Version, step.Description)
+		for _, lock := range step.Locks {
+			switch {
+			case lock.Refused:
+				_, _ = fmt.Fprintf(w, "-- REFUSED: locks table %s with about %d rows\n", lock.Table, lock.EstimatedRows)
+			case lock.EstimatedRows >= 0:
+				_, _ = fmt.Fprintf(w, "-- locks table %s with about %d rows\n", lock.Table, lock.EstimatedRows)
+			default:
+				_, _ = fmt.Fprintf(w, "-- locks table %s\n", lock.Table)
+			}
+		}
+		switch {
+		case step.Backfill && step.Checkpoint != "":
+			_, _ = fmt.Fprintf(w, "-- batched backfill, continues after %q\n", step.Checkpoint)
+		case step.Backfill:
+			_, _ = fmt.Fprintln(w, "-- batched backfill")
+		case len(step.Statements) == 0:
+			_, _ = fmt.Fprintln(w, "-- custom migration code")
+		}
+		for _, statement := range step.Statements {
+			_, _ = fmt.Fprintf(w, "%s;\n", statement)
+		}
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/storj/satellite/orders"
+	"storj.io/storj/satellite/satellitedb"
+)
+
+// generateOrdersDiscrepanciesCSV creates a report of the orders windows in a
+// given period, whose claimed amounts differ from the settled amounts.
+func generateOrdersDiscrepanciesCSV(ctx context.Context, start time.Time, end time.Time, output io.Writer) (err error) {
+	db, err := satellitedb.Open(ctx, zap.L().Named("db"), reportsOrdersDiscrepanciesCfg.Database, satellitedb.Options{ApplicationName: "satellite-orders-discrepancies"})
+	if err != nil {
+		return errs.New("error connecting to master database on satellite: %+v", err)
+	}
+	defer func() {
+		err = errs.Combine(err, db.Close())
+	}()
+
+	reconciler := orders.NewReconciler(zap.L().Named("orders:reconciler"), db.Orders(), orders.ReconcilerConfig{
+		ReopenWindows: reportsOrdersDiscrepanciesCfg.Reopen,
+	})
+	nodeReports, err := reconciler.ReconcileRange(ctx, start, end)
+	if err != nil {
+		return err
+	}
+
+	w := csv.NewWriter(output)
+	headers := []string{
+		"nodeID",
+		"window",
+		"action",
+		"kind",
+		"claimStatus",
+		"claimed",
+		"settled",
+		"reopened",
+	}
+	if err := w.Write(headers); err != nil {
+		return err
+	}
+
+	for _, report := range nodeReports {
+		reopened := map[time.Time]bool{}
+		for _, window := range report.Reopened {
+			reopened[window] = true
+		}
+
+		for _, discrepancy := range report.Discrepancies {
+			row := []string{
+				report.NodeID.String(),
+				discrepancy.Window.UTC().Format(time.RFC3339),
+				discrepancy.Action.String(),
+				discrepancy.Kind().String(),
+				discrepancy.Status.String(),
+				strconv.FormatInt(discrepancy.Claimed, 10),
+				strconv.FormatInt(discrepancy.Settled, 10),
+				strconv.FormatBool(reopened[discrepancy.Window]),
+			}
+			if err := w.Write(row); err != nil {
+				return err
+			}
+		}
+	}
+	w.Flush()
+	return w.Error()
+}
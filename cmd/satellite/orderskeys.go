@@ -0,0 +1,138 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/satellite/orders"
+)
+
+// ordersKeysConfigName is the name of the configuration value containing the order encryption keys.
+const ordersKeysConfigName = "orders.encryption-keys"
+
+func cmdOrdersKeysList(cmd *cobra.Command, args []string) error {
+	keys := ordersKeysListCfg.Orders.EncryptionKeys
+	return printOrdersKeys(cmd.OutOrStdout(), &keys, time.Now(), ordersKeysListCfg.Orders)
+}
+
+func cmdOrdersKeysAdd(cmd *cobra.Command, args []string) error {
+	keys := ordersKeysAddCfg.Orders.EncryptionKeys
+
+	var key orders.EncryptionKey
+	if _, err := rand.Read(key.ID[:]); err != nil {
+		return errs.Wrap(err)
+	}
+	if _, err := rand.Read(key.Key[:]); err != nil {
+		return errs.Wrap(err)
+	}
+	// the key must be known by all satellite instances before it's used for encryption.
+	key.ActiveFrom = time.Now().Add(ordersKeysAddCfg.ActivationDelay).UTC().Truncate(time.Second)
+
+	if err := keys.Add(key); err != nil {
+		return err
+	}
+
+	return printOrdersKeysValue(cmd.OutOrStdout(), &keys)
+}
+
+func cmdOrdersKeysRetire(cmd *cobra.Command, args []string) error {
+	keys := ordersKeysRetireCfg.Orders.EncryptionKeys
+
+	id, err := parseOrdersKeyID(args[0])
+	if err != nil {
+		return err
+	}
+
+	retireAt := time.Now().Add(ordersKeysRetireCfg.RetireDelay).UTC().Truncate(time.Second)
+	if err := keys.Retire(id, retireAt); err != nil {
+		return err
+	}
+
+	return printOrdersKeysValue(cmd.OutOrStdout(), &keys)
+}
+
+func cmdOrdersKeysPrune(cmd *cobra.Command, args []string) error {
+	keys := ordersKeysPruneCfg.Orders.EncryptionKeys
+	config := ordersKeysPruneCfg.Orders
+
+	removable := keys.Removable(time.Now(), config.Expiration, config.KeyManagement.RetirementGracePeriod)
+	for _, key := range removable {
+		if err := keys.Remove(key.ID); err != nil {
+			return err
+		}
+		_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "removing key %s retired at %s\n",
+			hex.EncodeToString(key.ID[:]), key.RetireAt.UTC().Format(time.RFC3339))
+	}
+	if len(keys.List) == 0 {
+		return errs.New("all keys would be removed")
+	}
+
+	return printOrdersKeysValue(cmd.OutOrStdout(), &keys)
+}
+
+// printOrdersKeys prints the state of the keys as a table.
+func printOrdersKeys(w io.Writer, keys *orders.EncryptionKeys, now time.Time, config orders.Config) (err error) {
+	active := keys.Active(now)
+
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	defer func() { err = errs.Combine(err, tw.Flush()) }()
+
+	_, err = fmt.Fprintln(tw, "ID\tSTATE\tACTIVE FROM\tRETIRE AT")
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys.List {
+		state := "pending"
+		switch {
+		case key.ID == active.ID:
+			state = "encrypting"
+		case key.IsRemovable(now, config.Expiration, config.KeyManagement.RetirementGracePeriod):
+			state = "removable"
+		case key.IsRetired(now):
+			state = "retired"
+		case key.IsActive(now):
+			state = "active"
+		}
+
+		_, err = fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n",
+			hex.EncodeToString(key.ID[:]), state,
+			formatOrdersKeyTime(key.ActiveFrom), formatOrdersKeyTime(key.RetireAt))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// printOrdersKeysValue prints the updated configuration value.
+func printOrdersKeysValue(w io.Writer, keys *orders.EncryptionKeys) error {
+	_, err := fmt.Fprintf(w, "%s: %q\n", ordersKeysConfigName, keys.String())
+	return err
+}
+
+func formatOrdersKeyTime(t time.Time) string {
+	if t.IsZero() {
+		return "-"
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+func parseOrdersKeyID(s string) (id orders.EncryptionKeyID, err error) {
+	b, err := hex.DecodeString(s)
+	if err != nil || len(b) != len(id) {
+		return id, errs.New("invalid key id %q", s)
+	}
+	copy(id[:], b)
+	return id, nil
+}
@@ -101,7 +101,7 @@ func cmdRepairSegment(cmd *cobra.Command, args []string) (err error) {
 	dialer := rpc.NewDefaultDialer(tlsOptions)
 
 	// mail service is nil
-	overlay, err := overlay.NewService(log.Named("overlay"), db.OverlayCache(), db.NodeEvents(), nil, config.Console.ExternalAddress, config.Console.SatelliteName, config.Overlay)
+	overlay, err := overlay.NewService(log.Named("overlay"), db.OverlayCache(), db.NodeEvents(), nil, nil, config.Console.ExternalAddress, config.Console.SatelliteName, config.Overlay)
 	if err != nil {
 		return err
 	}
@@ -62,6 +62,7 @@ func cmdRepairerRun(cmd *cobra.Command, args []string) (err error) {
 	}()
 
 	peer, err := satellite.NewRepairer(
+		ctx,
 		log,
 		identity,
 		metabaseDB,
@@ -0,0 +1,65 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/private/process"
+	"storj.io/storj/satellite/accounting/rollupexport"
+	"storj.io/storj/satellite/satellitedb"
+)
+
+func cmdRestoreRollups(cmd *cobra.Command, args []string) (err error) {
+	ctx, _ := process.Ctx(cmd)
+	log := zap.L()
+
+	months, err := parseRollupMonths(args)
+	if err != nil {
+		return err
+	}
+
+	db, err := satellitedb.Open(ctx, log.Named("restore-rollups"), runCfg.Database, satellitedb.Options{ApplicationName: "satellite-restore-rollups"})
+	if err != nil {
+		return errs.New("Error creating new master database connection: %+v", err)
+	}
+	defer func() {
+		err = errs.Combine(err, db.Close())
+	}()
+
+	return rollupexport.Restore(ctx, log.Named("restore-rollups"), db.RollupExport(), runCfg.RollupExport, months)
+}
+
+func cmdUnpinRollups(cmd *cobra.Command, args []string) (err error) {
+	ctx, _ := process.Ctx(cmd)
+
+	months, err := parseRollupMonths(args)
+	if err != nil {
+		return err
+	}
+
+	return rollupexport.Unpin(ctx, zap.L().Named("unpin-rollups"), runCfg.RollupExport, months)
+}
+
+// parseRollupMonths parses the months and checks that the rollup export
+// bucket is configured.
+func parseRollupMonths(args []string) ([]time.Time, error) {
+	var months []time.Time
+	for _, arg := range args {
+		month, err := time.Parse("2006-01", arg)
+		if err != nil {
+			return nil, errs.New("invalid month %q, expected YYYY-MM: %+v", arg, err)
+		}
+		months = append(months, month)
+	}
+
+	if runCfg.RollupExport.Access == "" || runCfg.RollupExport.Bucket == "" {
+		return nil, errs.New("rollup-export.access and rollup-export.bucket are required")
+	}
+	return months, nil
+}
@@ -8,6 +8,7 @@ import (
 	"bufio"
 	"bytes"
 	"context"
+	"crypto/sha256"
 	"io"
 	"net/http"
 	"os"
@@ -19,6 +20,7 @@ import (
 
 	"storj.io/common/sync2"
 	"storj.io/private/version"
+	"storj.io/storj/private/version/checker"
 )
 
 func binaryVersion(location string) (version.SemVer, error) {
@@ -40,7 +42,9 @@ func binaryVersion(location string) (version.SemVer, error) {
 	return version.SemVer{}, errs.New("unable to determine binary version")
 }
 
-func downloadBinary(ctx context.Context, url, target string) error {
+// downloadBinary downloads and unpacks the binary. When a release manifest is
+// given, the archive must match the hash in the manifest.
+func downloadBinary(ctx context.Context, url, target string, manifest *checker.ReleaseManifest) error {
 	f, err := os.CreateTemp("", createPattern(url))
 	if err != nil {
 		return errs.New("cannot create temporary archive: %v", err)
@@ -54,9 +58,15 @@ func downloadBinary(ctx context.Context, url, target string) error {
 
 	zap.L().Info("Download started.", zap.String("From", url), zap.String("To", f.Name()))
 
-	if err = downloadArchive(ctx, f, url); err != nil {
+	hash := sha256.New()
+	if err = downloadArchive(ctx, io.MultiWriter(f, hash), url); err != nil {
 		return errs.Wrap(err)
 	}
+	if manifest != nil {
+		if err = manifest.VerifyBinary(url, hash.Sum(nil)); err != nil {
+			return errs.Wrap(err)
+		}
+	}
 	if err = unpackBinary(ctx, f.Name(), target); err != nil {
 		return errs.Wrap(err)
 	}
@@ -162,11 +162,18 @@ func cmdShouldUpdate(cmd *cobra.Command, args []string) (err error) {
 		zap.L().Fatal("Empty node ID.")
 	}
 
-	ver, err := checker.New(runCfg.Version.ClientConfig).Process(ctx, service)
+	client := checker.New(runCfg.Version.ClientConfig)
+
+	ver, err := client.Process(ctx, service)
 	if err != nil {
 		zap.L().Fatal("Error retrieving version info.", zap.Error(err))
 	}
 
+	ver, err = pinnedProcess(ctx, client, service, ver)
+	if err != nil {
+		zap.L().Fatal("Error retrieving pinned version.", zap.Error(err))
+	}
+
 	var shouldUpdate bool
 
 	if runCfg.BinaryLocation != "" && fileExists(runCfg.BinaryLocation) {
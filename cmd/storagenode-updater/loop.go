@@ -18,18 +18,38 @@ import (
 func loopFunc(ctx context.Context) error {
 	zap.L().Info("Downloading versions.", zap.String("Server Address", runCfg.Version.ServerAddress))
 
-	all, err := checker.New(runCfg.Version.ClientConfig).All(ctx)
+	client := checker.New(runCfg.Version.ClientConfig)
+
+	all, err := client.All(ctx)
 	if err != nil {
 		zap.L().Error("Error retrieving version info.", zap.Error(err))
 		return nil
 	}
 
-	if err := update(ctx, runCfg.ServiceName, runCfg.BinaryLocation, all.Processes.Storagenode); err != nil {
+	storagenode, err := pinnedProcess(ctx, client, "storagenode", all.Processes.Storagenode)
+	if err != nil {
+		zap.L().Error("Error retrieving pinned version.", zap.String("Service", runCfg.ServiceName), zap.Error(err))
+		return nil
+	}
+
+	updater, err := pinnedProcess(ctx, client, updaterServiceName, all.Processes.StoragenodeUpdater)
+	if err != nil {
+		zap.L().Error("Error retrieving pinned version.", zap.String("Service", updaterServiceName), zap.Error(err))
+		return nil
+	}
+
+	manifest, err := releaseManifest(ctx)
+	if err != nil {
+		zap.L().Error("Error retrieving release manifest.", zap.Error(err))
+		return nil
+	}
+
+	if err := update(ctx, runCfg.ServiceName, runCfg.BinaryLocation, storagenode, manifest); err != nil {
 		// don't finish loop in case of error just wait for another execution
 		zap.L().Error("Error updating service.", zap.String("Service", runCfg.ServiceName), zap.Error(err))
 	}
 
-	if err := update(ctx, updaterServiceName, updaterBinaryPath, all.Processes.StoragenodeUpdater); err != nil {
+	if err := update(ctx, updaterServiceName, updaterBinaryPath, updater, manifest); err != nil {
 		// don't finish loop in case of error just wait for another execution
 		zap.L().Error("Error updating service.", zap.String("Service", updaterServiceName), zap.Error(err))
 	}
@@ -11,9 +11,10 @@ import (
 	"go.uber.org/zap"
 
 	"storj.io/private/version"
+	"storj.io/storj/private/version/checker"
 )
 
-func update(ctx context.Context, serviceName, binaryLocation string, ver version.Process) error {
+func update(ctx context.Context, serviceName, binaryLocation string, ver version.Process, manifest *checker.ReleaseManifest) error {
 	currentVersion, err := binaryVersion(binaryLocation)
 	if err != nil {
 		return errs.Wrap(err)
@@ -36,7 +37,7 @@ func update(ctx context.Context, serviceName, binaryLocation string, ver version
 
 	newVersionPath := prependExtension(binaryLocation, newVersion.Version)
 
-	if err = downloadBinary(ctx, parseDownloadURL(newVersion.URL), newVersionPath); err != nil {
+	if err = downloadBinary(ctx, parseDownloadURL(newVersion.URL), newVersionPath, manifest); err != nil {
 		return errs.Wrap(err)
 	}
 
@@ -72,3 +73,41 @@ func update(ctx context.Context, serviceName, binaryLocation string, ver version
 	zap.L().Info("Service restarted successfully.", zap.String("Service", serviceName))
 	return nil
 }
+
+// pinnedProcess limits the versions of the process to the version the node
+// is pinned to on the version control server. The versions are returned
+// unchanged when the node isn't pinned.
+func pinnedProcess(ctx context.Context, client *checker.Client, serviceName string, ver version.Process) (version.Process, error) {
+	nodeVersion, err := client.NodeVersion(ctx, serviceName, nodeID)
+	if err != nil {
+		return version.Process{}, err
+	}
+	if !nodeVersion.Pinned {
+		return ver, nil
+	}
+
+	zap.L().Info("Node is pinned to a version.",
+		zap.String("Service", serviceName),
+		zap.String("Version", nodeVersion.Version.Version),
+	)
+
+	// the pinned version is both the minimum and the suggested version, so
+	// that the node updates to it, but never beyond it.
+	ver.Minimum = nodeVersion.Version
+	ver.Suggested = nodeVersion.Version
+	return ver, nil
+}
+
+// releaseManifest returns the signed release manifest, when the public key of
+// the release signing key is configured.
+func releaseManifest(ctx context.Context) (*checker.ReleaseManifest, error) {
+	if runCfg.Version.PublicKey == "" {
+		return nil, nil
+	}
+
+	manifest, err := checker.New(runCfg.Version.ClientConfig).ReleaseManifest(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &manifest, nil
+}
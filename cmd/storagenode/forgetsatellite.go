@@ -0,0 +1,126 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/memory"
+	"storj.io/common/storj"
+	"storj.io/private/process"
+	"storj.io/storj/private/prompt"
+	"storj.io/storj/storagenode/forgetsatellite"
+	"storj.io/storj/storagenode/orders"
+	"storj.io/storj/storagenode/pieces"
+	"storj.io/storj/storagenode/storagenodedb"
+	"storj.io/storj/storagenode/trust"
+)
+
+func cmdForgetSatellite(cmd *cobra.Command, args []string) (err error) {
+	ctx, _ := process.Ctx(cmd)
+	log := zap.L()
+
+	satelliteID, err := storj.NodeIDFromString(args[0])
+	if err != nil {
+		return errs.New("invalid satellite id %q: %v", args[0], err)
+	}
+
+	if err := checkNodeStopped(forgetSatelliteCfg.Server.Address, forgetSatelliteCfg.Server.PrivateAddress); err != nil {
+		return err
+	}
+
+	db, err := storagenodedb.OpenExisting(ctx, log.Named("db"), forgetSatelliteCfg.DatabaseConfig())
+	if err != nil {
+		return errs.New("Error starting master database on storage node: %v", err)
+	}
+	defer func() {
+		err = errs.Combine(err, db.Close())
+	}()
+
+	store := pieces.NewStore(log.Named("pieces"),
+		db.Pieces(),
+		db.V0PieceInfo(),
+		db.PieceExpirationDB(),
+		db.PieceSpaceUsedDB(),
+		forgetSatelliteCfg.Pieces,
+	)
+
+	ordersStore, err := orders.NewFileStore(log.Named("ordersfilestore"),
+		forgetSatelliteCfg.Storage2.Orders.Path,
+		forgetSatelliteCfg.Storage2.OrderLimitGracePeriod,
+	)
+	if err != nil {
+		return err
+	}
+
+	// the trust pool only fetches the lists of the trusted satellites, so it
+	// doesn't need to resolve their identities.
+	trustPool, err := trust.NewPool(log.Named("trust"), nil, forgetSatelliteCfg.Storage2.Trust, db.Satellites())
+	if err != nil {
+		return err
+	}
+	if err := trustPool.Refresh(ctx); err != nil {
+		return errs.New("unable to fetch the trusted satellites: %v", err)
+	}
+
+	cleaner := forgetsatellite.NewCleaner(log.Named("forget-satellite"), store, ordersStore, trustPool, forgetsatellite.DB{
+		Satellites:   db.Satellites(),
+		Reputation:   db.Reputation(),
+		Pricing:      db.Pricing(),
+		StorageUsage: db.StorageUsage(),
+		SpaceUsed:    db.PieceSpaceUsedDB(),
+	})
+
+	estimate, err := cleaner.Estimate(ctx, satelliteID)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Satellite %s has %d pieces using %s.\n", satelliteID, estimate.Pieces, memory.Size(estimate.ContentSize).Base10String())
+	if forgetSatelliteCfg.DryRun {
+		return nil
+	}
+
+	if err := cleaner.CheckForgettable(ctx, satelliteID); err != nil {
+		return err
+	}
+
+	confirmed, err := prompt.Confirm("All pieces, orders and database records of the satellite will be deleted.\nThis action can not be undone.\nAre you sure you want to continue? [y/n]\n")
+	if err != nil {
+		return err
+	}
+	if !confirmed {
+		return nil
+	}
+
+	summary, err := cleaner.Forget(ctx, satelliteID)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Deleted %d pieces using %s and %d order files of satellite %s.\n", summary.Pieces, memory.Size(summary.ContentSize).Base10String(), summary.OrderFiles, satelliteID)
+	return nil
+}
+
+// checkNodeStopped returns an error, when the storage node listens on one of
+// its addresses. The running node would keep storing pieces and orders of the
+// satellite, while they are deleted.
+func checkNodeStopped(addresses ...string) error {
+	for _, address := range addresses {
+		if address == "" {
+			continue
+		}
+		conn, err := net.DialTimeout("tcp", address, time.Second)
+		if err != nil {
+			continue
+		}
+		_ = conn.Close()
+		return errs.New("the storage node is running on %s, stop it first", address)
+	}
+	return nil
+}
@@ -11,6 +11,7 @@ import (
 	"os"
 	"strings"
 	"text/tabwriter"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/zeebo/errs"
@@ -58,6 +59,10 @@ func (client *gracefulExitClient) gracefulExitFeasibility(ctx context.Context, i
 	return internalpb.NewDRPCNodeGracefulExitClient(client.conn).GracefulExitFeasibility(ctx, &internalpb.GracefulExitFeasibilityRequest{NodeId: id})
 }
 
+func (client *gracefulExitClient) abortGracefulExit(ctx context.Context, req *internalpb.AbortGracefulExitRequest) (*internalpb.AbortGracefulExitResponse, error) {
+	return internalpb.NewDRPCNodeGracefulExitClient(client.conn).AbortGracefulExit(ctx, req)
+}
+
 func (client *gracefulExitClient) close() error {
 	return client.conn.Close()
 }
@@ -185,6 +190,61 @@ func cmdGracefulExitStatus(cmd *cobra.Command, args []string) (err error) {
 	return nil
 }
 
+func cmdGracefulExitAbort(cmd *cobra.Command, args []string) (err error) {
+	ctx, _ := process.Ctx(cmd)
+
+	satelliteID, err := storj.NodeIDFromString(args[0])
+	if err != nil {
+		return errs.New("invalid satellite id: %w", err)
+	}
+	var reason string
+	if len(args) > 1 {
+		reason = args[1]
+	}
+
+	ident, err := runCfg.Identity.Load()
+	if err != nil {
+		zap.L().Fatal("Failed to load identity.", zap.Error(err))
+	} else {
+		zap.L().Info("Identity loaded.", zap.Stringer("Node ID", ident.ID))
+	}
+
+	// display warning message
+	confirmed, err := prompt.Confirm("By aborting a graceful exit from a satellite, you will not be able to initiate a new one until the cooldown of the satellite has passed.\nAre you sure you want to continue? [y/n]\n")
+	if err != nil {
+		return err
+	}
+	if !confirmed {
+		return nil
+	}
+
+	client, err := dialGracefulExitClient(ctx, diagCfg.Server.PrivateAddress)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	defer func() {
+		if err := client.close(); err != nil {
+			zap.L().Debug("Closing graceful exit client failed.", zap.Error(err))
+		}
+	}()
+
+	return gracefulExitAbort(ctx, satelliteID, reason, os.Stdout, client)
+}
+
+func gracefulExitAbort(ctx context.Context, satelliteID storj.NodeID, reason string, w io.Writer, client *gracefulExitClient) (err error) {
+	resp, err := client.abortGracefulExit(ctx, &internalpb.AbortGracefulExitRequest{
+		NodeId: satelliteID,
+		Reason: reason,
+	})
+	if err != nil {
+		fmt.Fprintln(w, "Failed to abort graceful exit. Please check that the graceful exit is in progress.")
+		return errs.Wrap(err)
+	}
+
+	fmt.Fprintf(w, "Graceful exit from %s aborted. A new graceful exit can be initiated after %s.\n", satelliteID, resp.CooldownUntil.Local().Format(time.RFC1123))
+	return nil
+}
+
 func displayExitProgress(w io.Writer, progresses []*internalpb.ExitProgress) {
 	fmt.Fprintln(w, "\nDomain Name\tNode ID\tPercent Complete\tSuccessful\tCompletion Receipt")
 
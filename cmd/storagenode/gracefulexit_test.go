@@ -4,9 +4,11 @@
 package main
 
 import (
+	"io"
 	"os"
 	"testing"
 	"text/tabwriter"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
@@ -15,6 +17,8 @@ import (
 	"storj.io/common/testcontext"
 	"storj.io/storj/private/testplanet"
 	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/overlay"
+	"storj.io/storj/storagenode/internalpb"
 )
 
 func TestGracefulExitTooEarly(t *testing.T) {
@@ -71,3 +75,48 @@ func TestGracefulExitInit(t *testing.T) {
 		require.Error(t, err)
 	})
 }
+
+func TestGracefulExitAbort(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 0,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.GracefulExit.AbortCooldown = time.Hour
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		node := planet.StorageNodes[0]
+
+		client, err := dialGracefulExitClient(ctx, node.Server.PrivateAddr().String())
+		require.NoError(t, err)
+		defer ctx.Check(client.close)
+
+		// the satellite rejects aborting an exit, which hasn't been initiated.
+		err = gracefulExitAbort(ctx, satellite.ID(), "test", io.Discard, client)
+		require.Error(t, err)
+
+		_, err = client.initGracefulExit(ctx, &internalpb.InitiateGracefulExitRequest{NodeId: satellite.ID()})
+		require.NoError(t, err)
+		_, err = satellite.Overlay.DB.UpdateExitStatus(ctx, &overlay.ExitStatusRequest{
+			NodeID:          node.ID(),
+			ExitInitiatedAt: time.Now().UTC(),
+		})
+		require.NoError(t, err)
+
+		err = gracefulExitAbort(ctx, satellite.ID(), "test", io.Discard, client)
+		require.NoError(t, err)
+
+		status, err := satellite.Overlay.DB.GetExitStatus(ctx, node.ID())
+		require.NoError(t, err)
+		require.Nil(t, status.ExitInitiatedAt)
+
+		abort, err := satellite.DB.GracefulExit().GetLatestAbort(ctx, node.ID())
+		require.NoError(t, err)
+		require.Equal(t, "test", abort.Reason)
+
+		progress, err := client.getExitProgress(ctx)
+		require.NoError(t, err)
+		require.Empty(t, progress.Progress)
+	})
+}
@@ -88,6 +88,16 @@ var (
 		RunE:        cmdGracefulExitStatus,
 		Annotations: map[string]string{"type": "helper"},
 	}
+	gracefulExitAbortCmd = &cobra.Command{
+		Use:   "exit-abort <satellite id> [reason]",
+		Short: "Abort graceful exit",
+		Long: "Abort the graceful exit from a satellite, which is in progress.\n" +
+			"A new graceful exit from the satellite can't be initiated until " +
+			"the cooldown of the satellite has passed.",
+		Args:        cobra.RangeArgs(1, 2),
+		RunE:        cmdGracefulExitAbort,
+		Annotations: map[string]string{"type": "helper"},
+	}
 	issueAPITokenCmd = &cobra.Command{
 		Use:   "issue-apikey",
 		Short: "Issue apikey for multinode",
@@ -118,6 +128,21 @@ $ storagenode info --json --config-dir '<path/to/config-dir>' --identity-dir '<p
 		Args: cobra.ExactArgs(0),
 	}
 
+	forgetSatelliteCmd = &cobra.Command{
+		Use:   "forget-satellite <satellite-id>",
+		Short: "Delete all data stored for a satellite",
+		Long: `Delete all pieces, orders and local database records of an untrusted or
+shut down satellite. The bandwidth usage and the payout history are kept.
+
+The command refuses to run while the node is running, and unless the satellite
+was removed from the trusted satellites, announced its shutdown or the node
+exited it gracefully. Use --dry-run to only print the amount of data stored for
+the satellite.
+`,
+		RunE: cmdForgetSatellite,
+		Args: cobra.ExactArgs(1),
+	}
+
 	runCfg      StorageNodeFlags
 	setupCfg    StorageNodeFlags
 	diagCfg     storagenode.Config
@@ -126,6 +151,11 @@ $ storagenode info --json --config-dir '<path/to/config-dir>' --identity-dir '<p
 
 		JSON bool `default:"false" help:"print node info in JSON format"`
 	}
+	forgetSatelliteCfg struct {
+		storagenode.Config
+
+		DryRun bool `default:"false" help:"only print the amount of data stored for the satellite"`
+	}
 	dashboardCfg struct {
 		Address string `default:"127.0.0.1:7778" help:"address for dashboard service"`
 	}
@@ -156,8 +186,10 @@ func init() {
 	rootCmd.AddCommand(dashboardCmd)
 	rootCmd.AddCommand(gracefulExitInitCmd)
 	rootCmd.AddCommand(gracefulExitStatusCmd)
+	rootCmd.AddCommand(gracefulExitAbortCmd)
 	rootCmd.AddCommand(issueAPITokenCmd)
 	rootCmd.AddCommand(nodeInfoCmd)
+	rootCmd.AddCommand(forgetSatelliteCmd)
 	process.Bind(runCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(setupCmd, &setupCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir), cfgstruct.SetupMode())
 	process.Bind(configCmd, &setupCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir), cfgstruct.SetupMode())
@@ -165,8 +197,10 @@ func init() {
 	process.Bind(dashboardCmd, &dashboardCfg, defaults, cfgstruct.ConfDir(defaultDiagDir))
 	process.Bind(gracefulExitInitCmd, &diagCfg, defaults, cfgstruct.ConfDir(defaultDiagDir))
 	process.Bind(gracefulExitStatusCmd, &diagCfg, defaults, cfgstruct.ConfDir(defaultDiagDir))
+	process.Bind(gracefulExitAbortCmd, &diagCfg, defaults, cfgstruct.ConfDir(defaultDiagDir))
 	process.Bind(issueAPITokenCmd, &diagCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(nodeInfoCmd, &nodeInfoCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
+	process.Bind(forgetSatelliteCmd, &forgetSatelliteCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 }
 
 func cmdRun(cmd *cobra.Command, args []string) (err error) {
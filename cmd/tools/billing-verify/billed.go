@@ -0,0 +1,64 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/stripe/stripe-go/v72"
+
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/payments/stripecoinpayments"
+)
+
+// StripeBilledItems lists the invoice line items, which were created in
+// Stripe for the project owners.
+type StripeBilledItems struct {
+	customers stripecoinpayments.CustomersDB
+	client    stripecoinpayments.StripeClient
+}
+
+// NewStripeBilledItems creates a new lister of the billed invoice items.
+func NewStripeBilledItems(customers stripecoinpayments.CustomersDB, client stripecoinpayments.StripeClient) *StripeBilledItems {
+	return &StripeBilledItems{
+		customers: customers,
+		client:    client,
+	}
+}
+
+// ProjectItems returns the invoice items of the project for the billing
+// period between start and end. The items of a period are created after the
+// period has ended, but before the next one ends.
+func (items *StripeBilledItems) ProjectItems(ctx context.Context, project console.Project, start, end time.Time) (_ []*stripe.InvoiceItem, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	customerID, err := items.customers.GetCustomerID(ctx, project.OwnerID)
+	if err != nil {
+		if errors.Is(err, stripecoinpayments.ErrNoCustomer) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	params := &stripe.InvoiceItemListParams{
+		Customer: stripe.String(customerID),
+		CreatedRange: &stripe.RangeQueryParams{
+			GreaterThanOrEqual: end.Unix(),
+			LesserThan:         end.AddDate(0, 1, 0).Unix(),
+		},
+	}
+	params.Context = ctx
+
+	var projectItems []*stripe.InvoiceItem
+	it := items.client.InvoiceItems().List(params)
+	for it.Next() {
+		item := it.InvoiceItem()
+		if item.Metadata["projectID"] == project.ID.String() {
+			projectItems = append(projectItems, item)
+		}
+	}
+	return projectItems, it.Err()
+}
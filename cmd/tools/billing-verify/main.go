@@ -0,0 +1,139 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"os"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/spf13/cobra"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/fpath"
+	"storj.io/common/storj"
+	"storj.io/private/cfgstruct"
+	"storj.io/private/process"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/payments/stripecoinpayments"
+	"storj.io/storj/satellite/satellitedb"
+)
+
+var mon = monkit.Package()
+
+// Error is the default error class for billing-verify.
+var Error = errs.Class("billing-verify")
+
+// Satellite defines satellite configuration.
+type Satellite struct {
+	Database string `help:"satellite database connection string" releaseDefault:"postgres://" devDefault:"postgres://"`
+
+	satellite.Config
+}
+
+var (
+	rootCmd = &cobra.Command{
+		Use:   "billing-verify",
+		Short: "billing-verify",
+	}
+
+	verifyCmd = &cobra.Command{
+		Use:   "verify <yyyy-mm>",
+		Short: "recomputes the usage of a billing period and compares it with the billed invoice items",
+		Args:  cobra.ExactArgs(1),
+		RunE:  verify,
+	}
+
+	satelliteCfg Satellite
+	verifyCfg    Config
+
+	confDir     string
+	identityDir string
+)
+
+func init() {
+	defaultConfDir := fpath.ApplicationDir("storj", "satellite")
+	defaultIdentityDir := fpath.ApplicationDir("storj", "identity", "satellite")
+	cfgstruct.SetupFlag(zap.L(), rootCmd, &confDir, "config-dir", defaultConfDir, "main directory for satellite configuration")
+	cfgstruct.SetupFlag(zap.L(), rootCmd, &identityDir, "identity-dir", defaultIdentityDir, "main directory for satellite identity credentials")
+	defaults := cfgstruct.DefaultsFlag(rootCmd)
+
+	rootCmd.AddCommand(verifyCmd)
+
+	process.Bind(verifyCmd, &satelliteCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
+	process.Bind(verifyCmd, &verifyCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
+}
+
+func verify(cmd *cobra.Command, args []string) (err error) {
+	ctx, _ := process.Ctx(cmd)
+	log := zap.L()
+
+	period, err := time.Parse("2006-01", args[0])
+	if err != nil {
+		return Error.New("invalid period %q, expected yyyy-mm: %v", args[0], err)
+	}
+
+	db, err := satellitedb.Open(ctx, log.Named("db"), satelliteCfg.Database, satellitedb.Options{ApplicationName: "billing-verify"})
+	if err != nil {
+		return errs.New("error connecting to master database on satellite: %+v", err)
+	}
+	defer func() {
+		err = errs.Combine(err, db.Close())
+	}()
+
+	pc := satelliteCfg.Payments
+	prices, err := pc.UsagePrice.ToModel()
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	priceOverrides, err := pc.UsagePriceOverrides.ToModels()
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	// the payments service is only used for calculating the invoice items,
+	// so the stripe mock is sufficient. The billed items are listed with the
+	// real client.
+	payments, err := stripecoinpayments.NewService(
+		log.Named("payments.stripe:service"),
+		stripecoinpayments.NewStripeMock(storj.NodeID{}, db.StripeCoinPayments().Customers(), db.Console().Users()),
+		pc.StripeCoinPayments,
+		db.StripeCoinPayments(),
+		db.Wallets(),
+		db.Billing(),
+		db.Console().Projects(),
+		db.ProjectAccounting(),
+		prices,
+		priceOverrides,
+		pc.BonusRate,
+		nil)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	report, err := os.Create(verifyCfg.ReportPath)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, report.Close()) }()
+
+	billed := NewStripeBilledItems(db.StripeCoinPayments().Customers(), stripecoinpayments.NewStripeClient(log.Named("payments.stripe:client"), pc.StripeCoinPayments))
+
+	verifier := NewVerifier(log.Named("verifier"), db.Console().Projects(), billed, db.ProjectAccounting(), payments.InvoiceItemsFromProjectRecord, verifyCfg)
+	summary, err := verifier.Verify(ctx, period, report)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	log.Info("billing verification finished",
+		zap.Int("projects", summary.Projects),
+		zap.Int("missing items", summary.MissingItems),
+		zap.Int("discrepancies", summary.Discrepancies))
+	return nil
+}
+
+func main() {
+	process.Exec(rootCmd)
+}
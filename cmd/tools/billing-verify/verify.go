@@ -0,0 +1,225 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/stripe/stripe-go/v72"
+	"go.uber.org/zap"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/accounting"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/payments/stripecoinpayments"
+)
+
+// Config contains configurable options for the billing verification.
+type Config struct {
+	ReportPath   string  `help:"csv file for the discrepancies" default:"billing-discrepancies.csv"`
+	ListingLimit int     `help:"number of projects to list at once" default:"100"`
+	Threshold    float64 `help:"minimum difference in cents per invoice item to report" default:"1"`
+}
+
+// Projects lists the projects.
+type Projects interface {
+	List(ctx context.Context, offset int64, limit int, before time.Time) (console.ProjectsPage, error)
+}
+
+// BilledItems returns the invoice line items, which were billed for the
+// usage of a project in a billing period.
+type BilledItems interface {
+	ProjectItems(ctx context.Context, project console.Project, start, end time.Time) ([]*stripe.InvoiceItem, error)
+}
+
+// ProjectUsage calculates the usage of a project from the tallies and bandwidth rollups.
+type ProjectUsage interface {
+	GetProjectTotal(ctx context.Context, projectID uuid.UUID, since, before time.Time) (*accounting.ProjectUsage, error)
+}
+
+// InvoiceItemsFunc calculates the invoice items of the usage of a project.
+type InvoiceItemsFunc func(projName string, record stripecoinpayments.ProjectRecord) []*stripe.InvoiceItemParams
+
+// Summary contains the totals of the verification.
+type Summary struct {
+	Projects      int
+	MissingItems  int
+	Discrepancies int
+}
+
+// Verifier recomputes the usage of projects and compares it with the billed
+// invoice line items.
+type Verifier struct {
+	log    *zap.Logger
+	config Config
+
+	projects     Projects
+	billed       BilledItems
+	usage        ProjectUsage
+	invoiceItems InvoiceItemsFunc
+}
+
+// NewVerifier creates a new billing verifier.
+func NewVerifier(log *zap.Logger, projects Projects, billed BilledItems, usage ProjectUsage, invoiceItems InvoiceItemsFunc, config Config) *Verifier {
+	return &Verifier{
+		log:          log,
+		config:       config,
+		projects:     projects,
+		billed:       billed,
+		usage:        usage,
+		invoiceItems: invoiceItems,
+	}
+}
+
+// Verify verifies the billed usage of all projects in the billing period of the specified month.
+// The discrepancies are written as csv into w.
+func (verifier *Verifier) Verify(ctx context.Context, period time.Time, w io.Writer) (summary Summary, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	utc := period.UTC()
+	start := time.Date(utc.Year(), utc.Month(), 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(utc.Year(), utc.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+
+	report := csv.NewWriter(w)
+	err = report.Write([]string{"project id", "item", "billed quantity", "recomputed quantity", "billed cents", "recomputed cents", "difference cents"})
+	if err != nil {
+		return Summary{}, Error.Wrap(err)
+	}
+
+	var offset int64
+	for {
+		page, err := verifier.projects.List(ctx, offset, verifier.config.ListingLimit, end)
+		if err != nil {
+			return Summary{}, Error.Wrap(err)
+		}
+
+		for _, project := range page.Projects {
+			summary.Projects++
+			if err := verifier.verifyProject(ctx, report, &summary, project, start, end); err != nil {
+				return Summary{}, Error.Wrap(err)
+			}
+		}
+
+		if !page.Next {
+			break
+		}
+		offset = page.NextOffset
+	}
+
+	report.Flush()
+	return summary, Error.Wrap(report.Error())
+}
+
+// verifyProject compares the billed invoice items of the project with invoice
+// items calculated from the current usage.
+func (verifier *Verifier) verifyProject(ctx context.Context, report *csv.Writer, summary *Summary, project console.Project, start, end time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	usage, err := verifier.usage.GetProjectTotal(ctx, project.ID, start, end)
+	if err != nil {
+		return err
+	}
+	recomputed := verifier.invoiceItems(project.Name, stripecoinpayments.ProjectRecord{
+		ProjectID: project.ID,
+		Storage:   usage.Storage,
+		Egress:    usage.Egress,
+		Segments:  usage.SegmentCount,
+	})
+
+	billed, err := verifier.billed.ProjectItems(ctx, project, start, end)
+	if err != nil {
+		return err
+	}
+
+	if len(billed) == 0 {
+		// projects without any usage are fine without invoice items.
+		total := 0.0
+		for _, item := range recomputed {
+			total += itemCents(item)
+		}
+		if total < verifier.config.Threshold {
+			return nil
+		}
+
+		summary.MissingItems++
+		verifier.log.Warn("invoice items missing", zap.Stringer("project-id", project.ID))
+		return report.Write([]string{project.ID.String(), "missing invoice items", "", "", "0", formatCents(total), formatCents(total)})
+	}
+
+	// the project name is part of the description and may have changed since
+	// the invoice items were created, so the items are matched by their kind.
+	billedByKind := make(map[string]*stripe.InvoiceItem, len(billed))
+	for _, item := range billed {
+		billedByKind[itemKind(item.Description)] = item
+	}
+
+	for _, item := range recomputed {
+		kind := itemKind(stripe.StringValue(item.Description))
+
+		var billedQuantity int64
+		var billedCents float64
+		if billedItem, ok := billedByKind[kind]; ok {
+			billedQuantity, billedCents = billedItem.Quantity, float64(billedItem.Amount)
+			delete(billedByKind, kind)
+		}
+
+		recomputedCents := itemCents(item)
+		if err := verifier.compare(report, summary, project, kind, billedQuantity, stripe.Int64Value(item.Quantity), billedCents, recomputedCents); err != nil {
+			return err
+		}
+	}
+
+	// items, which were billed, but aren't calculated anymore.
+	for kind, billedItem := range billedByKind {
+		if err := verifier.compare(report, summary, project, kind, billedItem.Quantity, 0, float64(billedItem.Amount), 0); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// compare reports the item, when the billed and the recomputed amount differ
+// at least by the threshold.
+func (verifier *Verifier) compare(report *csv.Writer, summary *Summary, project console.Project, kind string, billedQuantity, recomputedQuantity int64, billedCents, recomputedCents float64) error {
+	difference := recomputedCents - billedCents
+	if math.Abs(difference) < verifier.config.Threshold {
+		return nil
+	}
+
+	summary.Discrepancies++
+	return report.Write([]string{
+		project.ID.String(),
+		kind,
+		strconv.FormatInt(billedQuantity, 10),
+		strconv.FormatInt(recomputedQuantity, 10),
+		formatCents(billedCents),
+		formatCents(recomputedCents),
+		formatCents(difference),
+	})
+}
+
+// itemKind returns the description of the item without the project name.
+func itemKind(description string) string {
+	if i := strings.LastIndex(description, " - "); i >= 0 {
+		return description[i+len(" - "):]
+	}
+	return description
+}
+
+// itemCents returns the total amount of the invoice item in cents, the same
+// way as Stripe rounds the amount of invoice items.
+func itemCents(item *stripe.InvoiceItemParams) float64 {
+	return math.Round(float64(stripe.Int64Value(item.Quantity)) * stripe.Float64Value(item.UnitAmountDecimal))
+}
+
+func formatCents(cents float64) string {
+	return strconv.FormatFloat(cents, 'f', 2, 64)
+}
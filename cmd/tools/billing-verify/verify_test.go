@@ -0,0 +1,127 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main_test
+
+import (
+	"context"
+	"encoding/csv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stripe/stripe-go/v72"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/common/uuid"
+	billingverify "storj.io/storj/cmd/tools/billing-verify"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite/accounting"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/payments/stripecoinpayments"
+)
+
+func TestVerifier(t *testing.T) {
+	ctx := testcontext.New(t)
+	log := testplanet.NewLogger(t)
+
+	correct := console.Project{ID: testrand.UUID(), Name: "correct"}
+	underbilled := console.Project{ID: testrand.UUID(), Name: "underbilled"}
+	unbilled := console.Project{ID: testrand.UUID(), Name: "unbilled"}
+	unused := console.Project{ID: testrand.UUID(), Name: "unused"}
+	renamed := console.Project{ID: testrand.UUID(), Name: "renamed"}
+
+	db := &billingMock{
+		projects: []console.Project{correct, underbilled, unbilled, unused, renamed},
+		billed: map[uuid.UUID][]*stripe.InvoiceItem{
+			correct.ID: {
+				{Description: "Project correct - storage", Quantity: 100, Amount: 100},
+				{Description: "Project correct - egress", Quantity: 10, Amount: 20},
+			},
+			// the amount is what was actually billed, even when the quantity
+			// matches.
+			underbilled.ID: {
+				{Description: "Project underbilled - storage", Quantity: 100, Amount: 100},
+				{Description: "Project underbilled - egress", Quantity: 30, Amount: 20},
+			},
+			renamed.ID: {
+				{Description: "Project old name - storage", Quantity: 7, Amount: 7},
+				{Description: "Project old name - egress", Quantity: 1, Amount: 2},
+			},
+		},
+		usage: map[uuid.UUID]*accounting.ProjectUsage{
+			correct.ID:     {Storage: 100.4, Egress: 10},
+			underbilled.ID: {Storage: 100, Egress: 30},
+			unbilled.ID:    {Storage: 5},
+			unused.ID:      {},
+			renamed.ID:     {Storage: 7, Egress: 1},
+		},
+	}
+
+	verifier := billingverify.NewVerifier(log, db, db, db, invoiceItems, billingverify.Config{
+		ListingLimit: 2,
+		Threshold:    1,
+	})
+
+	var out strings.Builder
+	summary, err := verifier.Verify(ctx, time.Date(2022, 10, 15, 0, 0, 0, 0, time.UTC), &out)
+	require.NoError(t, err)
+	require.Equal(t, billingverify.Summary{Projects: 5, MissingItems: 1, Discrepancies: 1}, summary)
+
+	rows, err := csv.NewReader(strings.NewReader(out.String())).ReadAll()
+	require.NoError(t, err)
+	require.Equal(t, [][]string{
+		{"project id", "item", "billed quantity", "recomputed quantity", "billed cents", "recomputed cents", "difference cents"},
+		{underbilled.ID.String(), "egress", "30", "30", "20.00", "60.00", "40.00"},
+		{unbilled.ID.String(), "missing invoice items", "", "", "0", "5.00", "5.00"},
+	}, rows)
+
+	require.Equal(t, time.Date(2022, 10, 1, 0, 0, 0, 0, time.UTC), db.start)
+	require.Equal(t, time.Date(2022, 11, 1, 0, 0, 0, 0, time.UTC), db.end)
+}
+
+func invoiceItems(projName string, record stripecoinpayments.ProjectRecord) []*stripe.InvoiceItemParams {
+	return []*stripe.InvoiceItemParams{
+		{
+			Description:       stripe.String("Project " + projName + " - storage"),
+			Quantity:          stripe.Int64(int64(record.Storage)),
+			UnitAmountDecimal: stripe.Float64(1),
+		},
+		{
+			Description:       stripe.String("Project " + projName + " - egress"),
+			Quantity:          stripe.Int64(record.Egress),
+			UnitAmountDecimal: stripe.Float64(2),
+		},
+	}
+}
+
+type billingMock struct {
+	projects []console.Project
+	billed   map[uuid.UUID][]*stripe.InvoiceItem
+	usage    map[uuid.UUID]*accounting.ProjectUsage
+
+	start, end time.Time
+}
+
+func (db *billingMock) List(ctx context.Context, offset int64, limit int, before time.Time) (console.ProjectsPage, error) {
+	page := console.ProjectsPage{}
+	for i := int(offset); i < len(db.projects) && len(page.Projects) < limit; i++ {
+		page.Projects = append(page.Projects, db.projects[i])
+	}
+	if int(offset)+limit < len(db.projects) {
+		page.Next = true
+		page.NextOffset = offset + int64(limit)
+	}
+	return page, nil
+}
+
+func (db *billingMock) ProjectItems(ctx context.Context, project console.Project, start, end time.Time) ([]*stripe.InvoiceItem, error) {
+	db.start, db.end = start, end
+	return db.billed[project.ID], nil
+}
+
+func (db *billingMock) GetProjectTotal(ctx context.Context, projectID uuid.UUID, since, before time.Time) (*accounting.ProjectUsage, error) {
+	return db.usage[projectID], nil
+}
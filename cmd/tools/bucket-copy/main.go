@@ -0,0 +1,125 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/spf13/cobra"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/fpath"
+	"storj.io/common/uuid"
+	"storj.io/private/cfgstruct"
+	"storj.io/private/process"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/satellitedb"
+)
+
+var mon = monkit.Package()
+
+// Error is the default error class for bucket-copy.
+var Error = errs.Class("bucket-copy")
+
+// Satellite defines satellite configuration.
+type Satellite struct {
+	Database string `help:"satellite database connection string" releaseDefault:"postgres://" devDefault:"postgres://"`
+
+	satellite.Config
+}
+
+var (
+	rootCmd = &cobra.Command{
+		Use:   "bucket-copy",
+		Short: "bucket-copy",
+	}
+
+	runCmd = &cobra.Command{
+		Use:   "run",
+		Short: "copies the objects of a bucket into a bucket of another project",
+		RunE:  run,
+	}
+
+	satelliteCfg Satellite
+	runCfg       Config
+
+	confDir     string
+	identityDir string
+)
+
+func init() {
+	defaultConfDir := fpath.ApplicationDir("storj", "satellite")
+	defaultIdentityDir := fpath.ApplicationDir("storj", "identity", "satellite")
+	cfgstruct.SetupFlag(zap.L(), rootCmd, &confDir, "config-dir", defaultConfDir, "main directory for satellite configuration")
+	cfgstruct.SetupFlag(zap.L(), rootCmd, &identityDir, "identity-dir", defaultIdentityDir, "main directory for satellite identity credentials")
+	defaults := cfgstruct.DefaultsFlag(rootCmd)
+
+	rootCmd.AddCommand(runCmd)
+
+	process.Bind(runCmd, &satelliteCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
+	process.Bind(runCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
+}
+
+func run(cmd *cobra.Command, args []string) (err error) {
+	ctx, _ := process.Ctx(cmd)
+	log := zap.L()
+
+	sourceProject, err := uuid.FromString(runCfg.SourceProject)
+	if err != nil {
+		return Error.New("invalid source project %q: %v", runCfg.SourceProject, err)
+	}
+	destinationProject, err := uuid.FromString(runCfg.DestinationProject)
+	if err != nil {
+		return Error.New("invalid destination project %q: %v", runCfg.DestinationProject, err)
+	}
+	if runCfg.SourceBucket == "" {
+		return Error.New("source bucket not specified")
+	}
+	destinationBucket := runCfg.DestinationBucket
+	if destinationBucket == "" {
+		destinationBucket = runCfg.SourceBucket
+	}
+
+	// open default satellite database
+	db, err := satellitedb.Open(ctx, log.Named("db"), satelliteCfg.Database, satellitedb.Options{
+		ApplicationName: "bucket-copy",
+	})
+	if err != nil {
+		return errs.New("Error starting master database on satellite: %+v", err)
+	}
+	defer func() {
+		err = errs.Combine(err, db.Close())
+	}()
+
+	// open metabase, the metadata at rest is re-encrypted with its key manager.
+	metabaseDB, err := metabase.Open(ctx, log.Named("metabase"), satelliteCfg.Metainfo.DatabaseURL,
+		satelliteCfg.Config.Metainfo.Metabase("bucket-copy"))
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	defer func() { _ = metabaseDB.Close() }()
+
+	// check whether satellite and metabase versions match
+	versionErr := db.CheckVersion(ctx)
+	if versionErr != nil {
+		log.Error("versions skewed", zap.Error(versionErr))
+		return Error.Wrap(versionErr)
+	}
+
+	versionErr = metabaseDB.CheckVersion(ctx)
+	if versionErr != nil {
+		log.Error("versions skewed", zap.Error(versionErr))
+		return Error.Wrap(versionErr)
+	}
+
+	service := NewService(log.Named("bucket-copy"), metabaseDB, db.Buckets(), KeepEncryption{}, runCfg)
+	return service.Copy(ctx,
+		metabase.BucketLocation{ProjectID: sourceProject, BucketName: runCfg.SourceBucket},
+		metabase.BucketLocation{ProjectID: destinationProject, BucketName: destinationBucket})
+}
+
+func main() {
+	process.Exec(rootCmd)
+}
@@ -0,0 +1,325 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"io"
+	"os"
+	"strconv"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/metabase"
+)
+
+// Config contains configurable options for copying a bucket.
+type Config struct {
+	SourceProject      string `help:"project ID of the source bucket" default:""`
+	SourceBucket       string `help:"name of the source bucket" default:""`
+	DestinationProject string `help:"project ID of the destination bucket" default:""`
+	DestinationBucket  string `help:"name of the destination bucket, the name of the source bucket is used when empty, another name requires re-encrypting the objects" default:""`
+
+	BatchSize  int    `help:"number of objects to list per batch" default:"1000"`
+	ReportPath string `help:"csv file for the copied and skipped objects" default:"bucket-copy.csv"`
+	DryRun     bool   `help:"only report the objects, which would be copied" default:"false"`
+}
+
+// Metabase defines implementation dependencies we need from metabase.
+type Metabase interface {
+	ListObjects(ctx context.Context, opts metabase.ListObjects) (metabase.ListObjectsResult, error)
+	BeginCopyObject(ctx context.Context, opts metabase.BeginCopyObject) (metabase.BeginCopyObjectResult, error)
+	FinishCopyObject(ctx context.Context, opts metabase.FinishCopyObject) (metabase.Object, error)
+}
+
+// Buckets defines implementation dependencies we need from the buckets database.
+type Buckets interface {
+	GetBucketPlacement(ctx context.Context, bucketName []byte, projectID uuid.UUID) (storj.PlacementConstraint, error)
+}
+
+// EncryptedObject contains the parts of an object, which are encrypted by the
+// uplink.
+type EncryptedObject struct {
+	ObjectKey metabase.ObjectKey
+
+	EncryptedMetadata         []byte
+	EncryptedMetadataKeyNonce []byte
+	EncryptedMetadataKey      []byte
+
+	SegmentKeys []metabase.EncryptedKeyAndNonce
+}
+
+// Reencrypter re-encrypts the objects for the destination project.
+//
+// The metadata at rest is re-encrypted by metabase, but the satellite cannot
+// decrypt what the uplink encrypted. A reencrypter can replace those parts,
+// e.g. with keys provided by the owner of the bucket. The uplink derives the
+// keys of the objects from the bucket name, so a reencrypter is also needed
+// for copying into a bucket with another name.
+type Reencrypter interface {
+	Reencrypt(ctx context.Context, object EncryptedObject) (EncryptedObject, error)
+}
+
+// KeepEncryption copies the objects with their encryption unchanged. The
+// destination project needs the same encryption key as the source to read
+// the objects and the destination bucket must have the name of the source
+// bucket.
+type KeepEncryption struct{}
+
+// Reencrypt implements Reencrypter.
+func (KeepEncryption) Reencrypt(ctx context.Context, object EncryptedObject) (EncryptedObject, error) {
+	return object, nil
+}
+
+// Result contains the summary of a copy.
+type Result struct {
+	Copied   int
+	Skipped  int
+	Segments int64
+	Bytes    int64
+}
+
+// Service copies the objects of a bucket into a bucket of another project.
+type Service struct {
+	log    *zap.Logger
+	config Config
+
+	metabase    Metabase
+	buckets     Buckets
+	reencrypter Reencrypter
+}
+
+// NewService creates a new bucket copy service.
+func NewService(log *zap.Logger, metabaseDB Metabase, buckets Buckets, reencrypter Reencrypter, config Config) *Service {
+	return &Service{
+		log:    log,
+		config: config,
+
+		metabase:    metabaseDB,
+		buckets:     buckets,
+		reencrypter: reencrypter,
+	}
+}
+
+// Copy copies the last committed version of every object in the source bucket
+// into the destination bucket and writes the report.
+func (service *Service) Copy(ctx context.Context, source, destination metabase.BucketLocation) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	file, err := os.Create(service.config.ReportPath)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, Error.Wrap(file.Close())) }()
+
+	result, err := service.CopyTo(ctx, source, destination, file)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	service.log.Info("copy finished",
+		zap.Stringer("source-project", source.ProjectID),
+		zap.String("source-bucket", source.BucketName),
+		zap.Stringer("destination-project", destination.ProjectID),
+		zap.String("destination-bucket", destination.BucketName),
+		zap.Bool("dry-run", service.config.DryRun),
+		zap.Int("copied", result.Copied),
+		zap.Int("skipped", result.Skipped),
+		zap.Int64("segments", result.Segments),
+		zap.Int64("bytes", result.Bytes))
+	return nil
+}
+
+// CopyTo copies the objects of the source bucket into the destination bucket
+// and writes the report as csv into w.
+//
+// The remote segments of the copies reference the pieces of the source
+// objects, therefore both buckets must have the same placement. Objects with
+// too many segments for a server-side copy are skipped.
+func (service *Service) CopyTo(ctx context.Context, source, destination metabase.BucketLocation, w io.Writer) (_ Result, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if source == destination {
+		return Result{}, Error.New("source and destination are the same bucket")
+	}
+	if _, ok := service.reencrypter.(KeepEncryption); ok && source.BucketName != destination.BucketName {
+		return Result{}, Error.New("destination bucket %q must have the name of the source bucket %q, when the encryption is kept",
+			destination.BucketName, source.BucketName)
+	}
+
+	sourcePlacement, err := service.buckets.GetBucketPlacement(ctx, []byte(source.BucketName), source.ProjectID)
+	if err != nil {
+		return Result{}, Error.Wrap(err)
+	}
+	destinationPlacement, err := service.buckets.GetBucketPlacement(ctx, []byte(destination.BucketName), destination.ProjectID)
+	if err != nil {
+		return Result{}, Error.Wrap(err)
+	}
+	if sourcePlacement != destinationPlacement {
+		return Result{}, Error.New("placement of the destination bucket (%d) differs from the source bucket (%d)",
+			destinationPlacement, sourcePlacement)
+	}
+
+	report := csv.NewWriter(w)
+	err = report.Write([]string{"status", "source stream id", "destination stream id", "segments", "encrypted size", "reason"})
+	if err != nil {
+		return Result{}, Error.Wrap(err)
+	}
+
+	var result Result
+	var cursor metabase.ListObjectsCursor
+	for {
+		list, err := service.metabase.ListObjects(ctx, metabase.ListObjects{
+			ProjectID:  source.ProjectID,
+			BucketName: source.BucketName,
+			Recursive:  true,
+			Limit:      service.config.BatchSize,
+			Cursor:     cursor,
+			Status:     metabase.Committed,
+
+			IncludeSystemMetadata: true,
+		})
+		if err != nil {
+			return Result{}, Error.Wrap(err)
+		}
+
+		for _, entry := range list.Objects {
+			// versions are listed in ascending order, the last one is copied.
+			if entry.ObjectKey == cursor.Key {
+				continue
+			}
+			cursor = metabase.ListObjectsCursor{Key: entry.ObjectKey, Version: entry.Version}
+
+			row, copied, err := service.copyObject(ctx, source, destination, entry.ObjectKey)
+			if err != nil {
+				return Result{}, Error.Wrap(err)
+			}
+			if copied != nil {
+				result.Copied++
+				result.Segments += copied.Segments
+				result.Bytes += copied.Bytes
+			} else {
+				result.Skipped++
+			}
+
+			if err := report.Write(row); err != nil {
+				return Result{}, Error.Wrap(err)
+			}
+		}
+
+		if len(list.Objects) > 0 {
+			last := list.Objects[len(list.Objects)-1]
+			cursor = metabase.ListObjectsCursor{Key: last.ObjectKey, Version: last.Version}
+		}
+
+		service.log.Info("progress",
+			zap.Int("copied", result.Copied),
+			zap.Int("skipped", result.Skipped),
+			zap.Int64("segments", result.Segments),
+			zap.Int64("bytes", result.Bytes))
+
+		if !list.More {
+			break
+		}
+	}
+
+	report.Flush()
+	return result, Error.Wrap(report.Error())
+}
+
+// copyObject copies the last committed version of the object and returns the
+// report row and the size of the copy. Objects, which cannot be copied, are
+// reported as skipped.
+func (service *Service) copyObject(ctx context.Context, source, destination metabase.BucketLocation, key metabase.ObjectKey) (row []string, copied *Result, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var size Result
+	begin, err := service.metabase.BeginCopyObject(ctx, metabase.BeginCopyObject{
+		ObjectLocation: metabase.ObjectLocation{
+			ProjectID:  source.ProjectID,
+			BucketName: source.BucketName,
+			ObjectKey:  key,
+		},
+		VerifyLimits: func(encryptedObjectSize int64, nSegments int64) error {
+			size.Bytes, size.Segments = encryptedObjectSize, nSegments
+			return nil
+		},
+	})
+	if err != nil {
+		if metabase.ErrInvalidRequest.Has(err) || storj.ErrObjectNotFound.Has(err) {
+			return []string{"skipped", "", "", "", "", err.Error()}, nil, nil
+		}
+		return nil, nil, err
+	}
+
+	reportRow := func(status string, newStreamID uuid.UUID, reason string) []string {
+		var newStreamIDString string
+		if !newStreamID.IsZero() {
+			newStreamIDString = newStreamID.String()
+		}
+		return []string{status, begin.StreamID.String(), newStreamIDString,
+			strconv.FormatInt(size.Segments, 10), strconv.FormatInt(size.Bytes, 10), reason}
+	}
+
+	if service.config.DryRun {
+		return reportRow("dry-run", uuid.UUID{}, ""), &size, nil
+	}
+
+	reencrypted, err := service.reencrypter.Reencrypt(ctx, EncryptedObject{
+		ObjectKey:                 key,
+		EncryptedMetadata:         begin.EncryptedMetadata,
+		EncryptedMetadataKeyNonce: begin.EncryptedMetadataKeyNonce,
+		EncryptedMetadataKey:      begin.EncryptedMetadataKey,
+		SegmentKeys:               begin.EncryptedKeysNonces,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	newStreamID, err := uuid.New()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	finish := metabase.FinishCopyObject{
+		ObjectStream: metabase.ObjectStream{
+			ProjectID:  source.ProjectID,
+			BucketName: source.BucketName,
+			ObjectKey:  key,
+			Version:    begin.Version,
+			StreamID:   begin.StreamID,
+		},
+		NewProjectID:          destination.ProjectID,
+		NewBucket:             destination.BucketName,
+		NewEncryptedObjectKey: reencrypted.ObjectKey,
+		NewStreamID:           newStreamID,
+		NewSegmentKeys:        reencrypted.SegmentKeys,
+
+		// the metadata is always passed, so metabase seals it with the key of
+		// the destination project.
+		OverrideMetadata: true,
+	}
+	if len(reencrypted.EncryptedMetadata) > 0 {
+		finish.NewEncryptedMetadata = reencrypted.EncryptedMetadata
+		finish.NewEncryptedMetadataKey = reencrypted.EncryptedMetadataKey
+		finish.NewEncryptedMetadataKeyNonce, err = storj.NonceFromBytes(reencrypted.EncryptedMetadataKeyNonce)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	_, err = service.metabase.FinishCopyObject(ctx, finish)
+	if err != nil {
+		if storj.ErrObjectNotFound.Has(err) {
+			return reportRow("skipped", uuid.UUID{}, err.Error()), nil, nil
+		}
+		return nil, nil, err
+	}
+
+	return reportRow("copied", newStreamID, ""), &size, nil
+}
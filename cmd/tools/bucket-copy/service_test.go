@@ -0,0 +1,209 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main_test
+
+import (
+	"context"
+	"encoding/csv"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/common/uuid"
+	bucketcopy "storj.io/storj/cmd/tools/bucket-copy"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite/metabase"
+)
+
+func TestService_CopyTo(t *testing.T) {
+	ctx := testcontext.New(t)
+	log := testplanet.NewLogger(t)
+
+	source := metabase.BucketLocation{ProjectID: testrand.UUID(), BucketName: "source"}
+	destination := metabase.BucketLocation{ProjectID: testrand.UUID(), BucketName: "destination"}
+
+	objects := []metabase.Object{
+		{ObjectStream: metabase.ObjectStream{ObjectKey: "a", Version: 1, StreamID: uuid.UUID{0x10}}, SegmentCount: 2, TotalEncryptedSize: 100,
+			EncryptedMetadata: []byte("metadata"), EncryptedMetadataNonce: testrand.Nonce().Bytes(), EncryptedMetadataEncryptedKey: []byte("key")},
+		// older version, which isn't copied.
+		{ObjectStream: metabase.ObjectStream{ObjectKey: "b", Version: 1, StreamID: uuid.UUID{0x20}}, SegmentCount: 1, TotalEncryptedSize: 10},
+		{ObjectStream: metabase.ObjectStream{ObjectKey: "b", Version: 2, StreamID: uuid.UUID{0x21}}, SegmentCount: 1, TotalEncryptedSize: 20},
+		// too many segments.
+		{ObjectStream: metabase.ObjectStream{ObjectKey: "c", Version: 1, StreamID: uuid.UUID{0x30}}, SegmentCount: 10001},
+		{ObjectStream: metabase.ObjectStream{ObjectKey: "d", Version: 1, StreamID: uuid.UUID{0x40}}, SegmentCount: 0},
+	}
+	for i := range objects {
+		objects[i].ProjectID, objects[i].BucketName = source.ProjectID, source.BucketName
+	}
+
+	buckets := bucketsMock{source: storj.EU, destination: storj.EU}
+	db := &metabaseMock{objects: objects}
+
+	service := bucketcopy.NewService(log, db, buckets, prefixKeys{}, bucketcopy.Config{
+		BatchSize: 2,
+	})
+
+	var out strings.Builder
+	result, err := service.CopyTo(ctx, source, destination, &out)
+	require.NoError(t, err)
+	require.Equal(t, bucketcopy.Result{Copied: 3, Skipped: 1, Segments: 3, Bytes: 120}, result)
+
+	require.Len(t, db.copies, 3)
+	for _, finish := range db.copies {
+		require.Equal(t, source.ProjectID, finish.ProjectID)
+		require.Equal(t, destination.ProjectID, finish.NewProjectID)
+		require.Equal(t, destination.BucketName, finish.NewBucket)
+		require.True(t, finish.OverrideMetadata)
+		require.Equal(t, "copy/"+finish.ObjectKey, finish.NewEncryptedObjectKey)
+	}
+	require.Equal(t, uuid.UUID{0x21}, db.copies[1].StreamID)
+	require.Equal(t, []byte("metadata"), db.copies[0].NewEncryptedMetadata)
+	require.Nil(t, db.copies[2].NewEncryptedMetadata)
+
+	rows, err := csv.NewReader(strings.NewReader(out.String())).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, rows, 5)
+	require.Equal(t, []string{"status", "source stream id", "destination stream id", "segments", "encrypted size", "reason"}, rows[0])
+	require.Equal(t, []string{"copied", uuid.UUID{0x10}.String(), db.copies[0].NewStreamID.String(), "2", "100", ""}, rows[1])
+	require.Equal(t, "skipped", rows[3][0])
+
+	t.Run("dry run", func(t *testing.T) {
+		db := &metabaseMock{objects: objects}
+		service := bucketcopy.NewService(log, db, buckets, prefixKeys{}, bucketcopy.Config{
+			BatchSize: 10,
+			DryRun:    true,
+		})
+
+		var out strings.Builder
+		result, err := service.CopyTo(ctx, source, destination, &out)
+		require.NoError(t, err)
+		require.Equal(t, bucketcopy.Result{Copied: 3, Skipped: 1, Segments: 3, Bytes: 120}, result)
+		require.Empty(t, db.copies)
+	})
+
+	t.Run("different placement", func(t *testing.T) {
+		service := bucketcopy.NewService(log, &metabaseMock{}, bucketsMock{source: storj.EU, destination: storj.US}, prefixKeys{}, bucketcopy.Config{
+			BatchSize: 10,
+		})
+
+		_, err := service.CopyTo(ctx, source, destination, &strings.Builder{})
+		require.Error(t, err)
+	})
+
+	t.Run("keep encryption", func(t *testing.T) {
+		db := &metabaseMock{objects: objects}
+		service := bucketcopy.NewService(log, db, buckets, bucketcopy.KeepEncryption{}, bucketcopy.Config{
+			BatchSize: 10,
+		})
+
+		// the keys of the objects depend on the bucket name.
+		_, err := service.CopyTo(ctx, source, destination, &strings.Builder{})
+		require.Error(t, err)
+		require.Empty(t, db.copies)
+
+		sameName := metabase.BucketLocation{ProjectID: destination.ProjectID, BucketName: source.BucketName}
+		result, err := service.CopyTo(ctx, source, sameName, &strings.Builder{})
+		require.NoError(t, err)
+		require.Equal(t, 3, result.Copied)
+		for _, finish := range db.copies {
+			require.Equal(t, finish.ObjectKey, finish.NewEncryptedObjectKey)
+		}
+	})
+
+	t.Run("same bucket", func(t *testing.T) {
+		_, err := service.CopyTo(ctx, source, source, &strings.Builder{})
+		require.Error(t, err)
+	})
+}
+
+type bucketsMock struct {
+	source, destination storj.PlacementConstraint
+}
+
+func (buckets bucketsMock) GetBucketPlacement(ctx context.Context, bucketName []byte, projectID uuid.UUID) (storj.PlacementConstraint, error) {
+	if string(bucketName) == "source" {
+		return buckets.source, nil
+	}
+	return buckets.destination, nil
+}
+
+type prefixKeys struct{}
+
+func (prefixKeys) Reencrypt(ctx context.Context, object bucketcopy.EncryptedObject) (bucketcopy.EncryptedObject, error) {
+	object.ObjectKey = "copy/" + object.ObjectKey
+	return object, nil
+}
+
+type metabaseMock struct {
+	objects []metabase.Object
+	copies  []metabase.FinishCopyObject
+}
+
+func (db *metabaseMock) ListObjects(ctx context.Context, opts metabase.ListObjects) (result metabase.ListObjectsResult, err error) {
+	index := sort.Search(len(db.objects), func(i int) bool {
+		object := db.objects[i]
+		return object.ObjectKey > opts.Cursor.Key ||
+			(object.ObjectKey == opts.Cursor.Key && object.Version > opts.Cursor.Version)
+	})
+
+	for _, object := range db.objects[index:] {
+		if len(result.Objects) == opts.Limit {
+			result.More = true
+			break
+		}
+		result.Objects = append(result.Objects, metabase.ObjectEntry{
+			ObjectKey:          object.ObjectKey,
+			Version:            object.Version,
+			StreamID:           object.StreamID,
+			SegmentCount:       object.SegmentCount,
+			TotalEncryptedSize: object.TotalEncryptedSize,
+		})
+	}
+	return result, nil
+}
+
+func (db *metabaseMock) BeginCopyObject(ctx context.Context, opts metabase.BeginCopyObject) (metabase.BeginCopyObjectResult, error) {
+	var last *metabase.Object
+	for i := range db.objects {
+		if db.objects[i].ObjectKey == opts.ObjectKey {
+			last = &db.objects[i]
+		}
+	}
+	if last == nil {
+		return metabase.BeginCopyObjectResult{}, storj.ErrObjectNotFound.New("")
+	}
+	if int64(last.SegmentCount) > metabase.CopySegmentLimit {
+		return metabase.BeginCopyObjectResult{}, metabase.ErrInvalidRequest.New("object has too many segments")
+	}
+	if err := opts.VerifyLimits(last.TotalEncryptedSize, int64(last.SegmentCount)); err != nil {
+		return metabase.BeginCopyObjectResult{}, err
+	}
+
+	result := metabase.BeginCopyObjectResult{
+		StreamID:                  last.StreamID,
+		Version:                   last.Version,
+		EncryptedMetadata:         last.EncryptedMetadata,
+		EncryptedMetadataKeyNonce: last.EncryptedMetadataNonce,
+		EncryptedMetadataKey:      last.EncryptedMetadataEncryptedKey,
+	}
+	for i := 0; i < int(last.SegmentCount); i++ {
+		result.EncryptedKeysNonces = append(result.EncryptedKeysNonces, metabase.EncryptedKeyAndNonce{
+			Position: metabase.SegmentPosition{Index: uint32(i)},
+		})
+	}
+	return result, nil
+}
+
+func (db *metabaseMock) FinishCopyObject(ctx context.Context, opts metabase.FinishCopyObject) (metabase.Object, error) {
+	if err := opts.Verify(); err != nil {
+		return metabase.Object{}, err
+	}
+	db.copies = append(db.copies, opts)
+	return metabase.Object{}, nil
+}
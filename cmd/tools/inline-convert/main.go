@@ -0,0 +1,190 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/spf13/cobra"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/fpath"
+	"storj.io/common/peertls/tlsopts"
+	"storj.io/common/signing"
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/private/cfgstruct"
+	"storj.io/private/process"
+	"storj.io/storj/private/revocation"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/nodedialer"
+	"storj.io/storj/satellite/orders"
+	"storj.io/storj/satellite/overlay"
+	"storj.io/storj/satellite/repair/repairer"
+	"storj.io/storj/satellite/satellitedb"
+)
+
+var mon = monkit.Package()
+
+// Error is the default error class for inline-convert.
+var Error = errs.Class("inline-convert")
+
+// Satellite defines satellite configuration.
+type Satellite struct {
+	Database string `help:"satellite database connection string" releaseDefault:"postgres://" devDefault:"postgres://"`
+
+	satellite.Config
+}
+
+var (
+	rootCmd = &cobra.Command{
+		Use:   "inline-convert",
+		Short: "inline-convert",
+	}
+
+	runCmd = &cobra.Command{
+		Use:   "run",
+		Short: "converts the inline segments of a project above a threshold to remote segments or vice versa",
+		RunE:  run,
+	}
+
+	satelliteCfg Satellite
+	runCfg       Config
+
+	confDir     string
+	identityDir string
+)
+
+func init() {
+	defaultConfDir := fpath.ApplicationDir("storj", "satellite")
+	defaultIdentityDir := fpath.ApplicationDir("storj", "identity", "satellite")
+	cfgstruct.SetupFlag(zap.L(), rootCmd, &confDir, "config-dir", defaultConfDir, "main directory for satellite configuration")
+	cfgstruct.SetupFlag(zap.L(), rootCmd, &identityDir, "identity-dir", defaultIdentityDir, "main directory for satellite identity credentials")
+	defaults := cfgstruct.DefaultsFlag(rootCmd)
+
+	rootCmd.AddCommand(runCmd)
+
+	process.Bind(runCmd, &satelliteCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
+	process.Bind(runCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
+}
+
+func run(cmd *cobra.Command, args []string) (err error) {
+	ctx, _ := process.Ctx(cmd)
+	log := zap.L()
+
+	projectID, err := uuid.FromString(runCfg.ProjectID)
+	if err != nil {
+		return Error.New("invalid project id %q: %v", runCfg.ProjectID, err)
+	}
+
+	threshold := runCfg.Threshold
+	if threshold == 0 {
+		threshold = satelliteCfg.Metainfo.MaxInlineSegmentSize
+		if size, ok := satelliteCfg.Metainfo.InlineSegmentSizes.Get(projectID); ok {
+			threshold = size
+		}
+	}
+
+	// open default satellite database
+	db, err := satellitedb.Open(ctx, log.Named("db"), satelliteCfg.Database, satellitedb.Options{
+		ApplicationName: "inline-convert",
+	})
+	if err != nil {
+		return errs.New("Error starting master database on satellite: %+v", err)
+	}
+	defer func() {
+		err = errs.Combine(err, db.Close())
+	}()
+
+	// open metabase
+	metabaseDB, err := metabase.Open(ctx, log.Named("metabase"), satelliteCfg.Metainfo.DatabaseURL,
+		satelliteCfg.Config.Metainfo.Metabase("satellite-core"))
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	defer func() { _ = metabaseDB.Close() }()
+
+	// check whether satellite and metabase versions match
+	versionErr := db.CheckVersion(ctx)
+	if versionErr != nil {
+		log.Error("versions skewed", zap.Error(versionErr))
+		return Error.Wrap(versionErr)
+	}
+
+	versionErr = metabaseDB.CheckVersion(ctx)
+	if versionErr != nil {
+		log.Error("versions skewed", zap.Error(versionErr))
+		return Error.Wrap(versionErr)
+	}
+
+	// setup dialer
+	identity, err := satelliteCfg.Identity.Load()
+	if err != nil {
+		log.Error("Failed to load identity.", zap.Error(err))
+		return errs.New("Failed to load identity: %+v", err)
+	}
+
+	revocationDB, err := revocation.OpenDBFromCfg(ctx, satelliteCfg.Server.Config)
+	if err != nil {
+		return errs.New("Error creating revocation database: %+v", err)
+	}
+	defer func() {
+		err = errs.Combine(err, revocationDB.Close())
+	}()
+
+	tlsOptions, err := tlsopts.NewOptions(identity, satelliteCfg.Server.Config, revocationDB)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	nodeDialer := nodedialer.New(satelliteCfg.NodeDialer)
+	defer func() {
+		err = errs.Combine(err, nodeDialer.Close())
+	}()
+	dialer := nodeDialer.RPCDialer(tlsOptions)
+
+	// setup dependencies for uploading and downloading pieces
+	overlay, err := overlay.NewService(log.Named("overlay"), db.OverlayCache(), db.NodeEvents(), nil, nil, "", "", satelliteCfg.Overlay)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	ordersService, err := orders.NewService(log.Named("orders"), signing.SignerFromFullIdentity(identity), overlay, db.Orders(), satelliteCfg.Orders)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	ec := repairer.NewECRepairer(log.Named("ec"), dialer,
+		signing.SigneeFromPeerIdentity(identity.PeerIdentity()),
+		satelliteCfg.Repairer.DownloadTimeout, true)
+
+	rs := satelliteCfg.Metainfo.RS
+	redundancy := storj.RedundancyScheme{
+		Algorithm:      storj.ReedSolomon,
+		ShareSize:      rs.ErasureShareSize.Int32(),
+		RequiredShares: int16(rs.Min),
+		RepairShares:   int16(rs.Repair),
+		OptimalShares:  int16(rs.Success),
+		TotalShares:    int16(rs.Total),
+	}
+
+	service := NewService(log.Named("inline-convert"), metabaseDB, overlay, ordersService, ec, redundancy, satelliteCfg.Repairer, runCfg)
+	stats, err := service.Process(ctx, projectID, threshold)
+	log.Info("finished converting segments",
+		zap.Stringer("Project ID", projectID),
+		zap.Stringer("threshold", threshold),
+		zap.Bool("to inline", runCfg.ToInline),
+		zap.Bool("dry run", runCfg.DryRun),
+		zap.Int("listed", stats.Listed),
+		zap.Int("converted", stats.Converted),
+		zap.Int("changed", stats.Changed),
+		zap.Int("failed", stats.Failed),
+		zap.Int64("bytes", stats.Bytes))
+	return err
+}
+
+func main() {
+	process.Exec(rootCmd)
+}
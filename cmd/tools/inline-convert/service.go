@@ -0,0 +1,278 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"math"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/encryption"
+	"storj.io/common/memory"
+	"storj.io/common/pb"
+	"storj.io/common/storj"
+	"storj.io/common/sync2"
+	"storj.io/common/uuid"
+	"storj.io/storj/private/nodecapabilities"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/orders"
+	"storj.io/storj/satellite/overlay"
+	"storj.io/storj/satellite/repair/repairer"
+	"storj.io/storj/storage"
+	"storj.io/uplink/private/eestream"
+)
+
+// Config contains configurable options for converting segments.
+type Config struct {
+	ProjectID string      `help:"project id of the segments to convert" default:""`
+	ToInline  bool        `help:"convert remote segments up to the threshold to inline segments, instead of inline segments above the threshold to remote segments" default:"false"`
+	Threshold memory.Size `help:"plain size threshold of the segments, when 0 the maximum inline segment size of the project is used" default:"0"`
+
+	BatchSize int           `help:"number of segments to list and convert per batch" default:"100"`
+	Interval  time.Duration `help:"how long to wait between batches" default:"1s"`
+	Limit     int           `help:"maximum number of segments to convert, 0 is unlimited" default:"0"`
+	DryRun    bool          `help:"only count the segments, which would be converted" default:"false"`
+}
+
+// Metabase defines implementation dependencies we need from metabase.
+type Metabase interface {
+	ListConvertibleSegments(ctx context.Context, opts metabase.ListConvertibleSegments) (metabase.ListConvertibleSegmentsResult, error)
+	ConvertSegmentToRemote(ctx context.Context, opts metabase.ConvertSegmentToRemote) error
+	ConvertSegmentToInline(ctx context.Context, opts metabase.ConvertSegmentToInline) error
+}
+
+// Stats contains the outcome of converting the segments.
+type Stats struct {
+	Listed    int
+	Converted int
+	// Changed are the segments, which were modified while being converted.
+	Changed int
+	Failed  int
+	// Bytes is the encrypted size of the converted segments.
+	Bytes int64
+}
+
+// Service converts inline segments to remote segments and vice versa.
+type Service struct {
+	log    *zap.Logger
+	config Config
+
+	metabase Metabase
+	overlay  *overlay.Service
+	orders   *orders.Service
+	ec       *repairer.ECRepairer
+
+	// redundancy is used for the segments converted to remote segments.
+	redundancy                 storj.RedundancyScheme
+	optimalThresholdMultiplier float64
+	uploadTimeout              time.Duration
+
+	sleepFn func(ctx context.Context, duration time.Duration) bool
+}
+
+// NewService creates a new segment conversion service.
+func NewService(log *zap.Logger, metabaseDB Metabase, overlay *overlay.Service, orders *orders.Service, ec *repairer.ECRepairer, redundancy storj.RedundancyScheme, repairConfig repairer.Config, config Config) *Service {
+	return &Service{
+		log:    log,
+		config: config,
+
+		metabase: metabaseDB,
+		overlay:  overlay,
+		orders:   orders,
+		ec:       ec,
+
+		redundancy:                 redundancy,
+		optimalThresholdMultiplier: 1 + repairConfig.MaxExcessRateOptimalThreshold,
+		uploadTimeout:              repairConfig.Timeout,
+
+		sleepFn: sync2.Sleep,
+	}
+}
+
+// Process converts the segments of the project, whose plain size is above the
+// threshold to remote segments or up to the threshold to inline segments.
+func (service *Service) Process(ctx context.Context, projectID uuid.UUID, threshold memory.Size) (stats Stats, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var cursorStreamID uuid.UUID
+	var cursorPosition metabase.SegmentPosition
+	for batch := 0; ; batch++ {
+		if batch > 0 && !service.sleepFn(ctx, service.config.Interval) {
+			return stats, ctx.Err()
+		}
+
+		result, err := service.metabase.ListConvertibleSegments(ctx, metabase.ListConvertibleSegments{
+			ProjectID:      projectID,
+			CursorStreamID: cursorStreamID,
+			CursorPosition: cursorPosition,
+			Inline:         !service.config.ToInline,
+			Threshold:      int32(threshold.Int64()),
+			Limit:          service.config.BatchSize,
+		})
+		if err != nil {
+			return stats, Error.Wrap(err)
+		}
+		if len(result.Segments) == 0 {
+			return stats, nil
+		}
+
+		last := &result.Segments[len(result.Segments)-1]
+		cursorStreamID, cursorPosition = last.StreamID, last.Position
+
+		for i := range result.Segments {
+			if service.config.Limit > 0 && stats.Listed >= service.config.Limit {
+				return stats, nil
+			}
+
+			segment := &result.Segments[i]
+			stats.Listed++
+			if service.config.DryRun {
+				continue
+			}
+
+			if service.config.ToInline {
+				err = service.convertToInline(ctx, segment)
+			} else {
+				err = service.convertToRemote(ctx, segment)
+			}
+			switch {
+			case err == nil:
+				stats.Converted++
+				stats.Bytes += int64(segment.EncryptedSize)
+			case storage.ErrValueChanged.Has(err) || metabase.ErrSegmentNotFound.Has(err):
+				stats.Changed++
+			case errs.Is(err, context.Canceled):
+				return stats, err
+			default:
+				stats.Failed++
+				service.log.Warn("failed to convert segment",
+					zap.Stringer("Stream ID", segment.StreamID),
+					zap.Uint64("Position", segment.Position.Encode()),
+					zap.Error(err))
+			}
+		}
+
+		service.log.Info("converted batch",
+			zap.Int("listed", stats.Listed),
+			zap.Int("converted", stats.Converted),
+			zap.Int("changed", stats.Changed),
+			zap.Int("failed", stats.Failed))
+	}
+}
+
+// convertToRemote uploads the inline data of the segment to storage nodes.
+func (service *Service) convertToRemote(ctx context.Context, segment *metabase.Segment) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	redundancy, err := eestream.NewRedundancyStrategyFromStorj(service.redundancy)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	remote := *segment
+	remote.RootPieceID = storj.NewPieceID()
+	remote.Redundancy = service.redundancy
+
+	requestCount := int(math.Ceil(float64(redundancy.OptimalThreshold()) * service.optimalThresholdMultiplier))
+	if requestCount > redundancy.TotalCount() {
+		requestCount = redundancy.TotalCount()
+	}
+
+	nodes, err := service.overlay.FindStorageNodesForUpload(ctx, overlay.FindStorageNodesRequest{
+		RequestedCount: requestCount,
+		Placement:      segment.Placement,
+		Requires: nodecapabilities.Requirements{
+			TTL:       segment.ExpiresAt != nil,
+			PieceSize: eestream.CalcPieceSize(int64(segment.EncryptedSize), redundancy),
+		},
+	})
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	limits, privateKey, err := service.orders.CreatePutRepairOrderLimits(ctx, metabase.BucketLocation{}, remote,
+		make([]*pb.AddressedOrderLimit, redundancy.TotalCount()), nodes, service.optimalThresholdMultiplier, 0)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	// pad the data the same way as uplink does for remote segments.
+	data := encryption.PadReader(io.NopCloser(bytes.NewReader(segment.InlineData)), redundancy.StripeSize())
+
+	successfulNodes, _, err := service.ec.Repair(ctx, limits, privateKey, redundancy,
+		data, service.uploadTimeout, redundancy.OptimalThreshold())
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	var pieces metabase.Pieces
+	for i, node := range successfulNodes {
+		if node == nil {
+			continue
+		}
+		pieces = append(pieces, metabase.Piece{
+			Number:      uint16(i),
+			StorageNode: node.Id,
+		})
+	}
+	if len(pieces) < redundancy.OptimalThreshold() {
+		return Error.New("uploaded %d pieces, but %d are required", len(pieces), redundancy.OptimalThreshold())
+	}
+
+	return service.metabase.ConvertSegmentToRemote(ctx, metabase.ConvertSegmentToRemote{
+		StreamID: segment.StreamID,
+		Position: segment.Position,
+
+		OldInlineData: segment.InlineData,
+
+		RootPieceID: remote.RootPieceID,
+		Redundancy:  remote.Redundancy,
+		Pieces:      pieces,
+	})
+}
+
+// convertToInline downloads the pieces of the segment and stores the data
+// inline.
+func (service *Service) convertToInline(ctx context.Context, segment *metabase.Segment) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	redundancy, err := eestream.NewRedundancyStrategyFromStorj(segment.Redundancy)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	limits, privateKey, cachedNodesInfo, err := service.orders.CreateGetRepairOrderLimits(ctx, metabase.BucketLocation{}, *segment, segment.Pieces)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	reader, _, err := service.ec.Get(ctx, limits, cachedNodesInfo, privateKey, redundancy, int64(segment.EncryptedSize))
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, reader.Close()) }()
+
+	// the decoded data contains the padding added by uplink.
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if len(data) < int(segment.EncryptedSize) {
+		return Error.New("downloaded %d bytes, but the segment has %d bytes", len(data), segment.EncryptedSize)
+	}
+	data = data[:segment.EncryptedSize]
+
+	return service.metabase.ConvertSegmentToInline(ctx, metabase.ConvertSegmentToInline{
+		StreamID: segment.StreamID,
+		Position: segment.Position,
+
+		OldPieces:  segment.Pieces,
+		InlineData: data,
+	})
+}
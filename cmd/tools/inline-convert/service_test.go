@@ -0,0 +1,95 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/memory"
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	convert "storj.io/storj/cmd/tools/inline-convert"
+	"storj.io/storj/private/testplanet"
+)
+
+func TestService_Process(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		projectID := planet.Uplinks[0].Projects[0].ID
+
+		expectedData := testrand.Bytes(memory.KiB)
+		require.NoError(t, planet.Uplinks[0].Upload(ctx, satellite, "testbucket", "test/path", expectedData))
+
+		rs := satellite.Config.Metainfo.RS
+		redundancy := storj.RedundancyScheme{
+			Algorithm:      storj.ReedSolomon,
+			ShareSize:      rs.ErasureShareSize.Int32(),
+			RequiredShares: int16(rs.Min),
+			RepairShares:   int16(rs.Repair),
+			OptimalShares:  int16(rs.Success),
+			TotalShares:    int16(rs.Total),
+		}
+
+		newService := func(config convert.Config) *convert.Service {
+			return convert.NewService(testplanet.NewLogger(t),
+				satellite.Metabase.DB,
+				satellite.Repairer.Overlay,
+				satellite.Repairer.Orders.Service,
+				satellite.Repairer.EcRepairer,
+				redundancy, satellite.Config.Repairer, config)
+		}
+
+		requireSegment := func(inline bool) {
+			segments, err := satellite.Metabase.DB.TestingAllSegments(ctx)
+			require.NoError(t, err)
+			require.Len(t, segments, 1)
+			require.Equal(t, inline, segments[0].Inline())
+
+			data, err := planet.Uplinks[0].Download(ctx, satellite, "testbucket", "test/path")
+			require.NoError(t, err)
+			require.Equal(t, expectedData, data)
+		}
+		requireSegment(true)
+
+		// dry run doesn't convert the segment.
+		stats, err := newService(convert.Config{BatchSize: 10, DryRun: true}).Process(ctx, projectID, memory.KiB-1)
+		require.NoError(t, err)
+		require.Equal(t, convert.Stats{Listed: 1}, stats)
+		requireSegment(true)
+
+		// the segment isn't above the threshold.
+		stats, err = newService(convert.Config{BatchSize: 10}).Process(ctx, projectID, memory.KiB)
+		require.NoError(t, err)
+		require.Equal(t, convert.Stats{}, stats)
+		requireSegment(true)
+
+		stats, err = newService(convert.Config{BatchSize: 10, Interval: time.Millisecond}).Process(ctx, projectID, memory.KiB-1)
+		require.NoError(t, err)
+		require.Equal(t, 1, stats.Converted)
+		require.Zero(t, stats.Failed)
+		requireSegment(false)
+
+		segments, err := satellite.Metabase.DB.TestingAllSegments(ctx)
+		require.NoError(t, err)
+		require.Equal(t, redundancy, segments[0].Redundancy)
+		require.GreaterOrEqual(t, len(segments[0].Pieces), int(redundancy.OptimalShares))
+
+		stats, err = newService(convert.Config{BatchSize: 10, ToInline: true}).Process(ctx, projectID, memory.KiB)
+		require.NoError(t, err)
+		require.Equal(t, 1, stats.Converted)
+		require.Zero(t, stats.Failed)
+		requireSegment(true)
+
+		// converting again finds nothing.
+		stats, err = newService(convert.Config{BatchSize: 10, ToInline: true}).Process(ctx, projectID, memory.KiB)
+		require.NoError(t, err)
+		require.Equal(t, convert.Stats{}, stats)
+	})
+}
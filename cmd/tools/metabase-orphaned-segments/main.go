@@ -5,7 +5,11 @@ package main
 
 import (
 	"context"
+	"encoding/csv"
 	"errors"
+	"os"
+	"strconv"
+	"time"
 
 	"github.com/jackc/pgx/v4"
 	"github.com/spacemonkeygo/monkit/v3"
@@ -13,7 +17,9 @@ import (
 	flag "github.com/spf13/pflag"
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
+	"golang.org/x/time/rate"
 
+	"storj.io/common/storj"
 	"storj.io/common/uuid"
 	"storj.io/private/dbutil/pgutil"
 	"storj.io/private/process"
@@ -30,13 +36,13 @@ var (
 
 	reportCmd = &cobra.Command{
 		Use:   "report",
-		Short: "report metainfo-orphaned-segments",
+		Short: "report orphaned segments and abandoned pending objects, nothing is deleted",
 		RunE:  reportCommand,
 	}
 
 	deleteCmd = &cobra.Command{
 		Use:   "delete",
-		Short: "delete metainfo-orphaned-segments",
+		Short: "delete orphaned segments and abandoned pending objects",
 		RunE:  deleteCommand,
 	}
 
@@ -56,6 +62,9 @@ type Config struct {
 	MetabaseDB      string
 	LoopBatchSize   int
 	DeleteBatchSize int
+	DeleteRate      float64
+	PendingCutoff   time.Duration
+	ReportPath      string
 	Cockroach       bool
 }
 
@@ -64,6 +73,9 @@ func (config *Config) BindFlags(flag *flag.FlagSet) {
 	flag.StringVar(&config.MetabaseDB, "metabasedb", "", "connection URL for MetabaseDB")
 	flag.IntVar(&config.LoopBatchSize, "loop-batch-size", 10000, "number of objects to process at once")
 	flag.IntVar(&config.DeleteBatchSize, "delete-batch-size", 100, "number of entries to delete with single query")
+	flag.Float64Var(&config.DeleteRate, "delete-rate", 0, "maximum number of delete queries per second (0 means no limit)")
+	flag.DurationVar(&config.PendingCutoff, "pending-cutoff", 0, "pending objects older than this are considered abandoned (0 means pending objects are kept)")
+	flag.StringVar(&config.ReportPath, "report", "", "csv file for listing the found orphaned segments and abandoned pending objects")
 	flag.BoolVar(&config.Cockroach, "cockroach", true, "metabase is on CRDB")
 }
 
@@ -73,6 +85,12 @@ func (config *Config) VerifyFlags() error {
 	if config.MetabaseDB == "" {
 		errlist.Add(errors.New("flag '--metabasedb' is not set"))
 	}
+	if config.DeleteBatchSize <= 0 {
+		errlist.Add(errors.New("flag '--delete-batch-size' must be positive"))
+	}
+	if config.PendingCutoff < 0 {
+		errlist.Add(errors.New("flag '--pending-cutoff' must not be negative"))
+	}
 	return errlist.Err()
 }
 
@@ -102,41 +120,83 @@ func main() {
 	process.Exec(rootCmd)
 }
 
-// Report finds and reports orphaned segments, nothing is deleted.
+// Garbage contains the data found for cleanup.
+type Garbage struct {
+	// OrphanedStreamIDs are stream ids of segments that don't have an object.
+	OrphanedStreamIDs []uuid.UUID
+	// PendingObjects are pending objects created before the cutoff.
+	PendingObjects []metabase.ObjectStream
+}
+
+// Report finds and reports orphaned segments and abandoned pending objects,
+// nothing is deleted.
 func Report(ctx context.Context, log *zap.Logger, config Config) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	ids, err := findOrphanedSegments(ctx, log, config)
+	garbage, err := findGarbage(ctx, log, config)
 	if err != nil {
 		return err
 	}
 	log.Info("orphaned segments stream ids (number of existing segments can be bigger)",
-		zap.Int("count", len(ids)))
-	for _, id := range ids {
+		zap.Int("count", len(garbage.OrphanedStreamIDs)))
+	for _, id := range garbage.OrphanedStreamIDs {
 		log.Info("StreamID", zap.String("id", id.String()))
 	}
+	log.Info("abandoned pending objects", zap.Int("count", len(garbage.PendingObjects)))
+
+	if config.ReportPath != "" {
+		return writeReport(config.ReportPath, garbage)
+	}
 	return nil
 }
 
-// Delete finds and deletes orphaned segments.
+// Delete finds and deletes orphaned segments and abandoned pending objects.
 func Delete(ctx context.Context, log *zap.Logger, config Config) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	ids, err := findOrphanedSegments(ctx, log, config)
+	garbage, err := findGarbage(ctx, log, config)
 	if err != nil {
 		return err
 	}
 
+	if config.ReportPath != "" {
+		if err := writeReport(config.ReportPath, garbage); err != nil {
+			return err
+		}
+	}
+
+	var limiter *rate.Limiter
+	if config.DeleteRate > 0 {
+		limiter = rate.NewLimiter(rate.Limit(config.DeleteRate), 1)
+	}
+	wait := func(ctx context.Context) error {
+		if limiter == nil {
+			return nil
+		}
+		return limiter.Wait(ctx)
+	}
+
+	if len(garbage.PendingObjects) > 0 {
+		if err := deletePendingObjects(ctx, log, config, garbage.PendingObjects, wait); err != nil {
+			return err
+		}
+	}
+
 	rawMetabaseDB, err := pgx.Connect(ctx, config.MetabaseDB)
 	if err != nil {
 		return errs.New("unable to connect %q: %w", config.MetabaseDB, err)
 	}
 	defer func() { err = errs.Combine(err, rawMetabaseDB.Close(ctx)) }()
 
+	ids := garbage.OrphanedStreamIDs
 	log.Info("orphaned segments stream ids (number of existing segments can be bigger)", zap.Int("count", len(ids)))
 	log.Info("starting deletion")
 
 	for len(ids) > 0 {
+		if err := wait(ctx); err != nil {
+			return err
+		}
+
 		batch := config.DeleteBatchSize
 		if batch > len(ids) {
 			batch = len(ids)
@@ -152,19 +212,20 @@ func Delete(ctx context.Context, log *zap.Logger, config Config) (err error) {
 	return nil
 }
 
-func findOrphanedSegments(ctx context.Context, log *zap.Logger, config Config) (_ []uuid.UUID, err error) {
+func findGarbage(ctx context.Context, log *zap.Logger, config Config) (_ Garbage, err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	metabaseDB, err := metabase.Open(ctx, log.Named("metabase"), config.MetabaseDB, metabase.Config{ApplicationName: "metabase-orphaned-segments"})
 	if err != nil {
-		return nil, errs.New("unable to connect %q: %w", config.MetabaseDB, err)
+		return Garbage{}, errs.New("unable to connect %q: %w", config.MetabaseDB, err)
 	}
 	defer func() { err = errs.Combine(err, metabaseDB.Close()) }()
 
 	startingTime, err := metabaseDB.Now(ctx)
 	if err != nil {
-		return nil, err
+		return Garbage{}, err
 	}
+	pendingCutoff := startingTime.Add(-config.PendingCutoff)
 
 	streamIDs := make(map[uuid.UUID]struct{})
 	numberOfElements := 0
@@ -190,9 +251,11 @@ func findOrphanedSegments(ctx context.Context, log *zap.Logger, config Config) (
 		return nil
 	})
 	if err != nil {
-		return nil, err
+		return Garbage{}, err
 	}
 
+	var garbage Garbage
+
 	numberOfElements = 0
 	err = metabaseDB.IterateLoopObjects(ctx, metabase.IterateLoopObjects{
 		BatchSize:      config.LoopBatchSize,
@@ -207,6 +270,10 @@ func findOrphanedSegments(ctx context.Context, log *zap.Logger, config Config) (
 
 			delete(streamIDs, entry.StreamID)
 
+			if config.PendingCutoff > 0 && entry.Status == metabase.Pending && entry.CreatedAt.Before(pendingCutoff) {
+				garbage.PendingObjects = append(garbage.PendingObjects, entry.ObjectStream)
+			}
+
 			if numberOfElements%100000 == 0 {
 				log.Info("objects iterated", zap.Int("objects", numberOfElements))
 			}
@@ -217,15 +284,53 @@ func findOrphanedSegments(ctx context.Context, log *zap.Logger, config Config) (
 		return nil
 	})
 	if err != nil {
-		return nil, err
+		return Garbage{}, err
 	}
 
-	ids := make([]uuid.UUID, 0, len(streamIDs))
+	garbage.OrphanedStreamIDs = make([]uuid.UUID, 0, len(streamIDs))
 	for id := range streamIDs {
-		ids = append(ids, id)
+		garbage.OrphanedStreamIDs = append(garbage.OrphanedStreamIDs, id)
 	}
 
-	return ids, nil
+	return garbage, nil
+}
+
+func deletePendingObjects(ctx context.Context, log *zap.Logger, config Config, objects []metabase.ObjectStream, wait func(context.Context) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	metabaseDB, err := metabase.Open(ctx, log.Named("metabase"), config.MetabaseDB, metabase.Config{ApplicationName: "metabase-orphaned-segments"})
+	if err != nil {
+		return errs.New("unable to connect %q: %w", config.MetabaseDB, err)
+	}
+	defer func() { err = errs.Combine(err, metabaseDB.Close()) }()
+
+	log.Info("deleting pending objects", zap.Int("count", len(objects)))
+
+	deleted := 0
+	for _, object := range objects {
+		if err := wait(ctx); err != nil {
+			return err
+		}
+
+		_, err := metabaseDB.DeletePendingObject(ctx, metabase.DeletePendingObject{
+			ObjectStream: object,
+		})
+		if err != nil {
+			// the upload might have been committed or deleted in the meantime.
+			if storj.ErrObjectNotFound.Has(err) {
+				continue
+			}
+			return err
+		}
+
+		deleted++
+		if deleted%1000 == 0 {
+			log.Info("pending objects deleted", zap.Int("count", deleted))
+		}
+	}
+
+	log.Info("pending objects deleted", zap.Int("count", deleted))
+	return nil
 }
 
 func sendDelete(ctx context.Context, conn *pgx.Conn, ids []uuid.UUID) (err error) {
@@ -235,9 +340,42 @@ func sendDelete(ctx context.Context, conn *pgx.Conn, ids []uuid.UUID) (err error
 		return nil
 	}
 
+	// the objects don't need to be checked again: segments are only added to
+	// existing objects, and the segments created after the listing started
+	// were skipped, so the objects of these stream ids can't appear anymore.
 	_, err = conn.Exec(ctx, "DELETE FROM segments WHERE stream_id = ANY($1)", pgutil.UUIDArray(ids))
+	return err
+}
+
+func writeReport(path string, garbage Garbage) (err error) {
+	file, err := os.Create(path)
 	if err != nil {
 		return err
 	}
-	return nil
+	defer func() { err = errs.Combine(err, file.Close()) }()
+
+	w := csv.NewWriter(file)
+	if err := w.Write([]string{"kind", "stream id", "project id", "bucket", "object key", "version"}); err != nil {
+		return err
+	}
+	for _, id := range garbage.OrphanedStreamIDs {
+		if err := w.Write([]string{"orphaned segments", id.String(), "", "", "", ""}); err != nil {
+			return err
+		}
+	}
+	for _, object := range garbage.PendingObjects {
+		err := w.Write([]string{
+			"pending object",
+			object.StreamID.String(),
+			object.ProjectID.String(),
+			object.BucketName,
+			string(object.ObjectKey),
+			strconv.FormatInt(int64(object.Version), 10),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	w.Flush()
+	return w.Error()
 }
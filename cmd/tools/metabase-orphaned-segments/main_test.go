@@ -5,8 +5,11 @@ package main_test
 
 import (
 	"context"
+	"encoding/csv"
+	"os"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap/zaptest"
@@ -98,7 +101,69 @@ func Test_ManyOrphanedSegment(t *testing.T) {
 	test(t, prepare, check)
 }
 
+func Test_AbandonedPendingObject(t *testing.T) {
+	committed := metabasetest.RandObjectStream()
+	pending := metabasetest.RandObjectStream()
+
+	prepare := func(t *testing.T, ctx *testcontext.Context, rawDB *dbutil.TempDatabase, metabaseDB *metabase.DB) {
+		metabasetest.CreateObject(ctx, t, metabaseDB, committed, 1)
+
+		metabasetest.BeginObjectExactVersion{
+			Opts: metabase.BeginObjectExactVersion{
+				ObjectStream: pending,
+				Encryption:   metabasetest.DefaultEncryption,
+			},
+			Version: pending.Version,
+		}.Check(ctx, t, metabaseDB)
+
+		obj := metabasetest.CreateObject(ctx, t, metabaseDB, metabasetest.RandObjectStream(), 10)
+		_, err := rawDB.ExecContext(ctx, `DELETE FROM objects WHERE stream_id = $1`, obj.StreamID)
+		require.NoError(t, err)
+	}
+
+	reported := func(t *testing.T, ctx context.Context, metabaseDB *metabase.DB, report [][]string) {
+		// header, orphaned segments and pending object.
+		require.Len(t, report, 3)
+
+		objects, err := metabaseDB.TestingAllObjects(ctx)
+		require.NoError(t, err)
+		require.Len(t, objects, 2)
+
+		segments, err := metabaseDB.TestingAllSegments(ctx)
+		require.NoError(t, err)
+		require.Len(t, segments, 11)
+	}
+
+	check := func(t *testing.T, ctx context.Context, metabaseDB *metabase.DB) {
+		objects, err := metabaseDB.TestingAllObjects(ctx)
+		require.NoError(t, err)
+		require.Len(t, objects, 1)
+		require.Equal(t, committed.StreamID, objects[0].StreamID)
+
+		segments, err := metabaseDB.TestingAllSegments(ctx)
+		require.NoError(t, err)
+		require.Len(t, segments, 1)
+		require.Equal(t, committed.StreamID, segments[0].StreamID)
+	}
+
+	testWithConfig(t, cmd.Config{
+		LoopBatchSize:   3,
+		DeleteBatchSize: 2,
+		DeleteRate:      100,
+		PendingCutoff:   time.Nanosecond,
+	}, prepare, reported, check)
+}
+
 func test(t *testing.T, prepare func(t *testing.T, ctx *testcontext.Context, rawDB *dbutil.TempDatabase, metabaseDB *metabase.DB),
+	check func(t *testing.T, ctx context.Context, metabaseDB *metabase.DB)) {
+	testWithConfig(t, cmd.Config{
+		LoopBatchSize:   3,
+		DeleteBatchSize: 2,
+	}, prepare, nil, check)
+}
+
+func testWithConfig(t *testing.T, config cmd.Config, prepare func(t *testing.T, ctx *testcontext.Context, rawDB *dbutil.TempDatabase, metabaseDB *metabase.DB),
+	reported func(t *testing.T, ctx context.Context, metabaseDB *metabase.DB, report [][]string),
 	check func(t *testing.T, ctx context.Context, metabaseDB *metabase.DB)) {
 	ctx := testcontext.New(t)
 	defer ctx.Cleanup()
@@ -127,16 +192,26 @@ func test(t *testing.T, prepare func(t *testing.T, ctx *testcontext.Context, raw
 
 			prepare(t, ctx, metabaseTempDB, metabaseDB)
 
-			cockroach := strings.HasPrefix(metabaseTempDB.ConnStr, "cockroach")
-
+			config := config
+			config.Cockroach = strings.HasPrefix(metabaseTempDB.ConnStr, "cockroach")
 			// TODO workaround for pgx
-			mConnStr := strings.Replace(metabaseTempDB.ConnStr, "cockroach", "postgres", 1)
-			err = cmd.Delete(ctx, log, cmd.Config{
-				MetabaseDB:      mConnStr,
-				LoopBatchSize:   3,
-				DeleteBatchSize: 2,
-				Cockroach:       cockroach,
-			})
+			config.MetabaseDB = strings.Replace(metabaseTempDB.ConnStr, "cockroach", "postgres", 1)
+
+			if reported != nil {
+				config.ReportPath = ctx.File("report.csv")
+				err = cmd.Report(ctx, log, config)
+				require.NoError(t, err)
+
+				reportFile, err := os.Open(config.ReportPath)
+				require.NoError(t, err)
+				report, err := csv.NewReader(reportFile).ReadAll()
+				require.NoError(t, err)
+				require.NoError(t, reportFile.Close())
+
+				reported(t, ctx, metabaseDB, report)
+			}
+
+			err = cmd.Delete(ctx, log, config)
 			require.NoError(t, err)
 
 			check(t, ctx, metabaseDB)
@@ -0,0 +1,114 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/spf13/cobra"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/fpath"
+	"storj.io/common/storj"
+	"storj.io/private/cfgstruct"
+	"storj.io/private/process"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/satellitedb"
+)
+
+var mon = monkit.Package()
+
+// Error is the default error class for node-decommission.
+var Error = errs.Class("node-decommission")
+
+// Satellite defines satellite configuration.
+type Satellite struct {
+	Database string `help:"satellite database connection string" releaseDefault:"postgres://" devDefault:"postgres://"`
+
+	satellite.Config
+}
+
+var (
+	rootCmd = &cobra.Command{
+		Use:   "node-decommission",
+		Short: "node-decommission",
+	}
+
+	runCmd = &cobra.Command{
+		Use:   "run",
+		Short: "reports segments with pieces on a node and optionally enqueues them for repair",
+		RunE:  run,
+	}
+
+	satelliteCfg Satellite
+	runCfg       Config
+
+	confDir     string
+	identityDir string
+)
+
+func init() {
+	defaultConfDir := fpath.ApplicationDir("storj", "satellite")
+	defaultIdentityDir := fpath.ApplicationDir("storj", "identity", "satellite")
+	cfgstruct.SetupFlag(zap.L(), rootCmd, &confDir, "config-dir", defaultConfDir, "main directory for satellite configuration")
+	cfgstruct.SetupFlag(zap.L(), rootCmd, &identityDir, "identity-dir", defaultIdentityDir, "main directory for satellite identity credentials")
+	defaults := cfgstruct.DefaultsFlag(rootCmd)
+
+	rootCmd.AddCommand(runCmd)
+
+	process.Bind(runCmd, &satelliteCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
+	process.Bind(runCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
+}
+
+func run(cmd *cobra.Command, args []string) (err error) {
+	ctx, _ := process.Ctx(cmd)
+	log := zap.L()
+
+	nodeID, err := storj.NodeIDFromString(runCfg.NodeID)
+	if err != nil {
+		return Error.New("invalid node id %q: %v", runCfg.NodeID, err)
+	}
+
+	// open default satellite database
+	db, err := satellitedb.Open(ctx, log.Named("db"), satelliteCfg.Database, satellitedb.Options{
+		ApplicationName: "node-decommission",
+	})
+	if err != nil {
+		return errs.New("Error starting master database on satellite: %+v", err)
+	}
+	defer func() {
+		err = errs.Combine(err, db.Close())
+	}()
+
+	// open metabase
+	metabaseDB, err := metabase.Open(ctx, log.Named("metabase"), satelliteCfg.Metainfo.DatabaseURL,
+		satelliteCfg.Config.Metainfo.Metabase("satellite-core"))
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	defer func() { _ = metabaseDB.Close() }()
+
+	// check whether satellite and metabase versions match
+	versionErr := db.CheckVersion(ctx)
+	if versionErr != nil {
+		log.Error("versions skewed", zap.Error(versionErr))
+		return Error.Wrap(versionErr)
+	}
+
+	versionErr = metabaseDB.CheckVersion(ctx)
+	if versionErr != nil {
+		log.Error("versions skewed", zap.Error(versionErr))
+		return Error.Wrap(versionErr)
+	}
+
+	service := NewService(log.Named("decommission"), metabaseDB, db.RepairQueue(), runCfg)
+	service.SetRepairOverrides(satelliteCfg.Checker.RepairOverrides.GetMap(), satelliteCfg.Checker.NodeFailureRate)
+
+	return service.Process(ctx, nodeID)
+}
+
+func main() {
+	process.Exec(rootCmd)
+}
@@ -0,0 +1,283 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/memory"
+	"storj.io/common/storj"
+	"storj.io/common/sync2"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/repair"
+	"storj.io/storj/satellite/repair/checker"
+	"storj.io/storj/satellite/repair/queue"
+	"storj.io/uplink/private/eestream"
+)
+
+// Config contains configurable options for the decommission assistant.
+type Config struct {
+	NodeID     string `help:"node ID to decommission" default:""`
+	BatchSize  int    `help:"number of segments to list per batch" default:"10000"`
+	ReportPath string `help:"csv file for the segments with a piece on the node" default:"decommission-segments.csv"`
+
+	Enqueue          bool          `help:"insert the segments into the repair queue" default:"false"`
+	EnqueueBatchSize int           `help:"number of segments to insert into the repair queue at once" default:"1000"`
+	EnqueueInterval  time.Duration `help:"how long to wait between inserting batches into the repair queue" default:"1m"`
+
+	AsOfSystemInterval time.Duration `help:"as of system interval" releaseDefault:"-5m" devDefault:"-1us" testDefault:"-1us"`
+}
+
+// Metabase defines implementation dependencies we need from metabase.
+type Metabase interface {
+	LatestNodesAliasMap(ctx context.Context) (*metabase.NodeAliasMap, error)
+	ListVerifySegments(ctx context.Context, opts metabase.ListVerifySegments) (result metabase.ListVerifySegmentsResult, err error)
+}
+
+// RepairQueue is used to enqueue the segments for repair.
+type RepairQueue interface {
+	Insert(ctx context.Context, s *queue.InjuredSegment) (alreadyInserted bool, err error)
+}
+
+// Estimate contains the estimated cost of repairing the segments of a node.
+type Estimate struct {
+	Segments int64
+	// PieceBytes is the amount of data stored on the node.
+	PieceBytes int64
+	// DownloadBytes is the amount of data that needs to be downloaded from other nodes.
+	DownloadBytes int64
+	// UploadBytes is the amount of data that needs to be uploaded to new nodes.
+	UploadBytes int64
+}
+
+// Add adds a segment to the estimate.
+func (estimate *Estimate) Add(segment *metabase.VerifySegment) error {
+	redundancy, err := eestream.NewRedundancyStrategyFromStorj(segment.Redundancy)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	pieceSize := eestream.CalcPieceSize(int64(segment.EncryptedSize), redundancy)
+
+	estimate.Segments++
+	estimate.PieceBytes += pieceSize
+	// the repairer downloads the minimum number of pieces and
+	// uploads at least the missing piece.
+	estimate.DownloadBytes += pieceSize * int64(segment.Redundancy.RequiredShares)
+	estimate.UploadBytes += pieceSize
+	return nil
+}
+
+// Service reports and enqueues the segments of a node for repair.
+type Service struct {
+	log    *zap.Logger
+	config Config
+
+	metabase    Metabase
+	repairQueue RepairQueue
+
+	repairOverrides checker.RepairOverridesMap
+	nodeFailureRate float64
+
+	sleepFn func(ctx context.Context, duration time.Duration) bool
+}
+
+// NewService creates a new decommission service.
+func NewService(log *zap.Logger, metabaseDB Metabase, repairQueue RepairQueue, config Config) *Service {
+	return &Service{
+		log:    log,
+		config: config,
+
+		metabase:    metabaseDB,
+		repairQueue: repairQueue,
+
+		sleepFn: sync2.Sleep,
+	}
+}
+
+// SetRepairOverrides configures the values used for calculating the segment health.
+func (service *Service) SetRepairOverrides(overrides checker.RepairOverridesMap, nodeFailureRate float64) {
+	service.repairOverrides = overrides
+	service.nodeFailureRate = nodeFailureRate
+}
+
+// Process lists all the segments with a piece on the node, writes them to the report and
+// optionally enqueues them for repair.
+func (service *Service) Process(ctx context.Context, nodeID storj.NodeID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	aliasMap, err := service.metabase.LatestNodesAliasMap(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	alias, ok := aliasMap.Alias(nodeID)
+	if !ok {
+		service.log.Info("node does not hold any data", zap.Stringer("node-id", nodeID))
+		return nil
+	}
+
+	file, err := os.Create(service.config.ReportPath)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, Error.Wrap(file.Close())) }()
+
+	report := csv.NewWriter(file)
+	err = report.Write([]string{"stream id", "position", "piece number", "healthy pieces", "repair threshold", "piece size"})
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	var estimate Estimate
+	var pending []*queue.InjuredSegment
+
+	// flush inserts the pending segments, waiting between the batches to
+	// avoid flooding the repairers.
+	batches := 0
+	flush := func() error {
+		if batches > 0 && !service.sleepFn(ctx, service.config.EnqueueInterval) {
+			return ctx.Err()
+		}
+		batches++
+
+		err := service.enqueue(ctx, pending)
+		pending = pending[:0]
+		return err
+	}
+
+	var cursorStreamID uuid.UUID
+	var cursorPosition metabase.SegmentPosition
+	for {
+		result, err := service.metabase.ListVerifySegments(ctx, metabase.ListVerifySegments{
+			CursorStreamID: cursorStreamID,
+			CursorPosition: cursorPosition,
+			Limit:          service.config.BatchSize,
+
+			AsOfSystemInterval: service.config.AsOfSystemInterval,
+		})
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		if len(result.Segments) == 0 {
+			break
+		}
+
+		last := &result.Segments[len(result.Segments)-1]
+		cursorStreamID, cursorPosition = last.StreamID, last.Position
+
+		for i := range result.Segments {
+			segment := &result.Segments[i]
+
+			pieceNum, ok := findPieceNum(segment, alias)
+			if !ok {
+				continue
+			}
+
+			if err := estimate.Add(segment); err != nil {
+				return Error.Wrap(err)
+			}
+
+			// the pieces on the node are considered lost.
+			numHealthy := len(segment.AliasPieces) - 1
+			repairThreshold := int(segment.Redundancy.RepairShares)
+			if override := service.repairOverrides.GetOverrideValue(segment.Redundancy, segment.Placement); override != 0 {
+				repairThreshold = int(override)
+			}
+
+			redundancy, err := eestream.NewRedundancyStrategyFromStorj(segment.Redundancy)
+			if err != nil {
+				return Error.Wrap(err)
+			}
+
+			err = report.Write([]string{
+				segment.StreamID.String(),
+				strconv.FormatUint(segment.Position.Encode(), 10),
+				strconv.Itoa(int(pieceNum)),
+				strconv.Itoa(numHealthy),
+				strconv.Itoa(repairThreshold),
+				strconv.FormatInt(eestream.CalcPieceSize(int64(segment.EncryptedSize), redundancy), 10),
+			})
+			if err != nil {
+				return Error.Wrap(err)
+			}
+
+			if !service.config.Enqueue {
+				continue
+			}
+
+			pending = append(pending, &queue.InjuredSegment{
+				StreamID:      segment.StreamID,
+				Position:      segment.Position,
+				SegmentHealth: repair.SegmentHealth(numHealthy, int(segment.Redundancy.RequiredShares), aliasMap.Size(), service.nodeFailureRate),
+			})
+			if len(pending) >= service.config.EnqueueBatchSize {
+				if err := flush(); err != nil {
+					return Error.Wrap(err)
+				}
+			}
+		}
+	}
+
+	if len(pending) > 0 {
+		if err := flush(); err != nil {
+			return Error.Wrap(err)
+		}
+	}
+
+	report.Flush()
+	if err := report.Error(); err != nil {
+		return Error.Wrap(err)
+	}
+
+	service.log.Info("repair estimate",
+		zap.Stringer("node-id", nodeID),
+		zap.Int64("segments", estimate.Segments),
+		zap.Stringer("stored", memory.Size(estimate.PieceBytes)),
+		zap.Stringer("download", memory.Size(estimate.DownloadBytes)),
+		zap.Stringer("upload", memory.Size(estimate.UploadBytes)),
+		zap.Bool("enqueued", service.config.Enqueue))
+
+	return nil
+}
+
+// enqueue inserts a batch into the repair queue.
+func (service *Service) enqueue(ctx context.Context, segments []*queue.InjuredSegment) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	now := time.Now().UTC()
+
+	inserted := 0
+	for _, segment := range segments {
+		segment.UpdatedAt = now
+		alreadyInserted, err := service.repairQueue.Insert(ctx, segment)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		if !alreadyInserted {
+			inserted++
+		}
+	}
+
+	service.log.Info("segments enqueued for repair",
+		zap.Int("count", len(segments)),
+		zap.Int("new", inserted))
+
+	return nil
+}
+
+func findPieceNum(segment *metabase.VerifySegment, alias metabase.NodeAlias) (uint16, bool) {
+	for _, piece := range segment.AliasPieces {
+		if piece.Alias == alias {
+			return piece.Number, true
+		}
+	}
+	return 0, false
+}
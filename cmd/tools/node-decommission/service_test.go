@@ -0,0 +1,154 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main_test
+
+import (
+	"context"
+	"encoding/csv"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/uuid"
+	decommission "storj.io/storj/cmd/tools/node-decommission"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/repair/queue"
+)
+
+func TestService_Process(t *testing.T) {
+	ctx := testcontext.New(t)
+	log := testplanet.NewLogger(t)
+
+	redundancy := storj.RedundancyScheme{
+		Algorithm:      storj.ReedSolomon,
+		RequiredShares: 2,
+		RepairShares:   3,
+		OptimalShares:  4,
+		TotalShares:    5,
+		ShareSize:      256,
+	}
+
+	nodes := map[metabase.NodeAlias]storj.NodeID{}
+	for i := 1; i <= 10; i++ {
+		nodes[metabase.NodeAlias(i)] = storj.NodeID{byte(i)}
+	}
+
+	segments := []metabase.VerifySegment{
+		{
+			StreamID:      uuid.UUID{0x10},
+			Redundancy:    redundancy,
+			EncryptedSize: 1024,
+			AliasPieces:   metabase.AliasPieces{{Number: 0, Alias: 1}, {Number: 1, Alias: 2}, {Number: 2, Alias: 3}, {Number: 3, Alias: 4}},
+		},
+		{ // not on the node
+			StreamID:      uuid.UUID{0x20},
+			Redundancy:    redundancy,
+			EncryptedSize: 1024,
+			AliasPieces:   metabase.AliasPieces{{Number: 0, Alias: 2}, {Number: 1, Alias: 3}, {Number: 2, Alias: 4}, {Number: 3, Alias: 5}},
+		},
+		{
+			StreamID:      uuid.UUID{0x30},
+			Redundancy:    redundancy,
+			EncryptedSize: 1024,
+			AliasPieces:   metabase.AliasPieces{{Number: 0, Alias: 2}, {Number: 1, Alias: 3}, {Number: 4, Alias: 1}},
+		},
+		{
+			StreamID:      uuid.UUID{0x40},
+			Redundancy:    redundancy,
+			EncryptedSize: 1024,
+			AliasPieces:   metabase.AliasPieces{{Number: 0, Alias: 1}, {Number: 1, Alias: 3}, {Number: 2, Alias: 4}, {Number: 3, Alias: 5}},
+		},
+	}
+
+	config := decommission.Config{
+		BatchSize:        2,
+		ReportPath:       ctx.File("report.csv"),
+		Enqueue:          true,
+		EnqueueBatchSize: 2,
+	}
+
+	repairQueue := &repairQueueMock{}
+	service := decommission.NewService(log, &metabaseMock{nodes: nodes, segments: segments}, repairQueue, config)
+
+	err := service.Process(ctx, nodes[1])
+	require.NoError(t, err)
+
+	require.Len(t, repairQueue.segments, 3)
+	require.Equal(t, segments[0].StreamID, repairQueue.segments[0].StreamID)
+	require.Equal(t, segments[2].StreamID, repairQueue.segments[1].StreamID)
+	require.Equal(t, segments[3].StreamID, repairQueue.segments[2].StreamID)
+	// the segment with fewer healthy pieces is more urgent.
+	require.Less(t, repairQueue.segments[1].SegmentHealth, repairQueue.segments[0].SegmentHealth)
+
+	f, err := os.Open(config.ReportPath)
+	require.NoError(t, err)
+	defer ctx.Check(f.Close)
+
+	rows, err := csv.NewReader(f).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, rows, 4)
+	require.Equal(t, []string{segments[2].StreamID.String(), "0", "4", "2", "3", "768"}, rows[2])
+}
+
+func TestService_UnknownNode(t *testing.T) {
+	ctx := testcontext.New(t)
+	log := testplanet.NewLogger(t)
+
+	repairQueue := &repairQueueMock{}
+	service := decommission.NewService(log, &metabaseMock{}, repairQueue, decommission.Config{
+		BatchSize:  2,
+		ReportPath: ctx.File("report.csv"),
+		Enqueue:    true,
+	})
+
+	err := service.Process(ctx, storj.NodeID{1})
+	require.NoError(t, err)
+	require.Empty(t, repairQueue.segments)
+}
+
+type metabaseMock struct {
+	nodes    map[metabase.NodeAlias]storj.NodeID
+	segments []metabase.VerifySegment
+}
+
+func (db *metabaseMock) LatestNodesAliasMap(ctx context.Context) (*metabase.NodeAliasMap, error) {
+	var entries []metabase.NodeAliasEntry
+	for alias, id := range db.nodes {
+		entries = append(entries, metabase.NodeAliasEntry{
+			ID:    id,
+			Alias: alias,
+		})
+	}
+	return metabase.NewNodeAliasMap(entries), nil
+}
+
+func (db *metabaseMock) ListVerifySegments(ctx context.Context, opts metabase.ListVerifySegments) (result metabase.ListVerifySegmentsResult, err error) {
+	for _, s := range db.segments {
+		if s.StreamID.Less(opts.CursorStreamID) {
+			continue
+		}
+		if s.StreamID == opts.CursorStreamID && !opts.CursorPosition.Less(s.Position) {
+			continue
+		}
+
+		result.Segments = append(result.Segments, s)
+		if len(result.Segments) >= opts.Limit {
+			break
+		}
+	}
+	return result, nil
+}
+
+type repairQueueMock struct {
+	segments []queue.InjuredSegment
+}
+
+func (q *repairQueueMock) Insert(ctx context.Context, s *queue.InjuredSegment) (bool, error) {
+	q.segments = append(q.segments, *s)
+	return false, nil
+}
@@ -0,0 +1,52 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"context"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/rpc"
+	"storj.io/common/storj"
+	"storj.io/storj/private/piecelistpb"
+)
+
+// Inventory is the set of pieces stored on a node.
+type Inventory map[storj.PieceID]struct{}
+
+// ListInventory lists the pieces, which the node stores for the satellite of
+// the dialer, with the piece list service of the node. limit is the number of
+// pieces requested at once.
+func ListInventory(ctx context.Context, dialer rpc.Dialer, node storj.NodeURL, limit int) (_ Inventory, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	conn, err := dialer.DialNodeURL(ctx, node)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, Error.Wrap(conn.Close())) }()
+
+	client := piecelistpb.NewDRPCPieceListClient(conn)
+
+	inventory := Inventory{}
+	var cursor storj.PieceID
+	for {
+		resp, err := client.List(ctx, &piecelistpb.ListRequest{
+			Cursor: cursor,
+			Limit:  int32(limit),
+		})
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+
+		for _, piece := range resp.Pieces {
+			inventory[piece.PieceId] = struct{}{}
+		}
+		if !resp.More || len(resp.Pieces) == 0 {
+			return inventory, nil
+		}
+		cursor = resp.Pieces[len(resp.Pieces)-1].PieceId
+	}
+}
@@ -0,0 +1,136 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/spf13/cobra"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/fpath"
+	"storj.io/common/peertls/tlsopts"
+	"storj.io/common/storj"
+	"storj.io/private/cfgstruct"
+	"storj.io/private/process"
+	"storj.io/storj/private/revocation"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/nodedialer"
+	"storj.io/storj/satellite/satellitedb"
+)
+
+var mon = monkit.Package()
+
+// Error is the default error class for piece-tracker.
+var Error = errs.Class("piece-tracker")
+
+// Satellite defines satellite configuration.
+type Satellite struct {
+	Database string `help:"satellite database connection string" releaseDefault:"postgres://" devDefault:"postgres://"`
+
+	satellite.Config
+}
+
+var (
+	rootCmd = &cobra.Command{
+		Use:   "piece-tracker",
+		Short: "piece-tracker",
+	}
+
+	compareCmd = &cobra.Command{
+		Use:   "compare",
+		Short: "compares the piece inventory of a node with the pieces expected by the satellite",
+		RunE:  compare,
+	}
+
+	satelliteCfg Satellite
+	compareCfg   Config
+
+	confDir     string
+	identityDir string
+)
+
+func init() {
+	defaultConfDir := fpath.ApplicationDir("storj", "satellite")
+	defaultIdentityDir := fpath.ApplicationDir("storj", "identity", "satellite")
+	cfgstruct.SetupFlag(zap.L(), rootCmd, &confDir, "config-dir", defaultConfDir, "main directory for satellite configuration")
+	cfgstruct.SetupFlag(zap.L(), rootCmd, &identityDir, "identity-dir", defaultIdentityDir, "main directory for satellite identity credentials")
+	defaults := cfgstruct.DefaultsFlag(rootCmd)
+
+	rootCmd.AddCommand(compareCmd)
+
+	process.Bind(compareCmd, &satelliteCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
+	process.Bind(compareCmd, &compareCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
+}
+
+func compare(cmd *cobra.Command, args []string) (err error) {
+	ctx, _ := process.Ctx(cmd)
+	log := zap.L()
+
+	nodeID, err := storj.NodeIDFromString(compareCfg.NodeID)
+	if err != nil {
+		return Error.New("invalid node id %q: %v", compareCfg.NodeID, err)
+	}
+
+	db, err := satellitedb.Open(ctx, log.Named("db"), satelliteCfg.Database, satellitedb.Options{
+		ApplicationName: "piece-tracker",
+	})
+	if err != nil {
+		return errs.New("Error starting master database on satellite: %+v", err)
+	}
+	defer func() { err = errs.Combine(err, db.Close()) }()
+
+	node, err := db.OverlayCache().Get(ctx, nodeID)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	identity, err := satelliteCfg.Identity.Load()
+	if err != nil {
+		return errs.New("Failed to load identity: %+v", err)
+	}
+
+	revocationDB, err := revocation.OpenDBFromCfg(ctx, satelliteCfg.Server.Config)
+	if err != nil {
+		return errs.New("Error creating revocation database: %+v", err)
+	}
+	defer func() { err = errs.Combine(err, revocationDB.Close()) }()
+
+	tlsOptions, err := tlsopts.NewOptions(identity, satelliteCfg.Server.Config, revocationDB)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	nodeDialer := nodedialer.New(satelliteCfg.NodeDialer)
+	defer func() { err = errs.Combine(err, nodeDialer.Close()) }()
+
+	inventory, err := ListInventory(ctx, nodeDialer.RPCDialer(tlsOptions), storj.NodeURL{
+		ID:      nodeID,
+		Address: node.Address.Address,
+	}, compareCfg.ListLimit)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	metabaseDB, err := metabase.Open(ctx, log.Named("metabase"), satelliteCfg.Metainfo.DatabaseURL,
+		satelliteCfg.Metainfo.Metabase("piece-tracker"))
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	defer func() { _ = metabaseDB.Close() }()
+
+	versionErr := metabaseDB.CheckVersion(ctx)
+	if versionErr != nil {
+		log.Error("versions skewed", zap.Error(versionErr))
+		return Error.Wrap(versionErr)
+	}
+
+	service := NewService(log.Named("piece-tracker"), metabaseDB, compareCfg)
+	return service.Compare(ctx, nodeID, inventory)
+}
+
+func main() {
+	process.Exec(rootCmd)
+}
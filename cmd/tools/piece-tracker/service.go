@@ -0,0 +1,174 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"io"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/metabase"
+)
+
+// Config contains configurable options for comparing the pieces of a node.
+type Config struct {
+	NodeID     string `help:"node ID to compare" default:""`
+	ReportPath string `help:"csv file for the missing and extra pieces" default:"piece-tracker.csv"`
+	BatchSize  int    `help:"number of segments to list per batch" default:"10000"`
+	ListLimit  int    `help:"number of pieces to request from the node at once" default:"10000"`
+
+	AsOfSystemInterval time.Duration `help:"as of system interval" releaseDefault:"-5m" devDefault:"-1us" testDefault:"-1us"`
+}
+
+// Metabase defines implementation dependencies we need from metabase.
+type Metabase interface {
+	LatestNodesAliasMap(ctx context.Context) (*metabase.NodeAliasMap, error)
+	ListVerifySegments(ctx context.Context, opts metabase.ListVerifySegments) (result metabase.ListVerifySegmentsResult, err error)
+}
+
+// Result contains the differences between the inventory and the metabase.
+type Result struct {
+	Expected int
+	Missing  int
+	Extra    int
+}
+
+// Service compares the piece inventory of a node with the metabase.
+type Service struct {
+	log    *zap.Logger
+	config Config
+
+	metabase Metabase
+}
+
+// NewService creates a new piece tracker service.
+func NewService(log *zap.Logger, metabaseDB Metabase, config Config) *Service {
+	return &Service{
+		log:      log,
+		config:   config,
+		metabase: metabaseDB,
+	}
+}
+
+// Compare compares the inventory of the node with the pieces expected by the metabase
+// and writes the missing and extra pieces into the report.
+//
+// Pieces uploaded or deleted while the inventory was created show up as differences,
+// as do pieces that the node still keeps until garbage collection.
+func (service *Service) Compare(ctx context.Context, nodeID storj.NodeID, inventory Inventory) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	inventorySize := len(inventory)
+
+	file, err := os.Create(service.config.ReportPath)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, Error.Wrap(file.Close())) }()
+
+	result, err := service.CompareTo(ctx, nodeID, inventory, file)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	service.log.Info("comparison finished",
+		zap.Stringer("node-id", nodeID),
+		zap.Int("inventory", inventorySize),
+		zap.Int("expected", result.Expected),
+		zap.Int("missing", result.Missing),
+		zap.Int("extra", result.Extra))
+	return nil
+}
+
+// CompareTo compares the inventory of the node with the pieces expected by the metabase
+// and writes the differences as csv into w. The inventory is modified.
+func (service *Service) CompareTo(ctx context.Context, nodeID storj.NodeID, inventory Inventory, w io.Writer) (_ Result, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	report := csv.NewWriter(w)
+	err = report.Write([]string{"status", "piece id", "stream id", "position", "piece number"})
+	if err != nil {
+		return Result{}, Error.Wrap(err)
+	}
+
+	var result Result
+
+	aliasMap, err := service.metabase.LatestNodesAliasMap(ctx)
+	if err != nil {
+		return Result{}, Error.Wrap(err)
+	}
+
+	alias, ok := aliasMap.Alias(nodeID)
+	if ok {
+		var cursorStreamID uuid.UUID
+		var cursorPosition metabase.SegmentPosition
+		for {
+			list, err := service.metabase.ListVerifySegments(ctx, metabase.ListVerifySegments{
+				CursorStreamID: cursorStreamID,
+				CursorPosition: cursorPosition,
+				Limit:          service.config.BatchSize,
+
+				AsOfSystemInterval: service.config.AsOfSystemInterval,
+			})
+			if err != nil {
+				return Result{}, Error.Wrap(err)
+			}
+			if len(list.Segments) == 0 {
+				break
+			}
+
+			last := &list.Segments[len(list.Segments)-1]
+			cursorStreamID, cursorPosition = last.StreamID, last.Position
+
+			for _, segment := range list.Segments {
+				for _, piece := range segment.AliasPieces {
+					if piece.Alias != alias {
+						continue
+					}
+					result.Expected++
+
+					pieceID := segment.RootPieceID.Derive(nodeID, int32(piece.Number))
+					if _, ok := inventory[pieceID]; ok {
+						delete(inventory, pieceID)
+						continue
+					}
+
+					result.Missing++
+					err := report.Write([]string{
+						"missing",
+						pieceID.String(),
+						segment.StreamID.String(),
+						strconv.FormatUint(segment.Position.Encode(), 10),
+						strconv.Itoa(int(piece.Number)),
+					})
+					if err != nil {
+						return Result{}, Error.Wrap(err)
+					}
+				}
+			}
+		}
+	} else {
+		service.log.Info("node does not hold any data according to the metabase", zap.Stringer("node-id", nodeID))
+	}
+
+	// everything left in the inventory is unknown to the metabase.
+	for pieceID := range inventory {
+		result.Extra++
+		err := report.Write([]string{"extra", pieceID.String(), "", "", ""})
+		if err != nil {
+			return Result{}, Error.Wrap(err)
+		}
+	}
+
+	report.Flush()
+	return result, Error.Wrap(report.Error())
+}
@@ -0,0 +1,140 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main_test
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/memory"
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/common/uuid"
+	piecetracker "storj.io/storj/cmd/tools/piece-tracker"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite/metabase"
+)
+
+func TestListInventory(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		node := planet.StorageNodes[0]
+
+		for i := 0; i < 3; i++ {
+			require.NoError(t, planet.Uplinks[0].Upload(ctx, satellite, "testbucket", fmt.Sprintf("object%d", i), testrand.Bytes(10*memory.KiB)))
+		}
+
+		expected := piecetracker.Inventory{}
+		segments, err := satellite.Metabase.DB.TestingAllSegments(ctx)
+		require.NoError(t, err)
+		for _, segment := range segments {
+			for _, piece := range segment.Pieces {
+				if piece.StorageNode == node.ID() {
+					expected[segment.RootPieceID.Derive(node.ID(), int32(piece.Number))] = struct{}{}
+				}
+			}
+		}
+		require.NotEmpty(t, expected)
+
+		// a small limit makes the listing use multiple pages.
+		inventory, err := piecetracker.ListInventory(ctx, satellite.Dialer, node.NodeURL(), 1)
+		require.NoError(t, err)
+		require.Equal(t, expected, inventory)
+
+		// nodes only list the pieces to the satellites.
+		_, err = piecetracker.ListInventory(ctx, planet.Uplinks[0].Dialer, node.NodeURL(), 1)
+		require.Error(t, err)
+	})
+}
+
+func TestService_CompareTo(t *testing.T) {
+	ctx := testcontext.New(t)
+	log := testplanet.NewLogger(t)
+
+	nodeID := storj.NodeID{1}
+	nodes := map[metabase.NodeAlias]storj.NodeID{
+		1: nodeID,
+		2: {2},
+	}
+
+	segments := []metabase.VerifySegment{
+		{
+			StreamID:    uuid.UUID{0x10},
+			RootPieceID: testrand.PieceID(),
+			AliasPieces: metabase.AliasPieces{{Number: 0, Alias: 1}, {Number: 1, Alias: 2}},
+		},
+		{
+			StreamID:    uuid.UUID{0x20},
+			RootPieceID: testrand.PieceID(),
+			AliasPieces: metabase.AliasPieces{{Number: 0, Alias: 2}, {Number: 3, Alias: 1}},
+		},
+		{ // not on the node
+			StreamID:    uuid.UUID{0x30},
+			RootPieceID: testrand.PieceID(),
+			AliasPieces: metabase.AliasPieces{{Number: 0, Alias: 2}},
+		},
+	}
+
+	stored := segments[0].RootPieceID.Derive(nodeID, 0)
+	missing := segments[1].RootPieceID.Derive(nodeID, 3)
+	extra := testrand.PieceID()
+
+	service := piecetracker.NewService(log, &metabaseMock{nodes: nodes, segments: segments}, piecetracker.Config{
+		BatchSize: 2,
+	})
+
+	var out strings.Builder
+	result, err := service.CompareTo(ctx, nodeID, piecetracker.Inventory{stored: {}, extra: {}}, &out)
+	require.NoError(t, err)
+	require.Equal(t, piecetracker.Result{Expected: 2, Missing: 1, Extra: 1}, result)
+
+	rows, err := csv.NewReader(strings.NewReader(out.String())).ReadAll()
+	require.NoError(t, err)
+	require.Equal(t, [][]string{
+		{"status", "piece id", "stream id", "position", "piece number"},
+		{"missing", missing.String(), segments[1].StreamID.String(), "0", "3"},
+		{"extra", extra.String(), "", "", ""},
+	}, rows)
+}
+
+type metabaseMock struct {
+	nodes    map[metabase.NodeAlias]storj.NodeID
+	segments []metabase.VerifySegment
+}
+
+func (db *metabaseMock) LatestNodesAliasMap(ctx context.Context) (*metabase.NodeAliasMap, error) {
+	var entries []metabase.NodeAliasEntry
+	for alias, id := range db.nodes {
+		entries = append(entries, metabase.NodeAliasEntry{
+			ID:    id,
+			Alias: alias,
+		})
+	}
+	return metabase.NewNodeAliasMap(entries), nil
+}
+
+func (db *metabaseMock) ListVerifySegments(ctx context.Context, opts metabase.ListVerifySegments) (result metabase.ListVerifySegmentsResult, err error) {
+	for _, s := range db.segments {
+		if s.StreamID.Less(opts.CursorStreamID) {
+			continue
+		}
+		if s.StreamID == opts.CursorStreamID && !opts.CursorPosition.Less(s.Position) {
+			continue
+		}
+
+		result.Segments = append(result.Segments, s)
+		if len(result.Segments) >= opts.Limit {
+			break
+		}
+	}
+	return result, nil
+}
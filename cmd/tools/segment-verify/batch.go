@@ -66,6 +66,11 @@ func (service *Service) CreateBatches(ctx context.Context, segments []*Segment)
 		return allQueues[i].Len() >= allQueues[k].Len()
 	})
 
+	// none of the segments have a piece that can be checked.
+	if len(allQueues) == 0 {
+		return nil, nil
+	}
+
 	// try to redistribute segments in different slices
 	//   queue with length above 65% will be redistributed
 	//   to queues with length below 40%, but no more than 50%
@@ -115,17 +120,18 @@ func (service *Service) CreateBatches(ctx context.Context, segments []*Segment)
 	return allQueues, nil
 }
 
-// selectOnlinePieces modifies slice such that it only contains online pieces.
+// selectOnlinePieces modifies slice such that it only contains online pieces,
+// which are on the target nodes.
 func (service *Service) selectOnlinePieces(segment *Segment) {
 	for i, x := range segment.AliasPieces {
-		if service.onlineNodes.Contains(x.Alias) {
+		if service.isVerifiable(x.Alias) {
 			continue
 		}
 
 		// found an offline node, start removing
 		rs := segment.AliasPieces[:i]
 		for _, x := range segment.AliasPieces[i+1:] {
-			if service.onlineNodes.Contains(x.Alias) {
+			if service.isVerifiable(x.Alias) {
 				rs = append(rs, x)
 			}
 		}
@@ -134,6 +140,40 @@ func (service *Service) selectOnlinePieces(segment *Segment) {
 	}
 }
 
+// isVerifiable checks whether the pieces on the node should be verified.
+func (service *Service) isVerifiable(alias metabase.NodeAlias) bool {
+	if service.targetNodes != nil && !service.targetNodes.Contains(alias) {
+		return false
+	}
+	return service.onlineNodes.Contains(alias)
+}
+
+// selectTargetSegments returns only the segments, which have a piece on a target node.
+func (service *Service) selectTargetSegments(segments []*Segment) []*Segment {
+	if service.targetNodes == nil {
+		return segments
+	}
+
+	selected := segments[:0]
+	for _, segment := range segments {
+		if service.countTargetPieces(segment) > 0 {
+			selected = append(selected, segment)
+		}
+	}
+	return selected
+}
+
+// countTargetPieces returns the number of pieces on the target nodes.
+func (service *Service) countTargetPieces(segment *Segment) int {
+	count := 0
+	for _, piece := range segment.AliasPieces {
+		if service.targetNodes.Contains(piece.Alias) {
+			count++
+		}
+	}
+	return count
+}
+
 // removePriorityPieces modifies slice such that it only contains non-priority pieces.
 func (service *Service) removePriorityPieces(segment *Segment) {
 	target := 0
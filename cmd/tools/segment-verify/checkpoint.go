@@ -0,0 +1,105 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+
+	"storj.io/common/fpath"
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/metabase"
+)
+
+// Checkpoint contains the persisted progress of a verification run,
+// which allows an interrupted run to be resumed.
+type Checkpoint struct {
+	path string
+
+	Ranges map[string]*RangeProgress  `json:"ranges"`
+	Nodes  map[storj.NodeID]NodeState `json:"nodes"`
+}
+
+// RangeProgress contains the progress of verifying a single range.
+type RangeProgress struct {
+	CursorStreamID uuid.UUID `json:"cursorStreamID"`
+	CursorPosition uint64    `json:"cursorPosition"`
+	Processed      int64     `json:"processed"`
+	Done           bool      `json:"done"`
+}
+
+// NodeState contains the retry state of a single node.
+type NodeState struct {
+	OfflineCount int  `json:"offlineCount"`
+	Offline      bool `json:"offline"`
+}
+
+// LoadCheckpoint loads the checkpoint from the specified path. When the file
+// does not exist, an empty checkpoint is returned.
+func LoadCheckpoint(path string) (*Checkpoint, error) {
+	checkpoint := &Checkpoint{
+		path:   path,
+		Ranges: map[string]*RangeProgress{},
+		Nodes:  map[storj.NodeID]NodeState{},
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return checkpoint, nil
+	}
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	if err := json.Unmarshal(data, checkpoint); err != nil {
+		return nil, Error.New("invalid checkpoint %q: %w", path, err)
+	}
+	if checkpoint.Ranges == nil {
+		checkpoint.Ranges = map[string]*RangeProgress{}
+	}
+	if checkpoint.Nodes == nil {
+		checkpoint.Nodes = map[storj.NodeID]NodeState{}
+	}
+	return checkpoint, nil
+}
+
+// IsEmpty returns whether the checkpoint contains any progress.
+func (checkpoint *Checkpoint) IsEmpty() bool {
+	return len(checkpoint.Ranges) == 0 && len(checkpoint.Nodes) == 0
+}
+
+// Range returns the progress for range between low and high.
+func (checkpoint *Checkpoint) Range(low, high uuid.UUID) *RangeProgress {
+	key := low.String() + "-" + high.String()
+	progress, ok := checkpoint.Ranges[key]
+	if !ok {
+		progress = &RangeProgress{}
+		checkpoint.Ranges[key] = progress
+	}
+	return progress
+}
+
+// Save atomically writes the checkpoint to disk.
+func (checkpoint *Checkpoint) Save() error {
+	data, err := json.MarshalIndent(checkpoint, "", "\t")
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	return Error.Wrap(fpath.AtomicWriteFile(checkpoint.path, data, 0644))
+}
+
+// Cursor returns the position where the range should continue from.
+func (progress *RangeProgress) Cursor() (uuid.UUID, metabase.SegmentPosition) {
+	return progress.CursorStreamID, metabase.SegmentPositionFromEncoded(progress.CursorPosition)
+}
+
+// Advance updates the cursor after a batch of segments has been processed.
+func (progress *RangeProgress) Advance(streamID uuid.UUID, position metabase.SegmentPosition, count int) {
+	progress.CursorStreamID = streamID
+	progress.CursorPosition = position.Encode()
+	progress.Processed += int64(count)
+}
@@ -11,6 +11,8 @@ import (
 	"os"
 	"sync"
 
+	"github.com/zeebo/errs"
+
 	"storj.io/common/storj"
 	"storj.io/storj/satellite/audit"
 	"storj.io/storj/satellite/metabase"
@@ -26,14 +28,16 @@ type CSVWriter struct {
 var _ SegmentWriter = (*CSVWriter)(nil)
 
 // NewCSVWriter creates a new segment writer that writes to the specified path.
-func NewCSVWriter(path string) (*CSVWriter, error) {
-	f, err := os.Create(path)
+// When resume is true, the segments are appended to the existing file.
+func NewCSVWriter(path string, resume bool) (*CSVWriter, error) {
+	f, hasContent, err := openOutput(path, resume)
 	if err != nil {
 		return nil, Error.Wrap(err)
 	}
 	return &CSVWriter{
-		file: f,
-		wr:   csv.NewWriter(f),
+		header: hasContent,
+		file:   f,
+		wr:     csv.NewWriter(f),
 	}, nil
 }
 
@@ -90,6 +94,25 @@ func (csv *CSVWriter) Write(ctx context.Context, segments []*Segment) (err error
 	return nil
 }
 
+// openOutput opens the output file for writing. When resume is true, the
+// existing content is kept and hasContent reports whether there was any.
+func openOutput(path string, resume bool) (_ *os.File, hasContent bool, err error) {
+	if !resume {
+		f, err := os.Create(path)
+		return f, false, err
+	}
+
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0644)
+	if err != nil {
+		return nil, false, err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		return nil, false, errs.Combine(err, f.Close())
+	}
+	return f, info.Size() > 0, nil
+}
+
 // nopCloser adds Close method to a writer.
 type nopCloser struct{ io.Writer }
 
@@ -104,14 +127,16 @@ type pieceCSVWriter struct {
 }
 
 // newPieceCSVWriter creates a new piece CSV writer that writes to the specified path.
-func newPieceCSVWriter(path string) (*pieceCSVWriter, error) {
-	f, err := os.Create(path)
+// When resume is true, the pieces are appended to the existing file.
+func newPieceCSVWriter(path string, resume bool) (*pieceCSVWriter, error) {
+	f, hasContent, err := openOutput(path, resume)
 	if err != nil {
 		return nil, Error.Wrap(err)
 	}
 	return &pieceCSVWriter{
-		file: f,
-		wr:   csv.NewWriter(f),
+		header: hasContent,
+		file:   f,
+		wr:     csv.NewWriter(f),
 	}, nil
 }
 
@@ -177,6 +202,8 @@ func outcomeString(outcome audit.Outcome) string {
 		return "RETRY"
 	case audit.OutcomeTimedOut:
 		return "TIMED_OUT"
+	case outcomeHashMismatch:
+		return "HASH_MISMATCH"
 	}
 	return fmt.Sprintf("(unexpected outcome code %d)", outcome)
 }
@@ -0,0 +1,215 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"sync"
+	"time"
+
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/audit"
+	"storj.io/storj/satellite/metabase"
+)
+
+// FindingKind classifies a problem found during verification.
+type FindingKind string
+
+const (
+	// FindingSegmentMissingPieces means a segment had at least one piece that was not found.
+	FindingSegmentMissingPieces FindingKind = "segment_missing_pieces"
+	// FindingSegmentUnverified means a segment could not be verified against enough nodes.
+	FindingSegmentUnverified FindingKind = "segment_unverified"
+	// FindingStreamMissing means a segment was deleted from the metabase during verification.
+	FindingStreamMissing FindingKind = "stream_missing"
+	// FindingPieceMissing means the node did not have the piece.
+	FindingPieceMissing FindingKind = "piece_missing"
+	// FindingHashMismatch means the downloaded piece did not match its hash.
+	FindingHashMismatch FindingKind = "hash_mismatch"
+	// FindingNodeOffline means the node could not be contacted or did not respond in time.
+	FindingNodeOffline FindingKind = "node_offline"
+	// FindingVerificationError means the piece could not be checked for some other reason.
+	FindingVerificationError FindingKind = "verification_error"
+)
+
+// Severity describes how urgently a finding needs attention.
+type Severity string
+
+const (
+	// SeverityInfo findings don't need any action.
+	SeverityInfo Severity = "info"
+	// SeverityWarning findings should be retried later.
+	SeverityWarning Severity = "warning"
+	// SeverityError findings indicate data loss on a node.
+	SeverityError Severity = "error"
+	// SeverityCritical findings indicate corrupted data.
+	SeverityCritical Severity = "critical"
+)
+
+// Severity returns the severity of the finding kind.
+func (kind FindingKind) Severity() Severity {
+	switch kind {
+	case FindingHashMismatch:
+		return SeverityCritical
+	case FindingPieceMissing, FindingSegmentMissingPieces:
+		return SeverityError
+	case FindingNodeOffline, FindingVerificationError, FindingSegmentUnverified:
+		return SeverityWarning
+	default:
+		return SeverityInfo
+	}
+}
+
+// findingKindFromOutcome converts a piece check outcome to a finding kind.
+func findingKindFromOutcome(outcome audit.Outcome) FindingKind {
+	switch outcome {
+	case audit.OutcomeFailure:
+		return FindingPieceMissing
+	case audit.OutcomeNodeOffline, audit.OutcomeTimedOut:
+		return FindingNodeOffline
+	case outcomeHashMismatch:
+		return FindingHashMismatch
+	default:
+		return FindingVerificationError
+	}
+}
+
+// Finding is a single problem found during verification.
+type Finding struct {
+	Time     time.Time   `json:"time"`
+	Kind     FindingKind `json:"kind"`
+	Severity Severity    `json:"severity"`
+
+	StreamID uuid.UUID `json:"streamID"`
+	Position uint64    `json:"position"`
+
+	Found     *int32 `json:"found,omitempty"`
+	NotFound  *int32 `json:"notFound,omitempty"`
+	Retry     *int32 `json:"retry,omitempty"`
+	Corrupted *int32 `json:"corrupted,omitempty"`
+
+	NodeID      *storj.NodeID `json:"nodeID,omitempty"`
+	PieceNumber *int          `json:"pieceNumber,omitempty"`
+}
+
+// FindingWriter writes findings as newline delimited JSON.
+type FindingWriter struct {
+	mu   sync.Mutex
+	file io.WriteCloser
+	enc  *json.Encoder
+
+	nowFn func() time.Time
+}
+
+// NewFindingWriter creates a new finding writer that writes to the specified path.
+// When resume is true, the findings are appended to the existing file.
+func NewFindingWriter(path string, resume bool) (*FindingWriter, error) {
+	f, _, err := openOutput(path, resume)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return newFindingWriter(f), nil
+}
+
+// NewCustomFindingWriter creates a new finding writer that writes to the io.Writer.
+func NewCustomFindingWriter(w io.Writer) *FindingWriter {
+	return newFindingWriter(nopCloser{w})
+}
+
+func newFindingWriter(w io.WriteCloser) *FindingWriter {
+	return &FindingWriter{
+		file:  w,
+		enc:   json.NewEncoder(w),
+		nowFn: time.Now,
+	}
+}
+
+// Close closes the writer.
+func (writer *FindingWriter) Close() error {
+	return Error.Wrap(writer.file.Close())
+}
+
+// WriteSegments writes a finding for each of the segments.
+func (writer *FindingWriter) WriteSegments(ctx context.Context, kind FindingKind, segments []*Segment) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	writer.mu.Lock()
+	defer writer.mu.Unlock()
+
+	now := writer.nowFn()
+	for _, seg := range segments {
+		if ctx.Err() != nil {
+			return Error.Wrap(ctx.Err())
+		}
+
+		status := seg.Status
+		finding := Finding{
+			Time:     now,
+			Kind:     kind,
+			Severity: kind.Severity(),
+			StreamID: seg.StreamID,
+			Position: seg.Position.Encode(),
+			Found:    &status.Found,
+			NotFound: &status.NotFound,
+			Retry:    &status.Retry,
+		}
+		if status.Corrupted > 0 {
+			finding.Corrupted = &status.Corrupted
+		}
+		err := writer.enc.Encode(finding)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+	}
+	return nil
+}
+
+// WritePiece writes a finding about a single piece.
+func (writer *FindingWriter) WritePiece(ctx context.Context, kind FindingKind, segment *metabase.VerifySegment, nodeID storj.NodeID, pieceNum int) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	writer.mu.Lock()
+	defer writer.mu.Unlock()
+
+	if ctx.Err() != nil {
+		return Error.Wrap(ctx.Err())
+	}
+
+	return Error.Wrap(writer.enc.Encode(Finding{
+		Time:        writer.nowFn(),
+		Kind:        kind,
+		Severity:    kind.Severity(),
+		StreamID:    segment.StreamID,
+		Position:    segment.Position.Encode(),
+		NodeID:      &nodeID,
+		PieceNumber: &pieceNum,
+	}))
+}
+
+// ReportPiece writes a finding based on the outcome of a piece check.
+func (writer *FindingWriter) ReportPiece(ctx context.Context, segment *metabase.VerifySegment, nodeID storj.NodeID, pieceNum int, outcome audit.Outcome) error {
+	return writer.WritePiece(ctx, findingKindFromOutcome(outcome), segment, nodeID, pieceNum)
+}
+
+// SegmentWriter returns a SegmentWriter that writes findings of the specified kind.
+func (writer *FindingWriter) SegmentWriter(kind FindingKind) SegmentWriter {
+	return &findingSegmentWriter{writer: writer, kind: kind}
+}
+
+// findingSegmentWriter adapts FindingWriter to SegmentWriter.
+type findingSegmentWriter struct {
+	writer *FindingWriter
+	kind   FindingKind
+}
+
+// Write writes the segments as findings.
+func (w *findingSegmentWriter) Write(ctx context.Context, segments []*Segment) error {
+	return w.writer.WriteSegments(ctx, w.kind, segments)
+}
+
+// Close does nothing, the underlying FindingWriter is closed separately.
+func (w *findingSegmentWriter) Close() error { return nil }
@@ -0,0 +1,143 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main_test
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/uuid"
+	segmentverify "storj.io/storj/cmd/tools/segment-verify"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite/audit"
+	"storj.io/storj/satellite/metabase"
+)
+
+func TestFindingWriter(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	var out strings.Builder
+	w := segmentverify.NewCustomFindingWriter(&out)
+
+	segment := &segmentverify.Segment{
+		VerifySegment: metabase.VerifySegment{
+			StreamID: uuid.UUID{1, 2, 3, 4, 5, 6},
+			Position: metabase.SegmentPosition{Part: 10, Index: 56},
+		},
+		Status: segmentverify.Status{Retry: 1, Found: 3, NotFound: 5},
+	}
+
+	err := w.SegmentWriter(segmentverify.FindingSegmentMissingPieces).Write(ctx, []*segmentverify.Segment{segment})
+	require.NoError(t, err)
+
+	err = w.ReportPiece(ctx, &segment.VerifySegment, storj.NodeID{7}, 2, audit.OutcomeFailure)
+	require.NoError(t, err)
+	err = w.ReportPiece(ctx, &segment.VerifySegment, storj.NodeID{8}, 3, audit.OutcomeTimedOut)
+	require.NoError(t, err)
+	err = w.WritePiece(ctx, segmentverify.FindingHashMismatch, &segment.VerifySegment, storj.NodeID{9}, 4)
+	require.NoError(t, err)
+
+	require.NoError(t, w.Close())
+
+	findings := decodeFindings(t, strings.NewReader(out.String()))
+	require.Len(t, findings, 4)
+
+	require.Equal(t, segmentverify.FindingSegmentMissingPieces, findings[0].Kind)
+	require.Equal(t, segmentverify.SeverityError, findings[0].Severity)
+	require.Equal(t, segment.StreamID, findings[0].StreamID)
+	require.Equal(t, segment.Position.Encode(), findings[0].Position)
+	require.EqualValues(t, 3, *findings[0].Found)
+	require.EqualValues(t, 5, *findings[0].NotFound)
+	require.EqualValues(t, 1, *findings[0].Retry)
+	require.Nil(t, findings[0].NodeID)
+
+	require.Equal(t, segmentverify.FindingPieceMissing, findings[1].Kind)
+	require.Equal(t, segmentverify.SeverityError, findings[1].Severity)
+	require.Equal(t, storj.NodeID{7}, *findings[1].NodeID)
+	require.Equal(t, 2, *findings[1].PieceNumber)
+	require.Nil(t, findings[1].Found)
+
+	require.Equal(t, segmentverify.FindingNodeOffline, findings[2].Kind)
+	require.Equal(t, segmentverify.SeverityWarning, findings[2].Severity)
+
+	require.Equal(t, segmentverify.FindingHashMismatch, findings[3].Kind)
+	require.Equal(t, segmentverify.SeverityCritical, findings[3].Severity)
+}
+
+func TestService_NDJSONOutput(t *testing.T) {
+	ctx := testcontext.New(t)
+	log := testplanet.NewLogger(t)
+
+	config := segmentverify.ServiceConfig{
+		OutputFormat: "ndjson",
+		FindingsPath: ctx.File("findings.ndjson"),
+
+		Check:       2,
+		BatchSize:   100,
+		Concurrency: 3,
+		MaxOffline:  2,
+	}
+
+	nodes := map[metabase.NodeAlias]storj.NodeID{}
+	for i := 1; i <= 0xFF; i++ {
+		nodes[metabase.NodeAlias(i)] = storj.NodeID{byte(i)}
+	}
+
+	segments := []metabase.VerifySegment{
+		{
+			StreamID:    uuid.UUID{0x10, 0x10},
+			AliasPieces: metabase.AliasPieces{{Number: 1, Alias: 8}, {Number: 3, Alias: 9}},
+		},
+		{
+			StreamID:    uuid.UUID{0x20, 0x20},
+			AliasPieces: metabase.AliasPieces{{Number: 0, Alias: 2}, {Number: 1, Alias: 3}},
+		},
+	}
+
+	func() {
+		metabase := newMetabaseMock(nodes, segments...)
+		verifier := &verifierMock{
+			success:  []uuid.UUID{segments[0].StreamID},
+			notFound: []uuid.UUID{segments[1].StreamID},
+		}
+
+		service, err := segmentverify.NewService(log.Named("segment-verify"), metabase, verifier, metabase, config)
+		require.NoError(t, err)
+		defer ctx.Check(service.Close)
+
+		err = service.ProcessRange(ctx, uuid.UUID{}, maxUUID)
+		require.NoError(t, err)
+	}()
+
+	f, err := os.Open(config.FindingsPath)
+	require.NoError(t, err)
+	defer ctx.Check(f.Close)
+
+	findings := decodeFindings(t, f)
+	require.Len(t, findings, 1)
+	require.Equal(t, segmentverify.FindingSegmentMissingPieces, findings[0].Kind)
+	require.Equal(t, segments[1].StreamID, findings[0].StreamID)
+	require.EqualValues(t, 2, *findings[0].NotFound)
+}
+
+func decodeFindings(t *testing.T, r io.Reader) []segmentverify.Finding {
+	var findings []segmentverify.Finding
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		var finding segmentverify.Finding
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &finding))
+		require.False(t, finding.Time.IsZero())
+		findings = append(findings, finding)
+	}
+	require.NoError(t, scanner.Err())
+	return findings
+}
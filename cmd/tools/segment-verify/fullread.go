@@ -0,0 +1,162 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/errs2"
+	"storj.io/common/pb"
+	"storj.io/common/pkcrypto"
+	"storj.io/common/rpc/rpcpool"
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/common/signing"
+	"storj.io/common/storj"
+	"storj.io/storj/satellite/audit"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/uplink/private/eestream"
+	"storj.io/uplink/private/piecestore"
+)
+
+// outcomeHashMismatch extends audit.Outcome for pieces whose downloaded content
+// or signatures don't match the piece hash. It's only used by this tool.
+const outcomeHashMismatch audit.Outcome = -1
+
+var errHashMismatch = errs.Class("hash mismatch")
+
+// VerifyFullRead downloads the pieces of the segments from the target node and
+// verifies them against their signed piece hash and original order limit.
+func (service *NodeVerifier) VerifyFullRead(ctx context.Context, alias metabase.NodeAlias, target storj.NodeURL, segments []*Segment, ignoreThrottle bool) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	client, err := piecestore.Dial(rpcpool.WithForceDial(ctx), service.dialer, target, piecestore.DefaultConfig)
+	if err != nil {
+		service.log.Info("failed to dial node for full read",
+			zap.Stringer("node-id", target.ID),
+			zap.Error(err))
+		return nil
+	}
+	defer func() { _ = client.Close() }()
+
+	nextRequest := time.Now()
+	for _, segment := range segments {
+		nextRequest, err = service.nodeRateLimiter(target.ID, ignoreThrottle).next(ctx, nextRequest)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+
+		outcome, err := service.fullReadSegment(ctx, client, alias, target, segment)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		service.observe(target.ID, outcome, ignoreThrottle)
+		switch outcome {
+		case audit.OutcomeNodeOffline:
+			// the existence checks have already been done, so there's
+			// no need to mark anything.
+			return nil
+		case outcomeHashMismatch:
+			segment.Status.MarkCorrupted()
+		}
+	}
+	return nil
+}
+
+// fullReadSegment downloads the whole piece of the segment from the target node
+// and verifies its hash.
+func (service *NodeVerifier) fullReadSegment(ctx context.Context, client *piecestore.Client, alias metabase.NodeAlias, target storj.NodeURL, segment *Segment) (outcome audit.Outcome, err error) {
+	pieceNum := findPieceNum(segment, alias)
+
+	logger := service.log.With(
+		zap.Stringer("stream-id", segment.StreamID),
+		zap.Stringer("node-id", target.ID),
+		zap.Uint64("position", segment.Position.Encode()),
+		zap.Uint16("piece-num", pieceNum))
+
+	defer func() {
+		// only corrupted pieces are reported, the existence check reports the rest.
+		if outcome == outcomeHashMismatch && service.reportPiece != nil {
+			reportErr := service.reportPiece(ctx, &segment.VerifySegment, target.ID, int(pieceNum), outcome)
+			err = errs.Combine(err, reportErr)
+		}
+	}()
+
+	redundancy, err := eestream.NewRedundancyStrategyFromStorj(segment.Redundancy)
+	if err != nil {
+		return audit.OutcomeNotPerformed, Error.Wrap(err)
+	}
+	pieceSize := eestream.CalcPieceSize(int64(segment.EncryptedSize), redundancy)
+
+	limit, piecePrivateKey, _, err := service.orders.CreateAuditPieceOrderLimit(ctx, target.ID, pieceNum, segment.RootPieceID, int32(pieceSize))
+	if err != nil {
+		logger.Error("failed to create order limit", zap.Error(err))
+		return audit.OutcomeNotPerformed, nil
+	}
+
+	timedCtx, cancel := context.WithTimeout(ctx, service.config.FullReadTimeout)
+	defer cancel()
+
+	downloader, err := client.Download(timedCtx, limit.GetLimit(), piecePrivateKey, 0, pieceSize)
+	if err != nil {
+		logger.Error("full read download failed", zap.Error(err))
+		if errs2.IsRPC(err, rpcstatus.Unknown) {
+			return audit.OutcomeNodeOffline, nil
+		}
+		return audit.OutcomeUnknownError, nil
+	}
+
+	hasher := pkcrypto.NewHash()
+	downloaded, errRead := io.Copy(hasher, downloader)
+	errClose := downloader.Close()
+	if err := errs.Combine(errRead, errClose); err != nil {
+		logger.Error("full read failed", zap.Error(err))
+		if errs2.IsRPC(err, rpcstatus.DeadlineExceeded) {
+			return audit.OutcomeTimedOut, nil
+		}
+		return audit.OutcomeUnknownError, nil
+	}
+
+	if downloaded != pieceSize {
+		logger.Info("piece has incorrect size", zap.Int64("expected", pieceSize), zap.Int64("downloaded", downloaded))
+		return outcomeHashMismatch, nil
+	}
+
+	hash, originalLimit := downloader.GetHashAndLimit()
+	if err := service.verifyPieceHash(ctx, originalLimit, hash, hasher.Sum(nil)); err != nil {
+		logger.Info("piece failed hash verification", zap.Error(err))
+		return outcomeHashMismatch, nil
+	}
+
+	logger.Debug("full read succeeded")
+	return audit.OutcomeSuccess, nil
+}
+
+// verifyPieceHash verifies that the original order limit is signed by the satellite and
+// that the piece hash is signed by the uplink and matches the downloaded data.
+func (service *NodeVerifier) verifyPieceHash(ctx context.Context, originalLimit *pb.OrderLimit, hash *pb.PieceHash, calculated []byte) error {
+	switch {
+	case hash == nil:
+		return errHashMismatch.New("hash was not sent from storagenode")
+	case originalLimit == nil:
+		return errHashMismatch.New("original order limit was not sent from storagenode")
+	case originalLimit.PieceId != hash.PieceId:
+		return errHashMismatch.New("piece id changed")
+	case !bytes.Equal(hash.Hash, calculated):
+		return errHashMismatch.New("hash from storage node, %x, does not match calculated hash, %x", hash.Hash, calculated)
+	}
+
+	if err := service.orders.VerifyOrderLimitSignature(ctx, originalLimit); err != nil {
+		return errHashMismatch.New("invalid order limit signature: %v", err)
+	}
+	if err := signing.VerifyUplinkPieceHashSignature(ctx, originalLimit.UplinkPublicKey, hash); err != nil {
+		return errHashMismatch.New("invalid piece hash signature: %v", err)
+	}
+	return nil
+}
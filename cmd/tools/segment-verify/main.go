@@ -17,7 +17,6 @@ import (
 
 	"storj.io/common/fpath"
 	"storj.io/common/peertls/tlsopts"
-	"storj.io/common/rpc"
 	"storj.io/common/signing"
 	"storj.io/common/uuid"
 	"storj.io/private/cfgstruct"
@@ -25,8 +24,10 @@ import (
 	"storj.io/storj/private/revocation"
 	"storj.io/storj/satellite"
 	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/nodedialer"
 	"storj.io/storj/satellite/orders"
 	"storj.io/storj/satellite/overlay"
+	"storj.io/storj/satellite/repair/checker"
 	"storj.io/storj/satellite/satellitedb"
 )
 
@@ -60,6 +61,12 @@ var (
 		RunE:  verifySegments,
 	}
 
+	scopeCmd = &cobra.Command{
+		Use:   "scope",
+		Short: "runs the command on segments of a project, bucket or object key prefix",
+		RunE:  verifySegments,
+	}
+
 	summarizeCmd = &cobra.Command{
 		Use:   "summarize-log",
 		Short: "summarizes verification log",
@@ -76,6 +83,7 @@ var (
 	satelliteCfg Satellite
 	rangeCfg     RangeConfig
 	bucketsCfg   BucketConfig
+	scopeCfg     ScopeConfig
 	nodeCheckCfg NodeCheckConfig
 
 	confDir     string
@@ -94,6 +102,7 @@ func init() {
 	rootCmd.AddCommand(nodeCheckCmd)
 	runCmd.AddCommand(rangeCmd)
 	runCmd.AddCommand(bucketsCmd)
+	runCmd.AddCommand(scopeCmd)
 
 	process.Bind(runCmd, &satelliteCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 
@@ -101,6 +110,8 @@ func init() {
 	process.Bind(rangeCmd, &rangeCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(bucketsCmd, &satelliteCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(bucketsCmd, &bucketsCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
+	process.Bind(scopeCmd, &satelliteCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
+	process.Bind(scopeCmd, &scopeCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 
 	process.Bind(nodeCheckCmd, &satelliteCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(nodeCheckCmd, &nodeCheckCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
@@ -123,6 +134,16 @@ type BucketConfig struct {
 	BucketsCSV string `help:"csv file of project_id,bucket_name of buckets to verify" default:""`
 }
 
+// ScopeConfig defines configuration for verifying segment existence within a project, bucket or prefix.
+type ScopeConfig struct {
+	Service ServiceConfig
+	Verify  VerifierConfig
+
+	ProjectID string `help:"project id of the segments to verify" default:""`
+	Bucket    string `help:"bucket name of the segments to verify, requires project id" default:""`
+	Prefix    string `help:"object key prefix of the segments to verify, requires bucket" default:""`
+}
+
 func verifySegments(cmd *cobra.Command, args []string) error {
 
 	ctx, _ := process.Ctx(cmd)
@@ -182,10 +203,14 @@ func verifySegments(cmd *cobra.Command, args []string) error {
 		return Error.Wrap(err)
 	}
 
-	dialer := rpc.NewDefaultDialer(tlsOptions)
+	nodeDialer := nodedialer.New(satelliteCfg.NodeDialer)
+	defer func() {
+		err = errs.Combine(err, nodeDialer.Close())
+	}()
+	dialer := nodeDialer.RPCDialer(tlsOptions)
 
 	// setup dependencies for verification
-	overlay, err := overlay.NewService(log.Named("overlay"), db.OverlayCache(), db.NodeEvents(), nil, "", "", satelliteCfg.Overlay)
+	overlay, err := overlay.NewService(log.Named("overlay"), db.OverlayCache(), db.NodeEvents(), nil, nil, "", "", satelliteCfg.Overlay)
 	if err != nil {
 		return Error.Wrap(err)
 	}
@@ -195,19 +220,33 @@ func verifySegments(cmd *cobra.Command, args []string) error {
 		return Error.Wrap(err)
 	}
 
+	serviceCfg, verifyCfg := rangeCfg.Service, rangeCfg.Verify
+	switch cmd.Name() {
+	case "buckets":
+		serviceCfg, verifyCfg = bucketsCfg.Service, bucketsCfg.Verify
+	case "scope":
+		serviceCfg, verifyCfg = scopeCfg.Service, scopeCfg.Verify
+	}
+
 	// setup verifier
-	verifier := NewVerifier(log.Named("verifier"), dialer, ordersService, rangeCfg.Verify)
-	service, err := NewService(log.Named("service"), metabaseDB, verifier, overlay, rangeCfg.Service)
+	verifier := NewVerifier(log.Named("verifier"), dialer, ordersService, verifyCfg)
+	service, err := NewService(log.Named("service"), metabaseDB, verifier, overlay, serviceCfg)
 	if err != nil {
 		return Error.Wrap(err)
 	}
 	verifier.reportPiece = service.reportPiece
 	defer func() { err = errs.Combine(err, service.Close()) }()
-	if cmd.Name() == "range" {
-		return verifySegmentsRange(ctx, service, rangeCfg)
+	if serviceCfg.RepairEnqueue {
+		reliableNodes := checker.NewReliabilityCache(overlay, satelliteCfg.Checker.ReliabilityCacheStaleness)
+		service.SetRepairQueue(db.RepairQueue(), reliableNodes, satelliteCfg.Checker.RepairOverrides.GetMap(), satelliteCfg.Checker.NodeFailureRate)
 	}
-	if cmd.Name() == "buckets" {
+	switch cmd.Name() {
+	case "range":
+		return verifySegmentsRange(ctx, service, rangeCfg)
+	case "buckets":
 		return verifySegmentsBuckets(ctx, service, bucketsCfg)
+	case "scope":
+		return verifySegmentsScope(ctx, service, scopeCfg)
 	}
 	return errors.New("unknown commnand: " + cmd.Name())
 }
@@ -247,6 +286,25 @@ func verifySegmentsBuckets(ctx context.Context, service *Service, bucketCfg Buck
 	return service.ProcessBuckets(ctx, bucketList.Buckets)
 }
 
+func verifySegmentsScope(ctx context.Context, service *Service, scopeCfg ScopeConfig) error {
+	if scopeCfg.ProjectID == "" {
+		return Error.New("project id not specified")
+	}
+	if scopeCfg.Prefix != "" && scopeCfg.Bucket == "" {
+		return Error.New("prefix requires bucket to be specified")
+	}
+
+	projectID, err := uuid.FromString(scopeCfg.ProjectID)
+	if err != nil {
+		projectID, err = projectIdFromCompactString(scopeCfg.ProjectID)
+		if err != nil {
+			return Error.New("invalid project id %q", scopeCfg.ProjectID)
+		}
+	}
+
+	return service.ProcessScope(ctx, projectID, scopeCfg.Bucket, metabase.ObjectKey(scopeCfg.Prefix))
+}
+
 func main() {
 	process.Exec(rootCmd)
 }
@@ -30,3 +30,10 @@ func (set NodeAliasSet) RemoveAll(xs NodeAliasSet) {
 		delete(set, x)
 	}
 }
+
+// AddAll adds xs to the set.
+func (set NodeAliasSet) AddAll(xs NodeAliasSet) {
+	for x := range xs {
+		set[x] = struct{}{}
+	}
+}
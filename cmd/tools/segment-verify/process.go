@@ -23,6 +23,10 @@ func (service *Service) Verify(ctx context.Context, segments []*Segment) (err er
 		if retryCount == 0 {
 			retryCount = len(segment.AliasPieces)
 		}
+		if service.targetNodes != nil {
+			// all the pieces on the target nodes need to be checked.
+			retryCount = service.countTargetPieces(segment)
+		}
 		segment.Status.Retry = int32(retryCount)
 	}
 
@@ -92,15 +96,18 @@ func (service *Service) VerifyBatches(ctx context.Context, batches []*Batch) err
 
 		limiter.Go(ctx, func() {
 			verifiedCount, err := service.verifier.Verify(ctx, batch.Alias, info.NodeURL, info.Version, batch.Items, ignoreThrottle)
+			service.progress.RecordBatch(info.NodeURL.ID, verifiedCount, ErrNodeOffline.Has(err))
 			if err != nil {
 				if ErrNodeOffline.Has(err) {
 					mu.Lock()
 					if verifiedCount == 0 {
 						service.onlineNodes.Remove(batch.Alias)
+						service.offlineNodes.Add(batch.Alias)
 					} else {
 						service.offlineCount[batch.Alias]++
 						if service.config.MaxOffline > 0 && service.offlineCount[batch.Alias] >= service.config.MaxOffline {
 							service.onlineNodes.Remove(batch.Alias)
+							service.offlineNodes.Add(batch.Alias)
 						}
 					}
 					mu.Unlock()
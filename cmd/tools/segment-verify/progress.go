@@ -0,0 +1,269 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"encoding/binary"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/audit"
+)
+
+// Progress tracks the global and per-node progress of a verification run.
+type Progress struct {
+	mu    sync.Mutex
+	nowFn func() time.Time
+
+	start   time.Time
+	lastLog time.Time
+
+	verified  int64
+	notFound  int64
+	corrupted int64
+
+	// low, high and cursor are used to estimate the remaining time,
+	// when verifying a range.
+	low, high, cursor uint64
+	hasRange          bool
+
+	nodes map[storj.NodeID]*NodeProgress
+}
+
+// NodeProgress contains the verification statistics of a single node.
+type NodeProgress struct {
+	Verified        int64
+	NotFound        int64
+	Corrupted       int64
+	Failed          int64
+	OfflineBatches  int64
+	VerifiedBatches int64
+}
+
+// NewProgress creates a new progress tracker.
+func NewProgress() *Progress {
+	now := time.Now()
+	return &Progress{
+		nowFn:   time.Now,
+		start:   now,
+		lastLog: now,
+		nodes:   map[storj.NodeID]*NodeProgress{},
+	}
+}
+
+// SetRange sets the range being verified, which enables estimating the remaining time.
+// Stream IDs are random, hence the cursor position within the range is a good
+// estimate of the work done.
+func (progress *Progress) SetRange(low, high uuid.UUID) {
+	progress.mu.Lock()
+	defer progress.mu.Unlock()
+
+	progress.low = binary.BigEndian.Uint64(low[:8])
+	progress.high = binary.BigEndian.Uint64(high[:8])
+	progress.cursor = progress.low
+	progress.hasRange = progress.low < progress.high
+}
+
+// SetCursor updates the position of the range verification.
+func (progress *Progress) SetCursor(cursor uuid.UUID) {
+	progress.mu.Lock()
+	defer progress.mu.Unlock()
+
+	progress.cursor = binary.BigEndian.Uint64(cursor[:8])
+}
+
+// RecordSegments records the outcome of verifying a batch of segments.
+func (progress *Progress) RecordSegments(segments []*Segment) {
+	progress.mu.Lock()
+	defer progress.mu.Unlock()
+
+	for _, segment := range segments {
+		progress.verified++
+		if segment.Status.NotFound > 0 {
+			progress.notFound++
+		}
+		if segment.Status.Corrupted > 0 {
+			progress.corrupted++
+		}
+	}
+
+	mon.Meter("segments_verified").Mark(len(segments))
+	mon.IntVal("segments_verified_total").Observe(progress.verified)
+	mon.IntVal("segments_not_found_total").Observe(progress.notFound)
+	mon.IntVal("segments_corrupted_total").Observe(progress.corrupted)
+}
+
+// RecordBatch records the result of verifying a batch on a node.
+func (progress *Progress) RecordBatch(nodeID storj.NodeID, verifiedCount int, offline bool) {
+	progress.mu.Lock()
+	defer progress.mu.Unlock()
+
+	node := progress.node(nodeID)
+	node.Verified += int64(verifiedCount)
+	if offline {
+		node.OfflineBatches++
+	} else {
+		node.VerifiedBatches++
+	}
+}
+
+// RecordPiece records a piece that was not successfully verified.
+func (progress *Progress) RecordPiece(nodeID storj.NodeID, outcome audit.Outcome) {
+	progress.mu.Lock()
+	defer progress.mu.Unlock()
+
+	node := progress.node(nodeID)
+	switch outcome {
+	case audit.OutcomeSuccess:
+	case audit.OutcomeFailure:
+		node.NotFound++
+	case outcomeHashMismatch:
+		node.Corrupted++
+	default:
+		node.Failed++
+	}
+}
+
+func (progress *Progress) node(nodeID storj.NodeID) *NodeProgress {
+	node, ok := progress.nodes[nodeID]
+	if !ok {
+		node = &NodeProgress{}
+		progress.nodes[nodeID] = node
+	}
+	return node
+}
+
+// Nodes returns a copy of the per-node statistics.
+func (progress *Progress) Nodes() map[storj.NodeID]NodeProgress {
+	progress.mu.Lock()
+	defer progress.mu.Unlock()
+
+	nodes := make(map[storj.NodeID]NodeProgress, len(progress.nodes))
+	for id, node := range progress.nodes {
+		nodes[id] = *node
+	}
+	return nodes
+}
+
+// ETA estimates the remaining duration of the range verification.
+// It returns false when there is not enough information for an estimate.
+func (progress *Progress) ETA() (time.Duration, bool) {
+	progress.mu.Lock()
+	defer progress.mu.Unlock()
+
+	return progress.eta(progress.nowFn())
+}
+
+func (progress *Progress) eta(now time.Time) (time.Duration, bool) {
+	if !progress.hasRange || progress.cursor <= progress.low {
+		return 0, false
+	}
+
+	done := float64(progress.cursor-progress.low) / float64(progress.high-progress.low)
+	if done >= 1 {
+		return 0, true
+	}
+
+	elapsed := now.Sub(progress.start)
+	return time.Duration(float64(elapsed) * (1 - done) / done), true
+}
+
+// Log logs the progress, when at least interval has passed since the last log.
+func (progress *Progress) Log(log *zap.Logger, interval time.Duration, onlineNodes, offlineNodes int) {
+	progress.mu.Lock()
+	defer progress.mu.Unlock()
+
+	now := progress.nowFn()
+	if now.Sub(progress.lastLog) < interval {
+		return
+	}
+	progress.lastLog = now
+
+	mon.IntVal("nodes_online").Observe(int64(onlineNodes))
+	mon.IntVal("nodes_offline").Observe(int64(offlineNodes))
+
+	elapsed := now.Sub(progress.start)
+	rate := float64(progress.verified) / elapsed.Seconds()
+
+	fields := []zap.Field{
+		zap.Int64("verified", progress.verified),
+		zap.Int64("not-found", progress.notFound),
+		zap.Int64("corrupted", progress.corrupted),
+		zap.Float64("segments-per-second", rate),
+		zap.Int("nodes-online", onlineNodes),
+		zap.Int("nodes-offline", offlineNodes),
+		zap.Duration("elapsed", elapsed),
+	}
+	if eta, ok := progress.eta(now); ok {
+		mon.IntVal("eta_seconds").Observe(int64(eta.Seconds()))
+		fields = append(fields, zap.Duration("eta", eta))
+	}
+
+	log.Info("verification progress", fields...)
+}
+
+// WriteNodeSummary writes the per-node statistics as csv, sorted by node ID.
+func (progress *Progress) WriteNodeSummary(w io.Writer) error {
+	nodes := progress.Nodes()
+
+	ids := make([]storj.NodeID, 0, len(nodes))
+	for id := range nodes {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, k int) bool { return ids[i].Less(ids[k]) })
+
+	wr := csv.NewWriter(w)
+	err := wr.Write([]string{
+		"node id",
+		"verified",
+		"not found",
+		"corrupted",
+		"failed",
+		"verified batches",
+		"offline batches",
+	})
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	for _, id := range ids {
+		node := nodes[id]
+		err := wr.Write([]string{
+			id.String(),
+			fmt.Sprint(node.Verified),
+			fmt.Sprint(node.NotFound),
+			fmt.Sprint(node.Corrupted),
+			fmt.Sprint(node.Failed),
+			fmt.Sprint(node.VerifiedBatches),
+			fmt.Sprint(node.OfflineBatches),
+		})
+		if err != nil {
+			return Error.Wrap(err)
+		}
+	}
+
+	wr.Flush()
+	return Error.Wrap(wr.Error())
+}
+
+// writeNodeSummaryFile writes the per-node statistics to the specified path.
+func (progress *Progress) writeNodeSummaryFile(path string) (err error) {
+	f, err := os.Create(path)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, f.Close()) }()
+
+	return progress.WriteNodeSummary(f)
+}
@@ -0,0 +1,111 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/repair"
+	"storj.io/storj/satellite/repair/checker"
+	"storj.io/storj/satellite/repair/queue"
+)
+
+// RepairQueue is used to enqueue damaged segments for repair.
+type RepairQueue interface {
+	Insert(ctx context.Context, s *queue.InjuredSegment) (alreadyInserted bool, err error)
+}
+
+// ReliableNodes tells which pieces are on unreliable nodes, i.e. nodes that
+// are offline, disqualified or exited. It's implemented by
+// checker.ReliabilityCache.
+type ReliableNodes interface {
+	NumNodes(ctx context.Context) (int, error)
+	MissingPieces(ctx context.Context, created time.Time, pieces metabase.Pieces) ([]metabase.Piece, error)
+}
+
+// SetRepairQueue enables inserting segments, which are found below the repair
+// threshold, into the repair queue.
+func (service *Service) SetRepairQueue(repairQueue RepairQueue, reliable ReliableNodes, overrides checker.RepairOverridesMap, nodeFailureRate float64) {
+	service.repairQueue = repairQueue
+	service.reliableNodes = reliable
+	service.repairOverrides = overrides
+	service.nodeFailureRate = nodeFailureRate
+}
+
+// EnqueueRepair inserts the damaged segments that are below the repair threshold into
+// the repair queue. The health is computed the same way as by the repair
+// checker, except that the pieces, which the verifier found missing or
+// corrupted, aren't counted as healthy either.
+func (service *Service) EnqueueRepair(ctx context.Context, damaged []*Segment) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if service.repairQueue == nil || len(damaged) == 0 {
+		return nil
+	}
+
+	totalNodes, err := service.reliableNodes.NumNodes(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	for _, seg := range damaged {
+		segment, err := service.metabase.GetSegmentByPosition(ctx, metabase.GetSegmentByPosition{
+			StreamID: seg.StreamID,
+			Position: seg.Position,
+		})
+		if err != nil {
+			if metabase.ErrSegmentNotFound.Has(err) {
+				continue
+			}
+			return Error.Wrap(err)
+		}
+
+		unreliable, err := service.reliableNodes.MissingPieces(ctx, segment.CreatedAt, segment.Pieces)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+
+		// The verifier only contacts online nodes, so the pieces it found
+		// missing or corrupted aren't on the unreliable nodes.
+		numHealthy := len(segment.Pieces) - len(unreliable) - int(seg.Status.NotFound) - int(seg.Status.Corrupted)
+		if numHealthy < 0 {
+			numHealthy = 0
+		}
+
+		repairThreshold := int(segment.Redundancy.RepairShares)
+		if override := service.repairOverrides.GetOverrideValue(segment.Redundancy, segment.Placement); override != 0 {
+			repairThreshold = int(override)
+		}
+		if numHealthy > repairThreshold || numHealthy >= int(segment.Redundancy.OptimalShares) {
+			continue
+		}
+
+		health := repair.SegmentHealth(numHealthy, int(segment.Redundancy.RequiredShares), totalNodes, service.nodeFailureRate)
+
+		alreadyInserted, err := service.repairQueue.Insert(ctx, &queue.InjuredSegment{
+			StreamID:      seg.StreamID,
+			Position:      seg.Position,
+			UpdatedAt:     time.Now().UTC(),
+			SegmentHealth: health,
+		})
+		if err != nil {
+			return Error.Wrap(err)
+		}
+
+		service.log.Info("segment enqueued for repair",
+			zap.Stringer("stream-id", seg.StreamID),
+			zap.Uint64("position", seg.Position.Encode()),
+			zap.Int("healthy", numHealthy),
+			zap.Int("unreliable", len(unreliable)),
+			zap.Int("repair-threshold", repairThreshold),
+			zap.Float64("health", health),
+			zap.Bool("already-inserted", alreadyInserted))
+	}
+
+	return nil
+}
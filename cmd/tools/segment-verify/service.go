@@ -6,7 +6,9 @@ package main
 import (
 	"context"
 	"fmt"
+	"io"
 	"os"
+	"strconv"
 	"strings"
 	"sync/atomic"
 	"time"
@@ -20,6 +22,7 @@ import (
 	"storj.io/storj/satellite/audit"
 	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/overlay"
+	"storj.io/storj/satellite/repair/checker"
 )
 
 var mon = monkit.Package()
@@ -33,6 +36,7 @@ type Metabase interface {
 	GetSegmentByPosition(ctx context.Context, opts metabase.GetSegmentByPosition) (segment metabase.Segment, err error)
 	ListVerifySegments(ctx context.Context, opts metabase.ListVerifySegments) (result metabase.ListVerifySegmentsResult, err error)
 	ListBucketsStreamIDs(ctx context.Context, opts metabase.ListBucketsStreamIDs) (result metabase.ListBucketsStreamIDsResult, err error)
+	ListScopeStreamIDs(ctx context.Context, opts metabase.ListScopeStreamIDs) (result metabase.ListScopeStreamIDsResult, err error)
 }
 
 // Verifier verifies a batch of segments.
@@ -60,6 +64,15 @@ type ServiceConfig struct {
 	ProblemPiecesPath string `help:"pieces that could not be fetched successfully" default:"problem-pieces.csv"`
 	PriorityNodesPath string `help:"list of priority node ID-s" default:""`
 	IgnoreNodesPath   string `help:"list of nodes to ignore" default:""`
+	TargetNodes       string `help:"comma separated node ID-s or aliases, only pieces on these nodes are verified (if empty, verify all nodes)" default:""`
+	ExcludeNodes      string `help:"comma separated node ID-s or aliases to exclude from verification" default:""`
+	CheckpointPath    string `help:"file for persisting progress, which allows resuming an interrupted run" default:""`
+	OutputFormat      string `help:"format of the results, csv or ndjson" default:"csv"`
+	FindingsPath      string `help:"classified findings, when using ndjson output format" default:"segments-findings.ndjson"`
+	RepairEnqueue     bool   `help:"insert segments found below the repair threshold into the repair queue" default:"false"`
+	NodeSummaryPath   string `help:"per-node verification statistics, written at the end of the run" default:"node-summary.csv"`
+
+	ProgressInterval time.Duration `help:"how often to log the verification progress" default:"1m"`
 
 	Check       int `help:"how many storagenodes to query per segment (if 0, query all)" default:"3"`
 	BatchSize   int `help:"number of segments to process per batch" default:"10000"`
@@ -83,19 +96,35 @@ type Service struct {
 
 	notFound SegmentWriter
 	retry    SegmentWriter
+	// deleted is nil when segments deleted during verification are not reported.
+	deleted SegmentWriter
+	closers []io.Closer
 
 	metabase Metabase
 	verifier Verifier
 	overlay  Overlay
 
-	aliasMap        *metabase.NodeAliasMap
-	aliasToNodeURL  map[metabase.NodeAlias]storj.NodeURL
-	priorityNodes   NodeAliasSet
-	onlineNodes     NodeAliasSet
+	aliasMap       *metabase.NodeAliasMap
+	aliasToNodeURL map[metabase.NodeAlias]storj.NodeURL
+	priorityNodes  NodeAliasSet
+	onlineNodes    NodeAliasSet
+	// targetNodes is nil when pieces on all nodes are verified.
+	targetNodes     NodeAliasSet
 	offlineCount    map[metabase.NodeAlias]int
+	offlineNodes    NodeAliasSet
 	bucketList      BucketList
 	nodesVersionMap map[metabase.NodeAlias]string
 
+	// checkpoint is nil when progress is not persisted.
+	checkpoint *Checkpoint
+	progress   *Progress
+
+	// repairQueue is nil when damaged segments are not enqueued for repair.
+	repairQueue     RepairQueue
+	reliableNodes   ReliableNodes
+	repairOverrides checker.RepairOverridesMap
+	nodeFailureRate float64
+
 	// this is a callback so that problematic pieces can be reported as they are found,
 	// rather than being kept in a list which might grow unreasonably large.
 	reportPiece pieceReporterFunc
@@ -103,29 +132,22 @@ type Service struct {
 
 // NewService returns a new service for verifying segments.
 func NewService(log *zap.Logger, metabaseDB Metabase, verifier Verifier, overlay Overlay, config ServiceConfig) (*Service, error) {
-	notFound, err := NewCSVWriter(config.NotFoundPath)
-	if err != nil {
-		return nil, Error.Wrap(err)
-	}
-
-	retry, err := NewCSVWriter(config.RetryPath)
-	if err != nil {
-		return nil, errs.Combine(Error.Wrap(err), notFound.Close())
-	}
-
-	problemPieces, err := newPieceCSVWriter(config.ProblemPiecesPath)
-	if err != nil {
-		return nil, errs.Combine(Error.Wrap(err), retry.Close(), notFound.Close())
+	var checkpoint *Checkpoint
+	resume := false
+	if config.CheckpointPath != "" {
+		var err error
+		checkpoint, err = LoadCheckpoint(config.CheckpointPath)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		// keep the results from the previous run
+		resume = !checkpoint.IsEmpty()
 	}
-	defer func() { _ = problemPieces.Close() }()
 
-	return &Service{
+	service := &Service{
 		log:    log,
 		config: config,
 
-		notFound: notFound,
-		retry:    retry,
-
 		metabase: metabaseDB,
 		verifier: verifier,
 		overlay:  overlay,
@@ -134,18 +156,102 @@ func NewService(log *zap.Logger, metabaseDB Metabase, verifier Verifier, overlay
 		priorityNodes:   NodeAliasSet{},
 		onlineNodes:     NodeAliasSet{},
 		offlineCount:    map[metabase.NodeAlias]int{},
+		offlineNodes:    NodeAliasSet{},
 		nodesVersionMap: map[metabase.NodeAlias]string{},
 
-		reportPiece: problemPieces.Write,
-	}, nil
+		checkpoint: checkpoint,
+		progress:   NewProgress(),
+	}
+
+	switch config.OutputFormat {
+	case "", "csv":
+		notFound, err := NewCSVWriter(config.NotFoundPath, resume)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+
+		retry, err := NewCSVWriter(config.RetryPath, resume)
+		if err != nil {
+			return nil, errs.Combine(Error.Wrap(err), notFound.Close())
+		}
+
+		problemPieces, err := newPieceCSVWriter(config.ProblemPiecesPath, resume)
+		if err != nil {
+			return nil, errs.Combine(Error.Wrap(err), retry.Close(), notFound.Close())
+		}
+
+		service.notFound = notFound
+		service.retry = retry
+		service.closers = []io.Closer{notFound, retry, problemPieces}
+		service.reportPiece = problemPieces.Write
+	case "ndjson":
+		findings, err := NewFindingWriter(config.FindingsPath, resume)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+
+		service.notFound = findings.SegmentWriter(FindingSegmentMissingPieces)
+		service.retry = findings.SegmentWriter(FindingSegmentUnverified)
+		service.deleted = findings.SegmentWriter(FindingStreamMissing)
+		service.closers = []io.Closer{findings}
+		service.reportPiece = findings.ReportPiece
+	default:
+		return nil, Error.New("unknown output format %q", config.OutputFormat)
+	}
+
+	reportPiece := service.reportPiece
+	service.reportPiece = func(ctx context.Context, segment *metabase.VerifySegment, nodeID storj.NodeID, pieceNum int, outcome audit.Outcome) error {
+		service.progress.RecordPiece(nodeID, outcome)
+		return reportPiece(ctx, segment, nodeID, pieceNum, outcome)
+	}
+
+	return service, nil
 }
 
-// Close closes the outputs from the service.
+// Close closes the outputs from the service and writes the per-node summary.
 func (service *Service) Close() error {
-	return Error.Wrap(errs.Combine(
-		service.notFound.Close(),
-		service.retry.Close(),
-	))
+	// always log the final totals
+	service.progress.Log(service.log, 0, len(service.onlineNodes), len(service.offlineNodes))
+
+	var group errs.Group
+	if service.config.NodeSummaryPath != "" {
+		group.Add(service.progress.writeNodeSummaryFile(service.config.NodeSummaryPath))
+	}
+	for _, closer := range service.closers {
+		group.Add(closer.Close())
+	}
+	return Error.Wrap(group.Err())
+}
+
+// loadNodes loads the node alias map and the online, priority, ignored and target nodes.
+func (service *Service) loadNodes(ctx context.Context) (err error) {
+	aliasMap, err := service.metabase.LatestNodesAliasMap(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	service.aliasMap = aliasMap
+
+	err = service.loadOnlineNodes(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	err = service.loadPriorityNodes(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	err = service.applyIgnoreNodes(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	err = service.loadTargetNodes(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	return nil
 }
 
 // loadOnlineNodes loads the list of online nodes.
@@ -195,13 +301,22 @@ func (service *Service) loadPriorityNodes(ctx context.Context) (err error) {
 
 // applyIgnoreNodes loads the list of nodes to ignore completely and modifies priority and online nodes.
 func (service *Service) applyIgnoreNodes(ctx context.Context) (err error) {
-	if service.config.IgnoreNodesPath == "" {
-		return nil
+	ignoreNodes := NodeAliasSet{}
+
+	if service.config.IgnoreNodesPath != "" {
+		fromFile, err := service.parseNodeFile(service.config.IgnoreNodesPath)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		ignoreNodes.AddAll(fromFile)
 	}
 
-	ignoreNodes, err := service.parseNodeFile(service.config.IgnoreNodesPath)
-	if err != nil {
-		return Error.Wrap(err)
+	if service.config.ExcludeNodes != "" {
+		excluded, err := service.parseNodeList(service.config.ExcludeNodes)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		ignoreNodes.AddAll(excluded)
 	}
 
 	service.onlineNodes.RemoveAll(ignoreNodes)
@@ -210,7 +325,23 @@ func (service *Service) applyIgnoreNodes(ctx context.Context) (err error) {
 	return nil
 }
 
-// parseNodeFile parses a file containing node ID-s.
+// loadTargetNodes loads the list of nodes to restrict the verification to.
+func (service *Service) loadTargetNodes(ctx context.Context) (err error) {
+	if service.config.TargetNodes == "" {
+		return nil
+	}
+
+	service.targetNodes, err = service.parseNodeList(service.config.TargetNodes)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if len(service.targetNodes) == 0 {
+		return Error.New("none of the target nodes hold any data")
+	}
+	return nil
+}
+
+// parseNodeFile parses a file containing node ID-s or aliases.
 func (service *Service) parseNodeFile(path string) (NodeAliasSet, error) {
 	set := NodeAliasSet{}
 	data, err := os.ReadFile(path)
@@ -224,23 +355,63 @@ func (service *Service) parseNodeFile(path string) (NodeAliasSet, error) {
 			continue
 		}
 
-		nodeID, err := storj.NodeIDFromString(line)
+		alias, ok, err := service.parseNode(line)
 		if err != nil {
 			return set, Error.Wrap(err)
 		}
+		if ok {
+			set.Add(alias)
+		}
+	}
 
-		alias, ok := service.aliasMap.Alias(nodeID)
-		if !ok {
-			service.log.Info("node ID not used", zap.Stringer("node id", nodeID), zap.Error(err))
+	return set, nil
+}
+
+// parseNodeList parses a comma separated list of node ID-s or aliases.
+func (service *Service) parseNodeList(list string) (NodeAliasSet, error) {
+	set := NodeAliasSet{}
+	for _, value := range strings.Split(list, ",") {
+		value = strings.TrimSpace(value)
+		if value == "" {
 			continue
 		}
 
-		set.Add(alias)
+		alias, ok, err := service.parseNode(value)
+		if err != nil {
+			return set, Error.Wrap(err)
+		}
+		if ok {
+			set.Add(alias)
+		}
 	}
-
 	return set, nil
 }
 
+// parseNode parses a node ID or a node alias. It returns false when
+// the node does not hold any data.
+func (service *Service) parseNode(value string) (_ metabase.NodeAlias, ok bool, err error) {
+	if v, err := strconv.ParseInt(value, 10, 32); err == nil {
+		alias := metabase.NodeAlias(v)
+		if _, ok := service.aliasMap.Node(alias); !ok {
+			service.log.Info("node alias not used", zap.Int32("node alias", int32(alias)))
+			return 0, false, nil
+		}
+		return alias, true, nil
+	}
+
+	nodeID, err := storj.NodeIDFromString(value)
+	if err != nil {
+		return 0, false, Error.Wrap(err)
+	}
+
+	alias, ok := service.aliasMap.Alias(nodeID)
+	if !ok {
+		service.log.Info("node ID not used", zap.Stringer("node id", nodeID))
+		return 0, false, nil
+	}
+	return alias, true, nil
+}
+
 // BucketList contains a list of buckets to check segments from.
 type BucketList struct {
 	Buckets []metabase.BucketLocation
@@ -258,26 +429,13 @@ func (list *BucketList) Add(projectID uuid.UUID, bucketName string) {
 func (service *Service) ProcessRange(ctx context.Context, low, high uuid.UUID) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	aliasMap, err := service.metabase.LatestNodesAliasMap(ctx)
-	if err != nil {
-		return Error.Wrap(err)
-	}
-	service.aliasMap = aliasMap
-
-	err = service.loadOnlineNodes(ctx)
+	err = service.loadNodes(ctx)
 	if err != nil {
 		return Error.Wrap(err)
 	}
 
-	err = service.loadPriorityNodes(ctx)
-	if err != nil {
-		return Error.Wrap(err)
-	}
-
-	err = service.applyIgnoreNodes(ctx)
-	if err != nil {
-		return Error.Wrap(err)
-	}
+	service.restoreNodeState()
+	service.progress.SetRange(low, high)
 
 	cursorStreamID := low
 	var cursorPosition metabase.SegmentPosition
@@ -287,6 +445,24 @@ func (service *Service) ProcessRange(ctx context.Context, low, high uuid.UUID) (
 	}
 
 	var progress int64
+
+	var rangeProgress *RangeProgress
+	if service.checkpoint != nil {
+		rangeProgress = service.checkpoint.Range(low, high)
+		if rangeProgress.Done {
+			service.log.Info("range already verified", zap.Stringer("low", low), zap.Stringer("high", high))
+			return nil
+		}
+		if rangeProgress.Processed > 0 {
+			cursorStreamID, cursorPosition = rangeProgress.Cursor()
+			progress = rangeProgress.Processed
+			service.progress.SetCursor(cursorStreamID)
+			service.log.Info("resuming range",
+				zap.Int64("progress", progress),
+				zap.Stringer("cursor", cursorStreamID))
+		}
+	}
+
 	for {
 		result, err := service.metabase.ListVerifySegments(ctx, metabase.ListVerifySegments{
 			CursorStreamID: cursorStreamID,
@@ -308,6 +484,10 @@ func (service *Service) ProcessRange(ctx context.Context, low, high uuid.UUID) (
 
 		// All done?
 		if len(verifySegments) == 0 {
+			if rangeProgress != nil {
+				rangeProgress.Done = true
+				return service.saveCheckpoint()
+			}
 			return nil
 		}
 
@@ -335,30 +515,66 @@ func (service *Service) ProcessRange(ctx context.Context, low, high uuid.UUID) (
 		if err != nil {
 			return Error.Wrap(err)
 		}
+
+		service.progress.SetCursor(cursorStreamID)
+
+		if rangeProgress != nil {
+			rangeProgress.Advance(cursorStreamID, cursorPosition, len(segments))
+			if err := service.saveCheckpoint(); err != nil {
+				return Error.Wrap(err)
+			}
+		}
 	}
 }
 
-// ProcessBuckets processes segments in buckets with the specified batchSize.
-func (service *Service) ProcessBuckets(ctx context.Context, buckets []metabase.BucketLocation) (err error) {
-	defer mon.Task()(&ctx)(&err)
-
-	aliasMap, err := service.metabase.LatestNodesAliasMap(ctx)
-	if err != nil {
-		return Error.Wrap(err)
+// restoreNodeState applies the node retry state from the checkpoint.
+func (service *Service) restoreNodeState() {
+	if service.checkpoint == nil {
+		return
 	}
-	service.aliasMap = aliasMap
 
-	err = service.loadOnlineNodes(ctx)
-	if err != nil {
-		return Error.Wrap(err)
+	for nodeID, state := range service.checkpoint.Nodes {
+		alias, ok := service.aliasMap.Alias(nodeID)
+		if !ok {
+			continue
+		}
+		if state.OfflineCount > 0 {
+			service.offlineCount[alias] = state.OfflineCount
+		}
+		if state.Offline {
+			service.offlineNodes.Add(alias)
+			service.onlineNodes.Remove(alias)
+		}
 	}
+}
 
-	err = service.loadPriorityNodes(ctx)
-	if err != nil {
-		return Error.Wrap(err)
+// saveCheckpoint persists the progress and node retry state.
+func (service *Service) saveCheckpoint() error {
+	nodes := map[storj.NodeID]NodeState{}
+	for alias, count := range service.offlineCount {
+		if nodeID, ok := service.aliasMap.Node(alias); ok && count > 0 {
+			state := nodes[nodeID]
+			state.OfflineCount = count
+			nodes[nodeID] = state
+		}
 	}
+	for alias := range service.offlineNodes {
+		if nodeID, ok := service.aliasMap.Node(alias); ok {
+			state := nodes[nodeID]
+			state.Offline = true
+			nodes[nodeID] = state
+		}
+	}
+	service.checkpoint.Nodes = nodes
 
-	err = service.applyIgnoreNodes(ctx)
+	return Error.Wrap(service.checkpoint.Save())
+}
+
+// ProcessBuckets processes segments in buckets with the specified batchSize.
+func (service *Service) ProcessBuckets(ctx context.Context, buckets []metabase.BucketLocation) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = service.loadNodes(ctx)
 	if err != nil {
 		return Error.Wrap(err)
 	}
@@ -439,23 +655,111 @@ func (service *Service) ProcessBuckets(ctx context.Context, buckets []metabase.B
 	}
 }
 
+// ProcessScope processes segments of objects within a project, optionally
+// limited to a bucket and an object key prefix.
+func (service *Service) ProcessScope(ctx context.Context, projectID uuid.UUID, bucketName string, prefix metabase.ObjectKey) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = service.loadNodes(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	var progress int64
+
+	var cursor metabase.ListScopeStreamIDsCursor
+	for {
+		streamIDs, err := service.metabase.ListScopeStreamIDs(ctx, metabase.ListScopeStreamIDs{
+			ProjectID:  projectID,
+			BucketName: bucketName,
+			Prefix:     prefix,
+			Cursor:     cursor,
+			Limit:      service.config.BatchSize,
+
+			AsOfSystemInterval: service.config.AsOfSystemInterval,
+		})
+		if err != nil {
+			return Error.Wrap(err)
+		}
+
+		// All done?
+		if len(streamIDs.StreamIDs) == 0 {
+			return nil
+		}
+		cursor = streamIDs.Cursor
+
+		var cursorStreamID uuid.UUID
+		var cursorPosition metabase.SegmentPosition
+		for {
+			result, err := service.metabase.ListVerifySegments(ctx, metabase.ListVerifySegments{
+				StreamIDs:      streamIDs.StreamIDs,
+				CursorStreamID: cursorStreamID,
+				CursorPosition: cursorPosition,
+				Limit:          service.config.BatchSize,
+
+				AsOfSystemInterval: service.config.AsOfSystemInterval,
+			})
+			if err != nil {
+				return Error.Wrap(err)
+			}
+
+			if len(result.Segments) == 0 {
+				break
+			}
+
+			last := &result.Segments[len(result.Segments)-1]
+			cursorStreamID, cursorPosition = last.StreamID, last.Position
+
+			// Convert to struct that contains the status.
+			segmentsData := make([]Segment, len(result.Segments))
+			segments := make([]*Segment, len(result.Segments))
+			for i := range segments {
+				segmentsData[i].VerifySegment = result.Segments[i]
+				segments[i] = &segmentsData[i]
+			}
+
+			service.log.Info("processing segments",
+				zap.Int64("progress", progress),
+				zap.Int("count", len(segments)),
+				zap.Stringer("first", segments[0].StreamID),
+				zap.Stringer("last", segments[len(segments)-1].StreamID),
+			)
+			progress += int64(len(segments))
+
+			// Process the data.
+			err = service.ProcessSegments(ctx, segments)
+			if err != nil {
+				return Error.Wrap(err)
+			}
+		}
+	}
+}
+
 // ProcessSegments processes a collection of segments.
 func (service *Service) ProcessSegments(ctx context.Context, segments []*Segment) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	segments = service.selectTargetSegments(segments)
+	if len(segments) == 0 {
+		return nil
+	}
+
 	// Verify all the segments against storage nodes.
 	err = service.Verify(ctx, segments)
 	if err != nil {
 		return Error.Wrap(err)
 	}
 
+	service.progress.RecordSegments(segments)
+	service.progress.Log(service.log, service.config.ProgressInterval, len(service.onlineNodes), len(service.offlineNodes))
+
 	notFound := []*Segment{}
 	retry := []*Segment{}
 
 	// Find out which of the segments we did not find
 	// or there was some other failure.
 	for _, segment := range segments {
-		if segment.Status.NotFound > 0 {
+		if segment.Status.NotFound > 0 || segment.Status.Corrupted > 0 {
 			notFound = append(notFound, segment)
 		} else if (service.config.Check > 0 && segment.Status.Retry > 0) || segment.Status.Retry > 5 {
 			retry = append(retry, segment)
@@ -465,11 +769,12 @@ func (service *Service) ProcessSegments(ctx context.Context, segments []*Segment
 	// Some segments might have been deleted during the
 	// processing, so cross-reference and remove any deleted
 	// segments from the list.
-	notFound, err = service.RemoveDeleted(ctx, notFound)
+	var deleted []*Segment
+	notFound, deleted, err = service.removeDeleted(ctx, notFound, deleted)
 	if err != nil {
 		return Error.Wrap(err)
 	}
-	retry, err = service.RemoveDeleted(ctx, retry)
+	retry, deleted, err = service.removeDeleted(ctx, retry, deleted)
 	if err != nil {
 		return Error.Wrap(err)
 	}
@@ -478,12 +783,26 @@ func (service *Service) ProcessSegments(ctx context.Context, segments []*Segment
 	errNotFound := service.notFound.Write(ctx, notFound)
 	errRetry := service.retry.Write(ctx, retry)
 
-	return errs.Combine(errNotFound, errRetry)
+	var errDeleted error
+	if service.deleted != nil && len(deleted) > 0 {
+		errDeleted = service.deleted.Write(ctx, deleted)
+	}
+
+	errRepair := service.EnqueueRepair(ctx, notFound)
+
+	return errs.Combine(errNotFound, errRetry, errDeleted, errRepair)
 }
 
 // RemoveDeleted modifies the slice and returns only the segments that
 // still exist in the database.
 func (service *Service) RemoveDeleted(ctx context.Context, segments []*Segment) (_ []*Segment, err error) {
+	valid, _, err := service.removeDeleted(ctx, segments, nil)
+	return valid, err
+}
+
+// removeDeleted modifies the slice and returns only the segments that
+// still exist in the database. The deleted segments are appended to deleted.
+func (service *Service) removeDeleted(ctx context.Context, segments, deleted []*Segment) (_, _ []*Segment, err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	valid := segments[:0]
@@ -493,17 +812,18 @@ func (service *Service) RemoveDeleted(ctx context.Context, segments []*Segment)
 			Position: seg.Position,
 		})
 		if metabase.ErrSegmentNotFound.Has(err) {
+			deleted = append(deleted, seg)
 			continue
 		}
 		if err != nil {
 			service.log.Error("get segment by id failed", zap.Stringer("stream-id", seg.StreamID), zap.String("position", fmt.Sprint(seg.Position)))
 			if ctx.Err() != nil {
-				return valid, ctx.Err()
+				return valid, deleted, ctx.Err()
 			}
 		}
 		valid = append(valid, seg)
 	}
-	return valid, nil
+	return valid, deleted, nil
 }
 
 // Segment contains minimal information necessary for verifying a single Segment.
@@ -517,6 +837,8 @@ type Status struct {
 	Retry    int32
 	Found    int32
 	NotFound int32
+	// Corrupted counts pieces that were found, but failed full read verification.
+	Corrupted int32
 }
 
 // MarkFound moves a retry token from retry to found.
@@ -531,6 +853,12 @@ func (status *Status) MarkNotFound() {
 	atomic.AddInt32(&status.NotFound, 1)
 }
 
+// MarkCorrupted moves a found token to corrupted.
+func (status *Status) MarkCorrupted() {
+	atomic.AddInt32(&status.Found, -1)
+	atomic.AddInt32(&status.Corrupted, 1)
+}
+
 // Batch is a list of segments to be verified on a single node.
 type Batch struct {
 	Alias metabase.NodeAlias
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"os"
 	"sort"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -24,6 +25,8 @@ import (
 	"storj.io/storj/private/testplanet"
 	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/overlay"
+	"storj.io/storj/satellite/repair/checker"
+	"storj.io/storj/satellite/repair/queue"
 )
 
 var maxUUID = uuid.UUID{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
@@ -294,6 +297,394 @@ func TestService_Failures(t *testing.T) {
 		string(notFoundCSV))
 }
 
+func TestService_Checkpoint(t *testing.T) {
+	ctx := testcontext.New(t)
+	log := testplanet.NewLogger(t)
+
+	config := segmentverify.ServiceConfig{
+		NotFoundPath:      ctx.File("not-found.csv"),
+		RetryPath:         ctx.File("retry.csv"),
+		ProblemPiecesPath: ctx.File("problem-pieces.csv"),
+		CheckpointPath:    ctx.File("checkpoint.json"),
+
+		Check:       2,
+		BatchSize:   1,
+		Concurrency: 3,
+		MaxOffline:  2,
+	}
+
+	nodes := map[metabase.NodeAlias]storj.NodeID{}
+	for i := 1; i <= 0xFF; i++ {
+		nodes[metabase.NodeAlias(i)] = storj.NodeID{byte(i)}
+	}
+
+	segments := []metabase.VerifySegment{
+		{
+			StreamID:    uuid.UUID{0x10, 0x10},
+			AliasPieces: metabase.AliasPieces{{Number: 1, Alias: 8}, {Number: 3, Alias: 9}, {Number: 5, Alias: 10}},
+		},
+		{
+			StreamID:    uuid.UUID{0x20, 0x20},
+			AliasPieces: metabase.AliasPieces{{Number: 0, Alias: 2}, {Number: 1, Alias: 3}, {Number: 7, Alias: 4}},
+		},
+	}
+
+	// simulate an interrupted run, which processed the first segment
+	checkpoint, err := segmentverify.LoadCheckpoint(config.CheckpointPath)
+	require.NoError(t, err)
+	require.True(t, checkpoint.IsEmpty())
+	checkpoint.Range(uuid.UUID{}, maxUUID).Advance(segments[0].StreamID, segments[0].Position, 1)
+	checkpoint.Nodes[nodes[2]] = segmentverify.NodeState{Offline: true}
+	require.NoError(t, checkpoint.Save())
+
+	require.NoError(t, os.WriteFile(config.NotFoundPath, []byte(""+
+		"stream id,position,found,not found,retry\n"+
+		"10100000-0000-0000-0000-000000000000,0,0,2,0\n"), 0644))
+
+	func() {
+		metabase := newMetabaseMock(nodes, segments...)
+		verifier := &verifierMock{allSuccess: true}
+
+		service, err := segmentverify.NewService(log.Named("segment-verify"), metabase, verifier, metabase, config)
+		require.NoError(t, err)
+		defer ctx.Check(service.Close)
+
+		err = service.ProcessRange(ctx, uuid.UUID{}, maxUUID)
+		require.NoError(t, err)
+
+		// first segment was verified in the previous run
+		assert.Empty(t, verifier.processed[nodes[8]])
+		assert.Empty(t, verifier.processed[nodes[9]])
+		assert.Empty(t, verifier.processed[nodes[10]])
+		// node 2 was offline in the previous run
+		assert.Empty(t, verifier.processed[nodes[2]])
+		assert.Len(t, verifier.processed[nodes[3]], 1)
+		assert.Len(t, verifier.processed[nodes[4]], 1)
+	}()
+
+	checkpoint, err = segmentverify.LoadCheckpoint(config.CheckpointPath)
+	require.NoError(t, err)
+	progress := checkpoint.Range(uuid.UUID{}, maxUUID)
+	require.True(t, progress.Done)
+	require.EqualValues(t, 2, progress.Processed)
+	require.True(t, checkpoint.Nodes[nodes[2]].Offline)
+
+	// results from the previous run are kept
+	notFoundCSV, err := os.ReadFile(config.NotFoundPath)
+	require.NoError(t, err)
+	require.Equal(t, ""+
+		"stream id,position,found,not found,retry\n"+
+		"10100000-0000-0000-0000-000000000000,0,0,2,0\n",
+		string(notFoundCSV))
+
+	// a completed range is not verified again
+	func() {
+		metabase := newMetabaseMock(nodes, segments...)
+		verifier := &verifierMock{allSuccess: true}
+
+		service, err := segmentverify.NewService(log.Named("segment-verify"), metabase, verifier, metabase, config)
+		require.NoError(t, err)
+		defer ctx.Check(service.Close)
+
+		err = service.ProcessRange(ctx, uuid.UUID{}, maxUUID)
+		require.NoError(t, err)
+		require.Empty(t, verifier.processed)
+	}()
+}
+
+func TestService_Scope(t *testing.T) {
+	ctx := testcontext.New(t)
+	log := testplanet.NewLogger(t)
+
+	config := segmentverify.ServiceConfig{
+		NotFoundPath:      ctx.File("not-found.csv"),
+		RetryPath:         ctx.File("retry.csv"),
+		ProblemPiecesPath: ctx.File("problem-pieces.csv"),
+
+		Check:       1,
+		BatchSize:   1,
+		Concurrency: 3,
+		MaxOffline:  2,
+	}
+
+	nodes := map[metabase.NodeAlias]storj.NodeID{}
+	for i := 1; i <= 0xFF; i++ {
+		nodes[metabase.NodeAlias(i)] = storj.NodeID{byte(i)}
+	}
+
+	segments := []metabase.VerifySegment{
+		{
+			StreamID:    uuid.UUID{0x10, 0x10},
+			AliasPieces: metabase.AliasPieces{{Number: 1, Alias: 1}},
+		},
+		{
+			StreamID:    uuid.UUID{0x10, 0x10},
+			Position:    metabase.SegmentPosition{Index: 1},
+			AliasPieces: metabase.AliasPieces{{Number: 1, Alias: 1}},
+		},
+		{
+			StreamID:    uuid.UUID{0x20, 0x20},
+			AliasPieces: metabase.AliasPieces{{Number: 0, Alias: 2}},
+		},
+		{
+			StreamID:    uuid.UUID{0x30, 0x30},
+			AliasPieces: metabase.AliasPieces{{Number: 0, Alias: 3}},
+		},
+		{
+			StreamID:    uuid.UUID{0x40, 0x40},
+			AliasPieces: metabase.AliasPieces{{Number: 0, Alias: 4}},
+		},
+	}
+
+	projectID := uuid.UUID{1}
+
+	for _, tc := range []struct {
+		bucket   string
+		prefix   metabase.ObjectKey
+		expected map[storj.NodeID]int
+	}{
+		{expected: map[storj.NodeID]int{nodes[1]: 2, nodes[2]: 1, nodes[3]: 1}},
+		{bucket: "bucket", expected: map[storj.NodeID]int{nodes[1]: 2, nodes[2]: 1}},
+		{bucket: "bucket", prefix: "a/", expected: map[storj.NodeID]int{nodes[1]: 2}},
+	} {
+		metabase := newMetabaseMock(nodes, segments...)
+		metabase.AddObject(projectID, "bucket", "a/1", uuid.UUID{0x10, 0x10})
+		metabase.AddObject(projectID, "bucket", "b/1", uuid.UUID{0x20, 0x20})
+		metabase.AddObject(projectID, "other", "a/1", uuid.UUID{0x30, 0x30})
+		metabase.AddObject(uuid.UUID{2}, "bucket", "a/1", uuid.UUID{0x40, 0x40})
+
+		verifier := &verifierMock{allSuccess: true}
+
+		service, err := segmentverify.NewService(log.Named("segment-verify"), metabase, verifier, metabase, config)
+		require.NoError(t, err)
+
+		err = service.ProcessScope(ctx, projectID, tc.bucket, tc.prefix)
+		require.NoError(t, err)
+		require.NoError(t, service.Close())
+
+		processed := map[storj.NodeID]int{}
+		for node, list := range verifier.processed {
+			processed[node] = len(list)
+		}
+		require.Equal(t, tc.expected, processed, "bucket=%q prefix=%q", tc.bucket, tc.prefix)
+	}
+}
+
+func TestService_RepairEnqueue(t *testing.T) {
+	ctx := testcontext.New(t)
+	log := testplanet.NewLogger(t)
+
+	config := segmentverify.ServiceConfig{
+		NotFoundPath:      ctx.File("not-found.csv"),
+		RetryPath:         ctx.File("retry.csv"),
+		ProblemPiecesPath: ctx.File("problem-pieces.csv"),
+
+		Check:       0,
+		BatchSize:   100,
+		Concurrency: 3,
+		MaxOffline:  2,
+	}
+
+	nodes := map[metabase.NodeAlias]storj.NodeID{}
+	for i := 1; i <= 0xFF; i++ {
+		nodes[metabase.NodeAlias(i)] = storj.NodeID{byte(i)}
+	}
+
+	redundancy := storj.RedundancyScheme{
+		Algorithm:      storj.ReedSolomon,
+		RequiredShares: 1,
+		RepairShares:   2,
+		OptimalShares:  3,
+		TotalShares:    4,
+		ShareSize:      256,
+	}
+
+	segments := []metabase.VerifySegment{
+		{ // one missing piece, healthy enough
+			StreamID:    uuid.UUID{0x10, 0x10},
+			Redundancy:  redundancy,
+			AliasPieces: metabase.AliasPieces{{Number: 0, Alias: 1}, {Number: 1, Alias: 2}, {Number: 2, Alias: 3}, {Number: 3, Alias: 4}},
+		},
+		{ // two missing pieces, needs repair
+			StreamID:    uuid.UUID{0x20, 0x20},
+			Redundancy:  redundancy,
+			AliasPieces: metabase.AliasPieces{{Number: 0, Alias: 1}, {Number: 1, Alias: 2}, {Number: 2, Alias: 3}, {Number: 3, Alias: 4}},
+		},
+		{ // one missing piece and one piece on an unreliable node, needs repair
+			StreamID:    uuid.UUID{0x30, 0x30},
+			Redundancy:  redundancy,
+			AliasPieces: metabase.AliasPieces{{Number: 0, Alias: 1}, {Number: 1, Alias: 2}, {Number: 2, Alias: 3}, {Number: 3, Alias: 5}},
+		},
+	}
+
+	metabase := newMetabaseMock(nodes, segments...)
+	verifier := &verifierMock{
+		notFoundOn: map[uuid.UUID][]storj.NodeID{
+			segments[0].StreamID: {nodes[1]},
+			segments[1].StreamID: {nodes[1], nodes[2]},
+			segments[2].StreamID: {nodes[1]},
+		},
+	}
+
+	repairQueue := &repairQueueMock{}
+	reliableNodes := &reliableNodesMock{
+		numNodes:   len(nodes) - 1,
+		unreliable: map[storj.NodeID]bool{nodes[5]: true},
+	}
+
+	service, err := segmentverify.NewService(log.Named("segment-verify"), metabase, verifier, metabase, config)
+	require.NoError(t, err)
+	defer ctx.Check(service.Close)
+
+	service.SetRepairQueue(repairQueue, reliableNodes, checker.RepairOverridesMap{}, 0.00005435)
+
+	err = service.ProcessRange(ctx, uuid.UUID{}, maxUUID)
+	require.NoError(t, err)
+
+	require.Len(t, repairQueue.segments, 2)
+	var enqueued []uuid.UUID
+	for _, segment := range repairQueue.segments {
+		require.NotZero(t, segment.SegmentHealth)
+		enqueued = append(enqueued, segment.StreamID)
+	}
+	require.ElementsMatch(t, []uuid.UUID{segments[1].StreamID, segments[2].StreamID}, enqueued)
+}
+
+func TestService_TargetNodes(t *testing.T) {
+	ctx := testcontext.New(t)
+	log := testplanet.NewLogger(t)
+
+	nodes := map[metabase.NodeAlias]storj.NodeID{}
+	for i := 1; i <= 0xFF; i++ {
+		nodes[metabase.NodeAlias(i)] = storj.NodeID{byte(i)}
+	}
+
+	config := segmentverify.ServiceConfig{
+		NotFoundPath:      ctx.File("not-found.csv"),
+		RetryPath:         ctx.File("retry.csv"),
+		ProblemPiecesPath: ctx.File("problem-pieces.csv"),
+		TargetNodes:       nodes[2].String() + ",3",
+		ExcludeNodes:      "3",
+
+		Check:       1,
+		BatchSize:   100,
+		Concurrency: 3,
+		MaxOffline:  2,
+	}
+
+	segments := []metabase.VerifySegment{
+		{ // not on a target node
+			StreamID:    uuid.UUID{0x10, 0x10},
+			AliasPieces: metabase.AliasPieces{{Number: 0, Alias: 1}, {Number: 1, Alias: 4}},
+		},
+		{
+			StreamID:    uuid.UUID{0x20, 0x20},
+			AliasPieces: metabase.AliasPieces{{Number: 0, Alias: 1}, {Number: 1, Alias: 2}},
+		},
+		{ // only on an excluded target node
+			StreamID:    uuid.UUID{0x30, 0x30},
+			AliasPieces: metabase.AliasPieces{{Number: 0, Alias: 3}, {Number: 1, Alias: 4}},
+		},
+	}
+
+	metabase := newMetabaseMock(nodes, segments...)
+	verifier := &verifierMock{allSuccess: true}
+
+	service, err := segmentverify.NewService(log.Named("segment-verify"), metabase, verifier, metabase, config)
+	require.NoError(t, err)
+	defer ctx.Check(service.Close)
+
+	err = service.ProcessRange(ctx, uuid.UUID{}, maxUUID)
+	require.NoError(t, err)
+
+	require.Len(t, verifier.processed, 1)
+	require.Len(t, verifier.processed[nodes[2]], 1)
+	require.Equal(t, segments[1].StreamID, verifier.processed[nodes[2]][0].StreamID)
+
+	// the segment on the excluded node couldn't be verified.
+	retryCSV, err := os.ReadFile(config.RetryPath)
+	require.NoError(t, err)
+	require.Contains(t, string(retryCSV), segments[2].StreamID.String())
+	require.NotContains(t, string(retryCSV), segments[0].StreamID.String())
+}
+
+func TestService_NodeSummary(t *testing.T) {
+	ctx := testcontext.New(t)
+	log := testplanet.NewLogger(t)
+
+	config := segmentverify.ServiceConfig{
+		NotFoundPath:      ctx.File("not-found.csv"),
+		RetryPath:         ctx.File("retry.csv"),
+		ProblemPiecesPath: ctx.File("problem-pieces.csv"),
+		NodeSummaryPath:   ctx.File("node-summary.csv"),
+
+		Check:       0,
+		BatchSize:   100,
+		Concurrency: 3,
+		MaxOffline:  2,
+	}
+
+	nodes := map[metabase.NodeAlias]storj.NodeID{}
+	for i := 1; i <= 0xFF; i++ {
+		nodes[metabase.NodeAlias(i)] = storj.NodeID{byte(i)}
+	}
+
+	segments := []metabase.VerifySegment{
+		{
+			StreamID:    uuid.UUID{0x10, 0x10},
+			AliasPieces: metabase.AliasPieces{{Number: 0, Alias: 1}, {Number: 1, Alias: 2}},
+		},
+		{
+			StreamID:    uuid.UUID{0x20, 0x20},
+			AliasPieces: metabase.AliasPieces{{Number: 0, Alias: 1}, {Number: 1, Alias: 3}},
+		},
+	}
+
+	func() {
+		metabase := newMetabaseMock(nodes, segments...)
+		verifier := &verifierMock{
+			success: []uuid.UUID{segments[0].StreamID, segments[1].StreamID},
+		}
+
+		service, err := segmentverify.NewService(log.Named("segment-verify"), metabase, verifier, metabase, config)
+		require.NoError(t, err)
+		defer ctx.Check(service.Close)
+
+		err = service.ProcessRange(ctx, uuid.UUID{}, maxUUID)
+		require.NoError(t, err)
+	}()
+
+	summary, err := os.ReadFile(config.NodeSummaryPath)
+	require.NoError(t, err)
+	require.Equal(t, ""+
+		"node id,verified,not found,corrupted,failed,verified batches,offline batches\n"+
+		nodes[1].String()+",2,0,0,0,1,0\n"+
+		nodes[2].String()+",1,0,0,0,1,0\n"+
+		nodes[3].String()+",1,0,0,0,1,0\n",
+		string(summary))
+}
+
+func TestProgress_ETA(t *testing.T) {
+	progress := segmentverify.NewProgress()
+
+	_, ok := progress.ETA()
+	require.False(t, ok)
+
+	progress.SetRange(uuid.UUID{}, maxUUID)
+	_, ok = progress.ETA()
+	require.False(t, ok)
+
+	progress.SetCursor(uuid.UUID{0x80})
+	eta, ok := progress.ETA()
+	require.True(t, ok)
+	require.GreaterOrEqual(t, eta, time.Duration(0))
+
+	progress.SetCursor(maxUUID)
+	eta, ok = progress.ETA()
+	require.True(t, ok)
+	require.Zero(t, eta)
+}
+
 func isUnique(segments []*segmentverify.Segment) bool {
 	type segmentID struct {
 		StreamID uuid.UUID
@@ -314,6 +705,7 @@ type metabaseMock struct {
 	nodeIDToAlias      map[storj.NodeID]metabase.NodeAlias
 	aliasToNodeID      map[metabase.NodeAlias]storj.NodeID
 	streamIDsPerBucket map[metabase.BucketLocation][]uuid.UUID
+	objects            []metabase.ObjectStream
 	segments           []metabase.VerifySegment
 }
 
@@ -335,6 +727,25 @@ func (db *metabaseMock) AddStreamIDToBucket(projectID uuid.UUID, bucketName stri
 	db.streamIDsPerBucket[bucket] = append(db.streamIDsPerBucket[bucket], streamIDs...)
 }
 
+func (db *metabaseMock) AddObject(projectID uuid.UUID, bucketName string, key metabase.ObjectKey, streamID uuid.UUID) {
+	db.objects = append(db.objects, metabase.ObjectStream{
+		ProjectID:  projectID,
+		BucketName: bucketName,
+		ObjectKey:  key,
+		StreamID:   streamID,
+	})
+	sort.Slice(db.objects, func(i, k int) bool {
+		a, b := db.objects[i], db.objects[k]
+		if a.ProjectID != b.ProjectID {
+			return a.ProjectID.Less(b.ProjectID)
+		}
+		if a.BucketName != b.BucketName {
+			return a.BucketName < b.BucketName
+		}
+		return a.ObjectKey < b.ObjectKey
+	})
+}
+
 func (db *metabaseMock) Get(ctx context.Context, nodeID storj.NodeID) (*overlay.NodeDossier, error) {
 	return &overlay.NodeDossier{
 		Node: pb.Node{
@@ -397,9 +808,10 @@ func (db *metabaseMock) GetSegmentByPosition(ctx context.Context, opts metabase.
 			}
 
 			return metabase.Segment{
-				StreamID: s.StreamID,
-				Position: s.Position,
-				Pieces:   pieces,
+				StreamID:   s.StreamID,
+				Position:   s.Position,
+				Redundancy: s.Redundancy,
+				Pieces:     pieces,
 			}, nil
 		}
 	}
@@ -440,10 +852,39 @@ func (db *metabaseMock) ListBucketsStreamIDs(ctx context.Context, opts metabase.
 	return result, nil
 }
 
+func (db *metabaseMock) ListScopeStreamIDs(ctx context.Context, opts metabase.ListScopeStreamIDs) (metabase.ListScopeStreamIDsResult, error) {
+	result := metabase.ListScopeStreamIDsResult{}
+	for _, obj := range db.objects {
+		if obj.ProjectID != opts.ProjectID {
+			continue
+		}
+		if opts.BucketName != "" && obj.BucketName != opts.BucketName {
+			continue
+		}
+		if !strings.HasPrefix(string(obj.ObjectKey), string(opts.Prefix)) {
+			continue
+		}
+		if obj.BucketName < opts.Cursor.BucketName ||
+			(obj.BucketName == opts.Cursor.BucketName && obj.ObjectKey <= opts.Cursor.ObjectKey) {
+			continue
+		}
+
+		result.StreamIDs = append(result.StreamIDs, obj.StreamID)
+		result.Cursor = metabase.ListScopeStreamIDsCursor{BucketName: obj.BucketName, ObjectKey: obj.ObjectKey}
+		if len(result.StreamIDs) >= opts.Limit {
+			break
+		}
+	}
+	return result, nil
+}
+
 func (db *metabaseMock) ListVerifySegments(ctx context.Context, opts metabase.ListVerifySegments) (result metabase.ListVerifySegmentsResult, err error) {
 	r := metabase.ListVerifySegmentsResult{}
 
 	for _, s := range db.segments {
+		if len(opts.StreamIDs) > 0 && !containsStreamID(opts.StreamIDs, s.StreamID) {
+			continue
+		}
 		if s.StreamID.Less(opts.CursorStreamID) {
 			continue
 		}
@@ -460,12 +901,62 @@ func (db *metabaseMock) ListVerifySegments(ctx context.Context, opts metabase.Li
 	return r, nil
 }
 
+func containsStreamID(streamIDs []uuid.UUID, streamID uuid.UUID) bool {
+	for _, id := range streamIDs {
+		if id == streamID {
+			return true
+		}
+	}
+	return false
+}
+
+func containsNodeID(nodeIDs []storj.NodeID, nodeID storj.NodeID) bool {
+	for _, id := range nodeIDs {
+		if id == nodeID {
+			return true
+		}
+	}
+	return false
+}
+
+type repairQueueMock struct {
+	mu       sync.Mutex
+	segments []*queue.InjuredSegment
+}
+
+func (q *repairQueueMock) Insert(ctx context.Context, s *queue.InjuredSegment) (bool, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.segments = append(q.segments, s)
+	return false, nil
+}
+
+type reliableNodesMock struct {
+	numNodes   int
+	unreliable map[storj.NodeID]bool
+}
+
+func (mock *reliableNodesMock) NumNodes(ctx context.Context) (int, error) {
+	return mock.numNodes, nil
+}
+
+func (mock *reliableNodesMock) MissingPieces(ctx context.Context, created time.Time, pieces metabase.Pieces) (missing []metabase.Piece, _ error) {
+	for _, piece := range pieces {
+		if mock.unreliable[piece.StorageNode] {
+			missing = append(missing, piece)
+		}
+	}
+	return missing, nil
+}
+
 type verifierMock struct {
 	allSuccess bool
 	fail       error
 	offline    []storj.NodeID
 	success    []uuid.UUID
 	notFound   []uuid.UUID
+	// notFoundOn overrides the outcome for specific nodes.
+	notFoundOn map[uuid.UUID][]storj.NodeID
 
 	mu        sync.Mutex
 	processed map[storj.NodeID][]*segmentverify.Segment
@@ -495,6 +986,17 @@ func (v *verifierMock) Verify(ctx context.Context, alias metabase.NodeAlias, tar
 		return len(segments), nil
 	}
 
+	if v.notFoundOn != nil {
+		for _, t := range segments {
+			if containsNodeID(v.notFoundOn[t.StreamID], target.ID) {
+				t.Status.MarkNotFound()
+			} else {
+				t.Status.MarkFound()
+			}
+		}
+		return len(segments), nil
+	}
+
 	for _, seg := range v.success {
 		for _, t := range segments {
 			if t.StreamID == seg {
@@ -0,0 +1,83 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"sync"
+	"time"
+
+	"storj.io/common/storj"
+	"storj.io/storj/satellite/audit"
+)
+
+// AdaptiveThrottle paces the requests to each node individually. The interval
+// between requests is decreased while the node responds, and increased
+// when it times out or fails to respond.
+type AdaptiveThrottle struct {
+	initial time.Duration
+	min     time.Duration
+	max     time.Duration
+
+	mu        sync.Mutex
+	intervals map[storj.NodeID]time.Duration
+}
+
+// NewAdaptiveThrottle creates a new throttle, where every node starts at the initial interval.
+func NewAdaptiveThrottle(initial, min, max time.Duration) *AdaptiveThrottle {
+	if min > initial {
+		min = initial
+	}
+	if max < initial {
+		max = initial
+	}
+	return &AdaptiveThrottle{
+		initial:   initial,
+		min:       min,
+		max:       max,
+		intervals: map[storj.NodeID]time.Duration{},
+	}
+}
+
+// Interval returns the current interval between requests to the node.
+func (throttle *AdaptiveThrottle) Interval(nodeID storj.NodeID) time.Duration {
+	throttle.mu.Lock()
+	defer throttle.mu.Unlock()
+
+	return throttle.interval(nodeID)
+}
+
+func (throttle *AdaptiveThrottle) interval(nodeID storj.NodeID) time.Duration {
+	interval, ok := throttle.intervals[nodeID]
+	if !ok {
+		return throttle.initial
+	}
+	return interval
+}
+
+// Observe adjusts the interval of the node based on the outcome of a request.
+func (throttle *AdaptiveThrottle) Observe(nodeID storj.NodeID, outcome audit.Outcome) {
+	throttle.mu.Lock()
+	defer throttle.mu.Unlock()
+
+	interval := throttle.interval(nodeID)
+	switch outcome {
+	case audit.OutcomeSuccess, audit.OutcomeFailure, outcomeHashMismatch:
+		// the node responded, so it's able to handle the load.
+		interval -= interval / 10
+		if interval < throttle.min {
+			interval = throttle.min
+		}
+	case audit.OutcomeNotPerformed:
+		// the request was not sent, so there's nothing to learn.
+		return
+	default:
+		interval *= 2
+		if interval > throttle.max {
+			interval = throttle.max
+		}
+	}
+
+	throttle.intervals[nodeID] = interval
+	mon.DurationVal("node_request_interval").Observe(interval)
+}
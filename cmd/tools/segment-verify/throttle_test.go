@@ -0,0 +1,41 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/storj"
+	segmentverify "storj.io/storj/cmd/tools/segment-verify"
+	"storj.io/storj/satellite/audit"
+)
+
+func TestAdaptiveThrottle(t *testing.T) {
+	throttle := segmentverify.NewAdaptiveThrottle(100*time.Millisecond, 50*time.Millisecond, 300*time.Millisecond)
+
+	healthy, slow := storj.NodeID{1}, storj.NodeID{2}
+	require.Equal(t, 100*time.Millisecond, throttle.Interval(healthy))
+
+	throttle.Observe(healthy, audit.OutcomeSuccess)
+	require.Equal(t, 90*time.Millisecond, throttle.Interval(healthy))
+
+	for i := 0; i < 100; i++ {
+		throttle.Observe(healthy, audit.OutcomeFailure)
+	}
+	require.Equal(t, 50*time.Millisecond, throttle.Interval(healthy))
+
+	throttle.Observe(slow, audit.OutcomeTimedOut)
+	require.Equal(t, 200*time.Millisecond, throttle.Interval(slow))
+	throttle.Observe(slow, audit.OutcomeNodeOffline)
+	require.Equal(t, 300*time.Millisecond, throttle.Interval(slow))
+
+	throttle.Observe(slow, audit.OutcomeNotPerformed)
+	require.Equal(t, 300*time.Millisecond, throttle.Interval(slow))
+
+	// nodes are paced independently.
+	require.Equal(t, 50*time.Millisecond, throttle.Interval(healthy))
+}
@@ -6,6 +6,7 @@ package main
 import (
 	"context"
 	"io"
+	"math/rand"
 	"time"
 
 	"github.com/blang/semver"
@@ -38,6 +39,13 @@ type VerifierConfig struct {
 
 	RequestThrottle   time.Duration `help:"minimum interval for sending out each request" default:"150ms"`
 	VersionWithExists string        `help:"minimum storage node version with implemented Exists method" default:"v1.69.2"`
+
+	AdaptiveThrottle   bool          `help:"adjust the request interval per node, based on how the node responds" default:"true"`
+	MinRequestThrottle time.Duration `help:"minimum request interval, when using adaptive throttling" default:"20ms"`
+	MaxRequestThrottle time.Duration `help:"maximum request interval, when using adaptive throttling" default:"5s"`
+
+	FullReadSampleRate float64       `help:"fraction of verified pieces that are fully downloaded and checked against their signed hash (0 disables)" default:"0"`
+	FullReadTimeout    time.Duration `help:"duration to wait per full piece download" default:"30s"`
 }
 
 // NodeVerifier implements segment verification by dialing nodes.
@@ -52,6 +60,12 @@ type NodeVerifier struct {
 	reportPiece pieceReporterFunc
 
 	versionWithExists semver.Version
+
+	// sampleFn decides whether a piece should be fully downloaded.
+	sampleFn func() bool
+
+	// throttle is nil when every node uses the fixed RequestThrottle.
+	throttle *AdaptiveThrottle
 }
 
 var _ Verifier = (*NodeVerifier)(nil)
@@ -74,17 +88,70 @@ func NewVerifier(log *zap.Logger, dialer rpc.Dialer, orders *orders.Service, con
 		log.Warn("invalid VersionWithExists", zap.String("VersionWithExists", config.VersionWithExists), zap.Error(err))
 	}
 
+	var throttle *AdaptiveThrottle
+	if config.AdaptiveThrottle {
+		throttle = NewAdaptiveThrottle(config.RequestThrottle, config.MinRequestThrottle, config.MaxRequestThrottle)
+	}
+
 	return &NodeVerifier{
 		log:               log,
 		config:            config,
 		dialer:            configuredDialer,
 		orders:            orders,
 		versionWithExists: version,
+
+		sampleFn: func() bool {
+			return rand.Float64() < config.FullReadSampleRate
+		},
+		throttle: throttle,
+	}
+}
+
+// nodeRateLimiter returns the rate limiter for requests to the target node.
+// Priority nodes are not throttled.
+func (service *NodeVerifier) nodeRateLimiter(nodeID storj.NodeID, ignoreThrottle bool) rateLimiter {
+	if ignoreThrottle {
+		return newRateLimiter(0, 0)
+	}
+
+	interval := service.config.RequestThrottle
+	if service.throttle != nil {
+		interval = service.throttle.Interval(nodeID)
+	}
+	return newRateLimiter(interval, interval/4)
+}
+
+// observe adjusts the pacing of the node based on the outcome of a request.
+func (service *NodeVerifier) observe(nodeID storj.NodeID, outcome audit.Outcome, ignoreThrottle bool) {
+	if service.throttle == nil || ignoreThrottle {
+		return
 	}
+	service.throttle.Observe(nodeID, outcome)
 }
 
-// Verify a collection of segments by attempting to download a byte from each segment from the target node.
+// Verify a collection of segments by checking their existence on the target node.
+// Additionally a sample of the pieces is fully downloaded and verified, when configured.
 func (service *NodeVerifier) Verify(ctx context.Context, alias metabase.NodeAlias, target storj.NodeURL, targetVersion string, segments []*Segment, ignoreThrottle bool) (verifiedCount int, err error) {
+	verifiedCount, err = service.verifyExistence(ctx, alias, target, targetVersion, segments, ignoreThrottle)
+	if err != nil || service.config.FullReadSampleRate <= 0 {
+		return verifiedCount, err
+	}
+
+	var sampled []*Segment
+	for _, segment := range segments[:verifiedCount] {
+		if service.sampleFn() {
+			sampled = append(sampled, segment)
+		}
+	}
+	if len(sampled) == 0 {
+		return verifiedCount, nil
+	}
+
+	return verifiedCount, service.VerifyFullRead(ctx, alias, target, sampled, ignoreThrottle)
+}
+
+// verifyExistence verifies a collection of segments by attempting to download a byte from each segment from the target node.
+func (service *NodeVerifier) verifyExistence(ctx context.Context, alias metabase.NodeAlias, target storj.NodeURL, targetVersion string, segments []*Segment, ignoreThrottle bool) (verifiedCount int, err error) {
 	verifiedCount, err = service.VerifyWithExists(ctx, alias, target, targetVersion, segments)
 	// if Exists method is unimplemented or it is wrong node version fallback to download verification
 	if !methodUnimplemented(err) && !errWrongNodeVersion.Has(err) {
@@ -107,13 +174,9 @@ func (service *NodeVerifier) Verify(ctx context.Context, alias metabase.NodeAlia
 	const maxDials = 2
 	dialCount := 0
 
-	rateLimiter := newRateLimiter(0, 0)
-	if !ignoreThrottle {
-		rateLimiter = newRateLimiter(service.config.RequestThrottle, service.config.RequestThrottle/4)
-	}
-
 	nextRequest := time.Now()
 	for i, segment := range segments {
+		rateLimiter := service.nodeRateLimiter(target.ID, ignoreThrottle)
 		nextRequest, err = rateLimiter.next(ctx, nextRequest)
 		if err != nil {
 			return i, Error.Wrap(err)
@@ -130,6 +193,7 @@ func (service *NodeVerifier) Verify(ctx context.Context, alias metabase.NodeAlia
 					zap.Stringer("node-id", target.ID),
 					zap.Error(err))
 				client = nil
+				service.observe(target.ID, audit.OutcomeNodeOffline, ignoreThrottle)
 				nextRequest, err = rateLimiter.next(ctx, nextRequest)
 				if err != nil {
 					return i, Error.Wrap(err)
@@ -143,6 +207,7 @@ func (service *NodeVerifier) Verify(ctx context.Context, alias metabase.NodeAlia
 			// to do any more
 			return i, Error.Wrap(err)
 		}
+		service.observe(target.ID, outcome, ignoreThrottle)
 		switch outcome {
 		case audit.OutcomeNodeOffline:
 			_ = client.Close()
@@ -4,6 +4,8 @@
 package main_test
 
 import (
+	"context"
+	"io"
 	"strconv"
 	"testing"
 	"time"
@@ -14,12 +16,14 @@ import (
 	"golang.org/x/sync/errgroup"
 
 	"storj.io/common/memory"
+	"storj.io/common/storj"
 	"storj.io/common/testcontext"
 	"storj.io/common/testrand"
 	"storj.io/common/uuid"
 	segmentverify "storj.io/storj/cmd/tools/segment-verify"
 	"storj.io/storj/private/testplanet"
 	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/storage"
 )
 
 func TestVerifier(t *testing.T) {
@@ -193,3 +197,106 @@ func TestVerifier(t *testing.T) {
 		})
 	})
 }
+
+func TestVerifier_FullRead(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: testplanet.ReconfigureRS(4, 4, 4, 4),
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+
+		config := segmentverify.VerifierConfig{
+			PerPieceTimeout:    time.Second,
+			OrderRetryThrottle: 500 * time.Millisecond,
+			RequestThrottle:    500 * time.Millisecond,
+			VersionWithExists:  "v1.69.2",
+			FullReadSampleRate: 1,
+			FullReadTimeout:    10 * time.Second,
+		}
+
+		service := segmentverify.NewVerifier(
+			planet.Log().Named("verifier"),
+			satellite.Dialer,
+			satellite.Orders.Service,
+			config)
+
+		err := planet.Uplinks[0].Upload(ctx, satellite, "bucket1", "object", testrand.Bytes(8*memory.KiB))
+		require.NoError(t, err)
+
+		result, err := satellite.Metabase.DB.ListVerifySegments(ctx, metabase.ListVerifySegments{
+			Limit: 1,
+		})
+		require.NoError(t, err)
+		require.Len(t, result.Segments, 1)
+
+		aliasMap, err := satellite.Metabase.DB.LatestNodesAliasMap(ctx)
+		require.NoError(t, err)
+
+		corruptedNode := planet.StorageNodes[0]
+		corruptedAlias, ok := aliasMap.Alias(corruptedNode.ID())
+		require.True(t, ok)
+
+		var pieceNum uint16
+		for _, piece := range result.Segments[0].AliasPieces {
+			if piece.Alias == corruptedAlias {
+				pieceNum = piece.Number
+			}
+		}
+		corruptPieceData(ctx, t, planet, corruptedNode, result.Segments[0].RootPieceID.Derive(corruptedNode.ID(), int32(pieceNum)))
+
+		for _, node := range planet.StorageNodes {
+			alias, ok := aliasMap.Alias(node.ID())
+			require.True(t, ok)
+
+			segment := &segmentverify.Segment{
+				VerifySegment: result.Segments[0],
+				Status:        segmentverify.Status{Retry: 1},
+			}
+
+			count, err := service.Verify(ctx, alias, node.NodeURL(), "v1.69.2", []*segmentverify.Segment{segment}, true)
+			require.NoError(t, err)
+			require.Equal(t, 1, count)
+
+			if node == corruptedNode {
+				require.Equal(t, segmentverify.Status{Corrupted: 1}, segment.Status)
+			} else {
+				require.Equal(t, segmentverify.Status{Found: 1}, segment.Status)
+			}
+		}
+	})
+}
+
+// corruptPieceData manipulates piece data on a storage node.
+func corruptPieceData(ctx context.Context, t *testing.T, planet *testplanet.Planet, corruptedNode *testplanet.StorageNode, corruptedPieceID storj.PieceID) {
+	t.Helper()
+
+	blobRef := storage.BlobRef{
+		Namespace: planet.Satellites[0].ID().Bytes(),
+		Key:       corruptedPieceID.Bytes(),
+	}
+
+	// get currently stored piece data from storagenode
+	reader, err := corruptedNode.Storage2.BlobsCache.Open(ctx, blobRef)
+	require.NoError(t, err)
+	pieceData, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	require.NotEmpty(t, pieceData)
+	require.NoError(t, reader.Close())
+
+	// delete piece data
+	err = corruptedNode.Storage2.BlobsCache.Delete(ctx, blobRef)
+	require.NoError(t, err)
+
+	// corrupt piece data (not PieceHeader) and write back to storagenode
+	pieceData[len(pieceData)-1]++
+	writer, err := corruptedNode.Storage2.BlobsCache.Create(ctx, blobRef, int64(len(pieceData)))
+	require.NoError(t, err)
+
+	_, err = writer.Write(pieceData)
+	require.NoError(t, err)
+
+	err = writer.Commit(ctx)
+	require.NoError(t, err)
+}
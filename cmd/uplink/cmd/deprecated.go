@@ -9,10 +9,6 @@ package cmd
 
 import (
 	"context"
-	"os"
-	"strings"
-
-	"github.com/zeebo/errs"
 
 	"storj.io/uplink"
 	"storj.io/uplink/edge"
@@ -20,28 +16,9 @@ import (
 
 // RegisterAccess registers an access grant with a Gateway Authorization Service.
 func RegisterAccess(ctx context.Context, access *uplink.Access, authService string, public bool, certificateFile string) (credentials *edge.Credentials, err error) {
-	if authService == "" {
-		return nil, errs.New("no auth service address provided")
-	}
-
-	// preserve compatibility with previous https service
-	authService = strings.TrimPrefix(authService, "https://")
-	authService = strings.TrimSuffix(authService, "/")
-	if !strings.Contains(authService, ":") {
-		authService += ":7777"
-	}
-
-	var certificatePEM []byte
-	if certificateFile != "" {
-		certificatePEM, err = os.ReadFile(certificateFile)
-		if err != nil {
-			return nil, errs.New("can't read certificate file: %w", err)
-		}
-	}
-
-	edgeConfig := edge.Config{
-		AuthServiceAddress: authService,
-		CertificatePEM:     certificatePEM,
+	edgeConfig, err := EdgeConfig(authService, certificateFile)
+	if err != nil {
+		return nil, err
 	}
 	return edgeConfig.RegisterAccess(ctx, access, &edge.RegisterAccessOptions{Public: public})
 }
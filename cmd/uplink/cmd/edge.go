@@ -0,0 +1,56 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package cmd
+
+import (
+	"crypto/x509"
+	"os"
+	"strings"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/uplink/edge"
+)
+
+// EdgeConfig returns the configuration for registering accesses with the
+// auth service. The auth service is validated with the certificates of
+// certificateFile, when it's set, and not validated when the address starts
+// with insecure://.
+func EdgeConfig(authService, certificateFile string) (_ edge.Config, err error) {
+	if authService == "" {
+		return edge.Config{}, errs.New("no auth service address provided")
+	}
+
+	var edgeConfig edge.Config
+
+	if strings.HasPrefix(authService, "insecure://") {
+		if certificateFile != "" {
+			return edge.Config{}, errs.New("the certificate can't be validated with an insecure auth service")
+		}
+		authService = strings.TrimPrefix(authService, "insecure://")
+		edgeConfig.InsecureSkipVerify = true
+	}
+	// preserve compatibility with previous https service
+	authService = strings.TrimPrefix(authService, "https://")
+	authService = strings.TrimSuffix(authService, "/")
+	if !strings.Contains(authService, ":") {
+		authService += ":7777"
+	}
+
+	var certificatePEM []byte
+	if certificateFile != "" {
+		certificatePEM, err = os.ReadFile(certificateFile)
+		if err != nil {
+			return edge.Config{}, errs.New("can't read certificate file: %w", err)
+		}
+		// the auth service would be validated against no certificates.
+		if !x509.NewCertPool().AppendCertsFromPEM(certificatePEM) {
+			return edge.Config{}, errs.New("no PEM certificates found in %q", certificateFile)
+		}
+	}
+
+	edgeConfig.AuthServiceAddress = authService
+	edgeConfig.CertificatePEM = certificatePEM
+	return edgeConfig, nil
+}
@@ -0,0 +1,439 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math/rand"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/zeebo/clingy"
+	"github.com/zeebo/errs"
+
+	"storj.io/common/memory"
+	"storj.io/common/rpc/rpcpool"
+	"storj.io/storj/cmd/uplink/ulext"
+	"storj.io/storj/cmd/uplink/ulfs"
+	"storj.io/storj/cmd/uplink/ulloc"
+)
+
+type cmdBench struct {
+	ex ulext.External
+
+	access        string
+	sizes         benchSizes
+	parallelism   int
+	duration      time.Duration
+	operations    []string
+	maxOperations int
+	keep          bool
+
+	prefix ulloc.Location
+}
+
+func newCmdBench(ex ulext.External) *cmdBench {
+	return &cmdBench{ex: ex}
+}
+
+func (c *cmdBench) Setup(params clingy.Parameters) {
+	c.access = params.Flag("access", "Access name or value to use", "").(string)
+	c.sizes = params.Flag("sizes", "Object sizes to upload with optional weights, e.g. '1KiB:10,1MiB:5,64MiB'", benchSizes{{Size: memory.MiB, Weight: 1}},
+		clingy.Transform(parseBenchSizes), clingy.Type("sizes"),
+	).(benchSizes)
+	c.parallelism = params.Flag("parallelism", "Controls how many operations run in parallel", 1,
+		clingy.Short('p'),
+		clingy.Transform(strconv.Atoi),
+		clingy.Transform(func(n int) (int, error) {
+			if n <= 0 {
+				return 0, errs.New("parallelism must be at least 1")
+			}
+			return n, nil
+		}),
+	).(int)
+	c.duration = params.Flag("duration", "How long every operation is benchmarked", 10*time.Second,
+		clingy.Transform(time.ParseDuration),
+		clingy.Transform(func(d time.Duration) (time.Duration, error) {
+			if d <= 0 {
+				return 0, errs.New("duration must be positive")
+			}
+			return d, nil
+		}),
+	).(time.Duration)
+	c.operations = params.Flag("operations", "Operations to benchmark in order (upload, download)", []string{"upload", "download"},
+		clingy.Transform(parseBenchOperations), clingy.Type("operations"),
+	).([]string)
+	c.maxOperations = params.Flag("max-operations", "Stops an operation after this many objects, 0 means no limit", 0,
+		clingy.Transform(strconv.Atoi), clingy.Advanced,
+	).(int)
+	c.keep = params.Flag("keep", "Keep the uploaded objects instead of removing them at the end", false,
+		clingy.Transform(strconv.ParseBool), clingy.Boolean,
+	).(bool)
+
+	c.prefix = params.Arg("prefix", "Remote prefix to upload the objects to (sj://BUCKET[/KEY])",
+		clingy.Transform(ulloc.Parse),
+		clingy.Transform(func(loc ulloc.Location) (ulloc.Location, error) {
+			if !loc.Remote() {
+				return ulloc.Location{}, errs.New("prefix must be a remote sj:// location")
+			}
+			return loc.AsDirectoryish(), nil
+		}),
+	).(ulloc.Location)
+}
+
+func (c *cmdBench) Execute(ctx context.Context) (err error) {
+	fs, err := c.ex.OpenFilesystem(ctx, c.access, ulext.ConnectionPoolOptions(rpcpool.Options{
+		Capacity:       100 * c.parallelism,
+		KeyCapacity:    5,
+		IdleExpiration: 2 * time.Minute,
+	}))
+	if err != nil {
+		return err
+	}
+	defer func() { _ = fs.Close() }()
+
+	bench := &benchRun{
+		fs:     fs,
+		prefix: c.prefix,
+		sizes:  c.sizes,
+		data:   newBenchData(),
+		rand:   rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+	defer func() {
+		if !c.keep {
+			err = errs.Combine(err, bench.cleanup(ctx))
+		}
+	}()
+
+	var results []benchResult
+	for _, operation := range c.operations {
+		var result benchResult
+		switch operation {
+		case "upload":
+			result = bench.run(ctx, operation, c.parallelism, c.duration, c.maxOperations, bench.upload)
+		case "download":
+			// downloads need objects, so upload one for every worker, which
+			// isn't part of the results.
+			if len(bench.objects) == 0 {
+				for i := 0; i < c.parallelism; i++ {
+					if _, err := bench.upload(ctx); err != nil {
+						return errs.Wrap(err)
+					}
+				}
+			}
+			result = bench.run(ctx, operation, c.parallelism, c.duration, c.maxOperations, bench.download)
+		}
+		results = append(results, result)
+	}
+
+	tw := newTabbedWriter(clingy.Stdout(ctx), "OPERATION", "COUNT", "ERRORS", "BYTES", "THROUGHPUT", "P50", "P90", "P99", "MAX")
+	defer tw.Done()
+	for _, result := range results {
+		tw.WriteLine(result.operation, len(result.latencies), result.errors,
+			memory.Size(result.bytes).String(), result.throughput(),
+			result.percentile(0.50), result.percentile(0.90), result.percentile(0.99), result.percentile(1))
+	}
+	return nil
+}
+
+// benchRun contains the state shared by the workers of a benchmark.
+type benchRun struct {
+	fs     ulfs.Filesystem
+	prefix ulloc.Location
+	sizes  benchSizes
+	data   []byte
+
+	mu      sync.Mutex
+	rand    *rand.Rand
+	objects []benchObject
+	next    int
+}
+
+// benchObject is an uploaded object.
+type benchObject struct {
+	loc  ulloc.Location
+	size int64
+}
+
+// benchResult contains the measurements of an operation.
+type benchResult struct {
+	operation string
+	elapsed   time.Duration
+	latencies []time.Duration
+	bytes     int64
+	errors    int
+}
+
+// run runs the operation with the given parallelism, until the duration has
+// elapsed or maxOperations operations have been started.
+func (b *benchRun) run(ctx context.Context, operation string, parallelism int, duration time.Duration, maxOperations int,
+	fn func(ctx context.Context) (int64, error)) benchResult {
+	result := benchResult{operation: operation}
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	started := 0
+	deadline := time.Now().Add(duration)
+
+	start := time.Now()
+	for i := 0; i < parallelism; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for ctx.Err() == nil && time.Now().Before(deadline) {
+				mu.Lock()
+				if maxOperations > 0 && started >= maxOperations {
+					mu.Unlock()
+					return
+				}
+				started++
+				mu.Unlock()
+
+				opStart := time.Now()
+				n, err := fn(ctx)
+				latency := time.Since(opStart)
+
+				mu.Lock()
+				if err != nil {
+					result.errors++
+				} else {
+					result.latencies = append(result.latencies, latency)
+					result.bytes += n
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	result.elapsed = time.Since(start)
+
+	sort.Slice(result.latencies, func(i, k int) bool {
+		return result.latencies[i] < result.latencies[k]
+	})
+	return result
+}
+
+// upload uploads an object with a size from the distribution.
+func (b *benchRun) upload(ctx context.Context) (_ int64, err error) {
+	b.mu.Lock()
+	size := b.sizes.pick(b.rand)
+	loc := b.prefix.AppendKey(fmt.Sprintf("bench-%d-%016x", time.Now().UnixNano(), b.rand.Uint64()))
+	b.mu.Unlock()
+
+	mwh, err := b.fs.Create(ctx, loc, &ulfs.CreateOptions{Length: size})
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		if err != nil {
+			_ = mwh.Abort(ctx)
+		}
+	}()
+
+	wh, err := mwh.NextPart(ctx, size)
+	if err != nil {
+		return 0, err
+	}
+	if _, err := io.Copy(wh, &benchReader{data: b.data, remaining: size}); err != nil {
+		return 0, errs.Combine(err, wh.Abort())
+	}
+	if err := wh.Commit(); err != nil {
+		return 0, err
+	}
+	if err := mwh.Commit(ctx); err != nil {
+		return 0, err
+	}
+
+	b.mu.Lock()
+	b.objects = append(b.objects, benchObject{loc: loc, size: size})
+	b.mu.Unlock()
+	return size, nil
+}
+
+// download downloads the uploaded objects in turn.
+func (b *benchRun) download(ctx context.Context) (_ int64, err error) {
+	b.mu.Lock()
+	object := b.objects[b.next%len(b.objects)]
+	b.next++
+	b.mu.Unlock()
+
+	mrh, err := b.fs.Open(ctx, object.loc)
+	if err != nil {
+		return 0, err
+	}
+	defer func() { err = errs.Combine(err, mrh.Close()) }()
+
+	rh, err := mrh.NextPart(ctx, -1)
+	if err != nil {
+		return 0, err
+	}
+	defer func() { err = errs.Combine(err, rh.Close()) }()
+
+	n, err := io.Copy(io.Discard, rh)
+	if err != nil {
+		return n, err
+	}
+	if n != object.size {
+		return n, errs.New("downloaded %d bytes of %s, expected %d", n, object.loc, object.size)
+	}
+	return n, nil
+}
+
+// cleanup removes the uploaded objects.
+func (b *benchRun) cleanup(ctx context.Context) error {
+	b.mu.Lock()
+	objects := b.objects
+	b.objects = nil
+	b.mu.Unlock()
+
+	var group errs.Group
+	for _, object := range objects {
+		group.Add(b.fs.Remove(ctx, object.loc, nil))
+	}
+	return group.Err()
+}
+
+// throughput returns the transferred bytes per second.
+func (r benchResult) throughput() string {
+	if r.elapsed <= 0 {
+		return "-"
+	}
+	return memory.Size(float64(r.bytes)/r.elapsed.Seconds()).String() + "/s"
+}
+
+// percentile returns the latency of the successful operations at the
+// percentile p, which is in [0, 1].
+func (r benchResult) percentile(p float64) string {
+	if len(r.latencies) == 0 {
+		return "-"
+	}
+	index := int(float64(len(r.latencies))*p+0.5) - 1
+	if index < 0 {
+		index = 0
+	}
+	if index >= len(r.latencies) {
+		index = len(r.latencies) - 1
+	}
+	return r.latencies[index].Round(time.Microsecond).String()
+}
+
+// benchSize is an object size with its relative weight.
+type benchSize struct {
+	Size   memory.Size
+	Weight int
+}
+
+// benchSizes is a distribution of object sizes.
+type benchSizes []benchSize
+
+// pick returns a random size from the distribution.
+func (sizes benchSizes) pick(r *rand.Rand) int64 {
+	total := 0
+	for _, size := range sizes {
+		total += size.Weight
+	}
+	n := r.Intn(total)
+	for _, size := range sizes {
+		if n < size.Weight {
+			return size.Size.Int64()
+		}
+		n -= size.Weight
+	}
+	return sizes[len(sizes)-1].Size.Int64()
+}
+
+// parseBenchSizes parses a comma separated list of sizes with optional
+// weights, e.g. '1KiB:10,1MiB:5,64MiB'.
+func parseBenchSizes(value string) (benchSizes, error) {
+	var sizes benchSizes
+	for _, part := range strings.Split(value, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		sizeString, weightString, hasWeight := strings.Cut(part, ":")
+		// memory.ParseString doesn't handle sizes without digits.
+		if strings.IndexAny(sizeString, "0123456789") < 0 {
+			return nil, errs.New("invalid size %q", sizeString)
+		}
+		size, err := memory.ParseString(sizeString)
+		if err != nil {
+			return nil, errs.New("invalid size %q: %v", sizeString, err)
+		}
+		if size <= 0 {
+			return nil, errs.New("size %q must be positive", sizeString)
+		}
+
+		weight := 1
+		if hasWeight {
+			weight, err = strconv.Atoi(weightString)
+			if err != nil || weight <= 0 {
+				return nil, errs.New("invalid weight %q", weightString)
+			}
+		}
+		sizes = append(sizes, benchSize{Size: memory.Size(size), Weight: weight})
+	}
+	if len(sizes) == 0 {
+		return nil, errs.New("at least one size is required")
+	}
+	return sizes, nil
+}
+
+// parseBenchOperations parses a comma separated list of operations.
+func parseBenchOperations(value string) ([]string, error) {
+	var operations []string
+	for _, operation := range strings.Split(value, ",") {
+		operation = strings.TrimSpace(operation)
+		switch operation {
+		case "":
+			continue
+		case "upload", "download":
+			operations = append(operations, operation)
+		default:
+			return nil, errs.New("unknown operation %q", operation)
+		}
+	}
+	if len(operations) == 0 {
+		return nil, errs.New("at least one operation is required")
+	}
+	return operations, nil
+}
+
+// benchDataSize is the size of the random data, which is repeated for the
+// uploaded objects.
+const benchDataSize = 1 << 20
+
+// newBenchData returns random data for the uploaded objects.
+func newBenchData() []byte {
+	data := make([]byte, benchDataSize)
+	_, _ = rand.New(rand.NewSource(time.Now().UnixNano())).Read(data)
+	return data
+}
+
+// benchReader repeats data until remaining bytes have been read.
+type benchReader struct {
+	data      []byte
+	offset    int
+	remaining int64
+}
+
+// Read implements io.Reader.
+func (r *benchReader) Read(p []byte) (int, error) {
+	if r.remaining <= 0 {
+		return 0, io.EOF
+	}
+	if int64(len(p)) > r.remaining {
+		p = p[:r.remaining]
+	}
+	n := copy(p, r.data[r.offset:])
+	r.offset = (r.offset + n) % len(r.data)
+	r.remaining -= int64(n)
+	return n, nil
+}
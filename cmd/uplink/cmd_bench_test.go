@@ -0,0 +1,64 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/memory"
+	"storj.io/storj/cmd/uplink/ultest"
+)
+
+func TestBench(t *testing.T) {
+	state := ultest.Setup(commands,
+		ultest.WithFile("sj://user/other.txt"),
+	)
+
+	t.Run("Basic", func(t *testing.T) {
+		result := state.Succeed(t, "bench", "sj://user/bench", "--sizes", "1KiB:3,4KiB", "-p", "2", "--max-operations", "4")
+		result.RequireFiles(t, ultest.File{Loc: "sj://user/other.txt"})
+
+		lines := strings.Split(strings.TrimSpace(result.Stdout), "\n")
+		require.Len(t, lines, 3)
+		require.Equal(t, []string{"OPERATION", "COUNT", "ERRORS"}, strings.Fields(lines[0])[:3])
+		require.Equal(t, []string{"upload", "4", "0"}, strings.Fields(lines[1])[:3])
+		require.Equal(t, []string{"download", "4", "0"}, strings.Fields(lines[2])[:3])
+	})
+
+	t.Run("DownloadOnly", func(t *testing.T) {
+		result := state.Succeed(t, "bench", "sj://user/bench", "--sizes", "1KiB", "--operations", "download", "--max-operations", "3")
+		result.RequireFiles(t, ultest.File{Loc: "sj://user/other.txt"})
+		require.Contains(t, result.Stdout, "download")
+		require.NotContains(t, result.Stdout, "upload")
+	})
+
+	t.Run("Keep", func(t *testing.T) {
+		result := state.Succeed(t, "bench", "sj://user/bench", "--sizes", "1KiB", "--operations", "upload", "--max-operations", "1", "--keep")
+		require.Len(t, result.Files, 2)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		state.Fail(t, "bench", "/home/user/bench")
+		state.Fail(t, "bench", "sj://user/bench", "--sizes", "0B")
+		state.Fail(t, "bench", "sj://user/bench", "--operations", "delete")
+	})
+}
+
+func TestParseBenchSizes(t *testing.T) {
+	sizes, err := parseBenchSizes("1KiB:10, 1MiB:5,64MiB")
+	require.NoError(t, err)
+	require.Equal(t, benchSizes{
+		{Size: memory.KiB, Weight: 10},
+		{Size: memory.MiB, Weight: 5},
+		{Size: 64 * memory.MiB, Weight: 1},
+	}, sizes)
+
+	for _, invalid := range []string{"", "1KiB:0", "1KiB:x", "-1KiB", "foo"} {
+		_, err := parseBenchSizes(invalid)
+		require.Error(t, err, invalid)
+	}
+}
@@ -43,12 +43,18 @@ type cmdCp struct {
 	parallelismChunkSize memory.Size
 
 	inmemoryEC bool
+	resumable  bool
+
+	filter objectFilter
 
 	locs []ulloc.Location
 }
 
 const maxPartCount int64 = 10000
 
+// minDownloadPartSize is the smallest range downloaded in parallel.
+const minDownloadPartSize = 4 * memory.MiB
+
 func newCmdCp(ex ulext.External) *cmdCp {
 	return &cmdCp{ex: ex}
 }
@@ -103,6 +109,10 @@ func (c *cmdCp) Setup(params clingy.Parameters) {
 		clingy.Advanced,
 	).(bool)
 
+	c.resumable = params.Flag("resumable", "Keep the state of uploads of local files with multiple parts, so that interrupted uploads can be resumed", false,
+		clingy.Transform(strconv.ParseBool), clingy.Boolean,
+	).(bool)
+
 	c.expires = params.Flag("expires",
 		"Schedule removal after this time (e.g. '+2h', 'now', '2020-01-02T15:04:05Z0700')",
 		time.Time{}, clingy.Transform(parseHumanDate), clingy.Type("relative_date")).(time.Time)
@@ -111,6 +121,8 @@ func (c *cmdCp) Setup(params clingy.Parameters) {
 		"optional metadata for the object. Please use a single level JSON object of string to string only",
 		nil, clingy.Transform(parseJSON), clingy.Type("string")).(map[string]string)
 
+	c.filter.Setup(params)
+
 	c.locs = params.Arg("locations", "Locations to copy (at least one source and one destination). Use - for standard input/output",
 		clingy.Transform(ulloc.Parse),
 		clingy.Repeated,
@@ -163,6 +175,8 @@ func (c *cmdCp) dispatchCopy(ctx context.Context, fs ulfs.Filesystem, source, de
 			return errs.New("unable to do recursive copy with byte range")
 		}
 		return c.copyRecursive(ctx, fs, source, dest)
+	} else if c.filter.Enabled() {
+		return errs.New("filters can only be used with --recursive")
 	}
 
 	// if the destination is directoryish, we add the basename of the source
@@ -197,6 +211,7 @@ func (c *cmdCp) copyRecursive(ctx context.Context, fs ulfs.Filesystem, source, d
 	if err != nil {
 		return err
 	}
+	iter = c.filter.Filter(source, iter)
 
 	var (
 		limiter = sync2.NewLimiter(c.transfers)
@@ -273,14 +288,56 @@ func (c *cmdCp) copyFile(ctx context.Context, fs ulfs.Filesystem, source, dest u
 	}
 	defer func() { _ = mrh.Close() }()
 
-	mwh, err := fs.Create(ctx, dest, &ulfs.CreateOptions{
+	var partSize int64
+	if source.Remote() && dest.Local() {
+		partSize = c.calculateDownloadPartSize(mrh.Length(), c.parallelismChunkSize.Int64())
+	} else {
+		partSize, err = c.calculatePartSize(mrh.Length(), c.parallelismChunkSize.Int64())
+		if err != nil {
+			return err
+		}
+	}
+
+	opts := &ulfs.CreateOptions{
 		Expires:  c.expires,
 		Metadata: c.metadata,
-	})
+		Length:   expectedLength(offset, length, mrh.Length()),
+	}
+
+	// uploads of local files with multiple parts keep their state, so that
+	// they can be resumed when interrupted.
+	resumable := c.resumable && source.Local() && dest.Remote() && c.byteRange == "" && mrh.Length() > partSize
+
+	var info *ulfs.ObjectInfo
+	var previous *ulext.ResumableUpload
+	if resumable {
+		info, err = mrh.Info(ctx)
+		if err != nil {
+			return err
+		}
+		previous, err = findResumableUpload(ctx, c.ex, fs, source, dest, info, partSize)
+		if err != nil {
+			return err
+		}
+		if previous != nil {
+			opts.UploadID = previous.UploadID
+		}
+	}
+
+	mwh, err := fs.Create(ctx, dest, opts)
 	if err != nil {
 		return err
 	}
-	defer func() { _ = mwh.Abort(ctx) }()
+
+	var resume *resumableUpload
+	if handle, ok := mwh.(ulfs.ResumableMultiWriteHandle); ok && resumable {
+		resume, err = startResumableUpload(c.ex, handle, source, dest, info, partSize, previous)
+		if err != nil {
+			return errs.Combine(err, mwh.Abort(ctx))
+		}
+	} else {
+		defer func() { _ = mwh.Abort(ctx) }()
+	}
 
 	var bar *progressbar.ProgressBar
 	if progress && !dest.Std() {
@@ -288,19 +345,18 @@ func (c *cmdCp) copyFile(ctx context.Context, fs ulfs.Filesystem, source, dest u
 		defer bar.Finish()
 	}
 
-	partSize, err := c.calculatePartSize(mrh.Length(), c.parallelismChunkSize.Int64())
-	if err != nil {
-		return err
-	}
-
-	return errs.Wrap(c.parallelCopy(
+	err = c.parallelCopy(
 		ctx,
 		source, dest,
 		mwh, mrh,
 		c.parallelism, partSize,
 		offset, length,
-		bar,
-	))
+		bar, resume,
+	)
+	if err != nil && resume != nil {
+		return errs.New("%v\nthe upload can be resumed by running the same command again", err)
+	}
+	return errs.Wrap(err)
 }
 
 // calculatePartSize returns the needed part size in order to upload the file with size of 'length'.
@@ -320,6 +376,50 @@ func (c *cmdCp) calculatePartSize(length, preferredSize int64) (requiredSize int
 	}
 }
 
+// calculateDownloadPartSize returns the part size in order to download the object with size of 'length'.
+// Unless the client requests a certain size, the object is split so that every parallel download gets
+// a range of at least minDownloadPartSize and at most 64MiB.
+func (c *cmdCp) calculateDownloadPartSize(length, preferredSize int64) int64 {
+	maxSize := (memory.MiB * 64).Int64()
+	switch {
+	case preferredSize > 0:
+		return preferredSize
+	case length <= 0 || c.parallelism <= 1:
+		return maxSize
+	}
+
+	partSize := (length + int64(c.parallelism) - 1) / int64(c.parallelism)
+	switch {
+	case partSize < minDownloadPartSize.Int64():
+		return minDownloadPartSize.Int64()
+	case partSize > maxSize:
+		return maxSize
+	default:
+		return partSize
+	}
+}
+
+// expectedLength returns the number of bytes copied from a source with size of 'total'
+// for the given offset and length, or -1 if it's unknown.
+func expectedLength(offset, length, total int64) int64 {
+	switch {
+	case total < 0:
+		return length
+	case offset < 0:
+		offset += total
+		if offset < 0 {
+			return -1
+		}
+	case offset > total:
+		return -1
+	}
+
+	if length < 0 || offset+length > total {
+		return total - offset
+	}
+	return length
+}
+
 func copyVerb(source, dest ulloc.Location) string {
 	switch {
 	case dest.Remote():
@@ -350,7 +450,8 @@ func (c *cmdCp) parallelCopy(
 	src ulfs.MultiReadHandle,
 	p int, chunkSize int64,
 	offset, length int64,
-	bar *progressbar.ProgressBar) error {
+	bar *progressbar.ProgressBar,
+	resume *resumableUpload) error {
 
 	if offset != 0 {
 		if err := src.SetOffset(offset); err != nil {
@@ -368,6 +469,10 @@ func (c *cmdCp) parallelCopy(
 
 	defer func() { _ = src.Close() }()
 	defer func() {
+		// resumable uploads are kept pending to be continued later.
+		if resume != nil {
+			return
+		}
 		nocancel := context2.WithoutCancellation(ctx)
 		timedctx, cancel := context.WithTimeout(nocancel, 5*time.Second)
 		defer cancel()
@@ -404,6 +509,27 @@ func (c *cmdCp) parallelCopy(
 		}
 		length -= chunk
 
+		if resume != nil && resume.Done(uint32(i+1)) {
+			rh, err := src.NextPart(ctx, chunk)
+			if err != nil {
+				if !errors.Is(err, io.EOF) {
+					addError(errs.New("error getting reader for part %d: %v", i, err))
+				}
+				break
+			}
+			_ = rh.Close()
+
+			if err := resume.handle.SkipPart(ctx); err != nil {
+				addError(errs.New("error skipping part %d: %v", i, err))
+				break
+			}
+			if bar != nil {
+				bar.SetTotal(rh.Info().ContentLength).Start()
+				bar.Add64(chunk)
+			}
+			continue
+		}
+
 		rh, err := src.NextPart(ctx, chunk)
 		if err != nil {
 			if !errors.Is(err, io.EOF) {
@@ -441,6 +567,9 @@ func (c *cmdCp) parallelCopy(
 			if err == nil {
 				err = wh.Commit()
 			}
+			if err == nil && resume != nil {
+				err = resume.Complete(uint32(i + 1))
+			}
 
 			if err != nil {
 				// TODO: it would be also nice to use wh.Abort and rh.Close directly
@@ -464,6 +593,10 @@ func (c *cmdCp) parallelCopy(
 	// don't try to commit if any error occur
 	if len(es) == 0 {
 		es.Add(dst.Commit(ctx))
+
+		if len(es) == 0 && resume != nil {
+			es.Add(resume.Finish())
+		}
 	}
 
 	return errs.Wrap(combineErrs(es))
@@ -94,6 +94,20 @@ func TestCpDownload(t *testing.T) {
 		// invalid range
 		state.Fail(t, "cp", "sj://user/file-for-byte-range", "/home/user/dest/file-for-byte-range", "--range", "bytes=0,-1").RequireFailure(t).RequireLocalFiles(t)
 	})
+
+	t.Run("Parallel", func(t *testing.T) {
+		state := ultest.Setup(commands,
+			ultest.WithFile("sj://user/file-for-parallel", "abcdefghijklmnopqrstuvwxyz"),
+		)
+
+		state.Succeed(t, "cp", "sj://user/file-for-parallel", "/home/user/dest/file-for-parallel", "--parallelism", "4", "--parallelism-chunk-size", "5B").RequireLocalFiles(t,
+			ultest.File{Loc: "/home/user/dest/file-for-parallel", Contents: "abcdefghijklmnopqrstuvwxyz"},
+		)
+
+		state.Succeed(t, "cp", "sj://user/file-for-parallel", "/home/user/dest/file-for-parallel", "--parallelism", "4", "--parallelism-chunk-size", "5B", "--range", "bytes=3-12").RequireLocalFiles(t,
+			ultest.File{Loc: "/home/user/dest/file-for-parallel", Contents: "defghijklm"},
+		)
+	})
 }
 
 func TestCpPartSize(t *testing.T) {
@@ -141,6 +155,54 @@ func TestCpPartSize(t *testing.T) {
 	require.EqualValues(t, memory.MiB*64, partSize)
 }
 
+func TestCpFilter(t *testing.T) {
+	state := ultest.Setup(commands,
+		ultest.WithFile("/home/user/src/small.txt", "small"),
+		ultest.WithFile("/home/user/src/large.txt", "large contents"),
+		ultest.WithFile("/home/user/src/image.jpg", "image contents"),
+		ultest.WithFile("/home/user/src/deep/large.txt", "deep contents"),
+		ultest.WithBucket("user"),
+	)
+
+	state.Succeed(t, "cp", "/home/user/src", "sj://user/dst", "--recursive", "--include", "*.txt", "--min-size", "10B").RequireRemoteFiles(t,
+		ultest.File{Loc: "sj://user/dst/deep/large.txt", Contents: "deep contents"},
+		ultest.File{Loc: "sj://user/dst/large.txt", Contents: "large contents"},
+	)
+
+	state.Succeed(t, "cp", "/home/user/src", "sj://user/dst", "--recursive", "--exclude", "deep/*", "--max-size", "5B").RequireRemoteFiles(t,
+		ultest.File{Loc: "sj://user/dst/small.txt", Contents: "small"},
+	)
+
+	state.Fail(t, "cp", "/home/user/src/small.txt", "sj://user/dst/", "--include", "*.txt")
+}
+
+func TestCpDownloadPartSize(t *testing.T) {
+	c := newCmdCp(nil)
+	c.parallelism = 8
+
+	// 1GiB file, should return 64MiB.
+	require.EqualValues(t, memory.MiB*64, c.calculateDownloadPartSize(memory.GiB.Int64(), 0))
+
+	// 256MiB file, should be split between all parallel downloads.
+	require.EqualValues(t, memory.MiB*32, c.calculateDownloadPartSize(memory.MiB.Int64()*256, 0))
+
+	// 10MiB file, should return the minimum part size.
+	require.EqualValues(t, memory.MiB*4, c.calculateDownloadPartSize(memory.MiB.Int64()*10, 0))
+
+	// should return 1MiB as requested.
+	require.EqualValues(t, memory.MiB, c.calculateDownloadPartSize(memory.GiB.Int64(), memory.MiB.Int64()))
+
+	// unknown length should return 64MiB.
+	require.EqualValues(t, memory.MiB*64, c.calculateDownloadPartSize(-1, 0))
+
+	require.EqualValues(t, 26, expectedLength(0, -1, 26))
+	require.EqualValues(t, 3, expectedLength(0, 3, 26))
+	require.EqualValues(t, 1, expectedLength(-1, -1, 26))
+	require.EqualValues(t, 6, expectedLength(20, 10, 26))
+	require.EqualValues(t, -1, expectedLength(30, -1, 26))
+	require.EqualValues(t, -1, expectedLength(0, -1, -1))
+}
+
 func TestCpUpload(t *testing.T) {
 	state := ultest.Setup(commands,
 		ultest.WithFile("/home/user/file1.txt", "local"),
@@ -29,6 +29,8 @@ type cmdLs struct {
 	utc       bool
 	output    string
 
+	filter objectFilter
+
 	prefix *ulloc.Location
 }
 
@@ -59,6 +61,8 @@ func (c *cmdLs) Setup(params clingy.Parameters) {
 		clingy.Short('o'),
 	).(string)
 
+	c.filter.Setup(params)
+
 	c.prefix = params.Arg("prefix", "Prefix to list (sj://BUCKET[/KEY])", clingy.Optional,
 		clingy.Transform(ulloc.Parse),
 	).(*ulloc.Location)
@@ -110,6 +114,7 @@ func (c *cmdLs) listLocation(ctx context.Context, prefix ulloc.Location) error {
 	if err != nil {
 		return err
 	}
+	iter = c.filter.Filter(prefix, iter)
 
 	switch c.output {
 	case "tabbed":
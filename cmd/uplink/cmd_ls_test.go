@@ -50,6 +50,19 @@ func TestLsRemote(t *testing.T) {
 		state.Succeed(t, "ls", "sj://user/fo", "--utc").RequireStdout(t, ``)
 	})
 
+	t.Run("Filter", func(t *testing.T) {
+		state.Succeed(t, "ls", "sj://user", "--recursive", "--utc", "--include", "foo*/*", "--exclude", "2").RequireStdout(t, `
+			KIND    CREATED                SIZE    KEY
+			OBJ     1970-01-01 00:00:06    0       foobar/1
+			OBJ     1970-01-01 00:00:08    0       foobar/3
+			OBJ     1970-01-01 00:00:09    0       foobaz/1
+		`)
+
+		state.Succeed(t, "ls", "sj://user", "--recursive", "--utc", "--newer-than", "1h").RequireStdout(t, ``)
+
+		state.Fail(t, "ls", "sj://user", "--include", "[")
+	})
+
 	t.Run("ExactPrefix", func(t *testing.T) {
 		state.Succeed(t, "ls", "sj://user/foobar", "--utc").RequireStdout(t, `
 			KIND    CREATED                SIZE    KEY
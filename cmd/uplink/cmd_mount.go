@@ -0,0 +1,106 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/zeebo/clingy"
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/cmd/uplink/ulext"
+	"storj.io/storj/cmd/uplink/ulloc"
+	"storj.io/storj/cmd/uplink/ulmount"
+)
+
+type cmdMount struct {
+	ex ulext.External
+
+	access         string
+	readonly       bool
+	cacheTTL       time.Duration
+	writeBack      ulmount.WriteBack
+	writeBackDelay time.Duration
+	cacheDir       string
+
+	loc ulloc.Location
+	dir string
+}
+
+func newCmdMount(ex ulext.External) *cmdMount {
+	return &cmdMount{ex: ex}
+}
+
+func (c *cmdMount) Setup(params clingy.Parameters) {
+	c.access = params.Flag("access", "Access name or value to use", "").(string)
+	c.readonly = params.Flag("readonly", "Mount the filesystem read-only", false,
+		clingy.Transform(strconv.ParseBool), clingy.Boolean,
+	).(bool)
+	c.cacheTTL = params.Flag("cache-ttl", "How long listed metadata is cached before objects are listed again", time.Minute,
+		clingy.Transform(time.ParseDuration),
+	).(time.Duration)
+	c.writeBack = params.Flag("write-back", "When written files are uploaded: 'sync' uploads when a file is closed, 'async' uploads in the background after --write-back-delay", ulmount.WriteBackSync,
+		clingy.Transform(ulmount.ParseWriteBack),
+	).(ulmount.WriteBack)
+	c.writeBackDelay = params.Flag("write-back-delay", "How long a closed file is kept locally before it is uploaded with --write-back=async", 5*time.Second,
+		clingy.Transform(time.ParseDuration),
+	).(time.Duration)
+	c.cacheDir = params.Flag("cache-dir", "Directory for the local copies of written files (defaults to the temporary directory)", "").(string)
+
+	c.loc = params.Arg("location", "Bucket or prefix to mount (sj://BUCKET[/PREFIX])",
+		clingy.Transform(ulloc.Parse),
+	).(ulloc.Location)
+	c.dir = params.Arg("dir", "Local directory to mount on").(string)
+}
+
+func (c *cmdMount) Execute(ctx context.Context) (err error) {
+	bucket, prefix, ok := c.loc.RemoteParts()
+	if !ok || bucket == "" {
+		return errs.New("location must be remote and include a bucket")
+	}
+
+	project, err := c.ex.OpenProject(ctx, c.access)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = project.Close() }()
+
+	server, err := ulmount.Mount(ctx, project, bucket, prefix, c.dir, ulmount.Config{
+		ReadOnly:       c.readonly,
+		CacheTTL:       c.cacheTTL,
+		WriteBack:      c.writeBack,
+		WriteBackDelay: c.writeBackDelay,
+		CacheDir:       c.cacheDir,
+		OnWriteBackError: func(key string, err error) {
+			fmt.Fprintf(clingy.Stderr(ctx), "failed to upload %q: %v\n", key, err)
+		},
+	})
+	if err != nil {
+		return err
+	}
+	defer func() { err = errs.Combine(err, server.Close(ctx)) }()
+
+	fmt.Fprintf(clingy.Stdout(ctx), "Mounted %s on %s, press Ctrl+C to unmount.\n", c.loc, c.dir)
+
+	interrupted, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	unmounted := make(chan struct{})
+	go func() {
+		server.Wait()
+		close(unmounted)
+	}()
+
+	select {
+	case <-interrupted.Done():
+	case <-unmounted:
+	}
+	return nil
+}
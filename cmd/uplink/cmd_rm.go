@@ -28,6 +28,8 @@ type cmdRm struct {
 	encrypted   bool
 	pending     bool
 
+	filter objectFilter
+
 	location ulloc.Location
 }
 
@@ -58,6 +60,8 @@ func (c *cmdRm) Setup(params clingy.Parameters) {
 		clingy.Transform(strconv.ParseBool), clingy.Boolean,
 	).(bool)
 
+	c.filter.Setup(params)
+
 	c.location = params.Arg("location", "Location to remove (sj://BUCKET[/KEY])",
 		clingy.Transform(ulloc.Parse),
 	).(ulloc.Location)
@@ -75,6 +79,10 @@ func (c *cmdRm) Execute(ctx context.Context) error {
 	defer func() { _ = fs.Close() }()
 
 	if !c.recursive {
+		if c.filter.Enabled() {
+			return errs.New("filters can only be used with --recursive")
+		}
+
 		err := fs.Remove(ctx, c.location, &ulfs.RemoveOptions{
 			Pending: c.pending,
 		})
@@ -93,6 +101,7 @@ func (c *cmdRm) Execute(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	iter = c.filter.Filter(c.location, iter)
 
 	var (
 		limiter = sync2.NewLimiter(c.parallelism)
@@ -41,6 +41,24 @@ func TestRmRemote(t *testing.T) {
 		)
 	})
 
+	t.Run("Filter", func(t *testing.T) {
+		state := ultest.Setup(commands,
+			ultest.WithFile("sj://user/files/file1.txt"),
+			ultest.WithFile("sj://user/files/file2.jpg"),
+			ultest.WithFile("sj://user/files/deep/file3.txt"),
+		)
+
+		state.Succeed(t, "rm", "sj://user/files", "-r", "--include", "*.txt", "--older-than", "24h").RequireFiles(t,
+			ultest.File{Loc: "sj://user/files/file2.jpg"},
+		)
+
+		state.Succeed(t, "rm", "sj://user/files", "-r", "--exclude", "deep/*").RequireFiles(t,
+			ultest.File{Loc: "sj://user/files/deep/file3.txt"},
+		)
+
+		state.Fail(t, "rm", "sj://user/files/file1.txt", "--include", "*.txt")
+	})
+
 	t.Run("Pending", func(t *testing.T) {
 		state := ultest.Setup(commands,
 			ultest.WithPendingFile("sj://user/files/file1.txt"),
@@ -16,6 +16,7 @@ import (
 	"github.com/zeebo/clingy"
 	"github.com/zeebo/errs"
 
+	"storj.io/storj/cmd/uplink/cmd"
 	"storj.io/storj/cmd/uplink/ulext"
 	"storj.io/uplink"
 	"storj.io/uplink/edge"
@@ -54,6 +55,7 @@ func (c *cmdShare) Setup(params clingy.Parameters) {
 	).(bool)
 	c.dns = params.Flag("dns", "Specify your custom hostname. if set, returns dns settings for web hosting. implies --register and --public", "").(string)
 	c.authService = params.Flag("auth-service", "URL for shared auth service", "https://auth.storjshare.io").(string)
+	c.caCert = params.Flag("ca-cert", "path to a file in PEM format with certificate(s) or certificate chain(s) to validate the auth service against", "").(string)
 	c.public = params.Flag("public", "If true, the access will be public. --dns and --url override this", false,
 		clingy.Transform(strconv.ParseBool), clingy.Boolean,
 	).(bool)
@@ -89,6 +91,10 @@ func (c *cmdShare) Execute(ctx context.Context) error {
 		}
 	}
 
+	if c.caCert != "" && !c.register {
+		return errs.New("--ca-cert is only used when registering the access, use it with --register")
+	}
+
 	newAccessData, err := access.Serialize()
 	if err != nil {
 		return err
@@ -196,34 +202,10 @@ func formatPaths(sharePrefixes []uplink.SharePrefix) string {
 
 // RegisterAccess registers an access grant with a Gateway Authorization Service.
 func RegisterAccess(ctx context.Context, access *uplink.Access, authService string, public bool, certificateFile string) (credentials *edge.Credentials, err error) {
-	if authService == "" {
-		return nil, errs.New("no auth service address provided")
-	}
-
-	var edgeConfig edge.Config
-
-	if strings.HasPrefix(authService, "insecure://") {
-		authService = strings.TrimPrefix(authService, "insecure://")
-		edgeConfig.InsecureSkipVerify = true
-	}
-	// preserve compatibility with previous https service
-	authService = strings.TrimPrefix(authService, "https://")
-	authService = strings.TrimSuffix(authService, "/")
-	if !strings.Contains(authService, ":") {
-		authService += ":7777"
-	}
-
-	var certificatePEM []byte
-	if certificateFile != "" {
-		certificatePEM, err = os.ReadFile(certificateFile)
-		if err != nil {
-			return nil, errs.New("can't read certificate file: %w", err)
-		}
+	edgeConfig, err := cmd.EdgeConfig(authService, certificateFile)
+	if err != nil {
+		return nil, err
 	}
-
-	edgeConfig.AuthServiceAddress = authService
-	edgeConfig.CertificatePEM = certificatePEM
-
 	return edgeConfig.RegisterAccess(ctx, access, &edge.RegisterAccessOptions{Public: public})
 }
 
@@ -4,6 +4,9 @@
 package main
 
 import (
+	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -96,6 +99,27 @@ func TestShare(t *testing.T) {
 		require.Equal(t, "permission is empty", result.Err.Error())
 	})
 
+	t.Run("share access with --ca-cert without registering", func(t *testing.T) {
+		state := ultest.Setup(commands)
+
+		result := state.Fail(t, "share", "--ca-cert", "ca.pem", "sj://some/prefix")
+
+		require.Equal(t, "--ca-cert is only used when registering the access, use it with --register", result.Err.Error())
+	})
+
+	t.Run("share access with invalid --ca-cert", func(t *testing.T) {
+		state := ultest.Setup(commands)
+
+		caCert := filepath.Join(t.TempDir(), "ca.pem")
+		require.NoError(t, os.WriteFile(caCert, []byte("not a certificate"), 0644))
+
+		result := state.Fail(t, "share", "--register", "--ca-cert", caCert, "sj://some/prefix")
+		require.Equal(t, fmt.Sprintf("no PEM certificates found in %q", caCert), result.Err.Error())
+
+		result = state.Fail(t, "share", "--register", "--ca-cert", caCert, "--auth-service", "insecure://localhost:7777", "sj://some/prefix")
+		require.Equal(t, "the certificate can't be validated with an insecure auth service", result.Err.Error())
+	})
+
 	t.Run("share access with --public", func(t *testing.T) {
 		// Can't run this scenario because AuthService is not running in testplanet.
 		// If necessary we can mock AuthService like in https://github.com/storj/uplink/blob/main/testsuite/edge_test.go
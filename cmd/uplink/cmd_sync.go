@@ -0,0 +1,552 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/zeebo/clingy"
+	"github.com/zeebo/errs"
+
+	"storj.io/common/sync2"
+	"storj.io/storj/cmd/uplink/ulext"
+	"storj.io/storj/cmd/uplink/ulfs"
+	"storj.io/storj/cmd/uplink/ulloc"
+)
+
+// defaultSyncStateName is the name of the state file kept in the local
+// directory when no --state flag is provided.
+const defaultSyncStateName = ".uplink-sync"
+
+// syncETagKey is the custom metadata key, which the ETag of an object is
+// stored under. It's the same key as the S3 gateway uses, so the objects
+// uploaded through it are compared by their ETag as well.
+const syncETagKey = "s3:etag"
+
+type cmdSync struct {
+	ex ulext.External
+
+	access        string
+	bidirectional bool
+	delete        bool
+	transfers     int
+	dryrun        bool
+	state         string
+
+	source ulloc.Location
+	dest   ulloc.Location
+}
+
+func newCmdSync(ex ulext.External) *cmdSync {
+	return &cmdSync{ex: ex}
+}
+
+func (c *cmdSync) Setup(params clingy.Parameters) {
+	c.access = params.Flag("access", "Access name or value to use", "").(string)
+	c.bidirectional = params.Flag("bidirectional", "Propagate changes and removals in both directions", false,
+		clingy.Short('b'),
+		clingy.Transform(strconv.ParseBool), clingy.Boolean,
+	).(bool)
+	c.delete = params.Flag("delete", "Remove files or objects from the destination which don't exist in the source", false,
+		clingy.Transform(strconv.ParseBool), clingy.Boolean,
+	).(bool)
+	c.transfers = params.Flag("transfers", "Controls how many uploads/downloads/removes to perform in parallel", 1,
+		clingy.Short('t'),
+		clingy.Transform(strconv.Atoi),
+		clingy.Transform(func(n int) (int, error) {
+			if n <= 0 {
+				return 0, errs.New("transfers must be at least 1")
+			}
+			return n, nil
+		}),
+	).(int)
+	c.dryrun = params.Flag("dry-run", "Print what operations would happen but don't execute them", false,
+		clingy.Transform(strconv.ParseBool), clingy.Boolean,
+	).(bool)
+	c.state = params.Flag("state", "Local file where the state of the last sync is stored (default: "+defaultSyncStateName+" in the local directory)", "").(string)
+
+	c.source = params.Arg("source", "Directory or prefix to sync from", clingy.Transform(ulloc.Parse)).(ulloc.Location)
+	c.dest = params.Arg("dest", "Directory or prefix to sync to", clingy.Transform(ulloc.Parse)).(ulloc.Location)
+}
+
+func (c *cmdSync) Execute(ctx context.Context) error {
+	switch {
+	case c.source.Std() || c.dest.Std():
+		return errs.New("cannot sync to stdin/stdout")
+	case c.source.Remote() == c.dest.Remote():
+		return errs.New("one of source and dest must be local and the other a remote sj:// location")
+	case c.bidirectional && c.delete:
+		return errs.New("--delete cannot be used with --bidirectional")
+	}
+
+	fs, err := c.ex.OpenFilesystem(ctx, c.access)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = fs.Close() }()
+
+	source, dest := c.source.AsDirectoryish(), c.dest.AsDirectoryish()
+
+	local, remote := source, dest
+	if source.Remote() {
+		local, remote = dest, source
+	}
+
+	stateLoc := local.AppendKey(defaultSyncStateName)
+	if c.state != "" {
+		stateLoc = ulloc.NewLocal(c.state)
+	}
+
+	state, err := loadSyncState(ctx, fs, stateLoc)
+	if err != nil {
+		return err
+	}
+
+	localFiles, err := listSyncFiles(ctx, fs, local, stateLoc)
+	if err != nil {
+		return err
+	}
+	remoteFiles, err := listSyncFiles(ctx, fs, remote, stateLoc)
+	if err != nil {
+		return err
+	}
+
+	if err := addLocalETags(ctx, fs, localFiles, remoteFiles); err != nil {
+		return err
+	}
+
+	var ops []syncOperation
+	if c.bidirectional {
+		ops = planBidirectionalSync(local, remote, localFiles, remoteFiles, state)
+	} else {
+		sourceFiles, destFiles := localFiles, remoteFiles
+		if source.Remote() {
+			sourceFiles, destFiles = remoteFiles, localFiles
+		}
+		ops = planOneWaySync(source, dest, sourceFiles, destFiles, state, source.Remote(), c.delete)
+	}
+
+	if c.dryrun {
+		for _, op := range ops {
+			fmt.Fprintln(clingy.Stdout(ctx), op)
+		}
+		return nil
+	}
+
+	failed := c.execute(ctx, fs, ops)
+
+	// the files are listed again to record the state after the transfers.
+	localFiles, err = listSyncFiles(ctx, fs, local, stateLoc)
+	if err != nil {
+		return err
+	}
+	remoteFiles, err = listSyncFiles(ctx, fs, remote, stateLoc)
+	if err != nil {
+		return err
+	}
+
+	newState := syncState{Files: make(map[string]syncStateEntry)}
+	for key, localInfo := range localFiles {
+		remoteInfo, ok := remoteFiles[key]
+		if !ok || localInfo.ContentLength != remoteInfo.ContentLength {
+			continue
+		}
+		if _, failed := failed[key]; failed {
+			continue
+		}
+		newState.Files[key] = syncStateEntry{
+			Local:  newSyncFileState(localInfo),
+			Remote: newSyncFileState(remoteInfo),
+		}
+	}
+
+	if err := saveSyncState(ctx, fs, stateLoc, newState); err != nil {
+		return err
+	}
+
+	if len(failed) > 0 {
+		var es errs.Group
+		for _, err := range failed {
+			es.Add(err)
+		}
+		return combineErrs(es)
+	}
+	return nil
+}
+
+// execute runs the operations in parallel and returns the errors of the
+// failed operations keyed by the relative key.
+func (c *cmdSync) execute(ctx context.Context, fs ulfs.Filesystem, ops []syncOperation) map[string]error {
+	var (
+		limiter = sync2.NewLimiter(c.transfers)
+		failed  = make(map[string]error)
+		mu      sync.Mutex
+	)
+
+	fprintln := func(w io.Writer, args ...interface{}) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		fmt.Fprintln(w, args...)
+	}
+
+	addError := func(key string, err error) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		failed[key] = err
+	}
+
+	for _, op := range ops {
+		op := op
+		ok := limiter.Go(ctx, func() {
+			fprintln(clingy.Stdout(ctx), op)
+
+			var err error
+			if op.remove {
+				err = fs.Remove(ctx, op.dest, nil)
+			} else {
+				err = c.transfer(ctx, fs, op)
+			}
+			if err != nil {
+				fprintln(clingy.Stdout(ctx), op.verb(), "failed:", err.Error())
+				addError(op.key, err)
+			}
+		})
+		if !ok {
+			break
+		}
+	}
+
+	limiter.Wait()
+
+	return failed
+}
+
+// transfer copies the file of the operation. Uploads store the ETag of the
+// file, so the next sync can compare the contents.
+func (c *cmdSync) transfer(ctx context.Context, fs ulfs.Filesystem, op syncOperation) error {
+	cp := &cmdCp{ex: c.ex, parallelism: 1}
+	if op.dest.Remote() {
+		etag, err := localETag(ctx, fs, op.source)
+		if err != nil {
+			return err
+		}
+		cp.metadata = map[string]string{syncETagKey: etag}
+	}
+	return cp.copyFile(ctx, fs, op.source, op.dest, false)
+}
+
+// syncOperation is a single upload, download or removal done by sync.
+type syncOperation struct {
+	key    string
+	remove bool
+	source ulloc.Location // not set for removals
+	dest   ulloc.Location
+}
+
+func (op syncOperation) verb() string {
+	if op.remove {
+		return "remove"
+	}
+	return copyVerb(op.source, op.dest)
+}
+
+func (op syncOperation) String() string {
+	if op.remove {
+		return fmt.Sprint(op.verb(), " ", op.dest)
+	}
+	return fmt.Sprint(op.verb(), " ", op.source, " to ", op.dest)
+}
+
+// syncState is the state of the files after the last sync, keyed by the key
+// relative to the synced directory and prefix.
+type syncState struct {
+	Files map[string]syncStateEntry `json:"files"`
+}
+
+// syncStateEntry is the state of a single file after the last sync.
+type syncStateEntry struct {
+	Local  syncFileState `json:"local"`
+	Remote syncFileState `json:"remote"`
+}
+
+// syncFileState is used to detect whether a file has been changed since the
+// last sync.
+type syncFileState struct {
+	Size     int64     `json:"size"`
+	Modified time.Time `json:"modified"`
+}
+
+func newSyncFileState(info ulfs.ObjectInfo) syncFileState {
+	return syncFileState{
+		Size:     info.ContentLength,
+		Modified: info.Created,
+	}
+}
+
+// changed returns whether the file is different from the recorded state.
+func (state syncFileState) changed(info ulfs.ObjectInfo) bool {
+	return state.Size != info.ContentLength || !state.Modified.Equal(info.Created)
+}
+
+// planOneWaySync returns the operations needed to make dest a copy of source.
+func planOneWaySync(source, dest ulloc.Location, sourceFiles, destFiles map[string]ulfs.ObjectInfo, state syncState, sourceRemote, remove bool) []syncOperation {
+	var ops []syncOperation
+	for _, key := range syncKeys(sourceFiles, destFiles) {
+		sourceInfo, sourceOK := sourceFiles[key]
+		destInfo, destOK := destFiles[key]
+
+		switch {
+		case sourceOK && !destOK:
+			ops = append(ops, syncOperation{key: key, source: joinDestWith(source, key), dest: joinDestWith(dest, key)})
+
+		case sourceOK && destOK:
+			changed := sourceInfo.ContentLength != destInfo.ContentLength
+			if same, known := sameETag(sourceInfo, destInfo); known {
+				// the contents are compared, when both ETags are known.
+				changed = !same
+			} else if entry, ok := state.Files[key]; ok {
+				sourceState, destState := entry.Local, entry.Remote
+				if sourceRemote {
+					sourceState, destState = destState, sourceState
+				}
+				changed = changed || sourceState.changed(sourceInfo) || destState.changed(destInfo)
+			}
+			if changed {
+				ops = append(ops, syncOperation{key: key, source: joinDestWith(source, key), dest: joinDestWith(dest, key)})
+			}
+
+		case !sourceOK && destOK && remove:
+			ops = append(ops, syncOperation{key: key, remove: true, dest: joinDestWith(dest, key)})
+		}
+	}
+	return ops
+}
+
+// planBidirectionalSync returns the operations needed to propagate the
+// changes since the last sync in both directions. When a file has been
+// changed on both sides, the most recently modified one wins.
+func planBidirectionalSync(local, remote ulloc.Location, localFiles, remoteFiles map[string]ulfs.ObjectInfo, state syncState) []syncOperation {
+	upload := func(key string) syncOperation {
+		return syncOperation{key: key, source: joinDestWith(local, key), dest: joinDestWith(remote, key)}
+	}
+	download := func(key string) syncOperation {
+		return syncOperation{key: key, source: joinDestWith(remote, key), dest: joinDestWith(local, key)}
+	}
+
+	var ops []syncOperation
+	for _, key := range syncKeys(localFiles, remoteFiles) {
+		localInfo, localOK := localFiles[key]
+		remoteInfo, remoteOK := remoteFiles[key]
+		entry, synced := state.Files[key]
+
+		switch {
+		case localOK && remoteOK:
+			localChanged := !synced || entry.Local.changed(localInfo)
+			remoteChanged := !synced || entry.Remote.changed(remoteInfo)
+			same, known := sameETag(localInfo, remoteInfo)
+			if known && same {
+				continue
+			}
+			if !known && !synced && localInfo.ContentLength == remoteInfo.ContentLength {
+				// without a previous state or ETags, files with the same
+				// size are considered to be in sync.
+				continue
+			}
+
+			switch {
+			case localChanged && remoteChanged:
+				if localInfo.Created.After(remoteInfo.Created) {
+					ops = append(ops, upload(key))
+				} else {
+					ops = append(ops, download(key))
+				}
+			case localChanged:
+				ops = append(ops, upload(key))
+			case remoteChanged:
+				ops = append(ops, download(key))
+			}
+
+		case localOK:
+			if synced && !entry.Local.changed(localInfo) {
+				ops = append(ops, syncOperation{key: key, remove: true, dest: joinDestWith(local, key)})
+			} else {
+				ops = append(ops, upload(key))
+			}
+
+		case remoteOK:
+			if synced && !entry.Remote.changed(remoteInfo) {
+				ops = append(ops, syncOperation{key: key, remove: true, dest: joinDestWith(remote, key)})
+			} else {
+				ops = append(ops, download(key))
+			}
+		}
+	}
+	return ops
+}
+
+// syncKeys returns the sorted union of the keys.
+func syncKeys(a, b map[string]ulfs.ObjectInfo) []string {
+	keys := make([]string, 0, len(a)+len(b))
+	for key := range a {
+		keys = append(keys, key)
+	}
+	for key := range b {
+		if _, ok := a[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// listSyncFiles returns the files under the prefix keyed by the key relative
+// to the prefix. The state file is skipped.
+func listSyncFiles(ctx context.Context, fs ulfs.Filesystem, prefix, stateLoc ulloc.Location) (map[string]ulfs.ObjectInfo, error) {
+	files := make(map[string]ulfs.ObjectInfo)
+	if prefix.Local() && !fs.IsLocalDir(ctx, prefix) {
+		return files, nil
+	}
+
+	iter, err := fs.List(ctx, prefix, &ulfs.ListOptions{Recursive: true, Expanded: true})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	for iter.Next() {
+		item := iter.Item()
+		if item.IsPrefix || item.Loc == stateLoc {
+			continue
+		}
+		key, err := prefix.RelativeTo(item.Loc)
+		if err != nil {
+			return nil, errs.Wrap(err)
+		}
+		files[key] = item
+	}
+	if err := iter.Err(); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return files, nil
+}
+
+// sameETag returns whether both files have the same ETag. known is false,
+// when the ETag of either file is unknown.
+func sameETag(a, b ulfs.ObjectInfo) (same, known bool) {
+	etagA, etagB := a.Metadata[syncETagKey], b.Metadata[syncETagKey]
+	if etagA == "" || etagB == "" {
+		return false, false
+	}
+	return etagA == etagB, true
+}
+
+// addLocalETags calculates the ETags of the local files, which have a remote
+// counterpart with an ETag and the same size. ETags of multipart uploads
+// aren't the hash of the contents, so they are skipped.
+func addLocalETags(ctx context.Context, fs ulfs.Filesystem, localFiles, remoteFiles map[string]ulfs.ObjectInfo) error {
+	for key, localInfo := range localFiles {
+		remoteInfo, ok := remoteFiles[key]
+		if !ok || remoteInfo.ContentLength != localInfo.ContentLength {
+			continue
+		}
+		remoteETag := remoteInfo.Metadata[syncETagKey]
+		if remoteETag == "" || strings.Contains(remoteETag, "-") {
+			continue
+		}
+
+		etag, err := localETag(ctx, fs, localInfo.Loc)
+		if err != nil {
+			return err
+		}
+		localInfo.Metadata = map[string]string{syncETagKey: etag}
+		localFiles[key] = localInfo
+	}
+	return nil
+}
+
+// localETag returns the ETag of the local file, which is the hex encoded MD5
+// hash of its contents like the ETags of S3.
+func localETag(ctx context.Context, fs ulfs.Filesystem, loc ulloc.Location) (_ string, err error) {
+	mrh, err := fs.Open(ctx, loc)
+	if err != nil {
+		return "", errs.Wrap(err)
+	}
+	defer func() { _ = mrh.Close() }()
+
+	rh, err := mrh.NextPart(ctx, -1)
+	if err != nil {
+		return "", errs.Wrap(err)
+	}
+	defer func() { _ = rh.Close() }()
+
+	hash := md5.New()
+	if _, err := io.Copy(hash, rh); err != nil {
+		return "", errs.Wrap(err)
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// loadSyncState reads the state of the last sync. A missing state file
+// results in an empty state.
+func loadSyncState(ctx context.Context, fs ulfs.Filesystem, loc ulloc.Location) (state syncState, err error) {
+	if _, err := fs.Stat(ctx, loc); err != nil {
+		return syncState{}, nil
+	}
+
+	mrh, err := fs.Open(ctx, loc)
+	if err != nil {
+		return syncState{}, errs.Wrap(err)
+	}
+	defer func() { _ = mrh.Close() }()
+
+	rh, err := mrh.NextPart(ctx, -1)
+	if err != nil {
+		return syncState{}, errs.Wrap(err)
+	}
+	defer func() { _ = rh.Close() }()
+
+	if err := json.NewDecoder(rh).Decode(&state); err != nil {
+		return syncState{}, errs.New("invalid sync state file %q: %v", loc, err)
+	}
+	return state, nil
+}
+
+// saveSyncState writes the state of the last sync.
+func saveSyncState(ctx context.Context, fs ulfs.Filesystem, loc ulloc.Location, state syncState) (err error) {
+	data, err := json.Marshal(state)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+
+	mwh, err := fs.Create(ctx, loc, nil)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	defer func() { _ = mwh.Abort(ctx) }()
+
+	wh, err := mwh.NextPart(ctx, int64(len(data)))
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	defer func() { _ = wh.Abort() }()
+
+	if _, err := io.Copy(wh, bytes.NewReader(data)); err != nil {
+		return errs.Wrap(err)
+	}
+	if err := wh.Commit(); err != nil {
+		return errs.Wrap(err)
+	}
+	return errs.Wrap(mwh.Commit(ctx))
+}
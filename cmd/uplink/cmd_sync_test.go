@@ -0,0 +1,145 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/cmd/uplink/ulfs"
+	"storj.io/storj/cmd/uplink/ulloc"
+	"storj.io/storj/cmd/uplink/ultest"
+)
+
+func TestSyncUpload(t *testing.T) {
+	state := ultest.Setup(commands,
+		ultest.WithFile("/home/user/dir/file1.txt", "local"),
+		ultest.WithFile("/home/user/dir/foo/file2.txt", "local"),
+
+		ultest.WithFile("sj://user/dir/file4.txt", "extra"),
+	)
+
+	t.Run("DryRun", func(t *testing.T) {
+		state.Succeed(t, "sync", "/home/user/dir/", "sj://user/dir/", "--delete", "--dry-run").RequireStdout(t, `
+			upload /home/user/dir/file1.txt to sj://user/dir/file1.txt
+			remove sj://user/dir/file4.txt
+			upload /home/user/dir/foo/file2.txt to sj://user/dir/foo/file2.txt
+		`).RequireRemoteFiles(t,
+			ultest.File{Loc: "sj://user/dir/file4.txt", Contents: "extra"},
+		)
+	})
+
+	t.Run("Basic", func(t *testing.T) {
+		state.Succeed(t, "sync", "/home/user/dir", "sj://user/dir").RequireRemoteFiles(t,
+			ultest.File{Loc: "sj://user/dir/file1.txt", Contents: "local", Metadata: etag("local")},
+			ultest.File{Loc: "sj://user/dir/file4.txt", Contents: "extra"},
+			ultest.File{Loc: "sj://user/dir/foo/file2.txt", Contents: "local", Metadata: etag("local")},
+		)
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		state.Succeed(t, "sync", "/home/user/dir/", "sj://user/dir/", "--delete", "--transfers", "4").RequireRemoteFiles(t,
+			ultest.File{Loc: "sj://user/dir/file1.txt", Contents: "local", Metadata: etag("local")},
+			ultest.File{Loc: "sj://user/dir/foo/file2.txt", Contents: "local", Metadata: etag("local")},
+		)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		state.Fail(t, "sync", "/home/user/dir/", "/home/user/other/")
+		state.Fail(t, "sync", "sj://user/dir/", "sj://user/other/")
+		state.Fail(t, "sync", "-", "sj://user/dir/")
+		state.Fail(t, "sync", "/home/user/dir/", "sj://user/dir/", "--delete", "--bidirectional")
+	})
+}
+
+func TestSyncDownload(t *testing.T) {
+	state := ultest.Setup(commands,
+		ultest.WithFile("sj://user/dir/file1.txt", "remote"),
+		ultest.WithFile("/home/user/dir/file2.txt", "local"),
+	)
+
+	state.Succeed(t, "sync", "sj://user/dir/", "/home/user/dir/", "--delete", "--state", "/home/state").RequireRemoteFiles(t,
+		ultest.File{Loc: "sj://user/dir/file1.txt", Contents: "remote"},
+	).RequireStdout(t, `
+		download sj://user/dir/file1.txt to /home/user/dir/file1.txt
+		remove /home/user/dir/file2.txt
+	`)
+}
+
+func TestSyncBidirectional(t *testing.T) {
+	state := ultest.Setup(commands,
+		ultest.WithFile("/home/user/dir/.uplink-sync",
+			`{"files":{"old.txt":{"local":{"size":3,"modified":"0001-01-01T00:00:00Z"},"remote":{"size":3,"modified":"1970-01-01T00:00:01Z"}}}}`),
+		ultest.WithFile("/home/user/dir/old.txt", "old"),
+		ultest.WithFile("/home/user/dir/new.txt", "new"),
+
+		ultest.WithFile("sj://user/dir/remote.txt", "remote"),
+	)
+
+	state.Succeed(t, "sync", "/home/user/dir/", "sj://user/dir/", "--bidirectional", "--dry-run").RequireStdout(t, `
+		upload /home/user/dir/new.txt to sj://user/dir/new.txt
+		remove /home/user/dir/old.txt
+		download sj://user/dir/remote.txt to /home/user/dir/remote.txt
+	`)
+
+	state.Succeed(t, "sync", "/home/user/dir/", "sj://user/dir/", "--bidirectional").RequireRemoteFiles(t,
+		ultest.File{Loc: "sj://user/dir/new.txt", Contents: "new", Metadata: etag("new")},
+		ultest.File{Loc: "sj://user/dir/remote.txt", Contents: "remote"},
+	)
+}
+
+func TestSyncETag(t *testing.T) {
+	state := ultest.Setup(commands,
+		ultest.WithFile("/home/user/dir/changed.txt", "new"),
+		ultest.WithFile("/home/user/dir/same.txt", "same"),
+
+		ultest.WithBucket("user"),
+		withRemoteETagFile("sj://user/dir/changed.txt", "old"),
+		withRemoteETagFile("sj://user/dir/same.txt", "same"),
+	)
+
+	// without a previous state, files with the same size are only
+	// transferred, when their ETags differ.
+	state.Succeed(t, "sync", "/home/user/dir/", "sj://user/dir/", "--dry-run").RequireStdout(t, `
+		upload /home/user/dir/changed.txt to sj://user/dir/changed.txt
+	`)
+
+	state.Succeed(t, "sync", "sj://user/dir/", "/home/user/dir/", "--dry-run").RequireStdout(t, `
+		download sj://user/dir/changed.txt to /home/user/dir/changed.txt
+	`)
+
+	state.Succeed(t, "sync", "/home/user/dir/", "sj://user/dir/").RequireRemoteFiles(t,
+		ultest.File{Loc: "sj://user/dir/changed.txt", Contents: "new", Metadata: etag("new")},
+		ultest.File{Loc: "sj://user/dir/same.txt", Contents: "same", Metadata: etag("same")},
+	)
+}
+
+func etag(contents string) map[string]string {
+	hash := md5.Sum([]byte(contents))
+	return map[string]string{syncETagKey: hex.EncodeToString(hash[:])}
+}
+
+func withRemoteETagFile(location, contents string) ultest.ExecuteOption {
+	return ultest.WithFilesystem(func(t *testing.T, ctx context.Context, fs ulfs.Filesystem) {
+		loc, err := ulloc.Parse(location)
+		require.NoError(t, err)
+
+		mwh, err := fs.Create(ctx, loc, &ulfs.CreateOptions{Metadata: etag(contents)})
+		require.NoError(t, err)
+		defer func() { _ = mwh.Abort(ctx) }()
+
+		wh, err := mwh.NextPart(ctx, -1)
+		require.NoError(t, err)
+		defer func() { _ = wh.Abort() }()
+
+		_, err = wh.Write([]byte(contents))
+		require.NoError(t, err)
+		require.NoError(t, wh.Commit())
+		require.NoError(t, mwh.Commit(ctx))
+	})
+}
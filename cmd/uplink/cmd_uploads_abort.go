@@ -0,0 +1,109 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/zeebo/clingy"
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/cmd/uplink/ulext"
+	"storj.io/storj/cmd/uplink/ulfs"
+	"storj.io/storj/cmd/uplink/ulloc"
+)
+
+type cmdUploadsAbort struct {
+	ex ulext.External
+
+	access string
+	all    bool
+
+	dests []ulloc.Location
+}
+
+func newCmdUploadsAbort(ex ulext.External) *cmdUploadsAbort {
+	return &cmdUploadsAbort{ex: ex}
+}
+
+func (c *cmdUploadsAbort) Setup(params clingy.Parameters) {
+	c.access = params.Flag("access", "Access name or value to use", "").(string)
+	c.all = params.Flag("all", "Abort all resumable uploads", false,
+		clingy.Transform(strconv.ParseBool), clingy.Boolean,
+	).(bool)
+
+	c.dests = params.Arg("destinations", "Destinations of the resumable uploads to abort",
+		clingy.Transform(ulloc.Parse),
+		clingy.Repeated,
+	).([]ulloc.Location)
+}
+
+func (c *cmdUploadsAbort) Execute(ctx context.Context) error {
+	if len(c.dests) == 0 && !c.all {
+		return errs.New("must have at least one destination or --all")
+	}
+
+	uploads, err := c.ex.GetUploads()
+	if err != nil {
+		return err
+	}
+
+	abort := func(upload ulext.ResumableUpload) bool {
+		if c.all {
+			return true
+		}
+		for _, dest := range c.dests {
+			if dest.String() == upload.Dest {
+				return true
+			}
+		}
+		return false
+	}
+
+	fs, err := c.ex.OpenFilesystem(ctx, c.access)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = fs.Close() }()
+
+	var aborted []ulext.ResumableUpload
+	var es errs.Group
+	for _, upload := range uploads {
+		if !abort(upload) {
+			continue
+		}
+		aborted = append(aborted, upload)
+
+		dest, err := ulloc.Parse(upload.Dest)
+		if err == nil {
+			err = fs.Remove(ctx, dest, &ulfs.RemoveOptions{
+				Pending:  true,
+				UploadID: upload.UploadID,
+			})
+		}
+		if err != nil {
+			// the pending upload may have expired already, so the state is
+			// removed regardless.
+			fmt.Fprintln(clingy.Stderr(ctx), "abort", upload.Dest, "failed:", err.Error())
+			es.Add(err)
+			continue
+		}
+		fmt.Fprintln(clingy.Stdout(ctx), "aborted", upload.Dest)
+	}
+
+	// other uplinks may have changed the uploads in the meantime, so only the
+	// aborted uploads are removed from the current state.
+	err = c.ex.UpdateUploads(func(uploads []ulext.ResumableUpload) ([]ulext.ResumableUpload, error) {
+		for _, upload := range aborted {
+			uploads = removeUpload(uploads, upload.Source, upload.Dest)
+		}
+		return uploads, nil
+	})
+	if err != nil {
+		return err
+	}
+	return combineErrs(es)
+}
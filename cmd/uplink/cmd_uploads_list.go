@@ -0,0 +1,49 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/zeebo/clingy"
+
+	"storj.io/storj/cmd/uplink/ulext"
+)
+
+type cmdUploadsList struct {
+	ex ulext.External
+
+	utc bool
+}
+
+func newCmdUploadsList(ex ulext.External) *cmdUploadsList {
+	return &cmdUploadsList{ex: ex}
+}
+
+func (c *cmdUploadsList) Setup(params clingy.Parameters) {
+	c.utc = params.Flag("utc", "Show all timestamps in UTC instead of local time", false,
+		clingy.Transform(strconv.ParseBool), clingy.Boolean,
+	).(bool)
+}
+
+func (c *cmdUploadsList) Execute(ctx context.Context) error {
+	uploads, err := c.ex.GetUploads()
+	if err != nil {
+		return err
+	}
+
+	tw := newTabbedWriter(clingy.Stdout(ctx), "STARTED", "PARTS", "SOURCE", "DESTINATION")
+	defer tw.Done()
+
+	for _, upload := range uploads {
+		parts := int64(0)
+		if upload.PartSize > 0 {
+			parts = (upload.Size + upload.PartSize - 1) / upload.PartSize
+		}
+		tw.WriteLine(formatTime(c.utc, upload.Started), fmt.Sprintf("%d/%d", len(upload.Parts), parts), upload.Source, upload.Dest)
+	}
+	return nil
+}
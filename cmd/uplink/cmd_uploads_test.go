@@ -0,0 +1,177 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sync/errgroup"
+
+	"storj.io/storj/cmd/uplink/ulext"
+	"storj.io/storj/cmd/uplink/ulfs"
+	"storj.io/storj/cmd/uplink/ulloc"
+	"storj.io/storj/cmd/uplink/ultest"
+)
+
+var (
+	testUpload1 = ulext.ResumableUpload{
+		Source:   "/home/user/file1.txt",
+		Dest:     "sj://user/file1.txt",
+		UploadID: "upload1",
+		Size:     300,
+		PartSize: 100,
+		Parts:    []uint32{1, 2},
+		Started:  time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC),
+	}
+	testUpload2 = ulext.ResumableUpload{
+		Source:   "/home/user/file2.txt",
+		Dest:     "sj://user/file2.txt",
+		UploadID: "upload2",
+		Size:     300,
+		PartSize: 100,
+		Started:  time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC),
+	}
+)
+
+func TestUploadsList(t *testing.T) {
+	state := ultest.Setup(commands,
+		ultest.WithUpload(testUpload1),
+		ultest.WithUpload(testUpload2),
+	)
+
+	state.Succeed(t, "uploads", "list", "--utc").RequireStdout(t, `
+		STARTED                PARTS    SOURCE                  DESTINATION
+		2023-01-02 03:04:05    2/3      /home/user/file1.txt    sj://user/file1.txt
+		2023-01-02 03:04:05    0/3      /home/user/file2.txt    sj://user/file2.txt
+	`)
+}
+
+func TestUploadsAbort(t *testing.T) {
+	state := ultest.Setup(commands,
+		ultest.WithPendingFile("sj://user/file1.txt"),
+		ultest.WithPendingFile("sj://user/file2.txt"),
+		ultest.WithUpload(testUpload1),
+		ultest.WithUpload(testUpload2),
+	)
+
+	state.Succeed(t, "uploads", "abort", "sj://user/file1.txt").RequirePending(t,
+		ultest.File{Loc: "sj://user/file2.txt"},
+	).RequireUploads(t, testUpload2)
+
+	state.Succeed(t, "uploads", "abort", "--all").RequirePending(t).RequireUploads(t)
+
+	state.Fail(t, "uploads", "abort")
+}
+
+type testUploadsExternal struct {
+	ulext.External
+
+	uploads []ulext.ResumableUpload
+}
+
+func (ex *testUploadsExternal) GetUploads() ([]ulext.ResumableUpload, error) {
+	return append([]ulext.ResumableUpload(nil), ex.uploads...), nil
+}
+
+func (ex *testUploadsExternal) UpdateUploads(fn func(uploads []ulext.ResumableUpload) ([]ulext.ResumableUpload, error)) error {
+	uploads, err := fn(append([]ulext.ResumableUpload(nil), ex.uploads...))
+	if err != nil {
+		return err
+	}
+	ex.uploads = append([]ulext.ResumableUpload(nil), uploads...)
+	return nil
+}
+
+type testResumableHandle struct {
+	ulfs.MultiWriteHandle
+}
+
+func (testResumableHandle) UploadID() string                   { return "upload" }
+func (testResumableHandle) SkipPart(ctx context.Context) error { return nil }
+
+func TestResumableUpload(t *testing.T) {
+	ctx := context.Background()
+	ex := &testUploadsExternal{}
+	fs := ulfs.NewMixed(ulfs.NewLocal(ulfs.NewLocalBackendMem()), nil)
+
+	source, dest := ulloc.NewLocal("/home/user/file.txt"), ulloc.NewRemote("user", "file.txt")
+	sourcePath, err := filepath.Abs("/home/user/file.txt")
+	require.NoError(t, err)
+
+	info := &ulfs.ObjectInfo{ContentLength: 300, Created: time.Now()}
+
+	previous, err := findResumableUpload(ctx, ex, fs, source, dest, info, 100)
+	require.NoError(t, err)
+	require.Nil(t, previous)
+
+	resume, err := startResumableUpload(ex, testResumableHandle{}, source, dest, info, 100, nil)
+	require.NoError(t, err)
+	require.Len(t, ex.uploads, 1)
+	require.Equal(t, sourcePath, ex.uploads[0].Source)
+	require.Equal(t, "upload", ex.uploads[0].UploadID)
+
+	require.NoError(t, resume.Complete(2))
+	require.True(t, resume.Done(2))
+	require.False(t, resume.Done(1))
+
+	// the same file with the same part size resumes the upload.
+	previous, err = findResumableUpload(ctx, ex, fs, source, dest, info, 100)
+	require.NoError(t, err)
+	require.NotNil(t, previous)
+	require.Equal(t, []uint32{2}, previous.Parts)
+
+	resume, err = startResumableUpload(ex, testResumableHandle{}, source, dest, info, 100, previous)
+	require.NoError(t, err)
+	require.True(t, resume.Done(2))
+
+	require.NoError(t, resume.Finish())
+	require.Empty(t, ex.uploads)
+}
+
+func TestUpdateUploads(t *testing.T) {
+	ex := new(external)
+	ex.dirs.current = t.TempDir()
+
+	// concurrent updates don't lose each other's changes.
+	var group errgroup.Group
+	for i := 0; i < 10; i++ {
+		upload := ulext.ResumableUpload{Source: fmt.Sprintf("/file%d.txt", i), Dest: "sj://user/file.txt"}
+		group.Go(func() error {
+			return ex.UpdateUploads(func(uploads []ulext.ResumableUpload) ([]ulext.ResumableUpload, error) {
+				return append(uploads, upload), nil
+			})
+		})
+	}
+	require.NoError(t, group.Wait())
+
+	uploads, err := ex.GetUploads()
+	require.NoError(t, err)
+	require.Len(t, uploads, 10)
+
+	// only the uploads file is left behind.
+	entries, err := os.ReadDir(ex.dirs.current)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	require.Equal(t, "uploads.json", entries[0].Name())
+
+	// a failed update keeps the previous state.
+	require.Error(t, ex.UpdateUploads(func(uploads []ulext.ResumableUpload) ([]ulext.ResumableUpload, error) {
+		return nil, fmt.Errorf("failed")
+	}))
+	uploads, err = ex.GetUploads()
+	require.NoError(t, err)
+	require.Len(t, uploads, 10)
+
+	require.NoError(t, ex.UpdateUploads(func(uploads []ulext.ResumableUpload) ([]ulext.ResumableUpload, error) {
+		return nil, nil
+	}))
+	_, err = os.Stat(ex.UploadsFile())
+	require.True(t, os.IsNotExist(err))
+}
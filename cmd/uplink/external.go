@@ -155,6 +155,7 @@ func transformFloat64(x string) (float64, error) {
 
 func (ex *external) AccessInfoFile() string   { return filepath.Join(ex.dirs.current, "access.json") }
 func (ex *external) ConfigFile() string       { return filepath.Join(ex.dirs.current, "config.ini") }
+func (ex *external) UploadsFile() string      { return filepath.Join(ex.dirs.current, "uploads.json") }
 func (ex *external) legacyConfigFile() string { return filepath.Join(ex.dirs.legacy, "config.yaml") }
 
 // Dynamic is called by clingy to look up values for global flags not specified on the command
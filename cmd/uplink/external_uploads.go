@@ -0,0 +1,144 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/cmd/uplink/ulext"
+)
+
+const (
+	// uploadsLockTimeout is how long UpdateUploads waits for the lock of the
+	// uploads file.
+	uploadsLockTimeout = 30 * time.Second
+	// uploadsLockStale is the age of a lock file, after which it's assumed
+	// that the process, which created it, died without removing it.
+	uploadsLockStale = 5 * time.Minute
+)
+
+// GetUploads returns the state of the resumable uploads.
+func (ex *external) GetUploads() ([]ulext.ResumableUpload, error) {
+	return readUploads(ex.UploadsFile())
+}
+
+// UpdateUploads replaces the state of the resumable uploads with the result
+// of fn. The uploads file is locked while fn runs, so that concurrent uplink
+// processes don't overwrite each other's changes, and it's replaced
+// atomically, so that it's never left half written.
+func (ex *external) UpdateUploads(fn func(uploads []ulext.ResumableUpload) ([]ulext.ResumableUpload, error)) (err error) {
+	path := ex.UploadsFile()
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return errs.Wrap(err)
+	}
+
+	unlock, err := lockFile(path+".lock", uploadsLockTimeout)
+	if err != nil {
+		return err
+	}
+	defer func() { err = errs.Combine(err, unlock()) }()
+
+	uploads, err := readUploads(path)
+	if err != nil {
+		return err
+	}
+
+	uploads, err = fn(uploads)
+	if err != nil {
+		return err
+	}
+
+	return writeUploads(path, uploads)
+}
+
+// readUploads reads the state of the resumable uploads from path.
+func readUploads(path string) ([]ulext.ResumableUpload, error) {
+	fh, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	defer func() { _ = fh.Close() }()
+
+	var uploads []ulext.ResumableUpload
+	if err := json.NewDecoder(fh).Decode(&uploads); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return uploads, nil
+}
+
+// writeUploads writes the state of the resumable uploads to a temporary file
+// and renames it to path.
+func writeUploads(path string, uploads []ulext.ResumableUpload) (err error) {
+	if len(uploads) == 0 {
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			return errs.Wrap(err)
+		}
+		return nil
+	}
+
+	data, err := json.MarshalIndent(uploads, "", "\t")
+	if err != nil {
+		return errs.Wrap(err)
+	}
+
+	fh, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	defer func() {
+		if err != nil {
+			_ = fh.Close()
+			_ = os.Remove(fh.Name())
+		}
+	}()
+
+	if _, err := fh.Write(data); err != nil {
+		return errs.Wrap(err)
+	}
+	if err := fh.Sync(); err != nil {
+		return errs.Wrap(err)
+	}
+	if err := fh.Close(); err != nil {
+		return errs.Wrap(err)
+	}
+
+	return errs.Wrap(os.Rename(fh.Name(), path))
+}
+
+// lockFile creates the lock file exclusively, waiting until it's removed by
+// its current owner, and returns the function that removes it again. Lock
+// files older than uploadsLockStale are removed.
+func lockFile(path string, timeout time.Duration) (unlock func() error, err error) {
+	deadline := time.Now().Add(timeout)
+	for {
+		fh, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+		if err == nil {
+			if err := fh.Close(); err != nil {
+				return nil, errs.Combine(errs.Wrap(err), os.Remove(path))
+			}
+			return func() error { return errs.Wrap(os.Remove(path)) }, nil
+		}
+		if !os.IsExist(err) {
+			return nil, errs.Wrap(err)
+		}
+
+		if info, err := os.Stat(path); err == nil && time.Since(info.ModTime()) > uploadsLockStale {
+			_ = os.Remove(path)
+			continue
+		}
+
+		if time.Now().After(deadline) {
+			return nil, errs.New("timed out waiting for lock %q, remove it if no other uplink is running", path)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
@@ -0,0 +1,152 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"path"
+	"strings"
+	"time"
+
+	"github.com/zeebo/clingy"
+	"github.com/zeebo/errs"
+
+	"storj.io/common/memory"
+	"storj.io/storj/cmd/uplink/ulfs"
+	"storj.io/storj/cmd/uplink/ulloc"
+)
+
+// objectFilter selects the objects or files a batch operation is done on.
+type objectFilter struct {
+	include   []string
+	exclude   []string
+	minSize   memory.Size
+	maxSize   memory.Size
+	olderThan time.Duration
+	newerThan time.Duration
+}
+
+func (f *objectFilter) Setup(params clingy.Parameters) {
+	f.include = params.Flag("include", "Only include objects or files whose key relative to the prefix, or base name, matches the glob pattern", nil,
+		clingy.Repeated,
+		clingy.Transform(parseGlob),
+	).([]string)
+	f.exclude = params.Flag("exclude", "Exclude objects or files whose key relative to the prefix, or base name, matches the glob pattern", nil,
+		clingy.Repeated,
+		clingy.Transform(parseGlob),
+	).([]string)
+	f.minSize = params.Flag("min-size", "Only include objects or files of at least this size", memory.Size(0),
+		clingy.Transform(memory.ParseString),
+		clingy.Transform(func(n int64) (memory.Size, error) { return memory.Size(n), nil }),
+	).(memory.Size)
+	f.maxSize = params.Flag("max-size", "Only include objects or files of at most this size, 0 means unlimited", memory.Size(0),
+		clingy.Transform(memory.ParseString),
+		clingy.Transform(func(n int64) (memory.Size, error) { return memory.Size(n), nil }),
+	).(memory.Size)
+	f.olderThan = params.Flag("older-than", "Only include objects or files created or modified longer ago than this duration (e.g. '720h')", time.Duration(0),
+		clingy.Transform(time.ParseDuration),
+	).(time.Duration)
+	f.newerThan = params.Flag("newer-than", "Only include objects or files created or modified within this duration (e.g. '24h')", time.Duration(0),
+		clingy.Transform(time.ParseDuration),
+	).(time.Duration)
+}
+
+// Enabled returns whether any filter has been configured.
+func (f *objectFilter) Enabled() bool {
+	return len(f.include) > 0 || len(f.exclude) > 0 ||
+		f.minSize > 0 || f.maxSize > 0 ||
+		f.olderThan > 0 || f.newerThan > 0
+}
+
+// Match returns whether the item listed under prefix passes the filters.
+// Prefixes always pass.
+func (f *objectFilter) Match(prefix ulloc.Location, item ulfs.ObjectInfo) bool {
+	if item.IsPrefix || !f.Enabled() {
+		return true
+	}
+
+	switch {
+	case f.minSize > 0 && item.ContentLength < f.minSize.Int64():
+		return false
+	case f.maxSize > 0 && item.ContentLength > f.maxSize.Int64():
+		return false
+	}
+
+	if f.olderThan > 0 || f.newerThan > 0 {
+		age := time.Since(item.Created)
+
+		switch {
+		case f.olderThan > 0 && age < f.olderThan:
+			return false
+		case f.newerThan > 0 && age > f.newerThan:
+			return false
+		}
+	}
+
+	// keys are matched relative to the prefix, when it's a directory.
+	key, err := prefix.AsDirectoryish().RelativeTo(item.Loc)
+	if err != nil {
+		key, err = prefix.RelativeTo(item.Loc)
+		if err != nil {
+			key = item.Loc.Loc()
+		}
+	}
+
+	if len(f.include) > 0 && !matchGlobs(f.include, key) {
+		return false
+	}
+	return !matchGlobs(f.exclude, key)
+}
+
+// Filter returns an iterator, which skips the items listed under prefix
+// that don't pass the filters.
+func (f *objectFilter) Filter(prefix ulloc.Location, iter ulfs.ObjectIterator) ulfs.ObjectIterator {
+	if !f.Enabled() {
+		return iter
+	}
+	return &filteredObjectIterator{ObjectIterator: iter, prefix: prefix, filter: f}
+}
+
+// filteredObjectIterator is an ulfs.ObjectIterator skipping the items, which
+// don't pass the filter.
+type filteredObjectIterator struct {
+	ulfs.ObjectIterator
+	prefix ulloc.Location
+	filter *objectFilter
+}
+
+func (it *filteredObjectIterator) Next() bool {
+	for it.ObjectIterator.Next() {
+		if it.filter.Match(it.prefix, it.Item()) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchGlobs returns whether any of the patterns matches the key. Patterns
+// without a slash are matched against the base name of the key as well.
+func matchGlobs(patterns []string, key string) bool {
+	key = strings.TrimSuffix(key, "/")
+	base := path.Base(key)
+
+	for _, pattern := range patterns {
+		if ok, _ := path.Match(pattern, key); ok {
+			return true
+		}
+		if !strings.Contains(pattern, "/") {
+			if ok, _ := path.Match(pattern, base); ok {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// parseGlob validates the glob pattern.
+func parseGlob(pattern string) (string, error) {
+	if _, err := path.Match(pattern, ""); err != nil {
+		return "", errs.New("invalid pattern %q: %v", pattern, err)
+	}
+	return pattern, nil
+}
@@ -80,11 +80,18 @@ func commands(cmds clingy.Commands, ex ulext.External) {
 	cmds.New("rb", "Remove a bucket bucket", newCmdRb(ex))
 	cmds.New("cp", "Copies files or objects into or out of storj", newCmdCp(ex))
 	cmds.New("mv", "Moves files or objects", newCmdMv(ex))
+	cmds.New("sync", "Synchronizes a local directory and a remote prefix", newCmdSync(ex))
 	cmds.New("ls", "Lists buckets, prefixes, or objects", newCmdLs(ex))
 	cmds.New("rm", "Remove an object", newCmdRm(ex))
 	cmds.Group("meta", "Object metadata related commands", func() {
 		cmds.New("get", "Get an object's metadata", newCmdMetaGet(ex))
 	})
+	cmds.Group("uploads", "Resumable upload related commands", func() {
+		cmds.New("list", "List interrupted uploads, which can be resumed", newCmdUploadsList(ex))
+		cmds.New("abort", "Abort interrupted uploads", newCmdUploadsAbort(ex))
+	})
 	cmds.New("share", "Shares restricted accesses to objects", newCmdShare(ex))
+	cmds.New("mount", "Mounts a bucket or prefix as a local filesystem", newCmdMount(ex))
+	cmds.New("bench", "Benchmarks uploads and downloads against a project", newCmdBench(ex))
 	cmds.New("version", "Prints version information", newCmdVersion())
 }
@@ -0,0 +1,160 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"context"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/cmd/uplink/ulext"
+	"storj.io/storj/cmd/uplink/ulfs"
+	"storj.io/storj/cmd/uplink/ulloc"
+)
+
+// resumableUpload keeps track of the completed parts of an upload, so that it
+// can be resumed when it's interrupted.
+type resumableUpload struct {
+	ex     ulext.External
+	handle ulfs.ResumableMultiWriteHandle
+
+	mu        sync.Mutex
+	upload    ulext.ResumableUpload
+	completed map[uint32]bool
+}
+
+// findResumableUpload returns the stored state of the upload of source to dest,
+// if it matches the current state of the local file and the part size. Stale
+// state is removed and its pending upload aborted.
+func findResumableUpload(ctx context.Context, ex ulext.External, fs ulfs.Filesystem, source, dest ulloc.Location, info *ulfs.ObjectInfo, partSize int64) (*ulext.ResumableUpload, error) {
+	sourcePath, err := resumableSourcePath(source)
+	if err != nil {
+		return nil, err
+	}
+
+	uploads, err := ex.GetUploads()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, upload := range uploads {
+		if upload.Source != sourcePath || upload.Dest != dest.String() {
+			continue
+		}
+
+		if upload.Size == info.ContentLength && upload.Modified.Equal(info.Created) && upload.PartSize == partSize {
+			return &upload, nil
+		}
+
+		// the file has been changed since, so the uploaded parts can't be used.
+		_ = fs.Remove(ctx, dest, &ulfs.RemoveOptions{Pending: true, UploadID: upload.UploadID})
+		return nil, ex.UpdateUploads(func(uploads []ulext.ResumableUpload) ([]ulext.ResumableUpload, error) {
+			return removeUpload(uploads, upload.Source, upload.Dest), nil
+		})
+	}
+	return nil, nil
+}
+
+// startResumableUpload starts tracking the upload of source to dest using
+// the pending upload of the handle.
+func startResumableUpload(ex ulext.External, handle ulfs.ResumableMultiWriteHandle, source, dest ulloc.Location, info *ulfs.ObjectInfo, partSize int64, previous *ulext.ResumableUpload) (*resumableUpload, error) {
+	sourcePath, err := resumableSourcePath(source)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &resumableUpload{
+		ex:     ex,
+		handle: handle,
+		upload: ulext.ResumableUpload{
+			Source:   sourcePath,
+			Dest:     dest.String(),
+			UploadID: handle.UploadID(),
+			Size:     info.ContentLength,
+			Modified: info.Created,
+			PartSize: partSize,
+			Started:  time.Now(),
+		},
+		completed: make(map[uint32]bool),
+	}
+
+	if previous != nil {
+		r.upload.Started = previous.Started
+		r.upload.Parts = append(r.upload.Parts, previous.Parts...)
+		for _, part := range previous.Parts {
+			r.completed[part] = true
+		}
+	}
+
+	return r, r.save(false)
+}
+
+// Done returns whether the part has been completed before.
+func (r *resumableUpload) Done(part uint32) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.completed[part]
+}
+
+// Complete records the part as completed.
+func (r *resumableUpload) Complete(part uint32) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.completed[part] {
+		return nil
+	}
+	r.completed[part] = true
+	r.upload.Parts = append(r.upload.Parts, part)
+
+	return r.save(false)
+}
+
+// Finish removes the stored state, after the upload has been committed.
+func (r *resumableUpload) Finish() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.save(true)
+}
+
+// save replaces the stored state of the upload. It must be called with the
+// mutex held.
+func (r *resumableUpload) save(remove bool) error {
+	return r.ex.UpdateUploads(func(uploads []ulext.ResumableUpload) ([]ulext.ResumableUpload, error) {
+		updated := removeUpload(uploads, r.upload.Source, r.upload.Dest)
+		if !remove {
+			upload := r.upload
+			upload.Parts = append([]uint32(nil), r.upload.Parts...)
+			updated = append(updated, upload)
+		}
+		return updated, nil
+	})
+}
+
+// removeUpload returns the uploads except the upload of source to dest.
+func removeUpload(uploads []ulext.ResumableUpload, source, dest string) []ulext.ResumableUpload {
+	var kept []ulext.ResumableUpload
+	for _, upload := range uploads {
+		if upload.Source != source || upload.Dest != dest {
+			kept = append(kept, upload)
+		}
+	}
+	return kept
+}
+
+// resumableSourcePath returns the absolute path of the local source, so that
+// the upload can be resumed from a different working directory.
+func resumableSourcePath(source ulloc.Location) (string, error) {
+	path, ok := source.LocalParts()
+	if !ok {
+		return "", errs.New("resumable uploads require a local source: %q", source)
+	}
+	path, err := filepath.Abs(path)
+	return path, errs.Wrap(err)
+}
@@ -36,10 +36,27 @@ type External interface {
 	ConfigFile() string
 	SaveConfig(values map[string]string) error
 
+	UploadsFile() string
+	GetUploads() ([]ResumableUpload, error)
+	UpdateUploads(fn func(uploads []ResumableUpload) ([]ResumableUpload, error)) error
+
 	PromptInput(ctx context.Context, prompt string) (input string, err error)
 	PromptSecret(ctx context.Context, prompt string) (secret string, err error)
 }
 
+// ResumableUpload is the state of an upload of a local file, which can be
+// resumed from the last completed part when it's interrupted.
+type ResumableUpload struct {
+	Source   string    // absolute path of the local file
+	Dest     string    // remote location of the object
+	UploadID string    // id of the pending upload
+	Size     int64     // size of the local file
+	Modified time.Time // modification time of the local file
+	PartSize int64     // size of the uploaded parts
+	Parts    []uint32  // numbers of the completed parts
+	Started  time.Time // time the upload was started
+}
+
 // Options contains all of the possible options for opening a filesystem or project.
 type Options struct {
 	EncryptionBypass      bool
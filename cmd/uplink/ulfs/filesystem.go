@@ -16,6 +16,13 @@ import (
 type CreateOptions struct {
 	Expires  time.Time
 	Metadata map[string]string
+
+	// Length is the expected size of the object, if known. Local files are
+	// preallocated to it, so that parts can be written in parallel.
+	Length int64
+
+	// UploadID continues the pending upload instead of beginning a new one.
+	UploadID string
 }
 
 // ListOptions describes options to the List command.
@@ -31,6 +38,9 @@ func (lo *ListOptions) isPending() bool   { return lo != nil && lo.Pending }
 // RemoveOptions describes options to the Remove command.
 type RemoveOptions struct {
 	Pending bool
+
+	// UploadID selects the pending upload to abort.
+	UploadID string
 }
 
 func (ro *RemoveOptions) isPending() bool { return ro != nil && ro.Pending }
@@ -52,7 +62,7 @@ type Filesystem interface {
 type FilesystemLocal interface {
 	IsLocalDir(ctx context.Context, path string) bool
 	Open(ctx context.Context, path string) (MultiReadHandle, error)
-	Create(ctx context.Context, path string) (MultiWriteHandle, error)
+	Create(ctx context.Context, path string, opts *CreateOptions) (MultiWriteHandle, error)
 	Move(ctx context.Context, oldpath string, newpath string) error
 	Copy(ctx context.Context, oldpath string, newpath string) error
 	Remove(ctx context.Context, path string, opts *RemoveOptions) error
@@ -154,6 +164,17 @@ type MultiWriteHandle interface {
 	Abort(ctx context.Context) error
 }
 
+// ResumableMultiWriteHandle is a MultiWriteHandle of a pending upload, which
+// can be continued with CreateOptions.UploadID when it's interrupted.
+type ResumableMultiWriteHandle interface {
+	MultiWriteHandle
+
+	// UploadID returns the id of the pending upload.
+	UploadID() string
+	// SkipPart skips the next part, which has already been uploaded.
+	SkipPart(ctx context.Context) error
+}
+
 // WriteHandle is anything that can be written to with commit/abort semantics.
 type WriteHandle interface {
 	io.Writer
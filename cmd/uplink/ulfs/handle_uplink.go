@@ -215,6 +215,22 @@ func (u *uplinkMultiWriteHandle) NextPart(ctx context.Context, length int64) (Wr
 	}, nil
 }
 
+func (u *uplinkMultiWriteHandle) UploadID() string {
+	return u.info.UploadID
+}
+
+func (u *uplinkMultiWriteHandle) SkipPart(ctx context.Context) error {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	if u.tail {
+		return errs.New("unable to skip part after tail part")
+	}
+
+	u.part++
+	return nil
+}
+
 func (u *uplinkMultiWriteHandle) Commit(ctx context.Context) error {
 	_, err := u.project.CommitUpload(ctx, u.bucket, u.info.Key, u.info.UploadID, &uplink.CommitUploadOptions{
 		CustomMetadata: u.metadata,
@@ -23,6 +23,7 @@ type LocalBackendFile interface {
 	Name() string
 	Stat() (os.FileInfo, error)
 	Readdir(int) ([]os.FileInfo, error)
+	Truncate(size int64) error
 }
 
 // LocalBackend abstracts what the Local filesystem interacts with.
@@ -57,7 +58,8 @@ func (l *Local) Open(ctx context.Context, path string) (MultiReadHandle, error)
 }
 
 // Create makes any directories necessary to create a file at path and returns a WriteHandle.
-func (l *Local) Create(ctx context.Context, path string) (MultiWriteHandle, error) {
+// The file is preallocated when the expected length is known.
+func (l *Local) Create(ctx context.Context, path string, opts *CreateOptions) (MultiWriteHandle, error) {
 	fi, err := l.fs.Stat(path)
 	if err != nil && !os.IsNotExist(err) {
 		return nil, errs.Wrap(err)
@@ -74,6 +76,13 @@ func (l *Local) Create(ctx context.Context, path string) (MultiWriteHandle, erro
 	if err != nil {
 		return nil, errs.Wrap(err)
 	}
+
+	if opts != nil && opts.Length > 0 {
+		if err := fh.Truncate(opts.Length); err != nil {
+			return nil, errs.Combine(errs.Wrap(err), fh.Close(), l.fs.Remove(path))
+		}
+	}
+
 	return newOSMultiWriteHandle(l.fs, fh), nil
 }
 
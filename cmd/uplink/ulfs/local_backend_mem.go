@@ -207,6 +207,17 @@ func (mf *memFile) WriteAt(p []byte, off int64) (int, error) {
 	return copy(mf.buf[off:], p), nil
 }
 
+func (mf *memFile) Truncate(size int64) error {
+	if size < 0 {
+		return errs.New("invalid size: %d", size)
+	}
+	if delta := size - int64(len(mf.buf)); delta > 0 {
+		mf.buf = append(mf.buf, make([]byte, delta)...)
+	}
+	mf.buf = mf.buf[:size]
+	return nil
+}
+
 func (mf *memFile) Stat() (os.FileInfo, error) {
 	return (*memFileInfo)(mf), nil
 }
@@ -260,6 +271,10 @@ func (md *memDir) WriteAt(p []byte, off int64) (int, error) {
 	return 0, errs.New("writeat on directory")
 }
 
+func (md *memDir) Truncate(size int64) error {
+	return errs.New("truncate on directory")
+}
+
 func (md *memDir) Stat() (os.FileInfo, error) {
 	return (*memDirInfo)(md), nil
 }
@@ -46,7 +46,7 @@ func (m *Mixed) Create(ctx context.Context, loc ulloc.Location, opts *CreateOpti
 	if bucket, key, ok := loc.RemoteParts(); ok {
 		return m.remote.Create(ctx, bucket, key, opts)
 	} else if path, ok := loc.LocalParts(); ok {
-		return m.local.Create(ctx, path)
+		return m.local.Create(ctx, path, opts)
 	}
 	return newStdMultiWriteHandle(clingy.Stdout(ctx)), nil
 }
@@ -56,6 +56,11 @@ func (r *Remote) Create(ctx context.Context, bucket, key string, opts *CreateOpt
 		}
 	}
 
+	if opts.UploadID != "" {
+		info := uplink.UploadInfo{UploadID: opts.UploadID, Key: key}
+		return newUplinkMultiWriteHandle(r.project, bucket, info, customMetadata), nil
+	}
+
 	info, err := r.project.BeginUpload(ctx, bucket, key, &uplink.UploadOptions{
 		Expires: opts.Expires,
 	})
@@ -86,6 +91,10 @@ func (r *Remote) Remove(ctx context.Context, bucket, key string, opts *RemoveOpt
 		return nil
 	}
 
+	if opts.UploadID != "" {
+		return errs.Wrap(r.project.AbortUpload(ctx, bucket, key, opts.UploadID))
+	}
+
 	// TODO: we may need a dedicated endpoint for deleting pending object streams
 	list := r.project.ListUploads(ctx, bucket, &uplink.ListUploadsOptions{Prefix: key})
 
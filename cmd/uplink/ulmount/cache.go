@@ -0,0 +1,122 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package ulmount
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// entry is the metadata of an object or a prefix in a directory.
+type entry struct {
+	name     string
+	isPrefix bool
+	size     int64
+	modified time.Time
+}
+
+// metadataCache keeps the listed directories for the cache ttl, so lookups
+// and attribute requests don't list the objects every time.
+type metadataCache struct {
+	ttl time.Duration
+	now func() time.Time
+
+	mu   sync.Mutex
+	dirs map[string]cachedDir
+}
+
+type cachedDir struct {
+	entries map[string]entry
+	expires time.Time
+}
+
+func newMetadataCache(ttl time.Duration) *metadataCache {
+	return &metadataCache{
+		ttl:  ttl,
+		now:  time.Now,
+		dirs: map[string]cachedDir{},
+	}
+}
+
+// list returns the entries of the directory with the prefix sorted by name,
+// ok is false when the directory is not cached.
+func (cache *metadataCache) list(prefix string) (_ []entry, ok bool) {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	dir, ok := cache.get(prefix)
+	if !ok {
+		return nil, false
+	}
+
+	entries := make([]entry, 0, len(dir.entries))
+	for _, e := range dir.entries {
+		entries = append(entries, e)
+	}
+	sort.Slice(entries, func(i, k int) bool { return entries[i].name < entries[k].name })
+	return entries, true
+}
+
+// set caches the entries of the directory with the prefix.
+func (cache *metadataCache) set(prefix string, entries []entry) {
+	if cache.ttl <= 0 {
+		return
+	}
+
+	dir := cachedDir{
+		entries: make(map[string]entry, len(entries)),
+		expires: cache.now().Add(cache.ttl),
+	}
+	for _, e := range entries {
+		dir.entries[e.name] = e
+	}
+
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+	cache.dirs[prefix] = dir
+}
+
+// update changes the entry in the directory with the prefix, when the
+// directory is cached.
+func (cache *metadataCache) update(prefix string, e entry) {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	if dir, ok := cache.get(prefix); ok {
+		dir.entries[e.name] = e
+	}
+}
+
+// remove removes the entry from the directory with the prefix, when the
+// directory is cached.
+func (cache *metadataCache) remove(prefix, name string) {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	if dir, ok := cache.get(prefix); ok {
+		delete(dir.entries, name)
+	}
+}
+
+// invalidate removes the directory with the prefix from the cache.
+func (cache *metadataCache) invalidate(prefix string) {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	delete(cache.dirs, prefix)
+}
+
+// get returns the directory, when it is cached and not expired.
+func (cache *metadataCache) get(prefix string) (cachedDir, bool) {
+	dir, ok := cache.dirs[prefix]
+	if !ok {
+		return cachedDir{}, false
+	}
+	if !cache.now().Before(dir.expires) {
+		delete(cache.dirs, prefix)
+		return cachedDir{}, false
+	}
+	return dir, true
+}
@@ -0,0 +1,79 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package ulmount
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetadataCache(t *testing.T) {
+	now := time.Now()
+	cache := newMetadataCache(time.Minute)
+	cache.now = func() time.Time { return now }
+
+	_, ok := cache.list("dir/")
+	require.False(t, ok)
+
+	cache.set("dir/", []entry{
+		{name: "b", size: 2},
+		{name: "a", size: 1},
+		{name: "sub", isPrefix: true},
+	})
+
+	entries, ok := cache.list("dir/")
+	require.True(t, ok)
+	require.Equal(t, []entry{
+		{name: "a", size: 1},
+		{name: "b", size: 2},
+		{name: "sub", isPrefix: true},
+	}, entries)
+
+	cache.update("dir/", entry{name: "a", size: 10})
+	cache.update("other/", entry{name: "a", size: 10})
+	cache.remove("dir/", "b")
+
+	entries, ok = cache.list("dir/")
+	require.True(t, ok)
+	require.Equal(t, []entry{
+		{name: "a", size: 10},
+		{name: "sub", isPrefix: true},
+	}, entries)
+
+	_, ok = cache.list("other/")
+	require.False(t, ok)
+
+	// entries expire after the ttl.
+	now = now.Add(time.Minute)
+	_, ok = cache.list("dir/")
+	require.False(t, ok)
+
+	cache.set("dir/", []entry{{name: "a"}})
+	cache.invalidate("dir/")
+	_, ok = cache.list("dir/")
+	require.False(t, ok)
+}
+
+func TestMetadataCache_Disabled(t *testing.T) {
+	cache := newMetadataCache(0)
+	cache.set("dir/", []entry{{name: "a"}})
+
+	_, ok := cache.list("dir/")
+	require.False(t, ok)
+}
+
+func TestParseWriteBack(t *testing.T) {
+	mode, err := ParseWriteBack("sync")
+	require.NoError(t, err)
+	require.Equal(t, WriteBackSync, mode)
+
+	mode, err = ParseWriteBack("async")
+	require.NoError(t, err)
+	require.Equal(t, WriteBackAsync, mode)
+
+	_, err = ParseWriteBack("never")
+	require.Error(t, err)
+}
@@ -0,0 +1,53 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package ulmount exposes a bucket or a prefix as a FUSE filesystem.
+package ulmount
+
+import (
+	"time"
+
+	"github.com/zeebo/errs"
+)
+
+// WriteBack is the mode in which written files are uploaded.
+type WriteBack string
+
+const (
+	// WriteBackSync uploads a written file when it is closed, so closing the
+	// file fails when the upload fails.
+	WriteBackSync WriteBack = "sync"
+	// WriteBackAsync uploads a written file in the background, once it has
+	// been closed for the write-back delay. Pending uploads are finished
+	// when the filesystem is unmounted.
+	WriteBackAsync WriteBack = "async"
+)
+
+// ParseWriteBack parses the write-back mode.
+func ParseWriteBack(mode string) (WriteBack, error) {
+	switch WriteBack(mode) {
+	case WriteBackSync, WriteBackAsync:
+		return WriteBack(mode), nil
+	default:
+		return "", errs.New("invalid write-back mode %q: must be %q or %q", mode, WriteBackSync, WriteBackAsync)
+	}
+}
+
+// Config contains the options of a mounted filesystem.
+type Config struct {
+	// ReadOnly disallows any modifications through the filesystem.
+	ReadOnly bool
+	// CacheTTL is how long the listed metadata is used before the
+	// objects are listed again.
+	CacheTTL time.Duration
+	// WriteBack is the mode in which written files are uploaded.
+	WriteBack WriteBack
+	// WriteBackDelay is how long a closed file is kept locally before it is
+	// uploaded in the async write-back mode.
+	WriteBackDelay time.Duration
+	// CacheDir is the directory for the local copies of written files. The
+	// default temporary directory is used when it's empty.
+	CacheDir string
+	// OnWriteBackError is called when a background upload fails.
+	OnWriteBackError func(key string, err error)
+}
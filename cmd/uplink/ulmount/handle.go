@@ -0,0 +1,157 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+//go:build linux || darwin
+// +build linux darwin
+
+package ulmount
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/hanwen/go-fuse/v2/fs"
+	"github.com/hanwen/go-fuse/v2/fuse"
+
+	"storj.io/uplink"
+)
+
+// localHandle is an open file, which is read from and written to its
+// local copy.
+type localHandle struct {
+	fsys  *filesystem
+	local *localFile
+}
+
+var _ = (fs.FileReader)((*localHandle)(nil))
+var _ = (fs.FileWriter)((*localHandle)(nil))
+var _ = (fs.FileFlusher)((*localHandle)(nil))
+var _ = (fs.FileFsyncer)((*localHandle)(nil))
+var _ = (fs.FileReleaser)((*localHandle)(nil))
+
+// Read reads from the local copy.
+func (h *localHandle) Read(ctx context.Context, dest []byte, off int64) (fuse.ReadResult, syscall.Errno) {
+	h.local.mu.Lock()
+	defer h.local.mu.Unlock()
+
+	n, err := h.local.file.ReadAt(dest, off)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, toErrno(err)
+	}
+	return fuse.ReadResultData(dest[:n]), 0
+}
+
+// Write writes to the local copy.
+func (h *localHandle) Write(ctx context.Context, data []byte, off int64) (uint32, syscall.Errno) {
+	h.local.mu.Lock()
+	defer h.local.mu.Unlock()
+
+	n, err := h.local.file.WriteAt(data, off)
+	if n > 0 {
+		h.local.dirty, h.local.modified = true, time.Now()
+	}
+	if err != nil {
+		return uint32(n), toErrno(err)
+	}
+	return uint32(n), 0
+}
+
+// Flush is called when the file is closed, it uploads the local copy in
+// the sync write-back mode.
+func (h *localHandle) Flush(ctx context.Context) syscall.Errno {
+	if h.fsys.config.WriteBack != WriteBackSync {
+		return 0
+	}
+
+	h.local.mu.Lock()
+	defer h.local.mu.Unlock()
+	return toErrno(h.fsys.upload(ctx, h.local))
+}
+
+// Fsync uploads the local copy.
+func (h *localHandle) Fsync(ctx context.Context, flags uint32) syscall.Errno {
+	h.local.mu.Lock()
+	defer h.local.mu.Unlock()
+	return toErrno(h.fsys.upload(ctx, h.local))
+}
+
+// Release releases the local copy, it is scheduled for the write-back when
+// it was not uploaded yet.
+func (h *localHandle) Release(ctx context.Context) syscall.Errno {
+	h.fsys.release(h.local)
+	return 0
+}
+
+// truncate changes the size of the local copy.
+func (local *localFile) truncate(size int64) syscall.Errno {
+	local.mu.Lock()
+	defer local.mu.Unlock()
+
+	if err := local.file.Truncate(size); err != nil {
+		return toErrno(err)
+	}
+	local.dirty, local.modified = true, time.Now()
+	return 0
+}
+
+// remoteHandle is a file opened for reading, which is downloaded as it is
+// read. Sequential reads continue the same download.
+type remoteHandle struct {
+	fsys *filesystem
+	key  string
+
+	mu       sync.Mutex
+	download *uplink.Download
+	offset   int64
+}
+
+var _ = (fs.FileReader)((*remoteHandle)(nil))
+var _ = (fs.FileReleaser)((*remoteHandle)(nil))
+
+// Read reads from the object.
+func (h *remoteHandle) Read(ctx context.Context, dest []byte, off int64) (fuse.ReadResult, syscall.Errno) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.download == nil || h.offset != off {
+		if h.download != nil {
+			_ = h.download.Close()
+			h.download = nil
+		}
+
+		// the download outlives the read request.
+		download, err := h.fsys.project.DownloadObject(h.fsys.ctx, h.fsys.bucket, h.key, &uplink.DownloadOptions{
+			Offset: off,
+			Length: -1,
+		})
+		if err != nil {
+			return nil, toErrno(err)
+		}
+		h.download, h.offset = download, off
+	}
+
+	n, err := io.ReadFull(h.download, dest)
+	h.offset += int64(n)
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+		_ = h.download.Close()
+		h.download = nil
+		return nil, toErrno(err)
+	}
+	return fuse.ReadResultData(dest[:n]), 0
+}
+
+// Release closes the download.
+func (h *remoteHandle) Release(ctx context.Context) syscall.Errno {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.download != nil {
+		_ = h.download.Close()
+		h.download = nil
+	}
+	return 0
+}
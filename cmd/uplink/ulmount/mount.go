@@ -0,0 +1,454 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+//go:build linux || darwin
+// +build linux darwin
+
+package ulmount
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/hanwen/go-fuse/v2/fs"
+	"github.com/hanwen/go-fuse/v2/fuse"
+	"github.com/zeebo/errs"
+
+	"storj.io/uplink"
+)
+
+// Server is a mounted filesystem.
+type Server struct {
+	server *fuse.Server
+	fsys   *filesystem
+}
+
+// Mount mounts the prefix of the bucket on the directory.
+func Mount(ctx context.Context, project *uplink.Project, bucket, prefix, dir string, config Config) (*Server, error) {
+	if _, err := project.StatBucket(ctx, bucket); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	if prefix != "" && !strings.HasSuffix(prefix, "/") {
+		prefix += "/"
+	}
+
+	fsys := &filesystem{
+		ctx:       ctx,
+		project:   project,
+		bucket:    bucket,
+		config:    config,
+		cache:     newMetadataCache(config.CacheTTL),
+		mountedAt: time.Now(),
+		dirs:      map[string]struct{}{},
+		pending:   map[string]*localFile{},
+	}
+
+	ttl := config.CacheTTL
+	server, err := fs.Mount(dir, &node{fsys: fsys, key: prefix, dir: true}, &fs.Options{
+		EntryTimeout:    &ttl,
+		AttrTimeout:     &ttl,
+		NegativeTimeout: &ttl,
+		MountOptions: fuse.MountOptions{
+			FsName: "sj://" + bucket + "/" + prefix,
+			Name:   "uplink",
+		},
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+
+	return &Server{server: server, fsys: fsys}, nil
+}
+
+// Wait waits until the filesystem is unmounted.
+func (server *Server) Wait() { server.server.Wait() }
+
+// Close unmounts the filesystem, when it is still mounted, and uploads the
+// files waiting for the write-back.
+func (server *Server) Close(ctx context.Context) error {
+	// the filesystem may have been unmounted externally already.
+	_ = server.server.Unmount()
+	return server.fsys.writeBackAll(ctx)
+}
+
+// filesystem contains the state shared by all nodes of the filesystem.
+type filesystem struct {
+	ctx       context.Context
+	project   *uplink.Project
+	bucket    string
+	config    Config
+	cache     *metadataCache
+	mountedAt time.Time
+
+	// mu must not be held while locking a localFile.
+	mu      sync.Mutex
+	dirs    map[string]struct{}   // directories created locally, which have no objects yet
+	pending map[string]*localFile // written files, which are not uploaded yet
+}
+
+// localFile is the local copy of a written file.
+type localFile struct {
+	file *os.File
+
+	// handles and timer are protected by filesystem.mu.
+	handles int
+	timer   *time.Timer
+
+	mu       sync.Mutex
+	key      string
+	loaded   bool
+	dirty    bool
+	removed  bool
+	modified time.Time
+}
+
+// splitKey splits the key of an object into the prefix of its directory and
+// its name.
+func splitKey(key string) (prefix, name string) {
+	i := strings.LastIndex(strings.TrimSuffix(key, "/"), "/")
+	return key[:i+1], strings.TrimSuffix(key[i+1:], "/")
+}
+
+// list returns the entries of the directory with the prefix, including the
+// local files and directories, which are not uploaded yet.
+func (fsys *filesystem) list(ctx context.Context, prefix string) (_ []entry, err error) {
+	entries, ok := fsys.cache.list(prefix)
+	if !ok {
+		entries, err = fsys.listRemote(ctx, prefix)
+		if err != nil {
+			return nil, err
+		}
+		fsys.cache.set(prefix, entries)
+	}
+
+	byName := make(map[string]entry, len(entries))
+	for _, e := range entries {
+		byName[e.name] = e
+	}
+
+	fsys.mu.Lock()
+	for key, local := range fsys.pending {
+		if !strings.HasPrefix(key, prefix) {
+			continue
+		}
+		name := key[len(prefix):]
+		if i := strings.Index(name, "/"); i >= 0 {
+			byName[name[:i]] = entry{name: name[:i], isPrefix: true, modified: fsys.mountedAt}
+			continue
+		}
+		e := entry{name: name, modified: fsys.mountedAt}
+		if info, err := local.file.Stat(); err == nil {
+			e.size, e.modified = info.Size(), info.ModTime()
+		}
+		byName[name] = e
+	}
+	for key := range fsys.dirs {
+		if !strings.HasPrefix(key, prefix) || key == prefix {
+			continue
+		}
+		name := key[len(prefix):]
+		name = name[:strings.Index(name, "/")]
+		if _, ok := byName[name]; !ok {
+			byName[name] = entry{name: name, isPrefix: true, modified: fsys.mountedAt}
+		}
+	}
+	fsys.mu.Unlock()
+
+	entries = entries[:0]
+	for _, e := range byName {
+		entries = append(entries, e)
+	}
+	return entries, nil
+}
+
+// listRemote lists the objects and prefixes in the directory with the prefix.
+func (fsys *filesystem) listRemote(ctx context.Context, prefix string) ([]entry, error) {
+	var entries []entry
+
+	it := fsys.project.ListObjects(ctx, fsys.bucket, &uplink.ListObjectsOptions{
+		Prefix: prefix,
+		System: true,
+	})
+	for it.Next() {
+		item := it.Item()
+		name := strings.TrimSuffix(strings.TrimPrefix(item.Key, prefix), "/")
+		if name == "" {
+			continue
+		}
+		e := entry{name: name, isPrefix: item.IsPrefix, modified: fsys.mountedAt}
+		if !item.IsPrefix {
+			e.size, e.modified = item.System.ContentLength, item.System.Created
+		}
+		entries = append(entries, e)
+	}
+	return entries, it.Err()
+}
+
+// lookup returns the entry with the name in the directory with the prefix.
+func (fsys *filesystem) lookup(ctx context.Context, prefix, name string) (entry, syscall.Errno) {
+	entries, err := fsys.list(ctx, prefix)
+	if err != nil {
+		return entry{}, toErrno(err)
+	}
+	for _, e := range entries {
+		if e.name == name {
+			return e, 0
+		}
+	}
+	return entry{}, syscall.ENOENT
+}
+
+// local returns the local copy of the file with the key, if any.
+func (fsys *filesystem) local(key string) *localFile {
+	fsys.mu.Lock()
+	defer fsys.mu.Unlock()
+	return fsys.pending[key]
+}
+
+// openLocal opens the local copy of the file with the key. The content of
+// an existing object is downloaded into the copy, unless the file is created
+// or truncated.
+func (fsys *filesystem) openLocal(ctx context.Context, key string, create, truncate bool) (_ *localFile, errno syscall.Errno) {
+	fsys.mu.Lock()
+	local, ok := fsys.pending[key]
+	if !ok {
+		file, err := os.CreateTemp(fsys.config.CacheDir, "uplink-mount-*")
+		if err != nil {
+			fsys.mu.Unlock()
+			return nil, toErrno(err)
+		}
+		local = &localFile{file: file, key: key, modified: time.Now()}
+		fsys.pending[key] = local
+	}
+	local.handles++
+	if local.timer != nil {
+		local.timer.Stop()
+		local.timer = nil
+	}
+	fsys.mu.Unlock()
+
+	local.mu.Lock()
+	defer local.mu.Unlock()
+
+	switch {
+	case create || truncate:
+		if err := local.file.Truncate(0); err != nil {
+			errno = toErrno(err)
+			break
+		}
+		local.loaded, local.dirty, local.modified = true, true, time.Now()
+	case !local.loaded:
+		if err := fsys.download(ctx, local); err != nil {
+			errno = toErrno(err)
+			break
+		}
+		local.loaded = true
+	}
+	if errno != 0 {
+		fsys.releaseLocked(local)
+		return nil, errno
+	}
+	return local, 0
+}
+
+// download downloads the object into the local copy.
+func (fsys *filesystem) download(ctx context.Context, local *localFile) (err error) {
+	download, err := fsys.project.DownloadObject(ctx, fsys.bucket, local.key, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { err = errs.Combine(err, download.Close()) }()
+
+	if _, err := local.file.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	if _, err := io.Copy(local.file, download); err != nil {
+		return err
+	}
+	local.modified = download.Info().System.Created
+	return nil
+}
+
+// upload uploads the local copy, when it has been changed. The local copy
+// must be locked.
+func (fsys *filesystem) upload(ctx context.Context, local *localFile) (err error) {
+	if !local.dirty || local.removed {
+		return nil
+	}
+
+	info, err := local.file.Stat()
+	if err != nil {
+		return err
+	}
+
+	upload, err := fsys.project.UploadObject(ctx, fsys.bucket, local.key, nil)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(upload, io.NewSectionReader(local.file, 0, info.Size())); err != nil {
+		return errs.Combine(err, upload.Abort())
+	}
+	if err := upload.Commit(); err != nil {
+		return err
+	}
+	local.dirty = false
+
+	prefix, name := splitKey(local.key)
+	fsys.cache.update(prefix, entry{name: name, size: info.Size(), modified: upload.Info().System.Created})
+	return nil
+}
+
+// release releases a handle of the local copy.
+func (fsys *filesystem) release(local *localFile) {
+	local.mu.Lock()
+	defer local.mu.Unlock()
+	fsys.releaseLocked(local)
+}
+
+// releaseLocked releases a handle of the locked local copy. The copy of the
+// last handle is dropped, when it is uploaded, or scheduled for the
+// write-back otherwise.
+func (fsys *filesystem) releaseLocked(local *localFile) {
+	fsys.mu.Lock()
+	defer fsys.mu.Unlock()
+
+	local.handles--
+	if local.handles > 0 {
+		return
+	}
+	if local.dirty && !local.removed {
+		local.timer = time.AfterFunc(fsys.config.WriteBackDelay, func() { fsys.writeBack(local) })
+		return
+	}
+	fsys.dropLocked(local)
+}
+
+// writeBack uploads the local copy in the background.
+func (fsys *filesystem) writeBack(local *localFile) {
+	local.mu.Lock()
+	defer local.mu.Unlock()
+
+	if err := fsys.upload(fsys.ctx, local); err != nil {
+		if fsys.config.OnWriteBackError != nil {
+			fsys.config.OnWriteBackError(local.key, err)
+		}
+		// the upload is retried when the filesystem is closed.
+		return
+	}
+
+	fsys.mu.Lock()
+	defer fsys.mu.Unlock()
+	if local.handles == 0 {
+		fsys.dropLocked(local)
+	}
+}
+
+// writeBackAll uploads all local copies, which have been changed.
+func (fsys *filesystem) writeBackAll(ctx context.Context) error {
+	fsys.mu.Lock()
+	locals := make([]*localFile, 0, len(fsys.pending))
+	for _, local := range fsys.pending {
+		if local.timer != nil {
+			local.timer.Stop()
+			local.timer = nil
+		}
+		locals = append(locals, local)
+	}
+	fsys.mu.Unlock()
+
+	var group errs.Group
+	for _, local := range locals {
+		local.mu.Lock()
+		if err := fsys.upload(ctx, local); err != nil {
+			group.Add(errs.New("failed to upload %q: %w", local.key, err))
+		}
+		fsys.mu.Lock()
+		fsys.dropLocked(local)
+		fsys.mu.Unlock()
+		local.mu.Unlock()
+	}
+	return group.Err()
+}
+
+// remove discards the local copy of the file with the key.
+func (fsys *filesystem) remove(key string) {
+	local := fsys.local(key)
+	if local == nil {
+		return
+	}
+
+	local.mu.Lock()
+	defer local.mu.Unlock()
+
+	local.removed = true
+	fsys.mu.Lock()
+	defer fsys.mu.Unlock()
+	if local.timer != nil {
+		local.timer.Stop()
+		local.timer = nil
+	}
+	// the copy is closed when the last handle is released.
+	if fsys.pending[key] == local {
+		delete(fsys.pending, key)
+	}
+	if local.handles == 0 {
+		fsys.dropLocked(local)
+	}
+}
+
+// move moves the local copy of the file with the key to the new key.
+func (fsys *filesystem) move(key, newKey string) {
+	local := fsys.local(key)
+	if local == nil {
+		return
+	}
+
+	local.mu.Lock()
+	defer local.mu.Unlock()
+
+	fsys.mu.Lock()
+	defer fsys.mu.Unlock()
+	if fsys.pending[key] == local {
+		delete(fsys.pending, key)
+		local.key = newKey
+		fsys.pending[newKey] = local
+	}
+}
+
+// dropLocked removes the local copy. The filesystem must be locked.
+func (fsys *filesystem) dropLocked(local *localFile) {
+	if fsys.pending[local.key] == local {
+		delete(fsys.pending, local.key)
+	}
+	if local.timer != nil {
+		local.timer.Stop()
+		local.timer = nil
+	}
+	_ = local.file.Close()
+	_ = os.Remove(local.file.Name())
+}
+
+// toErrno converts the error to an errno for the kernel.
+func toErrno(err error) syscall.Errno {
+	var errno syscall.Errno
+	switch {
+	case err == nil:
+		return 0
+	case errors.As(err, &errno):
+		return errno
+	case errors.Is(err, uplink.ErrObjectNotFound), errors.Is(err, uplink.ErrBucketNotFound), errors.Is(err, os.ErrNotExist):
+		return syscall.ENOENT
+	case errors.Is(err, uplink.ErrPermissionDenied):
+		return syscall.EACCES
+	case errors.Is(err, context.Canceled):
+		return syscall.EINTR
+	default:
+		return syscall.EIO
+	}
+}
@@ -0,0 +1,31 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+//go:build !linux && !darwin
+// +build !linux,!darwin
+
+package ulmount
+
+import (
+	"context"
+	"runtime"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/uplink"
+)
+
+// Server is a mounted filesystem.
+type Server struct{}
+
+// Mount mounts the prefix of the bucket on the directory.
+func Mount(ctx context.Context, project *uplink.Project, bucket, prefix, dir string, config Config) (*Server, error) {
+	return nil, errs.New("mount is not supported on %s", runtime.GOOS)
+}
+
+// Wait waits until the filesystem is unmounted.
+func (server *Server) Wait() {}
+
+// Close unmounts the filesystem, when it is still mounted, and uploads the
+// files waiting for the write-back.
+func (server *Server) Close(ctx context.Context) error { return nil }
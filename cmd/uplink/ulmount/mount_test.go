@@ -0,0 +1,246 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+//go:build linux
+// +build linux
+
+package ulmount_test
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/cmd/uplink/ulmount"
+	"storj.io/storj/private/testplanet"
+	"storj.io/uplink"
+)
+
+// mount mounts the prefix of the bucket on a temporary directory and
+// returns the directory. The test is skipped when FUSE isn't available.
+func mount(ctx *testcontext.Context, t *testing.T, planet *testplanet.Planet, bucket, prefix string, config ulmount.Config) (string, *ulmount.Server) {
+	if _, err := os.Stat("/dev/fuse"); err != nil {
+		t.Skip("FUSE is not available:", err)
+	}
+	if _, err := exec.LookPath("fusermount"); err != nil {
+		if _, err := exec.LookPath("fusermount3"); err != nil {
+			t.Skip("fusermount is not available")
+		}
+	}
+
+	project, err := planet.Uplinks[0].OpenProject(ctx, planet.Satellites[0])
+	require.NoError(t, err)
+	t.Cleanup(func() { ctx.Check(project.Close) })
+
+	if config.CacheDir == "" {
+		config.CacheDir = ctx.Dir("cache")
+	}
+
+	dir := ctx.Dir("mount")
+	server, err := ulmount.Mount(ctx, project, bucket, prefix, dir, config)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = server.Close(ctx) })
+
+	return dir, server
+}
+
+func listDir(t *testing.T, dir string) []string {
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+
+	names := []string{}
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() {
+			name += "/"
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func TestMountRead(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat, up := planet.Satellites[0], planet.Uplinks[0]
+
+		require.NoError(t, up.Upload(ctx, sat, "bucket", "a.txt", []byte("aaa")))
+		require.NoError(t, up.Upload(ctx, sat, "bucket", "dir/b.txt", []byte("bb")))
+		require.NoError(t, up.Upload(ctx, sat, "bucket", "dir/sub/c.txt", []byte("c")))
+
+		dir, _ := mount(ctx, t, planet, "bucket", "", ulmount.Config{
+			CacheTTL:  time.Minute,
+			WriteBack: ulmount.WriteBackSync,
+		})
+
+		require.Equal(t, []string{"a.txt", "dir/"}, listDir(t, dir))
+		require.Equal(t, []string{"b.txt", "sub/"}, listDir(t, filepath.Join(dir, "dir")))
+
+		data, err := os.ReadFile(filepath.Join(dir, "dir", "b.txt"))
+		require.NoError(t, err)
+		require.Equal(t, "bb", string(data))
+
+		info, err := os.Stat(filepath.Join(dir, "a.txt"))
+		require.NoError(t, err)
+		require.EqualValues(t, 3, info.Size())
+
+		_, err = os.Stat(filepath.Join(dir, "missing.txt"))
+		require.True(t, errors.Is(err, os.ErrNotExist))
+
+		// files are read at an offset.
+		file, err := os.Open(filepath.Join(dir, "a.txt"))
+		require.NoError(t, err)
+		buf := make([]byte, 2)
+		n, err := file.ReadAt(buf, 1)
+		require.NoError(t, err)
+		require.Equal(t, "aa", string(buf[:n]))
+		require.NoError(t, file.Close())
+	})
+}
+
+func TestMountPrefix(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat, up := planet.Satellites[0], planet.Uplinks[0]
+
+		require.NoError(t, up.Upload(ctx, sat, "bucket", "outside.txt", []byte("outside")))
+		require.NoError(t, up.Upload(ctx, sat, "bucket", "dir/inside.txt", []byte("inside")))
+
+		dir, _ := mount(ctx, t, planet, "bucket", "dir", ulmount.Config{
+			CacheTTL:  time.Minute,
+			WriteBack: ulmount.WriteBackSync,
+		})
+
+		require.Equal(t, []string{"inside.txt"}, listDir(t, dir))
+
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "new.txt"), []byte("new"), 0644))
+		data, err := up.Download(ctx, sat, "bucket", "dir/new.txt")
+		require.NoError(t, err)
+		require.Equal(t, "new", string(data))
+	})
+}
+
+func TestMountWriteSync(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat, up := planet.Satellites[0], planet.Uplinks[0]
+
+		require.NoError(t, up.Upload(ctx, sat, "bucket", "existing.txt", []byte("existing")))
+
+		dir, _ := mount(ctx, t, planet, "bucket", "", ulmount.Config{
+			CacheTTL:  time.Minute,
+			WriteBack: ulmount.WriteBackSync,
+		})
+
+		// a created file is uploaded when it's closed.
+		require.NoError(t, os.Mkdir(filepath.Join(dir, "dir"), 0755))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "dir", "new.txt"), []byte("new"), 0644))
+
+		data, err := up.Download(ctx, sat, "bucket", "dir/new.txt")
+		require.NoError(t, err)
+		require.Equal(t, "new", string(data))
+
+		// an existing file is downloaded before it's modified.
+		file, err := os.OpenFile(filepath.Join(dir, "existing.txt"), os.O_WRONLY, 0)
+		require.NoError(t, err)
+		_, err = file.WriteAt([]byte("EX"), 0)
+		require.NoError(t, err)
+		require.NoError(t, file.Close())
+
+		data, err = up.Download(ctx, sat, "bucket", "existing.txt")
+		require.NoError(t, err)
+		require.Equal(t, "EXisting", string(data))
+
+		// renaming moves the object.
+		require.NoError(t, os.Rename(filepath.Join(dir, "existing.txt"), filepath.Join(dir, "dir", "moved.txt")))
+		_, err = up.Download(ctx, sat, "bucket", "existing.txt")
+		require.True(t, errors.Is(err, uplink.ErrObjectNotFound))
+		data, err = up.Download(ctx, sat, "bucket", "dir/moved.txt")
+		require.NoError(t, err)
+		require.Equal(t, "EXisting", string(data))
+
+		// removing deletes the object.
+		require.NoError(t, os.Remove(filepath.Join(dir, "dir", "new.txt")))
+		_, err = up.Download(ctx, sat, "bucket", "dir/new.txt")
+		require.True(t, errors.Is(err, uplink.ErrObjectNotFound))
+
+		require.Equal(t, []string{"moved.txt"}, listDir(t, filepath.Join(dir, "dir")))
+	})
+}
+
+func TestMountWriteAsync(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat, up := planet.Satellites[0], planet.Uplinks[0]
+
+		require.NoError(t, up.CreateBucket(ctx, sat, "bucket"))
+
+		dir, server := mount(ctx, t, planet, "bucket", "", ulmount.Config{
+			CacheTTL:       time.Minute,
+			WriteBack:      ulmount.WriteBackAsync,
+			WriteBackDelay: time.Hour,
+		})
+
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "file.txt"), []byte("data"), 0644))
+
+		// the file isn't uploaded yet, but it's visible and readable locally.
+		_, err := up.Download(ctx, sat, "bucket", "file.txt")
+		require.True(t, errors.Is(err, uplink.ErrObjectNotFound))
+
+		require.Equal(t, []string{"file.txt"}, listDir(t, dir))
+		data, err := os.ReadFile(filepath.Join(dir, "file.txt"))
+		require.NoError(t, err)
+		require.Equal(t, "data", string(data))
+
+		// the pending files are uploaded when the filesystem is closed.
+		require.NoError(t, server.Close(ctx))
+
+		data, err = up.Download(ctx, sat, "bucket", "file.txt")
+		require.NoError(t, err)
+		require.Equal(t, "data", string(data))
+	})
+}
+
+func TestMountReadOnly(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat, up := planet.Satellites[0], planet.Uplinks[0]
+
+		require.NoError(t, up.Upload(ctx, sat, "bucket", "file.txt", []byte("data")))
+
+		dir, _ := mount(ctx, t, planet, "bucket", "", ulmount.Config{
+			ReadOnly:  true,
+			CacheTTL:  time.Minute,
+			WriteBack: ulmount.WriteBackSync,
+		})
+
+		data, err := os.ReadFile(filepath.Join(dir, "file.txt"))
+		require.NoError(t, err)
+		require.Equal(t, "data", string(data))
+
+		err = os.WriteFile(filepath.Join(dir, "file.txt"), []byte("changed"), 0644)
+		require.True(t, errors.Is(err, syscall.EROFS))
+		err = os.WriteFile(filepath.Join(dir, "new.txt"), []byte("new"), 0644)
+		require.True(t, errors.Is(err, syscall.EROFS))
+		err = os.Remove(filepath.Join(dir, "file.txt"))
+		require.True(t, errors.Is(err, syscall.EROFS))
+
+		data, err = up.Download(ctx, sat, "bucket", "file.txt")
+		require.NoError(t, err)
+		require.Equal(t, "data", string(data))
+	})
+}
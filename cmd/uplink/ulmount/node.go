@@ -0,0 +1,367 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+//go:build linux || darwin
+// +build linux darwin
+
+package ulmount
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/hanwen/go-fuse/v2/fs"
+	"github.com/hanwen/go-fuse/v2/fuse"
+
+	"storj.io/uplink"
+)
+
+// node is a file or a directory of the filesystem.
+type node struct {
+	fs.Inode
+
+	fsys *filesystem
+	dir  bool
+
+	mu       sync.Mutex
+	key      string // object key, or prefix with a trailing slash for directories
+	size     int64
+	modified time.Time
+}
+
+var _ = (fs.NodeGetattrer)((*node)(nil))
+var _ = (fs.NodeSetattrer)((*node)(nil))
+var _ = (fs.NodeLookuper)((*node)(nil))
+var _ = (fs.NodeReaddirer)((*node)(nil))
+var _ = (fs.NodeOpener)((*node)(nil))
+var _ = (fs.NodeCreater)((*node)(nil))
+var _ = (fs.NodeMkdirer)((*node)(nil))
+var _ = (fs.NodeUnlinker)((*node)(nil))
+var _ = (fs.NodeRmdirer)((*node)(nil))
+var _ = (fs.NodeRenamer)((*node)(nil))
+var _ = (fs.NodeStatfser)((*node)(nil))
+
+func (n *node) getKey() string {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return n.key
+}
+
+// childKey returns the key of the child with the name.
+func (n *node) childKey(name string, dir bool) string {
+	key := n.getKey() + name
+	if dir {
+		key += "/"
+	}
+	return key
+}
+
+// newChild creates the inode of the child for the entry.
+func (n *node) newChild(ctx context.Context, e entry, out *fuse.EntryOut) *fs.Inode {
+	child := &node{
+		fsys:     n.fsys,
+		dir:      e.isPrefix,
+		key:      n.childKey(e.name, e.isPrefix),
+		size:     e.size,
+		modified: e.modified,
+	}
+	child.fillAttr(&out.Attr)
+
+	mode := uint32(fuse.S_IFREG)
+	if e.isPrefix {
+		mode = fuse.S_IFDIR
+	}
+	return n.NewInode(ctx, child, fs.StableAttr{Mode: mode})
+}
+
+// fillAttr fills the attributes of the node.
+func (n *node) fillAttr(attr *fuse.Attr) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	size, modified := n.size, n.modified
+	if !n.dir {
+		if local := n.fsys.local(n.key); local != nil {
+			if info, err := local.file.Stat(); err == nil {
+				size, modified = info.Size(), info.ModTime()
+			}
+		}
+	}
+	if modified.IsZero() {
+		modified = n.fsys.mountedAt
+	}
+
+	if n.dir {
+		attr.Mode = fuse.S_IFDIR | 0755
+	} else {
+		attr.Mode = fuse.S_IFREG | 0644
+		attr.Size = uint64(size)
+		attr.Blocks = (attr.Size + 511) / 512
+	}
+	if n.fsys.config.ReadOnly {
+		attr.Mode &^= 0222
+	}
+	attr.Nlink = 1
+	attr.SetTimes(&modified, &modified, &modified)
+}
+
+// refresh updates the size and the modification time of a file from the
+// listing of its directory.
+func (n *node) refresh(ctx context.Context) syscall.Errno {
+	prefix, name := splitKey(n.getKey())
+	e, errno := n.fsys.lookup(ctx, prefix, name)
+	if errno != 0 {
+		return errno
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.size, n.modified = e.size, e.modified
+	return 0
+}
+
+// Getattr returns the attributes of the node.
+func (n *node) Getattr(ctx context.Context, f fs.FileHandle, out *fuse.AttrOut) syscall.Errno {
+	if !n.dir && n.fsys.local(n.getKey()) == nil {
+		if errno := n.refresh(ctx); errno != 0 {
+			return errno
+		}
+	}
+	n.fillAttr(&out.Attr)
+	return 0
+}
+
+// Setattr changes the size of a file, other attributes are ignored.
+func (n *node) Setattr(ctx context.Context, f fs.FileHandle, in *fuse.SetAttrIn, out *fuse.AttrOut) syscall.Errno {
+	if size, ok := in.GetSize(); ok {
+		if n.dir {
+			return syscall.EISDIR
+		}
+		if n.fsys.config.ReadOnly {
+			return syscall.EROFS
+		}
+
+		local, errno := n.fsys.openLocal(ctx, n.getKey(), false, size == 0)
+		if errno != 0 {
+			return errno
+		}
+		errno = local.truncate(int64(size))
+		n.fsys.release(local)
+		if errno != 0 {
+			return errno
+		}
+	}
+
+	n.fillAttr(&out.Attr)
+	return 0
+}
+
+// Lookup returns the child with the name.
+func (n *node) Lookup(ctx context.Context, name string, out *fuse.EntryOut) (*fs.Inode, syscall.Errno) {
+	e, errno := n.fsys.lookup(ctx, n.getKey(), name)
+	if errno != 0 {
+		return nil, errno
+	}
+	return n.newChild(ctx, e, out), 0
+}
+
+// Readdir lists the children of the directory.
+func (n *node) Readdir(ctx context.Context) (fs.DirStream, syscall.Errno) {
+	entries, err := n.fsys.list(ctx, n.getKey())
+	if err != nil {
+		return nil, toErrno(err)
+	}
+
+	list := make([]fuse.DirEntry, 0, len(entries))
+	for _, e := range entries {
+		mode := uint32(fuse.S_IFREG)
+		if e.isPrefix {
+			mode = fuse.S_IFDIR
+		}
+		list = append(list, fuse.DirEntry{Name: e.name, Mode: mode})
+	}
+	return fs.NewListDirStream(list), 0
+}
+
+// Open opens the file. Files opened for writing, and files waiting for the
+// write-back, are opened from their local copy.
+func (n *node) Open(ctx context.Context, flags uint32) (fs.FileHandle, uint32, syscall.Errno) {
+	if n.dir {
+		return nil, 0, syscall.EISDIR
+	}
+
+	key := n.getKey()
+	write := flags&syscall.O_ACCMODE != syscall.O_RDONLY
+	if write && n.fsys.config.ReadOnly {
+		return nil, 0, syscall.EROFS
+	}
+
+	if write || n.fsys.local(key) != nil {
+		local, errno := n.fsys.openLocal(ctx, key, false, flags&syscall.O_TRUNC != 0)
+		if errno != 0 {
+			return nil, 0, errno
+		}
+		return &localHandle{fsys: n.fsys, local: local}, 0, 0
+	}
+
+	return &remoteHandle{fsys: n.fsys, key: key}, 0, 0
+}
+
+// Create creates a file, which is uploaded when it is written back.
+func (n *node) Create(ctx context.Context, name string, flags uint32, mode uint32, out *fuse.EntryOut) (*fs.Inode, fs.FileHandle, uint32, syscall.Errno) {
+	if n.fsys.config.ReadOnly {
+		return nil, nil, 0, syscall.EROFS
+	}
+
+	local, errno := n.fsys.openLocal(ctx, n.childKey(name, false), true, true)
+	if errno != 0 {
+		return nil, nil, 0, errno
+	}
+
+	inode := n.newChild(ctx, entry{name: name, modified: time.Now()}, out)
+	return inode, &localHandle{fsys: n.fsys, local: local}, 0, 0
+}
+
+// Mkdir creates a directory. Object storage has no directories, so the
+// directory exists only locally until a file is uploaded into it.
+func (n *node) Mkdir(ctx context.Context, name string, mode uint32, out *fuse.EntryOut) (*fs.Inode, syscall.Errno) {
+	if n.fsys.config.ReadOnly {
+		return nil, syscall.EROFS
+	}
+
+	if _, errno := n.fsys.lookup(ctx, n.getKey(), name); errno == 0 {
+		return nil, syscall.EEXIST
+	}
+
+	n.fsys.mu.Lock()
+	n.fsys.dirs[n.childKey(name, true)] = struct{}{}
+	n.fsys.mu.Unlock()
+
+	return n.newChild(ctx, entry{name: name, isPrefix: true, modified: time.Now()}, out), 0
+}
+
+// Unlink deletes the file.
+func (n *node) Unlink(ctx context.Context, name string) syscall.Errno {
+	if n.fsys.config.ReadOnly {
+		return syscall.EROFS
+	}
+
+	key := n.childKey(name, false)
+	local := n.fsys.local(key) != nil
+	n.fsys.remove(key)
+
+	_, err := n.fsys.project.DeleteObject(ctx, n.fsys.bucket, key)
+	// files, which were not uploaded yet, exist only locally.
+	if err != nil && !(local && errors.Is(err, uplink.ErrObjectNotFound)) {
+		return toErrno(err)
+	}
+
+	n.fsys.cache.remove(n.getKey(), name)
+	return 0
+}
+
+// Rmdir removes the directory, when it is empty.
+func (n *node) Rmdir(ctx context.Context, name string) syscall.Errno {
+	if n.fsys.config.ReadOnly {
+		return syscall.EROFS
+	}
+
+	key := n.childKey(name, true)
+	entries, err := n.fsys.list(ctx, key)
+	if err != nil {
+		return toErrno(err)
+	}
+	if len(entries) > 0 {
+		return syscall.ENOTEMPTY
+	}
+
+	n.fsys.mu.Lock()
+	delete(n.fsys.dirs, key)
+	n.fsys.mu.Unlock()
+
+	n.fsys.cache.remove(n.getKey(), name)
+	n.fsys.cache.invalidate(key)
+	return 0
+}
+
+// Rename moves the file. Directories are not renamed, EXDEV lets tools like
+// mv fall back to copying them.
+func (n *node) Rename(ctx context.Context, name string, newParent fs.InodeEmbedder, newName string, flags uint32) syscall.Errno {
+	if n.fsys.config.ReadOnly {
+		return syscall.EROFS
+	}
+	if flags != 0 {
+		return syscall.ENOTSUP
+	}
+
+	e, errno := n.fsys.lookup(ctx, n.getKey(), name)
+	if errno != 0 {
+		return errno
+	}
+	if e.isPrefix {
+		return syscall.EXDEV
+	}
+
+	parent, ok := newParent.(*node)
+	if !ok {
+		return syscall.EXDEV
+	}
+
+	key, newKey := n.childKey(name, false), parent.childKey(newName, false)
+	if key == newKey {
+		return 0
+	}
+
+	// the local copy is uploaded first, so the object can be moved.
+	if local := n.fsys.local(key); local != nil {
+		local.mu.Lock()
+		err := n.fsys.upload(ctx, local)
+		local.mu.Unlock()
+		if err != nil {
+			return toErrno(err)
+		}
+	}
+
+	n.fsys.remove(newKey)
+	_, err := n.fsys.project.DeleteObject(ctx, n.fsys.bucket, newKey)
+	if err != nil && !errors.Is(err, uplink.ErrObjectNotFound) {
+		return toErrno(err)
+	}
+
+	if err := n.fsys.project.MoveObject(ctx, n.fsys.bucket, key, n.fsys.bucket, newKey, nil); err != nil {
+		return toErrno(err)
+	}
+	n.fsys.move(key, newKey)
+
+	if child := n.GetChild(name); child != nil {
+		if moved, ok := child.Operations().(*node); ok {
+			moved.mu.Lock()
+			moved.key = newKey
+			moved.mu.Unlock()
+		}
+	}
+
+	n.fsys.cache.remove(n.getKey(), name)
+	n.fsys.cache.invalidate(parent.getKey())
+	return 0
+}
+
+// Statfs returns the filesystem statistics. Object storage has no fixed
+// capacity, so a large capacity is reported.
+func (n *node) Statfs(ctx context.Context, out *fuse.StatfsOut) syscall.Errno {
+	const blockSize = 4096
+	const blocks = 1 << 40 / blockSize
+
+	out.Bsize = blockSize
+	out.Frsize = blockSize
+	out.Blocks = blocks
+	out.Bfree = blocks
+	out.Bavail = blocks
+	out.Files = 1 << 32
+	out.Ffree = 1 << 32
+	out.NameLen = 1024
+	return 0
+}
@@ -21,12 +21,14 @@ type external struct {
 
 	fs      ulfs.Filesystem
 	project *uplink.Project
+	cs      *callbackState
 }
 
-func newExternal(fs ulfs.Filesystem, project *uplink.Project) *external {
+func newExternal(fs ulfs.Filesystem, project *uplink.Project, cs *callbackState) *external {
 	return &external{
 		fs:      fs,
 		project: project,
+		cs:      cs,
 	}
 }
 
@@ -62,3 +64,20 @@ func (ex *external) OpenAccess(accessName string) (access *uplink.Access, err er
 func (ex *external) GetAccessInfo(required bool) (string, map[string]string, error) {
 	return accesses["TestAccessA"], accesses, nil
 }
+
+func (ex *external) UploadsFile() string {
+	return "uploads.json"
+}
+
+func (ex *external) GetUploads() ([]ulext.ResumableUpload, error) {
+	return append([]ulext.ResumableUpload(nil), ex.cs.uploads...), nil
+}
+
+func (ex *external) UpdateUploads(fn func(uploads []ulext.ResumableUpload) ([]ulext.ResumableUpload, error)) error {
+	uploads, err := fn(append([]ulext.ResumableUpload(nil), ex.cs.uploads...))
+	if err != nil {
+		return err
+	}
+	ex.cs.uploads = append([]ulext.ResumableUpload(nil), uploads...)
+	return nil
+}
@@ -204,11 +204,16 @@ func (rfs *remoteFilesystem) List(ctx context.Context, bucket, key string, opts
 	var infos []ulfs.ObjectInfo
 	for loc, mf := range rfs.files {
 		if (loc.HasPrefix(prefixDir) || loc == prefix) && !mf.expired() {
-			infos = append(infos, ulfs.ObjectInfo{
+			info := ulfs.ObjectInfo{
 				Loc:     loc,
 				Created: time.Unix(mf.created, 0),
 				Expires: mf.expires,
-			})
+			}
+			if opts != nil && opts.Expanded {
+				info.ContentLength = int64(len(mf.contents))
+				info.Metadata = mf.metadata
+			}
+			infos = append(infos, info)
 		}
 	}
 
@@ -10,6 +10,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"storj.io/storj/cmd/uplink/ulext"
 	"storj.io/storj/cmd/uplink/ulloc"
 )
 
@@ -21,6 +22,7 @@ type Result struct {
 	Err     error
 	Files   []File
 	Pending []File
+	Uploads []ulext.ResumableUpload
 }
 
 // RequireSuccess fails if the Result did not observe a successful execution.
@@ -81,6 +83,13 @@ func (r Result) RequirePending(t *testing.T, files ...File) Result {
 	return r
 }
 
+// RequireUploads requires that the set of resumable uploads provided are all of
+// the resumable uploads stored at the end of the execution.
+func (r Result) RequireUploads(t *testing.T, uploads ...ulext.ResumableUpload) Result {
+	require.Equal(t, uploads, r.Uploads)
+	return r
+}
+
 // RequireLocalFiles requires that the set of files provided are all of the
 // local files that existed at the end of the execution. It assumes any passed
 // in files with no contents contain the filename as the contents instead.
@@ -95,7 +95,7 @@ func (st State) Run(t *testing.T, args ...string) Result {
 			return cmd.Execute(ctx)
 		},
 	}.Run(ctx, func(cmds clingy.Commands) {
-		st.cmds(cmds, newExternal(fs, nil))
+		st.cmds(cmds, newExternal(fs, nil, cs))
 	})
 
 	if ok && err == nil {
@@ -113,6 +113,7 @@ func (st State) Run(t *testing.T, args ...string) Result {
 		Err:     err,
 		Files:   files,
 		Pending: rfs.Pending(),
+		Uploads: cs.uploads,
 	}
 }
 
@@ -157,9 +158,10 @@ func collectIterator(ctx context.Context, t *testing.T, fs ulfs.FilesystemLocal,
 }
 
 type callbackState struct {
-	stdin string
-	fs    ulfs.Filesystem
-	rfs   *remoteFilesystem
+	stdin   string
+	fs      ulfs.Filesystem
+	rfs     *remoteFilesystem
+	uploads []ulext.ResumableUpload
 }
 
 // ExecuteOption allows one to control the environment that a command executes in.
@@ -238,3 +240,11 @@ func WithPendingFile(location string) ExecuteOption {
 		require.NoError(t, err)
 	}}
 }
+
+// WithUpload sets the command to execute with the state of a resumable upload
+// stored.
+func WithUpload(upload ulext.ResumableUpload) ExecuteOption {
+	return ExecuteOption{func(_ *testing.T, _ context.Context, cs *callbackState) {
+		cs.uploads = append(cs.uploads, upload)
+	}}
+}
@@ -4,6 +4,9 @@
 package main
 
 import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/hex"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -34,6 +37,21 @@ var (
 		RunE:        cmdSetup,
 		Annotations: map[string]string{"type": "setup"},
 	}
+	generateKeyCmd = &cobra.Command{
+		Use:   "generate-signing-key <path>",
+		Short: "Generate a key for signing release manifests and print its public key",
+		Args:  cobra.ExactArgs(1),
+		RunE:  cmdGenerateKey,
+	}
+	signManifestCmd = &cobra.Command{
+		Use:   "sign-manifest <key-path> <output> <url>=<archive>...",
+		Short: "Create a signed release manifest containing the hashes of the archives",
+		Long: "Create a signed release manifest containing the hashes of the archives, which " +
+			"are downloaded from the urls. It's meant to be run offline, the versioncontrol " +
+			"server only serves the resulting manifest.",
+		Args: cobra.MinimumNArgs(3),
+		RunE: cmdSignManifest,
+	}
 
 	runCfg   versioncontrol.Config
 	setupCfg versioncontrol.Config
@@ -51,6 +69,8 @@ func init() {
 	defaults := cfgstruct.DefaultsFlag(rootCmd)
 	rootCmd.AddCommand(runCmd)
 	rootCmd.AddCommand(setupCmd)
+	rootCmd.AddCommand(generateKeyCmd)
+	rootCmd.AddCommand(signManifestCmd)
 	process.Bind(runCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir))
 	process.Bind(setupCmd, &setupCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.SetupMode())
 }
@@ -93,6 +113,21 @@ func cmdSetup(cmd *cobra.Command, args []string) (err error) {
 		process.SaveConfigWithOverrides(overrides))
 }
 
+func cmdGenerateKey(cmd *cobra.Command, args []string) (err error) {
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return err
+	}
+
+	seed := hex.EncodeToString(privateKey.Seed())
+	if err := os.WriteFile(args[0], []byte(seed+"\n"), 0600); err != nil {
+		return err
+	}
+
+	fmt.Println(hex.EncodeToString(publicKey))
+	return nil
+}
+
 func main() {
 	process.Exec(rootCmd)
 }
@@ -0,0 +1,74 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/private/version/checker"
+)
+
+func cmdSignManifest(cmd *cobra.Command, args []string) (err error) {
+	keyData, err := os.ReadFile(args[0])
+	if err != nil {
+		return err
+	}
+	key, err := checker.ParsePrivateKey(string(keyData))
+	if err != nil {
+		return err
+	}
+
+	manifest := checker.ReleaseManifest{
+		IssuedAt: time.Now().UTC(),
+		Binaries: map[string]string{},
+	}
+	for _, arg := range args[2:] {
+		url, archive, ok := strings.Cut(arg, "=")
+		if !ok || url == "" || archive == "" {
+			return errs.New("invalid archive %q, expected <url>=<archive>", arg)
+		}
+
+		hash, err := hashFile(archive)
+		if err != nil {
+			return err
+		}
+		manifest.Binaries[url] = hex.EncodeToString(hash)
+	}
+
+	signed, err := checker.SignManifest(key, manifest)
+	if err != nil {
+		return err
+	}
+
+	// the manifest is signed as is, hence it must not be reformatted.
+	data, err := json.Marshal(signed)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(args[1], data, 0644)
+}
+
+// hashFile returns the sha256 hash of the file.
+func hashFile(path string) (_ []byte, err error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = errs.Combine(err, file.Close()) }()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return nil, err
+	}
+	return hash.Sum(nil), nil
+}
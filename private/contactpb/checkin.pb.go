@@ -0,0 +1,363 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: checkin.proto
+
+package contactpb
+
+import (
+	fmt "fmt"
+	math "math"
+	time "time"
+
+	proto "github.com/gogo/protobuf/proto"
+
+	pb "storj.io/common/pb"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type CheckInRequest struct {
+	CheckIn              *pb.CheckInRequest `protobuf:"bytes,1,opt,name=check_in,json=checkIn,proto3" json:"check_in,omitempty"`
+	Capabilities         *Capabilities      `protobuf:"bytes,2,opt,name=capabilities,proto3" json:"capabilities,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *CheckInRequest) Reset()         { *m = CheckInRequest{} }
+func (m *CheckInRequest) String() string { return proto.CompactTextString(m) }
+func (*CheckInRequest) ProtoMessage()    {}
+func (*CheckInRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_072e71e6019dc001, []int{0}
+}
+func (m *CheckInRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CheckInRequest.Unmarshal(m, b)
+}
+func (m *CheckInRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CheckInRequest.Marshal(b, m, deterministic)
+}
+func (m *CheckInRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckInRequest.Merge(m, src)
+}
+func (m *CheckInRequest) XXX_Size() int {
+	return xxx_messageInfo_CheckInRequest.Size(m)
+}
+func (m *CheckInRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckInRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckInRequest proto.InternalMessageInfo
+
+func (m *CheckInRequest) GetCheckIn() *pb.CheckInRequest {
+	if m != nil {
+		return m.CheckIn
+	}
+	return nil
+}
+
+func (m *CheckInRequest) GetCapabilities() *Capabilities {
+	if m != nil {
+		return m.Capabilities
+	}
+	return nil
+}
+
+type CheckInResponse struct {
+	CheckIn *pb.CheckInResponse `protobuf:"bytes,1,opt,name=check_in,json=checkIn,proto3" json:"check_in,omitempty"`
+	// successor is set, when the satellite is succeeded by another one.
+	Successor *SuccessorPointer `protobuf:"bytes,2,opt,name=successor,proto3" json:"successor,omitempty"`
+	// decommission is set, when the satellite is shutting down.
+	Decommission         *DecommissionNotice `protobuf:"bytes,3,opt,name=decommission,proto3" json:"decommission,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *CheckInResponse) Reset()         { *m = CheckInResponse{} }
+func (m *CheckInResponse) String() string { return proto.CompactTextString(m) }
+func (*CheckInResponse) ProtoMessage()    {}
+func (*CheckInResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_072e71e6019dc001, []int{1}
+}
+func (m *CheckInResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CheckInResponse.Unmarshal(m, b)
+}
+func (m *CheckInResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CheckInResponse.Marshal(b, m, deterministic)
+}
+func (m *CheckInResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckInResponse.Merge(m, src)
+}
+func (m *CheckInResponse) XXX_Size() int {
+	return xxx_messageInfo_CheckInResponse.Size(m)
+}
+func (m *CheckInResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckInResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckInResponse proto.InternalMessageInfo
+
+func (m *CheckInResponse) GetCheckIn() *pb.CheckInResponse {
+	if m != nil {
+		return m.CheckIn
+	}
+	return nil
+}
+
+func (m *CheckInResponse) GetSuccessor() *SuccessorPointer {
+	if m != nil {
+		return m.Successor
+	}
+	return nil
+}
+
+func (m *CheckInResponse) GetDecommission() *DecommissionNotice {
+	if m != nil {
+		return m.Decommission
+	}
+	return nil
+}
+
+// Capabilities are the features, which a storagenode supports.
+type Capabilities struct {
+	Protocols            []string `protobuf:"bytes,1,rep,name=protocols,proto3" json:"protocols,omitempty"`
+	Hashstore            bool     `protobuf:"varint,2,opt,name=hashstore,proto3" json:"hashstore,omitempty"`
+	Ttl                  bool     `protobuf:"varint,3,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	MaxPieceSize         int64    `protobuf:"varint,4,opt,name=max_piece_size,json=maxPieceSize,proto3" json:"max_piece_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Capabilities) Reset()         { *m = Capabilities{} }
+func (m *Capabilities) String() string { return proto.CompactTextString(m) }
+func (*Capabilities) ProtoMessage()    {}
+func (*Capabilities) Descriptor() ([]byte, []int) {
+	return fileDescriptor_072e71e6019dc001, []int{2}
+}
+func (m *Capabilities) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Capabilities.Unmarshal(m, b)
+}
+func (m *Capabilities) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Capabilities.Marshal(b, m, deterministic)
+}
+func (m *Capabilities) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Capabilities.Merge(m, src)
+}
+func (m *Capabilities) XXX_Size() int {
+	return xxx_messageInfo_Capabilities.Size(m)
+}
+func (m *Capabilities) XXX_DiscardUnknown() {
+	xxx_messageInfo_Capabilities.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Capabilities proto.InternalMessageInfo
+
+func (m *Capabilities) GetProtocols() []string {
+	if m != nil {
+		return m.Protocols
+	}
+	return nil
+}
+
+func (m *Capabilities) GetHashstore() bool {
+	if m != nil {
+		return m.Hashstore
+	}
+	return false
+}
+
+func (m *Capabilities) GetTtl() bool {
+	if m != nil {
+		return m.Ttl
+	}
+	return false
+}
+
+func (m *Capabilities) GetMaxPieceSize() int64 {
+	if m != nil {
+		return m.MaxPieceSize
+	}
+	return 0
+}
+
+// SuccessorPointer is signed by the predecessor.
+type SuccessorPointer struct {
+	Predecessor          []byte    `protobuf:"bytes,1,opt,name=predecessor,proto3" json:"predecessor,omitempty"`
+	Successor            string    `protobuf:"bytes,2,opt,name=successor,proto3" json:"successor,omitempty"`
+	CreatedAt            time.Time `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at"`
+	Signature            []byte    `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *SuccessorPointer) Reset()         { *m = SuccessorPointer{} }
+func (m *SuccessorPointer) String() string { return proto.CompactTextString(m) }
+func (*SuccessorPointer) ProtoMessage()    {}
+func (*SuccessorPointer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_072e71e6019dc001, []int{3}
+}
+func (m *SuccessorPointer) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SuccessorPointer.Unmarshal(m, b)
+}
+func (m *SuccessorPointer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SuccessorPointer.Marshal(b, m, deterministic)
+}
+func (m *SuccessorPointer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SuccessorPointer.Merge(m, src)
+}
+func (m *SuccessorPointer) XXX_Size() int {
+	return xxx_messageInfo_SuccessorPointer.Size(m)
+}
+func (m *SuccessorPointer) XXX_DiscardUnknown() {
+	xxx_messageInfo_SuccessorPointer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SuccessorPointer proto.InternalMessageInfo
+
+func (m *SuccessorPointer) GetPredecessor() []byte {
+	if m != nil {
+		return m.Predecessor
+	}
+	return nil
+}
+
+func (m *SuccessorPointer) GetSuccessor() string {
+	if m != nil {
+		return m.Successor
+	}
+	return ""
+}
+
+func (m *SuccessorPointer) GetCreatedAt() time.Time {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return time.Time{}
+}
+
+func (m *SuccessorPointer) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+// DecommissionNotice is signed by the satellite, which is shutting down.
+type DecommissionNotice struct {
+	Satellite            []byte    `protobuf:"bytes,1,opt,name=satellite,proto3" json:"satellite,omitempty"`
+	ShutdownAt           time.Time `protobuf:"bytes,2,opt,name=shutdown_at,json=shutdownAt,proto3,stdtime" json:"shutdown_at"`
+	CreatedAt            time.Time `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at"`
+	Signature            []byte    `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *DecommissionNotice) Reset()         { *m = DecommissionNotice{} }
+func (m *DecommissionNotice) String() string { return proto.CompactTextString(m) }
+func (*DecommissionNotice) ProtoMessage()    {}
+func (*DecommissionNotice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_072e71e6019dc001, []int{4}
+}
+func (m *DecommissionNotice) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DecommissionNotice.Unmarshal(m, b)
+}
+func (m *DecommissionNotice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DecommissionNotice.Marshal(b, m, deterministic)
+}
+func (m *DecommissionNotice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DecommissionNotice.Merge(m, src)
+}
+func (m *DecommissionNotice) XXX_Size() int {
+	return xxx_messageInfo_DecommissionNotice.Size(m)
+}
+func (m *DecommissionNotice) XXX_DiscardUnknown() {
+	xxx_messageInfo_DecommissionNotice.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DecommissionNotice proto.InternalMessageInfo
+
+func (m *DecommissionNotice) GetSatellite() []byte {
+	if m != nil {
+		return m.Satellite
+	}
+	return nil
+}
+
+func (m *DecommissionNotice) GetShutdownAt() time.Time {
+	if m != nil {
+		return m.ShutdownAt
+	}
+	return time.Time{}
+}
+
+func (m *DecommissionNotice) GetCreatedAt() time.Time {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return time.Time{}
+}
+
+func (m *DecommissionNotice) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*CheckInRequest)(nil), "contactv2.CheckInRequest")
+	proto.RegisterType((*CheckInResponse)(nil), "contactv2.CheckInResponse")
+	proto.RegisterType((*Capabilities)(nil), "contactv2.Capabilities")
+	proto.RegisterType((*SuccessorPointer)(nil), "contactv2.SuccessorPointer")
+	proto.RegisterType((*DecommissionNotice)(nil), "contactv2.DecommissionNotice")
+}
+
+func init() { proto.RegisterFile("checkin.proto", fileDescriptor_072e71e6019dc001) }
+
+var fileDescriptor_072e71e6019dc001 = []byte{
+	// 500 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x93, 0xc1, 0x8e, 0xd3, 0x30,
+	0x10, 0x86, 0xf1, 0x76, 0xc5, 0x36, 0xd3, 0xec, 0xb2, 0xf2, 0x85, 0x50, 0x40, 0xad, 0x22, 0x0e,
+	0x3d, 0xa5, 0x52, 0xf7, 0x84, 0xb8, 0xd0, 0x2d, 0x48, 0x70, 0x59, 0xad, 0xbc, 0x9c, 0xb8, 0x54,
+	0xae, 0x3b, 0xb4, 0x86, 0x24, 0x0e, 0xf1, 0xb4, 0xac, 0xf6, 0x06, 0x4f, 0xc0, 0xcb, 0xf0, 0x06,
+	0x1c, 0x78, 0x08, 0x04, 0xaf, 0x82, 0xe2, 0xa4, 0x24, 0xa1, 0x5c, 0xb8, 0x70, 0xb3, 0xff, 0x99,
+	0xdf, 0xfe, 0xfc, 0x6b, 0x0c, 0xc7, 0x6a, 0x8d, 0xea, 0x9d, 0x4e, 0xa3, 0x2c, 0x37, 0x64, 0xb8,
+	0xa7, 0x4c, 0x4a, 0x52, 0xd1, 0x76, 0xd2, 0x87, 0x95, 0x59, 0x99, 0x52, 0xee, 0x0f, 0x56, 0xc6,
+	0xac, 0x62, 0x1c, 0xbb, 0xdd, 0x62, 0xf3, 0x66, 0x4c, 0x3a, 0x41, 0x4b, 0x32, 0xc9, 0xaa, 0x86,
+	0xe3, 0xca, 0x57, 0x6e, 0xc3, 0x8f, 0x0c, 0x4e, 0x66, 0xc5, 0xc1, 0x2f, 0x53, 0x81, 0xef, 0x37,
+	0x68, 0x89, 0x4f, 0xa0, 0xeb, 0xae, 0x9a, 0xeb, 0x34, 0x60, 0x43, 0x36, 0xea, 0x4d, 0xee, 0x46,
+	0x3b, 0x53, 0xbb, 0x55, 0x1c, 0xa9, 0x72, 0xcf, 0x9f, 0x80, 0xaf, 0x64, 0x26, 0x17, 0x3a, 0xd6,
+	0xa4, 0xd1, 0x06, 0x07, 0x6d, 0xdf, 0x76, 0x12, 0xcd, 0x1a, 0x65, 0xd1, 0x6a, 0x0e, 0xbf, 0x32,
+	0xb8, 0xf3, 0xfb, 0x60, 0x9b, 0x99, 0xd4, 0x22, 0x3f, 0xdb, 0x83, 0x08, 0xf6, 0x21, 0xca, 0xde,
+	0x9a, 0xe2, 0x31, 0x78, 0x76, 0xa3, 0x14, 0x5a, 0x6b, 0xf2, 0x0a, 0xe1, 0x7e, 0x03, 0xe1, 0x6a,
+	0x57, 0xbb, 0x34, 0x3a, 0x25, 0xcc, 0x45, 0xdd, 0xcd, 0xa7, 0xe0, 0x2f, 0x51, 0x99, 0x24, 0xd1,
+	0xd6, 0x6a, 0x93, 0x06, 0x1d, 0xe7, 0x7e, 0xd8, 0x70, 0x3f, 0x6b, 0x94, 0x2f, 0x0c, 0x69, 0x85,
+	0xa2, 0x65, 0x09, 0x3f, 0x31, 0xf0, 0x9b, 0xaf, 0xe4, 0x0f, 0xc0, 0x73, 0x21, 0x2b, 0x13, 0xdb,
+	0x80, 0x0d, 0x3b, 0x23, 0x4f, 0xd4, 0x42, 0x51, 0x5d, 0x4b, 0xbb, 0xb6, 0x64, 0x72, 0x74, 0xb0,
+	0x5d, 0x51, 0x0b, 0xfc, 0x14, 0x3a, 0x44, 0xb1, 0xc3, 0xe8, 0x8a, 0x62, 0xc9, 0x1f, 0xc1, 0x49,
+	0x22, 0xaf, 0xe7, 0x99, 0x46, 0x85, 0x73, 0xab, 0x6f, 0x30, 0x38, 0x1c, 0xb2, 0x51, 0x47, 0xf8,
+	0x89, 0xbc, 0xbe, 0x2c, 0xc4, 0x2b, 0x7d, 0x83, 0xe1, 0x17, 0x06, 0xa7, 0x7f, 0xbe, 0x93, 0x0f,
+	0xa1, 0x97, 0xe5, 0xb8, 0xc4, 0x2a, 0x99, 0x22, 0x4f, 0x5f, 0x34, 0xa5, 0x02, 0xa6, 0x9d, 0x9c,
+	0xd7, 0x0c, 0x67, 0x06, 0xa0, 0x72, 0x94, 0x84, 0xcb, 0xb9, 0xa4, 0x2a, 0x9a, 0x7e, 0x54, 0x4e,
+	0x5a, 0xb4, 0x9b, 0xb4, 0xe8, 0xd5, 0x6e, 0xd2, 0xce, 0xbb, 0xdf, 0x7e, 0x0c, 0x6e, 0x7d, 0xfe,
+	0x39, 0x60, 0xc2, 0xab, 0x7c, 0x53, 0x72, 0x57, 0xe8, 0x55, 0x2a, 0x69, 0x93, 0x97, 0xe8, 0xbe,
+	0xa8, 0x85, 0xf0, 0x3b, 0x03, 0xbe, 0x9f, 0xb0, 0x33, 0x49, 0xc2, 0x38, 0xd6, 0x84, 0x15, 0x77,
+	0x2d, 0xf0, 0xe7, 0xd0, 0xb3, 0xeb, 0x0d, 0x2d, 0xcd, 0x87, 0xb4, 0x00, 0x3b, 0xf8, 0x07, 0x30,
+	0xd8, 0x19, 0xa7, 0xf4, 0x1f, 0x9e, 0x37, 0x79, 0x01, 0x87, 0x17, 0x66, 0x89, 0xfc, 0x29, 0x1c,
+	0x55, 0xd3, 0xcb, 0xef, 0x35, 0x3f, 0x47, 0xeb, 0x5b, 0xf5, 0xfb, 0x7f, 0x2b, 0x95, 0xc3, 0x7e,
+	0x1e, 0xbe, 0x1e, 0x16, 0x13, 0xf2, 0x36, 0xd2, 0x66, 0xec, 0x16, 0xe3, 0x2c, 0xd7, 0x5b, 0x49,
+	0x38, 0xae, 0x3c, 0xd9, 0x62, 0x71, 0xdb, 0x41, 0x9f, 0xfd, 0x1a, 0x00, 0xfb, 0x7c, 0x6e, 0xbc,
+	0x33, 0x04, 0x00, 0x00,
+}
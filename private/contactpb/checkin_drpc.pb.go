@@ -0,0 +1,115 @@
+// Code generated by protoc-gen-go-drpc. DO NOT EDIT.
+// protoc-gen-go-drpc version: v0.0.32
+// source: checkin.proto
+
+package contactpb
+
+import (
+	bytes "bytes"
+	context "context"
+	errors "errors"
+
+	jsonpb "github.com/gogo/protobuf/jsonpb"
+	proto "github.com/gogo/protobuf/proto"
+
+	drpc "storj.io/drpc"
+	drpcerr "storj.io/drpc/drpcerr"
+)
+
+type drpcEncoding_File_checkin_proto struct{}
+
+func (drpcEncoding_File_checkin_proto) Marshal(msg drpc.Message) ([]byte, error) {
+	return proto.Marshal(msg.(proto.Message))
+}
+
+func (drpcEncoding_File_checkin_proto) Unmarshal(buf []byte, msg drpc.Message) error {
+	return proto.Unmarshal(buf, msg.(proto.Message))
+}
+
+func (drpcEncoding_File_checkin_proto) JSONMarshal(msg drpc.Message) ([]byte, error) {
+	var buf bytes.Buffer
+	err := new(jsonpb.Marshaler).Marshal(&buf, msg.(proto.Message))
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (drpcEncoding_File_checkin_proto) JSONUnmarshal(buf []byte, msg drpc.Message) error {
+	return jsonpb.Unmarshal(bytes.NewReader(buf), msg.(proto.Message))
+}
+
+type DRPCNodeClient interface {
+	DRPCConn() drpc.Conn
+
+	CheckIn(ctx context.Context, in *CheckInRequest) (*CheckInResponse, error)
+}
+
+type drpcNodeClient struct {
+	cc drpc.Conn
+}
+
+func NewDRPCNodeClient(cc drpc.Conn) DRPCNodeClient {
+	return &drpcNodeClient{cc}
+}
+
+func (c *drpcNodeClient) DRPCConn() drpc.Conn { return c.cc }
+
+func (c *drpcNodeClient) CheckIn(ctx context.Context, in *CheckInRequest) (*CheckInResponse, error) {
+	out := new(CheckInResponse)
+	err := c.cc.Invoke(ctx, "/contactv2.Node/CheckIn", drpcEncoding_File_checkin_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+type DRPCNodeServer interface {
+	CheckIn(context.Context, *CheckInRequest) (*CheckInResponse, error)
+}
+
+type DRPCNodeUnimplementedServer struct{}
+
+func (s *DRPCNodeUnimplementedServer) CheckIn(context.Context, *CheckInRequest) (*CheckInResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+type DRPCNodeDescription struct{}
+
+func (DRPCNodeDescription) NumMethods() int { return 1 }
+
+func (DRPCNodeDescription) Method(n int) (string, drpc.Encoding, drpc.Receiver, interface{}, bool) {
+	switch n {
+	case 0:
+		return "/contactv2.Node/CheckIn", drpcEncoding_File_checkin_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCNodeServer).
+					CheckIn(
+						ctx,
+						in1.(*CheckInRequest),
+					)
+			}, DRPCNodeServer.CheckIn, true
+	default:
+		return "", nil, nil, nil, false
+	}
+}
+
+func DRPCRegisterNode(mux drpc.Mux, impl DRPCNodeServer) error {
+	return mux.Register(impl, DRPCNodeDescription{})
+}
+
+type DRPCNode_CheckInStream interface {
+	drpc.Stream
+	SendAndClose(*CheckInResponse) error
+}
+
+type drpcNode_CheckInStream struct {
+	drpc.Stream
+}
+
+func (x *drpcNode_CheckInStream) SendAndClose(m *CheckInResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_checkin_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
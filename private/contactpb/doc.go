@@ -0,0 +1,8 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package contactpb contains protobuf definitions for the second version of
+// the check-in, which aren't part of the public protocol yet.
+package contactpb
+
+//go:generate go run gen.go
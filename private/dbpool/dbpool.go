@@ -0,0 +1,130 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package dbpool implements connection pool sizing through the parameters of
+// a database connection url.
+//
+// The transaction_pooling=true parameter makes the connections compatible
+// with transaction pooling proxies like PgBouncer: the driver doesn't keep
+// named prepared statements, which only exist in the session of a single
+// server connection.
+package dbpool
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/private/dbutil"
+)
+
+// Error is the error class for connection pool options.
+var Error = errs.Class("dbpool")
+
+// The connection url parameters, which configure the connection pool. They
+// match the names of the global db flags, which are used when a parameter is
+// missing.
+const (
+	maxOpenConnsParam    = "max_open_conns"
+	maxIdleConnsParam    = "max_idle_conns"
+	connMaxLifetimeParam = "conn_max_lifetime"
+
+	transactionPoolingParam = "transaction_pooling"
+	// statementCacheModeParam is the pgx parameter, which selects how
+	// statements are cached. The describe mode only caches the description
+	// of statements and executes them as unnamed statements.
+	statementCacheModeParam = "statement_cache_mode"
+)
+
+// Options configures the connection pool of a database.
+type Options struct {
+	MaxOpenConns    *int
+	MaxIdleConns    *int
+	ConnMaxLifetime *time.Duration
+}
+
+// Split removes the connection pool parameters from the connection url, e.g.
+// postgres://host/db?max_open_conns=20, and returns them as options. The
+// transaction_pooling parameter is replaced by the driver parameters, which
+// it stands for.
+func Split(connstr string) (_ string, opts Options, err error) {
+	if !strings.Contains(connstr, "://") || !strings.Contains(connstr, "?") {
+		return connstr, opts, nil
+	}
+
+	u, err := url.Parse(connstr)
+	if err != nil {
+		return "", opts, Error.New("invalid connection url: %w", err)
+	}
+
+	query := u.Query()
+	parseInt := func(param string) (*int, error) {
+		if !query.Has(param) {
+			return nil, nil
+		}
+		value, err := strconv.Atoi(query.Get(param))
+		if err != nil {
+			return nil, Error.New("invalid %s: %w", param, err)
+		}
+		query.Del(param)
+		return &value, nil
+	}
+
+	if opts.MaxOpenConns, err = parseInt(maxOpenConnsParam); err != nil {
+		return "", opts, err
+	}
+	if opts.MaxIdleConns, err = parseInt(maxIdleConnsParam); err != nil {
+		return "", opts, err
+	}
+	if query.Has(connMaxLifetimeParam) {
+		lifetime, err := time.ParseDuration(query.Get(connMaxLifetimeParam))
+		if err != nil {
+			return "", opts, Error.New("invalid %s: %w", connMaxLifetimeParam, err)
+		}
+		query.Del(connMaxLifetimeParam)
+		opts.ConnMaxLifetime = &lifetime
+	}
+	if query.Has(transactionPoolingParam) {
+		pooling, err := strconv.ParseBool(query.Get(transactionPoolingParam))
+		if err != nil {
+			return "", opts, Error.New("invalid %s: %w", transactionPoolingParam, err)
+		}
+		query.Del(transactionPoolingParam)
+		if pooling && !query.Has(statementCacheModeParam) {
+			query.Set(statementCacheModeParam, "describe")
+		}
+	}
+
+	u.RawQuery = query.Encode()
+	return u.String(), opts, nil
+}
+
+// WithTransactionPooling returns the connection url with transaction pooling
+// enabled.
+func WithTransactionPooling(connstr string) (string, error) {
+	u, err := url.Parse(connstr)
+	if err != nil {
+		return "", Error.New("invalid connection url: %w", err)
+	}
+	query := u.Query()
+	query.Set(transactionPoolingParam, "true")
+	u.RawQuery = query.Encode()
+	return u.String(), nil
+}
+
+// Configure applies the options to the connection pool of db. Options, which
+// aren't set, are left as they are.
+func (opts Options) Configure(db dbutil.ConfigurableDB) {
+	if opts.MaxOpenConns != nil {
+		db.SetMaxOpenConns(*opts.MaxOpenConns)
+	}
+	if opts.MaxIdleConns != nil {
+		db.SetMaxIdleConns(*opts.MaxIdleConns)
+	}
+	if opts.ConnMaxLifetime != nil {
+		db.SetConnMaxLifetime(*opts.ConnMaxLifetime)
+	}
+}
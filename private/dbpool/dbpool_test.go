@@ -0,0 +1,76 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package dbpool_test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/private/dbpool"
+)
+
+type mockPool struct {
+	maxOpen  int
+	maxIdle  int
+	lifetime time.Duration
+}
+
+func (pool *mockPool) SetMaxOpenConns(n int)              { pool.maxOpen = n }
+func (pool *mockPool) SetMaxIdleConns(n int)              { pool.maxIdle = n }
+func (pool *mockPool) SetConnMaxLifetime(d time.Duration) { pool.lifetime = d }
+func (pool *mockPool) Stats() sql.DBStats                 { return sql.DBStats{} }
+
+func TestSplit(t *testing.T) {
+	connstr, opts, err := dbpool.Split("postgres://user@host/db?sslmode=disable&max_open_conns=20&max_idle_conns=5&conn_max_lifetime=10m")
+	require.NoError(t, err)
+	require.Equal(t, "postgres://user@host/db?sslmode=disable", connstr)
+
+	pool := &mockPool{maxOpen: 1, maxIdle: 1, lifetime: time.Minute}
+	opts.Configure(pool)
+	require.Equal(t, &mockPool{maxOpen: 20, maxIdle: 5, lifetime: 10 * time.Minute}, pool)
+
+	// missing parameters leave the pool as it is.
+	connstr, opts, err = dbpool.Split("postgres://host/db?max_open_conns=3")
+	require.NoError(t, err)
+	require.Equal(t, "postgres://host/db", connstr)
+
+	pool = &mockPool{maxOpen: 1, maxIdle: 1, lifetime: time.Minute}
+	opts.Configure(pool)
+	require.Equal(t, &mockPool{maxOpen: 3, maxIdle: 1, lifetime: time.Minute}, pool)
+
+	for _, connstr := range []string{"postgres://", "postgres://host/db?sslmode=disable", "host=localhost dbname=db"} {
+		unchanged, opts, err := dbpool.Split(connstr)
+		require.NoError(t, err)
+		require.Equal(t, connstr, unchanged)
+		require.Equal(t, dbpool.Options{}, opts)
+	}
+
+	// transaction pooling disables named prepared statements.
+	connstr, opts, err = dbpool.Split("postgres://host/db?transaction_pooling=true")
+	require.NoError(t, err)
+	require.Equal(t, "postgres://host/db?statement_cache_mode=describe", connstr)
+	require.Equal(t, dbpool.Options{}, opts)
+
+	connstr, opts, err = dbpool.Split("postgres://host/db?transaction_pooling=false")
+	require.NoError(t, err)
+	require.Equal(t, "postgres://host/db", connstr)
+	require.Equal(t, dbpool.Options{}, opts)
+
+	connstr, err = dbpool.WithTransactionPooling("postgres://host/db?sslmode=disable")
+	require.NoError(t, err)
+	connstr, opts, err = dbpool.Split(connstr)
+	require.NoError(t, err)
+	require.Equal(t, "postgres://host/db?sslmode=disable&statement_cache_mode=describe", connstr)
+	require.Equal(t, dbpool.Options{}, opts)
+
+	_, _, err = dbpool.Split("postgres://host/db?transaction_pooling=sometimes")
+	require.Error(t, err)
+	_, _, err = dbpool.Split("postgres://host/db?max_open_conns=many")
+	require.Error(t, err)
+	_, _, err = dbpool.Split("postgres://host/db?conn_max_lifetime=forever")
+	require.Error(t, err)
+}
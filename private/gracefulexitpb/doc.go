@@ -0,0 +1,7 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package gracefulexitpb contains protobuf definitions for the graceful exit requests of storagenodes, which aren't part of the public protocol.
+package gracefulexitpb
+
+//go:generate go run gen.go
@@ -0,0 +1,128 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: gracefulexit.proto
+
+package gracefulexitpb
+
+import (
+	fmt "fmt"
+	math "math"
+	time "time"
+
+	proto "github.com/gogo/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type AbortRequest struct {
+	Reason               string   `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AbortRequest) Reset()         { *m = AbortRequest{} }
+func (m *AbortRequest) String() string { return proto.CompactTextString(m) }
+func (*AbortRequest) ProtoMessage()    {}
+func (*AbortRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f0acbf2ce5fa631, []int{0}
+}
+func (m *AbortRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AbortRequest.Unmarshal(m, b)
+}
+func (m *AbortRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AbortRequest.Marshal(b, m, deterministic)
+}
+func (m *AbortRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AbortRequest.Merge(m, src)
+}
+func (m *AbortRequest) XXX_Size() int {
+	return xxx_messageInfo_AbortRequest.Size(m)
+}
+func (m *AbortRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AbortRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AbortRequest proto.InternalMessageInfo
+
+func (m *AbortRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+type AbortResponse struct {
+	// cooldown_until is when the storagenode can initiate a new graceful exit.
+	CooldownUntil        time.Time `protobuf:"bytes,1,opt,name=cooldown_until,json=cooldownUntil,proto3,stdtime" json:"cooldown_until"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *AbortResponse) Reset()         { *m = AbortResponse{} }
+func (m *AbortResponse) String() string { return proto.CompactTextString(m) }
+func (*AbortResponse) ProtoMessage()    {}
+func (*AbortResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f0acbf2ce5fa631, []int{1}
+}
+func (m *AbortResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AbortResponse.Unmarshal(m, b)
+}
+func (m *AbortResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AbortResponse.Marshal(b, m, deterministic)
+}
+func (m *AbortResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AbortResponse.Merge(m, src)
+}
+func (m *AbortResponse) XXX_Size() int {
+	return xxx_messageInfo_AbortResponse.Size(m)
+}
+func (m *AbortResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AbortResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AbortResponse proto.InternalMessageInfo
+
+func (m *AbortResponse) GetCooldownUntil() time.Time {
+	if m != nil {
+		return m.CooldownUntil
+	}
+	return time.Time{}
+}
+
+func init() {
+	proto.RegisterType((*AbortRequest)(nil), "gracefulexit.AbortRequest")
+	proto.RegisterType((*AbortResponse)(nil), "gracefulexit.AbortResponse")
+}
+
+func init() { proto.RegisterFile("gracefulexit.proto", fileDescriptor_8f0acbf2ce5fa631) }
+
+var fileDescriptor_8f0acbf2ce5fa631 = []byte{
+	// 242 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x4a, 0x2f, 0x4a, 0x4c,
+	0x4e, 0x4d, 0x2b, 0xcd, 0x49, 0xad, 0xc8, 0x2c, 0xd1, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2,
+	0x41, 0x16, 0x93, 0xe2, 0x4a, 0xcf, 0x4f, 0xcf, 0x87, 0xc8, 0x48, 0xc9, 0xa7, 0xe7, 0xe7, 0xa7,
+	0xe7, 0xa4, 0xea, 0x83, 0x79, 0x49, 0xa5, 0x69, 0xfa, 0x25, 0x99, 0xb9, 0xa9, 0xc5, 0x25, 0x89,
+	0xb9, 0x05, 0x10, 0x05, 0x4a, 0x6a, 0x5c, 0x3c, 0x8e, 0x49, 0xf9, 0x45, 0x25, 0x41, 0xa9, 0x85,
+	0xa5, 0xa9, 0xc5, 0x25, 0x42, 0x62, 0x5c, 0x6c, 0x45, 0xa9, 0x89, 0xc5, 0xf9, 0x79, 0x12, 0x8c,
+	0x0a, 0x8c, 0x1a, 0x9c, 0x41, 0x50, 0x9e, 0x52, 0x0c, 0x17, 0x2f, 0x54, 0x5d, 0x71, 0x41, 0x7e,
+	0x5e, 0x71, 0xaa, 0x90, 0x37, 0x17, 0x5f, 0x72, 0x7e, 0x7e, 0x4e, 0x4a, 0x7e, 0x79, 0x5e, 0x7c,
+	0x69, 0x5e, 0x49, 0x66, 0x0e, 0x58, 0x03, 0xb7, 0x91, 0x94, 0x1e, 0xc4, 0x4a, 0x3d, 0x98, 0x95,
+	0x7a, 0x21, 0x30, 0x2b, 0x9d, 0x38, 0x4e, 0xdc, 0x93, 0x67, 0x98, 0x70, 0x5f, 0x9e, 0x31, 0x88,
+	0x17, 0xa6, 0x37, 0x14, 0xa4, 0xd5, 0x28, 0x94, 0x4b, 0xd0, 0x1d, 0xea, 0x05, 0xd7, 0x8a, 0xcc,
+	0x12, 0xb0, 0x4d, 0x42, 0x0e, 0x5c, 0xac, 0x10, 0x86, 0x94, 0x1e, 0x8a, 0x9f, 0x91, 0xdd, 0x2b,
+	0x25, 0x8d, 0x55, 0x0e, 0xe2, 0x46, 0x27, 0xf5, 0x28, 0xd5, 0xe2, 0x92, 0xfc, 0xa2, 0x2c, 0xbd,
+	0xcc, 0x7c, 0x7d, 0x30, 0x43, 0xbf, 0xa0, 0x28, 0xb3, 0x2c, 0xb1, 0x24, 0x55, 0x1f, 0x59, 0x53,
+	0x41, 0x52, 0x12, 0x1b, 0xd8, 0xb1, 0xc6, 0x80, 0x01, 0x00, 0x83, 0xd2, 0x0e, 0xb4, 0x5d, 0x01,
+	0x00, 0x00,
+}
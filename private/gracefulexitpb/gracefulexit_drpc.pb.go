@@ -0,0 +1,115 @@
+// Code generated by protoc-gen-go-drpc. DO NOT EDIT.
+// protoc-gen-go-drpc version: v0.0.32
+// source: gracefulexit.proto
+
+package gracefulexitpb
+
+import (
+	bytes "bytes"
+	context "context"
+	errors "errors"
+
+	jsonpb "github.com/gogo/protobuf/jsonpb"
+	proto "github.com/gogo/protobuf/proto"
+
+	drpc "storj.io/drpc"
+	drpcerr "storj.io/drpc/drpcerr"
+)
+
+type drpcEncoding_File_gracefulexit_proto struct{}
+
+func (drpcEncoding_File_gracefulexit_proto) Marshal(msg drpc.Message) ([]byte, error) {
+	return proto.Marshal(msg.(proto.Message))
+}
+
+func (drpcEncoding_File_gracefulexit_proto) Unmarshal(buf []byte, msg drpc.Message) error {
+	return proto.Unmarshal(buf, msg.(proto.Message))
+}
+
+func (drpcEncoding_File_gracefulexit_proto) JSONMarshal(msg drpc.Message) ([]byte, error) {
+	var buf bytes.Buffer
+	err := new(jsonpb.Marshaler).Marshal(&buf, msg.(proto.Message))
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (drpcEncoding_File_gracefulexit_proto) JSONUnmarshal(buf []byte, msg drpc.Message) error {
+	return jsonpb.Unmarshal(bytes.NewReader(buf), msg.(proto.Message))
+}
+
+type DRPCGracefulExitAbortClient interface {
+	DRPCConn() drpc.Conn
+
+	Abort(ctx context.Context, in *AbortRequest) (*AbortResponse, error)
+}
+
+type drpcGracefulExitAbortClient struct {
+	cc drpc.Conn
+}
+
+func NewDRPCGracefulExitAbortClient(cc drpc.Conn) DRPCGracefulExitAbortClient {
+	return &drpcGracefulExitAbortClient{cc}
+}
+
+func (c *drpcGracefulExitAbortClient) DRPCConn() drpc.Conn { return c.cc }
+
+func (c *drpcGracefulExitAbortClient) Abort(ctx context.Context, in *AbortRequest) (*AbortResponse, error) {
+	out := new(AbortResponse)
+	err := c.cc.Invoke(ctx, "/gracefulexit.GracefulExitAbort/Abort", drpcEncoding_File_gracefulexit_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+type DRPCGracefulExitAbortServer interface {
+	Abort(context.Context, *AbortRequest) (*AbortResponse, error)
+}
+
+type DRPCGracefulExitAbortUnimplementedServer struct{}
+
+func (s *DRPCGracefulExitAbortUnimplementedServer) Abort(context.Context, *AbortRequest) (*AbortResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+type DRPCGracefulExitAbortDescription struct{}
+
+func (DRPCGracefulExitAbortDescription) NumMethods() int { return 1 }
+
+func (DRPCGracefulExitAbortDescription) Method(n int) (string, drpc.Encoding, drpc.Receiver, interface{}, bool) {
+	switch n {
+	case 0:
+		return "/gracefulexit.GracefulExitAbort/Abort", drpcEncoding_File_gracefulexit_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCGracefulExitAbortServer).
+					Abort(
+						ctx,
+						in1.(*AbortRequest),
+					)
+			}, DRPCGracefulExitAbortServer.Abort, true
+	default:
+		return "", nil, nil, nil, false
+	}
+}
+
+func DRPCRegisterGracefulExitAbort(mux drpc.Mux, impl DRPCGracefulExitAbortServer) error {
+	return mux.Register(impl, DRPCGracefulExitAbortDescription{})
+}
+
+type DRPCGracefulExitAbort_AbortStream interface {
+	drpc.Stream
+	SendAndClose(*AbortResponse) error
+}
+
+type drpcGracefulExitAbort_AbortStream struct {
+	drpc.Stream
+}
+
+func (x *drpcGracefulExitAbort_AbortStream) SendAndClose(m *AbortResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_gracefulexit_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
@@ -0,0 +1,9 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package maintenancepb contains protobuf definitions for querying the
+// maintenance mode of the satellite, which aren't part of the public
+// protocol yet.
+package maintenancepb
+
+//go:generate go run gen.go
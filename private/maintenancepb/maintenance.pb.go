@@ -0,0 +1,133 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: maintenance.proto
+
+package maintenancepb
+
+import (
+	fmt "fmt"
+	math "math"
+
+	proto "github.com/gogo/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type StateRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StateRequest) Reset()         { *m = StateRequest{} }
+func (m *StateRequest) String() string { return proto.CompactTextString(m) }
+func (*StateRequest) ProtoMessage()    {}
+func (*StateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6053ae89a3b3f561, []int{0}
+}
+func (m *StateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StateRequest.Unmarshal(m, b)
+}
+func (m *StateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StateRequest.Marshal(b, m, deterministic)
+}
+func (m *StateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StateRequest.Merge(m, src)
+}
+func (m *StateRequest) XXX_Size() int {
+	return xxx_messageInfo_StateRequest.Size(m)
+}
+func (m *StateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StateRequest proto.InternalMessageInfo
+
+type StateResponse struct {
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// reason is why the satellite is in maintenance mode.
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	// retry_after_seconds is how long the clients should wait before retrying
+	// the rejected requests.
+	RetryAfterSeconds    int64    `protobuf:"varint,3,opt,name=retry_after_seconds,json=retryAfterSeconds,proto3" json:"retry_after_seconds,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StateResponse) Reset()         { *m = StateResponse{} }
+func (m *StateResponse) String() string { return proto.CompactTextString(m) }
+func (*StateResponse) ProtoMessage()    {}
+func (*StateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6053ae89a3b3f561, []int{1}
+}
+func (m *StateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StateResponse.Unmarshal(m, b)
+}
+func (m *StateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StateResponse.Marshal(b, m, deterministic)
+}
+func (m *StateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StateResponse.Merge(m, src)
+}
+func (m *StateResponse) XXX_Size() int {
+	return xxx_messageInfo_StateResponse.Size(m)
+}
+func (m *StateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StateResponse proto.InternalMessageInfo
+
+func (m *StateResponse) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *StateResponse) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *StateResponse) GetRetryAfterSeconds() int64 {
+	if m != nil {
+		return m.RetryAfterSeconds
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*StateRequest)(nil), "maintenance.StateRequest")
+	proto.RegisterType((*StateResponse)(nil), "maintenance.StateResponse")
+}
+
+func init() { proto.RegisterFile("maintenance.proto", fileDescriptor_6053ae89a3b3f561) }
+
+var fileDescriptor_6053ae89a3b3f561 = []byte{
+	// 205 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0x31, 0x4b, 0xc5, 0x30,
+	0x10, 0x80, 0x89, 0x0f, 0x9f, 0x7a, 0x55, 0xa1, 0x11, 0x24, 0x76, 0x2a, 0x45, 0xa4, 0x53, 0x0a,
+	0xba, 0x0b, 0xba, 0x77, 0x49, 0x37, 0x97, 0x92, 0xb6, 0x27, 0x54, 0x34, 0x49, 0x93, 0x53, 0xf0,
+	0xdf, 0x8b, 0x69, 0xc5, 0x0c, 0x6f, 0xbb, 0xef, 0x3e, 0x0e, 0x3e, 0x0e, 0xf2, 0x0f, 0x3d, 0x1b,
+	0x42, 0xa3, 0xcd, 0x88, 0xd2, 0x79, 0x4b, 0x96, 0x67, 0xc9, 0xaa, 0xba, 0x84, 0xf3, 0x8e, 0x34,
+	0xa1, 0xc2, 0xe5, 0x13, 0x03, 0x55, 0x0b, 0x5c, 0x6c, 0x1c, 0x9c, 0x35, 0x01, 0xb9, 0x80, 0x13,
+	0x34, 0x7a, 0x78, 0xc7, 0x49, 0xb0, 0x92, 0xd5, 0xa7, 0xea, 0x0f, 0xf9, 0x35, 0xec, 0x3d, 0xea,
+	0x60, 0x8d, 0x38, 0x2a, 0x59, 0x7d, 0xa6, 0x36, 0xe2, 0x12, 0xae, 0x3c, 0x92, 0xff, 0xee, 0xf5,
+	0x2b, 0xa1, 0xef, 0x03, 0x8e, 0xd6, 0x4c, 0x41, 0xec, 0x4a, 0x56, 0xef, 0x54, 0x1e, 0xd5, 0xd3,
+	0xaf, 0xe9, 0x56, 0x71, 0xdf, 0x42, 0xd6, 0xfe, 0x17, 0xf1, 0x47, 0x38, 0x8e, 0x05, 0xfc, 0x46,
+	0xa6, 0xed, 0x69, 0x65, 0x51, 0x1c, 0x52, 0x6b, 0xf0, 0xf3, 0xdd, 0xcb, 0x6d, 0x20, 0xeb, 0xdf,
+	0xe4, 0x6c, 0x9b, 0x38, 0x34, 0xce, 0xcf, 0x5f, 0x9a, 0xb0, 0x49, 0x6e, 0xdc, 0x30, 0xec, 0xe3,
+	0x37, 0x1e, 0x7e, 0x06, 0x00, 0x9c, 0x52, 0x06, 0x7b, 0x22, 0x01, 0x00, 0x00,
+}
@@ -0,0 +1,115 @@
+// Code generated by protoc-gen-go-drpc. DO NOT EDIT.
+// protoc-gen-go-drpc version: v0.0.32
+// source: maintenance.proto
+
+package maintenancepb
+
+import (
+	bytes "bytes"
+	context "context"
+	errors "errors"
+
+	jsonpb "github.com/gogo/protobuf/jsonpb"
+	proto "github.com/gogo/protobuf/proto"
+
+	drpc "storj.io/drpc"
+	drpcerr "storj.io/drpc/drpcerr"
+)
+
+type drpcEncoding_File_maintenance_proto struct{}
+
+func (drpcEncoding_File_maintenance_proto) Marshal(msg drpc.Message) ([]byte, error) {
+	return proto.Marshal(msg.(proto.Message))
+}
+
+func (drpcEncoding_File_maintenance_proto) Unmarshal(buf []byte, msg drpc.Message) error {
+	return proto.Unmarshal(buf, msg.(proto.Message))
+}
+
+func (drpcEncoding_File_maintenance_proto) JSONMarshal(msg drpc.Message) ([]byte, error) {
+	var buf bytes.Buffer
+	err := new(jsonpb.Marshaler).Marshal(&buf, msg.(proto.Message))
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (drpcEncoding_File_maintenance_proto) JSONUnmarshal(buf []byte, msg drpc.Message) error {
+	return jsonpb.Unmarshal(bytes.NewReader(buf), msg.(proto.Message))
+}
+
+type DRPCMaintenanceClient interface {
+	DRPCConn() drpc.Conn
+
+	State(ctx context.Context, in *StateRequest) (*StateResponse, error)
+}
+
+type drpcMaintenanceClient struct {
+	cc drpc.Conn
+}
+
+func NewDRPCMaintenanceClient(cc drpc.Conn) DRPCMaintenanceClient {
+	return &drpcMaintenanceClient{cc}
+}
+
+func (c *drpcMaintenanceClient) DRPCConn() drpc.Conn { return c.cc }
+
+func (c *drpcMaintenanceClient) State(ctx context.Context, in *StateRequest) (*StateResponse, error) {
+	out := new(StateResponse)
+	err := c.cc.Invoke(ctx, "/maintenance.Maintenance/State", drpcEncoding_File_maintenance_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+type DRPCMaintenanceServer interface {
+	State(context.Context, *StateRequest) (*StateResponse, error)
+}
+
+type DRPCMaintenanceUnimplementedServer struct{}
+
+func (s *DRPCMaintenanceUnimplementedServer) State(context.Context, *StateRequest) (*StateResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+type DRPCMaintenanceDescription struct{}
+
+func (DRPCMaintenanceDescription) NumMethods() int { return 1 }
+
+func (DRPCMaintenanceDescription) Method(n int) (string, drpc.Encoding, drpc.Receiver, interface{}, bool) {
+	switch n {
+	case 0:
+		return "/maintenance.Maintenance/State", drpcEncoding_File_maintenance_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCMaintenanceServer).
+					State(
+						ctx,
+						in1.(*StateRequest),
+					)
+			}, DRPCMaintenanceServer.State, true
+	default:
+		return "", nil, nil, nil, false
+	}
+}
+
+func DRPCRegisterMaintenance(mux drpc.Mux, impl DRPCMaintenanceServer) error {
+	return mux.Register(impl, DRPCMaintenanceDescription{})
+}
+
+type DRPCMaintenance_StateStream interface {
+	drpc.Stream
+	SendAndClose(*StateResponse) error
+}
+
+type drpcMaintenance_StateStream struct {
+	drpc.Stream
+}
+
+func (x *drpcMaintenance_StateStream) SendAndClose(m *StateResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_maintenance_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
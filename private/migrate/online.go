@@ -0,0 +1,307 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package migrate
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/private/dbutil/txutil"
+	"storj.io/private/tagsql"
+)
+
+// ErrLongLock is returned when a step would lock a large table.
+var ErrLongLock = errs.Class("long locking migration")
+
+// BatchFunc migrates the rows after cursor, at most limit of them, and returns
+// the cursor of the next batch. It returns done, when there are no rows left.
+// An empty cursor is the start.
+type BatchFunc func(ctx context.Context, log *zap.Logger, db tagsql.DB, tx tagsql.Tx, cursor string, limit int) (next string, done bool, err error)
+
+// Backfill is an action, which migrates the rows of a large table in batches.
+//
+// When run by Migration.Run, every batch is committed in its own transaction
+// together with a checkpoint of the cursor, so an interrupted backfill
+// continues where it stopped.
+type Backfill struct {
+	BatchSize int
+	Batch     BatchFunc
+}
+
+// Run runs all batches in the transaction.
+func (backfill *Backfill) Run(ctx context.Context, log *zap.Logger, db tagsql.DB, tx tagsql.Tx) error {
+	cursor := ""
+	for {
+		next, done, err := backfill.Batch(ctx, log, db, tx, cursor, backfill.batchSize())
+		if err != nil || done {
+			return err
+		}
+		cursor = next
+	}
+}
+
+func (backfill *Backfill) batchSize() int {
+	if backfill.BatchSize <= 0 {
+		return 1000
+	}
+	return backfill.BatchSize
+}
+
+// LockGuard refuses steps, which lock tables with more rows than allowed.
+type LockGuard struct {
+	// MaxRows is the largest estimated number of rows of a locked table.
+	MaxRows int64
+	// EstimateRows returns the estimated number of rows of the table.
+	EstimateRows func(ctx context.Context, db tagsql.DB, table string) (int64, error)
+}
+
+// check returns an error, when the step locks a table with too many rows.
+func (guard *LockGuard) check(ctx context.Context, db tagsql.DB, step *Step) error {
+	if guard == nil {
+		return nil
+	}
+	for _, table := range step.Locks {
+		rows, err := guard.EstimateRows(ctx, db, table)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		if rows > guard.MaxRows {
+			return ErrLongLock.New("step %d locks table %q with about %d rows (max %d)", step.Version, table, rows, guard.MaxRows)
+		}
+	}
+	return nil
+}
+
+// PlannedStep is a step, which hasn't been applied to the database yet.
+type PlannedStep struct {
+	Version     int
+	Description string
+	// Statements are the statements of a SQL action, other actions don't
+	// have any.
+	Statements []string
+	// Backfill is whether the step migrates rows in batches.
+	Backfill bool
+	// Checkpoint is the cursor of an interrupted backfill.
+	Checkpoint string
+	// Locks are the tables locked by the step.
+	Locks []LockedTable
+}
+
+// LockedTable is a table locked by a planned step.
+type LockedTable struct {
+	Table string
+	// EstimatedRows is the estimated number of rows. It's -1 when the
+	// migration doesn't have a guard.
+	EstimatedRows int64
+	// Refused is whether the guard refuses to lock the table.
+	Refused bool
+}
+
+// Plan returns the steps, which Run would apply, without changing the
+// database.
+func (migration *Migration) Plan(ctx context.Context, log *zap.Logger) (_ []PlannedStep, err error) {
+	if err := migration.ValidateSteps(); err != nil {
+		return nil, err
+	}
+
+	versions := map[tagsql.DB]int{}
+	var planned []PlannedStep
+	for _, step := range migration.Steps {
+		if step.DB == nil || *step.DB == nil {
+			// the database of the step is created by an earlier step.
+			planned = append(planned, planStep(step))
+			continue
+		}
+		db := *step.DB
+
+		version, ok := versions[db]
+		if !ok {
+			version, err = migration.peekVersion(ctx, db)
+			if err != nil {
+				return nil, Error.Wrap(err)
+			}
+			versions[db] = version
+		}
+		if step.Version <= version {
+			continue
+		}
+
+		plan := planStep(step)
+		if plan.Backfill {
+			plan.Checkpoint, err = migration.peekCheckpoint(ctx, db, step.Version)
+			if err != nil {
+				return nil, Error.Wrap(err)
+			}
+		}
+		for i := range plan.Locks {
+			if migration.Guard == nil {
+				continue
+			}
+			rows, err := migration.Guard.EstimateRows(ctx, db, plan.Locks[i].Table)
+			if err != nil {
+				return nil, Error.Wrap(err)
+			}
+			plan.Locks[i].EstimatedRows = rows
+			plan.Locks[i].Refused = rows > migration.Guard.MaxRows
+		}
+		planned = append(planned, plan)
+	}
+	return planned, nil
+}
+
+// planStep describes the step without looking at the database.
+func planStep(step *Step) PlannedStep {
+	plan := PlannedStep{
+		Version:     step.Version,
+		Description: step.Description,
+	}
+	switch action := step.Action.(type) {
+	case SQL:
+		plan.Statements = append(plan.Statements, action...)
+	case *Backfill:
+		plan.Backfill = true
+	}
+	for _, table := range step.Locks {
+		plan.Locks = append(plan.Locks, LockedTable{Table: table, EstimatedRows: -1})
+	}
+	return plan
+}
+
+// checkpointTable is the table of the backfill checkpoints.
+func (migration *Migration) checkpointTable() string {
+	return migration.Table + "_checkpoints"
+}
+
+// ensureCheckpointTable creates the checkpoint table if not exists.
+func (migration *Migration) ensureCheckpointTable(ctx context.Context, db tagsql.DB, tx tagsql.Tx) error {
+	_, err := tx.Exec(ctx, rebind(db, `CREATE TABLE IF NOT EXISTS `+migration.checkpointTable()+` (version int, checkpoint text)`))
+	return err
+}
+
+// peekVersion returns the latest version like getLatestVersion, but
+// rolls back the creation of the version table.
+func (migration *Migration) peekVersion(ctx context.Context, db tagsql.DB) (_ int, err error) {
+	if err := migration.ValidTableName(); err != nil {
+		return 0, err
+	}
+
+	var version sql.NullInt64
+	err = rollbackTx(ctx, db, func(tx tagsql.Tx) error {
+		_, err := tx.Exec(ctx, rebind(db, `CREATE TABLE IF NOT EXISTS `+migration.Table+` (version int, commited_at text)`)) //nolint:misspell
+		if err != nil {
+			return err
+		}
+		/* #nosec G202 */ // Table name is white listed by the ValidTableName method
+		return tx.QueryRow(ctx, rebind(db, `SELECT MAX(version) FROM `+migration.Table)).Scan(&version)
+	})
+	if errors.Is(err, sql.ErrNoRows) || (err == nil && !version.Valid) {
+		return -1, nil
+	}
+	return int(version.Int64), err
+}
+
+// peekCheckpoint returns the checkpoint of the backfill without creating
+// the checkpoint table.
+func (migration *Migration) peekCheckpoint(ctx context.Context, db tagsql.DB, version int) (cursor string, err error) {
+	err = rollbackTx(ctx, db, func(tx tagsql.Tx) error {
+		if err := migration.ensureCheckpointTable(ctx, db, tx); err != nil {
+			return err
+		}
+		cursor, err = migration.getCheckpoint(ctx, db, tx, version)
+		return err
+	})
+	return cursor, err
+}
+
+// getCheckpoint returns the cursor of an interrupted backfill or an empty cursor.
+func (migration *Migration) getCheckpoint(ctx context.Context, db tagsql.DB, tx tagsql.Tx, version int) (cursor string, err error) {
+	/* #nosec G202 */ // Table name is white listed by the ValidTableName method
+	err = tx.QueryRow(ctx, rebind(db, `SELECT checkpoint FROM `+migration.checkpointTable()+` WHERE version = ?`), version).Scan(&cursor)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", nil
+	}
+	return cursor, err
+}
+
+// setCheckpoint stores the cursor of the next batch of the backfill.
+func (migration *Migration) setCheckpoint(ctx context.Context, db tagsql.DB, tx tagsql.Tx, version int, cursor string) error {
+	/* #nosec G202 */ // Table name is white listed by the ValidTableName method
+	_, err := tx.Exec(ctx, rebind(db, `DELETE FROM `+migration.checkpointTable()+` WHERE version = ?`), version)
+	if err != nil {
+		return err
+	}
+	/* #nosec G202 */ // Table name is white listed by the ValidTableName method
+	_, err = tx.Exec(ctx, rebind(db, `INSERT INTO `+migration.checkpointTable()+` (version, checkpoint) VALUES (?, ?)`), version, cursor)
+	return err
+}
+
+// runBackfill runs the batches of the backfill starting from the last
+// checkpoint and adds the version after the last batch.
+func (migration *Migration) runBackfill(ctx context.Context, log *zap.Logger, db tagsql.DB, version int, backfill *Backfill) error {
+	if err := migration.ValidTableName(); err != nil {
+		return err
+	}
+
+	var cursor string
+	err := txutil.WithTx(ctx, db, nil, func(ctx context.Context, tx tagsql.Tx) (err error) {
+		if err := migration.ensureCheckpointTable(ctx, db, tx); err != nil {
+			return err
+		}
+		cursor, err = migration.getCheckpoint(ctx, db, tx, version)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	if cursor != "" {
+		log.Info("continuing backfill", zap.String("cursor", cursor))
+	}
+
+	batches := 0
+	for {
+		var next string
+		var done bool
+		err := txutil.WithTx(ctx, db, nil, func(ctx context.Context, tx tagsql.Tx) (err error) {
+			next, done, err = backfill.Batch(ctx, log, db, tx, cursor, backfill.batchSize())
+			if err != nil {
+				return err
+			}
+			if !done {
+				return migration.setCheckpoint(ctx, db, tx, version, next)
+			}
+
+			/* #nosec G202 */ // Table name is white listed by the ValidTableName method
+			_, err = tx.Exec(ctx, rebind(db, `DELETE FROM `+migration.checkpointTable()+` WHERE version = ?`), version)
+			if err != nil {
+				return err
+			}
+			return migration.addVersion(ctx, tx, db, version)
+		})
+		if err != nil {
+			return err
+		}
+
+		batches++
+		if done {
+			log.Info("backfill finished", zap.Int("batches", batches))
+			return nil
+		}
+		cursor = next
+		log.Debug("backfill progress", zap.Int("batches", batches), zap.String("cursor", cursor))
+	}
+}
+
+// rollbackTx runs fn in a transaction, which is always rolled back.
+func rollbackTx(ctx context.Context, db tagsql.DB, fn func(tx tagsql.Tx) error) (err error) {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { err = errs.Combine(err, tx.Rollback()) }()
+	return fn(tx)
+}
@@ -0,0 +1,156 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package migrate_test
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/testcontext"
+	"storj.io/private/tagsql"
+	"storj.io/storj/private/migrate"
+)
+
+func TestPlanAndBackfillSqlite(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	db, err := tagsql.Open(ctx, "sqlite3", ctx.File("online.db"))
+	require.NoError(t, err)
+	defer func() { assert.NoError(t, db.Close()) }()
+
+	var testDB tagsql.DB = &sqliteDB{DB: db}
+
+	m := migrate.Migration{
+		Table: "versions",
+		Steps: []*migrate.Step{
+			{
+				DB:          &testDB,
+				Description: "Initialize Table",
+				Version:     1,
+				Action: migrate.SQL{
+					`CREATE TABLE users (id int, name text)`,
+					`INSERT INTO users (id) VALUES (1), (2), (3), (4), (5)`,
+				},
+			},
+		},
+	}
+	require.NoError(t, m.Run(ctx, zap.NewNop()))
+
+	failAfter := 1
+	backfill := &migrate.Backfill{
+		BatchSize: 2,
+		Batch: func(ctx context.Context, log *zap.Logger, _ tagsql.DB, tx tagsql.Tx, cursor string, limit int) (string, bool, error) {
+			if failAfter == 0 {
+				return "", false, errors.New("interrupted")
+			}
+			failAfter--
+
+			after := 0
+			if cursor != "" {
+				after, _ = strconv.Atoi(cursor)
+			}
+			rows, err := tx.Query(ctx, `SELECT id FROM users WHERE id > ? ORDER BY id LIMIT ?`, after, limit)
+			if err != nil {
+				return "", false, err
+			}
+			var ids []int
+			for rows.Next() {
+				var id int
+				if err := rows.Scan(&id); err != nil {
+					return "", false, errs.Combine(err, rows.Close())
+				}
+				ids = append(ids, id)
+			}
+			if err := errs.Combine(rows.Err(), rows.Close()); err != nil {
+				return "", false, err
+			}
+			if len(ids) == 0 {
+				return "", true, nil
+			}
+			for _, id := range ids {
+				_, err := tx.Exec(ctx, `UPDATE users SET name = ? WHERE id = ?`, "user"+strconv.Itoa(id), id)
+				if err != nil {
+					return "", false, err
+				}
+			}
+			return strconv.Itoa(ids[len(ids)-1]), false, nil
+		},
+	}
+
+	m.Steps = append(m.Steps,
+		&migrate.Step{
+			DB:          &testDB,
+			Description: "Backfill names",
+			Version:     2,
+			Action:      backfill,
+		},
+		&migrate.Step{
+			DB:          &testDB,
+			Description: "Rewrite users",
+			Version:     3,
+			Action:      migrate.SQL{`CREATE INDEX users_name ON users (name)`},
+			Locks:       []string{"users"},
+		},
+	)
+
+	plan, err := m.Plan(ctx, zaptest.NewLogger(t))
+	require.NoError(t, err)
+	require.Len(t, plan, 2)
+	require.Equal(t, 2, plan[0].Version)
+	require.True(t, plan[0].Backfill)
+	require.Empty(t, plan[0].Checkpoint)
+	require.Equal(t, []string{`CREATE INDEX users_name ON users (name)`}, plan[1].Statements)
+	require.Equal(t, []migrate.LockedTable{{Table: "users", EstimatedRows: -1}}, plan[1].Locks)
+
+	// the backfill is interrupted after the first batch.
+	err = m.Run(ctx, zap.NewNop())
+	require.Error(t, err)
+
+	plan, err = m.Plan(ctx, zaptest.NewLogger(t))
+	require.NoError(t, err)
+	require.Len(t, plan, 2)
+	require.Equal(t, "2", plan[0].Checkpoint)
+
+	// the guard refuses to lock the table.
+	m.Guard = &migrate.LockGuard{
+		MaxRows: 3,
+		EstimateRows: func(ctx context.Context, db tagsql.DB, table string) (rows int64, err error) {
+			err = db.QueryRowContext(ctx, `SELECT COUNT(*) FROM `+table).Scan(&rows)
+			return rows, err
+		},
+	}
+	plan, err = m.Plan(ctx, zaptest.NewLogger(t))
+	require.NoError(t, err)
+	require.Equal(t, []migrate.LockedTable{{Table: "users", EstimatedRows: 5, Refused: true}}, plan[1].Locks)
+
+	failAfter = 10
+	err = m.Run(ctx, zap.NewNop())
+	require.True(t, migrate.ErrLongLock.Has(err), err)
+
+	// the backfill continued from the checkpoint.
+	var missing int
+	require.NoError(t, db.QueryRowContext(ctx, `SELECT COUNT(*) FROM users WHERE name IS NULL`).Scan(&missing))
+	require.Zero(t, missing)
+	// two batches and the last empty one.
+	require.Equal(t, 7, failAfter)
+
+	version, err := m.CurrentVersion(ctx, nil, testDB)
+	require.NoError(t, err)
+	require.Equal(t, 2, version)
+
+	m.Guard.MaxRows = 5
+	require.NoError(t, m.Run(ctx, zap.NewNop()))
+
+	plan, err = m.Plan(ctx, zaptest.NewLogger(t))
+	require.NoError(t, err)
+	require.Empty(t, plan)
+}
@@ -60,6 +60,9 @@ type Migration struct {
 	// concatenated in a query string for avoiding SQL injection attacks.
 	Table string
 	Steps []*Step
+
+	// Guard, when set, refuses steps, which lock large tables.
+	Guard *LockGuard
 }
 
 // Step describes a single step in migration.
@@ -73,6 +76,10 @@ type Step struct {
 	// SeparateTx marks a step as it should not be merged together for optimization.
 	// Cockroach cannot add a column and update the value in the same transaction.
 	SeparateTx bool
+
+	// Locks are the tables, which are locked while the step runs, e.g. by
+	// rewriting the table. Steps, which don't block writers, leave it empty.
+	Locks []string
 }
 
 // Action is something that needs to be done.
@@ -191,6 +198,20 @@ func (migration *Migration) Run(ctx context.Context, log *zap.Logger) error {
 		stepLog := log.Named(strconv.Itoa(step.Version))
 		if !initialSetup {
 			stepLog.Info(step.Description)
+
+			// a new database doesn't have large tables.
+			if err := migration.Guard.check(ctx, db, step); err != nil {
+				return err
+			}
+		}
+
+		if backfill, ok := step.Action.(*Backfill); ok {
+			// backfills commit every batch, so they can't run in a single transaction.
+			err = migration.runBackfill(ctx, stepLog, db, step.Version, backfill)
+			if err != nil {
+				return Error.Wrap(err)
+			}
+			continue
 		}
 
 		err = txutil.WithTx(ctx, db, nil, func(ctx context.Context, tx tagsql.Tx) error {
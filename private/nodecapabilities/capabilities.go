@@ -0,0 +1,123 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package nodecapabilities implements the capabilities, which storage nodes
+// announce with the second version of the check-in protocol.
+package nodecapabilities
+
+import (
+	"sort"
+	"strings"
+
+	"storj.io/storj/private/contactpb"
+)
+
+// Protocols which nodes support for piece transfers.
+const (
+	ProtocolTCP  = "tcp"
+	ProtocolQUIC = "quic"
+)
+
+// Capabilities are the features, which a node supports.
+type Capabilities struct {
+	// Protocols are the transports, which the node accepts connections on.
+	Protocols []string
+	// Hashstore is whether the node stores the pieces in a hashstore.
+	Hashstore bool
+	// TTL is whether the node deletes the pieces once they expire.
+	TTL bool
+	// MaxPieceSize is the largest piece the node accepts, zero means there
+	// is no limit.
+	MaxPieceSize int64
+}
+
+// Requirements are the capabilities, which a node needs to be selected.
+type Requirements struct {
+	Protocols []string
+	Hashstore bool
+	TTL       bool
+	// PieceSize is the size of the pieces, which the node has to accept.
+	PieceSize int64
+}
+
+// IsZero returns whether there are no requirements.
+func (required Requirements) IsZero() bool {
+	return len(required.Protocols) == 0 && !required.Hashstore && !required.TTL && required.PieceSize == 0
+}
+
+// HasProtocol returns whether the node supports the protocol.
+func (caps Capabilities) HasProtocol(protocol string) bool {
+	for _, p := range caps.Protocols {
+		if p == protocol {
+			return true
+		}
+	}
+	return false
+}
+
+// Equal returns whether the capabilities are the same, regardless of the
+// order of the protocols.
+func (caps Capabilities) Equal(other Capabilities) bool {
+	return EncodeProtocols(caps.Protocols) == EncodeProtocols(other.Protocols) &&
+		caps.Hashstore == other.Hashstore &&
+		caps.TTL == other.TTL &&
+		caps.MaxPieceSize == other.MaxPieceSize
+}
+
+// Satisfies returns whether the capabilities meet the requirements.
+func (caps Capabilities) Satisfies(required Requirements) bool {
+	for _, protocol := range required.Protocols {
+		if !caps.HasProtocol(protocol) {
+			return false
+		}
+	}
+	if required.Hashstore && !caps.Hashstore {
+		return false
+	}
+	if required.TTL && !caps.TTL {
+		return false
+	}
+	if caps.MaxPieceSize > 0 && required.PieceSize > caps.MaxPieceSize {
+		return false
+	}
+	return true
+}
+
+// EncodeProtocols returns the protocols as a sorted comma separated list.
+func EncodeProtocols(protocols []string) string {
+	sorted := append([]string(nil), protocols...)
+	sort.Strings(sorted)
+	return strings.Join(sorted, ",")
+}
+
+// DecodeProtocols parses a comma separated list of protocols.
+func DecodeProtocols(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(s, ",")
+}
+
+// FromProto returns the capabilities, which the node sent with the second
+// version of the check-in.
+func FromProto(message *contactpb.Capabilities) Capabilities {
+	if message == nil {
+		return Capabilities{}
+	}
+	return Capabilities{
+		Protocols:    message.Protocols,
+		Hashstore:    message.Hashstore,
+		TTL:          message.Ttl,
+		MaxPieceSize: message.MaxPieceSize,
+	}
+}
+
+// Proto returns the capabilities for the second version of the check-in.
+func (caps Capabilities) Proto() *contactpb.Capabilities {
+	return &contactpb.Capabilities{
+		Protocols:    caps.Protocols,
+		Hashstore:    caps.Hashstore,
+		Ttl:          caps.TTL,
+		MaxPieceSize: caps.MaxPieceSize,
+	}
+}
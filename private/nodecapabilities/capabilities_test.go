@@ -0,0 +1,68 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package nodecapabilities_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/pb"
+	"storj.io/storj/private/contactpb"
+	"storj.io/storj/private/nodecapabilities"
+)
+
+func TestProtoRoundTrip(t *testing.T) {
+	caps := nodecapabilities.Capabilities{
+		Protocols:    []string{nodecapabilities.ProtocolTCP, nodecapabilities.ProtocolQUIC},
+		TTL:          true,
+		MaxPieceSize: 64 << 20,
+	}
+
+	data, err := pb.Marshal(&contactpb.CheckInRequest{
+		CheckIn:      &pb.CheckInRequest{Address: "127.0.0.1:7777"},
+		Capabilities: caps.Proto(),
+	})
+	require.NoError(t, err)
+
+	var decoded contactpb.CheckInRequest
+	require.NoError(t, pb.Unmarshal(data, &decoded))
+	require.Equal(t, "127.0.0.1:7777", decoded.CheckIn.Address)
+	require.Equal(t, caps, nodecapabilities.FromProto(decoded.Capabilities))
+
+	// a check-in without capabilities announces none.
+	require.Equal(t, nodecapabilities.Capabilities{}, nodecapabilities.FromProto(nil))
+}
+
+func TestSatisfies(t *testing.T) {
+	caps := nodecapabilities.Capabilities{
+		Protocols:    []string{nodecapabilities.ProtocolTCP},
+		TTL:          true,
+		MaxPieceSize: 1000,
+	}
+
+	for _, tt := range []struct {
+		required  nodecapabilities.Requirements
+		satisfied bool
+	}{
+		{nodecapabilities.Requirements{}, true},
+		{nodecapabilities.Requirements{Protocols: []string{nodecapabilities.ProtocolTCP}}, true},
+		{nodecapabilities.Requirements{Protocols: []string{nodecapabilities.ProtocolQUIC}}, false},
+		{nodecapabilities.Requirements{TTL: true}, true},
+		{nodecapabilities.Requirements{Hashstore: true}, false},
+		{nodecapabilities.Requirements{PieceSize: 1000}, true},
+		{nodecapabilities.Requirements{PieceSize: 1001}, false},
+	} {
+		require.Equal(t, tt.satisfied, caps.Satisfies(tt.required), "%+v", tt.required)
+	}
+
+	// a node without a limit accepts pieces of any size.
+	require.True(t, nodecapabilities.Capabilities{}.Satisfies(nodecapabilities.Requirements{PieceSize: 1 << 40}))
+}
+
+func TestProtocols(t *testing.T) {
+	require.Equal(t, "quic,tcp", nodecapabilities.EncodeProtocols([]string{"tcp", "quic"}))
+	require.Equal(t, []string{"quic", "tcp"}, nodecapabilities.DecodeProtocols("quic,tcp"))
+	require.Nil(t, nodecapabilities.DecodeProtocols(""))
+}
@@ -0,0 +1,45 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package nodeinventory
+
+import (
+	"fmt"
+
+	"golang.org/x/sys/unix"
+)
+
+// filesystemTypes maps the magic numbers of statfs to the names of common
+// filesystems.
+var filesystemTypes = map[int64]string{
+	0xef53:     "ext4",
+	0x58465342: "xfs",
+	0x9123683e: "btrfs",
+	0x2fc12fc1: "zfs",
+	0x6969:     "nfs",
+	0xff534d42: "cifs",
+	0xfe534d42: "smb2",
+	0x5346544e: "ntfs",
+	0x4d44:     "vfat",
+	0x2011bab0: "exfat",
+	0x65735546: "fuse",
+	0x794c7630: "overlayfs",
+	0x01021994: "tmpfs",
+	0xf2f52010: "f2fs",
+}
+
+// filesystem returns the type of the filesystem, which contains the
+// directory.
+func filesystem(dir string) string {
+	if dir == "" {
+		return ""
+	}
+	var stat unix.Statfs_t
+	if err := unix.Statfs(dir, &stat); err != nil {
+		return ""
+	}
+	if name, ok := filesystemTypes[int64(stat.Type)]; ok {
+		return name
+	}
+	return fmt.Sprintf("0x%x", stat.Type)
+}
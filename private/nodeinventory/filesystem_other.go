@@ -0,0 +1,13 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+//go:build !linux
+// +build !linux
+
+package nodeinventory
+
+// filesystem returns the type of the filesystem, which contains the
+// directory. It's only detected on Linux.
+func filesystem(dir string) string {
+	return ""
+}
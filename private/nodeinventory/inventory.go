@@ -0,0 +1,140 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package nodeinventory implements the inventory of the software
+// environment, which storage nodes report with their check-ins, so the
+// satellite can summarize the composition of the fleet.
+//
+// The inventory is sent as metadata of the check-in request, which
+// satellites without support for it ignore.
+package nodeinventory
+
+import (
+	"context"
+	"os"
+	"runtime"
+	"strings"
+
+	"storj.io/drpc/drpcmetadata"
+)
+
+// Metadata keys of the inventory.
+const (
+	MetadataOS             = "node-os"
+	MetadataArch           = "node-arch"
+	MetadataRuntime        = "node-runtime"
+	MetadataStorageBackend = "node-storage-backend"
+	MetadataFilesystem     = "node-filesystem"
+)
+
+// Runtimes of nodes.
+const (
+	RuntimeContainer = "container"
+	RuntimeBareMetal = "bare-metal"
+)
+
+// StorageBackendFilestore is the storage backend, which stores every piece
+// in its own file.
+const StorageBackendFilestore = "filestore"
+
+// maxValueLength is the maximum length of a reported value, so nodes can't
+// fill the database with arbitrary data.
+const maxValueLength = 64
+
+// Inventory describes the software environment of a node.
+type Inventory struct {
+	OS             string `json:"os"`
+	Arch           string `json:"arch"`
+	Runtime        string `json:"runtime"`
+	StorageBackend string `json:"storageBackend"`
+	Filesystem     string `json:"filesystem"`
+}
+
+// IsZero returns whether nothing is known about the node.
+func (inventory Inventory) IsZero() bool {
+	return inventory == Inventory{}
+}
+
+// Detect returns the inventory of the running node, which stores its pieces
+// with the storage backend in the directory.
+func Detect(storageBackend, dir string) Inventory {
+	inventory := Inventory{
+		OS:             runtime.GOOS,
+		Arch:           runtime.GOARCH,
+		Runtime:        RuntimeBareMetal,
+		StorageBackend: storageBackend,
+		Filesystem:     filesystem(dir),
+	}
+	if inContainer() {
+		inventory.Runtime = RuntimeContainer
+	}
+	return inventory
+}
+
+// inContainer returns whether the process runs in a container.
+func inContainer() bool {
+	if _, err := os.Stat("/.dockerenv"); err == nil {
+		return true
+	}
+	if _, err := os.Stat("/run/.containerenv"); err == nil {
+		return true
+	}
+	cgroup, err := os.ReadFile("/proc/1/cgroup")
+	if err != nil {
+		return false
+	}
+	for _, marker := range []string{"docker", "kubepods", "containerd", "libpod", "lxc"} {
+		if strings.Contains(string(cgroup), marker) {
+			return true
+		}
+	}
+	return false
+}
+
+// Attach adds the inventory to the metadata of the requests made with the
+// context.
+func Attach(ctx context.Context, inventory Inventory) context.Context {
+	if inventory.IsZero() {
+		return ctx
+	}
+	return drpcmetadata.AddPairs(ctx, map[string]string{
+		MetadataOS:             inventory.OS,
+		MetadataArch:           inventory.Arch,
+		MetadataRuntime:        inventory.Runtime,
+		MetadataStorageBackend: inventory.StorageBackend,
+		MetadataFilesystem:     inventory.Filesystem,
+	})
+}
+
+// FromContext returns the inventory, which the node sent with the request.
+// ok is false, when the node didn't send it. Values are truncated, so they
+// can be stored safely.
+func FromContext(ctx context.Context) (inventory Inventory, ok bool) {
+	metadata, ok := drpcmetadata.Get(ctx)
+	if !ok {
+		return Inventory{}, false
+	}
+	inventory = Inventory{
+		OS:             sanitize(metadata[MetadataOS]),
+		Arch:           sanitize(metadata[MetadataArch]),
+		Runtime:        sanitize(metadata[MetadataRuntime]),
+		StorageBackend: sanitize(metadata[MetadataStorageBackend]),
+		Filesystem:     sanitize(metadata[MetadataFilesystem]),
+	}
+	return inventory, !inventory.IsZero()
+}
+
+// sanitize truncates the value and removes everything except printable
+// ASCII characters.
+func sanitize(value string) string {
+	value = strings.Map(func(r rune) rune {
+		if r < 0x20 || r > 0x7e {
+			return -1
+		}
+		return r
+	}, value)
+	if len(value) > maxValueLength {
+		value = value[:maxValueLength]
+	}
+	return value
+}
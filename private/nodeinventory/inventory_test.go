@@ -0,0 +1,58 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package nodeinventory_test
+
+import (
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/private/nodeinventory"
+)
+
+func TestDetect(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	inventory := nodeinventory.Detect(nodeinventory.StorageBackendFilestore, ctx.Dir())
+	require.Equal(t, runtime.GOOS, inventory.OS)
+	require.Equal(t, runtime.GOARCH, inventory.Arch)
+	require.Contains(t, []string{nodeinventory.RuntimeContainer, nodeinventory.RuntimeBareMetal}, inventory.Runtime)
+	require.Equal(t, nodeinventory.StorageBackendFilestore, inventory.StorageBackend)
+	if runtime.GOOS == "linux" {
+		require.NotEmpty(t, inventory.Filesystem)
+	}
+}
+
+func TestAttach(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	_, ok := nodeinventory.FromContext(ctx)
+	require.False(t, ok)
+
+	_, ok = nodeinventory.FromContext(nodeinventory.Attach(ctx, nodeinventory.Inventory{}))
+	require.False(t, ok)
+
+	inventory := nodeinventory.Inventory{
+		OS:             "linux",
+		Arch:           "arm64",
+		Runtime:        nodeinventory.RuntimeContainer,
+		StorageBackend: nodeinventory.StorageBackendFilestore,
+		Filesystem:     "ext4",
+	}
+	received, ok := nodeinventory.FromContext(nodeinventory.Attach(ctx, inventory))
+	require.True(t, ok)
+	require.Equal(t, inventory, received)
+
+	// values are sanitized.
+	received, ok = nodeinventory.FromContext(nodeinventory.Attach(ctx, nodeinventory.Inventory{
+		OS:         "linux\n\x00",
+		Filesystem: strings.Repeat("x", 100),
+	}))
+	require.True(t, ok)
+	require.Equal(t, "linux", received.OS)
+	require.Len(t, received.Filesystem, 64)
+}
@@ -4,6 +4,7 @@
 package nodeoperator_test
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -76,3 +77,53 @@ func TestWalletFeaturesValidation(t *testing.T) {
 		require.Error(t, err)
 	})
 }
+
+func TestWalletValidation(t *testing.T) {
+	checksummed := []string{
+		"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+		"0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359",
+		"0xdbF03B407c01E7cD3CBea99509d93f8DDDC8C6FB",
+		"0xD1220A0cf47c7B9Be7A2E6BA89F429762e7b9aDb",
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		for _, checksum := range []bool{false, true} {
+			validation := nodeoperator.WalletValidation{Checksum: checksum}
+			for _, wallet := range append([]string{
+				"0x" + strings.Repeat("00", 20),
+				"0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed",
+				"0x5AAEB6053F3E94C9B9A09F33669435E7EF1BEAED",
+			}, checksummed...) {
+				require.NoError(t, validation.Validate(wallet), wallet)
+			}
+		}
+	})
+
+	t.Run("invalid format", func(t *testing.T) {
+		validation := nodeoperator.WalletValidation{}
+		for _, wallet := range []string{
+			"",
+			"5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+			"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAe",
+			"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed00",
+			"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAeg",
+			"0X5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+		} {
+			err := validation.Validate(wallet)
+			require.Error(t, err, wallet)
+			require.True(t, nodeoperator.WalletValidationError.Has(err))
+		}
+	})
+
+	t.Run("invalid checksum", func(t *testing.T) {
+		wallet := "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAeD"
+
+		validation := nodeoperator.WalletValidation{}
+		require.NoError(t, validation.Validate(wallet))
+
+		validation.Checksum = true
+		err := validation.Validate(wallet)
+		require.Error(t, err)
+		require.True(t, nodeoperator.WalletValidationError.Has(err))
+	})
+}
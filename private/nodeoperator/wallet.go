@@ -0,0 +1,68 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package nodeoperator
+
+import (
+	"encoding/hex"
+	"strings"
+
+	"github.com/zeebo/errs"
+	"golang.org/x/crypto/sha3"
+)
+
+// WalletValidationError wallet address validation errors class.
+var WalletValidationError = errs.Class("wallet validation")
+
+// WalletValidation contains config for wallet address validation.
+type WalletValidation struct {
+	// Checksum requires mixed-case addresses to have a valid EIP-55
+	// checksum. All lower-case or all upper-case addresses don't carry a
+	// checksum, so they're accepted either way.
+	Checksum bool
+}
+
+// Validate validates the wallet address, which must be a 0x-prefixed
+// Ethereum address of 20 hex-encoded bytes.
+func (validation *WalletValidation) Validate(wallet string) error {
+	if wallet == "" {
+		return WalletValidationError.New("wallet address is empty")
+	}
+	if !strings.HasPrefix(wallet, "0x") {
+		return WalletValidationError.New("wallet address %q doesn't start with 0x", wallet)
+	}
+
+	address := wallet[2:]
+	if len(address) != 40 {
+		return WalletValidationError.New("wallet address %q has %d hex digits instead of 40", wallet, len(address))
+	}
+	if _, err := hex.DecodeString(address); err != nil {
+		return WalletValidationError.New("wallet address %q isn't hex encoded", wallet)
+	}
+
+	if validation.Checksum && !(address == strings.ToLower(address) || address == strings.ToUpper(address)) {
+		if address != checksumAddress(address) {
+			return WalletValidationError.New("wallet address %q has an invalid checksum", wallet)
+		}
+	}
+
+	return nil
+}
+
+// checksumAddress returns the EIP-55 mixed-case encoding of the hex encoded
+// address without the 0x prefix.
+func checksumAddress(address string) string {
+	lower := strings.ToLower(address)
+
+	hash := sha3.NewLegacyKeccak256()
+	_, _ = hash.Write([]byte(lower))
+	digest := hex.EncodeToString(hash.Sum(nil))
+
+	checksummed := []byte(lower)
+	for i, c := range checksummed {
+		if c >= 'a' && c <= 'f' && digest[i] >= '8' {
+			checksummed[i] = c - 'a' + 'A'
+		}
+	}
+	return string(checksummed)
+}
@@ -0,0 +1,334 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package otlp exports the monkit traces to an OpenTelemetry collector using
+// the OTLP/HTTP exporter of OpenTelemetry.
+package otlp
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"math/rand"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.12.0"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/common/rpc/rpctracing"
+)
+
+var (
+	// Error is the error class for the otlp exporter.
+	Error = errs.Class("otlp")
+	mon   = monkit.Package()
+)
+
+// Config configures the export of traces.
+type Config struct {
+	Endpoint      string        `help:"base url of the OTLP/HTTP collector the traces are exported to (e.g. http://localhost:4318), disabled when empty" default:""`
+	Headers       string        `help:"comma separated list of key=value headers sent to the collector" default:""`
+	SampleRate    float64       `help:"fraction of the traces started by this process, which are exported" default:"0.01"`
+	BatchSize     int           `help:"maximum number of spans sent to the collector at once" default:"512"`
+	QueueSize     int           `help:"number of spans buffered for the collector, before new spans are dropped" default:"10000"`
+	FlushInterval time.Duration `help:"how often the buffered spans are sent to the collector" default:"5s"`
+}
+
+// Exporter sends the spans of the sampled monkit traces to the collector.
+//
+// Whether a trace is sampled is decided once per trace and shared with the
+// other trace observers through rpctracing, so traces started by remote
+// requests follow the decision of the caller. The finished monkit spans are
+// recorded as OpenTelemetry spans with the same ids, which are batched and
+// sent by the OpenTelemetry SDK.
+type Exporter struct {
+	registry *monkit.Registry
+	config   Config
+
+	provider *sdktrace.TracerProvider
+	tracer   trace.Tracer
+}
+
+// NewExporter creates a new exporter for the traces of registry, identifying
+// the process as serviceName.
+func NewExporter(log *zap.Logger, registry *monkit.Registry, config Config, serviceName string) (*Exporter, error) {
+	exporter := &Exporter{
+		registry: registry,
+		config:   config,
+	}
+	if !exporter.Enabled() {
+		return exporter, nil
+	}
+
+	options, err := clientOptions(config)
+	if err != nil {
+		return nil, err
+	}
+	client, err := otlptracehttp.New(context.Background(), options...)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	// the defaults of the SDK are used for the unset options.
+	var batchOptions []sdktrace.BatchSpanProcessorOption
+	if config.BatchSize > 0 {
+		batchOptions = append(batchOptions, sdktrace.WithMaxExportBatchSize(config.BatchSize))
+	}
+	if config.QueueSize > 0 {
+		batchOptions = append(batchOptions, sdktrace.WithMaxQueueSize(config.QueueSize))
+	}
+	if config.FlushInterval > 0 {
+		batchOptions = append(batchOptions, sdktrace.WithBatchTimeout(config.FlushInterval))
+	}
+
+	exporter.provider = sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(&monitoredExporter{log: log, SpanExporter: client}, batchOptions...),
+		// the traces are sampled before their spans are recorded.
+		sdktrace.WithSampler(sdktrace.AlwaysSample()),
+		sdktrace.WithIDGenerator(monkitIDs{}),
+		sdktrace.WithResource(resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceNameKey.String(serviceName))),
+	)
+	exporter.tracer = exporter.provider.Tracer("monkit")
+
+	return exporter, nil
+}
+
+// clientOptions returns the options of the OTLP/HTTP client for the config.
+func clientOptions(config Config) ([]otlptracehttp.Option, error) {
+	endpoint, err := url.Parse(config.Endpoint)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	if endpoint.Host == "" {
+		return nil, Error.New("invalid endpoint %q", config.Endpoint)
+	}
+
+	options := []otlptracehttp.Option{
+		otlptracehttp.WithEndpoint(endpoint.Host),
+		otlptracehttp.WithURLPath(strings.TrimSuffix(endpoint.Path, "/") + "/v1/traces"),
+	}
+	switch endpoint.Scheme {
+	case "http":
+		options = append(options, otlptracehttp.WithInsecure())
+	case "https":
+	default:
+		return nil, Error.New("invalid endpoint scheme %q", endpoint.Scheme)
+	}
+
+	headers := map[string]string{}
+	for _, header := range strings.Split(config.Headers, ",") {
+		header = strings.TrimSpace(header)
+		if header == "" {
+			continue
+		}
+		key, value, ok := strings.Cut(header, "=")
+		if !ok {
+			return nil, Error.New("invalid header %q", header)
+		}
+		headers[strings.TrimSpace(key)] = strings.TrimSpace(value)
+	}
+	if len(headers) > 0 {
+		options = append(options, otlptracehttp.WithHeaders(headers))
+	}
+
+	return options, nil
+}
+
+// Enabled returns whether a collector has been configured.
+func (exporter *Exporter) Enabled() bool {
+	return exporter.config.Endpoint != ""
+}
+
+// Run observes the traces and sends their spans to the collector until ctx
+// is canceled.
+func (exporter *Exporter) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if !exporter.Enabled() {
+		return nil
+	}
+
+	unregister := exporter.register()
+	<-ctx.Done()
+	unregister()
+
+	// use a new context, so the remaining spans can still be sent.
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	return Error.Wrap(exporter.provider.Shutdown(shutdownCtx))
+}
+
+// register starts observing the traces of the registry.
+func (exporter *Exporter) register() (unregister func()) {
+	var mu sync.Mutex
+	return exporter.registry.ObserveTraces(func(t *monkit.Trace) {
+		sampled, exists := t.Get(rpctracing.Sampled).(bool)
+		if !exists {
+			sampled = rand.Float64() < exporter.config.SampleRate
+			t.Set(rpctracing.Sampled, sampled)
+		}
+		if !sampled {
+			return
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		if t.Get(observedKey{}) != nil {
+			return
+		}
+		t.Set(observedKey{}, struct{}{})
+
+		t.ObserveSpans(spanObserverFunc(exporter.observe))
+	})
+}
+
+type observedKey struct{}
+
+type spanObserverFunc func(s *monkit.Span, err error, panicked bool, finish time.Time)
+
+func (f spanObserverFunc) Start(*monkit.Span) {}
+func (f spanObserverFunc) Finish(s *monkit.Span, err error, panicked bool, finish time.Time) {
+	f(s, err, panicked, finish)
+}
+
+// observe records the finished monkit span as an OpenTelemetry span.
+func (exporter *Exporter) observe(s *monkit.Span, spanErr error, panicked bool, finish time.Time) {
+	if rpctracing.IsExcluded(s) {
+		return
+	}
+
+	convertedTraceID := traceID(s.Trace().Id())
+	ctx := withSpanID(context.Background(), spanID(s.Id()))
+
+	kind := trace.SpanKindServer
+	parentID, hasParent := s.ParentId()
+	if hasParent {
+		kind = trace.SpanKindInternal
+		ctx = trace.ContextWithRemoteSpanContext(ctx, trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID: convertedTraceID,
+			SpanID:  spanID(parentID),
+		}))
+	} else {
+		ctx = withTraceID(ctx, convertedTraceID)
+	}
+
+	_, converted := exporter.tracer.Start(ctx, s.Func().FullName(),
+		trace.WithTimestamp(s.Start()),
+		trace.WithSpanKind(kind),
+		trace.WithAttributes(spanAttributes(s, spanErr, hasParent)...),
+	)
+
+	// only known error kinds are included, since error messages may contain
+	// private user information.
+	switch {
+	case panicked:
+		converted.SetStatus(codes.Error, "panicked")
+	case errors.Is(spanErr, context.Canceled):
+		converted.SetStatus(codes.Error, "canceled")
+	case spanErr != nil:
+		converted.SetStatus(codes.Error, "errored")
+	}
+
+	converted.End(trace.WithTimestamp(finish))
+}
+
+// spanAttributes returns the attributes of the monkit span.
+func spanAttributes(s *monkit.Span, spanErr error, hasParent bool) (attributes []attribute.KeyValue) {
+	if args := s.Args(); len(args) > 0 {
+		attributes = append(attributes, attribute.String("args", strings.Join(args, " ")))
+	}
+	for _, annotation := range s.Annotations() {
+		attributes = append(attributes, attribute.String(annotation.Name, annotation.Value))
+	}
+
+	// only attach trace metadata to the root span.
+	if !hasParent {
+		for k, v := range s.Trace().GetAll() {
+			key, ok := k.(string)
+			if !ok || key == rpctracing.ParentID || key == rpctracing.Sampled || key == rpctracing.TraceID {
+				continue
+			}
+			if value, ok := v.(string); ok {
+				attributes = append(attributes, attribute.String(key, value))
+			}
+		}
+	}
+
+	if spanErr != nil && !errors.Is(spanErr, context.Canceled) {
+		if code := rpcstatus.Code(spanErr); code != rpcstatus.Unknown {
+			attributes = append(attributes, attribute.String("rpc.status_code", strconv.FormatUint(uint64(code), 10)))
+		}
+	}
+
+	return attributes
+}
+
+// monitoredExporter counts the exported spans. Failures are only logged,
+// since the traces are best effort.
+type monitoredExporter struct {
+	log *zap.Logger
+	sdktrace.SpanExporter
+}
+
+// ExportSpans sends the spans to the collector.
+func (exporter *monitoredExporter) ExportSpans(ctx context.Context, spans []sdktrace.ReadOnlySpan) error {
+	if err := exporter.SpanExporter.ExportSpans(ctx, spans); err != nil {
+		mon.Counter("otlp_spans_failed").Inc(int64(len(spans)))
+		exporter.log.Debug("failed to export spans", zap.Int("count", len(spans)), zap.Error(err))
+		return err
+	}
+	mon.Counter("otlp_spans_exported").Inc(int64(len(spans)))
+	return nil
+}
+
+// monkitIDs makes the OpenTelemetry spans use the ids of the monkit spans.
+type monkitIDs struct{}
+
+type traceIDKey struct{}
+type spanIDKey struct{}
+
+func withTraceID(ctx context.Context, id trace.TraceID) context.Context {
+	return context.WithValue(ctx, traceIDKey{}, id)
+}
+
+func withSpanID(ctx context.Context, id trace.SpanID) context.Context {
+	return context.WithValue(ctx, spanIDKey{}, id)
+}
+
+// NewIDs returns the ids of a root span.
+func (monkitIDs) NewIDs(ctx context.Context) (trace.TraceID, trace.SpanID) {
+	traceID, _ := ctx.Value(traceIDKey{}).(trace.TraceID)
+	return traceID, monkitIDs{}.NewSpanID(ctx, traceID)
+}
+
+// NewSpanID returns the id of a child span.
+func (monkitIDs) NewSpanID(ctx context.Context, traceID trace.TraceID) trace.SpanID {
+	spanID, _ := ctx.Value(spanIDKey{}).(trace.SpanID)
+	return spanID
+}
+
+// traceID converts the monkit trace id. Monkit uses 64 bit trace ids, which
+// are used as the lower half of the 128 bit OpenTelemetry trace id.
+func traceID(id int64) (converted trace.TraceID) {
+	binary.BigEndian.PutUint64(converted[8:], uint64(id))
+	return converted
+}
+
+func spanID(id int64) (converted trace.SpanID) {
+	binary.BigEndian.PutUint64(converted[:], uint64(id))
+	return converted
+}
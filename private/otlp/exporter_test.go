@@ -0,0 +1,194 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package otlp
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/stretchr/testify/require"
+	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+	"go.uber.org/zap/zaptest"
+	"google.golang.org/protobuf/proto"
+
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/common/rpc/rpctracing"
+	"storj.io/common/testcontext"
+)
+
+type collector struct {
+	mu       sync.Mutex
+	headers  []http.Header
+	requests []*coltracepb.ExportTraceServiceRequest
+}
+
+func newCollector(t *testing.T) (*httptest.Server, *collector) {
+	c := &collector{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/v1/traces", r.URL.Path)
+
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+
+		request := &coltracepb.ExportTraceServiceRequest{}
+		require.NoError(t, proto.Unmarshal(body, request))
+
+		c.mu.Lock()
+		c.headers = append(c.headers, r.Header)
+		c.requests = append(c.requests, request)
+		c.mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/x-protobuf")
+	}))
+	t.Cleanup(server.Close)
+	return server, c
+}
+
+func (c *collector) Spans() (spans []*tracepb.Span) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, request := range c.requests {
+		for _, resource := range request.ResourceSpans {
+			for _, scope := range resource.ScopeSpans {
+				spans = append(spans, scope.Spans...)
+			}
+		}
+	}
+	return spans
+}
+
+func attributes(keyValues []*commonpb.KeyValue) map[string]string {
+	values := map[string]string{}
+	for _, keyValue := range keyValues {
+		values[keyValue.Key] = keyValue.Value.GetStringValue()
+	}
+	return values
+}
+
+func TestExporter(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	server, collector := newCollector(t)
+
+	registry := monkit.NewRegistry()
+	exporter, err := NewExporter(zaptest.NewLogger(t), registry, Config{
+		Endpoint:   server.URL,
+		Headers:    "Authorization=token",
+		SampleRate: 1,
+		BatchSize:  2,
+		QueueSize:  10,
+	}, "satellite")
+	require.NoError(t, err)
+	require.True(t, exporter.Enabled())
+
+	unregister := exporter.register()
+
+	scope := registry.ScopeNamed("test")
+	var rootSpan, childSpan *monkit.Span
+	func() {
+		ctx := context.Context(ctx)
+		var err error
+		defer scope.TaskNamed("root")(&ctx)(&err)
+		rootSpan = monkit.SpanFromCtx(ctx)
+
+		func() {
+			var err error
+			defer scope.TaskNamed("child")(&ctx, "arg")(&err)
+			childSpan = monkit.SpanFromCtx(ctx)
+			err = rpcstatus.Error(rpcstatus.NotFound, "private details")
+		}()
+	}()
+
+	unregister()
+	require.NoError(t, exporter.provider.Shutdown(ctx))
+
+	spans := collector.Spans()
+	require.Len(t, spans, 2)
+	require.Equal(t, "token", collector.headers[0].Get("Authorization"))
+	require.Equal(t, "satellite", attributes(collector.requests[0].ResourceSpans[0].Resource.Attributes)["service.name"])
+
+	child, root := spans[0], spans[1]
+	require.Equal(t, "test.child", child.Name)
+	require.Equal(t, "test.root", root.Name)
+
+	// the ids of the monkit spans are kept.
+	rootTraceID := traceID(rootSpan.Trace().Id())
+	rootSpanID, childSpanID := spanID(rootSpan.Id()), spanID(childSpan.Id())
+	require.Equal(t, rootTraceID[:], root.TraceId)
+	require.Equal(t, rootSpanID[:], root.SpanId)
+	require.Equal(t, root.TraceId, child.TraceId)
+	require.Equal(t, childSpanID[:], child.SpanId)
+	require.Equal(t, root.SpanId, child.ParentSpanId)
+	require.Empty(t, root.ParentSpanId)
+	require.Equal(t, tracepb.Span_SPAN_KIND_SERVER, root.Kind)
+	require.Equal(t, tracepb.Span_SPAN_KIND_INTERNAL, child.Kind)
+
+	require.Equal(t, map[string]string{
+		"args":            `"arg"`,
+		"rpc.status_code": "5",
+	}, attributes(child.Attributes))
+	require.Equal(t, tracepb.Status_STATUS_CODE_ERROR, child.Status.Code)
+	require.Equal(t, "errored", child.Status.Message)
+	require.Equal(t, tracepb.Status_STATUS_CODE_UNSET, root.Status.GetCode())
+}
+
+func TestExporter_Sampling(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	server, collector := newCollector(t)
+
+	registry := monkit.NewRegistry()
+	exporter, err := NewExporter(zaptest.NewLogger(t), registry, Config{
+		Endpoint:   server.URL,
+		SampleRate: 0,
+	}, "satellite")
+	require.NoError(t, err)
+
+	unregister := exporter.register()
+	defer unregister()
+
+	root := registry.ScopeNamed("test").FuncNamed("root")
+
+	// the trace isn't sampled by the exporter.
+	func() {
+		ctx := context.Context(ctx)
+		var err error
+		defer root.ResetTrace(&ctx)(&err)
+	}()
+
+	// the trace has been sampled by the caller.
+	func() {
+		ctx := context.Context(ctx)
+		trace := monkit.NewTrace(monkit.NewId())
+		trace.Set(rpctracing.Sampled, true)
+
+		var err error
+		defer root.RemoteTrace(&ctx, monkit.NewId(), trace)(&err)
+	}()
+
+	require.NoError(t, exporter.provider.ForceFlush(ctx))
+	require.Len(t, collector.Spans(), 1)
+}
+
+func TestExporter_Disabled(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	exporter, err := NewExporter(zaptest.NewLogger(t), monkit.NewRegistry(), Config{}, "satellite")
+	require.NoError(t, err)
+	require.False(t, exporter.Enabled())
+	require.NoError(t, exporter.Run(ctx))
+
+	_, err = NewExporter(zaptest.NewLogger(t), monkit.NewRegistry(), Config{Endpoint: "http://localhost:4318", Headers: "invalid"}, "satellite")
+	require.Error(t, err)
+
+	_, err = NewExporter(zaptest.NewLogger(t), monkit.NewRegistry(), Config{Endpoint: "localhost:4318"}, "satellite")
+	require.Error(t, err)
+}
@@ -0,0 +1,7 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package piecelistpb contains protobuf definitions for listing the pieces stored on a storagenode.
+package piecelistpb
+
+//go:generate go run gen.go
@@ -0,0 +1,190 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: piecelist.proto
+
+package piecelistpb
+
+import (
+	fmt "fmt"
+	math "math"
+	time "time"
+
+	proto "github.com/gogo/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type ListRequest struct {
+	Cursor               PieceID  `protobuf:"bytes,1,opt,name=cursor,proto3,customtype=PieceID" json:"cursor"`
+	Limit                int32    `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListRequest) Reset()         { *m = ListRequest{} }
+func (m *ListRequest) String() string { return proto.CompactTextString(m) }
+func (*ListRequest) ProtoMessage()    {}
+func (*ListRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f5b8af2b8d6a9a7, []int{0}
+}
+func (m *ListRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListRequest.Unmarshal(m, b)
+}
+func (m *ListRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListRequest.Marshal(b, m, deterministic)
+}
+func (m *ListRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListRequest.Merge(m, src)
+}
+func (m *ListRequest) XXX_Size() int {
+	return xxx_messageInfo_ListRequest.Size(m)
+}
+func (m *ListRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListRequest proto.InternalMessageInfo
+
+func (m *ListRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type ListResponse struct {
+	Pieces               []*PieceInfo `protobuf:"bytes,1,rep,name=pieces,proto3" json:"pieces,omitempty"`
+	More                 bool         `protobuf:"varint,2,opt,name=more,proto3" json:"more,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *ListResponse) Reset()         { *m = ListResponse{} }
+func (m *ListResponse) String() string { return proto.CompactTextString(m) }
+func (*ListResponse) ProtoMessage()    {}
+func (*ListResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f5b8af2b8d6a9a7, []int{1}
+}
+func (m *ListResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListResponse.Unmarshal(m, b)
+}
+func (m *ListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListResponse.Marshal(b, m, deterministic)
+}
+func (m *ListResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListResponse.Merge(m, src)
+}
+func (m *ListResponse) XXX_Size() int {
+	return xxx_messageInfo_ListResponse.Size(m)
+}
+func (m *ListResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListResponse proto.InternalMessageInfo
+
+func (m *ListResponse) GetPieces() []*PieceInfo {
+	if m != nil {
+		return m.Pieces
+	}
+	return nil
+}
+
+func (m *ListResponse) GetMore() bool {
+	if m != nil {
+		return m.More
+	}
+	return false
+}
+
+type PieceInfo struct {
+	PieceId              PieceID   `protobuf:"bytes,1,opt,name=piece_id,json=pieceId,proto3,customtype=PieceID" json:"piece_id"`
+	ContentSize          int64     `protobuf:"varint,2,opt,name=content_size,json=contentSize,proto3" json:"content_size,omitempty"`
+	ModificationTime     time.Time `protobuf:"bytes,3,opt,name=modification_time,json=modificationTime,proto3,stdtime" json:"modification_time"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *PieceInfo) Reset()         { *m = PieceInfo{} }
+func (m *PieceInfo) String() string { return proto.CompactTextString(m) }
+func (*PieceInfo) ProtoMessage()    {}
+func (*PieceInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f5b8af2b8d6a9a7, []int{2}
+}
+func (m *PieceInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PieceInfo.Unmarshal(m, b)
+}
+func (m *PieceInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PieceInfo.Marshal(b, m, deterministic)
+}
+func (m *PieceInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PieceInfo.Merge(m, src)
+}
+func (m *PieceInfo) XXX_Size() int {
+	return xxx_messageInfo_PieceInfo.Size(m)
+}
+func (m *PieceInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_PieceInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PieceInfo proto.InternalMessageInfo
+
+func (m *PieceInfo) GetContentSize() int64 {
+	if m != nil {
+		return m.ContentSize
+	}
+	return 0
+}
+
+func (m *PieceInfo) GetModificationTime() time.Time {
+	if m != nil {
+		return m.ModificationTime
+	}
+	return time.Time{}
+}
+
+func init() {
+	proto.RegisterType((*ListRequest)(nil), "piecelist.ListRequest")
+	proto.RegisterType((*ListResponse)(nil), "piecelist.ListResponse")
+	proto.RegisterType((*PieceInfo)(nil), "piecelist.PieceInfo")
+}
+
+func init() { proto.RegisterFile("piecelist.proto", fileDescriptor_2f5b8af2b8d6a9a7) }
+
+var fileDescriptor_2f5b8af2b8d6a9a7 = []byte{
+	// 342 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x90, 0xcf, 0x4a, 0xf3, 0x40,
+	0x14, 0xc5, 0xbf, 0xf9, 0xfa, 0x7f, 0x52, 0xa8, 0x0e, 0x45, 0x43, 0x36, 0x89, 0x41, 0x30, 0x88,
+	0x24, 0x50, 0x17, 0xee, 0x4b, 0x37, 0x85, 0x2e, 0xea, 0xe8, 0xca, 0x4d, 0x69, 0xd3, 0x69, 0xb8,
+	0xd2, 0xe4, 0xc6, 0xcc, 0xd4, 0x45, 0x9f, 0xc2, 0x17, 0xf1, 0x3d, 0x7c, 0x06, 0x17, 0xf5, 0x55,
+	0x24, 0x33, 0x69, 0x2d, 0x88, 0xab, 0xdc, 0x7b, 0x72, 0xee, 0xc9, 0xc9, 0x8f, 0xf6, 0x72, 0x10,
+	0xb1, 0x58, 0x83, 0x54, 0x61, 0x5e, 0xa0, 0x42, 0xd6, 0x39, 0x08, 0x0e, 0x4d, 0x30, 0x41, 0x23,
+	0x3b, 0x6e, 0x82, 0x98, 0xac, 0x45, 0xa4, 0xb7, 0xc5, 0x66, 0x15, 0x29, 0x48, 0x85, 0x54, 0xf3,
+	0x34, 0x37, 0x06, 0x7f, 0x42, 0xad, 0x09, 0x48, 0xc5, 0xc5, 0xcb, 0x46, 0x48, 0xc5, 0xae, 0x68,
+	0x33, 0xde, 0x14, 0x12, 0x0b, 0x9b, 0x78, 0x24, 0xe8, 0x0e, 0x7b, 0x1f, 0x3b, 0xf7, 0xdf, 0xe7,
+	0xce, 0x6d, 0x4d, 0xcb, 0xf8, 0xf1, 0x88, 0x57, 0xaf, 0x59, 0x9f, 0x36, 0xd6, 0x90, 0x82, 0xb2,
+	0xff, 0x7b, 0x24, 0x68, 0x70, 0xb3, 0xf8, 0x53, 0xda, 0x35, 0x69, 0x32, 0xc7, 0x4c, 0x0a, 0x76,
+	0x43, 0x9b, 0xba, 0x97, 0xb4, 0x89, 0x57, 0x0b, 0xac, 0x41, 0x3f, 0xfc, 0xe9, 0x6d, 0x12, 0xb3,
+	0x15, 0xf2, 0xca, 0xc3, 0x18, 0xad, 0xa7, 0x58, 0x08, 0x1d, 0xd9, 0xe6, 0x7a, 0xf6, 0xdf, 0x09,
+	0xed, 0x1c, 0x9c, 0xec, 0x9a, 0xb6, 0xb5, 0x77, 0x06, 0xcb, 0xbf, 0x0a, 0xb6, 0xb4, 0x61, 0xbc,
+	0x64, 0x17, 0xb4, 0x1b, 0x63, 0xa6, 0x44, 0xa6, 0x66, 0x12, 0xb6, 0x26, 0xb5, 0xc6, 0xad, 0x4a,
+	0x7b, 0x80, 0xad, 0x60, 0xf7, 0xf4, 0x34, 0xc5, 0x25, 0xac, 0x20, 0x9e, 0x2b, 0xc0, 0x6c, 0x56,
+	0xc2, 0xb1, 0x6b, 0x1e, 0x09, 0xac, 0x81, 0x13, 0x1a, 0x72, 0xe1, 0x9e, 0x5c, 0xf8, 0xb8, 0x27,
+	0x37, 0x6c, 0x97, 0xdf, 0x7c, 0xfb, 0x72, 0x09, 0x3f, 0x39, 0x3e, 0x2f, 0x0d, 0x83, 0x51, 0x55,
+	0xb7, 0xc4, 0xc0, 0xee, 0x68, 0x5d, 0x3f, 0xcf, 0x8e, 0x7e, 0xfb, 0x88, 0xb6, 0x73, 0xfe, 0x4b,
+	0x37, 0xdc, 0x86, 0x97, 0x4f, 0xbe, 0x54, 0x58, 0x3c, 0x87, 0x80, 0x91, 0x1e, 0xa2, 0xbc, 0x80,
+	0xd7, 0xb9, 0x12, 0xd1, 0xe1, 0x20, 0x5f, 0x2c, 0x9a, 0xba, 0xdb, 0xed, 0xf7, 0x00, 0xaa, 0x94,
+	0xab, 0x37, 0x0d, 0x02, 0x00, 0x00,
+}
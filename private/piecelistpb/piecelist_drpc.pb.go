@@ -0,0 +1,115 @@
+// Code generated by protoc-gen-go-drpc. DO NOT EDIT.
+// protoc-gen-go-drpc version: v0.0.32
+// source: piecelist.proto
+
+package piecelistpb
+
+import (
+	bytes "bytes"
+	context "context"
+	errors "errors"
+
+	jsonpb "github.com/gogo/protobuf/jsonpb"
+	proto "github.com/gogo/protobuf/proto"
+
+	drpc "storj.io/drpc"
+	drpcerr "storj.io/drpc/drpcerr"
+)
+
+type drpcEncoding_File_piecelist_proto struct{}
+
+func (drpcEncoding_File_piecelist_proto) Marshal(msg drpc.Message) ([]byte, error) {
+	return proto.Marshal(msg.(proto.Message))
+}
+
+func (drpcEncoding_File_piecelist_proto) Unmarshal(buf []byte, msg drpc.Message) error {
+	return proto.Unmarshal(buf, msg.(proto.Message))
+}
+
+func (drpcEncoding_File_piecelist_proto) JSONMarshal(msg drpc.Message) ([]byte, error) {
+	var buf bytes.Buffer
+	err := new(jsonpb.Marshaler).Marshal(&buf, msg.(proto.Message))
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (drpcEncoding_File_piecelist_proto) JSONUnmarshal(buf []byte, msg drpc.Message) error {
+	return jsonpb.Unmarshal(bytes.NewReader(buf), msg.(proto.Message))
+}
+
+type DRPCPieceListClient interface {
+	DRPCConn() drpc.Conn
+
+	List(ctx context.Context, in *ListRequest) (*ListResponse, error)
+}
+
+type drpcPieceListClient struct {
+	cc drpc.Conn
+}
+
+func NewDRPCPieceListClient(cc drpc.Conn) DRPCPieceListClient {
+	return &drpcPieceListClient{cc}
+}
+
+func (c *drpcPieceListClient) DRPCConn() drpc.Conn { return c.cc }
+
+func (c *drpcPieceListClient) List(ctx context.Context, in *ListRequest) (*ListResponse, error) {
+	out := new(ListResponse)
+	err := c.cc.Invoke(ctx, "/piecelist.PieceList/List", drpcEncoding_File_piecelist_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+type DRPCPieceListServer interface {
+	List(context.Context, *ListRequest) (*ListResponse, error)
+}
+
+type DRPCPieceListUnimplementedServer struct{}
+
+func (s *DRPCPieceListUnimplementedServer) List(context.Context, *ListRequest) (*ListResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+type DRPCPieceListDescription struct{}
+
+func (DRPCPieceListDescription) NumMethods() int { return 1 }
+
+func (DRPCPieceListDescription) Method(n int) (string, drpc.Encoding, drpc.Receiver, interface{}, bool) {
+	switch n {
+	case 0:
+		return "/piecelist.PieceList/List", drpcEncoding_File_piecelist_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCPieceListServer).
+					List(
+						ctx,
+						in1.(*ListRequest),
+					)
+			}, DRPCPieceListServer.List, true
+	default:
+		return "", nil, nil, nil, false
+	}
+}
+
+func DRPCRegisterPieceList(mux drpc.Mux, impl DRPCPieceListServer) error {
+	return mux.Register(impl, DRPCPieceListDescription{})
+}
+
+type DRPCPieceList_ListStream interface {
+	drpc.Stream
+	SendAndClose(*ListResponse) error
+}
+
+type drpcPieceList_ListStream struct {
+	drpc.Stream
+}
+
+func (x *drpcPieceList_ListStream) SendAndClose(m *ListResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_piecelist_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
@@ -0,0 +1,146 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package rpcdeadline derives the deadlines of the requests, which a DRPC
+// server handles, from the timeouts, which the clients send with the
+// requests, limited by the maximum timeouts of the server.
+//
+// The deadline is set on the context of the request, so everything using the
+// context, like database queries, is canceled once it passes.
+package rpcdeadline
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+
+	"storj.io/drpc"
+	"storj.io/drpc/drpcmetadata"
+)
+
+var mon = monkit.Package()
+
+// MetadataKey is the key of the request metadata, which contains the timeout
+// of the client in milliseconds.
+const MetadataKey = "timeout"
+
+// WithTimeout adds the timeout to the metadata of the requests made with the
+// context, so the server stops handling them once the client gives up. The
+// deadline of the context is sent, if it's earlier than the timeout.
+func WithTimeout(ctx context.Context, timeout time.Duration) context.Context {
+	if deadline, ok := ctx.Deadline(); ok {
+		if remaining := time.Until(deadline); timeout <= 0 || remaining < timeout {
+			timeout = remaining
+		}
+	}
+	if timeout <= 0 {
+		return ctx
+	}
+	return drpcmetadata.Add(ctx, MetadataKey, strconv.FormatInt(timeout.Milliseconds(), 10))
+}
+
+// Timeout returns the timeout, which the client sent with the request.
+func Timeout(ctx context.Context) (_ time.Duration, ok bool) {
+	metadata, ok := drpcmetadata.Get(ctx)
+	if !ok {
+		return 0, false
+	}
+	value, ok := metadata[MetadataKey]
+	if !ok {
+		return 0, false
+	}
+	millis, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || millis <= 0 {
+		return 0, false
+	}
+	return time.Duration(millis) * time.Millisecond, true
+}
+
+// Limits are the maximum timeouts of the requests per service.
+type Limits struct {
+	mu       sync.RWMutex
+	services map[string]time.Duration
+}
+
+// NewLimits creates limits without any maximum timeouts.
+func NewLimits() *Limits {
+	return &Limits{
+		services: make(map[string]time.Duration),
+	}
+}
+
+// Set sets the maximum timeout of the requests to the service, e.g.
+// "metainfo.Metainfo". Zero only applies the timeouts of the clients.
+func (limits *Limits) Set(service string, timeout time.Duration) {
+	limits.mu.Lock()
+	defer limits.mu.Unlock()
+	limits.services[service] = timeout
+}
+
+// For returns the maximum timeout of the rpc, e.g.
+// "/metainfo.Metainfo/BeginObject". ok is false, when the deadlines of the
+// requests to the service aren't managed.
+func (limits *Limits) For(rpc string) (_ time.Duration, ok bool) {
+	service := strings.TrimPrefix(rpc, "/")
+	if i := strings.IndexByte(service, '/'); i >= 0 {
+		service = service[:i]
+	}
+
+	limits.mu.RLock()
+	defer limits.mu.RUnlock()
+	timeout, ok := limits.services[service]
+	return timeout, ok
+}
+
+// Handler sets the deadlines of the requests, before passing them to the
+// wrapped handler.
+type Handler struct {
+	handler drpc.Handler
+	limits  *Limits
+}
+
+// NewHandler returns a handler, which sets the deadlines of the requests to
+// the services with limits.
+func NewHandler(handler drpc.Handler, limits *Limits) *Handler {
+	return &Handler{
+		handler: handler,
+		limits:  limits,
+	}
+}
+
+// HandleRPC implements drpc.Handler.
+func (handler *Handler) HandleRPC(stream drpc.Stream, rpc string) (err error) {
+	maximum, ok := handler.limits.For(rpc)
+	if !ok {
+		return handler.handler.HandleRPC(stream, rpc)
+	}
+
+	ctx := stream.Context()
+	timeout, hinted := Timeout(ctx)
+	if maximum > 0 && (!hinted || timeout > maximum) {
+		timeout = maximum
+	}
+	if timeout <= 0 {
+		return handler.handler.HandleRPC(stream, rpc)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	err = handler.handler.HandleRPC(&streamWrapper{Stream: stream, ctx: ctx}, rpc)
+	if ctx.Err() == context.DeadlineExceeded {
+		mon.Event("request_deadline_exceeded", monkit.NewSeriesTag("rpc", rpc))
+	}
+	return err
+}
+
+type streamWrapper struct {
+	drpc.Stream
+	ctx context.Context
+}
+
+func (s *streamWrapper) Context() context.Context { return s.ctx }
@@ -0,0 +1,89 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package rpcdeadline_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/drpc"
+	"storj.io/storj/private/rpcdeadline"
+)
+
+func TestTimeout(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	_, ok := rpcdeadline.Timeout(ctx)
+	require.False(t, ok)
+
+	timeout, ok := rpcdeadline.Timeout(rpcdeadline.WithTimeout(ctx, time.Minute))
+	require.True(t, ok)
+	require.Equal(t, time.Minute, timeout)
+
+	// an earlier deadline of the context is sent instead.
+	deadlineCtx, cancel := context.WithTimeout(ctx, time.Second)
+	defer cancel()
+	timeout, ok = rpcdeadline.Timeout(rpcdeadline.WithTimeout(deadlineCtx, time.Minute))
+	require.True(t, ok)
+	require.LessOrEqual(t, timeout, time.Second)
+}
+
+func TestHandler(t *testing.T) {
+	// the test context has a deadline, which would be sent as hint.
+	ctx := context.Background()
+
+	limits := rpcdeadline.NewLimits()
+	limits.Set("metainfo.Metainfo", time.Hour)
+	limits.Set("piecestore.Piecestore", 0)
+
+	var deadline time.Time
+	var hasDeadline bool
+	handler := rpcdeadline.NewHandler(handlerFunc(func(stream drpc.Stream, rpc string) error {
+		deadline, hasDeadline = stream.Context().Deadline()
+		return nil
+	}), limits)
+
+	handle := func(ctx context.Context, rpc string) time.Duration {
+		require.NoError(t, handler.HandleRPC(&fakeStream{ctx: ctx}, rpc))
+		if !hasDeadline {
+			return 0
+		}
+		return time.Until(deadline)
+	}
+
+	// without a hint the maximum applies.
+	remaining := handle(ctx, "/metainfo.Metainfo/BeginObject")
+	require.InDelta(t, time.Hour, remaining, float64(time.Minute))
+
+	// a shorter hint of the client applies.
+	remaining = handle(rpcdeadline.WithTimeout(ctx, time.Minute), "/metainfo.Metainfo/BeginObject")
+	require.InDelta(t, time.Minute, remaining, float64(10*time.Second))
+
+	// a longer hint is limited to the maximum.
+	remaining = handle(rpcdeadline.WithTimeout(ctx, 2*time.Hour), "/metainfo.Metainfo/BeginObject")
+	require.InDelta(t, time.Hour, remaining, float64(time.Minute))
+
+	// without a maximum only the hints apply.
+	require.Zero(t, handle(ctx, "/piecestore.Piecestore/Upload"))
+	remaining = handle(rpcdeadline.WithTimeout(ctx, time.Minute), "/piecestore.Piecestore/Upload")
+	require.InDelta(t, time.Minute, remaining, float64(10*time.Second))
+
+	// services without limits aren't managed.
+	require.Zero(t, handle(rpcdeadline.WithTimeout(ctx, time.Minute), "/contact.Node/CheckIn"))
+}
+
+type handlerFunc func(stream drpc.Stream, rpc string) error
+
+func (fn handlerFunc) HandleRPC(stream drpc.Stream, rpc string) error { return fn(stream, rpc) }
+
+type fakeStream struct {
+	drpc.Stream
+	ctx context.Context
+}
+
+func (stream *fakeStream) Context() context.Context { return stream.ctx }
@@ -0,0 +1,40 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package rpcidempotency sends idempotency keys with DRPC requests, so the
+// server can recognize retries of mutating requests, which it has already
+// handled, and reply with the earlier response instead of repeating them.
+package rpcidempotency
+
+import (
+	"context"
+
+	"storj.io/drpc/drpcmetadata"
+)
+
+// MetadataKey is the key of the request metadata, which contains the
+// idempotency key.
+const MetadataKey = "idempotency-key"
+
+// WithKey adds the idempotency key to the metadata of the requests made with
+// the context. Retries of a request have to use the same key, and different
+// requests have to use different keys.
+func WithKey(ctx context.Context, key string) context.Context {
+	if key == "" {
+		return ctx
+	}
+	return drpcmetadata.Add(ctx, MetadataKey, key)
+}
+
+// Key returns the idempotency key, which the client sent with the request.
+func Key(ctx context.Context) (_ string, ok bool) {
+	metadata, ok := drpcmetadata.Get(ctx)
+	if !ok {
+		return "", false
+	}
+	key, ok := metadata[MetadataKey]
+	if !ok || key == "" {
+		return "", false
+	}
+	return key, true
+}
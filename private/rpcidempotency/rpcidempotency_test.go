@@ -0,0 +1,27 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package rpcidempotency_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/private/rpcidempotency"
+)
+
+func TestKey(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	_, ok := rpcidempotency.Key(ctx)
+	require.False(t, ok)
+
+	_, ok = rpcidempotency.Key(rpcidempotency.WithKey(ctx, ""))
+	require.False(t, ok)
+
+	key, ok := rpcidempotency.Key(rpcidempotency.WithKey(ctx, "retry-1"))
+	require.True(t, ok)
+	require.Equal(t, "retry-1", key)
+}
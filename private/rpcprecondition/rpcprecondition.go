@@ -0,0 +1,86 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package rpcprecondition sends the preconditions of conditional requests with
+// DRPC requests, whose messages don't have fields for them, so the server
+// executes the requests only when the object at the location satisfies them.
+package rpcprecondition
+
+import (
+	"context"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/storj"
+	"storj.io/drpc/drpcmetadata"
+)
+
+const (
+	// IfMatchKey is the key of the request metadata, which contains the
+	// stream ID of the object, which must be the last committed object at
+	// the location.
+	IfMatchKey = "if-match"
+	// IfNoneMatchKey is the key of the request metadata, which requires that
+	// there is no committed object at the location. Its only valid value is
+	// IfNoneMatchAny.
+	IfNoneMatchKey = "if-none-match"
+	// IfNoneMatchAny is the value of IfNoneMatchKey.
+	IfNoneMatchAny = "*"
+)
+
+// Error is the error class for malformed preconditions.
+var Error = errs.Class("rpcprecondition")
+
+// Precondition contains the preconditions, which the client sent with the
+// request.
+type Precondition struct {
+	// IfMatch is the stream ID of the object, as the satellite returned it.
+	IfMatch     storj.StreamID
+	IfNoneMatch bool
+}
+
+// IsZero returns true when no precondition was sent.
+func (p Precondition) IsZero() bool {
+	return p.IfMatch.IsZero() && !p.IfNoneMatch
+}
+
+// WithIfMatch adds the precondition, that the object with the stream ID must
+// be the last committed object at the location, to the metadata of the
+// requests made with the context.
+func WithIfMatch(ctx context.Context, streamID storj.StreamID) context.Context {
+	if streamID.IsZero() {
+		return ctx
+	}
+	return drpcmetadata.Add(ctx, IfMatchKey, streamID.String())
+}
+
+// WithIfNoneMatch adds the precondition, that there must be no committed
+// object at the location, to the metadata of the requests made with the
+// context.
+func WithIfNoneMatch(ctx context.Context) context.Context {
+	return drpcmetadata.Add(ctx, IfNoneMatchKey, IfNoneMatchAny)
+}
+
+// FromContext returns the preconditions, which the client sent with the
+// request.
+func FromContext(ctx context.Context) (_ Precondition, err error) {
+	metadata, ok := drpcmetadata.Get(ctx)
+	if !ok {
+		return Precondition{}, nil
+	}
+
+	var precondition Precondition
+	if value, ok := metadata[IfMatchKey]; ok && value != "" {
+		precondition.IfMatch, err = storj.StreamIDFromString(value)
+		if err != nil {
+			return Precondition{}, Error.New("invalid %s: %w", IfMatchKey, err)
+		}
+	}
+	if value, ok := metadata[IfNoneMatchKey]; ok && value != "" {
+		if value != IfNoneMatchAny {
+			return Precondition{}, Error.New("invalid %s: %q", IfNoneMatchKey, value)
+		}
+		precondition.IfNoneMatch = true
+	}
+	return precondition, nil
+}
@@ -0,0 +1,38 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package rpcprecondition_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/drpc/drpcmetadata"
+	"storj.io/storj/private/rpcprecondition"
+)
+
+func TestFromContext(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	precondition, err := rpcprecondition.FromContext(ctx)
+	require.NoError(t, err)
+	require.True(t, precondition.IsZero())
+
+	streamID := storj.StreamID("stream id of the committed object")
+	precondition, err = rpcprecondition.FromContext(rpcprecondition.WithIfMatch(ctx, streamID))
+	require.NoError(t, err)
+	require.Equal(t, rpcprecondition.Precondition{IfMatch: streamID}, precondition)
+
+	precondition, err = rpcprecondition.FromContext(rpcprecondition.WithIfNoneMatch(ctx))
+	require.NoError(t, err)
+	require.Equal(t, rpcprecondition.Precondition{IfNoneMatch: true}, precondition)
+
+	_, err = rpcprecondition.FromContext(drpcmetadata.Add(ctx, rpcprecondition.IfMatchKey, "not base32!"))
+	require.True(t, rpcprecondition.Error.Has(err))
+
+	_, err = rpcprecondition.FromContext(drpcmetadata.Add(ctx, rpcprecondition.IfNoneMatchKey, "etag"))
+	require.True(t, rpcprecondition.Error.Has(err))
+}
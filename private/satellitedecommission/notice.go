@@ -0,0 +1,95 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package satellitedecommission implements the notices, which satellites
+// publish to announce that they are shutting down.
+//
+// A notice is signed by the identity of the satellite, so storage nodes,
+// which trust the satellite, can verify it, stop trusting the satellite once
+// it shuts down and remove the data stored for it after a grace period.
+//
+// The notice is sent with the second version of the check-in response.
+package satellitedecommission
+
+import (
+	"context"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/pb"
+	"storj.io/common/signing"
+	"storj.io/common/storj"
+	"storj.io/storj/private/contactpb"
+)
+
+// Error is the error class for satellite decommission notices.
+var Error = errs.Class("satellite decommission")
+
+// Notice announces when the satellite shuts down.
+type Notice struct {
+	Satellite  storj.NodeID `json:"satellite"`
+	ShutdownAt time.Time    `json:"shutdown_at"`
+	CreatedAt  time.Time    `json:"created_at"`
+	Signature  []byte       `json:"signature"`
+}
+
+// Sign creates a notice, which announces that the signer shuts down at
+// shutdownAt.
+func Sign(ctx context.Context, signer signing.Signer, shutdownAt, createdAt time.Time) (_ Notice, err error) {
+	if shutdownAt.IsZero() {
+		return Notice{}, Error.New("shutdown time is missing")
+	}
+
+	notice := Notice{
+		Satellite:  signer.ID(),
+		ShutdownAt: shutdownAt.UTC(),
+		CreatedAt:  createdAt.UTC(),
+	}
+	notice.Signature, err = signer.HashAndSign(ctx, notice.signedBytes())
+	if err != nil {
+		return Notice{}, Error.Wrap(err)
+	}
+	return notice, nil
+}
+
+// Verify checks that the notice is signed by the satellite.
+func Verify(ctx context.Context, signee signing.Signee, notice Notice) error {
+	if signee.ID() != notice.Satellite {
+		return Error.New("notice of %s is not signed by %s", notice.Satellite, signee.ID())
+	}
+	return Error.Wrap(signee.HashAndVerifySignature(ctx, notice.signedBytes(), notice.Signature))
+}
+
+// FromProto returns the notice, which the satellite sent with the check-in
+// response.
+func FromProto(message *contactpb.DecommissionNotice) (notice Notice, err error) {
+	notice.Satellite, err = storj.NodeIDFromBytes(message.Satellite)
+	if err != nil {
+		return Notice{}, Error.Wrap(err)
+	}
+	notice.ShutdownAt = message.ShutdownAt.UTC()
+	notice.CreatedAt = message.CreatedAt.UTC()
+	notice.Signature = message.Signature
+	return notice, nil
+}
+
+// Proto returns the notice for the check-in response.
+func (notice Notice) Proto() *contactpb.DecommissionNotice {
+	return &contactpb.DecommissionNotice{
+		Satellite:  notice.Satellite.Bytes(),
+		ShutdownAt: notice.ShutdownAt,
+		CreatedAt:  notice.CreatedAt,
+		Signature:  notice.Signature,
+	}
+}
+
+// signedBytes returns the encoded notice without the signature.
+func (notice Notice) signedBytes() []byte {
+	message := notice.Proto()
+	message.Signature = nil
+
+	// marshaling a message without maps can't fail.
+	encoded, _ := pb.Marshal(message)
+	return encoded
+}
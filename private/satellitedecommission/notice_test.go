@@ -0,0 +1,81 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedecommission_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/identity/testidentity"
+	"storj.io/common/pb"
+	"storj.io/common/signing"
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/storj/private/contactpb"
+	"storj.io/storj/private/satellitedecommission"
+	"storj.io/storj/private/satellitesuccessor"
+)
+
+func TestNotice(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	satellite := testidentity.MustPregeneratedSignedIdentity(0, storj.LatestIDVersion())
+	other := testidentity.MustPregeneratedSignedIdentity(1, storj.LatestIDVersion())
+	shutdownAt := time.Now().Add(30 * 24 * time.Hour)
+
+	notice, err := satellitedecommission.Sign(ctx, signing.SignerFromFullIdentity(satellite), shutdownAt, time.Now())
+	require.NoError(t, err)
+	require.Equal(t, satellite.ID, notice.Satellite)
+
+	require.NoError(t, satellitedecommission.Verify(ctx, signing.SigneeFromPeerIdentity(satellite.PeerIdentity()), notice))
+
+	// the notice is only valid for the satellite, which signed it.
+	err = satellitedecommission.Verify(ctx, signing.SigneeFromPeerIdentity(other.PeerIdentity()), notice)
+	require.Error(t, err)
+
+	// a modified notice doesn't match the signature.
+	modified := notice
+	modified.ShutdownAt = time.Now()
+	err = satellitedecommission.Verify(ctx, signing.SigneeFromPeerIdentity(satellite.PeerIdentity()), modified)
+	require.Error(t, err)
+
+	// the shutdown time is required.
+	_, err = satellitedecommission.Sign(ctx, signing.SignerFromFullIdentity(satellite), time.Time{}, time.Now())
+	require.Error(t, err)
+}
+
+func TestCheckInRoundTrip(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	satellite := testidentity.MustPregeneratedSignedIdentity(0, storj.LatestIDVersion())
+	successor := testidentity.MustPregeneratedSignedIdentity(1, storj.LatestIDVersion())
+
+	notice, err := satellitedecommission.Sign(ctx, signing.SignerFromFullIdentity(satellite), time.Now().Add(time.Hour), time.Now())
+	require.NoError(t, err)
+	pointer, err := satellitesuccessor.Sign(ctx, signing.SignerFromFullIdentity(satellite),
+		storj.NodeURL{ID: successor.ID, Address: "successor.test:7777"}, time.Now())
+	require.NoError(t, err)
+
+	data, err := pb.Marshal(&contactpb.CheckInResponse{
+		CheckIn:      &pb.CheckInResponse{PingNodeSuccess: true},
+		Successor:    pointer.Proto(),
+		Decommission: notice.Proto(),
+	})
+	require.NoError(t, err)
+
+	var decoded contactpb.CheckInResponse
+	require.NoError(t, pb.Unmarshal(data, &decoded))
+	require.True(t, decoded.CheckIn.PingNodeSuccess)
+
+	got, err := satellitedecommission.FromProto(decoded.Decommission)
+	require.NoError(t, err)
+	require.Equal(t, notice, got)
+	require.NoError(t, satellitedecommission.Verify(ctx, signing.SigneeFromPeerIdentity(satellite.PeerIdentity()), got))
+
+	gotPointer, err := satellitesuccessor.FromProto(decoded.Successor)
+	require.NoError(t, err)
+	require.Equal(t, pointer, gotPointer)
+}
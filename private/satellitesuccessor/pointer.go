@@ -0,0 +1,109 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package satellitesuccessor implements the pointers, which satellites
+// publish to announce the satellite succeeding them.
+//
+// A pointer is signed by the identity of the predecessor, so storage nodes,
+// which trust the predecessor, can verify it and follow the successor when
+// the satellite rotates its identity or migrates to another address.
+//
+// The pointer is sent with the second version of the check-in response.
+package satellitesuccessor
+
+import (
+	"context"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/pb"
+	"storj.io/common/signing"
+	"storj.io/common/storj"
+	"storj.io/storj/private/contactpb"
+)
+
+// Error is the error class for satellite successor pointers.
+var Error = errs.Class("satellite successor")
+
+// Pointer announces the satellite, which succeeds the predecessor.
+type Pointer struct {
+	Predecessor storj.NodeID `json:"predecessor"`
+	Successor   string       `json:"successor"`
+	CreatedAt   time.Time    `json:"created_at"`
+	Signature   []byte       `json:"signature"`
+}
+
+// SuccessorURL returns the node URL of the successor.
+func (pointer Pointer) SuccessorURL() (storj.NodeURL, error) {
+	url, err := storj.ParseNodeURL(pointer.Successor)
+	if err != nil {
+		return storj.NodeURL{}, Error.Wrap(err)
+	}
+	if url.ID.IsZero() || url.Address == "" {
+		return storj.NodeURL{}, Error.New("successor %q requires an ID and an address", pointer.Successor)
+	}
+	return url, nil
+}
+
+// Sign creates a pointer from the signer to the successor.
+func Sign(ctx context.Context, signer signing.Signer, successor storj.NodeURL, createdAt time.Time) (_ Pointer, err error) {
+	pointer := Pointer{
+		Predecessor: signer.ID(),
+		Successor:   successor.String(),
+		CreatedAt:   createdAt.UTC(),
+	}
+	if _, err := pointer.SuccessorURL(); err != nil {
+		return Pointer{}, err
+	}
+
+	pointer.Signature, err = signer.HashAndSign(ctx, pointer.signedBytes())
+	if err != nil {
+		return Pointer{}, Error.Wrap(err)
+	}
+	return pointer, nil
+}
+
+// Verify checks that the pointer is signed by the predecessor.
+func Verify(ctx context.Context, signee signing.Signee, pointer Pointer) error {
+	if signee.ID() != pointer.Predecessor {
+		return Error.New("pointer of %s is not signed by %s", pointer.Predecessor, signee.ID())
+	}
+	if _, err := pointer.SuccessorURL(); err != nil {
+		return err
+	}
+	return Error.Wrap(signee.HashAndVerifySignature(ctx, pointer.signedBytes(), pointer.Signature))
+}
+
+// FromProto returns the pointer, which the satellite sent with the check-in
+// response.
+func FromProto(message *contactpb.SuccessorPointer) (pointer Pointer, err error) {
+	pointer.Predecessor, err = storj.NodeIDFromBytes(message.Predecessor)
+	if err != nil {
+		return Pointer{}, Error.Wrap(err)
+	}
+	pointer.Successor = message.Successor
+	pointer.CreatedAt = message.CreatedAt.UTC()
+	pointer.Signature = message.Signature
+	return pointer, nil
+}
+
+// Proto returns the pointer for the check-in response.
+func (pointer Pointer) Proto() *contactpb.SuccessorPointer {
+	return &contactpb.SuccessorPointer{
+		Predecessor: pointer.Predecessor.Bytes(),
+		Successor:   pointer.Successor,
+		CreatedAt:   pointer.CreatedAt,
+		Signature:   pointer.Signature,
+	}
+}
+
+// signedBytes returns the encoded pointer without the signature.
+func (pointer Pointer) signedBytes() []byte {
+	message := pointer.Proto()
+	message.Signature = nil
+
+	// marshaling a message without maps can't fail.
+	encoded, _ := pb.Marshal(message)
+	return encoded
+}
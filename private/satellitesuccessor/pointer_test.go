@@ -0,0 +1,81 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitesuccessor_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/identity/testidentity"
+	"storj.io/common/pb"
+	"storj.io/common/signing"
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/storj/private/contactpb"
+	"storj.io/storj/private/satellitesuccessor"
+)
+
+func TestPointer(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	predecessor := testidentity.MustPregeneratedSignedIdentity(0, storj.LatestIDVersion())
+	successor := testidentity.MustPregeneratedSignedIdentity(1, storj.LatestIDVersion())
+	successorURL := storj.NodeURL{ID: successor.ID, Address: "successor.test:7777"}
+
+	pointer, err := satellitesuccessor.Sign(ctx, signing.SignerFromFullIdentity(predecessor), successorURL, time.Now())
+	require.NoError(t, err)
+	require.Equal(t, predecessor.ID, pointer.Predecessor)
+
+	url, err := pointer.SuccessorURL()
+	require.NoError(t, err)
+	require.Equal(t, successorURL, url)
+
+	require.NoError(t, satellitesuccessor.Verify(ctx, signing.SigneeFromPeerIdentity(predecessor.PeerIdentity()), pointer))
+
+	// the pointer is only valid for the predecessor.
+	err = satellitesuccessor.Verify(ctx, signing.SigneeFromPeerIdentity(successor.PeerIdentity()), pointer)
+	require.Error(t, err)
+
+	// a modified pointer doesn't match the signature.
+	modified := pointer
+	modified.Successor = storj.NodeURL{ID: successor.ID, Address: "attacker.test:7777"}.String()
+	err = satellitesuccessor.Verify(ctx, signing.SigneeFromPeerIdentity(predecessor.PeerIdentity()), modified)
+	require.Error(t, err)
+
+	// the successor needs an ID and an address.
+	_, err = satellitesuccessor.Sign(ctx, signing.SignerFromFullIdentity(predecessor), storj.NodeURL{Address: "successor.test:7777"}, time.Now())
+	require.Error(t, err)
+}
+
+func TestCheckInRoundTrip(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	predecessor := testidentity.MustPregeneratedSignedIdentity(0, storj.LatestIDVersion())
+	successor := testidentity.MustPregeneratedSignedIdentity(1, storj.LatestIDVersion())
+
+	pointer, err := satellitesuccessor.Sign(ctx, signing.SignerFromFullIdentity(predecessor),
+		storj.NodeURL{ID: successor.ID, Address: "successor.test:7777"}, time.Now())
+	require.NoError(t, err)
+
+	data, err := pb.Marshal(&contactpb.CheckInResponse{
+		CheckIn:   &pb.CheckInResponse{PingNodeSuccess: true},
+		Successor: pointer.Proto(),
+	})
+	require.NoError(t, err)
+
+	var decoded contactpb.CheckInResponse
+	require.NoError(t, pb.Unmarshal(data, &decoded))
+	require.True(t, decoded.CheckIn.PingNodeSuccess)
+
+	got, err := satellitesuccessor.FromProto(decoded.Successor)
+	require.NoError(t, err)
+	require.Equal(t, pointer, got)
+	require.NoError(t, satellitesuccessor.Verify(ctx, signing.SigneeFromPeerIdentity(predecessor.PeerIdentity()), got))
+
+	// a pointer without a valid predecessor is rejected.
+	_, err = satellitesuccessor.FromProto(&contactpb.SuccessorPointer{Successor: pointer.Successor})
+	require.Error(t, err)
+}
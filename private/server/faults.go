@@ -0,0 +1,289 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package server
+
+import (
+	"math/rand"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/drpc"
+)
+
+// ErrInjectedFault is returned by connections, which have been closed by an injected fault.
+var ErrInjectedFault = errs.Class("injected fault")
+
+// Faults injects network faults into the public connections accepted by a
+// server. It's only intended for testing timeout, retry and containment
+// behavior.
+//
+// The zero value doesn't inject any faults. Faults can be changed while the
+// server is running; they apply to the connections accepted afterwards, and
+// latency and disconnects also to the already accepted ones.
+type Faults struct {
+	mu              sync.Mutex
+	rand            *rand.Rand
+	latency         time.Duration
+	dropRate        float64
+	disconnectAfter int64
+	endpoints       map[string]EndpointFaults
+}
+
+// EndpointFaults are the faults injected into the requests of a single
+// endpoint, e.g. "/metainfo.Metainfo/BeginObject". The zero value doesn't
+// inject any faults.
+type EndpointFaults struct {
+	// Latency delays the handling of every request.
+	Latency time.Duration
+	// FailRate makes the given fraction of the requests fail with
+	// Unavailable before they are handled.
+	FailRate float64
+	// DisconnectAfter makes a request fail after the given amount of
+	// messages has been sent or received on its stream, in either direction.
+	// 0 disables the disconnects.
+	DisconnectAfter int
+}
+
+// NewFaults returns faults, which make random decisions from the seed, so
+// tests injecting drops stay deterministic.
+func NewFaults(seed int64) *Faults {
+	return &Faults{rand: rand.New(rand.NewSource(seed))}
+}
+
+// SetLatency delays every read from and write to the connections.
+func (faults *Faults) SetLatency(latency time.Duration) {
+	faults.mu.Lock()
+	defer faults.mu.Unlock()
+	faults.latency = latency
+}
+
+// SetDropRate makes the server close the given fraction of the accepted
+// connections immediately. 0 drops none of them, 1 drops all of them.
+func (faults *Faults) SetDropRate(rate float64) {
+	faults.mu.Lock()
+	defer faults.mu.Unlock()
+	faults.dropRate = rate
+}
+
+// SetDisconnectAfter makes the server close a connection after the given
+// amount of bytes has been transferred over it, in either direction.
+// 0 disables the disconnects.
+func (faults *Faults) SetDisconnectAfter(bytes int64) {
+	faults.mu.Lock()
+	defer faults.mu.Unlock()
+	faults.disconnectAfter = bytes
+}
+
+// SetEndpointFaults injects the faults into the requests of the endpoint
+// with the given rpc name, e.g. "/piecestore.Piecestore/Upload". The zero
+// EndpointFaults removes them.
+func (faults *Faults) SetEndpointFaults(rpc string, endpoint EndpointFaults) {
+	faults.mu.Lock()
+	defer faults.mu.Unlock()
+	if endpoint == (EndpointFaults{}) {
+		delete(faults.endpoints, rpc)
+		return
+	}
+	if faults.endpoints == nil {
+		faults.endpoints = map[string]EndpointFaults{}
+	}
+	faults.endpoints[rpc] = endpoint
+}
+
+// Reset removes all the injected faults.
+func (faults *Faults) Reset() {
+	faults.mu.Lock()
+	defer faults.mu.Unlock()
+	faults.latency = 0
+	faults.dropRate = 0
+	faults.disconnectAfter = 0
+	faults.endpoints = nil
+}
+
+func (faults *Faults) current() (latency time.Duration, disconnectAfter int64) {
+	faults.mu.Lock()
+	defer faults.mu.Unlock()
+	return faults.latency, faults.disconnectAfter
+}
+
+func (faults *Faults) shouldDrop() bool {
+	faults.mu.Lock()
+	defer faults.mu.Unlock()
+	return faults.chance(faults.dropRate)
+}
+
+// endpoint returns the faults of the endpoint and whether its request
+// should fail.
+func (faults *Faults) endpoint(rpc string) (_ EndpointFaults, fail bool) {
+	faults.mu.Lock()
+	defer faults.mu.Unlock()
+	endpoint := faults.endpoints[rpc]
+	return endpoint, faults.chance(endpoint.FailRate)
+}
+
+// chance returns true with the given probability. faults.mu must be held.
+func (faults *Faults) chance(rate float64) bool {
+	if rate <= 0 {
+		return false
+	}
+	if faults.rand == nil {
+		faults.rand = rand.New(rand.NewSource(0))
+	}
+	return faults.rand.Float64() < rate
+}
+
+// wrapListener wraps the listener so its connections are affected by the faults.
+func (faults *Faults) wrapListener(lis net.Listener) net.Listener {
+	return &faultListener{Listener: lis, faults: faults}
+}
+
+// faultListener drops accepted connections and wraps the rest with faultConn.
+type faultListener struct {
+	net.Listener
+	faults *Faults
+}
+
+// Accept waits for and returns the next connection, which wasn't dropped.
+func (lis *faultListener) Accept() (net.Conn, error) {
+	for {
+		conn, err := lis.Listener.Accept()
+		if err != nil {
+			return nil, err
+		}
+		if lis.faults.shouldDrop() {
+			_ = conn.Close()
+			continue
+		}
+		return &faultConn{Conn: conn, faults: lis.faults}, nil
+	}
+}
+
+// faultConn delays and disconnects the connection according to the faults.
+type faultConn struct {
+	net.Conn
+	faults *Faults
+
+	mu          sync.Mutex
+	transferred int64
+}
+
+// Read reads data from the connection.
+func (conn *faultConn) Read(b []byte) (n int, err error) {
+	if err := conn.inject(); err != nil {
+		return 0, err
+	}
+	n, err = conn.Conn.Read(b)
+	conn.add(n)
+	return n, err
+}
+
+// Write writes data to the connection.
+func (conn *faultConn) Write(b []byte) (n int, err error) {
+	if err := conn.inject(); err != nil {
+		return 0, err
+	}
+	n, err = conn.Conn.Write(b)
+	conn.add(n)
+	return n, err
+}
+
+// inject sleeps for the latency and closes the connection, when it has
+// transferred enough data.
+func (conn *faultConn) inject() error {
+	latency, disconnectAfter := conn.faults.current()
+	if latency > 0 {
+		time.Sleep(latency)
+	}
+
+	conn.mu.Lock()
+	transferred := conn.transferred
+	conn.mu.Unlock()
+
+	if disconnectAfter > 0 && transferred >= disconnectAfter {
+		_ = conn.Conn.Close()
+		return ErrInjectedFault.New("disconnected after %d bytes", transferred)
+	}
+	return nil
+}
+
+func (conn *faultConn) add(n int) {
+	conn.mu.Lock()
+	defer conn.mu.Unlock()
+	conn.transferred += int64(n)
+}
+
+// faultHandler injects the endpoint faults into the requests before passing
+// them to the handler.
+type faultHandler struct {
+	handler drpc.Handler
+	faults  *Faults
+}
+
+// HandleRPC handles the rpc after injecting its faults.
+func (handler *faultHandler) HandleRPC(stream drpc.Stream, rpc string) (err error) {
+	if handler.faults == nil {
+		return handler.handler.HandleRPC(stream, rpc)
+	}
+
+	endpoint, fail := handler.faults.endpoint(rpc)
+	if endpoint.Latency > 0 {
+		timer := time.NewTimer(endpoint.Latency)
+		select {
+		case <-timer.C:
+		case <-stream.Context().Done():
+			timer.Stop()
+			return stream.Context().Err()
+		}
+	}
+	if fail {
+		return rpcstatus.Wrap(rpcstatus.Unavailable, ErrInjectedFault.New("%s failed", rpc))
+	}
+	if endpoint.DisconnectAfter > 0 {
+		stream = &faultStream{Stream: stream, rpc: rpc, disconnectAfter: endpoint.DisconnectAfter}
+	}
+	return handler.handler.HandleRPC(stream, rpc)
+}
+
+// faultStream fails the stream after the given amount of messages.
+type faultStream struct {
+	drpc.Stream
+	rpc             string
+	disconnectAfter int
+
+	mu       sync.Mutex
+	messages int
+}
+
+// MsgSend sends the message to the remote.
+func (stream *faultStream) MsgSend(msg drpc.Message, enc drpc.Encoding) error {
+	if err := stream.inject(); err != nil {
+		return err
+	}
+	return stream.Stream.MsgSend(msg, enc)
+}
+
+// MsgRecv receives a message from the remote.
+func (stream *faultStream) MsgRecv(msg drpc.Message, enc drpc.Encoding) error {
+	if err := stream.inject(); err != nil {
+		return err
+	}
+	return stream.Stream.MsgRecv(msg, enc)
+}
+
+// inject fails the stream, when it has transferred enough messages.
+func (stream *faultStream) inject() error {
+	stream.mu.Lock()
+	defer stream.mu.Unlock()
+
+	if stream.messages >= stream.disconnectAfter {
+		return rpcstatus.Wrap(rpcstatus.Unavailable,
+			ErrInjectedFault.New("%s disconnected after %d messages", stream.rpc, stream.messages))
+	}
+	stream.messages++
+	return nil
+}
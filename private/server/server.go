@@ -13,6 +13,7 @@ import (
 	"runtime"
 	"sync"
 	"syscall"
+	"time"
 
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
@@ -29,6 +30,7 @@ import (
 	"storj.io/drpc/drpcmux"
 	"storj.io/drpc/drpcserver"
 	jaeger "storj.io/monkit-jaeger"
+	"storj.io/storj/private/rpcdeadline"
 )
 
 // Config holds server specific configuration parameters.
@@ -54,7 +56,9 @@ type public struct {
 	// http fallback for the public endpoint
 	http http.HandlerFunc
 
-	mux *drpcmux.Mux
+	mux       *drpcmux.Mux
+	faults    *faultHandler
+	deadlines *rpcdeadline.Limits
 }
 
 type private struct {
@@ -75,6 +79,8 @@ type Server struct {
 	wg   sync.WaitGroup
 	once sync.Once
 	done chan struct{}
+
+	faults *Faults
 }
 
 // New creates a Server out of an Identity, a net.Listener,
@@ -124,9 +130,25 @@ func (p *Server) DRPC() *drpcmux.Mux { return p.public.mux }
 // PrivateDRPC returns the server's dRPC mux for registration purposes.
 func (p *Server) PrivateDRPC() *drpcmux.Mux { return p.private.mux }
 
+// SetMaxRequestTimeout sets the maximum time, which the requests to the public
+// service, e.g. "metainfo.Metainfo", are handled. The requests are canceled
+// earlier, when the client sends a shorter timeout. Zero only applies the
+// timeouts of the clients.
+func (p *Server) SetMaxRequestTimeout(service string, timeout time.Duration) {
+	p.public.deadlines.Set(service, timeout)
+}
+
 // IsQUICEnabled checks if QUIC is enabled by config and udp port is open.
 func (p *Server) IsQUICEnabled() bool { return !p.public.disableQUIC && p.public.udpConn != nil }
 
+// TestInjectFaults makes the public listeners inject the faults into the
+// accepted connections and the public endpoints into their requests. It must
+// be called before Run.
+func (p *Server) TestInjectFaults(faults *Faults) {
+	p.faults = faults
+	p.public.faults.faults = faults
+}
+
 // Close shuts down the server.
 func (p *Server) Close() error {
 	p.mu.Lock()
@@ -179,7 +201,11 @@ func (p *Server) Run(ctx context.Context) (err error) {
 		publicHTTPListener net.Listener
 	)
 	if p.public.tcpListener != nil {
-		publicMux = drpcmigrate.NewListenMux(p.public.tcpListener, len(drpcmigrate.DRPCHeader))
+		tcpListener := p.public.tcpListener
+		if p.faults != nil {
+			tcpListener = p.faults.wrapListener(tcpListener)
+		}
+		publicMux = drpcmigrate.NewListenMux(tcpListener, len(drpcmigrate.DRPCHeader))
 		publicDRPCListener = tls.NewListener(publicMux.Route(drpcmigrate.DRPCHeader), p.tlsOptions.ServerTLSConfig())
 
 		if p.public.http != nil {
@@ -240,7 +266,11 @@ func (p *Server) Run(ctx context.Context) (err error) {
 	if p.public.quicListener != nil {
 		group.Go(func() error {
 			defer cancel()
-			return p.public.drpc.Serve(ctx, wrapListener(p.public.quicListener))
+			quicListener := wrapListener(p.public.quicListener)
+			if p.faults != nil {
+				quicListener = p.faults.wrapListener(quicListener)
+			}
+			return p.public.drpc.Serve(ctx, quicListener)
 		})
 	}
 
@@ -321,7 +351,9 @@ func newPublic(publicAddr string, disableTCPTLS, disableQUIC bool) (public, erro
 	}
 
 	publicMux := drpcmux.New()
-	publicTracingHandler := rpctracing.NewHandler(publicMux, jaeger.RemoteTraceHandler)
+	publicFaults := &faultHandler{handler: publicMux}
+	publicDeadlines := rpcdeadline.NewLimits()
+	publicTracingHandler := rpctracing.NewHandler(rpcdeadline.NewHandler(publicFaults, publicDeadlines), jaeger.RemoteTraceHandler)
 
 	serverOptions := drpcserver.Options{
 		Manager: rpc.NewDefaultManagerOptions(),
@@ -342,6 +374,8 @@ func newPublic(publicAddr string, disableTCPTLS, disableQUIC bool) (public, erro
 		addr:          netAddr,
 		drpc:          drpcserver.NewWithOptions(experiment.NewHandler(publicTracingHandler), serverOptions),
 		mux:           publicMux,
+		faults:        publicFaults,
+		deadlines:     publicDeadlines,
 		disableTCPTLS: disableTCPTLS,
 		disableQUIC:   disableQUIC,
 	}, nil
@@ -0,0 +1,8 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package settlementpb contains protobuf definitions for settling the orders
+// of multiple windows at once.
+package settlementpb
+
+//go:generate go run gen.go
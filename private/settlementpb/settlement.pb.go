@@ -0,0 +1,152 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: settlement.proto
+
+package settlementpb
+
+import (
+	fmt "fmt"
+	math "math"
+	time "time"
+
+	proto "github.com/gogo/protobuf/proto"
+
+	pb "storj.io/common/pb"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type SettleWindowsResponse struct {
+	Windows              []*WindowStatus `protobuf:"bytes,1,rep,name=windows,proto3" json:"windows,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *SettleWindowsResponse) Reset()         { *m = SettleWindowsResponse{} }
+func (m *SettleWindowsResponse) String() string { return proto.CompactTextString(m) }
+func (*SettleWindowsResponse) ProtoMessage()    {}
+func (*SettleWindowsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5f2a06c6108c8ef, []int{0}
+}
+func (m *SettleWindowsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SettleWindowsResponse.Unmarshal(m, b)
+}
+func (m *SettleWindowsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SettleWindowsResponse.Marshal(b, m, deterministic)
+}
+func (m *SettleWindowsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SettleWindowsResponse.Merge(m, src)
+}
+func (m *SettleWindowsResponse) XXX_Size() int {
+	return xxx_messageInfo_SettleWindowsResponse.Size(m)
+}
+func (m *SettleWindowsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SettleWindowsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SettleWindowsResponse proto.InternalMessageInfo
+
+func (m *SettleWindowsResponse) GetWindows() []*WindowStatus {
+	if m != nil {
+		return m.Windows
+	}
+	return nil
+}
+
+type WindowStatus struct {
+	Window               time.Time                              `protobuf:"bytes,1,opt,name=window,proto3,stdtime" json:"window"`
+	Status               pb.SettlementWithWindowResponse_Status `protobuf:"varint,2,opt,name=status,proto3,enum=orders.SettlementWithWindowResponse_Status" json:"status,omitempty"`
+	ActionSettled        map[int32]int64                        `protobuf:"bytes,3,rep,name=action_settled,json=actionSettled,proto3" json:"action_settled,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}                               `json:"-"`
+	XXX_unrecognized     []byte                                 `json:"-"`
+	XXX_sizecache        int32                                  `json:"-"`
+}
+
+func (m *WindowStatus) Reset()         { *m = WindowStatus{} }
+func (m *WindowStatus) String() string { return proto.CompactTextString(m) }
+func (*WindowStatus) ProtoMessage()    {}
+func (*WindowStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5f2a06c6108c8ef, []int{1}
+}
+func (m *WindowStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WindowStatus.Unmarshal(m, b)
+}
+func (m *WindowStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WindowStatus.Marshal(b, m, deterministic)
+}
+func (m *WindowStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WindowStatus.Merge(m, src)
+}
+func (m *WindowStatus) XXX_Size() int {
+	return xxx_messageInfo_WindowStatus.Size(m)
+}
+func (m *WindowStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_WindowStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WindowStatus proto.InternalMessageInfo
+
+func (m *WindowStatus) GetWindow() time.Time {
+	if m != nil {
+		return m.Window
+	}
+	return time.Time{}
+}
+
+func (m *WindowStatus) GetStatus() pb.SettlementWithWindowResponse_Status {
+	if m != nil {
+		return m.Status
+	}
+	return pb.SettlementWithWindowResponse_ACCEPTED
+}
+
+func (m *WindowStatus) GetActionSettled() map[int32]int64 {
+	if m != nil {
+		return m.ActionSettled
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*SettleWindowsResponse)(nil), "settlement.SettleWindowsResponse")
+	proto.RegisterType((*WindowStatus)(nil), "settlement.WindowStatus")
+	proto.RegisterMapType((map[int32]int64)(nil), "settlement.WindowStatus.ActionSettledEntry")
+}
+
+func init() { proto.RegisterFile("settlement.proto", fileDescriptor_a5f2a06c6108c8ef) }
+
+var fileDescriptor_a5f2a06c6108c8ef = []byte{
+	// 350 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0xdf, 0x4a, 0xeb, 0x40,
+	0x10, 0xc6, 0xcf, 0x36, 0xb4, 0xe7, 0x30, 0xfd, 0x43, 0x59, 0x8e, 0x10, 0x73, 0xd3, 0x5a, 0x11,
+	0x02, 0x85, 0x0d, 0xc4, 0x1b, 0x11, 0x2f, 0xb4, 0xe2, 0x95, 0x17, 0xc2, 0x56, 0x28, 0x08, 0x22,
+	0xa9, 0x59, 0x63, 0xb4, 0xcd, 0xc6, 0xec, 0xa4, 0xa5, 0x6f, 0xe1, 0x5b, 0xf8, 0x2a, 0x3e, 0x85,
+	0xbe, 0x8a, 0xb8, 0x9b, 0xd8, 0x48, 0xf5, 0x6e, 0xe7, 0xdb, 0x6f, 0x66, 0x7e, 0x1f, 0x03, 0x5d,
+	0x25, 0x10, 0x67, 0x62, 0x2e, 0x12, 0x64, 0x69, 0x26, 0x51, 0x52, 0x58, 0x2b, 0x0e, 0x44, 0x32,
+	0x92, 0x46, 0x77, 0x7a, 0x91, 0x94, 0xd1, 0x4c, 0x78, 0xba, 0x9a, 0xe6, 0x77, 0x1e, 0xc6, 0x73,
+	0xa1, 0x30, 0x98, 0xa7, 0x85, 0xa1, 0x25, 0xb3, 0x50, 0x64, 0xca, 0x54, 0x83, 0x73, 0xd8, 0x1a,
+	0xeb, 0x41, 0x93, 0x38, 0x09, 0xe5, 0x52, 0x71, 0xa1, 0x52, 0x99, 0x28, 0x41, 0x7d, 0xf8, 0xbb,
+	0x34, 0x92, 0x4d, 0xfa, 0x96, 0xdb, 0xf4, 0x6d, 0x56, 0x61, 0x30, 0xee, 0x31, 0x06, 0x98, 0x2b,
+	0x5e, 0x1a, 0x07, 0x2f, 0x35, 0x68, 0x55, 0x7f, 0xe8, 0x11, 0x34, 0xcc, 0x9f, 0x4d, 0xfa, 0xc4,
+	0x6d, 0xfa, 0x0e, 0x33, 0x74, 0xac, 0xa4, 0x63, 0x97, 0x25, 0xdd, 0xe8, 0xdf, 0xeb, 0x5b, 0xef,
+	0xcf, 0xf3, 0x7b, 0x8f, 0xf0, 0xa2, 0x87, 0x9e, 0x42, 0x43, 0xe9, 0x39, 0x76, 0xad, 0x4f, 0xdc,
+	0x8e, 0x3f, 0x64, 0x05, 0xfa, 0xf8, 0x0b, 0x64, 0x12, 0xe3, 0xbd, 0xd9, 0x58, 0x82, 0xb3, 0x02,
+	0xaa, 0x68, 0xa5, 0x1c, 0x3a, 0xc1, 0x2d, 0xc6, 0x32, 0xb9, 0x31, 0xf8, 0xa1, 0x6d, 0xe9, 0x38,
+	0xc3, 0xdf, 0xe2, 0xb0, 0x13, 0x6d, 0x37, 0x3b, 0xc2, 0xb3, 0x04, 0xb3, 0x15, 0x6f, 0x07, 0x55,
+	0xcd, 0x39, 0x06, 0xba, 0x69, 0xa2, 0x5d, 0xb0, 0x1e, 0xc5, 0x4a, 0x27, 0xad, 0xf3, 0xcf, 0x27,
+	0xfd, 0x0f, 0xf5, 0x45, 0x30, 0xcb, 0x85, 0xe6, 0xb7, 0xb8, 0x29, 0x0e, 0x6b, 0x07, 0xc4, 0xbf,
+	0x06, 0x58, 0x87, 0xa0, 0x17, 0xd0, 0xfe, 0x76, 0x04, 0xba, 0xbd, 0x99, 0x94, 0x8b, 0xa7, 0x5c,
+	0x28, 0x74, 0x76, 0xaa, 0xdc, 0x3f, 0x9e, 0xce, 0x25, 0xa3, 0xbd, 0xab, 0x5d, 0x85, 0x32, 0x7b,
+	0x60, 0xb1, 0xf4, 0xf4, 0xc3, 0x4b, 0xb3, 0x78, 0x11, 0xa0, 0xf0, 0xd6, 0xcd, 0xe9, 0x74, 0xda,
+	0xd0, 0x67, 0xd8, 0xff, 0x18, 0x00, 0x3e, 0x54, 0x64, 0x38, 0x5e, 0x02, 0x00, 0x00,
+}
@@ -0,0 +1,160 @@
+// Code generated by protoc-gen-go-drpc. DO NOT EDIT.
+// protoc-gen-go-drpc version: v0.0.32
+// source: settlement.proto
+
+package settlementpb
+
+import (
+	bytes "bytes"
+	context "context"
+	errors "errors"
+
+	jsonpb "github.com/gogo/protobuf/jsonpb"
+	proto "github.com/gogo/protobuf/proto"
+
+	pb "storj.io/common/pb"
+	drpc "storj.io/drpc"
+	drpcerr "storj.io/drpc/drpcerr"
+)
+
+type drpcEncoding_File_settlement_proto struct{}
+
+func (drpcEncoding_File_settlement_proto) Marshal(msg drpc.Message) ([]byte, error) {
+	return proto.Marshal(msg.(proto.Message))
+}
+
+func (drpcEncoding_File_settlement_proto) Unmarshal(buf []byte, msg drpc.Message) error {
+	return proto.Unmarshal(buf, msg.(proto.Message))
+}
+
+func (drpcEncoding_File_settlement_proto) JSONMarshal(msg drpc.Message) ([]byte, error) {
+	var buf bytes.Buffer
+	err := new(jsonpb.Marshaler).Marshal(&buf, msg.(proto.Message))
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (drpcEncoding_File_settlement_proto) JSONUnmarshal(buf []byte, msg drpc.Message) error {
+	return jsonpb.Unmarshal(bytes.NewReader(buf), msg.(proto.Message))
+}
+
+type DRPCSettlementClient interface {
+	DRPCConn() drpc.Conn
+
+	SettleWindows(ctx context.Context) (DRPCSettlement_SettleWindowsClient, error)
+}
+
+type drpcSettlementClient struct {
+	cc drpc.Conn
+}
+
+func NewDRPCSettlementClient(cc drpc.Conn) DRPCSettlementClient {
+	return &drpcSettlementClient{cc}
+}
+
+func (c *drpcSettlementClient) DRPCConn() drpc.Conn { return c.cc }
+
+func (c *drpcSettlementClient) SettleWindows(ctx context.Context) (DRPCSettlement_SettleWindowsClient, error) {
+	stream, err := c.cc.NewStream(ctx, "/settlement.Settlement/SettleWindows", drpcEncoding_File_settlement_proto{})
+	if err != nil {
+		return nil, err
+	}
+	x := &drpcSettlement_SettleWindowsClient{stream}
+	return x, nil
+}
+
+type DRPCSettlement_SettleWindowsClient interface {
+	drpc.Stream
+	Send(*pb.SettlementRequest) error
+	CloseAndRecv() (*SettleWindowsResponse, error)
+}
+
+type drpcSettlement_SettleWindowsClient struct {
+	drpc.Stream
+}
+
+func (x *drpcSettlement_SettleWindowsClient) Send(m *pb.SettlementRequest) error {
+	return x.MsgSend(m, drpcEncoding_File_settlement_proto{})
+}
+
+func (x *drpcSettlement_SettleWindowsClient) CloseAndRecv() (*SettleWindowsResponse, error) {
+	if err := x.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(SettleWindowsResponse)
+	if err := x.MsgRecv(m, drpcEncoding_File_settlement_proto{}); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (x *drpcSettlement_SettleWindowsClient) CloseAndRecvMsg(m *SettleWindowsResponse) error {
+	if err := x.CloseSend(); err != nil {
+		return err
+	}
+	return x.MsgRecv(m, drpcEncoding_File_settlement_proto{})
+}
+
+type DRPCSettlementServer interface {
+	SettleWindows(DRPCSettlement_SettleWindowsStream) error
+}
+
+type DRPCSettlementUnimplementedServer struct{}
+
+func (s *DRPCSettlementUnimplementedServer) SettleWindows(DRPCSettlement_SettleWindowsStream) error {
+	return drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+type DRPCSettlementDescription struct{}
+
+func (DRPCSettlementDescription) NumMethods() int { return 1 }
+
+func (DRPCSettlementDescription) Method(n int) (string, drpc.Encoding, drpc.Receiver, interface{}, bool) {
+	switch n {
+	case 0:
+		return "/settlement.Settlement/SettleWindows", drpcEncoding_File_settlement_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return nil, srv.(DRPCSettlementServer).
+					SettleWindows(
+						&drpcSettlement_SettleWindowsStream{in1.(drpc.Stream)},
+					)
+			}, DRPCSettlementServer.SettleWindows, true
+	default:
+		return "", nil, nil, nil, false
+	}
+}
+
+func DRPCRegisterSettlement(mux drpc.Mux, impl DRPCSettlementServer) error {
+	return mux.Register(impl, DRPCSettlementDescription{})
+}
+
+type DRPCSettlement_SettleWindowsStream interface {
+	drpc.Stream
+	SendAndClose(*SettleWindowsResponse) error
+	Recv() (*pb.SettlementRequest, error)
+}
+
+type drpcSettlement_SettleWindowsStream struct {
+	drpc.Stream
+}
+
+func (x *drpcSettlement_SettleWindowsStream) SendAndClose(m *SettleWindowsResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_settlement_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+func (x *drpcSettlement_SettleWindowsStream) Recv() (*pb.SettlementRequest, error) {
+	m := new(pb.SettlementRequest)
+	if err := x.MsgRecv(m, drpcEncoding_File_settlement_proto{}); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (x *drpcSettlement_SettleWindowsStream) RecvMsg(m *pb.SettlementRequest) error {
+	return x.MsgRecv(m, drpcEncoding_File_settlement_proto{})
+}
@@ -124,6 +124,22 @@ func (bad *BadBlobs) Trash(ctx context.Context, ref storage.BlobRef) error {
 	return bad.blobs.Trash(ctx, ref)
 }
 
+// Quarantine moves the blob with the namespace and key to the quarantine.
+func (bad *BadBlobs) Quarantine(ctx context.Context, ref storage.BlobRef) error {
+	if err := bad.err.Err(); err != nil {
+		return err
+	}
+	return bad.blobs.Quarantine(ctx, ref)
+}
+
+// EmptyQuarantine empties the quarantine.
+func (bad *BadBlobs) EmptyQuarantine(ctx context.Context, namespace []byte, quarantinedBefore time.Time) (int64, error) {
+	if err := bad.err.Err(); err != nil {
+		return 0, err
+	}
+	return bad.blobs.EmptyQuarantine(ctx, namespace, quarantinedBefore)
+}
+
 // RestoreTrash restores all files in the trash.
 func (bad *BadBlobs) RestoreTrash(ctx context.Context, namespace []byte) ([][]byte, error) {
 	if err := bad.err.Err(); err != nil {
@@ -192,6 +208,15 @@ func (bad *BadBlobs) WalkNamespace(ctx context.Context, namespace []byte, walkFu
 	return bad.blobs.WalkNamespace(ctx, namespace, walkFunc)
 }
 
+// WalkNamespaceFrom executes walkFunc for each locally stored blob in the given namespace,
+// whose key is greater than the cursor, in the order of the keys.
+func (bad *BadBlobs) WalkNamespaceFrom(ctx context.Context, namespace, cursor []byte, walkFunc func(storage.BlobInfo) error) error {
+	if err := bad.err.Err(); err != nil {
+		return err
+	}
+	return bad.blobs.WalkNamespaceFrom(ctx, namespace, cursor, walkFunc)
+}
+
 // ListNamespaces returns all namespaces that might be storing data.
 func (bad *BadBlobs) ListNamespaces(ctx context.Context) ([][]byte, error) {
 	if err := bad.err.Err(); err != nil {
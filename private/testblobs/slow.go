@@ -99,6 +99,22 @@ func (slow *SlowBlobs) Trash(ctx context.Context, ref storage.BlobRef) error {
 	return slow.blobs.Trash(ctx, ref)
 }
 
+// Quarantine moves the blob with the namespace and key to the quarantine.
+func (slow *SlowBlobs) Quarantine(ctx context.Context, ref storage.BlobRef) error {
+	if err := slow.sleep(ctx); err != nil {
+		return errs.Wrap(err)
+	}
+	return slow.blobs.Quarantine(ctx, ref)
+}
+
+// EmptyQuarantine empties the quarantine.
+func (slow *SlowBlobs) EmptyQuarantine(ctx context.Context, namespace []byte, quarantinedBefore time.Time) (int64, error) {
+	if err := slow.sleep(ctx); err != nil {
+		return 0, errs.Wrap(err)
+	}
+	return slow.blobs.EmptyQuarantine(ctx, namespace, quarantinedBefore)
+}
+
 // RestoreTrash restores all files in the trash.
 func (slow *SlowBlobs) RestoreTrash(ctx context.Context, namespace []byte) ([][]byte, error) {
 	if err := slow.sleep(ctx); err != nil {
@@ -167,6 +183,15 @@ func (slow *SlowBlobs) WalkNamespace(ctx context.Context, namespace []byte, walk
 	return slow.blobs.WalkNamespace(ctx, namespace, walkFunc)
 }
 
+// WalkNamespaceFrom executes walkFunc for each locally stored blob in the given namespace,
+// whose key is greater than the cursor, in the order of the keys.
+func (slow *SlowBlobs) WalkNamespaceFrom(ctx context.Context, namespace, cursor []byte, walkFunc func(storage.BlobInfo) error) error {
+	if err := slow.sleep(ctx); err != nil {
+		return errs.Wrap(err)
+	}
+	return slow.blobs.WalkNamespaceFrom(ctx, namespace, cursor, walkFunc)
+}
+
 // ListNamespaces returns all namespaces that might be storing data.
 func (slow *SlowBlobs) ListNamespaces(ctx context.Context) ([][]byte, error) {
 	return slow.blobs.ListNamespaces(ctx)
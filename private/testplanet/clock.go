@@ -0,0 +1,59 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package testplanet
+
+import (
+	"sync"
+	"time"
+)
+
+// Clock is a controllable clock used by the time dependent satellite chores.
+//
+// It follows the wall clock, shifted by the total duration it has been
+// advanced with, so tests can move time forward instead of sleeping or
+// reconfiguring tiny intervals.
+type Clock struct {
+	mu     sync.Mutex
+	offset time.Duration
+}
+
+// Now returns the current time of the clock.
+func (clock *Clock) Now() time.Time {
+	clock.mu.Lock()
+	defer clock.mu.Unlock()
+	return time.Now().Add(clock.offset)
+}
+
+// Advance moves the clock forward by the duration.
+func (clock *Clock) Advance(d time.Duration) {
+	if d < 0 {
+		panic("testplanet: clock can't be moved backwards")
+	}
+
+	clock.mu.Lock()
+	defer clock.mu.Unlock()
+	clock.offset += d
+}
+
+// AdvanceTo moves the clock forward to the time, it does nothing when the
+// time has already passed.
+func (clock *Clock) AdvanceTo(t time.Time) {
+	clock.mu.Lock()
+	defer clock.mu.Unlock()
+	if d := time.Until(t) - clock.offset; d > 0 {
+		clock.offset += d
+	}
+}
+
+// useClock makes the time dependent chores of the satellite use the planet clock.
+func (planet *Planet) useClock(system *Satellite) {
+	now := planet.Clock.Now
+
+	system.ZombieDeletion.Chore.TestingSetNow(now)
+	system.ExpiredDeletion.Chore.SetNow(now)
+	system.GarbageCollection.Sender.SetNow(now)
+	system.GarbageCollection.BloomFilters.SetNow(now)
+	system.API.FreezeAccounts.Service.SetNow(now)
+	system.Admin.FreezeAccounts.Service.SetNow(now)
+}
@@ -0,0 +1,57 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package testplanet_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/memory"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/testplanet"
+)
+
+func TestClock(t *testing.T) {
+	var clock testplanet.Clock
+
+	require.WithinDuration(t, time.Now(), clock.Now(), time.Second)
+
+	clock.Advance(time.Hour)
+	require.WithinDuration(t, time.Now().Add(time.Hour), clock.Now(), time.Second)
+
+	clock.AdvanceTo(time.Now())
+	require.WithinDuration(t, time.Now().Add(time.Hour), clock.Now(), time.Second)
+
+	clock.AdvanceTo(time.Now().Add(48 * time.Hour))
+	require.WithinDuration(t, time.Now().Add(48*time.Hour), clock.Now(), time.Second)
+
+	require.Panics(t, func() { clock.Advance(-time.Minute) })
+}
+
+func TestClock_ExpiredDeletion(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		satellite.Core.ExpiredDeletion.Chore.Loop.Pause()
+
+		err := planet.Uplinks[0].UploadWithExpiration(ctx, satellite, "bucket", "object", testrand.Bytes(memory.KiB), time.Now().Add(time.Hour))
+		require.NoError(t, err)
+
+		satellite.Core.ExpiredDeletion.Chore.Loop.TriggerWait()
+		objects, err := satellite.Metabase.DB.TestingAllObjects(ctx)
+		require.NoError(t, err)
+		require.Len(t, objects, 1)
+
+		planet.Clock.Advance(2 * time.Hour)
+
+		satellite.Core.ExpiredDeletion.Chore.Loop.TriggerWait()
+		objects, err = satellite.Metabase.DB.TestingAllObjects(ctx)
+		require.NoError(t, err)
+		require.Len(t, objects, 0)
+	})
+}
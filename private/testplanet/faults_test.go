@@ -0,0 +1,109 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package testplanet_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/memory"
+	"storj.io/common/pb"
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/common/testcontext"
+	"storj.io/storj/private/server"
+	"storj.io/storj/private/testplanet"
+)
+
+func TestStorageNodeFaults(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		node := planet.StorageNodes[0]
+		uplink := planet.Uplinks[0]
+
+		ping := func() (time.Duration, error) {
+			start := time.Now()
+			conn, err := uplink.Dialer.DialNodeURL(ctx, node.NodeURL())
+			if err != nil {
+				return 0, err
+			}
+			defer ctx.Check(conn.Close)
+
+			_, err = pb.NewDRPCContactClient(conn).PingNode(ctx, &pb.ContactPingRequest{})
+			return time.Since(start), err
+		}
+
+		_, err := ping()
+		require.NoError(t, err)
+
+		node.Faults.SetDropRate(1)
+		_, err = ping()
+		require.Error(t, err)
+
+		node.Faults.Reset()
+		node.Faults.SetLatency(100 * time.Millisecond)
+		elapsed, err := ping()
+		require.NoError(t, err)
+		require.GreaterOrEqual(t, elapsed, 100*time.Millisecond)
+
+		node.Faults.Reset()
+		// the TLS handshake alone transfers more than 1KiB.
+		node.Faults.SetDisconnectAfter(memory.KiB.Int64())
+		_, err = ping()
+		require.Error(t, err)
+
+		node.Faults.Reset()
+		_, err = ping()
+		require.NoError(t, err)
+	})
+}
+
+func TestStorageNodeEndpointFaults(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		node := planet.StorageNodes[0]
+		uplink := planet.Uplinks[0]
+
+		conn, err := uplink.Dialer.DialNodeURL(ctx, node.NodeURL())
+		require.NoError(t, err)
+		defer ctx.Check(conn.Close)
+		client := pb.NewDRPCContactClient(conn)
+
+		ping := func() (time.Duration, error) {
+			start := time.Now()
+			_, err := client.PingNode(ctx, &pb.ContactPingRequest{})
+			return time.Since(start), err
+		}
+
+		const rpc = "/contact.Contact/PingNode"
+
+		node.Faults.SetEndpointFaults(rpc, server.EndpointFaults{FailRate: 1})
+		_, err = ping()
+		require.Error(t, err)
+		require.Equal(t, rpcstatus.Unavailable, rpcstatus.Code(err))
+
+		// the faults of other endpoints don't affect the request.
+		node.Faults.SetEndpointFaults(rpc, server.EndpointFaults{})
+		node.Faults.SetEndpointFaults("/piecestore.Piecestore/Upload", server.EndpointFaults{FailRate: 1})
+		_, err = ping()
+		require.NoError(t, err)
+
+		node.Faults.SetEndpointFaults(rpc, server.EndpointFaults{Latency: 100 * time.Millisecond})
+		elapsed, err := ping()
+		require.NoError(t, err)
+		require.GreaterOrEqual(t, elapsed, 100*time.Millisecond)
+
+		// the request is received, but the response isn't sent.
+		node.Faults.SetEndpointFaults(rpc, server.EndpointFaults{DisconnectAfter: 1})
+		_, err = ping()
+		require.Error(t, err)
+
+		node.Faults.Reset()
+		_, err = ping()
+		require.NoError(t, err)
+	})
+}
@@ -0,0 +1,70 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package testplanet
+
+import (
+	"context"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/storj"
+	"storj.io/common/storj/location"
+)
+
+// assignStorageNodeCountries sets the country codes configured with
+// Config.StorageNodeCountries in the overlay of every satellite.
+//
+// The satellite only resolves the country of a node, when it doesn't have
+// one yet or its address changes, hence the assigned countries are kept
+// across check-ins.
+func (planet *Planet) assignStorageNodeCountries(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(planet.config.StorageNodeCountries) == 0 {
+		return nil
+	}
+
+	for _, satellite := range planet.Satellites {
+		for _, node := range planet.StorageNodesOf(satellite) {
+			country := planet.StorageNodeCountry(node)
+			if country == location.None {
+				continue
+			}
+			err := satellite.Overlay.DB.TestNodeCountryCode(ctx, node.ID(), country.String())
+			if err != nil {
+				return errs.Wrap(err)
+			}
+		}
+
+		err := satellite.API.Overlay.Service.UploadSelectionCache.Refresh(ctx)
+		if err != nil {
+			return errs.Wrap(err)
+		}
+	}
+
+	return nil
+}
+
+// StorageNodeCountry returns the country assigned to the storage node with
+// Config.StorageNodeCountries.
+func (planet *Planet) StorageNodeCountry(node *StorageNode) location.CountryCode {
+	for i, storageNode := range planet.StorageNodes {
+		if storageNode == node && i < len(planet.config.StorageNodeCountries) {
+			return location.ToCountryCode(planet.config.StorageNodeCountries[i])
+		}
+	}
+	return location.None
+}
+
+// StorageNodesInPlacement returns the storage nodes which are allowed to store
+// pieces of segments with the placement constraint.
+func (planet *Planet) StorageNodesInPlacement(placement storj.PlacementConstraint) []*StorageNode {
+	var nodes []*StorageNode
+	for _, node := range planet.StorageNodes {
+		if placement.AllowedCountry(planet.StorageNodeCountry(node)) {
+			nodes = append(nodes, node)
+		}
+	}
+	return nodes
+}
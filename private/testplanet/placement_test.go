@@ -0,0 +1,53 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package testplanet_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/memory"
+	"storj.io/common/storj"
+	"storj.io/common/storj/location"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/testplanet"
+)
+
+func TestStorageNodeCountries(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 6, UplinkCount: 1,
+		StorageNodeCountries: []string{"DE", "DE", "DE", "DE", "US", "US"},
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: testplanet.ReconfigureRS(2, 3, 4, 4),
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+
+		for _, node := range planet.StorageNodes {
+			dossier, err := satellite.Overlay.DB.Get(ctx, node.ID())
+			require.NoError(t, err)
+			require.Equal(t, planet.StorageNodeCountry(node), dossier.CountryCode)
+		}
+		require.Equal(t, location.Germany, planet.StorageNodeCountry(planet.StorageNodes[0]))
+
+		euNodes := map[storj.NodeID]bool{}
+		for _, node := range planet.StorageNodesInPlacement(storj.EU) {
+			euNodes[node.ID()] = true
+		}
+		require.Len(t, euNodes, 4)
+
+		require.NoError(t, planet.Uplinks[0].CreateBucketWithPlacement(ctx, satellite, "eu-bucket", storj.EU))
+		require.NoError(t, planet.Uplinks[0].Upload(ctx, satellite, "eu-bucket", "object", testrand.Bytes(10*memory.KiB)))
+
+		segments, err := satellite.Metabase.DB.TestingAllSegments(ctx)
+		require.NoError(t, err)
+		require.Len(t, segments, 1)
+		require.Equal(t, storj.EU, segments[0].Placement)
+		for _, piece := range segments[0].Pieces {
+			require.True(t, euNodes[piece.StorageNode], "piece stored outside of placement")
+		}
+	})
+}
@@ -24,6 +24,7 @@ import (
 	"storj.io/common/identity/testidentity"
 	"storj.io/common/pb"
 	"storj.io/common/storj"
+	"storj.io/common/storj/location"
 	"storj.io/common/testcontext"
 	"storj.io/common/testrand"
 	"storj.io/private/dbutil/pgutil"
@@ -59,6 +60,16 @@ type Config struct {
 	IdentityVersion *storj.IDVersion
 	Reconfigure     Reconfigure
 
+	// StorageNodeCountries assigns ISO country codes (e.g. "DE", "US") to the
+	// storage nodes by index, which allows testing geofencing and placement
+	// aware node selection. Nodes without an entry don't have a country.
+	StorageNodeCountries []string
+
+	// StorageNodeSatellites returns the indexes of the satellites, which the
+	// storage node with the index trusts. When nil, every storage node trusts
+	// every satellite. See SharedNodePool, DistinctNodePools and NodePools.
+	StorageNodeSatellites func(index int) []int
+
 	Name        string
 	Host        string
 	NonParallel bool
@@ -91,6 +102,10 @@ type Planet struct {
 	Multinodes     []*Multinode
 	Uplinks        []*Uplink
 
+	// Clock is used by the time dependent satellite chores, which allows
+	// tests to advance time.
+	Clock *Clock
+
 	identities    *testidentity.Identities
 	whitelistPath string // TODO: in-memory
 
@@ -143,11 +158,21 @@ func NewCustom(ctx *testcontext.Context, log *zap.Logger, config Config, satelli
 		}
 	}
 
+	if len(config.StorageNodeCountries) > config.StorageNodeCount {
+		return nil, errs.New("more storage node countries (%d) than storage nodes (%d)", len(config.StorageNodeCountries), config.StorageNodeCount)
+	}
+	for _, country := range config.StorageNodeCountries {
+		if country != "" && location.ToCountryCode(country) == location.None {
+			return nil, errs.New("invalid storage node country %q", country)
+		}
+	}
+
 	planet := &Planet{
 		ctx:    ctx,
 		log:    log,
 		id:     config.Name + "/" + pgutil.CreateRandomTestingSchemaName(6),
 		config: config,
+		Clock:  &Clock{},
 	}
 
 	if config.Reconfigure.Identities != nil {
@@ -210,8 +235,8 @@ func (planet *Planet) createPeers(ctx context.Context, satelliteDatabases satell
 }
 
 // Start starts all the nodes.
-func (planet *Planet) Start(ctx context.Context) {
-	defer mon.Task()(&ctx)(nil)
+func (planet *Planet) Start(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
 
 	ctx, cancel := context.WithCancel(ctx)
 	planet.cancel = cancel
@@ -249,7 +274,12 @@ func (planet *Planet) Start(ctx context.Context) {
 
 	_ = group.Wait()
 
+	if err := planet.assignStorageNodeCountries(ctx); err != nil {
+		return errs.Wrap(err)
+	}
+
 	planet.started = true
+	return nil
 }
 
 // StopPeer stops a single peer in the planet.
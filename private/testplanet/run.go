@@ -63,7 +63,9 @@ func Run(t *testing.T, config Config, test func(t *testing.T, ctx *testcontext.C
 				}
 				defer ctx.Check(planet.Shutdown)
 
-				planet.Start(ctx)
+				if err := planet.Start(ctx); err != nil {
+					t.Fatalf("%+v", err)
+				}
 				provisionUplinks(ctx, t, planet)
 
 				test(t, ctx, planet)
@@ -112,7 +114,9 @@ func Bench(b *testing.B, config Config, bench func(b *testing.B, ctx *testcontex
 				}
 				defer ctx.Check(planet.Shutdown)
 
-				planet.Start(ctx)
+				if err := planet.Start(ctx); err != nil {
+					b.Fatalf("%+v", err)
+				}
 				provisionUplinks(ctx, b, planet)
 
 				bench(b, ctx, planet)
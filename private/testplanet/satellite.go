@@ -32,6 +32,7 @@ import (
 	"storj.io/storj/satellite/accounting/live"
 	"storj.io/storj/satellite/accounting/nodetally"
 	"storj.io/storj/satellite/accounting/projectbwcleanup"
+	"storj.io/storj/satellite/accounting/projectops"
 	"storj.io/storj/satellite/accounting/rollup"
 	"storj.io/storj/satellite/accounting/rolluparchive"
 	"storj.io/storj/satellite/accounting/tally"
@@ -39,6 +40,7 @@ import (
 	"storj.io/storj/satellite/compensation"
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/console/consoleweb"
+	"storj.io/storj/satellite/console/projectdeletion"
 	"storj.io/storj/satellite/console/userinfo"
 	"storj.io/storj/satellite/contact"
 	"storj.io/storj/satellite/gc/bloomfilter"
@@ -85,12 +87,15 @@ type Satellite struct {
 	Dialer rpc.Dialer
 
 	Server *server.Server
+	// Faults injects network faults into the connections of the API server.
+	Faults *server.Faults
 
 	Version *versionchecker.Service
 
 	Contact struct {
-		Service  *contact.Service
-		Endpoint *contact.Endpoint
+		Service    *contact.Service
+		Endpoint   *contact.Endpoint
+		EndpointV2 *contact.EndpointV2
 	}
 
 	Overlay struct {
@@ -167,6 +172,10 @@ type Satellite struct {
 		Chore *zombiedeletion.Chore
 	}
 
+	ProjectDeletion struct {
+		Chore *projectdeletion.Chore
+	}
+
 	Accounting struct {
 		Tally            *tally.Service
 		NodeTally        *nodetally.Service
@@ -174,6 +183,9 @@ type Satellite struct {
 		ProjectUsage     *accounting.Service
 		ProjectBWCleanup *projectbwcleanup.Chore
 		RollupArchive    *rolluparchive.Chore
+		ProjectOps       *projectops.Recorder
+
+		BandwidthReconciler *live.BandwidthReconciler
 	}
 
 	LiveAccounting struct {
@@ -490,6 +502,8 @@ func (planet *Planet) newSatellite(ctx context.Context, prefix string, index int
 		MaxNumberOfParts: config.Metainfo.MaxNumberOfParts,
 		ServerSideCopy:   config.Metainfo.ServerSideCopy,
 		MultipleVersions: config.Metainfo.MultipleVersions,
+		TallyDeltas:      config.Metainfo.TallyDeltas,
+		KeyManager:       config.Metainfo.MetadataEncryptionKey.KeyManager(),
 	})
 	if err != nil {
 		return nil, err
@@ -523,7 +537,7 @@ func (planet *Planet) newSatellite(ctx context.Context, prefix string, index int
 	rollupsWriteCache := orders.NewRollupsWriteCache(log.Named("orders-write-cache"), db.Orders(), config.Orders.FlushBatchSize)
 	planet.databases = append(planet.databases, rollupsWriteCacheCloser{rollupsWriteCache})
 
-	peer, err := satellite.New(log, identity, db, metabaseDB, revocationDB, liveAccounting, rollupsWriteCache, versionInfo, &config, nil)
+	peer, err := satellite.New(ctx, log, identity, db, metabaseDB, revocationDB, liveAccounting, rollupsWriteCache, versionInfo, &config, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -577,7 +591,10 @@ func (planet *Planet) newSatellite(ctx context.Context, prefix string, index int
 		peer.Mail.EmailReminders.TestSetLinkAddress("http://" + api.Console.Listener.Addr().String() + "/")
 	}
 
-	return createNewSystem(prefix, log, config, peer, api, repairerPeer, auditorPeer, adminPeer, gcPeer, gcBFPeer, rangedLoopPeer), nil
+	system := createNewSystem(prefix, log, config, peer, api, repairerPeer, auditorPeer, adminPeer, gcPeer, gcBFPeer, rangedLoopPeer)
+	planet.useClock(system)
+
+	return system, nil
 }
 
 // createNewSystem makes a new Satellite System and exposes the same interface from
@@ -598,6 +615,8 @@ func createNewSystem(name string, log *zap.Logger, config satellite.Config, peer
 		RangedLoop: rangedLoopPeer,
 	}
 	system.Log = log
+	system.Faults = server.NewFaults(0)
+	api.Server.TestInjectFaults(system.Faults)
 	system.Identity = peer.Identity
 	system.DB = api.DB
 
@@ -605,6 +624,7 @@ func createNewSystem(name string, log *zap.Logger, config satellite.Config, peer
 
 	system.Contact.Service = api.Contact.Service
 	system.Contact.Endpoint = api.Contact.Endpoint
+	system.Contact.EndpointV2 = api.Contact.EndpointV2
 
 	system.Overlay.DB = api.Overlay.DB
 	system.Overlay.Service = api.Overlay.Service
@@ -650,13 +670,16 @@ func createNewSystem(name string, log *zap.Logger, config satellite.Config, peer
 
 	system.ExpiredDeletion.Chore = peer.ExpiredDeletion.Chore
 	system.ZombieDeletion.Chore = peer.ZombieDeletion.Chore
+	system.ProjectDeletion.Chore = peer.ProjectDeletion.Chore
 
 	system.Accounting.Tally = peer.Accounting.Tally
 	system.Accounting.NodeTally = peer.Accounting.NodeTally
 	system.Accounting.Rollup = peer.Accounting.Rollup
 	system.Accounting.ProjectUsage = api.Accounting.ProjectUsage
 	system.Accounting.ProjectBWCleanup = peer.Accounting.ProjectBWCleanupChore
+	system.Accounting.BandwidthReconciler = peer.Accounting.BandwidthReconciler
 	system.Accounting.RollupArchive = peer.Accounting.RollupArchiveChore
+	system.Accounting.ProjectOps = api.ProjectOps.Recorder
 
 	system.LiveAccounting = peer.LiveAccounting
 
@@ -691,7 +714,7 @@ func (planet *Planet) newAPI(ctx context.Context, index int, identity *identity.
 	rollupsWriteCache := orders.NewRollupsWriteCache(log.Named("orders-write-cache"), db.Orders(), config.Orders.FlushBatchSize)
 	planet.databases = append(planet.databases, rollupsWriteCacheCloser{rollupsWriteCache})
 
-	return satellite.NewAPI(log, identity, db, metabaseDB, revocationDB, liveAccounting, rollupsWriteCache, &config, versionInfo, nil)
+	return satellite.NewAPI(ctx, log, identity, db, metabaseDB, revocationDB, liveAccounting, rollupsWriteCache, &config, versionInfo, nil)
 }
 
 func (planet *Planet) newAdmin(ctx context.Context, index int, identity *identity.FullIdentity, db satellite.DB, metabaseDB *metabase.DB, config satellite.Config, versionInfo version.Info) (_ *satellite.Admin, err error) {
@@ -700,7 +723,7 @@ func (planet *Planet) newAdmin(ctx context.Context, index int, identity *identit
 	prefix := "satellite-admin" + strconv.Itoa(index)
 	log := planet.log.Named(prefix)
 
-	return satellite.NewAdmin(log, identity, db, metabaseDB, versionInfo, &config, nil)
+	return satellite.NewAdmin(ctx, log, identity, db, metabaseDB, versionInfo, &config, nil)
 }
 
 func (planet *Planet) newRepairer(ctx context.Context, index int, identity *identity.FullIdentity, db satellite.DB, metabaseDB *metabase.DB, config satellite.Config, versionInfo version.Info) (_ *satellite.Repairer, err error) {
@@ -718,7 +741,7 @@ func (planet *Planet) newRepairer(ctx context.Context, index int, identity *iden
 	rollupsWriteCache := orders.NewRollupsWriteCache(log.Named("orders-write-cache"), db.Orders(), config.Orders.FlushBatchSize)
 	planet.databases = append(planet.databases, rollupsWriteCacheCloser{rollupsWriteCache})
 
-	return satellite.NewRepairer(log, identity, metabaseDB, revocationDB, db.RepairQueue(), db.Buckets(), db.OverlayCache(), db.NodeEvents(), db.Reputation(), db.Containment(), rollupsWriteCache, versionInfo, &config, nil)
+	return satellite.NewRepairer(ctx, log, identity, metabaseDB, revocationDB, db.RepairQueue(), db.Buckets(), db.OverlayCache(), db.NodeEvents(), db.Reputation(), db.Containment(), rollupsWriteCache, versionInfo, &config, nil)
 }
 
 func (planet *Planet) newAuditor(ctx context.Context, index int, identity *identity.FullIdentity, db satellite.DB, metabaseDB *metabase.DB, config satellite.Config, versionInfo version.Info) (_ *satellite.Auditor, err error) {
@@ -736,7 +759,7 @@ func (planet *Planet) newAuditor(ctx context.Context, index int, identity *ident
 	rollupsWriteCache := orders.NewRollupsWriteCache(log.Named("orders-write-cache"), db.Orders(), config.Orders.FlushBatchSize)
 	planet.databases = append(planet.databases, rollupsWriteCacheCloser{rollupsWriteCache})
 
-	return satellite.NewAuditor(log, identity, metabaseDB, revocationDB, db.VerifyQueue(), db.ReverifyQueue(), db.OverlayCache(), db.NodeEvents(), db.Reputation(), db.Containment(), rollupsWriteCache, versionInfo, &config, nil)
+	return satellite.NewAuditor(ctx, log, identity, metabaseDB, revocationDB, db.VerifyQueue(), db.ReverifyQueue(), db.OverlayCache(), db.NodeEvents(), db.Reputation(), db.Containment(), rollupsWriteCache, versionInfo, &config, nil)
 }
 
 type rollupsWriteCacheCloser struct {
@@ -0,0 +1,272 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package testplanet
+
+import (
+	"context"
+	"strings"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/private/dbutil/pgutil"
+	"storj.io/private/tagsql"
+)
+
+// Snapshot is a copy of the satellite databases, which can be restored
+// between subtests instead of setting up a new planet.
+//
+// Only the satellite databases are restored. In-memory state, such as the
+// satellite caches, and the storage node databases and pieces aren't.
+// The upload selection cache is refreshed after restoring.
+//
+// The copy is kept in separate schemas, so it doesn't show up in the
+// satellite databases. They are dropped when the planet is shut down.
+type Snapshot struct {
+	planet *Planet
+	dbs    []*dbSnapshot
+}
+
+// dbSnapshot is a copy of all the tables and sequences of a single database.
+type dbSnapshot struct {
+	db tagsql.DB
+	// schema contains the copies of the tables.
+	schema string
+	// tables are ordered so that tables are listed before the tables
+	// referencing them.
+	tables    []string
+	sequences []sequenceValue
+}
+
+// sequenceValue is the state of a sequence.
+type sequenceValue struct {
+	name      string
+	lastValue int64
+	isCalled  bool
+}
+
+// Snapshot copies the current state of the satellite databases and metabases.
+func (planet *Planet) Snapshot(ctx context.Context) (_ *Snapshot, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	snapshot := &Snapshot{planet: planet}
+	for _, satellite := range planet.Satellites {
+		access, ok := satellite.DB.(interface{ DebugGetDBHandle() tagsql.DB })
+		if !ok {
+			return nil, errs.New("satellite %s database doesn't support snapshots", satellite.Name)
+		}
+
+		for _, db := range []tagsql.DB{access.DebugGetDBHandle(), satellite.Metabase.DB.UnderlyingTagSQL()} {
+			dbSnapshot, err := snapshotDB(ctx, db)
+			if err != nil {
+				return nil, errs.Combine(errs.Wrap(err), snapshot.Close())
+			}
+			snapshot.dbs = append(snapshot.dbs, dbSnapshot)
+		}
+	}
+
+	planet.databases = append(planet.databases, snapshot)
+	return snapshot, nil
+}
+
+// Close drops the copies of the databases.
+func (snapshot *Snapshot) Close() error {
+	var group errs.Group
+	for _, db := range snapshot.dbs {
+		group.Add(pgutil.DropSchema(context.Background(), db.db, db.schema))
+	}
+	snapshot.dbs = nil
+	return errs.Wrap(group.Err())
+}
+
+// Restore resets the satellite databases to the state of the snapshot.
+func (snapshot *Snapshot) Restore(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	for _, db := range snapshot.dbs {
+		if err := db.restore(ctx); err != nil {
+			return errs.Wrap(err)
+		}
+	}
+
+	for _, satellite := range snapshot.planet.Satellites {
+		err := satellite.API.Overlay.Service.UploadSelectionCache.Refresh(ctx)
+		if err != nil {
+			return errs.Wrap(err)
+		}
+	}
+	return nil
+}
+
+func snapshotDB(ctx context.Context, db tagsql.DB) (_ *dbSnapshot, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	tables, err := orderedTables(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	sequences, err := sequenceValues(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+
+	schema := "snapshot_" + pgutil.CreateRandomTestingSchemaName(8)
+	if err := pgutil.CreateSchema(ctx, db, schema); err != nil {
+		return nil, err
+	}
+
+	snapshot := &dbSnapshot{db: db, schema: schema, tables: tables, sequences: sequences}
+	for _, table := range tables {
+		_, err := db.ExecContext(ctx, `CREATE TABLE `+snapshot.copyOf(table)+` AS SELECT * FROM `+pgutil.QuoteIdentifier(table))
+		if err != nil {
+			return nil, errs.Combine(err, pgutil.DropSchema(ctx, db, schema))
+		}
+	}
+
+	return snapshot, nil
+}
+
+// copyOf returns the qualified name of the copy of the table.
+func (snapshot *dbSnapshot) copyOf(table string) string {
+	return pgutil.QuoteSchema(snapshot.schema) + "." + pgutil.QuoteIdentifier(table)
+}
+
+func (snapshot *dbSnapshot) restore(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(snapshot.tables) == 0 {
+		return nil
+	}
+
+	quoted := make([]string, 0, len(snapshot.tables))
+	for _, table := range snapshot.tables {
+		quoted = append(quoted, pgutil.QuoteIdentifier(table))
+	}
+	_, err = snapshot.db.ExecContext(ctx, `TRUNCATE TABLE `+strings.Join(quoted, ", ")+` CASCADE`)
+	if err != nil {
+		return err
+	}
+
+	for _, table := range snapshot.tables {
+		_, err := snapshot.db.ExecContext(ctx, `INSERT INTO `+pgutil.QuoteIdentifier(table)+` SELECT * FROM `+snapshot.copyOf(table))
+		if err != nil {
+			return err
+		}
+	}
+
+	// the sequences are restored, so the restored rows and the rows inserted
+	// afterwards get the same ids as after taking the snapshot.
+	for _, sequence := range snapshot.sequences {
+		_, err := snapshot.db.ExecContext(ctx, `SELECT setval($1, $2, $3)`, sequence.name, sequence.lastValue, sequence.isCalled)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// sequenceValues returns the state of the sequences of the current schema.
+func sequenceValues(ctx context.Context, db tagsql.DB) (_ []sequenceValue, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var names []string
+	rows, err := db.QueryContext(ctx, `
+		SELECT sequence_name FROM information_schema.sequences
+		WHERE sequence_schema = current_schema()
+		ORDER BY sequence_name
+	`)
+	if err != nil {
+		return nil, err
+	}
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, errs.Combine(err, rows.Close())
+		}
+		names = append(names, name)
+	}
+	if err := errs.Combine(rows.Err(), rows.Close()); err != nil {
+		return nil, err
+	}
+
+	sequences := make([]sequenceValue, 0, len(names))
+	for _, name := range names {
+		sequence := sequenceValue{name: pgutil.QuoteIdentifier(name)}
+		err := db.QueryRowContext(ctx, `SELECT last_value, is_called FROM `+sequence.name).
+			Scan(&sequence.lastValue, &sequence.isCalled)
+		if err != nil {
+			return nil, err
+		}
+		sequences = append(sequences, sequence)
+	}
+	return sequences, nil
+}
+
+// orderedTables returns the tables of the current schema ordered so that
+// referenced tables come before the tables referencing them.
+func orderedTables(ctx context.Context, db tagsql.DB) (_ []string, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var tables []string
+	rows, err := db.QueryContext(ctx, `
+		SELECT table_name FROM information_schema.tables
+		WHERE table_schema = current_schema() AND table_type = 'BASE TABLE'
+		ORDER BY table_name
+	`)
+	if err != nil {
+		return nil, err
+	}
+	for rows.Next() {
+		var table string
+		if err := rows.Scan(&table); err != nil {
+			return nil, errs.Combine(err, rows.Close())
+		}
+		tables = append(tables, table)
+	}
+	if err := errs.Combine(rows.Err(), rows.Close()); err != nil {
+		return nil, err
+	}
+
+	references := map[string][]string{}
+	rows, err = db.QueryContext(ctx, `
+		SELECT DISTINCT tc.table_name, ccu.table_name
+		FROM information_schema.table_constraints AS tc
+		JOIN information_schema.constraint_column_usage AS ccu
+			ON tc.constraint_name = ccu.constraint_name AND tc.table_schema = ccu.table_schema
+		WHERE tc.constraint_type = 'FOREIGN KEY' AND tc.table_schema = current_schema()
+	`)
+	if err != nil {
+		return nil, err
+	}
+	for rows.Next() {
+		var table, referenced string
+		if err := rows.Scan(&table, &referenced); err != nil {
+			return nil, errs.Combine(err, rows.Close())
+		}
+		if table != referenced {
+			references[table] = append(references[table], referenced)
+		}
+	}
+	if err := errs.Combine(rows.Err(), rows.Close()); err != nil {
+		return nil, err
+	}
+
+	ordered := make([]string, 0, len(tables))
+	visited := map[string]bool{}
+	var visit func(table string)
+	visit = func(table string) {
+		if visited[table] {
+			return
+		}
+		visited[table] = true
+		for _, referenced := range references[table] {
+			visit(referenced)
+		}
+		ordered = append(ordered, table)
+	}
+	for _, table := range tables {
+		visit(table)
+	}
+
+	return ordered, nil
+}
@@ -0,0 +1,99 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package testplanet_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/memory"
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/private/tagsql"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite/metabase"
+)
+
+func TestSnapshot(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		uplink := planet.Uplinks[0]
+
+		err := uplink.Upload(ctx, satellite, "bucket", "before", testrand.Bytes(memory.KiB))
+		require.NoError(t, err)
+
+		tables := func() []string {
+			db := satellite.DB.(interface{ DebugGetDBHandle() tagsql.DB }).DebugGetDBHandle()
+			rows, err := db.QueryContext(ctx, `
+				SELECT table_name FROM information_schema.tables
+				WHERE table_schema = current_schema()
+				ORDER BY table_name
+			`)
+			require.NoError(t, err)
+			defer func() { require.NoError(t, rows.Close()) }()
+
+			var tables []string
+			for rows.Next() {
+				var table string
+				require.NoError(t, rows.Scan(&table))
+				tables = append(tables, table)
+			}
+			require.NoError(t, rows.Err())
+			return tables
+		}
+
+		tablesBefore := tables()
+
+		snapshot, err := planet.Snapshot(ctx)
+		require.NoError(t, err)
+
+		// the copies aren't kept next to the satellite tables.
+		require.Equal(t, tablesBefore, tables())
+
+		newNode := testrand.NodeID()
+		aliases := map[string]metabase.NodeAlias{}
+		for _, name := range []string{"first", "second"} {
+			t.Run(name, func(t *testing.T) {
+				require.NoError(t, snapshot.Restore(ctx))
+
+				err := uplink.Upload(ctx, satellite, "bucket", name, testrand.Bytes(memory.KiB))
+				require.NoError(t, err)
+				err = uplink.CreateBucket(ctx, satellite, "other")
+				require.NoError(t, err)
+
+				objects, err := satellite.Metabase.DB.TestingAllObjects(ctx)
+				require.NoError(t, err)
+				require.Len(t, objects, 2)
+
+				err = satellite.Metabase.DB.EnsureNodeAliases(ctx, metabase.EnsureNodeAliases{
+					Nodes: []storj.NodeID{newNode},
+				})
+				require.NoError(t, err)
+
+				entries, err := satellite.Metabase.DB.ListNodeAliases(ctx)
+				require.NoError(t, err)
+				for _, entry := range entries {
+					if entry.ID == newNode {
+						aliases[name] = entry.Alias
+					}
+				}
+			})
+		}
+
+		// the sequences are restored as well.
+		require.NotZero(t, aliases["first"])
+		require.Equal(t, aliases["first"], aliases["second"])
+
+		require.NoError(t, snapshot.Restore(ctx))
+
+		objects, err := satellite.Metabase.DB.TestingAllObjects(ctx)
+		require.NoError(t, err)
+		require.Len(t, objects, 1)
+		require.Equal(t, "before", string(objects[0].ObjectKey))
+	})
+}
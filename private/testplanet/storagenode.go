@@ -30,6 +30,7 @@ import (
 	"storj.io/storj/storagenode/collector"
 	"storj.io/storj/storagenode/console/consoleserver"
 	"storj.io/storj/storagenode/contact"
+	"storj.io/storj/storagenode/forgetsatellite"
 	"storj.io/storj/storagenode/gracefulexit"
 	"storj.io/storj/storagenode/monitor"
 	"storj.io/storj/storagenode/nodestats"
@@ -39,6 +40,7 @@ import (
 	"storj.io/storj/storagenode/piecestore"
 	"storj.io/storj/storagenode/preflight"
 	"storj.io/storj/storagenode/retain"
+	"storj.io/storj/storagenode/scrubber"
 	"storj.io/storj/storagenode/storagenodedb/storagenodedbtest"
 	"storj.io/storj/storagenode/trust"
 )
@@ -49,6 +51,9 @@ type StorageNode struct {
 	Config storagenode.Config
 	*storagenode.Peer
 
+	// Faults injects network faults into the connections of the storage node.
+	Faults *server.Faults
+
 	apiKey apikeys.APIKey
 }
 
@@ -72,13 +77,13 @@ func (system *StorageNode) APIKey() string {
 func (planet *Planet) newStorageNodes(ctx context.Context, count int, whitelistedSatellites storj.NodeURLs) (_ []*StorageNode, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var sources []trust.Source
+	var allSources []trust.Source
 	for _, u := range whitelistedSatellites {
 		source, err := trust.NewStaticURLSource(u.String())
 		if err != nil {
 			return nil, err
 		}
-		sources = append(sources, source)
+		allSources = append(allSources, source)
 	}
 
 	var xs []*StorageNode
@@ -87,6 +92,17 @@ func (planet *Planet) newStorageNodes(ctx context.Context, count int, whiteliste
 		prefix := "storage" + strconv.Itoa(index)
 		log := planet.log.Named(prefix)
 
+		sources := allSources
+		if planet.config.StorageNodeSatellites != nil {
+			sources = nil
+			for _, satelliteIndex := range planet.config.StorageNodeSatellites(index) {
+				if satelliteIndex < 0 || satelliteIndex >= len(allSources) {
+					return nil, errs.New("storage node %d trusts unknown satellite %d", index, satelliteIndex)
+				}
+				sources = append(sources, allSources[satelliteIndex])
+			}
+		}
+
 		var system *StorageNode
 		var err error
 		pprof.Do(ctx, pprof.Labels("peer", prefix), func(ctx context.Context) {
@@ -212,6 +228,14 @@ func (planet *Planet) newStorageNode(ctx context.Context, prefix string, index,
 			MinBytesPerSecond:      128 * memory.B,
 			MinDownloadTimeout:     2 * time.Minute,
 		},
+		ForgetSatellite: forgetsatellite.Config{
+			Interval:    defaultInterval,
+			GracePeriod: time.Hour,
+		},
+		Scrubber: scrubber.Config{
+			Interval: defaultInterval,
+			ReadRate: 0,
+		},
 	}
 	if planet.config.Reconfigure.StorageNode != nil {
 		planet.config.Reconfigure.StorageNode(index, &config)
@@ -273,10 +297,14 @@ func (planet *Planet) newStorageNode(ctx context.Context, prefix string, index,
 		return nil, errs.New("error while trying to issue new api key: %v", err)
 	}
 
+	faults := server.NewFaults(int64(index))
+	peer.Server.TestInjectFaults(faults)
+
 	return &StorageNode{
 		Name:   prefix,
 		Config: config,
 		Peer:   peer,
+		Faults: faults,
 		apiKey: apiKey,
 	}, nil
 }
@@ -0,0 +1,82 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package testplanet
+
+// NodePool is a group of consecutive storage nodes, which trust the same satellites.
+type NodePool struct {
+	// Nodes is the number of storage nodes in the pool.
+	Nodes int
+	// Satellites are the indexes of the satellites, which the nodes trust.
+	Satellites []int
+}
+
+// SharedNodePool returns a Config.StorageNodeSatellites, where every storage
+// node trusts every one of the satellites.
+func SharedNodePool(satelliteCount int) func(index int) []int {
+	return func(index int) []int {
+		satellites := make([]int, satelliteCount)
+		for i := range satellites {
+			satellites[i] = i
+		}
+		return satellites
+	}
+}
+
+// DistinctNodePools returns a Config.StorageNodeSatellites, where every
+// storage node trusts only a single satellite. The nodes are distributed
+// evenly between the satellites, node i trusts satellite i % satelliteCount.
+func DistinctNodePools(satelliteCount int) func(index int) []int {
+	return func(index int) []int {
+		return []int{index % satelliteCount}
+	}
+}
+
+// NodePools returns a Config.StorageNodeSatellites, which assigns the storage
+// nodes to the pools in order. The nodes after the last pool don't trust any
+// satellite.
+func NodePools(pools ...NodePool) func(index int) []int {
+	return func(index int) []int {
+		for _, pool := range pools {
+			if index < pool.Nodes {
+				return pool.Satellites
+			}
+			index -= pool.Nodes
+		}
+		return nil
+	}
+}
+
+// TrustedSatellites returns the satellites, which the storage node trusts.
+func (planet *Planet) TrustedSatellites(node *StorageNode) []*Satellite {
+	if planet.config.StorageNodeSatellites == nil {
+		return planet.Satellites
+	}
+
+	for i, storageNode := range planet.StorageNodes {
+		if storageNode != node {
+			continue
+		}
+
+		var satellites []*Satellite
+		for _, satelliteIndex := range planet.config.StorageNodeSatellites(i) {
+			satellites = append(satellites, planet.Satellites[satelliteIndex])
+		}
+		return satellites
+	}
+	return nil
+}
+
+// StorageNodesOf returns the storage nodes, which trust the satellite.
+func (planet *Planet) StorageNodesOf(satellite *Satellite) []*StorageNode {
+	var nodes []*StorageNode
+	for _, node := range planet.StorageNodes {
+		for _, trusted := range planet.TrustedSatellites(node) {
+			if trusted == satellite {
+				nodes = append(nodes, node)
+				break
+			}
+		}
+	}
+	return nodes
+}
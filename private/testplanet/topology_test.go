@@ -0,0 +1,57 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package testplanet_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/private/testplanet"
+)
+
+func TestNodePools(t *testing.T) {
+	require.Equal(t, []int{0, 1, 2}, testplanet.SharedNodePool(3)(5))
+	require.Equal(t, []int{2}, testplanet.DistinctNodePools(3)(5))
+
+	pools := testplanet.NodePools(
+		testplanet.NodePool{Nodes: 2, Satellites: []int{0}},
+		testplanet.NodePool{Nodes: 1, Satellites: []int{0, 1}},
+	)
+	require.Equal(t, []int{0}, pools(1))
+	require.Equal(t, []int{0, 1}, pools(2))
+	require.Nil(t, pools(3))
+}
+
+func TestDistinctNodePools(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 2, StorageNodeCount: 4, UplinkCount: 0,
+		StorageNodeSatellites: testplanet.DistinctNodePools(2),
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		for _, satellite := range planet.Satellites {
+			nodes := planet.StorageNodesOf(satellite)
+			require.Len(t, nodes, 2)
+
+			for _, node := range planet.StorageNodes {
+				_, err := satellite.Overlay.DB.Get(ctx, node.ID())
+				if containsNode(nodes, node) {
+					require.NoError(t, err)
+					require.Len(t, node.Storage2.Trust.GetSatellites(ctx), 1)
+				} else {
+					require.Error(t, err)
+				}
+			}
+		}
+	})
+}
+
+func containsNode(nodes []*testplanet.StorageNode, node *testplanet.StorageNode) bool {
+	for _, n := range nodes {
+		if n == node {
+			return true
+		}
+	}
+	return false
+}
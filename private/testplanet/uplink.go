@@ -366,6 +366,36 @@ func (client *Uplink) CreateBucket(ctx context.Context, satellite *Satellite, bu
 	return nil
 }
 
+// CreateBucketWithPlacement creates a new bucket, which is geofenced with the
+// placement constraint.
+func (client *Uplink) CreateBucketWithPlacement(ctx context.Context, satellite *Satellite, bucketName string, placement storj.PlacementConstraint) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = client.CreateBucket(ctx, satellite, bucketName)
+	if err != nil {
+		return err
+	}
+
+	var projectID uuid.UUID
+	for _, project := range client.Projects {
+		if project.Satellite.ID() == satellite.ID() {
+			projectID = project.ID
+		}
+	}
+	if projectID.IsZero() {
+		return errs.New("uplink doesn't have a project on satellite %s", satellite.ID())
+	}
+
+	bucket, err := satellite.API.Buckets.Service.GetBucket(ctx, []byte(bucketName), projectID)
+	if err != nil {
+		return err
+	}
+	bucket.Placement = placement
+
+	_, err = satellite.API.Buckets.Service.UpdateBucket(ctx, bucket)
+	return err
+}
+
 // DeleteBucket deletes a bucket.
 func (client *Uplink) DeleteBucket(ctx context.Context, satellite *Satellite, bucketName string) (err error) {
 	defer mon.Task()(&ctx)(&err)
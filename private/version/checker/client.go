@@ -9,6 +9,7 @@ import (
 	"encoding/json"
 	"io"
 	"net/http"
+	"net/url"
 	"reflect"
 	"strings"
 	"time"
@@ -18,6 +19,7 @@ import (
 	"golang.org/x/text/cases"
 	"golang.org/x/text/language"
 
+	"storj.io/common/storj"
 	"storj.io/private/version"
 )
 
@@ -32,6 +34,8 @@ var (
 type ClientConfig struct {
 	ServerAddress  string        `help:"server address to check its version against" default:"https://version.storj.io"`
 	RequestTimeout time.Duration `help:"Request timeout for version checks" default:"0h1m0s"`
+	PublicKey      string        `help:"hex-encoded ed25519 public key of the release signing key, when set downloaded binaries must match the signed release manifest" default:""`
+	MaxManifestAge time.Duration `help:"release manifests issued longer ago are rejected, 0 accepts manifests of any age" default:"720h0m0s"`
 }
 
 // Client defines helper methods for using version control server response data.
@@ -52,34 +56,95 @@ func New(config ClientConfig) *Client {
 func (client *Client) All(ctx context.Context) (ver version.AllowedVersions, err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	body, err := client.get(ctx, client.config.ServerAddress)
+	if err != nil {
+		return version.AllowedVersions{}, err
+	}
+
+	err = json.NewDecoder(bytes.NewReader(body)).Decode(&ver)
+	return ver, Error.Wrap(err)
+}
+
+// ReleaseManifest returns the release manifest served by the version control
+// server, after verifying its signature with the configured public key.
+func (client *Client) ReleaseManifest(ctx context.Context) (_ ReleaseManifest, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if client.config.PublicKey == "" {
+		return ReleaseManifest{}, Error.New("public key of the release signing key is not configured")
+	}
+	publicKey, err := ParsePublicKey(client.config.PublicKey)
+	if err != nil {
+		return ReleaseManifest{}, err
+	}
+
+	body, err := client.get(ctx, strings.TrimSuffix(client.config.ServerAddress, "/")+"/manifest")
+	if err != nil {
+		return ReleaseManifest{}, err
+	}
+
+	var signed SignedManifest
+	if err := json.Unmarshal(body, &signed); err != nil {
+		return ReleaseManifest{}, Error.Wrap(err)
+	}
+
+	return VerifyManifest(publicKey, signed, client.config.MaxManifestAge, time.Now())
+}
+
+// NodeVersion is the version a specific node should run.
+type NodeVersion struct {
+	version.Version
+	// Pinned is true when the version has been pinned for the node,
+	// rather than selected by the rollout.
+	Pinned bool `json:"pinned"`
+}
+
+// NodeVersion returns the version the node should run of the named process,
+// taking the version the node is pinned to into account.
+func (client *Client) NodeVersion(ctx context.Context, processName string, nodeID storj.NodeID) (_ NodeVersion, err error) {
+	defer mon.Task()(&ctx, processName)(&err)
+
+	body, err := client.get(ctx, strings.TrimSuffix(client.config.ServerAddress, "/")+
+		"/processes/"+url.PathEscape(processName)+"/nodes/"+nodeID.String()+"/version")
+	if err != nil {
+		return NodeVersion{}, err
+	}
+
+	var nodeVersion NodeVersion
+	if err := json.Unmarshal(body, &nodeVersion); err != nil {
+		return NodeVersion{}, Error.Wrap(err)
+	}
+	return nodeVersion, nil
+}
+
+// get returns the body of a successful response from the url.
+func (client *Client) get(ctx context.Context, url string) (_ []byte, err error) {
 	// Tune Client to have a custom Timeout (reduces hanging software)
 	httpClient := http.Client{
 		Timeout: client.config.RequestTimeout,
 	}
 
 	// New Request that used the passed in context
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, client.config.ServerAddress, nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
 	if err != nil {
-		return version.AllowedVersions{}, Error.Wrap(err)
+		return nil, Error.Wrap(err)
 	}
 
 	resp, err := httpClient.Do(req)
 	if err != nil {
-		return version.AllowedVersions{}, Error.Wrap(err)
+		return nil, Error.Wrap(err)
 	}
 
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return version.AllowedVersions{}, Error.Wrap(err)
+		return nil, Error.Wrap(err)
 	}
 	defer func() { _ = resp.Body.Close() }()
 
 	if resp.StatusCode != http.StatusOK {
-		return version.AllowedVersions{}, Error.New("non-success http status code: %d; body: %s\n", resp.StatusCode, body)
+		return nil, Error.New("non-success http status code: %d; body: %s\n", resp.StatusCode, body)
 	}
-
-	err = json.NewDecoder(bytes.NewReader(body)).Decode(&ver)
-	return ver, Error.Wrap(err)
+	return body, nil
 }
 
 // OldMinimum returns the version with the given name at the root-level of the version control response.
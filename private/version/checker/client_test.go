@@ -4,15 +4,21 @@
 package checker_test
 
 import (
+	"crypto/ed25519"
+	"crypto/sha256"
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
+	"os"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap/zaptest"
 
 	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
 	"storj.io/private/version"
 	"storj.io/storj/private/version/checker"
 	"storj.io/storj/versioncontrol"
@@ -24,7 +30,7 @@ func TestClient_All(t *testing.T) {
 	ctx := testcontext.New(t)
 	defer ctx.Cleanup()
 
-	peer := newTestPeer(t, ctx)
+	peer := newTestPeer(t, ctx, "")
 	defer ctx.Check(peer.Close)
 
 	clientConfig := checker.ClientConfig{
@@ -55,7 +61,7 @@ func TestClient_Process(t *testing.T) {
 	ctx := testcontext.New(t)
 	defer ctx.Cleanup()
 
-	peer := newTestPeer(t, ctx)
+	peer := newTestPeer(t, ctx, "")
 	defer ctx.Check(peer.Close)
 
 	clientConfig := checker.ClientConfig{
@@ -86,7 +92,83 @@ func TestClient_Process(t *testing.T) {
 	}
 }
 
-func newTestPeer(t *testing.T, ctx *testcontext.Context) *versioncontrol.Peer {
+func TestClient_ReleaseManifest(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	seed := testrand.BytesInt(ed25519.SeedSize)
+	privateKey, err := checker.ParsePrivateKey(hex.EncodeToString(seed))
+	require.NoError(t, err)
+	publicKey := hex.EncodeToString(privateKey.Public().(ed25519.PublicKey))
+
+	const url = "https://example.test/v1.0.0/storagenode_linux_amd64.zip"
+	archiveHash := sha256.Sum256([]byte("archive"))
+
+	writeManifest := func(issuedAt time.Time) string {
+		signed, err := checker.SignManifest(privateKey, checker.ReleaseManifest{
+			IssuedAt: issuedAt,
+			Binaries: map[string]string{url: hex.EncodeToString(archiveHash[:])},
+		})
+		require.NoError(t, err)
+
+		data, err := json.Marshal(signed)
+		require.NoError(t, err)
+
+		path := ctx.File(fmt.Sprintf("manifest-%d.json", issuedAt.UnixNano()))
+		require.NoError(t, os.WriteFile(path, data, 0644))
+		return path
+	}
+
+	current := newTestPeer(t, ctx, writeManifest(time.Now()))
+	defer ctx.Check(current.Close)
+
+	outdated := newTestPeer(t, ctx, writeManifest(time.Now().Add(-48*time.Hour)))
+	defer ctx.Check(outdated.Close)
+
+	missing := newTestPeer(t, ctx, "")
+	defer ctx.Check(missing.Close)
+
+	otherKey := hex.EncodeToString(testrand.BytesInt(ed25519.PublicKeySize))
+
+	for _, tt := range []struct {
+		name      string
+		peer      *versioncontrol.Peer
+		publicKey string
+		ok        bool
+	}{
+		{"signed", current, publicKey, true},
+		{"outdated", outdated, publicKey, false},
+		{"missing", missing, publicKey, false},
+		{"wrong key", current, otherKey, false},
+		{"invalid key", current, "invalid", false},
+		{"no key", current, "", false},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			client := checker.New(checker.ClientConfig{
+				ServerAddress:  "http://" + tt.peer.Addr(),
+				PublicKey:      tt.publicKey,
+				MaxManifestAge: 24 * time.Hour,
+			})
+
+			manifest, err := client.ReleaseManifest(ctx)
+			if !tt.ok {
+				require.Error(t, err)
+				require.True(t, checker.Error.Has(err))
+				return
+			}
+			require.NoError(t, err)
+
+			require.NoError(t, manifest.VerifyBinary(url, archiveHash[:]))
+
+			otherHash := sha256.Sum256([]byte("other"))
+			require.Error(t, manifest.VerifyBinary(url, otherHash[:]))
+			require.Error(t, manifest.VerifyBinary(url+".old", archiveHash[:]))
+		})
+	}
+}
+
+func newTestPeer(t *testing.T, ctx *testcontext.Context, releaseManifest string) *versioncontrol.Peer {
 	t.Helper()
 
 	testVersions := newTestVersions(t)
@@ -99,7 +181,8 @@ func newTestPeer(t *testing.T, ctx *testcontext.Context) *versioncontrol.Peer {
 			Gateway:     "v0.0.1",
 			Identity:    "v0.0.1",
 		},
-		Binary: testVersions,
+		Binary:          testVersions,
+		ReleaseManifest: releaseManifest,
 	}
 	peer, err := versioncontrol.New(zaptest.NewLogger(t), serverConfig)
 	require.NoError(t, err)
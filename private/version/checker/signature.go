@@ -0,0 +1,117 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package checker
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+	"time"
+)
+
+// ReleaseManifest lists the hashes of the released binary archives. It's
+// signed offline with the release key, the version server only serves it.
+type ReleaseManifest struct {
+	// IssuedAt is the time the manifest was signed at, older manifests are
+	// rejected to prevent serving outdated releases.
+	IssuedAt time.Time `json:"issuedAt"`
+	// Binaries contains the hex-encoded sha256 hashes of the archives by
+	// their download url.
+	Binaries map[string]string `json:"binaries"`
+}
+
+// SignedManifest is a release manifest together with its signature.
+type SignedManifest struct {
+	// Manifest contains the encoded ReleaseManifest, which is signed as is.
+	Manifest json.RawMessage `json:"manifest"`
+	// Signature is the base64-encoded ed25519 signature of Manifest.
+	Signature string `json:"signature"`
+}
+
+// ParsePublicKey parses a hex-encoded ed25519 public key.
+func ParsePublicKey(s string) (ed25519.PublicKey, error) {
+	key, err := hex.DecodeString(strings.TrimSpace(s))
+	if err != nil {
+		return nil, Error.New("invalid public key: %v", err)
+	}
+	if len(key) != ed25519.PublicKeySize {
+		return nil, Error.New("invalid public key length: %d", len(key))
+	}
+	return ed25519.PublicKey(key), nil
+}
+
+// ParsePrivateKey parses a hex-encoded ed25519 private key seed.
+func ParsePrivateKey(s string) (ed25519.PrivateKey, error) {
+	seed, err := hex.DecodeString(strings.TrimSpace(s))
+	if err != nil {
+		return nil, Error.New("invalid private key: %v", err)
+	}
+	if len(seed) != ed25519.SeedSize {
+		return nil, Error.New("invalid private key length: %d", len(seed))
+	}
+	return ed25519.NewKeyFromSeed(seed), nil
+}
+
+// SignManifest encodes and signs the manifest.
+func SignManifest(key ed25519.PrivateKey, manifest ReleaseManifest) (SignedManifest, error) {
+	data, err := json.Marshal(manifest)
+	if err != nil {
+		return SignedManifest{}, Error.Wrap(err)
+	}
+	return SignedManifest{
+		Manifest:  data,
+		Signature: base64.StdEncoding.EncodeToString(ed25519.Sign(key, data)),
+	}, nil
+}
+
+// VerifyManifest checks the signature of the manifest and returns the
+// decoded manifest. Manifests issued more than maxAge ago are rejected, when
+// maxAge is positive.
+func VerifyManifest(key ed25519.PublicKey, signed SignedManifest, maxAge time.Duration, now time.Time) (ReleaseManifest, error) {
+	if signed.Signature == "" {
+		return ReleaseManifest{}, Error.New("manifest is not signed")
+	}
+	signature, err := base64.StdEncoding.DecodeString(signed.Signature)
+	if err != nil {
+		return ReleaseManifest{}, Error.New("invalid signature: %v", err)
+	}
+	if !ed25519.Verify(key, signed.Manifest, signature) {
+		return ReleaseManifest{}, Error.New("signature verification failed")
+	}
+
+	var manifest ReleaseManifest
+	if err := json.Unmarshal(signed.Manifest, &manifest); err != nil {
+		return ReleaseManifest{}, Error.Wrap(err)
+	}
+
+	if manifest.IssuedAt.IsZero() {
+		return ReleaseManifest{}, Error.New("manifest doesn't have an issue time")
+	}
+	if maxAge > 0 && now.Sub(manifest.IssuedAt) > maxAge {
+		return ReleaseManifest{}, Error.New("manifest was issued at %s, which is more than %s ago", manifest.IssuedAt, maxAge)
+	}
+	return manifest, nil
+}
+
+// VerifyBinary checks that the sha256 hash of the archive downloaded from the
+// url matches the manifest.
+func (manifest *ReleaseManifest) VerifyBinary(url string, hash []byte) error {
+	expected, ok := manifest.Binaries[url]
+	if !ok {
+		return Error.New("binary %q is not part of the release manifest", url)
+	}
+
+	expectedHash, err := hex.DecodeString(expected)
+	if err != nil {
+		return Error.New("invalid hash of binary %q: %v", url, err)
+	}
+	if len(hash) != sha256.Size || !bytes.Equal(expectedHash, hash) {
+		return Error.New("hash mismatch of binary %q", url)
+	}
+	return nil
+}
@@ -0,0 +1,112 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package accesslog implements customer facing access logs of the objects of
+// a project, which are exported to a bucket of the customer.
+package accesslog
+
+import (
+	"context"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/metabase"
+)
+
+var (
+	// Error is the error class for access logs.
+	Error = errs.Class("accesslog")
+	// ErrExportNotFound is returned when the project has no access log export.
+	ErrExportNotFound = errs.Class("access log export not found")
+	// ErrExportExists is returned when the project already has an access log export.
+	ErrExportExists = errs.Class("access log export already exists")
+
+	mon = monkit.Package()
+)
+
+// Operation is the kind of access to an object.
+type Operation string
+
+const (
+	// GetObject is the download of an object.
+	GetObject Operation = "GetObject"
+	// HeadObject is the retrieval of the metadata of an object.
+	HeadObject Operation = "HeadObject"
+	// PutObject is the upload of an object.
+	PutObject Operation = "PutObject"
+	// DeleteObject is the deletion of an object.
+	DeleteObject Operation = "DeleteObject"
+)
+
+// Entry is an access to an object.
+type Entry struct {
+	ProjectID uuid.UUID
+	Time      time.Time
+	ID        uuid.UUID
+
+	// APIKeyID is the API key used for the access.
+	APIKeyID   uuid.UUID
+	BucketName string
+	ObjectKey  metabase.ObjectKey
+	Operation  Operation
+	// Bytes is the number of plain bytes downloaded or uploaded.
+	Bytes int64
+}
+
+// DestinationAccessPurpose is the purpose of the sealed destination access
+// grant of an export.
+const DestinationAccessPurpose = "accesslog-destination"
+
+// Export configures the export of the access logs of a project to a bucket.
+//
+// The access grant must allow uploading to the destination bucket. The
+// destination may be in another project or on another satellite. The access
+// grant is stored sealed with sealedaccess, see DestinationAccessPurpose.
+type Export struct {
+	ProjectID         uuid.UUID
+	DestinationAccess string
+	DestinationBucket string
+	// Prefix is prepended to the names of the exported log objects.
+	Prefix string
+
+	CreatedAt time.Time
+	Status    Status
+}
+
+// Status is the progress of the export of the access logs of a project.
+type Status struct {
+	// ExportedUntil is the time up to which the entries have been exported.
+	ExportedUntil time.Time
+	// LastRunAt is when the access logs have been exported the last time.
+	LastRunAt *time.Time
+	// LastError is the error of the last run, when it has failed.
+	LastError string
+}
+
+// DB stores the access log entries and exports.
+//
+// architecture: Database
+type DB interface {
+	// CreateExport adds an export. Only accesses after its creation are
+	// exported.
+	CreateExport(ctx context.Context, export Export) error
+	// GetExport returns the export of the project.
+	GetExport(ctx context.Context, projectID uuid.UUID) (Export, error)
+	// DeleteExport removes the export and the pending entries of the project.
+	DeleteExport(ctx context.Context, projectID uuid.UUID) error
+	// ListExports returns all the exports.
+	ListExports(ctx context.Context) ([]Export, error)
+	// UpdateExportStatus updates the status of the export of the project.
+	UpdateExportStatus(ctx context.Context, projectID uuid.UUID, status Status) error
+
+	// InsertEntries adds access log entries.
+	InsertEntries(ctx context.Context, entries []Entry) error
+	// ListEntries returns at most limit entries of the project after from and
+	// up to until, in the order of their time.
+	ListEntries(ctx context.Context, projectID uuid.UUID, from, until time.Time, limit int) ([]Entry, error)
+	// DeleteEntries removes the entries of the project up to until.
+	DeleteEntries(ctx context.Context, projectID uuid.UUID, until time.Time) error
+}
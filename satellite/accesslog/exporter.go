@@ -0,0 +1,249 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package accesslog
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/sync2"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/sealedaccess"
+	"storj.io/uplink"
+)
+
+// exportTimeFormat is the format of the time in the names of the exported
+// log objects. It sorts in the order of time.
+const exportTimeFormat = "2006-01-02T15-04-05.000000000Z"
+
+// Exporter uploads the access log entries of the projects to their
+// destination bucket as newline delimited json and removes them afterwards.
+//
+// architecture: Chore
+type Exporter struct {
+	log    *zap.Logger
+	db     DB
+	sealer *sealedaccess.Sealer
+	config Config
+	Loop   *sync2.Cycle
+
+	nowFn func() time.Time
+}
+
+// NewExporter creates a new access log exporter.
+func NewExporter(log *zap.Logger, db DB, sealer *sealedaccess.Sealer, config Config) *Exporter {
+	return &Exporter{
+		log:    log,
+		db:     db,
+		sealer: sealer,
+		config: config,
+		Loop:   sync2.NewCycle(config.ExportInterval),
+
+		nowFn: time.Now,
+	}
+}
+
+// Run exports the access logs until ctx is canceled.
+func (exporter *Exporter) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if !exporter.config.Enabled {
+		return nil
+	}
+
+	return exporter.Loop.Run(ctx, func(ctx context.Context) error {
+		if err := exporter.RunOnce(ctx); err != nil {
+			exporter.log.Error("failed to export access logs", zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// SetNow allows tests to have the exporter act as if the current time is
+// whatever they want.
+func (exporter *Exporter) SetNow(nowFn func() time.Time) {
+	exporter.nowFn = nowFn
+}
+
+// RunOnce exports the access logs of all projects with an export. Failures
+// of a single project are recorded in its status.
+func (exporter *Exporter) RunOnce(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	exports, err := exporter.db.ListExports(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	var failed int64
+	for _, export := range exports {
+		status := exporter.export(ctx, export)
+		if status.LastError != "" {
+			failed++
+		}
+
+		err := exporter.db.UpdateExportStatus(ctx, export.ProjectID, status)
+		if err != nil {
+			exporter.log.Error("failed to update access log export status",
+				zap.Stringer("Project ID", export.ProjectID),
+				zap.Error(err))
+		}
+	}
+
+	mon.IntVal("accesslog_exports").Observe(int64(len(exports)))
+	mon.IntVal("accesslog_failed_exports").Observe(failed)
+
+	return ctx.Err()
+}
+
+// export exports the pending entries of the project and returns the updated
+// status.
+func (exporter *Exporter) export(ctx context.Context, export Export) (status Status) {
+	defer mon.Task()(&ctx)(nil)
+
+	status = export.Status
+	now := exporter.nowFn()
+	status.LastRunAt = &now
+	status.LastError = ""
+
+	until, err := exporter.exportEntries(ctx, export, now.Add(-exporter.config.ExportDelay))
+	if err != nil {
+		exporter.log.Warn("failed to export access logs",
+			zap.Stringer("Project ID", export.ProjectID),
+			zap.Error(err))
+		status.LastError = err.Error()
+		return status
+	}
+
+	status.ExportedUntil = until
+	return status
+}
+
+// exportEntries uploads the entries up to until as a single object and
+// removes them. It returns the time up to which the entries are exported.
+func (exporter *Exporter) exportEntries(ctx context.Context, export Export, until time.Time) (_ time.Time, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	from := export.Status.ExportedUntil
+	if !from.Before(until) {
+		return from, nil
+	}
+
+	entries, err := exporter.db.ListEntries(ctx, export.ProjectID, from, until, exporter.config.MaxEntriesPerExport)
+	if err != nil {
+		return from, Error.Wrap(err)
+	}
+	entries, until = exportBatch(entries, until, exporter.config.MaxEntriesPerExport)
+
+	if len(entries) > 0 {
+		data, err := encodeEntries(entries)
+		if err != nil {
+			return from, Error.Wrap(err)
+		}
+
+		err = exporter.upload(ctx, export, export.Prefix+until.UTC().Format(exportTimeFormat)+".jsonl", data)
+		if err != nil {
+			return from, Error.New("uploading: %w", err)
+		}
+
+		mon.IntVal("accesslog_exported_entries").Observe(int64(len(entries)))
+	}
+
+	err = exporter.db.DeleteEntries(ctx, export.ProjectID, until)
+	if err != nil {
+		return from, Error.Wrap(err)
+	}
+	return until, nil
+}
+
+// exportBatch returns the entries to export and the time up to which they
+// are exported. When the entries are limited, the entries of the last time
+// are left to the next export, so no entry is skipped.
+func exportBatch(entries []Entry, until time.Time, limit int) ([]Entry, time.Time) {
+	if len(entries) == 0 || len(entries) < limit {
+		return entries, until
+	}
+
+	last := entries[len(entries)-1].Time
+	for i := len(entries) - 1; i >= 0; i-- {
+		if entries[i].Time.Before(last) {
+			return entries[:i+1], entries[i].Time
+		}
+	}
+	return entries, last
+}
+
+// entryLine is the json representation of an exported entry.
+type entryLine struct {
+	Time         time.Time `json:"time"`
+	ID           uuid.UUID `json:"id"`
+	APIKeyID     uuid.UUID `json:"apiKeyId"`
+	Bucket       string    `json:"bucket"`
+	EncryptedKey []byte    `json:"encryptedKey"`
+	Operation    Operation `json:"operation"`
+	Bytes        int64     `json:"bytes"`
+}
+
+// encodeEntries encodes the entries as newline delimited json.
+func encodeEntries(entries []Entry) ([]byte, error) {
+	var buffer bytes.Buffer
+	encoder := json.NewEncoder(&buffer)
+	for _, entry := range entries {
+		err := encoder.Encode(entryLine{
+			Time:         entry.Time,
+			ID:           entry.ID,
+			APIKeyID:     entry.APIKeyID,
+			Bucket:       entry.BucketName,
+			EncryptedKey: []byte(entry.ObjectKey),
+			Operation:    entry.Operation,
+			Bytes:        entry.Bytes,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return buffer.Bytes(), nil
+}
+
+// upload uploads the data to the destination bucket of the export.
+func (exporter *Exporter) upload(ctx context.Context, export Export, key string, data []byte) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	serializedAccess, err := exporter.sealer.Open(export.ProjectID, DestinationAccessPurpose, export.DestinationAccess)
+	if err != nil {
+		return err
+	}
+
+	access, err := uplink.ParseAccess(serializedAccess)
+	if err != nil {
+		return err
+	}
+
+	project, err := uplink.OpenProject(ctx, access)
+	if err != nil {
+		return err
+	}
+	defer func() { err = errs.Combine(err, project.Close()) }()
+
+	upload, err := project.UploadObject(ctx, export.DestinationBucket, key, nil)
+	if err != nil {
+		return err
+	}
+
+	if _, err := upload.Write(data); err != nil {
+		return errs.Combine(err, upload.Abort())
+	}
+	return upload.Commit()
+}
+
+// Close stops the exporter.
+func (exporter *Exporter) Close() error {
+	exporter.Loop.Close()
+	return nil
+}
@@ -0,0 +1,89 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package accesslog
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite/sealedaccess"
+)
+
+func TestExportBatch(t *testing.T) {
+	start := time.Date(2023, 3, 1, 10, 0, 0, 0, time.UTC)
+	until := start.Add(time.Hour)
+	at := func(seconds ...int) (entries []Entry) {
+		for _, s := range seconds {
+			entries = append(entries, Entry{Time: start.Add(time.Duration(s) * time.Second)})
+		}
+		return entries
+	}
+
+	entries, exportedUntil := exportBatch(nil, until, 3)
+	require.Empty(t, entries)
+	require.Equal(t, until, exportedUntil)
+
+	entries, exportedUntil = exportBatch(at(1, 2), until, 3)
+	require.Len(t, entries, 2)
+	require.Equal(t, until, exportedUntil)
+
+	// the entries of the last time may continue in the next batch.
+	entries, exportedUntil = exportBatch(at(1, 2, 2), until, 3)
+	require.Len(t, entries, 1)
+	require.Equal(t, start.Add(time.Second), exportedUntil)
+
+	// all entries have the same time, so there's nothing better to do.
+	entries, exportedUntil = exportBatch(at(2, 2, 2), until, 3)
+	require.Len(t, entries, 3)
+	require.Equal(t, start.Add(2*time.Second), exportedUntil)
+}
+
+func TestEncodeEntries(t *testing.T) {
+	entries := []Entry{
+		{BucketName: "a", ObjectKey: "key-1", Operation: GetObject, Bytes: 10},
+		{BucketName: "b", ObjectKey: "key-2", Operation: DeleteObject},
+	}
+
+	data, err := encodeEntries(entries)
+	require.NoError(t, err)
+
+	lines := bytes.Split(bytes.TrimSpace(data), []byte("\n"))
+	require.Len(t, lines, 2)
+
+	var line entryLine
+	require.NoError(t, json.Unmarshal(lines[0], &line))
+	require.Equal(t, "a", line.Bucket)
+	require.Equal(t, []byte("key-1"), line.EncryptedKey)
+	require.Equal(t, GetObject, line.Operation)
+	require.EqualValues(t, 10, line.Bytes)
+}
+
+func TestExporterRequiresSealedAccess(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	var config sealedaccess.Config
+	require.NoError(t, config.Key.Set(hex.EncodeToString(testrand.BytesInt(32))))
+	sealer, err := sealedaccess.NewSealer(config)
+	require.NoError(t, err)
+
+	exporter := NewExporter(zaptest.NewLogger(t), nil, sealer, Config{})
+	defer ctx.Check(exporter.Close)
+
+	// the access grants are never used as stored in plain.
+	err = exporter.upload(ctx, Export{
+		ProjectID:         testrand.UUID(),
+		DestinationAccess: "plain-access-grant",
+		DestinationBucket: "audit",
+	}, "key", nil)
+	require.Error(t, err)
+	require.True(t, sealedaccess.Error.Has(err))
+}
@@ -0,0 +1,175 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package accesslog
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/lrucache"
+	"storj.io/common/uuid"
+)
+
+// Config contains configurable values for access logs.
+type Config struct {
+	Enabled         bool          `help:"whether the accesses to the objects of projects with an access log export are logged" default:"false"`
+	BufferSize      int           `help:"number of access log entries buffered, before new entries are dropped" default:"10000"`
+	FlushInterval   time.Duration `help:"how often the buffered access log entries are stored" default:"10s"`
+	CacheCapacity   int           `help:"number of projects, whose access log export is cached" default:"10000"`
+	CacheExpiration time.Duration `help:"how long the access log export of a project is cached" default:"1m"`
+
+	ExportInterval      time.Duration `help:"how often the access logs are exported" releaseDefault:"1h" devDefault:"5m" testDefault:"$TESTINTERVAL"`
+	ExportDelay         time.Duration `help:"how long access log entries are held back from the export, so buffered entries aren't missed" default:"1m"`
+	MaxEntriesPerExport int           `help:"maximum number of access log entries per exported object" default:"100000"`
+}
+
+// Recorder records the accesses to the objects of the projects with an
+// access log export. Entries are dropped when the database can't keep up.
+//
+// A nil Recorder and a disabled Recorder don't record anything.
+//
+// architecture: Service
+type Recorder struct {
+	log    *zap.Logger
+	db     DB
+	config Config
+	cache  *lrucache.ExpiringLRU
+	queue  chan Entry
+
+	nowFn func() time.Time
+}
+
+// NewRecorder creates a new access log recorder.
+func NewRecorder(log *zap.Logger, db DB, config Config) *Recorder {
+	if config.BufferSize <= 0 {
+		config.BufferSize = 1
+	}
+	if config.FlushInterval <= 0 {
+		config.FlushInterval = 10 * time.Second
+	}
+	return &Recorder{
+		log:    log,
+		db:     db,
+		config: config,
+		cache: lrucache.New(lrucache.Options{
+			Capacity:   config.CacheCapacity,
+			Expiration: config.CacheExpiration,
+		}),
+		queue: make(chan Entry, config.BufferSize),
+		nowFn: time.Now,
+	}
+}
+
+// Enabled returns whether accesses are recorded.
+func (recorder *Recorder) Enabled() bool {
+	return recorder != nil && recorder.config.Enabled
+}
+
+// Record queues the entry, when the project of the entry has an access log
+// export. The time and the id of the entry are set by the recorder.
+func (recorder *Recorder) Record(ctx context.Context, entry Entry) {
+	if !recorder.Enabled() {
+		return
+	}
+
+	exported, err := recorder.exported(ctx, entry.ProjectID)
+	if err != nil {
+		recorder.log.Warn("failed to get access log export",
+			zap.Stringer("Project ID", entry.ProjectID),
+			zap.Error(err))
+		return
+	}
+	if !exported {
+		return
+	}
+
+	entry.ID, err = uuid.New()
+	if err != nil {
+		recorder.log.Error("failed to create access log entry id", zap.Error(err))
+		return
+	}
+	entry.Time = recorder.nowFn().UTC()
+
+	select {
+	case recorder.queue <- entry:
+		mon.Counter("accesslog_recorded").Inc(1)
+	default:
+		mon.Counter("accesslog_dropped").Inc(1)
+	}
+}
+
+// exported returns whether the project has an access log export.
+func (recorder *Recorder) exported(ctx context.Context, projectID uuid.UUID) (_ bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	value, err := recorder.cache.Get(projectID.String(), func() (interface{}, error) {
+		_, err := recorder.db.GetExport(ctx, projectID)
+		if err != nil {
+			if ErrExportNotFound.Has(err) {
+				return false, nil
+			}
+			return nil, err
+		}
+		return true, nil
+	})
+	if err != nil {
+		return false, Error.Wrap(err)
+	}
+	return value.(bool), nil
+}
+
+// Run stores the queued entries until ctx is canceled. The remaining entries
+// are stored before returning.
+func (recorder *Recorder) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if !recorder.Enabled() {
+		return nil
+	}
+
+	ticker := time.NewTicker(recorder.config.FlushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			// use a new context, so the remaining entries can still be stored.
+			flushCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			recorder.Flush(flushCtx)
+			cancel()
+			return nil
+		case <-ticker.C:
+			recorder.Flush(ctx)
+		}
+	}
+}
+
+// Flush stores all queued entries.
+func (recorder *Recorder) Flush(ctx context.Context) {
+	if !recorder.Enabled() {
+		return
+	}
+
+	entries := make([]Entry, 0, len(recorder.queue))
+	for len(entries) < cap(entries) {
+		entries = append(entries, <-recorder.queue)
+	}
+	if len(entries) == 0 {
+		return
+	}
+
+	err := recorder.db.InsertEntries(ctx, entries)
+	if err != nil {
+		mon.Counter("accesslog_store_failed").Inc(int64(len(entries)))
+		recorder.log.Error("failed to store access log entries", zap.Int("count", len(entries)), zap.Error(err))
+	}
+}
+
+// SetNow allows tests to have the recorder act as if the current time is
+// whatever they want.
+func (recorder *Recorder) SetNow(nowFn func() time.Time) {
+	recorder.nowFn = nowFn
+}
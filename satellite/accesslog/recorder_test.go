@@ -0,0 +1,157 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package accesslog_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/accesslog"
+)
+
+func TestRecorder(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	exported, other := testrand.UUID(), testrand.UUID()
+	db := newFakeDB()
+	require.NoError(t, db.CreateExport(ctx, accesslog.Export{ProjectID: exported}))
+
+	now := time.Date(2023, 3, 1, 10, 0, 0, 0, time.UTC)
+	recorder := accesslog.NewRecorder(zaptest.NewLogger(t), db, accesslog.Config{
+		Enabled:         true,
+		BufferSize:      2,
+		CacheCapacity:   10,
+		CacheExpiration: time.Minute,
+	})
+	recorder.SetNow(func() time.Time { return now })
+
+	recorder.Record(ctx, accesslog.Entry{ProjectID: exported, BucketName: "a", Operation: accesslog.PutObject, Bytes: 10})
+	recorder.Record(ctx, accesslog.Entry{ProjectID: other, BucketName: "b", Operation: accesslog.PutObject})
+	recorder.Record(ctx, accesslog.Entry{ProjectID: exported, BucketName: "a", Operation: accesslog.GetObject, Bytes: 5})
+	// the buffer is full, so the entry is dropped.
+	recorder.Record(ctx, accesslog.Entry{ProjectID: exported, BucketName: "a", Operation: accesslog.DeleteObject})
+
+	recorder.Flush(ctx)
+
+	entries, err := db.ListEntries(ctx, exported, now.Add(-time.Hour), now, 10)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	require.Equal(t, accesslog.PutObject, entries[0].Operation)
+	require.Equal(t, accesslog.GetObject, entries[1].Operation)
+	require.Equal(t, now, entries[0].Time)
+	require.False(t, entries[0].ID.IsZero())
+	require.NotEqual(t, entries[0].ID, entries[1].ID)
+
+	entries, err = db.ListEntries(ctx, other, now.Add(-time.Hour), now, 10)
+	require.NoError(t, err)
+	require.Empty(t, entries)
+
+	// nil and disabled recorders don't record anything.
+	var nilRecorder *accesslog.Recorder
+	nilRecorder.Record(ctx, accesslog.Entry{ProjectID: exported})
+	nilRecorder.Flush(ctx)
+
+	disabled := accesslog.NewRecorder(zaptest.NewLogger(t), db, accesslog.Config{})
+	disabled.Record(ctx, accesslog.Entry{ProjectID: exported})
+	disabled.Flush(ctx)
+
+	entries, err = db.ListEntries(ctx, exported, time.Time{}, now.Add(time.Hour), 10)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+}
+
+// fakeDB is an in-memory accesslog.DB.
+type fakeDB struct {
+	mu      sync.Mutex
+	exports map[uuid.UUID]accesslog.Export
+	entries []accesslog.Entry
+}
+
+func newFakeDB() *fakeDB {
+	return &fakeDB{exports: map[uuid.UUID]accesslog.Export{}}
+}
+
+func (db *fakeDB) CreateExport(ctx context.Context, export accesslog.Export) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	if _, ok := db.exports[export.ProjectID]; ok {
+		return accesslog.ErrExportExists.New("%s", export.ProjectID)
+	}
+	db.exports[export.ProjectID] = export
+	return nil
+}
+
+func (db *fakeDB) GetExport(ctx context.Context, projectID uuid.UUID) (accesslog.Export, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	export, ok := db.exports[projectID]
+	if !ok {
+		return accesslog.Export{}, accesslog.ErrExportNotFound.New("%s", projectID)
+	}
+	return export, nil
+}
+
+func (db *fakeDB) DeleteExport(ctx context.Context, projectID uuid.UUID) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	delete(db.exports, projectID)
+	return nil
+}
+
+func (db *fakeDB) ListExports(ctx context.Context) (exports []accesslog.Export, _ error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	for _, export := range db.exports {
+		exports = append(exports, export)
+	}
+	return exports, nil
+}
+
+func (db *fakeDB) UpdateExportStatus(ctx context.Context, projectID uuid.UUID, status accesslog.Status) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	export := db.exports[projectID]
+	export.Status = status
+	db.exports[projectID] = export
+	return nil
+}
+
+func (db *fakeDB) InsertEntries(ctx context.Context, entries []accesslog.Entry) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	db.entries = append(db.entries, entries...)
+	return nil
+}
+
+func (db *fakeDB) ListEntries(ctx context.Context, projectID uuid.UUID, from, until time.Time, limit int) (entries []accesslog.Entry, _ error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	for _, entry := range db.entries {
+		if entry.ProjectID == projectID && entry.Time.After(from) && !entry.Time.After(until) && len(entries) < limit {
+			entries = append(entries, entry)
+		}
+	}
+	return entries, nil
+}
+
+func (db *fakeDB) DeleteEntries(ctx context.Context, projectID uuid.UUID, until time.Time) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	kept := db.entries[:0]
+	for _, entry := range db.entries {
+		if entry.ProjectID != projectID || entry.Time.After(until) {
+			kept = append(kept, entry)
+		}
+	}
+	db.entries = kept
+	return nil
+}
@@ -217,6 +217,8 @@ type ProjectAccounting interface {
 	GetProjectDailyBandwidth(ctx context.Context, projectID uuid.UUID, year int, month time.Month, day int) (int64, int64, int64, error)
 	// DeleteProjectBandwidthBefore deletes project bandwidth rollups before the given time
 	DeleteProjectBandwidthBefore(ctx context.Context, before time.Time) error
+	// DeleteProjectRecords deletes the storage tallies and the bandwidth rollups of the project.
+	DeleteProjectRecords(ctx context.Context, projectID uuid.UUID) error
 	// GetProjectDailyUsageByDateRange returns daily allocated, settled bandwidth and storage usage for the specified date range.
 	GetProjectDailyUsageByDateRange(ctx context.Context, projectID uuid.UUID, from, to time.Time, crdbInterval time.Duration) (*ProjectDailyUsage, error)
 
@@ -289,6 +291,14 @@ type Cache interface {
 	// it. The projectID is inserted to the increment when it doesn't exists,
 	// hence this method will never return ErrKeyNotFound error's class.
 	UpdateProjectBandwidthUsage(ctx context.Context, projectID uuid.UUID, increment int64, ttl time.Duration, now time.Time) error
+	// ReconcileProjectBandwidthUsage raises the project's bandwidth usage to
+	// value, when it's lower, so the allocations, which were added to the
+	// rollups after the usage was cached, aren't missed. The usage is inserted
+	// when it doesn't exist. It returns the resulting usage.
+	ReconcileProjectBandwidthUsage(ctx context.Context, projectID uuid.UUID, value int64, ttl time.Duration, now time.Time) (currentUsed int64, _ error)
+	// GetAllProjectBandwidthUsage returns the bandwidth usage of the projects,
+	// which is cached for the day of now.
+	GetAllProjectBandwidthUsage(ctx context.Context, now time.Time) (map[uuid.UUID]int64, error)
 	// UpdateProjectSegmentUsage updates the project's segment usage increasing
 	// it. The projectID is inserted to the increment when it doesn't exists,
 	// hence this method will never return ErrKeyNotFound error's class.
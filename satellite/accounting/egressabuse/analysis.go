@@ -0,0 +1,237 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package egressabuse
+
+import (
+	"context"
+	"math"
+	"sort"
+	"time"
+
+	"storj.io/common/pb"
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/accounting"
+	"storj.io/storj/satellite/orders"
+)
+
+// ProjectInfo provides additional information about projects with high egress.
+type ProjectInfo interface {
+	// IsPaidTier returns whether the project owner is in the paid tier.
+	IsPaidTier(ctx context.Context, projectID uuid.UUID) (bool, error)
+	// AverageStored returns the average number of bytes stored by the project in the period.
+	AverageStored(ctx context.Context, projectID uuid.UUID, since, before time.Time) (float64, error)
+}
+
+// ProjectAnomaly is a free-tier project with a suspiciously high egress
+// compared to the data it stores.
+type ProjectAnomaly struct {
+	ProjectID     uuid.UUID
+	Egress        int64
+	AverageStored float64
+	Ratio         float64
+}
+
+// NodeAnomaly is a node with a suspiciously high egress compared to other nodes.
+type NodeAnomaly struct {
+	NodeID       storj.NodeID
+	Egress       int64
+	MedianEgress int64
+}
+
+// Collusion is a flagged project and node, whose hourly egress is highly correlated.
+// This is a strong indication of self-dealing egress, where the project downloads
+// from its own node to earn egress payouts.
+type Collusion struct {
+	ProjectID   uuid.UUID
+	NodeID      storj.NodeID
+	Correlation float64
+}
+
+// Report contains the results of an analysis.
+type Report struct {
+	Since  time.Time
+	Before time.Time
+
+	Projects   []ProjectAnomaly
+	Nodes      []NodeAnomaly
+	Collusions []Collusion
+}
+
+// Analyzer detects anomalous egress patterns in the bandwidth rollups.
+type Analyzer struct {
+	config Config
+}
+
+// NewAnalyzer creates a new analyzer.
+func NewAnalyzer(config Config) *Analyzer {
+	return &Analyzer{config: config}
+}
+
+// Analyze analyzes the customer egress in the period [since, before).
+// A period that is not a whole number of hours gets a shorter last hour.
+func (analyzer *Analyzer) Analyze(ctx context.Context, since, before time.Time,
+	projectRollups []orders.BucketBandwidthRollup, nodeRollups []accounting.StoragenodeBandwidthRollup,
+	projects ProjectInfo) (_ Report, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	report := Report{Since: since, Before: before}
+	hours := int(math.Ceil(before.Sub(since).Hours()))
+	if hours <= 0 {
+		return report, Error.New("invalid period %s - %s", since, before)
+	}
+
+	projectEgress := map[uuid.UUID]*series{}
+	for _, rollup := range projectRollups {
+		if rollup.Action != pb.PieceAction_GET || !inPeriod(rollup.IntervalStart, since, before) {
+			continue
+		}
+		egress, ok := projectEgress[rollup.ProjectID]
+		if !ok {
+			egress = newSeries(hours)
+			projectEgress[rollup.ProjectID] = egress
+		}
+		egress.add(since, rollup.IntervalStart, rollup.Settled)
+	}
+
+	nodeEgress := map[storj.NodeID]*series{}
+	for _, rollup := range nodeRollups {
+		if rollup.Action != uint(pb.PieceAction_GET) || !inPeriod(rollup.IntervalStart, since, before) {
+			continue
+		}
+		egress, ok := nodeEgress[rollup.NodeID]
+		if !ok {
+			egress = newSeries(hours)
+			nodeEgress[rollup.NodeID] = egress
+		}
+		egress.add(since, rollup.IntervalStart, int64(rollup.Settled))
+	}
+
+	for projectID, egress := range projectEgress {
+		if egress.total < analyzer.config.MinProjectEgress.Int64() {
+			continue
+		}
+
+		paid, err := projects.IsPaidTier(ctx, projectID)
+		if err != nil {
+			return report, Error.Wrap(err)
+		}
+		if paid {
+			continue
+		}
+
+		stored, err := projects.AverageStored(ctx, projectID, since, before)
+		if err != nil {
+			return report, Error.Wrap(err)
+		}
+
+		ratio := float64(egress.total) / math.Max(stored, 1)
+		if ratio < analyzer.config.EgressStorageRatio {
+			continue
+		}
+
+		report.Projects = append(report.Projects, ProjectAnomaly{
+			ProjectID:     projectID,
+			Egress:        egress.total,
+			AverageStored: stored,
+			Ratio:         ratio,
+		})
+	}
+	sort.Slice(report.Projects, func(i, k int) bool {
+		return report.Projects[i].Ratio > report.Projects[k].Ratio
+	})
+
+	median := medianEgress(nodeEgress)
+	for nodeID, egress := range nodeEgress {
+		if egress.total < analyzer.config.MinNodeEgress.Int64() {
+			continue
+		}
+		if float64(egress.total) < float64(median)*analyzer.config.NodeEgressDeviation {
+			continue
+		}
+		report.Nodes = append(report.Nodes, NodeAnomaly{
+			NodeID:       nodeID,
+			Egress:       egress.total,
+			MedianEgress: median,
+		})
+	}
+	sort.Slice(report.Nodes, func(i, k int) bool {
+		return report.Nodes[i].Egress > report.Nodes[k].Egress
+	})
+
+	for _, project := range report.Projects {
+		for _, node := range report.Nodes {
+			correlation := correlate(projectEgress[project.ProjectID].hourly, nodeEgress[node.NodeID].hourly)
+			if correlation < analyzer.config.MinCorrelation {
+				continue
+			}
+			report.Collusions = append(report.Collusions, Collusion{
+				ProjectID:   project.ProjectID,
+				NodeID:      node.NodeID,
+				Correlation: correlation,
+			})
+		}
+	}
+	sort.Slice(report.Collusions, func(i, k int) bool {
+		return report.Collusions[i].Correlation > report.Collusions[k].Correlation
+	})
+
+	return report, nil
+}
+
+// series contains the hourly egress of a project or node.
+type series struct {
+	total  int64
+	hourly []float64
+}
+
+func newSeries(hours int) *series {
+	return &series{hourly: make([]float64, hours)}
+}
+
+func (s *series) add(since, intervalStart time.Time, amount int64) {
+	s.total += amount
+	s.hourly[int(intervalStart.Sub(since).Hours())] += float64(amount)
+}
+
+func inPeriod(t, since, before time.Time) bool {
+	return !t.Before(since) && t.Before(before)
+}
+
+// medianEgress returns the median egress of the nodes.
+func medianEgress(nodes map[storj.NodeID]*series) int64 {
+	if len(nodes) == 0 {
+		return 0
+	}
+	totals := make([]int64, 0, len(nodes))
+	for _, node := range nodes {
+		totals = append(totals, node.total)
+	}
+	sort.Slice(totals, func(i, k int) bool { return totals[i] < totals[k] })
+	return totals[len(totals)/2]
+}
+
+// correlate returns the Pearson correlation coefficient of the series.
+// It returns 0, when either of the series is constant.
+func correlate(a, b []float64) float64 {
+	n := float64(len(a))
+	var sumA, sumB float64
+	for i := range a {
+		sumA += a[i]
+		sumB += b[i]
+	}
+	meanA, meanB := sumA/n, sumB/n
+
+	var cov, varA, varB float64
+	for i := range a {
+		da, db := a[i]-meanA, b[i]-meanB
+		cov += da * db
+		varA += da * da
+		varB += db * db
+	}
+	if varA == 0 || varB == 0 {
+		return 0
+	}
+	return cov / math.Sqrt(varA*varB)
+}
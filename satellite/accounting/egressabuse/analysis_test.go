@@ -0,0 +1,163 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package egressabuse_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/memory"
+	"storj.io/common/pb"
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/accounting"
+	"storj.io/storj/satellite/accounting/egressabuse"
+	"storj.io/storj/satellite/orders"
+)
+
+type projectInfo struct {
+	paid   map[uuid.UUID]bool
+	stored map[uuid.UUID]float64
+}
+
+func (info *projectInfo) IsPaidTier(ctx context.Context, projectID uuid.UUID) (bool, error) {
+	return info.paid[projectID], nil
+}
+
+func (info *projectInfo) AverageStored(ctx context.Context, projectID uuid.UUID, since, before time.Time) (float64, error) {
+	return info.stored[projectID], nil
+}
+
+func TestAnalyze(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	before := time.Date(2022, 10, 1, 0, 0, 0, 0, time.UTC)
+	since := before.Add(-24 * time.Hour)
+
+	abuser, paid, regular := testrand.UUID(), testrand.UUID(), testrand.UUID()
+	colluding, unrelated := testrand.NodeID(), testrand.NodeID()
+	normal := []storj.NodeID{testrand.NodeID(), testrand.NodeID(), testrand.NodeID()}
+
+	var projectRollups []orders.BucketBandwidthRollup
+	var nodeRollups []accounting.StoragenodeBandwidthRollup
+	addProject := func(projectID uuid.UUID, hour int, action pb.PieceAction, amount memory.Size) {
+		projectRollups = append(projectRollups, orders.BucketBandwidthRollup{
+			ProjectID:     projectID,
+			BucketName:    "bucket",
+			Action:        action,
+			IntervalStart: since.Add(time.Duration(hour) * time.Hour),
+			Settled:       amount.Int64(),
+		})
+	}
+	addNode := func(nodeID storj.NodeID, hour int, amount memory.Size) {
+		nodeRollups = append(nodeRollups, accounting.StoragenodeBandwidthRollup{
+			NodeID:        nodeID,
+			IntervalStart: since.Add(time.Duration(hour) * time.Hour),
+			Action:        uint(pb.PieceAction_GET),
+			Settled:       uint64(amount.Int64()),
+		})
+	}
+
+	for hour := 0; hour < 24; hour++ {
+		// the abuser downloads from the colluding node during the night.
+		amount := memory.GB
+		if hour < 8 {
+			amount = 50 * memory.GB
+		}
+		addProject(abuser, hour, pb.PieceAction_GET, amount)
+		addNode(colluding, hour, amount)
+
+		// the unrelated node has high egress during the day.
+		amount = memory.GB
+		if hour >= 12 {
+			amount = 50 * memory.GB
+		}
+		addNode(unrelated, hour, amount)
+
+		for _, nodeID := range normal {
+			addNode(nodeID, hour, 100*memory.MB)
+		}
+
+		addProject(paid, hour, pb.PieceAction_GET, 50*memory.GB)
+		addProject(regular, hour, pb.PieceAction_GET, 10*memory.GB)
+		// repair traffic is not customer egress.
+		addProject(regular, hour, pb.PieceAction_GET_REPAIR, 100*memory.GB)
+	}
+	// rollups outside of the period are ignored.
+	addProject(abuser, -1, pb.PieceAction_GET, memory.PB)
+	addNode(colluding, 24, memory.PB)
+
+	analyzer := egressabuse.NewAnalyzer(egressabuse.Config{
+		MinProjectEgress:    100 * memory.GB,
+		EgressStorageRatio:  10,
+		MinNodeEgress:       100 * memory.GB,
+		NodeEgressDeviation: 10,
+		MinCorrelation:      0.8,
+	})
+
+	report, err := analyzer.Analyze(ctx, since, before, projectRollups, nodeRollups, &projectInfo{
+		paid: map[uuid.UUID]bool{paid: true},
+		stored: map[uuid.UUID]float64{
+			abuser:  float64(memory.GB),
+			paid:    float64(memory.GB),
+			regular: float64(memory.TB),
+		},
+	})
+	require.NoError(t, err)
+
+	require.Len(t, report.Projects, 1)
+	require.Equal(t, abuser, report.Projects[0].ProjectID)
+	require.Equal(t, (8*50+16)*memory.GB.Int64(), report.Projects[0].Egress)
+
+	require.Len(t, report.Nodes, 2)
+	require.Equal(t, unrelated, report.Nodes[0].NodeID)
+	require.Equal(t, colluding, report.Nodes[1].NodeID)
+
+	require.Len(t, report.Collusions, 1)
+	require.Equal(t, abuser, report.Collusions[0].ProjectID)
+	require.Equal(t, colluding, report.Collusions[0].NodeID)
+	require.InDelta(t, 1, report.Collusions[0].Correlation, 0.001)
+
+	_, err = analyzer.Analyze(ctx, before, since, projectRollups, nodeRollups, &projectInfo{})
+	require.Error(t, err)
+}
+
+func TestAnalyze_PartialHour(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	before := time.Date(2022, 10, 1, 0, 0, 0, 0, time.UTC)
+	since := before.Add(-90 * time.Minute)
+
+	projectID := testrand.UUID()
+	projectRollups := []orders.BucketBandwidthRollup{{
+		ProjectID:     projectID,
+		BucketName:    "bucket",
+		Action:        pb.PieceAction_GET,
+		IntervalStart: before.Add(-time.Minute),
+		Settled:       memory.TB.Int64(),
+	}}
+	nodeRollups := []accounting.StoragenodeBandwidthRollup{{
+		NodeID:        testrand.NodeID(),
+		IntervalStart: before.Add(-time.Minute),
+		Action:        uint(pb.PieceAction_GET),
+		Settled:       uint64(memory.TB.Int64()),
+	}}
+
+	analyzer := egressabuse.NewAnalyzer(egressabuse.Config{
+		MinProjectEgress:   100 * memory.GB,
+		EgressStorageRatio: 10,
+	})
+
+	report, err := analyzer.Analyze(ctx, since, before, projectRollups, nodeRollups, &projectInfo{
+		stored: map[uuid.UUID]float64{projectID: float64(memory.GB)},
+	})
+	require.NoError(t, err)
+	require.Len(t, report.Projects, 1)
+	require.Equal(t, memory.TB.Int64(), report.Projects[0].Egress)
+}
@@ -0,0 +1,270 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package egressabuse
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/memory"
+	"storj.io/common/sync2"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/accounting"
+	"storj.io/storj/satellite/console"
+)
+
+var (
+	// Error is the error class for this package.
+	Error = errs.Class("egressabuse")
+
+	mon = monkit.Package()
+)
+
+// Config contains configurable values for the egress abuse detection.
+type Config struct {
+	Enabled  bool          `help:"whether the egress abuse detection is enabled" default:"false"`
+	Interval time.Duration `help:"how often to analyze the bandwidth rollups" default:"24h" testDefault:"$TESTINTERVAL"`
+	Window   time.Duration `help:"how far back the bandwidth rollups are analyzed, rounded up to whole hours" default:"24h"`
+
+	MinProjectEgress   memory.Size `help:"minimum egress of a free-tier project to be analyzed" default:"100GB"`
+	EgressStorageRatio float64     `help:"ratio of egress to average stored data at which a free-tier project is flagged" default:"10"`
+
+	MinNodeEgress       memory.Size `help:"minimum egress of a node to be analyzed" default:"100GB"`
+	NodeEgressDeviation float64     `help:"how many times the median node egress a node needs to have to be flagged" default:"10"`
+
+	MinCorrelation float64 `help:"minimum correlation of hourly egress between a flagged project and node to report them as colluding" default:"0.8"`
+
+	ReportDir string `help:"directory where the csv reports are written, when empty no reports are written" default:""`
+
+	RateLimitColluding bool `help:"whether to rate limit free-tier projects which are suspected of colluding with a node" default:"false"`
+	RateLimit          int  `help:"rate limit to set for projects suspected of colluding with a node" default:"1"`
+}
+
+// Chore periodically analyzes the bandwidth rollups for anomalous egress patterns.
+//
+// architecture: Chore
+type Chore struct {
+	log    *zap.Logger
+	config Config
+
+	projectAccounting accounting.ProjectAccounting
+	nodeAccounting    accounting.StoragenodeAccounting
+	projects          console.Projects
+	users             console.Users
+
+	analyzer *Analyzer
+	nowFn    func() time.Time
+
+	Loop *sync2.Cycle
+}
+
+// NewChore creates a new egress abuse detection chore.
+func NewChore(log *zap.Logger, projectAccounting accounting.ProjectAccounting, nodeAccounting accounting.StoragenodeAccounting,
+	projects console.Projects, users console.Users, config Config) *Chore {
+	return &Chore{
+		log:    log,
+		config: config,
+
+		projectAccounting: projectAccounting,
+		nodeAccounting:    nodeAccounting,
+		projects:          projects,
+		users:             users,
+
+		analyzer: NewAnalyzer(config),
+		nowFn:    time.Now,
+
+		Loop: sync2.NewCycle(config.Interval),
+	}
+}
+
+// Run starts the chore.
+func (chore *Chore) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return chore.Loop.Run(ctx, func(ctx context.Context) error {
+		_, err := chore.RunOnce(ctx)
+		if err != nil {
+			chore.log.Error("egress abuse detection failed", zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// RunOnce analyzes the bandwidth rollups of the last window and acts on the results.
+func (chore *Chore) RunOnce(ctx context.Context) (_ Report, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// the rollups are hourly, so the window is rounded up to whole hours.
+	window := chore.config.Window
+	if window%time.Hour != 0 {
+		window = window.Truncate(time.Hour) + time.Hour
+	}
+
+	before := chore.nowFn().UTC().Truncate(time.Hour)
+	since := before.Add(-window)
+
+	projectRollups, err := chore.projectAccounting.GetRollupsSince(ctx, since)
+	if err != nil {
+		return Report{}, Error.Wrap(err)
+	}
+	nodeRollups, err := chore.nodeAccounting.GetRollupsSince(ctx, since)
+	if err != nil {
+		return Report{}, Error.Wrap(err)
+	}
+
+	report, err := chore.analyzer.Analyze(ctx, since, before, projectRollups, nodeRollups, &projectInfo{
+		projectAccounting: chore.projectAccounting,
+		projects:          chore.projects,
+		users:             chore.users,
+	})
+	if err != nil {
+		return report, err
+	}
+
+	mon.IntVal("egress_abuse_projects").Observe(int64(len(report.Projects)))
+	mon.IntVal("egress_abuse_nodes").Observe(int64(len(report.Nodes)))
+	mon.IntVal("egress_abuse_collusions").Observe(int64(len(report.Collusions)))
+
+	for _, project := range report.Projects {
+		chore.log.Info("free-tier project with anomalous egress",
+			zap.Stringer("project-id", project.ProjectID),
+			zap.Int64("egress", project.Egress),
+			zap.Float64("average-stored", project.AverageStored),
+			zap.Float64("ratio", project.Ratio))
+	}
+	for _, node := range report.Nodes {
+		chore.log.Info("node with anomalous egress",
+			zap.Stringer("node-id", node.NodeID),
+			zap.Int64("egress", node.Egress),
+			zap.Int64("median-egress", node.MedianEgress))
+	}
+	for _, collusion := range report.Collusions {
+		chore.log.Warn("suspected self-dealing egress",
+			zap.Stringer("project-id", collusion.ProjectID),
+			zap.Stringer("node-id", collusion.NodeID),
+			zap.Float64("correlation", collusion.Correlation))
+	}
+
+	if chore.config.ReportDir != "" {
+		if err := WriteReport(filepath.Join(chore.config.ReportDir, reportName(before)), report); err != nil {
+			return report, err
+		}
+	}
+
+	if chore.config.RateLimitColluding {
+		if err := chore.rateLimit(ctx, report); err != nil {
+			return report, err
+		}
+	}
+
+	return report, nil
+}
+
+// rateLimit lowers the rate limit of the projects which are suspected of colluding.
+func (chore *Chore) rateLimit(ctx context.Context, report Report) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	limited := map[uuid.UUID]struct{}{}
+	for _, collusion := range report.Collusions {
+		if _, ok := limited[collusion.ProjectID]; ok {
+			continue
+		}
+		limited[collusion.ProjectID] = struct{}{}
+
+		project, err := chore.projects.Get(ctx, collusion.ProjectID)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		if project.RateLimit != nil && *project.RateLimit <= chore.config.RateLimit {
+			continue
+		}
+
+		err = chore.projects.UpdateRateLimit(ctx, collusion.ProjectID, chore.config.RateLimit)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		mon.Event("egress_abuse_project_rate_limited")
+		chore.log.Warn("rate limited project suspected of self-dealing egress",
+			zap.Stringer("project-id", collusion.ProjectID),
+			zap.Int("rate-limit", chore.config.RateLimit))
+	}
+	return nil
+}
+
+// Close stops the chore.
+func (chore *Chore) Close() error {
+	chore.Loop.Close()
+	return nil
+}
+
+// projectInfo implements ProjectInfo using the satellite database.
+type projectInfo struct {
+	projectAccounting accounting.ProjectAccounting
+	projects          console.Projects
+	users             console.Users
+}
+
+// IsPaidTier returns whether the project owner is in the paid tier.
+func (info *projectInfo) IsPaidTier(ctx context.Context, projectID uuid.UUID) (bool, error) {
+	project, err := info.projects.Get(ctx, projectID)
+	if err != nil {
+		return false, err
+	}
+	return info.users.GetUserPaidTier(ctx, project.OwnerID)
+}
+
+// AverageStored returns the average number of bytes stored by the project in the period.
+func (info *projectInfo) AverageStored(ctx context.Context, projectID uuid.UUID, since, before time.Time) (float64, error) {
+	usage, err := info.projectAccounting.GetProjectTotal(ctx, projectID, since, before)
+	if err != nil {
+		return 0, err
+	}
+	return usage.Storage / before.Sub(since).Hours(), nil
+}
+
+func reportName(before time.Time) string {
+	return "egress-abuse-" + before.Format("2006-01-02T15") + ".csv"
+}
+
+// WriteReport writes the report as csv to the specified path.
+func WriteReport(path string, report Report) (err error) {
+	f, err := os.Create(path)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, Error.Wrap(f.Close())) }()
+
+	w := csv.NewWriter(f)
+	if err := w.Write([]string{"kind", "project id", "node id", "egress", "average stored", "ratio", "median egress", "correlation"}); err != nil {
+		return Error.Wrap(err)
+	}
+	for _, project := range report.Projects {
+		if err := w.Write([]string{"project", project.ProjectID.String(), "",
+			fmt.Sprint(project.Egress), fmt.Sprintf("%.0f", project.AverageStored), fmt.Sprintf("%.2f", project.Ratio), "", ""}); err != nil {
+			return Error.Wrap(err)
+		}
+	}
+	for _, node := range report.Nodes {
+		if err := w.Write([]string{"node", "", node.NodeID.String(),
+			fmt.Sprint(node.Egress), "", "", fmt.Sprint(node.MedianEgress), ""}); err != nil {
+			return Error.Wrap(err)
+		}
+	}
+	for _, collusion := range report.Collusions {
+		if err := w.Write([]string{"collusion", collusion.ProjectID.String(), collusion.NodeID.String(),
+			"", "", "", "", fmt.Sprintf("%.3f", collusion.Correlation)}); err != nil {
+			return Error.Wrap(err)
+		}
+	}
+	w.Flush()
+	return Error.Wrap(w.Error())
+}
@@ -26,6 +26,7 @@ type Config struct {
 	StorageBackend     string        `help:"what to use for storing real-time accounting data"`
 	BandwidthCacheTTL  time.Duration `default:"5m" help:"bandwidth cache key time to live"`
 	AsOfSystemInterval time.Duration `default:"-10s" help:"as of system interval"`
+	ReconcileInterval  time.Duration `default:"1m" testDefault:"$TESTINTERVAL" help:"how often the cached bandwidth usage is reconciled with the bandwidth rollups"`
 }
 
 // OpenCache creates a new accounting.Cache instance using the type specified backend in
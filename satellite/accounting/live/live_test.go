@@ -339,3 +339,52 @@ func populateCache(ctx context.Context, cache accounting.Cache) ([]populateCache
 
 	return populatedData, errg.Wait()
 }
+
+func TestReconcileProjectBandwidthUsage(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	redis, err := testredis.Start(ctx)
+	require.NoError(t, err)
+	defer ctx.Check(redis.Close)
+
+	cache, err := live.OpenCache(ctx, zaptest.NewLogger(t).Named("live-accounting"), live.Config{
+		StorageBackend: "redis://" + redis.Addr() + "?db=0",
+	})
+	require.NoError(t, err)
+	defer ctx.Check(cache.Close)
+
+	var (
+		projectID      = testrand.UUID()
+		otherProjectID = testrand.UUID()
+		now            = time.Now()
+	)
+
+	_, err = cache.InsertProjectBandwidthUsage(ctx, projectID, 100, time.Hour, now)
+	require.NoError(t, err)
+
+	// a lower value doesn't change the usage.
+	used, err := cache.ReconcileProjectBandwidthUsage(ctx, projectID, 50, time.Hour, now)
+	require.NoError(t, err)
+	require.EqualValues(t, 100, used)
+
+	// a higher value raises the usage.
+	used, err = cache.ReconcileProjectBandwidthUsage(ctx, projectID, 150, time.Hour, now)
+	require.NoError(t, err)
+	require.EqualValues(t, 150, used)
+
+	// a missing usage is inserted.
+	used, err = cache.ReconcileProjectBandwidthUsage(ctx, otherProjectID, 10, time.Hour, now)
+	require.NoError(t, err)
+	require.EqualValues(t, 10, used)
+
+	// the usage of another day isn't returned.
+	require.NoError(t, cache.UpdateProjectBandwidthUsage(ctx, testrand.UUID(), 1, time.Hour, now.AddDate(0, 0, -1)))
+
+	usage, err := cache.GetAllProjectBandwidthUsage(ctx, now)
+	require.NoError(t, err)
+	require.Equal(t, map[uuid.UUID]int64{
+		projectID:      150,
+		otherProjectID: 10,
+	}, usage)
+}
@@ -0,0 +1,110 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package live
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/sync2"
+	"storj.io/storj/satellite/accounting"
+)
+
+// BandwidthReconciler periodically raises the cached bandwidth usage of the
+// projects to the usage of the bandwidth rollups. A key is populated from the
+// rollups, which lag behind the allocations, so without the reconciliation the
+// allocations missed at that moment are only enforced after the key expires.
+//
+// architecture: Chore
+type BandwidthReconciler struct {
+	log   *zap.Logger
+	cache accounting.Cache
+	db    accounting.ProjectAccounting
+
+	ttl                time.Duration
+	asOfSystemInterval time.Duration
+
+	nowFn func() time.Time
+	Loop  *sync2.Cycle
+}
+
+// NewBandwidthReconciler creates a new bandwidth usage reconciler.
+func NewBandwidthReconciler(log *zap.Logger, cache accounting.Cache, db accounting.ProjectAccounting, config Config) *BandwidthReconciler {
+	return &BandwidthReconciler{
+		log:   log,
+		cache: cache,
+		db:    db,
+
+		ttl:                config.BandwidthCacheTTL,
+		asOfSystemInterval: config.AsOfSystemInterval,
+
+		nowFn: time.Now,
+		Loop:  sync2.NewCycle(config.ReconcileInterval),
+	}
+}
+
+// Run starts the reconciler.
+func (reconciler *BandwidthReconciler) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return reconciler.Loop.Run(ctx, func(ctx context.Context) error {
+		err := reconciler.RunOnce(ctx)
+		if err != nil {
+			reconciler.log.Error("error reconciling project bandwidth usage", zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// RunOnce reconciles the cached bandwidth usage of all the projects, which
+// have it cached for the current day.
+func (reconciler *BandwidthReconciler) RunOnce(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	now := reconciler.nowFn()
+	cached, err := reconciler.cache.GetAllProjectBandwidthUsage(ctx, now)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	var raised int64
+	for projectID, used := range cached {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		rolledUp, err := reconciler.db.GetProjectBandwidth(ctx, projectID, now.Year(), now.Month(), now.Day(), reconciler.asOfSystemInterval)
+		if err != nil {
+			reconciler.log.Warn("unable to get project bandwidth rollups",
+				zap.Stringer("Project ID", projectID), zap.Error(err))
+			continue
+		}
+		if rolledUp <= used {
+			continue
+		}
+
+		if _, err := reconciler.cache.ReconcileProjectBandwidthUsage(ctx, projectID, rolledUp, reconciler.ttl, now); err != nil {
+			reconciler.log.Warn("unable to reconcile project bandwidth usage",
+				zap.Stringer("Project ID", projectID), zap.Error(err))
+			continue
+		}
+		raised++
+	}
+
+	mon.IntVal("live_bandwidth_cached_projects").Observe(int64(len(cached)))
+	mon.IntVal("live_bandwidth_raised_projects").Observe(raised)
+	return nil
+}
+
+// SetNow allows tests to have the reconciler act as if the current time is whatever they want.
+func (reconciler *BandwidthReconciler) SetNow(nowFn func() time.Time) {
+	reconciler.nowFn = nowFn
+}
+
+// Close stops the reconciler.
+func (reconciler *BandwidthReconciler) Close() error {
+	reconciler.Loop.Close()
+	return nil
+}
@@ -0,0 +1,51 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package live_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/pb"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/testplanet"
+)
+
+func TestBandwidthReconciler(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		satellite.Accounting.BandwidthReconciler.Loop.Pause()
+
+		cache := satellite.LiveAccounting.Cache
+		projectID := testrand.UUID()
+		now := time.Now().UTC()
+
+		// the cache was populated before the allocation reached the rollups.
+		_, err := cache.InsertProjectBandwidthUsage(ctx, projectID, 100, time.Hour, now)
+		require.NoError(t, err)
+
+		err = satellite.DB.Orders().UpdateBucketBandwidthAllocation(ctx, projectID, []byte("testbucket"), pb.PieceAction_GET, 1000, now)
+		require.NoError(t, err)
+
+		satellite.Accounting.BandwidthReconciler.SetNow(func() time.Time { return now })
+		require.NoError(t, satellite.Accounting.BandwidthReconciler.RunOnce(ctx))
+
+		used, err := cache.GetProjectBandwidthUsage(ctx, projectID, now)
+		require.NoError(t, err)
+		require.EqualValues(t, 1000, used)
+
+		// usage above the rollups isn't lowered.
+		require.NoError(t, cache.UpdateProjectBandwidthUsage(ctx, projectID, 500, time.Hour, now))
+		require.NoError(t, satellite.Accounting.BandwidthReconciler.RunOnce(ctx))
+
+		used, err = cache.GetProjectBandwidthUsage(ctx, projectID, now)
+		require.NoError(t, err)
+		require.EqualValues(t, 1500, used)
+	})
+}
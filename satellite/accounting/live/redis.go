@@ -145,6 +145,73 @@ func (cache *redisLiveAccounting) UpdateProjectBandwidthUsage(ctx context.Contex
 	return nil
 }
 
+// ReconcileProjectBandwidthUsage raises the bandwidth cache key value to
+// value, when it's lower. The key is inserted when it doesn't exist.
+func (cache *redisLiveAccounting) ReconcileProjectBandwidthUsage(ctx context.Context, projectID uuid.UUID, value int64, ttl time.Duration, now time.Time) (currentUsed int64, err error) {
+	defer mon.Task()(&ctx, projectID, value, ttl, now)(&err)
+
+	// The following script raises the key to the value. The difference is
+	// added, so the increments made concurrently aren't lost and the
+	// expiration of an existing key is kept.
+	script := redis.NewScript(`local current
+	current = redis.call("get", KEYS[1])
+	if not current then
+		redis.call("set", KEYS[1], ARGV[1], "EX", ARGV[2])
+		return tonumber(ARGV[1])
+	end
+	if tonumber(current) < tonumber(ARGV[1]) then
+		return redis.call("incrby", KEYS[1], tonumber(ARGV[1]) - tonumber(current))
+	end
+	return tonumber(current)
+	`)
+
+	key := createBandwidthProjectIDKey(projectID, now)
+	currentUsed, err = script.Run(ctx, cache.client, []string{key}, value, int(ttl.Seconds())).Int64()
+	if err != nil {
+		return 0, accounting.ErrSystemOrNetError.New("Redis eval failed: %w", err)
+	}
+
+	return currentUsed, nil
+}
+
+// GetAllProjectBandwidthUsage returns the bandwidth usage of the projects,
+// which is cached for the day of now.
+func (cache *redisLiveAccounting) GetAllProjectBandwidthUsage(ctx context.Context, now time.Time) (_ map[uuid.UUID]int64, err error) {
+	defer mon.Task()(&ctx, now)(&err)
+
+	// the key suffix contains the month and the day.
+	suffix := strings.TrimPrefix(createBandwidthProjectIDKey(uuid.UUID{}, now), string(make([]byte, len(uuid.UUID{}))))
+
+	usage := make(map[uuid.UUID]int64)
+	it := cache.client.Scan(ctx, 0, "*:bandwidth", 0).Iterator()
+	for it.Next(ctx) {
+		key := it.Val()
+		if !strings.HasSuffix(key, suffix) || len(key) != len(uuid.UUID{})+len(suffix) {
+			continue
+		}
+
+		projectID, err := uuid.FromBytes([]byte(strings.TrimSuffix(key, suffix)))
+		if err != nil {
+			return nil, accounting.ErrUnexpectedValue.New("cannot parse the key as UUID; key=%q", key)
+		}
+
+		bandwidthUsage, err := cache.getInt64(ctx, key)
+		if err != nil {
+			if accounting.ErrKeyNotFound.Has(err) {
+				continue
+			}
+			return nil, err
+		}
+
+		usage[projectID] = bandwidthUsage
+	}
+	if err := it.Err(); err != nil {
+		return nil, accounting.ErrSystemOrNetError.New("Redis scan failed: %w", err)
+	}
+
+	return usage, nil
+}
+
 // GetProjectSegmentUsage returns the current segment usage from specific project.
 func (cache *redisLiveAccounting) GetProjectSegmentUsage(ctx context.Context, projectID uuid.UUID) (currentUsed int64, err error) {
 	defer mon.Task()(&ctx, projectID)(&err)
@@ -0,0 +1,91 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package projectops
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/sync2"
+)
+
+// Chore deletes the operation rollups older than the retention.
+//
+// architecture: Chore
+type Chore struct {
+	log    *zap.Logger
+	db     DB
+	config Config
+
+	nowFn func() time.Time
+	Loop  *sync2.Cycle
+}
+
+// NewChore creates a new chore for deleting old operation rollups.
+func NewChore(log *zap.Logger, db DB, config Config) *Chore {
+	return &Chore{
+		log:    log,
+		db:     db,
+		config: config,
+
+		nowFn: time.Now,
+		Loop:  sync2.NewCycle(config.CleanupInterval),
+	}
+}
+
+// Run starts the chore.
+func (chore *Chore) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return chore.Loop.Run(ctx, func(ctx context.Context) error {
+		err := chore.RunOnce(ctx)
+		if err != nil {
+			chore.log.Error("error deleting project operation rollups", zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// RunOnce deletes the operation rollups older than the retention.
+func (chore *Chore) RunOnce(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if chore.config.Retention <= 0 {
+		return Error.New("retention must be positive")
+	}
+
+	batchSize := chore.config.CleanupBatch
+	if batchSize <= 0 {
+		batchSize = 1000
+	}
+
+	before := chore.nowFn().UTC().Add(-chore.config.Retention)
+	var total int64
+	for {
+		deleted, err := chore.db.DeleteBefore(ctx, before, batchSize)
+		total += deleted
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		if deleted < int64(batchSize) {
+			break
+		}
+	}
+
+	mon.IntVal("projectops_deleted_rollups").Observe(total)
+	return nil
+}
+
+// Close stops the chore.
+func (chore *Chore) Close() error {
+	chore.Loop.Close()
+	return nil
+}
+
+// SetNow allows tests to have the chore act as if the current time is
+// whatever they want.
+func (chore *Chore) SetNow(nowFn func() time.Time) {
+	chore.nowFn = nowFn
+}
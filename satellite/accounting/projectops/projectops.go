@@ -0,0 +1,102 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package projectops counts the metainfo operations of the projects, so
+// support can see what a project is doing.
+package projectops
+
+import (
+	"context"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+)
+
+var (
+	// Error is the default error class for project operations.
+	Error = errs.Class("projectops")
+
+	mon = monkit.Package()
+)
+
+// Config contains configurable values for project operation counters.
+type Config struct {
+	Enabled         bool          `help:"whether the metainfo operations of projects are counted" default:"true"`
+	FlushInterval   time.Duration `help:"how often the counted operations are added to the database" default:"1m"`
+	Retention       time.Duration `help:"how long the hourly operation rollups are kept" default:"720h"`
+	CleanupInterval time.Duration `help:"how often old operation rollups are deleted" releaseDefault:"24h" devDefault:"1h" testDefault:"$TESTINTERVAL"`
+	CleanupBatch    int           `help:"number of operation rollups deleted per query" default:"1000"`
+}
+
+// Operation is a kind of metainfo operation.
+type Operation int
+
+const (
+	// Upload is the start of an object upload.
+	Upload Operation = iota
+	// Download is an object download.
+	Download
+	// List is a listing of objects.
+	List
+	// Delete is an object deletion.
+	Delete
+)
+
+// Counts contains the number of operations and of failed operations.
+type Counts struct {
+	Uploads   int64 `json:"uploads"`
+	Downloads int64 `json:"downloads"`
+	Lists     int64 `json:"lists"`
+	Deletes   int64 `json:"deletes"`
+	Errors    int64 `json:"errors"`
+}
+
+// Add adds the counts of other to counts.
+func (counts *Counts) Add(other Counts) {
+	counts.Uploads += other.Uploads
+	counts.Downloads += other.Downloads
+	counts.Lists += other.Lists
+	counts.Deletes += other.Deletes
+	counts.Errors += other.Errors
+}
+
+// add counts an operation.
+func (counts *Counts) add(op Operation, failed bool) {
+	switch op {
+	case Upload:
+		counts.Uploads++
+	case Download:
+		counts.Downloads++
+	case List:
+		counts.Lists++
+	case Delete:
+		counts.Deletes++
+	}
+	if failed {
+		counts.Errors++
+	}
+}
+
+// Rollup contains the operations of a project in an hour.
+type Rollup struct {
+	ProjectID     uuid.UUID `json:"-"`
+	IntervalStart time.Time `json:"intervalStart"`
+	Counts
+}
+
+// DB stores the hourly operation rollups of the projects.
+//
+// architecture: Database
+type DB interface {
+	// Add adds the counts to the rollups of the projects in the interval.
+	Add(ctx context.Context, intervalStart time.Time, counts map[uuid.UUID]Counts) error
+	// List returns the rollups of the project with an interval start in
+	// [since, before), the oldest first.
+	List(ctx context.Context, projectID uuid.UUID, since, before time.Time) ([]Rollup, error)
+	// DeleteBefore deletes at most batchSize rollups with an interval start
+	// before the given time and returns the number of deleted rollups.
+	DeleteBefore(ctx context.Context, before time.Time, batchSize int) (int64, error)
+}
@@ -0,0 +1,149 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package projectops
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/uuid"
+)
+
+// Recorder counts the operations of the projects in memory and adds them
+// periodically to the hourly rollups in the database.
+//
+// A nil Recorder and a disabled Recorder don't count anything.
+//
+// architecture: Service
+type Recorder struct {
+	log    *zap.Logger
+	db     DB
+	config Config
+
+	mu      sync.Mutex
+	pending map[time.Time]map[uuid.UUID]Counts
+
+	nowFn func() time.Time
+}
+
+// NewRecorder creates a new project operations recorder.
+func NewRecorder(log *zap.Logger, db DB, config Config) *Recorder {
+	if config.FlushInterval <= 0 {
+		config.FlushInterval = time.Minute
+	}
+	return &Recorder{
+		log:     log,
+		db:      db,
+		config:  config,
+		pending: map[time.Time]map[uuid.UUID]Counts{},
+		nowFn:   time.Now,
+	}
+}
+
+// Enabled returns whether operations are counted.
+func (recorder *Recorder) Enabled() bool {
+	return recorder != nil && recorder.config.Enabled
+}
+
+// Record counts the operation of the project. The operation is counted as
+// failed, when *errp is not nil. It's intended to be deferred.
+func (recorder *Recorder) Record(projectID uuid.UUID, op Operation, errp *error) {
+	if !recorder.Enabled() {
+		return
+	}
+
+	failed := errp != nil && *errp != nil
+	intervalStart := recorder.nowFn().UTC().Truncate(time.Hour)
+
+	recorder.mu.Lock()
+	defer recorder.mu.Unlock()
+
+	projects, ok := recorder.pending[intervalStart]
+	if !ok {
+		projects = map[uuid.UUID]Counts{}
+		recorder.pending[intervalStart] = projects
+	}
+	counts := projects[projectID]
+	counts.add(op, failed)
+	projects[projectID] = counts
+}
+
+// Run adds the counted operations to the database until ctx is canceled. The
+// remaining operations are added before returning.
+func (recorder *Recorder) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if !recorder.Enabled() {
+		return nil
+	}
+
+	ticker := time.NewTicker(recorder.config.FlushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			// use a new context, so the remaining operations can still be stored.
+			flushCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			recorder.Flush(flushCtx)
+			cancel()
+			return nil
+		case <-ticker.C:
+			recorder.Flush(ctx)
+		}
+	}
+}
+
+// Flush adds all counted operations to the database. Operations, which
+// couldn't be stored, are kept for the next flush.
+func (recorder *Recorder) Flush(ctx context.Context) {
+	if !recorder.Enabled() {
+		return
+	}
+
+	recorder.mu.Lock()
+	pending := recorder.pending
+	recorder.pending = map[time.Time]map[uuid.UUID]Counts{}
+	recorder.mu.Unlock()
+
+	for intervalStart, projects := range pending {
+		err := recorder.db.Add(ctx, intervalStart, projects)
+		if err != nil {
+			recorder.log.Error("failed to store project operations",
+				zap.Time("interval start", intervalStart),
+				zap.Int("projects", len(projects)),
+				zap.Error(err))
+			recorder.restore(intervalStart, projects)
+			continue
+		}
+		mon.Counter("projectops_flushed_projects").Inc(int64(len(projects)))
+	}
+}
+
+// restore adds the counts, which couldn't be stored, back to the pending
+// counts.
+func (recorder *Recorder) restore(intervalStart time.Time, projects map[uuid.UUID]Counts) {
+	recorder.mu.Lock()
+	defer recorder.mu.Unlock()
+
+	current, ok := recorder.pending[intervalStart]
+	if !ok {
+		recorder.pending[intervalStart] = projects
+		return
+	}
+	for projectID, counts := range projects {
+		merged := current[projectID]
+		merged.Add(counts)
+		current[projectID] = merged
+	}
+}
+
+// SetNow allows tests to have the recorder act as if the current time is
+// whatever they want.
+func (recorder *Recorder) SetNow(nowFn func() time.Time) {
+	recorder.nowFn = nowFn
+}
@@ -0,0 +1,112 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package projectops_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/accounting/projectops"
+)
+
+func TestRecorder(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	first, second := testrand.UUID(), testrand.UUID()
+	db := newFakeDB()
+
+	now := time.Date(2023, 3, 1, 10, 30, 0, 0, time.UTC)
+	recorder := projectops.NewRecorder(zaptest.NewLogger(t), db, projectops.Config{Enabled: true})
+	recorder.SetNow(func() time.Time { return now })
+
+	failure := errors.New("failure")
+	record := func(projectID uuid.UUID, op projectops.Operation, err error) {
+		recorder.Record(projectID, op, &err)
+	}
+
+	record(first, projectops.Upload, nil)
+	record(first, projectops.Upload, failure)
+	record(first, projectops.List, nil)
+	record(second, projectops.Download, nil)
+
+	// the store fails, so the counts are kept for the next flush.
+	db.fail = true
+	recorder.Flush(ctx)
+	require.Empty(t, db.rollups)
+
+	now = now.Add(time.Hour)
+	record(first, projectops.Delete, nil)
+	record(second, projectops.Download, nil)
+
+	db.fail = false
+	recorder.Flush(ctx)
+
+	hour := time.Date(2023, 3, 1, 10, 0, 0, 0, time.UTC)
+	require.Equal(t, map[time.Time]map[uuid.UUID]projectops.Counts{
+		hour: {
+			first:  {Uploads: 2, Lists: 1, Errors: 1},
+			second: {Downloads: 1},
+		},
+		hour.Add(time.Hour): {
+			first:  {Deletes: 1},
+			second: {Downloads: 1},
+		},
+	}, db.rollups)
+
+	// nothing is added twice.
+	recorder.Flush(ctx)
+	require.Len(t, db.rollups, 2)
+
+	// a nil recorder doesn't count anything.
+	var disabled *projectops.Recorder
+	disabled.Record(first, projectops.Upload, nil)
+	disabled.Flush(ctx)
+}
+
+type fakeDB struct {
+	mu      sync.Mutex
+	fail    bool
+	rollups map[time.Time]map[uuid.UUID]projectops.Counts
+}
+
+func newFakeDB() *fakeDB {
+	return &fakeDB{rollups: map[time.Time]map[uuid.UUID]projectops.Counts{}}
+}
+
+func (db *fakeDB) Add(ctx context.Context, intervalStart time.Time, counts map[uuid.UUID]projectops.Counts) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	if db.fail {
+		return errors.New("database is unavailable")
+	}
+	projects, ok := db.rollups[intervalStart]
+	if !ok {
+		projects = map[uuid.UUID]projectops.Counts{}
+		db.rollups[intervalStart] = projects
+	}
+	for projectID, c := range counts {
+		current := projects[projectID]
+		current.Add(c)
+		projects[projectID] = current
+	}
+	return nil
+}
+
+func (db *fakeDB) List(ctx context.Context, projectID uuid.UUID, since, before time.Time) ([]projectops.Rollup, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (db *fakeDB) DeleteBefore(ctx context.Context, before time.Time, batchSize int) (int64, error) {
+	return 0, errors.New("not implemented")
+}
@@ -0,0 +1,59 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package projectops
+
+import (
+	"context"
+	"time"
+
+	"storj.io/common/uuid"
+)
+
+// Summary contains the operations of a project in a period.
+type Summary struct {
+	Since  time.Time `json:"since"`
+	Before time.Time `json:"before"`
+	Total  Counts    `json:"total"`
+	Hourly []Rollup  `json:"hourly"`
+}
+
+// Service returns the counted operations of the projects.
+//
+// architecture: Service
+type Service struct {
+	db DB
+}
+
+// NewService creates a new project operations service.
+func NewService(db DB) *Service {
+	return &Service{db: db}
+}
+
+// Summary returns the hourly and the total operations of the project with an
+// hour in [since, before).
+func (service *Service) Summary(ctx context.Context, projectID uuid.UUID, since, before time.Time) (_ Summary, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if !since.Before(before) {
+		return Summary{}, Error.New("since (%s) must be before %s", since, before)
+	}
+
+	rollups, err := service.db.List(ctx, projectID, since.UTC().Truncate(time.Hour), before.UTC())
+	if err != nil {
+		return Summary{}, Error.Wrap(err)
+	}
+
+	summary := Summary{
+		Since:  since,
+		Before: before,
+		Hourly: rollups,
+	}
+	if summary.Hourly == nil {
+		summary.Hourly = []Rollup{}
+	}
+	for _, rollup := range rollups {
+		summary.Total.Add(rollup.Counts)
+	}
+	return summary, nil
+}
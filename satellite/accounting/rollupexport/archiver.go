@@ -0,0 +1,338 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package rollupexport
+
+import (
+	"context"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/sync2"
+	"storj.io/uplink"
+)
+
+// Archiver exports the rollups older than the configured number of months to
+// the bucket and deletes them from the database.
+//
+// architecture: Chore
+type Archiver struct {
+	log    *zap.Logger
+	db     DB
+	config Config
+	Loop   *sync2.Cycle
+
+	nowFn func() time.Time
+}
+
+// NewArchiver creates a new rollup archiver.
+func NewArchiver(log *zap.Logger, db DB, config Config) *Archiver {
+	return &Archiver{
+		log:    log,
+		db:     db,
+		config: config,
+		Loop:   sync2.NewCycle(config.Interval),
+
+		nowFn: time.Now,
+	}
+}
+
+// Run exports the old rollups until ctx is canceled.
+func (archiver *Archiver) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if !archiver.config.Enabled {
+		return nil
+	}
+	if archiver.config.Access == "" || archiver.config.Bucket == "" {
+		return Error.New("access and bucket are required")
+	}
+	if archiver.config.Months < 0 {
+		return Error.New("months can't be less than 0")
+	}
+
+	return archiver.Loop.Run(ctx, func(ctx context.Context) error {
+		if err := archiver.RunOnce(ctx); err != nil {
+			archiver.log.Error("failed to export rollups", zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// SetNow allows tests to have the archiver act as if the current time is
+// whatever they want.
+func (archiver *Archiver) SetNow(nowFn func() time.Time) {
+	archiver.nowFn = nowFn
+}
+
+// Cutoff returns the start of the oldest month, which is kept in the
+// database.
+func (archiver *Archiver) Cutoff() time.Time {
+	return StartOfMonth(archiver.nowFn()).AddDate(0, -archiver.config.Months, 0)
+}
+
+// RunOnce exports the rollups of every month before the cutoff, the oldest
+// first, and deletes them after they're uploaded. Pinned months are skipped.
+func (archiver *Archiver) RunOnce(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	cutoff := archiver.Cutoff()
+
+	project, err := openProject(ctx, archiver.config.Access)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, Error.Wrap(project.Close())) }()
+
+	pinned, err := pinnedMonths(ctx, project, archiver.config)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	for _, table := range Tables {
+		oldest, ok, err := archiver.db.Oldest(ctx, table)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		if !ok {
+			continue
+		}
+
+		for month := StartOfMonth(oldest); month.Before(cutoff); month = month.AddDate(0, 1, 0) {
+			if pinned[month.Format("2006-01")] {
+				continue
+			}
+			if err := archiver.archiveMonth(ctx, project, table, month); err != nil {
+				return Error.New("%s %s: %w", table, month.Format("2006-01"), err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// archiveMonth uploads the rows of the table and month and deletes them.
+func (archiver *Archiver) archiveMonth(ctx context.Context, project *uplink.Project, table Table, month time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	from, to := month, month.AddDate(0, 1, 0)
+	key := ObjectKey(archiver.config.Prefix, table, month, archiver.nowFn())
+
+	upload, err := project.UploadObject(ctx, archiver.config.Bucket, key, nil)
+	if err != nil {
+		return err
+	}
+
+	exported, err := archiver.encode(ctx, upload, table, from, to)
+	if err != nil {
+		return errs.Combine(err, upload.Abort())
+	}
+	if exported == 0 {
+		return upload.Abort()
+	}
+	if err := upload.Commit(); err != nil {
+		return err
+	}
+
+	deleted, err := archiver.db.Delete(ctx, table, from, to, archiver.config.BatchSize)
+	if err != nil {
+		return err
+	}
+
+	archiver.log.Info("exported rollups",
+		zap.String("Table", string(table)),
+		zap.String("Month", month.Format("2006-01")),
+		zap.String("Key", key),
+		zap.Int64("Exported", exported),
+		zap.Int64("Deleted", deleted))
+	mon.IntVal("rollupexport_exported_rows", monkit.NewSeriesTag("table", string(table))).Observe(exported)
+
+	return nil
+}
+
+// encode writes the rows of the table within [from, to) to w.
+func (archiver *Archiver) encode(ctx context.Context, w io.Writer, table Table, from, to time.Time) (int64, error) {
+	batchSize := archiver.config.BatchSize
+
+	switch table {
+	case StorageTalliesTable:
+		return encodeRows(w, func(fn func(StorageTally) error) error {
+			return archiver.db.IterateStorageTallies(ctx, from, to, batchSize, fn)
+		})
+	case BucketBandwidthTable:
+		return encodeRows(w, func(fn func(BucketBandwidth) error) error {
+			return archiver.db.IterateBucketBandwidth(ctx, from, to, batchSize, fn)
+		})
+	case NodeBandwidthTable:
+		return encodeRows(w, func(fn func(NodeBandwidth) error) error {
+			return archiver.db.IterateNodeBandwidth(ctx, from, to, batchSize, fn)
+		})
+	default:
+		return 0, Error.New("unknown table %q", table)
+	}
+}
+
+// Restore imports the exported rollups of the months back into the database
+// and pins the months, so the archiver doesn't export them again until they're
+// unpinned. When a month was exported more than once, the exports are merged,
+// the newest first. Rows, which already exist, are skipped, so a month can be
+// restored more than once. Months without exports are logged and skipped.
+func Restore(ctx context.Context, log *zap.Logger, db DB, config Config, months []time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	project, err := openProject(ctx, config.Access)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, Error.Wrap(project.Close())) }()
+
+	for _, month := range months {
+		// pin the month before restoring it, so a running archiver doesn't
+		// delete the restored rows again.
+		if err := pin(ctx, project, config, month); err != nil {
+			return Error.New("pin %s: %w", month.Format("2006-01"), err)
+		}
+
+		for _, table := range Tables {
+			keys, err := listKeys(ctx, project, config.Bucket, MonthPrefix(config.Prefix, table, month))
+			if err != nil {
+				return Error.Wrap(err)
+			}
+			if len(keys) == 0 {
+				log.Info("no exported rollups", zap.String("Table", string(table)), zap.String("Month", month.Format("2006-01")))
+				continue
+			}
+
+			// the keys end with the export time, restore the newest export
+			// first, so its rows take precedence over older exports.
+			sort.Sort(sort.Reverse(sort.StringSlice(keys)))
+
+			for _, key := range keys {
+				restored, err := restoreObject(ctx, project, db, config, table, key)
+				if err != nil {
+					return Error.New("%s: %w", key, err)
+				}
+
+				log.Info("restored rollups",
+					zap.String("Table", string(table)),
+					zap.String("Key", key),
+					zap.Int64("Rows", restored))
+			}
+		}
+	}
+
+	return nil
+}
+
+// Unpin unpins the months, so the archiver exports them again. The rows of
+// the months are exported under new keys and don't replace earlier exports.
+func Unpin(ctx context.Context, log *zap.Logger, config Config, months []time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	project, err := openProject(ctx, config.Access)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, Error.Wrap(project.Close())) }()
+
+	for _, month := range months {
+		key := PinKey(config.Prefix, month)
+		if _, err := project.DeleteObject(ctx, config.Bucket, key); err != nil {
+			return Error.New("%s: %w", key, err)
+		}
+		log.Info("unpinned month", zap.String("Month", month.Format("2006-01")))
+	}
+
+	return nil
+}
+
+// pin uploads the marker, which pins the month.
+func pin(ctx context.Context, project *uplink.Project, config Config, month time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	upload, err := project.UploadObject(ctx, config.Bucket, PinKey(config.Prefix, month), nil)
+	if err != nil {
+		return err
+	}
+	return upload.Commit()
+}
+
+// pinnedMonths returns the pinned months, formatted as YYYY-MM.
+func pinnedMonths(ctx context.Context, project *uplink.Project, config Config) (_ map[string]bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	prefix := PinPrefix(config.Prefix)
+	keys, err := listKeys(ctx, project, config.Bucket, prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	pinned := make(map[string]bool, len(keys))
+	for _, key := range keys {
+		pinned[strings.TrimPrefix(key, prefix)] = true
+	}
+	return pinned, nil
+}
+
+// listKeys returns the keys of the objects with the prefix.
+func listKeys(ctx context.Context, project *uplink.Project, bucket, prefix string) (keys []string, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	objects := project.ListObjects(ctx, bucket, &uplink.ListObjectsOptions{
+		Prefix:    prefix,
+		Recursive: true,
+	})
+	for objects.Next() {
+		keys = append(keys, objects.Item().Key)
+	}
+	return keys, objects.Err()
+}
+
+// restoreObject inserts the rows of the exported object into the table.
+func restoreObject(ctx context.Context, project *uplink.Project, db DB, config Config, table Table, key string) (_ int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	download, err := project.DownloadObject(ctx, config.Bucket, key, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer func() { err = errs.Combine(err, download.Close()) }()
+
+	switch table {
+	case StorageTalliesTable:
+		return decodeRows(download, config.BatchSize, func(rows []StorageTally) error {
+			return db.InsertStorageTallies(ctx, rows)
+		})
+	case BucketBandwidthTable:
+		return decodeRows(download, config.BatchSize, func(rows []BucketBandwidth) error {
+			return db.InsertBucketBandwidth(ctx, rows)
+		})
+	case NodeBandwidthTable:
+		return decodeRows(download, config.BatchSize, func(rows []NodeBandwidth) error {
+			return db.InsertNodeBandwidth(ctx, rows)
+		})
+	default:
+		return 0, Error.New("unknown table %q", table)
+	}
+}
+
+// openProject opens the project of the access grant.
+func openProject(ctx context.Context, serializedAccess string) (*uplink.Project, error) {
+	access, err := uplink.ParseAccess(serializedAccess)
+	if err != nil {
+		return nil, err
+	}
+	return uplink.OpenProject(ctx, access)
+}
+
+// Close stops the archiver.
+func (archiver *Archiver) Close() error {
+	archiver.Loop.Close()
+	return nil
+}
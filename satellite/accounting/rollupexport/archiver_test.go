@@ -0,0 +1,167 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package rollupexport_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/pb"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite/accounting/rollupexport"
+)
+
+func TestArchiver(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		db := sat.DB.RollupExport()
+
+		now := time.Date(2023, 5, 14, 8, 0, 0, 0, time.UTC)
+		old := time.Date(2023, 2, 3, 0, 0, 0, 0, time.UTC)
+		recent := time.Date(2023, 4, 20, 0, 0, 0, 0, time.UTC)
+
+		projectID := testrand.UUID()
+		nodeID := testrand.NodeID()
+
+		tallies := []rollupexport.StorageTally{
+			{ProjectID: projectID, BucketName: "old", IntervalStart: old, TotalBytes: 100, Remote: 100, TotalSegments: 1, RemoteSegments: 1, ObjectCount: 1},
+			{ProjectID: projectID, BucketName: "recent", IntervalStart: recent, TotalBytes: 200, Inline: 200, TotalSegments: 2, InlineSegments: 2, ObjectCount: 2},
+		}
+		require.NoError(t, db.InsertStorageTallies(ctx, tallies))
+
+		buckets := []rollupexport.BucketBandwidth{
+			{ProjectID: projectID, BucketName: "old", IntervalStart: old, IntervalSeconds: 3600, Action: int(pb.PieceAction_GET), Allocated: 10, Settled: 5},
+			{ProjectID: projectID, BucketName: "recent", IntervalStart: recent, IntervalSeconds: 3600, Action: int(pb.PieceAction_PUT), Inline: 3},
+		}
+		require.NoError(t, db.InsertBucketBandwidth(ctx, buckets))
+
+		nodes := []rollupexport.NodeBandwidth{
+			{NodeID: nodeID, IntervalStart: old, IntervalSeconds: 3600, Action: int(pb.PieceAction_GET), Allocated: 10, Settled: 5},
+			{NodeID: nodeID, IntervalStart: recent, IntervalSeconds: 3600, Action: int(pb.PieceAction_PUT), Settled: 7},
+		}
+		require.NoError(t, db.InsertNodeBandwidth(ctx, nodes))
+
+		bucketName := "rollups"
+		require.NoError(t, planet.Uplinks[0].CreateBucket(ctx, sat, bucketName))
+
+		access, err := planet.Uplinks[0].Access[sat.ID()].Serialize()
+		require.NoError(t, err)
+
+		config := rollupexport.Config{
+			Enabled:   true,
+			Interval:  time.Hour,
+			Months:    1,
+			Access:    access,
+			Bucket:    bucketName,
+			Prefix:    "rollups/",
+			BatchSize: 1,
+		}
+
+		archiver := rollupexport.NewArchiver(zaptest.NewLogger(t), db, config)
+		defer ctx.Check(archiver.Close)
+		archiver.SetNow(func() time.Time { return now })
+		require.Equal(t, time.Date(2023, 4, 1, 0, 0, 0, 0, time.UTC), archiver.Cutoff())
+
+		require.NoError(t, archiver.RunOnce(ctx))
+
+		objects, err := planet.Uplinks[0].ListObjects(ctx, sat, bucketName)
+		require.NoError(t, err)
+		var keys []string
+		for _, object := range objects {
+			keys = append(keys, object.Key)
+		}
+		require.ElementsMatch(t, []string{
+			"rollups/bucket_storage_tallies/2023-02/20230514T080000.000000000Z.jsonl.gz",
+			"rollups/bucket_bandwidth_rollup_archives/2023-02/20230514T080000.000000000Z.jsonl.gz",
+			"rollups/storagenode_bandwidth_rollup_archives/2023-02/20230514T080000.000000000Z.jsonl.gz",
+		}, keys)
+
+		for _, table := range rollupexport.Tables {
+			oldest, ok, err := db.Oldest(ctx, table)
+			require.NoError(t, err)
+			require.True(t, ok)
+			require.Equal(t, recent, oldest.UTC())
+		}
+
+		// running again doesn't export anything new.
+		require.NoError(t, archiver.RunOnce(ctx))
+
+		restoreMonths := []time.Time{old, time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)}
+		require.NoError(t, rollupexport.Restore(ctx, zaptest.NewLogger(t), db, config, restoreMonths))
+		// restoring twice doesn't duplicate rows.
+		require.NoError(t, rollupexport.Restore(ctx, zaptest.NewLogger(t), db, config, restoreMonths))
+
+		// the restored months are pinned and aren't exported again.
+		require.NoError(t, archiver.RunOnce(ctx))
+		oldest, ok, err := db.Oldest(ctx, rollupexport.StorageTalliesTable)
+		require.NoError(t, err)
+		require.True(t, ok)
+		require.Equal(t, old, oldest.UTC())
+
+		// exporting an unpinned month again keeps the earlier export and a
+		// restore merges both.
+		late := rollupexport.StorageTally{ProjectID: projectID, BucketName: "late", IntervalStart: old, TotalBytes: 300, Remote: 300, TotalSegments: 1, RemoteSegments: 1, ObjectCount: 1}
+		require.NoError(t, db.InsertStorageTallies(ctx, []rollupexport.StorageTally{late}))
+		require.NoError(t, rollupexport.Unpin(ctx, zaptest.NewLogger(t), config, restoreMonths))
+
+		later := now.Add(time.Hour)
+		archiver.SetNow(func() time.Time { return later })
+		require.NoError(t, archiver.RunOnce(ctx))
+
+		objects, err = planet.Uplinks[0].ListObjects(ctx, sat, bucketName)
+		require.NoError(t, err)
+		var tallyKeys []string
+		for _, object := range objects {
+			if strings.HasPrefix(object.Key, "rollups/bucket_storage_tallies/") {
+				tallyKeys = append(tallyKeys, object.Key)
+			}
+		}
+		require.ElementsMatch(t, []string{
+			"rollups/bucket_storage_tallies/2023-02/20230514T080000.000000000Z.jsonl.gz",
+			"rollups/bucket_storage_tallies/2023-02/20230514T090000.000000000Z.jsonl.gz",
+		}, tallyKeys)
+
+		oldest, ok, err = db.Oldest(ctx, rollupexport.StorageTalliesTable)
+		require.NoError(t, err)
+		require.True(t, ok)
+		require.Equal(t, recent, oldest.UTC())
+
+		require.NoError(t, rollupexport.Restore(ctx, zaptest.NewLogger(t), db, config, restoreMonths))
+		tallies = append([]rollupexport.StorageTally{late}, tallies...)
+
+		from, to := time.Time{}, now
+
+		var gotTallies []rollupexport.StorageTally
+		require.NoError(t, db.IterateStorageTallies(ctx, from, to, 1, func(tally rollupexport.StorageTally) error {
+			tally.IntervalStart = tally.IntervalStart.UTC()
+			gotTallies = append(gotTallies, tally)
+			return nil
+		}))
+		require.Equal(t, tallies, gotTallies)
+
+		var gotBuckets []rollupexport.BucketBandwidth
+		require.NoError(t, db.IterateBucketBandwidth(ctx, from, to, 1, func(rollup rollupexport.BucketBandwidth) error {
+			rollup.IntervalStart = rollup.IntervalStart.UTC()
+			gotBuckets = append(gotBuckets, rollup)
+			return nil
+		}))
+		require.Equal(t, buckets, gotBuckets)
+
+		var gotNodes []rollupexport.NodeBandwidth
+		require.NoError(t, db.IterateNodeBandwidth(ctx, from, to, 1, func(rollup rollupexport.NodeBandwidth) error {
+			rollup.IntervalStart = rollup.IntervalStart.UTC()
+			gotNodes = append(gotNodes, rollup)
+			return nil
+		}))
+		require.Equal(t, nodes, gotNodes)
+	})
+}
@@ -0,0 +1,67 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package rollupexport
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"io"
+
+	"github.com/zeebo/errs"
+)
+
+// encodeRows writes the rows produced by iterate to w as gzip compressed
+// newline delimited json, and returns their number.
+func encodeRows[R any](w io.Writer, iterate func(fn func(R) error) error) (count int64, err error) {
+	compressed := gzip.NewWriter(w)
+	encoder := json.NewEncoder(compressed)
+
+	err = iterate(func(row R) error {
+		count++
+		return encoder.Encode(row)
+	})
+	return count, errs.Combine(err, compressed.Close())
+}
+
+// decodeRows reads the gzip compressed newline delimited json rows from r and
+// passes them to insert in batches. It returns the number of rows.
+func decodeRows[R any](r io.Reader, batchSize int, insert func([]R) error) (count int64, err error) {
+	compressed, err := gzip.NewReader(r)
+	if err != nil {
+		return 0, err
+	}
+	defer func() { err = errs.Combine(err, compressed.Close()) }()
+
+	decoder := json.NewDecoder(compressed)
+
+	batch := make([]R, 0, batchSize)
+	for {
+		var row R
+		err := decoder.Decode(&row)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return count, err
+		}
+
+		batch = append(batch, row)
+		if len(batch) >= batchSize {
+			if err := insert(batch); err != nil {
+				return count, err
+			}
+			count += int64(len(batch))
+			batch = batch[:0]
+		}
+	}
+
+	if len(batch) > 0 {
+		if err := insert(batch); err != nil {
+			return count, err
+		}
+		count += int64(len(batch))
+	}
+	return count, nil
+}
@@ -0,0 +1,51 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package rollupexport
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testrand"
+)
+
+func TestCodec(t *testing.T) {
+	intervalStart := time.Date(2023, 2, 3, 4, 0, 0, 0, time.UTC)
+
+	var rows []NodeBandwidth
+	for i := 0; i < 5; i++ {
+		rows = append(rows, NodeBandwidth{
+			NodeID:          testrand.NodeID(),
+			IntervalStart:   intervalStart.Add(time.Duration(i) * time.Hour),
+			IntervalSeconds: 3600,
+			Action:          i,
+			Allocated:       int64(i * 10),
+			Settled:         int64(i),
+		})
+	}
+
+	var buf bytes.Buffer
+	count, err := encodeRows(&buf, func(fn func(NodeBandwidth) error) error {
+		for _, row := range rows {
+			if err := fn(row); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	require.EqualValues(t, len(rows), count)
+
+	var batches [][]NodeBandwidth
+	count, err = decodeRows(&buf, 2, func(batch []NodeBandwidth) error {
+		batches = append(batches, append([]NodeBandwidth(nil), batch...))
+		return nil
+	})
+	require.NoError(t, err)
+	require.EqualValues(t, len(rows), count)
+	require.Equal(t, [][]NodeBandwidth{rows[0:2], rows[2:4], rows[4:5]}, batches)
+}
@@ -0,0 +1,156 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package rollupexport moves the finalized accounting rollups out of the
+// database into compressed files in a bucket, and restores them.
+//
+// The rollups are exported per table and calendar month, as gzip compressed
+// newline delimited json, so that a month can be restored independently.
+// Every export of a month is stored under its own key, so that exporting a
+// month again, e.g. after rows were added to it, doesn't replace the earlier
+// exports. A restore merges all exports of the month.
+//
+// Restored months are pinned, which keeps the archiver from exporting and
+// deleting them again until they're unpinned.
+package rollupexport
+
+import (
+	"context"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+)
+
+var (
+	// Error is the error class for rollup exports.
+	Error = errs.Class("rollup export")
+
+	mon = monkit.Package()
+)
+
+// Config contains configurable values for the rollup exports.
+type Config struct {
+	Enabled   bool          `help:"whether old rollups are exported to the bucket and deleted from the database" default:"false"`
+	Interval  time.Duration `help:"how often old rollups are exported" releaseDefault:"24h" devDefault:"1h" testDefault:"$TESTINTERVAL"`
+	Months    int           `help:"number of full months before the current one, whose rollups are kept in the database" default:"12"`
+	Access    string        `help:"access grant of the bucket, which the rollups are exported to" default:""`
+	Bucket    string        `help:"bucket, which the rollups are exported to" default:""`
+	Prefix    string        `help:"key prefix of the exported rollups" default:"rollups/"`
+	BatchSize int           `help:"number of rows read, deleted or restored at once" default:"1000"`
+}
+
+// Table is a rollup table, which is exported.
+type Table string
+
+const (
+	// StorageTalliesTable contains the storage tallies of the buckets.
+	StorageTalliesTable Table = "bucket_storage_tallies"
+	// BucketBandwidthTable contains the archived bandwidth rollups of the
+	// buckets.
+	BucketBandwidthTable Table = "bucket_bandwidth_rollup_archives"
+	// NodeBandwidthTable contains the archived bandwidth rollups of the
+	// storage nodes.
+	NodeBandwidthTable Table = "storagenode_bandwidth_rollup_archives"
+)
+
+// Tables are the tables, which are exported.
+var Tables = []Table{StorageTalliesTable, BucketBandwidthTable, NodeBandwidthTable}
+
+// StorageTally is a row of the bucket storage tallies.
+type StorageTally struct {
+	ProjectID      uuid.UUID `json:"projectId"`
+	BucketName     string    `json:"bucketName"`
+	IntervalStart  time.Time `json:"intervalStart"`
+	TotalBytes     int64     `json:"totalBytes"`
+	Inline         int64     `json:"inline"`
+	Remote         int64     `json:"remote"`
+	TotalSegments  int64     `json:"totalSegments"`
+	RemoteSegments int64     `json:"remoteSegments"`
+	InlineSegments int64     `json:"inlineSegments"`
+	ObjectCount    int64     `json:"objectCount"`
+	MetadataSize   int64     `json:"metadataSize"`
+}
+
+// BucketBandwidth is a row of the archived bucket bandwidth rollups.
+type BucketBandwidth struct {
+	ProjectID       uuid.UUID `json:"projectId"`
+	BucketName      string    `json:"bucketName"`
+	IntervalStart   time.Time `json:"intervalStart"`
+	IntervalSeconds int       `json:"intervalSeconds"`
+	Action          int       `json:"action"`
+	Inline          int64     `json:"inline"`
+	Allocated       int64     `json:"allocated"`
+	Settled         int64     `json:"settled"`
+}
+
+// NodeBandwidth is a row of the archived storage node bandwidth rollups.
+type NodeBandwidth struct {
+	NodeID          storj.NodeID `json:"nodeId"`
+	IntervalStart   time.Time    `json:"intervalStart"`
+	IntervalSeconds int          `json:"intervalSeconds"`
+	Action          int          `json:"action"`
+	Allocated       int64        `json:"allocated"`
+	Settled         int64        `json:"settled"`
+}
+
+// DB reads, deletes and restores the rollups of the exported tables.
+//
+// architecture: Database
+type DB interface {
+	// Oldest returns the oldest interval start of the table. ok is false,
+	// when the table is empty.
+	Oldest(ctx context.Context, table Table) (_ time.Time, ok bool, err error)
+	// Delete deletes the rows of the table with an interval start within
+	// [from, to) and returns their number.
+	Delete(ctx context.Context, table Table, from, to time.Time, batchSize int) (int64, error)
+
+	// IterateStorageTallies calls fn for the storage tallies with an interval
+	// start within [from, to). The rows are read in batches.
+	IterateStorageTallies(ctx context.Context, from, to time.Time, batchSize int, fn func(StorageTally) error) error
+	// IterateBucketBandwidth calls fn for the bucket bandwidth rollups with
+	// an interval start within [from, to). The rows are read in batches.
+	IterateBucketBandwidth(ctx context.Context, from, to time.Time, batchSize int, fn func(BucketBandwidth) error) error
+	// IterateNodeBandwidth calls fn for the storage node bandwidth rollups
+	// with an interval start within [from, to). The rows are read in batches.
+	IterateNodeBandwidth(ctx context.Context, from, to time.Time, batchSize int, fn func(NodeBandwidth) error) error
+
+	// InsertStorageTallies inserts the storage tallies, which don't exist.
+	InsertStorageTallies(ctx context.Context, tallies []StorageTally) error
+	// InsertBucketBandwidth inserts the bucket bandwidth rollups, which don't
+	// exist.
+	InsertBucketBandwidth(ctx context.Context, rollups []BucketBandwidth) error
+	// InsertNodeBandwidth inserts the storage node bandwidth rollups, which
+	// don't exist.
+	InsertNodeBandwidth(ctx context.Context, rollups []NodeBandwidth) error
+}
+
+// StartOfMonth returns the start of the month of t in UTC.
+func StartOfMonth(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
+
+// MonthPrefix returns the key prefix of the exports of the table and month.
+func MonthPrefix(prefix string, table Table, month time.Time) string {
+	return prefix + string(table) + "/" + StartOfMonth(month).Format("2006-01") + "/"
+}
+
+// ObjectKey returns the key of the rollups of the table and month, which were
+// exported at exportedAt.
+func ObjectKey(prefix string, table Table, month, exportedAt time.Time) string {
+	return MonthPrefix(prefix, table, month) + exportedAt.UTC().Format("20060102T150405.000000000Z") + ".jsonl.gz"
+}
+
+// PinPrefix returns the key prefix of the markers of the pinned months.
+func PinPrefix(prefix string) string {
+	return prefix + "pinned/"
+}
+
+// PinKey returns the key of the marker, which pins the month.
+func PinKey(prefix string, month time.Time) string {
+	return PinPrefix(prefix) + StartOfMonth(month).Format("2006-01")
+}
@@ -33,6 +33,10 @@ type Config struct {
 
 	ListLimit          int           `help:"how many objects to query in a batch" default:"2500"`
 	AsOfSystemInterval time.Duration `help:"as of system interval" releaseDefault:"-5m" devDefault:"-1us" testDefault:"-1us"`
+
+	UseDeltas         bool          `help:"flag to update bucket tallies with the changes recorded by metabase instead of calculating them every time, not supported with the objects loop" default:"false"`
+	FullTallyInterval time.Duration `help:"how frequently bucket tallies are fully recalculated when deltas are used" default:"24h"`
+	DeltaBatchSize    int           `help:"how many tally deltas to consume in a batch" default:"10000"`
 }
 
 // Service is the tally service for data stored on each storage node.
@@ -49,6 +53,10 @@ type Service struct {
 	storagenodeAccountingDB accounting.StoragenodeAccounting
 	projectAccountingDB     accounting.ProjectAccounting
 	nowFn                   func() time.Time
+
+	// tallies and lastFullTally are only used with deltas.
+	tallies       map[metabase.BucketLocation]*accounting.BucketTally
+	lastFullTally time.Time
 }
 
 // New creates a new tally Service.
@@ -193,8 +201,7 @@ func (service *Service) Tally(ctx context.Context) (err error) {
 	}
 
 	// add up all buckets
-	collector := NewBucketTallyCollector(service.log.Named("observer"), service.nowFn(), service.metabase, service.bucketsDB, service.config)
-	err = collector.Run(ctx)
+	tallies, err := service.collectTallies(ctx)
 	if err != nil {
 		return Error.Wrap(err)
 	}
@@ -202,23 +209,23 @@ func (service *Service) Tally(ctx context.Context) (err error) {
 
 	// save the new results
 	var errAtRest error
-	if len(collector.Bucket) > 0 {
+	if len(tallies) > 0 {
 		// record bucket tallies to DB
-		err = service.projectAccountingDB.SaveTallies(ctx, finishTime, collector.Bucket)
+		err = service.projectAccountingDB.SaveTallies(ctx, finishTime, tallies)
 		if err != nil {
 			errAtRest = Error.New("ProjectAccounting.SaveTallies failed: %v", err)
 		}
 
-		updateLiveAccountingTotals(projectTotalsFromBuckets(collector.Bucket))
+		updateLiveAccountingTotals(projectTotalsFromBuckets(tallies))
 	}
 
-	if len(collector.Bucket) > 0 {
+	if len(tallies) > 0 {
 		var total accounting.BucketTally
 		// TODO for now we don't have access to inline/remote stats per bucket
 		// but that may change in the future. To get back those stats we would
 		// most probably need to add inline/remote information to object in
 		// metabase. We didn't decide yet if that is really needed right now.
-		for _, bucket := range collector.Bucket {
+		for _, bucket := range tallies {
 			monAccounting.IntVal("bucket_objects").Observe(bucket.ObjectCount) //mon:locked
 			monAccounting.IntVal("bucket_segments").Observe(bucket.Segments()) //mon:locked
 			// monAccounting.IntVal("bucket_inline_segments").Observe(bucket.InlineSegments) //mon:locked
@@ -239,6 +246,109 @@ func (service *Service) Tally(ctx context.Context) (err error) {
 	return errAtRest
 }
 
+// collectTallies returns the current tallies of all buckets.
+//
+// When deltas are used, the tallies are only fully recalculated once per
+// FullTallyInterval, otherwise the changes recorded by metabase since the
+// previous cycle are added to the tallies of the previous cycle. The full
+// tally reads the deltas from the same snapshot as the objects and deletes
+// exactly those, which are part of it. Deltas can't be matched to the objects
+// loop, so it always collects full tallies.
+func (service *Service) collectTallies(ctx context.Context) (_ map[metabase.BucketLocation]*accounting.BucketTally, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	now := service.nowFn()
+	useDeltas := service.config.UseDeltas && !service.config.UseObjectsLoop
+	if useDeltas && service.tallies != nil && now.Sub(service.lastFullTally) < service.config.FullTallyInterval {
+		err := service.applyDeltas(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return service.tallies, nil
+	}
+
+	// a failed full tally may have deleted some of the deltas already, so the
+	// previous tallies can't be used anymore.
+	service.tallies = nil
+
+	collector := NewBucketTallyCollector(service.log.Named("observer"), now, service.metabase, service.bucketsDB, service.config)
+	collector.UseDeltas = useDeltas
+	err = collector.Run(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if useDeltas {
+		err = service.metabase.DeleteBucketTallyDeltas(ctx, metabase.DeleteBucketTallyDeltas{
+			Keys:      collector.TallyDeltas,
+			BatchSize: service.config.DeltaBatchSize,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		service.tallies = collector.Bucket
+		service.lastFullTally = now
+	}
+
+	return collector.Bucket, nil
+}
+
+// applyDeltas adds the recorded changes to the tallies of the previous cycle.
+func (service *Service) applyDeltas(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	deltas, err := service.metabase.ConsumeBucketTallyDeltas(ctx, metabase.ConsumeBucketTallyDeltas{
+		BatchSize: service.config.DeltaBatchSize,
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, delta := range deltas {
+		bucket, ok := service.tallies[delta.BucketLocation]
+		if !ok {
+			bucket = &accounting.BucketTally{BucketLocation: delta.BucketLocation}
+			service.tallies[delta.BucketLocation] = bucket
+		}
+		bucket.ObjectCount += delta.ObjectCount
+		bucket.TotalSegments += delta.TotalSegments
+		bucket.TotalBytes += delta.TotalBytes
+		bucket.MetadataSize += delta.MetadataSize
+	}
+
+	// deleting a bucket doesn't record deltas for its objects, so the tallies
+	// of buckets which don't exist anymore are dropped.
+	existing := make(map[metabase.BucketLocation]struct{}, len(service.tallies))
+	var lastBucketLocation metabase.BucketLocation
+	for {
+		more, err := service.bucketsDB.IterateBucketLocations(ctx, lastBucketLocation.ProjectID, lastBucketLocation.BucketName, service.config.ListLimit, func(bucketLocations []metabase.BucketLocation) error {
+			for _, location := range bucketLocations {
+				existing[location] = struct{}{}
+			}
+			lastBucketLocation = bucketLocations[len(bucketLocations)-1]
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		if !more {
+			break
+		}
+	}
+
+	for location, bucket := range service.tallies {
+		_, ok := existing[location]
+		empty := bucket.ObjectCount == 0 && bucket.PendingObjectCount == 0 && bucket.TotalSegments == 0 && bucket.TotalBytes == 0
+		if !ok || empty {
+			delete(service.tallies, location)
+		}
+	}
+
+	mon.IntVal("tally_deltas_buckets").Observe(int64(len(deltas)))
+	return nil
+}
+
 var objectFunc = mon.Task()
 
 // BucketTallyCollector collects and adds up tallies for buckets.
@@ -247,6 +357,11 @@ type BucketTallyCollector struct {
 	Log    *zap.Logger
 	Bucket map[metabase.BucketLocation]*accounting.BucketTally
 
+	// UseDeltas makes the collector count only committed objects and collect
+	// the keys of the tally deltas, which are part of the tallies.
+	UseDeltas   bool
+	TallyDeltas []metabase.BucketTallyDeltaKey
+
 	metabase  *metabase.DB
 	bucketsDB buckets.DB
 	config    Config
@@ -302,13 +417,22 @@ func (observer *BucketTallyCollector) fillBucketTallies(ctx context.Context, sta
 	var lastBucketLocation metabase.BucketLocation
 	for {
 		more, err := observer.bucketsDB.IterateBucketLocations(ctx, lastBucketLocation.ProjectID, lastBucketLocation.BucketName, observer.config.ListLimit, func(bucketLocations []metabase.BucketLocation) (err error) {
-			tallies, err := observer.metabase.CollectBucketTallies(ctx, metabase.CollectBucketTallies{
+			opts := metabase.CollectBucketTallies{
 				From:               bucketLocations[0],
 				To:                 bucketLocations[len(bucketLocations)-1],
 				AsOfSystemTime:     startingTime,
 				AsOfSystemInterval: observer.config.AsOfSystemInterval,
 				Now:                observer.Now,
-			})
+			}
+
+			var tallies []metabase.BucketTally
+			if observer.UseDeltas {
+				var deltas []metabase.BucketTallyDeltaKey
+				tallies, deltas, err = observer.metabase.CollectBucketTalliesWithDeltas(ctx, opts)
+				observer.TallyDeltas = append(observer.TallyDeltas, deltas...)
+			} else {
+				tallies, err = observer.metabase.CollectBucketTallies(ctx, opts)
+			}
 			if err != nil {
 				return err
 			}
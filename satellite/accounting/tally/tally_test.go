@@ -471,3 +471,73 @@ func TestTallyBatchSize(t *testing.T) {
 		}
 	})
 }
+
+func TestTallyWithDeltas(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Metainfo.TallyDeltas = true
+				config.Tally.UseDeltas = true
+				config.Tally.FullTallyInterval = 24 * time.Hour
+				config.Tally.DeltaBatchSize = 2
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		uplink := planet.Uplinks[0]
+		sat.Accounting.Tally.Loop.Pause()
+
+		// requireFullTally checks that the last tallies of all buckets match
+		// the tallies calculated from all objects.
+		requireFullTally := func(t *testing.T) {
+			collector := tally.NewBucketTallyCollector(zaptest.NewLogger(t), time.Now(), sat.Metabase.DB, sat.DB.Buckets(), sat.Config.Tally)
+			require.NoError(t, collector.Run(ctx))
+
+			tallies, err := sat.DB.ProjectAccounting().GetTallies(ctx)
+			require.NoError(t, err)
+
+			for location, expected := range collector.Bucket {
+				var found bool
+				for _, tally := range tallies {
+					if tally.BucketLocation == location {
+						require.Equal(t, expected.ObjectCount, tally.ObjectCount, location.BucketName)
+						require.Equal(t, expected.TotalSegments, tally.TotalSegments, location.BucketName)
+						require.Equal(t, expected.TotalBytes, tally.TotalBytes, location.BucketName)
+						found = true
+						break
+					}
+				}
+				require.True(t, found, location.BucketName)
+			}
+		}
+
+		require.NoError(t, uplink.Upload(ctx, sat, "first", "a", testrand.Bytes(memory.KiB)))
+		require.NoError(t, uplink.Upload(ctx, sat, "first", "b", testrand.Bytes(8*memory.KiB)))
+
+		// the first cycle is a full tally.
+		sat.Accounting.Tally.Loop.TriggerWait()
+		requireFullTally(t)
+
+		require.NoError(t, uplink.Upload(ctx, sat, "first", "c", testrand.Bytes(2*memory.KiB)))
+		require.NoError(t, uplink.Upload(ctx, sat, "second", "a", testrand.Bytes(9*memory.KiB)))
+		require.NoError(t, uplink.DeleteObject(ctx, sat, "first", "a"))
+
+		project, err := uplink.GetProject(ctx, sat)
+		require.NoError(t, err)
+		defer ctx.Check(project.Close)
+
+		_, err = project.CopyObject(ctx, "first", "b", "second", "b", nil)
+		require.NoError(t, err)
+		err = project.MoveObject(ctx, "first", "c", "second", "c", nil)
+		require.NoError(t, err)
+
+		// the following cycles only apply the deltas.
+		sat.Accounting.Tally.Loop.TriggerWait()
+		requireFullTally(t)
+
+		deltas, err := sat.Metabase.DB.ConsumeBucketTallyDeltas(ctx, metabase.ConsumeBucketTallyDeltas{BatchSize: 10})
+		require.NoError(t, err)
+		require.Empty(t, deltas)
+	})
+}
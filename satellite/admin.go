@@ -15,6 +15,7 @@ import (
 	"golang.org/x/sync/errgroup"
 
 	"storj.io/common/identity"
+	"storj.io/common/signing"
 	"storj.io/common/storj"
 	"storj.io/private/debug"
 	"storj.io/private/version"
@@ -23,10 +24,20 @@ import (
 	"storj.io/storj/satellite/admin"
 	"storj.io/storj/satellite/buckets"
 	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/console/consoleauth"
 	"storj.io/storj/satellite/console/restkeys"
+	"storj.io/storj/satellite/gracefulexit"
+	"storj.io/storj/satellite/mailservice"
 	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/overlay/nodechurn"
+	"storj.io/storj/satellite/overlay/nodescan"
+	"storj.io/storj/satellite/overlay/placementstats"
+	"storj.io/storj/satellite/overlay/walletstats"
 	"storj.io/storj/satellite/payments"
 	"storj.io/storj/satellite/payments/stripecoinpayments"
+	"storj.io/storj/satellite/payouts"
+	"storj.io/storj/satellite/sealedaccess"
+	"storj.io/storj/satellite/secrets"
 )
 
 // Admin is the satellite core process that runs chores.
@@ -74,10 +85,42 @@ type Admin struct {
 	FreezeAccounts struct {
 		Service *console.AccountFreezeService
 	}
+
+	Impersonation struct {
+		Service *console.ImpersonationService
+	}
+
+	Mail struct {
+		Service *mailservice.Service
+	}
+
+	LimitRequests struct {
+		Service *console.LimitRequestService
+	}
+
+	Secrets struct {
+		Chore *secrets.Chore
+	}
+
+	NodeScan struct {
+		Chore *nodescan.Chore
+	}
+
+	PlacementStats struct {
+		Observer *placementstats.Observer
+	}
+
+	NodeChurn struct {
+		Observer *nodechurn.Observer
+	}
+
+	WalletStats struct {
+		Observer *walletstats.Observer
+	}
 }
 
 // NewAdmin creates a new satellite admin peer.
-func NewAdmin(log *zap.Logger, full *identity.FullIdentity, db DB, metabaseDB *metabase.DB,
+func NewAdmin(ctx context.Context, log *zap.Logger, full *identity.FullIdentity, db DB, metabaseDB *metabase.DB,
 	versionInfo version.Info, config *Config, atomicLogLevel *zap.AtomicLevel) (*Admin, error) {
 	peer := &Admin{
 		Log:        log,
@@ -147,7 +190,15 @@ func NewAdmin(log *zap.Logger, full *identity.FullIdentity, db DB, metabaseDB *m
 				peer.DB.Console().Users(),
 			)
 		case "stripecoinpayments":
-			stripeClient = stripecoinpayments.NewStripeClient(log, pc.StripeCoinPayments)
+			peerSecrets, err := newSecretsLoader(ctx, log.Named("secrets"), config.Secrets)
+			if err != nil {
+				return nil, errs.Combine(err, peer.Close())
+			}
+			stripeConfig, err := peerSecrets.stripeConfig(ctx, pc.StripeCoinPayments)
+			if err != nil {
+				return nil, errs.Combine(err, peer.Close())
+			}
+			stripeClient = stripecoinpayments.NewStripeClient(log, stripeConfig)
 		}
 
 		prices, err := pc.UsagePrice.ToModel()
@@ -171,7 +222,8 @@ func NewAdmin(log *zap.Logger, full *identity.FullIdentity, db DB, metabaseDB *m
 			peer.DB.ProjectAccounting(),
 			prices,
 			priceOverrides,
-			pc.BonusRate)
+			pc.BonusRate,
+			nil)
 
 		if err != nil {
 			return nil, errs.Combine(err, peer.Close())
@@ -182,6 +234,83 @@ func NewAdmin(log *zap.Logger, full *identity.FullIdentity, db DB, metabaseDB *m
 		peer.FreezeAccounts.Service = console.NewAccountFreezeService(db.Console().AccountFreezeEvents(), db.Console().Users(), db.Console().Projects())
 	}
 
+	{ // setup node statistics
+		peer.PlacementStats.Observer = placementstats.NewObserver(config.Overlay.Node.OnlineWindow, config.PlacementStats)
+		peer.WalletStats.Observer = walletstats.NewObserver(log.Named("walletstats"), config.WalletStats)
+
+		observers := []nodescan.Observer{peer.PlacementStats.Observer, peer.WalletStats.Observer}
+		if config.NodeChurn.Enabled {
+			peer.NodeChurn.Observer = nodechurn.NewObserver(peer.DB.NodeChurn(), config.NodeChurn)
+			observers = append(observers, peer.NodeChurn.Observer)
+		}
+
+		peer.NodeScan.Chore = nodescan.NewChore(log.Named("nodescan"), peer.DB.OverlayCache(), config.NodeScan, observers...)
+		peer.Services.Add(lifecycle.Item{
+			Name:  "nodescan",
+			Run:   peer.NodeScan.Chore.Run,
+			Close: peer.NodeScan.Chore.Close,
+		})
+		peer.Debug.Server.Panel.Add(
+			debug.Cycle("Node Scan", peer.NodeScan.Chore.Loop))
+	}
+
+	{ // setup impersonation
+		peerSecrets, err := newSecretsLoader(ctx, log.Named("secrets:chore"), config.Secrets)
+		if err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
+		peer.Secrets.Chore = peerSecrets.Chore
+		if peer.Secrets.Chore != nil {
+			peer.Services.Add(lifecycle.Item{
+				Name:  "secrets:chore",
+				Run:   peer.Secrets.Chore.Run,
+				Close: peer.Secrets.Chore.Close,
+			})
+			peer.Debug.Server.Panel.Add(
+				debug.Cycle("Secrets Chore", peer.Secrets.Chore.Loop))
+		}
+
+		// impersonation sessions are signed like the sessions of the console,
+		// without its secret admins can't impersonate users.
+		authTokenSigner, err := peerSecrets.consoleAuthSigner(ctx, config.Console.AuthTokenSecret)
+		if err != nil {
+			peer.Log.Warn("impersonation disabled", zap.Error(err))
+		} else {
+			peer.Impersonation.Service = console.NewImpersonationService(
+				log.Named("console:impersonation"),
+				peer.DB.Console().Impersonations(),
+				peer.DB.Console().Users(),
+				peer.DB.Console().WebappSessions(),
+				consoleauth.NewService(config.ConsoleAuth, authTokenSigner),
+				config.Console.Impersonation,
+			)
+		}
+	}
+
+	{ // setup mailservice
+		var err error
+		peer.Mail.Service, err = setupMailService(peer.Log, *config)
+		if err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
+
+		peer.Services.Add(lifecycle.Item{
+			Name:  "mail:service",
+			Close: peer.Mail.Service.Close,
+		})
+	}
+
+	{ // setup limit increase requests
+		peer.LimitRequests.Service = console.NewLimitRequestService(
+			log.Named("console:limitrequests"),
+			peer.DB.Console().LimitRequests(),
+			peer.DB.Console().Projects(),
+			peer.DB.Console().Users(),
+			peer.Mail.Service,
+			config.Console.ExternalAddress,
+		)
+	}
+
 	{ // setup admin endpoint
 		var err error
 		peer.Admin.Listener, err = net.Listen("tcp", config.Admin.Address)
@@ -192,7 +321,15 @@ func NewAdmin(log *zap.Logger, full *identity.FullIdentity, db DB, metabaseDB *m
 		adminConfig := config.Admin
 		adminConfig.AuthorizationToken = config.Console.AuthToken
 
-		peer.Admin.Server = admin.NewServer(log.Named("admin"), peer.Admin.Listener, peer.DB, peer.Buckets.Service, peer.REST.Keys, peer.FreezeAccounts.Service, peer.Payments.Accounts, config.Console, adminConfig)
+		gracefulExitReporter := gracefulexit.NewReporter(peer.DB.GracefulExit(), peer.DB.OverlayCache(), signing.SignerFromFullIdentity(peer.Identity))
+		gracefulExitAborter := gracefulexit.NewAborter(log.Named("gracefulexit:aborter"), peer.DB.GracefulExit(), config.GracefulExit)
+		accessSealer, err := sealedaccess.NewSealer(config.AccessEncryption)
+		if err != nil {
+			return nil, err
+		}
+
+		payoutsService := payouts.NewService(log.Named("payouts:service"), peer.DB.PayoutContracts(), peer.DB.Compensation(), peer.DB.StoragenodeAccounting(), peer.DB.OverlayCache(), config.Compensation, config.Payouts)
+		peer.Admin.Server = admin.NewServer(log.Named("admin"), peer.Admin.Listener, peer.DB, peer.Buckets.Service, peer.REST.Keys, peer.FreezeAccounts.Service, peer.Impersonation.Service, peer.LimitRequests.Service, gracefulExitReporter, gracefulExitAborter, payoutsService, peer.PlacementStats.Observer, peer.WalletStats.Observer, accessSealer, peer.Payments.Accounts, config.Console, adminConfig)
 		peer.Servers.Add(lifecycle.Item{
 			Name:  "admin",
 			Run:   peer.Admin.Server.Run,
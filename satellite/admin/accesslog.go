@@ -0,0 +1,181 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/accesslog"
+	"storj.io/uplink"
+)
+
+func (server *Server) createAccessLogExport(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	projectUUID, ok := parseProjectParameter(w, mux.Vars(r))
+	if !ok {
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		sendJSONError(w, "failed to read body",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	var input struct {
+		DestinationAccess string `json:"destinationAccess"`
+		DestinationBucket string `json:"destinationBucket"`
+		Prefix            string `json:"prefix"`
+	}
+
+	err = json.Unmarshal(body, &input)
+	if err != nil {
+		sendJSONError(w, "failed to unmarshal request",
+			err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if input.DestinationBucket == "" {
+		sendJSONError(w, "destinationBucket is not set",
+			"", http.StatusBadRequest)
+		return
+	}
+	// the access grant is restricted to what the export needs, and stored
+	// sealed.
+	destinationAccess, err := restrictAccess(input.DestinationAccess,
+		uplink.Permission{AllowUpload: true},
+		uplink.SharePrefix{Bucket: input.DestinationBucket})
+	if err != nil {
+		sendJSONError(w, "invalid destinationAccess",
+			err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	sealedDestination, err := server.accessSealer.Seal(projectUUID, accesslog.DestinationAccessPurpose, destinationAccess)
+	if err != nil {
+		sendAccessSealError(w, err)
+		return
+	}
+
+	_, err = server.db.Console().Projects().Get(ctx, projectUUID)
+	if errors.Is(err, sql.ErrNoRows) {
+		sendJSONError(w, "project with specified uuid does not exist",
+			"", http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		sendJSONError(w, "error getting project",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	err = server.db.AccessLog().CreateExport(ctx, accesslog.Export{
+		ProjectID:         projectUUID,
+		DestinationAccess: sealedDestination,
+		DestinationBucket: input.DestinationBucket,
+		Prefix:            input.Prefix,
+		CreatedAt:         server.nowFn(),
+	})
+	if err != nil {
+		if accesslog.ErrExportExists.Has(err) {
+			sendJSONError(w, "project already has an access log export", "", http.StatusConflict)
+		} else {
+			sendJSONError(w, "unable to create access log export", err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+}
+
+func (server *Server) getAccessLogExport(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	projectUUID, ok := parseProjectParameter(w, mux.Vars(r))
+	if !ok {
+		return
+	}
+
+	export, err := server.db.AccessLog().GetExport(ctx, projectUUID)
+	if err != nil {
+		if accesslog.ErrExportNotFound.Has(err) {
+			sendJSONError(w, "project has no access log export", "", http.StatusNotFound)
+		} else {
+			sendJSONError(w, "unable to get access log export", err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	// the access grant is never returned.
+	output := struct {
+		DestinationBucket string     `json:"destinationBucket"`
+		Prefix            string     `json:"prefix"`
+		CreatedAt         time.Time  `json:"createdAt"`
+		ExportedUntil     time.Time  `json:"exportedUntil"`
+		LastRunAt         *time.Time `json:"lastRunAt"`
+		LastError         string     `json:"lastError"`
+	}{
+		DestinationBucket: export.DestinationBucket,
+		Prefix:            export.Prefix,
+		CreatedAt:         export.CreatedAt,
+		ExportedUntil:     export.Status.ExportedUntil,
+		LastRunAt:         export.Status.LastRunAt,
+		LastError:         export.Status.LastError,
+	}
+
+	data, err := json.Marshal(output)
+	if err != nil {
+		sendJSONError(w, "json encoding failed",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONData(w, http.StatusOK, data)
+}
+
+func (server *Server) deleteAccessLogExport(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	projectUUID, ok := parseProjectParameter(w, mux.Vars(r))
+	if !ok {
+		return
+	}
+
+	err := server.db.AccessLog().DeleteExport(ctx, projectUUID)
+	if err != nil {
+		if accesslog.ErrExportNotFound.Has(err) {
+			sendJSONError(w, "project has no access log export", "", http.StatusNotFound)
+		} else {
+			sendJSONError(w, "unable to delete access log export", err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+}
+
+// parseProjectParameter parses the project id of the path. It sends an error
+// response when it's missing or invalid.
+func parseProjectParameter(w http.ResponseWriter, vars map[string]string) (uuid.UUID, bool) {
+	projectUUIDString, ok := vars["project"]
+	if !ok {
+		sendJSONError(w, "project-uuid missing",
+			"", http.StatusBadRequest)
+		return uuid.UUID{}, false
+	}
+
+	projectUUID, err := uuid.FromString(projectUUIDString)
+	if err != nil {
+		sendJSONError(w, "invalid project-uuid",
+			err.Error(), http.StatusBadRequest)
+		return uuid.UUID{}, false
+	}
+	return projectUUID, true
+}
@@ -0,0 +1,125 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"storj.io/common/storj"
+	"storj.io/storj/satellite/metabase"
+)
+
+// bucketLifecycle is the JSON representation of the lifecycle configuration of
+// a bucket.
+type bucketLifecycle struct {
+	TransitionDays      int                       `json:"transitionDays"`
+	TransitionPlacement storj.PlacementConstraint `json:"transitionPlacement"`
+	ExpirationDays      int                       `json:"expirationDays"`
+	CreatedAt           time.Time                 `json:"createdAt"`
+}
+
+func (server *Server) setBucketLifecycle(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	project, bucket, err := validateBucketPathParameters(mux.Vars(r))
+	if err != nil {
+		sendJSONError(w, err.Error(), "", http.StatusBadRequest)
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		sendJSONError(w, "failed to read body",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	var input bucketLifecycle
+	err = json.Unmarshal(body, &input)
+	if err != nil {
+		sendJSONError(w, "failed to unmarshal request",
+			err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	err = server.buckets.SetBucketLifecycle(ctx, metabase.SetBucketLifecycle{
+		BucketLocation: metabase.BucketLocation{
+			ProjectID:  project.UUID,
+			BucketName: string(bucket),
+		},
+		TransitionDays:      input.TransitionDays,
+		TransitionPlacement: input.TransitionPlacement,
+		ExpirationDays:      input.ExpirationDays,
+	})
+	if err != nil {
+		switch {
+		case storj.ErrBucketNotFound.Has(err):
+			sendJSONError(w, "bucket does not exist", "", http.StatusNotFound)
+		case metabase.ErrInvalidRequest.Has(err):
+			sendJSONError(w, "invalid bucket lifecycle", err.Error(), http.StatusBadRequest)
+		default:
+			sendJSONError(w, "unable to store bucket lifecycle", err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+}
+
+func (server *Server) getBucketLifecycle(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	project, bucket, err := validateBucketPathParameters(mux.Vars(r))
+	if err != nil {
+		sendJSONError(w, err.Error(), "", http.StatusBadRequest)
+		return
+	}
+
+	lifecycle, err := server.buckets.GetBucketLifecycle(ctx, bucket, project.UUID)
+	if err != nil {
+		if metabase.ErrBucketLifecycleNotFound.Has(err) {
+			sendJSONError(w, "bucket has no lifecycle", "", http.StatusNotFound)
+		} else {
+			sendJSONError(w, "unable to get bucket lifecycle", err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	data, err := json.Marshal(bucketLifecycle{
+		TransitionDays:      lifecycle.TransitionDays,
+		TransitionPlacement: lifecycle.TransitionPlacement,
+		ExpirationDays:      lifecycle.ExpirationDays,
+		CreatedAt:           lifecycle.CreatedAt,
+	})
+	if err != nil {
+		sendJSONError(w, "json encoding failed",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONData(w, http.StatusOK, data)
+}
+
+func (server *Server) deleteBucketLifecycle(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	project, bucket, err := validateBucketPathParameters(mux.Vars(r))
+	if err != nil {
+		sendJSONError(w, err.Error(), "", http.StatusBadRequest)
+		return
+	}
+
+	err = server.buckets.DeleteBucketLifecycle(ctx, bucket, project.UUID)
+	if err != nil {
+		if metabase.ErrBucketLifecycleNotFound.Has(err) {
+			sendJSONError(w, "bucket has no lifecycle", "", http.StatusNotFound)
+		} else {
+			sendJSONError(w, "unable to delete bucket lifecycle", err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+}
@@ -0,0 +1,95 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"storj.io/common/storj"
+	"storj.io/storj/satellite/bucketpolicy"
+)
+
+func (server *Server) setBucketPolicy(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	project, bucket, err := validateBucketPathParameters(mux.Vars(r))
+	if err != nil {
+		sendJSONError(w, err.Error(), "", http.StatusBadRequest)
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		sendJSONError(w, "failed to read body",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if _, err := bucketpolicy.Parse(string(bucket), body); err != nil {
+		sendJSONError(w, "invalid bucket policy",
+			err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	_, err = server.buckets.GetBucket(ctx, bucket, project.UUID)
+	if err != nil {
+		if storj.ErrBucketNotFound.Has(err) {
+			sendJSONError(w, "bucket does not exist", "", http.StatusNotFound)
+		} else {
+			sendJSONError(w, "unable to check bucket", err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	err = server.db.BucketPolicies().Set(ctx, project.UUID, string(bucket), body)
+	if err != nil {
+		sendJSONError(w, "unable to store bucket policy", err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+func (server *Server) getBucketPolicy(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	project, bucket, err := validateBucketPathParameters(mux.Vars(r))
+	if err != nil {
+		sendJSONError(w, err.Error(), "", http.StatusBadRequest)
+		return
+	}
+
+	policy, err := server.db.BucketPolicies().Get(ctx, project.UUID, string(bucket))
+	if err != nil {
+		if bucketpolicy.ErrNotFound.Has(err) {
+			sendJSONError(w, "bucket has no policy", "", http.StatusNotFound)
+		} else {
+			sendJSONError(w, "unable to get bucket policy", err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	sendJSONData(w, http.StatusOK, policy)
+}
+
+func (server *Server) deleteBucketPolicy(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	project, bucket, err := validateBucketPathParameters(mux.Vars(r))
+	if err != nil {
+		sendJSONError(w, err.Error(), "", http.StatusBadRequest)
+		return
+	}
+
+	err = server.db.BucketPolicies().Delete(ctx, project.UUID, string(bucket))
+	if err != nil {
+		if bucketpolicy.ErrNotFound.Has(err) {
+			sendJSONError(w, "bucket has no policy", "", http.StatusNotFound)
+		} else {
+			sendJSONError(w, "unable to delete bucket policy", err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+}
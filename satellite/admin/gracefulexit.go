@@ -0,0 +1,156 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"storj.io/common/storj"
+	"storj.io/storj/satellite/gracefulexit"
+)
+
+func (server *Server) getGracefulExitReport(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	nodeID, ok := parseNodeID(w, r)
+	if !ok {
+		return
+	}
+
+	report, err := server.gracefulExit.Report(ctx, nodeID)
+	if gracefulexit.ErrNotExiting.Has(err) {
+		sendJSONError(w, "node is not gracefully exiting",
+			err.Error(), http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		sendJSONError(w, "failed to get graceful exit report",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	data, err := json.Marshal(report)
+	if err != nil {
+		sendJSONError(w, "json encoding failed",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONData(w, http.StatusOK, data)
+}
+
+func (server *Server) getGracefulExitFailingTransfers(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	nodeID, ok := parseNodeID(w, r)
+	if !ok {
+		return
+	}
+
+	limit, offset := 100, int64(0)
+	if value := r.URL.Query().Get("limit"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed <= 0 {
+			sendJSONError(w, "invalid limit", value, http.StatusBadRequest)
+			return
+		}
+		limit = parsed
+	}
+	if value := r.URL.Query().Get("offset"); value != "" {
+		parsed, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || parsed < 0 {
+			sendJSONError(w, "invalid offset", value, http.StatusBadRequest)
+			return
+		}
+		offset = parsed
+	}
+
+	items, err := server.gracefulExit.FailingTransfers(ctx, nodeID, limit, offset)
+	if err != nil {
+		sendJSONError(w, "failed to get failing transfers",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	type failingTransfer struct {
+		StreamID       string     `json:"streamId"`
+		Position       uint64     `json:"position"`
+		PieceNum       int32      `json:"pieceNum"`
+		QueuedAt       time.Time  `json:"queuedAt"`
+		LastFailedAt   *time.Time `json:"lastFailedAt"`
+		LastFailedCode *int       `json:"lastFailedCode"`
+		FailedCount    *int       `json:"failedCount"`
+	}
+
+	output := make([]failingTransfer, 0, len(items))
+	for _, item := range items {
+		output = append(output, failingTransfer{
+			StreamID:       item.StreamID.String(),
+			Position:       item.Position.Encode(),
+			PieceNum:       item.PieceNum,
+			QueuedAt:       item.QueuedAt,
+			LastFailedAt:   item.LastFailedAt,
+			LastFailedCode: item.LastFailedCode,
+			FailedCount:    item.FailedCount,
+		})
+	}
+
+	data, err := json.Marshal(output)
+	if err != nil {
+		sendJSONError(w, "json encoding failed",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONData(w, http.StatusOK, data)
+}
+
+func (server *Server) abortGracefulExit(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	nodeID, ok := parseNodeID(w, r)
+	if !ok {
+		return
+	}
+
+	reason := r.URL.Query().Get("reason")
+	if reason == "" {
+		reason = "aborted by admin"
+	}
+
+	_, err := server.exitAborter.Abort(ctx, nodeID, reason)
+	if gracefulexit.ErrNotExiting.Has(err) {
+		sendJSONError(w, "node is not gracefully exiting",
+			err.Error(), http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		sendJSONError(w, "failed to abort graceful exit",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+// parseNodeID parses the node ID from the request path, it sends an error response when it fails.
+func parseNodeID(w http.ResponseWriter, r *http.Request) (storj.NodeID, bool) {
+	nodeIDString, ok := mux.Vars(r)["nodeid"]
+	if !ok {
+		sendJSONError(w, "node-id missing",
+			"", http.StatusBadRequest)
+		return storj.NodeID{}, false
+	}
+
+	nodeID, err := storj.NodeIDFromString(nodeIDString)
+	if err != nil {
+		sendJSONError(w, "invalid node-id",
+			err.Error(), http.StatusBadRequest)
+		return storj.NodeID{}, false
+	}
+	return nodeID, true
+}
@@ -0,0 +1,164 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/console"
+)
+
+func (server *Server) impersonateUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	if server.impersonation == nil {
+		sendJSONError(w, "impersonation is not configured",
+			"the console auth token secret is required", http.StatusNotImplemented)
+		return
+	}
+
+	vars := mux.Vars(r)
+	userEmail, ok := vars["useremail"]
+	if !ok {
+		sendJSONError(w, "user-email missing", "", http.StatusBadRequest)
+		return
+	}
+
+	user, err := server.db.Console().Users().GetByEmail(ctx, userEmail)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			sendJSONError(w, fmt.Sprintf("user with email %q does not exist", userEmail),
+				"", http.StatusNotFound)
+			return
+		}
+		sendJSONError(w, "failed to get user details",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		sendJSONError(w, "failed to read body",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	var input struct {
+		Admin  string `json:"admin"`
+		Reason string `json:"reason"`
+	}
+
+	err = json.Unmarshal(body, &input)
+	if err != nil {
+		sendJSONError(w, "failed to unmarshal request",
+			err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// the admin authenticated by the oauth proxy can't be overridden.
+	if email := r.Header.Get("X-Forwarded-Email"); email != "" {
+		input.Admin = email
+	}
+	if input.Admin == "" || input.Reason == "" {
+		sendJSONError(w, "admin and reason are required",
+			"", http.StatusBadRequest)
+		return
+	}
+
+	tokenInfo, session, err := server.impersonation.Impersonate(ctx, console.ImpersonationRequest{
+		UserID:    user.ID,
+		Admin:     input.Admin,
+		Reason:    input.Reason,
+		IP:        r.RemoteAddr,
+		UserAgent: r.UserAgent(),
+	})
+	if err != nil {
+		if console.ErrImpersonationConsent.Has(err) {
+			sendJSONError(w, "user has not granted support access",
+				err.Error(), http.StatusForbidden)
+			return
+		}
+		sendJSONError(w, "failed to impersonate user",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	var output struct {
+		SessionID uuid.UUID `json:"sessionID"`
+		Token     string    `json:"token"`
+		Consented bool      `json:"consented"`
+		ExpiresAt time.Time `json:"expiresAt"`
+	}
+
+	output.SessionID = session.ID
+	output.Token = tokenInfo.Token.String()
+	output.Consented = session.Consented
+	output.ExpiresAt = tokenInfo.ExpiresAt
+
+	data, err := json.Marshal(output)
+	if err != nil {
+		sendJSONError(w, "json encoding failed",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONData(w, http.StatusOK, data)
+}
+
+func (server *Server) getUserImpersonations(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	vars := mux.Vars(r)
+	userEmail, ok := vars["useremail"]
+	if !ok {
+		sendJSONError(w, "user-email missing", "", http.StatusBadRequest)
+		return
+	}
+
+	user, err := server.db.Console().Users().GetByEmail(ctx, userEmail)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			sendJSONError(w, fmt.Sprintf("user with email %q does not exist", userEmail),
+				"", http.StatusNotFound)
+			return
+		}
+		sendJSONError(w, "failed to get user details",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if server.impersonation == nil {
+		sendJSONError(w, "impersonation is not configured",
+			"the console auth token secret is required", http.StatusNotImplemented)
+		return
+	}
+
+	sessions, err := server.impersonation.GetSessions(ctx, user.ID)
+	if err != nil {
+		sendJSONError(w, "failed to get impersonation sessions",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if sessions == nil {
+		sessions = []console.ImpersonationSession{}
+	}
+
+	data, err := json.Marshal(sessions)
+	if err != nil {
+		sendJSONError(w, "json encoding failed",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONData(w, http.StatusOK, data)
+}
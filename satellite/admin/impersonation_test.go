@@ -0,0 +1,87 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/console/consoleauth"
+)
+
+func TestImpersonateUser(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount:   1,
+		StorageNodeCount: 0,
+		UplinkCount:      1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(_ *zap.Logger, _ int, config *satellite.Config) {
+				config.Admin.Address = "127.0.0.1:0"
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		address := sat.Admin.Admin.Listener.Addr()
+		owner := planet.Uplinks[0].Projects[0].Owner
+		authToken := sat.Config.Console.AuthToken
+
+		link := "http://" + address.String() + "/api/users/" + owner.Email + "/impersonate"
+
+		// the reason and the admin are required.
+		assertReq(ctx, t, link, http.MethodPost, `{"admin":"admin@mail.test"}`, http.StatusBadRequest, "", authToken)
+
+		// the user hasn't granted support access yet.
+		body := assertReq(ctx, t, link, http.MethodPost, `{"admin":"admin@mail.test","reason":"ticket 1"}`, http.StatusForbidden, "", authToken)
+		require.Contains(t, string(body), "support access")
+
+		_, err := sat.DB.Console().Impersonations().UpsertSupportAccess(ctx, &console.SupportAccessGrant{
+			UserID:    owner.ID,
+			ExpiresAt: time.Now().Add(time.Hour),
+			CreatedAt: time.Now(),
+		})
+		require.NoError(t, err)
+
+		body = assertReq(ctx, t, link, http.MethodPost, `{"admin":"admin@mail.test","reason":"ticket 1"}`, http.StatusOK, "", authToken)
+
+		var output struct {
+			Token     string    `json:"token"`
+			Consented bool      `json:"consented"`
+			ExpiresAt time.Time `json:"expiresAt"`
+		}
+		require.NoError(t, json.Unmarshal(body, &output))
+		require.True(t, output.Consented)
+
+		token, err := consoleauth.FromBase64URLString(output.Token)
+		require.NoError(t, err)
+
+		userCtx, err := sat.API.Console.Service.TokenAuth(ctx, token, time.Now())
+		require.NoError(t, err)
+
+		user, err := console.GetUser(userCtx)
+		require.NoError(t, err)
+		require.Equal(t, owner.ID, user.ID)
+
+		impersonation := console.GetImpersonation(userCtx)
+		require.NotNil(t, impersonation)
+		require.Equal(t, "admin@mail.test", impersonation.Admin)
+		require.Equal(t, "ticket 1", impersonation.Reason)
+
+		link = "http://" + address.String() + "/api/users/" + owner.Email + "/impersonations"
+		body = assertReq(ctx, t, link, http.MethodGet, "", http.StatusOK, "", authToken)
+
+		var sessions []console.ImpersonationSession
+		require.NoError(t, json.Unmarshal(body, &sessions))
+		require.Len(t, sessions, 1)
+		require.Equal(t, impersonation.ID, sessions[0].ID)
+	})
+}
@@ -0,0 +1,128 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/console"
+)
+
+func (server *Server) getPendingLimitRequests(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	limit := 100
+	if value := r.URL.Query().Get("limit"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed <= 0 {
+			sendJSONError(w, "invalid limit", value, http.StatusBadRequest)
+			return
+		}
+		limit = parsed
+	}
+
+	requests, err := server.limitRequests.ListPending(ctx, limit)
+	if err != nil {
+		sendJSONError(w, "failed to list limit increase requests",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if requests == nil {
+		requests = []console.LimitRequest{}
+	}
+
+	data, err := json.Marshal(requests)
+	if err != nil {
+		sendJSONError(w, "json encoding failed",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONData(w, http.StatusOK, data)
+}
+
+func (server *Server) reviewLimitRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	vars := mux.Vars(r)
+	idString, ok := vars["id"]
+	if !ok {
+		sendJSONError(w, "id missing", "", http.StatusBadRequest)
+		return
+	}
+
+	id, err := uuid.FromString(idString)
+	if err != nil {
+		sendJSONError(w, "invalid id", err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		sendJSONError(w, "failed to read body",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	var input struct {
+		Admin   string `json:"admin"`
+		Approve bool   `json:"approve"`
+		Comment string `json:"comment"`
+	}
+
+	err = json.Unmarshal(body, &input)
+	if err != nil {
+		sendJSONError(w, "failed to unmarshal request",
+			err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// the admin authenticated by the oauth proxy can't be overridden.
+	if email := r.Header.Get("X-Forwarded-Email"); email != "" {
+		input.Admin = email
+	}
+	if input.Admin == "" {
+		sendJSONError(w, "admin is required",
+			"", http.StatusBadRequest)
+		return
+	}
+
+	request, err := server.limitRequests.Review(ctx, id, console.LimitRequestReview{
+		Admin:   input.Admin,
+		Approve: input.Approve,
+		Comment: input.Comment,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			sendJSONError(w, "limit increase request does not exist",
+				idString, http.StatusNotFound)
+			return
+		}
+		if console.ErrLimitRequestReviewed.Has(err) {
+			sendJSONError(w, "failed to review limit increase request",
+				err.Error(), http.StatusConflict)
+			return
+		}
+		sendJSONError(w, "failed to review limit increase request",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	data, err := json.Marshal(request)
+	if err != nil {
+		sendJSONError(w, "json encoding failed",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONData(w, http.StatusOK, data)
+}
@@ -0,0 +1,86 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/common/memory"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/console"
+)
+
+func TestReviewLimitRequest(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount:   1,
+		StorageNodeCount: 0,
+		UplinkCount:      1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(_ *zap.Logger, _ int, config *satellite.Config) {
+				config.Admin.Address = "127.0.0.1:0"
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		address := sat.Admin.Admin.Listener.Addr()
+		project := planet.Uplinks[0].Projects[0]
+		authToken := sat.Config.Console.AuthToken
+
+		userCtx, err := sat.UserContext(ctx, project.Owner.ID)
+		require.NoError(t, err)
+
+		limits, err := sat.API.Console.Service.GetProjectUsageLimits(userCtx, project.ID)
+		require.NoError(t, err)
+
+		request, err := sat.API.Console.Service.RequestLimitIncrease(userCtx, project.ID, console.LimitIncreaseRequest{
+			Kind:           console.LimitKindBandwidth,
+			RequestedLimit: limits.BandwidthLimit + memory.TB.Int64(),
+			Justification:  "video streaming",
+		})
+		require.NoError(t, err)
+
+		link := "http://" + address.String() + "/api/limit-requests"
+
+		body := assertReq(ctx, t, link, http.MethodGet, "", http.StatusOK, "", authToken)
+		var pending []console.LimitRequest
+		require.NoError(t, json.Unmarshal(body, &pending))
+		require.Len(t, pending, 1)
+		require.Equal(t, request.ID, pending[0].ID)
+		require.Equal(t, limits.BandwidthLimit, pending[0].CurrentLimit)
+		require.Equal(t, "video streaming", pending[0].Justification)
+
+		reviewLink := link + "/" + request.ID.String() + "/review"
+
+		// the admin is required.
+		assertReq(ctx, t, reviewLink, http.MethodPost, `{"approve":true}`, http.StatusBadRequest, "", authToken)
+		assertReq(ctx, t, link+"/"+testrand.UUID().String()+"/review", http.MethodPost,
+			`{"admin":"admin@mail.test","approve":true}`, http.StatusNotFound, "", authToken)
+
+		body = assertReq(ctx, t, reviewLink, http.MethodPost, `{"admin":"admin@mail.test","approve":true,"comment":"enjoy"}`, http.StatusOK, "", authToken)
+		var reviewed console.LimitRequest
+		require.NoError(t, json.Unmarshal(body, &reviewed))
+		require.Equal(t, console.LimitRequestApproved, reviewed.Status)
+		require.Equal(t, "admin@mail.test", reviewed.Reviewer)
+		require.Equal(t, "enjoy", reviewed.ReviewComment)
+
+		bandwidthLimit, err := sat.DB.ProjectAccounting().GetProjectBandwidthLimit(ctx, project.ID)
+		require.NoError(t, err)
+		require.NotNil(t, bandwidthLimit)
+		require.Equal(t, request.RequestedLimit, *bandwidthLimit)
+
+		// a request can be reviewed only once.
+		assertReq(ctx, t, reviewLink, http.MethodPost, `{"admin":"admin@mail.test"}`, http.StatusConflict, "", authToken)
+
+		body = assertReq(ctx, t, link, http.MethodGet, "", http.StatusOK, "", authToken)
+		require.JSONEq(t, "[]", string(body))
+	})
+}
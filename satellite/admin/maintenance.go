@@ -0,0 +1,84 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"time"
+
+	"storj.io/storj/satellite/maintenance"
+)
+
+// maintenanceState is the maintenance mode state as exchanged with the
+// admin api. RetryAfter is in seconds.
+type maintenanceState struct {
+	Enabled    bool      `json:"enabled"`
+	Reason     string    `json:"reason"`
+	RetryAfter int64     `json:"retryAfter"`
+	UpdatedAt  time.Time `json:"updatedAt"`
+}
+
+func (server *Server) getMaintenance(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	state, err := server.db.Maintenance().Get(ctx)
+	if err != nil {
+		sendJSONError(w, "failed to get maintenance mode",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	data, err := json.Marshal(maintenanceState{
+		Enabled:    state.Enabled,
+		Reason:     state.Reason,
+		RetryAfter: int64(state.RetryAfter / time.Second),
+		UpdatedAt:  state.UpdatedAt,
+	})
+	if err != nil {
+		sendJSONError(w, "json encoding failed",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONData(w, http.StatusOK, data)
+}
+
+func (server *Server) setMaintenance(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		sendJSONError(w, "failed to read body",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	var input maintenanceState
+	err = json.Unmarshal(body, &input)
+	if err != nil {
+		sendJSONError(w, "failed to unmarshal request",
+			err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if input.RetryAfter < 0 {
+		sendJSONError(w, "retryAfter must not be negative",
+			"", http.StatusBadRequest)
+		return
+	}
+
+	err = server.db.Maintenance().Set(ctx, maintenance.State{
+		Enabled:    input.Enabled,
+		Reason:     input.Reason,
+		RetryAfter: time.Duration(input.RetryAfter) * time.Second,
+		UpdatedAt:  server.nowFn(),
+	})
+	if err != nil {
+		sendJSONError(w, "failed to set maintenance mode",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
@@ -0,0 +1,67 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"storj.io/storj/satellite/overlay/nodechurn"
+)
+
+// defaultNodeChurnDays is the number of days returned, when the range isn't
+// specified.
+const defaultNodeChurnDays = 30
+
+func (server *Server) getNodeChurn(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	to := nodechurn.StartOfDay(server.nowFn()).Add(nodechurn.Day)
+	if value := r.URL.Query().Get("to"); value != "" {
+		parsed, err := time.Parse("2006-01-02", value)
+		if err != nil {
+			sendJSONError(w, "invalid to date, expected YYYY-MM-DD",
+				err.Error(), http.StatusBadRequest)
+			return
+		}
+		to = parsed
+	}
+
+	from := to.Add(-defaultNodeChurnDays * nodechurn.Day)
+	if value := r.URL.Query().Get("from"); value != "" {
+		parsed, err := time.Parse("2006-01-02", value)
+		if err != nil {
+			sendJSONError(w, "invalid from date, expected YYYY-MM-DD",
+				err.Error(), http.StatusBadRequest)
+			return
+		}
+		from = parsed
+	}
+
+	if !from.Before(to) {
+		sendJSONError(w, "from must be before to",
+			"", http.StatusBadRequest)
+		return
+	}
+
+	metrics, err := server.db.NodeChurn().List(ctx, from, to)
+	if err != nil {
+		sendJSONError(w, "failed to list node churn metrics",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if metrics == nil {
+		metrics = []nodechurn.DailyMetrics{}
+	}
+
+	data, err := json.Marshal(metrics)
+	if err != nil {
+		sendJSONError(w, "json encoding failed",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONData(w, http.StatusOK, data)
+}
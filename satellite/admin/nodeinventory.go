@@ -0,0 +1,47 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"storj.io/storj/satellite/overlay/inventory"
+)
+
+// defaultInventoryContactedWithin is how recently the summarized nodes must
+// have been contacted, when it isn't specified.
+const defaultInventoryContactedWithin = 7 * 24 * time.Hour
+
+func (server *Server) getNodeInventory(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	contactedWithin := defaultInventoryContactedWithin
+	if value := r.URL.Query().Get("contacted-within"); value != "" {
+		parsed, err := time.ParseDuration(value)
+		if err != nil || parsed <= 0 {
+			sendJSONError(w, "invalid contacted-within, expected a positive duration",
+				"", http.StatusBadRequest)
+			return
+		}
+		contactedWithin = parsed
+	}
+
+	groups, err := server.db.NodeInventory().Groups(ctx, server.nowFn().Add(-contactedWithin))
+	if err != nil {
+		sendJSONError(w, "failed to summarize node inventories",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	data, err := json.Marshal(inventory.Summarize(groups))
+	if err != nil {
+		sendJSONError(w, "json encoding failed",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONData(w, http.StatusOK, data)
+}
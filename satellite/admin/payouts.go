@@ -0,0 +1,41 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"storj.io/storj/satellite/overlay"
+)
+
+func (server *Server) getHeldStatus(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	nodeID, ok := parseNodeID(w, r)
+	if !ok {
+		return
+	}
+
+	status, err := server.payouts.HeldStatus(ctx, nodeID, server.nowFn())
+	if overlay.ErrNodeNotFound.Has(err) {
+		sendJSONError(w, "node not found",
+			err.Error(), http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		sendJSONError(w, "failed to get held status",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	data, err := json.Marshal(status)
+	if err != nil {
+		sendJSONError(w, "json encoding failed",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONData(w, http.StatusOK, data)
+}
@@ -0,0 +1,60 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+)
+
+func (server *Server) getPlacementStatistics(w http.ResponseWriter, r *http.Request) {
+	snapshot, ok := server.placementStats.Latest()
+	if !ok {
+		sendJSONError(w, "placement statistics aren't computed yet",
+			"", http.StatusServiceUnavailable)
+		return
+	}
+
+	data, err := json.Marshal(snapshot)
+	if err != nil {
+		sendJSONError(w, "json encoding failed",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONData(w, http.StatusOK, data)
+}
+
+func (server *Server) getPlacementStatisticsByName(w http.ResponseWriter, r *http.Request) {
+	name := mux.Vars(r)["placement"]
+
+	snapshot, ok := server.placementStats.Latest()
+	if !ok {
+		sendJSONError(w, "placement statistics aren't computed yet",
+			"", http.StatusServiceUnavailable)
+		return
+	}
+
+	for _, stats := range snapshot.Placements {
+		if stats.Name != name {
+			continue
+		}
+
+		data, err := json.Marshal(stats)
+		if err != nil {
+			sendJSONError(w, "json encoding failed",
+				err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		sendJSONData(w, http.StatusOK, data)
+		return
+	}
+
+	sendJSONError(w, "unknown placement",
+		fmt.Sprintf("available: global, EU, EEA, US, DE; got %q", name), http.StatusNotFound)
+}
@@ -0,0 +1,71 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"storj.io/storj/satellite/accounting/projectops"
+)
+
+// defaultProjectOperationsPeriod is the period returned, when the range
+// isn't specified.
+const defaultProjectOperationsPeriod = 24 * time.Hour
+
+func (server *Server) getProjectOperations(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	projectUUID, ok := parseProjectParameter(w, mux.Vars(r))
+	if !ok {
+		return
+	}
+
+	before := server.nowFn().UTC()
+	if value := r.URL.Query().Get("before"); value != "" {
+		parsed, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			sendJSONError(w, "invalid before time, expected RFC3339",
+				err.Error(), http.StatusBadRequest)
+			return
+		}
+		before = parsed
+	}
+
+	since := before.Add(-defaultProjectOperationsPeriod)
+	if value := r.URL.Query().Get("since"); value != "" {
+		parsed, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			sendJSONError(w, "invalid since time, expected RFC3339",
+				err.Error(), http.StatusBadRequest)
+			return
+		}
+		since = parsed
+	}
+
+	if !since.Before(before) {
+		sendJSONError(w, "since must be before before",
+			"", http.StatusBadRequest)
+		return
+	}
+
+	summary, err := projectops.NewService(server.db.ProjectOperations()).Summary(ctx, projectUUID, since, before)
+	if err != nil {
+		sendJSONError(w, "failed to get project operations",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	data, err := json.Marshal(summary)
+	if err != nil {
+		sendJSONError(w, "json encoding failed",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONData(w, http.StatusOK, data)
+}
@@ -0,0 +1,205 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"storj.io/common/storj"
+	"storj.io/storj/satellite/replication"
+	"storj.io/storj/satellite/sealedaccess"
+	"storj.io/uplink"
+)
+
+func (server *Server) createBucketReplication(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	project, bucket, err := validateBucketPathParameters(mux.Vars(r))
+	if err != nil {
+		sendJSONError(w, err.Error(), "", http.StatusBadRequest)
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		sendJSONError(w, "failed to read body",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	var input struct {
+		SourceAccess      string `json:"sourceAccess"`
+		DestinationAccess string `json:"destinationAccess"`
+		DestinationBucket string `json:"destinationBucket"`
+	}
+
+	err = json.Unmarshal(body, &input)
+	if err != nil {
+		sendJSONError(w, "failed to unmarshal request",
+			err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if input.DestinationBucket == "" {
+		sendJSONError(w, "destinationBucket is not set",
+			"", http.StatusBadRequest)
+		return
+	}
+	// the access grants are restricted to what the replication needs, and
+	// stored sealed.
+	sourceAccess, err := restrictAccess(input.SourceAccess,
+		uplink.Permission{AllowDownload: true, AllowList: true},
+		uplink.SharePrefix{Bucket: string(bucket)})
+	if err != nil {
+		sendJSONError(w, "invalid sourceAccess",
+			err.Error(), http.StatusBadRequest)
+		return
+	}
+	// overwriting an outdated replica requires the permission to delete it.
+	destinationAccess, err := restrictAccess(input.DestinationAccess,
+		uplink.Permission{AllowUpload: true, AllowDownload: true, AllowDelete: true},
+		uplink.SharePrefix{Bucket: input.DestinationBucket})
+	if err != nil {
+		sendJSONError(w, "invalid destinationAccess",
+			err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	sealedSource, err := server.accessSealer.Seal(project.UUID, replication.SourceAccessPurpose, sourceAccess)
+	if err != nil {
+		sendAccessSealError(w, err)
+		return
+	}
+	sealedDestination, err := server.accessSealer.Seal(project.UUID, replication.DestinationAccessPurpose, destinationAccess)
+	if err != nil {
+		sendAccessSealError(w, err)
+		return
+	}
+
+	_, err = server.buckets.GetBucket(ctx, bucket, project.UUID)
+	if err != nil {
+		if storj.ErrBucketNotFound.Has(err) {
+			sendJSONError(w, "bucket does not exist", "", http.StatusNotFound)
+		} else {
+			sendJSONError(w, "unable to check bucket", err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	err = server.db.Replication().Create(ctx, replication.Rule{
+		ProjectID:         project.UUID,
+		BucketName:        string(bucket),
+		SourceAccess:      sealedSource,
+		DestinationAccess: sealedDestination,
+		DestinationBucket: input.DestinationBucket,
+		CreatedAt:         server.nowFn(),
+	})
+	if err != nil {
+		if replication.ErrRuleExists.Has(err) {
+			sendJSONError(w, "bucket is already replicated", "", http.StatusConflict)
+		} else {
+			sendJSONError(w, "unable to create replication rule", err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+}
+
+func (server *Server) getBucketReplication(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	project, bucket, err := validateBucketPathParameters(mux.Vars(r))
+	if err != nil {
+		sendJSONError(w, err.Error(), "", http.StatusBadRequest)
+		return
+	}
+
+	rule, err := server.db.Replication().Get(ctx, project.UUID, string(bucket))
+	if err != nil {
+		if replication.ErrRuleNotFound.Has(err) {
+			sendJSONError(w, "bucket is not replicated", "", http.StatusNotFound)
+		} else {
+			sendJSONError(w, "unable to get replication rule", err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	// the access grants are never returned.
+	output := struct {
+		DestinationBucket string     `json:"destinationBucket"`
+		CreatedAt         time.Time  `json:"createdAt"`
+		ReplicatedUntil   time.Time  `json:"replicatedUntil"`
+		ReplicatedObjects int64      `json:"replicatedObjects"`
+		Backlog           int64      `json:"backlog"`
+		LastRunAt         *time.Time `json:"lastRunAt"`
+		LastError         string     `json:"lastError"`
+	}{
+		DestinationBucket: rule.DestinationBucket,
+		CreatedAt:         rule.CreatedAt,
+		ReplicatedUntil:   rule.Status.ReplicatedUntil,
+		ReplicatedObjects: rule.Status.ReplicatedObjects,
+		Backlog:           rule.Status.Backlog,
+		LastRunAt:         rule.Status.LastRunAt,
+		LastError:         rule.Status.LastError,
+	}
+
+	data, err := json.Marshal(output)
+	if err != nil {
+		sendJSONError(w, "json encoding failed",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONData(w, http.StatusOK, data)
+}
+
+func (server *Server) deleteBucketReplication(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	project, bucket, err := validateBucketPathParameters(mux.Vars(r))
+	if err != nil {
+		sendJSONError(w, err.Error(), "", http.StatusBadRequest)
+		return
+	}
+
+	err = server.db.Replication().Delete(ctx, project.UUID, string(bucket))
+	if err != nil {
+		if replication.ErrRuleNotFound.Has(err) {
+			sendJSONError(w, "bucket is not replicated", "", http.StatusNotFound)
+		} else {
+			sendJSONError(w, "unable to delete replication rule", err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+}
+
+// restrictAccess returns the serialized access grant restricted to the
+// permission and the prefix.
+func restrictAccess(serializedAccess string, permission uplink.Permission, prefix uplink.SharePrefix) (string, error) {
+	access, err := uplink.ParseAccess(serializedAccess)
+	if err != nil {
+		return "", err
+	}
+
+	restricted, err := access.Share(permission, prefix)
+	if err != nil {
+		return "", err
+	}
+	return restricted.Serialize()
+}
+
+// sendAccessSealError sends the error of sealing an access grant.
+func sendAccessSealError(w http.ResponseWriter, err error) {
+	if sealedaccess.ErrNoKey.Has(err) {
+		sendJSONError(w, "storing access grants is not configured",
+			err.Error(), http.StatusServiceUnavailable)
+		return
+	}
+	sendJSONError(w, "unable to seal access grant",
+		err.Error(), http.StatusInternalServerError)
+}
@@ -18,15 +18,27 @@ import (
 	"golang.org/x/sync/errgroup"
 
 	"storj.io/common/errs2"
+	"storj.io/storj/satellite/accesslog"
 	"storj.io/storj/satellite/accounting"
+	"storj.io/storj/satellite/accounting/projectops"
 	adminui "storj.io/storj/satellite/admin/ui"
+	"storj.io/storj/satellite/bucketpolicy"
 	"storj.io/storj/satellite/buckets"
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/console/consoleweb"
 	"storj.io/storj/satellite/console/restkeys"
+	"storj.io/storj/satellite/gracefulexit"
+	"storj.io/storj/satellite/maintenance"
 	"storj.io/storj/satellite/oidc"
+	"storj.io/storj/satellite/overlay/inventory"
+	"storj.io/storj/satellite/overlay/nodechurn"
+	"storj.io/storj/satellite/overlay/placementstats"
+	"storj.io/storj/satellite/overlay/walletstats"
 	"storj.io/storj/satellite/payments"
 	"storj.io/storj/satellite/payments/stripecoinpayments"
+	"storj.io/storj/satellite/payouts"
+	"storj.io/storj/satellite/replication"
+	"storj.io/storj/satellite/sealedaccess"
 )
 
 // Config defines configuration for debug server.
@@ -48,6 +60,20 @@ type DB interface {
 	OIDC() oidc.DB
 	// StripeCoinPayments returns database for satellite stripe coin payments
 	StripeCoinPayments() stripecoinpayments.DB
+	// Maintenance returns database for the maintenance mode state.
+	Maintenance() maintenance.DB
+	// Replication returns database for bucket replication rules.
+	Replication() replication.DB
+	// BucketPolicies returns database for bucket policies.
+	BucketPolicies() bucketpolicy.DB
+	// AccessLog returns database for the access logs of projects.
+	AccessLog() accesslog.DB
+	// NodeChurn returns database for the daily node churn metrics.
+	NodeChurn() nodechurn.DB
+	// NodeInventory returns database for the inventories of the nodes.
+	NodeInventory() inventory.DB
+	// ProjectOperations returns database for the hourly operation counts of projects.
+	ProjectOperations() projectops.DB
 }
 
 // Server provides endpoints for administrative tasks.
@@ -62,6 +88,14 @@ type Server struct {
 	buckets        *buckets.Service
 	restKeys       *restkeys.Service
 	freezeAccounts *console.AccountFreezeService
+	impersonation  *console.ImpersonationService
+	limitRequests  *console.LimitRequestService
+	gracefulExit   *gracefulexit.Reporter
+	exitAborter    *gracefulexit.Aborter
+	payouts        *payouts.Service
+	placementStats *placementstats.Observer
+	walletStats    *walletstats.Observer
+	accessSealer   *sealedaccess.Sealer
 
 	nowFn func() time.Time
 
@@ -70,7 +104,7 @@ type Server struct {
 }
 
 // NewServer returns a new administration Server.
-func NewServer(log *zap.Logger, listener net.Listener, db DB, buckets *buckets.Service, restKeys *restkeys.Service, freezeAccounts *console.AccountFreezeService, accounts payments.Accounts, console consoleweb.Config, config Config) *Server {
+func NewServer(log *zap.Logger, listener net.Listener, db DB, buckets *buckets.Service, restKeys *restkeys.Service, freezeAccounts *console.AccountFreezeService, impersonation *console.ImpersonationService, limitRequests *console.LimitRequestService, gracefulExit *gracefulexit.Reporter, exitAborter *gracefulexit.Aborter, payouts *payouts.Service, placementStats *placementstats.Observer, walletStats *walletstats.Observer, accessSealer *sealedaccess.Sealer, accounts payments.Accounts, console consoleweb.Config, config Config) *Server {
 	server := &Server{
 		log: log,
 
@@ -81,6 +115,14 @@ func NewServer(log *zap.Logger, listener net.Listener, db DB, buckets *buckets.S
 		buckets:        buckets,
 		restKeys:       restKeys,
 		freezeAccounts: freezeAccounts,
+		impersonation:  impersonation,
+		limitRequests:  limitRequests,
+		gracefulExit:   gracefulExit,
+		exitAborter:    exitAborter,
+		payouts:        payouts,
+		placementStats: placementStats,
+		walletStats:    walletStats,
+		accessSealer:   accessSealer,
 
 		nowFn: time.Now,
 
@@ -101,6 +143,8 @@ func NewServer(log *zap.Logger, listener net.Listener, db DB, buckets *buckets.S
 	api.HandleFunc("/users/{useremail}/mfa", server.disableUserMFA).Methods("DELETE")
 	api.HandleFunc("/users/{useremail}/freeze", server.freezeUser).Methods("PUT")
 	api.HandleFunc("/users/{useremail}/freeze", server.unfreezeUser).Methods("DELETE")
+	api.HandleFunc("/users/{useremail}/impersonate", server.impersonateUser).Methods("POST")
+	api.HandleFunc("/users/{useremail}/impersonations", server.getUserImpersonations).Methods("GET")
 	api.HandleFunc("/oauth/clients", server.createOAuthClient).Methods("POST")
 	api.HandleFunc("/oauth/clients/{id}", server.updateOAuthClient).Methods("PUT")
 	api.HandleFunc("/oauth/clients/{id}", server.deleteOAuthClient).Methods("DELETE")
@@ -111,15 +155,41 @@ func NewServer(log *zap.Logger, listener net.Listener, db DB, buckets *buckets.S
 	api.HandleFunc("/projects/{project}", server.getProject).Methods("GET")
 	api.HandleFunc("/projects/{project}", server.renameProject).Methods("PUT")
 	api.HandleFunc("/projects/{project}", server.deleteProject).Methods("DELETE")
+	api.HandleFunc("/limit-requests", server.getPendingLimitRequests).Methods("GET")
+	api.HandleFunc("/limit-requests/{id}/review", server.reviewLimitRequest).Methods("POST")
+	api.HandleFunc("/projects/{project}/operations", server.getProjectOperations).Methods("GET")
+	api.HandleFunc("/projects/{project}/access-log", server.getAccessLogExport).Methods("GET")
+	api.HandleFunc("/projects/{project}/access-log", server.createAccessLogExport).Methods("POST")
+	api.HandleFunc("/projects/{project}/access-log", server.deleteAccessLogExport).Methods("DELETE")
 	api.HandleFunc("/projects/{project}/apikeys", server.listAPIKeys).Methods("GET")
 	api.HandleFunc("/projects/{project}/apikeys", server.addAPIKey).Methods("POST")
 	api.HandleFunc("/projects/{project}/apikeys/{name}", server.deleteAPIKeyByName).Methods("DELETE")
 	api.HandleFunc("/projects/{project}/buckets/{bucket}", server.getBucketInfo).Methods("GET")
 	api.HandleFunc("/projects/{project}/buckets/{bucket}/geofence", server.createGeofenceForBucket).Methods("POST")
 	api.HandleFunc("/projects/{project}/buckets/{bucket}/geofence", server.deleteGeofenceForBucket).Methods("DELETE")
+	api.HandleFunc("/projects/{project}/buckets/{bucket}/replication", server.getBucketReplication).Methods("GET")
+	api.HandleFunc("/projects/{project}/buckets/{bucket}/replication", server.createBucketReplication).Methods("POST")
+	api.HandleFunc("/projects/{project}/buckets/{bucket}/replication", server.deleteBucketReplication).Methods("DELETE")
+	api.HandleFunc("/projects/{project}/buckets/{bucket}/policy", server.getBucketPolicy).Methods("GET")
+	api.HandleFunc("/projects/{project}/buckets/{bucket}/policy", server.setBucketPolicy).Methods("PUT")
+	api.HandleFunc("/projects/{project}/buckets/{bucket}/policy", server.deleteBucketPolicy).Methods("DELETE")
+	api.HandleFunc("/projects/{project}/buckets/{bucket}/lifecycle", server.getBucketLifecycle).Methods("GET")
+	api.HandleFunc("/projects/{project}/buckets/{bucket}/lifecycle", server.setBucketLifecycle).Methods("PUT")
+	api.HandleFunc("/projects/{project}/buckets/{bucket}/lifecycle", server.deleteBucketLifecycle).Methods("DELETE")
 	api.HandleFunc("/apikeys/{apikey}", server.deleteAPIKey).Methods("DELETE")
 	api.HandleFunc("/restkeys/{useremail}", server.addRESTKey).Methods("POST")
 	api.HandleFunc("/restkeys/{apikey}/revoke", server.revokeRESTKey).Methods("PUT")
+	api.HandleFunc("/nodes/churn", server.getNodeChurn).Methods("GET")
+	api.HandleFunc("/nodes/inventory", server.getNodeInventory).Methods("GET")
+	api.HandleFunc("/nodes/wallets/suspicious", server.getSuspiciousWallets).Methods("GET")
+	api.HandleFunc("/nodes/{nodeid}/graceful-exit", server.getGracefulExitReport).Methods("GET")
+	api.HandleFunc("/nodes/{nodeid}/graceful-exit", server.abortGracefulExit).Methods("DELETE")
+	api.HandleFunc("/nodes/{nodeid}/graceful-exit/failing", server.getGracefulExitFailingTransfers).Methods("GET")
+	api.HandleFunc("/nodes/{nodeid}/held", server.getHeldStatus).Methods("GET")
+	api.HandleFunc("/placements/statistics", server.getPlacementStatistics).Methods("GET")
+	api.HandleFunc("/placements/{placement}/statistics", server.getPlacementStatisticsByName).Methods("GET")
+	api.HandleFunc("/maintenance", server.getMaintenance).Methods("GET")
+	api.HandleFunc("/maintenance", server.setMaintenance).Methods("PUT")
 
 	// This handler must be the last one because it uses the root as prefix,
 	// otherwise will try to serve all the handlers set after this one.
@@ -395,6 +395,35 @@ Blank fields will not be updated.`,
 				func: async (email: string): Promise<null> => {
 					return this.fetch('DELETE', `users/${email}/freeze`) as Promise<null>;
 				}
+			},
+			{
+				name: 'impersonate user',
+				desc: 'create a read-only console session of the user, which is recorded with your reason',
+				params: [
+					['email', new InputText('email', true)],
+					['reason', new InputText('text', true)],
+					['admin (only with the authorization token)', new InputText('email', false)]
+				],
+				func: async (
+					email: string,
+					reason: string,
+					admin?: string
+				): Promise<Record<string, unknown>> => {
+					return this.fetch('POST', `users/${email}/impersonate`, null, {
+						reason,
+						admin
+					}) as Promise<Record<string, unknown>>;
+				}
+			},
+			{
+				name: 'get impersonations',
+				desc: 'get the impersonation sessions of the user',
+				params: [['email', new InputText('email', true)]],
+				func: async (email: string): Promise<Record<string, unknown>> => {
+					return this.fetch('GET', `users/${email}/impersonations`) as Promise<
+						Record<string, unknown>
+					>;
+				}
 			}
 		],
 		rest_api_keys: [
@@ -0,0 +1,27 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+func (server *Server) getSuspiciousWallets(w http.ResponseWriter, r *http.Request) {
+	snapshot, ok := server.walletStats.Latest()
+	if !ok {
+		sendJSONError(w, "wallet statistics aren't computed yet",
+			"", http.StatusServiceUnavailable)
+		return
+	}
+
+	data, err := json.Marshal(snapshot)
+	if err != nil {
+		sendJSONError(w, "json encoding failed",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONData(w, http.StatusOK, data)
+}
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"net"
 	"runtime/pprof"
+	"time"
 
 	"github.com/spacemonkeygo/monkit/v3"
 	"github.com/zeebo/errs"
@@ -24,23 +25,39 @@ import (
 	"storj.io/common/storj"
 	"storj.io/private/debug"
 	"storj.io/private/version"
+	"storj.io/storj/private/contactpb"
+	"storj.io/storj/private/gracefulexitpb"
 	"storj.io/storj/private/lifecycle"
+	"storj.io/storj/private/maintenancepb"
+	"storj.io/storj/private/otlp"
+	"storj.io/storj/private/satellitedecommission"
+	"storj.io/storj/private/satellitesuccessor"
 	"storj.io/storj/private/server"
+	"storj.io/storj/private/settlementpb"
 	"storj.io/storj/private/version/checker"
 	"storj.io/storj/satellite/abtesting"
+	"storj.io/storj/satellite/accesslog"
 	"storj.io/storj/satellite/accounting"
+	"storj.io/storj/satellite/accounting/projectops"
 	"storj.io/storj/satellite/analytics"
+	"storj.io/storj/satellite/audit"
+	"storj.io/storj/satellite/bucketevents"
+	"storj.io/storj/satellite/bucketpolicy"
 	"storj.io/storj/satellite/buckets"
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/console/consoleauth"
 	"storj.io/storj/satellite/console/consoleweb"
+	"storj.io/storj/satellite/console/objectbrowser"
 	"storj.io/storj/satellite/console/restkeys"
 	"storj.io/storj/satellite/console/userinfo"
 	"storj.io/storj/satellite/contact"
+	"storj.io/storj/satellite/events"
 	"storj.io/storj/satellite/gracefulexit"
 	"storj.io/storj/satellite/inspector"
+	"storj.io/storj/satellite/integrity"
 	"storj.io/storj/satellite/internalpb"
 	"storj.io/storj/satellite/mailservice"
+	"storj.io/storj/satellite/maintenance"
 	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/metainfo"
 	"storj.io/storj/satellite/metainfo/piecedeletion"
@@ -52,7 +69,9 @@ import (
 	"storj.io/storj/satellite/payments/storjscan"
 	"storj.io/storj/satellite/payments/stripecoinpayments"
 	"storj.io/storj/satellite/reputation"
+	"storj.io/storj/satellite/revocation"
 	"storj.io/storj/satellite/rewards"
+	"storj.io/storj/satellite/secrets"
 	"storj.io/storj/satellite/snopayouts"
 )
 
@@ -81,9 +100,18 @@ type API struct {
 		Server   *debug.Server
 	}
 
+	Tracing struct {
+		Exporter *otlp.Exporter
+	}
+
+	Secrets struct {
+		Chore *secrets.Chore
+	}
+
 	Contact struct {
-		Service  *contact.Service
-		Endpoint *contact.Endpoint
+		Service    *contact.Service
+		Endpoint   *contact.Endpoint
+		EndpointV2 *contact.EndpointV2
 	}
 
 	Overlay struct {
@@ -102,9 +130,36 @@ type API struct {
 		Chore    *orders.Chore
 	}
 
+	Maintenance struct {
+		Service  *maintenance.Service
+		Endpoint *maintenance.Endpoint
+	}
+
+	BucketEvents struct {
+		Service *bucketevents.Service
+	}
+
+	BucketPolicy struct {
+		Service *bucketpolicy.Service
+	}
+
+	AccessLog struct {
+		Recorder *accesslog.Recorder
+	}
+
+	ProjectOps struct {
+		Recorder *projectops.Recorder
+	}
+
+	Integrity struct {
+		Verifier *audit.Verifier
+		Service  *integrity.Service
+	}
+
 	Metainfo struct {
 		Metabase      *metabase.DB
 		PieceDeletion *piecedeletion.Service
+		Revocations   *revocation.Cache
 		Endpoint      *metainfo.Endpoint
 	}
 
@@ -128,6 +183,10 @@ type API struct {
 		Cache *accounting.ProjectLimitCache
 	}
 
+	Events struct {
+		Bus *events.Bus
+	}
+
 	Mail struct {
 		Service *mailservice.Service
 	}
@@ -148,10 +207,15 @@ type API struct {
 	}
 
 	Console struct {
-		Listener   net.Listener
-		Service    *console.Service
-		Endpoint   *consoleweb.Server
-		AuthTokens *consoleauth.Service
+		Listener      net.Listener
+		Service       *console.Service
+		Endpoint      *consoleweb.Server
+		AuthTokens    *consoleauth.Service
+		ObjectBrowser *objectbrowser.Service
+	}
+
+	FreezeAccounts struct {
+		Service *console.AccountFreezeService
 	}
 
 	Marketing struct {
@@ -173,7 +237,8 @@ type API struct {
 	}
 
 	GracefulExit struct {
-		Endpoint *gracefulexit.Endpoint
+		Endpoint      *gracefulexit.Endpoint
+		AbortEndpoint *gracefulexit.AbortEndpoint
 	}
 
 	Analytics struct {
@@ -190,7 +255,7 @@ type API struct {
 }
 
 // NewAPI creates a new satellite API process.
-func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
+func NewAPI(ctx context.Context, log *zap.Logger, full *identity.FullIdentity, db DB,
 	metabaseDB *metabase.DB, revocationDB extensions.RevocationDB,
 	liveAccounting accounting.Cache, rollupsWriteCache *orders.RollupsWriteCache,
 	config *Config, versionInfo version.Info, atomicLogLevel *zap.AtomicLevel) (*API, error) {
@@ -227,6 +292,37 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 		})
 	}
 
+	var peerSecrets *secretsLoader
+	{ // setup secrets
+		var err error
+		peerSecrets, err = newSecretsLoader(ctx, log.Named("secrets:chore"), config.Secrets)
+		if err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
+		peer.Secrets.Chore = peerSecrets.Chore
+		if peer.Secrets.Chore != nil {
+			peer.Services.Add(lifecycle.Item{
+				Name:  "secrets:chore",
+				Run:   peer.Secrets.Chore.Run,
+				Close: peer.Secrets.Chore.Close,
+			})
+			peer.Debug.Server.Panel.Add(
+				debug.Cycle("Secrets Chore", peer.Secrets.Chore.Loop))
+		}
+	}
+
+	{ // setup trace export
+		var err error
+		peer.Tracing.Exporter, err = otlp.NewExporter(log.Named("tracing"), monkit.Default, config.OTLP, "satellite-api")
+		if err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
+		peer.Services.Add(lifecycle.Item{
+			Name: "tracing",
+			Run:  peer.Tracing.Exporter.Run,
+		})
+	}
+
 	var err error
 
 	{
@@ -291,10 +387,21 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 		})
 	}
 
+	{ // setup events
+		peer.Events.Bus, err = events.NewBus(peer.Log.Named("events:bus"), config.Events)
+		if err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
+		peer.Services.Add(lifecycle.Item{
+			Name: "events:bus",
+			Run:  peer.Events.Bus.Run,
+		})
+	}
+
 	{ // setup overlay
 		peer.Overlay.DB = peer.DB.OverlayCache()
 
-		peer.Overlay.Service, err = overlay.NewService(peer.Log.Named("overlay"), peer.Overlay.DB, peer.DB.NodeEvents(), peer.Mail.Service, config.Console.ExternalAddress, config.Console.SatelliteName, config.Overlay)
+		peer.Overlay.Service, err = overlay.NewService(peer.Log.Named("overlay"), peer.Overlay.DB, peer.DB.NodeEvents(), peer.Events.Bus, peer.Mail.Service, config.Console.ExternalAddress, config.Console.SatelliteName, config.Overlay)
 		if err != nil {
 			return nil, errs.Combine(err, peer.Close())
 		}
@@ -338,11 +445,33 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 			Type:    pb.NodeType_SATELLITE,
 			Version: *pbVersion,
 		}
-		peer.Contact.Service = contact.NewService(peer.Log.Named("contact:service"), self, peer.Overlay.Service, peer.DB.PeerIdentities(), peer.Dialer, config.Contact)
+		peer.Contact.Service = contact.NewService(peer.Log.Named("contact:service"), self, peer.Overlay.Service, peer.DB.PeerIdentities(), peer.DB.NodeInventory(), peer.Dialer, config.Contact)
+		if !config.Contact.Successor.IsZero() {
+			pointer, err := satellitesuccessor.Sign(context.Background(), signing.SignerFromFullIdentity(peer.Identity), config.Contact.Successor, time.Now())
+			if err != nil {
+				return nil, errs.Combine(err, peer.Close())
+			}
+			peer.Contact.Service.SetSuccessor(pointer)
+		}
+		if config.Contact.ShutdownAt != "" {
+			shutdownAt, err := time.Parse(time.RFC3339, config.Contact.ShutdownAt)
+			if err != nil {
+				return nil, errs.Combine(err, peer.Close())
+			}
+			notice, err := satellitedecommission.Sign(context.Background(), signing.SignerFromFullIdentity(peer.Identity), shutdownAt, time.Now())
+			if err != nil {
+				return nil, errs.Combine(err, peer.Close())
+			}
+			peer.Contact.Service.SetDecommission(notice)
+		}
 		peer.Contact.Endpoint = contact.NewEndpoint(peer.Log.Named("contact:endpoint"), peer.Contact.Service)
 		if err := pb.DRPCRegisterNode(peer.Server.DRPC(), peer.Contact.Endpoint); err != nil {
 			return nil, errs.Combine(err, peer.Close())
 		}
+		peer.Contact.EndpointV2 = contact.NewEndpointV2(peer.Contact.Endpoint)
+		if err := contactpb.DRPCRegisterNode(peer.Server.DRPC(), peer.Contact.EndpointV2); err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
 
 		peer.Services.Add(lifecycle.Item{
 			Name:  "contact:service",
@@ -388,17 +517,21 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 		})
 		peer.Debug.Server.Panel.Add(
 			debug.Cycle("Orders Chore", peer.Orders.Chore.Loop))
-		var err error
+		ordersConfig, err := peerSecrets.ordersConfig(ctx, config.Orders)
+		if err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
 		peer.Orders.Service, err = orders.NewService(
 			peer.Log.Named("orders:service"),
 			signing.SignerFromFullIdentity(peer.Identity),
 			peer.Overlay.Service,
 			peer.Orders.DB,
-			config.Orders,
+			ordersConfig,
 		)
 		if err != nil {
 			return nil, errs.Combine(err, peer.Close())
 		}
+		peerSecrets.watchOrders(peer.Orders.Service)
 
 		satelliteSignee := signing.SigneeFromPeerIdentity(peer.Identity.PeerIdentity())
 		peer.Orders.Endpoint = orders.NewEndpoint(
@@ -413,6 +546,9 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 		if err := pb.DRPCRegisterOrders(peer.Server.DRPC(), peer.Orders.Endpoint); err != nil {
 			return nil, errs.Combine(err, peer.Close())
 		}
+		if err := settlementpb.DRPCRegisterSettlement(peer.Server.DRPC(), peer.Orders.Endpoint); err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
 	}
 
 	{ // setup marketing partners service
@@ -440,9 +576,80 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 		})
 	}
 
+	{ // setup maintenance mode
+		peer.Maintenance.Service = maintenance.NewService(
+			peer.Log.Named("maintenance"),
+			peer.DB.Maintenance(),
+			config.Maintenance,
+		)
+		peer.Services.Add(lifecycle.Item{
+			Name:  "maintenance",
+			Run:   peer.Maintenance.Service.Run,
+			Close: peer.Maintenance.Service.Close,
+		})
+
+		peer.Maintenance.Endpoint = maintenance.NewEndpoint(peer.Maintenance.Service)
+		if err := maintenancepb.DRPCRegisterMaintenance(peer.Server.DRPC(), peer.Maintenance.Endpoint); err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
+	}
+
+	{ // setup bucket event notifications
+		peer.BucketEvents.Service = bucketevents.NewService(
+			peer.Log.Named("bucketevents"),
+			peer.DB.BucketEvents(),
+			config.BucketEvents,
+		)
+		peer.Services.Add(lifecycle.Item{
+			Name: "bucketevents",
+			Run:  peer.BucketEvents.Service.Run,
+		})
+	}
+
+	{ // setup bucket policies
+		peer.BucketPolicy.Service = bucketpolicy.NewService(
+			peer.Log.Named("bucketpolicy"),
+			peer.DB.BucketPolicies(),
+			config.BucketPolicy,
+		)
+	}
+
+	{ // setup access log recording
+		peer.AccessLog.Recorder = accesslog.NewRecorder(
+			peer.Log.Named("accesslog:recorder"),
+			peer.DB.AccessLog(),
+			config.AccessLog,
+		)
+		peer.Services.Add(lifecycle.Item{
+			Name: "accesslog:recorder",
+			Run:  peer.AccessLog.Recorder.Run,
+		})
+	}
+
+	{ // setup project operation counting
+		peer.ProjectOps.Recorder = projectops.NewRecorder(
+			peer.Log.Named("projectops:recorder"),
+			peer.DB.ProjectOperations(),
+			config.ProjectOps,
+		)
+		peer.Services.Add(lifecycle.Item{
+			Name: "projectops:recorder",
+			Run:  peer.ProjectOps.Recorder.Run,
+		})
+	}
+
 	{ // setup metainfo
 		peer.Metainfo.Metabase = metabaseDB
 
+		peer.Metainfo.Revocations = revocation.NewCache(peer.Log.Named("metainfo:revocations"), peer.DB.Revocation(), config.Revocation)
+		peer.Services.Add(lifecycle.Item{
+			Name:  "metainfo:revocations",
+			Run:   peer.Metainfo.Revocations.Run,
+			Close: peer.Metainfo.Revocations.Close,
+		})
+		peer.Debug.Server.Panel.Add(
+			debug.Cycle("Metainfo Revocations", peer.Metainfo.Revocations.Loop))
+
 		peer.Metainfo.PieceDeletion, err = piecedeletion.NewService(
 			peer.Log.Named("metainfo:piecedeletion"),
 			peer.Dialer,
@@ -469,11 +676,18 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 			peer.DB.Attribution(),
 			peer.Marketing.PartnersService,
 			peer.DB.PeerIdentities(),
-			peer.DB.Console().APIKeys(),
+			objectbrowser.NewAPIKeys(config.ObjectBrowser, peer.DB.Console().APIKeys()),
 			peer.Accounting.ProjectUsage,
 			peer.DB.Console().Projects(),
+			peer.DB.Console().ProjectNetworkRules(),
 			signing.SignerFromFullIdentity(peer.Identity),
-			peer.DB.Revocation(),
+			peer.Metainfo.Revocations,
+			peer.Events.Bus,
+			peer.BucketEvents.Service,
+			peer.BucketPolicy.Service,
+			peer.AccessLog.Recorder,
+			peer.ProjectOps.Recorder,
+			peer.Maintenance.Service,
 			config.Metainfo,
 		)
 		if err != nil {
@@ -483,6 +697,7 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 		if err := pb.DRPCRegisterMetainfo(peer.Server.DRPC(), peer.Metainfo.Endpoint); err != nil {
 			return nil, errs.Combine(err, peer.Close())
 		}
+		peer.Server.SetMaxRequestTimeout("metainfo.Metainfo", config.Metainfo.MaxRequestTimeout)
 
 		peer.Services.Add(lifecycle.Item{
 			Name:  "metainfo:endpoint",
@@ -490,6 +705,31 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 		})
 	}
 
+	{ // setup object integrity checks
+		if config.Integrity.Enabled {
+			peer.Integrity.Verifier = audit.NewVerifier(
+				peer.Log.Named("integrity:verifier"),
+				peer.Metainfo.Metabase,
+				peer.Dialer,
+				peer.Overlay.Service,
+				peer.DB.Containment(),
+				peer.Orders.Service,
+				peer.Identity,
+				config.Audit.MinBytesPerSecond,
+				config.Audit.MinDownloadTimeout,
+			)
+
+			peer.Integrity.Service = integrity.NewService(
+				peer.Log.Named("integrity"),
+				peer.Metainfo.Metabase,
+				peer.Overlay.Service,
+				peer.Integrity.Verifier,
+				signing.SignerFromFullIdentity(peer.Identity),
+				config.Integrity,
+			)
+		}
+	}
+
 	{ // setup userinfo.
 		if config.Userinfo.Enabled {
 
@@ -540,7 +780,11 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 				peer.DB.Console().Users(),
 			)
 		case "stripecoinpayments":
-			stripeClient = stripecoinpayments.NewStripeClient(log, pc.StripeCoinPayments)
+			stripeConfig, err := peerSecrets.stripeConfig(ctx, pc.StripeCoinPayments)
+			if err != nil {
+				return nil, errs.Combine(err, peer.Close())
+			}
+			stripeClient = stripecoinpayments.NewStripeClient(log, stripeConfig)
 		}
 
 		prices, err := pc.UsagePrice.ToModel()
@@ -564,7 +808,8 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 			peer.DB.ProjectAccounting(),
 			prices,
 			priceOverrides,
-			pc.BonusRate)
+			pc.BonusRate,
+			peer.Events.Bus)
 
 		if err != nil {
 			return nil, errs.Combine(err, peer.Close())
@@ -599,11 +844,12 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 		if err != nil {
 			return nil, errs.Combine(err, peer.Close())
 		}
-		if consoleConfig.AuthTokenSecret == "" {
-			return nil, errs.New("Auth token secret required")
+		authTokenSigner, err := peerSecrets.consoleAuthSigner(ctx, consoleConfig.AuthTokenSecret)
+		if err != nil {
+			return nil, errs.Combine(err, peer.Close())
 		}
 
-		peer.Console.AuthTokens = consoleauth.NewService(config.ConsoleAuth, &consoleauth.Hmac{Secret: []byte(consoleConfig.AuthTokenSecret)})
+		peer.Console.AuthTokens = consoleauth.NewService(config.ConsoleAuth, authTokenSigner)
 
 		externalAddress := consoleConfig.ExternalAddress
 		if externalAddress == "" {
@@ -617,6 +863,8 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 			peer.DB.ProjectAccounting(),
 			peer.Accounting.ProjectUsage,
 			peer.Buckets.Service,
+			metabaseDB,
+			peer.Metainfo.Revocations,
 			peer.Marketing.PartnersService,
 			peer.Payments.Accounts,
 			peer.Payments.DepositWallets,
@@ -631,7 +879,15 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 			return nil, errs.Combine(err, peer.Close())
 		}
 
-		accountFreezeService := console.NewAccountFreezeService(db.Console().AccountFreezeEvents(), db.Console().Users(), db.Console().Projects())
+		if config.ObjectBrowser.Enabled {
+			peer.Console.ObjectBrowser = objectbrowser.NewService(
+				peer.Log.Named("console:objectbrowser"),
+				peer.URL(),
+				config.ObjectBrowser,
+			)
+		}
+
+		peer.FreezeAccounts.Service = console.NewAccountFreezeService(db.Console().AccountFreezeEvents(), db.Console().Users(), db.Console().Projects())
 
 		peer.Console.Endpoint = consoleweb.NewServer(
 			peer.Log.Named("console:endpoint"),
@@ -642,7 +898,11 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 			peer.Marketing.PartnersService,
 			peer.Analytics.Service,
 			peer.ABTesting.Service,
-			accountFreezeService,
+			peer.FreezeAccounts.Service,
+			peer.Integrity.Service,
+			peer.BucketEvents.Service,
+			peer.BucketPolicy.Service,
+			peer.Console.ObjectBrowser,
 			peer.Console.Listener,
 			config.Payments.StripeCoinPayments.StripePublicKey,
 			config.Payments.UsagePrice,
@@ -701,6 +961,14 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 			if err := pb.DRPCRegisterSatelliteGracefulExit(peer.Server.DRPC(), peer.GracefulExit.Endpoint); err != nil {
 				return nil, errs.Combine(err, peer.Close())
 			}
+
+			peer.GracefulExit.AbortEndpoint = gracefulexit.NewAbortEndpoint(
+				peer.Log.Named("gracefulexit:abort-endpoint"),
+				gracefulexit.NewAborter(peer.Log.Named("gracefulexit:aborter"), peer.DB.GracefulExit(), config.GracefulExit))
+
+			if err := gracefulexitpb.DRPCRegisterGracefulExitAbort(peer.Server.DRPC(), peer.GracefulExit.AbortEndpoint); err != nil {
+				return nil, errs.Combine(err, peer.Close())
+			}
 		} else {
 			peer.Log.Named("gracefulexit").Info("disabled")
 		}
@@ -0,0 +1,139 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package attestation implements the durability reports of buckets, which
+// the satellite signs, so that project owners can use them as compliance
+// evidence.
+//
+// A report summarizes the audits, the repairs and the redundancy of the
+// segments of a bucket. Anyone can verify a report with the public key of
+// the satellite identity.
+package attestation
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+
+	"storj.io/common/signing"
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+)
+
+var (
+	// Error is the error class for durability reports.
+	Error = errs.Class("attestation")
+	// ErrReportNotFound is returned when the report doesn't exist.
+	ErrReportNotFound = errs.Class("durability report not found")
+
+	mon = monkit.Package()
+)
+
+// Report is the durability attestation of a bucket.
+type Report struct {
+	Satellite  storj.NodeID `json:"satellite"`
+	ProjectID  uuid.UUID    `json:"projectId"`
+	BucketName string       `json:"bucketName"`
+	CreatedAt  time.Time    `json:"createdAt"`
+	// ComputedAt is the time, which the counts reflect. It may be before
+	// CreatedAt, because the counts are read from a recent snapshot of the
+	// database.
+	ComputedAt time.Time `json:"computedAt"`
+	// Since is the beginning of the period, which the audit and repair
+	// counts cover.
+	Since time.Time `json:"since"`
+
+	RemoteSegments int64 `json:"remoteSegments"`
+	InlineSegments int64 `json:"inlineSegments"`
+	// AuditedSegments and RecentlyAudited count the segments, whose pieces
+	// were audited, and not the segments, which were only sampled for audit.
+	AuditedSegments  int64 `json:"auditedSegments"`
+	RecentlyAudited  int64 `json:"recentlyAudited"`
+	RecentlyRepaired int64 `json:"recentlyRepaired"`
+	// AuditCoverage is the ratio of the remote segments, which were audited
+	// within the period.
+	AuditCoverage float64 `json:"auditCoverage"`
+	// PiecesAboveMinimum counts the remote segments by how many pieces on
+	// online and qualified nodes they have above the number required to
+	// reconstruct them.
+	PiecesAboveMinimum map[int]int64 `json:"piecesAboveMinimum"`
+}
+
+// SignedReport is a report with the signature of the satellite. The signature
+// covers Data, which is the report encoded as JSON.
+type SignedReport struct {
+	ID         uuid.UUID       `json:"id"`
+	ProjectID  uuid.UUID       `json:"-"`
+	BucketName string          `json:"-"`
+	CreatedAt  time.Time       `json:"-"`
+	Data       json.RawMessage `json:"report"`
+	Signature  []byte          `json:"signature"`
+}
+
+// DB stores the signed durability reports.
+//
+// architecture: Database
+type DB interface {
+	// Insert stores the signed report.
+	Insert(ctx context.Context, report SignedReport) error
+	// Get returns the report of the bucket with the ID.
+	Get(ctx context.Context, projectID uuid.UUID, bucketName string, id uuid.UUID) (SignedReport, error)
+	// List returns the reports of the bucket, the most recent first.
+	List(ctx context.Context, projectID uuid.UUID, bucketName string, limit int) ([]SignedReport, error)
+	// DeleteBefore deletes the reports created before the time.
+	DeleteBefore(ctx context.Context, before time.Time) (int64, error)
+}
+
+// Sign encodes the report and signs it with the identity of the satellite.
+func Sign(ctx context.Context, signer signing.Signer, report Report) (_ SignedReport, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if report.Satellite != signer.ID() {
+		return SignedReport{}, Error.New("report of %s can't be signed by %s", report.Satellite, signer.ID())
+	}
+
+	id, err := uuid.New()
+	if err != nil {
+		return SignedReport{}, Error.Wrap(err)
+	}
+
+	data, err := json.Marshal(report)
+	if err != nil {
+		return SignedReport{}, Error.Wrap(err)
+	}
+
+	signature, err := signer.HashAndSign(ctx, data)
+	if err != nil {
+		return SignedReport{}, Error.Wrap(err)
+	}
+
+	return SignedReport{
+		ID:         id,
+		ProjectID:  report.ProjectID,
+		BucketName: report.BucketName,
+		CreatedAt:  report.CreatedAt,
+		Data:       data,
+		Signature:  signature,
+	}, nil
+}
+
+// Verify checks that the report is signed by the satellite and returns the
+// decoded report.
+func Verify(ctx context.Context, signee signing.Signee, signed SignedReport) (_ Report, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var report Report
+	if err := json.Unmarshal(signed.Data, &report); err != nil {
+		return Report{}, Error.Wrap(err)
+	}
+	if report.Satellite != signee.ID() {
+		return Report{}, Error.New("report of %s is not signed by %s", report.Satellite, signee.ID())
+	}
+	if err := signee.HashAndVerifySignature(ctx, signed.Data, signed.Signature); err != nil {
+		return Report{}, Error.Wrap(err)
+	}
+	return report, nil
+}
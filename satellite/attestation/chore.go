@@ -0,0 +1,199 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package attestation
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/signing"
+	"storj.io/common/storj"
+	"storj.io/common/sync2"
+	"storj.io/storj/satellite/buckets"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/overlay"
+)
+
+// Config contains configurable values for the durability reports.
+type Config struct {
+	Enabled            bool          `help:"whether durability reports are generated for all buckets" default:"false"`
+	Interval           time.Duration `help:"how often durability reports are generated" releaseDefault:"168h" devDefault:"1h" testDefault:"$TESTINTERVAL"`
+	Period             time.Duration `help:"period, which the audit and repair counts of a report cover" default:"720h"`
+	Retention          time.Duration `help:"how long durability reports are kept" default:"8760h"`
+	ListLimit          int           `help:"how many buckets to query in a batch" default:"1000"`
+	AsOfSystemInterval time.Duration `help:"as of system interval" releaseDefault:"-5m" devDefault:"-1us" testDefault:"-1us"`
+}
+
+// Chore periodically generates the signed durability reports of all buckets.
+//
+// architecture: Chore
+type Chore struct {
+	log      *zap.Logger
+	db       DB
+	buckets  buckets.DB
+	metabase *metabase.DB
+	overlay  *overlay.Service
+	signer   signing.Signer
+	config   Config
+	Loop     *sync2.Cycle
+
+	nowFn func() time.Time
+}
+
+// NewChore creates a new durability report chore.
+func NewChore(log *zap.Logger, db DB, buckets buckets.DB, metabase *metabase.DB, overlay *overlay.Service, signer signing.Signer, config Config) *Chore {
+	return &Chore{
+		log:      log,
+		db:       db,
+		buckets:  buckets,
+		metabase: metabase,
+		overlay:  overlay,
+		signer:   signer,
+		config:   config,
+		Loop:     sync2.NewCycle(config.Interval),
+
+		nowFn: time.Now,
+	}
+}
+
+// Run generates the durability reports until ctx is canceled.
+func (chore *Chore) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if !chore.config.Enabled {
+		return nil
+	}
+
+	return chore.Loop.Run(ctx, func(ctx context.Context) error {
+		if err := chore.RunOnce(ctx); err != nil {
+			chore.log.Error("failed to generate durability reports", zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// SetNow allows tests to have the chore act as if the current time is
+// whatever they want.
+func (chore *Chore) SetNow(nowFn func() time.Time) {
+	chore.nowFn = nowFn
+}
+
+// RunOnce generates the durability reports of all buckets, which aren't
+// empty, and deletes the reports older than the retention. Failures of a
+// single bucket are logged.
+func (chore *Chore) RunOnce(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	now := chore.nowFn()
+
+	// pieces on offline and disqualified nodes don't count towards the
+	// redundancy of the reports.
+	nodes, err := chore.overlay.Reliable(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	reliable := make(map[storj.NodeID]struct{}, len(nodes))
+	for _, node := range nodes {
+		reliable[node.ID] = struct{}{}
+	}
+
+	var generated, failed int64
+	var last metabase.BucketLocation
+	for {
+		more, err := chore.buckets.IterateBucketLocations(ctx, last.ProjectID, last.BucketName, chore.config.ListLimit, func(locations []metabase.BucketLocation) error {
+			for _, location := range locations {
+				ok, err := chore.generate(ctx, location, reliable, now)
+				if err != nil {
+					if ctx.Err() != nil {
+						return ctx.Err()
+					}
+					chore.log.Warn("failed to generate durability report",
+						zap.Stringer("Project ID", location.ProjectID),
+						zap.String("Bucket", location.BucketName),
+						zap.Error(err))
+					failed++
+					continue
+				}
+				if ok {
+					generated++
+				}
+			}
+			last = locations[len(locations)-1]
+			return nil
+		})
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		if !more {
+			break
+		}
+	}
+
+	mon.IntVal("durability_reports_generated").Observe(generated)
+	mon.IntVal("durability_reports_failed").Observe(failed)
+
+	deleted, err := chore.db.DeleteBefore(ctx, now.Add(-chore.config.Retention))
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	mon.IntVal("durability_reports_deleted").Observe(deleted)
+
+	return nil
+}
+
+// generate creates and stores the report of the bucket. ok is false, when the
+// bucket is empty.
+func (chore *Chore) generate(ctx context.Context, location metabase.BucketLocation, reliable map[storj.NodeID]struct{}, now time.Time) (ok bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	since := now.Add(-chore.config.Period)
+
+	durability, err := chore.metabase.GetBucketDurability(ctx, metabase.GetBucketDurability{
+		ProjectID:          location.ProjectID,
+		BucketName:         location.BucketName,
+		Since:              since,
+		ReliableNodes:      reliable,
+		AsOfSystemInterval: chore.config.AsOfSystemInterval,
+	})
+	if err != nil {
+		return false, err
+	}
+	if durability.RemoteSegments == 0 && durability.InlineSegments == 0 {
+		return false, nil
+	}
+
+	report := Report{
+		Satellite:  chore.signer.ID(),
+		ProjectID:  location.ProjectID,
+		BucketName: location.BucketName,
+		CreatedAt:  now.UTC(),
+		ComputedAt: durability.ComputedAt.UTC(),
+		Since:      since.UTC(),
+
+		RemoteSegments:     durability.RemoteSegments,
+		InlineSegments:     durability.InlineSegments,
+		AuditedSegments:    durability.AuditedSegments,
+		RecentlyAudited:    durability.RecentlyAudited,
+		RecentlyRepaired:   durability.RecentlyRepaired,
+		PiecesAboveMinimum: durability.PiecesAboveMinimum,
+	}
+	if durability.RemoteSegments > 0 {
+		report.AuditCoverage = float64(durability.RecentlyAudited) / float64(durability.RemoteSegments)
+	}
+
+	signed, err := Sign(ctx, chore.signer, report)
+	if err != nil {
+		return false, err
+	}
+
+	return true, chore.db.Insert(ctx, signed)
+}
+
+// Close stops the chore.
+func (chore *Chore) Close() error {
+	chore.Loop.Close()
+	return nil
+}
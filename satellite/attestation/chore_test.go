@@ -0,0 +1,152 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package attestation_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/identity/testidentity"
+	"storj.io/common/memory"
+	"storj.io/common/signing"
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite/attestation"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/overlay"
+)
+
+func TestChore(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		uplink := planet.Uplinks[0]
+		projectID := uplink.Projects[0].ID
+
+		require.NoError(t, uplink.Upload(ctx, satellite, "full", "remote", testrand.Bytes(10*memory.KiB)))
+		require.NoError(t, uplink.Upload(ctx, satellite, "full", "inline", testrand.Bytes(memory.KiB)))
+		require.NoError(t, uplink.CreateBucket(ctx, satellite, "empty"))
+
+		db := satellite.DB.Console().DurabilityReports()
+		signer := signing.SignerFromFullIdentity(satellite.Identity)
+
+		chore := attestation.NewChore(zaptest.NewLogger(t), db, satellite.DB.Buckets(), satellite.Metabase.DB, satellite.Overlay.Service, signer, attestation.Config{
+			Enabled:            true,
+			Interval:           time.Hour,
+			Period:             24 * time.Hour,
+			Retention:          48 * time.Hour,
+			ListLimit:          1,
+			AsOfSystemInterval: -time.Microsecond,
+		})
+		defer ctx.Check(chore.Close)
+
+		now := time.Now()
+		chore.SetNow(func() time.Time { return now })
+		require.NoError(t, chore.RunOnce(ctx))
+
+		reports, err := db.List(ctx, projectID, "empty", 10)
+		require.NoError(t, err)
+		require.Empty(t, reports)
+
+		reports, err = db.List(ctx, projectID, "full", 10)
+		require.NoError(t, err)
+		require.Len(t, reports, 1)
+
+		report, err := attestation.Verify(ctx, signing.SigneeFromPeerIdentity(satellite.Identity.PeerIdentity()), reports[0])
+		require.NoError(t, err)
+		require.Equal(t, satellite.ID(), report.Satellite)
+		require.Equal(t, projectID, report.ProjectID)
+		require.Equal(t, "full", report.BucketName)
+		require.EqualValues(t, 1, report.RemoteSegments)
+		require.EqualValues(t, 1, report.InlineSegments)
+		require.EqualValues(t, 0, report.RecentlyAudited)
+		require.Len(t, report.PiecesAboveMinimum, 1)
+		require.False(t, report.ComputedAt.After(report.CreatedAt))
+		require.WithinDuration(t, report.CreatedAt, report.ComputedAt, time.Minute)
+
+		stored, err := db.Get(ctx, projectID, "full", reports[0].ID)
+		require.NoError(t, err)
+		require.Equal(t, reports[0].Signature, stored.Signature)
+
+		_, err = db.Get(ctx, projectID, "empty", reports[0].ID)
+		require.True(t, attestation.ErrReportNotFound.Has(err))
+
+		// pieces on disqualified nodes don't count.
+		segments, err := satellite.Metabase.DB.TestingAllSegments(ctx)
+		require.NoError(t, err)
+		var remote metabase.Segment
+		for _, segment := range segments {
+			if len(segment.Pieces) > 0 {
+				remote = segment
+			}
+		}
+		_, err = satellite.DB.OverlayCache().DisqualifyNode(ctx, remote.Pieces[0].StorageNode, time.Now(), overlay.DisqualificationReasonUnknown)
+		require.NoError(t, err)
+
+		// reports older than the retention are deleted.
+		chore.SetNow(func() time.Time { return now.Add(72 * time.Hour) })
+		require.NoError(t, chore.RunOnce(ctx))
+
+		reports, err = db.List(ctx, projectID, "full", 10)
+		require.NoError(t, err)
+		require.Len(t, reports, 1)
+		require.NotEqual(t, stored.ID, reports[0].ID)
+
+		disqualified, err := attestation.Verify(ctx, signing.SigneeFromPeerIdentity(satellite.Identity.PeerIdentity()), reports[0])
+		require.NoError(t, err)
+		above := len(remote.Pieces) - int(remote.Redundancy.RequiredShares)
+		require.Equal(t, map[int]int64{above: 1}, report.PiecesAboveMinimum)
+		require.Equal(t, map[int]int64{above - 1: 1}, disqualified.PiecesAboveMinimum)
+	})
+}
+
+func TestSign(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	identity, err := testidentity.PregeneratedIdentity(0, storj.LatestIDVersion())
+	require.NoError(t, err)
+	otherIdentity, err := testidentity.PregeneratedIdentity(1, storj.LatestIDVersion())
+	require.NoError(t, err)
+
+	signer := signing.SignerFromFullIdentity(identity)
+	signee := signing.SigneeFromPeerIdentity(identity.PeerIdentity())
+	other := signing.SignerFromFullIdentity(otherIdentity)
+
+	report := attestation.Report{
+		Satellite:          signer.ID(),
+		ProjectID:          testrand.UUID(),
+		BucketName:         "bucket",
+		CreatedAt:          time.Now().UTC(),
+		RemoteSegments:     10,
+		RecentlyAudited:    5,
+		AuditCoverage:      0.5,
+		PiecesAboveMinimum: map[int]int64{3: 10},
+	}
+
+	signed, err := attestation.Sign(ctx, signer, report)
+	require.NoError(t, err)
+
+	verified, err := attestation.Verify(ctx, signee, signed)
+	require.NoError(t, err)
+	require.Equal(t, report, verified)
+
+	_, err = attestation.Verify(ctx, signing.SigneeFromPeerIdentity(otherIdentity.PeerIdentity()), signed)
+	require.Error(t, err)
+
+	// a tampered report doesn't verify.
+	tampered := signed
+	tampered.Signature = append([]byte{}, signed.Signature...)
+	tampered.Signature[0]++
+	_, err = attestation.Verify(ctx, signee, tampered)
+	require.Error(t, err)
+
+	_, err = attestation.Sign(ctx, other, report)
+	require.Error(t, err)
+}
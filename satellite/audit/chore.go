@@ -12,6 +12,7 @@ import (
 
 	"storj.io/common/sync2"
 	"storj.io/common/uuid"
+	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/metabase/segmentloop"
 )
 
@@ -25,11 +26,12 @@ type Chore struct {
 	Loop  *sync2.Cycle
 
 	segmentLoop *segmentloop.Service
+	metabase    *metabase.DB
 	config      Config
 }
 
 // NewChore instantiates Chore.
-func NewChore(log *zap.Logger, queue VerifyQueue, loop *segmentloop.Service, config Config) *Chore {
+func NewChore(log *zap.Logger, queue VerifyQueue, loop *segmentloop.Service, metabase *metabase.DB, config Config) *Chore {
 	if config.VerificationPushBatchSize < 1 {
 		config.VerificationPushBatchSize = 1
 	}
@@ -40,6 +42,7 @@ func NewChore(log *zap.Logger, queue VerifyQueue, loop *segmentloop.Service, con
 		Loop:  sync2.NewCycle(config.ChoreInterval),
 
 		segmentLoop: loop,
+		metabase:    metabase,
 		config:      config,
 	}
 }
@@ -51,6 +54,7 @@ func (chore *Chore) Run(ctx context.Context) (err error) {
 		defer mon.Task()(&ctx)(&err)
 
 		collector := NewCollector(chore.config.Slots, chore.rand)
+		collector.PreferNeverAudited(chore.config.NeverAuditedWeight)
 		err = chore.segmentLoop.Join(ctx, collector)
 		if err != nil {
 			chore.log.Error("error joining segmentloop", zap.Error(err))
@@ -90,7 +94,17 @@ func (chore *Chore) Run(ctx context.Context) (err error) {
 		}
 
 		// Push new queue to queues struct so it can be fetched by worker.
-		return chore.queue.Push(ctx, newQueue, chore.config.VerificationPushBatchSize)
+		err = chore.queue.Push(ctx, newQueue, chore.config.VerificationPushBatchSize)
+		if err != nil {
+			return err
+		}
+
+		collector.Coverage.report()
+		err = markSampled(ctx, chore.metabase, newQueue, time.Now(), chore.config.VerificationPushBatchSize)
+		if err != nil {
+			chore.log.Error("error recording sampled segments", zap.Error(err))
+		}
+		return nil
 	})
 }
 
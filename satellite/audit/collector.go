@@ -16,8 +16,11 @@ var _ segmentloop.Observer = (*Collector)(nil)
 // Collector uses the segment loop to add segments to node reservoirs.
 type Collector struct {
 	Reservoirs map[storj.NodeID]*Reservoir
+	Coverage   Coverage
 	slotCount  int
 	rand       *rand.Rand
+
+	neverAuditedWeight float64
 }
 
 // NewCollector instantiates a segment collector.
@@ -26,7 +29,18 @@ func NewCollector(reservoirSlots int, r *rand.Rand) *Collector {
 		Reservoirs: make(map[storj.NodeID]*Reservoir),
 		slotCount:  reservoirSlots,
 		rand:       r,
+
+		neverAuditedWeight: 1,
+	}
+}
+
+// PreferNeverAudited makes segments, which were never sampled for audit,
+// weight times more likely to be sampled. The weight must be at least 1.
+func (collector *Collector) PreferNeverAudited(weight float64) {
+	if weight < 1 {
+		weight = 1
 	}
+	collector.neverAuditedWeight = weight
 }
 
 // LoopStarted is called at each start of a loop.
@@ -38,13 +52,20 @@ func (collector *Collector) LoopStarted(context.Context, segmentloop.LoopInfo) (
 func (collector *Collector) RemoteSegment(ctx context.Context, segment *segmentloop.Segment) error {
 	// we are expliticy not adding monitoring here as we are tracking loop observers separately
 
+	collector.Coverage.Add(segment)
+
+	weight := 1.0
+	if segment.LastAuditedAt == nil {
+		weight = collector.neverAuditedWeight
+	}
+
 	for _, piece := range segment.Pieces {
 		res, ok := collector.Reservoirs[piece.StorageNode]
 		if !ok {
 			res = NewReservoir(collector.slotCount)
 			collector.Reservoirs[piece.StorageNode] = res
 		}
-		res.Sample(collector.rand, segment)
+		res.SampleWeighted(collector.rand, segment, weight)
 	}
 	return nil
 }
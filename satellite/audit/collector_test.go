@@ -13,10 +13,12 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"storj.io/common/memory"
+	"storj.io/common/storj"
 	"storj.io/common/testcontext"
 	"storj.io/common/testrand"
 	"storj.io/storj/private/testplanet"
 	"storj.io/storj/satellite/audit"
+	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/metabase/segmentloop"
 )
 
@@ -115,3 +117,43 @@ func BenchmarkRemoteSegment(b *testing.B) {
 	})
 
 }
+
+func TestAuditCollectorPreferNeverAudited(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	nodeID := testrand.NodeID()
+	auditedAt := time.Now()
+
+	audited := segmentloop.Segment{
+		StreamID:      testrand.UUID(),
+		EncryptedSize: 100,
+		LastAuditedAt: &auditedAt,
+		Redundancy:    storj.RedundancyScheme{Algorithm: storj.ReedSolomon, ShareSize: 256, RequiredShares: 1, RepairShares: 1, OptimalShares: 1, TotalShares: 1},
+		Pieces:        metabase.Pieces{{Number: 0, StorageNode: nodeID}},
+	}
+	neverAudited := audited
+	neverAudited.StreamID = testrand.UUID()
+	neverAudited.LastAuditedAt = nil
+
+	const rounds = 1000
+	picked := map[bool]int{}
+	for i := 0; i < rounds; i++ {
+		collector := audit.NewCollector(1, rand.New(rand.NewSource(int64(i))))
+		collector.PreferNeverAudited(10)
+		require.NoError(t, collector.Process(ctx, []segmentloop.Segment{audited, neverAudited}))
+
+		require.Equal(t, audit.Coverage{
+			Segments:          2,
+			NeverAudited:      1,
+			NeverAuditedBytes: 100,
+		}, collector.Coverage)
+
+		segments := collector.Reservoirs[nodeID].Segments()
+		require.Len(t, segments, 1)
+		picked[segments[0].LastAuditedAt == nil]++
+	}
+
+	// the never audited segment has a 10/11 chance to be picked.
+	require.Greater(t, picked[true], rounds*3/4)
+}
@@ -0,0 +1,83 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package audit
+
+import (
+	"context"
+	"time"
+
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/segmentloop"
+)
+
+// Coverage counts the remote segments, which were seen by the segment loop,
+// by whether they were ever sampled for audit.
+type Coverage struct {
+	Segments          int64
+	NeverAudited      int64
+	NeverAuditedBytes int64
+}
+
+// Add counts the segment.
+func (coverage *Coverage) Add(segment *segmentloop.Segment) {
+	coverage.Segments++
+	if segment.LastAuditedAt == nil {
+		coverage.NeverAudited++
+		coverage.NeverAuditedBytes += int64(segment.EncryptedSize)
+	}
+}
+
+// Merge adds the counts of the operand.
+func (coverage *Coverage) Merge(operand Coverage) {
+	coverage.Segments += operand.Segments
+	coverage.NeverAudited += operand.NeverAudited
+	coverage.NeverAuditedBytes += operand.NeverAuditedBytes
+}
+
+// Ratio returns the ratio of the segments, which were sampled for audit at
+// least once.
+func (coverage Coverage) Ratio() float64 {
+	if coverage.Segments == 0 {
+		return 1
+	}
+	return float64(coverage.Segments-coverage.NeverAudited) / float64(coverage.Segments)
+}
+
+func (coverage Coverage) report() {
+	mon.IntVal("audit_coverage_segments").Observe(coverage.Segments)
+	mon.IntVal("audit_coverage_never_audited_segments").Observe(coverage.NeverAudited)
+	mon.IntVal("audit_coverage_never_audited_bytes").Observe(coverage.NeverAuditedBytes)
+	mon.FloatVal("audit_coverage_ratio").Observe(coverage.Ratio())
+}
+
+// markSampled records when the segments of the queue were sampled for audit,
+// in batches of batchSize segments.
+func markSampled(ctx context.Context, db *metabase.DB, segments []Segment, sampledAt time.Time, batchSize int) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	for len(segments) > 0 {
+		batch := segments
+		if len(batch) > batchSize {
+			batch = batch[:batchSize]
+		}
+		segments = segments[len(batch):]
+
+		audited := make([]metabase.AuditedSegment, len(batch))
+		for i, segment := range batch {
+			audited[i] = metabase.AuditedSegment{
+				StreamID: segment.StreamID,
+				Position: segment.Position,
+			}
+		}
+
+		err := db.MarkSegmentsAudited(ctx, metabase.MarkSegmentsAudited{
+			Segments:  audited,
+			AuditedAt: sampledAt,
+		})
+		if err != nil {
+			return Error.Wrap(err)
+		}
+	}
+	return nil
+}
@@ -13,6 +13,7 @@ import (
 
 	"storj.io/common/storj"
 	"storj.io/common/uuid"
+	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/metabase/rangedloop"
 )
 
@@ -22,21 +23,26 @@ import (
 type Observer struct {
 	log      *zap.Logger
 	queue    VerifyQueue
+	metabase *metabase.DB
 	config   Config
 	seedRand *rand.Rand
 
 	// The follow fields are reset on each segment loop cycle.
 	reservoirs map[storj.NodeID]*Reservoir
+	coverage   Coverage
 }
 
-// NewObserver instantiates Observer.
-func NewObserver(log *zap.Logger, queue VerifyQueue, config Config) *Observer {
+// NewObserver instantiates Observer. With the never audited weight config
+// the observer biases the sampling toward segments, which were never
+// sampled for audit.
+func NewObserver(log *zap.Logger, queue VerifyQueue, metabase *metabase.DB, config Config) *Observer {
 	if config.VerificationPushBatchSize < 1 {
 		config.VerificationPushBatchSize = 1
 	}
 	return &Observer{
 		log:      log,
 		queue:    queue,
+		metabase: metabase,
 		config:   config,
 		seedRand: rand.New(rand.NewSource(time.Now().Unix())),
 	}
@@ -45,6 +51,7 @@ func NewObserver(log *zap.Logger, queue VerifyQueue, config Config) *Observer {
 // Start prepares the observer for audit segment collection.
 func (obs *Observer) Start(ctx context.Context, startTime time.Time) error {
 	obs.reservoirs = make(map[storj.NodeID]*Reservoir)
+	obs.coverage = Coverage{}
 	return nil
 }
 
@@ -56,7 +63,9 @@ func (obs *Observer) Fork(ctx context.Context) (rangedloop.Partial, error) {
 	// for two or more RNGs. To prevent that, the observer itself uses an RNG
 	// to seed the per-collector RNGs.
 	rnd := rand.New(rand.NewSource(obs.seedRand.Int63()))
-	return NewCollector(obs.config.Slots, rnd), nil
+	collector := NewCollector(obs.config.Slots, rnd)
+	collector.PreferNeverAudited(obs.config.NeverAuditedWeight)
+	return collector, nil
 }
 
 // Join merges the audit reservoir collector into the per-node reservoirs.
@@ -66,6 +75,8 @@ func (obs *Observer) Join(ctx context.Context, partial rangedloop.Partial) error
 		return errs.New("expected partial type %T but got %T", collector, partial)
 	}
 
+	obs.coverage.Merge(collector.Coverage)
+
 	for nodeID, reservoir := range collector.Reservoirs {
 		existing, ok := obs.reservoirs[nodeID]
 		if !ok {
@@ -110,5 +121,19 @@ func (obs *Observer) Finish(ctx context.Context) error {
 	}
 
 	// Push new queue to queues struct so it can be fetched by worker.
-	return obs.queue.Push(ctx, newQueue, obs.config.VerificationPushBatchSize)
+	if err := obs.queue.Push(ctx, newQueue, obs.config.VerificationPushBatchSize); err != nil {
+		return err
+	}
+
+	obs.coverage.report()
+	err := markSampled(ctx, obs.metabase, newQueue, time.Now(), obs.config.VerificationPushBatchSize)
+	if err != nil {
+		obs.log.Error("error recording sampled segments", zap.Error(err))
+	}
+	return nil
+}
+
+// Coverage returns the audit coverage of the segments seen by the last loop.
+func (obs *Observer) Coverage() Coverage {
+	return obs.coverage
 }
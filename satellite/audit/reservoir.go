@@ -56,7 +56,13 @@ func (reservoir *Reservoir) Keys() []float64 {
 // The specific algorithm we are using here is called A-Res on the Wikipedia
 // article: https://en.wikipedia.org/wiki/Reservoir_sampling#Algorithm_A-Res
 func (reservoir *Reservoir) Sample(r *rand.Rand, segment *segmentloop.Segment) {
-	k := -math.Log(r.Float64()) / float64(segment.EncryptedSize)
+	reservoir.SampleWeighted(r, segment, 1)
+}
+
+// SampleWeighted is like Sample, but the chance of the segment to be in the
+// reservoir is additionally multiplied by weight.
+func (reservoir *Reservoir) SampleWeighted(r *rand.Rand, segment *segmentloop.Segment, weight float64) {
+	k := -math.Log(r.Float64()) / (float64(segment.EncryptedSize) * weight)
 	reservoir.sample(k, segment)
 }
 
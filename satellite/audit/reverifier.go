@@ -21,6 +21,7 @@ import (
 	"storj.io/common/storj"
 	"storj.io/common/uuid"
 	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/nodedialer"
 	"storj.io/storj/satellite/overlay"
 	"storj.io/uplink/private/eestream"
 	"storj.io/uplink/private/piecestore"
@@ -203,6 +204,12 @@ func (reverifier *Reverifier) DoReverifyPiece(ctx context.Context, logger *zap.L
 	reputation = cachedNodeInfo.Reputation
 	pieceData, pieceHash, pieceOriginalLimit, err := reverifier.GetPiece(ctx, limit, piecePrivateKey, cachedNodeInfo.LastIPPort, int32(pieceSize))
 	if err != nil {
+		if nodedialer.ErrCircuitOpen.Has(err) {
+			// the satellite didn't dial the node, so the audit isn't
+			// counted against it.
+			logger.Debug("ReverifyPiece: dialing suspended", zap.Error(err))
+			return OutcomeNotPerformed, reputation, nil
+		}
 		if rpc.Error.Has(err) {
 			if errs.Is(err, context.DeadlineExceeded) {
 				// dial timeout
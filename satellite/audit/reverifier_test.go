@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
 
 	"storj.io/common/memory"
 	"storj.io/common/pb"
@@ -15,8 +16,10 @@ import (
 	"storj.io/common/testcontext"
 	"storj.io/common/testrand"
 	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite"
 	"storj.io/storj/satellite/audit"
 	"storj.io/storj/satellite/metabase"
+	"storj.io/uplink/private/eestream"
 )
 
 func TestReverifyPiece(t *testing.T) {
@@ -79,6 +82,47 @@ func TestReverifyPieceSucceeds(t *testing.T) {
 	})
 }
 
+func TestReverifyPieceWithAuditPieceAction(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount:   1,
+		StorageNodeCount: 5,
+		UplinkCount:      1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				testplanet.ReconfigureRS(3, 4, 4, 5)(log, index, config)
+				config.Orders.AuditPieceAction = true
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		audits := satellite.Audit
+
+		audits.Worker.Loop.Pause()
+		audits.Chore.Loop.Pause()
+
+		segment := uploadSomeData(t, ctx, planet)
+
+		piece := segment.Pieces[0]
+		redundancy, err := eestream.NewRedundancyStrategyFromStorj(segment.Redundancy)
+		require.NoError(t, err)
+		pieceSize := eestream.CalcPieceSize(int64(segment.EncryptedSize), redundancy)
+		limit, _, _, err := satellite.Orders.Service.CreateAuditPieceOrderLimit(ctx, piece.StorageNode, piece.Number, segment.RootPieceID, int32(pieceSize))
+		require.NoError(t, err)
+		require.Equal(t, pb.PieceAction_GET_AUDIT, limit.Limit.Action)
+
+		// ensure the nodes send the piece hash for whole piece audit downloads
+		for _, piece := range segment.Pieces {
+			outcome, _ := satellite.Audit.Reverifier.ReverifyPiece(ctx, planet.Log().Named("reverifier"), &audit.PieceLocator{
+				StreamID: segment.StreamID,
+				Position: segment.Position,
+				NodeID:   piece.StorageNode,
+				PieceNum: int(piece.Number),
+			})
+			require.Equal(t, audit.OutcomeSuccess, outcome)
+		}
+	})
+}
+
 func TestReverifyPieceWithNodeOffline(t *testing.T) {
 	testplanet.Run(t, testplanet.Config{
 		SatelliteCount:   1,
@@ -24,7 +24,9 @@ import (
 	"storj.io/common/rpc/rpcpool"
 	"storj.io/common/rpc/rpcstatus"
 	"storj.io/common/storj"
+	"storj.io/storj/private/rpcdeadline"
 	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/nodedialer"
 	"storj.io/storj/satellite/orders"
 	"storj.io/storj/satellite/overlay"
 	"storj.io/uplink/private/piecestore"
@@ -172,6 +174,15 @@ func (verifier *Verifier) Verify(ctx context.Context, segment Segment, skip map[
 			sharesToAudit[pieceNum] = share
 			continue
 		}
+		if nodedialer.ErrCircuitOpen.Has(share.Error) {
+			// the satellite didn't dial the node, so the audit isn't
+			// counted against it.
+			verifier.log.Debug("Verify: dialing suspended (not performed)",
+				zap.Stringer("Node ID", share.NodeID),
+				zap.String("Segment", segmentInfoString(segment)),
+				zap.Error(share.Error))
+			continue
+		}
 		if rpc.Error.Has(share.Error) {
 			if errs.Is(share.Error, context.DeadlineExceeded) {
 				// dial timeout
@@ -286,6 +297,16 @@ func (verifier *Verifier) Verify(ctx context.Context, segment Segment, skip map[
 		}, err
 	}
 
+	// the shares were checked, so the segment counts as audited in the
+	// durability reports.
+	err = verifier.metabase.MarkSegmentsVerified(ctx, metabase.MarkSegmentsVerified{
+		Segments:   []metabase.AuditedSegment{{StreamID: segment.StreamID, Position: segment.Position}},
+		VerifiedAt: verifier.nowFn(),
+	})
+	if err != nil {
+		verifier.log.Warn("unable to mark segment audited", zap.String("Segment", segmentInfoString(segment)), zap.Error(err))
+	}
+
 	return Report{
 		Successes:     successNodes,
 		Fails:         failedNodes,
@@ -426,7 +447,9 @@ func (verifier *Verifier) GetShare(ctx context.Context, limit *pb.AddressedOrder
 
 	offset := int64(shareSize) * int64(stripeIndex)
 
-	downloader, err := ps.Download(timedCtx, limit.GetLimit(), piecePrivateKey, offset, int64(shareSize))
+	// send the deadline, so the node stops reading the piece once the audit
+	// gives up on it.
+	downloader, err := ps.Download(rpcdeadline.WithTimeout(timedCtx, 0), limit.GetLimit(), piecePrivateKey, offset, int64(shareSize))
 	if err != nil {
 		return Share{}, err
 	}
@@ -31,6 +31,7 @@ type Config struct {
 	VerificationPushBatchSize int           `help:"number of audit jobs to push at once to the verification queue" devDefault:"10" releaseDefault:"4096"`
 	WorkerConcurrency         int           `help:"number of workers to run audits on segments" default:"2"`
 	UseRangedLoop             bool          `help:"whether or not to use the ranged loop observer instead of the chore." default:"false" testDefault:"false"`
+	NeverAuditedWeight        float64       `help:"how many times more likely segments, which were never sampled for audit, are sampled; 1 disables the bias" default:"1"`
 
 	ReverifyWorkerConcurrency   int           `help:"number of workers to run reverify audits on pieces" default:"2"`
 	ReverificationRetryInterval time.Duration `help:"how long a single reverification job can take before it may be taken over by another worker" releaseDefault:"6h" devDefault:"10m"`
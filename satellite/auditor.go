@@ -23,14 +23,18 @@ import (
 	"storj.io/private/debug"
 	"storj.io/private/version"
 	"storj.io/storj/private/lifecycle"
+	"storj.io/storj/private/otlp"
 	version_checker "storj.io/storj/private/version/checker"
 	"storj.io/storj/satellite/audit"
+	"storj.io/storj/satellite/events"
 	"storj.io/storj/satellite/mailservice"
 	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/nodedialer"
 	"storj.io/storj/satellite/nodeevents"
 	"storj.io/storj/satellite/orders"
 	"storj.io/storj/satellite/overlay"
 	"storj.io/storj/satellite/reputation"
+	"storj.io/storj/satellite/secrets"
 )
 
 // Auditor is the auditor process.
@@ -43,7 +47,8 @@ type Auditor struct {
 	Servers  *lifecycle.Group
 	Services *lifecycle.Group
 
-	Dialer rpc.Dialer
+	Dialer     rpc.Dialer
+	NodeDialer *nodedialer.Dialer
 
 	Version struct {
 		Chore   *version_checker.Chore
@@ -55,7 +60,16 @@ type Auditor struct {
 		Server   *debug.Server
 	}
 
+	Tracing struct {
+		Exporter *otlp.Exporter
+	}
+
+	Secrets struct {
+		Chore *secrets.Chore
+	}
+
 	Mail       *mailservice.Service
+	Events     *events.Bus
 	Overlay    *overlay.Service
 	Reputation *reputation.Service
 	Orders     struct {
@@ -76,7 +90,7 @@ type Auditor struct {
 }
 
 // NewAuditor creates a new auditor peer.
-func NewAuditor(log *zap.Logger, full *identity.FullIdentity,
+func NewAuditor(ctx context.Context, log *zap.Logger, full *identity.FullIdentity,
 	metabaseDB *metabase.DB,
 	revocationDB extensions.RevocationDB,
 	verifyQueue audit.VerifyQueue,
@@ -115,6 +129,37 @@ func NewAuditor(log *zap.Logger, full *identity.FullIdentity,
 		})
 	}
 
+	var peerSecrets *secretsLoader
+	{ // setup secrets
+		var err error
+		peerSecrets, err = newSecretsLoader(ctx, log.Named("secrets:chore"), config.Secrets)
+		if err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
+		peer.Secrets.Chore = peerSecrets.Chore
+		if peer.Secrets.Chore != nil {
+			peer.Services.Add(lifecycle.Item{
+				Name:  "secrets:chore",
+				Run:   peer.Secrets.Chore.Run,
+				Close: peer.Secrets.Chore.Close,
+			})
+			peer.Debug.Server.Panel.Add(
+				debug.Cycle("Secrets Chore", peer.Secrets.Chore.Loop))
+		}
+	}
+
+	{ // setup trace export
+		var err error
+		peer.Tracing.Exporter, err = otlp.NewExporter(log.Named("tracing"), monkit.Default, config.OTLP, "satellite-auditor")
+		if err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
+		peer.Services.Add(lifecycle.Item{
+			Name: "tracing",
+			Run:  peer.Tracing.Exporter.Run,
+		})
+	}
+
 	{ // setup version control
 		peer.Log.Info("Version info",
 			zap.Stringer("Version", versionInfo.Version.Version),
@@ -139,7 +184,13 @@ func NewAuditor(log *zap.Logger, full *identity.FullIdentity,
 			return nil, errs.Combine(err, peer.Close())
 		}
 
-		peer.Dialer = rpc.NewDefaultDialer(tlsOptions)
+		peer.NodeDialer = nodedialer.New(config.NodeDialer)
+		peer.Services.Add(lifecycle.Item{
+			Name:  "node-dialer",
+			Close: peer.NodeDialer.Close,
+		})
+
+		peer.Dialer = peer.NodeDialer.RPCDialer(tlsOptions)
 	}
 
 	{ // setup mail
@@ -155,9 +206,21 @@ func NewAuditor(log *zap.Logger, full *identity.FullIdentity,
 		})
 	}
 
+	{ // setup events
+		var err error
+		peer.Events, err = events.NewBus(peer.Log.Named("events:bus"), config.Events)
+		if err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
+		peer.Services.Add(lifecycle.Item{
+			Name: "events:bus",
+			Run:  peer.Events.Run,
+		})
+	}
+
 	{ // setup overlay
 		var err error
-		peer.Overlay, err = overlay.NewService(log.Named("overlay"), overlayCache, nodeEvents, peer.Mail, config.Console.ExternalAddress, config.Console.SatelliteName, config.Overlay)
+		peer.Overlay, err = overlay.NewService(log.Named("overlay"), overlayCache, nodeEvents, peer.Events, peer.Mail, config.Console.ExternalAddress, config.Console.SatelliteName, config.Overlay)
 		if err != nil {
 			return nil, errs.Combine(err, peer.Close())
 		}
@@ -200,17 +263,21 @@ func NewAuditor(log *zap.Logger, full *identity.FullIdentity,
 		peer.Debug.Server.Panel.Add(
 			debug.Cycle("Orders Chore", peer.Orders.Chore.Loop))
 
-		var err error
+		ordersConfig, err := peerSecrets.ordersConfig(ctx, config.Orders)
+		if err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
 		peer.Orders.Service, err = orders.NewService(
 			log.Named("orders"),
 			signing.SignerFromFullIdentity(peer.Identity),
 			peer.Overlay,
 			peer.Orders.DB,
-			config.Orders,
+			ordersConfig,
 		)
 		if err != nil {
 			return nil, errs.Combine(err, peer.Close())
 		}
+		peerSecrets.watchOrders(peer.Orders.Service)
 	}
 
 	{ // setup audit
@@ -219,7 +286,7 @@ func NewAuditor(log *zap.Logger, full *identity.FullIdentity,
 		dialer := peer.Dialer
 		//lint:ignore SA1019 deprecated is fine here.
 		//nolint:staticcheck // deprecated is fine here.
-		dialer.Connector = rpc.NewDefaultTCPConnector(nil)
+		dialer.Connector = peer.NodeDialer.Wrap(rpc.NewDefaultTCPConnector(nil))
 
 		peer.Audit.VerifyQueue = verifyQueue
 		peer.Audit.ReverifyQueue = reverifyQueue
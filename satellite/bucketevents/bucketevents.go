@@ -0,0 +1,132 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package bucketevents implements per-bucket notifications about created and
+// deleted objects, which are sent to a webhook or a queue of the customer.
+package bucketevents
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/events"
+	"storj.io/storj/satellite/metabase"
+)
+
+var (
+	// Error is the error class for bucket event notifications.
+	Error = errs.Class("bucketevents")
+	// ErrNotFound is returned when the bucket has no notification configuration.
+	ErrNotFound = errs.Class("bucket notification configuration not found")
+	// ErrInvalid is returned when a notification configuration is invalid.
+	ErrInvalid = errs.Class("invalid bucket notification configuration")
+
+	mon = monkit.Package()
+)
+
+// maxPrefixLength is the maximum length of the encrypted key prefix.
+const maxPrefixLength = 1024
+
+// SinkType is the kind of destination the notifications are sent to.
+type SinkType string
+
+const (
+	// SinkWebhook posts the notifications as json to an url.
+	SinkWebhook SinkType = "webhook"
+	// SinkKafka produces the notifications to a topic through the Kafka REST proxy.
+	SinkKafka SinkType = "kafka"
+)
+
+// Configuration configures which events of a bucket are sent where.
+type Configuration struct {
+	ProjectID  uuid.UUID
+	BucketName string
+
+	// ObjectCreated and ObjectDeleted select the events to send.
+	ObjectCreated bool
+	ObjectDeleted bool
+	// Prefix limits the notifications to the objects, whose encrypted key
+	// starts with it. The encrypted key of a path prefix ending with a "/"
+	// is a prefix of the encrypted keys of all objects below it.
+	Prefix metabase.ObjectKey
+
+	Sink      SinkType
+	URL       string
+	Topic     string
+	AuthToken string
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// Matches returns whether an event of the type about the object with the key
+// should be sent.
+func (configuration Configuration) Matches(typ events.Type, key metabase.ObjectKey) bool {
+	switch typ {
+	case events.ObjectCommitted:
+		if !configuration.ObjectCreated {
+			return false
+		}
+	case events.ObjectDeleted:
+		if !configuration.ObjectDeleted {
+			return false
+		}
+	default:
+		return false
+	}
+	return strings.HasPrefix(string(key), string(configuration.Prefix))
+}
+
+// Validate checks whether the configuration can be used. Plain http urls are
+// only accepted, when allowInsecure is set.
+func (configuration Configuration) Validate(allowInsecure bool) error {
+	if configuration.BucketName == "" {
+		return ErrInvalid.New("bucket name is not set")
+	}
+	if !configuration.ObjectCreated && !configuration.ObjectDeleted {
+		return ErrInvalid.New("no events selected")
+	}
+	if len(configuration.Prefix) > maxPrefixLength {
+		return ErrInvalid.New("prefix is longer than %d bytes", maxPrefixLength)
+	}
+
+	switch configuration.Sink {
+	case SinkWebhook:
+	case SinkKafka:
+		if configuration.Topic == "" {
+			return ErrInvalid.New("kafka topic is not set")
+		}
+	default:
+		return ErrInvalid.New("unknown sink %q", configuration.Sink)
+	}
+
+	u, err := url.Parse(configuration.URL)
+	if err != nil {
+		return ErrInvalid.New("invalid url: %v", err)
+	}
+	if u.Host == "" {
+		return ErrInvalid.New("url has no host")
+	}
+	if u.Scheme != "https" && !(allowInsecure && u.Scheme == "http") {
+		return ErrInvalid.New("unsupported url scheme %q", u.Scheme)
+	}
+	return nil
+}
+
+// DB stores the notification configurations of the buckets.
+//
+// architecture: Database
+type DB interface {
+	// Get returns the notification configuration of the bucket.
+	Get(ctx context.Context, projectID uuid.UUID, bucketName string) (Configuration, error)
+	// Set creates or replaces the notification configuration of the bucket.
+	Set(ctx context.Context, configuration Configuration) error
+	// Delete removes the notification configuration of the bucket.
+	Delete(ctx context.Context, projectID uuid.UUID, bucketName string) error
+}
@@ -0,0 +1,297 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package bucketevents
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"syscall"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+
+	"storj.io/common/lrucache"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/events"
+	"storj.io/storj/satellite/metabase"
+)
+
+// Config contains configurable values for bucket event notifications.
+type Config struct {
+	Enabled         bool          `help:"whether notifications about bucket events are sent" default:"false"`
+	QueueSize       int           `help:"number of notifications queued for delivery, before new notifications are dropped" default:"10000"`
+	Workers         int           `help:"number of notifications delivered concurrently" default:"4"`
+	RequestTimeout  time.Duration `help:"timeout for delivering a notification" default:"10s"`
+	CacheCapacity   int           `help:"number of bucket notification configurations cached" default:"10000"`
+	CacheExpiration time.Duration `help:"how long bucket notification configurations are cached" default:"1m"`
+	AllowInsecure   bool          `help:"allow sending notifications over plain http and to private network addresses" default:"false"`
+}
+
+// Service sends notifications about the events of the buckets with a
+// notification configuration. Notifications are delivered at most once and
+// dropped when the sinks can't keep up.
+//
+// A nil Service and a disabled Service don't send any notifications.
+//
+// architecture: Service
+type Service struct {
+	log    *zap.Logger
+	db     DB
+	config Config
+	client *http.Client
+	cache  *lrucache.ExpiringLRU
+	queue  chan delivery
+
+	nowFn func() time.Time
+}
+
+// target is the cached notification configuration of a bucket.
+type target struct {
+	configuration Configuration
+	sink          events.Sink
+}
+
+// delivery is a queued notification.
+type delivery struct {
+	sink  events.Sink
+	event events.Event
+}
+
+// NewService creates a new bucket event notification service.
+func NewService(log *zap.Logger, db DB, config Config) *Service {
+	if config.QueueSize <= 0 {
+		config.QueueSize = 1
+	}
+	if config.Workers <= 0 {
+		config.Workers = 1
+	}
+	return &Service{
+		log:    log,
+		db:     db,
+		config: config,
+		client: newClient(config),
+		cache: lrucache.New(lrucache.Options{
+			Capacity:   config.CacheCapacity,
+			Expiration: config.CacheExpiration,
+		}),
+		queue: make(chan delivery, config.QueueSize),
+		nowFn: time.Now,
+	}
+}
+
+// Enabled returns whether notifications are sent.
+func (service *Service) Enabled() bool {
+	return service != nil && service.config.Enabled
+}
+
+// Get returns the notification configuration of the bucket.
+func (service *Service) Get(ctx context.Context, projectID uuid.UUID, bucketName string) (_ Configuration, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return service.db.Get(ctx, projectID, bucketName)
+}
+
+// Set validates and stores the notification configuration of the bucket.
+func (service *Service) Set(ctx context.Context, configuration Configuration) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := configuration.Validate(service.config.AllowInsecure); err != nil {
+		return err
+	}
+
+	err = service.db.Set(ctx, configuration)
+	if err != nil {
+		return err
+	}
+
+	service.cache.Delete(cacheKey(configuration.ProjectID, configuration.BucketName))
+	return nil
+}
+
+// Delete removes the notification configuration of the bucket.
+func (service *Service) Delete(ctx context.Context, projectID uuid.UUID, bucketName string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = service.db.Delete(ctx, projectID, bucketName)
+	if err != nil {
+		return err
+	}
+
+	service.cache.Delete(cacheKey(projectID, bucketName))
+	return nil
+}
+
+// Publish queues a notification about the event of the object, when its
+// bucket is configured to be notified about it.
+func (service *Service) Publish(ctx context.Context, typ events.Type, location metabase.ObjectLocation, attributes map[string]string) {
+	if !service.Enabled() {
+		return
+	}
+
+	target, err := service.target(ctx, location.ProjectID, location.BucketName)
+	if err != nil {
+		service.log.Warn("failed to get bucket notification configuration",
+			zap.Stringer("Project ID", location.ProjectID),
+			zap.String("Bucket", location.BucketName),
+			zap.Error(err))
+		return
+	}
+	if target == nil || !target.configuration.Matches(typ, location.ObjectKey) {
+		return
+	}
+
+	id, err := uuid.New()
+	if err != nil {
+		service.log.Error("failed to create event id", zap.Error(err))
+		return
+	}
+
+	select {
+	case service.queue <- delivery{
+		sink: target.sink,
+		event: events.Event{
+			ID:         id,
+			Type:       typ,
+			Time:       service.nowFn().UTC(),
+			Attributes: attributes,
+		},
+	}:
+		mon.Counter("bucketevents_queued", monkit.NewSeriesTag("type", string(typ))).Inc(1)
+	default:
+		mon.Counter("bucketevents_dropped", monkit.NewSeriesTag("type", string(typ))).Inc(1)
+	}
+}
+
+// target returns the cached notification target of the bucket, or nil, when
+// the bucket has no notification configuration.
+func (service *Service) target(ctx context.Context, projectID uuid.UUID, bucketName string) (_ *target, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	value, err := service.cache.Get(cacheKey(projectID, bucketName), func() (interface{}, error) {
+		configuration, err := service.db.Get(ctx, projectID, bucketName)
+		if err != nil {
+			if ErrNotFound.Has(err) {
+				return (*target)(nil), nil
+			}
+			return nil, err
+		}
+		return &target{
+			configuration: configuration,
+			sink:          service.newSink(configuration),
+		}, nil
+	})
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return value.(*target), nil
+}
+
+// newSink creates the sink the notifications of the configuration are sent to.
+func (service *Service) newSink(configuration Configuration) events.Sink {
+	if configuration.Sink == SinkKafka {
+		return events.NewKafkaSinkWithClient(service.client, events.KafkaConfig{
+			URL:            configuration.URL,
+			Topic:          configuration.Topic,
+			RequestTimeout: service.config.RequestTimeout,
+		})
+	}
+	return events.NewWebhookSinkWithClient(service.client, events.WebhookConfig{
+		URL:            configuration.URL,
+		AuthToken:      configuration.AuthToken,
+		RequestTimeout: service.config.RequestTimeout,
+	})
+}
+
+// Run delivers the queued notifications until ctx is canceled.
+func (service *Service) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if !service.Enabled() {
+		return nil
+	}
+
+	group, ctx := errgroup.WithContext(ctx)
+	for i := 0; i < service.config.Workers; i++ {
+		group.Go(func() error {
+			for {
+				select {
+				case <-ctx.Done():
+					return nil
+				case delivery := <-service.queue:
+					service.deliver(ctx, delivery)
+				}
+			}
+		})
+	}
+	return group.Wait()
+}
+
+// deliver sends the notification. Failures are only logged, since the
+// notifications are delivered at most once.
+func (service *Service) deliver(ctx context.Context, delivery delivery) {
+	err := delivery.sink.Send(ctx, []events.Event{delivery.event})
+	if err != nil {
+		mon.Counter("bucketevents_failed", monkit.NewSeriesTag("sink", delivery.sink.Name())).Inc(1)
+		service.log.Debug("failed to deliver bucket notification",
+			zap.String("sink", delivery.sink.Name()),
+			zap.Stringer("Event ID", delivery.event.ID),
+			zap.Error(err))
+		return
+	}
+	mon.Counter("bucketevents_delivered", monkit.NewSeriesTag("sink", delivery.sink.Name())).Inc(1)
+}
+
+// SetNow allows tests to have the service act as if the current time is
+// whatever they want.
+func (service *Service) SetNow(nowFn func() time.Time) {
+	service.nowFn = nowFn
+}
+
+// cacheKey returns the key of the bucket in the configuration cache.
+func cacheKey(projectID uuid.UUID, bucketName string) string {
+	return projectID.String() + "/" + bucketName
+}
+
+// newClient creates the http client used for delivering the notifications.
+// Unless insecure notifications are allowed, it refuses to connect to
+// private network addresses, since the urls are provided by the customers.
+func newClient(config Config) *http.Client {
+	dialer := &net.Dialer{Timeout: config.RequestTimeout}
+	if !config.AllowInsecure {
+		dialer.Control = refusePrivateAddress
+	}
+	return &http.Client{
+		Timeout: config.RequestTimeout,
+		Transport: &http.Transport{
+			DialContext:         dialer.DialContext,
+			ForceAttemptHTTP2:   true,
+			MaxIdleConnsPerHost: 2,
+			IdleConnTimeout:     time.Minute,
+			TLSHandshakeTimeout: config.RequestTimeout,
+		},
+	}
+}
+
+// refusePrivateAddress fails for addresses, which aren't publicly routable.
+func refusePrivateAddress(network, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	ip := net.ParseIP(host)
+	if ip == nil || !isPublicIP(ip) {
+		return Error.New("refusing to connect to non-public address %s", host)
+	}
+	return nil
+}
+
+// isPublicIP returns whether the ip is publicly routable.
+func isPublicIP(ip net.IP) bool {
+	return !ip.IsLoopback() && !ip.IsPrivate() && !ip.IsUnspecified() &&
+		!ip.IsLinkLocalUnicast() && !ip.IsLinkLocalMulticast() &&
+		!ip.IsInterfaceLocalMulticast() && !ip.IsMulticast()
+}
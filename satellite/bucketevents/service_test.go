@@ -0,0 +1,159 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package bucketevents_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/bucketevents"
+	"storj.io/storj/satellite/events"
+	"storj.io/storj/satellite/metabase"
+)
+
+type memoryDB struct {
+	mu             sync.Mutex
+	configurations map[string]bucketevents.Configuration
+}
+
+func (db *memoryDB) Get(ctx context.Context, projectID uuid.UUID, bucketName string) (bucketevents.Configuration, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	configuration, ok := db.configurations[projectID.String()+"/"+bucketName]
+	if !ok {
+		return bucketevents.Configuration{}, bucketevents.ErrNotFound.New("")
+	}
+	return configuration, nil
+}
+
+func (db *memoryDB) Set(ctx context.Context, configuration bucketevents.Configuration) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	db.configurations[configuration.ProjectID.String()+"/"+configuration.BucketName] = configuration
+	return nil
+}
+
+func (db *memoryDB) Delete(ctx context.Context, projectID uuid.UUID, bucketName string) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	key := projectID.String() + "/" + bucketName
+	if _, ok := db.configurations[key]; !ok {
+		return bucketevents.ErrNotFound.New("")
+	}
+	delete(db.configurations, key)
+	return nil
+}
+
+func TestService(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	received := make(chan events.Event, 10)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "secret", r.Header.Get("Authorization"))
+
+		var batch []events.Event
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&batch))
+		for _, event := range batch {
+			received <- event
+		}
+	}))
+	defer server.Close()
+
+	db := &memoryDB{configurations: map[string]bucketevents.Configuration{}}
+	service := bucketevents.NewService(zaptest.NewLogger(t), db, bucketevents.Config{
+		Enabled:         true,
+		QueueSize:       10,
+		Workers:         1,
+		RequestTimeout:  time.Second,
+		CacheCapacity:   10,
+		CacheExpiration: time.Hour,
+		AllowInsecure:   true,
+	})
+	runCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	ctx.Go(func() error { return service.Run(runCtx) })
+
+	projectID := testrand.UUID()
+	location := func(key string) metabase.ObjectLocation {
+		return metabase.ObjectLocation{ProjectID: projectID, BucketName: "bucket", ObjectKey: metabase.ObjectKey(key)}
+	}
+
+	// the bucket isn't configured yet.
+	service.Publish(ctx, events.ObjectCommitted, location("photos/a"), nil)
+
+	err := service.Set(ctx, bucketevents.Configuration{
+		ProjectID:     projectID,
+		BucketName:    "bucket",
+		ObjectCreated: true,
+		Prefix:        "photos/",
+		Sink:          bucketevents.SinkWebhook,
+		URL:           server.URL,
+		AuthToken:     "secret",
+	})
+	require.NoError(t, err)
+
+	service.Publish(ctx, events.ObjectDeleted, location("photos/b"), nil)
+	service.Publish(ctx, events.ObjectCommitted, location("videos/c"), nil)
+	service.Publish(ctx, events.ObjectCommitted, location("photos/d"), map[string]string{"key": "d"})
+
+	select {
+	case event := <-received:
+		require.Equal(t, events.ObjectCommitted, event.Type)
+		require.Equal(t, map[string]string{"key": "d"}, event.Attributes)
+	case <-time.After(10 * time.Second):
+		t.Fatal("notification wasn't delivered")
+	}
+
+	require.NoError(t, service.Delete(ctx, projectID, "bucket"))
+	service.Publish(ctx, events.ObjectCommitted, location("photos/e"), nil)
+
+	select {
+	case event := <-received:
+		t.Fatalf("unexpected notification %v", event)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestConfiguration_Validate(t *testing.T) {
+	valid := bucketevents.Configuration{
+		BucketName:    "bucket",
+		ObjectCreated: true,
+		Sink:          bucketevents.SinkWebhook,
+		URL:           "https://example.test/hook",
+	}
+	require.NoError(t, valid.Validate(false))
+
+	for _, tt := range []struct {
+		name   string
+		modify func(*bucketevents.Configuration)
+	}{
+		{"no events", func(c *bucketevents.Configuration) { c.ObjectCreated = false }},
+		{"unknown sink", func(c *bucketevents.Configuration) { c.Sink = "smtp" }},
+		{"kafka without topic", func(c *bucketevents.Configuration) { c.Sink = bucketevents.SinkKafka }},
+		{"plain http", func(c *bucketevents.Configuration) { c.URL = "http://example.test/hook" }},
+		{"no host", func(c *bucketevents.Configuration) { c.URL = "https:///hook" }},
+	} {
+		configuration := valid
+		tt.modify(&configuration)
+		require.True(t, bucketevents.ErrInvalid.Has(configuration.Validate(false)), tt.name)
+	}
+
+	insecure := valid
+	insecure.URL = "http://example.test/hook"
+	require.NoError(t, insecure.Validate(true))
+}
@@ -0,0 +1,219 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package bucketlifecycle executes the lifecycle configurations of buckets,
+// which transition old objects to another placement and delete expired ones.
+package bucketlifecycle
+
+import (
+	"context"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/rangedloop"
+	"storj.io/storj/satellite/metabase/segmentloop"
+)
+
+var (
+	// Error defines the bucket lifecycle errors class.
+	Error = errs.Class("bucket lifecycle")
+	mon   = monkit.Package()
+)
+
+// Config contains configurable values for the execution of the bucket lifecycle
+// configurations.
+type Config struct {
+	Enabled   bool `help:"whether the lifecycle configurations of buckets are executed by the ranged loop" default:"false"`
+	BatchSize int  `help:"how many objects are listed in a batch, when the objects of a bucket are matched" default:"1000"`
+}
+
+// Observer transitions the segments of the objects, which are older than the
+// transition age of their bucket, to the placement of the lifecycle
+// configuration and deletes the objects, which are older than the expiration
+// age.
+//
+// The objects of the buckets with a lifecycle configuration are listed in
+// batches, when the loop finishes, and each batch is executed before the next
+// one is listed, so the memory use doesn't depend on the number of objects.
+// The pieces of the deleted objects are removed by garbage collection. The
+// checker finds the pieces of the transitioned segments outside of the new
+// placement and the repair moves them.
+type Observer struct {
+	log      *zap.Logger
+	metabase *metabase.DB
+	config   Config
+
+	nowFn func() time.Time
+
+	// lifecycles is reset on each loop cycle.
+	lifecycles []metabase.BucketLifecycle
+}
+
+var _ rangedloop.Observer = (*Observer)(nil)
+
+// NewObserver returns a new ranged loop observer.
+func NewObserver(log *zap.Logger, metabaseDB *metabase.DB, config Config) *Observer {
+	return &Observer{
+		log:      log,
+		metabase: metabaseDB,
+		config:   config,
+
+		nowFn: time.Now,
+	}
+}
+
+// TestingSetNow allows tests to have the observer act as if the current time is whatever they want.
+func (obs *Observer) TestingSetNow(nowFn func() time.Time) {
+	obs.nowFn = nowFn
+}
+
+// Start loads the lifecycle configurations of the buckets.
+func (obs *Observer) Start(ctx context.Context, startTime time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	obs.lifecycles, err = obs.metabase.ListBucketLifecycles(ctx)
+	return Error.Wrap(err)
+}
+
+// Fork creates a partial, which doesn't need the segments of the loop, because
+// the placement of the segments is changed by the stream IDs of the objects.
+func (obs *Observer) Fork(ctx context.Context) (_ rangedloop.Partial, err error) {
+	return observerFork{}, nil
+}
+
+// Join is a noop.
+func (obs *Observer) Join(ctx context.Context, partial rangedloop.Partial) (err error) {
+	return nil
+}
+
+// Finish executes the lifecycle configurations. Failures are logged, the
+// objects are matched again in the next cycle.
+func (obs *Observer) Finish(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var totals executed
+	now := obs.nowFn()
+	for _, lifecycle := range obs.lifecycles {
+		bucket, err := obs.execute(ctx, lifecycle, now)
+		if err != nil {
+			obs.log.Error("failed to execute bucket lifecycle",
+				zap.Stringer("Project ID", lifecycle.ProjectID),
+				zap.String("Bucket", lifecycle.BucketName),
+				zap.Error(err))
+		}
+		totals.transitionedSegments += bucket.transitionedSegments
+		totals.expiredObjects += bucket.expiredObjects
+	}
+
+	mon.IntVal("bucket_lifecycle_transitioned_segments").Observe(totals.transitionedSegments)
+	mon.IntVal("bucket_lifecycle_expired_objects").Observe(totals.expiredObjects)
+
+	obs.log.Debug("bucket lifecycles executed",
+		zap.Int("Buckets", len(obs.lifecycles)),
+		zap.Int64("Transitioned Segments", totals.transitionedSegments),
+		zap.Int64("Expired Objects", totals.expiredObjects))
+
+	obs.lifecycles = nil
+	return nil
+}
+
+// executed counts the changes made by the lifecycle configurations.
+type executed struct {
+	transitionedSegments int64
+	expiredObjects       int64
+}
+
+// execute lists the committed objects of the bucket and transitions or
+// deletes them in batches, when they are old enough for a rule of the
+// lifecycle configuration.
+func (obs *Observer) execute(ctx context.Context, lifecycle metabase.BucketLifecycle, now time.Time) (result executed, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var transitions []uuid.UUID
+	var expirations []metabase.DeleteObjectExactVersion
+
+	flush := func() {
+		if len(transitions) > 0 {
+			updated, err := obs.metabase.UpdateStreamPlacement(ctx, metabase.UpdateStreamPlacement{
+				StreamIDs: transitions,
+				Placement: lifecycle.TransitionPlacement,
+			})
+			if err != nil {
+				obs.log.Error("failed to transition objects",
+					zap.Stringer("Project ID", lifecycle.ProjectID),
+					zap.String("Bucket", lifecycle.BucketName),
+					zap.Error(err))
+			}
+			result.transitionedSegments += updated
+			transitions = transitions[:0]
+		}
+
+		for _, expiration := range expirations {
+			deleted, err := obs.metabase.DeleteObjectExactVersion(ctx, expiration)
+			if err != nil {
+				if !storj.ErrObjectNotFound.Has(err) {
+					obs.log.Error("failed to delete expired object",
+						zap.Stringer("Project ID", expiration.ProjectID),
+						zap.String("Bucket", expiration.BucketName),
+						zap.Error(err))
+				}
+				continue
+			}
+			result.expiredObjects += int64(len(deleted.Objects))
+		}
+		expirations = expirations[:0]
+	}
+
+	err = obs.metabase.IterateObjectsAllVersionsWithStatus(ctx, metabase.IterateObjectsWithStatus{
+		ProjectID:             lifecycle.ProjectID,
+		BucketName:            lifecycle.BucketName,
+		Recursive:             true,
+		BatchSize:             obs.config.BatchSize,
+		Status:                metabase.Committed,
+		IncludeSystemMetadata: true,
+	}, func(ctx context.Context, it metabase.ObjectsIterator) error {
+		var entry metabase.ObjectEntry
+		for it.Next(ctx, &entry) {
+			age := now.Sub(entry.CreatedAt)
+			switch {
+			case lifecycle.ExpirationDays > 0 && age >= days(lifecycle.ExpirationDays):
+				expirations = append(expirations, metabase.DeleteObjectExactVersion{
+					ObjectLocation: metabase.ObjectLocation{
+						ProjectID:  lifecycle.ProjectID,
+						BucketName: lifecycle.BucketName,
+						ObjectKey:  entry.ObjectKey,
+					},
+					Version: entry.Version,
+				})
+			case lifecycle.TransitionDays > 0 && age >= days(lifecycle.TransitionDays):
+				transitions = append(transitions, entry.StreamID)
+			}
+
+			if len(transitions)+len(expirations) >= obs.config.BatchSize {
+				flush()
+			}
+		}
+		return nil
+	})
+	flush()
+	return result, err
+}
+
+// observerFork doesn't process the segments.
+type observerFork struct{}
+
+// Process is a noop.
+func (observerFork) Process(ctx context.Context, segments []segmentloop.Segment) error {
+	return nil
+}
+
+// days returns the duration of n days.
+func days(n int) time.Duration {
+	return time.Duration(n) * 24 * time.Hour
+}
@@ -0,0 +1,99 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package bucketlifecycle_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/common/memory"
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/metabase"
+)
+
+func TestObserver(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.BucketLifecycle.Enabled = true
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		uplink := planet.Uplinks[0]
+		projectID := uplink.Projects[0].ID
+		observer := sat.RangedLoop.BucketLifecycle.Observer
+
+		require.NoError(t, uplink.Upload(ctx, sat, "archived", "remote", testrand.Bytes(10*memory.KiB)))
+		require.NoError(t, uplink.Upload(ctx, sat, "archived", "inline", testrand.Bytes(memory.KiB)))
+		require.NoError(t, uplink.Upload(ctx, sat, "untouched", "remote", testrand.Bytes(10*memory.KiB)))
+
+		require.NoError(t, sat.API.Buckets.Service.SetBucketLifecycle(ctx, metabase.SetBucketLifecycle{
+			BucketLocation:      metabase.BucketLocation{ProjectID: projectID, BucketName: "archived"},
+			TransitionDays:      30,
+			TransitionPlacement: storj.EU,
+			ExpirationDays:      365,
+		}))
+
+		placements := func() map[string]storj.PlacementConstraint {
+			objects, err := sat.Metabase.DB.TestingAllObjects(ctx)
+			require.NoError(t, err)
+			segments, err := sat.Metabase.DB.TestingAllSegments(ctx)
+			require.NoError(t, err)
+
+			result := map[string]storj.PlacementConstraint{}
+			for _, object := range objects {
+				for _, segment := range segments {
+					if segment.StreamID == object.StreamID {
+						result[object.BucketName+"/"+string(object.ObjectKey)] = segment.Placement
+					}
+				}
+			}
+			return result
+		}
+
+		// the objects are too young for the rules.
+		_, err := sat.RangedLoop.RangedLoop.Service.RunOnce(ctx)
+		require.NoError(t, err)
+		require.Len(t, placements(), 3)
+		for _, placement := range placements() {
+			require.Equal(t, storj.EveryCountry, placement)
+		}
+
+		observer.TestingSetNow(func() time.Time { return time.Now().Add(31 * 24 * time.Hour) })
+		_, err = sat.RangedLoop.RangedLoop.Service.RunOnce(ctx)
+		require.NoError(t, err)
+
+		found := placements()
+		require.Len(t, found, 3)
+		for key, placement := range found {
+			if key == "untouched/remote" {
+				require.Equal(t, storj.EveryCountry, placement, key)
+			} else {
+				require.Equal(t, storj.EU, placement, key)
+			}
+		}
+
+		observer.TestingSetNow(func() time.Time { return time.Now().Add(366 * 24 * time.Hour) })
+		_, err = sat.RangedLoop.RangedLoop.Service.RunOnce(ctx)
+		require.NoError(t, err)
+
+		found = placements()
+		require.Len(t, found, 1)
+		require.Contains(t, found, "untouched/remote")
+
+		// the lifecycle is deleted with the bucket.
+		require.NoError(t, uplink.DeleteBucket(ctx, sat, "archived"))
+		_, err = sat.API.Buckets.Service.GetBucketLifecycle(ctx, []byte("archived"), projectID)
+		require.True(t, metabase.ErrBucketLifecycleNotFound.Has(err))
+	})
+}
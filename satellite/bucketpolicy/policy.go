@@ -0,0 +1,282 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package bucketpolicy implements storage and evaluation of S3 compatible
+// bucket policies, which restrict the access to a bucket in addition to the
+// caveats of the API keys.
+package bucketpolicy
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/url"
+	"strings"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+
+	"storj.io/common/macaroon"
+)
+
+var (
+	// Error is the error class for bucket policies.
+	Error = errs.Class("bucketpolicy")
+	// ErrNotFound is returned when the bucket has no policy.
+	ErrNotFound = errs.Class("bucket policy not found")
+	// ErrInvalid is returned when a policy document is invalid or uses
+	// unsupported elements.
+	ErrInvalid = errs.Class("invalid bucket policy")
+	// ErrDenied is returned when a bucket policy denies an action.
+	ErrDenied = errs.Class("denied by bucket policy")
+
+	mon = monkit.Package()
+)
+
+// maxDocumentSize is the maximum size of a policy document, as in S3.
+const maxDocumentSize = 20 * 1024
+
+const resourcePrefix = "arn:aws:s3:::"
+
+// operations maps the supported S3 actions to the operations of the API keys.
+var operations = map[string][]macaroon.ActionType{
+	"s3:getobject":    {macaroon.ActionRead},
+	"s3:putobject":    {macaroon.ActionWrite},
+	"s3:deleteobject": {macaroon.ActionDelete},
+	"s3:listbucket":   {macaroon.ActionList},
+	"s3:*":            {macaroon.ActionRead, macaroon.ActionWrite, macaroon.ActionDelete, macaroon.ActionList},
+	"*":               {macaroon.ActionRead, macaroon.ActionWrite, macaroon.ActionDelete, macaroon.ActionList},
+}
+
+// Policy is a parsed bucket policy.
+//
+// A request is denied, when any Deny statement matches it. When the policy
+// has Allow statements, a request must also match one of them. Object
+// resources are matched against the encrypted object key, listings against
+// the encrypted listing prefix. The bucket resource matches listings and the
+// requests on the bucket itself.
+//
+// The satellite only sees the encrypted object keys, which are binary, so the
+// keys of the resources are percent-encoded. Gateways encrypt the prefix with
+// the access grant of the bucket and percent-encode the result. Buckets used
+// with unencrypted object keys can use the plain keys.
+type Policy struct {
+	statements []statement
+	hasAllow   bool
+}
+
+type statement struct {
+	allow      bool
+	operations map[macaroon.ActionType]bool
+	resources  []resource
+}
+
+type resource struct {
+	// object is false for the bucket resource.
+	object bool
+	// key is the object key, or the key prefix when wildcard is set.
+	key      []byte
+	wildcard bool
+}
+
+// document is the json representation of a policy.
+type document struct {
+	Version   string              `json:"Version"`
+	ID        string              `json:"Id"`
+	Statement []documentStatement `json:"Statement"`
+}
+
+type documentStatement struct {
+	Sid       string          `json:"Sid"`
+	Effect    string          `json:"Effect"`
+	Principal json.RawMessage `json:"Principal"`
+	Action    stringOrSlice   `json:"Action"`
+	Resource  stringOrSlice   `json:"Resource"`
+
+	NotPrincipal json.RawMessage `json:"NotPrincipal"`
+	NotAction    json.RawMessage `json:"NotAction"`
+	NotResource  json.RawMessage `json:"NotResource"`
+	Condition    json.RawMessage `json:"Condition"`
+}
+
+// stringOrSlice is a policy element, which is either a string or an array of
+// strings.
+type stringOrSlice []string
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (values *stringOrSlice) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*values = []string{single}
+		return nil
+	}
+	return json.Unmarshal(data, (*[]string)(values))
+}
+
+// Parse parses and validates the policy document of the bucket.
+func Parse(bucketName string, data []byte) (*Policy, error) {
+	if len(data) > maxDocumentSize {
+		return nil, ErrInvalid.New("policy is larger than %d bytes", maxDocumentSize)
+	}
+
+	var doc document
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&doc); err != nil {
+		return nil, ErrInvalid.Wrap(err)
+	}
+
+	switch doc.Version {
+	case "", "2008-10-17", "2012-10-17":
+	default:
+		return nil, ErrInvalid.New("unsupported version %q", doc.Version)
+	}
+
+	policy := &Policy{}
+	for i, docStatement := range doc.Statement {
+		statement, err := parseStatement(bucketName, docStatement)
+		if err != nil {
+			return nil, ErrInvalid.New("statement %d: %v", i, err)
+		}
+		policy.statements = append(policy.statements, statement)
+		policy.hasAllow = policy.hasAllow || statement.allow
+	}
+	return policy, nil
+}
+
+func parseStatement(bucketName string, doc documentStatement) (_ statement, err error) {
+	switch {
+	case doc.NotPrincipal != nil, doc.NotAction != nil, doc.NotResource != nil:
+		return statement{}, errs.New("NotPrincipal, NotAction and NotResource are not supported")
+	case doc.Condition != nil:
+		return statement{}, errs.New("conditions are not supported")
+	case !isAnyPrincipal(doc.Principal):
+		return statement{}, errs.New("only the principal \"*\" is supported")
+	case len(doc.Action) == 0:
+		return statement{}, errs.New("no action")
+	case len(doc.Resource) == 0:
+		return statement{}, errs.New("no resource")
+	}
+
+	var result statement
+	switch doc.Effect {
+	case "Allow":
+		result.allow = true
+	case "Deny":
+	default:
+		return statement{}, errs.New("unsupported effect %q", doc.Effect)
+	}
+
+	result.operations = map[macaroon.ActionType]bool{}
+	for _, action := range doc.Action {
+		ops, ok := operations[strings.ToLower(action)]
+		if !ok {
+			return statement{}, errs.New("unsupported action %q", action)
+		}
+		for _, op := range ops {
+			result.operations[op] = true
+		}
+	}
+
+	for _, value := range doc.Resource {
+		resource, err := parseResource(bucketName, value)
+		if err != nil {
+			return statement{}, err
+		}
+		result.resources = append(result.resources, resource)
+	}
+
+	return result, nil
+}
+
+// isAnyPrincipal returns whether the principal is missing, "*" or {"AWS": "*"}.
+func isAnyPrincipal(principal json.RawMessage) bool {
+	if principal == nil {
+		return true
+	}
+
+	var value string
+	if err := json.Unmarshal(principal, &value); err == nil {
+		return value == "*"
+	}
+
+	var aws struct {
+		AWS stringOrSlice `json:"AWS"`
+	}
+	decoder := json.NewDecoder(bytes.NewReader(principal))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&aws); err != nil {
+		return false
+	}
+	return len(aws.AWS) == 1 && aws.AWS[0] == "*"
+}
+
+// parseResource parses a resource of the bucket. The object key is
+// percent-encoded. Wildcards are only supported at the end of the object key.
+func parseResource(bucketName, value string) (resource, error) {
+	if !strings.HasPrefix(value, resourcePrefix) {
+		return resource{}, errs.New("unsupported resource %q", value)
+	}
+
+	path := strings.TrimPrefix(value, resourcePrefix)
+	bucket, key, isObject := strings.Cut(path, "/")
+	if bucket != bucketName {
+		return resource{}, errs.New("resource %q is not in bucket %q", value, bucketName)
+	}
+	if !isObject {
+		return resource{}, nil
+	}
+
+	wildcard := strings.HasSuffix(key, "*")
+	key = strings.TrimSuffix(key, "*")
+	if strings.ContainsAny(key, "*?") {
+		return resource{}, errs.New("wildcards are only supported at the end of resource %q", value)
+	}
+
+	key, err := url.PathUnescape(key)
+	if err != nil {
+		return resource{}, errs.New("invalid percent-encoding of resource %q: %v", value, err)
+	}
+
+	return resource{object: true, key: []byte(key), wildcard: wildcard}, nil
+}
+
+// Allowed returns whether the policy allows the action.
+func (policy *Policy) Allowed(action macaroon.Action) bool {
+	allowed := !policy.hasAllow
+	for _, statement := range policy.statements {
+		if !statement.matches(action) {
+			continue
+		}
+		if !statement.allow {
+			return false
+		}
+		allowed = true
+	}
+	return allowed
+}
+
+func (statement *statement) matches(action macaroon.Action) bool {
+	if !statement.operations[action.Op] {
+		return false
+	}
+	for _, resource := range statement.resources {
+		if resource.matches(action) {
+			return true
+		}
+	}
+	return false
+}
+
+func (resource *resource) matches(action macaroon.Action) bool {
+	isList := action.Op == macaroon.ActionList
+	if !resource.object {
+		return isList || len(action.EncryptedPath) == 0
+	}
+	if !isList && len(action.EncryptedPath) == 0 {
+		return false
+	}
+	if resource.wildcard {
+		return bytes.HasPrefix(action.EncryptedPath, resource.key)
+	}
+	return bytes.Equal(action.EncryptedPath, resource.key)
+}
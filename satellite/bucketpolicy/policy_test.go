@@ -0,0 +1,98 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package bucketpolicy_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/macaroon"
+	"storj.io/storj/satellite/bucketpolicy"
+)
+
+func TestParse_Invalid(t *testing.T) {
+	for _, document := range []string{
+		`not json`,
+		`{"Version": "2000-01-01", "Statement": []}`,
+		`{"Statement": [{"Effect": "Maybe", "Action": "s3:GetObject", "Resource": "arn:aws:s3:::bucket/*"}]}`,
+		`{"Statement": [{"Effect": "Allow", "Action": "s3:PutBucketAcl", "Resource": "arn:aws:s3:::bucket/*"}]}`,
+		`{"Statement": [{"Effect": "Allow", "Action": "s3:GetObject", "Resource": "arn:aws:s3:::other/*"}]}`,
+		`{"Statement": [{"Effect": "Allow", "Action": "s3:GetObject", "Resource": "arn:aws:s3:::bucket/*.jpg"}]}`,
+		`{"Statement": [{"Effect": "Allow", "Action": "s3:GetObject"}]}`,
+		`{"Statement": [{"Effect": "Allow", "Principal": {"AWS": "arn:aws:iam::1:root"}, "Action": "s3:GetObject", "Resource": "arn:aws:s3:::bucket/*"}]}`,
+		`{"Statement": [{"Effect": "Allow", "Action": "s3:GetObject", "Resource": "arn:aws:s3:::bucket/*", "Condition": {}}]}`,
+		`{"Statement": [{"Effect": "Allow", "NotAction": "s3:GetObject", "Resource": "arn:aws:s3:::bucket/*"}]}`,
+		`{"Statement": [{"Effect": "Allow", "Action": "s3:GetObject", "Resource": "arn:aws:s3:::bucket/%zz*"}]}`,
+	} {
+		_, err := bucketpolicy.Parse("bucket", []byte(document))
+		require.True(t, bucketpolicy.ErrInvalid.Has(err), document)
+	}
+}
+
+func TestPolicy_Allowed(t *testing.T) {
+	action := func(op macaroon.ActionType, key string) macaroon.Action {
+		return macaroon.Action{Op: op, Bucket: []byte("bucket"), EncryptedPath: []byte(key)}
+	}
+
+	t.Run("deny", func(t *testing.T) {
+		policy, err := bucketpolicy.Parse("bucket", []byte(`{
+			"Version": "2012-10-17",
+			"Statement": [{
+				"Sid": "protect-logs",
+				"Effect": "Deny",
+				"Principal": {"AWS": ["*"]},
+				"Action": ["s3:DeleteObject", "s3:PutObject"],
+				"Resource": "arn:aws:s3:::bucket/logs/*"
+			}]
+		}`))
+		require.NoError(t, err)
+
+		require.False(t, policy.Allowed(action(macaroon.ActionDelete, "logs/a")))
+		require.False(t, policy.Allowed(action(macaroon.ActionWrite, "logs/a")))
+		require.True(t, policy.Allowed(action(macaroon.ActionRead, "logs/a")))
+		require.True(t, policy.Allowed(action(macaroon.ActionDelete, "data/a")))
+		// deleting the bucket isn't an object action.
+		require.True(t, policy.Allowed(action(macaroon.ActionDelete, "")))
+	})
+
+	t.Run("allow", func(t *testing.T) {
+		policy, err := bucketpolicy.Parse("bucket", []byte(`{
+			"Statement": [{
+				"Effect": "Allow",
+				"Principal": "*",
+				"Action": "s3:*",
+				"Resource": ["arn:aws:s3:::bucket", "arn:aws:s3:::bucket/public/*", "arn:aws:s3:::bucket/index.html"]
+			}, {
+				"Effect": "Deny",
+				"Principal": "*",
+				"Action": "s3:DeleteObject",
+				"Resource": "arn:aws:s3:::bucket/index.html"
+			}]
+		}`))
+		require.NoError(t, err)
+
+		require.True(t, policy.Allowed(action(macaroon.ActionRead, "public/a")))
+		require.True(t, policy.Allowed(action(macaroon.ActionWrite, "index.html")))
+		require.True(t, policy.Allowed(action(macaroon.ActionList, "private/")))
+		require.True(t, policy.Allowed(action(macaroon.ActionRead, "")))
+		require.False(t, policy.Allowed(action(macaroon.ActionRead, "private/a")))
+		require.False(t, policy.Allowed(action(macaroon.ActionRead, "index.html.bak")))
+		require.False(t, policy.Allowed(action(macaroon.ActionDelete, "index.html")))
+	})
+
+	t.Run("encrypted", func(t *testing.T) {
+		policy, err := bucketpolicy.Parse("bucket", []byte(`{
+			"Statement": [{
+				"Effect": "Deny",
+				"Action": "s3:GetObject",
+				"Resource": "arn:aws:s3:::bucket/%00%FF%2A/*"
+			}]
+		}`))
+		require.NoError(t, err)
+
+		require.False(t, policy.Allowed(action(macaroon.ActionRead, "\x00\xff*/a")))
+		require.True(t, policy.Allowed(action(macaroon.ActionRead, "\x00\xfe*/a")))
+	})
+}
@@ -0,0 +1,139 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package bucketpolicy
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/lrucache"
+	"storj.io/common/macaroon"
+	"storj.io/common/uuid"
+)
+
+// Config contains configurable values for bucket policies.
+type Config struct {
+	Enabled         bool          `help:"whether bucket policies are evaluated" default:"false"`
+	CacheCapacity   int           `help:"number of bucket policies cached" default:"10000"`
+	CacheExpiration time.Duration `help:"how long bucket policies are cached" default:"1m"`
+}
+
+// DB stores the policy documents of the buckets.
+//
+// architecture: Database
+type DB interface {
+	// Get returns the policy document of the bucket.
+	Get(ctx context.Context, projectID uuid.UUID, bucketName string) ([]byte, error)
+	// Set creates or replaces the policy document of the bucket.
+	Set(ctx context.Context, projectID uuid.UUID, bucketName string, policy []byte) error
+	// Delete removes the policy of the bucket.
+	Delete(ctx context.Context, projectID uuid.UUID, bucketName string) error
+}
+
+// Service evaluates the policies of the buckets.
+//
+// A nil Service and a disabled Service allow all actions.
+//
+// architecture: Service
+type Service struct {
+	log    *zap.Logger
+	db     DB
+	config Config
+	cache  *lrucache.ExpiringLRU
+}
+
+// NewService creates a new bucket policy service.
+func NewService(log *zap.Logger, db DB, config Config) *Service {
+	return &Service{
+		log:    log,
+		db:     db,
+		config: config,
+		cache: lrucache.New(lrucache.Options{
+			Capacity:   config.CacheCapacity,
+			Expiration: config.CacheExpiration,
+		}),
+	}
+}
+
+// Check returns ErrDenied, when the policy of the bucket of the action
+// denies it. Actions without a bucket are always allowed.
+func (service *Service) Check(ctx context.Context, projectID uuid.UUID, action macaroon.Action) (err error) {
+	if service == nil || !service.config.Enabled || len(action.Bucket) == 0 {
+		return nil
+	}
+	defer mon.Task()(&ctx)(&err)
+
+	policy, err := service.policy(ctx, projectID, string(action.Bucket))
+	if err != nil {
+		return err
+	}
+	if policy != nil && !policy.Allowed(action) {
+		mon.Counter("bucketpolicy_denied").Inc(1)
+		return ErrDenied.New("%s/%s", projectID, action.Bucket)
+	}
+	return nil
+}
+
+// Enabled returns whether the bucket policies are evaluated.
+func (service *Service) Enabled() bool {
+	return service != nil && service.config.Enabled
+}
+
+// Get returns the policy document of the bucket.
+func (service *Service) Get(ctx context.Context, projectID uuid.UUID, bucketName string) (_ []byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return service.db.Get(ctx, projectID, bucketName)
+}
+
+// Set validates and stores the policy document of the bucket. It takes effect
+// immediately on this satellite process and after the cache expiration on the
+// others.
+func (service *Service) Set(ctx context.Context, projectID uuid.UUID, bucketName string, document []byte) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if _, err := Parse(bucketName, document); err != nil {
+		return err
+	}
+
+	if err := service.db.Set(ctx, projectID, bucketName, document); err != nil {
+		return err
+	}
+	service.cache.Delete(projectID.String() + "/" + bucketName)
+	return nil
+}
+
+// Delete removes the policy of the bucket.
+func (service *Service) Delete(ctx context.Context, projectID uuid.UUID, bucketName string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := service.db.Delete(ctx, projectID, bucketName); err != nil {
+		return err
+	}
+	service.cache.Delete(projectID.String() + "/" + bucketName)
+	return nil
+}
+
+// policy returns the cached policy of the bucket, or nil, when the bucket
+// has no policy.
+func (service *Service) policy(ctx context.Context, projectID uuid.UUID, bucketName string) (_ *Policy, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	value, err := service.cache.Get(projectID.String()+"/"+bucketName, func() (interface{}, error) {
+		data, err := service.db.Get(ctx, projectID, bucketName)
+		if err != nil {
+			if ErrNotFound.Has(err) {
+				return (*Policy)(nil), nil
+			}
+			return nil, err
+		}
+		return Parse(bucketName, data)
+	})
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return value.(*Policy), nil
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/zeebo/errs"
 
 	"storj.io/common/storj"
+	"storj.io/common/uuid"
 	"storj.io/storj/satellite/metabase"
 )
 
@@ -56,3 +57,45 @@ func (buckets *Service) UpdateBucket(ctx context.Context, bucket storj.Bucket) (
 
 	return buckets.DB.UpdateBucket(ctx, bucket)
 }
+
+// DeleteBucket overrides the default DeleteBucket behaviour by also deleting the
+// lifecycle configuration of the bucket, so that it doesn't apply to a new
+// bucket with the same name.
+func (buckets *Service) DeleteBucket(ctx context.Context, bucketName []byte, projectID uuid.UUID) error {
+	err := buckets.DB.DeleteBucket(ctx, bucketName, projectID)
+	if err != nil {
+		return err
+	}
+
+	err = buckets.metabase.DeleteBucketLifecycle(ctx, metabase.DeleteBucketLifecycle{
+		BucketLocation: metabase.BucketLocation{ProjectID: projectID, BucketName: string(bucketName)},
+	})
+	if err != nil && !metabase.ErrBucketLifecycleNotFound.Has(err) {
+		return err
+	}
+	return nil
+}
+
+// SetBucketLifecycle sets the lifecycle configuration of an existing bucket.
+func (buckets *Service) SetBucketLifecycle(ctx context.Context, lifecycle metabase.SetBucketLifecycle) error {
+	_, err := buckets.GetBucket(ctx, []byte(lifecycle.BucketName), lifecycle.ProjectID)
+	if err != nil {
+		return err
+	}
+
+	return buckets.metabase.SetBucketLifecycle(ctx, lifecycle)
+}
+
+// GetBucketLifecycle returns the lifecycle configuration of the bucket.
+func (buckets *Service) GetBucketLifecycle(ctx context.Context, bucketName []byte, projectID uuid.UUID) (metabase.BucketLifecycle, error) {
+	return buckets.metabase.GetBucketLifecycle(ctx, metabase.GetBucketLifecycle{
+		BucketLocation: metabase.BucketLocation{ProjectID: projectID, BucketName: string(bucketName)},
+	})
+}
+
+// DeleteBucketLifecycle deletes the lifecycle configuration of the bucket.
+func (buckets *Service) DeleteBucketLifecycle(ctx context.Context, bucketName []byte, projectID uuid.UUID) error {
+	return buckets.metabase.DeleteBucketLifecycle(ctx, metabase.DeleteBucketLifecycle{
+		BucketLocation: metabase.BucketLocation{ProjectID: projectID, BucketName: string(bucketName)},
+	})
+}
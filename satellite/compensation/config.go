@@ -47,6 +47,9 @@ func (percents *Percents) Set(value string) error {
 		if err != nil {
 			return Error.New("invalid percent %q: %w", entry, err)
 		}
+		if percent < 0 || percent > 100 {
+			return Error.New("invalid percent %q: must be between 0 and 100", entry)
+		}
 		toSet = append(toSet, int(percent))
 	}
 
@@ -24,6 +24,12 @@ func NodeWithheldPercent(withheldPercents []int, nodeCreatedAt, endDate time.Tim
 	return 0, false
 }
 
+// WithholdingEndDate returns when a node, which was created at nodeCreatedAt,
+// leaves the withholding period.
+func WithholdingEndDate(withheldPercents []int, nodeCreatedAt time.Time) time.Time {
+	return nodeCreatedAt.AddDate(0, len(withheldPercents), 0)
+}
+
 // PercentOf sets v to a percentage of itself. For example if v was 200 and
 // percent was 20, v would be set to 40.
 func PercentOf(v, percent decimal.Decimal) decimal.Decimal {
@@ -55,6 +55,19 @@ func TestNodeWithheldPercent(t *testing.T) {
 	}
 }
 
+func TestWithholdingEndDate(t *testing.T) {
+	rates := []int{75, 75, 75, 50, 50, 50, 25, 25, 25, 0, 0, 0, 0, 0, 0}
+	createdAt := time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	endDate := compensation.WithholdingEndDate(rates, createdAt)
+	assert.Equal(t, time.Date(2020, 4, 1, 0, 0, 0, 0, time.UTC), endDate)
+
+	_, inWithholding := compensation.NodeWithheldPercent(rates, createdAt, endDate.Add(-time.Nanosecond))
+	assert.True(t, inWithholding)
+	_, inWithholding = compensation.NodeWithheldPercent(rates, createdAt, endDate)
+	assert.False(t, inWithholding)
+}
+
 func TestPercentOf(t *testing.T) {
 	percentOf := func(v, p int64) int64 {
 		return compensation.PercentOf(decimal.NewFromInt(v), decimal.NewFromInt(p)).IntPart()
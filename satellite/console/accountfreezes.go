@@ -58,6 +58,8 @@ type AccountFreezeService struct {
 	freezeEventsDB AccountFreezeEvents
 	usersDB        Users
 	projectsDB     Projects
+
+	nowFn func() time.Time
 }
 
 // NewAccountFreezeService creates a new account freeze service.
@@ -66,9 +68,16 @@ func NewAccountFreezeService(freezeEventsDB AccountFreezeEvents, usersDB Users,
 		freezeEventsDB: freezeEventsDB,
 		usersDB:        usersDB,
 		projectsDB:     projectsDB,
+
+		nowFn: time.Now,
 	}
 }
 
+// SetNow allows tests to have the service act as if the current time is whatever they want.
+func (s *AccountFreezeService) SetNow(nowFn func() time.Time) {
+	s.nowFn = nowFn
+}
+
 // IsUserFrozen returns whether the user specified by the given ID is frozen.
 func (s *AccountFreezeService) IsUserFrozen(ctx context.Context, userID uuid.UUID) (_ bool, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -96,8 +105,9 @@ func (s *AccountFreezeService) FreezeUser(ctx context.Context, userID uuid.UUID)
 	event, err := s.freezeEventsDB.Get(ctx, userID, Freeze)
 	if errors.Is(err, sql.ErrNoRows) {
 		event = &AccountFreezeEvent{
-			UserID: userID,
-			Type:   Freeze,
+			UserID:    userID,
+			Type:      Freeze,
+			CreatedAt: s.nowFn(),
 			Limits: &AccountFreezeEventLimits{
 				User: UsageLimits{
 					Storage:   user.ProjectStorageLimit,
@@ -6,6 +6,7 @@ package consoleauth
 import (
 	"crypto/hmac"
 	"crypto/sha256"
+	"sync"
 )
 
 // TODO: change to JWT or Macaroon based auth
@@ -26,3 +27,55 @@ func (a *Hmac) Sign(data []byte) ([]byte, error) {
 
 	return mac.Sum(nil), nil
 }
+
+// RotatingHmac is hmac256 based Signer, whose secret can be rotated. Signatures
+// created with the previous secret are still valid after a rotation.
+type RotatingHmac struct {
+	mu       sync.RWMutex
+	current  Hmac
+	previous *Hmac
+}
+
+// NewRotatingHmac creates a new RotatingHmac using secret.
+func NewRotatingHmac(secret []byte) *RotatingHmac {
+	return &RotatingHmac{current: Hmac{Secret: secret}}
+}
+
+// Rotate replaces the secret used for signing, the replaced secret is still
+// accepted until the next rotation.
+func (a *RotatingHmac) Rotate(secret []byte) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	previous := a.current
+	a.previous = &previous
+	a.current = Hmac{Secret: secret}
+}
+
+// Sign implements satellite signer.
+func (a *RotatingHmac) Sign(data []byte) ([]byte, error) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	return a.current.Sign(data)
+}
+
+// Verify implements Verifier.
+func (a *RotatingHmac) Verify(data, signature []byte) (bool, error) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	for _, signer := range []*Hmac{&a.current, a.previous} {
+		if signer == nil {
+			continue
+		}
+		expected, err := signer.Sign(data)
+		if err != nil {
+			return false, err
+		}
+		if hmac.Equal(expected, signature) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,51 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoleauth
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+)
+
+func TestRotatingHmac(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	signer := NewRotatingHmac([]byte("first"))
+	service := NewService(Config{TokenExpirationTime: time.Hour}, signer)
+
+	validate := func(tokenString string) bool {
+		token, err := FromBase64URLString(tokenString)
+		require.NoError(t, err)
+		valid, err := service.ValidateToken(token)
+		require.NoError(t, err)
+		return valid
+	}
+
+	first, err := service.CreateToken(ctx, testrand.UUID(), "")
+	require.NoError(t, err)
+	require.True(t, validate(first))
+
+	signer.Rotate([]byte("second"))
+	second, err := service.CreateToken(ctx, testrand.UUID(), "")
+	require.NoError(t, err)
+	require.True(t, validate(first))
+	require.True(t, validate(second))
+
+	// the signatures are compatible with Hmac.
+	plain := NewService(Config{TokenExpirationTime: time.Hour}, &Hmac{Secret: []byte("second")})
+	token, err := FromBase64URLString(second)
+	require.NoError(t, err)
+	valid, err := plain.ValidateToken(token)
+	require.NoError(t, err)
+	require.True(t, valid)
+
+	signer.Rotate([]byte("third"))
+	require.False(t, validate(first))
+	require.True(t, validate(second))
+}
@@ -40,6 +40,12 @@ type Signer interface {
 	Sign(data []byte) ([]byte, error)
 }
 
+// Verifier verifies signatures created by a Signer, whose secret may have been
+// rotated since.
+type Verifier interface {
+	Verify(data, signature []byte) (bool, error)
+}
+
 // CreateToken creates a new auth token.
 func (s *Service) CreateToken(ctx context.Context, id uuid.UUID, email string) (_ string, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -54,6 +60,17 @@ func (s *Service) CreateToken(ctx context.Context, id uuid.UUID, email string) (
 	return s.createToken(ctx, claims)
 }
 
+// CreateTokenWithExpiration creates a new auth token, which expires at the specified time.
+func (s *Service) CreateTokenWithExpiration(ctx context.Context, id uuid.UUID, email string, expiration time.Time) (_ string, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return s.createToken(ctx, &Claims{
+		ID:         id,
+		Email:      email,
+		Expiration: expiration,
+	})
+}
+
 // createToken creates string representation.
 func (s *Service) createToken(ctx context.Context, claims *Claims) (_ string, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -87,6 +104,11 @@ func (s *Service) SignToken(token Token) ([]byte, error) {
 
 // ValidateToken determines token validity using its signature.
 func (s *Service) ValidateToken(token Token) (bool, error) {
+	if verifier, ok := s.Signer.(Verifier); ok {
+		encoded := base64.URLEncoding.EncodeToString(token.Payload)
+		return verifier.Verify([]byte(encoded), token.Signature)
+	}
+
 	signature, err := s.SignToken(token)
 	if err != nil {
 		return false, err
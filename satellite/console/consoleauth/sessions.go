@@ -13,7 +13,7 @@ import (
 // WebappSessions is the repository for webapp sessions.
 type WebappSessions interface {
 	// Create creates a webapp session and returns the session info.
-	Create(ctx context.Context, id, userID uuid.UUID, ip, userAgent string, expires time.Time) (WebappSession, error)
+	Create(ctx context.Context, id, userID uuid.UUID, ip, userAgent string, status SessionStatus, expires time.Time) (WebappSession, error)
 	// GetBySessionID gets the session info from the session ID.
 	GetBySessionID(ctx context.Context, sessionID uuid.UUID) (WebappSession, error)
 	// GetAllByUserID gets all webapp sessions with userID.
@@ -32,6 +32,18 @@ type WebappSession struct {
 	UserID    uuid.UUID
 	Address   string
 	UserAgent string
-	Status    int
+	Status    SessionStatus
 	ExpiresAt time.Time
 }
+
+// SessionStatus is the status of a webapp session.
+type SessionStatus int
+
+const (
+	// SessionStatusActive is the status of the sessions, which users create by
+	// signing in.
+	SessionStatusActive SessionStatus = 0
+	// SessionStatusImpersonation is the status of the read-only sessions, which
+	// are created for admins to see the console as the user does.
+	SessionStatusImpersonation SessionStatus = 1
+)
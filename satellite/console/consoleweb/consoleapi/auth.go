@@ -923,6 +923,93 @@ func (a *Auth) RefreshSession(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// GetSupportAccess returns the support access grant of the user, which is
+// null when support access isn't granted.
+func (a *Auth) GetSupportAccess(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	grant, err := a.service.GetSupportAccess(ctx)
+	if err != nil {
+		a.serveJSONError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	err = json.NewEncoder(w).Encode(grant)
+	if err != nil {
+		a.log.Error("could not encode support access grant", zap.Error(ErrAuthAPI.Wrap(err)))
+	}
+}
+
+// GrantSupportAccess allows admins to see the console as the user does, for
+// the requested duration.
+func (a *Auth) GrantSupportAccess(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	var body struct {
+		Duration string `json:"duration"`
+	}
+	err = json.NewDecoder(r.Body).Decode(&body)
+	if err != nil {
+		a.serveJSONError(w, console.ErrValidation.Wrap(err))
+		return
+	}
+
+	duration, err := time.ParseDuration(body.Duration)
+	if err != nil {
+		a.serveJSONError(w, console.ErrValidation.Wrap(err))
+		return
+	}
+
+	grant, err := a.service.GrantSupportAccess(ctx, duration)
+	if err != nil {
+		a.serveJSONError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	err = json.NewEncoder(w).Encode(grant)
+	if err != nil {
+		a.log.Error("could not encode support access grant", zap.Error(ErrAuthAPI.Wrap(err)))
+	}
+}
+
+// RevokeSupportAccess stops admins from seeing the console as the user does.
+func (a *Auth) RevokeSupportAccess(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	err = a.service.RevokeSupportAccess(ctx)
+	if err != nil {
+		a.serveJSONError(w, err)
+	}
+}
+
+// GetImpersonationSessions returns when and why admins saw the console as the
+// user does.
+func (a *Auth) GetImpersonationSessions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	sessions, err := a.service.GetImpersonationSessions(ctx)
+	if err != nil {
+		a.serveJSONError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	err = json.NewEncoder(w).Encode(sessions)
+	if err != nil {
+		a.log.Error("could not encode impersonation sessions", zap.Error(ErrAuthAPI.Wrap(err)))
+	}
+}
+
 // serveJSONError writes JSON error to response output stream.
 func (a *Auth) serveJSONError(w http.ResponseWriter, err error) {
 	status := a.getStatusCode(err)
@@ -936,6 +1023,8 @@ func (a *Auth) getStatusCode(err error) int {
 		return http.StatusBadRequest
 	case console.ErrUnauthorized.Has(err), console.ErrTokenExpiration.Has(err), console.ErrRecoveryToken.Has(err), console.ErrLoginCredentials.Has(err):
 		return http.StatusUnauthorized
+	case console.ErrReadOnlySession.Has(err):
+		return http.StatusForbidden
 	case console.ErrEmailUsed.Has(err), console.ErrMFAConflict.Has(err):
 		return http.StatusConflict
 	case errors.Is(err, errNotImplemented):
@@ -0,0 +1,204 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoleapi
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/private/web"
+	"storj.io/storj/satellite/bucketevents"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/metabase"
+)
+
+var (
+	// ErrBucketNotificationsAPI - console bucket notifications api error type.
+	ErrBucketNotificationsAPI = errs.Class("console api bucket notifications")
+)
+
+// BucketNotifications is an api controller that exposes the notification
+// configuration of the buckets.
+type BucketNotifications struct {
+	log          *zap.Logger
+	service      *console.Service
+	bucketEvents *bucketevents.Service
+}
+
+// NewBucketNotifications is a constructor for api bucket notifications controller.
+func NewBucketNotifications(log *zap.Logger, service *console.Service, bucketEvents *bucketevents.Service) *BucketNotifications {
+	return &BucketNotifications{
+		log:          log,
+		service:      service,
+		bucketEvents: bucketEvents,
+	}
+}
+
+// bucketNotification is the json representation of a notification
+// configuration. The prefix is the encrypted key prefix, base64url encoded.
+// The auth token is never returned.
+type bucketNotification struct {
+	ObjectCreated bool                  `json:"objectCreated"`
+	ObjectDeleted bool                  `json:"objectDeleted"`
+	Prefix        string                `json:"prefix"`
+	Sink          bucketevents.SinkType `json:"sink"`
+	URL           string                `json:"url"`
+	Topic         string                `json:"topic"`
+	AuthToken     string                `json:"authToken,omitempty"`
+	CreatedAt     time.Time             `json:"createdAt"`
+	UpdatedAt     time.Time             `json:"updatedAt"`
+}
+
+// Get returns the notification configuration of a bucket.
+func (n *BucketNotifications) Get(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	projectID, bucketName, ok := n.authorize(w, r)
+	if !ok {
+		return
+	}
+
+	configuration, err := n.bucketEvents.Get(ctx, projectID, bucketName)
+	if err != nil {
+		if bucketevents.ErrNotFound.Has(err) {
+			n.serveJSONError(w, http.StatusNotFound, err)
+			return
+		}
+
+		n.serveJSONError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	err = json.NewEncoder(w).Encode(bucketNotification{
+		ObjectCreated: configuration.ObjectCreated,
+		ObjectDeleted: configuration.ObjectDeleted,
+		Prefix:        base64.URLEncoding.EncodeToString([]byte(configuration.Prefix)),
+		Sink:          configuration.Sink,
+		URL:           configuration.URL,
+		Topic:         configuration.Topic,
+		CreatedAt:     configuration.CreatedAt,
+		UpdatedAt:     configuration.UpdatedAt,
+	})
+	if err != nil {
+		n.log.Error("failed to write json bucket notification response", zap.Error(ErrBucketNotificationsAPI.Wrap(err)))
+	}
+}
+
+// Set creates or replaces the notification configuration of a bucket.
+func (n *BucketNotifications) Set(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	projectID, bucketName, ok := n.authorize(w, r)
+	if !ok {
+		return
+	}
+
+	var input bucketNotification
+	if err = json.NewDecoder(r.Body).Decode(&input); err != nil {
+		n.serveJSONError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	prefix, err := base64.URLEncoding.DecodeString(input.Prefix)
+	if err != nil {
+		n.serveJSONError(w, http.StatusBadRequest, errs.New("invalid prefix"))
+		return
+	}
+
+	err = n.bucketEvents.Set(ctx, bucketevents.Configuration{
+		ProjectID:     projectID,
+		BucketName:    bucketName,
+		ObjectCreated: input.ObjectCreated,
+		ObjectDeleted: input.ObjectDeleted,
+		Prefix:        metabase.ObjectKey(prefix),
+		Sink:          input.Sink,
+		URL:           input.URL,
+		Topic:         input.Topic,
+		AuthToken:     input.AuthToken,
+	})
+	if err != nil {
+		if bucketevents.ErrInvalid.Has(err) {
+			n.serveJSONError(w, http.StatusBadRequest, err)
+			return
+		}
+
+		n.serveJSONError(w, http.StatusInternalServerError, err)
+		return
+	}
+}
+
+// Delete removes the notification configuration of a bucket.
+func (n *BucketNotifications) Delete(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	projectID, bucketName, ok := n.authorize(w, r)
+	if !ok {
+		return
+	}
+
+	err = n.bucketEvents.Delete(ctx, projectID, bucketName)
+	if err != nil {
+		if bucketevents.ErrNotFound.Has(err) {
+			n.serveJSONError(w, http.StatusNotFound, err)
+			return
+		}
+
+		n.serveJSONError(w, http.StatusInternalServerError, err)
+		return
+	}
+}
+
+// authorize parses the project and bucket of the request and checks that
+// the user is a member of the project.
+func (n *BucketNotifications) authorize(w http.ResponseWriter, r *http.Request) (projectID uuid.UUID, bucketName string, ok bool) {
+	query := r.URL.Query()
+
+	projectID, err := uuid.FromString(query.Get("projectID"))
+	if err != nil {
+		n.serveJSONError(w, http.StatusBadRequest, errs.New("invalid project id: %v", err))
+		return uuid.UUID{}, "", false
+	}
+
+	bucketName = query.Get("bucket")
+	if bucketName == "" {
+		n.serveJSONError(w, http.StatusBadRequest, errs.New("missing bucket name"))
+		return uuid.UUID{}, "", false
+	}
+
+	_, err = n.service.GetProject(r.Context(), projectID)
+	if err != nil {
+		if console.ErrUnauthorized.Has(err) || console.ErrNoMembership.Has(err) {
+			n.serveJSONError(w, http.StatusUnauthorized, err)
+			return uuid.UUID{}, "", false
+		}
+
+		n.serveJSONError(w, http.StatusInternalServerError, err)
+		return uuid.UUID{}, "", false
+	}
+
+	return projectID, bucketName, true
+}
+
+// serveJSONError writes JSON error to response output stream.
+func (n *BucketNotifications) serveJSONError(w http.ResponseWriter, status int, err error) {
+	web.ServeJSONError(n.log, w, status, err)
+}
@@ -0,0 +1,172 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoleapi
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/storj/private/web"
+	"storj.io/storj/satellite/bucketpolicy"
+	"storj.io/storj/satellite/console"
+)
+
+var (
+	// ErrBucketPolicyAPI - console bucket policy api error type.
+	ErrBucketPolicyAPI = errs.Class("console api bucket policy")
+)
+
+// maxBucketPolicySize is the maximum size of the request body, the policy
+// itself is validated by bucketpolicy.Parse.
+const maxBucketPolicySize = 64 * 1024
+
+// BucketPolicy is an api controller that exposes the bucket policies to the
+// owners of the projects.
+//
+// The policies are matched against the object keys as the satellite sees
+// them, i.e. encrypted, unless the bucket is used with unencrypted object
+// keys. Resources with a key prefix therefore have to use the percent-encoded
+// encrypted prefix, see bucketpolicy.Policy.
+type BucketPolicy struct {
+	log      *zap.Logger
+	service  *console.Service
+	policies *bucketpolicy.Service
+}
+
+// NewBucketPolicy is a constructor for api bucket policy controller.
+func NewBucketPolicy(log *zap.Logger, service *console.Service, policies *bucketpolicy.Service) *BucketPolicy {
+	return &BucketPolicy{
+		log:      log,
+		service:  service,
+		policies: policies,
+	}
+}
+
+// Get returns the policy document of a bucket.
+func (p *BucketPolicy) Get(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	projectID, bucketName, ok := p.authorize(w, r)
+	if !ok {
+		return
+	}
+
+	document, err := p.policies.Get(ctx, projectID, bucketName)
+	if err != nil {
+		if bucketpolicy.ErrNotFound.Has(err) {
+			p.serveJSONError(w, http.StatusNotFound, err)
+			return
+		}
+
+		p.serveJSONError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	if _, err = w.Write(document); err != nil {
+		p.log.Error("failed to write bucket policy response", zap.Error(ErrBucketPolicyAPI.Wrap(err)))
+	}
+}
+
+// Set creates or replaces the policy document of a bucket.
+func (p *BucketPolicy) Set(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	projectID, bucketName, ok := p.authorize(w, r)
+	if !ok {
+		return
+	}
+
+	document, err := io.ReadAll(io.LimitReader(r.Body, maxBucketPolicySize))
+	if err != nil {
+		p.serveJSONError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	err = p.policies.Set(ctx, projectID, bucketName, document)
+	if err != nil {
+		if bucketpolicy.ErrInvalid.Has(err) {
+			p.serveJSONError(w, http.StatusBadRequest, err)
+			return
+		}
+
+		p.serveJSONError(w, http.StatusInternalServerError, err)
+		return
+	}
+}
+
+// Delete removes the policy of a bucket.
+func (p *BucketPolicy) Delete(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	projectID, bucketName, ok := p.authorize(w, r)
+	if !ok {
+		return
+	}
+
+	err = p.policies.Delete(ctx, projectID, bucketName)
+	if err != nil {
+		if bucketpolicy.ErrNotFound.Has(err) {
+			p.serveJSONError(w, http.StatusNotFound, err)
+			return
+		}
+
+		p.serveJSONError(w, http.StatusInternalServerError, err)
+		return
+	}
+}
+
+// authorize parses the project and bucket of the request and checks that
+// the user is the owner of the project.
+func (p *BucketPolicy) authorize(w http.ResponseWriter, r *http.Request) (projectID uuid.UUID, bucketName string, ok bool) {
+	query := r.URL.Query()
+
+	projectID, err := uuid.FromString(query.Get("projectID"))
+	if err != nil {
+		p.serveJSONError(w, http.StatusBadRequest, errs.New("invalid project id: %v", err))
+		return uuid.UUID{}, "", false
+	}
+
+	bucketName = query.Get("bucket")
+	if bucketName == "" {
+		p.serveJSONError(w, http.StatusBadRequest, errs.New("missing bucket name"))
+		return uuid.UUID{}, "", false
+	}
+
+	err = p.service.AuthorizeBucketOwner(r.Context(), projectID, bucketName)
+	if err != nil {
+		switch {
+		case console.ErrUnauthorized.Has(err) || console.ErrNoMembership.Has(err):
+			p.serveJSONError(w, http.StatusUnauthorized, err)
+		case storj.ErrBucketNotFound.Has(err):
+			p.serveJSONError(w, http.StatusNotFound, err)
+		default:
+			p.serveJSONError(w, http.StatusInternalServerError, err)
+		}
+		return uuid.UUID{}, "", false
+	}
+
+	return projectID, bucketName, true
+}
+
+// serveJSONError writes JSON error to response output stream.
+func (p *BucketPolicy) serveJSONError(w http.ResponseWriter, status int, err error) {
+	web.ServeJSONError(p.log, w, status, err)
+}
@@ -0,0 +1,92 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoleapi_test
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/console"
+)
+
+func TestBucketPolicy(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 0,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.BucketPolicy.Enabled = true
+				config.Console.RateLimit.Burst = 100
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+
+		owner, err := sat.AddUser(ctx, console.CreateUser{FullName: "Owner", Email: "owner@test.test"}, 1)
+		require.NoError(t, err)
+		member, err := sat.AddUser(ctx, console.CreateUser{FullName: "Member", Email: "member@test.test"}, 1)
+		require.NoError(t, err)
+
+		project, err := sat.AddProject(ctx, owner.ID, "policies")
+		require.NoError(t, err)
+		_, err = sat.DB.Console().ProjectMembers().Insert(ctx, member.ID, project.ID)
+		require.NoError(t, err)
+
+		_, err = sat.API.Buckets.Service.CreateBucket(ctx, storj.Bucket{ID: testrand.UUID(), Name: "bucket", ProjectID: project.ID})
+		require.NoError(t, err)
+
+		request := func(user *console.User, method, bucket, body string) (int, string) {
+			tokenInfo, err := sat.API.Console.Service.Token(ctx, console.AuthUser{Email: user.Email, Password: user.FullName})
+			require.NoError(t, err)
+
+			url := "http://" + sat.API.Console.Listener.Addr().String() + "/api/v0/buckets/policy?projectID=" + project.ID.String() + "&bucket=" + bucket
+			req, err := http.NewRequestWithContext(ctx, method, url, strings.NewReader(body))
+			require.NoError(t, err)
+			req.AddCookie(&http.Cookie{Name: "_tokenKey", Path: "/", Value: tokenInfo.Token.String()})
+
+			resp, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer func() { require.NoError(t, resp.Body.Close()) }()
+
+			data, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+			return resp.StatusCode, string(data)
+		}
+
+		policy := `{"Statement": [{"Effect": "Deny", "Action": "s3:DeleteObject", "Resource": "arn:aws:s3:::bucket/*"}]}`
+
+		// only the owner can manage the policy.
+		status, _ := request(member, http.MethodPut, "bucket", policy)
+		require.Equal(t, http.StatusUnauthorized, status)
+		status, _ = request(member, http.MethodGet, "bucket", "")
+		require.Equal(t, http.StatusUnauthorized, status)
+
+		status, _ = request(owner, http.MethodPut, "missing", policy)
+		require.Equal(t, http.StatusNotFound, status)
+		status, _ = request(owner, http.MethodPut, "bucket", `{"Statement": [{"Effect": "Maybe"}]}`)
+		require.Equal(t, http.StatusBadRequest, status)
+
+		status, _ = request(owner, http.MethodPut, "bucket", policy)
+		require.Equal(t, http.StatusOK, status)
+		status, body := request(owner, http.MethodGet, "bucket", "")
+		require.Equal(t, http.StatusOK, status)
+		require.JSONEq(t, policy, body)
+
+		status, _ = request(member, http.MethodDelete, "bucket", "")
+		require.Equal(t, http.StatusUnauthorized, status)
+		status, _ = request(owner, http.MethodDelete, "bucket", "")
+		require.Equal(t, http.StatusOK, status)
+		status, _ = request(owner, http.MethodGet, "bucket", "")
+		require.Equal(t, http.StatusNotFound, status)
+	})
+}
@@ -5,6 +5,7 @@ package consoleapi
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/http"
 
 	"github.com/zeebo/errs"
@@ -12,6 +13,7 @@ import (
 
 	"storj.io/common/uuid"
 	"storj.io/storj/private/web"
+	"storj.io/storj/satellite/attestation"
 	"storj.io/storj/satellite/console"
 )
 
@@ -67,6 +69,101 @@ func (b *Buckets) AllBucketNames(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// durabilityReportsLimit is the maximum number of durability reports listed.
+const durabilityReportsLimit = 100
+
+// DurabilityReports returns the most recent signed durability reports of a bucket.
+func (b *Buckets) DurabilityReports(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	query := r.URL.Query()
+
+	projectID, err := uuid.FromString(query.Get("projectID"))
+	if err != nil {
+		b.serveJSONError(w, http.StatusBadRequest, errs.New("invalid project id: %v", err))
+		return
+	}
+
+	bucketName := query.Get("bucket")
+	if bucketName == "" {
+		b.serveJSONError(w, http.StatusBadRequest, errs.New("missing bucket name"))
+		return
+	}
+
+	reports, err := b.service.GetBucketDurabilityReports(ctx, projectID, bucketName, durabilityReportsLimit)
+	if err != nil {
+		if console.ErrUnauthorized.Has(err) {
+			b.serveJSONError(w, http.StatusUnauthorized, err)
+			return
+		}
+
+		b.serveJSONError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	if reports == nil {
+		reports = []attestation.SignedReport{}
+	}
+
+	err = json.NewEncoder(w).Encode(reports)
+	if err != nil {
+		b.log.Error("failed to write json durability reports response", zap.Error(ErrBucketsAPI.Wrap(err)))
+	}
+}
+
+// DownloadDurabilityReport returns a signed durability report of a bucket as
+// a file attachment.
+func (b *Buckets) DownloadDurabilityReport(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	query := r.URL.Query()
+
+	projectID, err := uuid.FromString(query.Get("projectID"))
+	if err != nil {
+		b.serveJSONError(w, http.StatusBadRequest, errs.New("invalid project id: %v", err))
+		return
+	}
+
+	bucketName := query.Get("bucket")
+	if bucketName == "" {
+		b.serveJSONError(w, http.StatusBadRequest, errs.New("missing bucket name"))
+		return
+	}
+
+	reportID, err := uuid.FromString(query.Get("id"))
+	if err != nil {
+		b.serveJSONError(w, http.StatusBadRequest, errs.New("invalid report id: %v", err))
+		return
+	}
+
+	report, err := b.service.GetBucketDurabilityReport(ctx, projectID, bucketName, reportID)
+	if err != nil {
+		switch {
+		case console.ErrUnauthorized.Has(err):
+			b.serveJSONError(w, http.StatusUnauthorized, err)
+		case attestation.ErrReportNotFound.Has(err):
+			b.serveJSONError(w, http.StatusNotFound, err)
+		default:
+			b.serveJSONError(w, http.StatusInternalServerError, err)
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", "durability-report-"+report.ID.String()+".json"))
+
+	err = json.NewEncoder(w).Encode(report)
+	if err != nil {
+		b.log.Error("failed to write json durability report response", zap.Error(ErrBucketsAPI.Wrap(err)))
+	}
+}
+
 // serveJSONError writes JSON error to response output stream.
 func (b *Buckets) serveJSONError(w http.ResponseWriter, status int, err error) {
 	web.ServeJSONError(b.log, w, status, err)
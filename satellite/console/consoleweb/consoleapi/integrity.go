@@ -0,0 +1,104 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoleapi
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/storj/private/web"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/integrity"
+	"storj.io/storj/satellite/metabase"
+)
+
+var (
+	// ErrIntegrityAPI - console integrity api error type.
+	ErrIntegrityAPI = errs.Class("console api integrity")
+)
+
+// Integrity is an api controller that exposes object integrity checks.
+type Integrity struct {
+	log       *zap.Logger
+	service   *console.Service
+	integrity *integrity.Service
+}
+
+// NewIntegrity is a constructor for api integrity controller.
+func NewIntegrity(log *zap.Logger, service *console.Service, integrity *integrity.Service) *Integrity {
+	return &Integrity{
+		log:       log,
+		service:   service,
+		integrity: integrity,
+	}
+}
+
+// CheckObject checks the integrity of an object and returns the signed
+// health report. The object key is the encrypted key, base64url encoded.
+func (i *Integrity) CheckObject(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	query := r.URL.Query()
+
+	projectID, err := uuid.FromString(query.Get("projectID"))
+	if err != nil {
+		i.serveJSONError(w, http.StatusBadRequest, errs.New("invalid project id: %v", err))
+		return
+	}
+
+	bucketName := query.Get("bucket")
+	if bucketName == "" {
+		i.serveJSONError(w, http.StatusBadRequest, errs.New("missing bucket name"))
+		return
+	}
+
+	objectKey, err := base64.URLEncoding.DecodeString(query.Get("key"))
+	if err != nil || len(objectKey) == 0 {
+		i.serveJSONError(w, http.StatusBadRequest, errs.New("invalid object key"))
+		return
+	}
+
+	// checks that the user is a member of the project.
+	_, err = i.service.GetProject(ctx, projectID)
+	if err != nil {
+		if console.ErrUnauthorized.Has(err) || console.ErrNoMembership.Has(err) {
+			i.serveJSONError(w, http.StatusUnauthorized, err)
+			return
+		}
+
+		i.serveJSONError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	report, err := i.integrity.Check(ctx, projectID, bucketName, metabase.ObjectKey(objectKey))
+	if err != nil {
+		if storj.ErrObjectNotFound.Has(err) {
+			i.serveJSONError(w, http.StatusNotFound, err)
+			return
+		}
+
+		i.serveJSONError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	err = json.NewEncoder(w).Encode(report)
+	if err != nil {
+		i.log.Error("failed to write json integrity report response", zap.Error(ErrIntegrityAPI.Wrap(err)))
+	}
+}
+
+// serveJSONError writes JSON error to response output stream.
+func (i *Integrity) serveJSONError(w http.ResponseWriter, status int, err error) {
+	web.ServeJSONError(i.log, w, status, err)
+}
@@ -0,0 +1,215 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoleapi
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/storj/private/web"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/console/objectbrowser"
+)
+
+var (
+	// ErrObjectBrowserAPI - console object browser api error type.
+	ErrObjectBrowserAPI = errs.Class("console api object browser")
+)
+
+// encryptionKeyHeader is the header with the base64 encoded encryption key of
+// the project, as derived by the web app from the passphrase of the user.
+const encryptionKeyHeader = "X-Encryption-Key"
+
+// ObjectBrowser is an api controller that lists and previews the objects of
+// a project for the object browser of the web app.
+type ObjectBrowser struct {
+	log           *zap.Logger
+	service       *console.Service
+	objectBrowser *objectbrowser.Service
+}
+
+// NewObjectBrowser is a constructor for api object browser controller.
+func NewObjectBrowser(log *zap.Logger, service *console.Service, objectBrowser *objectbrowser.Service) *ObjectBrowser {
+	return &ObjectBrowser{
+		log:           log,
+		service:       service,
+		objectBrowser: objectBrowser,
+	}
+}
+
+// List returns a page of the objects and prefixes under a prefix of a bucket.
+func (b *ObjectBrowser) List(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	projectID, encryptionKey, bucket, ok := b.authorize(w, r)
+	if !ok {
+		return
+	}
+
+	query := r.URL.Query()
+
+	var limit int
+	if value := query.Get("limit"); value != "" {
+		limit, err = strconv.Atoi(value)
+		if err != nil {
+			b.serveJSONError(w, http.StatusBadRequest, errs.New("invalid limit: %v", err))
+			return
+		}
+	}
+
+	page, err := b.objectBrowser.List(ctx, projectID, encryptionKey, bucket, query.Get("prefix"), query.Get("token"), limit)
+	if err != nil {
+		b.serveError(w, err)
+		return
+	}
+
+	err = json.NewEncoder(w).Encode(page)
+	if err != nil {
+		b.log.Error("failed to write json object listing response", zap.Error(ErrObjectBrowserAPI.Wrap(err)))
+	}
+}
+
+// Metadata returns the metadata of an object.
+func (b *ObjectBrowser) Metadata(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	projectID, encryptionKey, bucket, ok := b.authorize(w, r)
+	if !ok {
+		return
+	}
+
+	key := r.URL.Query().Get("key")
+	if key == "" {
+		b.serveJSONError(w, http.StatusBadRequest, errs.New("missing object key"))
+		return
+	}
+
+	item, err := b.objectBrowser.Stat(ctx, projectID, encryptionKey, bucket, key)
+	if err != nil {
+		b.serveError(w, err)
+		return
+	}
+
+	err = json.NewEncoder(w).Encode(item)
+	if err != nil {
+		b.log.Error("failed to write json object metadata response", zap.Error(ErrObjectBrowserAPI.Wrap(err)))
+	}
+}
+
+// Preview returns the data of an object, when it isn't too large.
+func (b *ObjectBrowser) Preview(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	projectID, encryptionKey, bucket, ok := b.authorize(w, r)
+	if !ok {
+		return
+	}
+
+	key := r.URL.Query().Get("key")
+	if key == "" {
+		b.serveJSONError(w, http.StatusBadRequest, errs.New("missing object key"))
+		return
+	}
+
+	var written bool
+	err = b.objectBrowser.Preview(ctx, projectID, encryptionKey, bucket, key, func(item objectbrowser.Item, data io.Reader) error {
+		contentType := item.ContentType
+		if contentType == "" {
+			contentType = "application/octet-stream"
+		}
+		w.Header().Set("Content-Type", contentType)
+		w.Header().Set("Content-Length", strconv.FormatInt(item.Size, 10))
+		// the data is only shown by the web app and never rendered as a page
+		// of the console.
+		w.Header().Set("Content-Security-Policy", "sandbox")
+		w.Header().Set("X-Content-Type-Options", "nosniff")
+
+		written = true
+		_, err := io.Copy(w, data)
+		return err
+	})
+	if err != nil {
+		if written {
+			b.log.Error("failed to write object preview response", zap.Error(ErrObjectBrowserAPI.Wrap(err)))
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		b.serveError(w, err)
+	}
+}
+
+// authorize checks that the user is a member of the requested project and
+// returns the project id, the encryption key and the bucket of the request.
+func (b *ObjectBrowser) authorize(w http.ResponseWriter, r *http.Request) (projectID uuid.UUID, encryptionKey storj.Key, bucket string, ok bool) {
+	query := r.URL.Query()
+
+	projectID, err := uuid.FromString(query.Get("projectID"))
+	if err != nil {
+		b.serveJSONError(w, http.StatusBadRequest, errs.New("invalid project id: %v", err))
+		return uuid.UUID{}, storj.Key{}, "", false
+	}
+
+	bucket = query.Get("bucket")
+	if bucket == "" {
+		b.serveJSONError(w, http.StatusBadRequest, errs.New("missing bucket name"))
+		return uuid.UUID{}, storj.Key{}, "", false
+	}
+
+	keyBytes, err := base64.StdEncoding.DecodeString(r.Header.Get(encryptionKeyHeader))
+	if err != nil || len(keyBytes) != len(encryptionKey) {
+		b.serveJSONError(w, http.StatusBadRequest, errs.New("invalid encryption key"))
+		return uuid.UUID{}, storj.Key{}, "", false
+	}
+	copy(encryptionKey[:], keyBytes)
+
+	_, err = b.service.GetProject(r.Context(), projectID)
+	if err != nil {
+		if console.ErrUnauthorized.Has(err) || console.ErrNoMembership.Has(err) {
+			b.serveJSONError(w, http.StatusUnauthorized, err)
+			return uuid.UUID{}, storj.Key{}, "", false
+		}
+
+		b.serveJSONError(w, http.StatusInternalServerError, err)
+		return uuid.UUID{}, storj.Key{}, "", false
+	}
+
+	return projectID, encryptionKey, bucket, true
+}
+
+// serveError writes the JSON error with the status of the object browser error.
+func (b *ObjectBrowser) serveError(w http.ResponseWriter, err error) {
+	switch {
+	case objectbrowser.ErrNotFound.Has(err):
+		b.serveJSONError(w, http.StatusNotFound, err)
+	case objectbrowser.ErrInvalidToken.Has(err):
+		b.serveJSONError(w, http.StatusBadRequest, err)
+	case objectbrowser.ErrTooLarge.Has(err):
+		b.serveJSONError(w, http.StatusUnprocessableEntity, err)
+	default:
+		b.serveJSONError(w, http.StatusInternalServerError, err)
+	}
+}
+
+// serveJSONError writes JSON error to response output stream.
+func (b *ObjectBrowser) serveJSONError(w http.ResponseWriter, status int, err error) {
+	web.ServeJSONError(b.log, w, status, err)
+}
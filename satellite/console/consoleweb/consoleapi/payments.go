@@ -131,6 +131,31 @@ func (p *Payments) ProjectsCharges(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// EstimateCost returns an itemized estimate of what the current user will be invoiced for the current billing period.
+func (p *Payments) EstimateCost(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	estimate, err := p.service.Payments().EstimateCost(ctx)
+	if err != nil {
+		if console.ErrUnauthorized.Has(err) {
+			p.serveJSONError(w, http.StatusUnauthorized, err)
+			return
+		}
+
+		p.serveJSONError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	err = json.NewEncoder(w).Encode(estimate)
+	if err != nil {
+		p.log.Error("failed to write json cost estimate response", zap.Error(ErrPaymentsAPI.Wrap(err)))
+	}
+}
+
 // triggerAttemptPaymentIfFrozen checks if the account is frozen and if frozen, will trigger attempt to pay outstanding invoices.
 func (p *Payments) triggerAttemptPaymentIfFrozen(ctx context.Context) (err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -4,33 +4,57 @@
 package consoleapi
 
 import (
+	"context"
 	"encoding/base64"
 	"encoding/json"
+	"fmt"
 	"net/http"
+	"net/url"
+	"time"
 
 	"github.com/gorilla/mux"
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 
 	"storj.io/common/uuid"
+	"storj.io/storj/private/post"
 	"storj.io/storj/private/web"
 	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/mailservice"
 )
 
 // Projects is an api controller that exposes projects related functionality.
 type Projects struct {
-	log     *zap.Logger
-	service *console.Service
+	log                   *zap.Logger
+	service               *console.Service
+	mailService           *mailservice.Service
+	externalAddress       string
+	letUsKnowURL          string
+	termsAndConditionsURL string
+	contactInfoURL        string
 }
 
 // NewProjects is a constructor for api analytics controller.
-func NewProjects(log *zap.Logger, service *console.Service) *Projects {
+func NewProjects(log *zap.Logger, service *console.Service, mailService *mailservice.Service, externalAddress, letUsKnowURL, termsAndConditionsURL, contactInfoURL string) *Projects {
 	return &Projects{
-		log:     log,
-		service: service,
+		log:                   log,
+		service:               service,
+		mailService:           mailService,
+		externalAddress:       externalAddress,
+		letUsKnowURL:          letUsKnowURL,
+		termsAndConditionsURL: termsAndConditionsURL,
+		contactInfoURL:        contactInfoURL,
 	}
 }
 
+// projectInvitation is the JSON representation of a project member invitation.
+type projectInvitation struct {
+	Email     string    `json:"email"`
+	CreatedAt time.Time `json:"createdAt"`
+	ExpiresAt time.Time `json:"expiresAt"`
+	Expired   bool      `json:"expired"`
+}
+
 // GetSalt returns the project's salt.
 func (p *Projects) GetSalt(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -64,6 +88,595 @@ func (p *Projects) GetSalt(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// GetServerSideEncryption returns the server-side encryption mode of the project.
+func (p *Projects) GetServerSideEncryption(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	id, ok := p.projectID(w, r)
+	if !ok {
+		return
+	}
+
+	mode, err := p.service.GetServerSideEncryption(ctx, id)
+	if err != nil {
+		p.serveServerSideEncryptionError(w, err)
+		return
+	}
+
+	err = json.NewEncoder(w).Encode(mode)
+	if err != nil {
+		p.log.Error("failed to write json server-side encryption response", zap.Error(ErrProjectsAPI.Wrap(err)))
+	}
+}
+
+// EnableServerSideEncryption opts the project into server-side encryption mode.
+func (p *Projects) EnableServerSideEncryption(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	id, ok := p.projectID(w, r)
+	if !ok {
+		return
+	}
+
+	mode, err := p.service.EnableServerSideEncryption(ctx, id)
+	if err != nil {
+		p.serveServerSideEncryptionError(w, err)
+		return
+	}
+
+	err = json.NewEncoder(w).Encode(mode)
+	if err != nil {
+		p.log.Error("failed to write json server-side encryption response", zap.Error(ErrProjectsAPI.Wrap(err)))
+	}
+}
+
+// GetEncryptionKey returns the satellite managed encryption key of a project
+// in server-side encryption mode, encoded as the passphrase of the project.
+func (p *Projects) GetEncryptionKey(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+
+	id, ok := p.projectID(w, r)
+	if !ok {
+		return
+	}
+
+	key, err := p.service.GetProjectEncryptionKey(ctx, id)
+	if err != nil {
+		p.serveServerSideEncryptionError(w, err)
+		return
+	}
+
+	err = json.NewEncoder(w).Encode(struct {
+		Passphrase string `json:"passphrase"`
+	}{
+		Passphrase: base64.RawURLEncoding.EncodeToString(key),
+	})
+	if err != nil {
+		p.log.Error("failed to write json project encryption key response", zap.Error(ErrProjectsAPI.Wrap(err)))
+	}
+}
+
+// Delete deletes the project. The access to the project is disabled
+// immediately, while its data is purged in the background.
+func (p *Projects) Delete(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	id, ok := p.projectID(w, r)
+	if !ok {
+		return
+	}
+
+	err = p.service.DeleteProject(ctx, id)
+	if err != nil {
+		p.serveProjectDeletionError(w, http.StatusConflict, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// GetDeletion returns the progress of the deletion of the project.
+func (p *Projects) GetDeletion(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	id, ok := p.projectID(w, r)
+	if !ok {
+		return
+	}
+
+	deletion, err := p.service.GetProjectDeletion(ctx, id)
+	if err != nil {
+		p.serveProjectDeletionError(w, http.StatusNotFound, err)
+		return
+	}
+
+	err = json.NewEncoder(w).Encode(deletion)
+	if err != nil {
+		p.log.Error("failed to write json project deletion response", zap.Error(ErrProjectsAPI.Wrap(err)))
+	}
+}
+
+// RequestLimitIncrease queues a request to increase a limit of the project
+// for the review of an admin.
+func (p *Projects) RequestLimitIncrease(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	id, ok := p.projectID(w, r)
+	if !ok {
+		return
+	}
+
+	var body console.LimitIncreaseRequest
+	err = json.NewDecoder(r.Body).Decode(&body)
+	if err != nil {
+		p.serveJSONError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	request, err := p.service.RequestLimitIncrease(ctx, id, body)
+	if err != nil {
+		p.serveLimitRequestError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	err = json.NewEncoder(w).Encode(request)
+	if err != nil {
+		p.log.Error("failed to write json limit request response", zap.Error(ErrProjectsAPI.Wrap(err)))
+	}
+}
+
+// GetLimitRequests returns the limit increase requests of the project.
+func (p *Projects) GetLimitRequests(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	id, ok := p.projectID(w, r)
+	if !ok {
+		return
+	}
+
+	requests, err := p.service.GetLimitRequests(ctx, id)
+	if err != nil {
+		p.serveLimitRequestError(w, err)
+		return
+	}
+	if requests == nil {
+		requests = []console.LimitRequest{}
+	}
+
+	err = json.NewEncoder(w).Encode(requests)
+	if err != nil {
+		p.log.Error("failed to write json limit requests response", zap.Error(ErrProjectsAPI.Wrap(err)))
+	}
+}
+
+// GetNetworkRules returns the network rules of the project.
+func (p *Projects) GetNetworkRules(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	id, ok := p.projectID(w, r)
+	if !ok {
+		return
+	}
+
+	rules, err := p.service.GetProjectNetworkRules(ctx, id)
+	if err != nil {
+		p.serveNetworkRulesError(w, err)
+		return
+	}
+	if rules == nil {
+		rules = console.NetworkRules{}
+	}
+
+	err = json.NewEncoder(w).Encode(rules)
+	if err != nil {
+		p.log.Error("failed to write json network rules response", zap.Error(ErrProjectsAPI.Wrap(err)))
+	}
+}
+
+// SetNetworkRules replaces the network rules of the project.
+func (p *Projects) SetNetworkRules(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	id, ok := p.projectID(w, r)
+	if !ok {
+		return
+	}
+
+	var body console.NetworkRules
+	err = json.NewDecoder(r.Body).Decode(&body)
+	if err != nil {
+		p.serveJSONError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	rules, err := p.service.SetProjectNetworkRules(ctx, id, body)
+	if err != nil {
+		p.serveNetworkRulesError(w, err)
+		return
+	}
+
+	err = json.NewEncoder(w).Encode(rules)
+	if err != nil {
+		p.log.Error("failed to write json network rules response", zap.Error(ErrProjectsAPI.Wrap(err)))
+	}
+}
+
+// InviteUsers invites users to the project and emails them the invitations.
+func (p *Projects) InviteUsers(w http.ResponseWriter, r *http.Request) {
+	p.inviteUsers(w, r, p.service.InviteProjectMembers)
+}
+
+// ReinviteUsers renews the pending invitations of the project and emails them
+// again.
+func (p *Projects) ReinviteUsers(w http.ResponseWriter, r *http.Request) {
+	p.inviteUsers(w, r, p.service.ReinviteProjectMembers)
+}
+
+// inviteUsers creates or renews the invitations with invite and emails them.
+func (p *Projects) inviteUsers(w http.ResponseWriter, r *http.Request, invite func(ctx context.Context, projectID uuid.UUID, emails []string) ([]console.ProjectInvitation, error)) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	id, ok := p.projectID(w, r)
+	if !ok {
+		return
+	}
+
+	var body struct {
+		Emails []string `json:"emails"`
+	}
+	err = json.NewDecoder(r.Body).Decode(&body)
+	if err != nil {
+		p.serveJSONError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	project, err := p.service.GetProject(ctx, id)
+	if err != nil {
+		p.serveInvitationError(w, err)
+		return
+	}
+
+	invites, err := invite(ctx, id, body.Emails)
+	if err != nil {
+		p.serveInvitationError(w, err)
+		return
+	}
+
+	inviter, err := console.GetUser(ctx)
+	if err != nil {
+		p.serveJSONError(w, http.StatusUnauthorized, err)
+		return
+	}
+
+	response := make([]projectInvitation, 0, len(invites))
+	for _, invite := range invites {
+		err = p.sendInvitation(ctx, inviter, project, invite)
+		if err != nil {
+			p.serveJSONError(w, http.StatusInternalServerError, err)
+			return
+		}
+		response = append(response, p.toProjectInvitation(&invite))
+	}
+
+	err = json.NewEncoder(w).Encode(response)
+	if err != nil {
+		p.log.Error("failed to write json project invitations response", zap.Error(ErrProjectsAPI.Wrap(err)))
+	}
+}
+
+// sendInvitation emails the invitation, existing users are asked to sign in
+// and everyone else is asked to sign up through the invitation link.
+func (p *Projects) sendInvitation(ctx context.Context, inviter *console.User, project *console.Project, invite console.ProjectInvitation) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, _, err := p.service.GetUserByEmailWithUnverified(ctx, invite.Email)
+	if err != nil && !console.ErrEmailNotFound.Has(err) {
+		return err
+	}
+
+	if user != nil {
+		userName := user.ShortName
+		if user.ShortName == "" {
+			userName = user.FullName
+		}
+
+		p.mailService.SendRenderedAsync(
+			ctx,
+			[]post.Address{{Address: user.Email, Name: userName}},
+			&console.ProjectInvitationEmail{
+				Origin:                p.externalAddress,
+				UserName:              userName,
+				ProjectName:           project.Name,
+				SignInLink:            p.externalAddress + "login",
+				LetUsKnowURL:          p.letUsKnowURL,
+				TermsAndConditionsURL: p.termsAndConditionsURL,
+				ContactInfoURL:        p.contactInfoURL,
+			},
+		)
+		return nil
+	}
+
+	token, err := p.service.CreateInviteToken(ctx, invite)
+	if err != nil {
+		return err
+	}
+
+	p.mailService.SendRenderedAsync(
+		ctx,
+		[]post.Address{{Address: invite.Email}},
+		&console.NewUserProjectInvitationEmail{
+			InviterEmail: inviter.Email,
+			ProjectName:  project.Name,
+			SignUpLink:   p.externalAddress + "signup?invite=" + url.QueryEscape(token),
+			ExpiresIn:    formatInvitationExpiration(time.Until(p.service.ProjectInvitationExpiresAt(&invite))),
+		},
+	)
+	return nil
+}
+
+// GetInvitations returns the invitations of the project, including the
+// expired ones.
+func (p *Projects) GetInvitations(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	id, ok := p.projectID(w, r)
+	if !ok {
+		return
+	}
+
+	invites, err := p.service.GetProjectInvitations(ctx, id)
+	if err != nil {
+		p.serveInvitationError(w, err)
+		return
+	}
+
+	response := make([]projectInvitation, 0, len(invites))
+	for i := range invites {
+		response = append(response, p.toProjectInvitation(&invites[i]))
+	}
+
+	err = json.NewEncoder(w).Encode(response)
+	if err != nil {
+		p.log.Error("failed to write json project invitations response", zap.Error(ErrProjectsAPI.Wrap(err)))
+	}
+}
+
+// GetUserInvitations returns the pending invitations of the user.
+func (p *Projects) GetUserInvitations(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	invites, err := p.service.GetUserProjectInvitations(ctx)
+	if err != nil {
+		p.serveInvitationError(w, err)
+		return
+	}
+
+	err = json.NewEncoder(w).Encode(invites)
+	if err != nil {
+		p.log.Error("failed to write json user invitations response", zap.Error(ErrProjectsAPI.Wrap(err)))
+	}
+}
+
+// RespondToInvitation accepts or declines the user's invitation to the project.
+func (p *Projects) RespondToInvitation(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	id, ok := p.projectID(w, r)
+	if !ok {
+		return
+	}
+
+	var body struct {
+		Response console.ProjectInvitationResponse `json:"projectResponse"`
+	}
+	err = json.NewDecoder(r.Body).Decode(&body)
+	if err != nil {
+		p.serveJSONError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	err = p.service.RespondToProjectInvitation(ctx, id, body.Response)
+	if err != nil {
+		p.serveInvitationError(w, err)
+	}
+}
+
+// GetInvitationByToken returns the details of the invitation of the token, so
+// that the sign up page can show them before the invitee has an account.
+func (p *Projects) GetInvitationByToken(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	token := r.URL.Query().Get("token")
+	if token == "" {
+		p.serveJSONError(w, http.StatusBadRequest, errs.New("missing token query param"))
+		return
+	}
+
+	invite, err := p.service.GetInviteByToken(ctx, token)
+	if err != nil {
+		p.serveInvitationError(w, err)
+		return
+	}
+
+	err = json.NewEncoder(w).Encode(invite)
+	if err != nil {
+		p.log.Error("failed to write json invitation response", zap.Error(ErrProjectsAPI.Wrap(err)))
+	}
+}
+
+// toProjectInvitation converts the invitation to its JSON representation.
+func (p *Projects) toProjectInvitation(invite *console.ProjectInvitation) projectInvitation {
+	return projectInvitation{
+		Email:     invite.Email,
+		CreatedAt: invite.CreatedAt,
+		ExpiresAt: p.service.ProjectInvitationExpiresAt(invite),
+		Expired:   p.service.IsProjectInvitationExpired(invite),
+	}
+}
+
+// formatInvitationExpiration formats how long an invitation is valid for in
+// whole days, or hours when it's shorter than a day.
+func formatInvitationExpiration(d time.Duration) string {
+	if days := int(d.Round(time.Hour).Hours()) / 24; days > 0 {
+		if days == 1 {
+			return "1 day"
+		}
+		return fmt.Sprintf("%d days", days)
+	}
+	hours := int(d.Round(time.Hour).Hours())
+	if hours <= 1 {
+		return "1 hour"
+	}
+	return fmt.Sprintf("%d hours", hours)
+}
+
+// projectID parses the project ID route param, it serves an error when the
+// param is missing or invalid.
+func (p *Projects) projectID(w http.ResponseWriter, r *http.Request) (_ uuid.UUID, ok bool) {
+	idParam, ok := mux.Vars(r)["id"]
+	if !ok {
+		p.serveJSONError(w, http.StatusBadRequest, errs.New("missing id route param"))
+		return uuid.UUID{}, false
+	}
+
+	id, err := uuid.FromString(idParam)
+	if err != nil {
+		p.serveJSONError(w, http.StatusBadRequest, err)
+		return uuid.UUID{}, false
+	}
+	return id, true
+}
+
+// serveServerSideEncryptionError writes the server-side encryption error
+// with the matching status.
+func (p *Projects) serveServerSideEncryptionError(w http.ResponseWriter, err error) {
+	switch {
+	case console.ErrUnauthorized.Has(err), console.ErrNoMembership.Has(err):
+		p.serveJSONError(w, http.StatusUnauthorized, err)
+	case console.ErrServerSideEncryption.Has(err):
+		p.serveJSONError(w, http.StatusConflict, err)
+	default:
+		p.serveJSONError(w, http.StatusInternalServerError, err)
+	}
+}
+
+// serveProjectDeletionError writes the project deletion error with the
+// matching status, deletionStatus is used for the errors of the deletion
+// itself.
+func (p *Projects) serveProjectDeletionError(w http.ResponseWriter, deletionStatus int, err error) {
+	switch {
+	case console.ErrUnauthorized.Has(err), console.ErrNoMembership.Has(err):
+		p.serveJSONError(w, http.StatusUnauthorized, err)
+	case console.ErrProjectDeletion.Has(err):
+		p.serveJSONError(w, deletionStatus, err)
+	default:
+		p.serveJSONError(w, http.StatusInternalServerError, err)
+	}
+}
+
+// serveLimitRequestError writes the limit increase request error with the
+// matching status.
+func (p *Projects) serveLimitRequestError(w http.ResponseWriter, err error) {
+	switch {
+	case console.ErrUnauthorized.Has(err), console.ErrNoMembership.Has(err):
+		p.serveJSONError(w, http.StatusUnauthorized, err)
+	case console.ErrValidation.Has(err):
+		p.serveJSONError(w, http.StatusBadRequest, err)
+	case console.ErrLimitRequest.Has(err):
+		p.serveJSONError(w, http.StatusConflict, err)
+	default:
+		p.serveJSONError(w, http.StatusInternalServerError, err)
+	}
+}
+
+// serveNetworkRulesError writes the network rules error with the matching
+// status.
+func (p *Projects) serveNetworkRulesError(w http.ResponseWriter, err error) {
+	switch {
+	case console.ErrUnauthorized.Has(err), console.ErrNoMembership.Has(err):
+		p.serveJSONError(w, http.StatusUnauthorized, err)
+	case console.ErrValidation.Has(err):
+		p.serveJSONError(w, http.StatusBadRequest, err)
+	default:
+		p.serveJSONError(w, http.StatusInternalServerError, err)
+	}
+}
+
+// serveInvitationError writes the project invitation error with the matching
+// status.
+func (p *Projects) serveInvitationError(w http.ResponseWriter, err error) {
+	switch {
+	case console.ErrUnauthorized.Has(err), console.ErrNoMembership.Has(err):
+		p.serveJSONError(w, http.StatusUnauthorized, err)
+	case console.ErrValidation.Has(err):
+		p.serveJSONError(w, http.StatusBadRequest, err)
+	case console.ErrAlreadyMember.Has(err), console.ErrAlreadyInvited.Has(err):
+		p.serveJSONError(w, http.StatusConflict, err)
+	case console.ErrProjectInviteInvalid.Has(err), console.ErrTokenInvalid.Has(err):
+		p.serveJSONError(w, http.StatusNotFound, err)
+	default:
+		p.serveJSONError(w, http.StatusInternalServerError, err)
+	}
+}
+
 // serveJSONError writes JSON error to response output stream.
 func (p *Projects) serveJSONError(w http.ResponseWriter, status int, err error) {
 	web.ServeJSONError(p.log, w, status, err)
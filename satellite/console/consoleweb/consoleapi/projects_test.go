@@ -0,0 +1,100 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoleapi_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/console"
+)
+
+func TestServerSideEncryption(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 0,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Console.ServerSideEncryptionEnabled = true
+				config.Console.RateLimit.Burst = 100
+				require.NoError(t, config.Metainfo.MetadataEncryptionKey.Set("000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f"))
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+
+		owner, err := sat.AddUser(ctx, console.CreateUser{FullName: "Owner", Email: "owner@test.test"}, 1)
+		require.NoError(t, err)
+		member, err := sat.AddUser(ctx, console.CreateUser{FullName: "Member", Email: "member@test.test"}, 1)
+		require.NoError(t, err)
+		outsider, err := sat.AddUser(ctx, console.CreateUser{FullName: "Outsider", Email: "outsider@test.test"}, 1)
+		require.NoError(t, err)
+
+		project, err := sat.AddProject(ctx, owner.ID, "encrypted")
+		require.NoError(t, err)
+		_, err = sat.DB.Console().ProjectMembers().Insert(ctx, member.ID, project.ID)
+		require.NoError(t, err)
+
+		request := func(user *console.User, method, path string) (*http.Response, []byte) {
+			tokenInfo, err := sat.API.Console.Service.Token(ctx, console.AuthUser{Email: user.Email, Password: user.FullName})
+			require.NoError(t, err)
+
+			url := "http://" + sat.API.Console.Listener.Addr().String() + "/api/v0/projects/" + project.ID.String() + path
+			req, err := http.NewRequestWithContext(ctx, method, url, nil)
+			require.NoError(t, err)
+			req.AddCookie(&http.Cookie{Name: "_tokenKey", Path: "/", Value: tokenInfo.Token.String()})
+
+			resp, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer func() { require.NoError(t, resp.Body.Close()) }()
+
+			data, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+			return resp, data
+		}
+
+		// the key can't be read before the mode is enabled.
+		resp, _ := request(member, http.MethodGet, "/encryption-key")
+		require.Equal(t, http.StatusConflict, resp.StatusCode)
+
+		// only the owner can enable the mode.
+		resp, _ = request(member, http.MethodPost, "/server-side-encryption")
+		require.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+		resp, _ = request(outsider, http.MethodPost, "/server-side-encryption")
+		require.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+
+		resp, body := request(owner, http.MethodPost, "/server-side-encryption")
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		var mode console.ServerSideEncryption
+		require.NoError(t, json.Unmarshal(body, &mode))
+		require.True(t, mode.Enabled)
+
+		// members can read the mode and the key, others can't.
+		resp, body = request(member, http.MethodGet, "/server-side-encryption")
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		require.NoError(t, json.Unmarshal(body, &mode))
+		require.True(t, mode.Enabled)
+
+		resp, body = request(member, http.MethodGet, "/encryption-key")
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		require.Equal(t, "no-store", resp.Header.Get("Cache-Control"))
+		var key struct {
+			Passphrase string `json:"passphrase"`
+		}
+		require.NoError(t, json.Unmarshal(body, &key))
+		require.NotEmpty(t, key.Passphrase)
+
+		resp, _ = request(outsider, http.MethodGet, "/server-side-encryption")
+		require.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+		resp, _ = request(outsider, http.MethodGet, "/encryption-key")
+		require.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	})
+}
@@ -16,6 +16,7 @@ import (
 	"storj.io/common/uuid"
 	"storj.io/storj/private/web"
 	"storj.io/storj/satellite/accounting"
+	"storj.io/storj/satellite/accounting/projectops"
 	"storj.io/storj/satellite/console"
 )
 
@@ -154,6 +155,56 @@ func (ul *UsageLimits) DailyUsage(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// ProjectOperations returns the uploads, downloads, listings and deletions
+// of the project per hour between the from and to unix timestamps.
+func (ul *UsageLimits) ProjectOperations(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	idParam, ok := mux.Vars(r)["id"]
+	if !ok {
+		ul.serveJSONError(w, http.StatusBadRequest, errs.New("missing project id route param"))
+		return
+	}
+	projectID, err := uuid.FromString(idParam)
+	if err != nil {
+		ul.serveJSONError(w, http.StatusBadRequest, errs.New("invalid project id: %v", err))
+		return
+	}
+
+	sinceStamp, err := strconv.ParseInt(r.URL.Query().Get("from"), 10, 64)
+	if err != nil {
+		ul.serveJSONError(w, http.StatusBadRequest, err)
+		return
+	}
+	beforeStamp, err := strconv.ParseInt(r.URL.Query().Get("to"), 10, 64)
+	if err != nil {
+		ul.serveJSONError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	operations, err := ul.service.GetProjectOperations(ctx, projectID, time.Unix(sinceStamp, 0), time.Unix(beforeStamp, 0))
+	if err != nil {
+		switch {
+		case console.ErrUnauthorized.Has(err), console.ErrNoMembership.Has(err):
+			ul.serveJSONError(w, http.StatusUnauthorized, err)
+		case projectops.Error.Has(err):
+			ul.serveJSONError(w, http.StatusBadRequest, err)
+		default:
+			ul.serveJSONError(w, http.StatusInternalServerError, err)
+		}
+		return
+	}
+
+	err = json.NewEncoder(w).Encode(operations)
+	if err != nil {
+		ul.log.Error("error encoding project operations", zap.Error(ErrUsageLimitsAPI.Wrap(err)))
+	}
+}
+
 // serveJSONError writes JSON error to response output stream.
 func (ul *UsageLimits) serveJSONError(w http.ResponseWriter, status int, err error) {
 	web.ServeJSONError(ul.log, w, status, err)
@@ -60,16 +60,22 @@ func Test_TotalUsageLimits(t *testing.T) {
 		require.NoError(t, err)
 		err = sat.DB.ProjectAccounting().UpdateProjectBandwidthLimit(ctx, project0.ID, expectedLimit)
 		require.NoError(t, err)
+		err = sat.DB.ProjectAccounting().UpdateProjectSegmentLimit(ctx, project0.ID, expectedLimit)
+		require.NoError(t, err)
 
 		err = sat.DB.ProjectAccounting().UpdateProjectUsageLimit(ctx, project1.ID, expectedLimit)
 		require.NoError(t, err)
 		err = sat.DB.ProjectAccounting().UpdateProjectBandwidthLimit(ctx, project1.ID, expectedLimit)
 		require.NoError(t, err)
+		err = sat.DB.ProjectAccounting().UpdateProjectSegmentLimit(ctx, project1.ID, expectedLimit)
+		require.NoError(t, err)
 
 		err = sat.DB.ProjectAccounting().UpdateProjectUsageLimit(ctx, project2.ID, expectedLimit)
 		require.NoError(t, err)
 		err = sat.DB.ProjectAccounting().UpdateProjectBandwidthLimit(ctx, project2.ID, expectedLimit)
 		require.NoError(t, err)
+		err = sat.DB.ProjectAccounting().UpdateProjectSegmentLimit(ctx, project2.ID, expectedLimit)
+		require.NoError(t, err)
 
 		// we are using full name as a password
 		tokenInfo, err := sat.API.Console.Service.Token(ctx, console.AuthUser{Email: user.Email, Password: user.FullName})
@@ -111,6 +117,8 @@ func Test_TotalUsageLimits(t *testing.T) {
 		require.Equal(t, int64(0), output.StorageUsed)
 		require.Equal(t, int64(expectedLimit*3), output.BandwidthLimit)
 		require.Equal(t, int64(expectedLimit*3), output.StorageLimit)
+		require.Equal(t, int64(0), output.SegmentUsed)
+		require.Equal(t, int64(expectedLimit*3), output.SegmentLimit)
 
 		defer func() {
 			err = result.Body.Close()
@@ -8,7 +8,6 @@ import (
 	"go.uber.org/zap"
 
 	"storj.io/common/uuid"
-	"storj.io/storj/private/post"
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/mailservice"
 )
@@ -24,8 +23,6 @@ const (
 	// UpdateProjectMutation is a mutation name for project name and description updating.
 	UpdateProjectMutation = "updateProject"
 
-	// AddProjectMembersMutation is a mutation name for adding new project members.
-	AddProjectMembersMutation = "addProjectMembers"
 	// DeleteProjectMembersMutation is a mutation name for deleting project members.
 	DeleteProjectMembersMutation = "deleteProjectMembers"
 
@@ -127,73 +124,6 @@ func rootMutation(log *zap.Logger, service *console.Service, mailService *mailse
 					return project, nil
 				},
 			},
-			// add user as member of given project
-			AddProjectMembersMutation: &graphql.Field{
-				Type: types.project,
-				Args: graphql.FieldConfigArgument{
-					FieldProjectID: &graphql.ArgumentConfig{
-						Type: graphql.NewNonNull(graphql.String),
-					},
-					FieldEmail: &graphql.ArgumentConfig{
-						Type: graphql.NewNonNull(graphql.NewList(graphql.String)),
-					},
-				},
-				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
-					pID, _ := p.Args[FieldProjectID].(string)
-					emails, _ := p.Args[FieldEmail].([]interface{})
-
-					projectID, err := uuid.FromString(pID)
-					if err != nil {
-						return nil, err
-					}
-
-					var userEmails []string
-					for _, email := range emails {
-						userEmails = append(userEmails, email.(string))
-					}
-
-					project, err := service.GetProject(p.Context, projectID)
-					if err != nil {
-						return nil, err
-					}
-
-					users, err := service.AddProjectMembers(p.Context, projectID, userEmails)
-					if err != nil {
-						return nil, err
-					}
-
-					rootObject := p.Info.RootValue.(map[string]interface{})
-					origin := rootObject["origin"].(string)
-					signIn := origin + rootObject[SignInPath].(string)
-
-					for _, user := range users {
-						userName := user.ShortName
-						if user.ShortName == "" {
-							userName = user.FullName
-						}
-
-						contactInfoURL := rootObject[ContactInfoURL].(string)
-						letUsKnowURL := rootObject[LetUsKnowURL].(string)
-						termsAndConditionsURL := rootObject[TermsAndConditionsURL].(string)
-
-						mailService.SendRenderedAsync(
-							p.Context,
-							[]post.Address{{Address: user.Email, Name: userName}},
-							&console.ProjectInvitationEmail{
-								Origin:                origin,
-								UserName:              userName,
-								ProjectName:           project.Name,
-								SignInLink:            signIn,
-								LetUsKnowURL:          letUsKnowURL,
-								TermsAndConditionsURL: termsAndConditionsURL,
-								ContactInfoURL:        contactInfoURL,
-							},
-						)
-					}
-
-					return project, nil
-				},
-			},
 			// delete user membership for given project
 			DeleteProjectMembersMutation: &graphql.Field{
 				Type: types.project,
@@ -101,7 +101,8 @@ func TestGraphqlMutation(t *testing.T) {
 			db.ProjectAccounting(),
 			prices,
 			priceOverrides,
-			pc.BonusRate)
+			pc.BonusRate,
+			nil)
 		require.NoError(t, err)
 
 		service, err := console.NewService(
@@ -111,6 +112,8 @@ func TestGraphqlMutation(t *testing.T) {
 			db.ProjectAccounting(),
 			projectUsage,
 			sat.API.Buckets.Service,
+			sat.Metabase.DB,
+			db.Revocation(),
 			partnersService,
 			paymentsService.Accounts(),
 			// TODO: do we need a payment deposit wallet here?
@@ -286,26 +289,26 @@ func TestGraphqlMutation(t *testing.T) {
 			user2.Email = "u2@mail.test"
 		})
 
-		t.Run("Add project members mutation", func(t *testing.T) {
+		t.Run("Add project members mutation is removed", func(t *testing.T) {
 			query := fmt.Sprintf(
-				"mutation {addProjectMembers(projectID:\"%s\",email:[\"%s\",\"%s\"]){id,name,members(cursor: { limit: 50, search: \"\", page: 1, order: 1, orderDirection: 2 }){projectMembers{joinedAt}}}}",
+				"mutation {addProjectMembers(projectID:\"%s\",email:[\"%s\"]){id}}",
 				project.ID.String(),
 				user1.Email,
-				user2.Email,
 			)
 
-			result, err := testQuery(t, query)
-			require.NoError(t, err)
-
-			data := result.(map[string]interface{})
-			proj := data[consoleql.AddProjectMembersMutation].(map[string]interface{})
-
-			members := proj[consoleql.FieldMembers].(map[string]interface{})
-			projectMembers := members[consoleql.FieldProjectMembers].([]interface{})
+			result := graphql.Do(graphql.Params{
+				Schema:        schema,
+				Context:       userCtx,
+				RequestString: query,
+				RootObject:    rootObject,
+			})
+			require.True(t, result.HasErrors())
 
-			assert.Equal(t, project.ID.String(), proj[consoleql.FieldID])
-			assert.Equal(t, project.Name, proj[consoleql.FieldName])
-			assert.Equal(t, 3, len(projectMembers))
+			// members are only added by accepting an invitation.
+			for _, user := range []*console.User{user1, user2} {
+				_, err := db.Console().ProjectMembers().Insert(ctx, user.ID, project.ID)
+				require.NoError(t, err)
+			}
 		})
 
 		t.Run("Delete project members mutation", func(t *testing.T) {
@@ -85,7 +85,8 @@ func TestGraphqlQuery(t *testing.T) {
 			db.ProjectAccounting(),
 			prices,
 			priceOverrides,
-			pc.BonusRate)
+			pc.BonusRate,
+			nil)
 		require.NoError(t, err)
 
 		service, err := console.NewService(
@@ -95,6 +96,8 @@ func TestGraphqlQuery(t *testing.T) {
 			db.ProjectAccounting(),
 			projectUsage,
 			sat.API.Buckets.Service,
+			sat.Metabase.DB,
+			db.Revocation(),
 			partnersService,
 			paymentsService.Accounts(),
 			// TODO: do we need a payment deposit wallet here?
@@ -266,12 +269,10 @@ func TestGraphqlQuery(t *testing.T) {
 			user2.Email = "muu2@mail.test"
 		})
 
-		users, err := service.AddProjectMembers(userCtx, createdProject.ID, []string{
-			user1.Email,
-			user2.Email,
-		})
-		require.NoError(t, err)
-		assert.Equal(t, 2, len(users))
+		for _, user := range []*console.User{user1, user2} {
+			_, err = db.Console().ProjectMembers().Insert(ctx, user.ID, createdProject.ID)
+			require.NoError(t, err)
+		}
 
 		t.Run("Project query team members", func(t *testing.T) {
 			query := fmt.Sprintf(
@@ -509,7 +509,7 @@ func TestProjects(t *testing.T) {
 			require.Equal(t, http.StatusOK, resp.StatusCode)
 		}
 
-		{ // Post_AddUserToProject
+		{ // Post_AddUserToProjectIsRemoved
 			resp, body := test.request(http.MethodPost, "/graphql",
 				test.toJSON(map[string]interface{}{
 					"variables": map[string]interface{}{
@@ -523,8 +523,14 @@ func TestProjects(t *testing.T) {
 								__typename
 							}
 						}`}))
-			require.Contains(t, body, "addProjectMembers")
+			require.Contains(t, body, `Cannot query field \"addProjectMembers\"`)
 			require.Equal(t, http.StatusOK, resp.StatusCode)
+
+			// members are only added by accepting an invitation.
+			member, err := planet.Satellites[0].DB.Console().Users().GetByEmail(ctx, user2.email)
+			require.NoError(t, err)
+			_, err = planet.Satellites[0].DB.Console().ProjectMembers().Insert(ctx, member.ID, planet.Uplinks[0].Projects[0].ID)
+			require.NoError(t, err)
 		}
 
 		{ // Post_RemoveUserFromProject
@@ -545,43 +551,6 @@ func TestProjects(t *testing.T) {
 			require.Equal(t, http.StatusOK, resp.StatusCode)
 		}
 
-		{ // Post_AddMultipleUsersToProjectWhere1UserIsInvalid
-			resp, body := test.request(http.MethodPost, "/graphql",
-				test.toJSON(map[string]interface{}{
-					"variables": map[string]interface{}{
-						"projectId": test.defaultProjectID(),
-						"emails":    []string{user2.email, "invalid@mail.test"},
-					},
-					"query": `
-						mutation ($projectId: String!, $emails: [String!]!) {
-							addProjectMembers(projectID: $projectId, email: $emails) {
-								id
-								__typename
-							}
-						}`}))
-			require.Contains(t, body, "addProjectMembers")
-			require.Equal(t, http.StatusOK, resp.StatusCode)
-		}
-
-		{ // Post_AddMultipleUsersToProjectWhereUserIsAlreadyAMember
-			resp, body := test.request(http.MethodPost, "/graphql",
-				test.toJSON(map[string]interface{}{
-					"variables": map[string]interface{}{
-						"projectId": test.defaultProjectID(),
-						"emails":    []string{user2.email, user.email},
-					},
-					"query": `
-						mutation ($projectId: String!, $emails: [String!]!) {
-							addProjectMembers(projectID: $projectId, email: $emails) {
-								id
-								__typename
-							}
-						}`}))
-			require.Contains(t, body, "error")
-			// TODO: this should return a better error
-			require.Equal(t, http.StatusInternalServerError, resp.StatusCode)
-		}
-
 		{ // Post_ProjectRenameInvalid
 			resp, body := test.request(http.MethodPost, "/graphql",
 				test.toJSON(map[string]interface{}{
@@ -722,25 +691,6 @@ func TestWrongUser(t *testing.T) {
 			require.Equal(t, http.StatusInternalServerError, resp.StatusCode)
 		}
 
-		{ // Post_AddUserToProject
-			resp, body := test.request(http.MethodPost, "/graphql",
-				test.toJSON(map[string]interface{}{
-					"variables": map[string]interface{}{
-						"projectId": test.defaultProjectID(),
-						"emails":    []string{user2.email},
-					},
-					"query": `
-						mutation ($projectId: String!, $emails: [String!]!) {
-							addProjectMembers(projectID: $projectId, email: $emails) {
-								id
-								__typename
-							}
-						}`}))
-			require.Contains(t, body, "not authorized")
-			// TODO: wrong error code
-			require.Equal(t, http.StatusInternalServerError, resp.StatusCode)
-		}
-
 		{ // Post_RemoveUserFromProject
 			resp, body := test.request(http.MethodPost, "/graphql",
 				test.toJSON(map[string]interface{}{
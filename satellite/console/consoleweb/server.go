@@ -36,10 +36,14 @@ import (
 	"storj.io/storj/private/web"
 	"storj.io/storj/satellite/abtesting"
 	"storj.io/storj/satellite/analytics"
+	"storj.io/storj/satellite/bucketevents"
+	"storj.io/storj/satellite/bucketpolicy"
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/console/consoleweb/consoleapi"
 	"storj.io/storj/satellite/console/consoleweb/consoleql"
 	"storj.io/storj/satellite/console/consoleweb/consolewebauth"
+	"storj.io/storj/satellite/console/objectbrowser"
+	"storj.io/storj/satellite/integrity"
 	"storj.io/storj/satellite/mailservice"
 	"storj.io/storj/satellite/oidc"
 	"storj.io/storj/satellite/payments/paymentsconfig"
@@ -175,6 +179,10 @@ func (a *apiAuth) IsAuthenticated(ctx context.Context, r *http.Request, isCookie
 		}
 	}
 
+	if console.GetImpersonation(ctx) != nil && !allowedWhileImpersonating(r) {
+		return nil, console.ErrReadOnlySession.New("changes can't be made while impersonating")
+	}
+
 	return ctx, nil
 }
 
@@ -205,7 +213,7 @@ func (a *apiAuth) RemoveAuthCookie(w http.ResponseWriter) {
 }
 
 // NewServer creates new instance of console server.
-func NewServer(logger *zap.Logger, config Config, service *console.Service, oidcService *oidc.Service, mailService *mailservice.Service, partners *rewards.PartnersService, analytics *analytics.Service, abTesting *abtesting.Service, accountFreezeService *console.AccountFreezeService, listener net.Listener, stripePublicKey string, usagePrice paymentsconfig.ProjectUsagePrice, nodeURL storj.NodeURL) *Server {
+func NewServer(logger *zap.Logger, config Config, service *console.Service, oidcService *oidc.Service, mailService *mailservice.Service, partners *rewards.PartnersService, analytics *analytics.Service, abTesting *abtesting.Service, accountFreezeService *console.AccountFreezeService, integrityService *integrity.Service, bucketEventsService *bucketevents.Service, bucketPolicyService *bucketpolicy.Service, objectBrowserService *objectbrowser.Service, listener net.Listener, stripePublicKey string, usagePrice paymentsconfig.ProjectUsagePrice, nodeURL storj.NodeURL) *Server {
 	server := Server{
 		log:               logger,
 		config:            config,
@@ -252,11 +260,71 @@ func NewServer(logger *zap.Logger, config Config, service *console.Service, oidc
 		consoleapi.NewUserManagement(logger, mon, server.service, router, &apiAuth{&server})
 	}
 
-	projectsController := consoleapi.NewProjects(logger, service)
+	projectsController := consoleapi.NewProjects(logger, service, mailService, server.config.ExternalAddress, config.LetUsKnowURL, config.TermsAndConditionsURL, config.ContactInfoURL)
+	router.Handle(
+		"/api/v0/projects/invitation",
+		server.ipRateLimiter.Limit(http.HandlerFunc(projectsController.GetInvitationByToken)),
+	).Methods(http.MethodGet)
+	router.Handle(
+		"/api/v0/projects/invitations",
+		server.withAuth(http.HandlerFunc(projectsController.GetUserInvitations)),
+	).Methods(http.MethodGet)
+	router.Handle(
+		"/api/v0/projects/invitations/{id}/respond",
+		server.withAuth(http.HandlerFunc(projectsController.RespondToInvitation)),
+	).Methods(http.MethodPost)
+	router.Handle(
+		"/api/v0/projects/{id}/invite",
+		server.withAuth(http.HandlerFunc(projectsController.InviteUsers)),
+	).Methods(http.MethodPost)
+	router.Handle(
+		"/api/v0/projects/{id}/reinvite",
+		server.withAuth(http.HandlerFunc(projectsController.ReinviteUsers)),
+	).Methods(http.MethodPost)
+	router.Handle(
+		"/api/v0/projects/{id}/invites",
+		server.withAuth(http.HandlerFunc(projectsController.GetInvitations)),
+	).Methods(http.MethodGet)
 	router.Handle(
 		"/api/v0/projects/{id}/salt",
 		server.withAuth(http.HandlerFunc(projectsController.GetSalt)),
 	).Methods(http.MethodGet)
+	router.Handle(
+		"/api/v0/projects/{id}/server-side-encryption",
+		server.withAuth(http.HandlerFunc(projectsController.GetServerSideEncryption)),
+	).Methods(http.MethodGet)
+	router.Handle(
+		"/api/v0/projects/{id}/server-side-encryption",
+		server.withAuth(http.HandlerFunc(projectsController.EnableServerSideEncryption)),
+	).Methods(http.MethodPost)
+	router.Handle(
+		"/api/v0/projects/{id}/encryption-key",
+		server.withAuth(http.HandlerFunc(projectsController.GetEncryptionKey)),
+	).Methods(http.MethodGet)
+	router.Handle(
+		"/api/v0/projects/{id}",
+		server.withAuth(http.HandlerFunc(projectsController.Delete)),
+	).Methods(http.MethodDelete)
+	router.Handle(
+		"/api/v0/projects/{id}/deletion",
+		server.withAuth(http.HandlerFunc(projectsController.GetDeletion)),
+	).Methods(http.MethodGet)
+	router.Handle(
+		"/api/v0/projects/{id}/limit-requests",
+		server.withAuth(http.HandlerFunc(projectsController.RequestLimitIncrease)),
+	).Methods(http.MethodPost)
+	router.Handle(
+		"/api/v0/projects/{id}/limit-requests",
+		server.withAuth(http.HandlerFunc(projectsController.GetLimitRequests)),
+	).Methods(http.MethodGet)
+	router.Handle(
+		"/api/v0/projects/{id}/network-rules",
+		server.withAuth(http.HandlerFunc(projectsController.GetNetworkRules)),
+	).Methods(http.MethodGet)
+	router.Handle(
+		"/api/v0/projects/{id}/network-rules",
+		server.withAuth(http.HandlerFunc(projectsController.SetNetworkRules)),
+	).Methods(http.MethodPut)
 
 	router.HandleFunc("/registrationToken/", server.createRegistrationTokenHandler)
 	router.HandleFunc("/robots.txt", server.seoHandler)
@@ -276,6 +344,10 @@ func NewServer(logger *zap.Logger, config Config, service *console.Service, oidc
 		"/api/v0/projects/{id}/daily-usage",
 		server.withAuth(http.HandlerFunc(usageLimitsController.DailyUsage)),
 	).Methods(http.MethodGet)
+	router.Handle(
+		"/api/v0/projects/{id}/operations",
+		server.withAuth(http.HandlerFunc(usageLimitsController.ProjectOperations)),
+	).Methods(http.MethodGet)
 
 	authController := consoleapi.NewAuth(logger, service, accountFreezeService, mailService, server.cookieAuth, partners, server.analytics, config.SatelliteName, server.config.ExternalAddress, config.LetUsKnowURL, config.TermsAndConditionsURL, config.ContactInfoURL, config.GeneralRequestURL)
 	authRouter := router.PathPrefix("/api/v0/auth").Subrouter()
@@ -285,6 +357,10 @@ func NewServer(logger *zap.Logger, config Config, service *console.Service, oidc
 	authRouter.Handle("/account/change-password", server.withAuth(http.HandlerFunc(authController.ChangePassword))).Methods(http.MethodPost)
 	authRouter.Handle("/account/freezestatus", server.withAuth(http.HandlerFunc(authController.IsAccountFrozen))).Methods(http.MethodGet)
 	authRouter.Handle("/account/delete", server.withAuth(http.HandlerFunc(authController.DeleteAccount))).Methods(http.MethodPost)
+	authRouter.Handle("/account/support-access", server.withAuth(http.HandlerFunc(authController.GetSupportAccess))).Methods(http.MethodGet)
+	authRouter.Handle("/account/support-access", server.withAuth(http.HandlerFunc(authController.GrantSupportAccess))).Methods(http.MethodPost)
+	authRouter.Handle("/account/support-access", server.withAuth(http.HandlerFunc(authController.RevokeSupportAccess))).Methods(http.MethodDelete)
+	authRouter.Handle("/account/support-access/sessions", server.withAuth(http.HandlerFunc(authController.GetImpersonationSessions))).Methods(http.MethodGet)
 	authRouter.Handle("/mfa/enable", server.withAuth(http.HandlerFunc(authController.EnableUserMFA))).Methods(http.MethodPost)
 	authRouter.Handle("/mfa/disable", server.withAuth(http.HandlerFunc(authController.DisableUserMFA))).Methods(http.MethodPost)
 	authRouter.Handle("/mfa/generate-secret-key", server.withAuth(http.HandlerFunc(authController.GenerateMFASecretKey))).Methods(http.MethodPost)
@@ -312,6 +388,7 @@ func NewServer(logger *zap.Logger, config Config, service *console.Service, oidc
 	paymentsRouter.HandleFunc("/cards", paymentController.ListCreditCards).Methods(http.MethodGet)
 	paymentsRouter.HandleFunc("/cards/{cardId}", paymentController.RemoveCreditCard).Methods(http.MethodDelete)
 	paymentsRouter.HandleFunc("/account/charges", paymentController.ProjectsCharges).Methods(http.MethodGet)
+	paymentsRouter.HandleFunc("/account/estimated-cost", paymentController.EstimateCost).Methods(http.MethodGet)
 	paymentsRouter.HandleFunc("/account/balance", paymentController.AccountBalance).Methods(http.MethodGet)
 	paymentsRouter.HandleFunc("/account", paymentController.SetupAccount).Methods(http.MethodPost)
 	paymentsRouter.HandleFunc("/wallet", paymentController.GetWallet).Methods(http.MethodGet)
@@ -325,6 +402,34 @@ func NewServer(logger *zap.Logger, config Config, service *console.Service, oidc
 	bucketsRouter := router.PathPrefix("/api/v0/buckets").Subrouter()
 	bucketsRouter.Use(server.withAuth)
 	bucketsRouter.HandleFunc("/bucket-names", bucketsController.AllBucketNames).Methods(http.MethodGet)
+	bucketsRouter.HandleFunc("/durability-reports", bucketsController.DurabilityReports).Methods(http.MethodGet)
+	bucketsRouter.HandleFunc("/durability-reports/download", bucketsController.DownloadDurabilityReport).Methods(http.MethodGet)
+
+	if integrityService != nil {
+		integrityController := consoleapi.NewIntegrity(logger, service, integrityService)
+		bucketsRouter.Handle("/integrity", server.userIDRateLimiter.Limit(http.HandlerFunc(integrityController.CheckObject))).Methods(http.MethodGet)
+	}
+
+	if bucketEventsService.Enabled() {
+		notificationsController := consoleapi.NewBucketNotifications(logger, service, bucketEventsService)
+		bucketsRouter.HandleFunc("/notifications", notificationsController.Get).Methods(http.MethodGet)
+		bucketsRouter.Handle("/notifications", server.userIDRateLimiter.Limit(http.HandlerFunc(notificationsController.Set))).Methods(http.MethodPut)
+		bucketsRouter.HandleFunc("/notifications", notificationsController.Delete).Methods(http.MethodDelete)
+	}
+
+	if bucketPolicyService.Enabled() {
+		bucketPolicyController := consoleapi.NewBucketPolicy(logger, service, bucketPolicyService)
+		bucketsRouter.HandleFunc("/policy", bucketPolicyController.Get).Methods(http.MethodGet)
+		bucketsRouter.Handle("/policy", server.userIDRateLimiter.Limit(http.HandlerFunc(bucketPolicyController.Set))).Methods(http.MethodPut)
+		bucketsRouter.HandleFunc("/policy", bucketPolicyController.Delete).Methods(http.MethodDelete)
+	}
+
+	if objectBrowserService.Enabled() {
+		objectBrowserController := consoleapi.NewObjectBrowser(logger, service, objectBrowserService)
+		bucketsRouter.Handle("/objects", server.userIDRateLimiter.Limit(http.HandlerFunc(objectBrowserController.List))).Methods(http.MethodGet)
+		bucketsRouter.Handle("/objects/metadata", server.userIDRateLimiter.Limit(http.HandlerFunc(objectBrowserController.Metadata))).Methods(http.MethodGet)
+		bucketsRouter.Handle("/objects/preview", server.userIDRateLimiter.Limit(http.HandlerFunc(objectBrowserController.Preview))).Methods(http.MethodGet)
+	}
 
 	apiKeysController := consoleapi.NewAPIKeys(logger, service)
 	apiKeysRouter := router.PathPrefix("/api/v0/api-keys").Subrouter()
@@ -562,10 +667,43 @@ func (server *Server) withAuth(handler http.Handler) http.Handler {
 		}
 		ctx = newCtx
 
+		if console.GetImpersonation(ctx) != nil && !allowedWhileImpersonating(r) {
+			web.ServeJSONError(server.log, w, http.StatusForbidden, console.ErrReadOnlySession.New("changes can't be made while impersonating"))
+			return
+		}
+
 		handler.ServeHTTP(w, r.Clone(ctx))
 	})
 }
 
+// allowedWhileImpersonating returns whether the request may be served for a
+// read-only impersonation session. GraphQL mutations are rejected by the
+// GraphQL handler itself.
+func allowedWhileImpersonating(r *http.Request) bool {
+	if deniedWhileImpersonating(r.URL.Path) {
+		return false
+	}
+	switch r.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	}
+	switch r.URL.Path {
+	case "/api/v0/graphql", "/api/v0/auth/logout":
+		return true
+	}
+	return false
+}
+
+// deniedWhileImpersonating returns whether path belongs to a read endpoint
+// that exposes secrets or mints credentials, which an impersonation session
+// must not be able to use even though the request doesn't change any state.
+func deniedWhileImpersonating(path string) bool {
+	if strings.HasPrefix(path, "/api/v0/projects/") && strings.HasSuffix(path, "/encryption-key") {
+		return true
+	}
+	return path == "/api/v0/buckets/objects" || strings.HasPrefix(path, "/api/v0/buckets/objects/")
+}
+
 // withRequest ensures the http request itself is reachable from the context.
 func (server *Server) withRequest(handler http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -783,6 +921,11 @@ func (server *Server) graphqlHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if console.GetImpersonation(ctx) != nil && isGraphqlMutation(query.Query) {
+		handleError(http.StatusForbidden, console.ErrReadOnlySession.New("changes can't be made while impersonating"))
+		return
+	}
+
 	rootObject := make(map[string]interface{})
 
 	rootObject["origin"] = server.config.ExternalAddress
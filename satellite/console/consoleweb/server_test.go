@@ -6,7 +6,9 @@ package consoleweb_test
 import (
 	"bytes"
 	"fmt"
+	"io"
 	"net/http"
+	"strings"
 	"testing"
 	"time"
 
@@ -147,3 +149,74 @@ func TestUserIDRateLimiter(t *testing.T) {
 		require.Equal(t, http.StatusTooManyRequests, applyCouponStatus(firstToken))
 	})
 }
+
+func TestGeneratedAPIImpersonation(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Console.GeneratedAPIEnabled = true
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		service := sat.API.Console.Service
+		project := planet.Uplinks[0].Projects[0]
+
+		userCtx, err := sat.UserContext(ctx, project.Owner.ID)
+		require.NoError(t, err)
+
+		apiKey, _, err := service.CreateAPIKey(userCtx, project.ID, "impersonation")
+		require.NoError(t, err)
+
+		_, err = service.GrantSupportAccess(userCtx, time.Hour)
+		require.NoError(t, err)
+		tokenInfo, _, err := sat.Admin.Impersonation.Service.Impersonate(ctx, console.ImpersonationRequest{
+			UserID: project.Owner.ID,
+			Admin:  "admin@mail.test",
+			Reason: "ticket 1",
+		})
+		require.NoError(t, err)
+
+		status := func(method, path string, body io.Reader) int {
+			urlLink := "http://" + sat.API.Console.Listener.Addr().String() + "/api/v0" + path
+
+			req, err := http.NewRequestWithContext(ctx, method, urlLink, body)
+			require.NoError(t, err)
+
+			req.AddCookie(&http.Cookie{
+				Name:    "_tokenKey",
+				Path:    "/",
+				Value:   tokenInfo.Token.String(),
+				Expires: time.Now().AddDate(0, 0, 1),
+			})
+
+			result, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			require.NoError(t, result.Body.Close())
+
+			return result.StatusCode
+		}
+
+		// the impersonation session can read.
+		require.Equal(t, http.StatusOK, status(http.MethodGet, "/projects/", nil))
+
+		// but it can't make changes or create credentials.
+		projectInfo := `{"name":"changed","description":"changed"}`
+		require.Equal(t, http.StatusUnauthorized, status(http.MethodPost, "/projects/create", strings.NewReader(projectInfo)))
+		require.Equal(t, http.StatusUnauthorized, status(http.MethodPatch, "/projects/update/"+project.ID.String(), strings.NewReader(projectInfo)))
+		require.Equal(t, http.StatusUnauthorized, status(http.MethodDelete, "/projects/delete/"+project.ID.String(), nil))
+		require.Equal(t, http.StatusUnauthorized, status(http.MethodPost, "/apikeys/create", strings.NewReader(`{"projectID":"`+project.ID.String()+`","name":"admin"}`)))
+		require.Equal(t, http.StatusUnauthorized, status(http.MethodDelete, "/apikeys/delete/"+apiKey.ID.String(), nil))
+
+		projects, err := service.GetUsersProjects(userCtx)
+		require.NoError(t, err)
+		require.Len(t, projects, 1)
+		require.NotEqual(t, "changed", projects[0].Name)
+
+		_, err = sat.DB.Console().APIKeys().Get(ctx, apiKey.ID)
+		require.NoError(t, err)
+		_, err = sat.DB.Console().APIKeys().GetByNameAndProjectID(ctx, "admin", project.ID)
+		require.Error(t, err)
+	})
+}
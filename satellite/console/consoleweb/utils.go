@@ -12,6 +12,8 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/parser"
 	"github.com/zeebo/errs"
 
 	"storj.io/common/memory"
@@ -53,6 +55,22 @@ func getQuery(w http.ResponseWriter, req *http.Request) (query graphqlJSON, err
 	}
 }
 
+// isGraphqlMutation returns whether the graphql query contains a mutation.
+// Queries, which can't be parsed, aren't executed, so they aren't mutations.
+func isGraphqlMutation(query string) bool {
+	document, err := parser.Parse(parser.ParseParams{Source: query})
+	if err != nil {
+		return false
+	}
+	for _, definition := range document.Definitions {
+		operation, ok := definition.(*ast.OperationDefinition)
+		if ok && operation.Operation == ast.OperationTypeMutation {
+			return true
+		}
+	}
+	return false
+}
+
 // queryPOST retrieves graphql query from POST request.
 func queryPOST(w http.ResponseWriter, req *http.Request) (query graphqlJSON, err error) {
 	limitedReader := http.MaxBytesReader(w, req.Body, ContentLengthLimit.Int64())
@@ -302,3 +302,71 @@ func TestStripPort(t *testing.T) {
 		})
 	}
 }
+
+func TestIsGraphqlMutation(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		query    string
+		mutation bool
+	}{
+		{
+			desc:  "query",
+			query: `query($id: String!) { project(id: $id) { name } }`,
+		},
+		{
+			desc:  "shorthand query",
+			query: `{ myProjects { id } }`,
+		},
+		{
+			desc:     "mutation",
+			query:    `mutation($id: String!) { deleteProject(id: $id) { id } }`,
+			mutation: true,
+		},
+		{
+			desc:     "query and mutation",
+			query:    `query A { myProjects { id } } mutation B { deleteProject(id: "x") { id } }`,
+			mutation: true,
+		},
+		{
+			desc:  "invalid",
+			query: `mutation {`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			assert.Equal(t, tc.mutation, isGraphqlMutation(tc.query))
+		})
+	}
+}
+
+func TestAllowedWhileImpersonating(t *testing.T) {
+	testCases := []struct {
+		method  string
+		path    string
+		allowed bool
+	}{
+		{http.MethodGet, "/api/v0/projects/invitations", true},
+		{http.MethodGet, "/api/v0/projects/b7b4a1c0-1c6e-4d2b-9a44-0f7ec2d1e4aa/salt", true},
+		{http.MethodGet, "/api/v0/buckets/bucket-names", true},
+		{http.MethodHead, "/api/v0/auth/account", true},
+		{http.MethodPost, "/api/v0/graphql", true},
+		{http.MethodPost, "/api/v0/auth/logout", true},
+		{http.MethodPatch, "/api/v0/auth/account", false},
+		{http.MethodDelete, "/api/v0/projects/b7b4a1c0-1c6e-4d2b-9a44-0f7ec2d1e4aa", false},
+
+		{http.MethodGet, "/api/v0/projects/b7b4a1c0-1c6e-4d2b-9a44-0f7ec2d1e4aa/encryption-key", false},
+		{http.MethodHead, "/api/v0/projects/b7b4a1c0-1c6e-4d2b-9a44-0f7ec2d1e4aa/encryption-key", false},
+		{http.MethodGet, "/api/v0/buckets/objects", false},
+		{http.MethodGet, "/api/v0/buckets/objects/metadata", false},
+		{http.MethodGet, "/api/v0/buckets/objects/preview", false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.method+" "+tc.path, func(t *testing.T) {
+			r, err := http.NewRequest(tc.method, tc.path, nil)
+			require.NoError(t, err)
+			assert.Equal(t, tc.allowed, allowedWhileImpersonating(r))
+		})
+	}
+}
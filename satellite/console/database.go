@@ -6,6 +6,8 @@ package console
 import (
 	"context"
 
+	"storj.io/storj/satellite/accounting/projectops"
+	"storj.io/storj/satellite/attestation"
 	"storj.io/storj/satellite/console/consoleauth"
 )
 
@@ -19,6 +21,8 @@ type DB interface {
 	Projects() Projects
 	// ProjectMembers is a getter for ProjectMembers repository.
 	ProjectMembers() ProjectMembers
+	// ProjectInvitations is a getter for ProjectInvitations repository.
+	ProjectInvitations() ProjectInvitations
 	// APIKeys is a getter for APIKeys repository.
 	APIKeys() APIKeys
 	// RegistrationTokens is a getter for RegistrationTokens repository.
@@ -29,6 +33,18 @@ type DB interface {
 	WebappSessions() consoleauth.WebappSessions
 	// AccountFreezeEvents is a getter for AccountFreezeEvents repository.
 	AccountFreezeEvents() AccountFreezeEvents
+	// Impersonations is a getter for Impersonations repository.
+	Impersonations() Impersonations
+	// DurabilityReports is a getter for the durability reports repository.
+	DurabilityReports() attestation.DB
+	// ProjectDeletions is a getter for ProjectDeletions repository.
+	ProjectDeletions() ProjectDeletions
+	// LimitRequests is a getter for LimitRequests repository.
+	LimitRequests() LimitRequests
+	// ProjectNetworkRules is a getter for ProjectNetworkRules repository.
+	ProjectNetworkRules() ProjectNetworkRules
+	// ProjectOperations is a getter for the hourly operation counts of projects.
+	ProjectOperations() projectops.DB
 
 	// WithTx is a method for executing transactions with retrying as necessary.
 	WithTx(ctx context.Context, fn func(ctx context.Context, tx DBTx) error) error
@@ -0,0 +1,315 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/console/consoleauth"
+)
+
+var (
+	// ErrImpersonation is the class for errors that occur during creation of impersonation sessions.
+	ErrImpersonation = errs.Class("impersonation")
+
+	// ErrImpersonationConsent occurs when the user hasn't granted support access.
+	ErrImpersonationConsent = errs.Class("support access not granted")
+
+	// ErrReadOnlySession occurs when a read-only impersonation session is used to make changes.
+	ErrReadOnlySession = errs.Class("read-only session")
+)
+
+// Impersonations exposes methods to manage the audit records of impersonation
+// sessions and the support access grants of users.
+//
+// architecture: Database
+type Impersonations interface {
+	// Insert records an impersonation session.
+	Insert(ctx context.Context, session *ImpersonationSession) error
+	// Get returns the impersonation session with the given ID.
+	Get(ctx context.Context, id uuid.UUID) (*ImpersonationSession, error)
+	// GetByUserID returns the impersonation sessions of the user, the most recent first.
+	GetByUserID(ctx context.Context, userID uuid.UUID) ([]ImpersonationSession, error)
+
+	// UpsertSupportAccess grants support access to the account of the user until expiresAt.
+	UpsertSupportAccess(ctx context.Context, grant *SupportAccessGrant) (*SupportAccessGrant, error)
+	// GetSupportAccess returns the support access grant of the user.
+	GetSupportAccess(ctx context.Context, userID uuid.UUID) (*SupportAccessGrant, error)
+	// DeleteSupportAccess removes the support access grant of the user.
+	DeleteSupportAccess(ctx context.Context, userID uuid.UUID) error
+}
+
+// ImpersonationSession is the audit record of a read-only webapp session, which
+// was created for an admin to see the console as the user does.
+type ImpersonationSession struct {
+	ID        uuid.UUID `json:"id"`
+	UserID    uuid.UUID `json:"userID"`
+	Admin     string    `json:"admin"`
+	Reason    string    `json:"reason"`
+	Consented bool      `json:"consented"`
+	CreatedAt time.Time `json:"createdAt"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// SupportAccessGrant is the consent of a user to admins impersonating them
+// until the grant expires.
+type SupportAccessGrant struct {
+	UserID    uuid.UUID `json:"-"`
+	ExpiresAt time.Time `json:"expiresAt"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// ImpersonationConfig contains configurations for the read-only sessions,
+// which admins create to see the console as a user does.
+type ImpersonationConfig struct {
+	SessionDuration       time.Duration `help:"duration an impersonation session is valid for" default:"1h"`
+	RequireConsent        bool          `help:"whether users must grant support access before admins can impersonate them" default:"true"`
+	MaxSupportAccessGrant time.Duration `help:"the longest duration users can grant support access for" default:"168h"`
+}
+
+// impersonationKey is context key for the impersonation session.
+const impersonationKey key = 2
+
+// WithImpersonation creates new context with the impersonation session.
+func WithImpersonation(ctx context.Context, session *ImpersonationSession) context.Context {
+	return context.WithValue(ctx, impersonationKey, session)
+}
+
+// GetImpersonation gets the impersonation session from context, it returns
+// nil when the context isn't authorized by an impersonation session.
+func GetImpersonation(ctx context.Context) *ImpersonationSession {
+	if session, ok := ctx.Value(impersonationKey).(*ImpersonationSession); ok {
+		return session
+	}
+	return nil
+}
+
+// ImpersonationRequest contains the details of the admin, who requests to
+// impersonate a user.
+type ImpersonationRequest struct {
+	UserID    uuid.UUID
+	Admin     string
+	Reason    string
+	IP        string
+	UserAgent string
+}
+
+// ImpersonationService creates the read-only sessions, which admins use to
+// see the console as a user does.
+type ImpersonationService struct {
+	log            *zap.Logger
+	impersonations Impersonations
+	users          Users
+	sessions       consoleauth.WebappSessions
+	tokens         *consoleauth.Service
+	config         ImpersonationConfig
+
+	nowFn func() time.Time
+}
+
+// NewImpersonationService creates a new impersonation service.
+func NewImpersonationService(log *zap.Logger, impersonations Impersonations, users Users, sessions consoleauth.WebappSessions, tokens *consoleauth.Service, config ImpersonationConfig) *ImpersonationService {
+	return &ImpersonationService{
+		log:            log,
+		impersonations: impersonations,
+		users:          users,
+		sessions:       sessions,
+		tokens:         tokens,
+		config:         config,
+
+		nowFn: time.Now,
+	}
+}
+
+// SetNow allows tests to have the service act as if the current time is whatever they want.
+func (s *ImpersonationService) SetNow(nowFn func() time.Time) {
+	s.nowFn = nowFn
+}
+
+// Impersonate creates a read-only session of the user for the admin and
+// records it for audit.
+func (s *ImpersonationService) Impersonate(ctx context.Context, request ImpersonationRequest) (_ *TokenInfo, _ *ImpersonationSession, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if request.Admin == "" {
+		return nil, nil, ErrImpersonation.New("admin is required")
+	}
+	if request.Reason == "" {
+		return nil, nil, ErrImpersonation.New("reason is required")
+	}
+
+	user, err := s.users.Get(ctx, request.UserID)
+	if err != nil {
+		return nil, nil, ErrImpersonation.Wrap(err)
+	}
+	if user.Status != Active {
+		return nil, nil, ErrImpersonation.New("user is not active")
+	}
+
+	now := s.nowFn()
+
+	grant, err := s.impersonations.GetSupportAccess(ctx, user.ID)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, nil, ErrImpersonation.Wrap(err)
+	}
+	consented := grant != nil && now.Before(grant.ExpiresAt)
+	if s.config.RequireConsent && !consented {
+		return nil, nil, ErrImpersonationConsent.New("%s has not granted support access", user.Email)
+	}
+
+	sessionID, err := uuid.New()
+	if err != nil {
+		return nil, nil, ErrImpersonation.Wrap(err)
+	}
+
+	session := &ImpersonationSession{
+		ID:        sessionID,
+		UserID:    user.ID,
+		Admin:     request.Admin,
+		Reason:    request.Reason,
+		Consented: consented,
+		CreatedAt: now,
+		ExpiresAt: now.Add(s.config.SessionDuration),
+	}
+
+	// the audit record is inserted first, so that no session exists without it.
+	err = s.impersonations.Insert(ctx, session)
+	if err != nil {
+		return nil, nil, ErrImpersonation.Wrap(err)
+	}
+
+	_, err = s.sessions.Create(ctx, sessionID, user.ID, request.IP, request.UserAgent, consoleauth.SessionStatusImpersonation, session.ExpiresAt)
+	if err != nil {
+		return nil, nil, ErrImpersonation.Wrap(err)
+	}
+
+	token := consoleauth.Token{Payload: sessionID.Bytes()}
+	token.Signature, err = s.tokens.SignToken(token)
+	if err != nil {
+		return nil, nil, ErrImpersonation.Wrap(errs.Combine(err, s.sessions.DeleteBySessionID(ctx, sessionID)))
+	}
+
+	s.log.Info("impersonation session created",
+		zap.Stringer("sessionID", session.ID),
+		zap.Stringer("userID", user.ID),
+		zap.String("email", user.Email),
+		zap.String("admin", session.Admin),
+		zap.String("reason", session.Reason),
+		zap.Bool("consented", session.Consented),
+		zap.Time("expiresAt", session.ExpiresAt),
+	)
+
+	return &TokenInfo{
+		Token:     token,
+		ExpiresAt: session.ExpiresAt,
+	}, session, nil
+}
+
+// GetSessions returns the impersonation sessions of the user, the most recent first.
+func (s *ImpersonationService) GetSessions(ctx context.Context, userID uuid.UUID) (_ []ImpersonationSession, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	sessions, err := s.impersonations.GetByUserID(ctx, userID)
+	return sessions, ErrImpersonation.Wrap(err)
+}
+
+// GetSupportAccess returns the unexpired support access grant of the user, or
+// nil when support access isn't granted.
+func (s *Service) GetSupportAccess(ctx context.Context) (_ *SupportAccessGrant, err error) {
+	defer mon.Task()(&ctx)(&err)
+	user, err := s.getUserAndAuditLog(ctx, "get support access")
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	grant, err := s.store.Impersonations().GetSupportAccess(ctx, user.ID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, Error.Wrap(err)
+	}
+	if !time.Now().Before(grant.ExpiresAt) {
+		return nil, nil
+	}
+	return grant, nil
+}
+
+// GrantSupportAccess allows admins to impersonate the user for the duration.
+func (s *Service) GrantSupportAccess(ctx context.Context, duration time.Duration) (_ *SupportAccessGrant, err error) {
+	defer mon.Task()(&ctx)(&err)
+	user, err := s.getUserAndAuditLog(ctx, "grant support access", zap.Duration("duration", duration))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	if GetImpersonation(ctx) != nil {
+		return nil, ErrReadOnlySession.New("support access can't be granted while impersonating")
+	}
+
+	if duration <= 0 || duration > s.config.Impersonation.MaxSupportAccessGrant {
+		return nil, ErrValidation.New("support access can be granted for at most %s", s.config.Impersonation.MaxSupportAccessGrant)
+	}
+
+	now := time.Now()
+	grant, err := s.store.Impersonations().UpsertSupportAccess(ctx, &SupportAccessGrant{
+		UserID:    user.ID,
+		ExpiresAt: now.Add(duration),
+		CreatedAt: now,
+	})
+	return grant, Error.Wrap(err)
+}
+
+// RevokeSupportAccess stops admins from impersonating the user. Impersonation
+// sessions, which were already created, are ended.
+func (s *Service) RevokeSupportAccess(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	user, err := s.getUserAndAuditLog(ctx, "revoke support access")
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	if GetImpersonation(ctx) != nil {
+		return ErrReadOnlySession.New("support access can't be revoked while impersonating")
+	}
+
+	err = s.store.Impersonations().DeleteSupportAccess(ctx, user.ID)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	sessions, err := s.store.WebappSessions().GetAllByUserID(ctx, user.ID)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	for _, session := range sessions {
+		if session.Status != consoleauth.SessionStatusImpersonation {
+			continue
+		}
+		if err := s.store.WebappSessions().DeleteBySessionID(ctx, session.ID); err != nil {
+			return Error.Wrap(err)
+		}
+	}
+	return nil
+}
+
+// GetImpersonationSessions returns the impersonation sessions of the user, so
+// that users can see when and why admins accessed their account.
+func (s *Service) GetImpersonationSessions(ctx context.Context) (_ []ImpersonationSession, err error) {
+	defer mon.Task()(&ctx)(&err)
+	user, err := s.getUserAndAuditLog(ctx, "get impersonation sessions")
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	sessions, err := s.store.Impersonations().GetByUserID(ctx, user.ID)
+	return sessions, Error.Wrap(err)
+}
@@ -0,0 +1,98 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite/console"
+)
+
+func TestSupportAccess(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		service := sat.API.Console.Service
+		impersonation := sat.Admin.Impersonation.Service
+		require.NotNil(t, impersonation)
+
+		owner := planet.Uplinks[0].Projects[0].Owner
+		userCtx, err := sat.UserContext(ctx, owner.ID)
+		require.NoError(t, err)
+
+		request := console.ImpersonationRequest{
+			UserID: owner.ID,
+			Admin:  "admin@mail.test",
+			Reason: "ticket 1",
+		}
+
+		grant, err := service.GetSupportAccess(userCtx)
+		require.NoError(t, err)
+		require.Nil(t, grant)
+
+		_, _, err = impersonation.Impersonate(ctx, request)
+		require.True(t, console.ErrImpersonationConsent.Has(err))
+
+		_, err = service.GrantSupportAccess(userCtx, 0)
+		require.True(t, console.ErrValidation.Has(err))
+		_, err = service.GrantSupportAccess(userCtx, sat.Config.Console.Impersonation.MaxSupportAccessGrant+time.Hour)
+		require.True(t, console.ErrValidation.Has(err))
+
+		grant, err = service.GrantSupportAccess(userCtx, time.Hour)
+		require.NoError(t, err)
+		require.WithinDuration(t, time.Now().Add(time.Hour), grant.ExpiresAt, time.Minute)
+
+		tokenInfo, session, err := impersonation.Impersonate(ctx, request)
+		require.NoError(t, err)
+		require.True(t, session.Consented)
+
+		impersonatedCtx, err := service.TokenAuth(ctx, tokenInfo.Token, time.Now())
+		require.NoError(t, err)
+		require.NotNil(t, console.GetImpersonation(impersonatedCtx))
+
+		// support access can't be changed by the admin.
+		_, err = service.GrantSupportAccess(impersonatedCtx, time.Hour)
+		require.True(t, console.ErrReadOnlySession.Has(err))
+		require.True(t, console.ErrReadOnlySession.Has(service.RevokeSupportAccess(impersonatedCtx)))
+
+		// neither can the projects and api keys.
+		projectID := planet.Uplinks[0].Projects[0].ID
+		_, httpErr := service.GenCreateProject(impersonatedCtx, console.ProjectInfo{Name: "project"})
+		require.True(t, console.ErrReadOnlySession.Has(httpErr.Err))
+		_, httpErr = service.GenUpdateProject(impersonatedCtx, projectID, console.ProjectInfo{Name: "project"})
+		require.True(t, console.ErrReadOnlySession.Has(httpErr.Err))
+		httpErr = service.GenDeleteProject(impersonatedCtx, projectID)
+		require.True(t, console.ErrReadOnlySession.Has(httpErr.Err))
+		_, httpErr = service.GenCreateAPIKey(impersonatedCtx, console.CreateAPIKeyRequest{ProjectID: projectID.String(), Name: "key"})
+		require.True(t, console.ErrReadOnlySession.Has(httpErr.Err))
+		require.Equal(t, http.StatusForbidden, httpErr.Status)
+
+		apiKey, _, err := service.CreateAPIKey(userCtx, projectID, "key")
+		require.NoError(t, err)
+		httpErr = service.GenDeleteAPIKey(impersonatedCtx, apiKey.ID)
+		require.True(t, console.ErrReadOnlySession.Has(httpErr.Err))
+		require.Equal(t, http.StatusForbidden, httpErr.Status)
+
+		sessions, err := service.GetImpersonationSessions(userCtx)
+		require.NoError(t, err)
+		require.Len(t, sessions, 1)
+		require.Equal(t, "ticket 1", sessions[0].Reason)
+
+		// revoking ends the impersonation sessions.
+		require.NoError(t, service.RevokeSupportAccess(userCtx))
+
+		_, err = service.TokenAuth(ctx, tokenInfo.Token, time.Now())
+		require.Error(t, err)
+
+		_, _, err = impersonation.Impersonate(ctx, request)
+		require.True(t, console.ErrImpersonationConsent.Has(err))
+	})
+}
@@ -0,0 +1,357 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console
+
+import (
+	"context"
+	"database/sql"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/memory"
+	"storj.io/common/uuid"
+	"storj.io/storj/private/post"
+	"storj.io/storj/satellite/mailservice"
+)
+
+var (
+	// ErrLimitRequest is the class for errors that occur when a limit increase
+	// is requested or reviewed.
+	ErrLimitRequest = errs.Class("limit increase request")
+
+	// ErrLimitRequestReviewed occurs when a request is reviewed again.
+	ErrLimitRequestReviewed = errs.Class("limit increase request reviewed")
+)
+
+// maxJustificationLength is the longest justification a user can give for a
+// limit increase request.
+const maxJustificationLength = 1000
+
+// LimitRequests exposes methods to manage the requests of users to increase
+// the limits of their projects.
+//
+// architecture: Database
+type LimitRequests interface {
+	// Insert records a limit increase request.
+	Insert(ctx context.Context, request *LimitRequest) error
+	// Get returns the limit increase request with the given ID.
+	Get(ctx context.Context, id uuid.UUID) (*LimitRequest, error)
+	// GetByProjectID returns the limit increase requests of the project, the most recent first.
+	GetByProjectID(ctx context.Context, projectID uuid.UUID) ([]LimitRequest, error)
+	// ListPending returns the requests, which aren't reviewed yet, the oldest first.
+	ListPending(ctx context.Context, limit int) ([]LimitRequest, error)
+	// Review stores the outcome of the review of a pending request and applies
+	// the limit of an approved request to the project in the same transaction.
+	// It returns sql.ErrNoRows, when the request doesn't exist or was reviewed
+	// already.
+	Review(ctx context.Context, request *LimitRequest) error
+}
+
+// LimitKind is the kind of the project limit, which a user requests to increase.
+type LimitKind string
+
+const (
+	// LimitKindStorage is the storage limit of a project in bytes.
+	LimitKindStorage LimitKind = "storage"
+	// LimitKindBandwidth is the monthly bandwidth limit of a project in bytes.
+	LimitKindBandwidth LimitKind = "bandwidth"
+	// LimitKindSegment is the segment limit of a project.
+	LimitKindSegment LimitKind = "segment"
+)
+
+// Valid returns whether the kind is known.
+func (kind LimitKind) Valid() bool {
+	switch kind {
+	case LimitKindStorage, LimitKindBandwidth, LimitKindSegment:
+		return true
+	default:
+		return false
+	}
+}
+
+// Format returns the limit of the kind in a human readable form.
+func (kind LimitKind) Format(limit int64) string {
+	if kind == LimitKindSegment {
+		return strconv.FormatInt(limit, 10) + " segments"
+	}
+	return memory.Size(limit).Base10String()
+}
+
+// LimitRequestStatus is the status of the review of a limit increase request.
+type LimitRequestStatus string
+
+const (
+	// LimitRequestPending means that the request waits for the review of an admin.
+	LimitRequestPending LimitRequestStatus = "pending"
+	// LimitRequestApproved means that the limit was increased.
+	LimitRequestApproved LimitRequestStatus = "approved"
+	// LimitRequestRejected means that the limit was left unchanged.
+	LimitRequestRejected LimitRequestStatus = "rejected"
+)
+
+// LimitRequest is the request of a user to increase a limit of a project. The
+// limit and the usage of the project, when the request was made, are recorded
+// for the review.
+type LimitRequest struct {
+	ID        uuid.UUID `json:"id"`
+	ProjectID uuid.UUID `json:"projectID"`
+	UserID    uuid.UUID `json:"userID"`
+
+	Kind           LimitKind `json:"kind"`
+	CurrentLimit   int64     `json:"currentLimit"`
+	RequestedLimit int64     `json:"requestedLimit"`
+	CurrentUsage   int64     `json:"currentUsage"`
+	Justification  string    `json:"justification"`
+
+	Status        LimitRequestStatus `json:"status"`
+	Reviewer      string             `json:"reviewer"`
+	ReviewComment string             `json:"reviewComment"`
+
+	CreatedAt  time.Time  `json:"createdAt"`
+	ReviewedAt *time.Time `json:"reviewedAt"`
+}
+
+// LimitIncreaseRequest contains the limit a user requests for a project.
+type LimitIncreaseRequest struct {
+	Kind           LimitKind `json:"kind"`
+	RequestedLimit int64     `json:"requestedLimit"`
+	Justification  string    `json:"justification"`
+}
+
+// RequestLimitIncrease queues a request to increase a limit of the project for
+// the review of an admin. Only the owner of the project can request it.
+func (s *Service) RequestLimitIncrease(ctx context.Context, projectID uuid.UUID, request LimitIncreaseRequest) (_ *LimitRequest, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "request limit increase",
+		zap.String("projectID", projectID.String()),
+		zap.String("kind", string(request.Kind)),
+		zap.Int64("requestedLimit", request.RequestedLimit))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	isOwner, err := s.isProjectOwner(ctx, user.ID, projectID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	if !isOwner {
+		return nil, ErrUnauthorized.New(unauthorizedErrMsg)
+	}
+
+	if !request.Kind.Valid() {
+		return nil, ErrValidation.New("unknown limit kind %q", request.Kind)
+	}
+	request.Justification = strings.TrimSpace(request.Justification)
+	if request.Justification == "" {
+		return nil, ErrValidation.New("justification is required")
+	}
+	if len(request.Justification) > maxJustificationLength {
+		return nil, ErrValidation.New("justification can be at most %d characters", maxJustificationLength)
+	}
+
+	limits, err := s.getProjectUsageLimits(ctx, projectID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	var currentLimit, currentUsage int64
+	switch request.Kind {
+	case LimitKindStorage:
+		currentLimit, currentUsage = limits.StorageLimit, limits.StorageUsed
+	case LimitKindBandwidth:
+		currentLimit, currentUsage = limits.BandwidthLimit, limits.BandwidthUsed
+	case LimitKindSegment:
+		currentLimit, currentUsage = limits.SegmentLimit, limits.SegmentUsed
+	}
+	if request.RequestedLimit <= currentLimit {
+		return nil, ErrValidation.New("requested limit must be greater than the current limit %s", request.Kind.Format(currentLimit))
+	}
+
+	existing, err := s.store.LimitRequests().GetByProjectID(ctx, projectID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	for _, other := range existing {
+		if other.Kind == request.Kind && other.Status == LimitRequestPending {
+			return nil, ErrLimitRequest.New("a %s limit increase is already requested", request.Kind)
+		}
+	}
+
+	id, err := uuid.New()
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	limitRequest := &LimitRequest{
+		ID:             id,
+		ProjectID:      projectID,
+		UserID:         user.ID,
+		Kind:           request.Kind,
+		CurrentLimit:   currentLimit,
+		RequestedLimit: request.RequestedLimit,
+		CurrentUsage:   currentUsage,
+		Justification:  request.Justification,
+		Status:         LimitRequestPending,
+		CreatedAt:      time.Now(),
+	}
+
+	err = s.store.LimitRequests().Insert(ctx, limitRequest)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return limitRequest, nil
+}
+
+// GetLimitRequests returns the limit increase requests of the project, the
+// most recent first.
+func (s *Service) GetLimitRequests(ctx context.Context, projectID uuid.UUID) (_ []LimitRequest, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "get limit increase requests", zap.String("projectID", projectID.String()))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	if _, err = s.isProjectMember(ctx, user.ID, projectID); err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	requests, err := s.store.LimitRequests().GetByProjectID(ctx, projectID)
+	return requests, Error.Wrap(err)
+}
+
+// LimitRequestReview contains the decision of an admin on a limit increase request.
+type LimitRequestReview struct {
+	Admin   string
+	Approve bool
+	Comment string
+}
+
+// LimitRequestService lets admins review the limit increase requests of users.
+type LimitRequestService struct {
+	log            *zap.Logger
+	requests       LimitRequests
+	projects       Projects
+	users          Users
+	mailService    *mailservice.Service
+	consoleAddress string
+
+	nowFn func() time.Time
+}
+
+// NewLimitRequestService creates a new limit request service.
+func NewLimitRequestService(log *zap.Logger, requests LimitRequests, projects Projects, users Users, mailService *mailservice.Service, consoleAddress string) *LimitRequestService {
+	if consoleAddress != "" && !strings.HasSuffix(consoleAddress, "/") {
+		consoleAddress += "/"
+	}
+
+	return &LimitRequestService{
+		log:            log,
+		requests:       requests,
+		projects:       projects,
+		users:          users,
+		mailService:    mailService,
+		consoleAddress: consoleAddress,
+
+		nowFn: time.Now,
+	}
+}
+
+// SetNow allows tests to have the service act as if the current time is whatever they want.
+func (s *LimitRequestService) SetNow(nowFn func() time.Time) {
+	s.nowFn = nowFn
+}
+
+// ListPending returns the requests, which wait for a review, the oldest first.
+func (s *LimitRequestService) ListPending(ctx context.Context, limit int) (_ []LimitRequest, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	requests, err := s.requests.ListPending(ctx, limit)
+	return requests, ErrLimitRequest.Wrap(err)
+}
+
+// Review approves or rejects the pending request. An approved limit is applied
+// to the project right away. The user is notified of the outcome by email.
+func (s *LimitRequestService) Review(ctx context.Context, id uuid.UUID, review LimitRequestReview) (_ *LimitRequest, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if review.Admin == "" {
+		return nil, ErrLimitRequest.New("admin is required")
+	}
+
+	request, err := s.requests.Get(ctx, id)
+	if err != nil {
+		if errs.Is(err, sql.ErrNoRows) {
+			return nil, err
+		}
+		return nil, ErrLimitRequest.Wrap(err)
+	}
+	if request.Status != LimitRequestPending {
+		return nil, ErrLimitRequestReviewed.New("request was %s already", request.Status)
+	}
+
+	project, err := s.projects.Get(ctx, request.ProjectID)
+	if err != nil {
+		return nil, ErrLimitRequest.Wrap(err)
+	}
+
+	reviewedAt := s.nowFn()
+	request.Status = LimitRequestRejected
+	if review.Approve {
+		request.Status = LimitRequestApproved
+	}
+	request.Reviewer = review.Admin
+	request.ReviewComment = review.Comment
+	request.ReviewedAt = &reviewedAt
+
+	// the approved limit is applied together with the review, so that
+	// concurrent reviews can't apply different limits.
+	err = s.requests.Review(ctx, request)
+	if err != nil {
+		if errs.Is(err, sql.ErrNoRows) {
+			return nil, ErrLimitRequestReviewed.New("request was reviewed already")
+		}
+		return nil, ErrLimitRequest.Wrap(err)
+	}
+
+	s.log.Info("limit increase request reviewed",
+		zap.Stringer("requestID", request.ID),
+		zap.Stringer("projectID", request.ProjectID),
+		zap.String("kind", string(request.Kind)),
+		zap.Int64("requestedLimit", request.RequestedLimit),
+		zap.String("status", string(request.Status)),
+		zap.String("admin", request.Reviewer),
+	)
+
+	user, err := s.users.Get(ctx, request.UserID)
+	if err != nil {
+		s.log.Warn("failed to notify user of reviewed limit increase request",
+			zap.Stringer("requestID", request.ID), zap.Error(err))
+		return request, nil
+	}
+
+	if s.mailService != nil {
+		s.mailService.SendRenderedAsync(
+			ctx,
+			[]post.Address{{Address: user.Email, Name: user.FullName}},
+			&LimitRequestReviewedEmail{
+				Name:           user.FullName,
+				ProjectName:    project.Name,
+				ProjectLink:    s.consoleAddress + "project-dashboard",
+				Kind:           request.Kind,
+				RequestedLimit: request.Kind.Format(request.RequestedLimit),
+				Approved:       review.Approve,
+				Comment:        review.Comment,
+			},
+		)
+	}
+
+	return request, nil
+}
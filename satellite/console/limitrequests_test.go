@@ -0,0 +1,124 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/memory"
+	"storj.io/common/testcontext"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite/console"
+)
+
+func TestLimitRequests(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		service := sat.API.Console.Service
+		reviews := sat.Admin.LimitRequests.Service
+		project := planet.Uplinks[0].Projects[0]
+
+		userCtx, err := sat.UserContext(ctx, project.Owner.ID)
+		require.NoError(t, err)
+
+		member, err := sat.AddUser(ctx, console.CreateUser{
+			FullName: "Member",
+			Email:    "member@mail.test",
+		}, 1)
+		require.NoError(t, err)
+		_, err = sat.DB.Console().ProjectMembers().Insert(ctx, member.ID, project.ID)
+		require.NoError(t, err)
+		memberCtx, err := sat.UserContext(ctx, member.ID)
+		require.NoError(t, err)
+
+		limits, err := service.GetProjectUsageLimits(userCtx, project.ID)
+		require.NoError(t, err)
+		requested := limits.StorageLimit + memory.TB.Int64()
+
+		request := console.LimitIncreaseRequest{
+			Kind:           console.LimitKindStorage,
+			RequestedLimit: requested,
+			Justification:  "backups of the media library",
+		}
+
+		t.Run("validation", func(t *testing.T) {
+			_, err := service.RequestLimitIncrease(memberCtx, project.ID, request)
+			require.True(t, console.ErrUnauthorized.Has(err))
+
+			for _, invalid := range []console.LimitIncreaseRequest{
+				{Kind: "objects", RequestedLimit: requested, Justification: "reason"},
+				{Kind: console.LimitKindStorage, RequestedLimit: requested, Justification: " "},
+				{Kind: console.LimitKindStorage, RequestedLimit: requested, Justification: strings.Repeat("a", 1001)},
+				{Kind: console.LimitKindStorage, RequestedLimit: limits.StorageLimit, Justification: "reason"},
+			} {
+				_, err := service.RequestLimitIncrease(userCtx, project.ID, invalid)
+				require.True(t, console.ErrValidation.Has(err), err)
+			}
+		})
+
+		created, err := service.RequestLimitIncrease(userCtx, project.ID, request)
+		require.NoError(t, err)
+		require.Equal(t, console.LimitRequestPending, created.Status)
+		require.Equal(t, limits.StorageLimit, created.CurrentLimit)
+		require.Equal(t, requested, created.RequestedLimit)
+
+		// only one request of a kind can be pending.
+		_, err = service.RequestLimitIncrease(userCtx, project.ID, request)
+		require.True(t, console.ErrLimitRequest.Has(err))
+
+		segments, err := service.RequestLimitIncrease(userCtx, project.ID, console.LimitIncreaseRequest{
+			Kind:           console.LimitKindSegment,
+			RequestedLimit: 1 << 40,
+			Justification:  "many small objects",
+		})
+		require.NoError(t, err)
+
+		requests, err := service.GetLimitRequests(memberCtx, project.ID)
+		require.NoError(t, err)
+		require.Len(t, requests, 2)
+		require.Equal(t, segments.ID, requests[0].ID)
+		require.Equal(t, created.ID, requests[1].ID)
+
+		pending, err := reviews.ListPending(ctx, 10)
+		require.NoError(t, err)
+		require.Len(t, pending, 2)
+		require.Equal(t, created.ID, pending[0].ID)
+
+		_, err = reviews.Review(ctx, created.ID, console.LimitRequestReview{Approve: true})
+		require.True(t, console.ErrLimitRequest.Has(err))
+
+		approved, err := reviews.Review(ctx, created.ID, console.LimitRequestReview{
+			Admin:   "admin@mail.test",
+			Approve: true,
+			Comment: "approved for the migration",
+		})
+		require.NoError(t, err)
+		require.Equal(t, console.LimitRequestApproved, approved.Status)
+		require.NotNil(t, approved.ReviewedAt)
+
+		limits, err = service.GetProjectUsageLimits(userCtx, project.ID)
+		require.NoError(t, err)
+		require.Equal(t, requested, limits.StorageLimit)
+
+		_, err = reviews.Review(ctx, created.ID, console.LimitRequestReview{Admin: "admin@mail.test"})
+		require.True(t, console.ErrLimitRequestReviewed.Has(err))
+
+		rejected, err := reviews.Review(ctx, segments.ID, console.LimitRequestReview{Admin: "admin@mail.test"})
+		require.NoError(t, err)
+		require.Equal(t, console.LimitRequestRejected, rejected.Status)
+
+		limits, err = service.GetProjectUsageLimits(userCtx, project.ID)
+		require.NoError(t, err)
+		require.Equal(t, segments.CurrentLimit, limits.SegmentLimit)
+
+		pending, err = reviews.ListPending(ctx, 10)
+		require.NoError(t, err)
+		require.Empty(t, pending)
+	})
+}
@@ -56,6 +56,22 @@ func (email *ProjectInvitationEmail) Subject() string {
 	return "You were invited to join the Project " + email.ProjectName
 }
 
+// NewUserProjectInvitationEmail is mailservice template for project invitation email for users without an account.
+type NewUserProjectInvitationEmail struct {
+	InviterEmail string
+	ProjectName  string
+	SignUpLink   string
+	ExpiresIn    string
+}
+
+// Template returns email template name.
+func (*NewUserProjectInvitationEmail) Template() string { return "NewUserInvite" }
+
+// Subject gets email subject.
+func (email *NewUserProjectInvitationEmail) Subject() string {
+	return "You were invited to join the Project " + email.ProjectName
+}
+
 // UnknownResetPasswordEmail is mailservice template with unknown password reset data.
 type UnknownResetPasswordEmail struct {
 	Satellite           string
@@ -103,3 +119,25 @@ func (*LockAccountEmail) Template() string { return "LockAccount" }
 
 // Subject gets email subject.
 func (*LockAccountEmail) Subject() string { return "Account Lock" }
+
+// LimitRequestReviewedEmail is mailservice template with the outcome of a limit increase request.
+type LimitRequestReviewedEmail struct {
+	Name           string
+	ProjectName    string
+	ProjectLink    string
+	Kind           LimitKind
+	RequestedLimit string
+	Approved       bool
+	Comment        string
+}
+
+// Template returns email template name.
+func (*LimitRequestReviewedEmail) Template() string { return "LimitRequestReviewed" }
+
+// Subject gets email subject.
+func (email *LimitRequestReviewedEmail) Subject() string {
+	if email.Approved {
+		return "Your limit increase request was approved"
+	}
+	return "Your limit increase request was declined"
+}
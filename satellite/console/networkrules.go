@@ -0,0 +1,212 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console
+
+import (
+	"context"
+	"net"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/private/web"
+)
+
+// ErrNetworkRestricted occurs when a project is accessed from a network,
+// which its network rules don't allow.
+var ErrNetworkRestricted = errs.Class("network restricted")
+
+// maxNetworkRules is the largest number of network rules of a project.
+const maxNetworkRules = 100
+
+// ProjectNetworkRules exposes methods to manage the networks, from which
+// projects can be accessed.
+//
+// architecture: Database
+type ProjectNetworkRules interface {
+	// List returns the network rules of the project.
+	List(ctx context.Context, projectID uuid.UUID) (NetworkRules, error)
+	// Set replaces the network rules of the project.
+	Set(ctx context.Context, projectID uuid.UUID, rules NetworkRules) error
+}
+
+// NetworkRule allows or denies the access to a project from a network.
+type NetworkRule struct {
+	ProjectID uuid.UUID `json:"-"`
+	// Network is a CIDR, e.g. 192.0.2.0/24.
+	Network   string    `json:"network"`
+	Allow     bool      `json:"allow"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// NetworkRules are the network rules of a project.
+//
+// An address is denied, when it's in a network of a deny rule. Otherwise,
+// when there are allow rules, it has to be in a network of one of them.
+// Without rules every address is allowed.
+type NetworkRules []NetworkRule
+
+// Allows returns whether the project can be accessed from ip.
+func (rules NetworkRules) Allows(ip net.IP) bool {
+	if len(rules) == 0 {
+		return true
+	}
+
+	hasAllowRules, allowed := false, false
+	for _, rule := range rules {
+		_, network, err := net.ParseCIDR(rule.Network)
+		if err != nil {
+			// rules are validated before they are stored, so ignoring an
+			// invalid rule can only make the rules stricter.
+			if rule.Allow {
+				hasAllowRules = true
+			}
+			continue
+		}
+		contains := ip != nil && network.Contains(ip)
+		if !rule.Allow {
+			if contains || ip == nil {
+				return false
+			}
+			continue
+		}
+		hasAllowRules = true
+		allowed = allowed || contains
+	}
+	return !hasAllowRules || allowed
+}
+
+// AllowsAddress returns whether the project can be accessed from the host of
+// the address, which may contain a port.
+func (rules NetworkRules) AllowsAddress(address string) bool {
+	if len(rules) == 0 {
+		return true
+	}
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		host = address
+	}
+	return rules.Allows(net.ParseIP(host))
+}
+
+// ParseNetwork validates the network and returns it in its canonical CIDR
+// form. A single address is a network with only that address.
+func ParseNetwork(network string) (string, error) {
+	if ip := net.ParseIP(network); ip != nil {
+		if ip4 := ip.To4(); ip4 != nil {
+			return (&net.IPNet{IP: ip4, Mask: net.CIDRMask(32, 32)}).String(), nil
+		}
+		return (&net.IPNet{IP: ip, Mask: net.CIDRMask(128, 128)}).String(), nil
+	}
+
+	_, parsed, err := net.ParseCIDR(network)
+	if err != nil {
+		return "", ErrValidation.New("invalid network %q", network)
+	}
+	return parsed.String(), nil
+}
+
+// GetProjectNetworkRules returns the network rules of the project. Only the
+// owner of the project can get them.
+func (s *Service) GetProjectNetworkRules(ctx context.Context, projectID uuid.UUID) (_ NetworkRules, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "get project network rules", zap.String("projectID", projectID.String()))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	// the owner can manage the rules from any network, so they can't lock
+	// themselves out.
+	project, err := s.store.Projects().Get(ctx, projectID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	if project.OwnerID != user.ID {
+		return nil, ErrUnauthorized.New(unauthorizedErrMsg)
+	}
+
+	rules, err := s.store.ProjectNetworkRules().List(ctx, projectID)
+	return rules, Error.Wrap(err)
+}
+
+// SetProjectNetworkRules replaces the network rules of the project. Only the
+// owner of the project can set them.
+func (s *Service) SetProjectNetworkRules(ctx context.Context, projectID uuid.UUID, rules NetworkRules) (_ NetworkRules, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "set project network rules",
+		zap.String("projectID", projectID.String()),
+		zap.Int("rules", len(rules)))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	project, err := s.store.Projects().Get(ctx, projectID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	if project.OwnerID != user.ID {
+		return nil, ErrUnauthorized.New(unauthorizedErrMsg)
+	}
+
+	if len(rules) > maxNetworkRules {
+		return nil, ErrValidation.New("a project can have at most %d network rules", maxNetworkRules)
+	}
+
+	now := time.Now()
+	networks := make(map[string]bool, len(rules))
+	normalized := make(NetworkRules, 0, len(rules))
+	for _, rule := range rules {
+		network, err := ParseNetwork(rule.Network)
+		if err != nil {
+			return nil, err
+		}
+		if networks[network] {
+			return nil, ErrValidation.New("duplicate network %q", network)
+		}
+		networks[network] = true
+
+		normalized = append(normalized, NetworkRule{
+			ProjectID: projectID,
+			Network:   network,
+			Allow:     rule.Allow,
+			CreatedAt: now,
+		})
+	}
+
+	if err := s.store.ProjectNetworkRules().Set(ctx, projectID, normalized); err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return normalized, nil
+}
+
+// checkNetworkAccess returns an error, when the project is accessed from a
+// network, which its rules don't allow. Requests, which don't come from the
+// console web server, aren't checked.
+func (s *Service) checkNetworkAccess(ctx context.Context, projectID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	req := GetRequest(ctx)
+	if req == nil {
+		return nil
+	}
+
+	rules, err := s.store.ProjectNetworkRules().List(ctx, projectID)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if len(rules) == 0 {
+		return nil
+	}
+
+	ip, err := web.GetRequestIP(req)
+	if err != nil || !rules.AllowsAddress(ip) {
+		mon.Event("console_network_restricted")
+		return ErrUnauthorized.Wrap(ErrNetworkRestricted.New("the project can't be accessed from this network"))
+	}
+	return nil
+}
@@ -0,0 +1,130 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console_test
+
+import (
+	"net"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite/console"
+)
+
+func TestNetworkRulesAllows(t *testing.T) {
+	rules := console.NetworkRules{
+		{Network: "10.0.0.0/8", Allow: true},
+		{Network: "10.1.0.0/16", Allow: false},
+		{Network: "2001:db8::/32", Allow: true},
+	}
+
+	for address, allowed := range map[string]bool{
+		"10.2.3.4":     true,
+		"10.1.2.3":     false,
+		"192.0.2.1":    false,
+		"2001:db8::1":  true,
+		"2001:db9::1":  false,
+		"not-an-ip":    false,
+		"10.2.3.4:443": true,
+	} {
+		require.Equal(t, allowed, rules.AllowsAddress(address), address)
+	}
+
+	// only deny rules allow everything else.
+	denyOnly := console.NetworkRules{{Network: "192.0.2.0/24", Allow: false}}
+	require.False(t, denyOnly.Allows(net.ParseIP("192.0.2.7")))
+	require.True(t, denyOnly.Allows(net.ParseIP("198.51.100.7")))
+
+	// no rules allow everything.
+	require.True(t, console.NetworkRules(nil).AllowsAddress("198.51.100.7"))
+}
+
+func TestParseNetwork(t *testing.T) {
+	for input, expected := range map[string]string{
+		"192.0.2.7":      "192.0.2.7/32",
+		"192.0.2.7/24":   "192.0.2.0/24",
+		"2001:db8::1":    "2001:db8::1/128",
+		"2001:db8::1/32": "2001:db8::/32",
+	} {
+		network, err := console.ParseNetwork(input)
+		require.NoError(t, err)
+		require.Equal(t, expected, network)
+	}
+
+	for _, invalid := range []string{"", "192.0.2.0/33", "example.test"} {
+		_, err := console.ParseNetwork(invalid)
+		require.True(t, console.ErrValidation.Has(err), invalid)
+	}
+}
+
+func TestProjectNetworkRules(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		service := sat.API.Console.Service
+		project := planet.Uplinks[0].Projects[0]
+
+		userCtx, err := sat.UserContext(ctx, project.Owner.ID)
+		require.NoError(t, err)
+
+		member, err := sat.AddUser(ctx, console.CreateUser{
+			FullName: "Member",
+			Email:    "member@mail.test",
+		}, 1)
+		require.NoError(t, err)
+		_, err = sat.DB.Console().ProjectMembers().Insert(ctx, member.ID, project.ID)
+		require.NoError(t, err)
+		memberCtx, err := sat.UserContext(ctx, member.ID)
+		require.NoError(t, err)
+
+		_, err = service.SetProjectNetworkRules(memberCtx, project.ID, console.NetworkRules{{Network: "10.0.0.0/8", Allow: true}})
+		require.True(t, console.ErrUnauthorized.Has(err))
+
+		_, err = service.SetProjectNetworkRules(userCtx, project.ID, console.NetworkRules{{Network: "10.0.0.0/33", Allow: true}})
+		require.True(t, console.ErrValidation.Has(err))
+
+		_, err = service.SetProjectNetworkRules(userCtx, project.ID, console.NetworkRules{
+			{Network: "10.0.0.1", Allow: true},
+			{Network: "10.0.0.1/32", Allow: false},
+		})
+		require.True(t, console.ErrValidation.Has(err))
+
+		rules, err := service.SetProjectNetworkRules(userCtx, project.ID, console.NetworkRules{
+			{Network: "10.1.2.3/8", Allow: true},
+			{Network: "10.9.9.9", Allow: false},
+		})
+		require.NoError(t, err)
+		require.Len(t, rules, 2)
+
+		stored, err := service.GetProjectNetworkRules(userCtx, project.ID)
+		require.NoError(t, err)
+		require.Len(t, stored, 2)
+		require.Equal(t, "10.0.0.0/8", stored[0].Network)
+		require.True(t, stored[0].Allow)
+		require.Equal(t, "10.9.9.9/32", stored[1].Network)
+		require.False(t, stored[1].Allow)
+
+		allowedReq := httptest.NewRequest("GET", "/", nil)
+		allowedReq.RemoteAddr = "10.2.3.4:1234"
+		deniedReq := httptest.NewRequest("GET", "/", nil)
+		deniedReq.RemoteAddr = "192.0.2.1:1234"
+
+		_, err = service.GetProjectUsageLimits(console.WithRequest(memberCtx, allowedReq), project.ID)
+		require.NoError(t, err)
+		_, err = service.GetProjectUsageLimits(console.WithRequest(memberCtx, deniedReq), project.ID)
+		require.True(t, console.ErrNetworkRestricted.Has(err), err)
+		require.True(t, console.ErrUnauthorized.Has(err), err)
+
+		// the owner can still manage the rules from anywhere.
+		_, err = service.SetProjectNetworkRules(console.WithRequest(userCtx, deniedReq), project.ID, nil)
+		require.NoError(t, err)
+
+		_, err = service.GetProjectUsageLimits(console.WithRequest(memberCtx, deniedReq), project.ID)
+		require.NoError(t, err)
+	})
+}
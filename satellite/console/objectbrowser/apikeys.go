@@ -0,0 +1,125 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package objectbrowser
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"time"
+
+	"storj.io/common/macaroon"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/console"
+)
+
+// keyHeadPrefix marks the heads of the api keys of the object browser.
+var keyHeadPrefix = []byte("objectbrowser:")
+
+// keyHeadLen is the length of a head: the prefix, the project id, the
+// expiration and the signature.
+var keyHeadLen = len(keyHeadPrefix) + len(uuid.UUID{}) + 8 + sha256.Size
+
+// KeyHeads looks up api keys by their head.
+type KeyHeads interface {
+	GetByHead(ctx context.Context, head []byte) (*console.APIKeyInfo, error)
+}
+
+// APIKeys resolves the api keys of the object browser, which aren't stored
+// in the database, and looks up all other keys with the wrapped KeyHeads.
+//
+// A key of the object browser is valid for a single project until it
+// expires. Its head is signed with the configured secret, so every satellite
+// api sharing the secret accepts it.
+type APIKeys struct {
+	keys   KeyHeads
+	secret []byte
+
+	nowFn func() time.Time
+}
+
+// NewAPIKeys wraps keys, so the api keys of the object browser are resolved.
+func NewAPIKeys(config Config, keys KeyHeads) *APIKeys {
+	apiKeys := &APIKeys{keys: keys, nowFn: time.Now}
+	if config.Enabled {
+		apiKeys.secret = []byte(config.Secret)
+	}
+	return apiKeys
+}
+
+// GetByHead implements KeyHeads.
+func (keys *APIKeys) GetByHead(ctx context.Context, head []byte) (_ *console.APIKeyInfo, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(keys.secret) == 0 || !bytes.HasPrefix(head, keyHeadPrefix) {
+		return keys.keys.GetByHead(ctx, head)
+	}
+
+	projectID, notAfter, ok := parseKeyHead(keys.secret, head)
+	if !ok {
+		return nil, Error.New("invalid object browser api key")
+	}
+	if !keys.nowFn().Before(notAfter) {
+		return nil, Error.New("object browser api key expired")
+	}
+
+	return &console.APIKeyInfo{
+		ProjectID: projectID,
+		UserAgent: []byte("object-browser"),
+		Name:      "object-browser",
+		Head:      head,
+		Secret:    keySecret(keys.secret, head),
+	}, nil
+}
+
+// newKey creates an api key of the project, which the satellite rejects after
+// notAfter.
+func newKey(secret []byte, projectID uuid.UUID, notAfter time.Time) (*macaroon.APIKey, error) {
+	head := make([]byte, 0, keyHeadLen)
+	head = append(head, keyHeadPrefix...)
+	head = append(head, projectID[:]...)
+	var expiration [8]byte
+	binary.BigEndian.PutUint64(expiration[:], uint64(notAfter.Unix()))
+	head = append(head, expiration[:]...)
+	head = append(head, sign(secret, head)...)
+
+	apiKey, err := macaroon.FromParts(head, keySecret(secret, head))
+	return apiKey, Error.Wrap(err)
+}
+
+// parseKeyHead returns the project and the expiration of a head, when it was
+// signed with the secret.
+func parseKeyHead(secret, head []byte) (projectID uuid.UUID, notAfter time.Time, ok bool) {
+	if len(head) != keyHeadLen {
+		return uuid.UUID{}, time.Time{}, false
+	}
+
+	signed, signature := head[:keyHeadLen-sha256.Size], head[keyHeadLen-sha256.Size:]
+	if !hmac.Equal(sign(secret, signed), signature) {
+		return uuid.UUID{}, time.Time{}, false
+	}
+
+	rest := signed[len(keyHeadPrefix):]
+	copy(projectID[:], rest)
+	notAfter = time.Unix(int64(binary.BigEndian.Uint64(rest[len(projectID):])), 0)
+	return projectID, notAfter, true
+}
+
+// sign returns the signature of a head.
+func sign(secret, head []byte) []byte {
+	mac := hmac.New(sha256.New, secret)
+	_, _ = mac.Write([]byte("head"))
+	_, _ = mac.Write(head)
+	return mac.Sum(nil)
+}
+
+// keySecret returns the macaroon secret of a head.
+func keySecret(secret, head []byte) []byte {
+	mac := hmac.New(sha256.New, secret)
+	_, _ = mac.Write([]byte("secret"))
+	_, _ = mac.Write(head)
+	return mac.Sum(nil)
+}
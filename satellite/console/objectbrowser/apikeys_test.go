@@ -0,0 +1,69 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package objectbrowser
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/console"
+)
+
+func TestAPIKeys(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	config := Config{Enabled: true, Secret: "secret", GrantExpiration: time.Minute}
+	stored := &storedKeys{}
+	keys := NewAPIKeys(config, stored)
+
+	projectID := testrand.UUID()
+	head := keyHead(t, config, projectID, time.Now().Add(time.Minute))
+
+	info, err := keys.GetByHead(ctx, head)
+	require.NoError(t, err)
+	require.Equal(t, projectID, info.ProjectID)
+	require.Zero(t, stored.lookups)
+
+	// heads signed with another secret or expired heads are rejected.
+	other := keyHead(t, Config{Secret: "other"}, projectID, time.Now().Add(time.Minute))
+	_, err = keys.GetByHead(ctx, other)
+	require.Error(t, err)
+
+	expired := keyHead(t, config, projectID, time.Now().Add(-time.Second))
+	_, err = keys.GetByHead(ctx, expired)
+	require.Error(t, err)
+	require.Zero(t, stored.lookups)
+
+	// other heads are looked up in the wrapped store.
+	_, err = keys.GetByHead(ctx, testrand.Bytes(32))
+	require.NoError(t, err)
+	require.Equal(t, 1, stored.lookups)
+
+	// when the object browser is disabled, heads aren't resolved.
+	disabled := NewAPIKeys(Config{Secret: "secret"}, stored)
+	_, err = disabled.GetByHead(ctx, head)
+	require.NoError(t, err)
+	require.Equal(t, 2, stored.lookups)
+}
+
+type storedKeys struct {
+	lookups int
+}
+
+func (keys *storedKeys) GetByHead(ctx context.Context, head []byte) (*console.APIKeyInfo, error) {
+	keys.lookups++
+	return &console.APIKeyInfo{Head: head}, nil
+}
+
+func keyHead(t *testing.T, config Config, projectID uuid.UUID, notAfter time.Time) []byte {
+	apiKey, err := newKey([]byte(config.Secret), projectID, notAfter)
+	require.NoError(t, err)
+	return apiKey.Head()
+}
@@ -0,0 +1,288 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package objectbrowser implements the backend of the object browser of the
+// satellite console, so the web app doesn't need edge credentials to list and
+// preview the objects of a project.
+package objectbrowser
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"io"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/grant"
+	"storj.io/common/macaroon"
+	"storj.io/common/memory"
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/uplink"
+)
+
+var (
+	// Error is the error class for the object browser.
+	Error = errs.Class("object browser")
+	// ErrNotFound is returned when the bucket or the object doesn't exist.
+	ErrNotFound = errs.Class("not found")
+	// ErrInvalidToken is returned when the pagination token can't be decoded.
+	ErrInvalidToken = errs.Class("invalid pagination token")
+	// ErrTooLarge is returned when an object is too large to be previewed.
+	ErrTooLarge = errs.Class("object too large for preview")
+
+	mon = monkit.Package()
+)
+
+// Config contains configurable values for the object browser backend.
+type Config struct {
+	Enabled         bool          `help:"whether the console lists and previews objects on behalf of the web app" default:"false"`
+	Secret          string        `help:"secret used to sign the short-lived api keys of the object browser, which all satellite apis must share" releaseDefault:"" devDefault:"my-suppa-secret-key"`
+	GrantExpiration time.Duration `help:"how long the restricted grants of the object browser are valid" default:"5m"`
+	DefaultPageSize int           `help:"number of items of a listing page, when not requested otherwise" default:"100"`
+	MaxPageSize     int           `help:"maximum number of items of a listing page" default:"1000"`
+	MaxPreviewSize  memory.Size   `help:"maximum size of an object, which can be previewed" default:"10MiB"`
+}
+
+// Item is an object or a prefix of a listing.
+type Item struct {
+	Key         string    `json:"key"`
+	IsPrefix    bool      `json:"isPrefix"`
+	Size        int64     `json:"size"`
+	CreatedAt   time.Time `json:"createdAt"`
+	ExpiresAt   time.Time `json:"expiresAt"`
+	ContentType string    `json:"contentType,omitempty"`
+}
+
+// Page is a page of a listing. NextToken is empty on the last page.
+type Page struct {
+	Prefix    string `json:"prefix"`
+	Items     []Item `json:"items"`
+	NextToken string `json:"nextToken"`
+}
+
+// Service lists, describes and previews the objects of a project with a
+// restricted grant, which is derived from a short-lived API key of the
+// project and the encryption key of the user.
+//
+// The API keys aren't stored; they are signed with the configured secret and
+// resolved by APIKeys. The encryption key is only used for the duration of a
+// request.
+//
+// architecture: Service
+type Service struct {
+	log       *zap.Logger
+	satellite storj.NodeURL
+	config    Config
+
+	nowFn func() time.Time
+}
+
+// NewService creates a new object browser service.
+func NewService(log *zap.Logger, satellite storj.NodeURL, config Config) *Service {
+	if config.DefaultPageSize <= 0 {
+		config.DefaultPageSize = 100
+	}
+	if config.MaxPageSize < config.DefaultPageSize {
+		config.MaxPageSize = config.DefaultPageSize
+	}
+	return &Service{
+		log:       log,
+		satellite: satellite,
+		config:    config,
+		nowFn:     time.Now,
+	}
+}
+
+// Enabled returns whether the object browser backend is enabled.
+func (service *Service) Enabled() bool {
+	return service != nil && service.config.Enabled
+}
+
+// List returns a page of the objects and prefixes directly under the prefix.
+// The token is the NextToken of the previous page, or empty for the first page.
+func (service *Service) List(ctx context.Context, projectID uuid.UUID, encryptionKey storj.Key, bucket, prefix, token string, limit int) (_ Page, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if limit <= 0 {
+		limit = service.config.DefaultPageSize
+	}
+	if limit > service.config.MaxPageSize {
+		limit = service.config.MaxPageSize
+	}
+
+	cursor, err := decodeToken(token)
+	if err != nil {
+		return Page{}, err
+	}
+
+	project, err := service.openProject(ctx, projectID, encryptionKey, bucket)
+	if err != nil {
+		return Page{}, err
+	}
+	defer func() { err = errs.Combine(err, Error.Wrap(project.Close())) }()
+
+	iterator := project.ListObjects(ctx, bucket, &uplink.ListObjectsOptions{
+		Prefix: prefix,
+		Cursor: cursor,
+		System: true,
+		Custom: true,
+	})
+
+	page := Page{Prefix: prefix, Items: []Item{}}
+	for iterator.Next() {
+		if len(page.Items) == limit {
+			// there's at least one more item, so the listing continues after
+			// the last item of this page.
+			page.NextToken = encodeToken(page.Items[len(page.Items)-1].Key[len(prefix):])
+			break
+		}
+		page.Items = append(page.Items, toItem(iterator.Item()))
+	}
+	if err := iterator.Err(); err != nil {
+		return Page{}, convertError(err)
+	}
+
+	return page, nil
+}
+
+// Stat returns the metadata of the object.
+func (service *Service) Stat(ctx context.Context, projectID uuid.UUID, encryptionKey storj.Key, bucket, key string) (_ Item, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	project, err := service.openProject(ctx, projectID, encryptionKey, bucket)
+	if err != nil {
+		return Item{}, err
+	}
+	defer func() { err = errs.Combine(err, Error.Wrap(project.Close())) }()
+
+	object, err := project.StatObject(ctx, bucket, key)
+	if err != nil {
+		return Item{}, convertError(err)
+	}
+	return toItem(object), nil
+}
+
+// Preview calls fn with the metadata and the data of the object. Objects
+// larger than the configured preview size are rejected with ErrTooLarge.
+func (service *Service) Preview(ctx context.Context, projectID uuid.UUID, encryptionKey storj.Key, bucket, key string, fn func(item Item, data io.Reader) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	project, err := service.openProject(ctx, projectID, encryptionKey, bucket)
+	if err != nil {
+		return err
+	}
+	defer func() { err = errs.Combine(err, Error.Wrap(project.Close())) }()
+
+	download, err := project.DownloadObject(ctx, bucket, key, nil)
+	if err != nil {
+		return convertError(err)
+	}
+	defer func() { err = errs.Combine(err, Error.Wrap(download.Close())) }()
+
+	item := toItem(download.Info())
+	maxSize := service.config.MaxPreviewSize.Int64()
+	if item.Size > maxSize {
+		return ErrTooLarge.New("%d bytes, at most %d bytes can be previewed", item.Size, maxSize)
+	}
+
+	return fn(item, io.LimitReader(download, maxSize))
+}
+
+// SetNow allows tests to have the service act as if the current time is
+// whatever they want.
+func (service *Service) SetNow(nowFn func() time.Time) {
+	service.nowFn = nowFn
+}
+
+// openProject opens the project with a grant, which only allows reading and
+// listing the bucket for a short time.
+func (service *Service) openProject(ctx context.Context, projectID uuid.UUID, encryptionKey storj.Key, bucket string) (_ *uplink.Project, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if service.config.Secret == "" {
+		return nil, Error.New("secret isn't configured")
+	}
+
+	notAfter := service.nowFn().Add(service.config.GrantExpiration)
+	apiKey, err := newKey([]byte(service.config.Secret), projectID, notAfter)
+	if err != nil {
+		return nil, err
+	}
+
+	apiKey, err = apiKey.Restrict(macaroon.Caveat{
+		DisallowWrites:  true,
+		DisallowDeletes: true,
+		AllowedPaths:    []*macaroon.Caveat_Path{{Bucket: []byte(bucket)}},
+		NotAfter:        &notAfter,
+	})
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	encAccess := grant.NewEncryptionAccessWithDefaultKey(&encryptionKey)
+	encAccess.SetDefaultPathCipher(storj.EncAESGCM)
+	encAccess.LimitTo(apiKey)
+
+	serialized, err := (&grant.Access{
+		SatelliteAddress: service.satellite.String(),
+		APIKey:           apiKey,
+		EncAccess:        encAccess,
+	}).Serialize()
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	access, err := uplink.ParseAccess(serialized)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	project, err := (uplink.Config{UserAgent: "object-browser"}).OpenProject(ctx, access)
+	return project, Error.Wrap(err)
+}
+
+// toItem converts an uplink object to a listing item.
+func toItem(object *uplink.Object) Item {
+	item := Item{
+		Key:       object.Key,
+		IsPrefix:  object.IsPrefix,
+		Size:      object.System.ContentLength,
+		CreatedAt: object.System.Created,
+		ExpiresAt: object.System.Expires,
+	}
+	if contentType, ok := object.Custom["content-type"]; ok {
+		item.ContentType = contentType
+	} else if contentType, ok := object.Custom["Content-Type"]; ok {
+		item.ContentType = contentType
+	}
+	return item
+}
+
+// convertError converts uplink errors to the errors of the object browser.
+func convertError(err error) error {
+	switch {
+	case errors.Is(err, uplink.ErrBucketNotFound), errors.Is(err, uplink.ErrObjectNotFound):
+		return ErrNotFound.Wrap(err)
+	default:
+		return Error.Wrap(err)
+	}
+}
+
+// encodeToken encodes the listing cursor as an opaque pagination token.
+func encodeToken(cursor string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(cursor))
+}
+
+// decodeToken decodes the listing cursor of a pagination token.
+func decodeToken(token string) (string, error) {
+	cursor, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return "", ErrInvalidToken.Wrap(err)
+	}
+	return string(cursor), nil
+}
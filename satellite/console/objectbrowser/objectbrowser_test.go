@@ -0,0 +1,119 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package objectbrowser_test
+
+import (
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/common/memory"
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/console/objectbrowser"
+)
+
+func TestObjectBrowser(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.ObjectBrowser.Enabled = true
+				config.ObjectBrowser.MaxPreviewSize = 10 * memory.KiB
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		uplink := planet.Uplinks[0]
+		projectID := uplink.Projects[0].ID
+		service := sat.API.Console.ObjectBrowser
+
+		// testplanet uplinks use the zero encryption key.
+		var encryptionKey storj.Key
+
+		data := testrand.Bytes(memory.KiB)
+		for _, key := range []string{"a/1", "a/2", "b", "c", "d"} {
+			require.NoError(t, uplink.Upload(ctx, sat, "bucket", key, data))
+		}
+		require.NoError(t, uplink.Upload(ctx, sat, "bucket", "large", testrand.Bytes(20*memory.KiB)))
+
+		t.Run("list", func(t *testing.T) {
+			var keys []string
+			var token string
+			for {
+				page, err := service.List(ctx, projectID, encryptionKey, "bucket", "", token, 2)
+				require.NoError(t, err)
+				require.LessOrEqual(t, len(page.Items), 2)
+				for _, item := range page.Items {
+					keys = append(keys, item.Key)
+				}
+				if page.NextToken == "" {
+					break
+				}
+				token = page.NextToken
+			}
+			require.ElementsMatch(t, []string{"a/", "b", "c", "d", "large"}, keys)
+
+			page, err := service.List(ctx, projectID, encryptionKey, "bucket", "a/", "", 0)
+			require.NoError(t, err)
+			require.Len(t, page.Items, 2)
+			require.Empty(t, page.NextToken)
+
+			_, err = service.List(ctx, projectID, encryptionKey, "bucket", "", "not base64!", 0)
+			require.True(t, objectbrowser.ErrInvalidToken.Has(err))
+
+			_, err = service.List(ctx, projectID, encryptionKey, "missing", "", "", 0)
+			require.True(t, objectbrowser.ErrNotFound.Has(err))
+		})
+
+		t.Run("stat", func(t *testing.T) {
+			item, err := service.Stat(ctx, projectID, encryptionKey, "bucket", "b")
+			require.NoError(t, err)
+			require.Equal(t, "b", item.Key)
+			require.EqualValues(t, len(data), item.Size)
+
+			_, err = service.Stat(ctx, projectID, encryptionKey, "bucket", "missing")
+			require.True(t, objectbrowser.ErrNotFound.Has(err))
+		})
+
+		t.Run("preview", func(t *testing.T) {
+			var previewed []byte
+			err := service.Preview(ctx, projectID, encryptionKey, "bucket", "a/1", func(item objectbrowser.Item, r io.Reader) (err error) {
+				previewed, err = io.ReadAll(r)
+				return err
+			})
+			require.NoError(t, err)
+			require.Equal(t, data, previewed)
+
+			err = service.Preview(ctx, projectID, encryptionKey, "bucket", "large", func(objectbrowser.Item, io.Reader) error {
+				t.Fatal("large object must not be previewed")
+				return nil
+			})
+			require.True(t, objectbrowser.ErrTooLarge.Has(err))
+		})
+
+		// the api keys of the object browser aren't stored.
+		keys, err := sat.DB.Console().APIKeys().GetPagedByProjectID(ctx, projectID, console.APIKeyCursor{Limit: 10, Page: 1})
+		require.NoError(t, err)
+		for _, key := range keys.APIKeys {
+			require.NotEqual(t, "object-browser", key.Name)
+		}
+
+		t.Run("expired", func(t *testing.T) {
+			service.SetNow(func() time.Time { return time.Now().Add(-time.Hour) })
+			defer service.SetNow(time.Now)
+
+			_, err := service.Stat(ctx, projectID, encryptionKey, "bucket", "b")
+			require.Error(t, err)
+			require.False(t, objectbrowser.ErrNotFound.Has(err))
+		})
+	})
+}
@@ -0,0 +1,283 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package projectdeletion purges the data of the projects, which their owners
+// deleted in the console.
+package projectdeletion
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/macaroon"
+	"storj.io/common/storj"
+	"storj.io/common/sync2"
+	"storj.io/storj/satellite/accounting"
+	"storj.io/storj/satellite/buckets"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/payments"
+)
+
+var (
+	// Error defines the project deletion chore errors class.
+	Error = errs.Class("project deletion chore")
+	mon   = monkit.Package()
+)
+
+// Config contains configurable values for the purge of the deleted projects.
+type Config struct {
+	Enabled   bool          `help:"whether the data of the deleted projects is purged" default:"true"`
+	Interval  time.Duration `help:"how often the data of the deleted projects is purged" releaseDefault:"1h" devDefault:"1m" testDefault:"$TESTINTERVAL"`
+	BatchSize int           `help:"how many deleted projects, buckets and api keys are processed in a batch" default:"50"`
+}
+
+// Chore purges the buckets, objects, api keys and accounting records of the
+// deleted projects. A project is deleted once its usage is invoiced.
+//
+// architecture: Chore
+type Chore struct {
+	log    *zap.Logger
+	config Config
+
+	consoleDB         console.DB
+	buckets           buckets.DB
+	metabase          *metabase.DB
+	projectAccounting accounting.ProjectAccounting
+	accounts          payments.Accounts
+
+	nowFn func() time.Time
+	Loop  *sync2.Cycle
+}
+
+// NewChore creates a new instance of the project deletion chore.
+func NewChore(log *zap.Logger, config Config, consoleDB console.DB, buckets buckets.DB, metabase *metabase.DB, projectAccounting accounting.ProjectAccounting, accounts payments.Accounts) *Chore {
+	return &Chore{
+		log:    log,
+		config: config,
+
+		consoleDB:         consoleDB,
+		buckets:           buckets,
+		metabase:          metabase,
+		projectAccounting: projectAccounting,
+		accounts:          accounts,
+
+		nowFn: time.Now,
+		Loop:  sync2.NewCycle(config.Interval),
+	}
+}
+
+// Run starts the project deletion loop.
+func (chore *Chore) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if !chore.config.Enabled {
+		return nil
+	}
+
+	return chore.Loop.Run(ctx, chore.purgeDeletedProjects)
+}
+
+// Close stops the project deletion chore.
+func (chore *Chore) Close() error {
+	chore.Loop.Close()
+	return nil
+}
+
+// TestingSetNow allows tests to have the chore act as if the current time is whatever they want.
+func (chore *Chore) TestingSetNow(nowFn func() time.Time) {
+	chore.nowFn = nowFn
+}
+
+func (chore *Chore) purgeDeletedProjects(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	deletions, err := chore.consoleDB.ProjectDeletions().ListUnfinished(ctx, chore.config.BatchSize)
+	if err != nil {
+		chore.log.Error("failed to list deleted projects", zap.Error(err))
+		return nil
+	}
+
+	for i := range deletions {
+		deletion := &deletions[i]
+
+		err := chore.purge(ctx, deletion)
+		if err != nil {
+			chore.log.Warn("failed to purge deleted project",
+				zap.Stringer("Project ID", deletion.ProjectID),
+				zap.String("Stage", string(deletion.Stage)),
+				zap.Error(err))
+		}
+	}
+
+	return nil
+}
+
+// purge advances the deletion through its stages, until it's done or a stage
+// fails. The progress and the error of the last stage are stored.
+func (chore *Chore) purge(ctx context.Context, deletion *console.ProjectDeletion) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	for err == nil && deletion.Stage != console.ProjectDeletionStageDone {
+		switch deletion.Stage {
+		case console.ProjectDeletionStageData:
+			err = chore.deleteData(ctx, deletion)
+		case console.ProjectDeletionStageAPIKeys:
+			err = chore.deleteAPIKeys(ctx, deletion)
+		case console.ProjectDeletionStageAccounting:
+			err = chore.deleteAccounting(ctx, deletion)
+		default:
+			err = Error.New("unknown stage %q", deletion.Stage)
+		}
+	}
+
+	deletion.LastError = ""
+	if err != nil {
+		deletion.LastError = err.Error()
+	}
+
+	return errs.Combine(err, chore.update(ctx, deletion))
+}
+
+// deleteData deletes the buckets of the project with their objects and
+// segments. The pieces are removed from the nodes by garbage collection.
+func (chore *Chore) deleteData(ctx context.Context, deletion *console.ProjectDeletion) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	for {
+		list, err := chore.buckets.ListBuckets(ctx, deletion.ProjectID, storj.BucketListOptions{
+			Direction: storj.Forward,
+			Limit:     chore.config.BatchSize,
+		}, macaroon.AllowedBuckets{All: true})
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		if len(list.Items) == 0 {
+			break
+		}
+
+		for _, bucket := range list.Items {
+			deletedObjects, err := chore.metabase.DeleteBucketObjects(ctx, metabase.DeleteBucketObjects{
+				Bucket: metabase.BucketLocation{
+					ProjectID:  deletion.ProjectID,
+					BucketName: bucket.Name,
+				},
+				DeletePieces: func(ctx context.Context, segments []metabase.DeletedSegmentInfo) error {
+					deletion.DeletedSegments += int64(len(segments))
+					return nil
+				},
+			})
+			deletion.DeletedObjects += deletedObjects
+			if err != nil {
+				return Error.Wrap(err)
+			}
+
+			err = chore.buckets.DeleteBucket(ctx, []byte(bucket.Name), deletion.ProjectID)
+			if err != nil && !storj.ErrBucketNotFound.Has(err) {
+				return Error.Wrap(err)
+			}
+			deletion.DeletedBuckets++
+
+			if err := chore.update(ctx, deletion); err != nil {
+				return err
+			}
+		}
+	}
+
+	deletion.Stage = console.ProjectDeletionStageAPIKeys
+	return nil
+}
+
+// deleteAPIKeys deletes the api keys of the project, which were revoked, when
+// the deletion was requested.
+func (chore *Chore) deleteAPIKeys(ctx context.Context, deletion *console.ProjectDeletion) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	apiKeys := chore.consoleDB.APIKeys()
+	for {
+		page, err := apiKeys.GetPagedByProjectID(ctx, deletion.ProjectID, console.APIKeyCursor{
+			Limit: uint(chore.config.BatchSize),
+			Page:  1,
+		})
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		if len(page.APIKeys) == 0 {
+			break
+		}
+
+		for _, key := range page.APIKeys {
+			err := apiKeys.Delete(ctx, key.ID)
+			if err != nil {
+				return Error.Wrap(err)
+			}
+			deletion.DeletedAPIKeys++
+		}
+
+		if err := chore.update(ctx, deletion); err != nil {
+			return err
+		}
+	}
+
+	deletion.Stage = console.ProjectDeletionStageAccounting
+	return nil
+}
+
+// deleteAccounting deletes the accounting records and the project, once all
+// of its usage is invoiced.
+func (chore *Chore) deleteAccounting(ctx context.Context, deletion *console.ProjectDeletion) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	project, err := chore.consoleDB.Projects().Get(ctx, deletion.ProjectID)
+	switch {
+	case errs.Is(err, sql.ErrNoRows):
+		// the project was deleted already, only its records may be left.
+	case err != nil:
+		return Error.Wrap(err)
+	default:
+		owner, err := chore.consoleDB.Users().Get(ctx, project.OwnerID)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+
+		if owner.PaidTier {
+			err = chore.accounts.CheckProjectUsageStatus(ctx, deletion.ProjectID)
+			if err != nil {
+				return Error.Wrap(err)
+			}
+		}
+
+		err = chore.accounts.CheckProjectInvoicingStatus(ctx, deletion.ProjectID)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+	}
+
+	err = chore.projectAccounting.DeleteProjectRecords(ctx, deletion.ProjectID)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	if project != nil {
+		err = chore.consoleDB.Projects().Delete(ctx, deletion.ProjectID)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+	}
+
+	finishedAt := chore.nowFn()
+	deletion.Stage = console.ProjectDeletionStageDone
+	deletion.FinishedAt = &finishedAt
+	return nil
+}
+
+// update stores the progress of the deletion.
+func (chore *Chore) update(ctx context.Context, deletion *console.ProjectDeletion) error {
+	deletion.UpdatedAt = chore.nowFn()
+	return Error.Wrap(chore.consoleDB.ProjectDeletions().Update(ctx, deletion))
+}
@@ -0,0 +1,93 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package projectdeletion_test
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zeebo/errs"
+
+	"storj.io/common/memory"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite/console"
+)
+
+func TestChore(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		uplink := planet.Uplinks[0]
+		chore := sat.Core.ProjectDeletion.Chore
+		chore.Loop.Pause()
+		sat.Accounting.Tally.Loop.Pause()
+
+		project := uplink.Projects[0]
+		owner := project.Owner
+
+		for _, object := range []struct{ bucket, key string }{
+			{"bucket1", "object1"},
+			{"bucket1", "object2"},
+			{"bucket2", "object1"},
+		} {
+			require.NoError(t, uplink.Upload(ctx, sat, object.bucket, object.key, testrand.Bytes(memory.KiB)))
+		}
+
+		// the usage of a paid tier project must be invoiced, before it's deleted.
+		users := sat.DB.Console().Users()
+		require.NoError(t, users.UpdatePaidTier(ctx, owner.ID, true, memory.TB, memory.TB, 10000, 3))
+		sat.Accounting.Tally.Loop.TriggerWait()
+
+		userCtx, err := sat.UserContext(ctx, owner.ID)
+		require.NoError(t, err)
+		require.NoError(t, sat.API.Console.Service.DeleteProject(userCtx, project.ID))
+
+		_, err = uplink.ListBuckets(ctx, sat)
+		require.Error(t, err)
+
+		chore.Loop.TriggerWait()
+
+		deletions := sat.DB.Console().ProjectDeletions()
+		deletion, err := deletions.Get(ctx, project.ID)
+		require.NoError(t, err)
+		require.Equal(t, console.ProjectDeletionStageAccounting, deletion.Stage)
+		require.EqualValues(t, 2, deletion.DeletedBuckets)
+		require.EqualValues(t, 3, deletion.DeletedObjects)
+		require.EqualValues(t, 3, deletion.DeletedSegments)
+		require.EqualValues(t, 1, deletion.DeletedAPIKeys)
+		require.NotEmpty(t, deletion.LastError)
+		require.Nil(t, deletion.FinishedAt)
+
+		count, err := sat.DB.Buckets().CountBuckets(ctx, project.ID)
+		require.NoError(t, err)
+		require.Zero(t, count)
+
+		_, err = sat.DB.Console().Projects().Get(ctx, project.ID)
+		require.NoError(t, err)
+
+		require.NoError(t, users.UpdatePaidTier(ctx, owner.ID, false, memory.TB, memory.TB, 10000, 3))
+		chore.Loop.TriggerWait()
+
+		deletion, err = deletions.Get(ctx, project.ID)
+		require.NoError(t, err)
+		require.Equal(t, console.ProjectDeletionStageDone, deletion.Stage)
+		require.Empty(t, deletion.LastError)
+		require.NotNil(t, deletion.FinishedAt)
+
+		_, err = sat.DB.Console().Projects().Get(ctx, project.ID)
+		require.True(t, errs.Is(err, sql.ErrNoRows))
+
+		objectsSegments, err := sat.DB.ProjectAccounting().GetProjectObjectsSegments(ctx, project.ID)
+		require.NoError(t, err)
+		require.Zero(t, objectsSegments.ObjectCount)
+
+		unfinished, err := deletions.ListUnfinished(ctx, 10)
+		require.NoError(t, err)
+		require.Empty(t, unfinished)
+	})
+}
@@ -0,0 +1,67 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console
+
+import (
+	"context"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+)
+
+// ErrProjectDeletion is the class for errors that occur when a project deletion
+// is requested or queried.
+var ErrProjectDeletion = errs.Class("project deletion")
+
+// ProjectDeletions exposes methods to manage the deletions of projects, whose
+// data is purged in the background.
+//
+// architecture: Database
+type ProjectDeletions interface {
+	// Insert records the deletion of a project.
+	Insert(ctx context.Context, deletion *ProjectDeletion) error
+	// Get returns the deletion of the project.
+	Get(ctx context.Context, projectID uuid.UUID) (*ProjectDeletion, error)
+	// GetByUserID returns the deletions requested by the user, the most recent first.
+	GetByUserID(ctx context.Context, userID uuid.UUID) ([]ProjectDeletion, error)
+	// ListUnfinished returns the deletions, which aren't done yet, the oldest first.
+	ListUnfinished(ctx context.Context, limit int) ([]ProjectDeletion, error)
+	// Update updates the stage, the progress and the error of the deletion.
+	Update(ctx context.Context, deletion *ProjectDeletion) error
+}
+
+// ProjectDeletionStage is the stage of the purge of a deleted project.
+type ProjectDeletionStage string
+
+const (
+	// ProjectDeletionStageData means that the buckets, objects and segments are being deleted.
+	ProjectDeletionStageData ProjectDeletionStage = "data"
+	// ProjectDeletionStageAPIKeys means that the api keys are being deleted.
+	ProjectDeletionStageAPIKeys ProjectDeletionStage = "api_keys"
+	// ProjectDeletionStageAccounting means that the deletion waits for the usage
+	// to be invoiced, before the accounting records and the project are deleted.
+	ProjectDeletionStageAccounting ProjectDeletionStage = "accounting"
+	// ProjectDeletionStageDone means that the project and all of its data are deleted.
+	ProjectDeletionStageDone ProjectDeletionStage = "done"
+)
+
+// ProjectDeletion is the progress of the deletion of a project.
+type ProjectDeletion struct {
+	ProjectID   uuid.UUID            `json:"projectID"`
+	RequestedBy uuid.UUID            `json:"requestedBy"`
+	Stage       ProjectDeletionStage `json:"stage"`
+
+	DeletedBuckets  int64 `json:"deletedBuckets"`
+	DeletedObjects  int64 `json:"deletedObjects"`
+	DeletedSegments int64 `json:"deletedSegments"`
+	DeletedAPIKeys  int64 `json:"deletedAPIKeys"`
+
+	LastError string `json:"lastError"`
+
+	CreatedAt  time.Time  `json:"createdAt"`
+	UpdatedAt  time.Time  `json:"updatedAt"`
+	FinishedAt *time.Time `json:"finishedAt"`
+}
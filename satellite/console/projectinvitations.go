@@ -0,0 +1,414 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/console/consoleauth"
+	"storj.io/storj/satellite/console/consoleweb/consoleapi/utils"
+)
+
+// ProjectInvitations exposes methods to manage pending project member invitations in the database.
+//
+// architecture: Database
+type ProjectInvitations interface {
+	// Upsert inserts a project member invitation, or refreshes its inviter and creation time if it exists.
+	Upsert(ctx context.Context, invite *ProjectInvitation) (*ProjectInvitation, error)
+	// Get returns a project member invitation from the database.
+	Get(ctx context.Context, projectID uuid.UUID, email string) (*ProjectInvitation, error)
+	// GetByProjectID returns all of the project member invitations for the project specified by the given ID.
+	GetByProjectID(ctx context.Context, projectID uuid.UUID) ([]ProjectInvitation, error)
+	// GetByEmail returns all of the project member invitations for the specified email address.
+	GetByEmail(ctx context.Context, email string) ([]ProjectInvitation, error)
+	// Delete removes a project member invitation from the database.
+	Delete(ctx context.Context, projectID uuid.UUID, email string) error
+}
+
+// ProjectInvitation represents a pending project member invitation.
+type ProjectInvitation struct {
+	ProjectID uuid.UUID
+	Email     string
+	InviterID *uuid.UUID
+	CreatedAt time.Time
+}
+
+// ProjectInvitationDetails contains the details of a project member
+// invitation, which are shown to the invitee.
+type ProjectInvitationDetails struct {
+	ProjectID    uuid.UUID `json:"projectID"`
+	ProjectName  string    `json:"projectName"`
+	Email        string    `json:"email"`
+	InviterEmail string    `json:"inviterEmail"`
+	CreatedAt    time.Time `json:"createdAt"`
+	ExpiresAt    time.Time `json:"expiresAt"`
+}
+
+// ProjectInvitationResponse represents a response to a project member invitation.
+type ProjectInvitationResponse int
+
+const (
+	// ProjectInvitationDecline represents rejection of a project member invitation.
+	ProjectInvitationDecline ProjectInvitationResponse = iota
+	// ProjectInvitationAccept represents acceptance of a project member invitation.
+	ProjectInvitationAccept
+)
+
+// InviteProjectMembers invites the users with the given emails to the project.
+// Invitations, which expired, are renewed.
+func (s *Service) InviteProjectMembers(ctx context.Context, projectID uuid.UUID, emails []string) (invites []ProjectInvitation, err error) {
+	defer mon.Task()(&ctx)(&err)
+	user, err := s.getUserAndAuditLog(ctx, "invite project members", zap.String("projectID", projectID.String()), zap.Strings("emails", emails))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	if _, err = s.isProjectMember(ctx, user.ID, projectID); err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	emails, err = normalizeInviteEmails(emails)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, email := range emails {
+		invitee, err := s.store.Users().GetByEmail(ctx, email)
+		if err == nil {
+			if _, err = s.isProjectMember(ctx, invitee.ID, projectID); err == nil {
+				return nil, ErrAlreadyMember.New(alreadyMemberErrMsg, email)
+			} else if !ErrNoMembership.Has(err) {
+				return nil, Error.Wrap(err)
+			}
+		} else if !errs.Is(err, sql.ErrNoRows) {
+			return nil, Error.Wrap(err)
+		}
+
+		invite, err := s.store.ProjectInvitations().Get(ctx, projectID, email)
+		if err == nil {
+			if !s.IsProjectInvitationExpired(invite) {
+				return nil, ErrAlreadyInvited.New(alreadyInvitedErrMsg, email)
+			}
+		} else if !errs.Is(err, sql.ErrNoRows) {
+			return nil, Error.Wrap(err)
+		}
+	}
+
+	invites, err = s.upsertProjectInvitations(ctx, user.ID, projectID, emails)
+	return invites, Error.Wrap(err)
+}
+
+// ReinviteProjectMembers renews the pending invitations of the users with the
+// given emails to the project.
+func (s *Service) ReinviteProjectMembers(ctx context.Context, projectID uuid.UUID, emails []string) (invites []ProjectInvitation, err error) {
+	defer mon.Task()(&ctx)(&err)
+	user, err := s.getUserAndAuditLog(ctx, "reinvite project members", zap.String("projectID", projectID.String()), zap.Strings("emails", emails))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	if _, err = s.isProjectMember(ctx, user.ID, projectID); err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	emails, err = normalizeInviteEmails(emails)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, email := range emails {
+		_, err := s.store.ProjectInvitations().Get(ctx, projectID, email)
+		if err != nil {
+			if errs.Is(err, sql.ErrNoRows) {
+				return nil, ErrProjectInviteInvalid.New(projectInviteInvalidErrMsg)
+			}
+			return nil, Error.Wrap(err)
+		}
+	}
+
+	invites, err = s.upsertProjectInvitations(ctx, user.ID, projectID, emails)
+	return invites, Error.Wrap(err)
+}
+
+// upsertProjectInvitations creates or renews the invitations of the emails to
+// the project.
+func (s *Service) upsertProjectInvitations(ctx context.Context, inviterID, projectID uuid.UUID, emails []string) (invites []ProjectInvitation, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	now := time.Now()
+	for _, email := range emails {
+		invite, err := s.store.ProjectInvitations().Upsert(ctx, &ProjectInvitation{
+			ProjectID: projectID,
+			Email:     email,
+			InviterID: &inviterID,
+			CreatedAt: now,
+		})
+		if err != nil {
+			return nil, err
+		}
+		invites = append(invites, *invite)
+	}
+	return invites, nil
+}
+
+// GetProjectInvitations returns the pending invitations of the project,
+// including the expired ones.
+func (s *Service) GetProjectInvitations(ctx context.Context, projectID uuid.UUID) (_ []ProjectInvitation, err error) {
+	defer mon.Task()(&ctx)(&err)
+	user, err := s.getUserAndAuditLog(ctx, "get project invitations", zap.String("projectID", projectID.String()))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	if _, err = s.isProjectMember(ctx, user.ID, projectID); err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	invites, err := s.store.ProjectInvitations().GetByProjectID(ctx, projectID)
+	return invites, Error.Wrap(err)
+}
+
+// GetUserProjectInvitations returns the unexpired invitations of the current
+// user.
+func (s *Service) GetUserProjectInvitations(ctx context.Context) (_ []ProjectInvitationDetails, err error) {
+	defer mon.Task()(&ctx)(&err)
+	user, err := s.getUserAndAuditLog(ctx, "get user project invitations")
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	invites, err := s.getActiveProjectInvitations(ctx, user.Email)
+	if err != nil {
+		return nil, err
+	}
+
+	details := make([]ProjectInvitationDetails, 0, len(invites))
+	for _, invite := range invites {
+		detail, err := s.projectInvitationDetails(ctx, invite)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		details = append(details, detail)
+	}
+	return details, nil
+}
+
+// projectInvitationDetails looks up the details of the invitation.
+func (s *Service) projectInvitationDetails(ctx context.Context, invite ProjectInvitation) (_ ProjectInvitationDetails, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	project, err := s.store.Projects().Get(ctx, invite.ProjectID)
+	if err != nil {
+		return ProjectInvitationDetails{}, err
+	}
+
+	details := ProjectInvitationDetails{
+		ProjectID:   invite.ProjectID,
+		ProjectName: project.Name,
+		Email:       invite.Email,
+		CreatedAt:   invite.CreatedAt,
+		ExpiresAt:   s.ProjectInvitationExpiresAt(&invite),
+	}
+
+	if invite.InviterID != nil {
+		inviter, err := s.store.Users().Get(ctx, *invite.InviterID)
+		if err != nil && !errs.Is(err, sql.ErrNoRows) {
+			return ProjectInvitationDetails{}, err
+		}
+		if inviter != nil {
+			details.InviterEmail = inviter.Email
+		}
+	}
+
+	return details, nil
+}
+
+// getActiveProjectInvitations returns the unexpired invitations of the email.
+func (s *Service) getActiveProjectInvitations(ctx context.Context, email string) (_ []ProjectInvitation, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	invites, err := s.store.ProjectInvitations().GetByEmail(ctx, normalizeInviteEmail(email))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	active := make([]ProjectInvitation, 0, len(invites))
+	for _, invite := range invites {
+		if !s.IsProjectInvitationExpired(&invite) {
+			active = append(active, invite)
+		}
+	}
+	return active, nil
+}
+
+// RespondToProjectInvitation accepts or declines the invitation of the current
+// user to the project.
+func (s *Service) RespondToProjectInvitation(ctx context.Context, projectID uuid.UUID, response ProjectInvitationResponse) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	user, err := s.getUserAndAuditLog(ctx, "respond to project invitation", zap.String("projectID", projectID.String()))
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	if response != ProjectInvitationAccept && response != ProjectInvitationDecline {
+		return ErrValidation.New("invalid project invitation response")
+	}
+
+	email := normalizeInviteEmail(user.Email)
+	invite, err := s.store.ProjectInvitations().Get(ctx, projectID, email)
+	if err != nil {
+		if errs.Is(err, sql.ErrNoRows) {
+			return ErrProjectInviteInvalid.New(projectInviteInvalidErrMsg)
+		}
+		return Error.Wrap(err)
+	}
+
+	if response == ProjectInvitationDecline {
+		return Error.Wrap(s.store.ProjectInvitations().Delete(ctx, projectID, email))
+	}
+
+	if s.IsProjectInvitationExpired(invite) {
+		return ErrProjectInviteInvalid.New(projectInviteInvalidErrMsg)
+	}
+
+	return Error.Wrap(s.joinProject(ctx, user, *invite))
+}
+
+// joinProject adds the user to the project of the invitation and removes the
+// invitation.
+func (s *Service) joinProject(ctx context.Context, user *User, invite ProjectInvitation) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = s.isProjectMember(ctx, user.ID, invite.ProjectID)
+	switch {
+	case ErrNoMembership.Has(err):
+		if _, err := s.store.ProjectMembers().Insert(ctx, user.ID, invite.ProjectID); err != nil {
+			return err
+		}
+		s.analytics.TrackProjectMemberAddition(user.ID, user.Email)
+	case err != nil:
+		return err
+	}
+
+	return s.store.ProjectInvitations().Delete(ctx, invite.ProjectID, invite.Email)
+}
+
+// joinInvitedProjects adds the user to all of the projects, which the user has
+// unexpired invitations to.
+func (s *Service) joinInvitedProjects(ctx context.Context, user *User) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	invites, err := s.getActiveProjectInvitations(ctx, user.Email)
+	if err != nil {
+		return err
+	}
+
+	for _, invite := range invites {
+		if err := s.joinProject(ctx, user, invite); err != nil {
+			return Error.Wrap(err)
+		}
+		s.auditLog(ctx, "join invited project", &user.ID, user.Email, zap.String("projectID", invite.ProjectID.String()))
+	}
+	return nil
+}
+
+// ProjectInvitationExpiresAt returns when the project member invitation expires.
+func (s *Service) ProjectInvitationExpiresAt(invite *ProjectInvitation) time.Time {
+	return invite.CreatedAt.Add(s.config.ProjectInvitationExpiration)
+}
+
+// IsProjectInvitationExpired returns whether the project member invitation has expired.
+func (s *Service) IsProjectInvitationExpired(invite *ProjectInvitation) bool {
+	return time.Now().After(s.ProjectInvitationExpiresAt(invite))
+}
+
+// CreateInviteToken creates a token for the invitation, which is used to sign
+// up with the invited email.
+func (s *Service) CreateInviteToken(ctx context.Context, invite ProjectInvitation) (_ string, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	token, err := s.tokens.CreateTokenWithExpiration(ctx, invite.ProjectID, invite.Email, s.ProjectInvitationExpiresAt(&invite))
+	return token, Error.Wrap(err)
+}
+
+// GetInviteByToken returns the details of the unexpired project member
+// invitation of the token.
+func (s *Service) GetInviteByToken(ctx context.Context, token string) (_ *ProjectInvitationDetails, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	parsed, err := consoleauth.FromBase64URLString(token)
+	if err != nil {
+		return nil, ErrTokenInvalid.Wrap(err)
+	}
+
+	valid, err := s.tokens.ValidateToken(parsed)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	if !valid {
+		return nil, ErrTokenInvalid.New("incorrect signature")
+	}
+
+	claims, err := consoleauth.FromJSON(parsed.Payload)
+	if err != nil {
+		return nil, ErrTokenInvalid.New("JSON decoder: %w", err)
+	}
+
+	if time.Now().After(claims.Expiration) {
+		return nil, ErrProjectInviteInvalid.New(projectInviteInvalidErrMsg)
+	}
+
+	invite, err := s.store.ProjectInvitations().Get(ctx, claims.ID, claims.Email)
+	if err != nil {
+		if errs.Is(err, sql.ErrNoRows) {
+			return nil, ErrProjectInviteInvalid.New(projectInviteInvalidErrMsg)
+		}
+		return nil, Error.Wrap(err)
+	}
+	if s.IsProjectInvitationExpired(invite) {
+		return nil, ErrProjectInviteInvalid.New(projectInviteInvalidErrMsg)
+	}
+
+	details, err := s.projectInvitationDetails(ctx, *invite)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return &details, nil
+}
+
+// normalizeInviteEmails validates the emails and returns them normalized
+// without duplicates.
+func normalizeInviteEmails(emails []string) ([]string, error) {
+	if len(emails) == 0 {
+		return nil, ErrValidation.New("no emails were provided")
+	}
+
+	normalized := make([]string, 0, len(emails))
+	seen := make(map[string]bool, len(emails))
+	for _, email := range emails {
+		email = normalizeInviteEmail(email)
+		if !utils.ValidateEmail(email) {
+			return nil, ErrValidation.New("invalid email: %q", email)
+		}
+		if seen[email] {
+			continue
+		}
+		seen[email] = true
+		normalized = append(normalized, email)
+	}
+	return normalized, nil
+}
+
+// normalizeInviteEmail returns the form of the email, which is used for
+// storing the invitations.
+func normalizeInviteEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
@@ -0,0 +1,207 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console_test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestProjectInvitationsRepository(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		invitesDB := db.Console().ProjectInvitations()
+		users := db.Console().Users()
+		projects := db.Console().Projects()
+
+		createdUsers, createdProjects := prepareUsersAndProjects(ctx, t, users, projects)
+		projectID := createdProjects[0].ID
+		inviterID := createdUsers[0].ID
+		email := "invitee@mail.test"
+
+		createdAt := time.Now().Truncate(time.Microsecond).UTC()
+		invite, err := invitesDB.Upsert(ctx, &console.ProjectInvitation{
+			ProjectID: projectID,
+			Email:     email,
+			InviterID: &inviterID,
+			CreatedAt: createdAt,
+		})
+		require.NoError(t, err)
+		require.Equal(t, projectID, invite.ProjectID)
+		require.Equal(t, email, invite.Email)
+		require.NotNil(t, invite.InviterID)
+		require.Equal(t, inviterID, *invite.InviterID)
+		require.WithinDuration(t, createdAt, invite.CreatedAt, time.Second)
+
+		// upserting again refreshes the invitation.
+		renewedAt := createdAt.Add(time.Hour)
+		invite, err = invitesDB.Upsert(ctx, &console.ProjectInvitation{
+			ProjectID: projectID,
+			Email:     email,
+			CreatedAt: renewedAt,
+		})
+		require.NoError(t, err)
+		require.Nil(t, invite.InviterID)
+		require.WithinDuration(t, renewedAt, invite.CreatedAt, time.Second)
+
+		invite, err = invitesDB.Get(ctx, projectID, email)
+		require.NoError(t, err)
+		require.WithinDuration(t, renewedAt, invite.CreatedAt, time.Second)
+
+		invites, err := invitesDB.GetByProjectID(ctx, projectID)
+		require.NoError(t, err)
+		require.Len(t, invites, 1)
+
+		invites, err = invitesDB.GetByEmail(ctx, email)
+		require.NoError(t, err)
+		require.Len(t, invites, 1)
+
+		require.NoError(t, invitesDB.Delete(ctx, projectID, email))
+
+		_, err = invitesDB.Get(ctx, projectID, email)
+		require.ErrorIs(t, err, sql.ErrNoRows)
+
+		invites, err = invitesDB.GetByEmail(ctx, email)
+		require.NoError(t, err)
+		require.Empty(t, invites)
+	})
+}
+
+func TestProjectInvitations(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Console.ProjectInvitationExpiration = time.Hour
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		service := sat.API.Console.Service
+		invitesDB := sat.DB.Console().ProjectInvitations()
+
+		project, err := sat.DB.Console().Projects().Get(ctx, planet.Uplinks[0].Projects[0].ID)
+		require.NoError(t, err)
+
+		ownerCtx, err := sat.UserContext(ctx, project.OwnerID)
+		require.NoError(t, err)
+
+		invitee, err := sat.AddUser(ctx, console.CreateUser{
+			FullName: "Invitee",
+			Email:    "invitee@mail.test",
+		}, 1)
+		require.NoError(t, err)
+
+		inviteeCtx, err := sat.UserContext(ctx, invitee.ID)
+		require.NoError(t, err)
+
+		t.Run("invite and accept", func(t *testing.T) {
+			invites, err := service.InviteProjectMembers(ownerCtx, project.ID, []string{" Invitee@Mail.Test "})
+			require.NoError(t, err)
+			require.Len(t, invites, 1)
+			require.Equal(t, invitee.Email, invites[0].Email)
+
+			// the invitee isn't a member yet, so it can't invite anyone.
+			_, err = service.InviteProjectMembers(inviteeCtx, project.ID, []string{"other@mail.test"})
+			require.True(t, console.ErrNoMembership.Has(err))
+
+			_, err = service.InviteProjectMembers(ownerCtx, project.ID, []string{invitee.Email})
+			require.True(t, console.ErrAlreadyInvited.Has(err))
+
+			userInvites, err := service.GetUserProjectInvitations(inviteeCtx)
+			require.NoError(t, err)
+			require.Len(t, userInvites, 1)
+			require.Equal(t, project.Name, userInvites[0].ProjectName)
+
+			require.NoError(t, service.RespondToProjectInvitation(inviteeCtx, project.ID, console.ProjectInvitationAccept))
+
+			_, err = service.GetProject(inviteeCtx, project.ID)
+			require.NoError(t, err)
+
+			_, err = invitesDB.Get(ctx, project.ID, invitee.Email)
+			require.ErrorIs(t, err, sql.ErrNoRows)
+
+			_, err = service.InviteProjectMembers(ownerCtx, project.ID, []string{invitee.Email})
+			require.True(t, console.ErrAlreadyMember.Has(err))
+		})
+
+		t.Run("expiration and reinvite", func(t *testing.T) {
+			email := "expired@mail.test"
+
+			_, err := service.ReinviteProjectMembers(ownerCtx, project.ID, []string{email})
+			require.True(t, console.ErrProjectInviteInvalid.Has(err))
+
+			invites, err := service.InviteProjectMembers(ownerCtx, project.ID, []string{email})
+			require.NoError(t, err)
+			require.Len(t, invites, 1)
+
+			token, err := service.CreateInviteToken(ctx, invites[0])
+			require.NoError(t, err)
+
+			details, err := service.GetInviteByToken(ctx, token)
+			require.NoError(t, err)
+			require.Equal(t, email, details.Email)
+			require.Equal(t, project.Name, details.ProjectName)
+
+			invite := invites[0]
+			invite.CreatedAt = time.Now().Add(-2 * time.Hour)
+			_, err = invitesDB.Upsert(ctx, &invite)
+			require.NoError(t, err)
+
+			pending, err := service.GetProjectInvitations(ownerCtx, project.ID)
+			require.NoError(t, err)
+			require.Len(t, pending, 1)
+			require.True(t, service.IsProjectInvitationExpired(&pending[0]))
+
+			_, err = service.GetInviteByToken(ctx, token)
+			require.True(t, console.ErrProjectInviteInvalid.Has(err))
+
+			invites, err = service.ReinviteProjectMembers(ownerCtx, project.ID, []string{email})
+			require.NoError(t, err)
+			require.Len(t, invites, 1)
+			require.False(t, service.IsProjectInvitationExpired(&invites[0]))
+		})
+
+		t.Run("join on activation", func(t *testing.T) {
+			email := "new@mail.test"
+
+			_, err := service.InviteProjectMembers(ownerCtx, project.ID, []string{email})
+			require.NoError(t, err)
+
+			regToken, err := service.CreateRegToken(ctx, 1)
+			require.NoError(t, err)
+
+			user, err := service.CreateUser(ctx, console.CreateUser{
+				FullName: "New User",
+				Email:    email,
+				Password: "password",
+			}, regToken.Secret)
+			require.NoError(t, err)
+
+			activationToken, err := service.GenerateActivationToken(ctx, user.ID, user.Email)
+			require.NoError(t, err)
+
+			_, err = service.ActivateAccount(ctx, activationToken)
+			require.NoError(t, err)
+
+			userCtx, err := sat.UserContext(ctx, user.ID)
+			require.NoError(t, err)
+
+			_, err = service.GetProject(userCtx, project.ID)
+			require.NoError(t, err)
+
+			_, err = invitesDB.Get(ctx, project.ID, email)
+			require.ErrorIs(t, err, sql.ErrNoRows)
+		})
+	})
+}
@@ -11,6 +11,8 @@ type ProjectUsageLimits struct {
 	BandwidthUsed  int64 `json:"bandwidthUsed"`
 	ObjectCount    int64 `json:"objectCount"`
 	SegmentCount   int64 `json:"segmentCount"`
+	SegmentLimit   int64 `json:"segmentLimit"`
+	SegmentUsed    int64 `json:"segmentUsed"`
 }
 
 // UsageLimits represents storage, bandwidth, and segment limits imposed on an entity.
@@ -0,0 +1,124 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console
+
+import (
+	"context"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/metabase"
+)
+
+// ErrServerSideEncryption is returned when the server-side encryption mode
+// cannot be used.
+var ErrServerSideEncryption = errs.Class("server-side encryption")
+
+// ProjectEncryptionKeys stores the satellite managed encryption keys of the
+// projects in server-side encryption mode.
+//
+// architecture: Database
+type ProjectEncryptionKeys interface {
+	// CreateProjectEncryptionKey creates the managed encryption key of a project.
+	CreateProjectEncryptionKey(ctx context.Context, opts metabase.CreateProjectEncryptionKey) (metabase.ProjectEncryptionKey, error)
+	// GetProjectEncryptionKey returns the managed encryption key of a project.
+	GetProjectEncryptionKey(ctx context.Context, opts metabase.GetProjectEncryptionKey) (metabase.ProjectEncryptionKey, error)
+}
+
+// ServerSideEncryption describes the server-side encryption mode of a project.
+//
+// In server-side encryption mode the satellite manages the encryption key of
+// the project, which is used as the passphrase of the project, so the members
+// can browse and download the objects without entering a passphrase.
+type ServerSideEncryption struct {
+	Enabled   bool       `json:"enabled"`
+	EnabledAt *time.Time `json:"enabledAt,omitempty"`
+}
+
+// GetServerSideEncryption returns the server-side encryption mode of the project.
+func (s *Service) GetServerSideEncryption(ctx context.Context, projectID uuid.UUID) (_ ServerSideEncryption, err error) {
+	defer mon.Task()(&ctx)(&err)
+	user, err := s.getUserAndAuditLog(ctx, "get server-side encryption", zap.String("projectID", projectID.String()))
+	if err != nil {
+		return ServerSideEncryption{}, Error.Wrap(err)
+	}
+
+	if _, err = s.isProjectMember(ctx, user.ID, projectID); err != nil {
+		return ServerSideEncryption{}, Error.Wrap(err)
+	}
+
+	if !s.config.ServerSideEncryptionEnabled || s.encryptionKeys == nil {
+		return ServerSideEncryption{}, nil
+	}
+
+	key, err := s.encryptionKeys.GetProjectEncryptionKey(ctx, metabase.GetProjectEncryptionKey{ProjectID: projectID})
+	if err != nil {
+		if metabase.ErrProjectEncryptionKeyNotFound.Has(err) {
+			return ServerSideEncryption{}, nil
+		}
+		return ServerSideEncryption{}, ErrServerSideEncryption.Wrap(err)
+	}
+
+	return ServerSideEncryption{Enabled: true, EnabledAt: &key.CreatedAt}, nil
+}
+
+// EnableServerSideEncryption opts the project into server-side encryption mode.
+// The mode cannot be disabled, because the objects uploaded in this mode can
+// only be decrypted with the managed encryption key.
+func (s *Service) EnableServerSideEncryption(ctx context.Context, projectID uuid.UUID) (_ ServerSideEncryption, err error) {
+	defer mon.Task()(&ctx)(&err)
+	user, err := s.getUserAndAuditLog(ctx, "enable server-side encryption", zap.String("projectID", projectID.String()))
+	if err != nil {
+		return ServerSideEncryption{}, Error.Wrap(err)
+	}
+
+	if _, err = s.isProjectOwner(ctx, user.ID, projectID); err != nil {
+		return ServerSideEncryption{}, Error.Wrap(err)
+	}
+
+	if !s.config.ServerSideEncryptionEnabled || s.encryptionKeys == nil {
+		return ServerSideEncryption{}, ErrServerSideEncryption.New("server-side encryption is not available on this satellite")
+	}
+
+	key, err := s.encryptionKeys.CreateProjectEncryptionKey(ctx, metabase.CreateProjectEncryptionKey{ProjectID: projectID})
+	if err != nil {
+		return ServerSideEncryption{}, ErrServerSideEncryption.Wrap(err)
+	}
+
+	return ServerSideEncryption{Enabled: true, EnabledAt: &key.CreatedAt}, nil
+}
+
+// GetProjectEncryptionKey returns the managed encryption key of a project in
+// server-side encryption mode. Every access to the key is audit logged.
+func (s *Service) GetProjectEncryptionKey(ctx context.Context, projectID uuid.UUID) (_ []byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+	user, err := s.getUserAndAuditLog(ctx, "get project encryption key", zap.String("projectID", projectID.String()))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	if _, err = s.isProjectMember(ctx, user.ID, projectID); err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	if !s.config.ServerSideEncryptionEnabled || s.encryptionKeys == nil {
+		return nil, ErrServerSideEncryption.New("server-side encryption is not available on this satellite")
+	}
+
+	key, err := s.encryptionKeys.GetProjectEncryptionKey(ctx, metabase.GetProjectEncryptionKey{ProjectID: projectID})
+	if err != nil {
+		if metabase.ErrProjectEncryptionKeyNotFound.Has(err) {
+			return nil, ErrServerSideEncryption.New("project is not in server-side encryption mode")
+		}
+		return nil, ErrServerSideEncryption.Wrap(err)
+	}
+
+	s.auditLog(ctx, "project encryption key accessed", &user.ID, user.Email, zap.String("projectID", projectID.String()))
+	mon.Meter("project_encryption_key_accessed").Mark(1)
+
+	return key.Key, nil
+}
@@ -0,0 +1,86 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/console"
+)
+
+func TestServerSideEncryption(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 0,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Console.ServerSideEncryptionEnabled = true
+				require.NoError(t, config.Metainfo.MetadataEncryptionKey.Set("000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f"))
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		service := sat.API.Console.Service
+
+		owner, err := sat.AddUser(ctx, console.CreateUser{FullName: "Owner", Email: "owner@test.test"}, 1)
+		require.NoError(t, err)
+		member, err := sat.AddUser(ctx, console.CreateUser{FullName: "Member", Email: "member@test.test"}, 1)
+		require.NoError(t, err)
+		outsider, err := sat.AddUser(ctx, console.CreateUser{FullName: "Outsider", Email: "outsider@test.test"}, 1)
+		require.NoError(t, err)
+
+		project, err := sat.AddProject(ctx, owner.ID, "encrypted")
+		require.NoError(t, err)
+		_, err = sat.DB.Console().ProjectMembers().Insert(ctx, member.ID, project.ID)
+		require.NoError(t, err)
+
+		ownerCtx, err := sat.UserContext(ctx, owner.ID)
+		require.NoError(t, err)
+		memberCtx, err := sat.UserContext(ctx, member.ID)
+		require.NoError(t, err)
+		outsiderCtx, err := sat.UserContext(ctx, outsider.ID)
+		require.NoError(t, err)
+
+		// the mode is disabled until the owner enables it.
+		mode, err := service.GetServerSideEncryption(memberCtx, project.ID)
+		require.NoError(t, err)
+		require.False(t, mode.Enabled)
+
+		_, err = service.GetProjectEncryptionKey(memberCtx, project.ID)
+		require.True(t, console.ErrServerSideEncryption.Has(err))
+
+		// only the owner can enable the mode.
+		_, err = service.EnableServerSideEncryption(memberCtx, project.ID)
+		require.True(t, console.ErrUnauthorized.Has(err))
+		_, err = service.EnableServerSideEncryption(outsiderCtx, project.ID)
+		require.True(t, console.ErrUnauthorized.Has(err))
+
+		mode, err = service.EnableServerSideEncryption(ownerCtx, project.ID)
+		require.NoError(t, err)
+		require.True(t, mode.Enabled)
+		require.NotNil(t, mode.EnabledAt)
+
+		// the members share the managed key, others can't see the mode nor the key.
+		mode, err = service.GetServerSideEncryption(memberCtx, project.ID)
+		require.NoError(t, err)
+		require.True(t, mode.Enabled)
+
+		ownerKey, err := service.GetProjectEncryptionKey(ownerCtx, project.ID)
+		require.NoError(t, err)
+		require.NotEmpty(t, ownerKey)
+		memberKey, err := service.GetProjectEncryptionKey(memberCtx, project.ID)
+		require.NoError(t, err)
+		require.Equal(t, ownerKey, memberKey)
+
+		_, err = service.GetServerSideEncryption(outsiderCtx, project.ID)
+		require.True(t, console.ErrNoMembership.Has(err))
+		_, err = service.GetProjectEncryptionKey(outsiderCtx, project.ID)
+		require.True(t, console.ErrNoMembership.Has(err))
+	})
+}
@@ -31,12 +31,15 @@ import (
 	"storj.io/storj/private/blockchain"
 	"storj.io/storj/private/post"
 	"storj.io/storj/satellite/accounting"
+	"storj.io/storj/satellite/accounting/projectops"
 	"storj.io/storj/satellite/analytics"
+	"storj.io/storj/satellite/attestation"
 	"storj.io/storj/satellite/buckets"
 	"storj.io/storj/satellite/console/consoleauth"
 	"storj.io/storj/satellite/mailservice"
 	"storj.io/storj/satellite/payments"
 	"storj.io/storj/satellite/payments/billing"
+	"storj.io/storj/satellite/revocation"
 	"storj.io/storj/satellite/rewards"
 )
 
@@ -68,6 +71,9 @@ const (
 	activationTokenExpiredErrMsg = "This activation token has expired, please request another one"
 	usedRegTokenErrMsg           = "This registration token has already been used"
 	projLimitErrMsg              = "Sorry, project creation is limited for your account. Please contact support!"
+	alreadyMemberErrMsg          = "%s is already a member of this project"
+	alreadyInvitedErrMsg         = "%s was invited already, resend the invitation instead"
+	projectInviteInvalidErrMsg   = "The invitation has expired or is invalid"
 )
 
 var (
@@ -118,6 +124,15 @@ var (
 
 	// ErrRecoveryToken describes account recovery token errors.
 	ErrRecoveryToken = errs.Class("recovery token")
+
+	// ErrAlreadyMember occurs when a user tries to invite a user who is already a project member.
+	ErrAlreadyMember = errs.Class("already a member")
+
+	// ErrAlreadyInvited occurs when a user tries to invite a user, who has a pending invitation already.
+	ErrAlreadyInvited = errs.Class("already invited")
+
+	// ErrProjectInviteInvalid occurs when a project member invitation doesn't exist or has expired.
+	ErrProjectInviteInvalid = errs.Class("invalid project invitation")
 )
 
 // Service is handling accounts related logic.
@@ -130,6 +145,8 @@ type Service struct {
 	projectAccounting          accounting.ProjectAccounting
 	projectUsage               *accounting.Service
 	buckets                    buckets.DB
+	encryptionKeys             ProjectEncryptionKeys
+	revocations                revocation.DB
 	partners                   *rewards.PartnersService
 	accounts                   payments.Accounts
 	depositWallets             payments.DepositWallets
@@ -165,9 +182,12 @@ type Config struct {
 	AsOfSystemTimeDuration      time.Duration `help:"default duration for AS OF SYSTEM TIME" devDefault:"-5m" releaseDefault:"-5m" testDefault:"0"`
 	LoginAttemptsWithoutPenalty int           `help:"number of times user can try to login without penalty" default:"3"`
 	FailedLoginPenalty          float64       `help:"incremental duration of penalty for failed login attempts in minutes" default:"2.0"`
+	ServerSideEncryptionEnabled bool          `help:"allow projects to opt into server-side encryption with satellite managed keys (requires --metainfo.metadata-encryption-key)" default:"false"`
+	ProjectInvitationExpiration time.Duration `help:"duration that project member invitations are valid for" default:"168h"`
 	UsageLimits                 UsageLimitsConfig
 	Captcha                     CaptchaConfig
 	Session                     SessionConfig
+	Impersonation               ImpersonationConfig
 }
 
 // CaptchaConfig contains configurations for login/registration captcha system.
@@ -203,7 +223,7 @@ type Payments struct {
 }
 
 // NewService returns new instance of Service.
-func NewService(log *zap.Logger, store DB, restKeys RESTKeys, projectAccounting accounting.ProjectAccounting, projectUsage *accounting.Service, buckets buckets.DB, partners *rewards.PartnersService, accounts payments.Accounts, depositWallets payments.DepositWallets, billing billing.TransactionsDB, analytics *analytics.Service, tokens *consoleauth.Service, mailService *mailservice.Service, satelliteAddress string, config Config) (*Service, error) {
+func NewService(log *zap.Logger, store DB, restKeys RESTKeys, projectAccounting accounting.ProjectAccounting, projectUsage *accounting.Service, buckets buckets.DB, encryptionKeys ProjectEncryptionKeys, revocations revocation.DB, partners *rewards.PartnersService, accounts payments.Accounts, depositWallets payments.DepositWallets, billing billing.TransactionsDB, analytics *analytics.Service, tokens *consoleauth.Service, mailService *mailservice.Service, satelliteAddress string, config Config) (*Service, error) {
 	if store == nil {
 		return nil, errs.New("store can't be nil")
 	}
@@ -239,6 +259,8 @@ func NewService(log *zap.Logger, store DB, restKeys RESTKeys, projectAccounting
 		projectAccounting:          projectAccounting,
 		projectUsage:               projectUsage,
 		buckets:                    buckets,
+		encryptionKeys:             encryptionKeys,
+		revocations:                revocations,
 		partners:                   partners,
 		accounts:                   accounts,
 		depositWallets:             depositWallets,
@@ -274,6 +296,9 @@ func (s *Service) auditLog(ctx context.Context, operation string, userID *uuid.U
 	if email != "" {
 		fields = append(fields, zap.String("email", email))
 	}
+	if impersonation := GetImpersonation(ctx); impersonation != nil {
+		fields = append(fields, zap.String("impersonated-by", impersonation.Admin))
+	}
 	fields = append(fields, fields...)
 	s.auditLogger.Info("console activity", fields...)
 }
@@ -403,6 +428,18 @@ func (payment Payments) ProjectsCharges(ctx context.Context, since, before time.
 	return payment.service.accounts.ProjectCharges(ctx, user.ID, since, before)
 }
 
+// EstimateCost returns an itemized estimate of what the current user will be invoiced for the current billing period.
+func (payment Payments) EstimateCost(ctx context.Context) (_ payments.CostEstimate, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := payment.service.getUserAndAuditLog(ctx, "estimate cost")
+	if err != nil {
+		return payments.CostEstimate{}, Error.Wrap(err)
+	}
+
+	return payment.service.accounts.EstimateCost(ctx, user.ID)
+}
+
 // ListCreditCards returns a list of credit cards for a given payment account.
 func (payment Payments) ListCreditCards(ctx context.Context) (_ []payments.CreditCard, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -565,31 +602,6 @@ func (payment Payments) checkOutstandingInvoice(ctx context.Context) (err error)
 	return nil
 }
 
-// checkProjectInvoicingStatus returns error if for the given project there are outstanding project records and/or usage
-// which have not been applied/invoiced yet (meaning sent over to stripe).
-func (payment Payments) checkProjectInvoicingStatus(ctx context.Context, projectID uuid.UUID) (err error) {
-	defer mon.Task()(&ctx)(&err)
-
-	_, err = payment.service.getUserAndAuditLog(ctx, "project invoicing status")
-	if err != nil {
-		return Error.Wrap(err)
-	}
-
-	return payment.service.accounts.CheckProjectInvoicingStatus(ctx, projectID)
-}
-
-// checkProjectUsageStatus returns error if for the given project there is some usage for current or previous month.
-func (payment Payments) checkProjectUsageStatus(ctx context.Context, projectID uuid.UUID) (err error) {
-	defer mon.Task()(&ctx)(&err)
-
-	_, err = payment.service.getUserAndAuditLog(ctx, "project usage status")
-	if err != nil {
-		return Error.Wrap(err)
-	}
-
-	return payment.service.accounts.CheckProjectUsageStatus(ctx, projectID)
-}
-
 // ApplyCouponCode applies a coupon code to a Stripe customer
 // and returns the coupon corresponding to the code.
 func (payment Payments) ApplyCouponCode(ctx context.Context, couponCode string) (coupon *payments.Coupon, err error) {
@@ -829,7 +841,7 @@ func (s *Service) GenerateSessionToken(ctx context.Context, userID uuid.UUID, em
 	}
 	expiresAt := time.Now().Add(duration)
 
-	_, err = s.store.WebappSessions().Create(ctx, sessionID, userID, ip, userAgent, expiresAt)
+	_, err = s.store.WebappSessions().Create(ctx, sessionID, userID, ip, userAgent, consoleauth.SessionStatusActive, expiresAt)
 	if err != nil {
 		return nil, err
 	}
@@ -899,6 +911,12 @@ func (s *Service) ActivateAccount(ctx context.Context, activationToken string) (
 
 	s.analytics.TrackAccountVerified(user.ID, user.Email)
 
+	// the invitations were sent to the verified email, so the user joins the
+	// invited projects right away.
+	if err := s.joinInvitedProjects(ctx, user); err != nil {
+		s.log.Warn("failed to join invited projects", zap.Stringer("userID", user.ID), zap.Error(err))
+	}
+
 	return user, nil
 }
 
@@ -1543,6 +1561,13 @@ func (s *Service) GenCreateProject(ctx context.Context, projectInfo ProjectInfo)
 		}
 	}
 
+	if GetImpersonation(ctx) != nil {
+		return nil, api.HTTPError{
+			Status: http.StatusForbidden,
+			Err:    ErrReadOnlySession.New("projects can't be created while impersonating"),
+		}
+	}
+
 	currentProjectCount, err := s.checkProjectLimit(ctx, user.ID)
 	if err != nil {
 		return nil, api.HTTPError{
@@ -1601,7 +1626,8 @@ func (s *Service) GenCreateProject(ctx context.Context, projectInfo ProjectInfo)
 	return p, httpError
 }
 
-// DeleteProject is a method for deleting project by id.
+// DeleteProject is a method for deleting project by id. The access to the
+// project is disabled immediately, while its data is purged in the background.
 func (s *Service) DeleteProject(ctx context.Context, projectID uuid.UUID) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
@@ -1615,12 +1641,7 @@ func (s *Service) DeleteProject(ctx context.Context, projectID uuid.UUID) (err e
 		return Error.Wrap(err)
 	}
 
-	err = s.checkProjectCanBeDeleted(ctx, user, projectID)
-	if err != nil {
-		return Error.Wrap(err)
-	}
-
-	err = s.store.Projects().Delete(ctx, projectID)
+	err = s.startProjectDeletion(ctx, user, projectID)
 	if err != nil {
 		return Error.Wrap(err)
 	}
@@ -1641,6 +1662,13 @@ func (s *Service) GenDeleteProject(ctx context.Context, projectID uuid.UUID) (ht
 		}
 	}
 
+	if GetImpersonation(ctx) != nil {
+		return api.HTTPError{
+			Status: http.StatusForbidden,
+			Err:    ErrReadOnlySession.New("projects can't be deleted while impersonating"),
+		}
+	}
+
 	_, err = s.isProjectOwner(ctx, user.ID, projectID)
 	if err != nil {
 		return api.HTTPError{
@@ -1649,23 +1677,44 @@ func (s *Service) GenDeleteProject(ctx context.Context, projectID uuid.UUID) (ht
 		}
 	}
 
-	err = s.checkProjectCanBeDeleted(ctx, user, projectID)
+	err = s.startProjectDeletion(ctx, user, projectID)
 	if err != nil {
+		status := http.StatusInternalServerError
+		if ErrProjectDeletion.Has(err) {
+			status = http.StatusConflict
+		}
 		return api.HTTPError{
-			Status: http.StatusConflict,
+			Status: status,
 			Err:    Error.Wrap(err),
 		}
 	}
 
-	err = s.store.Projects().Delete(ctx, projectID)
+	return httpError
+}
+
+// GetProjectDeletion returns the progress of the deletion of the project,
+// which the user requested.
+func (s *Service) GetProjectDeletion(ctx context.Context, projectID uuid.UUID) (_ *ProjectDeletion, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "get project deletion", zap.String("projectID", projectID.String()))
 	if err != nil {
-		return api.HTTPError{
-			Status: http.StatusInternalServerError,
-			Err:    Error.Wrap(err),
+		return nil, Error.Wrap(err)
+	}
+
+	deletion, err := s.store.ProjectDeletions().Get(ctx, projectID)
+	if err != nil {
+		if errs.Is(err, sql.ErrNoRows) {
+			return nil, ErrProjectDeletion.New("project is not being deleted")
 		}
+		return nil, Error.Wrap(err)
 	}
 
-	return httpError
+	if deletion.RequestedBy != user.ID {
+		return nil, ErrUnauthorized.New(unauthorizedErrMsg)
+	}
+
+	return deletion, nil
 }
 
 // UpdateProject is a method for updating project name and description by id.
@@ -1765,6 +1814,13 @@ func (s *Service) GenUpdateProject(ctx context.Context, projectID uuid.UUID, pro
 		}
 	}
 
+	if GetImpersonation(ctx) != nil {
+		return nil, api.HTTPError{
+			Status: http.StatusForbidden,
+			Err:    ErrReadOnlySession.New("projects can't be updated while impersonating"),
+		}
+	}
+
 	err = ValidateNameAndDescription(projectInfo.Name, projectInfo.Description)
 	if err != nil {
 		return nil, api.HTTPError{
@@ -1863,48 +1919,6 @@ func (s *Service) GenUpdateProject(ctx context.Context, projectID uuid.UUID, pro
 	return project, httpError
 }
 
-// AddProjectMembers adds users by email to given project.
-// Email addresses not belonging to a user are ignored.
-func (s *Service) AddProjectMembers(ctx context.Context, projectID uuid.UUID, emails []string) (users []*User, err error) {
-	defer mon.Task()(&ctx)(&err)
-	user, err := s.getUserAndAuditLog(ctx, "add project members", zap.String("projectID", projectID.String()), zap.Strings("emails", emails))
-	if err != nil {
-		return nil, Error.Wrap(err)
-	}
-
-	if _, err = s.isProjectMember(ctx, user.ID, projectID); err != nil {
-		return nil, Error.Wrap(err)
-	}
-
-	// collect user querying errors
-	for _, email := range emails {
-		user, err := s.store.Users().GetByEmail(ctx, email)
-		if err == nil {
-			users = append(users, user)
-		} else if !errs.Is(err, sql.ErrNoRows) {
-			return nil, Error.Wrap(err)
-		}
-
-	}
-
-	// add project members in transaction scope
-	err = s.store.WithTx(ctx, func(ctx context.Context, tx DBTx) error {
-		for _, user := range users {
-			if _, err := tx.ProjectMembers().Insert(ctx, user.ID, projectID); err != nil {
-				return err
-			}
-		}
-		return nil
-	})
-	if err != nil {
-		return nil, Error.Wrap(err)
-	}
-
-	s.analytics.TrackProjectMemberAddition(user.ID, user.Email)
-
-	return users, nil
-}
-
 // DeleteProjectMembers removes users by email from given project.
 func (s *Service) DeleteProjectMembers(ctx context.Context, projectID uuid.UUID, emails []string) (err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -2043,6 +2057,13 @@ func (s *Service) GenCreateAPIKey(ctx context.Context, requestInfo CreateAPIKeyR
 		}
 	}
 
+	if GetImpersonation(ctx) != nil {
+		return nil, api.HTTPError{
+			Status: http.StatusForbidden,
+			Err:    ErrReadOnlySession.New("api keys can't be created while impersonating"),
+		}
+	}
+
 	projectID, err := uuid.FromString(requestInfo.ProjectID)
 	if err != nil {
 		return nil, api.HTTPError{
@@ -2118,6 +2139,8 @@ func (s *Service) GenDeleteAPIKey(ctx context.Context, keyID uuid.UUID) (httpErr
 			status = http.StatusUnauthorized
 		} else if ErrAPIKeyRequest.Has(err) {
 			status = http.StatusBadRequest
+		} else if ErrReadOnlySession.Has(err) {
+			status = http.StatusForbidden
 		}
 
 		return api.HTTPError{
@@ -2215,6 +2238,10 @@ func (s *Service) DeleteAPIKeys(ctx context.Context, ids []uuid.UUID) (err error
 		return Error.Wrap(err)
 	}
 
+	if GetImpersonation(ctx) != nil {
+		return ErrReadOnlySession.New("api keys can't be deleted while impersonating")
+	}
+
 	var keysErr errs.Group
 
 	for _, keyID := range ids {
@@ -2498,6 +2525,71 @@ func (s *Service) GenGetSingleBucketUsageRollup(ctx context.Context, projectID u
 	return
 }
 
+// AuthorizeBucketOwner checks that the user is the owner of the project and
+// that the bucket exists.
+func (s *Service) AuthorizeBucketOwner(ctx context.Context, projectID uuid.UUID, bucketName string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "authorize bucket owner", zap.String("projectID", projectID.String()), zap.String("bucket", bucketName))
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	_, err = s.isProjectOwner(ctx, user.ID, projectID)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	_, err = s.buckets.GetBucket(ctx, []byte(bucketName), projectID)
+	return Error.Wrap(err)
+}
+
+// GetBucketDurabilityReports returns the most recent signed durability reports of the bucket.
+// Only the owner of the project can get them.
+func (s *Service) GetBucketDurabilityReports(ctx context.Context, projectID uuid.UUID, bucketName string, limit int) (_ []attestation.SignedReport, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "get bucket durability reports", zap.String("projectID", projectID.String()), zap.String("bucket", bucketName))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	_, err = s.isProjectOwner(ctx, user.ID, projectID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	reports, err := s.store.DurabilityReports().List(ctx, projectID, bucketName, limit)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return reports, nil
+}
+
+// GetBucketDurabilityReport returns the signed durability report of the bucket with the ID.
+// Only the owner of the project can get it.
+func (s *Service) GetBucketDurabilityReport(ctx context.Context, projectID uuid.UUID, bucketName string, reportID uuid.UUID) (_ attestation.SignedReport, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "get bucket durability report", zap.String("projectID", projectID.String()), zap.String("bucket", bucketName), zap.String("reportID", reportID.String()))
+	if err != nil {
+		return attestation.SignedReport{}, Error.Wrap(err)
+	}
+
+	_, err = s.isProjectOwner(ctx, user.ID, projectID)
+	if err != nil {
+		return attestation.SignedReport{}, Error.Wrap(err)
+	}
+
+	report, err := s.store.DurabilityReports().Get(ctx, projectID, bucketName, reportID)
+	if err != nil {
+		return attestation.SignedReport{}, Error.Wrap(err)
+	}
+
+	return report, nil
+}
+
 // GetDailyProjectUsage returns daily usage by project ID.
 func (s *Service) GetDailyProjectUsage(ctx context.Context, projectID uuid.UUID, from, to time.Time) (_ *accounting.ProjectDailyUsage, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -2520,6 +2612,29 @@ func (s *Service) GetDailyProjectUsage(ctx context.Context, projectID uuid.UUID,
 	return usage, nil
 }
 
+// GetProjectOperations returns the uploads, downloads, listings and
+// deletions of the project per hour in [since, before).
+func (s *Service) GetProjectOperations(ctx context.Context, projectID uuid.UUID, since, before time.Time) (_ projectops.Summary, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "get project operations", zap.String("projectID", projectID.String()))
+	if err != nil {
+		return projectops.Summary{}, Error.Wrap(err)
+	}
+
+	_, err = s.isProjectMember(ctx, user.ID, projectID)
+	if err != nil {
+		return projectops.Summary{}, Error.Wrap(err)
+	}
+
+	summary, err := projectops.NewService(s.store.ProjectOperations()).Summary(ctx, projectID, since, before)
+	if err != nil {
+		return projectops.Summary{}, Error.Wrap(err)
+	}
+
+	return summary, nil
+}
+
 // GetProjectUsageLimits returns project limits and current usage.
 //
 // Among others,it can return one of the following errors returned by
@@ -2554,6 +2669,8 @@ func (s *Service) GetProjectUsageLimits(ctx context.Context, projectID uuid.UUID
 		BandwidthUsed:  prUsageLimits.BandwidthUsed,
 		ObjectCount:    prObjectsSegments.ObjectCount,
 		SegmentCount:   prObjectsSegments.SegmentCount,
+		SegmentLimit:   prUsageLimits.SegmentLimit,
+		SegmentUsed:    prUsageLimits.SegmentUsed,
 	}, nil
 }
 
@@ -2575,6 +2692,8 @@ func (s *Service) GetTotalUsageLimits(ctx context.Context) (_ *ProjectUsageLimit
 	var totalBandwidthLimit int64
 	var totalStorageUsed int64
 	var totalBandwidthUsed int64
+	var totalSegmentLimit int64
+	var totalSegmentUsed int64
 
 	for _, pr := range projects {
 		prUsageLimits, err := s.getProjectUsageLimits(ctx, pr.ID)
@@ -2586,6 +2705,8 @@ func (s *Service) GetTotalUsageLimits(ctx context.Context) (_ *ProjectUsageLimit
 		totalBandwidthLimit += prUsageLimits.BandwidthLimit
 		totalStorageUsed += prUsageLimits.StorageUsed
 		totalBandwidthUsed += prUsageLimits.BandwidthUsed
+		totalSegmentLimit += prUsageLimits.SegmentLimit
+		totalSegmentUsed += prUsageLimits.SegmentUsed
 	}
 
 	return &ProjectUsageLimits{
@@ -2593,6 +2714,8 @@ func (s *Service) GetTotalUsageLimits(ctx context.Context) (_ *ProjectUsageLimit
 		BandwidthLimit: totalBandwidthLimit,
 		StorageUsed:    totalStorageUsed,
 		BandwidthUsed:  totalBandwidthUsed,
+		SegmentLimit:   totalSegmentLimit,
+		SegmentUsed:    totalSegmentUsed,
 	}, nil
 }
 
@@ -2617,11 +2740,31 @@ func (s *Service) getProjectUsageLimits(ctx context.Context, projectID uuid.UUID
 		return nil, err
 	}
 
+	segmentLimit, err := s.projectUsage.GetProjectSegmentLimit(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	// the live segment usage is used for enforcing the limit, it falls back
+	// to the segment count of the last tally, when it's not cached.
+	segmentUsed, err := s.projectUsage.GetProjectSegmentUsage(ctx, projectID)
+	if err != nil {
+		if !accounting.ErrKeyNotFound.Has(err) {
+			return nil, err
+		}
+		objectsSegments, err := s.projectAccounting.GetProjectObjectsSegments(ctx, projectID)
+		if err != nil {
+			return nil, err
+		}
+		segmentUsed = objectsSegments.SegmentCount
+	}
+
 	return &ProjectUsageLimits{
 		StorageLimit:   storageLimit.Int64(),
 		BandwidthLimit: bandwidthLimit.Int64(),
 		StorageUsed:    storageUsed,
 		BandwidthUsed:  bandwidthUsed,
+		SegmentLimit:   segmentLimit,
+		SegmentUsed:    segmentUsed,
 	}, nil
 }
 
@@ -2656,6 +2799,14 @@ func (s *Service) TokenAuth(ctx context.Context, token consoleauth.Token, authTi
 		return nil, err
 	}
 
+	if session.Status == consoleauth.SessionStatusImpersonation {
+		impersonation, err := s.store.Impersonations().Get(ctx, sessionID)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		ctx = WithImpersonation(ctx, impersonation)
+	}
+
 	return ctx, nil
 }
 
@@ -2678,40 +2829,112 @@ func (s *Service) KeyAuth(ctx context.Context, apikey string, authTime time.Time
 	return ctx, nil
 }
 
-// checkProjectCanBeDeleted ensures that all data, api-keys and buckets are deleted and usage has been accounted.
-// no error means the project status is clean.
-func (s *Service) checkProjectCanBeDeleted(ctx context.Context, user *User, projectID uuid.UUID) (err error) {
+// startProjectDeletion records the deletion of the project and disables the
+// access to it. The buckets, api keys and accounting records of the project
+// are deleted by the project deletion chore.
+//
+// The api keys are revoked first, which can be repeated, so a failed request
+// can be retried. The deletion is recorded in the same transaction, which
+// removes the members and the invitations of the project.
+func (s *Service) startProjectDeletion(ctx context.Context, user *User, projectID uuid.UUID) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	buckets, err := s.buckets.CountBuckets(ctx, projectID)
-	if err != nil {
-		return err
+	_, err = s.store.ProjectDeletions().Get(ctx, projectID)
+	if err == nil {
+		return ErrProjectDeletion.New("project is already being deleted")
 	}
-	if buckets > 0 {
-		return ErrUsage.New("some buckets still exist")
+	if !errs.Is(err, sql.ErrNoRows) {
+		return err
 	}
 
-	keys, err := s.store.APIKeys().GetPagedByProjectID(ctx, projectID, APIKeyCursor{Limit: 1, Page: 1})
+	// the uplinks can't use the api keys of the project anymore, when they
+	// are revoked, even though the keys are deleted only later.
+	err = s.revokeProjectAPIKeys(ctx, projectID)
 	if err != nil {
 		return err
 	}
-	if keys.TotalCount > 0 {
-		return ErrUsage.New("some api keys still exist")
-	}
 
-	if user.PaidTier {
-		err = s.Payments().checkProjectUsageStatus(ctx, projectID)
+	// the project isn't visible in the console anymore, when it doesn't have
+	// members nor pending invitations.
+	return s.store.WithTx(ctx, func(ctx context.Context, tx DBTx) error {
+		now := time.Now()
+		err := tx.ProjectDeletions().Insert(ctx, &ProjectDeletion{
+			ProjectID:   projectID,
+			RequestedBy: user.ID,
+			Stage:       ProjectDeletionStageData,
+			CreatedAt:   now,
+			UpdatedAt:   now,
+		})
 		if err != nil {
-			return ErrUsage.Wrap(err)
+			return err
 		}
-	}
 
-	err = s.Payments().checkProjectInvoicingStatus(ctx, projectID)
-	if err != nil {
-		return ErrUsage.Wrap(err)
-	}
+		for {
+			// the deleted members aren't returned anymore, so the first page
+			// is requested until it's empty.
+			page, err := tx.ProjectMembers().GetPagedByProjectID(ctx, projectID, ProjectMembersCursor{
+				Limit: 50,
+				Page:  1,
+			})
+			if err != nil {
+				return err
+			}
+			if len(page.ProjectMembers) == 0 {
+				break
+			}
+			for _, member := range page.ProjectMembers {
+				err := tx.ProjectMembers().Delete(ctx, member.MemberID, projectID)
+				if err != nil {
+					return err
+				}
+			}
+		}
 
-	return nil
+		invites, err := tx.ProjectInvitations().GetByProjectID(ctx, projectID)
+		if err != nil {
+			return err
+		}
+		for _, invite := range invites {
+			err := tx.ProjectInvitations().Delete(ctx, projectID, invite.Email)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// revokeProjectAPIKeys revokes all api keys of the project.
+func (s *Service) revokeProjectAPIKeys(ctx context.Context, projectID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	for pageNumber := uint(1); ; pageNumber++ {
+		page, err := s.store.APIKeys().GetPagedByProjectID(ctx, projectID, APIKeyCursor{
+			Limit: 50,
+			Page:  pageNumber,
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, info := range page.APIKeys {
+			// the paged keys don't contain the secrets.
+			key, err := s.store.APIKeys().Get(ctx, info.ID)
+			if err != nil {
+				return err
+			}
+
+			tail := macaroon.NewUnrestrictedFromParts(key.Head, key.Secret).Tail()
+			err = s.revocations.Revoke(ctx, tail, key.ID[:])
+			if err != nil {
+				return err
+			}
+		}
+
+		if pageNumber >= page.PageCount {
+			return nil
+		}
+	}
 }
 
 // checkProjectLimit is used to check if user is able to create a new project.
@@ -2798,6 +3021,10 @@ func (s *Service) isProjectOwner(ctx context.Context, userID uuid.UUID, projectI
 		return false, ErrUnauthorized.New(unauthorizedErrMsg)
 	}
 
+	if err := s.checkNetworkAccess(ctx, projectID); err != nil {
+		return false, err
+	}
+
 	return true, nil
 }
 
@@ -2816,6 +3043,9 @@ func (s *Service) isProjectMember(ctx context.Context, userID uuid.UUID, project
 
 	membership, ok := findMembershipByProjectID(memberships, projectID)
 	if ok {
+		if err := s.checkNetworkAccess(ctx, projectID); err != nil {
+			return isProjectMember{}, err
+		}
 		return isProjectMember{
 			project:    project,
 			membership: &membership,
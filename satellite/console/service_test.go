@@ -182,17 +182,15 @@ func TestService(t *testing.T) {
 				require.Equal(t, updateInfo.BandwidthLimit, *project.BandwidthLimit)
 			})
 
-			t.Run("AddProjectMembers", func(t *testing.T) {
-				// Adding members to own project should work
-				addedUsers, err := service.AddProjectMembers(userCtx1, up1Pro1.ID, []string{up2User.Email})
+			t.Run("InviteProjectMembers", func(t *testing.T) {
+				// members are only added by accepting an invitation.
+				_, err := service.InviteProjectMembers(userCtx1, up1Pro1.ID, []string{up2User.Email})
 				require.NoError(t, err)
-				require.Len(t, addedUsers, 1)
-				require.Contains(t, addedUsers, up2User)
+				require.NoError(t, service.RespondToProjectInvitation(userCtx2, up1Pro1.ID, console.ProjectInvitationAccept))
 
-				// Adding members to someone else project should not work
-				addedUsers, err = service.AddProjectMembers(userCtx1, up2Pro1.ID, []string{up2User.Email})
+				// Inviting members to someone else project should not work
+				_, err = service.InviteProjectMembers(userCtx1, up2Pro1.ID, []string{up2User.Email})
 				require.Error(t, err)
-				require.Nil(t, addedUsers)
 			})
 
 			t.Run("GetProjectMembers", func(t *testing.T) {
@@ -223,32 +221,37 @@ func TestService(t *testing.T) {
 			})
 
 			t.Run("DeleteProject", func(t *testing.T) {
-				// Deleting the own project should not work before deleting the API-Key
-				err := service.DeleteProject(userCtx1, up1Pro1.ID)
+				// Deleting someone else project should not work
+				err := service.DeleteProject(userCtx1, up2Pro1.ID)
 				require.Error(t, err)
 
-				keys, err := service.GetAPIKeys(userCtx1, up1Pro1.ID, console.APIKeyCursor{Page: 1, Limit: 10})
+				err = planet.Uplinks[0].CreateBucket(ctx, sat, "testbucket")
 				require.NoError(t, err)
-				require.Len(t, keys.APIKeys, 1)
 
-				err = service.DeleteAPIKeys(userCtx1, []uuid.UUID{keys.APIKeys[0].ID})
+				// Deleting the own project should work with a bucket and an API-Key,
+				// they are deleted in the background
+				err = service.DeleteProject(userCtx1, up1Pro1.ID)
 				require.NoError(t, err)
 
-				// Deleting the own project should now work
-				err = service.DeleteProject(userCtx1, up1Pro1.ID)
+				deletion, err := service.GetProjectDeletion(userCtx1, up1Pro1.ID)
 				require.NoError(t, err)
+				require.Equal(t, console.ProjectDeletionStageData, deletion.Stage)
+				require.Equal(t, up1Pro1.OwnerID, deletion.RequestedBy)
 
-				// Deleting someone else project should not work
-				err = service.DeleteProject(userCtx1, up2Pro1.ID)
-				require.Error(t, err)
+				// Getting the deletion of someone else project should not work
+				_, err = service.GetProjectDeletion(userCtx2, up1Pro1.ID)
+				require.True(t, console.ErrUnauthorized.Has(err))
 
-				err = planet.Uplinks[1].CreateBucket(ctx, sat, "testbucket")
-				require.NoError(t, err)
+				// the project and its API-Key can't be used anymore
+				_, err = service.GetProject(userCtx1, up1Pro1.ID)
+				require.Error(t, err)
 
-				// deleting a project with a bucket should fail
-				err = service.DeleteProject(userCtx2, up2Pro1.ID)
+				_, err = planet.Uplinks[0].ListBuckets(ctx, sat)
 				require.Error(t, err)
-				require.Equal(t, "console service: project usage: some buckets still exist", err.Error())
+
+				// Deleting the project again should not work
+				err = service.DeleteProject(userCtx1, up1Pro1.ID)
+				require.True(t, console.ErrProjectDeletion.Has(err))
 			})
 
 			t.Run("ChangeEmail", func(t *testing.T) {
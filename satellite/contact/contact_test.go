@@ -8,15 +8,23 @@ import (
 	"crypto/x509"
 	"net"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
 
+	"storj.io/common/errs2"
 	"storj.io/common/pb"
 	"storj.io/common/rpc/rpcpeer"
 	"storj.io/common/rpc/rpcstatus"
+	"storj.io/common/signing"
 	"storj.io/common/storj"
 	"storj.io/common/testcontext"
+	"storj.io/storj/private/contactpb"
+	"storj.io/storj/private/nodecapabilities"
+	"storj.io/storj/private/satellitesuccessor"
 	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite"
 	"storj.io/storj/storagenode"
 )
 
@@ -54,6 +62,64 @@ func TestSatelliteContactEndpoint(t *testing.T) {
 	})
 }
 
+func TestSatelliteContactEndpointV2(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		nodeInfo := planet.StorageNodes[0].Contact.Service.Local()
+		ident := planet.StorageNodes[0].Identity
+
+		successor, err := satellitesuccessor.Sign(ctx, signing.SignerFromFullIdentity(satellite.Identity),
+			storj.NodeURL{ID: ident.ID, Address: "successor.test:7777"}, time.Now())
+		require.NoError(t, err)
+		satellite.Contact.Service.SetSuccessor(successor)
+
+		peer := rpcpeer.Peer{
+			Addr: &net.TCPAddr{
+				IP:   net.ParseIP(nodeInfo.Address),
+				Port: 5,
+			},
+			State: tls.ConnectionState{
+				PeerCertificates: []*x509.Certificate{ident.Leaf, ident.CA},
+			},
+		}
+		peerCtx := rpcpeer.NewContext(ctx, &peer)
+
+		capabilities := nodecapabilities.Capabilities{
+			Protocols:    []string{nodecapabilities.ProtocolTCP},
+			TTL:          true,
+			MaxPieceSize: 64 << 20,
+		}
+		resp, err := satellite.Contact.EndpointV2.CheckIn(peerCtx, &contactpb.CheckInRequest{
+			CheckIn: &pb.CheckInRequest{
+				Address:  nodeInfo.Address,
+				Version:  &nodeInfo.Version,
+				Capacity: &nodeInfo.Capacity,
+				Operator: &nodeInfo.Operator,
+			},
+			Capabilities: capabilities.Proto(),
+		})
+		require.NoError(t, err)
+		require.True(t, resp.CheckIn.PingNodeSuccess)
+		require.Nil(t, resp.Decommission)
+
+		announced, err := satellitesuccessor.FromProto(resp.Successor)
+		require.NoError(t, err)
+		require.Equal(t, successor, announced)
+
+		node, err := satellite.Overlay.Service.Get(ctx, nodeInfo.ID)
+		require.NoError(t, err)
+		require.True(t, capabilities.Equal(node.Capabilities))
+
+		// the check-in itself is required.
+		_, err = satellite.Contact.EndpointV2.CheckIn(peerCtx, &contactpb.CheckInRequest{
+			Capabilities: capabilities.Proto(),
+		})
+		require.True(t, errs2.IsRPC(err, rpcstatus.InvalidArgument))
+	})
+}
+
 func TestSatelliteContactEndpoint_QUIC_Unreachable(t *testing.T) {
 	testplanet.Run(t, testplanet.Config{
 		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 0,
@@ -93,6 +159,59 @@ func TestSatelliteContactEndpoint_QUIC_Unreachable(t *testing.T) {
 	})
 }
 
+func TestSatelliteContactEndpoint_InvalidWallet(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 0,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Contact.RejectInvalidWallet = true
+				config.Contact.WalletChecksum = true
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		nodeInfo := planet.StorageNodes[0].Contact.Service.Local()
+		ident := planet.StorageNodes[0].Identity
+
+		peer := rpcpeer.Peer{
+			Addr: &net.TCPAddr{
+				IP:   net.ParseIP(nodeInfo.Address),
+				Port: 5,
+			},
+			State: tls.ConnectionState{
+				PeerCertificates: []*x509.Certificate{ident.Leaf, ident.CA},
+			},
+		}
+		peerCtx := rpcpeer.NewContext(ctx, &peer)
+
+		for _, wallet := range []string{"", "0x123", "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAeD"} {
+			operator := nodeInfo.Operator
+			operator.Wallet = wallet
+			_, err := planet.Satellites[0].Contact.Endpoint.CheckIn(peerCtx, &pb.CheckInRequest{
+				Address:  nodeInfo.Address,
+				Version:  &nodeInfo.Version,
+				Capacity: &nodeInfo.Capacity,
+				Operator: &operator,
+			})
+			require.Error(t, err, wallet)
+			require.Equal(t, rpcstatus.InvalidArgument, rpcstatus.Code(err), wallet)
+		}
+
+		operator := nodeInfo.Operator
+		operator.Wallet = "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"
+		_, err := planet.Satellites[0].Contact.Endpoint.CheckIn(peerCtx, &pb.CheckInRequest{
+			Address:  nodeInfo.Address,
+			Version:  &nodeInfo.Version,
+			Capacity: &nodeInfo.Capacity,
+			Operator: &operator,
+		})
+		require.NoError(t, err)
+
+		dossier, err := planet.Satellites[0].Overlay.Service.Get(ctx, nodeInfo.ID)
+		require.NoError(t, err)
+		require.Equal(t, operator.Wallet, dossier.Operator.Wallet)
+	})
+}
+
 func TestSatellitePingBack_Failure(t *testing.T) {
 	testplanet.Run(t, testplanet.Config{
 		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 0,
@@ -17,6 +17,9 @@ import (
 	"storj.io/common/rpc/rpcstatus"
 	"storj.io/common/storj"
 	"storj.io/drpc/drpcctx"
+	"storj.io/storj/private/contactpb"
+	"storj.io/storj/private/nodecapabilities"
+	"storj.io/storj/private/nodeinventory"
 	"storj.io/storj/private/nodeoperator"
 	"storj.io/storj/satellite/overlay"
 )
@@ -26,6 +29,7 @@ var (
 	errCheckInIdentity  = errs.Class("check-in identity")
 	errCheckInRateLimit = errs.Class("check-in ratelimit")
 	errCheckInNetwork   = errs.Class("check-in network")
+	errCheckInWallet    = errs.Class("check-in wallet")
 )
 
 // Endpoint implements the contact service Endpoints.
@@ -51,6 +55,13 @@ func NewEndpoint(log *zap.Logger, service *Service) *Endpoint {
 func (endpoint *Endpoint) CheckIn(ctx context.Context, req *pb.CheckInRequest) (_ *pb.CheckInResponse, err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	return endpoint.checkIn(ctx, req, nodecapabilities.Capabilities{})
+}
+
+// checkIn checks in the node with the capabilities it announced.
+func (endpoint *Endpoint) checkIn(ctx context.Context, req *pb.CheckInRequest, capabilities nodecapabilities.Capabilities) (_ *pb.CheckInResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
 	peerID, err := identity.PeerIdentityFromContext(ctx)
 	if err != nil {
 		endpoint.log.Info("failed to get node ID from context", zap.String("node address", req.Address), zap.Error(err))
@@ -81,6 +92,19 @@ func (endpoint *Endpoint) CheckIn(ctx context.Context, req *pb.CheckInRequest) (
 		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, errCheckInNetwork.New("IP address not allowed: %s", req.Address).Error())
 	}
 
+	// check wallet address
+	if err := endpoint.service.walletValidation.Validate(req.Operator.GetWallet()); err != nil {
+		mon.Counter("checkin_invalid_wallet").Inc(1)
+		endpoint.log.Info("invalid wallet address",
+			zap.Stringer("Node ID", nodeID),
+			zap.String("Wallet", req.Operator.GetWallet()),
+			zap.Error(err))
+
+		if endpoint.service.rejectInvalidWallet {
+			return nil, rpcstatus.Error(rpcstatus.InvalidArgument, errCheckInWallet.Wrap(err).Error())
+		}
+	}
+
 	nodeurl := storj.NodeURL{
 		ID:      nodeID,
 		Address: req.Address,
@@ -108,12 +132,13 @@ func (endpoint *Endpoint) CheckIn(ctx context.Context, req *pb.CheckInRequest) (
 			Address:   req.Address,
 			Transport: pb.NodeTransport_TCP_TLS_GRPC,
 		},
-		LastNet:    resolvedNetwork,
-		LastIPPort: net.JoinHostPort(resolvedIP.String(), port),
-		IsUp:       pingNodeSuccess,
-		Capacity:   req.Capacity,
-		Operator:   req.Operator,
-		Version:    req.Version,
+		LastNet:      resolvedNetwork,
+		LastIPPort:   net.JoinHostPort(resolvedIP.String(), port),
+		IsUp:         pingNodeSuccess,
+		Capacity:     req.Capacity,
+		Operator:     req.Operator,
+		Version:      req.Version,
+		Capabilities: capabilities,
 	}
 
 	endpoint.emitEvenkitEvent(ctx, req, pingNodeSuccess, pingNodeSuccessQUIC, nodeInfo)
@@ -124,6 +149,13 @@ func (endpoint *Endpoint) CheckIn(ctx context.Context, req *pb.CheckInRequest) (
 		return nil, rpcstatus.Error(rpcstatus.Internal, Error.Wrap(err).Error())
 	}
 
+	if inv, ok := nodeinventory.FromContext(ctx); ok {
+		// the inventory is only informational, so the check-in doesn't fail.
+		if err := endpoint.service.inventories.Update(ctx, nodeID, inv, time.Now()); err != nil {
+			endpoint.log.Warn("failed to update node inventory", zap.Stringer("Node ID", nodeID), zap.Error(err))
+		}
+	}
+
 	endpoint.log.Debug("checking in", zap.Stringer("Node ID", nodeID), zap.String("node addr", req.Address), zap.Bool("ping node success", pingNodeSuccess), zap.String("ping node err msg", pingErrorMessage))
 	return &pb.CheckInResponse{
 		PingNodeSuccess:     pingNodeSuccess,
@@ -132,6 +164,45 @@ func (endpoint *Endpoint) CheckIn(ctx context.Context, req *pb.CheckInRequest) (
 	}, nil
 }
 
+// EndpointV2 implements the second version of the check-in.
+type EndpointV2 struct {
+	contactpb.DRPCNodeUnimplementedServer
+	endpoint *Endpoint
+}
+
+// NewEndpointV2 returns the second version of the check-in endpoint, which
+// shares the service with endpoint.
+func NewEndpointV2(endpoint *Endpoint) *EndpointV2 {
+	return &EndpointV2{endpoint: endpoint}
+}
+
+// CheckIn checks in the node with the capabilities it announced. In return,
+// the satellite announces its successor and its shutdown, so the nodes start
+// to trust the successor and stop trusting the satellite.
+func (endpoint *EndpointV2) CheckIn(ctx context.Context, req *contactpb.CheckInRequest) (_ *contactpb.CheckInResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if req.CheckIn == nil {
+		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, "check-in is missing")
+	}
+
+	checkIn, err := endpoint.endpoint.checkIn(ctx, req.CheckIn, nodecapabilities.FromProto(req.Capabilities))
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &contactpb.CheckInResponse{
+		CheckIn: checkIn,
+	}
+	if successor, ok := endpoint.endpoint.service.Successor(); ok {
+		resp.Successor = successor.Proto()
+	}
+	if notice, ok := endpoint.endpoint.service.Decommission(); ok {
+		resp.Decommission = notice.Proto()
+	}
+	return resp, nil
+}
+
 func (endpoint *Endpoint) emitEvenkitEvent(ctx context.Context, req *pb.CheckInRequest, pingNodeTCPSuccess bool, pingNodeQUICSuccess bool, nodeInfo overlay.NodeCheckInInfo) {
 	var sourceAddr string
 	transport, found := drpcctx.Transport(ctx)
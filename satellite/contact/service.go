@@ -17,7 +17,11 @@ import (
 	"storj.io/common/rpc/quic"
 	"storj.io/common/rpc/rpcstatus"
 	"storj.io/common/storj"
+	"storj.io/storj/private/nodeoperator"
+	"storj.io/storj/private/satellitedecommission"
+	"storj.io/storj/private/satellitesuccessor"
 	"storj.io/storj/satellite/overlay"
+	"storj.io/storj/satellite/overlay/inventory"
 )
 
 // Config contains configurable values for contact service.
@@ -26,9 +30,15 @@ type Config struct {
 	Timeout         time.Duration `help:"timeout for pinging storage nodes" default:"10m0s" testDefault:"1m"`
 	AllowPrivateIP  bool          `help:"allow private IPs in CheckIn and PingMe" testDefault:"true" devDefault:"true" default:"false"`
 
+	RejectInvalidWallet bool `help:"reject check-ins of nodes with invalid wallet addresses, otherwise they're only logged" default:"false"`
+	WalletChecksum      bool `help:"require a valid EIP-55 checksum for mixed-case wallet addresses" default:"false"`
+
 	RateLimitInterval  time.Duration `help:"the amount of time that should happen between contact attempts usually" releaseDefault:"10m0s" devDefault:"1ns"`
 	RateLimitBurst     int           `help:"the maximum burst size for the contact rate limit token bucket" releaseDefault:"2" devDefault:"1000"`
 	RateLimitCacheSize int           `help:"the number of nodes or addresses to keep token buckets for" default:"1000"`
+
+	Successor  storj.NodeURL `help:"node URL of the satellite, which succeeds this satellite, announced to the storage nodes during check-in" default:""`
+	ShutdownAt string        `help:"time in RFC3339 format, when this satellite shuts down, announced to the storage nodes as signed decommission notice during check-in" default:""`
 }
 
 // Service is the contact service between storage nodes and satellites.
@@ -42,26 +52,37 @@ type Service struct {
 	mutex sync.Mutex
 	self  *overlay.NodeDossier
 
-	overlay *overlay.Service
-	peerIDs overlay.PeerIdentities
-	dialer  rpc.Dialer
+	overlay     *overlay.Service
+	peerIDs     overlay.PeerIdentities
+	inventories inventory.DB
+	dialer      rpc.Dialer
 
 	timeout        time.Duration
 	idLimiter      *RateLimiter
 	allowPrivateIP bool
+
+	walletValidation    nodeoperator.WalletValidation
+	rejectInvalidWallet bool
+
+	successor    *satellitesuccessor.Pointer
+	decommission *satellitedecommission.Notice
 }
 
 // NewService creates a new contact service.
-func NewService(log *zap.Logger, self *overlay.NodeDossier, overlay *overlay.Service, peerIDs overlay.PeerIdentities, dialer rpc.Dialer, config Config) *Service {
+func NewService(log *zap.Logger, self *overlay.NodeDossier, overlay *overlay.Service, peerIDs overlay.PeerIdentities, inventories inventory.DB, dialer rpc.Dialer, config Config) *Service {
 	return &Service{
 		log:            log,
 		self:           self,
 		overlay:        overlay,
 		peerIDs:        peerIDs,
+		inventories:    inventories,
 		dialer:         dialer,
 		timeout:        config.Timeout,
 		idLimiter:      NewRateLimiter(config.RateLimitInterval, config.RateLimitBurst, config.RateLimitCacheSize),
 		allowPrivateIP: config.AllowPrivateIP,
+
+		walletValidation:    nodeoperator.WalletValidation{Checksum: config.WalletChecksum},
+		rejectInvalidWallet: config.RejectInvalidWallet,
 	}
 }
 
@@ -72,6 +93,43 @@ func (service *Service) Local() overlay.NodeDossier {
 	return *service.self
 }
 
+// SetSuccessor sets the signed pointer to the successor of the satellite,
+// which is announced to the storage nodes during check-in.
+func (service *Service) SetSuccessor(pointer satellitesuccessor.Pointer) {
+	service.mutex.Lock()
+	defer service.mutex.Unlock()
+	service.successor = &pointer
+}
+
+// Successor returns the signed pointer to the successor of the satellite.
+func (service *Service) Successor() (_ satellitesuccessor.Pointer, ok bool) {
+	service.mutex.Lock()
+	defer service.mutex.Unlock()
+	if service.successor == nil {
+		return satellitesuccessor.Pointer{}, false
+	}
+	return *service.successor, true
+}
+
+// SetDecommission sets the signed notice, which announces the shutdown of the
+// satellite to the storage nodes during check-in.
+func (service *Service) SetDecommission(notice satellitedecommission.Notice) {
+	service.mutex.Lock()
+	defer service.mutex.Unlock()
+	service.decommission = &notice
+}
+
+// Decommission returns the signed notice, which announces the shutdown of the
+// satellite.
+func (service *Service) Decommission() (_ satellitedecommission.Notice, ok bool) {
+	service.mutex.Lock()
+	defer service.mutex.Unlock()
+	if service.decommission == nil {
+		return satellitedecommission.Notice{}, false
+	}
+	return *service.decommission, true
+}
+
 // Close closes resources.
 func (service *Service) Close() error { return nil }
 
@@ -23,16 +23,26 @@ import (
 	"storj.io/private/debug"
 	"storj.io/private/version"
 	"storj.io/storj/private/lifecycle"
+	"storj.io/storj/private/otlp"
 	version_checker "storj.io/storj/private/version/checker"
+	"storj.io/storj/satellite/accesslog"
 	"storj.io/storj/satellite/accounting"
+	"storj.io/storj/satellite/accounting/egressabuse"
+	"storj.io/storj/satellite/accounting/live"
 	"storj.io/storj/satellite/accounting/nodetally"
 	"storj.io/storj/satellite/accounting/projectbwcleanup"
+	"storj.io/storj/satellite/accounting/projectops"
 	"storj.io/storj/satellite/accounting/rollup"
 	"storj.io/storj/satellite/accounting/rolluparchive"
+	"storj.io/storj/satellite/accounting/rollupexport"
 	"storj.io/storj/satellite/accounting/tally"
+	"storj.io/storj/satellite/attestation"
 	"storj.io/storj/satellite/audit"
+	"storj.io/storj/satellite/buckets"
 	"storj.io/storj/satellite/console/consoleauth"
 	"storj.io/storj/satellite/console/emailreminders"
+	"storj.io/storj/satellite/console/projectdeletion"
+	"storj.io/storj/satellite/events"
 	"storj.io/storj/satellite/gracefulexit"
 	"storj.io/storj/satellite/mailservice"
 	"storj.io/storj/satellite/metabase"
@@ -49,8 +59,12 @@ import (
 	"storj.io/storj/satellite/payments/billing"
 	"storj.io/storj/satellite/payments/storjscan"
 	"storj.io/storj/satellite/payments/stripecoinpayments"
+	"storj.io/storj/satellite/payouts"
 	"storj.io/storj/satellite/repair/checker"
+	"storj.io/storj/satellite/replication"
 	"storj.io/storj/satellite/reputation"
+	"storj.io/storj/satellite/sealedaccess"
+	"storj.io/storj/satellite/secrets"
 )
 
 // Core is the satellite core process that runs chores.
@@ -72,6 +86,10 @@ type Core struct {
 		Service *version_checker.Service
 	}
 
+	Events struct {
+		Bus *events.Bus
+	}
+
 	Mail struct {
 		Service        *mailservice.Service
 		EmailReminders *emailreminders.Chore
@@ -82,6 +100,14 @@ type Core struct {
 		Server   *debug.Server
 	}
 
+	Tracing struct {
+		Exporter *otlp.Exporter
+	}
+
+	Secrets struct {
+		Chore *secrets.Chore
+	}
+
 	// services and endpoints
 	Overlay struct {
 		DB                overlay.DB
@@ -102,9 +128,10 @@ type Core struct {
 	}
 
 	Orders struct {
-		DB      orders.DB
-		Service *orders.Service
-		Chore   *orders.Chore
+		DB         orders.DB
+		Service    *orders.Service
+		Chore      *orders.Chore
+		Reconciler *orders.Reconciler
 	}
 
 	Reputation struct {
@@ -128,12 +155,39 @@ type Core struct {
 		Chore *zombiedeletion.Chore
 	}
 
+	ProjectDeletion struct {
+		Chore *projectdeletion.Chore
+	}
+
+	Replication struct {
+		Service *replication.Service
+	}
+
+	Attestation struct {
+		Chore *attestation.Chore
+	}
+
+	AccessLog struct {
+		Exporter *accesslog.Exporter
+	}
+
 	Accounting struct {
 		Tally                 *tally.Service
 		NodeTally             *nodetally.Service
 		Rollup                *rollup.Service
 		RollupArchiveChore    *rolluparchive.Chore
+		RollupExporter        *rollupexport.Archiver
 		ProjectBWCleanupChore *projectbwcleanup.Chore
+		BandwidthReconciler   *live.BandwidthReconciler
+		ProjectOpsCleanup     *projectops.Chore
+		EgressAbuseChore      *egressabuse.Chore
+	}
+
+	Payouts struct {
+		Service      *payouts.Service
+		Chore        *payouts.Chore
+		BatchService *payouts.BatchService
+		BatchChore   *payouts.BatchChore
 	}
 
 	LiveAccounting struct {
@@ -158,7 +212,7 @@ type Core struct {
 }
 
 // New creates a new satellite.
-func New(log *zap.Logger, full *identity.FullIdentity, db DB,
+func New(ctx context.Context, log *zap.Logger, full *identity.FullIdentity, db DB,
 	metabaseDB *metabase.DB, revocationDB extensions.RevocationDB,
 	liveAccounting accounting.Cache, rollupsWriteCache *orders.RollupsWriteCache,
 	versionInfo version.Info, config *Config, atomicLogLevel *zap.AtomicLevel) (*Core, error) {
@@ -190,6 +244,37 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB,
 		})
 	}
 
+	var peerSecrets *secretsLoader
+	{ // setup secrets
+		var err error
+		peerSecrets, err = newSecretsLoader(ctx, log.Named("secrets:chore"), config.Secrets)
+		if err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
+		peer.Secrets.Chore = peerSecrets.Chore
+		if peer.Secrets.Chore != nil {
+			peer.Services.Add(lifecycle.Item{
+				Name:  "secrets:chore",
+				Run:   peer.Secrets.Chore.Run,
+				Close: peer.Secrets.Chore.Close,
+			})
+			peer.Debug.Server.Panel.Add(
+				debug.Cycle("Secrets Chore", peer.Secrets.Chore.Loop))
+		}
+	}
+
+	{ // setup trace export
+		var err error
+		peer.Tracing.Exporter, err = otlp.NewExporter(log.Named("tracing"), monkit.Default, config.OTLP, "satellite-core")
+		if err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
+		peer.Services.Add(lifecycle.Item{
+			Name: "tracing",
+			Run:  peer.Tracing.Exporter.Run,
+		})
+	}
+
 	var err error
 
 	{ // setup version control
@@ -233,10 +318,11 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB,
 
 	{ // setup email reminders
 		if config.EmailReminders.Enable {
-			authTokens := consoleauth.NewService(config.ConsoleAuth, &consoleauth.Hmac{Secret: []byte(config.Console.AuthTokenSecret)})
+			authTokenSigner, err := peerSecrets.consoleAuthSigner(ctx, config.Console.AuthTokenSecret)
 			if err != nil {
 				return nil, errs.Combine(err, peer.Close())
 			}
+			authTokens := consoleauth.NewService(config.ConsoleAuth, authTokenSigner)
 
 			peer.Mail.EmailReminders = emailreminders.NewChore(
 				peer.Log.Named("console:chore"),
@@ -255,9 +341,20 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB,
 		}
 	}
 
+	{ // setup events
+		peer.Events.Bus, err = events.NewBus(peer.Log.Named("events:bus"), config.Events)
+		if err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
+		peer.Services.Add(lifecycle.Item{
+			Name: "events:bus",
+			Run:  peer.Events.Bus.Run,
+		})
+	}
+
 	{ // setup overlay
 		peer.Overlay.DB = peer.DB.OverlayCache()
-		peer.Overlay.Service, err = overlay.NewService(peer.Log.Named("overlay"), peer.Overlay.DB, peer.DB.NodeEvents(), peer.Mail.Service, config.Console.ExternalAddress, config.Console.SatelliteName, config.Overlay)
+		peer.Overlay.Service, err = overlay.NewService(peer.Log.Named("overlay"), peer.Overlay.DB, peer.DB.NodeEvents(), peer.Events.Bus, peer.Mail.Service, config.Console.ExternalAddress, config.Console.SatelliteName, config.Overlay)
 		if err != nil {
 			return nil, errs.Combine(err, peer.Close())
 		}
@@ -325,17 +422,32 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB,
 			Run:   peer.Orders.Chore.Run,
 			Close: peer.Orders.Chore.Close,
 		})
-		var err error
+		ordersConfig, err := peerSecrets.ordersConfig(ctx, config.Orders)
+		if err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
 		peer.Orders.Service, err = orders.NewService(
 			peer.Log.Named("orders:service"),
 			signing.SignerFromFullIdentity(peer.Identity),
 			peer.Overlay.Service,
 			peer.Orders.DB,
-			config.Orders,
+			ordersConfig,
 		)
 		if err != nil {
 			return nil, errs.Combine(err, peer.Close())
 		}
+		peerSecrets.watchOrders(peer.Orders.Service)
+
+		if config.Orders.Reconciliation.Enabled {
+			peer.Orders.Reconciler = orders.NewReconciler(peer.Log.Named("orders:reconciler"), peer.Orders.DB, config.Orders.Reconciliation)
+			peer.Services.Add(lifecycle.Item{
+				Name:  "orders:reconciler",
+				Run:   peer.Orders.Reconciler.Run,
+				Close: peer.Orders.Reconciler.Close,
+			})
+			peer.Debug.Server.Panel.Add(
+				debug.Cycle("Orders Reconciler", peer.Orders.Reconciler.Loop))
+		}
 	}
 
 	{ // setup metainfo
@@ -403,6 +515,7 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB,
 			peer.Audit.Chore = audit.NewChore(peer.Log.Named("audit:chore"),
 				peer.Audit.VerifyQueue,
 				peer.Metainfo.SegmentLoop,
+				peer.Metainfo.Metabase,
 				config,
 			)
 			peer.Services.Add(lifecycle.Item{
@@ -420,6 +533,7 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB,
 			peer.Log.Named("core-expired-deletion"),
 			config.ExpiredDeletion,
 			peer.Metainfo.Metabase,
+			peer.Events.Bus,
 		)
 		peer.Services.Add(lifecycle.Item{
 			Name:  "expireddeletion:chore",
@@ -445,6 +559,62 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB,
 			debug.Cycle("Zombie Objects Chore", peer.ZombieDeletion.Chore.Loop))
 	}
 
+	accessSealer, err := sealedaccess.NewSealer(config.AccessEncryption)
+	if err != nil {
+		return nil, errs.Combine(err, peer.Close())
+	}
+
+	{ // setup bucket replication
+		peer.Replication.Service = replication.NewService(
+			peer.Log.Named("replication"),
+			peer.DB.Replication(),
+			accessSealer,
+			config.Replication,
+		)
+		peer.Services.Add(lifecycle.Item{
+			Name:  "replication",
+			Run:   peer.Replication.Service.Run,
+			Close: peer.Replication.Service.Close,
+		})
+		peer.Debug.Server.Panel.Add(
+			debug.Cycle("Bucket Replication", peer.Replication.Service.Loop))
+	}
+
+	{ // setup durability reports
+		peer.Attestation.Chore = attestation.NewChore(
+			peer.Log.Named("attestation:chore"),
+			peer.DB.Console().DurabilityReports(),
+			peer.DB.Buckets(),
+			peer.Metainfo.Metabase,
+			peer.Overlay.Service,
+			signing.SignerFromFullIdentity(peer.Identity),
+			config.Attestation,
+		)
+		peer.Services.Add(lifecycle.Item{
+			Name:  "attestation:chore",
+			Run:   peer.Attestation.Chore.Run,
+			Close: peer.Attestation.Chore.Close,
+		})
+		peer.Debug.Server.Panel.Add(
+			debug.Cycle("Durability Reports", peer.Attestation.Chore.Loop))
+	}
+
+	{ // setup access log export
+		peer.AccessLog.Exporter = accesslog.NewExporter(
+			peer.Log.Named("accesslog:exporter"),
+			peer.DB.AccessLog(),
+			accessSealer,
+			config.AccessLog,
+		)
+		peer.Services.Add(lifecycle.Item{
+			Name:  "accesslog:exporter",
+			Run:   peer.AccessLog.Exporter.Run,
+			Close: peer.AccessLog.Exporter.Close,
+		})
+		peer.Debug.Server.Panel.Add(
+			debug.Cycle("Access Log Export", peer.AccessLog.Exporter.Loop))
+	}
+
 	{ // setup accounting
 		peer.Accounting.Tally = tally.New(peer.Log.Named("accounting:tally"), peer.DB.StoragenodeAccounting(), peer.DB.ProjectAccounting(), peer.LiveAccounting.Cache, peer.Metainfo.Metabase, peer.DB.Buckets(), config.Tally)
 		peer.Services.Add(lifecycle.Item{
@@ -483,6 +653,24 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB,
 		peer.Debug.Server.Panel.Add(
 			debug.Cycle("Accounting Project Bandwidth Rollup", peer.Accounting.ProjectBWCleanupChore.Loop))
 
+		peer.Accounting.BandwidthReconciler = live.NewBandwidthReconciler(peer.Log.Named("accounting:live-bandwidth-reconciler"), peer.LiveAccounting.Cache, peer.DB.ProjectAccounting(), config.LiveAccounting)
+		peer.Services.Add(lifecycle.Item{
+			Name:  "accounting:live-bandwidth-reconciler",
+			Run:   peer.Accounting.BandwidthReconciler.Run,
+			Close: peer.Accounting.BandwidthReconciler.Close,
+		})
+		peer.Debug.Server.Panel.Add(
+			debug.Cycle("Accounting Live Bandwidth Reconciler", peer.Accounting.BandwidthReconciler.Loop))
+
+		peer.Accounting.ProjectOpsCleanup = projectops.NewChore(peer.Log.Named("accounting:projectops-cleanup"), peer.DB.ProjectOperations(), config.ProjectOps)
+		peer.Services.Add(lifecycle.Item{
+			Name:  "accounting:projectops-cleanup",
+			Run:   peer.Accounting.ProjectOpsCleanup.Run,
+			Close: peer.Accounting.ProjectOpsCleanup.Close,
+		})
+		peer.Debug.Server.Panel.Add(
+			debug.Cycle("Accounting Project Operations Cleanup", peer.Accounting.ProjectOpsCleanup.Loop))
+
 		if config.RollupArchive.Enabled {
 			peer.Accounting.RollupArchiveChore = rolluparchive.New(peer.Log.Named("accounting:rollup-archive"), peer.DB.StoragenodeAccounting(), peer.DB.ProjectAccounting(), config.RollupArchive)
 			peer.Services.Add(lifecycle.Item{
@@ -495,6 +683,75 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB,
 		} else {
 			peer.Log.Named("rolluparchive").Info("disabled")
 		}
+
+		if config.RollupExport.Enabled {
+			peer.Accounting.RollupExporter = rollupexport.NewArchiver(peer.Log.Named("accounting:rollup-export"), peer.DB.RollupExport(), config.RollupExport)
+			peer.Services.Add(lifecycle.Item{
+				Name:  "accounting:rollup-export",
+				Run:   peer.Accounting.RollupExporter.Run,
+				Close: peer.Accounting.RollupExporter.Close,
+			})
+			peer.Debug.Server.Panel.Add(
+				debug.Cycle("Accounting Rollup Export", peer.Accounting.RollupExporter.Loop))
+		} else {
+			peer.Log.Named("rollupexport").Info("disabled")
+		}
+
+		if config.EgressAbuse.Enabled {
+			peer.Accounting.EgressAbuseChore = egressabuse.NewChore(peer.Log.Named("accounting:egress-abuse"),
+				peer.DB.ProjectAccounting(), peer.DB.StoragenodeAccounting(),
+				peer.DB.Console().Projects(), peer.DB.Console().Users(), config.EgressAbuse)
+			peer.Services.Add(lifecycle.Item{
+				Name:  "accounting:egress-abuse",
+				Run:   peer.Accounting.EgressAbuseChore.Run,
+				Close: peer.Accounting.EgressAbuseChore.Close,
+			})
+			peer.Debug.Server.Panel.Add(
+				debug.Cycle("Accounting Egress Abuse", peer.Accounting.EgressAbuseChore.Loop))
+		}
+	}
+
+	{ // setup payouts
+		peer.Payouts.Service = payouts.NewService(
+			peer.Log.Named("payouts:service"),
+			peer.DB.PayoutContracts(),
+			peer.DB.Compensation(),
+			peer.DB.StoragenodeAccounting(),
+			peer.DB.OverlayCache(),
+			config.Compensation,
+			config.Payouts,
+		)
+		peer.Payouts.Chore = payouts.NewChore(
+			peer.Log.Named("payouts:chore"),
+			peer.Payouts.Service,
+			config.Payouts,
+		)
+		peer.Services.Add(lifecycle.Item{
+			Name:  "payouts:chore",
+			Run:   peer.Payouts.Chore.Run,
+			Close: peer.Payouts.Chore.Close,
+		})
+		peer.Debug.Server.Panel.Add(
+			debug.Cycle("Payouts", peer.Payouts.Chore.Loop))
+
+		peer.Payouts.BatchService = payouts.NewBatchService(
+			peer.Log.Named("payouts:batch-service"),
+			peer.DB.PayoutBatches(),
+			peer.DB.OverlayCache(),
+			payouts.NewClient(config.Payouts.L2.Endpoint),
+		)
+		peer.Payouts.BatchChore = payouts.NewBatchChore(
+			peer.Log.Named("payouts:batch-chore"),
+			peer.Payouts.BatchService,
+			config.Payouts.L2,
+		)
+		peer.Services.Add(lifecycle.Item{
+			Name:  "payouts:batch-chore",
+			Run:   peer.Payouts.BatchChore.Run,
+			Close: peer.Payouts.BatchChore.Close,
+		})
+		peer.Debug.Server.Panel.Add(
+			debug.Cycle("Payouts Batches", peer.Payouts.BatchChore.Loop))
 	}
 
 	// TODO: remove in future, should be in API
@@ -510,7 +767,11 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB,
 				peer.DB.Console().Users(),
 			)
 		case "stripecoinpayments":
-			stripeClient = stripecoinpayments.NewStripeClient(log, pc.StripeCoinPayments)
+			stripeConfig, err := peerSecrets.stripeConfig(ctx, pc.StripeCoinPayments)
+			if err != nil {
+				return nil, errs.Combine(err, peer.Close())
+			}
+			stripeClient = stripecoinpayments.NewStripeClient(log, stripeConfig)
 		}
 
 		prices, err := pc.UsagePrice.ToModel()
@@ -534,7 +795,8 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB,
 			peer.DB.ProjectAccounting(),
 			prices,
 			priceOverrides,
-			pc.BonusRate)
+			pc.BonusRate,
+			peer.Events.Bus)
 		if err != nil {
 			return nil, errs.Combine(err, peer.Close())
 		}
@@ -584,6 +846,25 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB,
 		})
 	}
 
+	{ // setup deleted projects purge
+		peer.ProjectDeletion.Chore = projectdeletion.NewChore(
+			peer.Log.Named("console:projectdeletion"),
+			config.ProjectDeletion,
+			peer.DB.Console(),
+			buckets.NewService(peer.DB.Buckets(), peer.Metainfo.Metabase),
+			peer.Metainfo.Metabase,
+			peer.DB.ProjectAccounting(),
+			peer.Payments.Accounts,
+		)
+		peer.Services.Add(lifecycle.Item{
+			Name:  "console:projectdeletion",
+			Run:   peer.ProjectDeletion.Chore.Run,
+			Close: peer.ProjectDeletion.Chore.Close,
+		})
+		peer.Debug.Server.Panel.Add(
+			debug.Cycle("Project Deletion Chore", peer.ProjectDeletion.Chore.Loop))
+	}
+
 	{ // setup graceful exit
 		log := peer.Log.Named("gracefulexit")
 		switch {
@@ -0,0 +1,236 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package events
+
+import (
+	"context"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/uuid"
+)
+
+var (
+	// Error is the standard error class for events.
+	Error = errs.Class("events")
+	mon   = monkit.Package()
+)
+
+// Type is the type of a domain event.
+type Type string
+
+const (
+	// ObjectCommitted is published when an object has been committed, copied
+	// or moved to its location.
+	ObjectCommitted Type = "object.committed"
+	// ObjectDeleted is published when a committed object has been deleted,
+	// moved away or has expired.
+	ObjectDeleted Type = "object.deleted"
+	// ObjectMetadataUpdated is published when the metadata of a committed
+	// object has been replaced.
+	ObjectMetadataUpdated Type = "object.metadata_updated"
+	// BucketObjectsDeleted is published when all objects of a bucket have
+	// been deleted together with the bucket.
+	BucketObjectsDeleted Type = "bucket.objects_deleted"
+	// NodeDisqualified is published when a node has been disqualified.
+	NodeDisqualified Type = "node.disqualified"
+	// InvoiceFinalized is published when the invoices of a billing period have been finalized.
+	InvoiceFinalized Type = "invoice.finalized"
+)
+
+// Event is a domain event published to the sinks.
+type Event struct {
+	ID         uuid.UUID         `json:"id"`
+	Type       Type              `json:"type"`
+	Time       time.Time         `json:"time"`
+	Attributes map[string]string `json:"attributes,omitempty"`
+}
+
+// Config contains configurable values for the event bus.
+type Config struct {
+	BufferSize    int           `help:"number of events buffered for the sinks, before new events are dropped" default:"10000"`
+	BatchSize     int           `help:"maximum number of events sent to the sinks at once" default:"100"`
+	FlushInterval time.Duration `help:"how often the buffered events are sent to the sinks" default:"1s"`
+
+	Webhook WebhookConfig
+	PubSub  PubSubConfig
+	Kafka   KafkaConfig
+}
+
+// Sink receives the published events.
+type Sink interface {
+	// Name returns the name of the sink used for logging.
+	Name() string
+	// Send sends the batch of events.
+	Send(ctx context.Context, events []Event) error
+}
+
+// Bus publishes domain events to the configured sinks, without blocking the
+// publisher. Events are dropped when the sinks can't keep up.
+//
+// A nil Bus and a Bus without sinks discard all events.
+//
+// architecture: Service
+type Bus struct {
+	log    *zap.Logger
+	config Config
+	sinks  []Sink
+	queue  chan Event
+	nowFn  func() time.Time
+}
+
+// NewBus creates a new event bus, which sends events to the sinks configured
+// in config.
+func NewBus(log *zap.Logger, config Config) (*Bus, error) {
+	var sinks []Sink
+	if config.Webhook.URL != "" {
+		sinks = append(sinks, NewWebhookSink(config.Webhook))
+	}
+	if config.PubSub.ProjectID != "" {
+		sink, err := NewPubSubSinkFromCredentials(context.Background(), config.PubSub)
+		if err != nil {
+			return nil, err
+		}
+		sinks = append(sinks, sink)
+	}
+	if config.Kafka.URL != "" {
+		sinks = append(sinks, NewKafkaSink(config.Kafka))
+	}
+	return NewBusWithSinks(log, config, sinks...), nil
+}
+
+// NewBusWithSinks creates a new event bus, which sends events to sinks.
+func NewBusWithSinks(log *zap.Logger, config Config, sinks ...Sink) *Bus {
+	if config.BufferSize <= 0 {
+		config.BufferSize = 1
+	}
+	if config.BatchSize <= 0 {
+		config.BatchSize = 1
+	}
+	if config.FlushInterval <= 0 {
+		config.FlushInterval = time.Second
+	}
+	return &Bus{
+		log:    log,
+		config: config,
+		sinks:  sinks,
+		queue:  make(chan Event, config.BufferSize),
+		nowFn:  time.Now,
+	}
+}
+
+// Enabled returns whether there are any sinks for the events.
+func (bus *Bus) Enabled() bool {
+	return bus != nil && len(bus.sinks) > 0
+}
+
+// Publish queues an event with the attributes for the sinks.
+func (bus *Bus) Publish(ctx context.Context, typ Type, attributes map[string]string) {
+	if !bus.Enabled() {
+		return
+	}
+
+	id, err := uuid.New()
+	if err != nil {
+		bus.log.Error("failed to create event id", zap.Error(err))
+		return
+	}
+
+	event := Event{
+		ID:         id,
+		Type:       typ,
+		Time:       bus.nowFn().UTC(),
+		Attributes: attributes,
+	}
+
+	select {
+	case bus.queue <- event:
+		mon.Counter("events_published", monkit.NewSeriesTag("type", string(typ))).Inc(1)
+	default:
+		mon.Counter("events_dropped", monkit.NewSeriesTag("type", string(typ))).Inc(1)
+	}
+}
+
+// Run sends the published events to the sinks until ctx is canceled. The
+// remaining events are flushed before returning.
+func (bus *Bus) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if !bus.Enabled() {
+		return nil
+	}
+
+	ticker := time.NewTicker(bus.config.FlushInterval)
+	defer ticker.Stop()
+
+	batch := make([]Event, 0, bus.config.BatchSize)
+	for {
+		select {
+		case <-ctx.Done():
+			// use a new context, so the remaining events can still be sent.
+			flushCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			bus.send(flushCtx, batch)
+			bus.Flush(flushCtx)
+			cancel()
+			return nil
+		case event := <-bus.queue:
+			batch = append(batch, event)
+			if len(batch) >= bus.config.BatchSize {
+				bus.send(ctx, batch)
+				batch = batch[:0]
+			}
+		case <-ticker.C:
+			bus.send(ctx, batch)
+			batch = batch[:0]
+		}
+	}
+}
+
+// Flush sends all queued events to the sinks. It's intended for processes,
+// which publish events without running the bus.
+func (bus *Bus) Flush(ctx context.Context) {
+	if !bus.Enabled() {
+		return
+	}
+
+	batch := make([]Event, 0, bus.config.BatchSize)
+	for {
+		select {
+		case event := <-bus.queue:
+			batch = append(batch, event)
+			if len(batch) >= bus.config.BatchSize {
+				bus.send(ctx, batch)
+				batch = batch[:0]
+			}
+		default:
+			bus.send(ctx, batch)
+			return
+		}
+	}
+}
+
+// send sends the batch to all sinks. Failures are logged, since the events
+// are only informational for the downstream consumers.
+func (bus *Bus) send(ctx context.Context, batch []Event) {
+	if len(batch) == 0 {
+		return
+	}
+	for _, sink := range bus.sinks {
+		if err := sink.Send(ctx, batch); err != nil {
+			mon.Counter("events_send_failed", monkit.NewSeriesTag("sink", sink.Name())).Inc(int64(len(batch)))
+			bus.log.Error("failed to send events", zap.String("sink", sink.Name()), zap.Int("count", len(batch)), zap.Error(err))
+			continue
+		}
+		mon.Counter("events_sent", monkit.NewSeriesTag("sink", sink.Name())).Inc(int64(len(batch)))
+	}
+}
+
+// SetNow allows tests to have the bus act as if the current time is whatever
+// they want.
+func (bus *Bus) SetNow(nowFn func() time.Time) {
+	bus.nowFn = nowFn
+}
@@ -0,0 +1,112 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package events_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/satellite/events"
+)
+
+type mockSink struct {
+	mu      sync.Mutex
+	batches [][]events.Event
+}
+
+func (sink *mockSink) Name() string { return "mock" }
+
+func (sink *mockSink) Send(ctx context.Context, batch []events.Event) error {
+	sink.mu.Lock()
+	defer sink.mu.Unlock()
+	sink.batches = append(sink.batches, append([]events.Event(nil), batch...))
+	return nil
+}
+
+func (sink *mockSink) Events() (all []events.Event) {
+	sink.mu.Lock()
+	defer sink.mu.Unlock()
+	for _, batch := range sink.batches {
+		all = append(all, batch...)
+	}
+	return all
+}
+
+func TestBus_Flush(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	sink := &mockSink{}
+	bus := events.NewBusWithSinks(zaptest.NewLogger(t), events.Config{
+		BufferSize: 3,
+		BatchSize:  2,
+	}, sink)
+
+	now := time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)
+	bus.SetNow(func() time.Time { return now })
+
+	require.True(t, bus.Enabled())
+
+	bus.Publish(ctx, events.ObjectCommitted, map[string]string{"bucket": "a"})
+	bus.Publish(ctx, events.ObjectDeleted, map[string]string{"bucket": "b"})
+	bus.Publish(ctx, events.NodeDisqualified, nil)
+	// the buffer is full, so the event is dropped.
+	bus.Publish(ctx, events.InvoiceFinalized, nil)
+
+	bus.Flush(ctx)
+
+	require.Len(t, sink.batches, 2)
+	all := sink.Events()
+	require.Len(t, all, 3)
+	require.Equal(t, events.ObjectCommitted, all[0].Type)
+	require.Equal(t, "a", all[0].Attributes["bucket"])
+	require.Equal(t, now, all[0].Time)
+	require.False(t, all[0].ID.IsZero())
+	require.Equal(t, events.ObjectDeleted, all[1].Type)
+	require.Equal(t, events.NodeDisqualified, all[2].Type)
+}
+
+func TestBus_Run(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	sink := &mockSink{}
+	bus := events.NewBusWithSinks(zaptest.NewLogger(t), events.Config{
+		BufferSize:    10,
+		BatchSize:     10,
+		FlushInterval: time.Hour,
+	}, sink)
+
+	runCtx, cancel := context.WithCancel(ctx)
+	ctx.Go(func() error { return bus.Run(runCtx) })
+
+	bus.Publish(ctx, events.ObjectCommitted, nil)
+	bus.Publish(ctx, events.ObjectCommitted, nil)
+
+	// the remaining events are sent when the bus is stopped.
+	cancel()
+	ctx.Wait()
+
+	require.Len(t, sink.Events(), 2)
+}
+
+func TestBus_Disabled(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	var nilBus *events.Bus
+	require.False(t, nilBus.Enabled())
+	nilBus.Publish(ctx, events.ObjectCommitted, nil)
+	nilBus.Flush(ctx)
+	require.NoError(t, nilBus.Run(ctx))
+
+	bus, err := events.NewBus(zaptest.NewLogger(t), events.Config{})
+	require.NoError(t, err)
+	require.False(t, bus.Enabled())
+	bus.Publish(ctx, events.ObjectCommitted, nil)
+	require.NoError(t, bus.Run(ctx))
+}
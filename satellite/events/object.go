@@ -0,0 +1,44 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package events
+
+import (
+	"encoding/base64"
+	"strconv"
+
+	"storj.io/storj/satellite/metabase"
+)
+
+// Operation is the operation, which caused an object event.
+type Operation string
+
+const (
+	// OperationCommit is an upload of the object.
+	OperationCommit Operation = "commit"
+	// OperationCopy is a server-side copy of the object.
+	OperationCopy Operation = "copy"
+	// OperationMove is a server-side move of the object.
+	OperationMove Operation = "move"
+	// OperationDelete is a deletion requested by the uplink.
+	OperationDelete Operation = "delete"
+	// OperationExpire is a deletion of the object after it has expired.
+	OperationExpire Operation = "expire"
+	// OperationUpdateMetadata is a replacement of the object metadata.
+	OperationUpdateMetadata Operation = "update_metadata"
+)
+
+// ObjectAttributes returns the event attributes describing the object. The
+// object key is encrypted, so it's base64 encoded.
+func ObjectAttributes(object metabase.Object, operation Operation) map[string]string {
+	return map[string]string{
+		"project_id":    object.ProjectID.String(),
+		"bucket":        object.BucketName,
+		"encrypted_key": base64.StdEncoding.EncodeToString([]byte(object.ObjectKey)),
+		"stream_id":     object.StreamID.String(),
+		"version":       strconv.FormatInt(int64(object.Version), 10),
+		"size":          strconv.FormatInt(object.TotalEncryptedSize, 10),
+		"segment_count": strconv.FormatInt(int64(object.SegmentCount), 10),
+		"operation":     string(operation),
+	}
+}
@@ -0,0 +1,212 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package events
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/zeebo/errs"
+	"golang.org/x/oauth2/google"
+)
+
+// WebhookConfig configures the sink posting events to a webhook.
+type WebhookConfig struct {
+	URL            string        `help:"url events are posted to as json, disabled when empty" default:""`
+	AuthToken      string        `help:"value of the Authorization header sent to the webhook" default:""`
+	RequestTimeout time.Duration `help:"timeout for the http request to the webhook" default:"10s"`
+}
+
+// PubSubConfig configures the sink publishing events to a Google Pub/Sub topic.
+type PubSubConfig struct {
+	ProjectID      string        `help:"google cloud project of the pub/sub topic, disabled when empty" default:""`
+	TopicID        string        `help:"pub/sub topic events are published to" default:""`
+	Endpoint       string        `help:"pub/sub api endpoint" default:"https://pubsub.googleapis.com"`
+	RequestTimeout time.Duration `help:"timeout for the http request to pub/sub" default:"10s"`
+}
+
+// KafkaConfig configures the sink producing events to a Kafka topic through
+// the Kafka REST proxy.
+type KafkaConfig struct {
+	URL            string        `help:"url of the kafka rest proxy, disabled when empty" default:""`
+	Topic          string        `help:"kafka topic events are produced to" default:""`
+	RequestTimeout time.Duration `help:"timeout for the http request to the kafka rest proxy" default:"10s"`
+}
+
+// WebhookSink posts events as a json array to a webhook.
+type WebhookSink struct {
+	config WebhookConfig
+	client *http.Client
+}
+
+// NewWebhookSink is a constructor for WebhookSink.
+func NewWebhookSink(config WebhookConfig) *WebhookSink {
+	return NewWebhookSinkWithClient(&http.Client{Timeout: config.RequestTimeout}, config)
+}
+
+// NewWebhookSinkWithClient creates a WebhookSink using client for the requests.
+func NewWebhookSinkWithClient(client *http.Client, config WebhookConfig) *WebhookSink {
+	return &WebhookSink{
+		config: config,
+		client: client,
+	}
+}
+
+// Name implements Sink.
+func (sink *WebhookSink) Name() string { return "webhook" }
+
+// Send implements Sink.
+func (sink *WebhookSink) Send(ctx context.Context, events []Event) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	header := http.Header{}
+	if sink.config.AuthToken != "" {
+		header.Set("Authorization", sink.config.AuthToken)
+	}
+
+	return postJSON(ctx, sink.client, sink.config.URL, "application/json", header, events)
+}
+
+// PubSubSink publishes events to a Google Pub/Sub topic. Each event is sent
+// as a separate message, with the event type and id as attributes.
+type PubSubSink struct {
+	config PubSubConfig
+	client *http.Client
+}
+
+// NewPubSubSinkFromCredentials creates a PubSubSink authenticated with the
+// application default credentials.
+func NewPubSubSinkFromCredentials(ctx context.Context, config PubSubConfig) (*PubSubSink, error) {
+	client, err := google.DefaultClient(ctx, "https://www.googleapis.com/auth/pubsub")
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	client.Timeout = config.RequestTimeout
+	return NewPubSubSink(client, config), nil
+}
+
+// NewPubSubSink creates a PubSubSink using client for the requests.
+func NewPubSubSink(client *http.Client, config PubSubConfig) *PubSubSink {
+	return &PubSubSink{
+		config: config,
+		client: client,
+	}
+}
+
+// Name implements Sink.
+func (sink *PubSubSink) Name() string { return "pubsub" }
+
+// Send implements Sink.
+func (sink *PubSubSink) Send(ctx context.Context, events []Event) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	type message struct {
+		Data       []byte            `json:"data"`
+		Attributes map[string]string `json:"attributes"`
+	}
+
+	var request struct {
+		Messages []message `json:"messages"`
+	}
+	for _, event := range events {
+		data, err := json.Marshal(event)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		request.Messages = append(request.Messages, message{
+			Data: data,
+			Attributes: map[string]string{
+				"id":   event.ID.String(),
+				"type": string(event.Type),
+			},
+		})
+	}
+
+	publishURL := strings.TrimSuffix(sink.config.Endpoint, "/") +
+		"/v1/projects/" + url.PathEscape(sink.config.ProjectID) +
+		"/topics/" + url.PathEscape(sink.config.TopicID) + ":publish"
+
+	return postJSON(ctx, sink.client, publishURL, "application/json", nil, request)
+}
+
+// KafkaSink produces events to a Kafka topic through the Kafka REST proxy.
+// The event id is used as the record key.
+type KafkaSink struct {
+	config KafkaConfig
+	client *http.Client
+}
+
+// NewKafkaSink is a constructor for KafkaSink.
+func NewKafkaSink(config KafkaConfig) *KafkaSink {
+	return NewKafkaSinkWithClient(&http.Client{Timeout: config.RequestTimeout}, config)
+}
+
+// NewKafkaSinkWithClient creates a KafkaSink using client for the requests.
+func NewKafkaSinkWithClient(client *http.Client, config KafkaConfig) *KafkaSink {
+	return &KafkaSink{
+		config: config,
+		client: client,
+	}
+}
+
+// Name implements Sink.
+func (sink *KafkaSink) Name() string { return "kafka" }
+
+// Send implements Sink.
+func (sink *KafkaSink) Send(ctx context.Context, events []Event) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	type record struct {
+		Key   string `json:"key"`
+		Value Event  `json:"value"`
+	}
+
+	var request struct {
+		Records []record `json:"records"`
+	}
+	for _, event := range events {
+		request.Records = append(request.Records, record{Key: event.ID.String(), Value: event})
+	}
+
+	produceURL := strings.TrimSuffix(sink.config.URL, "/") + "/topics/" + url.PathEscape(sink.config.Topic)
+
+	return postJSON(ctx, sink.client, produceURL, "application/vnd.kafka.json.v2+json", nil, request)
+}
+
+// postJSON posts v as json to the url and checks for a successful response.
+func postJSON(ctx context.Context, client *http.Client, url, contentType string, header http.Header, v interface{}) (err error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(data))
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	for key, values := range header {
+		req.Header[key] = values
+	}
+	req.Header.Set("Content-Type", contentType)
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, resp.Body.Close()) }()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return Error.New("unexpected status code %d: %s", resp.StatusCode, body)
+	}
+
+	_, err = io.Copy(io.Discard, resp.Body)
+	return Error.Wrap(err)
+}
@@ -0,0 +1,134 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package events_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite/events"
+)
+
+type receivedRequest struct {
+	Path          string
+	ContentType   string
+	Authorization string
+	Body          []byte
+}
+
+func newReceiver(t *testing.T, status int) (*httptest.Server, *[]receivedRequest) {
+	var received []receivedRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		received = append(received, receivedRequest{
+			Path:          r.URL.Path,
+			ContentType:   r.Header.Get("Content-Type"),
+			Authorization: r.Header.Get("Authorization"),
+			Body:          body,
+		})
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(server.Close)
+	return server, &received
+}
+
+func testEvents() []events.Event {
+	return []events.Event{{
+		ID:         testrand.UUID(),
+		Type:       events.ObjectCommitted,
+		Time:       time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC),
+		Attributes: map[string]string{"bucket": "bucket"},
+	}}
+}
+
+func TestWebhookSink(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	server, received := newReceiver(t, http.StatusOK)
+	sink := events.NewWebhookSink(events.WebhookConfig{URL: server.URL + "/hook", AuthToken: "token"})
+
+	batch := testEvents()
+	require.NoError(t, sink.Send(ctx, batch))
+
+	require.Len(t, *received, 1)
+	request := (*received)[0]
+	require.Equal(t, "/hook", request.Path)
+	require.Equal(t, "application/json", request.ContentType)
+	require.Equal(t, "token", request.Authorization)
+
+	var sent []events.Event
+	require.NoError(t, json.Unmarshal(request.Body, &sent))
+	require.Equal(t, batch, sent)
+
+	failing, _ := newReceiver(t, http.StatusInternalServerError)
+	sink = events.NewWebhookSink(events.WebhookConfig{URL: failing.URL})
+	require.Error(t, sink.Send(ctx, batch))
+}
+
+func TestPubSubSink(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	server, received := newReceiver(t, http.StatusOK)
+	sink := events.NewPubSubSink(server.Client(), events.PubSubConfig{
+		ProjectID: "project",
+		TopicID:   "topic",
+		Endpoint:  server.URL,
+	})
+
+	batch := testEvents()
+	require.NoError(t, sink.Send(ctx, batch))
+
+	require.Len(t, *received, 1)
+	request := (*received)[0]
+	require.Equal(t, "/v1/projects/project/topics/topic:publish", request.Path)
+
+	var sent struct {
+		Messages []struct {
+			Data       []byte            `json:"data"`
+			Attributes map[string]string `json:"attributes"`
+		} `json:"messages"`
+	}
+	require.NoError(t, json.Unmarshal(request.Body, &sent))
+	require.Len(t, sent.Messages, 1)
+	require.Equal(t, string(events.ObjectCommitted), sent.Messages[0].Attributes["type"])
+
+	var event events.Event
+	require.NoError(t, json.Unmarshal(sent.Messages[0].Data, &event))
+	require.Equal(t, batch[0], event)
+}
+
+func TestKafkaSink(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	server, received := newReceiver(t, http.StatusOK)
+	sink := events.NewKafkaSink(events.KafkaConfig{URL: server.URL, Topic: "topic"})
+
+	batch := testEvents()
+	require.NoError(t, sink.Send(ctx, batch))
+
+	require.Len(t, *received, 1)
+	request := (*received)[0]
+	require.Equal(t, "/topics/topic", request.Path)
+	require.Equal(t, "application/vnd.kafka.json.v2+json", request.ContentType)
+
+	var sent struct {
+		Records []struct {
+			Key   string       `json:"key"`
+			Value events.Event `json:"value"`
+		} `json:"records"`
+	}
+	require.NoError(t, json.Unmarshal(request.Body, &sent))
+	require.Len(t, sent.Records, 1)
+	require.Equal(t, batch[0].ID.String(), sent.Records[0].Key)
+	require.Equal(t, batch[0], sent.Records[0].Value)
+}
@@ -26,6 +26,7 @@ import (
 	"storj.io/storj/satellite/gc/sender"
 	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/metabase/segmentloop"
+	"storj.io/storj/satellite/nodedialer"
 	"storj.io/storj/satellite/overlay"
 )
 
@@ -40,7 +41,8 @@ type GarbageCollection struct {
 	Servers  *lifecycle.Group
 	Services *lifecycle.Group
 
-	Dialer rpc.Dialer
+	Dialer     rpc.Dialer
+	NodeDialer *nodedialer.Dialer
 
 	Version struct {
 		Chore   *version_checker.Chore
@@ -121,7 +123,13 @@ func NewGarbageCollection(log *zap.Logger, full *identity.FullIdentity, db DB,
 			return nil, errs.Combine(err, peer.Close())
 		}
 
-		peer.Dialer = rpc.NewDefaultDialer(tlsOptions)
+		peer.NodeDialer = nodedialer.New(config.NodeDialer)
+		peer.Services.Add(lifecycle.Item{
+			Name:  "node-dialer",
+			Close: peer.NodeDialer.Close,
+		})
+
+		peer.Dialer = peer.NodeDialer.RPCDialer(tlsOptions)
 	}
 
 	{ // setup overlay
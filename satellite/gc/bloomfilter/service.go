@@ -55,6 +55,8 @@ type Service struct {
 
 	overlay     overlay.DB
 	segmentLoop *segmentloop.Service
+
+	nowFn func() time.Time
 }
 
 // NewService creates a new instance of the gc service.
@@ -65,9 +67,16 @@ func NewService(log *zap.Logger, config Config, overlay overlay.DB, loop *segmen
 		Loop:        sync2.NewCycle(config.Interval),
 		overlay:     overlay,
 		segmentLoop: loop,
+
+		nowFn: time.Now,
 	}
 }
 
+// SetNow allows tests to have the service act as if the current time is whatever they want.
+func (service *Service) SetNow(nowFn func() time.Time) {
+	service.nowFn = nowFn
+}
+
 // Run starts the gc loop service.
 func (service *Service) Run(ctx context.Context) (err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -129,9 +138,9 @@ func (service *Service) uploadBloomFilters(ctx context.Context, latestCreationDa
 		return nil
 	}
 
-	prefix := time.Now().Format(time.RFC3339)
+	prefix := service.nowFn().Format(time.RFC3339)
 
-	expirationTime := time.Now().Add(service.config.ExpireIn)
+	expirationTime := service.nowFn().Add(service.config.ExpireIn)
 
 	accessGrant, err := uplink.ParseAccess(service.config.AccessGrant)
 	if err != nil {
@@ -264,7 +273,7 @@ func (service *Service) uploadPack(ctx context.Context, project *uplink.Project,
 func (service *Service) cleanup(ctx context.Context, project *uplink.Project, prefix string) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	errPrefix := "upload-error-" + time.Now().Format(time.RFC3339)
+	errPrefix := "upload-error-" + service.nowFn().Format(time.RFC3339)
 	o := uplink.ListObjectsOptions{
 		Prefix: prefix + "/",
 	}
@@ -54,6 +54,8 @@ func NewService(log *zap.Logger, config Config, dialer rpc.Dialer, overlay overl
 
 		dialer:  dialer,
 		overlay: overlay,
+
+		nowFn: time.Now,
 	}
 }
 
@@ -71,6 +73,8 @@ type Service struct {
 
 	dialer  rpc.Dialer
 	overlay overlay.DB
+
+	nowFn func() time.Time
 }
 
 // Run continuously polls for new retain filters and sends them out.
@@ -84,11 +88,16 @@ func (service *Service) Run(ctx context.Context) (err error) {
 	return service.Loop.Run(ctx, service.RunOnce)
 }
 
+// SetNow allows tests to have the service act as if the current time is whatever they want.
+func (service *Service) SetNow(nowFn func() time.Time) {
+	service.nowFn = nowFn
+}
+
 // RunOnce opens the bucket and sends out all the retain filters located in it to the storage nodes.
 func (service *Service) RunOnce(ctx context.Context) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	loopStartTime := time.Now()
+	loopStartTime := service.nowFn()
 
 	switch {
 	case service.Config.AccessGrant == "":
@@ -214,7 +223,7 @@ func (service *Service) uploadError(
 	ctx context.Context, project *uplink.Project, destinationObjectKey string, previousErr error,
 ) (err error) {
 	upload, err := project.UploadObject(ctx, service.Config.Bucket, destinationObjectKey, &uplink.UploadOptions{
-		Expires: time.Now().Add(service.Config.ExpireIn),
+		Expires: service.nowFn().Add(service.Config.ExpireIn),
 	})
 	if err != nil {
 		return err
@@ -0,0 +1,132 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package gracefulexit
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/identity"
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/common/storj"
+	"storj.io/storj/private/gracefulexitpb"
+)
+
+// maxAbortReasonLength is the maximum length of the reason a node can give
+// for aborting its graceful exit.
+const maxAbortReasonLength = 256
+
+// Aborter aborts in-progress graceful exits.
+//
+// Aborting resets the exit status of the node, which makes it eligible for
+// upload selection again, and drops its transfer queue and progress. The node
+// can't initiate a new graceful exit until Config.AbortCooldown has passed;
+// until then the satellite rejects its exit attempts, which makes the storage
+// node drop its local exit state.
+type Aborter struct {
+	log    *zap.Logger
+	db     DB
+	config Config
+
+	nowFn func() time.Time
+}
+
+// NewAborter creates a new graceful exit aborter.
+func NewAborter(log *zap.Logger, db DB, config Config) *Aborter {
+	return &Aborter{
+		log:    log,
+		db:     db,
+		config: config,
+
+		nowFn: time.Now,
+	}
+}
+
+// Abort aborts the graceful exit of the node.
+// It returns ErrNotExiting when the node hasn't initiated a graceful exit or
+// has already finished it.
+func (aborter *Aborter) Abort(ctx context.Context, nodeID storj.NodeID, reason string) (_ *Abort, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	abort, err := aborter.db.AbortExit(ctx, nodeID, reason, aborter.nowFn())
+	if err != nil {
+		return nil, err
+	}
+
+	mon.Meter("graceful_exit_aborted").Mark(1)
+	aborter.log.Info("graceful exit aborted",
+		zap.Stringer("Node ID", nodeID),
+		zap.Time("Exit Initiated At", abort.ExitInitiatedAt),
+		zap.String("Reason", reason),
+		zap.Time("Cooldown Until", abort.AbortedAt.Add(aborter.config.AbortCooldown)))
+
+	return abort, nil
+}
+
+// CooldownUntil returns when the node will be able to initiate a new graceful
+// exit after its last aborted one. It returns the zero time when the node has
+// never aborted a graceful exit.
+func (aborter *Aborter) CooldownUntil(ctx context.Context, nodeID storj.NodeID) (_ time.Time, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	abort, err := aborter.db.GetLatestAbort(ctx, nodeID)
+	if err != nil {
+		if ErrNodeNotFound.Has(err) {
+			return time.Time{}, nil
+		}
+		return time.Time{}, Error.Wrap(err)
+	}
+
+	return abort.AbortedAt.Add(aborter.config.AbortCooldown), nil
+}
+
+// AbortEndpoint allows storage nodes to abort the graceful exit they have
+// initiated.
+type AbortEndpoint struct {
+	gracefulexitpb.DRPCGracefulExitAbortUnimplementedServer
+
+	log     *zap.Logger
+	aborter *Aborter
+}
+
+// NewAbortEndpoint creates a new graceful exit abort endpoint.
+func NewAbortEndpoint(log *zap.Logger, aborter *Aborter) *AbortEndpoint {
+	return &AbortEndpoint{
+		log:     log,
+		aborter: aborter,
+	}
+}
+
+// Abort aborts the graceful exit of the calling storage node.
+func (endpoint *AbortEndpoint) Abort(ctx context.Context, req *gracefulexitpb.AbortRequest) (_ *gracefulexitpb.AbortResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	peer, err := identity.PeerIdentityFromContext(ctx)
+	if err != nil {
+		return nil, rpcstatus.Error(rpcstatus.Unauthenticated, Error.Wrap(err).Error())
+	}
+
+	reason := req.Reason
+	if len(reason) > maxAbortReasonLength {
+		reason = reason[:maxAbortReasonLength]
+	}
+	if reason == "" {
+		reason = "aborted by node"
+	}
+
+	abort, err := endpoint.aborter.Abort(ctx, peer.ID, reason)
+	if err != nil {
+		if ErrNotExiting.Has(err) {
+			return nil, rpcstatus.Error(rpcstatus.FailedPrecondition, err.Error())
+		}
+		endpoint.log.Error("failed to abort graceful exit", zap.Stringer("Node ID", peer.ID), zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, Error.Wrap(err).Error())
+	}
+
+	return &gracefulexitpb.AbortResponse{
+		CooldownUntil: abort.AbortedAt.Add(endpoint.aborter.config.AbortCooldown),
+	}, nil
+}
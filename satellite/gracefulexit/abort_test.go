@@ -0,0 +1,100 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package gracefulexit_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/common/pb"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/gracefulexit"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/overlay"
+)
+
+func TestAborter(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 0,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.GracefulExit.AbortCooldown = time.Hour
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		node := planet.StorageNodes[0]
+		geDB := satellite.DB.GracefulExit()
+
+		aborter := gracefulexit.NewAborter(zap.L(), geDB, satellite.Config.GracefulExit)
+
+		_, err := aborter.Abort(ctx, node.ID(), "test")
+		require.True(t, gracefulexit.ErrNotExiting.Has(err))
+
+		cooldownUntil, err := aborter.CooldownUntil(ctx, node.ID())
+		require.NoError(t, err)
+		require.True(t, cooldownUntil.IsZero())
+
+		initiatedAt := time.Now().UTC()
+		_, err = satellite.Overlay.DB.UpdateExitStatus(ctx, &overlay.ExitStatusRequest{
+			NodeID:          node.ID(),
+			ExitInitiatedAt: initiatedAt,
+		})
+		require.NoError(t, err)
+		require.NoError(t, geDB.IncrementProgress(ctx, node.ID(), 1000, 3, 1))
+		require.NoError(t, geDB.Enqueue(ctx, []gracefulexit.TransferQueueItem{
+			{NodeID: node.ID(), StreamID: testrand.UUID(), Position: metabase.SegmentPosition{Index: 1}, PieceNum: 1, RootPieceID: testrand.PieceID()},
+		}, 10))
+
+		abort, err := aborter.Abort(ctx, node.ID(), "test")
+		require.NoError(t, err)
+		require.Equal(t, "test", abort.Reason)
+		require.WithinDuration(t, initiatedAt, abort.ExitInitiatedAt, time.Second)
+
+		latest, err := geDB.GetLatestAbort(ctx, node.ID())
+		require.NoError(t, err)
+		require.WithinDuration(t, abort.AbortedAt, latest.AbortedAt, time.Second)
+
+		cooldownUntil, err = aborter.CooldownUntil(ctx, node.ID())
+		require.NoError(t, err)
+		require.WithinDuration(t, abort.AbortedAt.Add(time.Hour), cooldownUntil, time.Second)
+
+		// the node is no longer exiting, so it's selectable for uploads again.
+		status, err := satellite.Overlay.DB.GetExitStatus(ctx, node.ID())
+		require.NoError(t, err)
+		require.Nil(t, status.ExitInitiatedAt)
+		require.Nil(t, status.ExitLoopCompletedAt)
+
+		_, err = geDB.GetProgress(ctx, node.ID())
+		require.True(t, gracefulexit.ErrNodeNotFound.Has(err))
+
+		stats, err := geDB.GetTransferQueueStats(ctx, node.ID())
+		require.NoError(t, err)
+		require.Zero(t, stats.Remaining)
+
+		// initiating a new exit is rejected during the cooldown.
+		conn, err := node.Dialer.DialNodeURL(ctx, satellite.NodeURL())
+		require.NoError(t, err)
+		defer ctx.Check(conn.Close)
+
+		client := pb.NewDRPCSatelliteGracefulExitClient(conn)
+		c, err := client.Process(ctx)
+		require.NoError(t, err)
+		defer ctx.Check(c.CloseSend)
+
+		_, err = c.Recv()
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "graceful exit was recently aborted")
+
+		status, err = satellite.Overlay.DB.GetExitStatus(ctx, node.ID())
+		require.NoError(t, err)
+		require.Nil(t, status.ExitInitiatedAt)
+	})
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 
+	"storj.io/common/pb"
 	"storj.io/common/storj"
 	"storj.io/common/sync2"
 	"storj.io/storj/satellite/metabase/segmentloop"
@@ -79,10 +80,12 @@ func (chore *Chore) Run(ctx context.Context) (err error) {
 
 			// check inactive timeframe
 			if lastActivityTime.Add(chore.config.MaxInactiveTimeFrame).Before(time.Now().UTC()) {
+				reason := pb.ExitFailed_INACTIVE_TIMEFRAME_EXCEEDED
 				exitStatusRequest := &overlay.ExitStatusRequest{
-					NodeID:         node.NodeID,
-					ExitSuccess:    false,
-					ExitFinishedAt: time.Now().UTC(),
+					NodeID:           node.NodeID,
+					ExitSuccess:      false,
+					ExitFinishedAt:   time.Now().UTC(),
+					ExitFailedReason: &reason,
 				}
 				mon.Meter("graceful_exit_fail_inactive").Mark(1)
 				_, err = chore.overlay.UpdateExitStatus(ctx, exitStatusRequest)
@@ -38,6 +38,22 @@ type TransferQueueItem struct {
 	OrderLimitSendCount int
 }
 
+// TransferQueueStats contains the number of transfer queue items of a node.
+type TransferQueueStats struct {
+	// Remaining is the number of items which haven't been transferred yet.
+	Remaining int64
+	// Failing is the number of remaining items which have failed at least once.
+	Failing int64
+}
+
+// Abort represents a persisted record of an aborted graceful exit.
+type Abort struct {
+	NodeID          storj.NodeID
+	ExitInitiatedAt time.Time
+	Reason          string
+	AbortedAt       time.Time
+}
+
 // DB implements CRUD operations for graceful exit service.
 //
 // architecture: Database
@@ -74,10 +90,20 @@ type DB interface {
 	GetIncompleteNotFailed(ctx context.Context, nodeID storj.NodeID, limit int, offset int64) ([]*TransferQueueItem, error)
 	// GetIncompleteNotFailed gets incomplete graceful exit transfer queue entries that have failed <= maxFailures times, ordered by durability ratio and queued date ascending.
 	GetIncompleteFailed(ctx context.Context, nodeID storj.NodeID, maxFailures int, limit int, offset int64) ([]*TransferQueueItem, error)
+	// GetTransferQueueStats gets the number of remaining and failing transfer queue items of a node.
+	GetTransferQueueStats(ctx context.Context, nodeID storj.NodeID) (TransferQueueStats, error)
 	// IncrementOrderLimitSendCount increments the number of times a node has been sent an order limit for transferring.
 	IncrementOrderLimitSendCount(ctx context.Context, nodeID storj.NodeID, StreamID uuid.UUID, Position metabase.SegmentPosition, pieceNum int32) error
 	// CountFinishedTransferQueueItemsByNode return a map of the nodes which has
 	// finished the exit before the indicated time but there are at least one item
 	// left in the transfer queue.
 	CountFinishedTransferQueueItemsByNode(ctx context.Context, before time.Time, asOfSystemTimeInterval time.Duration) (map[storj.NodeID]int64, error)
+
+	// AbortExit resets the exit status of a node which has initiated but not finished a graceful exit,
+	// deletes its transfer queue items and progress, and records the abort.
+	// It returns ErrNotExiting when the node isn't currently exiting.
+	AbortExit(ctx context.Context, nodeID storj.NodeID, reason string, abortedAt time.Time) (*Abort, error)
+	// GetLatestAbort returns the most recent graceful exit abort of a node.
+	// It returns ErrNodeNotFound when the node has never aborted a graceful exit.
+	GetLatestAbort(ctx context.Context, nodeID storj.NodeID) (*Abort, error)
 }
@@ -37,6 +37,8 @@ var (
 	ErrInvalidArgument = errs.Class("graceful exit")
 	// ErrIneligibleNodeAge is an error class for when a node has not been on the network long enough to graceful exit.
 	ErrIneligibleNodeAge = errs.Class("node is not yet eligible for graceful exit")
+	// ErrExitCooldown is an error class for when a node tries to initiate a graceful exit too soon after an aborted one.
+	ErrExitCooldown = errs.Class("graceful exit was recently aborted")
 )
 
 // Endpoint for handling the transfer of pieces for Graceful Exit.
@@ -143,7 +145,7 @@ func (endpoint *Endpoint) Process(stream pb.DRPCSatelliteGracefulExit_ProcessStr
 
 	msg, err := endpoint.checkExitStatus(ctx, nodeID)
 	if err != nil {
-		if ErrIneligibleNodeAge.Has(err) {
+		if ErrIneligibleNodeAge.Has(err) || ErrExitCooldown.Has(err) {
 			return rpcstatus.Error(rpcstatus.FailedPrecondition, err.Error())
 		}
 		return rpcstatus.Error(rpcstatus.Internal, err.Error())
@@ -660,6 +662,12 @@ func (endpoint *Endpoint) handleDisqualifiedNode(ctx context.Context, nodeID sto
 }
 
 func (endpoint *Endpoint) handleFinished(ctx context.Context, stream pb.DRPCSatelliteGracefulExit_ProcessStream, exitStatusRequest *overlay.ExitStatusRequest, failedReason pb.ExitFailed_Reason) error {
+	if !exitStatusRequest.ExitSuccess && failedReason >= 0 {
+		// the reason is recorded, so it can be included in the receipts
+		// signed later.
+		exitStatusRequest.ExitFailedReason = &failedReason
+	}
+
 	finishedMsg, err := endpoint.getFinishedMessage(ctx, exitStatusRequest.NodeID, exitStatusRequest.ExitFinishedAt, exitStatusRequest.ExitSuccess, failedReason)
 	if err != nil {
 		return Error.Wrap(err)
@@ -685,35 +693,12 @@ func (endpoint *Endpoint) handleFinished(ctx context.Context, stream pb.DRPCSate
 }
 
 func (endpoint *Endpoint) getFinishedMessage(ctx context.Context, nodeID storj.NodeID, finishedAt time.Time, success bool, reason pb.ExitFailed_Reason) (message *pb.SatelliteMessage, err error) {
-	if success {
-		unsigned := &pb.ExitCompleted{
-			SatelliteId: endpoint.signer.ID(),
-			NodeId:      nodeID,
-			Completed:   finishedAt,
-		}
-		signed, err := signing.SignExitCompleted(ctx, endpoint.signer, unsigned)
-		if err != nil {
-			return nil, Error.Wrap(err)
-		}
-		message = &pb.SatelliteMessage{Message: &pb.SatelliteMessage_ExitCompleted{
-			ExitCompleted: signed,
-		}}
-	} else {
-		unsigned := &pb.ExitFailed{
-			SatelliteId: endpoint.signer.ID(),
-			NodeId:      nodeID,
-			Failed:      finishedAt,
-		}
-		if reason >= 0 {
-			unsigned.Reason = reason
-		}
-		signed, err := signing.SignExitFailed(ctx, endpoint.signer, unsigned)
-		if err != nil {
-			return nil, Error.Wrap(err)
-		}
-		message = &pb.SatelliteMessage{Message: &pb.SatelliteMessage_ExitFailed{
-			ExitFailed: signed,
-		}}
+	message, err = signExitReceipt(ctx, endpoint.signer, nodeID, finishedAt, success, reason)
+	if err != nil {
+		return nil, err
+	}
+
+	if !success {
 		err = endpoint.overlay.DisqualifyNode(ctx, nodeID, overlay.DisqualificationReasonUnknown)
 		if err != nil {
 			return nil, Error.Wrap(err)
@@ -776,8 +761,7 @@ func (endpoint *Endpoint) checkExitStatus(ctx context.Context, nodeID storj.Node
 	}
 
 	if exitStatus.ExitFinishedAt != nil {
-		// TODO maybe we should store the reason in the DB so we know how it originally failed.
-		return endpoint.getFinishedMessage(ctx, nodeID, *exitStatus.ExitFinishedAt, exitStatus.ExitSuccess, -1)
+		return endpoint.getFinishedMessage(ctx, nodeID, *exitStatus.ExitFinishedAt, exitStatus.ExitSuccess, recordedExitFailedReason(exitStatus))
 	}
 
 	if exitStatus.ExitInitiatedAt == nil {
@@ -791,6 +775,18 @@ func (endpoint *Endpoint) checkExitStatus(ctx context.Context, nodeID storj.Node
 			return nil, ErrIneligibleNodeAge.New("will be eligible after %s", geEligibilityDate.String())
 		}
 
+		latestAbort, err := endpoint.db.GetLatestAbort(ctx, nodeID)
+		if err != nil && !ErrNodeNotFound.Has(err) {
+			return nil, Error.Wrap(err)
+		}
+		if latestAbort != nil {
+			cooldownEnd := latestAbort.AbortedAt.Add(endpoint.config.AbortCooldown)
+			if time.Now().Before(cooldownEnd) {
+				mon.Meter("graceful_exit_init_rejected_cooldown").Mark(1)
+				return nil, ErrExitCooldown.New("will be eligible after %s", cooldownEnd.String())
+			}
+		}
+
 		request := &overlay.ExitStatusRequest{NodeID: nodeID, ExitInitiatedAt: time.Now().UTC()}
 		node, err := endpoint.overlaydb.UpdateExitStatus(ctx, request)
 		if err != nil {
@@ -11,6 +11,7 @@ import (
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 
+	"storj.io/common/pb"
 	"storj.io/common/storj"
 	"storj.io/storj/satellite/metabase/rangedloop"
 	"storj.io/storj/satellite/overlay"
@@ -143,10 +144,12 @@ func (obs *Observer) checkForInactiveNodes(ctx context.Context, exitingNodes []*
 
 		// check inactive timeframe
 		if lastActivityTime.Add(obs.config.MaxInactiveTimeFrame).Before(time.Now().UTC()) {
+			reason := pb.ExitFailed_INACTIVE_TIMEFRAME_EXCEEDED
 			exitStatusRequest := &overlay.ExitStatusRequest{
-				NodeID:         node.NodeID,
-				ExitSuccess:    false,
-				ExitFinishedAt: time.Now().UTC(),
+				NodeID:           node.NodeID,
+				ExitSuccess:      false,
+				ExitFinishedAt:   time.Now().UTC(),
+				ExitFailedReason: &reason,
 			}
 			mon.Meter("graceful_exit_fail_inactive").Mark(1)
 			_, err = obs.overlay.UpdateExitStatus(ctx, exitStatusRequest)
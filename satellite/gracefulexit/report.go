@@ -0,0 +1,188 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package gracefulexit
+
+import (
+	"context"
+	"encoding/hex"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/pb"
+	"storj.io/common/signing"
+	"storj.io/common/storj"
+	"storj.io/storj/satellite/overlay"
+)
+
+// ErrNotExiting is returned when the node hasn't initiated a graceful exit.
+var ErrNotExiting = errs.Class("node is not exiting")
+
+// Report describes the progress of a gracefully exiting node.
+type Report struct {
+	NodeID storj.NodeID `json:"nodeId"`
+
+	ExitInitiatedAt     *time.Time `json:"exitInitiatedAt"`
+	ExitLoopCompletedAt *time.Time `json:"exitLoopCompletedAt"`
+	ExitFinishedAt      *time.Time `json:"exitFinishedAt"`
+	ExitSuccess         bool       `json:"exitSuccess"`
+
+	BytesTransferred  int64 `json:"bytesTransferred"`
+	PiecesTransferred int64 `json:"piecesTransferred"`
+	PiecesFailed      int64 `json:"piecesFailed"`
+	PiecesRemaining   int64 `json:"piecesRemaining"`
+	PiecesFailing     int64 `json:"piecesFailing"`
+	// SuccessRate is the ratio of successful transfers to all finished transfers.
+	SuccessRate float64 `json:"successRate"`
+
+	// Receipt is the hex encoded signed ExitCompleted or ExitFailed message,
+	// once the exit has finished. The ExitFailed message includes the
+	// recorded reason of the failure.
+	Receipt string `json:"receipt,omitempty"`
+}
+
+// Reporter reports the graceful exit progress of nodes.
+type Reporter struct {
+	db        DB
+	overlaydb overlay.DB
+	signer    signing.Signer
+}
+
+// NewReporter creates a new graceful exit reporter.
+func NewReporter(db DB, overlaydb overlay.DB, signer signing.Signer) *Reporter {
+	return &Reporter{
+		db:        db,
+		overlaydb: overlaydb,
+		signer:    signer,
+	}
+}
+
+// Report returns the graceful exit progress of the node.
+// It returns ErrNotExiting, when the node doesn't exist or hasn't initiated a graceful exit.
+func (reporter *Reporter) Report(ctx context.Context, nodeID storj.NodeID) (_ *Report, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	status, err := reporter.overlaydb.GetExitStatus(ctx, nodeID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	if status.ExitInitiatedAt == nil {
+		return nil, ErrNotExiting.New("%s", nodeID)
+	}
+
+	report := &Report{
+		NodeID:              nodeID,
+		ExitInitiatedAt:     status.ExitInitiatedAt,
+		ExitLoopCompletedAt: status.ExitLoopCompletedAt,
+		ExitFinishedAt:      status.ExitFinishedAt,
+		ExitSuccess:         status.ExitSuccess,
+	}
+
+	progress, err := reporter.db.GetProgress(ctx, nodeID)
+	if err != nil && !ErrNodeNotFound.Has(err) {
+		return nil, Error.Wrap(err)
+	}
+	if progress != nil {
+		report.BytesTransferred = progress.BytesTransferred
+		report.PiecesTransferred = progress.PiecesTransferred
+		report.PiecesFailed = progress.PiecesFailed
+		if total := progress.PiecesTransferred + progress.PiecesFailed; total > 0 {
+			report.SuccessRate = float64(progress.PiecesTransferred) / float64(total)
+		}
+	}
+
+	stats, err := reporter.db.GetTransferQueueStats(ctx, nodeID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	report.PiecesRemaining = stats.Remaining
+	report.PiecesFailing = stats.Failing
+
+	// the receipt of a failed exit is only signed with the recorded reason,
+	// exits which failed before the reasons were recorded don't have one.
+	if status.ExitFinishedAt != nil && (status.ExitSuccess || status.ExitFailedReason != nil) {
+		message, err := signExitReceipt(ctx, reporter.signer, nodeID, *status.ExitFinishedAt, status.ExitSuccess, recordedExitFailedReason(status))
+		if err != nil {
+			return nil, err
+		}
+		report.Receipt, err = encodeExitReceipt(message)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return report, nil
+}
+
+// FailingTransfers returns the transfers of the node, which have failed at least once
+// and haven't been transferred yet.
+func (reporter *Reporter) FailingTransfers(ctx context.Context, nodeID storj.NodeID, limit int, offset int64) (_ []*TransferQueueItem, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// all failures are included, regardless of how many times the transfer failed.
+	const anyFailures = 1<<31 - 1
+	items, err := reporter.db.GetIncompleteFailed(ctx, nodeID, anyFailures, limit, offset)
+	return items, Error.Wrap(err)
+}
+
+// recordedExitFailedReason returns the recorded reason of a failed exit or -1,
+// when it wasn't recorded.
+func recordedExitFailedReason(status *overlay.ExitStatus) pb.ExitFailed_Reason {
+	if status.ExitFailedReason == nil {
+		return -1
+	}
+	return *status.ExitFailedReason
+}
+
+// signExitReceipt creates the signed message, which is sent to the node once the exit has finished.
+func signExitReceipt(ctx context.Context, signer signing.Signer, nodeID storj.NodeID, finishedAt time.Time, success bool, reason pb.ExitFailed_Reason) (*pb.SatelliteMessage, error) {
+	if success {
+		signed, err := signing.SignExitCompleted(ctx, signer, &pb.ExitCompleted{
+			SatelliteId: signer.ID(),
+			NodeId:      nodeID,
+			Completed:   finishedAt,
+		})
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		return &pb.SatelliteMessage{Message: &pb.SatelliteMessage_ExitCompleted{
+			ExitCompleted: signed,
+		}}, nil
+	}
+
+	unsigned := &pb.ExitFailed{
+		SatelliteId: signer.ID(),
+		NodeId:      nodeID,
+		Failed:      finishedAt,
+	}
+	if reason >= 0 {
+		unsigned.Reason = reason
+	}
+	signed, err := signing.SignExitFailed(ctx, signer, unsigned)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return &pb.SatelliteMessage{Message: &pb.SatelliteMessage_ExitFailed{
+		ExitFailed: signed,
+	}}, nil
+}
+
+// encodeExitReceipt encodes the receipt in the format accepted by
+// `satellite reports verify-exit-receipt`.
+func encodeExitReceipt(message *pb.SatelliteMessage) (string, error) {
+	var data []byte
+	var err error
+	switch msg := message.Message.(type) {
+	case *pb.SatelliteMessage_ExitCompleted:
+		data, err = pb.Marshal(msg.ExitCompleted)
+	case *pb.SatelliteMessage_ExitFailed:
+		data, err = pb.Marshal(msg.ExitFailed)
+	default:
+		return "", Error.New("unexpected message %T", message.Message)
+	}
+	if err != nil {
+		return "", Error.Wrap(err)
+	}
+	return hex.EncodeToString(data), nil
+}
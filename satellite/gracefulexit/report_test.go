@@ -0,0 +1,140 @@
+// Copyright (C) 2022 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package gracefulexit_test
+
+import (
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/pb"
+	"storj.io/common/signing"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite/gracefulexit"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/overlay"
+)
+
+func TestReporter(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		nodeID := planet.StorageNodes[0].ID()
+		geDB := satellite.DB.GracefulExit()
+
+		reporter := gracefulexit.NewReporter(geDB, satellite.Overlay.DB, signing.SignerFromFullIdentity(satellite.Identity))
+
+		_, err := reporter.Report(ctx, nodeID)
+		require.True(t, gracefulexit.ErrNotExiting.Has(err))
+
+		initiatedAt := time.Now().UTC()
+		_, err = satellite.Overlay.DB.UpdateExitStatus(ctx, &overlay.ExitStatusRequest{
+			NodeID:          nodeID,
+			ExitInitiatedAt: initiatedAt,
+		})
+		require.NoError(t, err)
+
+		require.NoError(t, geDB.IncrementProgress(ctx, nodeID, 1000, 3, 1))
+
+		items := []gracefulexit.TransferQueueItem{
+			{NodeID: nodeID, StreamID: testrand.UUID(), Position: metabase.SegmentPosition{Index: 1}, PieceNum: 1, RootPieceID: testrand.PieceID()},
+			{NodeID: nodeID, StreamID: testrand.UUID(), Position: metabase.SegmentPosition{Index: 2}, PieceNum: 2, RootPieceID: testrand.PieceID()},
+		}
+		require.NoError(t, geDB.Enqueue(ctx, items, 10))
+
+		failedAt, failedCount := time.Now().UTC(), 2
+		failed := items[1]
+		failed.LastFailedAt = &failedAt
+		failed.FailedCount = &failedCount
+		require.NoError(t, geDB.UpdateTransferQueueItem(ctx, failed))
+
+		report, err := reporter.Report(ctx, nodeID)
+		require.NoError(t, err)
+		require.Equal(t, nodeID, report.NodeID)
+		require.NotNil(t, report.ExitInitiatedAt)
+		require.Nil(t, report.ExitFinishedAt)
+		require.EqualValues(t, 1000, report.BytesTransferred)
+		require.EqualValues(t, 3, report.PiecesTransferred)
+		require.EqualValues(t, 1, report.PiecesFailed)
+		require.EqualValues(t, 2, report.PiecesRemaining)
+		require.EqualValues(t, 1, report.PiecesFailing)
+		require.Equal(t, 0.75, report.SuccessRate)
+		require.Empty(t, report.Receipt)
+
+		failing, err := reporter.FailingTransfers(ctx, nodeID, 10, 0)
+		require.NoError(t, err)
+		require.Len(t, failing, 1)
+		require.Equal(t, failed.StreamID, failing[0].StreamID)
+
+		finishedAt := time.Now().UTC()
+		_, err = satellite.Overlay.DB.UpdateExitStatus(ctx, &overlay.ExitStatusRequest{
+			NodeID:              nodeID,
+			ExitInitiatedAt:     initiatedAt,
+			ExitLoopCompletedAt: initiatedAt,
+			ExitFinishedAt:      finishedAt,
+			ExitSuccess:         true,
+		})
+		require.NoError(t, err)
+
+		report, err = reporter.Report(ctx, nodeID)
+		require.NoError(t, err)
+		require.True(t, report.ExitSuccess)
+		require.NotEmpty(t, report.Receipt)
+
+		data, err := hex.DecodeString(report.Receipt)
+		require.NoError(t, err)
+
+		completed := &pb.ExitCompleted{}
+		require.NoError(t, pb.Unmarshal(data, completed))
+		require.Equal(t, nodeID, completed.NodeId)
+		require.Equal(t, satellite.ID(), completed.SatelliteId)
+
+		signee := signing.SigneeFromPeerIdentity(satellite.Identity.PeerIdentity())
+		require.NoError(t, signing.VerifyExitCompleted(ctx, signee, completed))
+
+		// a failed exit without a recorded reason doesn't have a receipt.
+		_, err = satellite.Overlay.DB.UpdateExitStatus(ctx, &overlay.ExitStatusRequest{
+			NodeID:         nodeID,
+			ExitFinishedAt: finishedAt,
+			ExitSuccess:    false,
+		})
+		require.NoError(t, err)
+
+		report, err = reporter.Report(ctx, nodeID)
+		require.NoError(t, err)
+		require.False(t, report.ExitSuccess)
+		require.Empty(t, report.Receipt)
+
+		// the recorded reason is included in the signed receipt.
+		reason := pb.ExitFailed_INACTIVE_TIMEFRAME_EXCEEDED
+		_, err = satellite.Overlay.DB.UpdateExitStatus(ctx, &overlay.ExitStatusRequest{
+			NodeID:           nodeID,
+			ExitFinishedAt:   finishedAt,
+			ExitSuccess:      false,
+			ExitFailedReason: &reason,
+		})
+		require.NoError(t, err)
+
+		report, err = reporter.Report(ctx, nodeID)
+		require.NoError(t, err)
+		require.NotEmpty(t, report.Receipt)
+
+		data, err = hex.DecodeString(report.Receipt)
+		require.NoError(t, err)
+
+		failedReceipt := &pb.ExitFailed{}
+		require.NoError(t, pb.Unmarshal(data, failedReceipt))
+		require.Equal(t, reason, failedReceipt.Reason)
+		require.NoError(t, signing.VerifyExitFailed(ctx, signee, failedReceipt))
+
+		// the reason can't be changed without invalidating the signature.
+		failedReceipt.Reason = pb.ExitFailed_VERIFICATION_FAILED
+		require.Error(t, signing.VerifyExitFailed(ctx, signee, failedReceipt))
+	})
+}
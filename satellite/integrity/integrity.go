@@ -0,0 +1,369 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package integrity implements on demand integrity checks of single objects,
+// which customers can request to get a signed health report of their data.
+package integrity
+
+import (
+	"context"
+	"encoding/json"
+	"math/rand"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/pb"
+	"storj.io/common/signing"
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/audit"
+	"storj.io/storj/satellite/metabase"
+)
+
+var (
+	// Error is the error class for integrity checks.
+	Error = errs.Class("integrity")
+
+	mon = monkit.Package()
+)
+
+// Config contains configurable values for object integrity checks.
+type Config struct {
+	Enabled         bool `help:"whether customers can request integrity checks of their objects" default:"false"`
+	SampledSegments int  `help:"number of remote segments per check, whose pieces are downloaded and verified" default:"3"`
+	MaxSegments     int  `help:"maximum number of segments of an object that can be checked" default:"10000"`
+}
+
+// Metabase is the subset of the metabase used by the integrity checks.
+type Metabase interface {
+	GetObjectLastCommitted(ctx context.Context, opts metabase.GetObjectLastCommitted) (metabase.Object, error)
+	ListSegments(ctx context.Context, opts metabase.ListSegments) (metabase.ListSegmentsResult, error)
+}
+
+// Overlay is the subset of the overlay used by the integrity checks.
+type Overlay interface {
+	KnownReliable(ctx context.Context, nodeIDs storj.NodeIDList) ([]*pb.Node, error)
+}
+
+// Verifier downloads and verifies the shares of a segment.
+type Verifier interface {
+	Verify(ctx context.Context, segment audit.Segment, skip map[storj.NodeID]bool) (audit.Report, error)
+}
+
+// Status is the health of an object or a segment.
+type Status string
+
+const (
+	// StatusHealthy means that all the data is present and above the repair threshold.
+	StatusHealthy Status = "healthy"
+	// StatusDegraded means that the data can be downloaded, but some of it is
+	// waiting for repair.
+	StatusDegraded Status = "degraded"
+	// StatusDamaged means that some of the data can't be downloaded.
+	StatusDamaged Status = "damaged"
+)
+
+// worse returns the worse of the two statuses.
+func (status Status) worse(other Status) Status {
+	rank := func(status Status) int {
+		switch status {
+		case StatusHealthy:
+			return 0
+		case StatusDegraded:
+			return 1
+		default:
+			return 2
+		}
+	}
+	if rank(other) > rank(status) {
+		return other
+	}
+	return status
+}
+
+// SegmentReport is the health of a single segment.
+type SegmentReport struct {
+	Position        uint64 `json:"position"`
+	Inline          bool   `json:"inline"`
+	Pieces          int    `json:"pieces"`
+	HealthyPieces   int    `json:"healthyPieces"`
+	RequiredPieces  int    `json:"requiredPieces"`
+	RepairThreshold int    `json:"repairThreshold"`
+	OptimalPieces   int    `json:"optimalPieces"`
+	Sampled         bool   `json:"sampled"`
+	FailedPieces    int    `json:"failedPieces"`
+	Status          Status `json:"status"`
+}
+
+// Report is the signed health report of an object.
+type Report struct {
+	SatelliteID     storj.NodeID    `json:"satelliteId"`
+	ProjectID       uuid.UUID       `json:"projectId"`
+	BucketName      string          `json:"bucketName"`
+	ObjectKey       []byte          `json:"objectKey"`
+	Version         int64           `json:"version"`
+	StreamID        uuid.UUID       `json:"streamId"`
+	CheckedAt       time.Time       `json:"checkedAt"`
+	Status          Status          `json:"status"`
+	SegmentCount    int             `json:"segmentCount"`
+	MissingSegments int             `json:"missingSegments"`
+	Segments        []SegmentReport `json:"segments"`
+	Signature       []byte          `json:"signature,omitempty"`
+}
+
+// signedData returns the data covered by the signature.
+func (report Report) signedData() ([]byte, error) {
+	report.Signature = nil
+	return json.Marshal(report)
+}
+
+// Verify checks that the report has been signed by the satellite.
+func (report *Report) Verify(ctx context.Context, satellite signing.Signee) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if report.SatelliteID != satellite.ID() {
+		return Error.New("report is signed by %s, not %s", report.SatelliteID, satellite.ID())
+	}
+
+	data, err := report.signedData()
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	return Error.Wrap(satellite.HashAndVerifySignature(ctx, data, report.Signature))
+}
+
+// Service checks the integrity of objects.
+//
+// architecture: Service
+type Service struct {
+	log      *zap.Logger
+	metabase Metabase
+	overlay  Overlay
+	verifier Verifier
+	signer   signing.Signer
+	config   Config
+
+	nowFn func() time.Time
+}
+
+// NewService creates a new integrity check service. When verifier is nil,
+// no pieces are downloaded.
+func NewService(log *zap.Logger, metabase Metabase, overlay Overlay, verifier Verifier, signer signing.Signer, config Config) *Service {
+	return &Service{
+		log:      log,
+		metabase: metabase,
+		overlay:  overlay,
+		verifier: verifier,
+		signer:   signer,
+		config:   config,
+		nowFn:    time.Now,
+	}
+}
+
+// SetNow allows tests to have the service act as if the current time is
+// whatever they want.
+func (service *Service) SetNow(nowFn func() time.Time) {
+	service.nowFn = nowFn
+}
+
+// Check verifies that all segments of the last committed version of the
+// object exist, that their pieces meet the redundancy and that the pieces of
+// a sample of segments are valid. It returns a report signed by the satellite.
+// storj.ErrObjectNotFound is returned, when the object doesn't exist.
+func (service *Service) Check(ctx context.Context, projectID uuid.UUID, bucketName string, objectKey metabase.ObjectKey) (_ *Report, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	object, err := service.metabase.GetObjectLastCommitted(ctx, metabase.GetObjectLastCommitted{
+		ObjectLocation: metabase.ObjectLocation{
+			ProjectID:  projectID,
+			BucketName: bucketName,
+			ObjectKey:  objectKey,
+		},
+	})
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	if int(object.SegmentCount) > service.config.MaxSegments {
+		return nil, Error.New("object has too many segments: %d", object.SegmentCount)
+	}
+
+	segments, err := service.listSegments(ctx, object.StreamID)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &Report{
+		SatelliteID:  service.signer.ID(),
+		ProjectID:    projectID,
+		BucketName:   bucketName,
+		ObjectKey:    []byte(objectKey),
+		Version:      int64(object.Version),
+		StreamID:     object.StreamID,
+		CheckedAt:    service.nowFn().UTC(),
+		Status:       StatusHealthy,
+		SegmentCount: int(object.SegmentCount),
+		Segments:     make([]SegmentReport, 0, len(segments)),
+	}
+
+	if len(segments) < int(object.SegmentCount) {
+		report.MissingSegments = int(object.SegmentCount) - len(segments)
+		report.Status = StatusDamaged
+	}
+
+	reliable, err := service.reliableNodes(ctx, segments)
+	if err != nil {
+		return nil, err
+	}
+
+	var remote []int
+	for i, segment := range segments {
+		report.Segments = append(report.Segments, checkPieces(segment, reliable))
+		if !segment.Inline() {
+			remote = append(remote, i)
+		}
+	}
+
+	if service.verifier != nil {
+		rand.Shuffle(len(remote), func(i, k int) { remote[i], remote[k] = remote[k], remote[i] })
+		if len(remote) > service.config.SampledSegments {
+			remote = remote[:service.config.SampledSegments]
+		}
+		for _, i := range remote {
+			service.verifySegment(ctx, segments[i], &report.Segments[i])
+		}
+	}
+
+	for _, segment := range report.Segments {
+		report.Status = report.Status.worse(segment.Status)
+	}
+	mon.Counter("integrity_check_status", monkit.NewSeriesTag("status", string(report.Status))).Inc(1)
+
+	data, err := report.signedData()
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	report.Signature, err = service.signer.HashAndSign(ctx, data)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return report, nil
+}
+
+// listSegments returns all the segments of the stream.
+func (service *Service) listSegments(ctx context.Context, streamID uuid.UUID) (segments []metabase.Segment, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	opts := metabase.ListSegments{StreamID: streamID}
+	for {
+		result, err := service.metabase.ListSegments(ctx, opts)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		segments = append(segments, result.Segments...)
+		if !result.More || len(result.Segments) == 0 {
+			return segments, nil
+		}
+		opts.Cursor = result.Segments[len(result.Segments)-1].Position
+	}
+}
+
+// reliableNodes returns the reliable nodes, which store pieces of the segments.
+func (service *Service) reliableNodes(ctx context.Context, segments []metabase.Segment) (_ map[storj.NodeID]bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	seen := make(map[storj.NodeID]bool)
+	var nodeIDs storj.NodeIDList
+	for _, segment := range segments {
+		for _, piece := range segment.Pieces {
+			if !seen[piece.StorageNode] {
+				seen[piece.StorageNode] = true
+				nodeIDs = append(nodeIDs, piece.StorageNode)
+			}
+		}
+	}
+
+	reliable := make(map[storj.NodeID]bool)
+	if len(nodeIDs) == 0 {
+		return reliable, nil
+	}
+
+	nodes, err := service.overlay.KnownReliable(ctx, nodeIDs)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	for _, node := range nodes {
+		reliable[node.Id] = true
+	}
+	return reliable, nil
+}
+
+// checkPieces compares the number of pieces on reliable nodes with the
+// redundancy of the segment.
+func checkPieces(segment metabase.Segment, reliable map[storj.NodeID]bool) SegmentReport {
+	report := SegmentReport{
+		Position: segment.Position.Encode(),
+		Inline:   segment.Inline(),
+		Status:   StatusHealthy,
+	}
+	if report.Inline {
+		return report
+	}
+
+	report.Pieces = len(segment.Pieces)
+	report.RequiredPieces = int(segment.Redundancy.RequiredShares)
+	report.RepairThreshold = int(segment.Redundancy.RepairShares)
+	report.OptimalPieces = int(segment.Redundancy.OptimalShares)
+	for _, piece := range segment.Pieces {
+		if reliable[piece.StorageNode] {
+			report.HealthyPieces++
+		}
+	}
+	report.Status = segmentStatus(report)
+
+	return report
+}
+
+// verifySegment downloads and verifies the shares of the segment. Failures
+// to verify are logged, the segment is reported as not sampled then.
+func (service *Service) verifySegment(ctx context.Context, segment metabase.Segment, report *SegmentReport) {
+	defer mon.Task()(&ctx)(nil)
+
+	auditReport, err := service.verifier.Verify(ctx, audit.Segment{
+		StreamID:      segment.StreamID,
+		Position:      segment.Position,
+		ExpiresAt:     segment.ExpiresAt,
+		EncryptedSize: segment.EncryptedSize,
+	}, nil)
+	if err != nil {
+		service.log.Warn("failed to verify segment pieces",
+			zap.Stringer("Stream ID", segment.StreamID),
+			zap.Uint64("Position", segment.Position.Encode()),
+			zap.Error(err))
+		return
+	}
+
+	report.Sampled = true
+	report.FailedPieces = len(auditReport.Fails)
+	report.HealthyPieces -= report.FailedPieces
+	if report.HealthyPieces < 0 {
+		report.HealthyPieces = 0
+	}
+	report.Status = segmentStatus(*report)
+}
+
+// segmentStatus returns the status of a remote segment.
+func segmentStatus(report SegmentReport) Status {
+	switch {
+	case report.HealthyPieces < report.RequiredPieces:
+		return StatusDamaged
+	case report.HealthyPieces <= report.RepairThreshold || report.FailedPieces > 0:
+		return StatusDegraded
+	default:
+		return StatusHealthy
+	}
+}
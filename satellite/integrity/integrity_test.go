@@ -0,0 +1,184 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package integrity_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/identity/testidentity"
+	"storj.io/common/pb"
+	"storj.io/common/signing"
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite/audit"
+	"storj.io/storj/satellite/integrity"
+	"storj.io/storj/satellite/metabase"
+)
+
+type mockMetabase struct {
+	object   metabase.Object
+	segments []metabase.Segment
+}
+
+func (db *mockMetabase) GetObjectLastCommitted(ctx context.Context, opts metabase.GetObjectLastCommitted) (metabase.Object, error) {
+	if opts.ObjectLocation != db.object.Location() {
+		return metabase.Object{}, storj.ErrObjectNotFound.New("")
+	}
+	return db.object, nil
+}
+
+func (db *mockMetabase) ListSegments(ctx context.Context, opts metabase.ListSegments) (metabase.ListSegmentsResult, error) {
+	return metabase.ListSegmentsResult{Segments: db.segments}, nil
+}
+
+type mockOverlay struct {
+	offline map[storj.NodeID]bool
+}
+
+func (overlay *mockOverlay) KnownReliable(ctx context.Context, nodeIDs storj.NodeIDList) (nodes []*pb.Node, err error) {
+	for _, id := range nodeIDs {
+		if !overlay.offline[id] {
+			nodes = append(nodes, &pb.Node{Id: id})
+		}
+	}
+	return nodes, nil
+}
+
+type mockVerifier struct {
+	fails map[metabase.SegmentPosition]storj.NodeIDList
+}
+
+func (verifier *mockVerifier) Verify(ctx context.Context, segment audit.Segment, skip map[storj.NodeID]bool) (audit.Report, error) {
+	return audit.Report{Fails: verifier.fails[segment.Position]}, nil
+}
+
+func remoteSegment(object metabase.Object, index uint32, pieces int) metabase.Segment {
+	segment := metabase.Segment{
+		StreamID: object.StreamID,
+		Position: metabase.SegmentPosition{Index: index},
+		Redundancy: storj.RedundancyScheme{
+			Algorithm:      storj.ReedSolomon,
+			RequiredShares: 2,
+			RepairShares:   3,
+			OptimalShares:  4,
+			TotalShares:    5,
+			ShareSize:      256,
+		},
+		RootPieceID:   testrand.PieceID(),
+		EncryptedSize: 1024,
+	}
+	for i := 0; i < pieces; i++ {
+		segment.Pieces = append(segment.Pieces, metabase.Piece{Number: uint16(i), StorageNode: testrand.NodeID()})
+	}
+	return segment
+}
+
+func TestService_Check(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	identity := testidentity.MustPregeneratedSignedIdentity(0, storj.LatestIDVersion())
+	signer := signing.SignerFromFullIdentity(identity)
+
+	object := metabase.Object{
+		ObjectStream: metabase.ObjectStream{
+			ProjectID:  testrand.UUID(),
+			BucketName: "bucket",
+			ObjectKey:  "key",
+			Version:    1,
+			StreamID:   testrand.UUID(),
+		},
+		SegmentCount: 3,
+	}
+
+	healthy := remoteSegment(object, 0, 5)
+	degraded := remoteSegment(object, 1, 5)
+	inline := metabase.Segment{
+		StreamID:   object.StreamID,
+		Position:   metabase.SegmentPosition{Index: 2},
+		InlineData: []byte{1, 2, 3},
+	}
+
+	db := &mockMetabase{object: object, segments: []metabase.Segment{healthy, degraded, inline}}
+	overlay := &mockOverlay{offline: map[storj.NodeID]bool{
+		degraded.Pieces[0].StorageNode: true,
+		degraded.Pieces[1].StorageNode: true,
+	}}
+	verifier := &mockVerifier{}
+
+	now := time.Date(2023, 3, 1, 10, 0, 0, 0, time.UTC)
+	service := integrity.NewService(zaptest.NewLogger(t), db, overlay, verifier, signer, integrity.Config{
+		SampledSegments: 10,
+		MaxSegments:     100,
+	})
+	service.SetNow(func() time.Time { return now })
+
+	t.Run("degraded", func(t *testing.T) {
+		report, err := service.Check(ctx, object.ProjectID, object.BucketName, object.ObjectKey)
+		require.NoError(t, err)
+
+		require.Equal(t, integrity.StatusDegraded, report.Status)
+		require.Equal(t, identity.ID, report.SatelliteID)
+		require.Equal(t, now, report.CheckedAt)
+		require.Zero(t, report.MissingSegments)
+		require.Len(t, report.Segments, 3)
+
+		require.Equal(t, integrity.StatusHealthy, report.Segments[0].Status)
+		require.Equal(t, 5, report.Segments[0].HealthyPieces)
+		require.True(t, report.Segments[0].Sampled)
+
+		require.Equal(t, integrity.StatusDegraded, report.Segments[1].Status)
+		require.Equal(t, 3, report.Segments[1].HealthyPieces)
+
+		require.True(t, report.Segments[2].Inline)
+		require.False(t, report.Segments[2].Sampled)
+
+		require.NoError(t, report.Verify(ctx, signer))
+
+		// any modification invalidates the signature.
+		report.Status = integrity.StatusHealthy
+		require.Error(t, report.Verify(ctx, signer))
+	})
+
+	t.Run("failed pieces", func(t *testing.T) {
+		verifier.fails = map[metabase.SegmentPosition]storj.NodeIDList{
+			healthy.Position: {healthy.Pieces[0].StorageNode, healthy.Pieces[1].StorageNode, healthy.Pieces[2].StorageNode, healthy.Pieces[3].StorageNode},
+		}
+		defer func() { verifier.fails = nil }()
+
+		report, err := service.Check(ctx, object.ProjectID, object.BucketName, object.ObjectKey)
+		require.NoError(t, err)
+		require.Equal(t, integrity.StatusDamaged, report.Status)
+		require.Equal(t, 4, report.Segments[0].FailedPieces)
+		require.Equal(t, 1, report.Segments[0].HealthyPieces)
+	})
+
+	t.Run("missing segment", func(t *testing.T) {
+		db.segments = []metabase.Segment{healthy, inline}
+		defer func() { db.segments = []metabase.Segment{healthy, degraded, inline} }()
+
+		report, err := service.Check(ctx, object.ProjectID, object.BucketName, object.ObjectKey)
+		require.NoError(t, err)
+		require.Equal(t, integrity.StatusDamaged, report.Status)
+		require.Equal(t, 1, report.MissingSegments)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		_, err := service.Check(ctx, object.ProjectID, object.BucketName, "missing")
+		require.True(t, storj.ErrObjectNotFound.Has(err))
+	})
+
+	t.Run("other satellite", func(t *testing.T) {
+		report, err := service.Check(ctx, object.ProjectID, object.BucketName, object.ObjectKey)
+		require.NoError(t, err)
+
+		other := signing.SignerFromFullIdentity(testidentity.MustPregeneratedSignedIdentity(1, storj.LatestIDVersion()))
+		require.Error(t, report.Verify(ctx, other))
+	})
+}
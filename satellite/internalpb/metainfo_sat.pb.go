@@ -36,6 +36,7 @@ type StreamID struct {
 	SatelliteSignature   []byte                   `protobuf:"bytes,9,opt,name=satellite_signature,json=satelliteSignature,proto3" json:"satellite_signature,omitempty"`
 	StreamId             []byte                   `protobuf:"bytes,10,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
 	Placement            int32                    `protobuf:"varint,13,opt,name=placement,proto3" json:"placement,omitempty"`
+	RedundancyScheme     *pb.RedundancyScheme     `protobuf:"bytes,14,opt,name=redundancy_scheme,json=redundancyScheme,proto3" json:"redundancy_scheme,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
 	XXX_unrecognized     []byte                   `json:"-"`
 	XXX_sizecache        int32                    `json:"-"`
@@ -135,6 +136,13 @@ func (m *StreamID) GetPlacement() int32 {
 	return 0
 }
 
+func (m *StreamID) GetRedundancyScheme() *pb.RedundancyScheme {
+	if m != nil {
+		return m.RedundancyScheme
+	}
+	return nil
+}
+
 type SegmentID struct {
 	StreamId             *StreamID                 `protobuf:"bytes,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
 	PartNumber           int32                     `protobuf:"varint,2,opt,name=part_number,json=partNumber,proto3" json:"part_number,omitempty"`
@@ -222,40 +230,42 @@ func init() {
 func init() { proto.RegisterFile("metainfo_sat.proto", fileDescriptor_47c60bd892d94aaf) }
 
 var fileDescriptor_47c60bd892d94aaf = []byte{
-	// 548 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x52, 0xcf, 0x6e, 0xd3, 0x4e,
-	0x10, 0xfe, 0xf9, 0x17, 0xe5, 0xdf, 0x26, 0x69, 0xaa, 0x6d, 0x8a, 0xac, 0x50, 0x14, 0xab, 0x08,
-	0xc9, 0x5c, 0x6c, 0xd4, 0x9e, 0x38, 0x12, 0x85, 0x43, 0xc4, 0x9f, 0x16, 0x07, 0x2e, 0x5c, 0xac,
-	0xb5, 0x3d, 0xb5, 0xb6, 0xb5, 0x77, 0xad, 0xdd, 0x09, 0x6a, 0x8e, 0xbc, 0x01, 0x8f, 0xc5, 0x33,
-	0x70, 0x28, 0x6f, 0xc1, 0x19, 0x79, 0x1d, 0x3b, 0x91, 0x68, 0x0f, 0x70, 0x9b, 0xf9, 0xe6, 0xdb,
-	0x6f, 0x76, 0xbe, 0x19, 0x42, 0x73, 0x40, 0xc6, 0xc5, 0x95, 0x0c, 0x35, 0x43, 0xaf, 0x50, 0x12,
-	0x25, 0xa5, 0x9a, 0x21, 0x64, 0x19, 0x47, 0xf0, 0xea, 0xea, 0xf4, 0x10, 0x44, 0xac, 0x36, 0x05,
-	0x72, 0x29, 0x2a, 0xd6, 0x94, 0xa4, 0x32, 0x95, 0xdb, 0x78, 0x96, 0x4a, 0x99, 0x66, 0xe0, 0x9b,
-	0x2c, 0x5a, 0x5f, 0xf9, 0xc8, 0x73, 0xd0, 0xc8, 0xf2, 0x62, 0x4b, 0x38, 0xa8, 0x85, 0xaa, 0xfc,
-	0xf4, 0x57, 0x8b, 0xf4, 0x56, 0xa8, 0x80, 0xe5, 0xcb, 0x05, 0x7d, 0x44, 0x3a, 0xd1, 0x3a, 0xbe,
-	0x01, 0xb4, 0x2d, 0xc7, 0x72, 0x87, 0xc1, 0x36, 0xa3, 0x2f, 0xc8, 0x64, 0xdb, 0x15, 0x92, 0x50,
-	0x46, 0xd7, 0x10, 0x63, 0x78, 0x03, 0x1b, 0xfb, 0x7f, 0xc3, 0xa2, 0x4d, 0xed, 0xc2, 0x94, 0xde,
-	0xc0, 0x86, 0xda, 0xa4, 0xfb, 0x05, 0x94, 0xe6, 0x52, 0xd8, 0x2d, 0xc7, 0x72, 0x5b, 0x41, 0x9d,
-	0xd2, 0x4f, 0xe4, 0x78, 0x37, 0x41, 0x58, 0x30, 0xc5, 0x72, 0x40, 0x50, 0xda, 0x1e, 0x3a, 0x96,
-	0x3b, 0x38, 0x73, 0xbc, 0xbd, 0xf9, 0x5e, 0x37, 0xe1, 0x65, 0xc3, 0x0b, 0x26, 0x70, 0x0f, 0x4a,
-	0x97, 0x64, 0x14, 0x2b, 0x60, 0x46, 0x34, 0x61, 0x08, 0x76, 0xdb, 0xc8, 0x4d, 0xbd, 0xca, 0x10,
-	0xaf, 0x36, 0xc4, 0xfb, 0x58, 0x1b, 0x32, 0xef, 0x7d, 0xbf, 0x9b, 0xfd, 0xf7, 0xed, 0xe7, 0xcc,
-	0x0a, 0x86, 0xf5, 0xd3, 0x05, 0x43, 0xa0, 0xef, 0xc8, 0x18, 0x6e, 0x0b, 0xae, 0xf6, 0xc4, 0x3a,
-	0x7f, 0x21, 0x76, 0xb0, 0x7b, 0x6c, 0xe4, 0x9e, 0x93, 0xc3, 0x7c, 0x9d, 0x21, 0x2f, 0x98, 0xc2,
-	0xad, 0x79, 0xf6, 0xc0, 0xb1, 0xdc, 0x5e, 0x30, 0x6e, 0xf0, 0xca, 0x38, 0xea, 0x93, 0xa3, 0x66,
-	0xe3, 0xa1, 0xe6, 0xa9, 0x60, 0xb8, 0x56, 0x60, 0xf7, 0x2b, 0x9b, 0x9b, 0xd2, 0xaa, 0xae, 0xd0,
-	0xc7, 0xa4, 0xaf, 0xcd, 0xf2, 0x42, 0x9e, 0xd8, 0xc4, 0xd0, 0x7a, 0x15, 0xb0, 0x4c, 0xe8, 0x09,
-	0xe9, 0x17, 0x19, 0x8b, 0x21, 0x07, 0x81, 0xf6, 0xc8, 0xb1, 0xdc, 0x76, 0xb0, 0x03, 0x4e, 0xbf,
-	0xb6, 0x48, 0x7f, 0x05, 0x69, 0x19, 0x2f, 0x17, 0xf4, 0xe5, 0xbe, 0x90, 0x65, 0xa6, 0x3d, 0xf1,
-	0xfe, 0xbc, 0x3e, 0xaf, 0x3e, 0x95, 0xbd, 0x36, 0x33, 0x32, 0x30, 0xa3, 0x89, 0x75, 0x1e, 0x81,
-	0x32, 0x37, 0xd1, 0x0e, 0x48, 0x09, 0xbd, 0x37, 0x08, 0x9d, 0x90, 0x36, 0x17, 0x09, 0xdc, 0x9a,
-	0x4b, 0x68, 0x07, 0x55, 0x42, 0xcf, 0xc9, 0x48, 0x49, 0x89, 0x61, 0xc1, 0x21, 0x86, 0xb2, 0x6b,
-	0xb9, 0xb0, 0xe1, 0x7c, 0x5c, 0xfa, 0xf8, 0xe3, 0x6e, 0xd6, 0xbd, 0x2c, 0xf1, 0xe5, 0x22, 0x18,
-	0x94, 0xac, 0x2a, 0x49, 0xe8, 0x07, 0x72, 0x2c, 0x15, 0x4f, 0xb9, 0x60, 0x59, 0x28, 0x55, 0x02,
-	0x2a, 0xcc, 0x78, 0xce, 0x51, 0xdb, 0x1d, 0xa7, 0xe5, 0x0e, 0xce, 0x9e, 0xec, 0x3e, 0xfa, 0x2a,
-	0x49, 0x14, 0x68, 0x0d, 0xc9, 0x45, 0x49, 0x7b, 0x5b, 0xb2, 0x82, 0xa3, 0xfa, 0xed, 0x0e, 0xbb,
-	0xe7, 0x70, 0xba, 0xff, 0x7c, 0x38, 0x0f, 0xac, 0xaf, 0xf7, 0xd0, 0xfa, 0xe6, 0xcf, 0x3e, 0x3f,
-	0xd5, 0x28, 0xd5, 0xb5, 0xc7, 0xa5, 0x6f, 0x02, 0xbf, 0x21, 0xf9, 0x5c, 0x20, 0x28, 0xc1, 0xb2,
-	0x22, 0x8a, 0x3a, 0xe6, 0x0f, 0xe7, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0x43, 0x2e, 0x40, 0x6c,
-	0x23, 0x04, 0x00, 0x00,
+	// 586 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x52, 0xcd, 0x6e, 0x13, 0x3d,
+	0x14, 0xfd, 0xe6, 0x0b, 0x49, 0x13, 0x27, 0x6d, 0x8a, 0xdb, 0x22, 0xab, 0x2d, 0xca, 0xa8, 0x08,
+	0x29, 0x6c, 0x26, 0xa8, 0x5d, 0xb1, 0xa4, 0x0a, 0x12, 0x11, 0x3f, 0x2d, 0x0e, 0x6c, 0xd8, 0x8c,
+	0x3c, 0xe3, 0xdb, 0xc1, 0xed, 0x8c, 0x3d, 0xb2, 0x1d, 0xd4, 0x2c, 0x79, 0x03, 0x1e, 0x89, 0x25,
+	0xcf, 0xc0, 0xa2, 0xbc, 0x0a, 0x1a, 0x4f, 0x66, 0x26, 0x82, 0x76, 0x01, 0x3b, 0xdf, 0x73, 0x8f,
+	0x8f, 0xaf, 0xcf, 0x3d, 0x08, 0x67, 0x60, 0x99, 0x90, 0x17, 0x2a, 0x34, 0xcc, 0x06, 0xb9, 0x56,
+	0x56, 0x61, 0x6c, 0x98, 0x85, 0x34, 0x15, 0x16, 0x82, 0xaa, 0xbb, 0xbf, 0x0d, 0x32, 0xd6, 0xcb,
+	0xdc, 0x0a, 0x25, 0x4b, 0xd6, 0x3e, 0x4a, 0x54, 0xa2, 0x56, 0xe7, 0x51, 0xa2, 0x54, 0x92, 0xc2,
+	0xc4, 0x55, 0xd1, 0xe2, 0x62, 0x62, 0x45, 0x06, 0xc6, 0xb2, 0x2c, 0x5f, 0x11, 0xb6, 0x2a, 0xa1,
+	0x55, 0x3d, 0xcc, 0x95, 0x90, 0x16, 0x34, 0x8f, 0x4a, 0xe0, 0xe8, 0xdb, 0x3d, 0xd4, 0x9d, 0x5b,
+	0x0d, 0x2c, 0x9b, 0x4d, 0xf1, 0x03, 0xd4, 0x89, 0x16, 0xf1, 0x15, 0x58, 0xe2, 0xf9, 0xde, 0x78,
+	0x40, 0x57, 0x15, 0x7e, 0x8a, 0x76, 0x57, 0x63, 0x00, 0x0f, 0x55, 0x74, 0x09, 0xb1, 0x0d, 0xaf,
+	0x60, 0x49, 0xfe, 0x77, 0x2c, 0x5c, 0xf7, 0xce, 0x5c, 0xeb, 0x15, 0x2c, 0x31, 0x41, 0x1b, 0x9f,
+	0x41, 0x1b, 0xa1, 0x24, 0x69, 0xf9, 0xde, 0xb8, 0x45, 0xab, 0x12, 0x7f, 0x40, 0x7b, 0xcd, 0x97,
+	0xc2, 0x9c, 0x69, 0x96, 0x81, 0x05, 0x6d, 0xc8, 0xc0, 0xf7, 0xc6, 0xfd, 0x63, 0x3f, 0x58, 0xfb,
+	0xf0, 0x8b, 0xfa, 0x78, 0x5e, 0xf3, 0xe8, 0x2e, 0xdc, 0x82, 0xe2, 0x19, 0xda, 0x8c, 0x35, 0x30,
+	0x27, 0xca, 0x99, 0x05, 0xd2, 0x76, 0x72, 0xfb, 0x41, 0xe9, 0x50, 0x50, 0x39, 0x14, 0xbc, 0xaf,
+	0x1c, 0x3a, 0xed, 0x7e, 0xbf, 0x19, 0xfd, 0xf7, 0xf5, 0xe7, 0xc8, 0xa3, 0x83, 0xea, 0xea, 0x94,
+	0x59, 0xc0, 0x6f, 0xd0, 0x10, 0xae, 0x73, 0xa1, 0xd7, 0xc4, 0x3a, 0x7f, 0x21, 0xb6, 0xd5, 0x5c,
+	0x76, 0x72, 0x4f, 0xd0, 0x76, 0xb6, 0x48, 0xad, 0xc8, 0x99, 0xb6, 0x2b, 0xf3, 0x48, 0xdf, 0xf7,
+	0xc6, 0x5d, 0x3a, 0xac, 0xf1, 0xd2, 0x38, 0x3c, 0x41, 0x3b, 0x75, 0x04, 0x42, 0x23, 0x12, 0xc9,
+	0xec, 0x42, 0x03, 0xe9, 0x95, 0x36, 0xd7, 0xad, 0x79, 0xd5, 0xc1, 0x07, 0xa8, 0x67, 0xdc, 0xf2,
+	0x42, 0xc1, 0x09, 0x72, 0xb4, 0x6e, 0x09, 0xcc, 0x38, 0x3e, 0x44, 0xbd, 0x3c, 0x65, 0x31, 0x64,
+	0x20, 0x2d, 0xd9, 0xf4, 0xbd, 0x71, 0x9b, 0x36, 0x00, 0x7e, 0x89, 0xee, 0x6b, 0xe0, 0x0b, 0xc9,
+	0x99, 0x8c, 0x97, 0xa1, 0x89, 0x3f, 0x41, 0x06, 0x64, 0xcb, 0xfd, 0xf3, 0x20, 0x68, 0x52, 0x42,
+	0x6b, 0xce, 0xdc, 0x51, 0xe8, 0xb6, 0xfe, 0x0d, 0x39, 0xfa, 0xd2, 0x42, 0xbd, 0x39, 0x24, 0x85,
+	0xea, 0x6c, 0x8a, 0x9f, 0xad, 0x8f, 0xe4, 0x39, 0xbd, 0xc3, 0xe0, 0xcf, 0x60, 0x07, 0x55, 0xe8,
+	0xd6, 0x06, 0x1e, 0xa1, 0xbe, 0x33, 0x49, 0x2e, 0xb2, 0x08, 0xb4, 0x4b, 0x57, 0x9b, 0xa2, 0x02,
+	0x7a, 0xeb, 0x10, 0xbc, 0x8b, 0xda, 0x42, 0x72, 0xb8, 0x76, 0x99, 0x6a, 0xd3, 0xb2, 0xc0, 0x27,
+	0x68, 0x53, 0x2b, 0x65, 0xc3, 0x5c, 0x40, 0x0c, 0xc5, 0xab, 0xc5, 0xea, 0x07, 0xa7, 0xc3, 0x62,
+	0x23, 0x3f, 0x6e, 0x46, 0x1b, 0xe7, 0x05, 0x3e, 0x9b, 0xd2, 0x7e, 0xc1, 0x2a, 0x0b, 0x8e, 0xdf,
+	0xa1, 0x3d, 0xa5, 0x45, 0x22, 0x24, 0x4b, 0x43, 0xa5, 0x39, 0xe8, 0x30, 0x15, 0x99, 0xb0, 0x86,
+	0x74, 0xfc, 0xd6, 0xb8, 0x7f, 0xfc, 0xb0, 0x19, 0xf4, 0x39, 0xe7, 0x1a, 0x8c, 0x01, 0x7e, 0x56,
+	0xd0, 0x5e, 0x17, 0x2c, 0xba, 0x53, 0xdd, 0x6d, 0xb0, 0x5b, 0x22, 0xb8, 0xf1, 0xcf, 0x11, 0xbc,
+	0x23, 0x08, 0xdd, 0xbb, 0x82, 0x70, 0xfa, 0xf8, 0xe3, 0x23, 0x63, 0x95, 0xbe, 0x0c, 0x84, 0x9a,
+	0xb8, 0xc3, 0xa4, 0x26, 0x4d, 0xdc, 0x2a, 0x25, 0x4b, 0xf3, 0x28, 0xea, 0xb8, 0x19, 0x4e, 0x7e,
+	0x0d, 0x00, 0xfe, 0xa6, 0xbe, 0x59, 0x7e, 0x04, 0x00, 0x00,
 }
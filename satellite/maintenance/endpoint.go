@@ -0,0 +1,41 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package maintenance
+
+import (
+	"context"
+	"time"
+
+	"storj.io/storj/private/maintenancepb"
+)
+
+var _ maintenancepb.DRPCMaintenanceServer = (*Endpoint)(nil)
+
+// Endpoint returns the maintenance mode state to the clients, so they know
+// how long to wait before retrying the rejected requests.
+//
+// architecture: Endpoint
+type Endpoint struct {
+	service *Service
+}
+
+// NewEndpoint creates a new maintenance mode endpoint.
+func NewEndpoint(service *Service) *Endpoint {
+	return &Endpoint{service: service}
+}
+
+// State returns the cached maintenance mode state.
+func (endpoint *Endpoint) State(ctx context.Context, req *maintenancepb.StateRequest) (_ *maintenancepb.StateResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	state := endpoint.service.State()
+	if !state.Enabled {
+		return &maintenancepb.StateResponse{}, nil
+	}
+	return &maintenancepb.StateResponse{
+		Enabled:           true,
+		Reason:            state.Reason,
+		RetryAfterSeconds: int64(state.RetryAfter / time.Second),
+	}, nil
+}
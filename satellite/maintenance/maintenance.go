@@ -0,0 +1,125 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package maintenance implements the satellite wide maintenance mode, which
+// makes the metainfo endpoint reject mutating requests while allowing reads.
+package maintenance
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/sync2"
+)
+
+var (
+	// Error is the error class for maintenance mode.
+	Error = errs.Class("maintenance")
+	mon   = monkit.Package()
+)
+
+// State is the maintenance mode state of the satellite.
+type State struct {
+	Enabled bool
+	// Reason is shown to the clients, whose requests are rejected.
+	Reason string
+	// RetryAfter is how long the clients should wait before retrying.
+	RetryAfter time.Duration
+	UpdatedAt  time.Time
+}
+
+// DB stores the maintenance mode state.
+//
+// architecture: Database
+type DB interface {
+	// Get returns the current state. The zero state is returned, when the
+	// state has never been set.
+	Get(ctx context.Context) (State, error)
+	// Set replaces the current state.
+	Set(ctx context.Context, state State) error
+}
+
+// Config contains configurable values for the maintenance mode service.
+type Config struct {
+	RefreshInterval time.Duration `help:"how often the maintenance mode state is read from the database" default:"10s" testDefault:"$TESTINTERVAL"`
+}
+
+// Service caches the maintenance mode state, so it can be checked for every
+// request without querying the database.
+//
+// A nil Service is never in maintenance mode.
+//
+// architecture: Service
+type Service struct {
+	log  *zap.Logger
+	db   DB
+	Loop *sync2.Cycle
+
+	mu    sync.RWMutex
+	state State
+}
+
+// NewService creates a new maintenance mode service.
+func NewService(log *zap.Logger, db DB, config Config) *Service {
+	return &Service{
+		log:  log,
+		db:   db,
+		Loop: sync2.NewCycle(config.RefreshInterval),
+	}
+}
+
+// Run refreshes the state until ctx is canceled.
+func (service *Service) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return service.Loop.Run(ctx, func(ctx context.Context) error {
+		if err := service.Refresh(ctx); err != nil {
+			service.log.Error("failed to refresh maintenance mode state", zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// Refresh reads the state from the database.
+func (service *Service) Refresh(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	state, err := service.db.Get(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	service.mu.Lock()
+	changed := state.Enabled != service.state.Enabled
+	service.state = state
+	service.mu.Unlock()
+
+	if changed {
+		service.log.Info("maintenance mode changed",
+			zap.Bool("enabled", state.Enabled),
+			zap.String("reason", state.Reason))
+	}
+	return nil
+}
+
+// State returns the cached state.
+func (service *Service) State() State {
+	if service == nil {
+		return State{}
+	}
+
+	service.mu.RLock()
+	defer service.mu.RUnlock()
+	return service.state
+}
+
+// Close stops the service.
+func (service *Service) Close() error {
+	service.Loop.Close()
+	return nil
+}
@@ -0,0 +1,95 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package maintenance_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/private/maintenancepb"
+	"storj.io/storj/satellite/maintenance"
+)
+
+type mockDB struct {
+	state maintenance.State
+	err   error
+}
+
+func (db *mockDB) Get(ctx context.Context) (maintenance.State, error) {
+	return db.state, db.err
+}
+
+func (db *mockDB) Set(ctx context.Context, state maintenance.State) error {
+	db.state = state
+	return db.err
+}
+
+func TestService(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	db := &mockDB{}
+	service := maintenance.NewService(zaptest.NewLogger(t), db, maintenance.Config{RefreshInterval: time.Hour})
+	defer ctx.Check(service.Close)
+
+	require.NoError(t, service.Refresh(ctx))
+	require.False(t, service.State().Enabled)
+
+	require.NoError(t, db.Set(ctx, maintenance.State{
+		Enabled:    true,
+		Reason:     "migration",
+		RetryAfter: time.Minute,
+	}))
+
+	// the state is cached until the next refresh.
+	require.False(t, service.State().Enabled)
+
+	require.NoError(t, service.Refresh(ctx))
+	state := service.State()
+	require.True(t, state.Enabled)
+	require.Equal(t, "migration", state.Reason)
+	require.Equal(t, time.Minute, state.RetryAfter)
+
+	// a failed refresh keeps the previous state.
+	db.err = errors.New("database unavailable")
+	require.Error(t, service.Refresh(ctx))
+	require.True(t, service.State().Enabled)
+}
+
+func TestService_Nil(t *testing.T) {
+	var service *maintenance.Service
+	require.False(t, service.State().Enabled)
+}
+
+func TestEndpoint(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	db := &mockDB{}
+	service := maintenance.NewService(zaptest.NewLogger(t), db, maintenance.Config{RefreshInterval: time.Hour})
+	defer ctx.Check(service.Close)
+	endpoint := maintenance.NewEndpoint(service)
+
+	require.NoError(t, service.Refresh(ctx))
+	resp, err := endpoint.State(ctx, &maintenancepb.StateRequest{})
+	require.NoError(t, err)
+	require.False(t, resp.Enabled)
+
+	require.NoError(t, db.Set(ctx, maintenance.State{
+		Enabled:    true,
+		Reason:     "migration",
+		RetryAfter: 5 * time.Minute,
+	}))
+	require.NoError(t, service.Refresh(ctx))
+
+	resp, err = endpoint.State(ctx, &maintenancepb.StateRequest{})
+	require.NoError(t, err)
+	require.True(t, resp.Enabled)
+	require.Equal(t, "migration", resp.Reason)
+	require.EqualValues(t, 300, resp.RetryAfterSeconds)
+}
@@ -0,0 +1,289 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/uuid"
+)
+
+// ErrAtRest is used when the object metadata cannot be encrypted or decrypted
+// at rest.
+var ErrAtRest = errs.Class("metadata at rest")
+
+// KeyManager manages the per project key encryption keys, which are used for
+// encrypting the object metadata at rest.
+//
+// The metadata is encrypted with data encryption keys, which are generated by
+// metabase and wrapped with the key of the project, i.e. the key encryption
+// keys never leave the key manager.
+type KeyManager interface {
+	// WrapKey encrypts a data encryption key with the key of the project.
+	WrapKey(ctx context.Context, projectID uuid.UUID, key []byte) ([]byte, error)
+	// UnwrapKey decrypts a data encryption key, which was wrapped with WrapKey.
+	UnwrapKey(ctx context.Context, projectID uuid.UUID, wrapped []byte) ([]byte, error)
+}
+
+// StaticKeyManager is a KeyManager, which derives the project keys from a
+// single master key.
+type StaticKeyManager struct {
+	masterKey [32]byte
+}
+
+// NewStaticKeyManager returns a new key manager, which derives the project
+// keys from the master key.
+func NewStaticKeyManager(masterKey [32]byte) *StaticKeyManager {
+	return &StaticKeyManager{masterKey: masterKey}
+}
+
+// WrapKey implements KeyManager.
+func (manager *StaticKeyManager) WrapKey(ctx context.Context, projectID uuid.UUID, key []byte) (_ []byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	aead, err := manager.projectAEAD(projectID)
+	if err != nil {
+		return nil, ErrAtRest.Wrap(err)
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, ErrAtRest.Wrap(err)
+	}
+	return aead.Seal(nonce, nonce, key, projectID[:]), nil
+}
+
+// UnwrapKey implements KeyManager.
+func (manager *StaticKeyManager) UnwrapKey(ctx context.Context, projectID uuid.UUID, wrapped []byte) (_ []byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	aead, err := manager.projectAEAD(projectID)
+	if err != nil {
+		return nil, ErrAtRest.Wrap(err)
+	}
+	if len(wrapped) < aead.NonceSize() {
+		return nil, ErrAtRest.New("wrapped key too short")
+	}
+
+	nonce, ciphertext := wrapped[:aead.NonceSize()], wrapped[aead.NonceSize():]
+	key, err := aead.Open(nil, nonce, ciphertext, projectID[:])
+	if err != nil {
+		return nil, ErrAtRest.Wrap(err)
+	}
+	return key, nil
+}
+
+// projectAEAD returns the cipher using the key of the project.
+func (manager *StaticKeyManager) projectAEAD(projectID uuid.UUID) (cipher.AEAD, error) {
+	mac := hmac.New(sha256.New, manager.masterKey[:])
+	_, _ = mac.Write(projectID[:])
+	return newAEAD(mac.Sum(nil))
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// sealedHeader prefixes the values, which are encrypted at rest. The values
+// sent by uplinks are ciphertexts, so the chance that one of them starts with
+// the header is negligible.
+var sealedHeader = []byte{0x00, 's', 'j', '-', 'r', 'e', 's', 't', 0x01}
+
+// metadataField identifies the column of a sealed value, so a sealed value
+// cannot be moved into a different column.
+type metadataField byte
+
+const (
+	metadataFieldNonce metadataField = iota + 1
+	metadataFieldMetadata
+	metadataFieldKey
+)
+
+// sealedMetadata contains the encrypted metadata fields of an object as stored
+// in the database.
+type sealedMetadata struct {
+	Nonce    []byte
+	Metadata []byte
+	Key      []byte
+}
+
+// sealMetadata encrypts the metadata fields of an object with a new data
+// encryption key, when a KeyManager is configured. Missing and empty fields
+// are stored as they are.
+func (db *DB) sealMetadata(ctx context.Context, projectID uuid.UUID, nonce, metadata, key []byte) (_ sealedMetadata, err error) {
+	if db.config.KeyManager == nil || (len(nonce) == 0 && len(metadata) == 0 && len(key) == 0) {
+		return sealedMetadata{Nonce: nonce, Metadata: metadata, Key: key}, nil
+	}
+	defer mon.Task()(&ctx)(&err)
+
+	dataKey := make([]byte, 32)
+	if _, err := rand.Read(dataKey); err != nil {
+		return sealedMetadata{}, ErrAtRest.Wrap(err)
+	}
+
+	wrapped, err := db.config.KeyManager.WrapKey(ctx, projectID, dataKey)
+	if err != nil {
+		return sealedMetadata{}, ErrAtRest.Wrap(err)
+	}
+
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return sealedMetadata{}, ErrAtRest.Wrap(err)
+	}
+
+	var sealed sealedMetadata
+	if sealed.Nonce, err = sealValue(aead, projectID, metadataFieldNonce, wrapped, nonce); err != nil {
+		return sealedMetadata{}, err
+	}
+	if sealed.Metadata, err = sealValue(aead, projectID, metadataFieldMetadata, wrapped, metadata); err != nil {
+		return sealedMetadata{}, err
+	}
+	if sealed.Key, err = sealValue(aead, projectID, metadataFieldKey, wrapped, key); err != nil {
+		return sealedMetadata{}, err
+	}
+
+	mon.Meter("metadata_sealed").Mark(1)
+	return sealed, nil
+}
+
+// openMetadata decrypts the metadata fields of an object in place. Fields,
+// which were stored before a KeyManager was configured, are left as they are.
+func (db *DB) openMetadata(ctx context.Context, projectID uuid.UUID, nonce, metadata, key *[]byte) (err error) {
+	if !isSealed(*nonce) && !isSealed(*metadata) && !isSealed(*key) {
+		return nil
+	}
+	defer mon.Task()(&ctx)(&err)
+
+	if db.config.KeyManager == nil {
+		return ErrAtRest.New("metadata is encrypted at rest, but no key manager is configured")
+	}
+
+	// the fields are usually sealed with the same data encryption key.
+	var lastWrapped []byte
+	var aead cipher.AEAD
+	open := func(field metadataField, value *[]byte) error {
+		if !isSealed(*value) {
+			return nil
+		}
+
+		wrapped, nonce, ciphertext, err := parseSealed(*value)
+		if err != nil {
+			return err
+		}
+
+		if aead == nil || !bytes.Equal(wrapped, lastWrapped) {
+			dataKey, err := db.config.KeyManager.UnwrapKey(ctx, projectID, wrapped)
+			if err != nil {
+				return ErrAtRest.Wrap(err)
+			}
+			aead, err = newAEAD(dataKey)
+			if err != nil {
+				return ErrAtRest.Wrap(err)
+			}
+			lastWrapped = wrapped
+		}
+
+		plaintext, err := aead.Open(nil, nonce, ciphertext, additionalData(projectID, field))
+		if err != nil {
+			return ErrAtRest.Wrap(err)
+		}
+		*value = plaintext
+		return nil
+	}
+
+	return errs.Combine(
+		open(metadataFieldNonce, nonce),
+		open(metadataFieldMetadata, metadata),
+		open(metadataFieldKey, key),
+	)
+}
+
+// openObjectMetadata decrypts the metadata fields of the object in place.
+func (db *DB) openObjectMetadata(ctx context.Context, object *Object) error {
+	return db.openMetadata(ctx, object.ProjectID,
+		&object.EncryptedMetadataNonce, &object.EncryptedMetadata, &object.EncryptedMetadataEncryptedKey)
+}
+
+// openDeletedObjects decrypts the metadata of the deleted objects in place.
+// The objects are already gone, so metadata which cannot be decrypted is
+// dropped instead of failing the deletion.
+func (db *DB) openDeletedObjects(ctx context.Context, objects []Object) {
+	for i := range objects {
+		object := &objects[i]
+		if err := db.openObjectMetadata(ctx, object); err != nil {
+			db.log.Warn("unable to decrypt metadata of deleted object",
+				zap.Stringer("Project ID", object.ProjectID),
+				zap.Stringer("Stream ID", object.StreamID),
+				zap.Error(err))
+			object.EncryptedMetadataNonce = nil
+			object.EncryptedMetadata = nil
+			object.EncryptedMetadataEncryptedKey = nil
+		}
+	}
+}
+
+// sealValue encrypts a single value into the format:
+//
+//	header | uvarint(len(wrapped)) | wrapped | nonce | ciphertext
+func sealValue(aead cipher.AEAD, projectID uuid.UUID, field metadataField, wrapped, value []byte) ([]byte, error) {
+	if len(value) == 0 {
+		return value, nil
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, ErrAtRest.Wrap(err)
+	}
+
+	var length [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(length[:], uint64(len(wrapped)))
+
+	sealed := make([]byte, 0, len(sealedHeader)+n+len(wrapped)+len(nonce)+len(value)+aead.Overhead())
+	sealed = append(sealed, sealedHeader...)
+	sealed = append(sealed, length[:n]...)
+	sealed = append(sealed, wrapped...)
+	sealed = append(sealed, nonce...)
+	return aead.Seal(sealed, nonce, value, additionalData(projectID, field)), nil
+}
+
+// parseSealed splits a sealed value into its parts.
+func parseSealed(sealed []byte) (wrapped, nonce, ciphertext []byte, err error) {
+	rest := sealed[len(sealedHeader):]
+
+	wrappedLength, n := binary.Uvarint(rest)
+	if n <= 0 || wrappedLength > uint64(len(rest)-n) {
+		return nil, nil, nil, ErrAtRest.New("invalid wrapped key length")
+	}
+	rest = rest[n:]
+	wrapped, rest = rest[:wrappedLength], rest[wrappedLength:]
+
+	// the data encryption keys are always used with the standard GCM nonce size.
+	const nonceSize = 12
+	if len(rest) < nonceSize {
+		return nil, nil, nil, ErrAtRest.New("sealed value too short")
+	}
+	return wrapped, rest[:nonceSize], rest[nonceSize:], nil
+}
+
+func isSealed(value []byte) bool {
+	return bytes.HasPrefix(value, sealedHeader)
+}
+
+func additionalData(projectID uuid.UUID, field metadataField) []byte {
+	return append(projectID[:len(projectID):len(projectID)], byte(field))
+}
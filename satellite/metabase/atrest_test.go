@@ -0,0 +1,158 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/metabasetest"
+)
+
+func TestStaticKeyManager(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	var masterKey [32]byte
+	copy(masterKey[:], testrand.BytesInt(32))
+	manager := metabase.NewStaticKeyManager(masterKey)
+
+	projectID := testrand.UUID()
+	key := testrand.BytesInt(32)
+
+	wrapped, err := manager.WrapKey(ctx, projectID, key)
+	require.NoError(t, err)
+	require.False(t, bytes.Contains(wrapped, key))
+
+	unwrapped, err := manager.UnwrapKey(ctx, projectID, wrapped)
+	require.NoError(t, err)
+	require.Equal(t, key, unwrapped)
+
+	// the keys of the projects differ.
+	_, err = manager.UnwrapKey(ctx, testrand.UUID(), wrapped)
+	require.Error(t, err)
+
+	// the master keys differ.
+	copy(masterKey[:], testrand.BytesInt(32))
+	_, err = metabase.NewStaticKeyManager(masterKey).UnwrapKey(ctx, projectID, wrapped)
+	require.Error(t, err)
+}
+
+func TestMetadataAtRest(t *testing.T) {
+	var masterKey [32]byte
+	copy(masterKey[:], testrand.BytesInt(32))
+
+	config := metabase.Config{
+		ApplicationName:  "satellite-test",
+		MinPartSize:      0,
+		MaxNumberOfParts: 10000,
+		KeyManager:       metabase.NewStaticKeyManager(masterKey),
+	}
+
+	metabasetest.RunWithConfig(t, config, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		obj := metabasetest.RandObjectStream()
+
+		nonce := testrand.Nonce()
+		metadata := testrand.BytesInt(64)
+		key := testrand.BytesInt(32)
+
+		requireSealed := func(nonce, metadata, key []byte) {
+			objects, err := db.TestingAllObjects(ctx)
+			require.NoError(t, err)
+			require.Len(t, objects, 1)
+
+			for _, value := range [][]byte{nonce, metadata, key} {
+				for _, stored := range [][]byte{objects[0].EncryptedMetadataNonce, objects[0].EncryptedMetadata, objects[0].EncryptedMetadataEncryptedKey} {
+					require.False(t, bytes.Contains(stored, value))
+				}
+			}
+		}
+
+		requireMetadata := func(location metabase.ObjectLocation, nonce, metadata, key []byte) {
+			object, err := db.GetObjectLastCommitted(ctx, metabase.GetObjectLastCommitted{ObjectLocation: location})
+			require.NoError(t, err)
+			require.Equal(t, nonce, object.EncryptedMetadataNonce)
+			require.Equal(t, metadata, object.EncryptedMetadata)
+			require.Equal(t, key, object.EncryptedMetadataEncryptedKey)
+
+			result, err := db.ListObjects(ctx, metabase.ListObjects{
+				ProjectID:             location.ProjectID,
+				BucketName:            location.BucketName,
+				Recursive:             true,
+				Limit:                 10,
+				Status:                metabase.Committed,
+				IncludeCustomMetadata: true,
+			})
+			require.NoError(t, err)
+			require.Len(t, result.Objects, 1)
+			require.Equal(t, nonce, result.Objects[0].EncryptedMetadataNonce)
+			require.Equal(t, metadata, result.Objects[0].EncryptedMetadata)
+			require.Equal(t, key, result.Objects[0].EncryptedMetadataEncryptedKey)
+		}
+
+		_, err := db.BeginObjectExactVersion(ctx, metabase.BeginObjectExactVersion{
+			ObjectStream: obj,
+			Encryption:   metabasetest.DefaultEncryption,
+
+			EncryptedMetadataNonce:        nonce[:],
+			EncryptedMetadata:             metadata,
+			EncryptedMetadataEncryptedKey: key,
+		})
+		require.NoError(t, err)
+		requireSealed(nonce[:], metadata, key)
+
+		object, err := db.CommitObject(ctx, metabase.CommitObject{ObjectStream: obj})
+		require.NoError(t, err)
+		require.Equal(t, metadata, object.EncryptedMetadata)
+		requireMetadata(obj.Location(), nonce[:], metadata, key)
+
+		newNonce := testrand.Nonce()
+		newMetadata := testrand.BytesInt(64)
+		newKey := testrand.BytesInt(32)
+		err = db.UpdateObjectMetadata(ctx, metabase.UpdateObjectMetadata{
+			ProjectID:  obj.ProjectID,
+			BucketName: obj.BucketName,
+			ObjectKey:  obj.ObjectKey,
+			StreamID:   obj.StreamID,
+
+			EncryptedMetadataNonce:        newNonce[:],
+			EncryptedMetadata:             newMetadata,
+			EncryptedMetadataEncryptedKey: newKey,
+		})
+		require.NoError(t, err)
+		requireSealed(newNonce[:], newMetadata, newKey)
+		requireMetadata(obj.Location(), newNonce[:], newMetadata, newKey)
+
+		movedNonce := testrand.Nonce()
+		movedKey := testrand.BytesInt(32)
+		err = db.FinishMoveObject(ctx, metabase.FinishMoveObject{
+			ObjectStream:                 obj,
+			NewBucket:                    obj.BucketName,
+			NewEncryptedObjectKey:        []byte("moved"),
+			NewEncryptedMetadataKeyNonce: movedNonce,
+			NewEncryptedMetadataKey:      movedKey,
+		})
+		require.NoError(t, err)
+
+		moved := obj.Location()
+		moved.ObjectKey = "moved"
+		requireSealed(movedNonce[:], newMetadata, movedKey)
+		requireMetadata(moved, movedNonce[:], newMetadata, movedKey)
+
+		result, err := db.DeleteObjectExactVersion(ctx, metabase.DeleteObjectExactVersion{
+			ObjectLocation: moved,
+			Version:        obj.Version,
+		})
+		require.NoError(t, err)
+		require.Len(t, result.Objects, 1)
+		require.Equal(t, newMetadata, result.Objects[0].EncryptedMetadata)
+		require.Equal(t, movedKey, result.Objects[0].EncryptedMetadataEncryptedKey)
+
+		metabasetest.Verify{}.Check(ctx, t, db)
+	})
+}
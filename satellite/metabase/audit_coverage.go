@@ -0,0 +1,143 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase
+
+import (
+	"context"
+	"time"
+
+	"storj.io/common/uuid"
+	"storj.io/private/dbutil/pgutil"
+)
+
+// AuditedSegment identifies a segment, which was sampled for audit.
+type AuditedSegment struct {
+	StreamID uuid.UUID
+	Position SegmentPosition
+}
+
+// MarkSegmentsAudited contains arguments necessary for recording when
+// segments were sampled for audit.
+type MarkSegmentsAudited struct {
+	Segments  []AuditedSegment
+	AuditedAt time.Time
+}
+
+// MarkSegmentsAudited sets the last audit time of the segments. Segments,
+// which don't exist anymore, are ignored.
+func (db *DB) MarkSegmentsAudited(ctx context.Context, opts MarkSegmentsAudited) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if opts.AuditedAt.IsZero() {
+		return ErrInvalidRequest.New("AuditedAt missing")
+	}
+	if err := db.markSegments(ctx, "last_audited_at", opts.Segments, opts.AuditedAt); err != nil {
+		return Error.New("unable to mark segments audited: %w", err)
+	}
+
+	mon.Meter("segments_marked_audited").Mark(len(opts.Segments))
+	return nil
+}
+
+// MarkSegmentsVerified contains arguments necessary for recording when
+// segments were audited.
+type MarkSegmentsVerified struct {
+	Segments   []AuditedSegment
+	VerifiedAt time.Time
+}
+
+// MarkSegmentsVerified sets the time, when the segments were last audited.
+// Unlike MarkSegmentsAudited, it's called after the audit was performed and
+// not when the segments were sampled. Segments, which don't exist anymore,
+// are ignored.
+func (db *DB) MarkSegmentsVerified(ctx context.Context, opts MarkSegmentsVerified) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if opts.VerifiedAt.IsZero() {
+		return ErrInvalidRequest.New("VerifiedAt missing")
+	}
+
+	if err := db.markSegments(ctx, "last_verified_at", opts.Segments, opts.VerifiedAt); err != nil {
+		return Error.New("unable to mark segments verified: %w", err)
+	}
+
+	mon.Meter("segments_marked_verified").Mark(len(opts.Segments))
+	return nil
+}
+
+// markSegments sets the timestamp column of the segments.
+func (db *DB) markSegments(ctx context.Context, column string, segments []AuditedSegment, at time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(segments) == 0 {
+		return nil
+	}
+
+	streamIDs := make([]uuid.UUID, len(segments))
+	positions := make([]int64, len(segments))
+	for i, segment := range segments {
+		streamIDs[i] = segment.StreamID
+		positions[i] = int64(segment.Position.Encode())
+	}
+
+	_, err = db.db.ExecContext(ctx, `
+		UPDATE segments
+		SET `+column+` = $3
+		FROM (SELECT unnest($1::BYTEA[]), unnest($2::INT8[])) AS P(stream_id, position)
+		WHERE segments.stream_id = P.stream_id AND segments.position = P.position
+	`, pgutil.UUIDArray(streamIDs), pgutil.Int8Array(positions), at)
+	return err
+}
+
+// GetAuditCoverage contains arguments necessary for the audit coverage
+// report.
+type GetAuditCoverage struct {
+	// AuditedSince is the time, after which segments count as recently
+	// audited.
+	AuditedSince time.Time
+
+	AsOfSystemTime     time.Time
+	AsOfSystemInterval time.Duration
+}
+
+// AuditCoverage summarizes how many of the remote segments were sampled for
+// audit.
+type AuditCoverage struct {
+	Segments          int64
+	AuditedSegments   int64
+	RecentlyAudited   int64
+	NeverAuditedBytes int64
+}
+
+// NeverAudited returns the number of segments, which were never sampled for
+// audit.
+func (coverage AuditCoverage) NeverAudited() int64 {
+	return coverage.Segments - coverage.AuditedSegments
+}
+
+// GetAuditCoverage counts the remote segments by when they were last sampled
+// for audit. It scans all segments, so it should only be used for reports.
+func (db *DB) GetAuditCoverage(ctx context.Context, opts GetAuditCoverage) (coverage AuditCoverage, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = db.db.QueryRowContext(ctx, `
+		SELECT
+			count(*),
+			count(last_audited_at),
+			COALESCE(sum(CASE WHEN last_audited_at >= $1 THEN 1 ELSE 0 END), 0),
+			COALESCE(sum(CASE WHEN last_audited_at IS NULL THEN encrypted_size ELSE 0 END), 0)
+		FROM segments
+		`+db.asOfTime(opts.AsOfSystemTime, opts.AsOfSystemInterval)+`
+		WHERE
+			inline_data IS NULL AND
+			remote_alias_pieces IS NOT NULL
+	`, opts.AuditedSince).Scan(
+		&coverage.Segments, &coverage.AuditedSegments,
+		&coverage.RecentlyAudited, &coverage.NeverAuditedBytes,
+	)
+	if err != nil {
+		return AuditCoverage{}, Error.New("unable to get audit coverage: %w", err)
+	}
+	return coverage, nil
+}
@@ -0,0 +1,65 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/metabasetest"
+)
+
+func TestAuditCoverage(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		obj := metabasetest.RandObjectStream()
+		metabasetest.CreateObject(ctx, t, db, obj, 3)
+
+		now := time.Now()
+		longAgo := now.Add(-90 * 24 * time.Hour)
+
+		err := db.MarkSegmentsAudited(ctx, metabase.MarkSegmentsAudited{
+			Segments: []metabase.AuditedSegment{{StreamID: obj.StreamID}},
+		})
+		require.True(t, metabase.ErrInvalidRequest.Has(err))
+
+		require.NoError(t, db.MarkSegmentsAudited(ctx, metabase.MarkSegmentsAudited{
+			Segments: []metabase.AuditedSegment{
+				{StreamID: obj.StreamID, Position: metabase.SegmentPosition{Index: 0}},
+			},
+			AuditedAt: longAgo,
+		}))
+		require.NoError(t, db.MarkSegmentsAudited(ctx, metabase.MarkSegmentsAudited{
+			Segments: []metabase.AuditedSegment{
+				{StreamID: obj.StreamID, Position: metabase.SegmentPosition{Index: 1}},
+				// missing segments are ignored.
+				{StreamID: obj.StreamID, Position: metabase.SegmentPosition{Index: 10}},
+			},
+			AuditedAt: now,
+		}))
+
+		state, err := db.TestingGetState(ctx)
+		require.NoError(t, err)
+		segments := state.Segments
+		require.Len(t, segments, 3)
+		require.WithinDuration(t, longAgo, *segments[0].LastAuditedAt, time.Second)
+		require.WithinDuration(t, now, *segments[1].LastAuditedAt, time.Second)
+		require.Nil(t, segments[2].LastAuditedAt)
+
+		coverage, err := db.GetAuditCoverage(ctx, metabase.GetAuditCoverage{
+			AuditedSince: now.Add(-30 * 24 * time.Hour),
+		})
+		require.NoError(t, err)
+		require.Equal(t, metabase.AuditCoverage{
+			Segments:          3,
+			AuditedSegments:   2,
+			RecentlyAudited:   1,
+			NeverAuditedBytes: int64(segments[2].EncryptedSize),
+		}, coverage)
+		require.EqualValues(t, 1, coverage.NeverAudited())
+	})
+}
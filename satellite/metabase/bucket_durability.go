@@ -0,0 +1,149 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase
+
+import (
+	"context"
+	"time"
+
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/private/dbutil"
+	"storj.io/private/tagsql"
+)
+
+// GetBucketDurability contains arguments necessary for the durability report
+// of a bucket.
+type GetBucketDurability struct {
+	ProjectID  uuid.UUID
+	BucketName string
+
+	// Since is the time, after which audits and repairs count as recent.
+	Since time.Time
+	// ReliableNodes are the online and qualified nodes. Pieces on other nodes
+	// don't count towards PiecesAboveMinimum.
+	ReliableNodes map[storj.NodeID]struct{}
+
+	AsOfSystemTime     time.Time
+	AsOfSystemInterval time.Duration
+}
+
+// BucketDurability summarizes the durability of the committed segments of a
+// bucket.
+type BucketDurability struct {
+	// ComputedAt is the time, which the summary reflects. It's before the
+	// time of the query, when it used AS OF SYSTEM TIME.
+	ComputedAt time.Time
+
+	RemoteSegments int64
+	InlineSegments int64
+
+	// AuditedSegments and RecentlyAudited count the segments, which were
+	// audited, and not the segments, which were only sampled for audit.
+	AuditedSegments  int64
+	RecentlyAudited  int64
+	RecentlyRepaired int64
+
+	// PiecesAboveMinimum counts the remote segments by how many pieces on
+	// reliable nodes they have above the number required to reconstruct them.
+	// It's negative for segments, which can't be reconstructed.
+	PiecesAboveMinimum map[int]int64
+}
+
+// GetBucketDurability summarizes the audits, the repairs and the redundancy of
+// the committed segments of the bucket. It scans all segments of the bucket,
+// so it should only be used for reports.
+func (db *DB) GetBucketDurability(ctx context.Context, opts GetBucketDurability) (durability BucketDurability, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := (BucketLocation{ProjectID: opts.ProjectID, BucketName: opts.BucketName}).Verify(); err != nil {
+		return BucketDurability{}, err
+	}
+
+	aliasMap, err := db.aliasCache.Latest(ctx)
+	if err != nil {
+		return BucketDurability{}, Error.New("unable to get bucket durability: %w", err)
+	}
+
+	// the time of the query is explicit, so that the report can include it.
+	now := time.Now()
+	durability.ComputedAt = now
+	if db.impl == dbutil.Cockroach {
+		switch {
+		case !opts.AsOfSystemTime.IsZero() && opts.AsOfSystemTime.Before(now):
+			durability.ComputedAt = opts.AsOfSystemTime
+		case opts.AsOfSystemInterval < 0:
+			durability.ComputedAt = now.Add(opts.AsOfSystemInterval)
+		}
+	}
+	asOfTime := ""
+	if durability.ComputedAt.Before(now) {
+		asOfTime = db.impl.AsOfSystemTime(durability.ComputedAt)
+	}
+
+	durability.PiecesAboveMinimum = map[int]int64{}
+
+	err = withRows(db.db.QueryContext(ctx, `
+		SELECT
+			segments.remote_alias_pieces, segments.redundancy,
+			segments.last_verified_at, segments.repaired_at
+		FROM objects
+		JOIN segments ON segments.stream_id = objects.stream_id
+		`+asOfTime+`
+		WHERE
+			objects.project_id  = $1 AND
+			objects.bucket_name = $2 AND
+			objects.status      = `+committedStatus,
+		opts.ProjectID, []byte(opts.BucketName)))(func(rows tagsql.Rows) error {
+		for rows.Next() {
+			var aliasPieces AliasPieces
+			var redundancy storj.RedundancyScheme
+			var lastVerifiedAt, repairedAt *time.Time
+			err := rows.Scan(&aliasPieces, redundancyScheme{&redundancy}, &lastVerifiedAt, &repairedAt)
+			if err != nil {
+				return err
+			}
+
+			if len(aliasPieces) == 0 {
+				durability.InlineSegments++
+				continue
+			}
+
+			reliable := 0
+			for _, piece := range aliasPieces {
+				node, ok := aliasMap.Node(piece.Alias)
+				if !ok {
+					// the node got its alias after the map was loaded.
+					nodes, err := db.aliasCache.Nodes(ctx, []NodeAlias{piece.Alias})
+					if err != nil {
+						return err
+					}
+					node = nodes[0]
+				}
+				if _, ok := opts.ReliableNodes[node]; ok {
+					reliable++
+				}
+			}
+
+			durability.RemoteSegments++
+			durability.PiecesAboveMinimum[reliable-int(redundancy.RequiredShares)]++
+
+			if lastVerifiedAt != nil {
+				durability.AuditedSegments++
+				if !lastVerifiedAt.Before(opts.Since) {
+					durability.RecentlyAudited++
+				}
+			}
+			if repairedAt != nil && !repairedAt.Before(opts.Since) {
+				durability.RecentlyRepaired++
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return BucketDurability{}, Error.New("unable to get bucket durability: %w", err)
+	}
+
+	return durability, nil
+}
@@ -0,0 +1,104 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/metabasetest"
+)
+
+func TestGetBucketDurability(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		obj := metabasetest.RandObjectStream()
+		metabasetest.CreateObject(ctx, t, db, obj, 3)
+
+		// objects of other buckets aren't included.
+		metabasetest.CreateObject(ctx, t, db, metabasetest.RandObjectStream(), 2)
+
+		now := time.Now()
+		since := now.Add(-30 * 24 * time.Hour)
+
+		_, err := db.GetBucketDurability(ctx, metabase.GetBucketDurability{ProjectID: obj.ProjectID})
+		require.True(t, metabase.ErrInvalidRequest.Has(err))
+		require.True(t, metabase.ErrInvalidRequest.Has(db.MarkSegmentsVerified(ctx, metabase.MarkSegmentsVerified{})))
+
+		require.NoError(t, db.MarkSegmentsVerified(ctx, metabase.MarkSegmentsVerified{
+			Segments: []metabase.AuditedSegment{
+				{StreamID: obj.StreamID, Position: metabase.SegmentPosition{Index: 0}},
+			},
+			VerifiedAt: now.Add(-90 * 24 * time.Hour),
+		}))
+		require.NoError(t, db.MarkSegmentsVerified(ctx, metabase.MarkSegmentsVerified{
+			Segments: []metabase.AuditedSegment{
+				{StreamID: obj.StreamID, Position: metabase.SegmentPosition{Index: 1}},
+			},
+			VerifiedAt: now,
+		}))
+		// segments, which were only sampled for audit, don't count as audited.
+		require.NoError(t, db.MarkSegmentsAudited(ctx, metabase.MarkSegmentsAudited{
+			Segments: []metabase.AuditedSegment{
+				{StreamID: obj.StreamID, Position: metabase.SegmentPosition{Index: 2}},
+			},
+			AuditedAt: now,
+		}))
+
+		// the repair added a piece to the last segment.
+		require.NoError(t, db.UpdateSegmentPieces(ctx, metabase.UpdateSegmentPieces{
+			StreamID:      obj.StreamID,
+			Position:      metabase.SegmentPosition{Index: 2},
+			OldPieces:     metabase.Pieces{{Number: 0, StorageNode: storj.NodeID{2}}},
+			NewRedundancy: metabasetest.DefaultRedundancy,
+			NewPieces: metabase.Pieces{
+				{Number: 0, StorageNode: storj.NodeID{2}},
+				{Number: 1, StorageNode: storj.NodeID{3}},
+			},
+			NewRepairedAt: now,
+		}))
+
+		durability, err := db.GetBucketDurability(ctx, metabase.GetBucketDurability{
+			ProjectID:  obj.ProjectID,
+			BucketName: obj.BucketName,
+			Since:      since,
+			ReliableNodes: map[storj.NodeID]struct{}{
+				{2}: {},
+				{3}: {},
+			},
+		})
+		require.NoError(t, err)
+		require.WithinDuration(t, time.Now(), durability.ComputedAt, time.Minute)
+		durability.ComputedAt = time.Time{}
+		require.Equal(t, metabase.BucketDurability{
+			RemoteSegments:   3,
+			AuditedSegments:  2,
+			RecentlyAudited:  1,
+			RecentlyRepaired: 1,
+			PiecesAboveMinimum: map[int]int64{
+				0: 2,
+				1: 1,
+			},
+		}, durability)
+
+		// pieces on offline or disqualified nodes don't count.
+		durability, err = db.GetBucketDurability(ctx, metabase.GetBucketDurability{
+			ProjectID:  obj.ProjectID,
+			BucketName: obj.BucketName,
+			Since:      since,
+			ReliableNodes: map[storj.NodeID]struct{}{
+				{3}: {},
+			},
+		})
+		require.NoError(t, err)
+		require.Equal(t, map[int]int64{
+			-1: 2,
+			0:  1,
+		}, durability.PiecesAboveMinimum)
+	})
+}
@@ -0,0 +1,231 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/private/dbutil/pgutil"
+	"storj.io/private/tagsql"
+)
+
+// ErrBucketLifecycleNotFound is an error class for buckets without a lifecycle
+// configuration.
+var ErrBucketLifecycleNotFound = errs.Class("bucket lifecycle not found")
+
+// BucketLifecycle is the lifecycle configuration of a bucket. The committed
+// objects of the bucket are transitioned to another placement, once they are
+// older than TransitionDays, and deleted, once they are older than
+// ExpirationDays. Zero days disable the rule.
+type BucketLifecycle struct {
+	ProjectID  uuid.UUID
+	BucketName string
+
+	TransitionDays      int
+	TransitionPlacement storj.PlacementConstraint
+	ExpirationDays      int
+
+	CreatedAt time.Time
+}
+
+// Bucket returns the location of the bucket of the configuration.
+func (lifecycle BucketLifecycle) Bucket() BucketLocation {
+	return BucketLocation{ProjectID: lifecycle.ProjectID, BucketName: lifecycle.BucketName}
+}
+
+// SetBucketLifecycle contains arguments necessary for setting the lifecycle
+// configuration of a bucket.
+type SetBucketLifecycle struct {
+	BucketLocation
+
+	TransitionDays      int
+	TransitionPlacement storj.PlacementConstraint
+	ExpirationDays      int
+}
+
+// Verify verifies set bucket lifecycle request fields.
+func (opts *SetBucketLifecycle) Verify() error {
+	if err := opts.BucketLocation.Verify(); err != nil {
+		return err
+	}
+	switch {
+	case opts.TransitionDays < 0:
+		return ErrInvalidRequest.New("TransitionDays is negative")
+	case opts.ExpirationDays < 0:
+		return ErrInvalidRequest.New("ExpirationDays is negative")
+	case opts.TransitionDays == 0 && opts.ExpirationDays == 0:
+		return ErrInvalidRequest.New("TransitionDays or ExpirationDays required")
+	case opts.TransitionDays > 0 && opts.ExpirationDays > 0 && opts.ExpirationDays <= opts.TransitionDays:
+		return ErrInvalidRequest.New("ExpirationDays must be greater than TransitionDays")
+	}
+	return nil
+}
+
+// SetBucketLifecycle creates or replaces the lifecycle configuration of a bucket.
+func (db *DB) SetBucketLifecycle(ctx context.Context, opts SetBucketLifecycle) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := opts.Verify(); err != nil {
+		return err
+	}
+
+	_, err = db.db.ExecContext(ctx, `
+		INSERT INTO bucket_lifecycles (
+			project_id, bucket_name,
+			transition_days, transition_placement, expiration_days
+		) VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (project_id, bucket_name) DO UPDATE SET
+			transition_days      = EXCLUDED.transition_days,
+			transition_placement = EXCLUDED.transition_placement,
+			expiration_days      = EXCLUDED.expiration_days,
+			created_at           = now()
+	`, opts.ProjectID, []byte(opts.BucketName),
+		opts.TransitionDays, opts.TransitionPlacement, opts.ExpirationDays)
+	if err != nil {
+		return Error.New("unable to set bucket lifecycle: %w", err)
+	}
+	return nil
+}
+
+// GetBucketLifecycle contains arguments necessary for fetching the lifecycle
+// configuration of a bucket.
+type GetBucketLifecycle struct {
+	BucketLocation
+}
+
+// GetBucketLifecycle returns the lifecycle configuration of a bucket.
+func (db *DB) GetBucketLifecycle(ctx context.Context, opts GetBucketLifecycle) (_ BucketLifecycle, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := opts.BucketLocation.Verify(); err != nil {
+		return BucketLifecycle{}, err
+	}
+
+	lifecycle, err := scanBucketLifecycle(db.db.QueryRowContext(ctx, `
+		SELECT
+			project_id, bucket_name,
+			transition_days, transition_placement, expiration_days,
+			created_at
+		FROM bucket_lifecycles
+		WHERE project_id = $1 AND bucket_name = $2
+	`, opts.ProjectID, []byte(opts.BucketName)))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return BucketLifecycle{}, ErrBucketLifecycleNotFound.New("%s/%s", opts.ProjectID, opts.BucketName)
+		}
+		return BucketLifecycle{}, Error.New("unable to query bucket lifecycle: %w", err)
+	}
+	return lifecycle, nil
+}
+
+// DeleteBucketLifecycle contains arguments necessary for deleting the
+// lifecycle configuration of a bucket.
+type DeleteBucketLifecycle struct {
+	BucketLocation
+}
+
+// DeleteBucketLifecycle deletes the lifecycle configuration of a bucket. It
+// returns ErrBucketLifecycleNotFound, when the bucket doesn't have one.
+func (db *DB) DeleteBucketLifecycle(ctx context.Context, opts DeleteBucketLifecycle) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := opts.BucketLocation.Verify(); err != nil {
+		return err
+	}
+
+	result, err := db.db.ExecContext(ctx, `
+		DELETE FROM bucket_lifecycles
+		WHERE project_id = $1 AND bucket_name = $2
+	`, opts.ProjectID, []byte(opts.BucketName))
+	if err != nil {
+		return Error.New("unable to delete bucket lifecycle: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return Error.New("unable to delete bucket lifecycle: %w", err)
+	}
+	if affected == 0 {
+		return ErrBucketLifecycleNotFound.New("%s/%s", opts.ProjectID, opts.BucketName)
+	}
+	return nil
+}
+
+// ListBucketLifecycles returns the lifecycle configurations of all buckets.
+func (db *DB) ListBucketLifecycles(ctx context.Context) (lifecycles []BucketLifecycle, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = withRows(db.db.QueryContext(ctx, `
+		SELECT
+			project_id, bucket_name,
+			transition_days, transition_placement, expiration_days,
+			created_at
+		FROM bucket_lifecycles
+		ORDER BY project_id, bucket_name
+	`))(func(rows tagsql.Rows) error {
+		for rows.Next() {
+			lifecycle, err := scanBucketLifecycle(rows)
+			if err != nil {
+				return err
+			}
+			lifecycles = append(lifecycles, lifecycle)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, Error.New("unable to list bucket lifecycles: %w", err)
+	}
+	return lifecycles, nil
+}
+
+func scanBucketLifecycle(row interface {
+	Scan(dest ...interface{}) error
+}) (lifecycle BucketLifecycle, err error) {
+	var bucketName []byte
+	err = row.Scan(&lifecycle.ProjectID, &bucketName,
+		&lifecycle.TransitionDays, &lifecycle.TransitionPlacement, &lifecycle.ExpirationDays,
+		&lifecycle.CreatedAt)
+	lifecycle.BucketName = string(bucketName)
+	return lifecycle, err
+}
+
+// UpdateStreamPlacement contains arguments necessary for changing the
+// placement of the segments of objects.
+type UpdateStreamPlacement struct {
+	StreamIDs []uuid.UUID
+	Placement storj.PlacementConstraint
+}
+
+// UpdateStreamPlacement changes the placement of the segments of the streams,
+// which aren't in the placement already. The checker finds the pieces outside
+// of the new placement and the repair moves them to nodes of the placement.
+// It returns the number of updated segments.
+func (db *DB) UpdateStreamPlacement(ctx context.Context, opts UpdateStreamPlacement) (updated int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(opts.StreamIDs) == 0 {
+		return 0, ErrInvalidRequest.New("StreamIDs missing")
+	}
+
+	result, err := db.db.ExecContext(ctx, `
+		UPDATE segments SET placement = $2
+		WHERE stream_id = ANY($1) AND placement IS DISTINCT FROM $2
+	`, pgutil.UUIDArray(opts.StreamIDs), opts.Placement)
+	if err != nil {
+		return 0, Error.New("unable to update stream placement: %w", err)
+	}
+
+	updated, err = result.RowsAffected()
+	if err != nil {
+		return 0, Error.New("unable to update stream placement: %w", err)
+	}
+	return updated, nil
+}
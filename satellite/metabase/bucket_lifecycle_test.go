@@ -0,0 +1,113 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/metabasetest"
+)
+
+func TestBucketLifecycle(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		bucket := metabase.BucketLocation{ProjectID: testrand.UUID(), BucketName: "bucket"}
+
+		for _, invalid := range []metabase.SetBucketLifecycle{
+			{TransitionDays: 1},
+			{BucketLocation: bucket},
+			{BucketLocation: bucket, TransitionDays: -1},
+			{BucketLocation: bucket, ExpirationDays: -1},
+			{BucketLocation: bucket, TransitionDays: 30, ExpirationDays: 30},
+		} {
+			err := db.SetBucketLifecycle(ctx, invalid)
+			require.True(t, metabase.ErrInvalidRequest.Has(err), err)
+		}
+
+		_, err := db.GetBucketLifecycle(ctx, metabase.GetBucketLifecycle{BucketLocation: bucket})
+		require.True(t, metabase.ErrBucketLifecycleNotFound.Has(err))
+
+		require.NoError(t, db.SetBucketLifecycle(ctx, metabase.SetBucketLifecycle{
+			BucketLocation:      bucket,
+			TransitionDays:      30,
+			TransitionPlacement: storj.EU,
+		}))
+
+		// the configuration is replaced.
+		require.NoError(t, db.SetBucketLifecycle(ctx, metabase.SetBucketLifecycle{
+			BucketLocation:      bucket,
+			TransitionDays:      30,
+			TransitionPlacement: storj.EU,
+			ExpirationDays:      365,
+		}))
+
+		lifecycle, err := db.GetBucketLifecycle(ctx, metabase.GetBucketLifecycle{BucketLocation: bucket})
+		require.NoError(t, err)
+		require.Equal(t, bucket, lifecycle.Bucket())
+		require.Equal(t, 30, lifecycle.TransitionDays)
+		require.Equal(t, storj.EU, lifecycle.TransitionPlacement)
+		require.Equal(t, 365, lifecycle.ExpirationDays)
+		require.False(t, lifecycle.CreatedAt.IsZero())
+
+		other := metabase.BucketLocation{ProjectID: bucket.ProjectID, BucketName: "other"}
+		require.NoError(t, db.SetBucketLifecycle(ctx, metabase.SetBucketLifecycle{
+			BucketLocation: other,
+			ExpirationDays: 7,
+		}))
+
+		lifecycles, err := db.ListBucketLifecycles(ctx)
+		require.NoError(t, err)
+		require.Len(t, lifecycles, 2)
+		require.Equal(t, bucket, lifecycles[0].Bucket())
+		require.Equal(t, other, lifecycles[1].Bucket())
+
+		require.NoError(t, db.DeleteBucketLifecycle(ctx, metabase.DeleteBucketLifecycle{BucketLocation: other}))
+		err = db.DeleteBucketLifecycle(ctx, metabase.DeleteBucketLifecycle{BucketLocation: other})
+		require.True(t, metabase.ErrBucketLifecycleNotFound.Has(err))
+
+		lifecycles, err = db.ListBucketLifecycles(ctx)
+		require.NoError(t, err)
+		require.Len(t, lifecycles, 1)
+	})
+}
+
+func TestUpdateStreamPlacement(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		_, err := db.UpdateStreamPlacement(ctx, metabase.UpdateStreamPlacement{})
+		require.True(t, metabase.ErrInvalidRequest.Has(err))
+
+		obj := metabasetest.RandObjectStream()
+		metabasetest.CreateObject(ctx, t, db, obj, 2)
+
+		other := metabasetest.RandObjectStream()
+		metabasetest.CreateObject(ctx, t, db, other, 1)
+
+		updated, err := db.UpdateStreamPlacement(ctx, metabase.UpdateStreamPlacement{
+			StreamIDs: []uuid.UUID{obj.StreamID, other.StreamID},
+			Placement: storj.EU,
+		})
+		require.NoError(t, err)
+		require.EqualValues(t, 3, updated)
+
+		segments, err := db.TestingAllSegments(ctx)
+		require.NoError(t, err)
+		for _, segment := range segments {
+			require.Equal(t, storj.EU, segment.Placement)
+		}
+
+		// segments, which have the placement already, aren't updated.
+		updated, err = db.UpdateStreamPlacement(ctx, metabase.UpdateStreamPlacement{
+			StreamIDs: []uuid.UUID{obj.StreamID},
+			Placement: storj.EU,
+		})
+		require.NoError(t, err)
+		require.Zero(t, updated)
+	})
+}
@@ -15,6 +15,7 @@ import (
 
 	"storj.io/common/memory"
 	"storj.io/common/storj"
+	"storj.io/common/uuid"
 	"storj.io/private/dbutil/pgutil/pgerrcode"
 	"storj.io/private/dbutil/txutil"
 	"storj.io/private/tagsql"
@@ -25,6 +26,10 @@ const validatePlainSize = false
 
 const defaultZombieDeletionPeriod = 24 * time.Hour
 
+// maxNextVersionAttempts is how many times BeginObjectNextVersion tries to
+// insert the object, when a concurrent upload took the same version.
+const maxNextVersionAttempts = 5
+
 var (
 	// ErrInvalidRequest is used to indicate invalid requests.
 	ErrInvalidRequest = errs.Class("metabase: invalid request")
@@ -44,6 +49,9 @@ type BeginObjectNextVersion struct {
 	EncryptedMetadataEncryptedKey []byte // optional
 
 	Encryption storj.EncryptionParameters
+
+	// UploaderKeyID is the ID of the API key, which started the upload.
+	UploaderKeyID *uuid.UUID // optional
 }
 
 // Verify verifies get object request fields.
@@ -89,12 +97,22 @@ func (db *DB) BeginObjectNextVersion(ctx context.Context, opts BeginObjectNextVe
 		ZombieDeletionDeadline: opts.ZombieDeletionDeadline,
 	}
 
-	if err := db.db.QueryRowContext(ctx, `
+	metadata, err := db.sealMetadata(ctx, opts.ProjectID, opts.EncryptedMetadataNonce, opts.EncryptedMetadata, opts.EncryptedMetadataEncryptedKey)
+	if err != nil {
+		return Object{}, err
+	}
+
+	// The version is computed from the latest one, so two uploads of the same
+	// key, which begin concurrently, may try to insert the same version. The
+	// one that loses the race retries with the next one.
+	for attempt := 1; ; attempt++ {
+		err = db.db.QueryRowContext(ctx, `
 		INSERT INTO objects (
 			project_id, bucket_name, object_key, version, stream_id,
 			expires_at, encryption,
 			zombie_deletion_deadline,
-			encrypted_metadata, encrypted_metadata_nonce, encrypted_metadata_encrypted_key
+			encrypted_metadata, encrypted_metadata_nonce, encrypted_metadata_encrypted_key,
+			uploader_key_id
 		) VALUES (
 			$1, $2, $3,
 				coalesce((
@@ -106,13 +124,24 @@ func (db *DB) BeginObjectNextVersion(ctx context.Context, opts BeginObjectNextVe
 				), 1),
 			$4, $5, $6,
 			$7,
-			$8, $9, $10)
+			$8, $9, $10,
+			$11)
 		RETURNING status, version, created_at
 	`, opts.ProjectID, []byte(opts.BucketName), opts.ObjectKey, opts.StreamID,
-		opts.ExpiresAt, encryptionParameters{&opts.Encryption},
-		opts.ZombieDeletionDeadline,
-		opts.EncryptedMetadata, opts.EncryptedMetadataNonce, opts.EncryptedMetadataEncryptedKey,
-	).Scan(&object.Status, &object.Version, &object.CreatedAt); err != nil {
+			opts.ExpiresAt, encryptionParameters{&opts.Encryption},
+			opts.ZombieDeletionDeadline,
+			metadata.Metadata, metadata.Nonce, metadata.Key,
+			opts.UploaderKeyID,
+		).Scan(&object.Status, &object.Version, &object.CreatedAt)
+		if err == nil {
+			break
+		}
+
+		code := pgerrcode.FromError(err)
+		if (code == pgxerrcode.UniqueViolation || code == pgxerrcode.SerializationFailure) && attempt < maxNextVersionAttempts {
+			mon.Meter("object_begin_next_version_conflict").Mark(1)
+			continue
+		}
 		return Object{}, Error.New("unable to insert object: %w", err)
 	}
 
@@ -133,6 +162,9 @@ type BeginObjectExactVersion struct {
 	EncryptedMetadataEncryptedKey []byte // optional
 
 	Encryption storj.EncryptionParameters
+
+	// UploaderKeyID is the ID of the API key, which started the upload.
+	UploaderKeyID *uuid.UUID // optional
 }
 
 // Verify verifies get object reqest fields.
@@ -179,23 +211,31 @@ func (db *DB) BeginObjectExactVersion(ctx context.Context, opts BeginObjectExact
 		ZombieDeletionDeadline: opts.ZombieDeletionDeadline,
 	}
 
+	metadata, err := db.sealMetadata(ctx, opts.ProjectID, opts.EncryptedMetadataNonce, opts.EncryptedMetadata, opts.EncryptedMetadataEncryptedKey)
+	if err != nil {
+		return Object{}, err
+	}
+
 	err = db.db.QueryRowContext(ctx, `
 		INSERT INTO objects (
 			project_id, bucket_name, object_key, version, stream_id,
 			expires_at, encryption,
 			zombie_deletion_deadline,
-			encrypted_metadata, encrypted_metadata_nonce, encrypted_metadata_encrypted_key
+			encrypted_metadata, encrypted_metadata_nonce, encrypted_metadata_encrypted_key,
+			uploader_key_id
 		) VALUES (
 			$1, $2, $3, $4, $5,
 			$6, $7,
 			$8,
-			$9, $10, $11
+			$9, $10, $11,
+			$12
 		)
 		RETURNING status, created_at
 		`, opts.ProjectID, []byte(opts.BucketName), opts.ObjectKey, opts.Version, opts.StreamID,
 		opts.ExpiresAt, encryptionParameters{&opts.Encryption},
 		opts.ZombieDeletionDeadline,
-		opts.EncryptedMetadata, opts.EncryptedMetadataNonce, opts.EncryptedMetadataEncryptedKey,
+		metadata.Metadata, metadata.Nonce, metadata.Key,
+		opts.UploaderKeyID,
 	).Scan(
 		&object.Status, &object.CreatedAt,
 	)
@@ -329,52 +369,50 @@ func (db *DB) CommitSegment(ctx context.Context, opts CommitSegment) (err error)
 		return Error.New("unable to convert pieces to aliases: %w", err)
 	}
 
-	// Verify that object exists and is partial.
-	_, err = db.db.ExecContext(ctx, `
-		INSERT INTO segments (
-			stream_id, position, expires_at,
-			root_piece_id, encrypted_key_nonce, encrypted_key,
-			encrypted_size, plain_offset, plain_size, encrypted_etag,
-			redundancy,
-			remote_alias_pieces,
-			placement
-		) VALUES (
-			(SELECT stream_id
-				FROM objects WHERE
-					project_id   = $12 AND
-					bucket_name  = $13 AND
-					object_key   = $14 AND
-					version      = $15 AND
-					stream_id    = $16 AND
-					status       = `+pendingStatus+
-		`	), $1, $2,
-			$3, $4, $5,
-			$6, $7, $8, $9,
-			$10,
-			$11,
-			$17
+	err = txutil.WithTx(ctx, db.db, nil, func(ctx context.Context, tx tagsql.Tx) error {
+		if err := lockPendingObject(ctx, tx, opts.ObjectStream); err != nil {
+			return err
+		}
+
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO segments (
+				stream_id, position, expires_at,
+				root_piece_id, encrypted_key_nonce, encrypted_key,
+				encrypted_size, plain_offset, plain_size, encrypted_etag,
+				redundancy,
+				remote_alias_pieces,
+				placement
+			) VALUES (
+				$12, $1, $2,
+				$3, $4, $5,
+				$6, $7, $8, $9,
+				$10,
+				$11,
+				$13
+			)
+			ON CONFLICT(stream_id, position)
+			DO UPDATE SET
+				expires_at = $2,
+				root_piece_id = $3, encrypted_key_nonce = $4, encrypted_key = $5,
+				encrypted_size = $6, plain_offset = $7, plain_size = $8, encrypted_etag = $9,
+				redundancy = $10,
+				remote_alias_pieces = $11,
+				placement = $13
+			`, opts.Position, opts.ExpiresAt,
+			opts.RootPieceID, opts.EncryptedKeyNonce, opts.EncryptedKey,
+			opts.EncryptedSize, opts.PlainOffset, opts.PlainSize, opts.EncryptedETag,
+			redundancyScheme{&opts.Redundancy},
+			aliasPieces,
+			opts.StreamID,
+			opts.Placement,
 		)
-		ON CONFLICT(stream_id, position)
-		DO UPDATE SET
-			expires_at = $2,
-			root_piece_id = $3, encrypted_key_nonce = $4, encrypted_key = $5,
-			encrypted_size = $6, plain_offset = $7, plain_size = $8, encrypted_etag = $9,
-			redundancy = $10,
-			remote_alias_pieces = $11,
-			placement = $17
-		`, opts.Position, opts.ExpiresAt,
-		opts.RootPieceID, opts.EncryptedKeyNonce, opts.EncryptedKey,
-		opts.EncryptedSize, opts.PlainOffset, opts.PlainSize, opts.EncryptedETag,
-		redundancyScheme{&opts.Redundancy},
-		aliasPieces,
-		opts.ProjectID, []byte(opts.BucketName), opts.ObjectKey, opts.Version, opts.StreamID,
-		opts.Placement,
-	)
-	if err != nil {
-		if code := pgerrcode.FromError(err); code == pgxerrcode.NotNullViolation {
-			return ErrPendingObjectMissing.New("")
+		if err != nil {
+			return Error.New("unable to insert segment: %w", err)
 		}
-		return Error.New("unable to insert segment: %w", err)
+		return nil
+	})
+	if err != nil {
+		return err
 	}
 
 	mon.Meter("segment_commit").Mark(1)
@@ -383,6 +421,42 @@ func (db *DB) CommitSegment(ctx context.Context, opts CommitSegment) (err error)
 	return nil
 }
 
+// lockPendingObject verifies that the object exists and is pending and takes a
+// share lock on it until the end of the transaction. The lock conflicts with
+// the one taken by CommitObject, so a segment is either committed before the
+// object, or it's rejected with ErrObjectAlreadyCommitted.
+func lockPendingObject(ctx context.Context, tx tagsql.Tx, stream ObjectStream) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var status ObjectStatus
+	err = tx.QueryRowContext(ctx, `
+		SELECT status
+		FROM objects
+		WHERE
+			project_id   = $1 AND
+			bucket_name  = $2 AND
+			object_key   = $3 AND
+			version      = $4 AND
+			stream_id    = $5
+		FOR SHARE
+	`, stream.ProjectID, []byte(stream.BucketName), stream.ObjectKey, stream.Version, stream.StreamID).Scan(&status)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrPendingObjectMissing.New("")
+		}
+		return Error.New("unable to lock object: %w", err)
+	}
+
+	switch status {
+	case Pending:
+		return nil
+	case Committed:
+		return ErrObjectAlreadyCommitted.New("")
+	default:
+		return ErrPendingObjectMissing.New("")
+	}
+}
+
 // CommitInlineSegment contains all necessary information about the segment.
 type CommitInlineSegment struct {
 	ObjectStream
@@ -423,44 +497,42 @@ func (db *DB) CommitInlineSegment(ctx context.Context, opts CommitInlineSegment)
 		return ErrInvalidRequest.New("PlainOffset negative")
 	}
 
-	// Verify that object exists and is partial.
-	_, err = db.db.ExecContext(ctx, `
-		INSERT INTO segments (
-			stream_id, position, expires_at,
-			root_piece_id, encrypted_key_nonce, encrypted_key,
-			encrypted_size, plain_offset, plain_size, encrypted_etag,
-			inline_data
-		) VALUES (
-			(SELECT stream_id
-				FROM objects WHERE
-					project_id   = $11 AND
-					bucket_name  = $12 AND
-					object_key   = $13 AND
-					version      = $14 AND
-					stream_id    = $15 AND
-					status       = `+pendingStatus+
-		`	), $1, $2,
-			$3, $4, $5,
-			$6, $7, $8, $9,
-			$10
+	err = txutil.WithTx(ctx, db.db, nil, func(ctx context.Context, tx tagsql.Tx) error {
+		if err := lockPendingObject(ctx, tx, opts.ObjectStream); err != nil {
+			return err
+		}
+
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO segments (
+				stream_id, position, expires_at,
+				root_piece_id, encrypted_key_nonce, encrypted_key,
+				encrypted_size, plain_offset, plain_size, encrypted_etag,
+				inline_data
+			) VALUES (
+				$11, $1, $2,
+				$3, $4, $5,
+				$6, $7, $8, $9,
+				$10
+			)
+			ON CONFLICT(stream_id, position)
+			DO UPDATE SET
+				expires_at = $2,
+				root_piece_id = $3, encrypted_key_nonce = $4, encrypted_key = $5,
+				encrypted_size = $6, plain_offset = $7, plain_size = $8, encrypted_etag = $9,
+				inline_data = $10
+			`, opts.Position, opts.ExpiresAt,
+			storj.PieceID{}, opts.EncryptedKeyNonce, opts.EncryptedKey,
+			len(opts.InlineData), opts.PlainOffset, opts.PlainSize, opts.EncryptedETag,
+			opts.InlineData,
+			opts.StreamID,
 		)
-		ON CONFLICT(stream_id, position)
-		DO UPDATE SET
-			expires_at = $2,
-			root_piece_id = $3, encrypted_key_nonce = $4, encrypted_key = $5,
-			encrypted_size = $6, plain_offset = $7, plain_size = $8, encrypted_etag = $9,
-			inline_data = $10
-		`, opts.Position, opts.ExpiresAt,
-		storj.PieceID{}, opts.EncryptedKeyNonce, opts.EncryptedKey,
-		len(opts.InlineData), opts.PlainOffset, opts.PlainSize, opts.EncryptedETag,
-		opts.InlineData,
-		opts.ProjectID, []byte(opts.BucketName), opts.ObjectKey, opts.Version, opts.StreamID,
-	)
-	if err != nil {
-		if code := pgerrcode.FromError(err); code == pgxerrcode.NotNullViolation {
-			return ErrPendingObjectMissing.New("")
+		if err != nil {
+			return Error.New("unable to insert segment: %w", err)
 		}
-		return Error.New("unable to insert segment: %w", err)
+		return nil
+	})
+	if err != nil {
+		return err
 	}
 
 	mon.Meter("segment_commit").Mark(1)
@@ -489,6 +561,10 @@ type CommitObject struct {
 	// Wil be only executed after succesfull commit + delete DB operation.
 	// Error on this function won't revert back committed object.
 	OnDelete func(segments []DeletedSegmentInfo)
+
+	// Precondition is checked against the committed object, which would be
+	// overwritten, within the commit transaction.
+	Precondition Precondition
 }
 
 // Verify verifies reqest fields.
@@ -497,6 +573,10 @@ func (c *CommitObject) Verify() error {
 		return err
 	}
 
+	if err := c.Precondition.Verify(); err != nil {
+		return err
+	}
+
 	if c.Encryption.CipherSuite != storj.EncUnspecified && c.Encryption.BlockSize <= 0 {
 		return ErrInvalidRequest.New("Encryption.BlockSize is negative or zero")
 	}
@@ -520,189 +600,242 @@ func (db *DB) CommitObject(ctx context.Context, opts CommitObject) (object Objec
 		return Object{}, err
 	}
 
-	deletedSegments := []DeletedSegmentInfo{}
-
-	err = txutil.WithTx(ctx, db.db, nil, func(ctx context.Context, tx tagsql.Tx) error {
-		segments, err := fetchSegmentsForCommit(ctx, tx, opts.StreamID)
+	var metadata sealedMetadata
+	if opts.OverrideEncryptedMetadata {
+		metadata, err = db.sealMetadata(ctx, opts.ProjectID, opts.EncryptedMetadataNonce, opts.EncryptedMetadata, opts.EncryptedMetadataEncryptedKey)
 		if err != nil {
-			return Error.New("failed to fetch segments: %w", err)
+			return Object{}, err
 		}
+	}
 
-		if err = db.validateParts(segments); err != nil {
-			return err
-		}
+	var deletedSegments []DeletedSegmentInfo
+	err = txutil.WithTx(ctx, db.db, nil, func(ctx context.Context, tx tagsql.Tx) error {
+		object, deletedSegments, err = db.commitObject(ctx, opts, metadata, tx)
+		return err
+	})
+	if err != nil {
+		return Object{}, err
+	}
 
-		finalSegments := convertToFinalSegments(segments)
-		err = updateSegmentOffsets(ctx, tx, opts.StreamID, finalSegments)
-		if err != nil {
-			return Error.New("failed to update segments: %w", err)
-		}
+	// we can execute this only when whole transaction is committed without any error
+	if len(deletedSegments) > 0 && opts.OnDelete != nil {
+		opts.OnDelete(deletedSegments)
+	}
 
-		// TODO: would we even need this when we make main index plain_offset?
-		fixedSegmentSize := int32(0)
-		if len(finalSegments) > 0 {
-			fixedSegmentSize = finalSegments[0].PlainSize
-			for i, seg := range finalSegments {
-				if seg.Position.Part != 0 || seg.Position.Index != uint32(i) {
-					fixedSegmentSize = -1
-					break
-				}
-				if i < len(finalSegments)-1 && seg.PlainSize != fixedSegmentSize {
-					fixedSegmentSize = -1
-					break
-				}
-			}
-		}
+	// the tally deltas use the size of the metadata as stored.
+	if err := db.openObjectMetadata(ctx, &object); err != nil {
+		return Object{}, err
+	}
 
-		var totalPlainSize, totalEncryptedSize int64
-		for _, seg := range finalSegments {
-			totalPlainSize += int64(seg.PlainSize)
-			totalEncryptedSize += int64(seg.EncryptedSize)
-		}
+	mon.Meter("object_commit").Mark(1)
+	mon.IntVal("object_commit_segments").Observe(int64(object.SegmentCount))
+	mon.IntVal("object_commit_encrypted_size").Observe(object.TotalEncryptedSize)
 
-		args := []interface{}{
-			opts.ProjectID, []byte(opts.BucketName), opts.ObjectKey, opts.Version, opts.StreamID,
-			len(segments),
-			totalPlainSize,
-			totalEncryptedSize,
-			fixedSegmentSize,
-			encryptionParameters{&opts.Encryption},
-		}
+	return object, nil
+}
 
-		metadataColumns := ""
-		if opts.OverrideEncryptedMetadata {
-			args = append(args,
-				opts.EncryptedMetadataNonce,
-				opts.EncryptedMetadata,
-				opts.EncryptedMetadataEncryptedKey,
-			)
-			metadataColumns = `,
-				encrypted_metadata_nonce         = $11,
-				encrypted_metadata               = $12,
-				encrypted_metadata_encrypted_key = $13
-			`
-		}
+// commitObject is the implementation of DB.CommitObject for re-use internally
+// in metabase package. The metadata must be sealed before the transaction.
+func (db *DB) commitObject(ctx context.Context, opts CommitObject, metadata sealedMetadata, tx tagsql.Tx) (object Object, deletedSegments []DeletedSegmentInfo, err error) {
+	defer mon.Task()(&ctx)(&err)
 
-		versionsToDelete := []Version{}
-		if err := withRows(tx.QueryContext(ctx, `
-			SELECT version
-			FROM objects
-			WHERE
-				project_id   = $1 AND
-				bucket_name  = $2 AND
-				object_key   = $3 AND
-				status       = `+committedStatus,
-			opts.ProjectID, []byte(opts.BucketName), opts.ObjectKey))(func(rows tagsql.Rows) error {
-			for rows.Next() {
-				var version Version
-				if err := rows.Scan(&version); err != nil {
-					return Error.New("failed to scan previous object: %w", err)
-				}
+	// all the objects at the location are locked, so the aggregates below are
+	// computed from the same segments, which are committed with the object,
+	// and the precondition is checked against the object, which is
+	// overwritten. The rows are locked in the order of their versions, so
+	// concurrent commits to the same location don't deadlock.
+	var pendingFound bool
+	versionsToDelete := []Version{}
+	// the stream ID of the last committed object, which hasn't expired.
+	var current *uuid.UUID
+	if err := withRows(tx.QueryContext(ctx, `
+		SELECT version, stream_id, status, (expires_at IS NULL OR expires_at > now())
+		FROM objects
+		WHERE
+			project_id   = $1 AND
+			bucket_name  = $2 AND
+			object_key   = $3
+		ORDER BY version ASC
+		FOR UPDATE`,
+		opts.ProjectID, []byte(opts.BucketName), opts.ObjectKey))(func(rows tagsql.Rows) error {
+		for rows.Next() {
+			var version Version
+			var streamID uuid.UUID
+			var status ObjectStatus
+			var alive bool
+			if err := rows.Scan(&version, &streamID, &status, &alive); err != nil {
+				return Error.New("failed to scan object: %w", err)
+			}
 
+			switch status {
+			case Pending:
+				if version == opts.Version && streamID == opts.StreamID {
+					pendingFound = true
+				}
+			case Committed:
+				if alive {
+					current = &streamID
+				}
 				versionsToDelete = append(versionsToDelete, version)
 			}
-			return nil
-		}); err != nil {
-			return Error.New("failed to find previous objects: %w", err)
 		}
+		return nil
+	}); err != nil {
+		return Object{}, nil, Error.New("failed to lock objects: %w", err)
+	}
+	if !pendingFound {
+		return Object{}, nil, storj.ErrObjectNotFound.Wrap(Error.New("object with specified version and pending status is missing"))
+	}
 
-		if len(versionsToDelete) > 1 {
-			db.log.Warn("object with multiple committed versions were found!",
-				zap.Stringer("Project ID", opts.ProjectID), zap.String("Bucket Name", opts.BucketName),
-				zap.ByteString("Object Key", []byte(opts.ObjectKey)), zap.Int("deleted", len(versionsToDelete)))
+	if err := opts.Precondition.check(current); err != nil {
+		return Object{}, nil, err
+	}
 
-			mon.Meter("multiple_committed_versions").Mark(1)
-		}
+	segments, err := fetchSegmentsForCommit(ctx, tx, opts.StreamID)
+	if err != nil {
+		return Object{}, nil, Error.New("failed to fetch segments: %w", err)
+	}
 
-		if len(versionsToDelete) != 0 && opts.DisallowDelete {
-			return ErrPermissionDenied.New("no permissions to delete existing object")
-		}
+	if err = db.validateParts(segments); err != nil {
+		return Object{}, nil, err
+	}
 
-		err = tx.QueryRowContext(ctx, `
-			UPDATE objects SET
-				status =`+committedStatus+`,
-				segment_count = $6,
-
-				total_plain_size     = $7,
-				total_encrypted_size = $8,
-				fixed_segment_size   = $9,
-				zombie_deletion_deadline = NULL,
-
-				-- TODO should we allow to override existing encryption parameters or return error if don't match with opts?
-				encryption = CASE
-					WHEN objects.encryption = 0 AND $10 <> 0 THEN $10
-					WHEN objects.encryption = 0 AND $10 = 0 THEN NULL
-					ELSE objects.encryption
-				END
-			    `+metadataColumns+`
-			WHERE
-				project_id   = $1 AND
-				bucket_name  = $2 AND
-				object_key   = $3 AND
-				version      = $4 AND
-				stream_id    = $5 AND
-				status       = `+pendingStatus+`
-			RETURNING
-				created_at, expires_at,
-				encrypted_metadata, encrypted_metadata_encrypted_key, encrypted_metadata_nonce,
-				encryption
-			`, args...).Scan(
-			&object.CreatedAt, &object.ExpiresAt,
-			&object.EncryptedMetadata, &object.EncryptedMetadataEncryptedKey, &object.EncryptedMetadataNonce,
-			encryptionParameters{&object.Encryption},
-		)
-		if err != nil {
-			if errors.Is(err, sql.ErrNoRows) {
-				return storj.ErrObjectNotFound.Wrap(Error.New("object with specified version and pending status is missing"))
-			} else if code := pgerrcode.FromError(err); code == pgxerrcode.NotNullViolation {
-				// TODO maybe we should check message if 'encryption' label is there
-				return ErrInvalidRequest.New("Encryption is missing")
-			}
-			return Error.New("failed to update object: %w", err)
-		}
+	finalSegments := convertToFinalSegments(segments)
+	err = updateSegmentOffsets(ctx, tx, opts.StreamID, finalSegments)
+	if err != nil {
+		return Object{}, nil, Error.New("failed to update segments: %w", err)
+	}
 
-		for _, version := range versionsToDelete {
-			deleteResult, err := db.deleteObjectExactVersion(ctx, DeleteObjectExactVersion{
-				ObjectLocation: ObjectLocation{
-					ProjectID:  opts.ProjectID,
-					BucketName: opts.BucketName,
-					ObjectKey:  opts.ObjectKey,
-				},
-				Version: version,
-			}, tx)
-			if err != nil {
-				return Error.New("failed to delete existing object: %w", err)
+	// TODO: would we even need this when we make main index plain_offset?
+	fixedSegmentSize := int32(0)
+	if len(finalSegments) > 0 {
+		fixedSegmentSize = finalSegments[0].PlainSize
+		for i, seg := range finalSegments {
+			if seg.Position.Part != 0 || seg.Position.Index != uint32(i) {
+				fixedSegmentSize = -1
+				break
+			}
+			if i < len(finalSegments)-1 && seg.PlainSize != fixedSegmentSize {
+				fixedSegmentSize = -1
+				break
 			}
-
-			deletedSegments = append(deletedSegments, deleteResult.Segments...)
 		}
+	}
 
-		object.StreamID = opts.StreamID
-		object.ProjectID = opts.ProjectID
-		object.BucketName = opts.BucketName
-		object.ObjectKey = opts.ObjectKey
-		object.Version = opts.Version
-		object.Status = Committed
-		object.SegmentCount = int32(len(segments))
-		object.TotalPlainSize = totalPlainSize
-		object.TotalEncryptedSize = totalEncryptedSize
-		object.FixedSegmentSize = fixedSegmentSize
-		return nil
-	})
-	if err != nil {
-		return Object{}, err
+	var totalPlainSize, totalEncryptedSize int64
+	for _, seg := range finalSegments {
+		totalPlainSize += int64(seg.PlainSize)
+		totalEncryptedSize += int64(seg.EncryptedSize)
 	}
 
-	// we can execute this only when whole transaction is committed without any error
-	if len(deletedSegments) > 0 && opts.OnDelete != nil {
-		opts.OnDelete(deletedSegments)
+	args := []interface{}{
+		opts.ProjectID, []byte(opts.BucketName), opts.ObjectKey, opts.Version, opts.StreamID,
+		len(segments),
+		totalPlainSize,
+		totalEncryptedSize,
+		fixedSegmentSize,
+		encryptionParameters{&opts.Encryption},
+	}
+
+	metadataColumns := ""
+	if opts.OverrideEncryptedMetadata {
+		args = append(args,
+			metadata.Nonce,
+			metadata.Metadata,
+			metadata.Key,
+		)
+		metadataColumns = `,
+			encrypted_metadata_nonce         = $11,
+			encrypted_metadata               = $12,
+			encrypted_metadata_encrypted_key = $13
+		`
+	}
+
+	if len(versionsToDelete) > 1 {
+		db.log.Warn("object with multiple committed versions were found!",
+			zap.Stringer("Project ID", opts.ProjectID), zap.String("Bucket Name", opts.BucketName),
+			zap.ByteString("Object Key", []byte(opts.ObjectKey)), zap.Int("deleted", len(versionsToDelete)))
+
+		mon.Meter("multiple_committed_versions").Mark(1)
+	}
+
+	if len(versionsToDelete) != 0 && opts.DisallowDelete {
+		return Object{}, nil, ErrPermissionDenied.New("no permissions to delete existing object")
+	}
+
+	err = tx.QueryRowContext(ctx, `
+		UPDATE objects SET
+			status =`+committedStatus+`,
+			segment_count = $6,
+
+			total_plain_size     = $7,
+			total_encrypted_size = $8,
+			fixed_segment_size   = $9,
+			zombie_deletion_deadline = NULL,
+
+			-- TODO should we allow to override existing encryption parameters or return error if don't match with opts?
+			encryption = CASE
+				WHEN objects.encryption = 0 AND $10 <> 0 THEN $10
+				WHEN objects.encryption = 0 AND $10 = 0 THEN NULL
+				ELSE objects.encryption
+			END
+		    `+metadataColumns+`
+		WHERE
+			project_id   = $1 AND
+			bucket_name  = $2 AND
+			object_key   = $3 AND
+			version      = $4 AND
+			stream_id    = $5 AND
+			status       = `+pendingStatus+`
+		RETURNING
+			created_at, expires_at,
+			encrypted_metadata, encrypted_metadata_encrypted_key, encrypted_metadata_nonce,
+			encryption
+		`, args...).Scan(
+		&object.CreatedAt, &object.ExpiresAt,
+		&object.EncryptedMetadata, &object.EncryptedMetadataEncryptedKey, &object.EncryptedMetadataNonce,
+		encryptionParameters{&object.Encryption},
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return Object{}, nil, storj.ErrObjectNotFound.Wrap(Error.New("object with specified version and pending status is missing"))
+		} else if code := pgerrcode.FromError(err); code == pgxerrcode.NotNullViolation {
+			// TODO maybe we should check message if 'encryption' label is there
+			return Object{}, nil, ErrInvalidRequest.New("Encryption is missing")
+		}
+		return Object{}, nil, Error.New("failed to update object: %w", err)
+	}
+
+	for _, version := range versionsToDelete {
+		deleteResult, err := db.deleteObjectExactVersion(ctx, DeleteObjectExactVersion{
+			ObjectLocation: ObjectLocation{
+				ProjectID:  opts.ProjectID,
+				BucketName: opts.BucketName,
+				ObjectKey:  opts.ObjectKey,
+			},
+			Version: version,
+		}, tx)
+		if err != nil {
+			return Object{}, nil, Error.New("failed to delete existing object: %w", err)
+		}
+
+		deletedSegments = append(deletedSegments, deleteResult.Segments...)
 	}
 
-	mon.Meter("object_commit").Mark(1)
-	mon.IntVal("object_commit_segments").Observe(int64(object.SegmentCount))
-	mon.IntVal("object_commit_encrypted_size").Observe(object.TotalEncryptedSize)
+	object.StreamID = opts.StreamID
+	object.ProjectID = opts.ProjectID
+	object.BucketName = opts.BucketName
+	object.ObjectKey = opts.ObjectKey
+	object.Version = opts.Version
+	object.Status = Committed
+	object.SegmentCount = int32(len(segments))
+	object.TotalPlainSize = totalPlainSize
+	object.TotalEncryptedSize = totalEncryptedSize
+	object.FixedSegmentSize = fixedSegmentSize
 
-	return object, nil
+	deltas := tallyDeltas{}
+	deltas.add(object, false)
+	return object, deletedSegments, db.recordTallyDeltas(ctx, tx, deltas)
 }
 
 func (db *DB) validateParts(segments []segmentInfoForCommit) error {
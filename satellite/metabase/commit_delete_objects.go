@@ -0,0 +1,153 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase
+
+import (
+	"context"
+
+	"storj.io/private/dbutil/txutil"
+	"storj.io/private/tagsql"
+)
+
+// maxCommitAndDeleteObjects is the maximum number of operations, which can be
+// executed in a single transaction.
+const maxCommitAndDeleteObjects = 100
+
+// CommitAndDeleteObjects contains arguments necessary for committing and
+// deleting a group of objects atomically. Either all of the operations are
+// executed or none of them.
+//
+// Gateways use it to emulate directory renames and to keep manifests
+// consistent with the data they describe.
+type CommitAndDeleteObjects struct {
+	// Commits contains the pending objects, which are committed. OnDelete
+	// isn't supported, the segments of the overwritten objects are returned
+	// with the result instead.
+	Commits []CommitObject
+	// Deletes contains the objects, which are deleted. Missing objects are
+	// ignored, unless the precondition requires them to exist.
+	Deletes []DeleteObjectLastCommitted
+}
+
+// CommitAndDeleteObjectsResult contains the result of committing and deleting
+// a group of objects.
+type CommitAndDeleteObjectsResult struct {
+	// Committed contains the committed objects in the order of the commits.
+	Committed []Object
+	// Deleted contains the deleted objects and the segments, which need to be
+	// deleted from storage nodes, including the segments of the objects
+	// overwritten by the commits.
+	Deleted DeleteObjectResult
+}
+
+// Verify verifies commit and delete objects fields.
+func (opts *CommitAndDeleteObjects) Verify() error {
+	count := len(opts.Commits) + len(opts.Deletes)
+	if count == 0 {
+		return ErrInvalidRequest.New("Commits and Deletes are missing")
+	}
+	if count > maxCommitAndDeleteObjects {
+		return ErrInvalidRequest.New("cannot commit and delete more than %d objects in a single request", maxCommitAndDeleteObjects)
+	}
+
+	var first ObjectLocation
+	locations := make(map[ObjectLocation]struct{}, count)
+	verifyLocation := func(location ObjectLocation) error {
+		if len(locations) == 0 {
+			first = location
+		} else if first.ProjectID != location.ProjectID {
+			return ErrInvalidRequest.New("all objects must be in the same project")
+		}
+
+		if _, ok := locations[location]; ok {
+			return ErrInvalidRequest.New("object %q is used by more than one operation", location.ObjectKey)
+		}
+		locations[location] = struct{}{}
+		return nil
+	}
+
+	for i := range opts.Commits {
+		commit := &opts.Commits[i]
+		if err := commit.Verify(); err != nil {
+			return err
+		}
+		if commit.OnDelete != nil {
+			return ErrInvalidRequest.New("OnDelete is not supported")
+		}
+		if err := verifyLocation(commit.Location()); err != nil {
+			return err
+		}
+	}
+
+	for i := range opts.Deletes {
+		if err := opts.Deletes[i].Verify(); err != nil {
+			return err
+		}
+		if err := verifyLocation(opts.Deletes[i].ObjectLocation); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// CommitAndDeleteObjects commits and deletes a group of objects within a single
+// transaction. When any of the operations fails, none of them is executed. The
+// transaction is retried when the database asks for it.
+func (db *DB) CommitAndDeleteObjects(ctx context.Context, opts CommitAndDeleteObjects) (result CommitAndDeleteObjectsResult, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := opts.Verify(); err != nil {
+		return CommitAndDeleteObjectsResult{}, err
+	}
+
+	metadata := make([]sealedMetadata, len(opts.Commits))
+	for i, commit := range opts.Commits {
+		if commit.OverrideEncryptedMetadata {
+			metadata[i], err = db.sealMetadata(ctx, commit.ProjectID, commit.EncryptedMetadataNonce, commit.EncryptedMetadata, commit.EncryptedMetadataEncryptedKey)
+			if err != nil {
+				return CommitAndDeleteObjectsResult{}, err
+			}
+		}
+	}
+
+	err = txutil.WithTx(ctx, db.db, nil, func(ctx context.Context, tx tagsql.Tx) error {
+		// the result is collected from scratch, when the transaction is retried.
+		result = CommitAndDeleteObjectsResult{}
+
+		for _, del := range opts.Deletes {
+			deleted, err := db.deleteObjectLastCommitted(ctx, del, tx)
+			if err != nil {
+				return err
+			}
+			result.Deleted.Objects = append(result.Deleted.Objects, deleted.Objects...)
+			result.Deleted.Segments = append(result.Deleted.Segments, deleted.Segments...)
+		}
+
+		for i, commit := range opts.Commits {
+			object, deletedSegments, err := db.commitObject(ctx, commit, metadata[i], tx)
+			if err != nil {
+				return err
+			}
+			result.Committed = append(result.Committed, object)
+			result.Deleted.Segments = append(result.Deleted.Segments, deletedSegments...)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return CommitAndDeleteObjectsResult{}, err
+	}
+
+	db.openDeletedObjects(ctx, result.Deleted.Objects)
+	for i := range result.Committed {
+		if err := db.openObjectMetadata(ctx, &result.Committed[i]); err != nil {
+			return CommitAndDeleteObjectsResult{}, err
+		}
+	}
+
+	mon.Meter("object_commit").Mark(len(result.Committed))
+
+	return result, nil
+}
@@ -0,0 +1,165 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase_test
+
+import (
+	"testing"
+	"time"
+
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/metabasetest"
+)
+
+func TestCommitAndDeleteObjects(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		t.Run("invalid request", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			obj := metabasetest.RandObjectStream()
+			other := metabasetest.RandObjectStream()
+
+			metabasetest.CommitAndDeleteObjects{
+				Opts:     metabase.CommitAndDeleteObjects{},
+				ErrClass: &metabase.ErrInvalidRequest,
+				ErrText:  "Commits and Deletes are missing",
+			}.Check(ctx, t, db)
+
+			metabasetest.CommitAndDeleteObjects{
+				Opts: metabase.CommitAndDeleteObjects{
+					Commits: []metabase.CommitObject{{ObjectStream: obj}},
+					Deletes: []metabase.DeleteObjectLastCommitted{{ObjectLocation: obj.Location()}},
+				},
+				ErrClass: &metabase.ErrInvalidRequest,
+				ErrText:  "object \"" + string(obj.ObjectKey) + "\" is used by more than one operation",
+			}.Check(ctx, t, db)
+
+			metabasetest.CommitAndDeleteObjects{
+				Opts: metabase.CommitAndDeleteObjects{
+					Commits: []metabase.CommitObject{{ObjectStream: obj}},
+					Deletes: []metabase.DeleteObjectLastCommitted{{ObjectLocation: other.Location()}},
+				},
+				ErrClass: &metabase.ErrInvalidRequest,
+				ErrText:  "all objects must be in the same project",
+			}.Check(ctx, t, db)
+
+			metabasetest.CommitAndDeleteObjects{
+				Opts: metabase.CommitAndDeleteObjects{
+					Commits: []metabase.CommitObject{{
+						ObjectStream: obj,
+						OnDelete:     func(segments []metabase.DeletedSegmentInfo) {},
+					}},
+				},
+				ErrClass: &metabase.ErrInvalidRequest,
+				ErrText:  "OnDelete is not supported",
+			}.Check(ctx, t, db)
+
+			metabasetest.Verify{}.Check(ctx, t, db)
+		})
+
+		t.Run("rename", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			source := metabasetest.RandObjectStream()
+			sourceObject := metabasetest.CreateObject(ctx, t, db, source, 2)
+
+			target := metabasetest.RandObjectStream()
+			target.ProjectID = source.ProjectID
+			target.BucketName = source.BucketName
+
+			metabasetest.BeginObjectExactVersion{
+				Opts: metabase.BeginObjectExactVersion{
+					ObjectStream: target,
+					Encryption:   metabasetest.DefaultEncryption,
+				},
+				Version: target.Version,
+			}.Check(ctx, t, db)
+
+			now := time.Now()
+
+			expectedSegmentInfo := metabase.DeletedSegmentInfo{
+				RootPieceID: storj.PieceID{1},
+				Pieces:      metabase.Pieces{{Number: 0, StorageNode: storj.NodeID{2}}},
+			}
+
+			targetObject := metabase.Object{
+				ObjectStream: target,
+				CreatedAt:    now,
+				Status:       metabase.Committed,
+				Encryption:   metabasetest.DefaultEncryption,
+			}
+
+			metabasetest.CommitAndDeleteObjects{
+				Opts: metabase.CommitAndDeleteObjects{
+					Commits: []metabase.CommitObject{{ObjectStream: target}},
+					Deletes: []metabase.DeleteObjectLastCommitted{{ObjectLocation: source.Location()}},
+				},
+				Result: metabase.CommitAndDeleteObjectsResult{
+					Committed: []metabase.Object{targetObject},
+					Deleted: metabase.DeleteObjectResult{
+						Objects:  []metabase.Object{sourceObject},
+						Segments: []metabase.DeletedSegmentInfo{expectedSegmentInfo, expectedSegmentInfo},
+					},
+				},
+			}.Check(ctx, t, db)
+
+			metabasetest.Verify{
+				Objects: []metabase.RawObject{
+					metabase.RawObject(targetObject),
+				},
+			}.Check(ctx, t, db)
+		})
+
+		t.Run("all or nothing", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			source := metabasetest.RandObjectStream()
+			sourceObject := metabasetest.CreateObject(ctx, t, db, source, 0)
+
+			target := metabasetest.RandObjectStream()
+			target.ProjectID = source.ProjectID
+			target.BucketName = source.BucketName
+
+			metabasetest.BeginObjectExactVersion{
+				Opts: metabase.BeginObjectExactVersion{
+					ObjectStream: target,
+					Encryption:   metabasetest.DefaultEncryption,
+				},
+				Version: target.Version,
+			}.Check(ctx, t, db)
+
+			now := time.Now()
+			zombieDeadline := now.Add(24 * time.Hour)
+
+			// the source was modified by another client, so the target isn't committed either.
+			metabasetest.CommitAndDeleteObjects{
+				Opts: metabase.CommitAndDeleteObjects{
+					Commits: []metabase.CommitObject{{ObjectStream: target}},
+					Deletes: []metabase.DeleteObjectLastCommitted{{
+						ObjectLocation: source.Location(),
+						Precondition:   metabase.Precondition{IfMatch: testrand.UUID()},
+					}},
+				},
+				ErrClass: &metabase.ErrPreconditionFailed,
+				ErrText:  "object has been modified",
+			}.Check(ctx, t, db)
+
+			metabasetest.Verify{
+				Objects: []metabase.RawObject{
+					metabase.RawObject(sourceObject),
+					{
+						ObjectStream: target,
+						CreatedAt:    now,
+						Status:       metabase.Pending,
+						Encryption:   metabasetest.DefaultEncryption,
+
+						ZombieDeletionDeadline: &zombieDeadline,
+					},
+				},
+			}.Check(ctx, t, db)
+		})
+	})
+}
@@ -5,10 +5,12 @@ package metabase_test
 
 import (
 	"math"
+	"sort"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/require"
+	"golang.org/x/sync/errgroup"
 
 	"storj.io/common/memory"
 	"storj.io/common/storj"
@@ -155,6 +157,36 @@ func TestBeginObjectNextVersion(t *testing.T) {
 		// TODO: expires at date
 		// TODO: zombie deletion deadline
 
+		t.Run("concurrent uploads", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			const uploads = 5
+
+			var group errgroup.Group
+			versions := make([]metabase.Version, uploads)
+			for i := range versions {
+				i := i
+				group.Go(func() error {
+					stream := objectStream
+					stream.Version = metabase.NextVersion
+					stream.StreamID = testrand.UUID()
+
+					object, err := db.BeginObjectNextVersion(ctx, metabase.BeginObjectNextVersion{
+						ObjectStream: stream,
+						Encryption:   metabasetest.DefaultEncryption,
+					})
+					versions[i] = object.Version
+					return err
+				})
+			}
+			require.NoError(t, group.Wait())
+
+			sort.Slice(versions, func(i, k int) bool { return versions[i] < versions[k] })
+			for i, version := range versions {
+				require.EqualValues(t, i+1, version)
+			}
+		})
+
 		t.Run("older committed version exists", func(t *testing.T) {
 			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
 
@@ -1469,7 +1501,7 @@ func TestCommitSegment(t *testing.T) {
 					PlainOffset:   0,
 					Redundancy:    metabasetest.DefaultRedundancy,
 				},
-				ErrClass: &metabase.ErrPendingObjectMissing,
+				ErrClass: &metabase.ErrObjectAlreadyCommitted,
 			}.Check(ctx, t, db)
 
 			metabasetest.Verify{
@@ -1912,7 +1944,7 @@ func TestCommitInlineSegment(t *testing.T) {
 					PlainSize:   512,
 					PlainOffset: 0,
 				},
-				ErrClass: &metabase.ErrPendingObjectMissing,
+				ErrClass: &metabase.ErrObjectAlreadyCommitted,
 			}.Check(ctx, t, db)
 
 			metabasetest.Verify{
@@ -2782,6 +2814,192 @@ func TestCommitObject_MultipleVersions(t *testing.T) {
 
 			require.Equal(t, expectedDeletedSegments, deletedSegments)
 		})
+
+		t.Run("invalid Precondition", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			metabasetest.CommitObject{
+				Opts: metabase.CommitObject{
+					ObjectStream: metabasetest.RandObjectStream(),
+					Precondition: metabase.Precondition{
+						IfMatch:     testrand.UUID(),
+						IfNoneMatch: true,
+					},
+				},
+				ErrClass: &metabase.ErrInvalidRequest,
+				ErrText:  "IfMatch and IfNoneMatch cannot be both set",
+			}.Check(ctx, t, db)
+		})
+
+		t.Run("IfNoneMatch", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			obj := metabasetest.RandObjectStream()
+			zombieDeadline := time.Now().Add(24 * time.Hour)
+
+			metabasetest.BeginObjectExactVersion{
+				Opts: metabase.BeginObjectExactVersion{
+					ObjectStream: obj,
+					Encryption:   metabasetest.DefaultEncryption,
+				},
+				Version: obj.Version,
+			}.Check(ctx, t, db)
+
+			object := metabasetest.CommitObject{
+				Opts: metabase.CommitObject{
+					ObjectStream: obj,
+					Precondition: metabase.Precondition{IfNoneMatch: true},
+				},
+			}.Check(ctx, t, db)
+
+			next := obj
+			next.Version++
+			next.StreamID = testrand.UUID()
+
+			metabasetest.BeginObjectExactVersion{
+				Opts: metabase.BeginObjectExactVersion{
+					ObjectStream: next,
+					Encryption:   metabasetest.DefaultEncryption,
+				},
+				Version: next.Version,
+			}.Check(ctx, t, db)
+
+			metabasetest.CommitObject{
+				Opts: metabase.CommitObject{
+					ObjectStream: next,
+					Precondition: metabase.Precondition{IfNoneMatch: true},
+				},
+				ErrClass: &metabase.ErrPreconditionFailed,
+				ErrText:  "object already exists",
+			}.Check(ctx, t, db)
+
+			metabasetest.Verify{
+				Objects: []metabase.RawObject{
+					metabase.RawObject(object),
+					{
+						ObjectStream: next,
+						CreatedAt:    time.Now(),
+						Status:       metabase.Pending,
+						Encryption:   metabasetest.DefaultEncryption,
+
+						ZombieDeletionDeadline: &zombieDeadline,
+					},
+				},
+			}.Check(ctx, t, db)
+		})
+
+		t.Run("IfMatch", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			obj := metabasetest.RandObjectStream()
+
+			metabasetest.BeginObjectExactVersion{
+				Opts: metabase.BeginObjectExactVersion{
+					ObjectStream: obj,
+					Encryption:   metabasetest.DefaultEncryption,
+				},
+				Version: obj.Version,
+			}.Check(ctx, t, db)
+
+			metabasetest.CommitObject{
+				Opts: metabase.CommitObject{
+					ObjectStream: obj,
+					Precondition: metabase.Precondition{IfMatch: testrand.UUID()},
+				},
+				ErrClass: &metabase.ErrPreconditionFailed,
+				ErrText:  "object doesn't exist",
+			}.Check(ctx, t, db)
+
+			metabasetest.CommitObject{
+				Opts: metabase.CommitObject{
+					ObjectStream: obj,
+				},
+			}.Check(ctx, t, db)
+
+			next := obj
+			next.Version++
+			next.StreamID = testrand.UUID()
+
+			metabasetest.BeginObjectExactVersion{
+				Opts: metabase.BeginObjectExactVersion{
+					ObjectStream: next,
+					Encryption:   metabasetest.DefaultEncryption,
+				},
+				Version: next.Version,
+			}.Check(ctx, t, db)
+
+			metabasetest.CommitObject{
+				Opts: metabase.CommitObject{
+					ObjectStream: next,
+					Precondition: metabase.Precondition{IfMatch: testrand.UUID()},
+				},
+				ErrClass: &metabase.ErrPreconditionFailed,
+				ErrText:  "object has been modified",
+			}.Check(ctx, t, db)
+
+			object := metabasetest.CommitObject{
+				Opts: metabase.CommitObject{
+					ObjectStream: next,
+					Precondition: metabase.Precondition{IfMatch: obj.StreamID},
+				},
+			}.Check(ctx, t, db)
+
+			metabasetest.Verify{
+				Objects: []metabase.RawObject{
+					metabase.RawObject(object),
+				},
+			}.Check(ctx, t, db)
+		})
+
+		t.Run("concurrent IfNoneMatch", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			const uploads = 5
+
+			obj := metabasetest.RandObjectStream()
+			location := obj.Location()
+			streams := make([]metabase.ObjectStream, uploads)
+			for i := range streams {
+				streams[i] = metabase.ObjectStream{
+					ProjectID:  location.ProjectID,
+					BucketName: location.BucketName,
+					ObjectKey:  location.ObjectKey,
+					Version:    metabase.Version(i + 1),
+					StreamID:   testrand.UUID(),
+				}
+				metabasetest.BeginObjectExactVersion{
+					Opts: metabase.BeginObjectExactVersion{
+						ObjectStream: streams[i],
+						Encryption:   metabasetest.DefaultEncryption,
+					},
+					Version: streams[i].Version,
+				}.Check(ctx, t, db)
+			}
+
+			var group errgroup.Group
+			commitErrs := make([]error, uploads)
+			for i := range streams {
+				i := i
+				group.Go(func() error {
+					_, commitErrs[i] = db.CommitObject(ctx, metabase.CommitObject{
+						ObjectStream: streams[i],
+						Precondition: metabase.Precondition{IfNoneMatch: true},
+					})
+					return nil
+				})
+			}
+			require.NoError(t, group.Wait())
+
+			committed := 0
+			for _, err := range commitErrs {
+				if err == nil {
+					committed++
+					continue
+				}
+				require.True(t, metabase.ErrPreconditionFailed.Has(err), err)
+			}
+			require.Equal(t, 1, committed)
+		})
 	})
 }
 
@@ -3248,3 +3466,148 @@ func TestMultipleVersionsBug(t *testing.T) {
 		require.Equal(t, 1, len(objects))
 	})
 }
+
+func TestCommitObject_MultipartAggregates(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		obj := metabasetest.RandObjectStream()
+
+		commitSegments := func(t *testing.T, positions []metabase.SegmentPosition, sizes []memory.Size) {
+			for i, position := range positions {
+				metabasetest.CommitSegment{
+					Opts: metabase.CommitSegment{
+						ObjectStream: obj,
+						Position:     position,
+						RootPieceID:  testrand.PieceID(),
+						Pieces:       metabase.Pieces{{Number: 0, StorageNode: testrand.NodeID()}},
+
+						EncryptedKey:      testrand.Bytes(32),
+						EncryptedKeyNonce: testrand.Bytes(32),
+
+						EncryptedSize: sizes[i].Int32() + 16,
+						PlainSize:     sizes[i].Int32(),
+						Redundancy:    metabasetest.DefaultRedundancy,
+					},
+				}.Check(ctx, t, db)
+			}
+		}
+
+		beginObject := func(t *testing.T) {
+			metabasetest.BeginObjectExactVersion{
+				Opts: metabase.BeginObjectExactVersion{
+					ObjectStream: obj,
+					Encryption:   metabasetest.DefaultEncryption,
+				},
+				Version: obj.Version,
+			}.Check(ctx, t, db)
+		}
+
+		t.Run("multiple parts with multiple segments", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			beginObject(t)
+
+			// parts and segments are committed out of order.
+			positions := []metabase.SegmentPosition{
+				{Part: 3, Index: 0},
+				{Part: 1, Index: 1},
+				{Part: 1, Index: 0},
+				{Part: 2, Index: 5},
+				{Part: 2, Index: 2},
+			}
+			sizes := []memory.Size{
+				100 * memory.KiB,
+				2 * memory.MiB,
+				4 * memory.MiB,
+				3 * memory.MiB,
+				3 * memory.MiB,
+			}
+			commitSegments(t, positions, sizes)
+
+			object := metabasetest.CommitObject{
+				Opts: metabase.CommitObject{ObjectStream: obj},
+			}.Check(ctx, t, db)
+
+			expectedPlainSize := (12*memory.MiB + 100*memory.KiB).Int64()
+			require.EqualValues(t, 5, object.SegmentCount)
+			require.Equal(t, expectedPlainSize, object.TotalPlainSize)
+			require.Equal(t, expectedPlainSize+5*16, object.TotalEncryptedSize)
+			require.EqualValues(t, -1, object.FixedSegmentSize)
+
+			objects, err := db.TestingAllObjects(ctx)
+			require.NoError(t, err)
+			require.Len(t, objects, 1)
+			require.Equal(t, object.SegmentCount, objects[0].SegmentCount)
+			require.Equal(t, object.TotalPlainSize, objects[0].TotalPlainSize)
+			require.Equal(t, object.TotalEncryptedSize, objects[0].TotalEncryptedSize)
+			require.Equal(t, object.FixedSegmentSize, objects[0].FixedSegmentSize)
+
+			// the segments are ordered by position, and their offsets continue
+			// over the parts.
+			segments, err := db.TestingAllSegments(ctx)
+			require.NoError(t, err)
+			require.Len(t, segments, 5)
+
+			expectedOffsets := map[metabase.SegmentPosition]memory.Size{
+				{Part: 1, Index: 0}: 0,
+				{Part: 1, Index: 1}: 4 * memory.MiB,
+				{Part: 2, Index: 2}: 6 * memory.MiB,
+				{Part: 2, Index: 5}: 9 * memory.MiB,
+				{Part: 3, Index: 0}: 12 * memory.MiB,
+			}
+			for _, segment := range segments {
+				require.Equal(t, expectedOffsets[segment.Position].Int64(), segment.PlainOffset, segment.Position)
+			}
+		})
+
+		t.Run("single part with equal segments", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			beginObject(t)
+			commitSegments(t,
+				[]metabase.SegmentPosition{{Index: 0}, {Index: 1}, {Index: 2}},
+				[]memory.Size{memory.MiB, memory.MiB, 512 * memory.KiB})
+
+			object := metabasetest.CommitObject{
+				Opts: metabase.CommitObject{ObjectStream: obj},
+			}.Check(ctx, t, db)
+
+			require.EqualValues(t, 3, object.SegmentCount)
+			require.Equal(t, (2*memory.MiB + 512*memory.KiB).Int64(), object.TotalPlainSize)
+			require.Equal(t, memory.MiB.Int32(), object.FixedSegmentSize)
+		})
+
+		t.Run("segments committed after the object are rejected", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			beginObject(t)
+			commitSegments(t, []metabase.SegmentPosition{{Index: 0}}, []memory.Size{memory.MiB})
+
+			object := metabasetest.CommitObject{
+				Opts: metabase.CommitObject{ObjectStream: obj},
+			}.Check(ctx, t, db)
+			require.EqualValues(t, 1, object.SegmentCount)
+
+			metabasetest.CommitSegment{
+				Opts: metabase.CommitSegment{
+					ObjectStream: obj,
+					Position:     metabase.SegmentPosition{Index: 1},
+					RootPieceID:  testrand.PieceID(),
+					Pieces:       metabase.Pieces{{Number: 0, StorageNode: testrand.NodeID()}},
+
+					EncryptedKey:      testrand.Bytes(32),
+					EncryptedKeyNonce: testrand.Bytes(32),
+
+					EncryptedSize: 1024,
+					PlainSize:     512,
+					Redundancy:    metabasetest.DefaultRedundancy,
+				},
+				ErrClass: &metabase.ErrObjectAlreadyCommitted,
+			}.Check(ctx, t, db)
+
+			objects, err := db.TestingAllObjects(ctx)
+			require.NoError(t, err)
+			require.Len(t, objects, 1)
+			require.EqualValues(t, 1, objects[0].SegmentCount)
+		})
+	})
+}
@@ -23,6 +23,9 @@ var (
 	ErrObjectAlreadyExists = errs.Class("object already exists")
 	// ErrPendingObjectMissing is used to indicate a pending object is no longer accessible.
 	ErrPendingObjectMissing = errs.Class("pending object missing")
+	// ErrObjectAlreadyCommitted is used to indicate that the pending object was
+	// committed, e.g. concurrently, before the request could modify it.
+	ErrObjectAlreadyCommitted = errs.Class("object already committed")
 	// ErrPermissionDenied general error for denying permission.
 	ErrPermissionDenied = errs.Class("permission denied")
 )
@@ -0,0 +1,269 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/private/tagsql"
+	"storj.io/storj/storage"
+)
+
+// ListConvertibleSegments contains arguments necessary for listing the
+// segments of a project, which can be converted between inline and remote
+// segments.
+//
+// Segments of copied objects are not listed, because their inline data or
+// pieces are shared with the other copies.
+type ListConvertibleSegments struct {
+	ProjectID uuid.UUID
+
+	CursorStreamID uuid.UUID
+	CursorPosition SegmentPosition
+
+	// Inline lists the inline segments larger than the threshold, otherwise
+	// the remote segments up to the threshold are listed.
+	Inline bool
+	// Threshold is the plain size of the segments.
+	Threshold int32
+
+	Limit int
+}
+
+// ListConvertibleSegmentsResult is the result of ListConvertibleSegments.
+type ListConvertibleSegmentsResult struct {
+	Segments []Segment
+}
+
+// Verify verifies list convertible segments request fields.
+func (opts *ListConvertibleSegments) Verify() error {
+	switch {
+	case opts.ProjectID.IsZero():
+		return ErrInvalidRequest.New("ProjectID missing")
+	case opts.Threshold < 0:
+		return ErrInvalidRequest.New("Threshold is negative")
+	case opts.Limit <= 0:
+		return ErrInvalidRequest.New("Invalid limit: %d", opts.Limit)
+	}
+	return nil
+}
+
+// ListConvertibleSegments lists the segments of a project, which can be
+// converted between inline and remote segments.
+func (db *DB) ListConvertibleSegments(ctx context.Context, opts ListConvertibleSegments) (result ListConvertibleSegmentsResult, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := opts.Verify(); err != nil {
+		return ListConvertibleSegmentsResult{}, err
+	}
+	ListLimit.Ensure(&opts.Limit)
+
+	kind := `inline_data IS NOT NULL AND remote_alias_pieces IS NULL AND plain_size > $4`
+	if !opts.Inline {
+		kind = `inline_data IS NULL AND remote_alias_pieces IS NOT NULL AND plain_size <= $4`
+	}
+
+	err = withRows(db.db.QueryContext(ctx, `
+		SELECT
+			stream_id, position,
+			created_at, expires_at, repaired_at,
+			root_piece_id, encrypted_key_nonce, encrypted_key,
+			encrypted_size, plain_offset, plain_size,
+			encrypted_etag,
+			redundancy,
+			inline_data, remote_alias_pieces,
+			placement
+		FROM segments
+		WHERE
+			stream_id IN (SELECT stream_id FROM objects WHERE project_id = $1) AND
+			(stream_id, position) > ($2, $3) AND
+			`+kind+` AND
+			NOT EXISTS (
+				SELECT 1 FROM segment_copies
+				WHERE
+					segment_copies.stream_id = segments.stream_id OR
+					segment_copies.ancestor_stream_id = segments.stream_id
+			)
+		ORDER BY stream_id ASC, position ASC
+		LIMIT $5
+	`, opts.ProjectID, opts.CursorStreamID, opts.CursorPosition, opts.Threshold, opts.Limit))(func(rows tagsql.Rows) error {
+		for rows.Next() {
+			var segment Segment
+			var aliasPieces AliasPieces
+			err := rows.Scan(
+				&segment.StreamID, &segment.Position,
+				&segment.CreatedAt, &segment.ExpiresAt, &segment.RepairedAt,
+				&segment.RootPieceID, &segment.EncryptedKeyNonce, &segment.EncryptedKey,
+				&segment.EncryptedSize, &segment.PlainOffset, &segment.PlainSize,
+				&segment.EncryptedETag,
+				redundancyScheme{&segment.Redundancy},
+				&segment.InlineData, &aliasPieces,
+				&segment.Placement,
+			)
+			if err != nil {
+				return Error.New("failed to scan segments: %w", err)
+			}
+
+			segment.Pieces, err = db.aliasCache.ConvertAliasesToPieces(ctx, aliasPieces)
+			if err != nil {
+				return Error.New("failed to convert aliases to pieces: %w", err)
+			}
+
+			result.Segments = append(result.Segments, segment)
+		}
+		return nil
+	})
+	if err != nil {
+		return ListConvertibleSegmentsResult{}, Error.Wrap(err)
+	}
+
+	return result, nil
+}
+
+// ConvertSegmentToRemote contains arguments necessary for replacing the inline
+// data of a segment with the pieces uploaded to storage nodes.
+type ConvertSegmentToRemote struct {
+	StreamID uuid.UUID
+	Position SegmentPosition
+
+	// OldInlineData is the inline data, which was uploaded.
+	OldInlineData []byte
+
+	RootPieceID storj.PieceID
+	Redundancy  storj.RedundancyScheme
+	Pieces      Pieces
+}
+
+// Verify verifies convert segment to remote request fields.
+func (opts *ConvertSegmentToRemote) Verify() error {
+	switch {
+	case opts.StreamID.IsZero():
+		return ErrInvalidRequest.New("StreamID missing")
+	case opts.RootPieceID.IsZero():
+		return ErrInvalidRequest.New("RootPieceID missing")
+	case opts.Redundancy.IsZero():
+		return ErrInvalidRequest.New("Redundancy zero")
+	case len(opts.Pieces) < int(opts.Redundancy.OptimalShares):
+		return ErrInvalidRequest.New("number of pieces is less than redundancy optimal shares value")
+	}
+	return opts.Pieces.Verify()
+}
+
+// ConvertSegmentToRemote replaces the inline data of a segment with pieces.
+// It fails with storage.ErrValueChanged, when the inline data of the segment
+// was changed in the meantime.
+func (db *DB) ConvertSegmentToRemote(ctx context.Context, opts ConvertSegmentToRemote) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := opts.Verify(); err != nil {
+		return err
+	}
+
+	aliasPieces, err := db.aliasCache.EnsurePiecesToAliases(ctx, opts.Pieces)
+	if err != nil {
+		return Error.New("unable to convert pieces to aliases: %w", err)
+	}
+
+	var resultPieces AliasPieces
+	err = db.db.QueryRowContext(ctx, `
+		UPDATE segments SET
+			root_piece_id = CASE WHEN inline_data = $3 THEN $4 ELSE root_piece_id END,
+			redundancy = CASE WHEN inline_data = $3 THEN $5 ELSE redundancy END,
+			remote_alias_pieces = CASE WHEN inline_data = $3 THEN $6 ELSE remote_alias_pieces END,
+			inline_data = CASE WHEN inline_data = $3 THEN NULL ELSE inline_data END
+		WHERE
+			stream_id = $1 AND
+			position  = $2
+		RETURNING remote_alias_pieces
+	`, opts.StreamID, opts.Position, opts.OldInlineData,
+		opts.RootPieceID, redundancyScheme{&opts.Redundancy}, aliasPieces).
+		Scan(&resultPieces)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrSegmentNotFound.New("segment missing")
+		}
+		return Error.New("unable to convert segment to remote: %w", err)
+	}
+	if !EqualAliasPieces(aliasPieces, resultPieces) {
+		return storage.ErrValueChanged.New("segment inline_data field was changed")
+	}
+
+	mon.Meter("segment_converted_to_remote").Mark(1)
+	return nil
+}
+
+// ConvertSegmentToInline contains arguments necessary for replacing the pieces
+// of a segment with the downloaded data.
+type ConvertSegmentToInline struct {
+	StreamID uuid.UUID
+	Position SegmentPosition
+
+	// OldPieces are the pieces, which were downloaded.
+	OldPieces Pieces
+
+	InlineData []byte
+}
+
+// Verify verifies convert segment to inline request fields.
+func (opts *ConvertSegmentToInline) Verify() error {
+	switch {
+	case opts.StreamID.IsZero():
+		return ErrInvalidRequest.New("StreamID missing")
+	case len(opts.OldPieces) == 0:
+		return ErrInvalidRequest.New("OldPieces missing")
+	case opts.InlineData == nil:
+		return ErrInvalidRequest.New("InlineData missing")
+	}
+	return nil
+}
+
+// ConvertSegmentToInline replaces the pieces of a segment with inline data.
+// It fails with storage.ErrValueChanged, when the pieces of the segment were
+// changed in the meantime, e.g. by repair.
+//
+// The pieces on the storage nodes are deleted by garbage collection.
+func (db *DB) ConvertSegmentToInline(ctx context.Context, opts ConvertSegmentToInline) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := opts.Verify(); err != nil {
+		return err
+	}
+
+	oldPieces, err := db.aliasCache.EnsurePiecesToAliases(ctx, opts.OldPieces)
+	if err != nil {
+		return Error.New("unable to convert pieces to aliases: %w", err)
+	}
+
+	var inlineData []byte
+	err = db.db.QueryRowContext(ctx, `
+		UPDATE segments SET
+			inline_data = CASE WHEN remote_alias_pieces = $3 THEN $4 ELSE inline_data END,
+			root_piece_id = CASE WHEN remote_alias_pieces = $3 THEN $5 ELSE root_piece_id END,
+			redundancy = CASE WHEN remote_alias_pieces = $3 THEN 0 ELSE redundancy END,
+			repaired_at = CASE WHEN remote_alias_pieces = $3 THEN NULL ELSE repaired_at END,
+			remote_alias_pieces = CASE WHEN remote_alias_pieces = $3 THEN NULL ELSE remote_alias_pieces END
+		WHERE
+			stream_id = $1 AND
+			position  = $2
+		RETURNING inline_data
+	`, opts.StreamID, opts.Position, oldPieces, opts.InlineData, storj.PieceID{}).
+		Scan(&inlineData)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrSegmentNotFound.New("segment missing")
+		}
+		return Error.New("unable to convert segment to inline: %w", err)
+	}
+	if !bytes.Equal(inlineData, opts.InlineData) {
+		return storage.ErrValueChanged.New("segment remote_alias_pieces field was changed")
+	}
+
+	mon.Meter("segment_converted_to_inline").Mark(1)
+	return nil
+}
@@ -0,0 +1,190 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/metabasetest"
+	"storj.io/storj/storage"
+)
+
+func TestListConvertibleSegments(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		obj := metabasetest.RandObjectStream()
+
+		t.Run("ProjectID missing", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			_, err := db.ListConvertibleSegments(ctx, metabase.ListConvertibleSegments{Limit: 1})
+			require.True(t, metabase.ErrInvalidRequest.Has(err))
+		})
+
+		t.Run("Invalid limit", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			_, err := db.ListConvertibleSegments(ctx, metabase.ListConvertibleSegments{ProjectID: obj.ProjectID})
+			require.True(t, metabase.ErrInvalidRequest.Has(err))
+		})
+
+		t.Run("Remote segments", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			metabasetest.CreateObject(ctx, t, db, obj, 2)
+			metabasetest.CreateObject(ctx, t, db, metabasetest.RandObjectStream(), 2)
+
+			result, err := db.ListConvertibleSegments(ctx, metabase.ListConvertibleSegments{
+				ProjectID: obj.ProjectID,
+				Threshold: 512,
+				Limit:     1,
+			})
+			require.NoError(t, err)
+			require.Len(t, result.Segments, 1)
+			require.Equal(t, obj.StreamID, result.Segments[0].StreamID)
+			require.Equal(t, metabase.SegmentPosition{Index: 0}, result.Segments[0].Position)
+
+			result, err = db.ListConvertibleSegments(ctx, metabase.ListConvertibleSegments{
+				ProjectID:      obj.ProjectID,
+				CursorStreamID: result.Segments[0].StreamID,
+				CursorPosition: result.Segments[0].Position,
+				Threshold:      512,
+				Limit:          10,
+			})
+			require.NoError(t, err)
+			require.Len(t, result.Segments, 1)
+			require.Equal(t, metabase.SegmentPosition{Index: 1}, result.Segments[0].Position)
+			require.Equal(t, metabase.Pieces{{Number: 0, StorageNode: storj.NodeID{2}}}, result.Segments[0].Pieces)
+
+			// the segments are larger than the threshold.
+			result, err = db.ListConvertibleSegments(ctx, metabase.ListConvertibleSegments{
+				ProjectID: obj.ProjectID,
+				Threshold: 511,
+				Limit:     10,
+			})
+			require.NoError(t, err)
+			require.Empty(t, result.Segments)
+
+			// there are no inline segments.
+			result, err = db.ListConvertibleSegments(ctx, metabase.ListConvertibleSegments{
+				ProjectID: obj.ProjectID,
+				Inline:    true,
+				Limit:     10,
+			})
+			require.NoError(t, err)
+			require.Empty(t, result.Segments)
+		})
+
+		t.Run("Copied segments", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			original, segments := metabasetest.CreateTestObject{}.Run(ctx, t, db, obj, 2)
+			metabasetest.CreateObjectCopy{
+				OriginalObject:   original,
+				OriginalSegments: segments,
+			}.Run(ctx, t, db)
+
+			result, err := db.ListConvertibleSegments(ctx, metabase.ListConvertibleSegments{
+				ProjectID: obj.ProjectID,
+				Threshold: 512,
+				Limit:     10,
+			})
+			require.NoError(t, err)
+			require.Empty(t, result.Segments)
+		})
+	})
+}
+
+func TestConvertSegment(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		obj := metabasetest.RandObjectStream()
+
+		t.Run("Convert to inline and back", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			metabasetest.CreateObject(ctx, t, db, obj, 1)
+
+			segment, err := db.GetSegmentByPosition(ctx, metabase.GetSegmentByPosition{StreamID: obj.StreamID})
+			require.NoError(t, err)
+
+			data := testrand.Bytes(1024)
+			err = db.ConvertSegmentToInline(ctx, metabase.ConvertSegmentToInline{
+				StreamID:   obj.StreamID,
+				OldPieces:  segment.Pieces,
+				InlineData: data,
+			})
+			require.NoError(t, err)
+
+			inline, err := db.GetSegmentByPosition(ctx, metabase.GetSegmentByPosition{StreamID: obj.StreamID})
+			require.NoError(t, err)
+			require.True(t, inline.Inline())
+			require.Equal(t, data, inline.InlineData)
+			require.Equal(t, segment.EncryptedSize, inline.EncryptedSize)
+			require.Equal(t, segment.PlainSize, inline.PlainSize)
+
+			result, err := db.ListConvertibleSegments(ctx, metabase.ListConvertibleSegments{
+				ProjectID: obj.ProjectID,
+				Inline:    true,
+				Limit:     10,
+			})
+			require.NoError(t, err)
+			require.Len(t, result.Segments, 1)
+
+			pieces := metabase.Pieces{{Number: 0, StorageNode: testrand.NodeID()}}
+			err = db.ConvertSegmentToRemote(ctx, metabase.ConvertSegmentToRemote{
+				StreamID:      obj.StreamID,
+				OldInlineData: data,
+				RootPieceID:   testrand.PieceID(),
+				Redundancy:    metabasetest.DefaultRedundancy,
+				Pieces:        pieces,
+			})
+			require.NoError(t, err)
+
+			remote, err := db.GetSegmentByPosition(ctx, metabase.GetSegmentByPosition{StreamID: obj.StreamID})
+			require.NoError(t, err)
+			require.False(t, remote.Inline())
+			require.Empty(t, remote.InlineData)
+			require.Equal(t, pieces, remote.Pieces)
+			require.Equal(t, segment.EncryptedSize, remote.EncryptedSize)
+		})
+
+		t.Run("Changed segment", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			metabasetest.CreateObject(ctx, t, db, obj, 1)
+
+			err := db.ConvertSegmentToInline(ctx, metabase.ConvertSegmentToInline{
+				StreamID:   obj.StreamID,
+				OldPieces:  metabase.Pieces{{Number: 1, StorageNode: testrand.NodeID()}},
+				InlineData: testrand.Bytes(1024),
+			})
+			require.True(t, storage.ErrValueChanged.Has(err))
+
+			err = db.ConvertSegmentToRemote(ctx, metabase.ConvertSegmentToRemote{
+				StreamID:      obj.StreamID,
+				OldInlineData: testrand.Bytes(1024),
+				RootPieceID:   testrand.PieceID(),
+				Redundancy:    metabasetest.DefaultRedundancy,
+				Pieces:        metabase.Pieces{{Number: 0, StorageNode: testrand.NodeID()}},
+			})
+			require.True(t, storage.ErrValueChanged.Has(err))
+		})
+
+		t.Run("Segment missing", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			err := db.ConvertSegmentToInline(ctx, metabase.ConvertSegmentToInline{
+				StreamID:   obj.StreamID,
+				OldPieces:  metabase.Pieces{{Number: 0, StorageNode: testrand.NodeID()}},
+				InlineData: testrand.Bytes(1024),
+			})
+			require.True(t, metabase.ErrSegmentNotFound.Has(err))
+		})
+	})
+}
@@ -45,6 +45,10 @@ type FinishCopyObject struct {
 	NewEncryptedObjectKey ObjectKey
 	NewStreamID           uuid.UUID
 
+	// NewProjectID is optional, the copy is created in the project of the
+	// source object when it's not set.
+	NewProjectID uuid.UUID
+
 	OverrideMetadata             bool
 	NewEncryptedMetadata         []byte
 	NewEncryptedMetadataKeyNonce storj.Nonce
@@ -73,6 +77,9 @@ func (finishCopy FinishCopyObject) Verify() error {
 		return ErrInvalidRequest.New("StreamIDs are identical")
 	case len(finishCopy.NewEncryptedObjectKey) == 0:
 		return ErrInvalidRequest.New("NewEncryptedObjectKey is missing")
+	case finishCopy.newProjectID() != finishCopy.ProjectID && !finishCopy.OverrideMetadata:
+		// the metadata of the source may be sealed with the key of its project.
+		return ErrInvalidRequest.New("OverrideMetadata is required when copying into another project")
 	}
 
 	if finishCopy.OverrideMetadata {
@@ -93,6 +100,14 @@ func (finishCopy FinishCopyObject) Verify() error {
 	return nil
 }
 
+// newProjectID returns the project of the copy.
+func (finishCopy FinishCopyObject) newProjectID() uuid.UUID {
+	if finishCopy.NewProjectID.IsZero() {
+		return finishCopy.ProjectID
+	}
+	return finishCopy.NewProjectID
+}
+
 // FinishCopyObject accepts new encryption keys for copied object and insert the corresponding new object ObjectKey and segments EncryptedKey.
 // It returns the object at the destination location.
 func (db *DB) FinishCopyObject(ctx context.Context, opts FinishCopyObject) (object Object, err error) {
@@ -102,6 +117,19 @@ func (db *DB) FinishCopyObject(ctx context.Context, opts FinishCopyObject) (obje
 		return Object{}, err
 	}
 
+	var newNonce, newMetadata []byte
+	if !opts.NewEncryptedMetadataKeyNonce.IsZero() {
+		newNonce = opts.NewEncryptedMetadataKeyNonce[:]
+	}
+	if opts.OverrideMetadata {
+		newMetadata = opts.NewEncryptedMetadata
+	}
+	newProjectID := opts.newProjectID()
+	sealed, err := db.sealMetadata(ctx, newProjectID, newNonce, newMetadata, opts.NewEncryptedMetadataKey)
+	if err != nil {
+		return Object{}, err
+	}
+
 	newObject := Object{}
 	var copyMetadata []byte
 
@@ -118,6 +146,8 @@ func (db *DB) FinishCopyObject(ctx context.Context, opts FinishCopyObject) (obje
 			newObject = sourceObject
 			return nil
 		}
+
+		deltas := tallyDeltas{}
 		if opts.VerifyLimits != nil {
 			err := opts.VerifyLimits(sourceObject.TotalEncryptedSize, int64(sourceObject.SegmentCount))
 			if err != nil {
@@ -206,7 +236,7 @@ func (db *DB) FinishCopyObject(ctx context.Context, opts FinishCopyObject) (obje
 		}
 
 		if opts.OverrideMetadata {
-			copyMetadata = opts.NewEncryptedMetadata
+			copyMetadata = sealed.Metadata
 		} else {
 			copyMetadata = sourceObject.EncryptedMetadata
 		}
@@ -230,6 +260,10 @@ func (db *DB) FinishCopyObject(ctx context.Context, opts FinishCopyObject) (obje
 				return Error.New("unable to delete existing object at copy destination: %w", err)
 			}
 
+			for _, deleted := range deletedObjects {
+				deltas.add(deleted.Object, true)
+			}
+
 			// The object at the destination was the ancestor!
 			// Now that the ancestor of the source object is removed, we need to change the target ancestor.
 			if ancestorStreamID == objectAtDestination.StreamID {
@@ -259,10 +293,10 @@ func (db *DB) FinishCopyObject(ctx context.Context, opts FinishCopyObject) (obje
 			)
 			RETURNING
 				created_at`,
-			opts.ProjectID, opts.NewBucket, opts.NewEncryptedObjectKey, nextAvailableVersion, opts.NewStreamID,
+			newProjectID, opts.NewBucket, opts.NewEncryptedObjectKey, nextAvailableVersion, opts.NewStreamID,
 			sourceObject.ExpiresAt, sourceObject.SegmentCount,
 			encryptionParameters{&sourceObject.Encryption},
-			copyMetadata, opts.NewEncryptedMetadataKeyNonce, opts.NewEncryptedMetadataKey,
+			copyMetadata, sealed.Nonce, sealed.Key,
 			sourceObject.TotalPlainSize, sourceObject.TotalEncryptedSize, sourceObject.FixedSegmentSize,
 		)
 
@@ -274,6 +308,16 @@ func (db *DB) FinishCopyObject(ctx context.Context, opts FinishCopyObject) (obje
 			return Error.New("unable to copy object: %w", err)
 		}
 
+		copiedObject := newObject
+		copiedObject.ProjectID = newProjectID
+		copiedObject.BucketName = opts.NewBucket
+		copiedObject.Status = Committed
+		copiedObject.EncryptedMetadata = copyMetadata
+		deltas.add(copiedObject, false)
+		if err := db.recordTallyDeltas(ctx, tx, deltas); err != nil {
+			return err
+		}
+
 		_, err = tx.ExecContext(ctx, `
 			INSERT INTO segments (
 				stream_id, position, expires_at,
@@ -323,6 +367,7 @@ func (db *DB) FinishCopyObject(ctx context.Context, opts FinishCopyObject) (obje
 	}
 
 	newObject.StreamID = opts.NewStreamID
+	newObject.ProjectID = newProjectID
 	newObject.BucketName = opts.NewBucket
 	newObject.ObjectKey = opts.NewEncryptedObjectKey
 	newObject.EncryptedMetadata = copyMetadata
@@ -331,6 +376,10 @@ func (db *DB) FinishCopyObject(ctx context.Context, opts FinishCopyObject) (obje
 		newObject.EncryptedMetadataNonce = opts.NewEncryptedMetadataKeyNonce[:]
 	}
 
+	if err := db.openObjectMetadata(ctx, &newObject); err != nil {
+		return Object{}, err
+	}
+
 	mon.Meter("finish_copy_object").Mark(1)
 
 	return newObject, nil
@@ -361,7 +410,7 @@ func getObjectAtCopySourceAndDestination(
 			SELECT status, max(version) AS version
 			FROM objects
 			WHERE
-				project_id  = $1 AND
+				project_id  = $7 AND
 				bucket_name = $5 AND
 				object_key  = $6
 			GROUP BY status
@@ -397,14 +446,15 @@ func getObjectAtCopySourceAndDestination(
 			(SELECT max(version) FROM destination_current_versions) AS highest_version
 		FROM objects
 		WHERE
-			project_id  = $1 AND
+			project_id  = $7 AND
 			bucket_name = $5 AND
 			object_key  = $6 AND
 			version     = (SELECT version FROM destination_current_versions
 							WHERE status = `+committedStatus+`)`,
 		sourceObject.ProjectID, sourceObject.Version,
 		[]byte(sourceObject.BucketName), sourceObject.ObjectKey,
-		opts.NewBucket, opts.NewEncryptedObjectKey)
+		opts.NewBucket, opts.NewEncryptedObjectKey,
+		opts.newProjectID())
 	if err != nil {
 		return Object{}, uuid.UUID{}, nil, 0, err
 	}
@@ -448,7 +498,7 @@ func getObjectAtCopySourceAndDestination(
 	if rows.Next() {
 		var _bogusBytes []byte
 		destinationObject = &Object{}
-		destinationObject.ProjectID = opts.ProjectID
+		destinationObject.ProjectID = opts.newProjectID()
 		destinationObject.BucketName = opts.NewBucket
 		destinationObject.ObjectKey = opts.NewEncryptedObjectKey
 		// There is an object at the destination.
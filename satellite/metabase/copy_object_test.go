@@ -630,6 +630,76 @@ func TestFinishCopyObject(t *testing.T) {
 			}.Check(ctx, t, db)
 		})
 
+		t.Run("finish copy object into another project", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			numberOfSegments := 3
+			copyStream := metabasetest.RandObjectStream()
+			require.NotEqual(t, obj.ProjectID, copyStream.ProjectID)
+
+			originalObj, _ := metabasetest.CreateTestObject{
+				CommitObject: &metabase.CommitObject{
+					ObjectStream:                  obj,
+					EncryptedMetadata:             testrand.Bytes(64),
+					EncryptedMetadataNonce:        testrand.Nonce().Bytes(),
+					EncryptedMetadataEncryptedKey: testrand.Bytes(265),
+				},
+			}.Run(ctx, t, db, obj, byte(numberOfSegments))
+
+			newSegmentKeys := make([]metabase.EncryptedKeyAndNonce, numberOfSegments)
+			for i := range newSegmentKeys {
+				newSegmentKeys[i] = metabasetest.RandEncryptedKeyAndNonce(i)
+			}
+
+			finishCopy := metabase.FinishCopyObject{
+				ObjectStream:          originalObj.ObjectStream,
+				NewProjectID:          copyStream.ProjectID,
+				NewBucket:             copyStream.BucketName,
+				NewStreamID:           copyStream.StreamID,
+				NewEncryptedObjectKey: copyStream.ObjectKey,
+				NewSegmentKeys:        newSegmentKeys,
+			}
+
+			metabasetest.FinishCopyObject{
+				Opts:     finishCopy,
+				ErrClass: &metabase.ErrInvalidRequest,
+				ErrText:  "OverrideMetadata is required when copying into another project",
+			}.Check(ctx, t, db)
+
+			finishCopy.OverrideMetadata = true
+			finishCopy.NewEncryptedMetadata = originalObj.EncryptedMetadata
+			finishCopy.NewEncryptedMetadataKeyNonce = testrand.Nonce()
+			finishCopy.NewEncryptedMetadataKey = testrand.Bytes(32)
+
+			copyObj, err := db.FinishCopyObject(ctx, finishCopy)
+			require.NoError(t, err)
+			require.Equal(t, copyStream.ProjectID, copyObj.ProjectID)
+			require.Equal(t, originalObj.EncryptedMetadata, copyObj.EncryptedMetadata)
+
+			state, err := db.TestingGetState(ctx)
+			require.NoError(t, err)
+			require.Len(t, state.Objects, 2)
+			require.Len(t, state.Segments, 2*numberOfSegments)
+			require.Equal(t, []metabase.RawCopy{{
+				StreamID:         copyStream.StreamID,
+				AncestorStreamID: originalObj.StreamID,
+			}}, state.Copies)
+
+			// the copy is visible only in the new project.
+			_, err = db.GetObjectLastCommitted(ctx, metabase.GetObjectLastCommitted{
+				ObjectLocation: copyStream.Location(),
+			})
+			require.NoError(t, err)
+			_, err = db.GetObjectLastCommitted(ctx, metabase.GetObjectLastCommitted{
+				ObjectLocation: metabase.ObjectLocation{
+					ProjectID:  obj.ProjectID,
+					BucketName: copyStream.BucketName,
+					ObjectKey:  copyStream.ObjectKey,
+				},
+			})
+			require.True(t, storj.ErrObjectNotFound.Has(err))
+		})
+
 		t.Run("finish copy object to already existing destination", func(t *testing.T) {
 			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
 
@@ -20,6 +20,7 @@ import (
 	"storj.io/private/dbutil"
 	"storj.io/private/dbutil/pgutil"
 	"storj.io/private/tagsql"
+	"storj.io/storj/private/dbpool"
 	"storj.io/storj/private/migrate"
 )
 
@@ -37,6 +38,18 @@ type Config struct {
 	ServerSideCopy         bool
 	ServerSideCopyDisabled bool
 	MultipleVersions       bool
+
+	// TallyDeltas enables recording the changes of the committed objects of
+	// buckets, so tally doesn't need to scan all objects every time.
+	TallyDeltas bool
+
+	// SlowQueryThreshold is the duration after which queries are logged as
+	// slow, zero disables the logging.
+	SlowQueryThreshold time.Duration
+
+	// KeyManager enables encrypting the object metadata at rest with per
+	// project keys, nil stores the metadata as sent by the uplink.
+	KeyManager KeyManager
 }
 
 // DB implements a database for storing objects and segments.
@@ -48,6 +61,8 @@ type DB struct {
 
 	aliasCache *NodeAliasCache
 
+	instrumentation *instrumentation
+
 	testCleanup func() error
 
 	config Config
@@ -69,6 +84,11 @@ func Open(ctx context.Context, log *zap.Logger, connstr string, config Config) (
 		return nil, Error.New("unsupported implementation: %s", connstr)
 	}
 
+	connstr, poolOpts, err := dbpool.Split(connstr)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
 	connstr, err = pgutil.CheckApplicationName(connstr, config.ApplicationName)
 	if err != nil {
 		return nil, Error.Wrap(err)
@@ -79,14 +99,18 @@ func Open(ctx context.Context, log *zap.Logger, connstr string, config Config) (
 		return nil, Error.Wrap(err)
 	}
 	dbutil.Configure(ctx, rawdb, "metabase", mon)
+	poolOpts.Configure(rawdb)
+
+	instrumented := newInstrumentedDB(log.Named("query"), rawdb, config.SlowQueryThreshold)
 
 	db := &DB{
-		log:         log,
-		db:          postgresRebind{rawdb},
-		connstr:     connstr,
-		impl:        impl,
-		testCleanup: func() error { return nil },
-		config:      config,
+		log:             log,
+		db:              postgresRebind{instrumented},
+		connstr:         connstr,
+		impl:            impl,
+		instrumentation: instrumented.instrumentation,
+		testCleanup:     func() error { return nil },
+		config:          config,
 	}
 	db.aliasCache = NewNodeAliasCache(db)
 
@@ -98,6 +122,15 @@ func Open(ctx context.Context, log *zap.Logger, connstr string, config Config) (
 // Implementation rturns the database implementation.
 func (db *DB) Implementation() dbutil.Implementation { return db.impl }
 
+// QueryLatency returns the moving average of the latency of the recent
+// queries, or zero when there haven't been any.
+func (db *DB) QueryLatency() time.Duration {
+	if db == nil || db.instrumentation == nil {
+		return 0
+	}
+	return db.instrumentation.Latency()
+}
+
 // UnderlyingTagSQL returns *tagsql.DB.
 // TODO: remove.
 func (db *DB) UnderlyingTagSQL() tagsql.DB { return db.db }
@@ -171,7 +204,7 @@ func (db *DB) TestMigrateToLatest(ctx context.Context) error {
 			{
 				DB:          &db.db,
 				Description: "Test snapshot",
-				Version:     15,
+				Version:     23,
 				Action: migrate.SQL{
 
 					`CREATE TABLE objects (
@@ -199,6 +232,8 @@ func (db *DB) TestMigrateToLatest(ctx context.Context) error {
 
 						zombie_deletion_deadline TIMESTAMPTZ default now() + '1 day',
 
+						uploader_key_id BYTEA default NULL,
+
 						PRIMARY KEY (project_id, bucket_name, object_key, version)
 					);
 					CREATE TABLE segments (
@@ -221,10 +256,14 @@ func (db *DB) TestMigrateToLatest(ctx context.Context) error {
 						created_at TIMESTAMPTZ DEFAULT now() NOT NULL,
 						repaired_at TIMESTAMPTZ,
 						expires_at TIMESTAMPTZ,
+						last_audited_at TIMESTAMPTZ,
+						last_verified_at TIMESTAMPTZ,
 
 						placement integer,
 						encrypted_etag BYTEA default NULL,
 
+						spread_score INT4,
+
 						PRIMARY KEY (stream_id, position)
 					);
 					CREATE SEQUENCE node_alias_seq
@@ -242,7 +281,55 @@ func (db *DB) TestMigrateToLatest(ctx context.Context) error {
 
 						CONSTRAINT not_self_ancestor CHECK (stream_id != ancestor_stream_id)
 					);
-					CREATE INDEX ON segment_copies (ancestor_stream_id);`,
+					CREATE INDEX ON segment_copies (ancestor_stream_id);
+
+					CREATE TABLE bucket_tally_deltas (
+						project_id  BYTEA NOT NULL,
+						bucket_name BYTEA NOT NULL,
+						id          BYTEA NOT NULL,
+
+						created_at TIMESTAMPTZ NOT NULL default now(),
+
+						object_count   INT8 NOT NULL default 0,
+						total_segments INT8 NOT NULL default 0,
+						total_bytes    INT8 NOT NULL default 0,
+						metadata_size  INT8 NOT NULL default 0,
+
+						PRIMARY KEY (project_id, bucket_name, id)
+					);
+
+					CREATE TABLE project_encryption_keys (
+						project_id    BYTEA NOT NULL PRIMARY KEY,
+						encrypted_key BYTEA NOT NULL,
+						created_at    TIMESTAMPTZ NOT NULL default now()
+					);
+
+					CREATE TABLE bucket_lifecycles (
+						project_id  BYTEA NOT NULL,
+						bucket_name BYTEA NOT NULL,
+
+						transition_days      INT4 NOT NULL default 0,
+						transition_placement INT4 NOT NULL default 0,
+						expiration_days      INT4 NOT NULL default 0,
+
+						created_at TIMESTAMPTZ NOT NULL default now(),
+
+						PRIMARY KEY (project_id, bucket_name)
+					);
+
+					CREATE TABLE idempotency_keys (
+						project_id   BYTEA NOT NULL,
+						method       TEXT  NOT NULL,
+						key          TEXT  NOT NULL,
+						request_hash BYTEA NOT NULL,
+						response     BYTEA,
+
+						created_at TIMESTAMPTZ NOT NULL default now(),
+						expires_at TIMESTAMPTZ NOT NULL,
+
+						PRIMARY KEY (project_id, method, key)
+					);
+					CREATE INDEX idempotency_keys_expires_at_index ON idempotency_keys (expires_at);`,
 				},
 			},
 		},
@@ -294,6 +381,12 @@ func (db *DB) CheckVersion(ctx context.Context) error {
 	return migration.ValidateVersions(ctx, db.log)
 }
 
+// MigrationPlan returns the migration steps, which MigrateToLatest would apply.
+func (db *DB) MigrationPlan(ctx context.Context) ([]migrate.PlannedStep, error) {
+	migration := db.PostgresMigration()
+	return migration.Plan(ctx, db.log)
+}
+
 // PostgresMigration returns steps needed for migrating postgres database.
 func (db *DB) PostgresMigration() *migrate.Migration {
 	// TODO: merge this with satellite migration code or a way to keep them in sync.
@@ -472,6 +565,110 @@ func (db *DB) PostgresMigration() *migrate.Migration {
 					`CREATE INDEX ON segment_copies (ancestor_stream_id)`,
 				},
 			},
+			{
+				DB:          &db.db,
+				Description: "add table for bucket tally deltas",
+				Version:     16,
+				Action: migrate.SQL{
+					`CREATE TABLE bucket_tally_deltas (
+						project_id  BYTEA NOT NULL,
+						bucket_name BYTEA NOT NULL,
+						id          BYTEA NOT NULL,
+
+						created_at TIMESTAMPTZ NOT NULL default now(),
+
+						object_count   INT8 NOT NULL default 0,
+						total_segments INT8 NOT NULL default 0,
+						total_bytes    INT8 NOT NULL default 0,
+						metadata_size  INT8 NOT NULL default 0,
+
+						PRIMARY KEY (project_id, bucket_name, id)
+					)`,
+				},
+			},
+			{
+				DB:          &db.db,
+				Description: "add table for the encryption keys of projects in server-side encryption mode",
+				Version:     17,
+				Action: migrate.SQL{
+					`CREATE TABLE project_encryption_keys (
+						project_id    BYTEA NOT NULL PRIMARY KEY,
+						encrypted_key BYTEA NOT NULL,
+						created_at    TIMESTAMPTZ NOT NULL default now()
+					)`,
+				},
+			},
+			{
+				DB:          &db.db,
+				Description: "add last_audited_at column to segments table",
+				Version:     18,
+				Action: migrate.SQL{
+					`ALTER TABLE segments ADD COLUMN last_audited_at TIMESTAMPTZ`,
+				},
+			},
+			{
+				DB:          &db.db,
+				Description: "add uploader_key_id column to objects table",
+				Version:     19,
+				Action: migrate.SQL{
+					`ALTER TABLE objects ADD COLUMN uploader_key_id BYTEA default NULL`,
+				},
+			},
+			{
+				DB:          &db.db,
+				Description: "add table for the lifecycle configurations of buckets",
+				Version:     20,
+				Action: migrate.SQL{
+					`CREATE TABLE bucket_lifecycles (
+						project_id  BYTEA NOT NULL,
+						bucket_name BYTEA NOT NULL,
+
+						transition_days      INT4 NOT NULL default 0,
+						transition_placement INT4 NOT NULL default 0,
+						expiration_days      INT4 NOT NULL default 0,
+
+						created_at TIMESTAMPTZ NOT NULL default now(),
+
+						PRIMARY KEY (project_id, bucket_name)
+					)`,
+				},
+			},
+			{
+				DB:          &db.db,
+				Description: "add table for the idempotency keys of requests",
+				Version:     21,
+				Action: migrate.SQL{
+					`CREATE TABLE idempotency_keys (
+						project_id   BYTEA NOT NULL,
+						method       TEXT  NOT NULL,
+						key          TEXT  NOT NULL,
+						request_hash BYTEA NOT NULL,
+						response     BYTEA,
+
+						created_at TIMESTAMPTZ NOT NULL default now(),
+						expires_at TIMESTAMPTZ NOT NULL,
+
+						PRIMARY KEY (project_id, method, key)
+					)`,
+					`CREATE INDEX idempotency_keys_expires_at_index ON idempotency_keys (expires_at)`,
+				},
+			},
+			{
+				DB:          &db.db,
+				Description: "add spread_score column to segments table",
+				Version:     22,
+				Action: migrate.SQL{
+					`ALTER TABLE segments ADD COLUMN spread_score INT4`,
+				},
+			},
+			{
+				DB:          &db.db,
+				Description: "add last_verified_at column to segments table",
+				Version:     23,
+				Action: migrate.SQL{
+					`ALTER TABLE segments ADD COLUMN last_verified_at TIMESTAMPTZ`,
+				},
+			},
 		},
 	}
 }
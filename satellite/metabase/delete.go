@@ -6,6 +6,8 @@ package metabase
 import (
 	"bytes"
 	"context"
+	"database/sql"
+	"errors"
 	"fmt"
 	"sort"
 	"time"
@@ -359,8 +361,13 @@ func (db *DB) DeleteObjectExactVersion(
 		}
 		return nil
 	})
+	if err != nil {
+		return DeleteObjectResult{}, err
+	}
+
+	db.openDeletedObjects(ctx, result.Objects)
 
-	return result, err
+	return result, nil
 }
 
 // implementation of DB.DeleteObjectExactVersion for re-use internally in metabase package.
@@ -406,6 +413,12 @@ func (db *DB) deleteObjectExactVersion(ctx context.Context, opts DeleteObjectExa
 		return DeleteObjectResult{}, err
 	}
 
+	deltas := tallyDeltas{}
+	deltas.addAll(result.Objects, true)
+	if err := db.recordTallyDeltas(ctx, tx, deltas); err != nil {
+		return DeleteObjectResult{}, err
+	}
+
 	mon.Meter("object_delete").Mark(len(result.Objects))
 	mon.Meter("segment_delete").Mark(len(result.Segments))
 
@@ -563,6 +576,8 @@ func (db *DB) DeletePendingObject(ctx context.Context, opts DeletePendingObject)
 	mon.Meter("object_delete").Mark(len(result.Objects))
 	mon.Meter("segment_delete").Mark(len(result.Segments))
 
+	db.openDeletedObjects(ctx, result.Objects)
+
 	return result, nil
 }
 
@@ -620,9 +635,17 @@ func (db *DB) DeleteObjectAnyStatusAllVersions(ctx context.Context, opts DeleteO
 		return DeleteObjectResult{}, storj.ErrObjectNotFound.Wrap(Error.New("no rows deleted"))
 	}
 
+	deltas := tallyDeltas{}
+	deltas.addAll(result.Objects, true)
+	if err := db.recordTallyDeltas(ctx, db.db, deltas); err != nil {
+		return DeleteObjectResult{}, err
+	}
+
 	mon.Meter("object_delete").Mark(len(result.Objects))
 	mon.Meter("segment_delete").Mark(len(result.Segments))
 
+	db.openDeletedObjects(ctx, result.Objects)
+
 	return result, nil
 }
 
@@ -698,9 +721,17 @@ func (db *DB) DeleteObjectsAllVersions(ctx context.Context, opts DeleteObjectsAl
 		return DeleteObjectResult{}, err
 	}
 
+	deltas := tallyDeltas{}
+	deltas.addAll(result.Objects, true)
+	if err := db.recordTallyDeltas(ctx, db.db, deltas); err != nil {
+		return DeleteObjectResult{}, err
+	}
+
 	mon.Meter("object_delete").Mark(len(result.Objects))
 	mon.Meter("segment_delete").Mark(len(result.Segments))
 
+	db.openDeletedObjects(ctx, result.Objects)
+
 	return result, nil
 }
 
@@ -877,11 +908,21 @@ func (db *DB) scanMultipleObjectsDeletion(ctx context.Context, rows tagsql.Rows)
 // DeleteObjectLastCommitted contains arguments necessary for deleting last committed version of object.
 type DeleteObjectLastCommitted struct {
 	ObjectLocation
+
+	// Precondition is checked against the last committed object within the
+	// delete transaction. Only IfMatch is supported.
+	Precondition Precondition
 }
 
 // Verify delete object last committed fields.
 func (obj *DeleteObjectLastCommitted) Verify() error {
-	return obj.ObjectLocation.Verify()
+	if err := obj.ObjectLocation.Verify(); err != nil {
+		return err
+	}
+	if obj.Precondition.IfNoneMatch {
+		return ErrInvalidRequest.New("IfNoneMatch is not supported for deletes")
+	}
+	return nil
 }
 
 // DeleteObjectLastCommitted deletes an object last committed version.
@@ -899,7 +940,13 @@ func (db *DB) DeleteObjectLastCommitted(
 		}
 		return nil
 	})
-	return result, err
+	if err != nil {
+		return DeleteObjectResult{}, err
+	}
+
+	db.openDeletedObjects(ctx, result.Objects)
+
+	return result, nil
 }
 
 // implementation of DB.DeleteObjectLastCommitted for re-use internally in metabase package.
@@ -910,6 +957,12 @@ func (db *DB) deleteObjectLastCommitted(ctx context.Context, opts DeleteObjectLa
 		return DeleteObjectResult{}, err
 	}
 
+	if !opts.Precondition.IsZero() {
+		if err := db.checkLastCommittedPrecondition(ctx, opts.ObjectLocation, opts.Precondition, tx); err != nil {
+			return DeleteObjectResult{}, err
+		}
+	}
+
 	if db.config.ServerSideCopy {
 		objects, err := db.deleteObjectLastCommittedServerSideCopy(ctx, opts, tx)
 		if err != nil {
@@ -945,12 +998,48 @@ func (db *DB) deleteObjectLastCommitted(ctx context.Context, opts DeleteObjectLa
 		return DeleteObjectResult{}, err
 	}
 
+	deltas := tallyDeltas{}
+	deltas.addAll(result.Objects, true)
+	if err := db.recordTallyDeltas(ctx, tx, deltas); err != nil {
+		return DeleteObjectResult{}, err
+	}
+
 	mon.Meter("object_delete").Mark(len(result.Objects))
 	mon.Meter("segment_delete").Mark(len(result.Segments))
 
 	return result, nil
 }
 
+// checkLastCommittedPrecondition checks the precondition against the last
+// committed object, which hasn't expired. The object row is locked until the
+// end of the transaction.
+func (db *DB) checkLastCommittedPrecondition(ctx context.Context, location ObjectLocation, precondition Precondition, tx tagsql.Tx) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var streamID uuid.UUID
+	err = tx.QueryRowContext(ctx, `
+		SELECT stream_id
+		FROM objects
+		WHERE
+			project_id   = $1 AND
+			bucket_name  = $2 AND
+			object_key   = $3 AND
+			status       = `+committedStatus+` AND
+			(expires_at IS NULL OR expires_at > now())
+		ORDER BY version DESC
+		LIMIT 1
+		FOR UPDATE
+	`, location.ProjectID, []byte(location.BucketName), location.ObjectKey).Scan(&streamID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return precondition.check(nil)
+		}
+		return Error.New("unable to query object: %w", err)
+	}
+
+	return precondition.check(&streamID)
+}
+
 func (db *DB) deleteObjectLastCommittedServerSideCopy(ctx context.Context, opts DeleteObjectLastCommitted, tx tagsql.Tx) (objects []deletedObjectInfo, err error) {
 	defer mon.Task()(&ctx)(&err)
 
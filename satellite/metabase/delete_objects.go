@@ -12,6 +12,7 @@ import (
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 
+	"storj.io/common/uuid"
 	"storj.io/private/dbutil/pgxutil"
 	"storj.io/private/tagsql"
 )
@@ -25,6 +26,9 @@ type DeleteExpiredObjects struct {
 	ExpiredBefore  time.Time
 	AsOfSystemTime time.Time
 	BatchSize      int
+
+	// Deleted is called with each batch of deleted objects, when set.
+	Deleted func(ctx context.Context, objects []Object)
 }
 
 // DeleteExpiredObjects deletes all objects that expired before expiredBefore.
@@ -35,7 +39,7 @@ func (db *DB) DeleteExpiredObjects(ctx context.Context, opts DeleteExpiredObject
 		query := `
 			SELECT
 				project_id, bucket_name, object_key, version, stream_id,
-				expires_at
+				expires_at, status, segment_count, total_encrypted_size
 			FROM objects
 			` + db.impl.AsOfSystemTime(opts.AsOfSystemTime) + `
 			WHERE
@@ -45,6 +49,7 @@ func (db *DB) DeleteExpiredObjects(ctx context.Context, opts DeleteExpiredObject
 			LIMIT $6;`
 
 		expiredObjects := make([]ObjectStream, 0, batchsize)
+		var deletedObjects []Object
 
 		scanErrClass := errs.Class("DB rows scan has failed")
 		err = withRows(db.db.QueryContext(ctx, query,
@@ -54,9 +59,10 @@ func (db *DB) DeleteExpiredObjects(ctx context.Context, opts DeleteExpiredObject
 		)(func(rows tagsql.Rows) error {
 			for rows.Next() {
 				var expiresAt time.Time
+				var object Object
 				err = rows.Scan(
 					&last.ProjectID, &last.BucketName, &last.ObjectKey, &last.Version, &last.StreamID,
-					&expiresAt)
+					&expiresAt, &object.Status, &object.SegmentCount, &object.TotalEncryptedSize)
 				if err != nil {
 					return scanErrClass.Wrap(err)
 				}
@@ -70,6 +76,12 @@ func (db *DB) DeleteExpiredObjects(ctx context.Context, opts DeleteExpiredObject
 					zap.Time("Expired At", expiresAt),
 				)
 				expiredObjects = append(expiredObjects, last)
+
+				if opts.Deleted != nil {
+					object.ObjectStream = last
+					object.ExpiresAt = &expiresAt
+					deletedObjects = append(deletedObjects, object)
+				}
 			}
 
 			return nil
@@ -89,6 +101,10 @@ func (db *DB) DeleteExpiredObjects(ctx context.Context, opts DeleteExpiredObject
 			return ObjectStream{}, nil
 		}
 
+		if opts.Deleted != nil && len(deletedObjects) > 0 {
+			opts.Deleted(ctx, deletedObjects)
+		}
+
 		return last, nil
 	})
 }
@@ -195,15 +211,37 @@ func (db *DB) deleteObjectsAndSegments(ctx context.Context, objects []ObjectStre
 		for _, obj := range objects {
 			obj := obj
 
+			// the removal of a committed object is recorded as a tally delta
+			// by the same statement, when tally deltas are enabled.
+			deltaID, err := uuid.New()
+			if err != nil {
+				return err
+			}
+
 			batch.Queue(`
 				WITH deleted_objects AS (
 					DELETE FROM objects
 					WHERE (project_id, bucket_name, object_key, version, stream_id) = ($1::BYTEA, $2, $3, $4, $5::BYTEA)
-					RETURNING stream_id
+					RETURNING project_id, bucket_name, status, segment_count, total_encrypted_size, encrypted_metadata
+				), deleted_segments AS (
+					DELETE FROM segments
+					WHERE segments.stream_id = $5::BYTEA
+					RETURNING 1
+				), recorded_deltas AS (
+					INSERT INTO bucket_tally_deltas (
+						project_id, bucket_name, id,
+						object_count, total_segments, total_bytes, metadata_size
+					)
+					SELECT
+						project_id, bucket_name, $6::BYTEA,
+						-1, -segment_count, -total_encrypted_size, -LENGTH(COALESCE(encrypted_metadata, ''))
+					FROM deleted_objects
+					WHERE $7::BOOL AND status = `+committedStatus+`
+					RETURNING 1
 				)
-				DELETE FROM segments
-				WHERE segments.stream_id = $5::BYTEA
-			`, obj.ProjectID, []byte(obj.BucketName), []byte(obj.ObjectKey), obj.Version, obj.StreamID)
+				SELECT count(*) FROM deleted_segments
+			`, obj.ProjectID, []byte(obj.BucketName), []byte(obj.ObjectKey), obj.Version, obj.StreamID,
+				deltaID, db.config.TallyDeltas)
 		}
 
 		results := conn.SendBatch(ctx, &batch)
@@ -213,10 +251,11 @@ func (db *DB) deleteObjectsAndSegments(ctx context.Context, objects []ObjectStre
 
 		var errlist errs.Group
 		for i := 0; i < batch.Len(); i++ {
-			result, err := results.Exec()
+			var affectedSegmentCount int64
+			err := results.QueryRow().Scan(&affectedSegmentCount)
 			errlist.Add(err)
 
-			if affectedSegmentCount := result.RowsAffected(); affectedSegmentCount > 0 {
+			if affectedSegmentCount > 0 {
 				// Note, this slightly miscounts objects without any segments
 				// there doesn't seem to be a simple work around for this.
 				// Luckily, this is used only for metrics, where it's not a
@@ -4,6 +4,7 @@
 package metabase_test
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -114,6 +115,36 @@ func TestDeleteExpiredObjects(t *testing.T) {
 			metabasetest.Verify{}.Check(ctx, t, db)
 		})
 
+		t.Run("deleted callback", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			expiresAt := time.Now().Add(-30 * 24 * time.Hour)
+			var expected []metabase.ObjectStream
+			for i := 0; i < 5; i++ {
+				object := metabasetest.CreateExpiredObject(ctx, t, db, metabasetest.RandObjectStream(), 3, expiresAt)
+				expected = append(expected, object.ObjectStream)
+			}
+
+			var deleted []metabase.ObjectStream
+			metabasetest.DeleteExpiredObjects{
+				Opts: metabase.DeleteExpiredObjects{
+					ExpiredBefore: time.Now(),
+					BatchSize:     2,
+					Deleted: func(ctx context.Context, objects []metabase.Object) {
+						require.LessOrEqual(t, len(objects), 2)
+						for _, object := range objects {
+							require.Equal(t, metabase.Committed, object.Status)
+							require.EqualValues(t, 3, object.SegmentCount)
+							deleted = append(deleted, object.ObjectStream)
+						}
+					},
+				},
+			}.Check(ctx, t, db)
+
+			require.ElementsMatch(t, expected, deleted)
+			metabasetest.Verify{}.Check(ctx, t, db)
+		})
+
 		t.Run("committed objects", func(t *testing.T) {
 			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
 
@@ -1400,5 +1400,55 @@ func TestDeleteObjectLastCommitted(t *testing.T) {
 				},
 			}.Check(ctx, t, db)
 		})
+		t.Run("Precondition", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			metabasetest.DeleteObjectLastCommitted{
+				Opts: metabase.DeleteObjectLastCommitted{
+					ObjectLocation: location,
+					Precondition:   metabase.Precondition{IfNoneMatch: true},
+				},
+				ErrClass: &metabase.ErrInvalidRequest,
+				ErrText:  "IfNoneMatch is not supported for deletes",
+			}.Check(ctx, t, db)
+
+			metabasetest.DeleteObjectLastCommitted{
+				Opts: metabase.DeleteObjectLastCommitted{
+					ObjectLocation: location,
+					Precondition:   metabase.Precondition{IfMatch: obj.StreamID},
+				},
+				ErrClass: &metabase.ErrPreconditionFailed,
+				ErrText:  "object doesn't exist",
+			}.Check(ctx, t, db)
+
+			object := metabasetest.CreateObject(ctx, t, db, obj, 0)
+
+			metabasetest.DeleteObjectLastCommitted{
+				Opts: metabase.DeleteObjectLastCommitted{
+					ObjectLocation: location,
+					Precondition:   metabase.Precondition{IfMatch: testrand.UUID()},
+				},
+				ErrClass: &metabase.ErrPreconditionFailed,
+				ErrText:  "object has been modified",
+			}.Check(ctx, t, db)
+
+			metabasetest.Verify{
+				Objects: []metabase.RawObject{
+					metabase.RawObject(object),
+				},
+			}.Check(ctx, t, db)
+
+			metabasetest.DeleteObjectLastCommitted{
+				Opts: metabase.DeleteObjectLastCommitted{
+					ObjectLocation: location,
+					Precondition:   metabase.Precondition{IfMatch: obj.StreamID},
+				},
+				Result: metabase.DeleteObjectResult{
+					Objects: []metabase.Object{object},
+				},
+			}.Check(ctx, t, db)
+
+			metabasetest.Verify{}.Check(ctx, t, db)
+		})
 	})
 }
@@ -52,6 +52,20 @@ func (s Segment) Expired(now time.Time) bool {
 	return s.ExpiresAt != nil && s.ExpiresAt.Before(now)
 }
 
+// expiredColumn selects whether the object is past its expiration, using the
+// clock of the database, so it's consistent with the listings.
+const expiredColumn = `(expires_at IS NOT NULL AND expires_at <= now())`
+
+// hideExpired reports whether an object must be treated as absent, because
+// it's past its expiration, even though the expired deletion chore hasn't
+// deleted it yet.
+func hideExpired(expired bool) bool {
+	if expired {
+		mon.Meter("expired_object_hidden").Mark(1)
+	}
+	return expired
+}
+
 // GetObjectExactVersion contains arguments necessary for fetching an information
 // about exact object version.
 type GetObjectExactVersion struct {
@@ -79,6 +93,7 @@ func (db *DB) GetObjectExactVersion(ctx context.Context, opts GetObjectExactVers
 	}
 
 	object := Object{}
+	var expired bool
 	err = db.db.QueryRowContext(ctx, `
 		SELECT
 			stream_id,
@@ -86,15 +101,15 @@ func (db *DB) GetObjectExactVersion(ctx context.Context, opts GetObjectExactVers
 			segment_count,
 			encrypted_metadata_nonce, encrypted_metadata, encrypted_metadata_encrypted_key,
 			total_plain_size, total_encrypted_size, fixed_segment_size,
-			encryption
+			encryption,
+			`+expiredColumn+`
 		FROM objects
 		WHERE
 			project_id   = $1 AND
 			bucket_name  = $2 AND
 			object_key   = $3 AND
 			version      = $4 AND
-			status       = `+committedStatus+` AND
-			(expires_at IS NULL OR expires_at > now())`,
+			status       = `+committedStatus,
 		opts.ProjectID, []byte(opts.BucketName), opts.ObjectKey, opts.Version).
 		Scan(
 			&object.StreamID,
@@ -103,7 +118,11 @@ func (db *DB) GetObjectExactVersion(ctx context.Context, opts GetObjectExactVers
 			&object.EncryptedMetadataNonce, &object.EncryptedMetadata, &object.EncryptedMetadataEncryptedKey,
 			&object.TotalPlainSize, &object.TotalEncryptedSize, &object.FixedSegmentSize,
 			encryptionParameters{&object.Encryption},
+			&expired,
 		)
+	if err == nil && hideExpired(expired) {
+		err = sql.ErrNoRows
+	}
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return Object{}, storj.ErrObjectNotFound.Wrap(Error.Wrap(err))
@@ -118,6 +137,10 @@ func (db *DB) GetObjectExactVersion(ctx context.Context, opts GetObjectExactVers
 
 	object.Status = Committed
 
+	if err := db.openObjectMetadata(ctx, &object); err != nil {
+		return Object{}, err
+	}
+
 	return object, nil
 }
 
@@ -144,19 +167,20 @@ func (db *DB) GetObjectLastCommitted(ctx context.Context, opts GetObjectLastComm
 			segment_count,
 			encrypted_metadata_nonce, encrypted_metadata, encrypted_metadata_encrypted_key,
 			total_plain_size, total_encrypted_size, fixed_segment_size,
-			encryption
+			encryption,
+			`+expiredColumn+`
 		FROM objects
 		WHERE
 			project_id   = $1 AND
 			bucket_name  = $2 AND
 			object_key   = $3 AND
-			status       = `+committedStatus+` AND
-			(expires_at IS NULL OR expires_at > now())
+			status       = `+committedStatus+`
 		ORDER BY version desc
 		`, opts.ProjectID, []byte(opts.BucketName), opts.ObjectKey))(func(rows tagsql.Rows) error {
 		objectFound := false
 		for rows.Next() {
 			var scannedObject Object
+			var expired bool
 			if err = rows.Scan(
 				&scannedObject.StreamID, &scannedObject.Version,
 				&scannedObject.CreatedAt, &scannedObject.ExpiresAt,
@@ -164,10 +188,15 @@ func (db *DB) GetObjectLastCommitted(ctx context.Context, opts GetObjectLastComm
 				&scannedObject.EncryptedMetadataNonce, &scannedObject.EncryptedMetadata, &scannedObject.EncryptedMetadataEncryptedKey,
 				&scannedObject.TotalPlainSize, &scannedObject.TotalEncryptedSize, &scannedObject.FixedSegmentSize,
 				encryptionParameters{&scannedObject.Encryption},
+				&expired,
 			); err != nil {
 				return Error.New("unable to query object status: %w", err)
 			}
 
+			if hideExpired(expired) {
+				continue
+			}
+
 			if objectFound {
 				db.log.Warn("object with multiple committed versions were found!",
 					zap.Stringer("Project ID", opts.ProjectID), zap.String("Bucket Name", opts.BucketName),
@@ -200,6 +229,10 @@ func (db *DB) GetObjectLastCommitted(ctx context.Context, opts GetObjectLastComm
 	object.ObjectKey = opts.ObjectKey
 	object.Status = Committed
 
+	if err := db.openObjectMetadata(ctx, &object); err != nil {
+		return Object{}, err
+	}
+
 	return object, nil
 }
 
@@ -234,7 +267,8 @@ func (db *DB) GetSegmentByPosition(ctx context.Context, opts GetSegmentByPositio
 			encrypted_etag,
 			redundancy,
 			inline_data, remote_alias_pieces,
-			placement
+			placement,
+			COALESCE(spread_score, 0)
 		FROM segments
 		WHERE
 			stream_id = $1 AND
@@ -248,6 +282,7 @@ func (db *DB) GetSegmentByPosition(ctx context.Context, opts GetSegmentByPositio
 			redundancyScheme{&segment.Redundancy},
 			&segment.InlineData, &aliasPieces,
 			&segment.Placement,
+			&segment.SpreadScore,
 		)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -299,14 +334,16 @@ func (db *DB) GetLatestObjectLastSegment(ctx context.Context, opts GetLatestObje
 			encrypted_etag,
 			redundancy,
 			inline_data, remote_alias_pieces,
-			placement
+			placement,
+			COALESCE(spread_score, 0)
 		FROM segments
 		WHERE
 			stream_id IN (SELECT stream_id FROM objects WHERE
 				project_id   = $1 AND
 				bucket_name  = $2 AND
 				object_key   = $3 AND
-				status       = `+committedStatus+`
+				status       = `+committedStatus+` AND
+				(expires_at IS NULL OR expires_at > now())
 				ORDER BY version DESC
 				LIMIT 1
 			)
@@ -322,6 +359,7 @@ func (db *DB) GetLatestObjectLastSegment(ctx context.Context, opts GetLatestObje
 			redundancyScheme{&segment.Redundancy},
 			&segment.InlineData, &aliasPieces,
 			&segment.Placement,
+			&segment.SpreadScore,
 		)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
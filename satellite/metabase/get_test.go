@@ -296,6 +296,28 @@ func TestGetObjectLastCommitted(t *testing.T) {
 			}}.Check(ctx, t, db)
 		})
 
+		t.Run("Get expired object", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+			expiresAt := now.Add(-2 * time.Hour)
+			metabasetest.CreateExpiredObject(ctx, t, db, obj, 0, expiresAt)
+			metabasetest.GetObjectLastCommitted{
+				Opts: metabase.GetObjectLastCommitted{
+					ObjectLocation: location,
+				},
+				ErrClass: &storj.ErrObjectNotFound,
+				ErrText:  "metabase: sql: no rows in result set",
+			}.Check(ctx, t, db)
+			metabasetest.Verify{Objects: []metabase.RawObject{
+				{
+					ObjectStream: obj,
+					CreatedAt:    now,
+					Status:       metabase.Committed,
+					ExpiresAt:    &expiresAt,
+					Encryption:   metabasetest.DefaultEncryption,
+				},
+			}}.Check(ctx, t, db)
+		})
+
 		t.Run("Get object last committed version from multiple", func(t *testing.T) {
 			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
 
@@ -1098,6 +1120,54 @@ func TestGetLatestObjectLastSegment(t *testing.T) {
 			}.Check(ctx, t, db)
 		})
 
+		t.Run("Get expired object", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			expiresAt := now.Add(-2 * time.Hour)
+			metabasetest.CreateExpiredObject(ctx, t, db, obj, 1, expiresAt)
+
+			metabasetest.GetLatestObjectLastSegment{
+				Opts: metabase.GetLatestObjectLastSegment{
+					ObjectLocation: location,
+				},
+				ErrClass: &storj.ErrObjectNotFound,
+				ErrText:  "metabase: object or segment missing",
+			}.Check(ctx, t, db)
+
+			metabasetest.Verify{
+				Objects: []metabase.RawObject{
+					{
+						ObjectStream: obj,
+						CreatedAt:    now,
+						ExpiresAt:    &expiresAt,
+						Status:       metabase.Committed,
+						SegmentCount: 1,
+
+						TotalPlainSize:     512,
+						TotalEncryptedSize: 1024,
+						FixedSegmentSize:   512,
+
+						Encryption: metabasetest.DefaultEncryption,
+					},
+				},
+				Segments: []metabase.RawSegment{
+					{
+						StreamID:          obj.StreamID,
+						CreatedAt:         now,
+						ExpiresAt:         &expiresAt,
+						RootPieceID:       storj.PieceID{1},
+						EncryptedKey:      []byte{3},
+						EncryptedKeyNonce: []byte{4},
+						EncryptedETag:     []byte{5},
+						EncryptedSize:     1024,
+						PlainSize:         512,
+						Pieces:            metabase.Pieces{{Number: 0, StorageNode: storj.NodeID{2}}},
+						Redundancy:        metabasetest.DefaultRedundancy,
+					},
+				},
+			}.Check(ctx, t, db)
+		})
+
 		t.Run("Get segment copy", func(t *testing.T) {
 			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
 
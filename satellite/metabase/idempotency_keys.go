@@ -0,0 +1,239 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+)
+
+var (
+	// ErrIdempotencyKeyReused is an error class for idempotency keys, which
+	// are reused for a different request.
+	ErrIdempotencyKeyReused = errs.Class("idempotency key reused")
+	// ErrIdempotencyKeyInProgress is an error class for idempotency keys of
+	// requests, which are still being handled.
+	ErrIdempotencyKeyInProgress = errs.Class("idempotency key in progress")
+)
+
+// MaxIdempotencyKeyLength is the maximum length of an idempotency key.
+const MaxIdempotencyKeyLength = 128
+
+// IdempotencyKey identifies a request of a client to a method.
+type IdempotencyKey struct {
+	ProjectID uuid.UUID
+	Method    string
+	Key       string
+}
+
+// Verify verifies the idempotency key fields.
+func (key IdempotencyKey) Verify() error {
+	switch {
+	case key.ProjectID.IsZero():
+		return ErrInvalidRequest.New("ProjectID missing")
+	case key.Method == "":
+		return ErrInvalidRequest.New("Method missing")
+	case key.Key == "":
+		return ErrInvalidRequest.New("Key missing")
+	case len(key.Key) > MaxIdempotencyKeyLength:
+		return ErrInvalidRequest.New("Key is longer than %d bytes", MaxIdempotencyKeyLength)
+	}
+	return nil
+}
+
+// ReserveIdempotencyKey contains arguments necessary for reserving an
+// idempotency key for a request.
+type ReserveIdempotencyKey struct {
+	IdempotencyKey
+
+	// RequestHash identifies the request, so the key can't be reused for a
+	// different one.
+	RequestHash []byte
+	// TTL is how long the key and the response are kept.
+	TTL time.Duration
+	// ReservationTimeout is how long a request can be handled, before its
+	// reservation is taken over by a retry. Zero only uses the TTL.
+	ReservationTimeout time.Duration
+}
+
+// IdempotencyKeyReservation is the result of reserving an idempotency key.
+type IdempotencyKeyReservation struct {
+	// Reserved is true, when the request has to be handled. Otherwise
+	// Response is the response of the earlier request with the same key.
+	Reserved bool
+	Response []byte
+}
+
+// ReserveIdempotencyKey reserves the idempotency key for handling the
+// request, unless an earlier request with the same key has been handled.
+// Expired keys and reservations, which timed out, are reserved again.
+func (db *DB) ReserveIdempotencyKey(ctx context.Context, opts ReserveIdempotencyKey) (_ IdempotencyKeyReservation, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := opts.Verify(); err != nil {
+		return IdempotencyKeyReservation{}, err
+	}
+	if opts.TTL <= 0 {
+		return IdempotencyKeyReservation{}, ErrInvalidRequest.New("TTL must be positive")
+	}
+
+	now := time.Now()
+	reservationTimeout := opts.ReservationTimeout
+	if reservationTimeout <= 0 || reservationTimeout > opts.TTL {
+		reservationTimeout = opts.TTL
+	}
+
+	var reserved bool
+	err = db.db.QueryRowContext(ctx, `
+		INSERT INTO idempotency_keys (project_id, method, key, request_hash, created_at, expires_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (project_id, method, key) DO UPDATE SET
+			request_hash = EXCLUDED.request_hash,
+			response     = NULL,
+			created_at   = EXCLUDED.created_at,
+			expires_at   = EXCLUDED.expires_at
+		WHERE idempotency_keys.expires_at <= $5
+			OR (idempotency_keys.response IS NULL AND idempotency_keys.created_at <= $7)
+		RETURNING true
+	`, opts.ProjectID, opts.Method, opts.Key, opts.RequestHash, now, now.Add(opts.TTL), now.Add(-reservationTimeout)).Scan(&reserved)
+	switch {
+	case err == nil:
+		return IdempotencyKeyReservation{Reserved: true}, nil
+	case !errors.Is(err, sql.ErrNoRows):
+		return IdempotencyKeyReservation{}, Error.New("unable to reserve idempotency key: %w", err)
+	}
+
+	var requestHash, response []byte
+	var handled bool
+	err = db.db.QueryRowContext(ctx, `
+		SELECT request_hash, response, response IS NOT NULL
+		FROM idempotency_keys
+		WHERE (project_id, method, key) = ($1, $2, $3)
+	`, opts.ProjectID, opts.Method, opts.Key).Scan(&requestHash, &response, &handled)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			// the key was released concurrently, so the client can retry.
+			return IdempotencyKeyReservation{}, ErrIdempotencyKeyInProgress.New("%s", opts.Key)
+		}
+		return IdempotencyKeyReservation{}, Error.New("unable to query idempotency key: %w", err)
+	}
+
+	if !bytes.Equal(requestHash, opts.RequestHash) {
+		return IdempotencyKeyReservation{}, ErrIdempotencyKeyReused.New("%s", opts.Key)
+	}
+	if !handled {
+		return IdempotencyKeyReservation{}, ErrIdempotencyKeyInProgress.New("%s", opts.Key)
+	}
+	if response == nil {
+		response = []byte{}
+	}
+
+	mon.Meter("idempotency_key_replayed").Mark(1)
+	return IdempotencyKeyReservation{Response: response}, nil
+}
+
+// StoreIdempotentResponse contains arguments necessary for storing the
+// response of a request with a reserved idempotency key.
+type StoreIdempotentResponse struct {
+	IdempotencyKey
+
+	Response []byte
+}
+
+// StoreIdempotentResponse stores the response of the request, which
+// reserved the idempotency key.
+func (db *DB) StoreIdempotentResponse(ctx context.Context, opts StoreIdempotentResponse) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := opts.Verify(); err != nil {
+		return err
+	}
+	if opts.Response == nil {
+		// an empty response has to differ from the missing one.
+		opts.Response = []byte{}
+	}
+
+	_, err = db.db.ExecContext(ctx, `
+		UPDATE idempotency_keys
+		SET response = $4
+		WHERE (project_id, method, key) = ($1, $2, $3)
+			AND response IS NULL
+	`, opts.ProjectID, opts.Method, opts.Key, opts.Response)
+	if err != nil {
+		return Error.New("unable to store idempotent response: %w", err)
+	}
+	return nil
+}
+
+// ReleaseIdempotencyKey contains arguments necessary for releasing an
+// idempotency key.
+type ReleaseIdempotencyKey struct {
+	IdempotencyKey
+}
+
+// ReleaseIdempotencyKey releases the idempotency key of a failed request,
+// so the request can be retried with the same key.
+func (db *DB) ReleaseIdempotencyKey(ctx context.Context, opts ReleaseIdempotencyKey) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := opts.Verify(); err != nil {
+		return err
+	}
+
+	_, err = db.db.ExecContext(ctx, `
+		DELETE FROM idempotency_keys
+		WHERE (project_id, method, key) = ($1, $2, $3)
+			AND response IS NULL
+	`, opts.ProjectID, opts.Method, opts.Key)
+	if err != nil {
+		return Error.New("unable to release idempotency key: %w", err)
+	}
+	return nil
+}
+
+// DeleteExpiredIdempotencyKeys contains arguments necessary for deleting
+// expired idempotency keys.
+type DeleteExpiredIdempotencyKeys struct {
+	ExpiredBefore time.Time
+	BatchSize     int
+}
+
+// DeleteExpiredIdempotencyKeys deletes the idempotency keys, which expired
+// before ExpiredBefore, in batches.
+func (db *DB) DeleteExpiredIdempotencyKeys(ctx context.Context, opts DeleteExpiredIdempotencyKeys) (deleted int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	deleteBatchsizeLimit.Ensure(&opts.BatchSize)
+
+	for {
+		result, err := db.db.ExecContext(ctx, `
+			DELETE FROM idempotency_keys
+			WHERE (project_id, method, key) IN (
+				SELECT project_id, method, key
+				FROM idempotency_keys
+				WHERE expires_at < $1
+				LIMIT $2
+			)
+		`, opts.ExpiredBefore, opts.BatchSize)
+		if err != nil {
+			return deleted, Error.New("unable to delete expired idempotency keys: %w", err)
+		}
+		affected, err := result.RowsAffected()
+		if err != nil {
+			return deleted, Error.New("unable to delete expired idempotency keys: %w", err)
+		}
+		deleted += affected
+
+		if affected < int64(opts.BatchSize) {
+			return deleted, nil
+		}
+	}
+}
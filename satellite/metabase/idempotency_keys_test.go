@@ -0,0 +1,109 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/metabasetest"
+)
+
+func TestIdempotencyKeys(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		key := metabase.IdempotencyKey{ProjectID: testrand.UUID(), Method: "BeginObject", Key: "key"}
+		hash := testrand.Bytes(32)
+
+		for _, invalid := range []metabase.ReserveIdempotencyKey{
+			{IdempotencyKey: metabase.IdempotencyKey{Method: "BeginObject", Key: "key"}, TTL: time.Hour},
+			{IdempotencyKey: metabase.IdempotencyKey{ProjectID: key.ProjectID, Key: "key"}, TTL: time.Hour},
+			{IdempotencyKey: metabase.IdempotencyKey{ProjectID: key.ProjectID, Method: "BeginObject"}, TTL: time.Hour},
+			{IdempotencyKey: metabase.IdempotencyKey{ProjectID: key.ProjectID, Method: "BeginObject", Key: strings.Repeat("k", 129)}, TTL: time.Hour},
+			{IdempotencyKey: key},
+		} {
+			_, err := db.ReserveIdempotencyKey(ctx, invalid)
+			require.True(t, metabase.ErrInvalidRequest.Has(err), err)
+		}
+
+		reserve := metabase.ReserveIdempotencyKey{IdempotencyKey: key, RequestHash: hash, TTL: time.Hour}
+		reservation, err := db.ReserveIdempotencyKey(ctx, reserve)
+		require.NoError(t, err)
+		require.True(t, reservation.Reserved)
+
+		// the request is still being handled.
+		_, err = db.ReserveIdempotencyKey(ctx, reserve)
+		require.True(t, metabase.ErrIdempotencyKeyInProgress.Has(err), err)
+
+		// a failed request releases the key.
+		require.NoError(t, db.ReleaseIdempotencyKey(ctx, metabase.ReleaseIdempotencyKey{IdempotencyKey: key}))
+		reservation, err = db.ReserveIdempotencyKey(ctx, reserve)
+		require.NoError(t, err)
+		require.True(t, reservation.Reserved)
+
+		require.NoError(t, db.StoreIdempotentResponse(ctx, metabase.StoreIdempotentResponse{
+			IdempotencyKey: key,
+			Response:       []byte("response"),
+		}))
+
+		reservation, err = db.ReserveIdempotencyKey(ctx, reserve)
+		require.NoError(t, err)
+		require.False(t, reservation.Reserved)
+		require.Equal(t, []byte("response"), reservation.Response)
+
+		// a handled request isn't released.
+		require.NoError(t, db.ReleaseIdempotencyKey(ctx, metabase.ReleaseIdempotencyKey{IdempotencyKey: key}))
+
+		// the key can't be reused for a different request.
+		_, err = db.ReserveIdempotencyKey(ctx, metabase.ReserveIdempotencyKey{IdempotencyKey: key, RequestHash: testrand.Bytes(32), TTL: time.Hour})
+		require.True(t, metabase.ErrIdempotencyKeyReused.Has(err), err)
+
+		// the same key of a different method is independent.
+		otherMethod := key
+		otherMethod.Method = "CommitObject"
+		reservation, err = db.ReserveIdempotencyKey(ctx, metabase.ReserveIdempotencyKey{IdempotencyKey: otherMethod, RequestHash: hash, TTL: time.Hour})
+		require.NoError(t, err)
+		require.True(t, reservation.Reserved)
+
+		// an empty response is replayed as well.
+		require.NoError(t, db.StoreIdempotentResponse(ctx, metabase.StoreIdempotentResponse{IdempotencyKey: otherMethod}))
+		reservation, err = db.ReserveIdempotencyKey(ctx, metabase.ReserveIdempotencyKey{IdempotencyKey: otherMethod, RequestHash: hash, TTL: time.Hour})
+		require.NoError(t, err)
+		require.False(t, reservation.Reserved)
+		require.Empty(t, reservation.Response)
+
+		// a reservation, which timed out, is taken over.
+		timedOut := key
+		timedOut.Key = "timed out"
+		reservation, err = db.ReserveIdempotencyKey(ctx, metabase.ReserveIdempotencyKey{IdempotencyKey: timedOut, RequestHash: hash, TTL: time.Hour})
+		require.NoError(t, err)
+		require.True(t, reservation.Reserved)
+		reservation, err = db.ReserveIdempotencyKey(ctx, metabase.ReserveIdempotencyKey{IdempotencyKey: timedOut, RequestHash: hash, TTL: time.Hour, ReservationTimeout: time.Nanosecond})
+		require.NoError(t, err)
+		require.True(t, reservation.Reserved)
+
+		deleted, err := db.DeleteExpiredIdempotencyKeys(ctx, metabase.DeleteExpiredIdempotencyKeys{
+			ExpiredBefore: time.Now(),
+		})
+		require.NoError(t, err)
+		require.Zero(t, deleted)
+
+		deleted, err = db.DeleteExpiredIdempotencyKeys(ctx, metabase.DeleteExpiredIdempotencyKeys{
+			ExpiredBefore: time.Now().Add(2 * time.Hour),
+			BatchSize:     2,
+		})
+		require.NoError(t, err)
+		require.EqualValues(t, 3, deleted)
+
+		// an expired key is reserved again.
+		reservation, err = db.ReserveIdempotencyKey(ctx, reserve)
+		require.NoError(t, err)
+		require.True(t, reservation.Reserved)
+	})
+}
@@ -0,0 +1,204 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"go.uber.org/zap"
+
+	"storj.io/private/tagsql"
+)
+
+// instrumentedDB measures the latency of every query and logs the slow ones.
+//
+// Queries are tagged with the operation, which is the metabase method the
+// query was issued from. For Query methods only the time until the first
+// rows are available is measured.
+type instrumentedDB struct {
+	tagsql.DB
+	instrumentation *instrumentation
+}
+
+// instrumentedTx measures the queries issued in a transaction.
+type instrumentedTx struct {
+	tagsql.Tx
+	instrumentation *instrumentation
+}
+
+// latencyWeight is the inverse weight of a single query in the latency
+// estimate.
+const latencyWeight = 16
+
+// latencyStaleAfter is how long the latency estimate is used without any new
+// queries.
+const latencyStaleAfter = 10 * time.Second
+
+type instrumentation struct {
+	log           *zap.Logger
+	slowThreshold time.Duration
+
+	mu      sync.Mutex
+	latency time.Duration
+	updated time.Time
+}
+
+func newInstrumentedDB(log *zap.Logger, db tagsql.DB, slowThreshold time.Duration) instrumentedDB {
+	return instrumentedDB{
+		DB: db,
+		instrumentation: &instrumentation{
+			log:           log,
+			slowThreshold: slowThreshold,
+		},
+	}
+}
+
+// ExecContext implements tagsql.DB.
+func (db instrumentedDB) ExecContext(ctx context.Context, query string, args ...interface{}) (_ sql.Result, err error) {
+	defer db.instrumentation.observe(ctx, time.Now(), query, args, &err)
+	return db.DB.ExecContext(ctx, query, args...)
+}
+
+// QueryContext implements tagsql.DB.
+func (db instrumentedDB) QueryContext(ctx context.Context, query string, args ...interface{}) (_ tagsql.Rows, err error) {
+	defer db.instrumentation.observe(ctx, time.Now(), query, args, &err)
+	return db.DB.QueryContext(ctx, query, args...)
+}
+
+// QueryRowContext implements tagsql.DB.
+func (db instrumentedDB) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	start := time.Now()
+	row := db.DB.QueryRowContext(ctx, query, args...)
+	err := row.Err()
+	db.instrumentation.observe(ctx, start, query, args, &err)
+	return row
+}
+
+// Exec implements tagsql.DB.
+func (db instrumentedDB) Exec(ctx context.Context, query string, args ...interface{}) (_ sql.Result, err error) {
+	defer db.instrumentation.observe(ctx, time.Now(), query, args, &err)
+	return db.DB.Exec(ctx, query, args...)
+}
+
+// Query implements tagsql.DB.
+func (db instrumentedDB) Query(ctx context.Context, query string, args ...interface{}) (_ tagsql.Rows, err error) {
+	defer db.instrumentation.observe(ctx, time.Now(), query, args, &err)
+	return db.DB.Query(ctx, query, args...)
+}
+
+// BeginTx implements tagsql.DB.
+func (db instrumentedDB) BeginTx(ctx context.Context, txOptions *sql.TxOptions) (tagsql.Tx, error) {
+	tx, err := db.DB.BeginTx(ctx, txOptions)
+	if err != nil {
+		return nil, err
+	}
+	return instrumentedTx{Tx: tx, instrumentation: db.instrumentation}, nil
+}
+
+// ExecContext implements tagsql.Tx.
+func (tx instrumentedTx) ExecContext(ctx context.Context, query string, args ...interface{}) (_ sql.Result, err error) {
+	defer tx.instrumentation.observe(ctx, time.Now(), query, args, &err)
+	return tx.Tx.ExecContext(ctx, query, args...)
+}
+
+// QueryContext implements tagsql.Tx.
+func (tx instrumentedTx) QueryContext(ctx context.Context, query string, args ...interface{}) (_ tagsql.Rows, err error) {
+	defer tx.instrumentation.observe(ctx, time.Now(), query, args, &err)
+	return tx.Tx.QueryContext(ctx, query, args...)
+}
+
+// QueryRowContext implements tagsql.Tx.
+func (tx instrumentedTx) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	start := time.Now()
+	row := tx.Tx.QueryRowContext(ctx, query, args...)
+	err := row.Err()
+	tx.instrumentation.observe(ctx, start, query, args, &err)
+	return row
+}
+
+// observe records the latency of the query and logs it, when it's slower
+// than the threshold. Only the shapes of the arguments are logged, since the
+// values may contain user data.
+func (instr *instrumentation) observe(ctx context.Context, start time.Time, query string, args []interface{}, errp *error) {
+	now := time.Now()
+	duration := now.Sub(start)
+	operation := queryOperation(ctx)
+	tag := monkit.NewSeriesTag("operation", operation)
+
+	instr.mu.Lock()
+	if now.Sub(instr.updated) > latencyStaleAfter {
+		instr.latency = duration
+	} else {
+		instr.latency += (duration - instr.latency) / latencyWeight
+	}
+	instr.updated = now
+	instr.mu.Unlock()
+
+	mon.DurationVal("metabase_query_duration", tag).Observe(duration)
+	if *errp != nil && !errors.Is(*errp, sql.ErrNoRows) {
+		mon.Counter("metabase_query_errors", tag).Inc(1)
+	}
+
+	if instr.slowThreshold <= 0 || duration < instr.slowThreshold {
+		return
+	}
+
+	mon.Counter("metabase_slow_queries", tag).Inc(1)
+	instr.log.Warn("slow query",
+		zap.String("operation", operation),
+		zap.Duration("duration", duration),
+		zap.String("query", strings.Join(strings.Fields(query), " ")),
+		zap.Strings("args", argShapes(args)),
+	)
+}
+
+// Latency returns the moving average of the query latency. It returns zero,
+// when there haven't been any recent queries.
+func (instr *instrumentation) Latency() time.Duration {
+	instr.mu.Lock()
+	defer instr.mu.Unlock()
+
+	if time.Since(instr.updated) > latencyStaleAfter {
+		return 0
+	}
+	return instr.latency
+}
+
+// queryOperation returns the name of the function, which issued the query.
+func queryOperation(ctx context.Context) string {
+	span := monkit.SpanFromCtx(ctx)
+	if span == nil {
+		return "unknown"
+	}
+	return span.Func().ShortName()
+}
+
+// argShapes describes the arguments of a query without their values, e.g.
+// "[]uint8(len=16)" for a byte slice.
+func argShapes(args []interface{}) []string {
+	shapes := make([]string, 0, len(args))
+	for _, arg := range args {
+		if arg == nil {
+			shapes = append(shapes, "nil")
+			continue
+		}
+
+		value := reflect.ValueOf(arg)
+		switch value.Kind() {
+		case reflect.Slice, reflect.Map, reflect.String:
+			shapes = append(shapes, fmt.Sprintf("%T(len=%d)", arg, value.Len()))
+		default:
+			shapes = append(shapes, fmt.Sprintf("%T", arg))
+		}
+	}
+	return shapes
+}
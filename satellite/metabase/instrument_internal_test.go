@@ -0,0 +1,76 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/uuid"
+	"storj.io/private/tagsql"
+)
+
+type slowDB struct {
+	tagsql.DB
+	delay time.Duration
+}
+
+func (db slowDB) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	time.Sleep(db.delay)
+	return nil, nil
+}
+
+func TestInstrumentedDB_SlowQuery(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	core, logs := observer.New(zapcore.DebugLevel)
+
+	exec := func(ctx context.Context, db tagsql.DB) (err error) {
+		defer mon.Task()(&ctx)(&err)
+		_, err = db.ExecContext(ctx, `
+			UPDATE objects SET status = $1
+			WHERE stream_id = $2 AND encrypted_metadata = $3`,
+			Committed, uuid.UUID{}, []byte("secret"))
+		return err
+	}
+
+	fast := newInstrumentedDB(zap.New(core), slowDB{}, time.Hour)
+	require.NoError(t, exec(ctx, fast))
+	require.Zero(t, logs.Len())
+
+	slow := newInstrumentedDB(zap.New(core), slowDB{delay: 10 * time.Millisecond}, time.Millisecond)
+	require.NoError(t, exec(ctx, slow))
+	require.Equal(t, 1, logs.Len())
+
+	fields := logs.All()[0].ContextMap()
+	require.Equal(t, "TestInstrumentedDB_SlowQuery.func1", fields["operation"])
+	require.Equal(t, "UPDATE objects SET status = $1 WHERE stream_id = $2 AND encrypted_metadata = $3", fields["query"])
+	require.Equal(t, []interface{}{"metabase.ObjectStatus", "uuid.UUID", "[]uint8(len=6)"}, fields["args"])
+
+	require.GreaterOrEqual(t, slow.instrumentation.Latency(), 10*time.Millisecond)
+}
+
+func TestArgShapes(t *testing.T) {
+	require.Equal(t, []string{
+		"nil",
+		"int64",
+		"string(len=3)",
+		"[]uuid.UUID(len=2)",
+		"map[string]int(len=1)",
+	}, argShapes([]interface{}{
+		nil,
+		int64(1),
+		"key",
+		[]uuid.UUID{{}, {}},
+		map[string]int{"a": 1},
+	}))
+}
@@ -209,7 +209,7 @@ func (it *objectsIterator) next(ctx context.Context, item *ObjectEntry) bool {
 		}
 	}
 
-	err := it.scanItem(item)
+	err := it.scanItem(ctx, item)
 	if err != nil {
 		it.failErr = errs.Combine(it.failErr, err)
 		return false
@@ -338,7 +338,7 @@ func doNextQueryStreamsByKey(ctx context.Context, it *objectsIterator) (_ tagsql
 }
 
 // scanItem scans doNextQuery results into ObjectEntry.
-func (it *objectsIterator) scanItem(item *ObjectEntry) (err error) {
+func (it *objectsIterator) scanItem(ctx context.Context, item *ObjectEntry) (err error) {
 	item.IsPrefix = false
 	item.Status = it.status
 
@@ -373,6 +373,11 @@ func (it *objectsIterator) scanItem(item *ObjectEntry) (err error) {
 	if err != nil {
 		return err
 	}
+
+	if it.includeCustomMetadata {
+		return it.db.openMetadata(ctx, it.projectID,
+			&item.EncryptedMetadataNonce, &item.EncryptedMetadata, &item.EncryptedMetadataEncryptedKey)
+	}
 	return nil
 }
 
@@ -0,0 +1,147 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase
+
+import (
+	"context"
+	"time"
+
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/private/tagsql"
+)
+
+// ListMultipartUploads contains arguments necessary for listing the pending
+// objects of a bucket.
+//
+// The listing is always recursive. Cursor is the key and the version of the
+// last upload of the previous page.
+type ListMultipartUploads struct {
+	ProjectID  uuid.UUID
+	BucketName string
+	Prefix     ObjectKey
+	Cursor     ListObjectsCursor
+	Limit      int
+}
+
+// Verify verifies list multipart uploads request fields.
+func (opts *ListMultipartUploads) Verify() error {
+	switch {
+	case opts.ProjectID.IsZero():
+		return ErrInvalidRequest.New("ProjectID missing")
+	case opts.BucketName == "":
+		return ErrInvalidRequest.New("BucketName missing")
+	case opts.Limit < 0:
+		return ErrInvalidRequest.New("Invalid limit: %d", opts.Limit)
+	}
+	return nil
+}
+
+// MultipartUpload is a pending object, which is still being uploaded.
+type MultipartUpload struct {
+	ObjectStream
+
+	CreatedAt time.Time
+	ExpiresAt *time.Time
+
+	SegmentCount     int32
+	FixedSegmentSize int32
+
+	EncryptedMetadataNonce        []byte
+	EncryptedMetadata             []byte
+	EncryptedMetadataEncryptedKey []byte
+
+	Encryption storj.EncryptionParameters
+
+	// UploaderKeyID is the ID of the API key, which started the upload. It's
+	// nil for the uploads, which were started before it was tracked.
+	UploaderKeyID *uuid.UUID
+}
+
+// ListMultipartUploadsResult is the result of listing multipart uploads.
+type ListMultipartUploadsResult struct {
+	Uploads []MultipartUpload
+	More    bool
+}
+
+// ListMultipartUploads lists the pending objects of the bucket ordered by key
+// and version.
+func (db *DB) ListMultipartUploads(ctx context.Context, opts ListMultipartUploads) (result ListMultipartUploadsResult, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := opts.Verify(); err != nil {
+		return ListMultipartUploadsResult{}, err
+	}
+
+	ListLimit.Ensure(&opts.Limit)
+
+	cursor := opts.Cursor
+	if lessKey(cursor.Key, opts.Prefix) {
+		cursor = ListObjectsCursor{Key: opts.Prefix}
+	}
+
+	args := []interface{}{opts.ProjectID, []byte(opts.BucketName), []byte(cursor.Key), cursor.Version, opts.Limit + 1}
+	prefixCondition := ""
+	if opts.Prefix != "" {
+		prefixCondition = "AND object_key < $6"
+		args = append(args, []byte(prefixLimit(opts.Prefix)))
+	}
+
+	err = withRows(db.db.QueryContext(ctx, `
+		SELECT
+			object_key, version, stream_id,
+			created_at, expires_at,
+			segment_count, fixed_segment_size,
+			encrypted_metadata_nonce, encrypted_metadata, encrypted_metadata_encrypted_key,
+			encryption, uploader_key_id
+		FROM objects
+		WHERE
+			project_id = $1 AND bucket_name = $2
+			AND (object_key, version) > ($3, $4)
+			`+prefixCondition+`
+			AND status = `+pendingStatus+`
+		ORDER BY object_key ASC, version ASC
+		LIMIT $5
+	`, args...))(func(rows tagsql.Rows) error {
+		for rows.Next() {
+			upload := MultipartUpload{
+				ObjectStream: ObjectStream{
+					ProjectID:  opts.ProjectID,
+					BucketName: opts.BucketName,
+				},
+			}
+			err := rows.Scan(
+				&upload.ObjectKey, &upload.Version, &upload.StreamID,
+				&upload.CreatedAt, &upload.ExpiresAt,
+				&upload.SegmentCount, &upload.FixedSegmentSize,
+				&upload.EncryptedMetadataNonce, &upload.EncryptedMetadata, &upload.EncryptedMetadataEncryptedKey,
+				encryptionParameters{&upload.Encryption}, &upload.UploaderKeyID,
+			)
+			if err != nil {
+				return err
+			}
+			result.Uploads = append(result.Uploads, upload)
+		}
+		return nil
+	})
+	if err != nil {
+		return ListMultipartUploadsResult{}, Error.New("unable to list multipart uploads: %w", err)
+	}
+
+	for i := range result.Uploads {
+		upload := &result.Uploads[i]
+		err := db.openMetadata(ctx, opts.ProjectID,
+			&upload.EncryptedMetadataNonce, &upload.EncryptedMetadata, &upload.EncryptedMetadataEncryptedKey)
+		if err != nil {
+			return ListMultipartUploadsResult{}, err
+		}
+	}
+
+	if len(result.Uploads) > opts.Limit {
+		result.More = true
+		result.Uploads = result.Uploads[:opts.Limit]
+	}
+
+	return result, nil
+}
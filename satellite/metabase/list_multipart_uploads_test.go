@@ -0,0 +1,156 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase_test
+
+import (
+	"testing"
+	"time"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/metabasetest"
+)
+
+func TestListMultipartUploads(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		obj := metabasetest.RandObjectStream()
+
+		t.Run("invalid arguments", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			metabasetest.ListMultipartUploads{
+				Opts:     metabase.ListMultipartUploads{BucketName: obj.BucketName},
+				ErrClass: &metabase.ErrInvalidRequest,
+				ErrText:  "ProjectID missing",
+			}.Check(ctx, t, db)
+
+			metabasetest.ListMultipartUploads{
+				Opts:     metabase.ListMultipartUploads{ProjectID: obj.ProjectID},
+				ErrClass: &metabase.ErrInvalidRequest,
+				ErrText:  "BucketName missing",
+			}.Check(ctx, t, db)
+
+			metabasetest.ListMultipartUploads{
+				Opts: metabase.ListMultipartUploads{
+					ProjectID:  obj.ProjectID,
+					BucketName: obj.BucketName,
+					Limit:      -1,
+				},
+				ErrClass: &metabase.ErrInvalidRequest,
+				ErrText:  "Invalid limit: -1",
+			}.Check(ctx, t, db)
+		})
+
+		t.Run("pending objects", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			now := time.Now()
+			keyID := testrand.UUID()
+
+			// committed objects aren't listed.
+			committed := obj
+			committed.ObjectKey = "a/committed"
+			metabasetest.CreateObject(ctx, t, db, committed, 1)
+
+			// objects of other buckets aren't listed.
+			otherBucket := metabasetest.RandObjectStream()
+			otherBucket.ProjectID = obj.ProjectID
+			metabasetest.CreatePendingObject(ctx, t, db, otherBucket, 0)
+
+			var uploads []metabase.MultipartUpload
+			for _, stream := range []struct {
+				key           metabase.ObjectKey
+				version       metabase.Version
+				uploaderKeyID bool
+			}{
+				{key: "a/first", version: 1, uploaderKeyID: true},
+				{key: "a/first", version: 2},
+				{key: "a/second", version: 1, uploaderKeyID: true},
+				{key: "b", version: 1},
+			} {
+				pending := obj
+				pending.ObjectKey = stream.key
+				pending.Version = stream.version
+				pending.StreamID = testrand.UUID()
+
+				upload := metabase.MultipartUpload{
+					ObjectStream: pending,
+					CreatedAt:    now,
+					Encryption:   metabasetest.DefaultEncryption,
+				}
+				if stream.uploaderKeyID {
+					upload.UploaderKeyID = &keyID
+				}
+
+				metabasetest.BeginObjectExactVersion{
+					Opts: metabase.BeginObjectExactVersion{
+						ObjectStream:  pending,
+						Encryption:    metabasetest.DefaultEncryption,
+						UploaderKeyID: upload.UploaderKeyID,
+					},
+					Version: stream.version,
+				}.Check(ctx, t, db)
+
+				uploads = append(uploads, upload)
+			}
+
+			metabasetest.ListMultipartUploads{
+				Opts: metabase.ListMultipartUploads{
+					ProjectID:  obj.ProjectID,
+					BucketName: obj.BucketName,
+				},
+				Result: metabase.ListMultipartUploadsResult{
+					Uploads: uploads,
+				},
+			}.Check(ctx, t, db)
+
+			metabasetest.ListMultipartUploads{
+				Opts: metabase.ListMultipartUploads{
+					ProjectID:  obj.ProjectID,
+					BucketName: obj.BucketName,
+					Limit:      2,
+				},
+				Result: metabase.ListMultipartUploadsResult{
+					Uploads: uploads[:2],
+					More:    true,
+				},
+			}.Check(ctx, t, db)
+
+			metabasetest.ListMultipartUploads{
+				Opts: metabase.ListMultipartUploads{
+					ProjectID:  obj.ProjectID,
+					BucketName: obj.BucketName,
+					Cursor:     metabase.ListObjectsCursor{Key: "a/first", Version: 2},
+					Limit:      1,
+				},
+				Result: metabase.ListMultipartUploadsResult{
+					Uploads: uploads[2:3],
+					More:    true,
+				},
+			}.Check(ctx, t, db)
+
+			metabasetest.ListMultipartUploads{
+				Opts: metabase.ListMultipartUploads{
+					ProjectID:  obj.ProjectID,
+					BucketName: obj.BucketName,
+					Prefix:     "a/",
+				},
+				Result: metabase.ListMultipartUploadsResult{
+					Uploads: uploads[:3],
+				},
+			}.Check(ctx, t, db)
+
+			metabasetest.ListMultipartUploads{
+				Opts: metabase.ListMultipartUploads{
+					ProjectID:  obj.ProjectID,
+					BucketName: obj.BucketName,
+					Prefix:     "a/",
+					Cursor:     metabase.ListObjectsCursor{Key: "a/second", Version: 1},
+				},
+				Result: metabase.ListMultipartUploadsResult{},
+			}.Check(ctx, t, db)
+		})
+	})
+}
@@ -78,6 +78,17 @@ func (db *DB) ListObjects(ctx context.Context, opts ListObjects) (result ListObj
 		return ListObjectsResult{}, Error.New("unable to list objects: %w", err)
 	}
 
+	if opts.IncludeCustomMetadata {
+		for i := range entries {
+			item := &entries[i]
+			err := db.openMetadata(ctx, opts.ProjectID,
+				&item.EncryptedMetadataNonce, &item.EncryptedMetadata, &item.EncryptedMetadataEncryptedKey)
+			if err != nil {
+				return ListObjectsResult{}, err
+			}
+		}
+	}
+
 	if len(entries) > opts.Limit {
 		result.More = true
 		result.Objects = entries[:opts.Limit]
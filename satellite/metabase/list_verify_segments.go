@@ -5,6 +5,7 @@ package metabase
 
 import (
 	"context"
+	"strconv"
 	"time"
 
 	"storj.io/common/storj"
@@ -41,10 +42,12 @@ type VerifySegment struct {
 	CreatedAt  time.Time
 	RepairedAt *time.Time
 
-	RootPieceID storj.PieceID
-	Redundancy  storj.RedundancyScheme
+	RootPieceID   storj.PieceID
+	Redundancy    storj.RedundancyScheme
+	EncryptedSize int32
 
 	AliasPieces AliasPieces
+	Placement   storj.PlacementConstraint
 }
 
 func (opts *ListVerifySegments) getQueryAndParameters(asof string) (string, []interface{}) {
@@ -55,7 +58,9 @@ func (opts *ListVerifySegments) getQueryAndParameters(asof string) (string, []in
 			stream_id, position,
 			created_at, repaired_at,
 			root_piece_id, redundancy,
-			remote_alias_pieces
+			encrypted_size,
+			remote_alias_pieces,
+			placement
 		FROM segments
 		` + asof + `
 		WHERE
@@ -75,7 +80,9 @@ func (opts *ListVerifySegments) getQueryAndParameters(asof string) (string, []in
 			segments.stream_id, segments.position,
 			segments.created_at, segments.repaired_at,
 			segments.root_piece_id, segments.redundancy,
-			segments.remote_alias_pieces
+			segments.encrypted_size,
+			segments.remote_alias_pieces,
+			segments.placement
 		FROM segments
 		` + asof + `
 		WHERE
@@ -118,7 +125,9 @@ func (db *DB) ListVerifySegments(ctx context.Context, opts ListVerifySegments) (
 
 				&seg.RootPieceID,
 				redundancyScheme{&seg.Redundancy},
+				&seg.EncryptedSize,
 				&seg.AliasPieces,
+				&seg.Placement,
 			)
 			if err != nil {
 				return Error.Wrap(err)
@@ -219,3 +228,104 @@ func (db *DB) ListBucketsStreamIDs(ctx context.Context, opts ListBucketsStreamID
 	}
 	return result, nil
 }
+
+// ListScopeStreamIDs contains arguments necessary for listing stream IDs of objects
+// within a project, optionally limited to a bucket and an object key prefix.
+type ListScopeStreamIDs struct {
+	ProjectID  uuid.UUID
+	BucketName string
+	Prefix     ObjectKey
+
+	Cursor ListScopeStreamIDsCursor
+	Limit  int
+
+	AsOfSystemTime     time.Time
+	AsOfSystemInterval time.Duration
+}
+
+// ListScopeStreamIDsCursor is the cursor for ListScopeStreamIDs.
+type ListScopeStreamIDsCursor struct {
+	BucketName string
+	ObjectKey  ObjectKey
+	Version    Version
+}
+
+// ListScopeStreamIDsResult is the result of ListScopeStreamIDs.
+type ListScopeStreamIDsResult struct {
+	StreamIDs []uuid.UUID
+	// Cursor points to the last listed object.
+	Cursor ListScopeStreamIDsCursor
+}
+
+// Verify verifies the request fields.
+func (opts *ListScopeStreamIDs) Verify() error {
+	switch {
+	case opts.ProjectID.IsZero():
+		return ErrInvalidRequest.New("ProjectID missing")
+	case opts.BucketName == "" && opts.Prefix != "":
+		return ErrInvalidRequest.New("BucketName missing")
+	case opts.BucketName != "" && opts.Cursor.BucketName != "" && opts.BucketName != opts.Cursor.BucketName:
+		return ErrInvalidRequest.New("cursor bucket does not match BucketName")
+	case opts.Limit <= 0:
+		return ErrInvalidRequest.New("invalid limit: %d", opts.Limit)
+	}
+	return nil
+}
+
+// ListScopeStreamIDs lists the stream IDs of objects within a project,
+// optionally limited to a bucket and an object key prefix.
+func (db *DB) ListScopeStreamIDs(ctx context.Context, opts ListScopeStreamIDs) (result ListScopeStreamIDsResult, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := opts.Verify(); err != nil {
+		return ListScopeStreamIDsResult{}, err
+	}
+	ListVerifyLimit.Ensure(&opts.Limit)
+
+	query := `
+		SELECT bucket_name, object_key, version, stream_id
+		FROM objects
+		` + db.asOfTime(opts.AsOfSystemTime, opts.AsOfSystemInterval) + `
+		WHERE
+			project_id = $1 AND
+			(bucket_name, object_key, version) > ($2, $3, $4)
+	`
+	args := []interface{}{
+		opts.ProjectID,
+		[]byte(opts.Cursor.BucketName), []byte(opts.Cursor.ObjectKey), opts.Cursor.Version,
+	}
+
+	if opts.BucketName != "" {
+		args = append(args, []byte(opts.BucketName))
+		query += ` AND bucket_name = $5`
+
+		if opts.Prefix != "" {
+			args = append(args, []byte(opts.Prefix), []byte(prefixLimit(opts.Prefix)))
+			query += ` AND object_key >= $6 AND object_key < $7`
+		}
+	}
+
+	args = append(args, opts.Limit)
+	query += `
+		ORDER BY project_id, bucket_name, object_key, version
+		LIMIT $` + strconv.Itoa(len(args))
+
+	err = withRows(db.db.QueryContext(ctx, query, args...))(func(rows tagsql.Rows) error {
+		for rows.Next() {
+			var bucketName, objectKey []byte
+			var streamID uuid.UUID
+			err := rows.Scan(&bucketName, &objectKey, &result.Cursor.Version, &streamID)
+			if err != nil {
+				return Error.Wrap(err)
+			}
+			result.Cursor.BucketName = string(bucketName)
+			result.Cursor.ObjectKey = ObjectKey(objectKey)
+			result.StreamIDs = append(result.StreamIDs, streamID)
+		}
+		return nil
+	})
+	if err != nil {
+		return ListScopeStreamIDsResult{}, Error.Wrap(err)
+	}
+	return result, nil
+}
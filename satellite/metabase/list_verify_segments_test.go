@@ -335,6 +335,73 @@ func TestListBucketsStreamIDs(t *testing.T) {
 	})
 }
 
+func TestListScopeStreamIDs(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		projectID := testrand.UUID()
+
+		t.Run("invalid request", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			_, err := db.ListScopeStreamIDs(ctx, metabase.ListScopeStreamIDs{Limit: 1})
+			require.True(t, metabase.ErrInvalidRequest.Has(err))
+
+			_, err = db.ListScopeStreamIDs(ctx, metabase.ListScopeStreamIDs{ProjectID: projectID, Prefix: "a/", Limit: 1})
+			require.True(t, metabase.ErrInvalidRequest.Has(err))
+
+			_, err = db.ListScopeStreamIDs(ctx, metabase.ListScopeStreamIDs{ProjectID: projectID, Limit: 0})
+			require.True(t, metabase.ErrInvalidRequest.Has(err))
+		})
+
+		t.Run("project, bucket and prefix", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			create := func(projectID uuid.UUID, bucketName string, key metabase.ObjectKey) uuid.UUID {
+				obj := metabasetest.RandObjectStream()
+				obj.ProjectID = projectID
+				obj.BucketName = bucketName
+				obj.ObjectKey = key
+				_ = metabasetest.CreateObject(ctx, t, db, obj, 1)
+				return obj.StreamID
+			}
+
+			a1 := create(projectID, "bucket-a", "a/1")
+			a2 := create(projectID, "bucket-a", "a/2")
+			b1 := create(projectID, "bucket-a", "b/1")
+			c1 := create(projectID, "bucket-c", "a/1")
+			_ = create(testrand.UUID(), "bucket-a", "a/1")
+
+			list := func(opts metabase.ListScopeStreamIDs) []uuid.UUID {
+				var all []uuid.UUID
+				for {
+					result, err := db.ListScopeStreamIDs(ctx, opts)
+					require.NoError(t, err)
+					if len(result.StreamIDs) == 0 {
+						return all
+					}
+					all = append(all, result.StreamIDs...)
+					opts.Cursor = result.Cursor
+				}
+			}
+
+			require.Equal(t, []uuid.UUID{a1, a2, b1, c1}, list(metabase.ListScopeStreamIDs{
+				ProjectID: projectID,
+				Limit:     1,
+			}))
+			require.Equal(t, []uuid.UUID{a1, a2, b1}, list(metabase.ListScopeStreamIDs{
+				ProjectID:  projectID,
+				BucketName: "bucket-a",
+				Limit:      2,
+			}))
+			require.Equal(t, []uuid.UUID{a1, a2}, list(metabase.ListScopeStreamIDs{
+				ProjectID:  projectID,
+				BucketName: "bucket-a",
+				Prefix:     "a/",
+				Limit:      10,
+			}))
+		})
+	})
+}
+
 func uuidBefore(v uuid.UUID) uuid.UUID {
 	for i := len(v) - 1; i >= 0; i-- {
 		v[i]--
@@ -352,8 +419,9 @@ func defaultVerifySegment(streamID uuid.UUID, index uint32) metabase.VerifySegme
 			Index: index,
 		},
 		CreatedAt:   time.Now(),
-		RootPieceID: storj.PieceID{1},
-		AliasPieces: metabase.AliasPieces{{Number: 0, Alias: 1}},
-		Redundancy:  metabasetest.DefaultRedundancy,
+		RootPieceID:   storj.PieceID{1},
+		AliasPieces:   metabase.AliasPieces{{Number: 0, Alias: 1}},
+		Redundancy:    metabasetest.DefaultRedundancy,
+		EncryptedSize: 1024,
 	}
 }
@@ -202,6 +202,7 @@ type LoopSegmentEntry struct {
 	CreatedAt     time.Time // non-nillable
 	ExpiresAt     *time.Time
 	RepairedAt    *time.Time // repair
+	LastAuditedAt *time.Time // audit
 	RootPieceID   storj.PieceID
 	EncryptedSize int32 // size of the whole segment (not a piece)
 	PlainOffset   int64 // verify
@@ -367,7 +368,7 @@ func (it *loopSegmentIterator) doNextQuery(ctx context.Context) (_ tagsql.Rows,
 	return it.db.db.QueryContext(ctx, `
 		SELECT
 			stream_id, position,
-			created_at, expires_at, repaired_at,
+			created_at, expires_at, repaired_at, last_audited_at,
 			root_piece_id,
 			encrypted_size,
 			plain_offset, plain_size,
@@ -390,7 +391,7 @@ func (it *loopSegmentIterator) scanItem(ctx context.Context, item *LoopSegmentEn
 	var aliasPieces AliasPieces
 	err := it.curRows.Scan(
 		&item.StreamID, &item.Position,
-		&item.CreatedAt, &item.ExpiresAt, &item.RepairedAt,
+		&item.CreatedAt, &item.ExpiresAt, &item.RepairedAt, &item.LastAuditedAt,
 		&item.RootPieceID,
 		&item.EncryptedSize,
 		&item.PlainOffset, &item.PlainSize,
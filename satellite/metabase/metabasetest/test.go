@@ -102,6 +102,29 @@ func (step CommitObject) Check(ctx *testcontext.Context, t require.TestingT, db
 	return object
 }
 
+// CommitAndDeleteObjects is for testing metabase.CommitAndDeleteObjects.
+type CommitAndDeleteObjects struct {
+	Opts     metabase.CommitAndDeleteObjects
+	Result   metabase.CommitAndDeleteObjectsResult
+	ErrClass *errs.Class
+	ErrText  string
+}
+
+// Check runs the test.
+func (step CommitAndDeleteObjects) Check(ctx *testcontext.Context, t testing.TB, db *metabase.DB) metabase.CommitAndDeleteObjectsResult {
+	result, err := db.CommitAndDeleteObjects(ctx, step.Opts)
+	checkError(t, err, step.ErrClass, step.ErrText)
+
+	sortObjects(result.Deleted.Objects)
+	sortObjects(step.Result.Deleted.Objects)
+	sortDeletedSegments(result.Deleted.Segments)
+	sortDeletedSegments(step.Result.Deleted.Segments)
+
+	diff := cmp.Diff(step.Result, result, DefaultTimeDiff(), cmpopts.EquateEmpty())
+	require.Zero(t, diff)
+	return result
+}
+
 // CommitObjectWithSegments is for testing metabase.CommitObjectWithSegments.
 type CommitObjectWithSegments struct {
 	Opts     metabase.CommitObjectWithSegments
@@ -339,6 +362,23 @@ func (step ListObjects) Check(ctx *testcontext.Context, t testing.TB, db *metaba
 	require.Zero(t, diff)
 }
 
+// ListMultipartUploads is for testing metabase.ListMultipartUploads.
+type ListMultipartUploads struct {
+	Opts     metabase.ListMultipartUploads
+	Result   metabase.ListMultipartUploadsResult
+	ErrClass *errs.Class
+	ErrText  string
+}
+
+// Check runs the test.
+func (step ListMultipartUploads) Check(ctx *testcontext.Context, t testing.TB, db *metabase.DB) {
+	result, err := db.ListMultipartUploads(ctx, step.Opts)
+	checkError(t, err, step.ErrClass, step.ErrText)
+
+	diff := cmp.Diff(step.Result, result, DefaultTimeDiff(), cmpopts.EquateEmpty())
+	require.Zero(t, diff)
+}
+
 // ListStreamPositions is for testing metabase.ListStreamPositions.
 type ListStreamPositions struct {
 	Opts     metabase.ListStreamPositions
@@ -5,11 +5,14 @@ package metabase
 
 import (
 	"context"
+	"database/sql"
 
 	"go.uber.org/zap"
 
 	"storj.io/common/storj"
 	"storj.io/common/uuid"
+	"storj.io/private/dbutil/txutil"
+	"storj.io/private/tagsql"
 )
 
 // UpdateObjectMetadata contains arguments necessary for replacing an object metadata.
@@ -47,32 +50,78 @@ func (db *DB) UpdateObjectMetadata(ctx context.Context, opts UpdateObjectMetadat
 		return err
 	}
 
+	metadata, err := db.sealMetadata(ctx, opts.ProjectID, opts.EncryptedMetadataNonce, opts.EncryptedMetadata, opts.EncryptedMetadataEncryptedKey)
+	if err != nil {
+		return err
+	}
+
 	// TODO So the issue is that during a multipart upload of an object,
 	// uplink can update object metadata. If we add the arguments EncryptedMetadata
 	// to CommitObject, they will need to account for them being optional.
 	// Leading to scenarios where uplink calls update metadata, but wants to clear them
 	// during commit object.
-	result, err := db.db.ExecContext(ctx, `
-		UPDATE objects SET
-			encrypted_metadata_nonce         = $5,
-			encrypted_metadata               = $6,
-			encrypted_metadata_encrypted_key = $7
-		WHERE
-			project_id   = $1 AND
-			bucket_name  = $2 AND
-			object_key   = $3 AND
-			version IN (SELECT version FROM objects WHERE
+	update := func(ctx context.Context, tx execer) (sql.Result, error) {
+		return tx.ExecContext(ctx, `
+			UPDATE objects SET
+				encrypted_metadata_nonce         = $5,
+				encrypted_metadata               = $6,
+				encrypted_metadata_encrypted_key = $7
+			WHERE
 				project_id   = $1 AND
 				bucket_name  = $2 AND
 				object_key   = $3 AND
-				status       = `+committedStatus+` AND
-				(expires_at IS NULL OR expires_at > now())
-				ORDER BY version desc
-			) AND
-			stream_id    = $4 AND
-			status       = `+committedStatus,
-		opts.ProjectID, []byte(opts.BucketName), opts.ObjectKey, opts.StreamID,
-		opts.EncryptedMetadataNonce, opts.EncryptedMetadata, opts.EncryptedMetadataEncryptedKey)
+				version IN (SELECT version FROM objects WHERE
+					project_id   = $1 AND
+					bucket_name  = $2 AND
+					object_key   = $3 AND
+					status       = `+committedStatus+` AND
+					(expires_at IS NULL OR expires_at > now())
+					ORDER BY version desc
+				) AND
+				stream_id    = $4 AND
+				status       = `+committedStatus,
+			opts.ProjectID, []byte(opts.BucketName), opts.ObjectKey, opts.StreamID,
+			metadata.Nonce, metadata.Metadata, metadata.Key)
+	}
+
+	var result sql.Result
+	if !db.config.TallyDeltas {
+		result, err = update(ctx, db.db)
+	} else {
+		// the size of the replaced metadata is needed for the tally delta.
+		err = txutil.WithTx(ctx, db.db, nil, func(ctx context.Context, tx tagsql.Tx) error {
+			var previousSize int64
+			err := tx.QueryRowContext(ctx, `
+				SELECT COALESCE(SUM(LENGTH(COALESCE(encrypted_metadata, ''))), 0)
+				FROM objects
+				WHERE
+					project_id   = $1 AND
+					bucket_name  = $2 AND
+					object_key   = $3 AND
+					stream_id    = $4 AND
+					status       = `+committedStatus+` AND
+					(expires_at IS NULL OR expires_at > now())
+			`, opts.ProjectID, []byte(opts.BucketName), opts.ObjectKey, opts.StreamID).Scan(&previousSize)
+			if err != nil {
+				return err
+			}
+
+			result, err = update(ctx, tx)
+			if err != nil {
+				return err
+			}
+			affected, err := result.RowsAffected()
+			if err != nil || affected == 0 {
+				return err
+			}
+
+			deltas := tallyDeltas{}
+			deltas.update(BucketLocation{ProjectID: opts.ProjectID, BucketName: opts.BucketName}, BucketTally{
+				MetadataSize: affected*int64(len(metadata.Metadata)) - previousSize,
+			})
+			return db.recordTallyDeltas(ctx, tx, deltas)
+		})
+	}
 	if err != nil {
 		return Error.New("unable to update object metadata: %w", err)
 	}
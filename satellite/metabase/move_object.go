@@ -153,6 +153,15 @@ func (db *DB) FinishMoveObject(ctx context.Context, opts FinishMoveObject) (err
 		return err
 	}
 
+	var newNonce []byte
+	if !opts.NewEncryptedMetadataKeyNonce.IsZero() {
+		newNonce = opts.NewEncryptedMetadataKeyNonce[:]
+	}
+	sealed, err := db.sealMetadata(ctx, opts.ProjectID, newNonce, nil, opts.NewEncryptedMetadataKey)
+	if err != nil {
+		return err
+	}
+
 	err = txutil.WithTx(ctx, db.db, nil, func(ctx context.Context, tx tagsql.Tx) (err error) {
 		updateObjectsQuery := `
 			UPDATE objects SET
@@ -174,15 +183,19 @@ func (db *DB) FinishMoveObject(ctx context.Context, opts FinishMoveObject) (err
 			RETURNING
 				segment_count, 
 				objects.encrypted_metadata IS NOT NULL AND LENGTH(objects.encrypted_metadata) > 0 AS has_metadata,
-				stream_id
+				stream_id,
+				status, total_encrypted_size, COALESCE(LENGTH(objects.encrypted_metadata), 0)
         `
 
 		var segmentsCount int
 		var hasMetadata bool
 		var streamID uuid.UUID
+		var status ObjectStatus
+		var totalEncryptedSize int64
+		var metadataSize int64
 
-		row := tx.QueryRowContext(ctx, updateObjectsQuery, []byte(opts.NewBucket), opts.NewEncryptedObjectKey, opts.NewEncryptedMetadataKey, opts.NewEncryptedMetadataKeyNonce, opts.ProjectID, []byte(opts.BucketName), opts.ObjectKey, opts.Version)
-		if err = row.Scan(&segmentsCount, &hasMetadata, &streamID); err != nil {
+		row := tx.QueryRowContext(ctx, updateObjectsQuery, []byte(opts.NewBucket), opts.NewEncryptedObjectKey, sealed.Key, sealed.Nonce, opts.ProjectID, []byte(opts.BucketName), opts.ObjectKey, opts.Version)
+		if err = row.Scan(&segmentsCount, &hasMetadata, &streamID, &status, &totalEncryptedSize, &metadataSize); err != nil {
 			if code := pgerrcode.FromError(err); code == pgxerrcode.UniqueViolation {
 				return Error.Wrap(ErrObjectAlreadyExists.New(""))
 			} else if errors.Is(err, sql.ErrNoRows) {
@@ -238,7 +251,28 @@ func (db *DB) FinishMoveObject(ctx context.Context, opts FinishMoveObject) (err
 		if affected != int64(len(newSegmentKeys.Positions)) {
 			return Error.New("segment is missing")
 		}
-		return nil
+
+		if opts.NewBucket == opts.BucketName {
+			return nil
+		}
+
+		// the object leaves the bucket, so its tally moves to the new bucket.
+		deltas := tallyDeltas{}
+		if status == Committed {
+			deltas.update(BucketLocation{ProjectID: opts.ProjectID, BucketName: opts.BucketName}, BucketTally{
+				ObjectCount:   -1,
+				TotalSegments: -int64(segmentsCount),
+				TotalBytes:    -totalEncryptedSize,
+				MetadataSize:  -metadataSize,
+			})
+			deltas.update(BucketLocation{ProjectID: opts.ProjectID, BucketName: opts.NewBucket}, BucketTally{
+				ObjectCount:   1,
+				TotalSegments: int64(segmentsCount),
+				TotalBytes:    totalEncryptedSize,
+				MetadataSize:  metadataSize,
+			})
+		}
+		return db.recordTallyDeltas(ctx, tx, deltas)
 	})
 	if err != nil {
 		return err
@@ -0,0 +1,55 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase
+
+import (
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+)
+
+// ErrPreconditionFailed is used to indicate that the committed object doesn't
+// satisfy the precondition of the request.
+var ErrPreconditionFailed = errs.Class("precondition failed")
+
+// Precondition contains the conditions, which the last committed object at the
+// location must satisfy for the operation to be executed. Gateways use it to
+// implement S3 conditional requests and to avoid lost updates between
+// concurrent clients.
+//
+// The object ETag is encrypted by the client, hence the objects are compared by
+// their stream ID, which changes with every upload.
+type Precondition struct {
+	// IfMatch requires the last committed object to have this stream ID.
+	IfMatch uuid.UUID
+	// IfNoneMatch requires that there is no committed object.
+	IfNoneMatch bool
+}
+
+// IsZero returns true when no condition is set.
+func (p Precondition) IsZero() bool {
+	return p.IfMatch.IsZero() && !p.IfNoneMatch
+}
+
+// Verify verifies precondition fields.
+func (p Precondition) Verify() error {
+	if !p.IfMatch.IsZero() && p.IfNoneMatch {
+		return ErrInvalidRequest.New("IfMatch and IfNoneMatch cannot be both set")
+	}
+	return nil
+}
+
+// check returns an error when the last committed object doesn't satisfy the
+// precondition, current is nil when there's no committed object.
+func (p Precondition) check(current *uuid.UUID) error {
+	switch {
+	case p.IfNoneMatch && current != nil:
+		return ErrPreconditionFailed.New("object already exists")
+	case !p.IfMatch.IsZero() && current == nil:
+		return ErrPreconditionFailed.New("object doesn't exist")
+	case !p.IfMatch.IsZero() && *current != p.IfMatch:
+		return ErrPreconditionFailed.New("object has been modified")
+	}
+	return nil
+}
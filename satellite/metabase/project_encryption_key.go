@@ -0,0 +1,110 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+)
+
+// ErrProjectEncryptionKeyNotFound is an error class for projects without
+// a satellite managed encryption key.
+var ErrProjectEncryptionKeyNotFound = errs.Class("project encryption key not found")
+
+// ProjectEncryptionKey is the satellite managed encryption key of a project
+// in server-side encryption mode.
+type ProjectEncryptionKey struct {
+	ProjectID uuid.UUID
+	Key       []byte
+	CreatedAt time.Time
+}
+
+// CreateProjectEncryptionKey contains arguments necessary for creating the
+// managed encryption key of a project.
+type CreateProjectEncryptionKey struct {
+	ProjectID uuid.UUID
+}
+
+// CreateProjectEncryptionKey creates the managed encryption key of a project,
+// unless the project already has one. The key is stored wrapped with the key
+// of the project from the KeyManager.
+func (db *DB) CreateProjectEncryptionKey(ctx context.Context, opts CreateProjectEncryptionKey) (_ ProjectEncryptionKey, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if opts.ProjectID.IsZero() {
+		return ProjectEncryptionKey{}, ErrInvalidRequest.New("ProjectID missing")
+	}
+	if db.config.KeyManager == nil {
+		return ProjectEncryptionKey{}, ErrAtRest.New("server-side encryption requires a key manager")
+	}
+
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		return ProjectEncryptionKey{}, ErrAtRest.Wrap(err)
+	}
+
+	wrapped, err := db.config.KeyManager.WrapKey(ctx, opts.ProjectID, key)
+	if err != nil {
+		return ProjectEncryptionKey{}, ErrAtRest.Wrap(err)
+	}
+
+	_, err = db.db.ExecContext(ctx, `
+		INSERT INTO project_encryption_keys (project_id, encrypted_key)
+		VALUES ($1, $2)
+		ON CONFLICT (project_id) DO NOTHING
+	`, opts.ProjectID, wrapped)
+	if err != nil {
+		return ProjectEncryptionKey{}, Error.New("unable to create project encryption key: %w", err)
+	}
+
+	// the key of a concurrent or earlier call wins.
+	return db.GetProjectEncryptionKey(ctx, GetProjectEncryptionKey{ProjectID: opts.ProjectID})
+}
+
+// GetProjectEncryptionKey contains arguments necessary for fetching the
+// managed encryption key of a project.
+type GetProjectEncryptionKey struct {
+	ProjectID uuid.UUID
+}
+
+// GetProjectEncryptionKey returns the managed encryption key of a project.
+func (db *DB) GetProjectEncryptionKey(ctx context.Context, opts GetProjectEncryptionKey) (_ ProjectEncryptionKey, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if opts.ProjectID.IsZero() {
+		return ProjectEncryptionKey{}, ErrInvalidRequest.New("ProjectID missing")
+	}
+
+	key := ProjectEncryptionKey{ProjectID: opts.ProjectID}
+	var wrapped []byte
+	err = db.db.QueryRowContext(ctx, `
+		SELECT encrypted_key, created_at
+		FROM project_encryption_keys
+		WHERE project_id = $1
+	`, opts.ProjectID).Scan(&wrapped, &key.CreatedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ProjectEncryptionKey{}, ErrProjectEncryptionKeyNotFound.New("%s", opts.ProjectID)
+		}
+		return ProjectEncryptionKey{}, Error.New("unable to query project encryption key: %w", err)
+	}
+
+	if db.config.KeyManager == nil {
+		return ProjectEncryptionKey{}, ErrAtRest.New("server-side encryption requires a key manager")
+	}
+	key.Key, err = db.config.KeyManager.UnwrapKey(ctx, opts.ProjectID, wrapped)
+	if err != nil {
+		return ProjectEncryptionKey{}, ErrAtRest.Wrap(err)
+	}
+
+	mon.Meter("project_encryption_key_access").Mark(1)
+	return key, nil
+}
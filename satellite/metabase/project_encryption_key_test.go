@@ -0,0 +1,60 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/metabasetest"
+)
+
+func TestProjectEncryptionKey(t *testing.T) {
+	var masterKey [32]byte
+	copy(masterKey[:], testrand.BytesInt(32))
+
+	config := metabase.Config{
+		ApplicationName:  "satellite-test",
+		MinPartSize:      0,
+		MaxNumberOfParts: 10000,
+		KeyManager:       metabase.NewStaticKeyManager(masterKey),
+	}
+
+	metabasetest.RunWithConfig(t, config, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		projectID := testrand.UUID()
+
+		_, err := db.GetProjectEncryptionKey(ctx, metabase.GetProjectEncryptionKey{})
+		require.True(t, metabase.ErrInvalidRequest.Has(err))
+
+		_, err = db.GetProjectEncryptionKey(ctx, metabase.GetProjectEncryptionKey{ProjectID: projectID})
+		require.True(t, metabase.ErrProjectEncryptionKeyNotFound.Has(err))
+
+		created, err := db.CreateProjectEncryptionKey(ctx, metabase.CreateProjectEncryptionKey{ProjectID: projectID})
+		require.NoError(t, err)
+		require.Len(t, created.Key, 32)
+		require.False(t, created.CreatedAt.IsZero())
+
+		// the key is created only once.
+		again, err := db.CreateProjectEncryptionKey(ctx, metabase.CreateProjectEncryptionKey{ProjectID: projectID})
+		require.NoError(t, err)
+		require.Equal(t, created.Key, again.Key)
+
+		key, err := db.GetProjectEncryptionKey(ctx, metabase.GetProjectEncryptionKey{ProjectID: projectID})
+		require.NoError(t, err)
+		require.Equal(t, created.Key, key.Key)
+
+		// the keys are different for each project.
+		other, err := db.CreateProjectEncryptionKey(ctx, metabase.CreateProjectEncryptionKey{ProjectID: testrand.UUID()})
+		require.NoError(t, err)
+		require.NotEqual(t, created.Key, other.Key)
+
+		_, err = db.GetProjectEncryptionKey(ctx, metabase.GetProjectEncryptionKey{ProjectID: uuid.UUID{1}})
+		require.True(t, metabase.ErrProjectEncryptionKeyNotFound.Has(err))
+	})
+}
@@ -0,0 +1,13 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package rangedloop implements a loop, which processes all the segments in
+// parallel ranges and passes them to the observers.
+//
+// An observer forks a Partial for every range and joins the partials, after
+// all the segments are processed. ForkJoin builds an observer from callbacks,
+// so new observers only implement the processing of the segments and the
+// merging of the results. Package rangedlooptest contains an in-memory segment
+// source and a golden-test harness, which checks that the output of an
+// observer doesn't depend on how the segments are split into ranges.
+package rangedloop
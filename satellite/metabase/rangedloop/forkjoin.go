@@ -0,0 +1,83 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package rangedloop
+
+import (
+	"context"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/satellite/metabase/segmentloop"
+)
+
+// Error is the error class for the ranged loop.
+var Error = errs.Class("ranged loop")
+
+// PartialFunc is an adapter to allow the use of an ordinary function as a
+// Partial. The function must not keep state, when it's shared by the ranges.
+type PartialFunc func(context.Context, []segmentloop.Segment) error
+
+// Process calls fn(ctx, segments).
+func (fn PartialFunc) Process(ctx context.Context, segments []segmentloop.Segment) error {
+	return fn(ctx, segments)
+}
+
+// ForkJoin is an Observer built from callbacks, so that observers only need to
+// implement the processing of the segments and the merging of the partial
+// results. P is the type of the partials created by NewPartial, the partials
+// are passed to Join with their concrete type.
+type ForkJoin[P Partial] struct {
+	// OnStart is called at the beginning of each loop. It's optional and
+	// should reset the results of the previous loop.
+	OnStart func(ctx context.Context, startTime time.Time) error
+
+	// NewPartial creates the partial, which processes a range of segments.
+	NewPartial func(ctx context.Context) (P, error)
+
+	// OnJoin merges the partial into the results. It's optional and it's not
+	// called concurrently.
+	OnJoin func(ctx context.Context, partial P) error
+
+	// OnFinish is called after all the partials are joined. It's optional.
+	OnFinish func(ctx context.Context) error
+}
+
+var _ Observer = (*ForkJoin[Partial])(nil)
+
+// Start implements Observer.
+func (fj *ForkJoin[P]) Start(ctx context.Context, startTime time.Time) error {
+	if fj.OnStart == nil {
+		return nil
+	}
+	return fj.OnStart(ctx, startTime)
+}
+
+// Fork implements Observer.
+func (fj *ForkJoin[P]) Fork(ctx context.Context) (Partial, error) {
+	if fj.NewPartial == nil {
+		return nil, Error.New("NewPartial is missing")
+	}
+	return fj.NewPartial(ctx)
+}
+
+// Join implements Observer.
+func (fj *ForkJoin[P]) Join(ctx context.Context, partial Partial) error {
+	typed, ok := partial.(P)
+	if !ok {
+		return Error.New("expected %T but got %T", typed, partial)
+	}
+	if fj.OnJoin == nil {
+		return nil
+	}
+	return fj.OnJoin(ctx, typed)
+}
+
+// Finish implements Observer.
+func (fj *ForkJoin[P]) Finish(ctx context.Context) error {
+	if fj.OnFinish == nil {
+		return nil
+	}
+	return fj.OnFinish(ctx)
+}
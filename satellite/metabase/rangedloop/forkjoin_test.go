@@ -0,0 +1,108 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package rangedloop_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/metabase/rangedloop"
+	"storj.io/storj/satellite/metabase/rangedloop/rangedlooptest"
+	"storj.io/storj/satellite/metabase/segmentloop"
+)
+
+// sizeCounter counts the segments and their encrypted size.
+type sizeCounter struct {
+	segments int
+	size     int64
+}
+
+func (counter *sizeCounter) Process(ctx context.Context, segments []segmentloop.Segment) error {
+	for _, segment := range segments {
+		counter.segments++
+		counter.size += int64(segment.EncryptedSize)
+	}
+	return nil
+}
+
+func newSizeObserver(total *sizeCounter, finished *bool) *rangedloop.ForkJoin[*sizeCounter] {
+	return &rangedloop.ForkJoin[*sizeCounter]{
+		OnStart: func(ctx context.Context, startTime time.Time) error {
+			*total = sizeCounter{}
+			return nil
+		},
+		NewPartial: func(ctx context.Context) (*sizeCounter, error) {
+			return &sizeCounter{}, nil
+		},
+		OnJoin: func(ctx context.Context, partial *sizeCounter) error {
+			total.segments += partial.segments
+			total.size += partial.size
+			return nil
+		},
+		OnFinish: func(ctx context.Context) error {
+			*finished = true
+			return nil
+		},
+	}
+}
+
+func TestForkJoin(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	segments := append(
+		rangedlooptest.Stream(uuid.UUID{1}, 3, 64, 0),
+		rangedlooptest.Stream(uuid.UUID{2}, 2, 32, 8)...)
+
+	var total sizeCounter
+	var finished bool
+	observer := newSizeObserver(&total, &finished)
+
+	for _, config := range rangedlooptest.Configs {
+		finished = false
+		rangedlooptest.RunOnce(ctx, t, config, segments, observer)
+
+		require.True(t, finished)
+		require.Equal(t, sizeCounter{segments: 5, size: 3*64 + 32 + 8}, total)
+	}
+
+	t.Run("join checks the partial type", func(t *testing.T) {
+		err := observer.Join(ctx, &rangedlooptest.CountObserver{})
+		require.True(t, rangedloop.Error.Has(err))
+	})
+
+	t.Run("PartialFunc", func(t *testing.T) {
+		var count int
+		partial := rangedloop.PartialFunc(func(ctx context.Context, segments []segmentloop.Segment) error {
+			count += len(segments)
+			return nil
+		})
+		require.NoError(t, partial.Process(ctx, segments))
+		require.Equal(t, len(segments), count)
+	})
+}
+
+func TestGolden(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	var segments []segmentloop.Segment
+	for i := 1; i <= 10; i++ {
+		segments = append(segments, rangedlooptest.Stream(uuid.UUID{byte(i)}, i, 1024, int32(i%3))...)
+	}
+
+	var total sizeCounter
+	var finished bool
+	rangedlooptest.Golden(ctx, t, "size", segments,
+		func() rangedloop.Observer {
+			return newSizeObserver(&total, &finished)
+		},
+		func(rangedloop.Observer) string {
+			return fmt.Sprintf("segments: %d\nsize: %d\n", total.segments, total.size)
+		})
+}
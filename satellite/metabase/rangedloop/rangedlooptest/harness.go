@@ -0,0 +1,89 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package rangedlooptest
+
+import (
+	"context"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/rangedloop"
+	"storj.io/storj/satellite/metabase/segmentloop"
+)
+
+var updateGolden = flag.Bool("update-golden", false, "update the golden files of the ranged loop observers")
+
+// Configs are the loop configurations, which the golden tests run the
+// observers with. The output of an observer mustn't depend on them.
+var Configs = []rangedloop.Config{
+	{Parallelism: 1, BatchSize: 1},
+	{Parallelism: 2, BatchSize: 3},
+	{Parallelism: 5, BatchSize: 2},
+	{Parallelism: 3, BatchSize: 100},
+}
+
+// Stream returns numSegments segments of the stream. The segments are remote,
+// unless inlineSize is positive, in which case the last segment is inline.
+func Stream(streamID uuid.UUID, numSegments int, remoteSize, inlineSize int32) []segmentloop.Segment {
+	var segments []segmentloop.Segment
+	for i := 0; i < numSegments; i++ {
+		segment := segmentloop.Segment{
+			StreamID:      streamID,
+			Position:      metabase.SegmentPosition{Index: uint32(i)},
+			EncryptedSize: remoteSize,
+			Pieces:        metabase.Pieces{{Number: 0}},
+		}
+		if inlineSize > 0 && i == numSegments-1 {
+			segment.EncryptedSize = inlineSize
+			segment.Pieces = nil
+		}
+		segments = append(segments, segment)
+	}
+	return segments
+}
+
+// RunOnce runs the observers once over the in-memory segments.
+func RunOnce(ctx context.Context, tb testing.TB, config rangedloop.Config, segments []segmentloop.Segment, observers ...rangedloop.Observer) {
+	service := rangedloop.NewService(zaptest.NewLogger(tb), config, &RangeSplitter{Segments: segments}, observers)
+	_, err := service.RunOnce(ctx)
+	require.NoError(tb, err)
+}
+
+// Golden runs a new observer over the segments with each of the Configs and
+// checks that the output of the observer is the same for all of them, and
+// that it matches the golden file testdata/<name>.golden.
+//
+// Run the tests with -update-golden to write the golden files.
+func Golden(ctx context.Context, t *testing.T, name string, segments []segmentloop.Segment, newObserver func() rangedloop.Observer, output func(rangedloop.Observer) string) {
+	var expected string
+	for i, config := range Configs {
+		observer := newObserver()
+		RunOnce(ctx, t, config, segments, observer)
+
+		actual := output(observer)
+		if i == 0 {
+			expected = actual
+			continue
+		}
+		require.Equal(t, expected, actual, "output depends on the loop config %+v", config)
+	}
+
+	path := filepath.Join("testdata", name+".golden")
+	if *updateGolden {
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, os.WriteFile(path, []byte(expected), 0644))
+		return
+	}
+
+	golden, err := os.ReadFile(path)
+	require.NoError(t, err, "run the test with -update-golden to create %s", path)
+	require.Equal(t, string(golden), expected)
+}
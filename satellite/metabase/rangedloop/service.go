@@ -5,6 +5,7 @@ package rangedloop
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/spacemonkeygo/monkit/v3"
@@ -69,7 +70,8 @@ func (service *Service) Run(ctx context.Context) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	for {
-		_, err := service.RunOnce(ctx)
+		// every iteration is a separate trace, so it can be sampled on its own.
+		_, err := service.RunOnce(monkit.ResetContextSpan(ctx))
 		if err != nil {
 			service.log.Error("ranged loop failure", zap.Error(err))
 
@@ -134,7 +136,7 @@ func createGoroutineClosure(ctx context.Context, rangeProvider SegmentProvider,
 		return rangeProvider.Iterate(ctx, func(segments []segmentloop.Segment) error {
 			for _, state := range states {
 				start := time.Now()
-				err := state.rangeObserver.Process(ctx, segments)
+				err := processObserver(ctx, state.rangeObserver, segments)
 				if err != nil {
 					return err
 				}
@@ -145,6 +147,19 @@ func createGoroutineClosure(ctx context.Context, rangeProvider SegmentProvider,
 	}
 }
 
+// processObserver passes the segments to the range observer. It's traced
+// separately, so the time spent by each observer is visible in the traces.
+func processObserver(ctx context.Context, rangeObserver Partial, segments []segmentloop.Segment) (err error) {
+	defer mon.Task()(&ctx, observerName(rangeObserver))(&err)
+
+	return rangeObserver.Process(ctx, segments)
+}
+
+// observerName returns the name of the observer used in the traces.
+func observerName(observer interface{}) string {
+	return fmt.Sprintf("%T", observer)
+}
+
 func startObservers(ctx context.Context, observers []Observer) (observerStates []observerState, err error) {
 	startTime := time.Now()
 
@@ -160,8 +175,10 @@ func startObservers(ctx context.Context, observers []Observer) (observerStates [
 	return observerStates, nil
 }
 
-func startObserver(ctx context.Context, startTime time.Time, observer Observer) (observerState, error) {
-	err := observer.Start(ctx, startTime)
+func startObserver(ctx context.Context, startTime time.Time, observer Observer) (_ observerState, err error) {
+	defer mon.Task()(&ctx, observerName(observer))(&err)
+
+	err = observer.Start(ctx, startTime)
 
 	return observerState{
 		observer: observer,
@@ -183,10 +200,12 @@ func finishObservers(ctx context.Context, observerStates []observerState) (obser
 
 // Iterating over the segments is done.
 // This is the reduce step.
-func finishObserver(ctx context.Context, state observerState) (ObserverDuration, error) {
+func finishObserver(ctx context.Context, state observerState) (_ ObserverDuration, err error) {
+	defer mon.Task()(&ctx, observerName(state.observer))(&err)
+
 	var duration time.Duration
 	for _, rangeObserver := range state.rangeObservers {
-		err := state.observer.Join(ctx, rangeObserver.rangeObserver)
+		err = state.observer.Join(ctx, rangeObserver.rangeObserver)
 		if err != nil {
 			return ObserverDuration{}, err
 		}
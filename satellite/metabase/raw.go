@@ -46,9 +46,10 @@ type RawSegment struct {
 	StreamID uuid.UUID
 	Position SegmentPosition
 
-	CreatedAt  time.Time // non-nillable
-	RepairedAt *time.Time
-	ExpiresAt  *time.Time
+	CreatedAt     time.Time // non-nillable
+	RepairedAt    *time.Time
+	ExpiresAt     *time.Time
+	LastAuditedAt *time.Time
 
 	RootPieceID       storj.PieceID
 	EncryptedKeyNonce []byte
@@ -67,6 +68,8 @@ type RawSegment struct {
 	Pieces     Pieces
 
 	Placement storj.PlacementConstraint
+	// SpreadScore is 0, when the spread of the pieces wasn't scored yet.
+	SpreadScore int32
 }
 
 // RawCopy contains a copy that is stored in the database.
@@ -110,6 +113,10 @@ func (db *DB) TestingDeleteAll(ctx context.Context) (err error) {
 		DELETE FROM objects;
 		DELETE FROM segments;
 		DELETE FROM segment_copies;
+		DELETE FROM bucket_tally_deltas;
+		DELETE FROM project_encryption_keys;
+		DELETE FROM bucket_lifecycles;
+		DELETE FROM idempotency_keys;
 		DELETE FROM node_aliases;
 		SELECT setval('node_alias_seq', 1, false);
 	`)
@@ -185,14 +192,15 @@ func (db *DB) testingGetAllSegments(ctx context.Context) (_ []RawSegment, err er
 	rows, err := db.db.QueryContext(ctx, `
 		SELECT
 			stream_id, position,
-			created_at, repaired_at, expires_at,
+			created_at, repaired_at, expires_at, last_audited_at,
 			root_piece_id, encrypted_key_nonce, encrypted_key,
 			encrypted_size,
 			plain_offset, plain_size,
 			encrypted_etag,
 			redundancy,
 			inline_data, remote_alias_pieces,
-			placement
+			placement,
+			COALESCE(spread_score, 0)
 		FROM segments
 		ORDER BY stream_id ASC, position ASC
 	`)
@@ -210,6 +218,7 @@ func (db *DB) testingGetAllSegments(ctx context.Context) (_ []RawSegment, err er
 			&seg.CreatedAt,
 			&seg.RepairedAt,
 			&seg.ExpiresAt,
+			&seg.LastAuditedAt,
 
 			&seg.RootPieceID,
 			&seg.EncryptedKeyNonce,
@@ -225,6 +234,7 @@ func (db *DB) testingGetAllSegments(ctx context.Context) (_ []RawSegment, err er
 			&seg.InlineData,
 			&aliasPieces,
 			&seg.Placement,
+			&seg.SpreadScore,
 		)
 		if err != nil {
 			return nil, Error.New("testingGetAllSegments scan failed: %w", err)
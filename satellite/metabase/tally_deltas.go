@@ -0,0 +1,327 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase
+
+import (
+	"context"
+	"database/sql"
+	"sort"
+	"time"
+
+	"storj.io/common/uuid"
+	"storj.io/private/dbutil/pgutil"
+	"storj.io/private/dbutil/txutil"
+	"storj.io/private/tagsql"
+)
+
+// tallyDeltas accumulates the changes of the committed objects of buckets.
+//
+// Only committed objects are tracked, pending objects are left to the full
+// tally. Expired objects are tracked when they are deleted.
+type tallyDeltas map[BucketLocation]*BucketTally
+
+// add adds the object to the deltas, or removes it when removed is set.
+func (deltas tallyDeltas) add(object Object, removed bool) {
+	if object.Status != Committed {
+		return
+	}
+
+	sign := int64(1)
+	if removed {
+		sign = -1
+	}
+
+	deltas.update(object.Location().Bucket(), BucketTally{
+		ObjectCount:   sign,
+		TotalSegments: sign * int64(object.SegmentCount),
+		TotalBytes:    sign * object.TotalEncryptedSize,
+		MetadataSize:  sign * int64(len(object.EncryptedMetadata)),
+	})
+}
+
+// update adds the changes to the deltas of the bucket.
+func (deltas tallyDeltas) update(location BucketLocation, change BucketTally) {
+	delta, ok := deltas[location]
+	if !ok {
+		delta = &BucketTally{BucketLocation: location}
+		deltas[location] = delta
+	}
+	delta.ObjectCount += change.ObjectCount
+	delta.TotalSegments += change.TotalSegments
+	delta.TotalBytes += change.TotalBytes
+	delta.MetadataSize += change.MetadataSize
+}
+
+// addAll adds all objects to the deltas, or removes them when removed is set.
+func (deltas tallyDeltas) addAll(objects []Object, removed bool) {
+	for _, object := range objects {
+		deltas.add(object, removed)
+	}
+}
+
+// execer executes queries with or without a transaction.
+type execer interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+}
+
+// queryer runs queries with or without a transaction.
+type queryer interface {
+	QueryContext(ctx context.Context, query string, args ...interface{}) (tagsql.Rows, error)
+}
+
+// recordTallyDeltas stores the deltas, when tally deltas are enabled.
+func (db *DB) recordTallyDeltas(ctx context.Context, tx execer, deltas tallyDeltas) (err error) {
+	if !db.config.TallyDeltas || len(deltas) == 0 {
+		return nil
+	}
+	defer mon.Task()(&ctx)(&err)
+
+	var projectIDs, ids []uuid.UUID
+	var bucketNames [][]byte
+	var objectCounts, totalSegments, totalBytes, metadataSizes []int64
+	for _, delta := range deltas {
+		if delta.ObjectCount == 0 && delta.TotalSegments == 0 && delta.TotalBytes == 0 && delta.MetadataSize == 0 {
+			continue
+		}
+
+		id, err := uuid.New()
+		if err != nil {
+			return Error.Wrap(err)
+		}
+
+		projectIDs = append(projectIDs, delta.ProjectID)
+		bucketNames = append(bucketNames, []byte(delta.BucketName))
+		ids = append(ids, id)
+		objectCounts = append(objectCounts, delta.ObjectCount)
+		totalSegments = append(totalSegments, delta.TotalSegments)
+		totalBytes = append(totalBytes, delta.TotalBytes)
+		metadataSizes = append(metadataSizes, delta.MetadataSize)
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO bucket_tally_deltas (
+			project_id, bucket_name, id,
+			object_count, total_segments, total_bytes, metadata_size
+		) SELECT
+			unnest($1::BYTEA[]), unnest($2::BYTEA[]), unnest($3::BYTEA[]),
+			unnest($4::INT8[]), unnest($5::INT8[]), unnest($6::INT8[]), unnest($7::INT8[])
+	`, pgutil.UUIDArray(projectIDs), pgutil.ByteaArray(bucketNames), pgutil.UUIDArray(ids),
+		pgutil.Int8Array(objectCounts), pgutil.Int8Array(totalSegments), pgutil.Int8Array(totalBytes), pgutil.Int8Array(metadataSizes))
+	if err != nil {
+		return Error.New("unable to record tally deltas: %w", err)
+	}
+
+	mon.Meter("tally_deltas_recorded").Mark(len(ids))
+	return nil
+}
+
+// ConsumeBucketTallyDeltas contains arguments for consuming the tally deltas.
+type ConsumeBucketTallyDeltas struct {
+	BatchSize int
+}
+
+// ConsumeBucketTallyDeltas removes all recorded tally deltas and returns them
+// summed up per bucket, ordered by bucket location. Every delta is returned
+// exactly once, even when deltas are recorded concurrently.
+func (db *DB) ConsumeBucketTallyDeltas(ctx context.Context, opts ConsumeBucketTallyDeltas) (result []BucketTally, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if opts.BatchSize <= 0 {
+		return nil, ErrInvalidRequest.New("BatchSize is negative or zero")
+	}
+
+	sums := tallyDeltas{}
+	for {
+		var consumed int
+		err := withRows(db.db.QueryContext(ctx, `
+			DELETE FROM bucket_tally_deltas
+			WHERE (project_id, bucket_name, id) IN (
+				SELECT project_id, bucket_name, id
+				FROM bucket_tally_deltas
+				LIMIT $1
+			)
+			RETURNING project_id, bucket_name, object_count, total_segments, total_bytes, metadata_size
+		`, opts.BatchSize))(func(rows tagsql.Rows) error {
+			for rows.Next() {
+				var delta BucketTally
+				if err := rows.Scan(&delta.ProjectID, &delta.BucketName,
+					&delta.ObjectCount, &delta.TotalSegments, &delta.TotalBytes, &delta.MetadataSize); err != nil {
+					return err
+				}
+				consumed++
+
+				sums.update(delta.BucketLocation, delta)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, Error.New("unable to consume tally deltas: %w", err)
+		}
+		if consumed < opts.BatchSize {
+			break
+		}
+	}
+
+	result = make([]BucketTally, 0, len(sums))
+	for _, sum := range sums {
+		result = append(result, *sum)
+	}
+	sort.Slice(result, func(i, k int) bool {
+		if result[i].ProjectID == result[k].ProjectID {
+			return result[i].BucketName < result[k].BucketName
+		}
+		return result[i].ProjectID.Less(result[k].ProjectID)
+	})
+
+	mon.IntVal("tally_deltas_consumed_buckets").Observe(int64(len(result)))
+	return result, nil
+}
+
+// BucketTallyDeltaKey identifies a recorded tally delta.
+type BucketTallyDeltaKey struct {
+	BucketLocation
+	ID uuid.UUID
+}
+
+// CollectBucketTalliesWithDeltas collects the bucket tallies like
+// CollectBucketTallies, but it also returns the keys of the tally deltas of
+// the buckets, which are already part of the tallies.
+//
+// The tallies and the deltas are read from the same database snapshot, so a
+// delta is returned exactly when the change it records is visible to the
+// tallies, regardless of when the delta was created. Only committed objects
+// are counted, including the expired ones which aren't deleted yet, because
+// that is what the deltas track.
+func (db *DB) CollectBucketTalliesWithDeltas(ctx context.Context, opts CollectBucketTallies) (tallies []BucketTally, deltas []BucketTallyDeltaKey, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := opts.Verify(); err != nil {
+		return nil, nil, err
+	}
+
+	collect := func(ctx context.Context, q queryer, asOf string) error {
+		tallies, deltas = nil, nil
+
+		err := withRows(q.QueryContext(ctx, `
+			SELECT project_id, bucket_name, SUM(total_encrypted_size), SUM(segment_count), COALESCE(SUM(length(encrypted_metadata)), 0), count(*)
+			FROM objects
+			`+asOf+`
+			WHERE (project_id, bucket_name) BETWEEN ($1, $2) AND ($3, $4) AND
+			status = `+committedStatus+`
+			GROUP BY (project_id, bucket_name)
+			ORDER BY (project_id, bucket_name) ASC
+		`, opts.From.ProjectID, []byte(opts.From.BucketName), opts.To.ProjectID, []byte(opts.To.BucketName)))(func(rows tagsql.Rows) error {
+			for rows.Next() {
+				var tally BucketTally
+				if err := rows.Scan(
+					&tally.ProjectID, &tally.BucketName,
+					&tally.TotalBytes, &tally.TotalSegments,
+					&tally.MetadataSize, &tally.ObjectCount,
+				); err != nil {
+					return err
+				}
+				tallies = append(tallies, tally)
+			}
+			return nil
+		})
+		if err != nil {
+			return Error.New("unable to query bucket tally: %w", err)
+		}
+
+		err = withRows(q.QueryContext(ctx, `
+			SELECT project_id, bucket_name, id
+			FROM bucket_tally_deltas
+			`+asOf+`
+			WHERE (project_id, bucket_name) BETWEEN ($1, $2) AND ($3, $4)
+		`, opts.From.ProjectID, []byte(opts.From.BucketName), opts.To.ProjectID, []byte(opts.To.BucketName)))(func(rows tagsql.Rows) error {
+			for rows.Next() {
+				var key BucketTallyDeltaKey
+				if err := rows.Scan(&key.ProjectID, &key.BucketName, &key.ID); err != nil {
+					return err
+				}
+				deltas = append(deltas, key)
+			}
+			return nil
+		})
+		if err != nil {
+			return Error.New("unable to query tally deltas: %w", err)
+		}
+		return nil
+	}
+
+	// a fixed AS OF SYSTEM TIME makes both queries read the same snapshot,
+	// without it they have to share a transaction.
+	if asOf := db.snapshotAsOfTime(opts.AsOfSystemTime, opts.AsOfSystemInterval); asOf != "" {
+		err = collect(ctx, db.db, asOf)
+	} else {
+		err = txutil.WithTx(ctx, db.db, &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true}, func(ctx context.Context, tx tagsql.Tx) error {
+			return collect(ctx, tx, "")
+		})
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	return tallies, deltas, nil
+}
+
+// snapshotAsOfTime returns an AS OF SYSTEM TIME clause with a fixed time,
+// unlike asOfTime, which may return an interval relative to the query.
+func (db *DB) snapshotAsOfTime(baseline time.Time, maxInterval time.Duration) string {
+	now := time.Now()
+	// maxInterval is negative
+	if maxInterval < 0 && (baseline.IsZero() || now.Sub(baseline) > -maxInterval) {
+		baseline = now.Add(maxInterval)
+	}
+	if baseline.IsZero() || baseline.After(now) {
+		return ""
+	}
+	return db.impl.AsOfSystemTime(baseline)
+}
+
+// DeleteBucketTallyDeltas contains arguments for deleting the tally deltas,
+// which are included in a full tally.
+type DeleteBucketTallyDeltas struct {
+	Keys      []BucketTallyDeltaKey
+	BatchSize int
+}
+
+// DeleteBucketTallyDeltas removes the tally deltas with the keys.
+func (db *DB) DeleteBucketTallyDeltas(ctx context.Context, opts DeleteBucketTallyDeltas) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if opts.BatchSize <= 0 {
+		return ErrInvalidRequest.New("BatchSize is negative or zero")
+	}
+
+	for keys := opts.Keys; len(keys) > 0; {
+		batch := keys
+		if len(batch) > opts.BatchSize {
+			batch = batch[:opts.BatchSize]
+		}
+		keys = keys[len(batch):]
+
+		projectIDs := make([]uuid.UUID, len(batch))
+		bucketNames := make([][]byte, len(batch))
+		ids := make([]uuid.UUID, len(batch))
+		for i, key := range batch {
+			projectIDs[i] = key.ProjectID
+			bucketNames[i] = []byte(key.BucketName)
+			ids[i] = key.ID
+		}
+
+		_, err = db.db.ExecContext(ctx, `
+			DELETE FROM bucket_tally_deltas
+			WHERE (project_id, bucket_name, id) IN (
+				SELECT unnest($1::BYTEA[]), unnest($2::BYTEA[]), unnest($3::BYTEA[])
+			)
+		`, pgutil.UUIDArray(projectIDs), pgutil.ByteaArray(bucketNames), pgutil.UUIDArray(ids))
+		if err != nil {
+			return Error.New("unable to delete tally deltas: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,186 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/metabasetest"
+)
+
+func TestBucketTallyDeltas(t *testing.T) {
+	metabasetest.RunWithConfig(t, metabase.Config{
+		ApplicationName:  "satellite-test",
+		MinPartSize:      5 * 1024 * 1024,
+		MaxNumberOfParts: 10000,
+		ServerSideCopy:   true,
+		TallyDeltas:      true,
+	}, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		consume := func(t *testing.T) []metabase.BucketTally {
+			deltas, err := db.ConsumeBucketTallyDeltas(ctx, metabase.ConsumeBucketTallyDeltas{BatchSize: 2})
+			require.NoError(t, err)
+			return deltas
+		}
+
+		t.Run("invalid batch size", func(t *testing.T) {
+			_, err := db.ConsumeBucketTallyDeltas(ctx, metabase.ConsumeBucketTallyDeltas{})
+			require.True(t, metabase.ErrInvalidRequest.Has(err))
+		})
+
+		t.Run("commit and delete", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			obj := metabasetest.RandObjectStream()
+			object := metabasetest.CreateObject(ctx, t, db, obj, 2)
+
+			other := metabasetest.RandObjectStream()
+			other.ProjectID = obj.ProjectID
+			metabasetest.CreatePendingObject(ctx, t, db, other, 0)
+
+			location := obj.Location().Bucket()
+			require.Equal(t, []metabase.BucketTally{{
+				BucketLocation: location,
+				ObjectCount:    1,
+				TotalSegments:  2,
+				TotalBytes:     object.TotalEncryptedSize,
+			}}, consume(t))
+			require.Empty(t, consume(t))
+
+			_, err := db.DeleteObjectExactVersion(ctx, metabase.DeleteObjectExactVersion{
+				ObjectLocation: obj.Location(),
+				Version:        obj.Version,
+			})
+			require.NoError(t, err)
+
+			require.Equal(t, []metabase.BucketTally{{
+				BucketLocation: location,
+				ObjectCount:    -1,
+				TotalSegments:  -2,
+				TotalBytes:     -object.TotalEncryptedSize,
+			}}, consume(t))
+		})
+
+		t.Run("summed in batches", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			obj := metabasetest.RandObjectStream()
+			var totalBytes int64
+			for i := 0; i < 5; i++ {
+				obj.ObjectKey = metabasetest.RandObjectKey()
+				obj.StreamID = testrand.UUID()
+				totalBytes += metabasetest.CreateObject(ctx, t, db, obj, 1).TotalEncryptedSize
+			}
+
+			require.Equal(t, []metabase.BucketTally{{
+				BucketLocation: obj.Location().Bucket(),
+				ObjectCount:    5,
+				TotalSegments:  5,
+				TotalBytes:     totalBytes,
+			}}, consume(t))
+		})
+
+		t.Run("full tally snapshot", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			obj := metabasetest.RandObjectStream()
+			object := metabasetest.CreateObject(ctx, t, db, obj, 1)
+
+			pending := metabasetest.RandObjectStream()
+			pending.ProjectID, pending.BucketName = obj.ProjectID, obj.BucketName
+			metabasetest.CreatePendingObject(ctx, t, db, pending, 0)
+
+			location := obj.Location().Bucket()
+			tallies, deltas, err := db.CollectBucketTalliesWithDeltas(ctx, metabase.CollectBucketTallies{
+				From: location,
+				To:   location,
+			})
+			require.NoError(t, err)
+			require.Equal(t, []metabase.BucketTally{{
+				BucketLocation: location,
+				ObjectCount:    1,
+				TotalSegments:  1,
+				TotalBytes:     object.TotalEncryptedSize,
+			}}, tallies)
+			require.Len(t, deltas, 1)
+
+			// a change after the snapshot isn't part of the full tally.
+			other := obj
+			other.ObjectKey = metabasetest.RandObjectKey()
+			other.StreamID = testrand.UUID()
+			otherObject := metabasetest.CreateObject(ctx, t, db, other, 1)
+
+			err = db.DeleteBucketTallyDeltas(ctx, metabase.DeleteBucketTallyDeltas{
+				Keys:      deltas,
+				BatchSize: 1,
+			})
+			require.NoError(t, err)
+			require.Equal(t, []metabase.BucketTally{{
+				BucketLocation: location,
+				ObjectCount:    1,
+				TotalSegments:  1,
+				TotalBytes:     otherObject.TotalEncryptedSize,
+			}}, consume(t))
+		})
+
+		t.Run("update metadata", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			obj := metabasetest.RandObjectStream()
+			metabasetest.CreateObject(ctx, t, db, obj, 1)
+			require.Len(t, consume(t), 1)
+
+			err := db.UpdateObjectMetadata(ctx, metabase.UpdateObjectMetadata{
+				ProjectID:              obj.ProjectID,
+				BucketName:             obj.BucketName,
+				ObjectKey:              obj.ObjectKey,
+				StreamID:               obj.StreamID,
+				EncryptedMetadata:      testrand.Bytes(64),
+				EncryptedMetadataNonce: testrand.Nonce().Bytes(),
+			})
+			require.NoError(t, err)
+			require.Equal(t, []metabase.BucketTally{{
+				BucketLocation: obj.Location().Bucket(),
+				MetadataSize:   64,
+			}}, consume(t))
+
+			err = db.UpdateObjectMetadata(ctx, metabase.UpdateObjectMetadata{
+				ProjectID:  obj.ProjectID,
+				BucketName: obj.BucketName,
+				ObjectKey:  obj.ObjectKey,
+				StreamID:   obj.StreamID,
+			})
+			require.NoError(t, err)
+			require.Equal(t, []metabase.BucketTally{{
+				BucketLocation: obj.Location().Bucket(),
+				MetadataSize:   -64,
+			}}, consume(t))
+		})
+
+		t.Run("delete expired", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			obj := metabasetest.RandObjectStream()
+			object := metabasetest.CreateExpiredObject(ctx, t, db, obj, 2, time.Now().Add(-time.Hour))
+			require.Len(t, consume(t), 1)
+
+			err := db.DeleteExpiredObjects(ctx, metabase.DeleteExpiredObjects{
+				ExpiredBefore: time.Now(),
+				BatchSize:     10,
+			})
+			require.NoError(t, err)
+			require.Equal(t, []metabase.BucketTally{{
+				BucketLocation: obj.Location().Bucket(),
+				ObjectCount:    -1,
+				TotalSegments:  -2,
+				TotalBytes:     -object.TotalEncryptedSize,
+			}}, consume(t))
+		})
+	})
+}
@@ -27,6 +27,9 @@ type UpdateSegmentPieces struct {
 	NewPieces     Pieces
 
 	NewRepairedAt time.Time // sets new time of last segment repair (optional).
+	// NewSpreadScore sets the geographic spread score of the new pieces
+	// (optional).
+	NewSpreadScore int32
 }
 
 // UpdateSegmentPieces updates pieces for specified segment. If provided old pieces
@@ -62,6 +65,10 @@ func (db *DB) UpdateSegmentPieces(ctx context.Context, opts UpdateSegmentPieces)
 		return err
 	}
 
+	if opts.NewSpreadScore < 0 {
+		return ErrInvalidRequest.New("NewSpreadScore negative")
+	}
+
 	updateRepairAt := !opts.NewRepairedAt.IsZero()
 
 	oldPieces, err := db.aliasCache.EnsurePiecesToAliases(ctx, opts.OldPieces)
@@ -88,12 +95,16 @@ func (db *DB) UpdateSegmentPieces(ctx context.Context, opts UpdateSegmentPieces)
 			repaired_at = CASE
 				WHEN remote_alias_pieces = $3 AND $7 = true THEN $6
 				ELSE repaired_at
+			END,
+			spread_score = CASE
+				WHEN remote_alias_pieces = $3 AND $8 > 0 THEN $8
+				ELSE spread_score
 			END
 		WHERE
 			stream_id     = $1 AND
 			position      = $2
 		RETURNING remote_alias_pieces
-		`, opts.StreamID, opts.Position, oldPieces, newPieces, redundancyScheme{&opts.NewRedundancy}, opts.NewRepairedAt, updateRepairAt).
+		`, opts.StreamID, opts.Position, oldPieces, newPieces, redundancyScheme{&opts.NewRedundancy}, opts.NewRepairedAt, updateRepairAt, opts.NewSpreadScore).
 		Scan(&resultPieces)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
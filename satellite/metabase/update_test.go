@@ -216,6 +216,23 @@ func TestUpdateSegmentPieces(t *testing.T) {
 			metabasetest.Verify{}.Check(ctx, t, db)
 		})
 
+		t.Run("NewSpreadScore negative", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			metabasetest.UpdateSegmentPieces{
+				Opts: metabase.UpdateSegmentPieces{
+					StreamID:       obj.StreamID,
+					Position:       metabase.SegmentPosition{Index: 1},
+					OldPieces:      validPieces,
+					NewRedundancy:  metabasetest.DefaultRedundancy,
+					NewPieces:      validPieces,
+					NewSpreadScore: -1,
+				},
+				ErrClass: &metabase.ErrInvalidRequest,
+				ErrText:  "NewSpreadScore negative",
+			}.Check(ctx, t, db)
+		})
+
 		t.Run("segment not found", func(t *testing.T) {
 			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
 
@@ -371,12 +388,15 @@ func TestUpdateSegmentPieces(t *testing.T) {
 					NewRedundancy: segment.Redundancy,
 					NewPieces:     expectedPieces,
 					NewRepairedAt: repairedAt,
+
+					NewSpreadScore: 2,
 				},
 			}.Check(ctx, t, db)
 
 			expectedSegment := segment
 			expectedSegment.Pieces = expectedPieces
 			expectedSegment.RepairedAt = &repairedAt
+			expectedSegment.SpreadScore = 2
 
 			segments, err := db.TestingAllSegments(ctx)
 			require.NoError(t, err)
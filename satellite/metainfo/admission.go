@@ -0,0 +1,131 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metainfo
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+
+	"storj.io/common/rpc/rpcstatus"
+)
+
+// AdmissionConfig configures the admission control of the metainfo requests.
+type AdmissionConfig struct {
+	Enabled                bool          `help:"whether low priority requests are held back when the metabase is slow" default:"false"`
+	BulkLatencyThreshold   time.Duration `help:"metabase query latency above which listing requests are held back" default:"250ms"`
+	UploadLatencyThreshold time.Duration `help:"metabase query latency above which upload requests are held back" default:"1s"`
+	QueueTimeout           time.Duration `help:"how long held back requests wait for the latency to recover, before they are rejected" default:"1s"`
+	MaxQueued              int           `help:"maximum number of held back requests, further requests are rejected immediately" default:"1000"`
+}
+
+// requestClass is the priority class of a metainfo request.
+type requestClass int
+
+const (
+	// requestInteractive requests, e.g. downloads, are never held back.
+	requestInteractive requestClass = iota
+	// requestUpload requests are held back, when the latency is high.
+	requestUpload
+	// requestBulk requests, e.g. listings, are held back first.
+	requestBulk
+)
+
+// String returns the name of the class used in metrics.
+func (class requestClass) String() string {
+	switch class {
+	case requestInteractive:
+		return "interactive"
+	case requestUpload:
+		return "upload"
+	case requestBulk:
+		return "bulk"
+	default:
+		return "unknown"
+	}
+}
+
+// admissionPollInterval is how often the held back requests check whether the
+// latency has recovered.
+const admissionPollInterval = 25 * time.Millisecond
+
+// admissionController holds back and sheds low priority requests, when the
+// metabase query latency crosses the thresholds, so interactive requests can
+// still be served.
+type admissionController struct {
+	config  AdmissionConfig
+	latency func() time.Duration
+	queued  int64
+}
+
+func newAdmissionController(config AdmissionConfig, latency func() time.Duration) *admissionController {
+	return &admissionController{
+		config:  config,
+		latency: latency,
+	}
+}
+
+// threshold returns the latency threshold of the class.
+func (controller *admissionController) threshold(class requestClass) time.Duration {
+	switch class {
+	case requestUpload:
+		return controller.config.UploadLatencyThreshold
+	case requestBulk:
+		return controller.config.BulkLatencyThreshold
+	default:
+		return 0
+	}
+}
+
+// overloaded returns whether requests of the class should be held back.
+func (controller *admissionController) overloaded(class requestClass) bool {
+	threshold := controller.threshold(class)
+	return threshold > 0 && controller.latency() > threshold
+}
+
+// Admit waits until a request of the class can be handled. It returns an
+// error, when the request should be rejected.
+func (controller *admissionController) Admit(ctx context.Context, class requestClass) (err error) {
+	if !controller.config.Enabled || !controller.overloaded(class) {
+		return nil
+	}
+
+	defer mon.Task()(&ctx)(&err)
+
+	tag := monkit.NewSeriesTag("class", class.String())
+
+	if atomic.AddInt64(&controller.queued, 1) > int64(controller.config.MaxQueued) {
+		atomic.AddInt64(&controller.queued, -1)
+		mon.Counter("metainfo_admission_shed", tag).Inc(1)
+		return rpcstatus.Error(rpcstatus.Unavailable, "satellite is overloaded, please retry later")
+	}
+	defer atomic.AddInt64(&controller.queued, -1)
+
+	mon.Counter("metainfo_admission_queued", tag).Inc(1)
+
+	start := time.Now()
+	defer func() { mon.DurationVal("metainfo_admission_wait", tag).Observe(time.Since(start)) }()
+
+	timeout := time.NewTimer(controller.config.QueueTimeout)
+	defer timeout.Stop()
+
+	ticker := time.NewTicker(admissionPollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return rpcstatus.Wrap(rpcstatus.Canceled, ctx.Err())
+		case <-timeout.C:
+			mon.Counter("metainfo_admission_shed", tag).Inc(1)
+			return rpcstatus.Error(rpcstatus.Unavailable, "satellite is overloaded, please retry later")
+		case <-ticker.C:
+			if !controller.overloaded(class) {
+				return nil
+			}
+		}
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metainfo
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/common/testcontext"
+)
+
+func TestAdmissionController(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	var latency int64
+	setLatency := func(d time.Duration) { atomic.StoreInt64(&latency, int64(d)) }
+
+	controller := newAdmissionController(AdmissionConfig{
+		Enabled:                true,
+		BulkLatencyThreshold:   100 * time.Millisecond,
+		UploadLatencyThreshold: time.Second,
+		QueueTimeout:           100 * time.Millisecond,
+		MaxQueued:              10,
+	}, func() time.Duration { return time.Duration(atomic.LoadInt64(&latency)) })
+
+	// nothing is held back, when the latency is low.
+	for _, class := range []requestClass{requestInteractive, requestUpload, requestBulk} {
+		require.NoError(t, controller.Admit(ctx, class))
+	}
+
+	// only bulk requests are rejected above the bulk threshold.
+	setLatency(500 * time.Millisecond)
+	require.NoError(t, controller.Admit(ctx, requestInteractive))
+	require.NoError(t, controller.Admit(ctx, requestUpload))
+	err := controller.Admit(ctx, requestBulk)
+	require.Equal(t, rpcstatus.Unavailable, rpcstatus.Code(err))
+
+	// uploads are rejected above the upload threshold.
+	setLatency(2 * time.Second)
+	require.NoError(t, controller.Admit(ctx, requestInteractive))
+	err = controller.Admit(ctx, requestUpload)
+	require.Equal(t, rpcstatus.Unavailable, rpcstatus.Code(err))
+
+	// held back requests are admitted, when the latency recovers.
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		setLatency(0)
+	}()
+	controller.config.QueueTimeout = time.Minute
+	require.NoError(t, controller.Admit(ctx, requestBulk))
+
+	// held back requests stop waiting, when they are canceled.
+	setLatency(time.Minute)
+	canceledCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	err = controller.Admit(canceledCtx, requestBulk)
+	require.Equal(t, rpcstatus.Canceled, rpcstatus.Code(err))
+
+	// requests are rejected immediately, when too many are held back.
+	controller.config.MaxQueued = 0
+	err = controller.Admit(ctx, requestBulk)
+	require.Equal(t, rpcstatus.Unavailable, rpcstatus.Code(err))
+
+	// admission control can be disabled.
+	controller.config.Enabled = false
+	require.NoError(t, controller.Admit(ctx, requestBulk))
+}
@@ -4,7 +4,9 @@
 package metainfo
 
 import (
+	"encoding/hex"
 	"fmt"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -12,6 +14,8 @@ import (
 	"github.com/vivint/infectious"
 
 	"storj.io/common/memory"
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
 	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/metabase/segmentloop"
 	"storj.io/storj/satellite/metainfo/piecedeletion"
@@ -104,24 +108,234 @@ func (rs *RSConfig) RedundancyStrategy() (eestream.RedundancyStrategy, error) {
 	return eestream.NewRedundancyStrategy(erasureScheme, rs.Repair, rs.Success)
 }
 
+// PlacementRSConfig are the redundancy schemes of the placements, which differ
+// from the default redundancy scheme.
+//
+// Can be used as a flag.
+type PlacementRSConfig struct {
+	schemes map[storj.PlacementConstraint]RSConfig
+}
+
+// Type implements pflag.Value.
+func (PlacementRSConfig) Type() string { return "metainfo.PlacementRSConfig" }
+
+// String is required for pflag.Value.
+func (config *PlacementRSConfig) String() string {
+	if config == nil {
+		return ""
+	}
+	placements := config.Placements()
+	sort.Slice(placements, func(i, k int) bool { return placements[i] < placements[k] })
+
+	entries := make([]string, 0, len(placements))
+	for _, placement := range placements {
+		rs := config.schemes[placement]
+		entries = append(entries, strconv.Itoa(int(placement))+":"+rs.String())
+	}
+	return strings.Join(entries, ",")
+}
+
+// Set sets the value from a string in the format placement:k/m/o/n-size,placement:k/m/o/n-size.
+func (config *PlacementRSConfig) Set(s string) error {
+	parsed := map[storj.PlacementConstraint]RSConfig{}
+	for _, entry := range strings.Split(s, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		info := strings.Split(entry, ":")
+		if len(info) != 2 {
+			return Error.New("Invalid placement RS config (expected format placement:k/m/o/n-ShareSize, got %s)", entry)
+		}
+
+		placement, err := strconv.ParseUint(strings.TrimSpace(info[0]), 10, 16)
+		if err != nil {
+			return Error.New("Invalid placement in placement RS config: '%s', %w", info[0], err)
+		}
+		if _, ok := parsed[storj.PlacementConstraint(placement)]; ok {
+			return Error.New("Duplicate placement in placement RS config: %d", placement)
+		}
+
+		var rs RSConfig
+		if err := rs.Set(strings.TrimSpace(info[1])); err != nil {
+			return err
+		}
+
+		parsed[storj.PlacementConstraint(placement)] = rs
+	}
+	config.schemes = parsed
+	return nil
+}
+
+// Get returns the redundancy scheme of the placement. ok is false, when the
+// placement uses the default redundancy scheme.
+func (config PlacementRSConfig) Get(placement storj.PlacementConstraint) (_ RSConfig, ok bool) {
+	rs, ok := config.schemes[placement]
+	return rs, ok
+}
+
+// Placements returns the placements, whose redundancy scheme differs from the
+// default.
+func (config PlacementRSConfig) Placements() []storj.PlacementConstraint {
+	placements := make([]storj.PlacementConstraint, 0, len(config.schemes))
+	for placement := range config.schemes {
+		placements = append(placements, placement)
+	}
+	return placements
+}
+
+// InlineSegmentSizes are the maximum inline segment sizes of the projects,
+// which differ from the default maximum inline segment size.
+//
+// Can be used as a flag.
+type InlineSegmentSizes struct {
+	sizes map[uuid.UUID]memory.Size
+}
+
+// Type implements pflag.Value.
+func (InlineSegmentSizes) Type() string { return "metainfo.InlineSegmentSizes" }
+
+// String is required for pflag.Value.
+func (sizes *InlineSegmentSizes) String() string {
+	if sizes == nil {
+		return ""
+	}
+	entries := make([]string, 0, len(sizes.sizes))
+	for projectID, size := range sizes.sizes {
+		entries = append(entries, projectID.String()+":"+size.String())
+	}
+	sort.Strings(entries)
+	return strings.Join(entries, ",")
+}
+
+// Set sets the value from a string in the format project-id:size,project-id:size.
+func (sizes *InlineSegmentSizes) Set(s string) error {
+	parsed := map[uuid.UUID]memory.Size{}
+	for _, entry := range strings.Split(s, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		info := strings.Split(entry, ":")
+		if len(info) != 2 {
+			return Error.New("Invalid inline segment size (expected format project-id:size, got %s)", entry)
+		}
+
+		projectID, err := uuid.FromString(strings.TrimSpace(info[0]))
+		if err != nil {
+			return Error.New("Invalid project id in inline segment size: '%s', %w", info[0], err)
+		}
+
+		var size memory.Size
+		if err := size.Set(strings.TrimSpace(info[1])); err != nil {
+			return Error.New("Invalid size in inline segment size: '%s', %w", info[1], err)
+		}
+
+		parsed[projectID] = size
+	}
+	sizes.sizes = parsed
+	return nil
+}
+
+// Get returns the maximum inline segment size of the project. ok is false,
+// when the project uses the default maximum inline segment size.
+func (sizes InlineSegmentSizes) Get(projectID uuid.UUID) (_ memory.Size, ok bool) {
+	size, ok := sizes.sizes[projectID]
+	return size, ok
+}
+
+// Projects returns the projects, whose maximum inline segment size differs
+// from the default.
+func (sizes InlineSegmentSizes) Projects() []uuid.UUID {
+	projects := make([]uuid.UUID, 0, len(sizes.sizes))
+	for projectID := range sizes.sizes {
+		projects = append(projects, projectID)
+	}
+	return projects
+}
+
+// MetadataEncryptionKey is the master key, from which the per project keys for
+// encrypting the object metadata at rest are derived.
+//
+// Can be used as a flag.
+type MetadataEncryptionKey struct {
+	key *[32]byte
+}
+
+// Type implements pflag.Value.
+func (MetadataEncryptionKey) Type() string { return "metainfo.MetadataEncryptionKey" }
+
+// String is required for pflag.Value.
+func (key *MetadataEncryptionKey) String() string {
+	if key == nil || key.key == nil {
+		return ""
+	}
+	return hex.EncodeToString(key.key[:])
+}
+
+// Set sets the value from a hex-encoded 32 byte key.
+func (key *MetadataEncryptionKey) Set(s string) error {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		key.key = nil
+		return nil
+	}
+
+	decoded, err := hex.DecodeString(s)
+	if err != nil {
+		return Error.New("Invalid metadata encryption key: %w", err)
+	}
+	if len(decoded) != 32 {
+		return Error.New("Invalid metadata encryption key length (expected 32 bytes, got %d)", len(decoded))
+	}
+
+	key.key = new([32]byte)
+	copy(key.key[:], decoded)
+	return nil
+}
+
+// KeyManager returns the key manager using the key, nil when the key is not set.
+func (key MetadataEncryptionKey) KeyManager() metabase.KeyManager {
+	if key.key == nil {
+		return nil
+	}
+	return metabase.NewStaticKeyManager(*key.key)
+}
+
+// NetworkRulesConfig is a configuration struct for enforcing the network rules
+// of projects.
+type NetworkRulesConfig struct {
+	Enabled         bool          `help:"whether the network rules of projects are enforced" default:"true"`
+	CacheCapacity   int           `help:"number of projects to cache the network rules of" releaseDefault:"10000" devDefault:"10" testDefault:"100"`
+	CacheExpiration time.Duration `help:"how long the network rules of projects are cached" releaseDefault:"1m" devDefault:"10s"`
+}
+
 // RateLimiterConfig is a configuration struct for endpoint rate limiting.
 type RateLimiterConfig struct {
 	Enabled         bool          `help:"whether rate limiting is enabled." releaseDefault:"true" devDefault:"true"`
 	Rate            float64       `help:"request rate per project per second." releaseDefault:"100" devDefault:"100" testDefault:"1000"`
 	CacheCapacity   int           `help:"number of projects to cache." releaseDefault:"10000" devDefault:"10" testDefault:"100"`
 	CacheExpiration time.Duration `help:"how long to cache the projects limiter." releaseDefault:"10m" devDefault:"10s"`
+
+	Backend              string        `help:"redis url of the rate limiter shared by the api instances (redis://host:port?db=N), when empty the limits are enforced per instance" default:""`
+	BackendRetryInterval time.Duration `help:"how long the per instance rate limiter is used after the shared rate limiter failed" default:"10s"`
 }
 
 // ProjectLimitConfig is a configuration struct for default project limits.
 type ProjectLimitConfig struct {
 	MaxBuckets int `help:"max bucket count for a project." default:"100" testDefault:"10"`
+
+	ValidateSegmentLimitAtCommit bool `help:"reject committing objects of projects over their segment limit, which catches concurrent uploads passing the checks when they begin" default:"true"`
 }
 
 // Config is a configuration struct that is everything you need to start a metainfo.
 type Config struct {
-	DatabaseURL          string      `help:"the database connection string to use" default:"postgres://"`
-	MinRemoteSegmentSize memory.Size `default:"1240" testDefault:"0" help:"minimum remote segment size"` // TODO: fix tests to work with 1024
-	MaxInlineSegmentSize memory.Size `default:"4KiB" help:"maximum inline segment size"`
+	DatabaseURL          string             `help:"the database connection string to use, the connection pool is sized with the max_open_conns, max_idle_conns and conn_max_lifetime url parameters, transaction_pooling=true makes it compatible with transaction pooling proxies" default:"postgres://"`
+	MinRemoteSegmentSize memory.Size        `default:"1240" testDefault:"0" help:"minimum remote segment size"` // TODO: fix tests to work with 1024
+	MaxInlineSegmentSize memory.Size        `default:"4KiB" help:"maximum inline segment size"`
+	InlineSegmentSizes   InlineSegmentSizes `default:"" help:"comma-separated maximum inline segment sizes of projects, which differ from the maximum inline segment size, in the format project-id:size"`
 	// we have such default value because max value for ObjectKey is 1024(1 Kib) but EncryptedObjectKey
 	// has encryption overhead 16 bytes. So overall size is 1024 + 16 * 16.
 	MaxEncryptedObjectKeyLength int                  `default:"1280" help:"maximum encrypted object key length"`
@@ -132,25 +346,40 @@ type Config struct {
 	MaxNumberOfParts            int                  `default:"10000" help:"maximum number of parts object can contain"`
 	Overlay                     bool                 `default:"true" help:"toggle flag if overlay is enabled"`
 	RS                          RSConfig             `releaseDefault:"29/35/80/110-256B" devDefault:"4/6/8/10-256B" help:"redundancy scheme configuration in the format k/m/o/n-sharesize"`
+	PlacementRS                 PlacementRSConfig    `default:"" help:"comma-separated redundancy schemes of the new objects of placements, which differ from the default redundancy scheme, in the format placement:k/m/o/n-sharesize"`
 	SegmentLoop                 segmentloop.Config   `help:"segment loop configuration"`
 	RateLimiter                 RateLimiterConfig    `help:"rate limiter configuration"`
+	NetworkRules                NetworkRulesConfig   `help:"project network rules configuration"`
 	ProjectLimits               ProjectLimitConfig   `help:"project limit configuration"`
 	PieceDeletion               piecedeletion.Config `help:"piece deletion configuration"`
+	Admission                   AdmissionConfig      `help:"admission control configuration"`
 	// TODO remove this flag when server-side copy implementation will be finished
 	ServerSideCopy         bool `help:"enable code for server-side copy, deprecated. please leave this to true." default:"true"`
 	ServerSideCopyDisabled bool `help:"disable already enabled server-side copy. this is because once server side copy is enabled, delete code should stay changed, even if you want to disable server side copy" default:"false"`
 	MultipleVersions       bool `help:"feature flag to enable using multple objects versions in the system internally" default:"false"`
+	TallyDeltas            bool `help:"record the changes of committed objects, so tally can update bucket tallies incrementally" default:"false"`
 	// TODO remove when we benchmarking are done and decision is made.
 	TestListingQuery bool `default:"false" help:"test the new query for non-recursive listing"`
+
+	MetabaseSlowQueryThreshold time.Duration `default:"1s" help:"metabase queries taking longer than this are logged with the shapes of their arguments, disabled when 0"`
+
+	MetadataEncryptionKey MetadataEncryptionKey `default:"" help:"hex-encoded 32 byte master key for encrypting the object metadata at rest with per project keys, empty stores the metadata as sent by the uplink"`
+
+	MaxRequestTimeout time.Duration `default:"10m" help:"maximum time a metainfo request is handled, before it and its database queries are canceled. clients can send shorter timeouts. 0 only applies the timeouts of the clients"`
+
+	IdempotencyKeyTTL time.Duration `default:"24h" help:"how long the responses of BeginObject, CommitObject and BeginDeleteObject requests with idempotency keys are kept for replaying them to retries, 0 ignores the idempotency keys"`
 }
 
 // Metabase constructs Metabase configuration based on Metainfo configuration with specific application name.
 func (c Config) Metabase(applicationName string) metabase.Config {
 	return metabase.Config{
-		ApplicationName:  applicationName,
-		MinPartSize:      c.MinPartSize,
-		MaxNumberOfParts: c.MaxNumberOfParts,
-		ServerSideCopy:   c.ServerSideCopy,
-		MultipleVersions: c.MultipleVersions,
+		ApplicationName:    applicationName,
+		MinPartSize:        c.MinPartSize,
+		MaxNumberOfParts:   c.MaxNumberOfParts,
+		ServerSideCopy:     c.ServerSideCopy,
+		MultipleVersions:   c.MultipleVersions,
+		TallyDeltas:        c.TallyDeltas,
+		SlowQueryThreshold: c.MetabaseSlowQueryThreshold,
+		KeyManager:         c.MetadataEncryptionKey.KeyManager(),
 	}
 }
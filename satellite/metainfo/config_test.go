@@ -4,11 +4,13 @@
 package metainfo_test
 
 import (
+	"encoding/hex"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
 	"storj.io/common/memory"
+	"storj.io/common/testrand"
 	"storj.io/storj/satellite/metainfo"
 )
 
@@ -96,3 +98,93 @@ func TestRSConfigValidation(t *testing.T) {
 		}
 	}
 }
+
+func TestPlacementRSConfig(t *testing.T) {
+	var config metainfo.PlacementRSConfig
+	require.NoError(t, config.Set(""))
+	require.Empty(t, config.Placements())
+	require.Equal(t, "", config.String())
+
+	require.NoError(t, config.Set("10:4/6/8/10-512B, 2:2/3/4/5-256B"))
+	require.Len(t, config.Placements(), 2)
+	require.Equal(t, "2:2/3/4/5-256 B,10:4/6/8/10-512 B", config.String())
+
+	rs, ok := config.Get(10)
+	require.True(t, ok)
+	require.Equal(t, metainfo.RSConfig{
+		ErasureShareSize: 512 * memory.B,
+		Min:              4,
+		Repair:           6,
+		Success:          8,
+		Total:            10,
+	}, rs)
+
+	_, ok = config.Get(0)
+	require.False(t, ok)
+
+	var parsed metainfo.PlacementRSConfig
+	require.NoError(t, parsed.Set(config.String()))
+	require.Equal(t, config, parsed)
+
+	for _, invalid := range []string{
+		"10",
+		"10:4/6/8/10-512B:1",
+		"invalid:4/6/8/10-512B",
+		"-1:4/6/8/10-512B",
+		"10:4/6/8-512B",
+		"10:4/6/8/10-512B,10:2/3/4/5-256B",
+	} {
+		require.Error(t, config.Set(invalid), invalid)
+	}
+}
+
+func TestInlineSegmentSizes(t *testing.T) {
+	projectA, projectB := testrand.UUID(), testrand.UUID()
+
+	var sizes metainfo.InlineSegmentSizes
+	require.NoError(t, sizes.Set(""))
+	require.Empty(t, sizes.Projects())
+	require.Equal(t, "", sizes.String())
+
+	require.NoError(t, sizes.Set(projectA.String()+":8KiB, "+projectB.String()+":0B"))
+	require.Len(t, sizes.Projects(), 2)
+
+	size, ok := sizes.Get(projectA)
+	require.True(t, ok)
+	require.Equal(t, 8*memory.KiB, size)
+
+	size, ok = sizes.Get(projectB)
+	require.True(t, ok)
+	require.Zero(t, size)
+
+	_, ok = sizes.Get(testrand.UUID())
+	require.False(t, ok)
+
+	var parsed metainfo.InlineSegmentSizes
+	require.NoError(t, parsed.Set(sizes.String()))
+	require.Equal(t, sizes, parsed)
+
+	for _, invalid := range []string{
+		projectA.String(),
+		projectA.String() + ":8KiB:1",
+		"invalid:8KiB",
+		projectA.String() + ":8XiB",
+	} {
+		require.Error(t, sizes.Set(invalid), invalid)
+	}
+}
+
+func TestMetadataEncryptionKey(t *testing.T) {
+	var key metainfo.MetadataEncryptionKey
+	require.NoError(t, key.Set(""))
+	require.Nil(t, key.KeyManager())
+	require.Equal(t, "", key.String())
+
+	encoded := hex.EncodeToString(testrand.BytesInt(32))
+	require.NoError(t, key.Set(encoded))
+	require.NotNil(t, key.KeyManager())
+	require.Equal(t, encoded, key.String())
+
+	require.Error(t, key.Set("not-hex"))
+	require.Error(t, key.Set(hex.EncodeToString(testrand.BytesInt(16))))
+}
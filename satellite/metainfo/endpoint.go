@@ -14,15 +14,23 @@ import (
 	"storj.io/common/encryption"
 	"storj.io/common/lrucache"
 	"storj.io/common/macaroon"
+	"storj.io/common/memory"
 	"storj.io/common/pb"
 	"storj.io/common/rpc/rpcstatus"
 	"storj.io/common/signing"
 	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/accesslog"
 	"storj.io/storj/satellite/accounting"
+	"storj.io/storj/satellite/accounting/projectops"
 	"storj.io/storj/satellite/attribution"
+	"storj.io/storj/satellite/bucketevents"
+	"storj.io/storj/satellite/bucketpolicy"
 	"storj.io/storj/satellite/buckets"
 	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/events"
 	"storj.io/storj/satellite/internalpb"
+	"storj.io/storj/satellite/maintenance"
 	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/metainfo/piecedeletion"
 	"storj.io/storj/satellite/metainfo/pointerverification"
@@ -70,14 +78,28 @@ type Endpoint struct {
 	pointerVerification  *pointerverification.Service
 	projectUsage         *accounting.Service
 	projects             console.Projects
+	networkRules         console.ProjectNetworkRules
 	apiKeys              APIKeys
 	satellite            signing.Signer
 	limiterCache         *lrucache.ExpiringLRU
+	networkRulesCache    *lrucache.ExpiringLRU
+	sharedLimiter        *sharedRateLimiter
+	admission            *admissionController
 	encInlineSegmentSize int64 // max inline segment size + encryption overhead
-	revocations          revocation.DB
-	defaultRS            *pb.RedundancyScheme
-	config               Config
-	versionCollector     *versionCollector
+	// projectEncInlineSegmentSizes are the max inline segment sizes + encryption
+	// overhead of the projects, which differ from the default.
+	projectEncInlineSegmentSizes map[uuid.UUID]int64
+	revocations                  revocation.DB
+	events                       *events.Bus
+	bucketEvents                 *bucketevents.Service
+	bucketPolicies               *bucketpolicy.Service
+	accessLog                    *accesslog.Recorder
+	projectOps                   *projectops.Recorder
+	maintenance                  *maintenance.Service
+	defaultRS                    *pb.RedundancyScheme
+	placementRS                  map[storj.PlacementConstraint]*pb.RedundancyScheme
+	config                       Config
+	versionCollector             *versionCollector
 }
 
 // NewEndpoint creates new metainfo endpoint instance.
@@ -85,24 +107,42 @@ func NewEndpoint(log *zap.Logger, buckets *buckets.Service, metabaseDB *metabase
 	deletePieces *piecedeletion.Service, orders *orders.Service, cache *overlay.Service,
 	attributions attribution.DB, partners *rewards.PartnersService, peerIdentities overlay.PeerIdentities,
 	apiKeys APIKeys, projectUsage *accounting.Service, projects console.Projects,
-	satellite signing.Signer, revocations revocation.DB, config Config) (*Endpoint, error) {
+	networkRules console.ProjectNetworkRules, satellite signing.Signer, revocations revocation.DB, eventBus *events.Bus,
+	bucketEvents *bucketevents.Service, bucketPolicies *bucketpolicy.Service,
+	accessLog *accesslog.Recorder, projectOps *projectops.Recorder, maintenanceService *maintenance.Service, config Config) (*Endpoint, error) {
 	// TODO do something with too many params
 
-	encInlineSegmentSize, err := encryption.CalcEncryptedSize(config.MaxInlineSegmentSize.Int64(), storj.EncryptionParameters{
-		CipherSuite: storj.EncAESGCM,
-		BlockSize:   128, // intentionally low block size to allow maximum possible encryption overhead
-	})
+	encInlineSegmentSize, err := calcEncInlineSegmentSize(config.MaxInlineSegmentSize)
 	if err != nil {
 		return nil, err
 	}
 
-	defaultRSScheme := &pb.RedundancyScheme{
-		Type:             pb.RedundancyScheme_RS,
-		MinReq:           int32(config.RS.Min),
-		RepairThreshold:  int32(config.RS.Repair),
-		SuccessThreshold: int32(config.RS.Success),
-		Total:            int32(config.RS.Total),
-		ErasureShareSize: config.RS.ErasureShareSize.Int32(),
+	projectEncInlineSegmentSizes := map[uuid.UUID]int64{}
+	for _, projectID := range config.InlineSegmentSizes.Projects() {
+		size, _ := config.InlineSegmentSizes.Get(projectID)
+		projectEncInlineSegmentSizes[projectID], err = calcEncInlineSegmentSize(size)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	defaultRSScheme := convertRSConfigToProto(config.RS)
+
+	placementRS := map[storj.PlacementConstraint]*pb.RedundancyScheme{}
+	for _, placement := range config.PlacementRS.Placements() {
+		rs, _ := config.PlacementRS.Get(placement)
+		if _, err := rs.RedundancyStrategy(); err != nil {
+			return nil, Error.New("invalid redundancy scheme of placement %d: %w", placement, err)
+		}
+		placementRS[placement] = convertRSConfigToProto(rs)
+	}
+
+	var sharedLimiter *sharedRateLimiter
+	if config.RateLimiter.Enabled && config.RateLimiter.Backend != "" {
+		sharedLimiter, err = newSharedRateLimiter(log.Named("ratelimiter"), config.RateLimiter.Backend, config.RateLimiter.BackendRetryInterval)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	return &Endpoint{
@@ -118,21 +158,92 @@ func NewEndpoint(log *zap.Logger, buckets *buckets.Service, metabaseDB *metabase
 		apiKeys:             apiKeys,
 		projectUsage:        projectUsage,
 		projects:            projects,
+		networkRules:        networkRules,
 		satellite:           satellite,
 		limiterCache: lrucache.New(lrucache.Options{
 			Capacity:   config.RateLimiter.CacheCapacity,
 			Expiration: config.RateLimiter.CacheExpiration,
 		}),
-		encInlineSegmentSize: encInlineSegmentSize,
-		revocations:          revocations,
-		defaultRS:            defaultRSScheme,
-		config:               config,
-		versionCollector:     newVersionCollector(log),
+		networkRulesCache: lrucache.New(lrucache.Options{
+			Capacity:   config.NetworkRules.CacheCapacity,
+			Expiration: config.NetworkRules.CacheExpiration,
+		}),
+		sharedLimiter:                sharedLimiter,
+		admission:                    newAdmissionController(config.Admission, metabaseDB.QueryLatency),
+		encInlineSegmentSize:         encInlineSegmentSize,
+		projectEncInlineSegmentSizes: projectEncInlineSegmentSizes,
+		revocations:                  revocations,
+		events:                       eventBus,
+		bucketEvents:                 bucketEvents,
+		bucketPolicies:               bucketPolicies,
+		accessLog:                    accessLog,
+		projectOps:                   projectOps,
+		maintenance:                  maintenanceService,
+		defaultRS:                    defaultRSScheme,
+		placementRS:                  placementRS,
+		config:                       config,
+		versionCollector:             newVersionCollector(log),
 	}, nil
 }
 
 // Close closes resources.
-func (endpoint *Endpoint) Close() error { return nil }
+func (endpoint *Endpoint) Close() error {
+	if endpoint.sharedLimiter != nil {
+		return endpoint.sharedLimiter.Close()
+	}
+	return nil
+}
+
+// convertRSConfigToProto converts the redundancy scheme configuration to its
+// protobuf representation.
+func convertRSConfigToProto(rs RSConfig) *pb.RedundancyScheme {
+	return &pb.RedundancyScheme{
+		Type:             pb.RedundancyScheme_RS,
+		MinReq:           int32(rs.Min),
+		RepairThreshold:  int32(rs.Repair),
+		SuccessThreshold: int32(rs.Success),
+		Total:            int32(rs.Total),
+		ErasureShareSize: rs.ErasureShareSize.Int32(),
+	}
+}
+
+// placementRedundancy returns the redundancy scheme of the new objects of the
+// placement.
+func (endpoint *Endpoint) placementRedundancy(placement storj.PlacementConstraint) *pb.RedundancyScheme {
+	if rs, ok := endpoint.placementRS[placement]; ok {
+		return rs
+	}
+	return endpoint.defaultRS
+}
+
+// streamRedundancy returns the redundancy scheme, which was selected for the
+// stream, when the object was begun. The stream IDs without the redundancy
+// scheme use the one of their placement.
+func (endpoint *Endpoint) streamRedundancy(streamID *internalpb.StreamID) *pb.RedundancyScheme {
+	if streamID.RedundancyScheme != nil {
+		return streamID.RedundancyScheme
+	}
+	return endpoint.placementRedundancy(storj.PlacementConstraint(streamID.Placement))
+}
+
+// calcEncInlineSegmentSize returns the max inline segment size including the
+// encryption overhead.
+func calcEncInlineSegmentSize(size memory.Size) (int64, error) {
+	return encryption.CalcEncryptedSize(size.Int64(), storj.EncryptionParameters{
+		CipherSuite: storj.EncAESGCM,
+		BlockSize:   128, // intentionally low block size to allow maximum possible encryption overhead
+	})
+}
+
+// maxInlineSegmentSize returns the max inline segment size of the project and
+// the max size including the encryption overhead.
+func (endpoint *Endpoint) maxInlineSegmentSize(projectID uuid.UUID) (memory.Size, int64) {
+	if encSize, ok := endpoint.projectEncInlineSegmentSizes[projectID]; ok {
+		size, _ := endpoint.config.InlineSegmentSizes.Get(projectID)
+		return size, encSize
+	}
+	return endpoint.config.MaxInlineSegmentSize, endpoint.encInlineSegmentSize
+}
 
 // ProjectInfo returns allowed ProjectInfo for the provided API key.
 func (endpoint *Endpoint) ProjectInfo(ctx context.Context, req *pb.ProjectInfoRequest) (_ *pb.ProjectInfoResponse, err error) {
@@ -164,6 +275,10 @@ func (endpoint *Endpoint) RevokeAPIKey(ctx context.Context, req *pb.RevokeAPIKey
 
 	endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
 
+	if err := endpoint.checkMaintenance(ctx); err != nil {
+		return nil, err
+	}
+
 	macToRevoke, err := macaroon.ParseMacaroon(req.GetApiKey())
 	if err != nil {
 		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, "API key to revoke is not a macaroon")
@@ -271,6 +386,11 @@ func (endpoint *Endpoint) convertMetabaseErr(err error) error {
 	}
 
 	switch {
+	case errs.Is(err, context.Canceled):
+		// the client gave up on the request.
+		return rpcstatus.Error(rpcstatus.Canceled, err.Error())
+	case errs.Is(err, context.DeadlineExceeded):
+		return rpcstatus.Error(rpcstatus.DeadlineExceeded, err.Error())
 	case storj.ErrObjectNotFound.Has(err):
 		return rpcstatus.Error(rpcstatus.NotFound, err.Error())
 	case metabase.ErrSegmentNotFound.Has(err):
@@ -281,8 +401,12 @@ func (endpoint *Endpoint) convertMetabaseErr(err error) error {
 		return rpcstatus.Error(rpcstatus.AlreadyExists, err.Error())
 	case metabase.ErrPendingObjectMissing.Has(err):
 		return rpcstatus.Error(rpcstatus.NotFound, err.Error())
+	case metabase.ErrObjectAlreadyCommitted.Has(err):
+		return rpcstatus.Error(rpcstatus.Aborted, err.Error())
 	case metabase.ErrPermissionDenied.Has(err):
 		return rpcstatus.Error(rpcstatus.PermissionDenied, err.Error())
+	case metabase.ErrPreconditionFailed.Has(err):
+		return rpcstatus.Error(rpcstatus.FailedPrecondition, err.Error())
 	default:
 		endpoint.log.Error("internal", zap.Error(err))
 		return rpcstatus.Error(rpcstatus.Internal, err.Error())
@@ -6,6 +6,7 @@ package metainfo
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/zeebo/errs"
@@ -17,7 +18,9 @@ import (
 	"storj.io/common/rpc/rpcstatus"
 	"storj.io/common/storj"
 	"storj.io/common/uuid"
+	"storj.io/storj/satellite/accounting/projectops"
 	"storj.io/storj/satellite/buckets"
+	"storj.io/storj/satellite/events"
 	"storj.io/storj/satellite/metabase"
 )
 
@@ -62,6 +65,10 @@ func (endpoint *Endpoint) CreateBucket(ctx context.Context, req *pb.BucketCreate
 
 	endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
 
+	if err := endpoint.checkMaintenance(ctx); err != nil {
+		return nil, err
+	}
+
 	keyInfo, err := endpoint.validateAuth(ctx, req.Header, macaroon.Action{
 		Op:     macaroon.ActionWrite,
 		Bucket: req.Name,
@@ -143,6 +150,10 @@ func (endpoint *Endpoint) DeleteBucket(ctx context.Context, req *pb.BucketDelete
 
 	endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
 
+	if err := endpoint.checkMaintenance(ctx); err != nil {
+		return nil, err
+	}
+
 	now := time.Now()
 
 	var canRead, canList bool
@@ -293,6 +304,15 @@ func (endpoint *Endpoint) deleteBucketObjects(ctx context.Context, projectID uui
 		},
 	})
 
+	if deletedObjects > 0 {
+		endpoint.events.Publish(ctx, events.BucketObjectsDeleted, map[string]string{
+			"project_id":      projectID.String(),
+			"bucket":          string(bucketName),
+			"deleted_objects": strconv.FormatInt(deletedObjects, 10),
+			"operation":       string(events.OperationDelete),
+		})
+	}
+
 	return deletedObjects, Error.Wrap(err)
 }
 
@@ -302,6 +322,10 @@ func (endpoint *Endpoint) ListBuckets(ctx context.Context, req *pb.BucketListReq
 
 	endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
 
+	if err := endpoint.admission.Admit(ctx, requestBulk); err != nil {
+		return nil, err
+	}
+
 	action := macaroon.Action{
 		// TODO: This has to be ActionList, but it seems to be set to
 		// ActionRead as a hacky workaround to make bucket listing possible.
@@ -312,6 +336,7 @@ func (endpoint *Endpoint) ListBuckets(ctx context.Context, req *pb.BucketListReq
 	if err != nil {
 		return nil, err
 	}
+	defer endpoint.projectOps.Record(keyInfo.ProjectID, projectops.List, &err)
 
 	allowedBuckets, err := getAllowedBuckets(ctx, req.Header, action)
 	if err != nil {
@@ -0,0 +1,67 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metainfo
+
+import (
+	"context"
+
+	"storj.io/common/pb"
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/metabase"
+)
+
+// listMultipartUploads lists the uploads of the bucket, which are in progress,
+// with the time they were started. It serves the recursive listings of
+// pending objects, which uplink uses for listing the multipart uploads.
+//
+// The API key, which started an upload, is stored, but isn't returned, because
+// pb.ObjectListItem doesn't have a field for it.
+func (endpoint *Endpoint) listMultipartUploads(ctx context.Context, projectID uuid.UUID, bucket []byte, prefix, cursor metabase.ObjectKey, limit int, includeCustomMetadata bool, placement storj.PlacementConstraint) (resp *pb.ObjectListResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	result, err := endpoint.metabase.ListMultipartUploads(ctx, metabase.ListMultipartUploads{
+		ProjectID:  projectID,
+		BucketName: string(bucket),
+		Prefix:     prefix,
+		Cursor: metabase.ListObjectsCursor{
+			Key:     cursor,
+			Version: metabase.DefaultVersion, // TODO: set to a the version from the protobuf request when it supports this
+		},
+		Limit: limit,
+	})
+	if err != nil {
+		return nil, endpoint.convertMetabaseErr(err)
+	}
+
+	resp = &pb.ObjectListResponse{
+		Items: make([]*pb.ObjectListItem, 0, len(result.Uploads)),
+		More:  result.More,
+	}
+	for _, upload := range result.Uploads {
+		// the keys of the listing are relative to the prefix.
+		item, err := endpoint.objectEntryToProtoListItem(ctx, bucket, metabase.ObjectEntry{
+			ObjectKey:                     upload.ObjectKey[len(prefix):],
+			Version:                       upload.Version,
+			StreamID:                      upload.StreamID,
+			CreatedAt:                     upload.CreatedAt,
+			ExpiresAt:                     upload.ExpiresAt,
+			Status:                        metabase.Pending,
+			SegmentCount:                  upload.SegmentCount,
+			EncryptedMetadataNonce:        upload.EncryptedMetadataNonce,
+			EncryptedMetadata:             upload.EncryptedMetadata,
+			EncryptedMetadataEncryptedKey: upload.EncryptedMetadataEncryptedKey,
+			FixedSegmentSize:              upload.FixedSegmentSize,
+			Encryption:                    upload.Encryption,
+		}, prefix, true, includeCustomMetadata, placement)
+		if err != nil {
+			return nil, endpoint.convertMetabaseErr(err)
+		}
+		resp.Items = append(resp.Items, item)
+	}
+
+	mon.Meter("req_list_multipart_uploads").Mark(1)
+
+	return resp, nil
+}
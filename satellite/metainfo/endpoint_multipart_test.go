@@ -0,0 +1,85 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metainfo_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/uplink/private/metaclient"
+)
+
+func TestEndpoint_ListMultipartUploads(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		apiKey := planet.Uplinks[0].APIKey[sat.ID()]
+
+		metainfoClient, err := planet.Uplinks[0].DialMetainfo(ctx, sat, apiKey)
+		require.NoError(t, err)
+		defer ctx.Check(metainfoClient.Close)
+
+		require.NoError(t, planet.Uplinks[0].CreateBucket(ctx, sat, "testbucket"))
+
+		// committed objects aren't listed.
+		require.NoError(t, planet.Uplinks[0].Upload(ctx, sat, "testbucket", "a/committed", testrand.Bytes(100)))
+
+		metadata := testrand.Bytes(32)
+		started := time.Now()
+		for _, key := range []string{"a/first", "a/second", "a/third", "b/other"} {
+			_, err := metainfoClient.BeginObject(ctx, metaclient.BeginObjectParams{
+				Bucket:             []byte("testbucket"),
+				EncryptedObjectKey: []byte(key),
+				EncryptionParameters: storj.EncryptionParameters{
+					CipherSuite: storj.EncAESGCM,
+					BlockSize:   256,
+				},
+				EncryptedMetadata:             metadata,
+				EncryptedMetadataEncryptedKey: testrand.Bytes(32),
+				EncryptedMetadataNonce:        testrand.Nonce(),
+			})
+			require.NoError(t, err)
+		}
+
+		var keys []string
+		var cursor []byte
+		for {
+			items, more, err := metainfoClient.ListObjects(ctx, metaclient.ListObjectsParams{
+				Bucket:                []byte("testbucket"),
+				EncryptedPrefix:       []byte("a/"),
+				EncryptedCursor:       cursor,
+				Limit:                 2,
+				Status:                int32(metabase.Pending),
+				Recursive:             true,
+				IncludeCustomMetadata: true,
+				IncludeSystemMetadata: true,
+			})
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(items), 2)
+
+			for _, item := range items {
+				require.Equal(t, int32(metabase.Pending), item.Status)
+				require.False(t, item.StreamID.IsZero())
+				require.WithinDuration(t, started, item.CreatedAt, time.Minute)
+				require.Equal(t, metadata, item.EncryptedMetadata)
+				keys = append(keys, string(item.EncryptedObjectKey))
+			}
+			if !more {
+				break
+			}
+			cursor = items[len(items)-1].EncryptedObjectKey
+		}
+
+		// the keys are relative to the prefix.
+		require.Equal(t, []string{"first", "second", "third"}, keys)
+	})
+}
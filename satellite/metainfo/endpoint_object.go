@@ -19,6 +19,11 @@ import (
 	"storj.io/common/rpc/rpcstatus"
 	"storj.io/common/storj"
 	"storj.io/common/uuid"
+	"storj.io/storj/private/rpcprecondition"
+	"storj.io/storj/satellite/accesslog"
+	"storj.io/storj/satellite/accounting/projectops"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/events"
 	"storj.io/storj/satellite/internalpb"
 	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/metainfo/piecedeletion"
@@ -31,6 +36,14 @@ func (endpoint *Endpoint) BeginObject(ctx context.Context, req *pb.ObjectBeginRe
 
 	endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
 
+	if err := endpoint.checkMaintenance(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := endpoint.admission.Admit(ctx, requestUpload); err != nil {
+		return nil, err
+	}
+
 	now := time.Now()
 
 	var canDelete bool
@@ -58,6 +71,17 @@ func (endpoint *Endpoint) BeginObject(ctx context.Context, req *pb.ObjectBeginRe
 	if err != nil {
 		return nil, err
 	}
+	defer endpoint.projectOps.Record(keyInfo.ProjectID, projectops.Upload, &err)
+
+	idempotency, err := endpoint.reserveIdempotencyKey(ctx, keyInfo.ProjectID, "BeginObject", req)
+	if err != nil {
+		return nil, err
+	}
+	replay := &pb.ObjectBeginResponse{}
+	if replayed, err := idempotency.replayed(replay); replayed {
+		return replay, err
+	}
+	defer func() { idempotency.finish(ctx, resp, err) }()
 
 	if !req.ExpiresAt.IsZero() && !req.ExpiresAt.After(time.Now()) {
 		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, "Invalid expiration time")
@@ -157,6 +181,8 @@ func (endpoint *Endpoint) BeginObject(ctx context.Context, req *pb.ObjectBeginRe
 			EncryptedMetadata:             req.EncryptedMetadata,
 			EncryptedMetadataEncryptedKey: req.EncryptedMetadataEncryptedKey,
 			EncryptedMetadataNonce:        nonce,
+
+			UploaderKeyID: &keyInfo.ID,
 		})
 	} else {
 		object, err = endpoint.metabase.BeginObjectExactVersion(ctx, metabase.BeginObjectExactVersion{
@@ -173,12 +199,16 @@ func (endpoint *Endpoint) BeginObject(ctx context.Context, req *pb.ObjectBeginRe
 			EncryptedMetadata:             req.EncryptedMetadata,
 			EncryptedMetadataEncryptedKey: req.EncryptedMetadataEncryptedKey,
 			EncryptedMetadataNonce:        nonce,
+
+			UploaderKeyID: &keyInfo.ID,
 		})
 	}
 	if err != nil {
 		return nil, endpoint.convertMetabaseErr(err)
 	}
 
+	redundancyScheme := endpoint.placementRedundancy(placement)
+
 	satStreamID, err := endpoint.packStreamID(ctx, &internalpb.StreamID{
 		Bucket:               []byte(object.BucketName),
 		EncryptedObjectKey:   []byte(object.ObjectKey),
@@ -189,6 +219,7 @@ func (endpoint *Endpoint) BeginObject(ctx context.Context, req *pb.ObjectBeginRe
 		MultipartObject:      object.FixedSegmentSize <= 0,
 		EncryptionParameters: req.EncryptionParameters,
 		Placement:            int32(placement),
+		RedundancyScheme:     redundancyScheme,
 	})
 	if err != nil {
 		endpoint.log.Error("internal", zap.Error(err))
@@ -203,7 +234,7 @@ func (endpoint *Endpoint) BeginObject(ctx context.Context, req *pb.ObjectBeginRe
 		EncryptedObjectKey: req.EncryptedObjectKey,
 		Version:            req.Version,
 		StreamId:           satStreamID,
-		RedundancyScheme:   endpoint.defaultRS,
+		RedundancyScheme:   redundancyScheme,
 	}, nil
 }
 
@@ -213,13 +244,21 @@ func (endpoint *Endpoint) CommitObject(ctx context.Context, req *pb.ObjectCommit
 
 	endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
 
+	if err := endpoint.checkMaintenance(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := endpoint.admission.Admit(ctx, requestUpload); err != nil {
+		return nil, err
+	}
+
 	streamID, err := endpoint.unmarshalSatStreamID(ctx, req.StreamId)
 	if err != nil {
 		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
 	}
 
 	now := time.Now()
-	var allowDelete bool
+	var allowDelete, canRead, canList bool
 	keyInfo, err := endpoint.validateAuthN(ctx, req.Header,
 		verifyPermission{
 			action: macaroon.Action{
@@ -239,6 +278,26 @@ func (endpoint *Endpoint) CommitObject(ctx context.Context, req *pb.ObjectCommit
 			actionPermitted: &allowDelete,
 			optional:        true,
 		},
+		verifyPermission{
+			action: macaroon.Action{
+				Op:            macaroon.ActionRead,
+				Bucket:        streamID.Bucket,
+				EncryptedPath: streamID.EncryptedObjectKey,
+				Time:          now,
+			},
+			actionPermitted: &canRead,
+			optional:        true,
+		},
+		verifyPermission{
+			action: macaroon.Action{
+				Op:            macaroon.ActionList,
+				Bucket:        streamID.Bucket,
+				EncryptedPath: streamID.EncryptedObjectKey,
+				Time:          now,
+			},
+			actionPermitted: &canList,
+			optional:        true,
+		},
 	)
 	if err != nil {
 		return nil, err
@@ -250,6 +309,21 @@ func (endpoint *Endpoint) CommitObject(ctx context.Context, req *pb.ObjectCommit
 		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
 	}
 
+	precondition, err := endpoint.requestPrecondition(ctx, nil, canRead || canList)
+	if err != nil {
+		return nil, err
+	}
+
+	idempotency, err := endpoint.reserveIdempotencyKey(ctx, keyInfo.ProjectID, "CommitObject", req)
+	if err != nil {
+		return nil, err
+	}
+	replay := &pb.ObjectCommitResponse{}
+	if replayed, err := idempotency.replayed(replay); replayed {
+		return replay, err
+	}
+	defer func() { idempotency.finish(ctx, resp, err) }()
+
 	// for old uplinks get Encryption from StreamMeta
 	streamMeta := &pb.StreamMeta{}
 	encryption := storj.EncryptionParameters{}
@@ -273,6 +347,7 @@ func (endpoint *Endpoint) CommitObject(ctx context.Context, req *pb.ObjectCommit
 		OnDelete: func(segments []metabase.DeletedSegmentInfo) {
 			endpoint.deleteSegmentPieces(ctx, segments)
 		},
+		Precondition: precondition,
 	}
 	// uplink can send empty metadata with not empty key/nonce
 	// we need to fix it on uplink side but that part will be
@@ -296,11 +371,20 @@ func (endpoint *Endpoint) CommitObject(ctx context.Context, req *pb.ObjectCommit
 		return nil, err
 	}
 
-	_, err = endpoint.metabase.CommitObject(ctx, request)
+	if endpoint.config.ProjectLimits.ValidateSegmentLimitAtCommit {
+		if err := endpoint.checkSegmentLimit(ctx, keyInfo.ProjectID); err != nil {
+			return nil, err
+		}
+	}
+
+	object, err := endpoint.metabase.CommitObject(ctx, request)
 	if err != nil {
 		return nil, endpoint.convertMetabaseErr(err)
 	}
 
+	endpoint.publishObjectEvent(ctx, events.ObjectCommitted, object, events.OperationCommit)
+	endpoint.logAccess(ctx, keyInfo, object.BucketName, object.ObjectKey, accesslog.PutObject, object.TotalPlainSize)
+
 	return &pb.ObjectCommitResponse{}, nil
 }
 
@@ -393,6 +477,7 @@ func (endpoint *Endpoint) GetObject(ctx context.Context, req *pb.ObjectGetReques
 
 	endpoint.log.Info("Object Get", zap.Stringer("Project ID", keyInfo.ProjectID), zap.String("operation", "get"), zap.String("type", "object"))
 	mon.Meter("req_get_object").Mark(1)
+	endpoint.logAccess(ctx, keyInfo, mbObject.BucketName, mbObject.ObjectKey, accesslog.HeadObject, 0)
 
 	return &pb.ObjectGetResponse{Object: object}, nil
 }
@@ -416,6 +501,7 @@ func (endpoint *Endpoint) DownloadObject(ctx context.Context, req *pb.ObjectDown
 	if err != nil {
 		return nil, err
 	}
+	defer endpoint.projectOps.Record(keyInfo.ProjectID, projectops.Download, &err)
 
 	err = endpoint.validateBucket(ctx, req.Bucket)
 	if err != nil {
@@ -612,6 +698,12 @@ func (endpoint *Endpoint) DownloadObject(ctx context.Context, req *pb.ObjectDown
 	endpoint.log.Info("Object Download", zap.Stringer("Project ID", keyInfo.ProjectID), zap.String("operation", "download"), zap.String("type", "object"))
 	mon.Meter("req_download_object").Mark(1)
 
+	downloadedBytes := object.TotalPlainSize
+	if streamRange != nil {
+		downloadedBytes = streamRange.PlainLimit - streamRange.PlainStart
+	}
+	endpoint.logAccess(ctx, keyInfo, object.BucketName, object.ObjectKey, accesslog.GetObject, downloadedBytes)
+
 	return &pb.ObjectDownloadResponse{
 		Object: protoObject,
 
@@ -753,6 +845,10 @@ func (endpoint *Endpoint) ListObjects(ctx context.Context, req *pb.ObjectListReq
 
 	endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
 
+	if err := endpoint.admission.Admit(ctx, requestBulk); err != nil {
+		return nil, err
+	}
+
 	keyInfo, err := endpoint.validateAuth(ctx, req.Header, macaroon.Action{
 		Op:            macaroon.ActionList,
 		Bucket:        req.Bucket,
@@ -762,6 +858,7 @@ func (endpoint *Endpoint) ListObjects(ctx context.Context, req *pb.ObjectListReq
 	if err != nil {
 		return nil, err
 	}
+	defer endpoint.projectOps.Record(keyInfo.ProjectID, projectops.List, &err)
 
 	err = endpoint.validateBucket(ctx, req.Bucket)
 	if err != nil {
@@ -811,7 +908,12 @@ func (endpoint *Endpoint) ListObjects(ctx context.Context, req *pb.ObjectListReq
 	}
 
 	resp = &pb.ObjectListResponse{}
-	if endpoint.config.TestListingQuery {
+	if status == metabase.Pending && req.Recursive {
+		resp, err = endpoint.listMultipartUploads(ctx, keyInfo.ProjectID, req.Bucket, prefix, metabase.ObjectKey(cursor), limit, includeCustomMetadata, placement)
+		if err != nil {
+			return nil, err
+		}
+	} else if endpoint.config.TestListingQuery {
 		result, err := endpoint.metabase.ListObjects(ctx,
 			metabase.ListObjects{
 				ProjectID:  keyInfo.ProjectID,
@@ -887,6 +989,10 @@ func (endpoint *Endpoint) ListPendingObjectStreams(ctx context.Context, req *pb.
 
 	endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
 
+	if err := endpoint.admission.Admit(ctx, requestBulk); err != nil {
+		return nil, err
+	}
+
 	keyInfo, err := endpoint.validateAuth(ctx, req.Header, macaroon.Action{
 		Op:            macaroon.ActionList,
 		Bucket:        req.Bucket,
@@ -896,6 +1002,7 @@ func (endpoint *Endpoint) ListPendingObjectStreams(ctx context.Context, req *pb.
 	if err != nil {
 		return nil, err
 	}
+	defer endpoint.projectOps.Record(keyInfo.ProjectID, projectops.List, &err)
 
 	err = endpoint.validateBucket(ctx, req.Bucket)
 	if err != nil {
@@ -971,6 +1078,10 @@ func (endpoint *Endpoint) BeginDeleteObject(ctx context.Context, req *pb.ObjectB
 
 	endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
 
+	if err := endpoint.checkMaintenance(ctx); err != nil {
+		return nil, err
+	}
+
 	now := time.Now()
 
 	var canRead, canList bool
@@ -1008,6 +1119,30 @@ func (endpoint *Endpoint) BeginDeleteObject(ctx context.Context, req *pb.ObjectB
 	if err != nil {
 		return nil, err
 	}
+	defer endpoint.projectOps.Record(keyInfo.ProjectID, projectops.Delete, &err)
+
+	// the stream ID of a committed object makes the delete conditional, it's
+	// executed only if the object wasn't overwritten meanwhile.
+	var precondition metabase.Precondition
+	if req.GetStatus() != int32(metabase.Pending) {
+		precondition, err = endpoint.requestPrecondition(ctx, req.StreamId, canRead || canList)
+		if err != nil {
+			return nil, err
+		}
+		if precondition.IfNoneMatch {
+			return nil, rpcstatus.Error(rpcstatus.InvalidArgument, "if-none-match is not supported for deletes")
+		}
+	}
+
+	idempotency, err := endpoint.reserveIdempotencyKey(ctx, keyInfo.ProjectID, "BeginDeleteObject", req)
+	if err != nil {
+		return nil, err
+	}
+	replay := &pb.ObjectBeginDeleteResponse{}
+	if replayed, err := idempotency.replayed(replay); replayed {
+		return replay, err
+	}
+	defer func() { idempotency.finish(ctx, resp, err) }()
 
 	err = endpoint.validateBucket(ctx, req.Bucket)
 	if err != nil {
@@ -1037,9 +1172,15 @@ func (endpoint *Endpoint) BeginDeleteObject(ctx context.Context, req *pb.ObjectB
 			}
 		}
 	} else {
-		deletedObjects, err = endpoint.DeleteCommittedObject(ctx, keyInfo.ProjectID, string(req.Bucket), metabase.ObjectKey(req.EncryptedObjectKey))
+		deletedObjects, err = endpoint.DeleteCommittedObject(ctx, keyInfo.ProjectID, string(req.Bucket), metabase.ObjectKey(req.EncryptedObjectKey), precondition)
 	}
 	if err != nil {
+		if metabase.ErrPreconditionFailed.Has(err) {
+			// the client has to know that the object wasn't deleted. It's
+			// allowed to know whether the object exists, because conditional
+			// deletes require read or list permission.
+			return nil, rpcstatus.Error(rpcstatus.FailedPrecondition, err.Error())
+		}
 		if !canRead && !canList {
 			// No error info is returned if neither Read, nor List permission is granted
 			return &pb.ObjectBeginDeleteResponse{}, nil
@@ -1065,12 +1206,55 @@ func (endpoint *Endpoint) BeginDeleteObject(ctx context.Context, req *pb.ObjectB
 
 	endpoint.log.Info("Object Delete", zap.Stringer("Project ID", keyInfo.ProjectID), zap.String("operation", "delete"), zap.String("type", "object"))
 	mon.Meter("req_delete_object").Mark(1)
+	for _, deleted := range deletedObjects {
+		endpoint.logAccess(ctx, keyInfo, string(deleted.Bucket), metabase.ObjectKey(deleted.EncryptedObjectKey), accesslog.DeleteObject, 0)
+	}
 
 	return &pb.ObjectBeginDeleteResponse{
 		Object: object,
 	}, nil
 }
 
+// requestPrecondition returns the precondition of a conditional request. The
+// stream ID, which the object must have, is taken from streamID, when it's
+// set, or from the request metadata otherwise. A failed precondition tells
+// whether the object exists, so conditional requests require read or list
+// permission, which canReadOrList reports.
+func (endpoint *Endpoint) requestPrecondition(ctx context.Context, streamID *storj.StreamID, canReadOrList bool) (_ metabase.Precondition, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	sent, err := rpcprecondition.FromContext(ctx)
+	if err != nil {
+		return metabase.Precondition{}, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+	}
+	if streamID != nil && !streamID.IsZero() {
+		sent.IfMatch = *streamID
+	}
+	if sent.IsZero() {
+		return metabase.Precondition{}, nil
+	}
+	if !canReadOrList {
+		return metabase.Precondition{}, rpcstatus.Error(rpcstatus.PermissionDenied, "conditional requests require read or list permission")
+	}
+
+	precondition := metabase.Precondition{IfNoneMatch: sent.IfNoneMatch}
+	if !sent.IfMatch.IsZero() {
+		satStreamID, err := endpoint.unmarshalSatStreamID(ctx, sent.IfMatch)
+		if err != nil {
+			return metabase.Precondition{}, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+		}
+
+		precondition.IfMatch, err = uuid.FromBytes(satStreamID.StreamId)
+		if err != nil {
+			return metabase.Precondition{}, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+		}
+	}
+	if err := precondition.Verify(); err != nil {
+		return metabase.Precondition{}, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+	}
+	return precondition, nil
+}
+
 // GetObjectIPs returns the IP addresses of the nodes holding the pieces for
 // the provided object. This is useful for knowing the locations of the pieces.
 func (endpoint *Endpoint) GetObjectIPs(ctx context.Context, req *pb.ObjectGetIPsRequest) (resp *pb.ObjectGetIPsResponse, err error) {
@@ -1161,6 +1345,10 @@ func (endpoint *Endpoint) UpdateObjectMetadata(ctx context.Context, req *pb.Obje
 
 	endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
 
+	if err := endpoint.checkMaintenance(ctx); err != nil {
+		return nil, err
+	}
+
 	keyInfo, err := endpoint.validateAuth(ctx, req.Header, macaroon.Action{
 		Op:            macaroon.ActionWrite,
 		Bucket:        req.Bucket,
@@ -1209,6 +1397,12 @@ func (endpoint *Endpoint) UpdateObjectMetadata(ctx context.Context, req *pb.Obje
 		return nil, endpoint.convertMetabaseErr(err)
 	}
 
+	endpoint.publishUpdatedObjectEvent(ctx, events.ObjectMetadataUpdated, metabase.ObjectLocation{
+		ProjectID:  keyInfo.ProjectID,
+		BucketName: string(req.Bucket),
+		ObjectKey:  metabase.ObjectKey(req.EncryptedObjectKey),
+	}, id, events.OperationUpdateMetadata)
+
 	return &pb.ObjectUpdateMetadataResponse{}, nil
 }
 
@@ -1389,12 +1583,13 @@ func (endpoint *Endpoint) objectEntryToProtoListItem(ctx context.Context, bucket
 }
 
 // DeleteCommittedObject deletes all the pieces of the storage nodes that belongs
-// to the specified object.
+// to the specified object. The object is deleted only when it satisfies the
+// precondition.
 //
 // NOTE: this method is exported for being able to individually test it without
 // having import cycles.
 func (endpoint *Endpoint) DeleteCommittedObject(
-	ctx context.Context, projectID uuid.UUID, bucket string, object metabase.ObjectKey,
+	ctx context.Context, projectID uuid.UUID, bucket string, object metabase.ObjectKey, precondition metabase.Precondition,
 ) (deletedObjects []*pb.Object, err error) {
 	defer mon.Task()(&ctx, projectID.String(), bucket, object)(&err)
 
@@ -1405,9 +1600,10 @@ func (endpoint *Endpoint) DeleteCommittedObject(
 	}
 
 	var result metabase.DeleteObjectResult
-	if endpoint.config.ServerSideCopy {
+	if endpoint.config.ServerSideCopy || !precondition.IsZero() {
 		result, err = endpoint.metabase.DeleteObjectLastCommitted(ctx, metabase.DeleteObjectLastCommitted{
 			ObjectLocation: req,
+			Precondition:   precondition,
 		})
 	} else {
 		result, err = endpoint.metabase.DeleteObjectsAllVersions(ctx, metabase.DeleteObjectsAllVersions{Locations: []metabase.ObjectLocation{req}})
@@ -1496,6 +1692,10 @@ func (endpoint *Endpoint) deleteObjectsPieces(ctx context.Context, result metaba
 			return nil, err
 		}
 		deletedObjects[i] = deletedObject
+
+		if object.Status == metabase.Committed {
+			endpoint.publishObjectEvent(ctx, events.ObjectDeleted, object, events.OperationDelete)
+		}
 	}
 
 	endpoint.deleteSegmentPieces(ctx, result.Segments)
@@ -1503,6 +1703,81 @@ func (endpoint *Endpoint) deleteObjectsPieces(ctx context.Context, result metaba
 	return deletedObjects, nil
 }
 
+// publishObjectEvent publishes an event about the object to the event bus and
+// to the notification sink of its bucket.
+func (endpoint *Endpoint) publishObjectEvent(ctx context.Context, typ events.Type, object metabase.Object, operation events.Operation) {
+	if !endpoint.events.Enabled() && !endpoint.bucketEvents.Enabled() {
+		return
+	}
+
+	attributes := events.ObjectAttributes(object, operation)
+
+	endpoint.events.Publish(ctx, typ, attributes)
+	endpoint.bucketEvents.Publish(ctx, typ, object.Location(), attributes)
+}
+
+// publishUpdatedObjectEvent publishes an event about the stream, which has
+// been modified in place at the location. The object is looked up again, as
+// the metabase doesn't return it.
+func (endpoint *Endpoint) publishUpdatedObjectEvent(ctx context.Context, typ events.Type, location metabase.ObjectLocation, streamID uuid.UUID, operation events.Operation) {
+	if !endpoint.events.Enabled() && !endpoint.bucketEvents.Enabled() {
+		return
+	}
+
+	object, err := endpoint.metabase.GetObjectLastCommitted(ctx, metabase.GetObjectLastCommitted{
+		ObjectLocation: location,
+	})
+	if err != nil {
+		endpoint.log.Warn("unable to get object for event", zap.String("type", string(typ)), zap.Error(err))
+		return
+	}
+	if object.StreamID != streamID {
+		// the object has been replaced in the meantime.
+		return
+	}
+
+	endpoint.publishObjectEvent(ctx, typ, object, operation)
+}
+
+// publishMovedObjectEvents publishes the deletion of the source and the
+// commit of the object at the target location of a move.
+func (endpoint *Endpoint) publishMovedObjectEvents(ctx context.Context, source metabase.ObjectStream, target metabase.ObjectLocation) {
+	if !endpoint.events.Enabled() && !endpoint.bucketEvents.Enabled() {
+		return
+	}
+
+	object, err := endpoint.metabase.GetObjectLastCommitted(ctx, metabase.GetObjectLastCommitted{
+		ObjectLocation: target,
+	})
+	if err != nil {
+		endpoint.log.Warn("unable to get moved object for event", zap.Error(err))
+		return
+	}
+	if object.StreamID != source.StreamID {
+		// the object has been replaced in the meantime.
+		return
+	}
+
+	deleted := object
+	deleted.ObjectStream = source
+
+	endpoint.publishObjectEvent(ctx, events.ObjectDeleted, deleted, events.OperationMove)
+	endpoint.publishObjectEvent(ctx, events.ObjectCommitted, object, events.OperationMove)
+}
+
+// logAccess records the access to the object in the access log of its
+// project.
+func (endpoint *Endpoint) logAccess(ctx context.Context, keyInfo *console.APIKeyInfo, bucketName string, objectKey metabase.ObjectKey, operation accesslog.Operation, bytes int64) {
+	endpoint.accessLog.Record(ctx, accesslog.Entry{
+		ProjectID:  keyInfo.ProjectID,
+		APIKeyID:   keyInfo.ID,
+		BucketName: bucketName,
+		ObjectKey:  objectKey,
+		Operation:  operation,
+		Bytes:      bytes,
+	})
+}
+
 func (endpoint *Endpoint) deleteSegmentPieces(ctx context.Context, segments []metabase.DeletedSegmentInfo) {
 	var err error
 	defer mon.Task()(&ctx)(&err)
@@ -1550,6 +1825,10 @@ func (endpoint *Endpoint) BeginMoveObject(ctx context.Context, req *pb.ObjectBeg
 
 	endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
 
+	if err := endpoint.checkMaintenance(ctx); err != nil {
+		return nil, err
+	}
+
 	now := time.Now()
 	keyInfo, err := endpoint.validateAuthN(ctx, req.Header,
 		verifyPermission{
@@ -1712,6 +1991,10 @@ func (endpoint *Endpoint) FinishMoveObject(ctx context.Context, req *pb.ObjectFi
 
 	endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
 
+	if err := endpoint.checkMaintenance(ctx); err != nil {
+		return nil, err
+	}
+
 	streamID, err := endpoint.unmarshalSatStreamID(ctx, req.StreamId)
 	if err != nil {
 		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
@@ -1745,14 +2028,16 @@ func (endpoint *Endpoint) FinishMoveObject(ctx context.Context, req *pb.ObjectFi
 		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
 	}
 
+	source := metabase.ObjectStream{
+		ProjectID:  keyInfo.ProjectID,
+		BucketName: string(streamID.Bucket),
+		ObjectKey:  metabase.ObjectKey(streamID.EncryptedObjectKey),
+		Version:    metabase.Version(streamID.Version),
+		StreamID:   streamUUID,
+	}
+
 	err = endpoint.metabase.FinishMoveObject(ctx, metabase.FinishMoveObject{
-		ObjectStream: metabase.ObjectStream{
-			ProjectID:  keyInfo.ProjectID,
-			BucketName: string(streamID.Bucket),
-			ObjectKey:  metabase.ObjectKey(streamID.EncryptedObjectKey),
-			Version:    metabase.Version(streamID.Version),
-			StreamID:   streamUUID,
-		},
+		ObjectStream:                 source,
 		NewSegmentKeys:               protobufkeysToMetabase(req.NewSegmentKeys),
 		NewBucket:                    string(req.NewBucket),
 		NewEncryptedObjectKey:        req.NewEncryptedObjectKey,
@@ -1763,6 +2048,12 @@ func (endpoint *Endpoint) FinishMoveObject(ctx context.Context, req *pb.ObjectFi
 		return nil, endpoint.convertMetabaseErr(err)
 	}
 
+	endpoint.publishMovedObjectEvents(ctx, source, metabase.ObjectLocation{
+		ProjectID:  keyInfo.ProjectID,
+		BucketName: string(req.NewBucket),
+		ObjectKey:  metabase.ObjectKey(req.NewEncryptedObjectKey),
+	})
+
 	endpoint.log.Info("Object Move Finished", zap.Stringer("Project ID", keyInfo.ProjectID), zap.String("operation", "move"), zap.String("type", "object"))
 	mon.Meter("req_move_object_finished").Mark(1)
 
@@ -1781,6 +2072,10 @@ func (endpoint *Endpoint) BeginCopyObject(ctx context.Context, req *pb.ObjectBeg
 
 	endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
 
+	if err := endpoint.checkMaintenance(ctx); err != nil {
+		return nil, err
+	}
+
 	now := time.Now()
 	keyInfo, err := endpoint.validateAuthN(ctx, req.Header,
 		verifyPermission{
@@ -1901,6 +2196,10 @@ func (endpoint *Endpoint) FinishCopyObject(ctx context.Context, req *pb.ObjectFi
 
 	endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
 
+	if err := endpoint.checkMaintenance(ctx); err != nil {
+		return nil, err
+	}
+
 	streamID, err := endpoint.unmarshalSatStreamID(ctx, req.StreamId)
 	if err != nil {
 		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
@@ -1974,6 +2273,8 @@ func (endpoint *Endpoint) FinishCopyObject(ctx context.Context, req *pb.ObjectFi
 		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
 	}
 
+	endpoint.publishObjectEvent(ctx, events.ObjectCommitted, object, events.OperationCopy)
+
 	endpoint.log.Info("Object Copy Finished", zap.Stringer("Project ID", keyInfo.ProjectID), zap.String("operation", "copy"), zap.String("type", "object"))
 	mon.Meter("req_copy_object_finished").Mark(1)
 
@@ -6,8 +6,11 @@ package metainfo_test
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"net"
+	"net/http"
+	"net/http/httptest"
 	"sort"
 	"strconv"
 	"sync"
@@ -21,6 +24,7 @@ import (
 
 	"storj.io/common/errs2"
 	"storj.io/common/identity"
+	"storj.io/common/macaroon"
 	"storj.io/common/memory"
 	"storj.io/common/pb"
 	"storj.io/common/rpc/rpcstatus"
@@ -29,9 +33,13 @@ import (
 	"storj.io/common/testcontext"
 	"storj.io/common/testrand"
 	"storj.io/common/uuid"
+	"storj.io/drpc/drpcmetadata"
+	"storj.io/storj/private/rpcidempotency"
+	"storj.io/storj/private/rpcprecondition"
 	"storj.io/storj/private/testplanet"
 	"storj.io/storj/satellite"
 	"storj.io/storj/satellite/buckets"
+	"storj.io/storj/satellite/events"
 	"storj.io/storj/satellite/internalpb"
 	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/metainfo"
@@ -1290,12 +1298,142 @@ func TestEndpoint_DeleteCommittedObject(t *testing.T) {
 	deleteObject := func(ctx context.Context, t *testing.T, planet *testplanet.Planet, bucket, encryptedKey string, streamID uuid.UUID) {
 		projectID := planet.Uplinks[0].Projects[0].ID
 
-		_, err := planet.Satellites[0].Metainfo.Endpoint.DeleteCommittedObject(ctx, projectID, bucket, metabase.ObjectKey(encryptedKey))
+		_, err := planet.Satellites[0].Metainfo.Endpoint.DeleteCommittedObject(ctx, projectID, bucket, metabase.ObjectKey(encryptedKey), metabase.Precondition{})
 		require.NoError(t, err)
 	}
 	testDeleteObject(t, createObject, deleteObject)
 }
 
+func TestEndpoint_DeleteCommittedObjectPrecondition(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		apiKey := planet.Uplinks[0].APIKey[planet.Satellites[0].ID()]
+
+		metainfoClient, err := planet.Uplinks[0].DialMetainfo(ctx, planet.Satellites[0], apiKey)
+		require.NoError(t, err)
+		defer ctx.Check(metainfoClient.Close)
+
+		getObject := func() metaclient.RawObjectItem {
+			objects, err := planet.Satellites[0].Metabase.DB.TestingAllCommittedObjects(ctx, planet.Uplinks[0].Projects[0].ID, "bucket")
+			require.NoError(t, err)
+			require.Len(t, objects, 1)
+
+			object, err := metainfoClient.GetObject(ctx, metaclient.GetObjectParams{
+				Bucket:             []byte("bucket"),
+				EncryptedObjectKey: []byte(objects[0].ObjectKey),
+			})
+			require.NoError(t, err)
+			return object
+		}
+
+		require.NoError(t, planet.Uplinks[0].Upload(ctx, planet.Satellites[0], "bucket", "object", testrand.Bytes(memory.KiB)))
+		original := getObject()
+
+		// the object is overwritten by another client.
+		require.NoError(t, planet.Uplinks[0].Upload(ctx, planet.Satellites[0], "bucket", "object", testrand.Bytes(memory.KiB)))
+		current := getObject()
+
+		_, err = metainfoClient.BeginDeleteObject(ctx, metaclient.BeginDeleteObjectParams{
+			Bucket:             []byte("bucket"),
+			EncryptedObjectKey: original.EncryptedObjectKey,
+			StreamID:           original.StreamID,
+		})
+		require.True(t, errs2.IsRPC(err, rpcstatus.FailedPrecondition))
+
+		// a key without read and list permission must not learn whether the
+		// object exists from a failed precondition.
+		deleteOnlyKey, err := apiKey.Restrict(macaroon.Caveat{DisallowReads: true, DisallowLists: true})
+		require.NoError(t, err)
+		deleteOnlyClient, err := planet.Uplinks[0].DialMetainfo(ctx, planet.Satellites[0], deleteOnlyKey)
+		require.NoError(t, err)
+		defer ctx.Check(deleteOnlyClient.Close)
+
+		_, err = deleteOnlyClient.BeginDeleteObject(ctx, metaclient.BeginDeleteObjectParams{
+			Bucket:             []byte("bucket"),
+			EncryptedObjectKey: original.EncryptedObjectKey,
+			StreamID:           original.StreamID,
+		})
+		require.True(t, errs2.IsRPC(err, rpcstatus.PermissionDenied))
+
+		_, err = metainfoClient.BeginDeleteObject(ctx, metaclient.BeginDeleteObjectParams{
+			Bucket:             []byte("bucket"),
+			EncryptedObjectKey: current.EncryptedObjectKey,
+			StreamID:           current.StreamID,
+		})
+		require.NoError(t, err)
+
+		objects, err := planet.Satellites[0].Metabase.DB.TestingAllObjects(ctx)
+		require.NoError(t, err)
+		require.Empty(t, objects)
+	})
+}
+
+func TestEndpoint_CommitObjectPrecondition(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		apiKey := planet.Uplinks[0].APIKey[planet.Satellites[0].ID()]
+		require.NoError(t, planet.Uplinks[0].CreateBucket(ctx, planet.Satellites[0], "bucket"))
+
+		metainfoClient, err := planet.Uplinks[0].DialMetainfo(ctx, planet.Satellites[0], apiKey)
+		require.NoError(t, err)
+		defer ctx.Check(metainfoClient.Close)
+
+		writeOnlyKey, err := apiKey.Restrict(macaroon.Caveat{DisallowReads: true, DisallowLists: true})
+		require.NoError(t, err)
+		writeOnlyClient, err := planet.Uplinks[0].DialMetainfo(ctx, planet.Satellites[0], writeOnlyKey)
+		require.NoError(t, err)
+		defer ctx.Check(writeOnlyClient.Close)
+
+		commit := func(ctx context.Context, client *metaclient.Client) error {
+			begin, err := client.BeginObject(ctx, metaclient.BeginObjectParams{
+				Bucket:             []byte("bucket"),
+				EncryptedObjectKey: []byte("object"),
+				EncryptionParameters: storj.EncryptionParameters{
+					CipherSuite: storj.EncAESGCM,
+					BlockSize:   256,
+				},
+			})
+			require.NoError(t, err)
+
+			return client.CommitObject(ctx, metaclient.CommitObjectParams{
+				StreamID: begin.StreamID,
+			})
+		}
+		getObject := func() metaclient.RawObjectItem {
+			object, err := metainfoClient.GetObject(ctx, metaclient.GetObjectParams{
+				Bucket:             []byte("bucket"),
+				EncryptedObjectKey: []byte("object"),
+			})
+			require.NoError(t, err)
+			return object
+		}
+
+		require.NoError(t, commit(rpcprecondition.WithIfNoneMatch(ctx), metainfoClient))
+		original := getObject()
+
+		err = commit(rpcprecondition.WithIfNoneMatch(ctx), metainfoClient)
+		require.True(t, errs2.IsRPC(err, rpcstatus.FailedPrecondition))
+
+		// a key without read and list permission must not learn whether the
+		// object exists from a failed precondition.
+		err = commit(rpcprecondition.WithIfNoneMatch(ctx), writeOnlyClient)
+		require.True(t, errs2.IsRPC(err, rpcstatus.PermissionDenied))
+
+		require.NoError(t, commit(rpcprecondition.WithIfMatch(ctx, original.StreamID), metainfoClient))
+		current := getObject()
+		require.NotEqual(t, original.StreamID, current.StreamID)
+
+		err = commit(rpcprecondition.WithIfMatch(ctx, original.StreamID), metainfoClient)
+		require.True(t, errs2.IsRPC(err, rpcstatus.FailedPrecondition))
+		require.Equal(t, current.StreamID, getObject().StreamID)
+
+		err = commit(drpcmetadata.Add(ctx, rpcprecondition.IfMatchKey, "malformed!"), metainfoClient)
+		require.True(t, errs2.IsRPC(err, rpcstatus.InvalidArgument))
+	})
+}
+
 func TestEndpoint_DeletePendingObject(t *testing.T) {
 	createPendingObject := func(ctx context.Context, t *testing.T, planet *testplanet.Planet, bucket, key string, data []byte) {
 		// TODO This should be replaced by a call to testplanet.Uplink.MultipartUpload when available.
@@ -1831,6 +1969,52 @@ func TestEndpoint_CopyObject(t *testing.T) {
 	})
 }
 
+func TestEndpoint_CommitObject_SegmentLimit(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		projectID := planet.Uplinks[0].Projects[0].ID
+
+		err := sat.DB.ProjectAccounting().UpdateProjectSegmentLimit(ctx, projectID, 2)
+		require.NoError(t, err)
+
+		project, err := planet.Uplinks[0].OpenProject(ctx, sat)
+		require.NoError(t, err)
+		defer ctx.Check(project.Close)
+
+		_, err = project.CreateBucket(ctx, "testbucket")
+		require.NoError(t, err)
+
+		upload := func(key string) (uplink.UploadInfo, error) {
+			info, err := project.BeginUpload(ctx, "testbucket", key, nil)
+			require.NoError(t, err)
+
+			part, err := project.UploadPart(ctx, "testbucket", key, info.UploadID, 1)
+			require.NoError(t, err)
+			_, err = part.Write(testrand.Bytes(100))
+			require.NoError(t, err)
+			return info, part.Commit()
+		}
+
+		info, err := upload("first")
+		require.NoError(t, err)
+		_, err = project.CommitUpload(ctx, "testbucket", "first", info.UploadID, nil)
+		require.NoError(t, err)
+
+		info, err = upload("second")
+		require.NoError(t, err)
+
+		// a concurrent upload through another api server exhausts the limit.
+		err = sat.API.Accounting.ProjectUsage.UpdateProjectSegmentUsage(ctx, projectID, 1)
+		require.NoError(t, err)
+
+		_, err = project.CommitUpload(ctx, "testbucket", "second", info.UploadID, nil)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "Exceeded Segments Limit")
+	})
+}
+
 func TestEndpoint_ParallelDeletes(t *testing.T) {
 	t.Skip("to be fixed - creating deadlocks")
 	testplanet.Run(t, testplanet.Config{
@@ -2271,3 +2455,154 @@ func TestEndpoint_Object_CopyObject_MultipleVersions(t *testing.T) {
 		}, items)
 	})
 }
+
+func TestEndpoint_ObjectEvents(t *testing.T) {
+	var mu sync.Mutex
+	var received []events.Event
+	webhook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var batch []events.Event
+		if err := json.NewDecoder(r.Body).Decode(&batch); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		mu.Lock()
+		received = append(received, batch...)
+		mu.Unlock()
+	}))
+	defer webhook.Close()
+
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Events.Webhook.URL = webhook.URL
+				config.Events.FlushInterval = 10 * time.Millisecond
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		projectID := planet.Uplinks[0].Projects[0].ID
+
+		// inline objects are committed through the same path as remote ones.
+		err := planet.Uplinks[0].Upload(ctx, planet.Satellites[0], "bucket", "object", testrand.Bytes(memory.KiB))
+		require.NoError(t, err)
+
+		project, err := planet.Uplinks[0].OpenProject(ctx, planet.Satellites[0])
+		require.NoError(t, err)
+		defer ctx.Check(project.Close)
+
+		_, err = project.CopyObject(ctx, "bucket", "object", "bucket", "copy", nil)
+		require.NoError(t, err)
+
+		err = project.MoveObject(ctx, "bucket", "copy", "bucket", "moved", nil)
+		require.NoError(t, err)
+
+		err = planet.Uplinks[0].DeleteObject(ctx, planet.Satellites[0], "bucket", "object")
+		require.NoError(t, err)
+
+		expected := []struct {
+			typ       events.Type
+			operation events.Operation
+		}{
+			{events.ObjectCommitted, events.OperationCommit},
+			{events.ObjectCommitted, events.OperationCopy},
+			{events.ObjectDeleted, events.OperationMove},
+			{events.ObjectCommitted, events.OperationMove},
+			{events.ObjectDeleted, events.OperationDelete},
+		}
+
+		require.Eventually(t, func() bool {
+			mu.Lock()
+			defer mu.Unlock()
+			return len(received) >= len(expected)
+		}, 10*time.Second, 10*time.Millisecond)
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		require.Len(t, received, len(expected))
+		for i, event := range received {
+			require.Equal(t, expected[i].typ, event.Type)
+			require.Equal(t, string(expected[i].operation), event.Attributes["operation"])
+			require.Equal(t, projectID.String(), event.Attributes["project_id"])
+			require.Equal(t, "bucket", event.Attributes["bucket"])
+		}
+
+		// the original object is committed and deleted.
+		require.Equal(t, received[0].Attributes["stream_id"], received[4].Attributes["stream_id"])
+		// the copy is moved away from its key to the new one.
+		require.Equal(t, received[1].Attributes["stream_id"], received[2].Attributes["stream_id"])
+		require.Equal(t, received[1].Attributes["encrypted_key"], received[2].Attributes["encrypted_key"])
+		require.Equal(t, received[2].Attributes["stream_id"], received[3].Attributes["stream_id"])
+		require.NotEqual(t, received[2].Attributes["encrypted_key"], received[3].Attributes["encrypted_key"])
+	})
+}
+
+func TestEndpoint_IdempotencyKeys(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		apiKey := planet.Uplinks[0].APIKey[satellite.ID()]
+
+		metainfoClient, err := planet.Uplinks[0].DialMetainfo(ctx, satellite, apiKey)
+		require.NoError(t, err)
+		defer ctx.Check(metainfoClient.Close)
+
+		bucketName := "testbucket"
+		require.NoError(t, planet.Uplinks[0].CreateBucket(ctx, satellite, bucketName))
+
+		beginParams := metaclient.BeginObjectParams{
+			Bucket:             []byte(bucketName),
+			EncryptedObjectKey: []byte("object"),
+			EncryptionParameters: storj.EncryptionParameters{
+				CipherSuite: storj.EncAESGCM,
+				BlockSize:   256,
+			},
+		}
+
+		beginCtx := rpcidempotency.WithKey(ctx, "begin")
+		first, err := metainfoClient.BeginObject(beginCtx, beginParams)
+		require.NoError(t, err)
+
+		// the retry gets the same pending object.
+		retried, err := metainfoClient.BeginObject(beginCtx, beginParams)
+		require.NoError(t, err)
+		require.Equal(t, first.StreamID, retried.StreamID)
+
+		objects, err := satellite.Metabase.DB.TestingAllObjects(ctx)
+		require.NoError(t, err)
+		require.Len(t, objects, 1)
+
+		// the key can't be reused for a different request.
+		otherParams := beginParams
+		otherParams.EncryptedObjectKey = []byte("other")
+		_, err = metainfoClient.BeginObject(beginCtx, otherParams)
+		require.True(t, errs2.IsRPC(err, rpcstatus.InvalidArgument), err)
+
+		// the retry of a successful commit succeeds as well.
+		commitCtx := rpcidempotency.WithKey(ctx, "commit")
+		commitParams := metaclient.CommitObjectParams{StreamID: first.StreamID}
+		require.NoError(t, metainfoClient.CommitObject(commitCtx, commitParams))
+		require.NoError(t, metainfoClient.CommitObject(commitCtx, commitParams))
+
+		// without the key the retry fails.
+		require.Error(t, metainfoClient.CommitObject(ctx, commitParams))
+
+		deleteCtx := rpcidempotency.WithKey(ctx, "delete")
+		deleteParams := metaclient.BeginDeleteObjectParams{
+			Bucket:             []byte(bucketName),
+			EncryptedObjectKey: []byte("object"),
+		}
+		deleted, err := metainfoClient.BeginDeleteObject(deleteCtx, deleteParams)
+		require.NoError(t, err)
+		require.Equal(t, first.StreamID, deleted.StreamID)
+
+		redeleted, err := metainfoClient.BeginDeleteObject(deleteCtx, deleteParams)
+		require.NoError(t, err)
+		require.Equal(t, deleted, redeleted)
+
+		objects, err = satellite.Metabase.DB.TestingAllObjects(ctx)
+		require.NoError(t, err)
+		require.Empty(t, objects)
+	})
+}
@@ -16,6 +16,7 @@ import (
 	"storj.io/common/rpc/rpcstatus"
 	"storj.io/common/storj"
 	"storj.io/common/uuid"
+	"storj.io/storj/private/nodecapabilities"
 	"storj.io/storj/satellite/internalpb"
 	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/orders"
@@ -34,6 +35,14 @@ func (endpoint *Endpoint) BeginSegment(ctx context.Context, req *pb.SegmentBegin
 
 	endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
 
+	if err := endpoint.checkMaintenance(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := endpoint.admission.Admit(ctx, requestUpload); err != nil {
+		return nil, err
+	}
+
 	streamID, err := endpoint.unmarshalSatStreamID(ctx, req.StreamId)
 	if err != nil {
 		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
@@ -59,7 +68,8 @@ func (endpoint *Endpoint) BeginSegment(ctx context.Context, req *pb.SegmentBegin
 		return nil, err
 	}
 
-	redundancy, err := eestream.NewRedundancyStrategyFromProto(endpoint.defaultRS)
+	redundancyScheme := endpoint.streamRedundancy(streamID)
+	redundancy, err := eestream.NewRedundancyStrategyFromProto(redundancyScheme)
 	if err != nil {
 		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
 	}
@@ -69,6 +79,10 @@ func (endpoint *Endpoint) BeginSegment(ctx context.Context, req *pb.SegmentBegin
 	request := overlay.FindStorageNodesRequest{
 		RequestedCount: redundancy.TotalCount(),
 		Placement:      storj.PlacementConstraint(streamID.Placement),
+		Requires: nodecapabilities.Requirements{
+			TTL:       !streamID.ExpirationDate.IsZero(),
+			PieceSize: maxPieceSize,
+		},
 	}
 	nodes, err := endpoint.overlay.FindStorageNodesForUpload(ctx, request)
 	if err != nil {
@@ -131,7 +145,7 @@ func (endpoint *Endpoint) BeginSegment(ctx context.Context, req *pb.SegmentBegin
 		SegmentId:        segmentID,
 		AddressedLimits:  addressedLimits,
 		PrivateKey:       piecePrivateKey,
-		RedundancyScheme: endpoint.defaultRS,
+		RedundancyScheme: redundancyScheme,
 	}, nil
 }
 
@@ -141,6 +155,14 @@ func (endpoint *Endpoint) CommitSegment(ctx context.Context, req *pb.SegmentComm
 
 	endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
 
+	if err := endpoint.checkMaintenance(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := endpoint.admission.Admit(ctx, requestUpload); err != nil {
+		return nil, err
+	}
+
 	segmentID, err := endpoint.unmarshalSatSegmentID(ctx, req.SegmentId)
 	if err != nil {
 		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
@@ -158,26 +180,28 @@ func (endpoint *Endpoint) CommitSegment(ctx context.Context, req *pb.SegmentComm
 		return nil, err
 	}
 
+	redundancyScheme := endpoint.streamRedundancy(streamID)
+
 	// cheap basic verification
-	if numResults := len(req.UploadResult); numResults < int(endpoint.defaultRS.GetSuccessThreshold()) {
+	if numResults := len(req.UploadResult); numResults < int(redundancyScheme.GetSuccessThreshold()) {
 		endpoint.log.Debug("the results of uploaded pieces for the segment is below the redundancy optimal threshold",
 			zap.Int("upload pieces results", numResults),
-			zap.Int32("redundancy optimal threshold", endpoint.defaultRS.GetSuccessThreshold()),
+			zap.Int32("redundancy optimal threshold", redundancyScheme.GetSuccessThreshold()),
 			zap.Stringer("Segment ID", req.SegmentId),
 		)
 		return nil, rpcstatus.Errorf(rpcstatus.InvalidArgument,
 			"the number of results of uploaded pieces (%d) is below the optimal threshold (%d)",
-			numResults, endpoint.defaultRS.GetSuccessThreshold(),
+			numResults, redundancyScheme.GetSuccessThreshold(),
 		)
 	}
 
 	rs := storj.RedundancyScheme{
-		Algorithm:      storj.RedundancyAlgorithm(endpoint.defaultRS.Type),
-		RequiredShares: int16(endpoint.defaultRS.MinReq),
-		RepairShares:   int16(endpoint.defaultRS.RepairThreshold),
-		OptimalShares:  int16(endpoint.defaultRS.SuccessThreshold),
-		TotalShares:    int16(endpoint.defaultRS.Total),
-		ShareSize:      endpoint.defaultRS.ErasureShareSize,
+		Algorithm:      storj.RedundancyAlgorithm(redundancyScheme.Type),
+		RequiredShares: int16(redundancyScheme.MinReq),
+		RepairShares:   int16(redundancyScheme.RepairThreshold),
+		OptimalShares:  int16(redundancyScheme.SuccessThreshold),
+		TotalShares:    int16(redundancyScheme.Total),
+		ShareSize:      redundancyScheme.ErasureShareSize,
 	}
 
 	err = endpoint.pointerVerification.VerifySizes(ctx, rs, req.SizeEncryptedData, req.UploadResult)
@@ -319,6 +343,14 @@ func (endpoint *Endpoint) MakeInlineSegment(ctx context.Context, req *pb.Segment
 
 	endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
 
+	if err := endpoint.checkMaintenance(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := endpoint.admission.Admit(ctx, requestUpload); err != nil {
+		return nil, err
+	}
+
 	streamID, err := endpoint.unmarshalSatStreamID(ctx, req.StreamId)
 	if err != nil {
 		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
@@ -339,8 +371,8 @@ func (endpoint *Endpoint) MakeInlineSegment(ctx context.Context, req *pb.Segment
 	}
 
 	inlineUsed := int64(len(req.EncryptedInlineData))
-	if inlineUsed > endpoint.encInlineSegmentSize {
-		return nil, rpcstatus.Errorf(rpcstatus.InvalidArgument, "inline segment size cannot be larger than %s", endpoint.config.MaxInlineSegmentSize)
+	if maxSize, encMaxSize := endpoint.maxInlineSegmentSize(keyInfo.ProjectID); inlineUsed > encMaxSize {
+		return nil, rpcstatus.Errorf(rpcstatus.InvalidArgument, "inline segment size cannot be larger than %s", maxSize)
 	}
 
 	id, err := uuid.FromBytes(streamID.StreamId)
@@ -409,6 +441,10 @@ func (endpoint *Endpoint) ListSegments(ctx context.Context, req *pb.SegmentListR
 
 	endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
 
+	if err := endpoint.admission.Admit(ctx, requestBulk); err != nil {
+		return nil, err
+	}
+
 	streamID, err := endpoint.unmarshalSatStreamID(ctx, req.StreamId)
 	if err != nil {
 		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
@@ -561,6 +597,13 @@ func (endpoint *Endpoint) DownloadSegment(ctx context.Context, req *pb.SegmentDo
 		return nil, endpoint.convertMetabaseErr(err)
 	}
 
+	// the object may have expired since the download started, but the
+	// expired deletion chore hasn't deleted it yet.
+	if segment.Expired(time.Now()) {
+		mon.Meter("download_expired_segment_hidden").Mark(1)
+		return nil, rpcstatus.Error(rpcstatus.NotFound, "object not found")
+	}
+
 	// Update the current bandwidth cache value incrementing the SegmentSize.
 	err = endpoint.projectUsage.UpdateProjectBandwidthUsage(ctx, keyInfo.ProjectID, int64(segment.EncryptedSize))
 	if err != nil {
@@ -10,6 +10,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
 
 	"storj.io/common/errs2"
 	"storj.io/common/memory"
@@ -20,6 +21,7 @@ import (
 	"storj.io/common/testcontext"
 	"storj.io/common/testrand"
 	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite"
 	"storj.io/storj/satellite/metabase"
 	"storj.io/uplink/private/metaclient"
 )
@@ -321,6 +323,38 @@ func TestRemoteSegment(t *testing.T) {
 	})
 }
 
+func TestPlacementRedundancyScheme(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 10, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				require.NoError(t, config.Metainfo.PlacementRS.Set("0:3/5/7/9-256B"))
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+
+		require.NoError(t, planet.Uplinks[0].Upload(ctx, sat, "testbucket", "object", testrand.Bytes(50*memory.KiB)))
+
+		segments, err := sat.Metabase.DB.TestingAllSegments(ctx)
+		require.NoError(t, err)
+		require.Len(t, segments, 1)
+		require.Equal(t, storj.RedundancyScheme{
+			Algorithm:      storj.ReedSolomon,
+			RequiredShares: 3,
+			RepairShares:   5,
+			OptimalShares:  7,
+			TotalShares:    9,
+			ShareSize:      256,
+		}, segments[0].Redundancy)
+		require.GreaterOrEqual(t, len(segments[0].Pieces), 7)
+
+		data, err := planet.Uplinks[0].Download(ctx, sat, "testbucket", "object")
+		require.NoError(t, err)
+		require.Len(t, data, 50*memory.KiB.Int())
+	})
+}
+
 func TestInlineSegmentThreshold(t *testing.T) {
 	testplanet.Run(t, testplanet.Config{
 		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
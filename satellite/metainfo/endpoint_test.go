@@ -105,6 +105,9 @@ func TestEndpoint_NoStorageNodes(t *testing.T) {
 			err = planet.Satellites[0].API.DB.Revocation().Revoke(ctx, apiKey.Tail(), []byte("apikey"))
 			require.NoError(t, err)
 
+			// the revocation was made by another api server.
+			planet.Satellites[0].API.Metainfo.Revocations.Loop.TriggerWait()
+
 			_, err = client.ListBuckets(ctx, metaclient.ListBucketsParams{})
 			assert.True(t, errs2.IsRPC(err, rpcstatus.PermissionDenied))
 
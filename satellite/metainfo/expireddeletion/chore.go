@@ -12,6 +12,7 @@ import (
 	"go.uber.org/zap"
 
 	"storj.io/common/sync2"
+	"storj.io/storj/satellite/events"
 	"storj.io/storj/satellite/metabase"
 )
 
@@ -35,17 +36,19 @@ type Chore struct {
 	log      *zap.Logger
 	config   Config
 	metabase *metabase.DB
+	events   *events.Bus
 
 	nowFn func() time.Time
 	Loop  *sync2.Cycle
 }
 
 // NewChore creates a new instance of the expireddeletion chore.
-func NewChore(log *zap.Logger, config Config, metabase *metabase.DB) *Chore {
+func NewChore(log *zap.Logger, config Config, metabase *metabase.DB, events *events.Bus) *Chore {
 	return &Chore{
 		log:      log,
 		config:   config,
 		metabase: metabase,
+		events:   events,
 
 		nowFn: time.Now,
 		Loop:  sync2.NewCycle(config.Interval),
@@ -80,13 +83,37 @@ func (chore *Chore) deleteExpiredObjects(ctx context.Context) (err error) {
 
 	// TODO log error instead of crashing core until we will be sure
 	// that queries for deleting expired objects are stable
-	err = chore.metabase.DeleteExpiredObjects(ctx, metabase.DeleteExpiredObjects{
+	opts := metabase.DeleteExpiredObjects{
 		ExpiredBefore: chore.nowFn(),
 		BatchSize:     chore.config.ListLimit,
-	})
+	}
+	if chore.events.Enabled() {
+		opts.Deleted = chore.publishDeleted
+	}
+
+	err = chore.metabase.DeleteExpiredObjects(ctx, opts)
 	if err != nil {
 		chore.log.Error("deleting expired objects failed", zap.Error(err))
 	}
 
+	deleted, err := chore.metabase.DeleteExpiredIdempotencyKeys(ctx, metabase.DeleteExpiredIdempotencyKeys{
+		ExpiredBefore: chore.nowFn(),
+		BatchSize:     chore.config.ListLimit,
+	})
+	if err != nil {
+		chore.log.Error("deleting expired idempotency keys failed", zap.Error(err))
+	}
+	mon.IntVal("expired_idempotency_keys_deleted").Observe(deleted)
+
 	return nil
 }
+
+// publishDeleted publishes the deletion of the committed expired objects.
+func (chore *Chore) publishDeleted(ctx context.Context, objects []metabase.Object) {
+	for _, object := range objects {
+		if object.Status != metabase.Committed {
+			continue
+		}
+		chore.events.Publish(ctx, events.ObjectDeleted, events.ObjectAttributes(object, events.OperationExpire))
+	}
+}
@@ -4,6 +4,10 @@
 package expireddeletion_test
 
 import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
 	"testing"
 	"time"
 
@@ -15,6 +19,7 @@ import (
 	"storj.io/common/testrand"
 	"storj.io/storj/private/testplanet"
 	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/events"
 )
 
 func TestExpiredDeletion(t *testing.T) {
@@ -65,6 +70,60 @@ func TestExpiredDeletion(t *testing.T) {
 	})
 }
 
+func TestExpiredDeletion_Events(t *testing.T) {
+	var mu sync.Mutex
+	var received []events.Event
+	webhook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var batch []events.Event
+		if err := json.NewDecoder(r.Body).Decode(&batch); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		mu.Lock()
+		received = append(received, batch...)
+		mu.Unlock()
+	}))
+	defer webhook.Close()
+
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Events.Webhook.URL = webhook.URL
+				config.Events.FlushInterval = 10 * time.Millisecond
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		expiredChore := satellite.Core.ExpiredDeletion.Chore
+		expiredChore.Loop.Pause()
+
+		err := planet.Uplinks[0].UploadWithExpiration(ctx, satellite, "testbucket", "expire", testrand.Bytes(memory.KiB), time.Now().Add(time.Hour))
+		require.NoError(t, err)
+
+		expiredChore.SetNow(func() time.Time {
+			return time.Now().Add(2 * time.Hour)
+		})
+		expiredChore.Loop.TriggerWait()
+
+		require.Eventually(t, func() bool {
+			mu.Lock()
+			defer mu.Unlock()
+			return len(received) >= 2
+		}, 10*time.Second, 10*time.Millisecond)
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		require.Len(t, received, 2)
+		require.Equal(t, events.ObjectCommitted, received[0].Type)
+		require.Equal(t, events.ObjectDeleted, received[1].Type)
+		require.Equal(t, string(events.OperationExpire), received[1].Attributes["operation"])
+		require.Equal(t, received[0].Attributes["stream_id"], received[1].Attributes["stream_id"])
+		require.Equal(t, received[0].Attributes["size"], received[1].Attributes["size"])
+	})
+}
+
 func TestExpiresAtForSegmentsAfterCopy(t *testing.T) {
 	testplanet.Run(t, testplanet.Config{
 		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
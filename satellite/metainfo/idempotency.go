@@ -0,0 +1,134 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metainfo
+
+import (
+	"context"
+	"crypto/sha256"
+
+	"github.com/gogo/protobuf/proto"
+	"go.uber.org/zap"
+
+	"storj.io/common/pb"
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/common/uuid"
+	"storj.io/storj/private/rpcidempotency"
+	"storj.io/storj/satellite/metabase"
+)
+
+// idempotentRequest is a request with an idempotency key, which reserved the
+// key. A nil idempotentRequest is a request without an idempotency key.
+type idempotentRequest struct {
+	endpoint *Endpoint
+	key      metabase.IdempotencyKey
+
+	// handled is true, when an earlier request with the same key has been
+	// handled. replay is its response.
+	handled bool
+	replay  []byte
+}
+
+// reserveIdempotencyKey reserves the idempotency key, which the client sent
+// with the request. The hash of the request includes its header, so only
+// retries with the same API key replay the response, which may contain
+// information the API key is allowed to read.
+func (endpoint *Endpoint) reserveIdempotencyKey(ctx context.Context, projectID uuid.UUID, method string, req proto.Message) (_ *idempotentRequest, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if endpoint.config.IdempotencyKeyTTL <= 0 {
+		return nil, nil
+	}
+	key, ok := rpcidempotency.Key(ctx)
+	if !ok {
+		return nil, nil
+	}
+
+	idempotencyKey := metabase.IdempotencyKey{ProjectID: projectID, Method: method, Key: key}
+	if len(key) > metabase.MaxIdempotencyKeyLength {
+		return nil, rpcstatus.Errorf(rpcstatus.InvalidArgument, "idempotency key is longer than %d bytes", metabase.MaxIdempotencyKeyLength)
+	}
+
+	requestHash, err := hashRequest(req)
+	if err != nil {
+		endpoint.log.Error("internal", zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+
+	reservation, err := endpoint.metabase.ReserveIdempotencyKey(ctx, metabase.ReserveIdempotencyKey{
+		IdempotencyKey: idempotencyKey,
+		RequestHash:    requestHash,
+		TTL:            endpoint.config.IdempotencyKeyTTL,
+		// a request isn't handled longer than the request timeout.
+		ReservationTimeout: endpoint.config.MaxRequestTimeout,
+	})
+	if err != nil {
+		switch {
+		case metabase.ErrIdempotencyKeyReused.Has(err):
+			return nil, rpcstatus.Error(rpcstatus.InvalidArgument, "idempotency key was used for a different request")
+		case metabase.ErrIdempotencyKeyInProgress.Has(err):
+			return nil, rpcstatus.Error(rpcstatus.Aborted, "request with the same idempotency key is in progress")
+		}
+		endpoint.log.Error("internal", zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+
+	return &idempotentRequest{
+		endpoint: endpoint,
+		key:      idempotencyKey,
+		handled:  !reservation.Reserved,
+		replay:   reservation.Response,
+	}, nil
+}
+
+// replayed returns whether an earlier request with the same key has been
+// handled, and unmarshals its response into resp.
+func (request *idempotentRequest) replayed(resp proto.Message) (_ bool, err error) {
+	if request == nil || !request.handled {
+		return false, nil
+	}
+	if err := pb.Unmarshal(request.replay, resp); err != nil {
+		request.endpoint.log.Error("internal", zap.Error(err))
+		return true, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+	return true, nil
+}
+
+// finish stores the response of a successful request, or releases the key of
+// a failed one, so it can be retried.
+func (request *idempotentRequest) finish(ctx context.Context, resp proto.Message, err error) {
+	if request == nil || request.handled {
+		return
+	}
+
+	if err != nil {
+		if releaseErr := request.endpoint.metabase.ReleaseIdempotencyKey(ctx, metabase.ReleaseIdempotencyKey{
+			IdempotencyKey: request.key,
+		}); releaseErr != nil {
+			request.endpoint.log.Warn("unable to release idempotency key", zap.Error(releaseErr))
+		}
+		return
+	}
+
+	response, err := pb.Marshal(resp)
+	if err == nil {
+		err = request.endpoint.metabase.StoreIdempotentResponse(ctx, metabase.StoreIdempotentResponse{
+			IdempotencyKey: request.key,
+			Response:       response,
+		})
+	}
+	if err != nil {
+		// the reservation expires, until then retries fail as in progress.
+		request.endpoint.log.Warn("unable to store idempotent response", zap.Error(err))
+	}
+}
+
+// hashRequest returns the hash of the request.
+func hashRequest(req proto.Message) ([]byte, error) {
+	data, err := pb.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+	hash := sha256.Sum256(data)
+	return hash[:], nil
+}
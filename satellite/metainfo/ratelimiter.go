@@ -0,0 +1,142 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metainfo
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"go.uber.org/zap"
+	"golang.org/x/time/rate"
+
+	"storj.io/common/uuid"
+)
+
+// sharedRateLimitScript implements a token bucket, which is refilled
+// according to the time passed by the caller. It returns 1 when a token has
+// been taken from the bucket.
+var sharedRateLimitScript = redis.NewScript(`
+local rate = tonumber(ARGV[1])
+local burst = tonumber(ARGV[2])
+local now = tonumber(ARGV[3])
+local ttl = tonumber(ARGV[4])
+
+local state = redis.call("HMGET", KEYS[1], "tokens", "updated")
+local tokens = tonumber(state[1])
+local updated = tonumber(state[2])
+if tokens == nil or updated == nil then
+	tokens = burst
+	updated = now
+end
+
+tokens = math.min(burst, tokens + math.max(0, now - updated) * rate / 1000000)
+
+local allowed = 0
+if tokens >= 1 then
+	tokens = tokens - 1
+	allowed = 1
+end
+
+redis.call("HMSET", KEYS[1], "tokens", tostring(tokens), "updated", tostring(now))
+redis.call("PEXPIRE", KEYS[1], ttl)
+return allowed
+`)
+
+// sharedRateLimiter enforces the project rate limits across all satellite
+// api instances using a token bucket stored in redis.
+//
+// When redis is unavailable, the caller is expected to fall back to its own
+// limiter. Redis isn't used again until the retry interval has passed, so an
+// outage doesn't add latency to every request.
+type sharedRateLimiter struct {
+	log           *zap.Logger
+	client        *redis.Client
+	retryInterval time.Duration
+	nowFn         func() time.Time
+
+	mu               sync.Mutex
+	unavailableUntil time.Time
+}
+
+// newSharedRateLimiter creates a rate limiter using the redis server at
+// address, which is formatted as redis://host:port?db=N&password=secret.
+func newSharedRateLimiter(log *zap.Logger, address string, retryInterval time.Duration) (*sharedRateLimiter, error) {
+	redisurl, err := url.Parse(address)
+	if err != nil {
+		return nil, Error.New("invalid rate limiter backend: %w", err)
+	}
+	if redisurl.Scheme != "redis" {
+		return nil, Error.New("invalid rate limiter backend: not a redis:// formatted address")
+	}
+
+	q := redisurl.Query()
+	db, err := strconv.Atoi(q.Get("db"))
+	if err != nil {
+		return nil, Error.New("invalid rate limiter backend: invalid database number %q", q.Get("db"))
+	}
+
+	return &sharedRateLimiter{
+		log: log,
+		client: redis.NewClient(&redis.Options{
+			Addr:     redisurl.Host,
+			Password: q.Get("password"),
+			DB:       db,
+		}),
+		retryInterval: retryInterval,
+		nowFn:         time.Now,
+	}, nil
+}
+
+// Allow takes a token from the bucket of the project. It returns an error,
+// when the limit can't be checked and the caller should use its own limiter.
+func (limiter *sharedRateLimiter) Allow(ctx context.Context, projectID uuid.UUID, limit rate.Limit, burst int) (_ bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if limit == rate.Inf {
+		return true, nil
+	}
+
+	now := limiter.nowFn()
+
+	limiter.mu.Lock()
+	unavailable := now.Before(limiter.unavailableUntil)
+	limiter.mu.Unlock()
+	if unavailable {
+		mon.Event("metainfo_shared_rate_limiter_skipped")
+		return false, Error.New("shared rate limiter unavailable")
+	}
+
+	// keep the bucket until it would have been refilled.
+	ttl := time.Second
+	if limit > 0 {
+		ttl += time.Duration(float64(burst) / float64(limit) * float64(time.Second))
+	}
+
+	allowed, err := sharedRateLimitScript.Run(ctx, limiter.client,
+		[]string{"metainfo:ratelimit:" + projectID.String()},
+		float64(limit), burst, now.UnixMicro(), ttl.Milliseconds(),
+	).Int()
+	if err != nil {
+		limiter.mu.Lock()
+		limiter.unavailableUntil = now.Add(limiter.retryInterval)
+		limiter.mu.Unlock()
+
+		mon.Event("metainfo_shared_rate_limiter_failed")
+		limiter.log.Warn("shared rate limiter unavailable, using the local rate limiter",
+			zap.Duration("retry interval", limiter.retryInterval),
+			zap.Error(err))
+		return false, Error.Wrap(err)
+	}
+
+	return allowed == 1, nil
+}
+
+// Close closes the connection to redis.
+func (limiter *sharedRateLimiter) Close() error {
+	return Error.Wrap(limiter.client.Close())
+}
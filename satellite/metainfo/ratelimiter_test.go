@@ -0,0 +1,93 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metainfo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/testredis"
+)
+
+func TestSharedRateLimiter(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	server, err := testredis.Mini(ctx)
+	require.NoError(t, err)
+	defer ctx.Check(server.Close)
+
+	now := time.Now()
+	newLimiter := func() *sharedRateLimiter {
+		limiter, err := newSharedRateLimiter(zaptest.NewLogger(t), "redis://"+server.Addr()+"?db=0", time.Minute)
+		require.NoError(t, err)
+		limiter.nowFn = func() time.Time { return now }
+		return limiter
+	}
+
+	// two api instances share the limit of the project.
+	first, second := newLimiter(), newLimiter()
+	defer ctx.Check(first.Close)
+	defer ctx.Check(second.Close)
+
+	projectID := testrand.UUID()
+	for i := 0; i < 2; i++ {
+		allowed, err := first.Allow(ctx, projectID, 1, 4)
+		require.NoError(t, err)
+		require.True(t, allowed)
+
+		allowed, err = second.Allow(ctx, projectID, 1, 4)
+		require.NoError(t, err)
+		require.True(t, allowed)
+	}
+
+	allowed, err := first.Allow(ctx, projectID, 1, 4)
+	require.NoError(t, err)
+	require.False(t, allowed)
+
+	// other projects have their own limits.
+	allowed, err = second.Allow(ctx, testrand.UUID(), 1, 4)
+	require.NoError(t, err)
+	require.True(t, allowed)
+
+	// the bucket is refilled over time.
+	now = now.Add(time.Second)
+	allowed, err = second.Allow(ctx, projectID, 1, 4)
+	require.NoError(t, err)
+	require.True(t, allowed)
+
+	allowed, err = first.Allow(ctx, projectID, 1, 4)
+	require.NoError(t, err)
+	require.False(t, allowed)
+}
+
+func TestSharedRateLimiter_Unavailable(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	server, err := testredis.Mini(ctx)
+	require.NoError(t, err)
+
+	now := time.Now()
+	limiter, err := newSharedRateLimiter(zaptest.NewLogger(t), "redis://"+server.Addr()+"?db=0", time.Minute)
+	require.NoError(t, err)
+	defer ctx.Check(limiter.Close)
+	limiter.nowFn = func() time.Time { return now }
+
+	require.NoError(t, server.Close())
+
+	_, err = limiter.Allow(ctx, testrand.UUID(), 1, 1)
+	require.Error(t, err)
+
+	// redis isn't used until the retry interval has passed.
+	require.False(t, limiter.unavailableUntil.IsZero())
+	_, err = limiter.Allow(ctx, testrand.UUID(), 1, 1)
+	require.Error(t, err)
+
+	_, err = newSharedRateLimiter(zaptest.NewLogger(t), "http://localhost?db=0", time.Minute)
+	require.Error(t, err)
+}
@@ -21,10 +21,12 @@ import (
 	"storj.io/common/macaroon"
 	"storj.io/common/memory"
 	"storj.io/common/pb"
+	"storj.io/common/rpc/rpcpeer"
 	"storj.io/common/rpc/rpcstatus"
 	"storj.io/common/storj"
 	"storj.io/common/uuid"
 	"storj.io/storj/satellite/accounting"
+	"storj.io/storj/satellite/bucketpolicy"
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/console/consoleauth"
 	"storj.io/storj/satellite/metabase"
@@ -61,10 +63,9 @@ func (endpoint *Endpoint) validateAuth(ctx context.Context, header *pb.RequestHe
 		return nil, err
 	}
 
-	err = key.Check(ctx, keyInfo.Secret, action, endpoint.revocations)
+	err = endpoint.checkAction(ctx, key, keyInfo, action)
 	if err != nil {
-		endpoint.log.Debug("unauthorized request", zap.Error(err))
-		return nil, rpcstatus.Error(rpcstatus.PermissionDenied, "Unauthorized API credentials")
+		return nil, endpoint.unauthorized(err)
 	}
 
 	return keyInfo, nil
@@ -103,13 +104,12 @@ func (endpoint *Endpoint) validateAuthN(ctx context.Context, header *pb.RequestH
 	}
 
 	for _, p := range permissions {
-		err = key.Check(ctx, keyInfo.Secret, p.action, endpoint.revocations)
+		err = endpoint.checkAction(ctx, key, keyInfo, p.action)
 		if p.actionPermitted != nil {
 			*p.actionPermitted = err == nil
 		}
 		if err != nil && !p.optional {
-			endpoint.log.Debug("unauthorized request", zap.Error(err))
-			return nil, rpcstatus.Error(rpcstatus.PermissionDenied, "Unauthorized API credentials")
+			return nil, endpoint.unauthorized(err)
 		}
 	}
 
@@ -133,7 +133,7 @@ func (endpoint *Endpoint) validateAuthAny(ctx context.Context, header *pb.Reques
 
 	var combinedErrs error
 	for _, action := range actions {
-		err = key.Check(ctx, keyInfo.Secret, action, endpoint.revocations)
+		err = endpoint.checkAction(ctx, key, keyInfo, action)
 		if err == nil {
 			return keyInfo, nil
 		}
@@ -144,6 +144,31 @@ func (endpoint *Endpoint) validateAuthAny(ctx context.Context, header *pb.Reques
 	return nil, rpcstatus.Error(rpcstatus.PermissionDenied, "Unauthorized API credentials")
 }
 
+// checkAction checks the action against the caveats of the key and the
+// policy of the bucket of the action.
+func (endpoint *Endpoint) checkAction(ctx context.Context, key *macaroon.APIKey, keyInfo *console.APIKeyInfo, action macaroon.Action) error {
+	err := key.Check(ctx, keyInfo.Secret, action, endpoint.revocations)
+	if err != nil {
+		return err
+	}
+	return endpoint.bucketPolicies.Check(ctx, keyInfo.ProjectID, action)
+}
+
+// unauthorized converts the error of checkAction to an rpc error.
+func (endpoint *Endpoint) unauthorized(err error) error {
+	switch {
+	case bucketpolicy.ErrDenied.Has(err):
+		endpoint.log.Debug("request denied by bucket policy", zap.Error(err))
+		return rpcstatus.Error(rpcstatus.PermissionDenied, "Access denied by bucket policy")
+	case bucketpolicy.Error.Has(err):
+		endpoint.log.Error("unable to check bucket policy", zap.Error(err))
+		return rpcstatus.Error(rpcstatus.Internal, "unable to check bucket policy")
+	default:
+		endpoint.log.Debug("unauthorized request", zap.Error(err))
+		return rpcstatus.Error(rpcstatus.PermissionDenied, "Unauthorized API credentials")
+	}
+}
+
 func (endpoint *Endpoint) validateBasic(ctx context.Context, header *pb.RequestHeader) (_ *macaroon.APIKey, _ *console.APIKeyInfo, err error) {
 	defer mon.Task()(&ctx)(&err)
 
@@ -169,6 +194,11 @@ func (endpoint *Endpoint) validateBasic(ctx context.Context, header *pb.RequestH
 		eventkit.String("partner", keyInfo.PartnerID.String()),
 	)
 
+	if err = endpoint.checkNetworkRules(ctx, keyInfo.ProjectID); err != nil {
+		endpoint.log.Debug("network rules check failed", zap.Error(err))
+		return nil, nil, err
+	}
+
 	if err = endpoint.checkRate(ctx, keyInfo.ProjectID); err != nil {
 		endpoint.log.Debug("rate check failed", zap.Error(err))
 		return nil, nil, err
@@ -177,6 +207,33 @@ func (endpoint *Endpoint) validateBasic(ctx context.Context, header *pb.RequestH
 	return key, keyInfo, nil
 }
 
+// checkNetworkRules rejects the request, when the network rules of the
+// project don't allow the address of the peer.
+func (endpoint *Endpoint) checkNetworkRules(ctx context.Context, projectID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !endpoint.config.NetworkRules.Enabled || endpoint.networkRules == nil {
+		return nil
+	}
+
+	cached, err := endpoint.networkRulesCache.Get(projectID.String(), func() (interface{}, error) {
+		return endpoint.networkRules.List(ctx, projectID)
+	})
+	if err != nil {
+		return rpcstatus.Error(rpcstatus.Unavailable, err.Error())
+	}
+	rules := cached.(console.NetworkRules)
+	if len(rules) == 0 {
+		return nil
+	}
+
+	peer, err := rpcpeer.FromContext(ctx)
+	if err != nil || !rules.AllowsAddress(peer.Addr.String()) {
+		mon.Event("metainfo_network_restricted")
+		return rpcstatus.Error(rpcstatus.PermissionDenied, "project can't be accessed from this network")
+	}
+	return nil
+}
+
 func (endpoint *Endpoint) validateRevoke(ctx context.Context, header *pb.RequestHeader, macToRevoke *macaroon.Macaroon) (_ *console.APIKeyInfo, err error) {
 	defer mon.Task()(&ctx)(&err)
 	key, keyInfo, err := endpoint.validateBasic(ctx, header)
@@ -231,7 +288,19 @@ func (endpoint *Endpoint) checkRate(ctx context.Context, projectID uuid.UUID) (e
 		return rpcstatus.Error(rpcstatus.Unavailable, err.Error())
 	}
 
-	if !limiter.(*rate.Limiter).Allow() {
+	allowed := false
+	if endpoint.sharedLimiter != nil {
+		allowed, err = endpoint.sharedLimiter.Allow(ctx, projectID, limiter.(*rate.Limiter).Limit(), limiter.(*rate.Limiter).Burst())
+		if err != nil {
+			// the shared limiter is unavailable, so the limits are enforced
+			// by this instance only.
+			allowed = limiter.(*rate.Limiter).Allow()
+		}
+	} else {
+		allowed = limiter.(*rate.Limiter).Allow()
+	}
+
+	if !allowed {
 		endpoint.log.Warn("too many requests for project",
 			zap.Stringer("projectID", projectID),
 			zap.Float64("rate limit", float64(limiter.(*rate.Limiter).Limit())),
@@ -245,6 +314,25 @@ func (endpoint *Endpoint) checkRate(ctx context.Context, projectID uuid.UUID) (e
 	return nil
 }
 
+// checkMaintenance rejects the request, when the satellite is in maintenance
+// mode. It must be called only by requests, which modify the metadata. The
+// clients get the retry-after from the maintenance endpoint.
+func (endpoint *Endpoint) checkMaintenance(ctx context.Context) error {
+	state := endpoint.maintenance.State()
+	if !state.Enabled {
+		return nil
+	}
+
+	mon.Event("metainfo_maintenance_rejected")
+
+	reason := state.Reason
+	if reason == "" {
+		reason = "maintenance"
+	}
+	return rpcstatus.Errorf(rpcstatus.Unavailable,
+		"satellite is in maintenance mode (%s), retry after %ds", reason, int64(state.RetryAfter/time.Second))
+}
+
 func (endpoint *Endpoint) validateBucket(ctx context.Context, bucket []byte) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
@@ -414,6 +502,35 @@ func (endpoint *Endpoint) checkUploadLimitsForNewObject(
 	return nil
 }
 
+// checkSegmentLimit checks whether the project is over its segment limit.
+// The segments of the object being committed are already included in the
+// segment usage.
+func (endpoint *Endpoint) checkSegmentLimit(ctx context.Context, projectID uuid.UUID) error {
+	limit, err := endpoint.projectUsage.ExceedsUploadLimits(ctx, projectID, 0, 0)
+	if err != nil {
+		if errs2.IsCanceled(err) {
+			return rpcstatus.Wrap(rpcstatus.Canceled, err)
+		}
+
+		endpoint.log.Error(
+			"Retrieving project segment limit failed; limit won't be enforced",
+			zap.Stringer("Project ID", projectID),
+			zap.Error(err),
+		)
+		return nil
+	}
+
+	if limit.ExceedsSegments {
+		endpoint.log.Warn("Segment limit exceeded on commit",
+			zap.String("Limit", strconv.Itoa(int(limit.SegmentsLimit))),
+			zap.Stringer("Project ID", projectID),
+		)
+		mon.Event("segment_limit_exceeded_on_commit")
+		return rpcstatus.Error(rpcstatus.ResourceExhausted, "Exceeded Segments Limit")
+	}
+	return nil
+}
+
 func (endpoint *Endpoint) addSegmentToUploadLimits(ctx context.Context, projectID uuid.UUID, segmentSize int64) error {
 	return endpoint.addToUploadLimits(ctx, projectID, segmentSize, 1)
 }
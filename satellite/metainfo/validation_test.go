@@ -14,9 +14,11 @@ import (
 
 	"storj.io/common/macaroon"
 	"storj.io/common/pb"
+	"storj.io/common/rpc/rpcstatus"
 	"storj.io/common/testcontext"
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/console/consoleauth"
+	"storj.io/storj/satellite/maintenance"
 )
 
 type mockAPIKeys struct {
@@ -231,3 +233,40 @@ func TestEndpoint_validateAuthN(t *testing.T) {
 		assert.Equal(t, tt.wantCanDelete, canDelete, i)
 	}
 }
+
+type mockMaintenanceDB struct {
+	state maintenance.State
+}
+
+func (db *mockMaintenanceDB) Get(ctx context.Context) (maintenance.State, error) {
+	return db.state, nil
+}
+
+func (db *mockMaintenanceDB) Set(ctx context.Context, state maintenance.State) error {
+	db.state = state
+	return nil
+}
+
+func TestEndpoint_checkMaintenance(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	// without the service the satellite is never in maintenance mode.
+	endpoint := Endpoint{log: zaptest.NewLogger(t)}
+	require.NoError(t, endpoint.checkMaintenance(ctx))
+
+	db := &mockMaintenanceDB{}
+	endpoint.maintenance = maintenance.NewService(zaptest.NewLogger(t), db, maintenance.Config{RefreshInterval: time.Hour})
+	defer ctx.Check(endpoint.maintenance.Close)
+
+	require.NoError(t, endpoint.maintenance.Refresh(ctx))
+	require.NoError(t, endpoint.checkMaintenance(ctx))
+
+	db.state = maintenance.State{Enabled: true, Reason: "migration", RetryAfter: 5 * time.Minute}
+	require.NoError(t, endpoint.maintenance.Refresh(ctx))
+
+	err := endpoint.checkMaintenance(ctx)
+	require.Error(t, err)
+	require.Equal(t, rpcstatus.Unavailable, rpcstatus.Code(err))
+	require.Contains(t, err.Error(), "migration")
+	require.Contains(t, err.Error(), "retry after 300s")
+}
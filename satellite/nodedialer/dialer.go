@@ -0,0 +1,240 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package nodedialer creates the dialers, which satellite services use to
+// dial storage nodes. The dialers reuse connections through a shared pool and
+// suspend dialing nodes, which repeatedly fail to accept connections.
+package nodedialer
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+
+	"storj.io/common/peertls/tlsopts"
+	"storj.io/common/rpc"
+	"storj.io/common/rpc/rpcpool"
+	"storj.io/common/rpc/rpcstatus"
+)
+
+var (
+	mon = monkit.Package()
+
+	// ErrCircuitOpen is returned, when dialing a node is suspended.
+	ErrCircuitOpen = errs.Class("circuit open")
+)
+
+// Config contains configurable values for dialing storage nodes.
+type Config struct {
+	PoolCapacity       int           `help:"number of connections to storage nodes to keep open for reuse, zero disables the pool" releaseDefault:"1000" devDefault:"100" testDefault:"0"`
+	PoolKeyCapacity    int           `help:"number of connections to keep open for reuse per storage node" default:"5"`
+	PoolIdleExpiration time.Duration `help:"how long an idle connection to a storage node is kept open for reuse" default:"2m"`
+
+	BreakerThreshold int           `help:"number of consecutive failed dials to a storage node, after which dialing it is suspended, zero disables the circuit breaker" default:"5" testDefault:"0"`
+	BreakerCooldown  time.Duration `help:"how long dialing a storage node is suspended, before a single dial is attempted again" default:"30s"`
+}
+
+// Dialer creates the dialers for storage nodes, which share the pool of
+// connections and the circuit breakers.
+//
+// architecture: Service
+type Dialer struct {
+	config   Config
+	pool     *rpcpool.Pool
+	breakers *Breakers
+}
+
+// New creates a new storage node dialer.
+func New(config Config) *Dialer {
+	dialer := &Dialer{
+		config:   config,
+		breakers: NewBreakers(config.BreakerThreshold, config.BreakerCooldown),
+	}
+	if config.PoolCapacity > 0 {
+		dialer.pool = rpcpool.New(rpcpool.Options{
+			Capacity:       config.PoolCapacity,
+			KeyCapacity:    config.PoolKeyCapacity,
+			IdleExpiration: config.PoolIdleExpiration,
+		})
+	}
+	return dialer
+}
+
+// RPCDialer returns a dialer with the tls options, which uses the shared pool
+// and circuit breakers.
+func (dialer *Dialer) RPCDialer(tlsOptions *tlsopts.Options) rpc.Dialer {
+	rpcDialer := rpc.NewDefaultDialer(tlsOptions)
+	rpcDialer.Pool = dialer.pool
+	rpcDialer.Connector = dialer.Wrap(rpcDialer.Connector)
+	return rpcDialer
+}
+
+// Wrap adds the circuit breakers to the connector.
+func (dialer *Dialer) Wrap(connector rpc.Connector) rpc.Connector {
+	if dialer.config.BreakerThreshold <= 0 {
+		return connector
+	}
+	return &breakerConnector{
+		connector: connector,
+		breakers:  dialer.breakers,
+	}
+}
+
+// Close closes the pooled connections.
+func (dialer *Dialer) Close() error {
+	if dialer.pool == nil {
+		return nil
+	}
+	return dialer.pool.Close()
+}
+
+// Breakers tracks the failed dials per address and suspends dialing the
+// addresses, which failed too many times in a row.
+type Breakers struct {
+	threshold int
+	cooldown  time.Duration
+	nowFn     func() time.Time
+
+	mu     sync.Mutex
+	failed map[string]*breaker
+}
+
+// breaker is the state of an address, whose last dial failed.
+type breaker struct {
+	failures  int
+	openUntil time.Time
+	probing   bool
+}
+
+// NewBreakers creates circuit breakers, which open after threshold
+// consecutive failures for the cooldown.
+func NewBreakers(threshold int, cooldown time.Duration) *Breakers {
+	return &Breakers{
+		threshold: threshold,
+		cooldown:  cooldown,
+		nowFn:     time.Now,
+		failed:    make(map[string]*breaker),
+	}
+}
+
+// Allow returns whether the address may be dialed. Once the cooldown of an
+// open breaker has passed, a single dial is allowed to probe the address.
+func (breakers *Breakers) Allow(address string) bool {
+	breakers.mu.Lock()
+	defer breakers.mu.Unlock()
+
+	state, ok := breakers.failed[address]
+	if !ok || state.failures < breakers.threshold {
+		return true
+	}
+	if breakers.nowFn().Before(state.openUntil) || state.probing {
+		return false
+	}
+	state.probing = true
+	return true
+}
+
+// Success resets the breaker of the address.
+func (breakers *Breakers) Success(address string) {
+	breakers.mu.Lock()
+	defer breakers.mu.Unlock()
+
+	delete(breakers.failed, address)
+}
+
+// Failure records a failed dial of the address.
+func (breakers *Breakers) Failure(address string) {
+	breakers.mu.Lock()
+	defer breakers.mu.Unlock()
+
+	state, ok := breakers.failed[address]
+	if !ok {
+		state = &breaker{}
+		breakers.failed[address] = state
+	}
+	state.failures++
+	state.probing = false
+	if state.failures >= breakers.threshold {
+		if state.failures == breakers.threshold {
+			mon.Event("node_dial_breaker_opened")
+		}
+		state.openUntil = breakers.nowFn().Add(breakers.cooldown)
+	}
+}
+
+// Abort releases the probe of the address without recording a result.
+func (breakers *Breakers) Abort(address string) {
+	breakers.mu.Lock()
+	defer breakers.mu.Unlock()
+
+	if state, ok := breakers.failed[address]; ok {
+		state.probing = false
+	}
+}
+
+// SetNow allows tests to have the breakers act as if the current time is
+// whatever they want.
+func (breakers *Breakers) SetNow(nowFn func() time.Time) {
+	breakers.mu.Lock()
+	defer breakers.mu.Unlock()
+	breakers.nowFn = nowFn
+}
+
+// breakerConnector dials through the connector, unless the breaker of the
+// address is open.
+type breakerConnector struct {
+	connector rpc.Connector
+	breakers  *Breakers
+}
+
+// DialContext implements rpc.Connector.
+func (connector *breakerConnector) DialContext(ctx context.Context, tlsConfig *tls.Config, address string) (_ rpc.ConnectorConn, err error) {
+	if !connector.breakers.Allow(address) {
+		mon.Event("node_dial_breaker_rejected")
+		// the dial isn't attempted, so it is reported as unavailable instead
+		// of as a failed dial, which is counted against the node. audits
+		// check for ErrCircuitOpen and treat the audit as not performed.
+		return nil, rpcstatus.Wrap(rpcstatus.Unavailable, ErrCircuitOpen.New("dialing %s is suspended", address))
+	}
+
+	conn, err := connector.connector.DialContext(ctx, tlsConfig, address)
+	connector.record(ctx, address, err)
+	return conn, err
+}
+
+// DialContextUnencrypted dials without tls, when the connector supports it.
+func (connector *breakerConnector) DialContextUnencrypted(ctx context.Context, address string) (net.Conn, error) {
+	unencrypted, ok := connector.connector.(interface {
+		DialContextUnencrypted(context.Context, string) (net.Conn, error)
+	})
+	if !ok {
+		return nil, errs.New("unsupported transport type: %T", connector.connector)
+	}
+
+	if !connector.breakers.Allow(address) {
+		mon.Event("node_dial_breaker_rejected")
+		return nil, rpcstatus.Wrap(rpcstatus.Unavailable, ErrCircuitOpen.New("dialing %s is suspended", address))
+	}
+
+	conn, err := unencrypted.DialContextUnencrypted(ctx, address)
+	connector.record(ctx, address, err)
+	return conn, err
+}
+
+func (connector *breakerConnector) record(ctx context.Context, address string, err error) {
+	switch {
+	case err == nil:
+		connector.breakers.Success(address)
+	case errs.Is(ctx.Err(), context.Canceled):
+		// the caller gave up, which says nothing about the node. Timeouts of
+		// the dial are counted as failures.
+		connector.breakers.Abort(address)
+	default:
+		connector.breakers.Failure(address)
+	}
+}
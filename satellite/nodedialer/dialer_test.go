@@ -0,0 +1,104 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package nodedialer_test
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/errs2"
+	"storj.io/common/rpc"
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/common/testcontext"
+	"storj.io/storj/satellite/nodedialer"
+)
+
+func TestBreakers(t *testing.T) {
+	now := time.Now()
+	breakers := nodedialer.NewBreakers(2, time.Minute)
+	breakers.SetNow(func() time.Time { return now })
+
+	const address = "node.test:7777"
+
+	require.True(t, breakers.Allow(address))
+	breakers.Failure(address)
+	require.True(t, breakers.Allow(address))
+	breakers.Failure(address)
+
+	// the breaker opened and other addresses aren't affected.
+	require.False(t, breakers.Allow(address))
+	require.True(t, breakers.Allow("other.test:7777"))
+
+	// after the cooldown a single probe is allowed.
+	now = now.Add(time.Minute)
+	require.True(t, breakers.Allow(address))
+	require.False(t, breakers.Allow(address))
+
+	// a failed probe opens the breaker again.
+	breakers.Failure(address)
+	require.False(t, breakers.Allow(address))
+
+	now = now.Add(time.Minute)
+	require.True(t, breakers.Allow(address))
+
+	// a successful probe closes the breaker.
+	breakers.Success(address)
+	require.True(t, breakers.Allow(address))
+	require.True(t, breakers.Allow(address))
+}
+
+func TestWrap(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	connector := &failingConnector{}
+	dialer := nodedialer.New(nodedialer.Config{
+		BreakerThreshold: 1,
+		BreakerCooldown:  time.Hour,
+	})
+	defer ctx.Check(dialer.Close)
+
+	wrapped := dialer.Wrap(connector)
+
+	_, err := wrapped.DialContext(ctx, nil, "node.test:7777")
+	require.Error(t, err)
+	require.Equal(t, 1, connector.dials)
+
+	// the node isn't dialed while the breaker is open, and the error doesn't
+	// look like a failed dial.
+	_, err = wrapped.DialContext(ctx, nil, "node.test:7777")
+	require.True(t, nodedialer.ErrCircuitOpen.Has(err))
+	require.True(t, errs2.IsRPC(err, rpcstatus.Unavailable))
+	require.Equal(t, 1, connector.dials)
+
+	// canceled dials don't count as failures.
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+	_, err = wrapped.DialContext(canceled, nil, "other.test:7777")
+	require.Error(t, err)
+	_, err = wrapped.DialContext(ctx, nil, "other.test:7777")
+	require.False(t, nodedialer.ErrCircuitOpen.Has(err))
+	require.Equal(t, 3, connector.dials)
+
+	// without a threshold the connector isn't wrapped.
+	disabled := nodedialer.New(nodedialer.Config{})
+	defer ctx.Check(disabled.Close)
+	require.Equal(t, rpc.Connector(connector), disabled.Wrap(connector))
+}
+
+type failingConnector struct {
+	dials int
+}
+
+func (connector *failingConnector) DialContext(ctx context.Context, tlsConfig *tls.Config, address string) (rpc.ConnectorConn, error) {
+	connector.dials++
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return nil, errors.New("connection refused")
+}
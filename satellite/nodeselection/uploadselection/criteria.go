@@ -6,6 +6,7 @@ package uploadselection
 import (
 	"storj.io/common/storj"
 	"storj.io/common/storj/location"
+	"storj.io/storj/private/nodecapabilities"
 )
 
 // Criteria to filter nodes.
@@ -14,6 +15,7 @@ type Criteria struct {
 	AutoExcludeSubnets   map[string]struct{} // initialize it with empty map to keep only one node per subnet.
 	Placement            storj.PlacementConstraint
 	ExcludedCountryCodes []location.CountryCode
+	Requires             nodecapabilities.Requirements
 }
 
 // MatchInclude returns with true if node is selected.
@@ -26,6 +28,10 @@ func (c *Criteria) MatchInclude(node *Node) bool {
 		return false
 	}
 
+	if !node.Capabilities.Satisfies(c.Requires) {
+		return false
+	}
+
 	if c.AutoExcludeSubnets != nil {
 		if _, excluded := c.AutoExcludeSubnets[node.LastNet]; excluded {
 			return false
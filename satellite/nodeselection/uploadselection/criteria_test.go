@@ -11,6 +11,7 @@ import (
 	"storj.io/common/storj"
 	"storj.io/common/storj/location"
 	"storj.io/common/testrand"
+	"storj.io/storj/private/nodecapabilities"
 )
 
 func TestCriteria_AutoExcludeSubnet(t *testing.T) {
@@ -138,3 +139,24 @@ func TestCriteria_Geofencing(t *testing.T) {
 		})
 	}
 }
+
+func TestCriteria_Requires(t *testing.T) {
+	criteria := Criteria{
+		Requires: nodecapabilities.Requirements{
+			TTL:       true,
+			PieceSize: 1000,
+		},
+	}
+
+	assert.True(t, criteria.MatchInclude(&Node{
+		Capabilities: nodecapabilities.Capabilities{TTL: true},
+	}))
+
+	assert.False(t, criteria.MatchInclude(&Node{
+		Capabilities: nodecapabilities.Capabilities{},
+	}))
+
+	assert.False(t, criteria.MatchInclude(&Node{
+		Capabilities: nodecapabilities.Capabilities{TTL: true, MaxPieceSize: 999},
+	}))
+}
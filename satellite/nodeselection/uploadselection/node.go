@@ -6,14 +6,16 @@ package uploadselection
 import (
 	"storj.io/common/storj"
 	"storj.io/common/storj/location"
+	"storj.io/storj/private/nodecapabilities"
 )
 
 // Node defines necessary information for node-selection.
 type Node struct {
 	storj.NodeURL
-	LastNet     string
-	LastIPPort  string
-	CountryCode location.CountryCode
+	LastNet      string
+	LastIPPort   string
+	CountryCode  location.CountryCode
+	Capabilities nodecapabilities.Capabilities
 }
 
 // Clone returns a deep clone of the selected node.
@@ -23,5 +25,11 @@ func (node *Node) Clone() *Node {
 		LastNet:     node.LastNet,
 		LastIPPort:  node.LastIPPort,
 		CountryCode: node.CountryCode,
+		Capabilities: nodecapabilities.Capabilities{
+			Protocols:    append([]string(nil), node.Capabilities.Protocols...),
+			Hashstore:    node.Capabilities.Hashstore,
+			TTL:          node.Capabilities.TTL,
+			MaxPieceSize: node.Capabilities.MaxPieceSize,
+		},
 	}
 }
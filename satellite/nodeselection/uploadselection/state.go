@@ -11,6 +11,7 @@ import (
 
 	"storj.io/common/storj"
 	"storj.io/common/storj/location"
+	"storj.io/storj/private/nodecapabilities"
 )
 
 // ErrNotEnoughNodes is when selecting nodes failed with the given parameters.
@@ -90,6 +91,7 @@ type Request struct {
 	ExcludedIDs          []storj.NodeID
 	Placement            storj.PlacementConstraint
 	ExcludedCountryCodes []string
+	Requires             nodecapabilities.Requirements
 }
 
 // Select selects requestedCount nodes where there will be newFraction nodes.
@@ -118,6 +120,7 @@ func (state *State) Select(ctx context.Context, request Request) (_ []*Node, err
 	}
 
 	criteria.Placement = request.Placement
+	criteria.Requires = request.Requires
 
 	if request.Distinct {
 		criteria.AutoExcludeSubnets = make(map[string]struct{})
@@ -6,7 +6,9 @@ package orders
 import (
 	"encoding/hex"
 	"fmt"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/zeebo/errs"
 	"golang.org/x/crypto/nacl/secretbox"
@@ -48,10 +50,36 @@ func NewEncryptionKeys(keys ...EncryptionKey) (*EncryptionKeys, error) {
 // EncryptionKey contains an identifier and an encryption key that is used to
 // encrypt transient metadata in orders.
 //
+// The key is used for encrypting new orders between ActiveFrom and RetireAt,
+// a zero value means unbounded. A key is used for decrypting orders as long
+// as it's configured.
+//
 // Can be used as a flag.
 type EncryptionKey struct {
 	ID  EncryptionKeyID
 	Key storj.Key
+
+	ActiveFrom time.Time
+	RetireAt   time.Time
+}
+
+// IsActive returns whether the key can be used for encrypting new orders at the specified time.
+func (key *EncryptionKey) IsActive(now time.Time) bool {
+	if !key.ActiveFrom.IsZero() && now.Before(key.ActiveFrom) {
+		return false
+	}
+	return !key.IsRetired(now)
+}
+
+// IsRetired returns whether the key is retired at the specified time.
+func (key *EncryptionKey) IsRetired(now time.Time) bool {
+	return !key.RetireAt.IsZero() && !now.Before(key.RetireAt)
+}
+
+// IsRemovable returns whether all the orders encrypted with the key have
+// expired at the specified time, given the order expiration and grace period.
+func (key *EncryptionKey) IsRemovable(now time.Time, orderExpiration, gracePeriod time.Duration) bool {
+	return !key.RetireAt.IsZero() && !now.Before(key.RetireAt.Add(orderExpiration+gracePeriod))
 }
 
 // When this fails to compile, then `serialToNonce` should be adjusted accordingly.
@@ -117,11 +145,22 @@ func (EncryptionKey) Type() string { return "orders.EncryptionKey" }
 
 // String is required for pflag.Value.
 func (key *EncryptionKey) String() string {
-	return hex.EncodeToString(key.ID[:]) + "=" + hex.EncodeToString(key.Key[:])
+	s := hex.EncodeToString(key.ID[:]) + "=" + hex.EncodeToString(key.Key[:])
+	if !key.ActiveFrom.IsZero() {
+		s += ";active-from=" + key.ActiveFrom.UTC().Format(time.RFC3339)
+	}
+	if !key.RetireAt.IsZero() {
+		s += ";retire-at=" + key.RetireAt.UTC().Format(time.RFC3339)
+	}
+	return s
 }
 
-// Set sets the value from an hex encoded string "hex(id)=hex(key)".
+// Set sets the value from an hex encoded string "hex(id)=hex(key)", optionally
+// followed by the validity window "hex(id)=hex(key);active-from=RFC3339;retire-at=RFC3339".
 func (key *EncryptionKey) Set(s string) error {
+	options := strings.Split(s, ";")
+	s = options[0]
+
 	tokens := strings.SplitN(s, "=", 2)
 	if len(tokens) != 2 {
 		return ErrEncryptionKey.New("invalid definition %q", s)
@@ -141,6 +180,32 @@ func (key *EncryptionKey) Set(s string) error {
 		return ErrEncryptionKey.New("neither identifier or key can be zero")
 	}
 
+	key.ActiveFrom, key.RetireAt = time.Time{}, time.Time{}
+	for _, option := range options[1:] {
+		tokens := strings.SplitN(strings.TrimSpace(option), "=", 2)
+		if len(tokens) != 2 {
+			return ErrEncryptionKey.New("invalid option %q", option)
+		}
+
+		at, err := time.Parse(time.RFC3339, tokens[1])
+		if err != nil {
+			return ErrEncryptionKey.New("invalid time %q: %v", tokens[1], err)
+		}
+
+		switch tokens[0] {
+		case "active-from":
+			key.ActiveFrom = at
+		case "retire-at":
+			key.RetireAt = at
+		default:
+			return ErrEncryptionKey.New("unknown option %q", tokens[0])
+		}
+	}
+
+	if !key.ActiveFrom.IsZero() && !key.RetireAt.IsZero() && !key.ActiveFrom.Before(key.RetireAt) {
+		return ErrEncryptionKey.New("key %s is retired before it's active", hex.EncodeToString(key.ID[:]))
+	}
+
 	return nil
 }
 
@@ -191,6 +256,115 @@ func (keys *EncryptionKeys) Add(ekey EncryptionKey) error {
 	return nil
 }
 
+// Active returns the key that should be used for encrypting new orders at the
+// specified time. When multiple keys are active, the one activated most recently
+// is used, so that a new key takes over when its validity window starts.
+// When no key is active, the default key is returned.
+func (keys *EncryptionKeys) Active(now time.Time) EncryptionKey {
+	active := keys.Default
+	found := false
+	for _, key := range keys.List {
+		if !key.IsActive(now) {
+			continue
+		}
+		if !found || key.ActiveFrom.After(active.ActiveFrom) {
+			active = key
+			found = true
+		}
+	}
+	return active
+}
+
+// Find returns the key with the specified identifier.
+func (keys *EncryptionKeys) Find(id EncryptionKeyID) (EncryptionKey, bool) {
+	if !keys.Default.IsZero() && keys.Default.ID == id {
+		return keys.Default, true
+	}
+	for _, key := range keys.List {
+		if key.ID == id {
+			return key, true
+		}
+	}
+	return EncryptionKey{}, false
+}
+
+// Retire schedules the key to be retired at the specified time. It fails when
+// there wouldn't be any key active after the retirement.
+func (keys *EncryptionKeys) Retire(id EncryptionKeyID, at time.Time) error {
+	index := -1
+	for i, key := range keys.List {
+		if key.ID == id {
+			index = i
+			break
+		}
+	}
+	if index < 0 {
+		return ErrEncryptionKey.New("key %s not found", hex.EncodeToString(id[:]))
+	}
+
+	retired := keys.List[index]
+	if !retired.ActiveFrom.IsZero() && !retired.ActiveFrom.Before(at) {
+		return ErrEncryptionKey.New("key %s is retired before it's active", hex.EncodeToString(id[:]))
+	}
+
+	successor := false
+	for _, key := range keys.List {
+		if key.ID == id || key.IsRetired(at) {
+			continue
+		}
+		if key.ActiveFrom.IsZero() || !key.ActiveFrom.After(at) {
+			successor = true
+			break
+		}
+	}
+	if !successor {
+		return ErrEncryptionKey.New("no other key is active at %s", at.UTC().Format(time.RFC3339))
+	}
+
+	retired.RetireAt = at
+	keys.List[index] = retired
+	if keys.Default.ID == id {
+		keys.Default = retired
+	}
+	return nil
+}
+
+// Remove removes the key with the specified identifier. When the default key
+// is removed, the first remaining key becomes the default.
+func (keys *EncryptionKeys) Remove(id EncryptionKeyID) error {
+	list := keys.List
+	keys.Clear()
+
+	found := false
+	for _, key := range list {
+		if key.ID == id {
+			found = true
+			continue
+		}
+		if err := keys.Add(key); err != nil {
+			return err
+		}
+	}
+	if !found {
+		return ErrEncryptionKey.New("key %s not found", hex.EncodeToString(id[:]))
+	}
+	return nil
+}
+
+// Removable returns the keys which aren't needed for decrypting orders anymore.
+func (keys *EncryptionKeys) Removable(now time.Time, orderExpiration, gracePeriod time.Duration) []EncryptionKey {
+	var removable []EncryptionKey
+	for _, key := range keys.List {
+		if key.IsRemovable(now, orderExpiration, gracePeriod) {
+			removable = append(removable, key)
+		}
+	}
+	sort.Slice(removable, func(i, k int) bool {
+		return removable[i].RetireAt.Before(removable[k].RetireAt)
+	})
+	return removable
+}
+
 // Clear removes all keys.
 func (keys *EncryptionKeys) Clear() {
 	keys.Default = EncryptionKey{}
@@ -6,6 +6,7 @@ package orders_test
 import (
 	"encoding/hex"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -225,3 +226,78 @@ func TestEncryptionKey_BackwardsCompatibility(t *testing.T) {
 		require.Equal(t, data, decrypted)
 	}
 }
+
+func TestEncryptionKey_ValidityWindow(t *testing.T) {
+	var key orders.EncryptionKey
+	require.NoError(t, key.Set(`0100000000000000=0100000000000000000000000000000000000000000000000000000000000000;active-from=2022-01-01T00:00:00Z;retire-at=2022-02-01T00:00:00Z`))
+	require.Equal(t, time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC), key.ActiveFrom.UTC())
+	require.Equal(t, time.Date(2022, 2, 1, 0, 0, 0, 0, time.UTC), key.RetireAt.UTC())
+	require.Equal(t, `0100000000000000=0100000000000000000000000000000000000000000000000000000000000000;active-from=2022-01-01T00:00:00Z;retire-at=2022-02-01T00:00:00Z`, key.String())
+
+	require.False(t, key.IsActive(time.Date(2021, 12, 31, 0, 0, 0, 0, time.UTC)))
+	require.True(t, key.IsActive(time.Date(2022, 1, 15, 0, 0, 0, 0, time.UTC)))
+	require.False(t, key.IsActive(time.Date(2022, 2, 1, 0, 0, 0, 0, time.UTC)))
+	require.True(t, key.IsRetired(time.Date(2022, 2, 1, 0, 0, 0, 0, time.UTC)))
+
+	require.False(t, key.IsRemovable(time.Date(2022, 2, 1, 12, 0, 0, 0, time.UTC), 24*time.Hour, time.Hour))
+	require.True(t, key.IsRemovable(time.Date(2022, 2, 2, 1, 0, 0, 0, time.UTC), 24*time.Hour, time.Hour))
+
+	for _, invalid := range []string{
+		`0100000000000000=0100000000000000000000000000000000000000000000000000000000000000;active-from`,
+		`0100000000000000=0100000000000000000000000000000000000000000000000000000000000000;active-from=yesterday`,
+		`0100000000000000=0100000000000000000000000000000000000000000000000000000000000000;expires=2022-01-01T00:00:00Z`,
+		`0100000000000000=0100000000000000000000000000000000000000000000000000000000000000;active-from=2022-02-01T00:00:00Z;retire-at=2022-01-01T00:00:00Z`,
+	} {
+		var got orders.EncryptionKey
+		assert.Error(t, got.Set(invalid), invalid)
+	}
+}
+
+func TestEncryptionKeys_Rotation(t *testing.T) {
+	now := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	var keys orders.EncryptionKeys
+	require.NoError(t, keys.Set(`0100000000000000=0100000000000000000000000000000000000000000000000000000000000000`))
+	old := keys.Default
+
+	// the new key takes over when it becomes active.
+	newKey := orders.EncryptionKey{
+		ID:         orders.EncryptionKeyID{0x02},
+		Key:        testrand.Key(),
+		ActiveFrom: now.Add(time.Hour),
+	}
+	require.NoError(t, keys.Add(newKey))
+	require.Equal(t, old.ID, keys.Active(now).ID)
+	require.Equal(t, newKey.ID, keys.Active(now.Add(time.Hour)).ID)
+
+	// the configuration survives a roundtrip.
+	var parsed orders.EncryptionKeys
+	require.NoError(t, parsed.Set(keys.String()))
+	require.Equal(t, keys.List, parsed.List)
+
+	// a key cannot be retired before it's active or without a successor.
+	require.Error(t, keys.Retire(newKey.ID, now))
+	require.Error(t, keys.Retire(old.ID, now))
+	require.Error(t, keys.Retire(orders.EncryptionKeyID{0x03}, now))
+
+	retireAt := now.Add(2 * time.Hour)
+	require.NoError(t, keys.Retire(old.ID, retireAt))
+	require.Equal(t, retireAt, keys.Default.RetireAt)
+	require.Equal(t, newKey.ID, keys.Active(retireAt).ID)
+
+	// old orders are still decryptable until they expire.
+	found, ok := keys.Find(old.ID)
+	require.True(t, ok)
+	require.Equal(t, old.Key, found.Key)
+	require.Empty(t, keys.Removable(retireAt.Add(24*time.Hour), 24*time.Hour, time.Hour))
+
+	removable := keys.Removable(retireAt.Add(25*time.Hour), 24*time.Hour, time.Hour)
+	require.Len(t, removable, 1)
+	require.Equal(t, old.ID, removable[0].ID)
+
+	require.NoError(t, keys.Remove(old.ID))
+	require.Equal(t, newKey.ID, keys.Default.ID)
+	require.Len(t, keys.List, 1)
+	_, ok = keys.Find(old.ID)
+	require.False(t, ok)
+}
@@ -22,6 +22,7 @@ import (
 	"storj.io/common/storj"
 	"storj.io/common/uuid"
 	"storj.io/storj/private/date"
+	"storj.io/storj/private/settlementpb"
 	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/nodeapiversion"
 )
@@ -44,6 +45,13 @@ type DB interface {
 	// UpdateStoragenodeBandwidthSettleWithWindow updates 'settled' bandwidth for given storage node
 	UpdateStoragenodeBandwidthSettleWithWindow(ctx context.Context, storageNodeID storj.NodeID, actionAmounts map[int32]int64, window time.Time) (status pb.SettlementWithWindowResponse_Status, alreadyProcessed bool, err error)
 
+	// RecordSettlementClaim records the amounts, which a storage node claimed for a window, with the outcome of settling them
+	RecordSettlementClaim(ctx context.Context, storageNodeID storj.NodeID, actionAmounts map[int32]int64, window time.Time, status ClaimStatus) error
+	// ListSettlementDiscrepancies lists the actions of windows starting within [from, to), whose claimed amounts differ from the settled amounts
+	ListSettlementDiscrepancies(ctx context.Context, from, to time.Time) ([]Discrepancy, error)
+	// SettleClaimedWindow replaces the settled amounts of the window with the claimed amounts
+	SettleClaimedWindow(ctx context.Context, storageNodeID storj.NodeID, window time.Time) error
+
 	// GetBucketBandwidth gets total bucket bandwidth from period of time
 	GetBucketBandwidth(ctx context.Context, projectID uuid.UUID, bucketName []byte, from, to time.Time) (int64, error)
 	// GetStorageNodeBandwidth gets total storage node bandwidth from period of time
@@ -184,9 +192,8 @@ type bucketIDAction struct {
 	action     pb.PieceAction
 }
 
-// SettlementWithWindow processes all orders that were created in a 1 hour window.
-// Only one window is processed at a time.
-// Batches are atomic, all orders are settled successfully or they all fail.
+// SettlementWithWindow processes orders that were created in 1 hour windows.
+// See SettlementWithWindowFinal for the details.
 func (endpoint *Endpoint) SettlementWithWindow(stream pb.DRPCOrders_SettlementWithWindowStream) (err error) {
 	return endpoint.SettlementWithWindowFinal(stream)
 }
@@ -202,21 +209,87 @@ func trackFinalStatus(status pb.SettlementWithWindowResponse_Status) {
 	}
 }
 
-// SettlementWithWindowFinal processes all orders that were created in a 1 hour window.
-// Only one window is processed at a time.
-// Batches are atomic, all orders are settled successfully or they all fail.
+// SettlementWithWindowFinal processes orders that were created in 1 hour windows.
+// A single stream may contain orders from multiple windows, every window is
+// settled separately. Settling a window is idempotent, replaying a window with
+// the same amounts is accepted without counting the bandwidth twice, hence a
+// failed batch can be safely retried as a whole.
+// The response has a single status for the whole stream, which is rejected
+// when any of the windows is rejected. The response contains only the amounts
+// from the accepted windows. SettleWindows returns the status of every window.
 func (endpoint *Endpoint) SettlementWithWindowFinal(stream pb.DRPCOrders_SettlementWithWindowStream) (err error) {
 	ctx := stream.Context()
 	defer mon.Task()(&ctx)(&err)
 
-	var alreadyProcessed bool
 	var status pb.SettlementWithWindowResponse_Status
-	defer trackFinalStatus(status)
+	defer func() { trackFinalStatus(status) }()
+
+	results, err := endpoint.settleStream(ctx, stream.Recv)
+	if err != nil {
+		return err
+	}
+
+	status = pb.SettlementWithWindowResponse_ACCEPTED
+	if len(results) == 0 {
+		status = pb.SettlementWithWindowResponse_REJECTED
+	}
+
+	actionSettled := map[int32]int64{}
+	for _, result := range results {
+		if result.status != pb.SettlementWithWindowResponse_ACCEPTED {
+			status = pb.SettlementWithWindowResponse_REJECTED
+			continue
+		}
+		for action, amount := range result.storagenodeSettled {
+			actionSettled[action] += amount
+		}
+	}
+
+	return stream.SendAndClose(&pb.SettlementWithWindowResponse{
+		Status:        status,
+		ActionSettled: actionSettled,
+	})
+}
+
+// SettleWindows processes orders that were created in 1 hour windows, like
+// SettlementWithWindowFinal, but returns the status of every window, so that
+// the storage node can archive the orders of each window accordingly.
+func (endpoint *Endpoint) SettleWindows(stream settlementpb.DRPCSettlement_SettleWindowsStream) (err error) {
+	ctx := stream.Context()
+	defer mon.Task()(&ctx)(&err)
+
+	results, err := endpoint.settleStream(ctx, stream.Recv)
+	if err != nil {
+		return err
+	}
+
+	response := &settlementpb.SettleWindowsResponse{}
+	for _, result := range results {
+		trackFinalStatus(result.status)
+
+		actionSettled := map[int32]int64{}
+		if result.status == pb.SettlementWithWindowResponse_ACCEPTED {
+			actionSettled = result.storagenodeSettled
+		}
+		response.Windows = append(response.Windows, &settlementpb.WindowStatus{
+			Window:        time.Unix(0, result.window).UTC(),
+			Status:        result.status,
+			ActionSettled: actionSettled,
+		})
+	}
+
+	return stream.SendAndClose(response)
+}
+
+// settleStream receives the orders of the stream and settles every window
+// separately. It returns the settled windows in window order.
+func (endpoint *Endpoint) settleStream(ctx context.Context, recv func() (*pb.SettlementRequest, error)) (_ []*settlementWindow, err error) {
+	defer mon.Task()(&ctx)(&err)
 
 	peer, err := identity.PeerIdentityFromContext(ctx)
 	if err != nil {
 		endpoint.log.Debug("err peer identity from context", zap.Error(err))
-		return rpcstatus.Error(rpcstatus.Unauthenticated, err.Error())
+		return nil, rpcstatus.Error(rpcstatus.Unauthenticated, err.Error())
 	}
 
 	versionAtLeast, err := endpoint.nodeAPIVersionDB.VersionAtLeast(ctx, peer.ID, nodeapiversion.HasWindowedOrders)
@@ -227,34 +300,26 @@ func (endpoint *Endpoint) SettlementWithWindowFinal(stream pb.DRPCOrders_Settlem
 	if !versionAtLeast {
 		err = endpoint.nodeAPIVersionDB.UpdateVersionAtLeast(ctx, peer.ID, nodeapiversion.HasWindowedOrders)
 		if err != nil {
-			return rpcstatus.Wrap(rpcstatus.Internal, err)
+			return nil, rpcstatus.Wrap(rpcstatus.Internal, err)
 		}
 	}
 
 	log := endpoint.log.Named(peer.ID.String())
 	log.Debug("SettlementWithWindow")
 
-	type bandwidthAmount struct {
-		Settled   int64
-		Allocated int64
-		Dead      int64
-	}
-
-	storagenodeSettled := map[int32]int64{}
-	bucketSettled := map[bucketIDAction]bandwidthAmount{}
+	windows := map[int64]*settlementWindow{}
 	seenSerials := map[storj.SerialNumber]struct{}{}
 
-	var window int64
 	var request *pb.SettlementRequest
 	var receivedCount int
 	for {
-		request, err = stream.Recv()
+		request, err = recv()
 		if err != nil {
 			if errors.Is(err, io.EOF) {
 				break
 			}
 			log.Debug("err streaming order request", zap.Error(err))
-			return rpcstatus.Error(rpcstatus.Unknown, err.Error())
+			return nil, rpcstatus.Error(rpcstatus.Unknown, err.Error())
 		}
 		receivedCount++
 
@@ -263,15 +328,13 @@ func (endpoint *Endpoint) SettlementWithWindowFinal(stream pb.DRPCOrders_Settlem
 			log.Debug("request.OrderLimit is nil")
 			continue
 		}
-		if window == 0 {
-			window = date.TruncateToHourInNano(orderLimit.OrderCreation)
-		}
 		order := request.Order
 		if order == nil {
 			log.Debug("request.Order is nil")
 			continue
 		}
 		serialNum := order.SerialNumber
+		window := date.TruncateToHourInNano(orderLimit.OrderCreation)
 
 		// don't process orders that aren't valid
 		if !endpoint.isValid(ctx, log, order, orderLimit, peer.ID, window) {
@@ -285,7 +348,12 @@ func (endpoint *Endpoint) SettlementWithWindowFinal(stream pb.DRPCOrders_Settlem
 		}
 		seenSerials[serialNum] = struct{}{}
 
-		storagenodeSettled[int32(orderLimit.Action)] += order.Amount
+		settlement, ok := windows[window]
+		if !ok {
+			settlement = newSettlementWindow(window)
+			windows[window] = settlement
+		}
+		settlement.storagenodeSettled[int32(orderLimit.Action)] += order.Amount
 
 		metadata, err := endpoint.ordersService.DecryptOrderMetadata(ctx, orderLimit)
 		if err != nil {
@@ -331,59 +399,131 @@ func (endpoint *Endpoint) SettlementWithWindowFinal(stream pb.DRPCOrders_Settlem
 			continue
 		}
 
+		// the egress of audits and repairs without a bucket is only accounted
+		// for the storage node.
+		if bucketInfo.BucketName == "" || bucketInfo.ProjectID.IsZero() {
+			mon.Event("settlement_satellite_action_without_bucket")
+			continue
+		}
+
 		currentBucketIDAction := bucketIDAction{
 			bucketname: bucketInfo.BucketName,
 			projectID:  bucketInfo.ProjectID,
 			action:     orderLimit.Action,
 		}
-		bucketSettled[currentBucketIDAction] = bandwidthAmount{
-			Settled:   bucketSettled[currentBucketIDAction].Settled + order.Amount,
-			Allocated: bucketSettled[currentBucketIDAction].Allocated + orderLimit.Limit,
-			Dead:      bucketSettled[currentBucketIDAction].Dead + orderLimit.Limit - order.Amount,
+		settlement.bucketSettled[currentBucketIDAction] = bandwidthAmount{
+			Settled:   settlement.bucketSettled[currentBucketIDAction].Settled + order.Amount,
+			Allocated: settlement.bucketSettled[currentBucketIDAction].Allocated + orderLimit.Limit,
+			Dead:      settlement.bucketSettled[currentBucketIDAction].Dead + orderLimit.Limit - order.Amount,
 		}
 	}
 
-	if len(storagenodeSettled) == 0 {
+	if len(windows) == 0 {
 		log.Debug("no orders were successfully processed", zap.Int("received count", receivedCount))
-		status = pb.SettlementWithWindowResponse_REJECTED
-		return stream.SendAndClose(&pb.SettlementWithWindowResponse{
-			Status:        status,
-			ActionSettled: storagenodeSettled,
-		})
+		return nil, nil
 	}
-	status, alreadyProcessed, err = endpoint.DB.UpdateStoragenodeBandwidthSettleWithWindow(
-		ctx, peer.ID, storagenodeSettled, time.Unix(0, window),
-	)
-	if err != nil {
-		log.Debug("err updating storagenode bandwidth settle", zap.Error(err))
-		return err
+	mon.IntVal("settlement_windows_per_batch").Observe(int64(len(windows)))
+
+	sorted := make([]*settlementWindow, 0, len(windows))
+	for _, settlement := range windows {
+		sorted = append(sorted, settlement)
 	}
+	sort.Slice(sorted, func(i, k int) bool { return sorted[i].window < sorted[k].window })
+
+	var accepted, rejected int
+	for _, settlement := range sorted {
+		settlement.status, err = endpoint.settleWindow(ctx, log, peer.ID, settlement)
+		if err != nil {
+			// accepted windows are replayed idempotently, when the node retries the batch.
+			log.Debug("err updating storagenode bandwidth settle",
+				zap.Time("window", time.Unix(0, settlement.window)),
+				zap.Error(err))
+			return nil, err
+		}
+
+		if settlement.status != pb.SettlementWithWindowResponse_ACCEPTED {
+			rejected++
+			mon.Event("settlement_window_rejected")
+			continue
+		}
+		accepted++
+	}
+
 	log.Debug("orders processed",
 		zap.Int("total orders received", receivedCount),
-		zap.Time("window", time.Unix(0, window)),
+		zap.Int("windows accepted", accepted),
+		zap.Int("windows rejected", rejected),
+	)
+
+	return sorted, nil
+}
+
+type bandwidthAmount struct {
+	Settled   int64
+	Allocated int64
+	Dead      int64
+}
+
+// settlementWindow contains the orders of a single 1 hour window in a settlement batch.
+type settlementWindow struct {
+	window             int64
+	storagenodeSettled map[int32]int64
+	bucketSettled      map[bucketIDAction]bandwidthAmount
+
+	status pb.SettlementWithWindowResponse_Status
+}
+
+func newSettlementWindow(window int64) *settlementWindow {
+	return &settlementWindow{
+		window:             window,
+		storagenodeSettled: map[int32]int64{},
+		bucketSettled:      map[bucketIDAction]bandwidthAmount{},
+	}
+}
+
+// settleWindow settles the orders of a single window. The bucket bandwidth is
+// updated only the first time the window is accepted.
+func (endpoint *Endpoint) settleWindow(ctx context.Context, log *zap.Logger, nodeID storj.NodeID, settlement *settlementWindow) (status pb.SettlementWithWindowResponse_Status, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	window := time.Unix(0, settlement.window)
+
+	status, alreadyProcessed, err := endpoint.DB.UpdateStoragenodeBandwidthSettleWithWindow(
+		ctx, nodeID, settlement.storagenodeSettled, window,
+	)
+
+	// the claim is recorded, so windows that failed to settle can be reconciled.
+	claimStatus := ClaimFailed
+	if err == nil {
+		claimStatus = claimStatusFromSettlement(status)
+	}
+	if claimErr := endpoint.DB.RecordSettlementClaim(ctx, nodeID, settlement.storagenodeSettled, window, claimStatus); claimErr != nil {
+		log.Info("err recording settlement claim", zap.Time("window", window), zap.Error(claimErr))
+	}
+
+	if err != nil {
+		return status, err
+	}
+	log.Debug("window processed",
+		zap.Time("window", window),
 		zap.String("status", status.String()),
+		zap.Bool("already processed", alreadyProcessed),
 	)
 
-	if status == pb.SettlementWithWindowResponse_ACCEPTED && !alreadyProcessed {
-		for bucketIDAction, bwAmount := range bucketSettled {
-			err = endpoint.DB.UpdateBucketBandwidthSettle(ctx,
-				bucketIDAction.projectID, []byte(bucketIDAction.bucketname), bucketIDAction.action, bwAmount.Settled, bwAmount.Dead, time.Unix(0, window),
-			)
-			if err != nil {
-				log.Info("err updating bucket bandwidth settle", zap.Error(err))
-			}
-		}
-	} else {
+	if status != pb.SettlementWithWindowResponse_ACCEPTED || alreadyProcessed {
 		mon.Event("orders_already_processed")
+		return status, nil
 	}
 
-	if status == pb.SettlementWithWindowResponse_REJECTED {
-		storagenodeSettled = map[int32]int64{}
+	for bucketIDAction, bwAmount := range settlement.bucketSettled {
+		err := endpoint.DB.UpdateBucketBandwidthSettle(ctx,
+			bucketIDAction.projectID, []byte(bucketIDAction.bucketname), bucketIDAction.action, bwAmount.Settled, bwAmount.Dead, window,
+		)
+		if err != nil {
+			log.Info("err updating bucket bandwidth settle", zap.Error(err))
+		}
 	}
-	return stream.SendAndClose(&pb.SettlementWithWindowResponse{
-		Status:        status,
-		ActionSettled: storagenodeSettled,
-	})
+	return status, nil
 }
 
 func (endpoint *Endpoint) isValid(ctx context.Context, log *zap.Logger, order *pb.Order,
@@ -14,6 +14,7 @@ import (
 	"storj.io/common/storj"
 	"storj.io/common/testcontext"
 	"storj.io/common/testrand"
+	"storj.io/storj/private/settlementpb"
 	"storj.io/storj/private/testplanet"
 	"storj.io/storj/satellite/internalpb"
 	"storj.io/storj/satellite/metabase"
@@ -52,9 +53,13 @@ func TestSettlementWithWindowEndpointManyOrders(t *testing.T) {
 			dataAmount    int64
 			orderCreation time.Time
 			settledAmt    int64
+			totalSettled  int64
+			status        pb.SettlementWithWindowResponse_Status
 		}{
-			{"settle 2 orders, valid", int64(50), now, int64(100)},
-			{"settle 2 orders, window mismatch", int64(50), now.Add(-48 * time.Hour), int64(50)},
+			{"settle 2 orders, valid", int64(50), now, int64(100), int64(100), pb.SettlementWithWindowResponse_ACCEPTED},
+			// the second order belongs to the already settled current window,
+			// hence only the first window is accepted and the stream is rejected.
+			{"settle 2 orders, different windows", int64(50), now.Add(-48 * time.Hour), int64(50), int64(50), pb.SettlementWithWindowResponse_REJECTED},
 		}
 
 		for _, tt := range testCases {
@@ -151,9 +156,9 @@ func TestSettlementWithWindowEndpointManyOrders(t *testing.T) {
 				resp, err := stream.CloseAndRecv()
 				require.NoError(t, err)
 
-				settled := map[int32]int64{int32(pb.PieceAction_PUT): tt.settledAmt}
+				settled := map[int32]int64{int32(pb.PieceAction_PUT): tt.totalSettled}
 				require.Equal(t, &pb.SettlementWithWindowResponse{
-					Status:        pb.SettlementWithWindowResponse_ACCEPTED,
+					Status:        tt.status,
 					ActionSettled: settled,
 				}, resp)
 
@@ -172,6 +177,97 @@ func TestSettlementWithWindowEndpointManyOrders(t *testing.T) {
 	})
 }
 
+func TestSettleWindowsEndpoint(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		storagenode := planet.StorageNodes[0]
+		now := time.Now()
+		bucketLocation := metabase.BucketLocation{
+			ProjectID:  testrand.UUID(),
+			BucketName: "testbucket",
+		}
+		key := satellite.Config.Orders.EncryptionKeys.Default
+
+		satellite.Orders.Chore.Loop.Pause()
+
+		createOrder := func(orderCreation time.Time, amount int64) *pb.SettlementRequest {
+			serialNumber := testrand.SerialNumber()
+			encrypted, err := key.EncryptMetadata(
+				serialNumber,
+				&internalpb.OrderLimitMetadata{
+					CompactProjectBucketPrefix: bucketLocation.CompactPrefix(),
+				},
+			)
+			require.NoError(t, err)
+
+			piecePublicKey, piecePrivateKey, err := storj.NewPieceKey()
+			require.NoError(t, err)
+
+			orderLimit, err := signing.SignOrderLimit(ctx, signing.SignerFromFullIdentity(satellite.Identity), &pb.OrderLimit{
+				SerialNumber:           serialNumber,
+				SatelliteId:            satellite.ID(),
+				UplinkPublicKey:        piecePublicKey,
+				StorageNodeId:          storagenode.ID(),
+				PieceId:                storj.NewPieceID(),
+				Action:                 pb.PieceAction_PUT,
+				Limit:                  1000,
+				OrderCreation:          orderCreation,
+				OrderExpiration:        now.Add(24 * time.Hour),
+				EncryptedMetadataKeyId: key.ID[:],
+				EncryptedMetadata:      encrypted,
+			})
+			require.NoError(t, err)
+
+			order, err := signing.SignUplinkOrder(ctx, piecePrivateKey, &pb.Order{
+				SerialNumber: serialNumber,
+				Amount:       amount,
+			})
+			require.NoError(t, err)
+
+			return &pb.SettlementRequest{Limit: orderLimit, Order: order}
+		}
+
+		conn, err := storagenode.Dialer.DialNodeURL(ctx, storj.NodeURL{ID: satellite.ID(), Address: satellite.Addr()})
+		require.NoError(t, err)
+		defer ctx.Check(conn.Close)
+
+		settle := func(requests ...*pb.SettlementRequest) *settlementpb.SettleWindowsResponse {
+			stream, err := settlementpb.NewDRPCSettlementClient(conn).SettleWindows(ctx)
+			require.NoError(t, err)
+			defer ctx.Check(stream.Close)
+
+			for _, request := range requests {
+				require.NoError(t, stream.Send(request))
+			}
+			resp, err := stream.CloseAndRecv()
+			require.NoError(t, err)
+			return resp
+		}
+
+		current := now.Truncate(time.Hour).UTC()
+		previous := current.Add(-2 * time.Hour)
+
+		resp := settle(createOrder(now, 100))
+		require.Len(t, resp.Windows, 1)
+		require.Equal(t, pb.SettlementWithWindowResponse_ACCEPTED, resp.Windows[0].Status)
+
+		// the current window doesn't match the previous settlement, which
+		// doesn't affect the other window.
+		resp = settle(createOrder(now, 50), createOrder(previous, 25))
+		require.Len(t, resp.Windows, 2)
+
+		require.True(t, previous.Equal(resp.Windows[0].Window))
+		require.Equal(t, pb.SettlementWithWindowResponse_ACCEPTED, resp.Windows[0].Status)
+		require.Equal(t, map[int32]int64{int32(pb.PieceAction_PUT): 25}, resp.Windows[0].ActionSettled)
+
+		require.True(t, current.Equal(resp.Windows[1].Window))
+		require.Equal(t, pb.SettlementWithWindowResponse_REJECTED, resp.Windows[1].Status)
+		require.Empty(t, resp.Windows[1].ActionSettled)
+	})
+}
+
 func TestSettlementWithWindowEndpointSingleOrder(t *testing.T) {
 	testplanet.Run(t, testplanet.Config{
 		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 1,
@@ -0,0 +1,277 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package orders
+
+import (
+	"bytes"
+	"context"
+	"sort"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/pb"
+	"storj.io/common/storj"
+	"storj.io/common/sync2"
+)
+
+// ReconcilerConfig contains configurable values for reconciling the orders
+// windows, which storage nodes claimed, with the settled windows.
+type ReconcilerConfig struct {
+	Enabled       bool          `help:"whether to reconcile the claimed orders windows with the settled windows" default:"false"`
+	Interval      time.Duration `help:"how often to reconcile the orders windows" default:"6h" testDefault:"$TESTINTERVAL"`
+	Lookback      time.Duration `help:"how far back orders windows are reconciled, should exceed the longest expected downtime" default:"168h"`
+	Grace         time.Duration `help:"how old an orders window has to be, before it is reconciled" default:"2h"`
+	ReopenWindows bool          `help:"whether to settle the claimed amounts of the windows, which were rejected or failed to settle" default:"false"`
+}
+
+// ClaimStatus is the outcome of settling the amounts, which a storage node
+// claimed for an orders window.
+type ClaimStatus int
+
+const (
+	// ClaimNone means that the storage node didn't claim the window.
+	ClaimNone ClaimStatus = 0
+	// ClaimAccepted means that the claimed amounts were settled.
+	ClaimAccepted ClaimStatus = 1
+	// ClaimRejected means that the claimed amounts didn't match the amounts
+	// settled previously.
+	ClaimRejected ClaimStatus = 2
+	// ClaimFailed means that settling the claimed amounts failed, e.g. because
+	// the database was unavailable.
+	ClaimFailed ClaimStatus = 3
+)
+
+// String returns the name of the claim status.
+func (status ClaimStatus) String() string {
+	switch status {
+	case ClaimNone:
+		return "none"
+	case ClaimAccepted:
+		return "accepted"
+	case ClaimRejected:
+		return "rejected"
+	case ClaimFailed:
+		return "failed"
+	default:
+		return "unknown"
+	}
+}
+
+// claimStatusFromSettlement converts the status of a settled window.
+func claimStatusFromSettlement(status pb.SettlementWithWindowResponse_Status) ClaimStatus {
+	if status == pb.SettlementWithWindowResponse_ACCEPTED {
+		return ClaimAccepted
+	}
+	return ClaimRejected
+}
+
+// DiscrepancyKind describes how the claimed and the settled amount differ.
+type DiscrepancyKind int
+
+const (
+	// DiscrepancyUnsettled means that the storage node claimed an amount, but
+	// nothing was settled.
+	DiscrepancyUnsettled DiscrepancyKind = iota + 1
+	// DiscrepancyUnclaimed means that an amount was settled, but the storage
+	// node didn't claim it.
+	DiscrepancyUnclaimed
+	// DiscrepancyMismatch means that the settled amount differs from the
+	// claimed amount.
+	DiscrepancyMismatch
+)
+
+// String returns the name of the discrepancy kind.
+func (kind DiscrepancyKind) String() string {
+	switch kind {
+	case DiscrepancyUnsettled:
+		return "unsettled"
+	case DiscrepancyUnclaimed:
+		return "unclaimed"
+	case DiscrepancyMismatch:
+		return "mismatch"
+	default:
+		return "unknown"
+	}
+}
+
+// Discrepancy is an action of an orders window, whose amount claimed by the
+// storage node differs from the settled amount.
+type Discrepancy struct {
+	NodeID  storj.NodeID
+	Window  time.Time
+	Action  pb.PieceAction
+	Claimed int64
+	Settled int64
+	Status  ClaimStatus
+}
+
+// Kind returns how the claimed and the settled amount differ.
+func (discrepancy Discrepancy) Kind() DiscrepancyKind {
+	switch {
+	case discrepancy.Status == ClaimNone:
+		return DiscrepancyUnclaimed
+	case discrepancy.Settled == 0:
+		return DiscrepancyUnsettled
+	default:
+		return DiscrepancyMismatch
+	}
+}
+
+// Reopenable returns whether the window can be settled with the claimed
+// amounts, i.e. the claim wasn't settled.
+func (discrepancy Discrepancy) Reopenable() bool {
+	return discrepancy.Status == ClaimRejected || discrepancy.Status == ClaimFailed
+}
+
+// NodeReport contains the discrepancies of a single storage node.
+type NodeReport struct {
+	NodeID        storj.NodeID
+	Claimed       int64
+	Settled       int64
+	Discrepancies []Discrepancy
+	// Reopened are the windows, which were settled with the claimed amounts.
+	Reopened []time.Time
+}
+
+// GroupByNode groups the discrepancies into reports per storage node, sorted
+// by node ID and window.
+func GroupByNode(discrepancies []Discrepancy) []NodeReport {
+	byNode := map[storj.NodeID]*NodeReport{}
+	for _, discrepancy := range discrepancies {
+		report, ok := byNode[discrepancy.NodeID]
+		if !ok {
+			report = &NodeReport{NodeID: discrepancy.NodeID}
+			byNode[discrepancy.NodeID] = report
+		}
+		report.Claimed += discrepancy.Claimed
+		report.Settled += discrepancy.Settled
+		report.Discrepancies = append(report.Discrepancies, discrepancy)
+	}
+
+	reports := make([]NodeReport, 0, len(byNode))
+	for _, report := range byNode {
+		sort.SliceStable(report.Discrepancies, func(i, k int) bool {
+			a, b := report.Discrepancies[i], report.Discrepancies[k]
+			if !a.Window.Equal(b.Window) {
+				return a.Window.Before(b.Window)
+			}
+			return a.Action < b.Action
+		})
+		reports = append(reports, *report)
+	}
+	sort.Slice(reports, func(i, k int) bool {
+		return bytes.Compare(reports[i].NodeID.Bytes(), reports[k].NodeID.Bytes()) < 0
+	})
+	return reports
+}
+
+// Reconciler periodically compares the orders windows, which storage nodes
+// claimed, with the settled windows and reports the discrepancies per storage
+// node. Windows, whose claims were rejected or failed to settle, are
+// optionally re-opened by settling the claimed amounts.
+//
+// Re-opening a window only updates the storage node bandwidth, the bucket
+// bandwidth of the window is not updated.
+//
+// architecture: Chore
+type Reconciler struct {
+	log    *zap.Logger
+	db     DB
+	config ReconcilerConfig
+	nowFn  func() time.Time
+
+	Loop *sync2.Cycle
+}
+
+// NewReconciler creates a new orders windows reconciler.
+func NewReconciler(log *zap.Logger, db DB, config ReconcilerConfig) *Reconciler {
+	return &Reconciler{
+		log:    log,
+		db:     db,
+		config: config,
+		nowFn:  time.Now,
+		Loop:   sync2.NewCycle(config.Interval),
+	}
+}
+
+// Run starts the reconciler loop.
+func (reconciler *Reconciler) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return reconciler.Loop.Run(ctx, func(ctx context.Context) error {
+		if _, err := reconciler.Reconcile(ctx); err != nil {
+			reconciler.log.Error("reconciling orders windows failed", zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// Reconcile reconciles the windows within the lookback, which are older than
+// the grace period. The windows are reconciled on every run, so windows
+// missed while the satellite was down are reconciled later.
+func (reconciler *Reconciler) Reconcile(ctx context.Context) (_ []NodeReport, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	to := reconciler.nowFn().Add(-reconciler.config.Grace).Truncate(time.Hour)
+	from := to.Add(-reconciler.config.Lookback)
+	return reconciler.ReconcileRange(ctx, from, to)
+}
+
+// ReconcileRange reconciles the windows starting within [from, to).
+func (reconciler *Reconciler) ReconcileRange(ctx context.Context, from, to time.Time) (_ []NodeReport, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	discrepancies, err := reconciler.db.ListSettlementDiscrepancies(ctx, from, to)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	reports := GroupByNode(discrepancies)
+	mon.IntVal("reconcile_discrepancies").Observe(int64(len(discrepancies)))
+	mon.IntVal("reconcile_nodes_with_discrepancies").Observe(int64(len(reports)))
+
+	for i := range reports {
+		report := &reports[i]
+		reconciler.log.Warn("orders windows don't match the claims of the node",
+			zap.Stringer("Node ID", report.NodeID),
+			zap.Int("discrepancies", len(report.Discrepancies)),
+			zap.Int64("claimed", report.Claimed),
+			zap.Int64("settled", report.Settled))
+
+		if !reconciler.config.ReopenWindows {
+			continue
+		}
+		for _, discrepancy := range report.Discrepancies {
+			if !discrepancy.Reopenable() {
+				continue
+			}
+			// a window has a discrepancy per action, but is re-opened once.
+			if n := len(report.Reopened); n > 0 && report.Reopened[n-1].Equal(discrepancy.Window) {
+				continue
+			}
+			if err := reconciler.db.SettleClaimedWindow(ctx, report.NodeID, discrepancy.Window); err != nil {
+				return reports, Error.Wrap(err)
+			}
+			mon.Event("reconcile_window_reopened")
+			reconciler.log.Info("re-opened orders window",
+				zap.Stringer("Node ID", report.NodeID),
+				zap.Time("window", discrepancy.Window))
+			report.Reopened = append(report.Reopened, discrepancy.Window)
+		}
+	}
+
+	return reports, nil
+}
+
+// SetNow allows tests to have the reconciler act as if the current time is
+// whatever they want.
+func (reconciler *Reconciler) SetNow(nowFn func() time.Time) {
+	reconciler.nowFn = nowFn
+}
+
+// Close stops the reconciler.
+func (reconciler *Reconciler) Close() error {
+	reconciler.Loop.Close()
+	return nil
+}
@@ -0,0 +1,109 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package orders_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/pb"
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/orders"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestGroupByNode(t *testing.T) {
+	window := time.Date(2023, 2, 14, 8, 0, 0, 0, time.UTC)
+
+	reports := orders.GroupByNode([]orders.Discrepancy{
+		{NodeID: storj.NodeID{2}, Window: window, Action: pb.PieceAction_GET, Settled: 10},
+		{NodeID: storj.NodeID{1}, Window: window.Add(time.Hour), Action: pb.PieceAction_PUT, Claimed: 20, Status: orders.ClaimRejected},
+		{NodeID: storj.NodeID{1}, Window: window, Action: pb.PieceAction_GET, Claimed: 30, Settled: 5, Status: orders.ClaimRejected},
+	})
+	require.Len(t, reports, 2)
+
+	require.Equal(t, storj.NodeID{1}, reports[0].NodeID)
+	require.Equal(t, int64(50), reports[0].Claimed)
+	require.Equal(t, int64(5), reports[0].Settled)
+	require.Len(t, reports[0].Discrepancies, 2)
+	require.Equal(t, window, reports[0].Discrepancies[0].Window)
+	require.Equal(t, orders.DiscrepancyMismatch, reports[0].Discrepancies[0].Kind())
+	require.Equal(t, orders.DiscrepancyUnsettled, reports[0].Discrepancies[1].Kind())
+
+	require.Equal(t, storj.NodeID{2}, reports[1].NodeID)
+	require.Equal(t, orders.DiscrepancyUnclaimed, reports[1].Discrepancies[0].Kind())
+	require.False(t, reports[1].Discrepancies[0].Reopenable())
+}
+
+func TestReconciler(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		ordersDB := db.Orders()
+		window := time.Now().UTC().Truncate(time.Hour).Add(-5 * time.Hour)
+		settled := storj.NodeID{1}
+		rejected := storj.NodeID{2}
+		failed := storj.NodeID{3}
+
+		amounts := map[int32]int64{int32(pb.PieceAction_GET): 100}
+		status, _, err := ordersDB.UpdateStoragenodeBandwidthSettleWithWindow(ctx, settled, amounts, window)
+		require.NoError(t, err)
+		require.NoError(t, ordersDB.RecordSettlementClaim(ctx, settled, amounts, window, orders.ClaimAccepted))
+		require.Equal(t, pb.SettlementWithWindowResponse_ACCEPTED, status)
+
+		// the node claims more than was settled.
+		_, _, err = ordersDB.UpdateStoragenodeBandwidthSettleWithWindow(ctx, rejected, amounts, window)
+		require.NoError(t, err)
+		claimed := map[int32]int64{int32(pb.PieceAction_GET): 150}
+		require.NoError(t, ordersDB.RecordSettlementClaim(ctx, rejected, claimed, window, orders.ClaimRejected))
+
+		// the window failed to settle.
+		require.NoError(t, ordersDB.RecordSettlementClaim(ctx, failed, amounts, window, orders.ClaimFailed))
+
+		reconciler := orders.NewReconciler(zaptest.NewLogger(t), ordersDB, orders.ReconcilerConfig{
+			Interval: time.Hour,
+			Lookback: 24 * time.Hour,
+			Grace:    time.Hour,
+		})
+		defer ctx.Check(reconciler.Close)
+
+		reports, err := reconciler.Reconcile(ctx)
+		require.NoError(t, err)
+		require.Len(t, reports, 2)
+		require.Equal(t, rejected, reports[0].NodeID)
+		require.Equal(t, orders.DiscrepancyMismatch, reports[0].Discrepancies[0].Kind())
+		require.Equal(t, failed, reports[1].NodeID)
+		require.Equal(t, orders.DiscrepancyUnsettled, reports[1].Discrepancies[0].Kind())
+		require.Empty(t, reports[0].Reopened)
+
+		// windows within the grace period aren't reconciled yet.
+		reconciler.SetNow(func() time.Time { return window.Add(30 * time.Minute) })
+		reports, err = reconciler.Reconcile(ctx)
+		require.NoError(t, err)
+		require.Empty(t, reports)
+
+		reopener := orders.NewReconciler(zaptest.NewLogger(t), ordersDB, orders.ReconcilerConfig{
+			ReopenWindows: true,
+		})
+		defer ctx.Check(reopener.Close)
+
+		reports, err = reopener.ReconcileRange(ctx, window, window.Add(time.Hour))
+		require.NoError(t, err)
+		require.Len(t, reports, 2)
+		require.Equal(t, []time.Time{window}, reports[0].Reopened)
+		require.Equal(t, []time.Time{window}, reports[1].Reopened)
+
+		// the re-opened windows are settled with the claimed amounts.
+		bandwidth, err := ordersDB.GetStorageNodeBandwidth(ctx, rejected, window.Add(-time.Hour), window)
+		require.NoError(t, err)
+		require.Equal(t, int64(150), bandwidth)
+
+		reports, err = reopener.ReconcileRange(ctx, window, window.Add(time.Hour))
+		require.NoError(t, err)
+		require.Empty(t, reports)
+	})
+}
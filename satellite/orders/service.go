@@ -5,6 +5,7 @@ package orders
 
 import (
 	"context"
+	"encoding/hex"
 	"math"
 	mathrand "math/rand"
 	"sync"
@@ -37,6 +38,14 @@ type Config struct {
 	FlushInterval       time.Duration  `help:"how often to flush the rollups write cache to the database" devDefault:"30s" releaseDefault:"1m" testDefault:"$TESTINTERVAL"`
 	NodeStatusLogging   bool           `hidden:"true" help:"deprecated, log the offline/disqualification status of nodes" default:"false" testDefault:"true"`
 	OrdersSemaphoreSize int            `help:"how many concurrent orders to process at once. zero is unlimited" default:"2"`
+	AuditPieceAction    bool           `help:"whether pieces downloaded for audits are ordered as audit egress instead of repair egress, requires the nodes to send the piece hash for audit downloads" default:"false"`
+	KeyManagement       KeyManagementConfig
+	Reconciliation      ReconcilerConfig
+}
+
+// KeyManagementConfig contains configurable values for rotating the order encryption keys.
+type KeyManagementConfig struct {
+	RetirementGracePeriod time.Duration `help:"how long a retired encryption key is still accepted, in addition to the order expiration" default:"1h"`
 }
 
 // Service for creating order limits.
@@ -48,9 +57,12 @@ type Service struct {
 	overlay   *overlay.Service
 	orders    DB
 
-	encryptionKeys EncryptionKeys
+	encryptionKeysMu sync.RWMutex
+	encryptionKeys   EncryptionKeys
+	keyManagement    KeyManagementConfig
 
-	orderExpiration time.Duration
+	orderExpiration  time.Duration
+	auditPieceAction bool
 
 	rngMu sync.Mutex
 	rng   *mathrand.Rand
@@ -65,6 +77,12 @@ func NewService(
 		return nil, Error.New("encryption keys must be specified to include encrypted metadata")
 	}
 
+	now := time.Now()
+	if active := config.EncryptionKeys.Active(now); !active.IsActive(now) {
+		log.Warn("no order encryption key is active, using the default key",
+			zap.String("key id", hex.EncodeToString(active.ID[:])))
+	}
+
 	return &Service{
 		log:       log,
 		satellite: satellite,
@@ -72,13 +90,47 @@ func NewService(
 		orders:    orders,
 
 		encryptionKeys: config.EncryptionKeys,
+		keyManagement:  config.KeyManagement,
 
-		orderExpiration: config.Expiration,
+		orderExpiration:  config.Expiration,
+		auditPieceAction: config.AuditPieceAction,
 
 		rng: mathrand.New(mathrand.NewSource(time.Now().UnixNano())),
 	}, nil
 }
 
+// SetEncryptionKeys replaces the keys used for encrypting and decrypting the
+// order metadata, e.g. when the keys were rotated in the secret store. Keys,
+// which are still needed for decrypting unexpired orders, must be retired
+// instead of removed.
+func (service *Service) SetEncryptionKeys(keys EncryptionKeys) error {
+	if keys.Default.IsZero() {
+		return Error.New("encryption keys must be specified to include encrypted metadata")
+	}
+
+	service.encryptionKeysMu.Lock()
+	defer service.encryptionKeysMu.Unlock()
+
+	service.encryptionKeys = keys
+	return nil
+}
+
+// activeEncryptionKey returns the key for encrypting new orders.
+func (service *Service) activeEncryptionKey(now time.Time) EncryptionKey {
+	service.encryptionKeysMu.RLock()
+	defer service.encryptionKeysMu.RUnlock()
+
+	return service.encryptionKeys.Active(now)
+}
+
+// findEncryptionKey returns the key with the id.
+func (service *Service) findEncryptionKey(id EncryptionKeyID) (EncryptionKey, bool) {
+	service.encryptionKeysMu.RLock()
+	defer service.encryptionKeysMu.RUnlock()
+
+	return service.encryptionKeys.Find(id)
+}
+
 // VerifyOrderLimitSignature verifies that the signature inside order limit belongs to the satellite.
 func (service *Service) VerifyOrderLimitSignature(ctx context.Context, signed *pb.OrderLimit) (err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -326,12 +378,17 @@ func (service *Service) CreateAuditOrderLimit(ctx context.Context, nodeID storj.
 // piece from a segment, requesting that the original order limit and piece
 // hash be included.
 //
-// Unfortunately, because of the way the protocol works historically, we
-// must use GET_REPAIR for this operation instead of GET_AUDIT.
+// Historically nodes only include them for GET_REPAIR, hence GET_AUDIT is
+// only used, when the audit piece action is enabled, so the egress isn't
+// accounted and paid as repair egress.
 func (service *Service) CreateAuditPieceOrderLimit(ctx context.Context, nodeID storj.NodeID, pieceNum uint16, rootPieceID storj.PieceID, pieceSize int32) (limit *pb.AddressedOrderLimit, _ storj.PiecePrivateKey, nodeInfo *overlay.NodeReputation, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	signer, err := NewSignerRepairGet(service, rootPieceID, time.Now(), int64(pieceSize), metabase.BucketLocation{})
+	newSigner := NewSignerRepairGet
+	if service.auditPieceAction {
+		newSigner = NewSignerAudit
+	}
+	signer, err := newSigner(service, rootPieceID, time.Now(), int64(pieceSize), metabase.BucketLocation{})
 	if err != nil {
 		return nil, storj.PiecePrivateKey{}, nodeInfo, Error.Wrap(err)
 	}
@@ -347,11 +404,12 @@ func (service *Service) createAuditOrderLimitWithSigner(ctx context.Context, nod
 	}
 
 	nodeInfo = &overlay.NodeReputation{
-		ID:         nodeID,
-		Address:    node.Address,
-		LastNet:    node.LastNet,
-		LastIPPort: node.LastIPPort,
-		Reputation: node.Reputation.Status,
+		ID:          nodeID,
+		Address:     node.Address,
+		LastNet:     node.LastNet,
+		LastIPPort:  node.LastIPPort,
+		CountryCode: node.CountryCode,
+		Reputation:  node.Reputation.Status,
 	}
 
 	if node.Disqualified != nil {
@@ -580,16 +638,13 @@ func (service *Service) DecryptOrderMetadata(ctx context.Context, order *pb.Orde
 	var orderKeyID EncryptionKeyID
 	copy(orderKeyID[:], order.EncryptedMetadataKeyId)
 
-	key := service.encryptionKeys.Default
-	if key.ID != orderKeyID {
-		val, ok := service.encryptionKeys.KeyByID[orderKeyID]
-		if !ok {
-			return nil, ErrDecryptOrderMetadata.New("no encryption key found that matches the order.EncryptedMetadataKeyId")
-		}
-		key = EncryptionKey{
-			ID:  orderKeyID,
-			Key: val,
-		}
+	key, ok := service.findEncryptionKey(orderKeyID)
+	if !ok {
+		return nil, ErrDecryptOrderMetadata.New("no encryption key found that matches the order.EncryptedMetadataKeyId")
+	}
+	if key.IsRemovable(time.Now(), service.orderExpiration, service.keyManagement.RetirementGracePeriod) {
+		mon.Event("order_metadata_key_retired")
+		return nil, ErrDecryptOrderMetadata.New("encryption key %s has been retired", hex.EncodeToString(key.ID[:]))
 	}
 	return key.DecryptMetadata(order.SerialNumber, order.EncryptedMetadata)
 }
@@ -144,7 +144,7 @@ func (signer *Signer) Sign(ctx context.Context, node storj.NodeURL, pieceNum int
 	defer mon.Task()(&ctx)(&err)
 
 	if len(signer.EncryptedMetadata) == 0 {
-		encryptionKey := signer.Service.encryptionKeys.Default
+		encryptionKey := signer.Service.activeEncryptionKey(time.Now())
 		if encryptionKey.IsZero() {
 			return nil, ErrSigner.New("default encryption key is missing")
 		}
@@ -48,6 +48,11 @@ type NodeSelectionConfig struct {
 	AsOfSystemTime AsOfSystemTimeConfig
 
 	UploadExcludedCountryCodes []string `help:"list of country codes to exclude from node selection for uploads" default:"" testDefault:"FR,BE"`
+
+	RequireCapabilities bool `help:"only select nodes for uploads and repairs, which announced the capabilities the segment requires with check-in" default:"false"`
+
+	ExcludeContained     bool `help:"exclude contained nodes, which have more pending reverification audits than the containment threshold, from node selection for uploads and repairs" default:"false"`
+	ContainmentThreshold int  `help:"number of pending reverification audits a contained node may have and still be selected, when contained nodes are excluded" default:"0"`
 }
 
 // GeoIPConfig is a configuration struct that helps configure the GeoIP lookup features on the satellite.
@@ -0,0 +1,104 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package inventory stores the software environment, which the nodes report
+// with their check-ins, and summarizes the composition of the fleet, e.g. to
+// guide rollouts and deprecations.
+package inventory
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/storj"
+	"storj.io/storj/private/nodeinventory"
+)
+
+// Error is the error class for node inventories.
+var Error = errs.Class("node inventory")
+
+// Unknown is the value of the dimensions, which a node didn't report.
+const Unknown = "unknown"
+
+// Group is the number of nodes with the same inventory and version.
+type Group struct {
+	nodeinventory.Inventory
+
+	Version string `json:"version"`
+	Nodes   int    `json:"nodes"`
+}
+
+// Summary is the composition of the fleet.
+type Summary struct {
+	// Nodes is the number of summarized nodes.
+	Nodes int `json:"nodes"`
+	// Reported is the number of nodes, which reported their inventory.
+	Reported int `json:"reported"`
+
+	ByOS             map[string]int `json:"byOS"`
+	ByArch           map[string]int `json:"byArch"`
+	ByRuntime        map[string]int `json:"byRuntime"`
+	ByStorageBackend map[string]int `json:"byStorageBackend"`
+	ByFilesystem     map[string]int `json:"byFilesystem"`
+	ByVersion        map[string]int `json:"byVersion"`
+
+	// Groups are ordered by the number of nodes descending.
+	Groups []Group `json:"groups"`
+}
+
+// DB stores the inventories of the nodes.
+//
+// architecture: Database
+type DB interface {
+	// Update stores the inventory of the node, when it differs from the
+	// stored one.
+	Update(ctx context.Context, nodeID storj.NodeID, inventory nodeinventory.Inventory, now time.Time) error
+	// Groups groups the nodes, which are neither disqualified nor exited and
+	// were contacted successfully since contactedSince, by their inventory and
+	// version. The dimensions of nodes without an inventory are empty.
+	Groups(ctx context.Context, contactedSince time.Time) ([]Group, error)
+}
+
+// Summarize summarizes the groups of nodes.
+func Summarize(groups []Group) Summary {
+	summary := Summary{
+		ByOS:             map[string]int{},
+		ByArch:           map[string]int{},
+		ByRuntime:        map[string]int{},
+		ByStorageBackend: map[string]int{},
+		ByFilesystem:     map[string]int{},
+		ByVersion:        map[string]int{},
+		Groups:           []Group{},
+	}
+
+	for _, group := range groups {
+		summary.Nodes += group.Nodes
+		if !group.Inventory.IsZero() {
+			summary.Reported += group.Nodes
+		}
+
+		summary.ByOS[orUnknown(group.OS)] += group.Nodes
+		summary.ByArch[orUnknown(group.Arch)] += group.Nodes
+		summary.ByRuntime[orUnknown(group.Runtime)] += group.Nodes
+		summary.ByStorageBackend[orUnknown(group.StorageBackend)] += group.Nodes
+		summary.ByFilesystem[orUnknown(group.Filesystem)] += group.Nodes
+		summary.ByVersion[orUnknown(group.Version)] += group.Nodes
+
+		summary.Groups = append(summary.Groups, group)
+	}
+
+	sort.SliceStable(summary.Groups, func(i, k int) bool {
+		return summary.Groups[i].Nodes > summary.Groups[k].Nodes
+	})
+	return summary
+}
+
+func orUnknown(value string) string {
+	if value == "" {
+		return Unknown
+	}
+	return value
+}
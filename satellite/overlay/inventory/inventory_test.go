@@ -0,0 +1,66 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package inventory_test
+
+import (
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/private/nodeinventory"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite/overlay/inventory"
+)
+
+func TestSummarize(t *testing.T) {
+	linux := nodeinventory.Inventory{OS: "linux", Arch: "amd64", Runtime: nodeinventory.RuntimeContainer, StorageBackend: nodeinventory.StorageBackendFilestore, Filesystem: "ext4"}
+	windows := nodeinventory.Inventory{OS: "windows", Arch: "amd64", Runtime: nodeinventory.RuntimeBareMetal, StorageBackend: nodeinventory.StorageBackendFilestore}
+
+	summary := inventory.Summarize([]inventory.Group{
+		{Inventory: windows, Version: "v1.2.3", Nodes: 2},
+		{Inventory: linux, Version: "v1.2.3", Nodes: 5},
+		{Version: "v1.1.0", Nodes: 1},
+	})
+
+	require.Equal(t, 8, summary.Nodes)
+	require.Equal(t, 7, summary.Reported)
+	require.Equal(t, map[string]int{"linux": 5, "windows": 2, inventory.Unknown: 1}, summary.ByOS)
+	require.Equal(t, map[string]int{"amd64": 7, inventory.Unknown: 1}, summary.ByArch)
+	require.Equal(t, map[string]int{"container": 5, "bare-metal": 2, inventory.Unknown: 1}, summary.ByRuntime)
+	require.Equal(t, map[string]int{"filestore": 7, inventory.Unknown: 1}, summary.ByStorageBackend)
+	require.Equal(t, map[string]int{"ext4": 5, inventory.Unknown: 3}, summary.ByFilesystem)
+	require.Equal(t, map[string]int{"v1.2.3": 7, "v1.1.0": 1}, summary.ByVersion)
+
+	require.Len(t, summary.Groups, 3)
+	require.Equal(t, linux, summary.Groups[0].Inventory)
+	require.Equal(t, windows, summary.Groups[1].Inventory)
+}
+
+func TestCheckInInventory(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 2, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		for _, node := range planet.StorageNodes {
+			require.NoError(t, node.Contact.Service.PingSatellites(ctx, time.Minute))
+		}
+
+		groups, err := sat.DB.NodeInventory().Groups(ctx, time.Now().Add(-time.Hour))
+		require.NoError(t, err)
+
+		summary := inventory.Summarize(groups)
+		require.Equal(t, 2, summary.Nodes)
+		require.Equal(t, 2, summary.Reported)
+		require.Equal(t, 2, summary.ByOS[runtime.GOOS])
+		require.Equal(t, 2, summary.ByStorageBackend[nodeinventory.StorageBackendFilestore])
+
+		// nodes, which weren't contacted recently, aren't summarized.
+		groups, err = sat.DB.NodeInventory().Groups(ctx, time.Now().Add(time.Hour))
+		require.NoError(t, err)
+		require.Empty(t, groups)
+	})
+}
@@ -0,0 +1,211 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package nodechurn aggregates daily metrics about the nodes joining and
+// leaving the network, e.g. for network health reporting.
+package nodechurn
+
+import (
+	"context"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/satellite/overlay"
+	"storj.io/storj/satellite/overlay/placementstats"
+)
+
+var (
+	// Error is the error class for node churn metrics.
+	Error = errs.Class("node churn")
+
+	mon = monkit.Package()
+)
+
+// Day is the length of the aggregated periods.
+const Day = 24 * time.Hour
+
+// Config contains configurable values for the node churn metrics.
+type Config struct {
+	Enabled      bool          `help:"whether the node churn metrics are updated with every node scan" default:"true"`
+	OfflineAfter time.Duration `help:"the duration without a successful contact, after which a node isn't counted as active" default:"72h"`
+}
+
+// DailyMetrics are the node churn metrics of a day.
+type DailyMetrics struct {
+	// Day is the start of the day in UTC.
+	Day time.Time `json:"day"`
+
+	// NewNodes are the nodes, which were created within the day.
+	NewNodes int `json:"newNodes"`
+	// ExitedNodes are the nodes, which finished graceful exit within the day.
+	ExitedNodes int `json:"exitedNodes"`
+	// DisqualifiedNodes are the nodes, which were disqualified within the day.
+	DisqualifiedNodes int `json:"disqualifiedNodes"`
+
+	// ActiveNodes are the nodes, which are neither disqualified nor exited and
+	// were contacted recently, as of the last update of the day.
+	ActiveNodes int `json:"activeNodes"`
+	// FreeDisk is the free disk space of the active nodes in bytes, as of the
+	// last update of the day.
+	FreeDisk int64 `json:"freeDisk"`
+	// CapacityChange is the change of the free disk space since the previous
+	// day. It's zero, when the metrics of the previous day are missing.
+	CapacityChange int64 `json:"capacityChange"`
+
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// DB stores the daily node churn metrics.
+//
+// architecture: Database
+type DB interface {
+	// Upsert stores the metrics, replacing the metrics of the same day.
+	Upsert(ctx context.Context, metrics DailyMetrics) error
+	// List returns the metrics of the days within [from, to) ordered by day.
+	List(ctx context.Context, from, to time.Time) ([]DailyMetrics, error)
+}
+
+// StartOfDay returns the start of the day of t in UTC.
+func StartOfDay(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// Aggregator counts the nodes joining and leaving the network within a day.
+type Aggregator struct {
+	day         time.Time
+	activeSince time.Time
+	churn       placementstats.Churn
+	activeNodes int
+	freeDisk    int64
+}
+
+// NewAggregator creates an aggregator for the day starting at day. Nodes,
+// which weren't contacted successfully within offlineAfter before now, aren't
+// counted as active.
+func NewAggregator(day, now time.Time, offlineAfter time.Duration) *Aggregator {
+	return &Aggregator{
+		day:         StartOfDay(day),
+		activeSince: now.Add(-offlineAfter),
+	}
+}
+
+// Add adds the node to the metrics.
+func (aggregator *Aggregator) Add(node *overlay.NodeDossier) {
+	aggregator.churn.Add(node, aggregator.day, aggregator.day.Add(Day))
+
+	if node.Disqualified != nil || node.ExitStatus.ExitFinishedAt != nil {
+		return
+	}
+	if node.Reputation.LastContactSuccess.Before(aggregator.activeSince) {
+		return
+	}
+	aggregator.activeNodes++
+	aggregator.freeDisk += node.Capacity.FreeDisk
+}
+
+// Metrics returns the metrics of the added nodes. The capacity change is
+// computed against the previous day, when its metrics are known.
+func (aggregator *Aggregator) Metrics(previous *DailyMetrics) DailyMetrics {
+	metrics := DailyMetrics{
+		Day:               aggregator.day,
+		NewNodes:          aggregator.churn.Joined,
+		ExitedNodes:       aggregator.churn.Exited,
+		DisqualifiedNodes: aggregator.churn.Disqualified,
+		ActiveNodes:       aggregator.activeNodes,
+		FreeDisk:          aggregator.freeDisk,
+	}
+	if previous != nil {
+		metrics.CapacityChange = metrics.FreeDisk - previous.FreeDisk
+	}
+	return metrics
+}
+
+// Observer updates the node churn metrics of the current day from the nodes
+// scanned by the nodescan chore.
+//
+// The counts of the previous day are recomputed as well, so the nodes that
+// joined or left after the last update of the previous day are counted. The
+// active nodes and the free disk space can't be computed retroactively, so
+// they are kept as they were at the last update of the previous day.
+type Observer struct {
+	db     DB
+	config Config
+
+	beforeYesterday *DailyMetrics
+	previous        *DailyMetrics
+	today           *Aggregator
+	yesterday       *Aggregator
+}
+
+// NewObserver creates a new node churn metrics observer.
+func NewObserver(db DB, config Config) *Observer {
+	return &Observer{
+		db:     db,
+		config: config,
+	}
+}
+
+// Start loads the stored metrics of the previous days.
+func (observer *Observer) Start(ctx context.Context, now time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	today := StartOfDay(now)
+	yesterday := today.Add(-Day)
+
+	stored, err := observer.db.List(ctx, yesterday.Add(-Day), today)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	observer.beforeYesterday, observer.previous = nil, nil
+	for i := range stored {
+		switch {
+		case stored[i].Day.Equal(yesterday.Add(-Day)):
+			observer.beforeYesterday = &stored[i]
+		case stored[i].Day.Equal(yesterday):
+			observer.previous = &stored[i]
+		}
+	}
+
+	observer.today = NewAggregator(today, now, observer.config.OfflineAfter)
+	observer.yesterday = NewAggregator(yesterday, now, observer.config.OfflineAfter)
+	return nil
+}
+
+// Visit adds the node to the metrics of today and yesterday.
+func (observer *Observer) Visit(node *overlay.NodeDossier) {
+	observer.today.Add(node)
+	observer.yesterday.Add(node)
+}
+
+// Finish stores the metrics of the current day, and updates the counts of
+// the previous day.
+func (observer *Observer) Finish(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	previous := observer.previous
+	if previous != nil {
+		counted := observer.yesterday.Metrics(observer.beforeYesterday)
+		previous.NewNodes = counted.NewNodes
+		previous.ExitedNodes = counted.ExitedNodes
+		previous.DisqualifiedNodes = counted.DisqualifiedNodes
+		if err := observer.db.Upsert(ctx, *previous); err != nil {
+			return Error.Wrap(err)
+		}
+	}
+
+	metrics := observer.today.Metrics(previous)
+	if err := observer.db.Upsert(ctx, metrics); err != nil {
+		return Error.Wrap(err)
+	}
+
+	mon.IntVal("node_churn_new_nodes").Observe(int64(metrics.NewNodes))
+	mon.IntVal("node_churn_exited_nodes").Observe(int64(metrics.ExitedNodes))
+	mon.IntVal("node_churn_disqualified_nodes").Observe(int64(metrics.DisqualifiedNodes))
+	mon.IntVal("node_churn_capacity_change").Observe(metrics.CapacityChange)
+
+	return nil
+}
@@ -0,0 +1,130 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package nodechurn_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/pb"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/overlay"
+	"storj.io/storj/satellite/overlay/nodechurn"
+	"storj.io/storj/satellite/overlay/nodescan"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestAggregator(t *testing.T) {
+	day := time.Date(2023, 2, 14, 0, 0, 0, 0, time.UTC)
+	during := day.Add(8 * time.Hour)
+	before := day.Add(-time.Hour)
+	after := day.Add(nodechurn.Day)
+
+	node := func(createdAt time.Time, freeDisk int64) *overlay.NodeDossier {
+		return &overlay.NodeDossier{
+			CreatedAt:  createdAt,
+			Capacity:   pb.NodeCapacity{FreeDisk: freeDisk},
+			Reputation: overlay.NodeStats{LastContactSuccess: during},
+		}
+	}
+
+	aggregator := nodechurn.NewAggregator(during, during, 72*time.Hour)
+
+	aggregator.Add(node(before, 100))
+	aggregator.Add(node(during, 200))
+	aggregator.Add(node(after, 400))
+
+	disqualified := node(before, 800)
+	disqualified.Disqualified = &during
+	aggregator.Add(disqualified)
+
+	exited := node(during, 1600)
+	exited.ExitStatus.ExitFinishedAt = &during
+	aggregator.Add(exited)
+
+	exitedBefore := node(before, 3200)
+	exitedBefore.ExitStatus.ExitFinishedAt = &before
+	aggregator.Add(exitedBefore)
+
+	offline := node(before, 6400)
+	offline.Reputation.LastContactSuccess = during.Add(-73 * time.Hour)
+	aggregator.Add(offline)
+
+	exitedAndDisqualified := node(before, 12800)
+	exitedAndDisqualified.ExitStatus.ExitFinishedAt = &during
+	exitedAndDisqualified.Disqualified = &during
+	aggregator.Add(exitedAndDisqualified)
+
+	require.Equal(t, nodechurn.DailyMetrics{
+		Day:               day,
+		NewNodes:          2,
+		ExitedNodes:       1,
+		DisqualifiedNodes: 2,
+		ActiveNodes:       3,
+		FreeDisk:          700,
+	}, aggregator.Metrics(nil))
+
+	metrics := aggregator.Metrics(&nodechurn.DailyMetrics{FreeDisk: 1000})
+	require.Equal(t, int64(-300), metrics.CapacityChange)
+}
+
+func TestObserver(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		now := time.Now()
+		today := nodechurn.StartOfDay(now)
+		yesterday := today.Add(-nodechurn.Day)
+
+		for i := 0; i < 2; i++ {
+			nodeID := testrand.NodeID()
+			require.NoError(t, db.OverlayCache().UpdateCheckIn(ctx, overlay.NodeCheckInInfo{
+				NodeID:   nodeID,
+				Address:  &pb.NodeAddress{Address: "127.0.0.1:0"},
+				IsUp:     true,
+				Version:  &pb.NodeVersion{Version: "v1.0.0"},
+				Capacity: &pb.NodeCapacity{FreeDisk: 1000},
+			}, now, overlay.NodeSelectionConfig{}))
+
+			if i == 0 {
+				_, err := db.OverlayCache().DisqualifyNode(ctx, nodeID, now, overlay.DisqualificationReasonAuditFailure)
+				require.NoError(t, err)
+			}
+		}
+
+		require.NoError(t, db.NodeChurn().Upsert(ctx, nodechurn.DailyMetrics{
+			Day:         yesterday,
+			NewNodes:    5,
+			ActiveNodes: 3,
+			FreeDisk:    3000,
+		}))
+
+		observer := nodechurn.NewObserver(db.NodeChurn(), nodechurn.Config{Enabled: true, OfflineAfter: 72 * time.Hour})
+		chore := nodescan.NewChore(zaptest.NewLogger(t), db.OverlayCache(), nodescan.Config{Interval: time.Hour}, observer)
+		defer ctx.Check(chore.Close)
+		chore.SetNow(func() time.Time { return now })
+
+		require.NoError(t, chore.Scan(ctx))
+
+		stored, err := db.NodeChurn().List(ctx, yesterday, today.Add(nodechurn.Day))
+		require.NoError(t, err)
+		require.Len(t, stored, 2)
+
+		// the counts of the previous day are recomputed, the capacity is kept.
+		require.Equal(t, yesterday, stored[0].Day)
+		require.Equal(t, 0, stored[0].NewNodes)
+		require.Equal(t, 3, stored[0].ActiveNodes)
+		require.Equal(t, int64(3000), stored[0].FreeDisk)
+
+		require.Equal(t, today, stored[1].Day)
+		require.Equal(t, 2, stored[1].NewNodes)
+		require.Equal(t, 1, stored[1].DisqualifiedNodes)
+		require.Equal(t, 1, stored[1].ActiveNodes)
+		require.Equal(t, int64(1000), stored[1].FreeDisk)
+		require.Equal(t, int64(-2000), stored[1].CapacityChange)
+	})
+}
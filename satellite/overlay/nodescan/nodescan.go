@@ -0,0 +1,118 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package nodescan periodically iterates all the nodes of the overlay and
+// feeds them to the node statistics, so they share a single scan.
+package nodescan
+
+import (
+	"context"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/sync2"
+	"storj.io/storj/satellite/overlay"
+)
+
+var (
+	// Error is the error class for the node scan.
+	Error = errs.Class("node scan")
+
+	mon = monkit.Package()
+)
+
+// Config contains configurable values for the node scan.
+type Config struct {
+	Interval time.Duration `help:"how often all the nodes are scanned for the node statistics" releaseDefault:"1h" devDefault:"5m" testDefault:"$TESTINTERVAL"`
+}
+
+// Observer computes statistics from the scanned nodes.
+type Observer interface {
+	// Start is called before the nodes are scanned, now is the time of the
+	// scan.
+	Start(ctx context.Context, now time.Time) error
+	// Visit is called for every node of the overlay.
+	Visit(node *overlay.NodeDossier)
+	// Finish is called after all nodes were visited.
+	Finish(ctx context.Context) error
+}
+
+// Chore periodically scans all the nodes of the overlay and feeds them to
+// the observers.
+//
+// architecture: Chore
+type Chore struct {
+	log       *zap.Logger
+	db        overlay.DB
+	observers []Observer
+	nowFn     func() time.Time
+
+	Loop *sync2.Cycle
+}
+
+// NewChore creates a new node scan chore.
+func NewChore(log *zap.Logger, db overlay.DB, config Config, observers ...Observer) *Chore {
+	return &Chore{
+		log:       log,
+		db:        db,
+		observers: observers,
+		nowFn:     time.Now,
+		Loop:      sync2.NewCycle(config.Interval),
+	}
+}
+
+// Run runs the chore.
+func (chore *Chore) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return chore.Loop.Run(ctx, func(ctx context.Context) error {
+		if err := chore.Scan(ctx); err != nil {
+			chore.log.Error("scanning the nodes failed", zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// Scan feeds all the nodes to the observers. An observer failing to finish
+// doesn't affect the others.
+func (chore *Chore) Scan(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	now := chore.nowFn()
+	for _, observer := range chore.observers {
+		if err := observer.Start(ctx, now); err != nil {
+			return Error.Wrap(err)
+		}
+	}
+
+	err = chore.db.IterateAllNodeDossiers(ctx, func(ctx context.Context, node *overlay.NodeDossier) error {
+		for _, observer := range chore.observers {
+			observer.Visit(node)
+		}
+		return nil
+	})
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	var group errs.Group
+	for _, observer := range chore.observers {
+		group.Add(observer.Finish(ctx))
+	}
+	return Error.Wrap(group.Err())
+}
+
+// SetNow allows tests to have the chore act as if the current time is
+// whatever they want.
+func (chore *Chore) SetNow(nowFn func() time.Time) {
+	chore.nowFn = nowFn
+}
+
+// Close stops the chore.
+func (chore *Chore) Close() error {
+	chore.Loop.Close()
+	return nil
+}
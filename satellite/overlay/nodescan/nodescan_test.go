@@ -0,0 +1,79 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package nodescan_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/pb"
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/overlay"
+	"storj.io/storj/satellite/overlay/nodescan"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+type recorder struct {
+	started  time.Time
+	visited  []storj.NodeID
+	finished bool
+	err      error
+}
+
+func (observer *recorder) Start(ctx context.Context, now time.Time) error {
+	observer.started = now
+	observer.visited = nil
+	return nil
+}
+
+func (observer *recorder) Visit(node *overlay.NodeDossier) {
+	observer.visited = append(observer.visited, node.Id)
+}
+
+func (observer *recorder) Finish(ctx context.Context) error {
+	observer.finished = true
+	return observer.err
+}
+
+func TestChore(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		now := time.Now()
+
+		var nodeIDs []storj.NodeID
+		for i := 0; i < 3; i++ {
+			nodeID := testrand.NodeID()
+			require.NoError(t, db.OverlayCache().UpdateCheckIn(ctx, overlay.NodeCheckInInfo{
+				NodeID:  nodeID,
+				Address: &pb.NodeAddress{Address: "127.0.0.1:0"},
+				IsUp:    true,
+				Version: &pb.NodeVersion{Version: "v1.0.0"},
+			}, now, overlay.NodeSelectionConfig{}))
+			nodeIDs = append(nodeIDs, nodeID)
+		}
+
+		failing := &recorder{err: errs.New("failure")}
+		succeeding := &recorder{}
+
+		chore := nodescan.NewChore(zaptest.NewLogger(t), db.OverlayCache(), nodescan.Config{Interval: time.Hour}, failing, succeeding)
+		defer ctx.Check(chore.Close)
+		chore.SetNow(func() time.Time { return now })
+
+		// the nodes are scanned once for all the observers, a failing observer
+		// doesn't keep the others from finishing.
+		require.Error(t, chore.Scan(ctx))
+		for _, observer := range []*recorder{failing, succeeding} {
+			require.Equal(t, now, observer.started)
+			require.ElementsMatch(t, nodeIDs, observer.visited)
+			require.True(t, observer.finished)
+		}
+	})
+}
@@ -0,0 +1,272 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package placementstats summarizes the storage nodes per
+// placement, e.g. for admin dashboards and capacity planning.
+package placementstats
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+
+	"storj.io/common/storj"
+	"storj.io/storj/satellite/overlay"
+)
+
+var (
+	// Error is the error class for placement statistics.
+	Error = errs.Class("placement statistics")
+
+	mon = monkit.Package()
+)
+
+// Placements are the placements, which are summarized.
+var Placements = []storj.PlacementConstraint{
+	storj.EveryCountry,
+	storj.EU,
+	storj.EEA,
+	storj.US,
+	storj.DE,
+}
+
+// PlacementName returns the name of the placement, as used by the admin API.
+func PlacementName(placement storj.PlacementConstraint) string {
+	switch placement {
+	case storj.EveryCountry:
+		return "global"
+	case storj.EU:
+		return "EU"
+	case storj.EEA:
+		return "EEA"
+	case storj.US:
+		return "US"
+	case storj.DE:
+		return "DE"
+	default:
+		return "invalid"
+	}
+}
+
+// Config contains configurable values for the placement statistics.
+type Config struct {
+	ChurnPeriod time.Duration `help:"the period, in which joined and left nodes are counted as churn" default:"720h"`
+}
+
+// Churn counts the nodes, which joined or left the network within a period.
+type Churn struct {
+	Joined       int
+	Exited       int
+	Disqualified int
+}
+
+// Add counts the node, when it joined, exited or was disqualified within
+// [since, before). A node, which exited and was disqualified, is only counted
+// as disqualified.
+func (churn *Churn) Add(node *overlay.NodeDossier, since, before time.Time) {
+	within := func(t time.Time) bool {
+		return !t.Before(since) && t.Before(before)
+	}
+
+	if within(node.CreatedAt) {
+		churn.Joined++
+	}
+	switch {
+	case node.Disqualified != nil:
+		if within(*node.Disqualified) {
+			churn.Disqualified++
+		}
+	case node.ExitStatus.ExitFinishedAt != nil:
+		if within(*node.ExitStatus.ExitFinishedAt) {
+			churn.Exited++
+		}
+	}
+}
+
+// Left returns the number of nodes, which exited or were disqualified.
+func (churn Churn) Left() int {
+	return churn.Exited + churn.Disqualified
+}
+
+// Statistics summarizes the storage nodes of a placement.
+type Statistics struct {
+	Placement storj.PlacementConstraint `json:"placement"`
+	Name      string                    `json:"name"`
+
+	// Nodes are the nodes, which are neither disqualified nor exited.
+	Nodes     int `json:"nodes"`
+	Online    int `json:"online"`
+	Vetted    int `json:"vetted"`
+	Unvetted  int `json:"unvetted"`
+	Suspended int `json:"suspended"`
+	Exiting   int `json:"exiting"`
+
+	// FreeDisk is the free disk space of the online nodes in bytes.
+	FreeDisk int64 `json:"freeDisk"`
+
+	// Joined are the nodes, which were created within the churn period.
+	Joined int `json:"joined"`
+	// Left are the nodes, which were disqualified or exited within the churn
+	// period.
+	Left int `json:"left"`
+}
+
+// Snapshot contains the statistics of all placements at a point in time.
+type Snapshot struct {
+	ComputedAt  time.Time     `json:"computedAt"`
+	ChurnPeriod time.Duration `json:"churnPeriod"`
+	Placements  []Statistics  `json:"placements"`
+}
+
+// Placement returns the statistics of the placement.
+func (snapshot *Snapshot) Placement(placement storj.PlacementConstraint) (Statistics, bool) {
+	for _, stats := range snapshot.Placements {
+		if stats.Placement == placement {
+			return stats, true
+		}
+	}
+	return Statistics{}, false
+}
+
+// Aggregator adds up the statistics of the nodes.
+type Aggregator struct {
+	now          time.Time
+	onlineWindow time.Duration
+	churnPeriod  time.Duration
+	stats        []Statistics
+	churn        []Churn
+}
+
+// NewAggregator creates an aggregator, which counts nodes contacted within
+// the online window as online.
+func NewAggregator(now time.Time, onlineWindow, churnPeriod time.Duration) *Aggregator {
+	stats := make([]Statistics, len(Placements))
+	for i, placement := range Placements {
+		stats[i] = Statistics{
+			Placement: placement,
+			Name:      PlacementName(placement),
+		}
+	}
+	return &Aggregator{
+		now:          now,
+		onlineWindow: onlineWindow,
+		churnPeriod:  churnPeriod,
+		stats:        stats,
+		churn:        make([]Churn, len(Placements)),
+	}
+}
+
+// Add adds the node to the statistics of the placements, which allow its
+// country.
+func (aggregator *Aggregator) Add(node *overlay.NodeDossier) {
+	churnStart := aggregator.now.Add(-aggregator.churnPeriod)
+	left := node.Disqualified != nil || node.ExitStatus.ExitFinishedAt != nil
+
+	for i := range aggregator.stats {
+		stats := &aggregator.stats[i]
+		if !stats.Placement.AllowedCountry(node.CountryCode) {
+			continue
+		}
+
+		aggregator.churn[i].Add(node, churnStart, aggregator.now)
+		if left {
+			continue
+		}
+
+		stats.Nodes++
+		if node.Reputation.Status.VettedAt != nil {
+			stats.Vetted++
+		} else {
+			stats.Unvetted++
+		}
+		if node.UnknownAuditSuspended != nil || node.OfflineSuspended != nil {
+			stats.Suspended++
+		}
+		if node.ExitStatus.ExitInitiatedAt != nil {
+			stats.Exiting++
+		}
+		if aggregator.now.Sub(node.Reputation.LastContactSuccess) < aggregator.onlineWindow {
+			stats.Online++
+			stats.FreeDisk += node.Capacity.FreeDisk
+		}
+	}
+}
+
+// Snapshot returns the statistics of the added nodes.
+func (aggregator *Aggregator) Snapshot() Snapshot {
+	placements := append([]Statistics(nil), aggregator.stats...)
+	for i := range placements {
+		placements[i].Joined = aggregator.churn[i].Joined
+		placements[i].Left = aggregator.churn[i].Left()
+	}
+	return Snapshot{
+		ComputedAt:  aggregator.now,
+		ChurnPeriod: aggregator.churnPeriod,
+		Placements:  placements,
+	}
+}
+
+// Observer computes the placement statistics from the nodes scanned by the
+// nodescan chore.
+type Observer struct {
+	onlineWindow time.Duration
+	config       Config
+
+	aggregator *Aggregator
+
+	mu       sync.RWMutex
+	snapshot *Snapshot
+}
+
+// NewObserver creates a new placement statistics observer.
+func NewObserver(onlineWindow time.Duration, config Config) *Observer {
+	return &Observer{
+		onlineWindow: onlineWindow,
+		config:       config,
+	}
+}
+
+// Start starts computing the statistics of a scan.
+func (observer *Observer) Start(ctx context.Context, now time.Time) error {
+	observer.aggregator = NewAggregator(now, observer.onlineWindow, observer.config.ChurnPeriod)
+	return nil
+}
+
+// Visit adds the node to the statistics.
+func (observer *Observer) Visit(node *overlay.NodeDossier) {
+	observer.aggregator.Add(node)
+}
+
+// Finish keeps the statistics of the scan as the latest snapshot.
+func (observer *Observer) Finish(ctx context.Context) error {
+	snapshot := observer.aggregator.Snapshot()
+	observer.aggregator = nil
+
+	for _, stats := range snapshot.Placements {
+		tag := monkit.NewSeriesTag("placement", stats.Name)
+		mon.IntVal("placement_nodes", tag).Observe(int64(stats.Nodes))
+		mon.IntVal("placement_online_nodes", tag).Observe(int64(stats.Online))
+		mon.IntVal("placement_free_disk", tag).Observe(stats.FreeDisk)
+	}
+
+	observer.mu.Lock()
+	observer.snapshot = &snapshot
+	observer.mu.Unlock()
+
+	return nil
+}
+
+// Latest returns the latest snapshot. ok is false, when the statistics
+// weren't computed yet.
+func (observer *Observer) Latest() (_ Snapshot, ok bool) {
+	observer.mu.RLock()
+	defer observer.mu.RUnlock()
+
+	if observer.snapshot == nil {
+		return Snapshot{}, false
+	}
+	return *observer.snapshot, true
+}
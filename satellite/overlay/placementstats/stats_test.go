@@ -0,0 +1,86 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package placementstats_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/pb"
+	"storj.io/common/storj"
+	"storj.io/common/storj/location"
+	"storj.io/storj/satellite/overlay"
+	"storj.io/storj/satellite/overlay/placementstats"
+)
+
+func TestAggregator(t *testing.T) {
+	now := time.Date(2023, 2, 14, 8, 0, 0, 0, time.UTC)
+	longAgo := now.Add(-365 * 24 * time.Hour)
+	recently := now.Add(-time.Hour)
+
+	node := func(country location.CountryCode, vetted bool, lastContact time.Time, freeDisk int64) *overlay.NodeDossier {
+		dossier := &overlay.NodeDossier{
+			CountryCode: country,
+			CreatedAt:   longAgo,
+			Capacity:    pb.NodeCapacity{FreeDisk: freeDisk},
+		}
+		dossier.Reputation.LastContactSuccess = lastContact
+		if vetted {
+			dossier.Reputation.Status.VettedAt = &longAgo
+		}
+		return dossier
+	}
+
+	aggregator := placementstats.NewAggregator(now, 4*time.Hour, 30*24*time.Hour)
+
+	aggregator.Add(node(location.Germany, true, now, 100))
+	aggregator.Add(node(location.UnitedStates, false, now, 200))
+
+	offline := node(location.France, true, longAgo, 300)
+	offline.CreatedAt = recently
+	aggregator.Add(offline)
+
+	disqualified := node(location.Germany, true, now, 400)
+	disqualified.Disqualified = &recently
+	aggregator.Add(disqualified)
+
+	exited := node(location.UnitedStates, true, now, 500)
+	exited.ExitStatus.ExitFinishedAt = &longAgo
+	aggregator.Add(exited)
+
+	snapshot := aggregator.Snapshot()
+	require.Equal(t, now, snapshot.ComputedAt)
+
+	global, ok := snapshot.Placement(storj.EveryCountry)
+	require.True(t, ok)
+	require.Equal(t, placementstats.Statistics{
+		Placement: storj.EveryCountry,
+		Name:      "global",
+		Nodes:     3,
+		Online:    2,
+		Vetted:    2,
+		Unvetted:  1,
+		FreeDisk:  300,
+		Joined:    1,
+		Left:      1,
+	}, global)
+
+	eu, ok := snapshot.Placement(storj.EU)
+	require.True(t, ok)
+	require.Equal(t, 2, eu.Nodes)
+	require.Equal(t, 1, eu.Online)
+	require.Equal(t, int64(100), eu.FreeDisk)
+	require.Equal(t, 1, eu.Left)
+
+	de, ok := snapshot.Placement(storj.DE)
+	require.True(t, ok)
+	require.Equal(t, 1, de.Nodes)
+
+	us, ok := snapshot.Placement(storj.US)
+	require.True(t, ok)
+	require.Equal(t, 1, us.Unvetted)
+	require.Equal(t, 0, us.Left)
+}
@@ -22,8 +22,11 @@ import (
 	"storj.io/common/rpc/rpcpeer"
 	"storj.io/common/storj"
 	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
 	"storj.io/storj/private/testplanet"
 	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/audit"
+	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/overlay"
 	"storj.io/storj/satellite/reputation"
 )
@@ -112,6 +115,63 @@ func TestMinimumDiskSpace(t *testing.T) {
 	})
 }
 
+func TestExcludeContained(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 3, UplinkCount: 0,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Overlay.Node.ExcludeContained = true
+				config.Overlay.Node.ContainmentThreshold = 1
+				config.Overlay.NodeSelectionCache.Staleness = lowStaleness
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		service := satellite.Overlay.Service
+		nodeConfig := satellite.Config.Overlay.Node
+
+		// node 0 has more pending reverifications than the threshold, node 1 has not.
+		contain := func(nodeID storj.NodeID, pending int) {
+			for i := 0; i < pending; i++ {
+				err := satellite.DB.Containment().Insert(ctx, &audit.PieceLocator{
+					StreamID: testrand.UUID(),
+					Position: metabase.SegmentPosition{Index: uint32(i)},
+					NodeID:   nodeID,
+				})
+				require.NoError(t, err)
+			}
+			require.NoError(t, service.SetNodeContained(ctx, nodeID, true))
+		}
+		contain(planet.StorageNodes[0].ID(), 2)
+		contain(planet.StorageNodes[1].ID(), 1)
+
+		require.NoError(t, service.UploadSelectionCache.Refresh(ctx))
+
+		req := overlay.FindStorageNodesRequest{RequestedCount: 3}
+		_, err := service.FindStorageNodesForUpload(ctx, req)
+		require.True(t, overlay.ErrNotEnoughNodes.Has(err))
+		_, err = service.FindStorageNodesWithPreferences(ctx, req, &nodeConfig)
+		require.True(t, overlay.ErrNotEnoughNodes.Has(err))
+
+		req = overlay.FindStorageNodesRequest{RequestedCount: 2}
+		cached, err := service.FindStorageNodesForUpload(ctx, req)
+		require.NoError(t, err)
+		selected, err := service.FindStorageNodesWithPreferences(ctx, req, &nodeConfig)
+		require.NoError(t, err)
+		for _, node := range append(cached, selected...) {
+			require.NotEqual(t, planet.StorageNodes[0].ID(), node.ID)
+		}
+
+		// the node is selected again, when it isn't contained anymore.
+		require.NoError(t, service.SetNodeContained(ctx, planet.StorageNodes[0].ID(), false))
+		require.NoError(t, service.UploadSelectionCache.Refresh(ctx))
+
+		req = overlay.FindStorageNodesRequest{RequestedCount: 3}
+		_, err = service.FindStorageNodesForUpload(ctx, req)
+		require.NoError(t, err)
+	})
+}
+
 func TestOffline(t *testing.T) {
 	testplanet.Run(t, testplanet.Config{
 		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
@@ -17,6 +17,8 @@ import (
 	"storj.io/common/storj/location"
 	"storj.io/common/sync2"
 	"storj.io/private/version"
+	"storj.io/storj/private/nodecapabilities"
+	"storj.io/storj/satellite/events"
 	"storj.io/storj/satellite/geoip"
 	"storj.io/storj/satellite/mailservice"
 	"storj.io/storj/satellite/metabase"
@@ -68,8 +70,8 @@ type DB interface {
 	KnownReliableInExcludedCountries(context.Context, *NodeCriteria, storj.NodeIDList) (storj.NodeIDList, error)
 	// KnownReliable filters a set of nodes to reliable (online and qualified) nodes.
 	KnownReliable(ctx context.Context, onlineWindow time.Duration, nodeIDs storj.NodeIDList) ([]*pb.Node, error)
-	// Reliable returns all nodes that are reliable
-	Reliable(context.Context, *NodeCriteria) (storj.NodeIDList, error)
+	// Reliable returns all nodes that are reliable, with their network and country.
+	Reliable(context.Context, *NodeCriteria) ([]*SelectedNode, error)
 	// UpdateReputation updates the DB columns for all reputation fields in ReputationStatus.
 	UpdateReputation(ctx context.Context, id storj.NodeID, request ReputationUpdate) error
 	// UpdateNodeInfo updates node dossier with info requested from the node itself like node type, email, wallet, capacity, and version.
@@ -161,6 +163,9 @@ type NodeCheckInInfo struct {
 	CountryCode             location.CountryCode
 	SoftwareUpdateEmailSent bool
 	VersionBelowMin         bool
+	// Capabilities are the capabilities, which the node announced with the
+	// check-in. They are empty for the first version of the check-in protocol.
+	Capabilities nodecapabilities.Capabilities
 }
 
 // InfoResponse contains node dossier info requested from the storage node.
@@ -178,6 +183,9 @@ type FindStorageNodesRequest struct {
 	MinimumVersion     string        // semver or empty
 	AsOfSystemInterval time.Duration // only used for CRDB queries
 	Placement          storj.PlacementConstraint
+	// Requires are the capabilities, which the selected nodes need. They're
+	// ignored, unless the node selection requires capabilities.
+	Requires nodecapabilities.Requirements
 }
 
 // NodeCriteria are the requirements for selecting nodes.
@@ -190,6 +198,10 @@ type NodeCriteria struct {
 	DistinctIP         bool
 	AsOfSystemInterval time.Duration // only used for CRDB queries
 	ExcludedCountries  []string
+	Requires           nodecapabilities.Requirements
+
+	ExcludeContained     bool
+	ContainmentThreshold int // only used when ExcludeContained is set
 }
 
 // ReputationStatus indicates current reputation status for a node.
@@ -218,6 +230,9 @@ type ExitStatus struct {
 	ExitLoopCompletedAt *time.Time
 	ExitFinishedAt      *time.Time
 	ExitSuccess         bool
+	// ExitFailedReason is the reason of a failed exit. It's nil, when the
+	// exit hasn't failed or the reason wasn't recorded.
+	ExitFailedReason *pb.ExitFailed_Reason
 }
 
 // ExitStatusRequest is used to update a node's graceful exit status.
@@ -227,6 +242,8 @@ type ExitStatusRequest struct {
 	ExitLoopCompletedAt time.Time
 	ExitFinishedAt      time.Time
 	ExitSuccess         bool
+	// ExitFailedReason is recorded, when it's set.
+	ExitFailedReason *pb.ExitFailed_Reason
 }
 
 // NodeDossier is the complete info that the satellite tracks for a storage node.
@@ -251,6 +268,7 @@ type NodeDossier struct {
 	LastOfflineEmail        *time.Time
 	LastSoftwareUpdateEmail *time.Time
 	CountryCode             location.CountryCode
+	Capabilities            nodecapabilities.Capabilities
 }
 
 // NodeStats contains statistics about a node.
@@ -277,15 +295,19 @@ type SelectedNode struct {
 	LastNet     string
 	LastIPPort  string
 	CountryCode location.CountryCode
+	// Capabilities are only set when the node is selected from the upload
+	// selection cache.
+	Capabilities nodecapabilities.Capabilities
 }
 
 // NodeReputation is used as a result for creating orders limits for audits.
 type NodeReputation struct {
-	ID         storj.NodeID
-	Address    *pb.NodeAddress
-	LastNet    string
-	LastIPPort string
-	Reputation ReputationStatus
+	ID          storj.NodeID
+	Address     *pb.NodeAddress
+	LastNet     string
+	LastIPPort  string
+	CountryCode location.CountryCode
+	Reputation  ReputationStatus
 }
 
 // Clone returns a deep clone of the selected node.
@@ -308,6 +330,7 @@ type Service struct {
 	log              *zap.Logger
 	db               DB
 	nodeEvents       nodeevents.DB
+	events           *events.Bus
 	mail             *mailservice.Service
 	satelliteName    string
 	satelliteAddress string
@@ -319,7 +342,7 @@ type Service struct {
 }
 
 // NewService returns a new Service.
-func NewService(log *zap.Logger, db DB, nodeEvents nodeevents.DB, mailService *mailservice.Service, satelliteAddr, satelliteName string, config Config) (*Service, error) {
+func NewService(log *zap.Logger, db DB, nodeEvents nodeevents.DB, eventBus *events.Bus, mailService *mailservice.Service, satelliteAddr, satelliteName string, config Config) (*Service, error) {
 	err := config.Node.AsOfSystemTime.isValid()
 	if err != nil {
 		return nil, errs.Wrap(err)
@@ -352,6 +375,7 @@ func NewService(log *zap.Logger, db DB, nodeEvents nodeevents.DB, mailService *m
 		log:              log,
 		db:               db,
 		nodeEvents:       nodeEvents,
+		events:           eventBus,
 		mail:             mailService,
 		satelliteAddress: satelliteAddr,
 		satelliteName:    satelliteName,
@@ -485,6 +509,11 @@ func (service *Service) FindStorageNodesWithPreferences(ctx context.Context, req
 		newNodeCount = int(float64(totalNeededNodes) * preferences.NewNodeFraction)
 	}
 
+	var requires nodecapabilities.Requirements
+	if preferences.RequireCapabilities {
+		requires = req.Requires
+	}
+
 	criteria := NodeCriteria{
 		FreeDisk:           preferences.MinimumDiskSpace.Int64(),
 		ExcludedIDs:        excludedIDs,
@@ -493,6 +522,10 @@ func (service *Service) FindStorageNodesWithPreferences(ctx context.Context, req
 		OnlineWindow:       preferences.OnlineWindow,
 		DistinctIP:         preferences.DistinctIP,
 		AsOfSystemInterval: req.AsOfSystemInterval,
+		Requires:           requires,
+
+		ExcludeContained:     preferences.ExcludeContained,
+		ContainmentThreshold: preferences.ContainmentThreshold,
 	}
 	nodes, err = service.db.SelectStorageNodes(ctx, totalNeededNodes, newNodeCount, &criteria)
 	if err != nil {
@@ -575,7 +608,7 @@ func (service *Service) KnownReliable(ctx context.Context, nodeIDs storj.NodeIDL
 }
 
 // Reliable filters a set of nodes that are reliable, independent of new.
-func (service *Service) Reliable(ctx context.Context) (nodes storj.NodeIDList, err error) {
+func (service *Service) Reliable(ctx context.Context) (nodes []*SelectedNode, err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	criteria := &NodeCriteria{
@@ -594,6 +627,12 @@ func (service *Service) UpdateReputation(ctx context.Context, id storj.NodeID, e
 		return err
 	}
 
+	for _, change := range reputationChanges {
+		if change == nodeevents.Disqualified {
+			service.publishDisqualified(ctx, id, request.DisqualificationReason)
+		}
+	}
+
 	if service.config.SendNodeEmails {
 		service.insertReputationNodeEvents(ctx, email, id, reputationChanges)
 	}
@@ -678,6 +717,8 @@ func (service *Service) UpdateCheckIn(ctx context.Context, node NodeCheckInInfo,
 	spaceChanged := (node.Capacity == nil && oldInfo.Capacity.FreeDisk != 0) ||
 		(node.Capacity != nil && node.Capacity.FreeDisk != oldInfo.Capacity.FreeDisk)
 
+	capabilitiesChanged := !node.Capabilities.Equal(oldInfo.Capabilities)
+
 	if oldInfo.CountryCode == location.CountryCode(0) || oldInfo.LastIPPort != node.LastIPPort {
 		node.CountryCode, err = service.GeoIP.LookupISOCountryCode(node.LastIPPort)
 		if err != nil {
@@ -715,7 +756,7 @@ func (service *Service) UpdateCheckIn(ctx context.Context, node NodeCheckInInfo,
 		}
 	}
 
-	if dbStale || addrChanged || walletChanged || verChanged || spaceChanged ||
+	if dbStale || addrChanged || walletChanged || verChanged || spaceChanged || capabilitiesChanged ||
 		oldInfo.LastNet != node.LastNet || oldInfo.LastIPPort != node.LastIPPort ||
 		oldInfo.CountryCode != node.CountryCode || node.SoftwareUpdateEmailSent {
 		err = service.db.UpdateCheckIn(ctx, node, timestamp, service.config.Node)
@@ -782,6 +823,33 @@ func (service *Service) GetReliablePiecesInExcludedCountries(ctx context.Context
 	return piecesInExcluded, nil
 }
 
+// GetPiecesOutOfPlacement returns the pieces on online nodes, which are in a
+// country not allowed by the placement. The repair moves them to nodes within
+// the placement.
+func (service *Service) GetPiecesOutOfPlacement(ctx context.Context, pieces metabase.Pieces, placement storj.PlacementConstraint) (outOfPlacement []uint16, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if placement == storj.EveryCountry || len(pieces) == 0 {
+		return nil, nil
+	}
+
+	nodeIDs := make([]storj.NodeID, 0, len(pieces))
+	for _, p := range pieces {
+		nodeIDs = append(nodeIDs, p.StorageNode)
+	}
+	nodes, err := service.db.GetOnlineNodesForAuditRepair(ctx, nodeIDs, service.config.Node.OnlineWindow)
+	if err != nil {
+		return nil, Error.New("error getting nodes %s", err)
+	}
+
+	for _, p := range pieces {
+		if node, ok := nodes[p.StorageNode]; ok && !placement.AllowedCountry(node.CountryCode) {
+			outOfPlacement = append(outOfPlacement, p.Number)
+		}
+	}
+	return outOfPlacement, nil
+}
+
 // DQNodesLastSeenBefore disqualifies nodes who have not been contacted since the cutoff time.
 func (service *Service) DQNodesLastSeenBefore(ctx context.Context, cutoff time.Time, limit int) (count int, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -790,6 +858,9 @@ func (service *Service) DQNodesLastSeenBefore(ctx context.Context, cutoff time.T
 	if err != nil {
 		return 0, err
 	}
+	for nodeID := range nodes {
+		service.publishDisqualified(ctx, nodeID, DisqualificationReasonNodeOffline)
+	}
 	if service.config.SendNodeEmails {
 		for nodeID, email := range nodes {
 			_, err = service.nodeEvents.Insert(ctx, email, nodeID, nodeevents.Disqualified)
@@ -808,6 +879,7 @@ func (service *Service) DisqualifyNode(ctx context.Context, nodeID storj.NodeID,
 	if err != nil {
 		return err
 	}
+	service.publishDisqualified(ctx, nodeID, reason)
 	if service.config.SendNodeEmails {
 		_, err = service.nodeEvents.Insert(ctx, email, nodeID, nodeevents.Disqualified)
 		if err != nil {
@@ -887,6 +959,26 @@ func (service *Service) TestNodeCountryCode(ctx context.Context, nodeID storj.No
 	return nil
 }
 
+// publishDisqualified publishes the disqualification of the node.
+func (service *Service) publishDisqualified(ctx context.Context, nodeID storj.NodeID, reason DisqualificationReason) {
+	var reasonName string
+	switch reason {
+	case DisqualificationReasonAuditFailure:
+		reasonName = "audit_failure"
+	case DisqualificationReasonSuspension:
+		reasonName = "suspension"
+	case DisqualificationReasonNodeOffline:
+		reasonName = "node_offline"
+	default:
+		reasonName = "unknown"
+	}
+
+	service.events.Publish(ctx, events.NodeDisqualified, map[string]string{
+		"node_id": nodeID.String(),
+		"reason":  reasonName,
+	})
+}
+
 func (service *Service) insertReputationNodeEvents(ctx context.Context, email string, id storj.NodeID, repEvents []nodeevents.Type) {
 	defer mon.Task()(&ctx)(nil)
 
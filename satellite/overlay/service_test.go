@@ -75,7 +75,7 @@ func testCache(ctx *testcontext.Context, t *testing.T, store overlay.DB, nodeEve
 
 	serviceCtx, serviceCancel := context.WithCancel(ctx)
 	defer serviceCancel()
-	service, err := overlay.NewService(zaptest.NewLogger(t), store, nodeEvents, nil, "", "", serviceConfig)
+	service, err := overlay.NewService(zaptest.NewLogger(t), store, nodeEvents, nil, nil, "", "", serviceConfig)
 	require.NoError(t, err)
 	ctx.Go(func() error { return service.Run(serviceCtx) })
 	defer ctx.Check(service.Close)
@@ -877,7 +877,7 @@ func TestReliable(t *testing.T) {
 		nodes, err = service.Reliable(ctx)
 		require.NoError(t, err)
 		require.Len(t, nodes, 1)
-		require.NotEqual(t, node.ID(), nodes[0])
+		require.NotEqual(t, node.ID(), nodes[0].ID)
 	})
 }
 
@@ -888,13 +888,18 @@ func TestKnownReliableInExcludedCountries(t *testing.T) {
 		service := planet.Satellites[0].Overlay.Service
 		node := planet.StorageNodes[0]
 
-		nodes, err := service.Reliable(ctx)
+		reliable, err := service.Reliable(ctx)
 		require.NoError(t, err)
-		require.Len(t, nodes, 2)
+		require.Len(t, reliable, 2)
 
 		err = planet.Satellites[0].Overlay.Service.TestNodeCountryCode(ctx, node.ID(), "FR")
 		require.NoError(t, err)
 
+		var nodes storj.NodeIDList
+		for _, reliableNode := range reliable {
+			nodes = append(nodes, reliableNode.ID)
+		}
+
 		// first node should be excluded from Reliable result because of country code
 		nodes, err = service.KnownReliableInExcludedCountries(ctx, nodes)
 		require.NoError(t, err)
@@ -119,9 +119,14 @@ func testDatabase(ctx context.Context, t *testing.T, cache overlay.DB) {
 		require.Contains(t, invalid, storj.NodeID{9}) // not in db
 		require.Len(t, invalid, 6)
 
-		valid, err := cache.Reliable(ctx, criteria)
+		reliable, err := cache.Reliable(ctx, criteria)
 		require.NoError(t, err)
 
+		var valid storj.NodeIDList
+		for _, node := range reliable {
+			valid = append(valid, node.ID)
+		}
+
 		require.NotContains(t, valid, storj.NodeID{2}) // disqualified
 		require.NotContains(t, valid, storj.NodeID{3}) // unknown audit suspended
 		require.NotContains(t, valid, storj.NodeID{4}) // offline
@@ -12,6 +12,7 @@ import (
 	"storj.io/common/pb"
 	"storj.io/common/storj"
 	"storj.io/common/sync2"
+	"storj.io/storj/private/nodecapabilities"
 	"storj.io/storj/satellite/nodeselection/uploadselection"
 )
 
@@ -94,6 +95,11 @@ func (cache *UploadSelectionCache) GetNodes(ctx context.Context, req FindStorage
 	}
 	state := stateAny.(*uploadselection.State)
 
+	var requires nodecapabilities.Requirements
+	if cache.selectionConfig.RequireCapabilities {
+		requires = req.Requires
+	}
+
 	selected, err := state.Select(ctx, uploadselection.Request{
 		Count:                req.RequestedCount,
 		NewFraction:          cache.selectionConfig.NewNodeFraction,
@@ -101,6 +107,7 @@ func (cache *UploadSelectionCache) GetNodes(ctx context.Context, req FindStorage
 		ExcludedIDs:          req.ExcludedIDs,
 		Placement:            req.Placement,
 		ExcludedCountryCodes: cache.selectionConfig.UploadExcludedCountryCodes,
+		Requires:             requires,
 	})
 	if uploadselection.ErrNotEnoughNodes.Has(err) {
 		err = ErrNotEnoughNodes.Wrap(err)
@@ -123,11 +130,12 @@ func (cache *UploadSelectionCache) Size(ctx context.Context) (reputableNodeCount
 func convNodesToSelectedNodes(nodes []*uploadselection.Node) (xs []*SelectedNode) {
 	for _, n := range nodes {
 		xs = append(xs, &SelectedNode{
-			ID:          n.ID,
-			Address:     &pb.NodeAddress{Address: n.Address},
-			LastNet:     n.LastNet,
-			LastIPPort:  n.LastIPPort,
-			CountryCode: n.CountryCode,
+			ID:           n.ID,
+			Address:      &pb.NodeAddress{Address: n.Address},
+			LastNet:      n.LastNet,
+			LastIPPort:   n.LastIPPort,
+			CountryCode:  n.CountryCode,
+			Capabilities: n.Capabilities,
 		})
 	}
 	return xs
@@ -140,9 +148,10 @@ func convSelectedNodesToNodes(nodes []*SelectedNode) (xs []*uploadselection.Node
 				ID:      n.ID,
 				Address: n.Address.Address,
 			},
-			LastNet:     n.LastNet,
-			LastIPPort:  n.LastIPPort,
-			CountryCode: n.CountryCode,
+			LastNet:      n.LastNet,
+			LastIPPort:   n.LastIPPort,
+			CountryCode:  n.CountryCode,
+			Capabilities: n.Capabilities,
 		})
 	}
 	return xs
@@ -0,0 +1,220 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package walletstats counts the storage nodes sharing a wallet
+// address, so that suspicious concentrations can be investigated for abuse.
+package walletstats
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+	"storj.io/storj/private/nodeoperator"
+	"storj.io/storj/satellite/overlay"
+)
+
+var (
+	// Error is the error class for wallet statistics.
+	Error = errs.Class("wallet statistics")
+
+	mon = monkit.Package()
+)
+
+// Config contains configurable values for the wallet statistics.
+type Config struct {
+	AlertThreshold int `help:"the number of active nodes sharing a wallet, from which the wallet is reported as suspicious" default:"20"`
+}
+
+// Wallet contains the active nodes sharing a wallet address.
+type Wallet struct {
+	// Address is the wallet address in lower case, so that the checksummed
+	// and the plain encoding of an address are counted together.
+	Address string `json:"address"`
+	Nodes   int    `json:"nodes"`
+	// Networks is the number of distinct /24 networks of the nodes.
+	Networks int            `json:"networks"`
+	NodeIDs  []storj.NodeID `json:"nodeIds"`
+}
+
+// Snapshot contains the suspicious wallets at a point in time.
+type Snapshot struct {
+	ComputedAt     time.Time `json:"computedAt"`
+	AlertThreshold int       `json:"alertThreshold"`
+
+	// ActiveNodes are the nodes, which are neither disqualified nor exited.
+	ActiveNodes int `json:"activeNodes"`
+	// DistinctWallets is the number of wallet addresses of the active nodes.
+	DistinctWallets int `json:"distinctWallets"`
+	// InvalidWallets is the number of active nodes with an invalid wallet
+	// address. These aren't grouped.
+	InvalidWallets int `json:"invalidWallets"`
+	// MaxNodesPerWallet is the largest number of active nodes sharing a wallet.
+	MaxNodesPerWallet int `json:"maxNodesPerWallet"`
+
+	// Suspicious are the wallets shared by at least AlertThreshold active
+	// nodes, ordered by the number of nodes descending.
+	Suspicious []Wallet `json:"suspicious"`
+}
+
+// Aggregator groups the active nodes by wallet address.
+type Aggregator struct {
+	now        time.Time
+	threshold  int
+	validation nodeoperator.WalletValidation
+
+	active   int
+	invalid  int
+	wallets  map[string][]storj.NodeID
+	networks map[string]map[string]struct{}
+}
+
+// NewAggregator creates an aggregator, which reports wallets shared by at
+// least threshold nodes.
+func NewAggregator(now time.Time, threshold int) *Aggregator {
+	return &Aggregator{
+		now:       now,
+		threshold: threshold,
+		wallets:   map[string][]storj.NodeID{},
+		networks:  map[string]map[string]struct{}{},
+	}
+}
+
+// Add adds the node to its wallet, when it's active.
+func (aggregator *Aggregator) Add(node *overlay.NodeDossier) {
+	if node.Disqualified != nil || node.ExitStatus.ExitFinishedAt != nil {
+		return
+	}
+	aggregator.active++
+
+	if err := aggregator.validation.Validate(node.Operator.Wallet); err != nil {
+		aggregator.invalid++
+		return
+	}
+
+	address := strings.ToLower(node.Operator.Wallet)
+	aggregator.wallets[address] = append(aggregator.wallets[address], node.Id)
+
+	networks, ok := aggregator.networks[address]
+	if !ok {
+		networks = map[string]struct{}{}
+		aggregator.networks[address] = networks
+	}
+	networks[node.LastNet] = struct{}{}
+}
+
+// Snapshot returns the suspicious wallets of the added nodes.
+func (aggregator *Aggregator) Snapshot() Snapshot {
+	snapshot := Snapshot{
+		ComputedAt:      aggregator.now,
+		AlertThreshold:  aggregator.threshold,
+		ActiveNodes:     aggregator.active,
+		DistinctWallets: len(aggregator.wallets),
+		InvalidWallets:  aggregator.invalid,
+		Suspicious:      []Wallet{},
+	}
+
+	for address, nodeIDs := range aggregator.wallets {
+		if len(nodeIDs) > snapshot.MaxNodesPerWallet {
+			snapshot.MaxNodesPerWallet = len(nodeIDs)
+		}
+		if len(nodeIDs) < aggregator.threshold {
+			continue
+		}
+
+		nodeIDs = append([]storj.NodeID(nil), nodeIDs...)
+		sort.Slice(nodeIDs, func(i, k int) bool { return nodeIDs[i].Less(nodeIDs[k]) })
+
+		snapshot.Suspicious = append(snapshot.Suspicious, Wallet{
+			Address:  address,
+			Nodes:    len(nodeIDs),
+			Networks: len(aggregator.networks[address]),
+			NodeIDs:  nodeIDs,
+		})
+	}
+
+	sort.Slice(snapshot.Suspicious, func(i, k int) bool {
+		a, b := snapshot.Suspicious[i], snapshot.Suspicious[k]
+		if a.Nodes != b.Nodes {
+			return a.Nodes > b.Nodes
+		}
+		return a.Address < b.Address
+	})
+
+	return snapshot
+}
+
+// Observer counts the nodes sharing a wallet from the nodes scanned by the
+// nodescan chore and alerts about the suspicious wallets.
+type Observer struct {
+	log    *zap.Logger
+	config Config
+
+	aggregator *Aggregator
+
+	mu       sync.RWMutex
+	snapshot *Snapshot
+}
+
+// NewObserver creates a new wallet statistics observer.
+func NewObserver(log *zap.Logger, config Config) *Observer {
+	return &Observer{
+		log:    log,
+		config: config,
+	}
+}
+
+// Start starts counting the wallets of a scan.
+func (observer *Observer) Start(ctx context.Context, now time.Time) error {
+	observer.aggregator = NewAggregator(now, observer.config.AlertThreshold)
+	return nil
+}
+
+// Visit adds the node to its wallet.
+func (observer *Observer) Visit(node *overlay.NodeDossier) {
+	observer.aggregator.Add(node)
+}
+
+// Finish logs a warning for every suspicious wallet and keeps them as the
+// latest snapshot.
+func (observer *Observer) Finish(ctx context.Context) error {
+	snapshot := observer.aggregator.Snapshot()
+	observer.aggregator = nil
+
+	mon.IntVal("wallet_distinct").Observe(int64(snapshot.DistinctWallets))
+	mon.IntVal("wallet_invalid_nodes").Observe(int64(snapshot.InvalidWallets))
+	mon.IntVal("wallet_max_nodes").Observe(int64(snapshot.MaxNodesPerWallet))
+	mon.IntVal("wallet_suspicious").Observe(int64(len(snapshot.Suspicious)))
+
+	for _, wallet := range snapshot.Suspicious {
+		observer.log.Warn("wallet shared by many nodes",
+			zap.String("Wallet", wallet.Address),
+			zap.Int("Nodes", wallet.Nodes),
+			zap.Int("Networks", wallet.Networks))
+	}
+
+	observer.mu.Lock()
+	observer.snapshot = &snapshot
+	observer.mu.Unlock()
+
+	return nil
+}
+
+// Latest returns the latest snapshot. ok is false, when the statistics
+// weren't computed yet.
+func (observer *Observer) Latest() (_ Snapshot, ok bool) {
+	observer.mu.RLock()
+	defer observer.mu.RUnlock()
+
+	if observer.snapshot == nil {
+		return Snapshot{}, false
+	}
+	return *observer.snapshot, true
+}
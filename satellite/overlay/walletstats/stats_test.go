@@ -0,0 +1,78 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package walletstats_test
+
+import (
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/storj"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite/overlay"
+	"storj.io/storj/satellite/overlay/walletstats"
+)
+
+func TestAggregator(t *testing.T) {
+	now := time.Date(2023, 2, 14, 8, 0, 0, 0, time.UTC)
+
+	shared := "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"
+	single := "0x" + strings.Repeat("11", 20)
+
+	node := func(wallet, lastNet string) *overlay.NodeDossier {
+		dossier := &overlay.NodeDossier{LastNet: lastNet}
+		dossier.Id = testrand.NodeID()
+		dossier.Operator.Wallet = wallet
+		return dossier
+	}
+
+	aggregator := walletstats.NewAggregator(now, 3)
+
+	var sharedIDs []storj.NodeID
+	for _, n := range []*overlay.NodeDossier{
+		node(shared, "10.0.1.0"),
+		node(shared, "10.0.1.0"),
+		// the plain encoding of the address is the same wallet.
+		node(strings.ToLower(shared), "10.0.2.0"),
+	} {
+		aggregator.Add(n)
+		sharedIDs = append(sharedIDs, n.Id)
+	}
+	sort.Slice(sharedIDs, func(i, k int) bool { return sharedIDs[i].Less(sharedIDs[k]) })
+
+	aggregator.Add(node(single, "10.0.3.0"))
+	aggregator.Add(node(single, "10.0.3.0"))
+	aggregator.Add(node("invalid", "10.0.4.0"))
+	aggregator.Add(node("", "10.0.4.0"))
+
+	disqualified := node(shared, "10.0.5.0")
+	disqualified.Disqualified = &now
+	aggregator.Add(disqualified)
+
+	exited := node(shared, "10.0.5.0")
+	exited.ExitStatus.ExitFinishedAt = &now
+	aggregator.Add(exited)
+
+	require.Equal(t, walletstats.Snapshot{
+		ComputedAt:        now,
+		AlertThreshold:    3,
+		ActiveNodes:       7,
+		DistinctWallets:   2,
+		InvalidWallets:    2,
+		MaxNodesPerWallet: 3,
+		Suspicious: []walletstats.Wallet{{
+			Address:  strings.ToLower(shared),
+			Nodes:    3,
+			Networks: 2,
+			NodeIDs:  sharedIDs,
+		}},
+	}, aggregator.Snapshot())
+
+	empty := walletstats.NewAggregator(now, 3).Snapshot()
+	require.Empty(t, empty.Suspicious)
+	require.NotNil(t, empty.Suspicious)
+}
@@ -0,0 +1,42 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package payments
+
+import (
+	"time"
+
+	"storj.io/common/uuid"
+)
+
+// CostEstimate is an itemized estimate of what the user will be invoiced for
+// the usage of the billing period. The discount and the credit are an
+// approximation, the final amounts are calculated by Stripe when the invoice
+// is finalized.
+type CostEstimate struct {
+	Since  time.Time `json:"since"`
+	Before time.Time `json:"before"`
+
+	Items []CostEstimateItem `json:"items"`
+	// Subtotal shows how many cents the items cost before discounts.
+	Subtotal int64 `json:"subtotal"`
+	// Coupon is the coupon, which will be applied to the invoice, if any.
+	Coupon *Coupon `json:"coupon"`
+	// Discount shows how many cents are taken off by the coupon.
+	Discount int64 `json:"discount"`
+	// Credit shows how many cents are taken off by the credit balance.
+	Credit int64 `json:"credit"`
+	// Total shows how many cents the invoice will be charged.
+	Total int64 `json:"total"`
+}
+
+// CostEstimateItem is a single line item of a cost estimate.
+type CostEstimateItem struct {
+	ProjectID   uuid.UUID `json:"projectId"`
+	Description string    `json:"description"`
+	Quantity    int64     `json:"quantity"`
+	// UnitPrice shows how many cents a single unit costs.
+	UnitPrice float64 `json:"unitPrice"`
+	// Amount shows how many cents the item costs.
+	Amount int64 `json:"amount"`
+}
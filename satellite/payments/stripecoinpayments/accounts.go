@@ -7,6 +7,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/shopspring/decimal"
 	"github.com/stripe/stripe-go/v72"
 	"github.com/zeebo/errs"
 
@@ -140,6 +141,126 @@ func (accounts *accounts) ProjectCharges(ctx context.Context, userID uuid.UUID,
 	return charges, nil
 }
 
+// EstimateCost returns an itemized estimate of what the user will be invoiced for the current billing period.
+//
+// The items are calculated the same way as when the invoice items are generated. The discount and the credit
+// are an approximation of what Stripe applies when the invoice is finalized: the coupon and the credit balance
+// of the customer are taken as they are now, and payments with STORJ tokens, which are applied to the invoice
+// as credit notes, aren't included.
+func (accounts *accounts) EstimateCost(ctx context.Context, userID uuid.UUID) (estimate payments.CostEstimate, err error) {
+	defer mon.Task()(&ctx, userID)(&err)
+
+	now := accounts.service.nowFn().UTC()
+	year, month, _ := now.Date()
+	estimate.Since = time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	estimate.Before = now
+	// to return empty slice instead of nil if there are no items
+	estimate.Items = make([]payments.CostEstimateItem, 0)
+
+	projects, err := accounts.service.projectsDB.GetOwn(ctx, userID)
+	if err != nil {
+		return payments.CostEstimate{}, Error.Wrap(err)
+	}
+
+	for _, project := range projects {
+		usage, err := accounts.service.usageDB.GetProjectTotal(ctx, project.ID, estimate.Since, estimate.Before)
+		if err != nil {
+			return payments.CostEstimate{}, Error.Wrap(err)
+		}
+
+		record := ProjectRecord{
+			ProjectID: project.ID,
+			Storage:   usage.Storage,
+			Egress:    usage.Egress,
+			Segments:  usage.SegmentCount,
+		}
+		if accounts.service.skipEmptyInvoices && doesProjectRecordHaveNoUsage(record) {
+			continue
+		}
+
+		for _, item := range accounts.service.usageItems(project.Name, record) {
+			unitPrice, _ := item.UnitPrice.Float64()
+			estimate.Items = append(estimate.Items, payments.CostEstimateItem{
+				ProjectID:   project.ID,
+				Description: item.Description,
+				Quantity:    item.Quantity,
+				UnitPrice:   unitPrice,
+				Amount:      item.Amount(),
+			})
+			estimate.Subtotal += item.Amount()
+		}
+	}
+
+	estimate.Total = estimate.Subtotal
+
+	customerID, err := accounts.service.db.Customers().GetCustomerID(ctx, userID)
+	if err != nil {
+		if errs.Is(err, ErrNoCustomer) {
+			return estimate, nil
+		}
+		return payments.CostEstimate{}, Error.Wrap(err)
+	}
+
+	params := &stripe.CustomerParams{}
+	params.AddExpand("discount.promotion_code")
+
+	customer, err := accounts.service.stripeClient.Customers().Get(customerID, params)
+	if err != nil {
+		return payments.CostEstimate{}, Error.Wrap(err)
+	}
+
+	// the invoice is created, when the billing period ends.
+	invoicedAt := estimate.Since.AddDate(0, 1, 0)
+	if customer.Discount != nil && customer.Discount.Coupon != nil {
+		coupon, err := stripeDiscountToPaymentsCoupon(customer.Discount)
+		if err != nil {
+			return payments.CostEstimate{}, Error.Wrap(err)
+		}
+		if couponAppliesAt(coupon, invoicedAt) {
+			estimate.Coupon = coupon
+			estimate.Discount = couponDiscount(coupon, estimate.Subtotal)
+		}
+	}
+	estimate.Total -= estimate.Discount
+
+	// a negative balance is a credit, which Stripe applies to the next invoice.
+	if customer.Balance < 0 {
+		estimate.Credit = -customer.Balance
+		if estimate.Credit > estimate.Total {
+			estimate.Credit = estimate.Total
+		}
+	}
+	estimate.Total -= estimate.Credit
+
+	return estimate, nil
+}
+
+// couponAppliesAt returns whether the coupon is still valid at the specified time.
+func couponAppliesAt(coupon *payments.Coupon, at time.Time) bool {
+	// coupons without an end are converted to the unix epoch.
+	if coupon.ExpiresAt.Unix() <= 0 {
+		return true
+	}
+	return coupon.ExpiresAt.After(at)
+}
+
+// couponDiscount returns how many cents the coupon takes off the subtotal. Stripe rounds the
+// percentage discounts to the nearest cent as well.
+func couponDiscount(coupon *payments.Coupon, subtotal int64) int64 {
+	if subtotal <= 0 {
+		return 0
+	}
+
+	discount := coupon.AmountOff
+	if discount == 0 {
+		discount = decimal.NewFromInt(subtotal).Mul(decimal.NewFromFloat(coupon.PercentOff)).Shift(-2).Round(0).IntPart()
+	}
+	if discount > subtotal {
+		return subtotal
+	}
+	return discount
+}
+
 // CheckProjectInvoicingStatus returns error if for the given project there are outstanding project records and/or usage
 // which have not been applied/invoiced yet (meaning sent over to stripe).
 func (accounts *accounts) CheckProjectInvoicingStatus(ctx context.Context, projectID uuid.UUID) (err error) {
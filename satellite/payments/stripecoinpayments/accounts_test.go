@@ -8,8 +8,10 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/require"
+	"github.com/stripe/stripe-go/v72"
 	"go.uber.org/zap/zaptest"
 
+	"storj.io/common/memory"
 	"storj.io/common/testcontext"
 	"storj.io/common/testrand"
 	"storj.io/storj/private/testplanet"
@@ -79,7 +81,8 @@ func TestSignupCouponCodes(t *testing.T) {
 			db.ProjectAccounting(),
 			prices,
 			priceOverrides,
-			pc.BonusRate)
+			pc.BonusRate,
+			nil)
 		require.NoError(t, err)
 
 		service, err := console.NewService(
@@ -89,6 +92,8 @@ func TestSignupCouponCodes(t *testing.T) {
 			db.ProjectAccounting(),
 			projectUsage,
 			sat.API.Buckets.Service,
+			sat.Metabase.DB,
+			db.Revocation(),
 			partnersService,
 			paymentsService.Accounts(),
 			// TODO: do we need a payment deposit wallet here?
@@ -141,3 +146,88 @@ func TestSignupCouponCodes(t *testing.T) {
 		}
 	})
 }
+
+func TestAccounts_EstimateCost(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		paymentsAPI := sat.API.Payments
+
+		// pick a specific date so that the usage is within the billing period.
+		period := time.Date(time.Now().Year(), time.Now().Month()+1, 10, 0, 0, 0, 0, time.UTC)
+		now := period.Add(48 * time.Hour)
+		paymentsAPI.StripeService.SetNow(func() time.Time { return now })
+
+		user, err := sat.AddUser(ctx, console.CreateUser{
+			FullName: "Test User",
+			Email:    "test@mail.test",
+		}, 1)
+		require.NoError(t, err)
+
+		project, err := sat.AddProject(ctx, user.ID, "testproject")
+		require.NoError(t, err)
+
+		generateProjectStorage(ctx, t, sat.DB, project.ID, period, period.Add(24*time.Hour), 10*memory.GB.Int64(), 100*memory.GB.Int64(), 10000)
+
+		accounts := paymentsAPI.Accounts
+		estimate, err := accounts.EstimateCost(ctx, user.ID)
+		require.NoError(t, err)
+		require.Equal(t, time.Date(period.Year(), period.Month(), 1, 0, 0, 0, 0, time.UTC), estimate.Since)
+		require.Equal(t, now, estimate.Before)
+		require.Len(t, estimate.Items, 3)
+
+		// the amounts match the project charges.
+		charges, err := accounts.ProjectCharges(ctx, user.ID, estimate.Since, estimate.Before)
+		require.NoError(t, err)
+		require.Len(t, charges, 1)
+		require.Equal(t, charges[0].StorageGbHrs, estimate.Items[0].Amount)
+		require.Equal(t, charges[0].Egress, estimate.Items[1].Amount)
+		require.Equal(t, charges[0].SegmentCount, estimate.Items[2].Amount)
+
+		var subtotal int64
+		for _, item := range estimate.Items {
+			require.Equal(t, project.ID, item.ProjectID)
+			require.Contains(t, item.Description, project.Name)
+			subtotal += item.Amount
+		}
+		require.NotZero(t, subtotal)
+		require.Equal(t, subtotal, estimate.Subtotal)
+		require.Equal(t, estimate.Subtotal-estimate.Discount, estimate.Total)
+
+		// the coupon is taken off the total.
+		_, err = accounts.Coupons().ApplyCouponCode(ctx, user.ID, "promo2")
+		require.NoError(t, err)
+
+		estimate, err = accounts.EstimateCost(ctx, user.ID)
+		require.NoError(t, err)
+		require.NotNil(t, estimate.Coupon)
+		require.Equal(t, subtotal, estimate.Subtotal)
+		require.Equal(t, (subtotal+1)/2, estimate.Discount)
+		require.Equal(t, subtotal-estimate.Discount, estimate.Total)
+
+		// the credit balance of the customer is taken off the total.
+		customerID, err := sat.DB.StripeCoinPayments().Customers().GetCustomerID(ctx, user.ID)
+		require.NoError(t, err)
+		_, err = paymentsAPI.StripeClient.Customers().Update(customerID, &stripe.CustomerParams{
+			Balance: stripe.Int64(-1),
+		})
+		require.NoError(t, err)
+
+		estimate, err = accounts.EstimateCost(ctx, user.ID)
+		require.NoError(t, err)
+		require.EqualValues(t, 1, estimate.Credit)
+		require.Equal(t, subtotal-estimate.Discount-1, estimate.Total)
+
+		// the credit isn't larger than the total.
+		_, err = paymentsAPI.StripeClient.Customers().Update(customerID, &stripe.CustomerParams{
+			Balance: stripe.Int64(-subtotal),
+		})
+		require.NoError(t, err)
+
+		estimate, err = accounts.EstimateCost(ctx, user.ID)
+		require.NoError(t, err)
+		require.Equal(t, subtotal-estimate.Discount, estimate.Credit)
+		require.Zero(t, estimate.Total)
+	})
+}
@@ -22,6 +22,7 @@ import (
 	"storj.io/common/uuid"
 	"storj.io/storj/satellite/accounting"
 	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/events"
 	"storj.io/storj/satellite/payments"
 	"storj.io/storj/satellite/payments/billing"
 	"storj.io/storj/satellite/payments/storjscan"
@@ -63,6 +64,7 @@ type Service struct {
 	projectsDB   console.Projects
 	usageDB      accounting.ProjectAccounting
 	stripeClient StripeClient
+	events       *events.Bus
 
 	usagePrices         ProjectUsagePriceModel
 	usagePriceOverrides map[string]ProjectUsagePriceModel
@@ -87,7 +89,7 @@ type ProjectUsagePriceModel struct {
 }
 
 // NewService creates a Service instance.
-func NewService(log *zap.Logger, stripeClient StripeClient, config Config, db DB, walletsDB storjscan.WalletsDB, billingDB billing.TransactionsDB, projectsDB console.Projects, usageDB accounting.ProjectAccounting, usagePrices ProjectUsagePriceModel, usagePriceOverrides map[string]ProjectUsagePriceModel, bonusRate int64) (*Service, error) {
+func NewService(log *zap.Logger, stripeClient StripeClient, config Config, db DB, walletsDB storjscan.WalletsDB, billingDB billing.TransactionsDB, projectsDB console.Projects, usageDB accounting.ProjectAccounting, usagePrices ProjectUsagePriceModel, usagePriceOverrides map[string]ProjectUsagePriceModel, bonusRate int64, eventBus *events.Bus) (*Service, error) {
 	return &Service{
 		log:                    log,
 		db:                     db,
@@ -96,6 +98,7 @@ func NewService(log *zap.Logger, stripeClient StripeClient, config Config, db DB
 		projectsDB:             projectsDB,
 		usageDB:                usageDB,
 		stripeClient:           stripeClient,
+		events:                 eventBus,
 		usagePrices:            usagePrices,
 		usagePriceOverrides:    usagePriceOverrides,
 		BonusRate:              bonusRate,
@@ -502,31 +505,55 @@ func (service *Service) createInvoiceItems(ctx context.Context, cusID, projName
 
 // InvoiceItemsFromProjectRecord calculates Stripe invoice item from project record.
 func (service *Service) InvoiceItemsFromProjectRecord(projName string, record ProjectRecord) (result []*stripe.InvoiceItemParams) {
-	projectItem := &stripe.InvoiceItemParams{}
-	projectItem.Description = stripe.String(fmt.Sprintf("Project %s - Segment Storage (MB-Month)", projName))
-	projectItem.Quantity = stripe.Int64(storageMBMonthDecimal(record.Storage).IntPart())
-	storagePrice, _ := service.usagePrices.StorageMBMonthCents.Float64()
-	projectItem.UnitAmountDecimal = stripe.Float64(storagePrice)
-	result = append(result, projectItem)
-
-	projectItem = &stripe.InvoiceItemParams{}
-	projectItem.Description = stripe.String(fmt.Sprintf("Project %s - Egress Bandwidth (MB)", projName))
-	projectItem.Quantity = stripe.Int64(egressMBDecimal(record.Egress).IntPart())
-	egressPrice, _ := service.usagePrices.EgressMBCents.Float64()
-	projectItem.UnitAmountDecimal = stripe.Float64(egressPrice)
-	result = append(result, projectItem)
-
-	projectItem = &stripe.InvoiceItemParams{}
-	projectItem.Description = stripe.String(fmt.Sprintf("Project %s - Segment Fee (Segment-Month)", projName))
-	projectItem.Quantity = stripe.Int64(segmentMonthDecimal(record.Segments).IntPart())
-	segmentPrice, _ := service.usagePrices.SegmentMonthCents.Float64()
-	projectItem.UnitAmountDecimal = stripe.Float64(segmentPrice)
-	result = append(result, projectItem)
+	for _, usage := range service.usageItems(projName, record) {
+		projectItem := &stripe.InvoiceItemParams{}
+		projectItem.Description = stripe.String(usage.Description)
+		projectItem.Quantity = stripe.Int64(usage.Quantity)
+		unitPrice, _ := usage.UnitPrice.Float64()
+		projectItem.UnitAmountDecimal = stripe.Float64(unitPrice)
+		result = append(result, projectItem)
+	}
 	service.log.Info("invoice items", zap.Any("result", result))
 
 	return result
 }
 
+// usageItem is a usage line item of an invoice.
+type usageItem struct {
+	Description string
+	Quantity    int64
+	// UnitPrice is the price of a single unit in cents.
+	UnitPrice decimal.Decimal
+}
+
+// Amount returns the price of the item rounded to cents, the same way as
+// Stripe rounds the amount of invoice items.
+func (item usageItem) Amount() int64 {
+	return item.UnitPrice.Mul(decimal.NewFromInt(item.Quantity)).Round(0).IntPart()
+}
+
+// usageItems returns the invoice line items for the usage of the project
+// record.
+func (service *Service) usageItems(projName string, record ProjectRecord) []usageItem {
+	return []usageItem{
+		{
+			Description: fmt.Sprintf("Project %s - Segment Storage (MB-Month)", projName),
+			Quantity:    storageMBMonthDecimal(record.Storage).IntPart(),
+			UnitPrice:   service.usagePrices.StorageMBMonthCents,
+		},
+		{
+			Description: fmt.Sprintf("Project %s - Egress Bandwidth (MB)", projName),
+			Quantity:    egressMBDecimal(record.Egress).IntPart(),
+			UnitPrice:   service.usagePrices.EgressMBCents,
+		},
+		{
+			Description: fmt.Sprintf("Project %s - Segment Fee (Segment-Month)", projName),
+			Quantity:    segmentMonthDecimal(record.Segments).IntPart(),
+			UnitPrice:   service.usagePrices.SegmentMonthCents,
+		},
+	}
+}
+
 // ApplyFreeTierCoupons iterates through all customers in Stripe. For each customer,
 // if that customer does not currently have a Stripe coupon, the free tier Stripe coupon
 // is applied.
@@ -715,6 +742,17 @@ func (service *Service) FinalizeInvoices(ctx context.Context) (err error) {
 		if err != nil {
 			return Error.Wrap(err)
 		}
+
+		var customerID string
+		if stripeInvoice.Customer != nil {
+			customerID = stripeInvoice.Customer.ID
+		}
+		service.events.Publish(ctx, events.InvoiceFinalized, map[string]string{
+			"invoice_id":  stripeInvoice.ID,
+			"customer_id": customerID,
+			"total":       strconv.FormatInt(stripeInvoice.Total, 10),
+			"currency":    string(stripeInvoice.Currency),
+		})
 	}
 
 	return Error.Wrap(invoicesIterator.Err())
@@ -306,6 +306,9 @@ func (m *mockCustomers) Update(id string, params *stripe.CustomerParams) (*strip
 	if params.Coupon != nil {
 		customer.Discount = &stripe.Discount{Coupon: &stripe.Coupon{ID: *params.Coupon}}
 	}
+	if params.Balance != nil {
+		customer.Balance = *params.Balance
+	}
 
 	// TODO update customer with more params as necessary
 
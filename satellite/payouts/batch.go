@@ -0,0 +1,138 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package payouts
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"storj.io/common/strictcsv"
+	"storj.io/common/uuid"
+	"storj.io/storj/private/currency"
+	"storj.io/storj/satellite/compensation"
+)
+
+// Network is a layer 2 network, which the payout batches are paid on. The
+// network is also the wallet feature, which nodes use to opt in to it.
+type Network string
+
+const (
+	// ZkSync is the zkSync Lite network.
+	ZkSync Network = "zksync"
+	// ZkSyncEra is the zkSync Era network.
+	ZkSyncEra Network = "zksync-era"
+)
+
+// NetworkFromString parses the name of a network.
+func NetworkFromString(s string) (Network, error) {
+	network := Network(s)
+	switch network {
+	case ZkSync, ZkSyncEra:
+		return network, nil
+	default:
+		return "", Error.New("unsupported network %q", s)
+	}
+}
+
+// Receipt returns the payment receipt of a transaction on the network, in
+// the format, which the nodes use to link the transaction.
+func (network Network) Receipt(transactionHash string) string {
+	return string(network) + ":" + transactionHash
+}
+
+// BatchStatus is the state of a payout batch.
+type BatchStatus string
+
+const (
+	// BatchPending is a batch, which is exported but not submitted yet.
+	BatchPending BatchStatus = "pending"
+	// BatchSubmitted is a batch, whose transaction is waiting for confirmation.
+	BatchSubmitted BatchStatus = "submitted"
+	// BatchConfirmed is a batch, whose payments are recorded.
+	BatchConfirmed BatchStatus = "confirmed"
+	// BatchFailed is a batch, whose transaction failed. Its nodes are
+	// included in the next batch of the period.
+	BatchFailed BatchStatus = "failed"
+)
+
+// Batch is a batch of payments to the nodes for a period, which is submitted
+// as a single transaction on a layer 2 network.
+type Batch struct {
+	ID              uuid.UUID
+	Period          compensation.Period
+	Network         Network
+	Status          BatchStatus
+	TransactionHash string
+
+	CreatedAt   time.Time
+	SubmittedAt *time.Time
+	ConfirmedAt *time.Time
+}
+
+// BatchEntry is the payment to a single node in a batch.
+type BatchEntry struct {
+	NodeID compensation.NodeID `csv:"node-id"`
+	Wallet string              `csv:"wallet"`
+	Amount currency.MicroUnit  `csv:"amount"`
+}
+
+// Payable is the amount, which is paid but not distributed yet to a node for
+// a period.
+type Payable struct {
+	NodeID compensation.NodeID
+	Amount currency.MicroUnit
+}
+
+// SelectEntries selects the entries of a batch from the payable amounts. An
+// entry must pay the whole payable amount of its node.
+type SelectEntries func(ctx context.Context, payables []Payable) ([]BatchEntry, error)
+
+// WriteBatchEntries writes the entries of a batch in CSV form, which is the
+// input of the batch payment tooling of the network.
+func WriteBatchEntries(w io.Writer, entries []BatchEntry) error {
+	return strictcsv.Write(w, entries)
+}
+
+// ReadBatchEntries reads the entries of a batch in CSV form.
+func ReadBatchEntries(r io.Reader) ([]BatchEntry, error) {
+	var entries []BatchEntry
+	if err := strictcsv.Read(r, &entries); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// BatchDB stores the payout batches.
+//
+// architecture: Database
+type BatchDB interface {
+	// ListPayable returns the amounts of the period, which are paid but not
+	// distributed yet, for the nodes, which aren't part of a batch of the
+	// period, unless the batch failed.
+	ListPayable(ctx context.Context, period compensation.Period) ([]Payable, error)
+
+	// CreateBatch creates a pending batch of the amounts of the period, which
+	// are payable, and returns its entries. The entries are selected from the
+	// payable amounts by selectEntries. Listing the amounts and creating the
+	// batch happen in a single transaction, and it fails with ErrBatchConflict
+	// when a node is already part of a batch of the period, which didn't
+	// fail. No batch is created when no entries are selected.
+	CreateBatch(ctx context.Context, batch Batch, selectEntries SelectEntries) ([]BatchEntry, error)
+	// GetBatch returns a batch.
+	GetBatch(ctx context.Context, id uuid.UUID) (Batch, error)
+	// ListBatches returns the batches with the status, oldest first.
+	ListBatches(ctx context.Context, status BatchStatus) ([]Batch, error)
+	// ListBatchEntries returns the entries of a batch.
+	ListBatchEntries(ctx context.Context, id uuid.UUID) ([]BatchEntry, error)
+
+	// SubmitBatch marks a pending batch as submitted with the transaction.
+	SubmitBatch(ctx context.Context, id uuid.UUID, transactionHash string, submittedAt time.Time) error
+	// ConfirmBatch marks a submitted batch as confirmed, and records the
+	// payments of its entries with the receipt against the paystubs.
+	ConfirmBatch(ctx context.Context, id uuid.UUID, receipt string, confirmedAt time.Time) error
+	// FailBatch marks a submitted batch as failed, so that its nodes may be
+	// part of a new batch of the period.
+	FailBatch(ctx context.Context, id uuid.UUID) error
+}
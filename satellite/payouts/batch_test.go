@@ -0,0 +1,329 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package payouts_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/common/uuid"
+	"storj.io/storj/private/currency"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/compensation"
+	"storj.io/storj/satellite/payouts"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestNetwork(t *testing.T) {
+	network, err := payouts.NetworkFromString("zksync-era")
+	require.NoError(t, err)
+	require.Equal(t, payouts.ZkSyncEra, network)
+	require.Equal(t, "zksync-era:0x1234", network.Receipt("0x1234"))
+
+	_, err = payouts.NetworkFromString("polygon")
+	require.Error(t, err)
+}
+
+func TestBatchEntriesCSV(t *testing.T) {
+	entries := []payouts.BatchEntry{
+		{
+			NodeID: compensation.NodeID(testrand.NodeID()),
+			Wallet: "0x0123456789abcdef0123456789abcdef01234567",
+			Amount: currency.NewMicroUnit(1234567),
+		},
+		{
+			NodeID: compensation.NodeID(testrand.NodeID()),
+			Wallet: "0x76543210fedcba9876543210fedcba9876543210",
+			Amount: currency.NewMicroUnit(1),
+		},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, payouts.WriteBatchEntries(&buf, entries))
+
+	read, err := payouts.ReadBatchEntries(&buf)
+	require.NoError(t, err)
+	require.Equal(t, entries, read)
+}
+
+func TestClientTransactionStatus(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	receipts := map[string]string{
+		"0xconfirmed": `{"jsonrpc":"2.0","id":1,"result":{"status":"0x1"}}`,
+		"0xfailed":    `{"jsonrpc":"2.0","id":1,"result":{"status":"0x0"}}`,
+		"0xpending":   `{"jsonrpc":"2.0","id":1,"result":null}`,
+		"0xerror":     `{"jsonrpc":"2.0","id":1,"error":{"code":-32602,"message":"invalid params"}}`,
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Method string   `json:"method"`
+			Params []string `json:"params"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if req.Method != "eth_getTransactionReceipt" || len(req.Params) != 1 {
+			http.Error(w, "unexpected request", http.StatusBadRequest)
+			return
+		}
+		_, _ = w.Write([]byte(receipts[req.Params[0]]))
+	}))
+	defer server.Close()
+
+	client := payouts.NewClient(server.URL)
+
+	status, err := client.TransactionStatus(ctx, "0xconfirmed")
+	require.NoError(t, err)
+	require.Equal(t, payouts.TransactionConfirmed, status)
+
+	status, err = client.TransactionStatus(ctx, "0xfailed")
+	require.NoError(t, err)
+	require.Equal(t, payouts.TransactionFailed, status)
+
+	status, err = client.TransactionStatus(ctx, "0xpending")
+	require.NoError(t, err)
+	require.Equal(t, payouts.TransactionPending, status)
+
+	_, err = client.TransactionStatus(ctx, "0xerror")
+	require.True(t, payouts.ClientErr.Has(err))
+}
+
+func TestBatchDB(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		batches := db.PayoutBatches()
+		period := compensation.Period{Year: 2023, Month: 1}
+		nodeID := testrand.NodeID()
+
+		require.NoError(t, db.Compensation().RecordPeriod(ctx, []compensation.Paystub{{
+			Period: period,
+			NodeID: compensation.NodeID(nodeID),
+			Codes:  compensation.Codes{},
+			Paid:   currency.NewMicroUnit(1000),
+		}}, nil))
+
+		payables, err := batches.ListPayable(ctx, period)
+		require.NoError(t, err)
+		require.Equal(t, []payouts.Payable{{
+			NodeID: compensation.NodeID(nodeID),
+			Amount: currency.NewMicroUnit(1000),
+		}}, payables)
+
+		_, err = batches.GetBatch(ctx, testrand.UUID())
+		require.True(t, payouts.ErrBatchNotFound.Has(err))
+
+		now := time.Now().UTC().Truncate(time.Second)
+		batch := payouts.Batch{
+			ID:        testrand.UUID(),
+			Period:    period,
+			Network:   payouts.ZkSyncEra,
+			CreatedAt: now,
+		}
+		entries := []payouts.BatchEntry{{
+			NodeID: compensation.NodeID(nodeID),
+			Wallet: "0x0123456789abcdef0123456789abcdef01234567",
+			Amount: currency.NewMicroUnit(1000),
+		}}
+		created, err := batches.CreateBatch(ctx, batch, selectEntries(entries))
+		require.NoError(t, err)
+		require.Equal(t, entries, created)
+
+		// the node is part of a batch, so it isn't payable anymore.
+		payables, err = batches.ListPayable(ctx, period)
+		require.NoError(t, err)
+		require.Empty(t, payables)
+
+		// and it can't be part of another batch of the period.
+		_, err = batches.CreateBatch(ctx, payouts.Batch{
+			ID:        testrand.UUID(),
+			Period:    period,
+			Network:   payouts.ZkSyncEra,
+			CreatedAt: now,
+		}, selectEntries(entries))
+		require.True(t, payouts.ErrBatchConflict.Has(err))
+
+		listed, err := batches.ListBatchEntries(ctx, batch.ID)
+		require.NoError(t, err)
+		require.Equal(t, entries, listed)
+
+		// only submitted batches can be confirmed.
+		err = batches.ConfirmBatch(ctx, batch.ID, payouts.ZkSyncEra.Receipt("0x1234"), now)
+		require.True(t, payouts.ErrBatchStatus.Has(err))
+
+		require.NoError(t, batches.SubmitBatch(ctx, batch.ID, "0x1234", now))
+		err = batches.SubmitBatch(ctx, batch.ID, "0x1234", now)
+		require.True(t, payouts.ErrBatchStatus.Has(err))
+
+		submitted, err := batches.ListBatches(ctx, payouts.BatchSubmitted)
+		require.NoError(t, err)
+		require.Len(t, submitted, 1)
+		require.Equal(t, "0x1234", submitted[0].TransactionHash)
+
+		require.NoError(t, batches.ConfirmBatch(ctx, batch.ID, payouts.ZkSyncEra.Receipt("0x1234"), now))
+
+		confirmed, err := batches.GetBatch(ctx, batch.ID)
+		require.NoError(t, err)
+		require.Equal(t, payouts.BatchConfirmed, confirmed.Status)
+		require.NotNil(t, confirmed.ConfirmedAt)
+
+		payment, err := db.SNOPayouts().GetPayment(ctx, nodeID, period.String())
+		require.NoError(t, err)
+		require.Equal(t, "zksync-era:0x1234", payment.Receipt)
+		require.Equal(t, int64(1000), payment.Amount)
+
+		totals, err := db.Compensation().QueryTotalAmounts(ctx, nodeID)
+		require.NoError(t, err)
+		require.Equal(t, currency.NewMicroUnit(1000), totals.TotalDistributed)
+	})
+}
+
+func TestBatchServiceCheckBatches(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		batches := db.PayoutBatches()
+		period := compensation.Period{Year: 2023, Month: 1}
+
+		checker := transactionChecker{
+			"0xconfirmed": payouts.TransactionConfirmed,
+			"0xfailed":    payouts.TransactionFailed,
+		}
+		service := payouts.NewBatchService(zaptest.NewLogger(t), batches, db.OverlayCache(), checker)
+
+		create := func(nodeID compensation.NodeID, transactionHash string) uuid.UUID {
+			batch := payouts.Batch{
+				ID:        testrand.UUID(),
+				Period:    period,
+				Network:   payouts.ZkSyncEra,
+				CreatedAt: time.Now(),
+			}
+			_, err := batches.CreateBatch(ctx, batch, selectEntries([]payouts.BatchEntry{{
+				NodeID: nodeID,
+				Wallet: "0x0123456789abcdef0123456789abcdef01234567",
+				Amount: currency.NewMicroUnit(1),
+			}}))
+			require.NoError(t, err)
+			require.NoError(t, service.SubmitBatch(ctx, batch.ID, transactionHash))
+			return batch.ID
+		}
+
+		var nodeIDs []compensation.NodeID
+		var paystubs []compensation.Paystub
+		for i := 0; i < 3; i++ {
+			nodeID := compensation.NodeID(testrand.NodeID())
+			nodeIDs = append(nodeIDs, nodeID)
+			paystubs = append(paystubs, compensation.Paystub{
+				Period: period,
+				NodeID: nodeID,
+				Codes:  compensation.Codes{},
+				Paid:   currency.NewMicroUnit(1),
+			})
+		}
+		require.NoError(t, db.Compensation().RecordPeriod(ctx, paystubs, nil))
+
+		confirmedID := create(nodeIDs[0], "0xconfirmed")
+		failedID := create(nodeIDs[1], "0xfailed")
+		pendingID := create(nodeIDs[2], "0xpending")
+
+		confirmed, failed, err := service.CheckBatches(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 1, confirmed)
+		require.Equal(t, 1, failed)
+
+		for id, status := range map[uuid.UUID]payouts.BatchStatus{
+			confirmedID: payouts.BatchConfirmed,
+			failedID:    payouts.BatchFailed,
+			pendingID:   payouts.BatchSubmitted,
+		} {
+			batch, err := batches.GetBatch(ctx, id)
+			require.NoError(t, err)
+			require.Equal(t, status, batch.Status)
+		}
+
+		// the node of the failed batch is payable again.
+		payables, err := batches.ListPayable(ctx, period)
+		require.NoError(t, err)
+		require.Equal(t, []payouts.Payable{{
+			NodeID: nodeIDs[1],
+			Amount: currency.NewMicroUnit(1),
+		}}, payables)
+		create(nodeIDs[1], "0xretried")
+	})
+}
+
+func TestBatchDBConcurrentCreate(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		batches := db.PayoutBatches()
+		period := compensation.Period{Year: 2023, Month: 1}
+		nodeID := compensation.NodeID(testrand.NodeID())
+
+		require.NoError(t, db.Compensation().RecordPeriod(ctx, []compensation.Paystub{{
+			Period: period,
+			NodeID: nodeID,
+			Codes:  compensation.Codes{},
+			Paid:   currency.NewMicroUnit(1000),
+		}}, nil))
+
+		newBatch := func() payouts.Batch {
+			return payouts.Batch{
+				ID:        testrand.UUID(),
+				Period:    period,
+				Network:   payouts.ZkSyncEra,
+				CreatedAt: time.Now(),
+			}
+		}
+		selectAll := func(ctx context.Context, payables []payouts.Payable) (entries []payouts.BatchEntry, err error) {
+			for _, payable := range payables {
+				entries = append(entries, payouts.BatchEntry{
+					NodeID: payable.NodeID,
+					Wallet: "0x0123456789abcdef0123456789abcdef01234567",
+					Amount: payable.Amount,
+				})
+			}
+			return entries, nil
+		}
+
+		// another export creates a batch with the node, after this export
+		// listed the node as payable, but before it created its batch.
+		interleaved := false
+		_, err := batches.CreateBatch(ctx, newBatch(), func(ctx context.Context, payables []payouts.Payable) ([]payouts.BatchEntry, error) {
+			if !interleaved {
+				interleaved = true
+				entries, err := batches.CreateBatch(ctx, newBatch(), selectAll)
+				require.NoError(t, err)
+				require.Len(t, entries, 1)
+			}
+			return selectAll(ctx, payables)
+		})
+		if err != nil {
+			require.True(t, payouts.ErrBatchConflict.Has(err), err)
+		}
+
+		pending, err := batches.ListBatches(ctx, payouts.BatchPending)
+		require.NoError(t, err)
+		require.Len(t, pending, 1)
+	})
+}
+
+func selectEntries(entries []payouts.BatchEntry) payouts.SelectEntries {
+	return func(ctx context.Context, payables []payouts.Payable) ([]payouts.BatchEntry, error) {
+		return entries, nil
+	}
+}
+
+type transactionChecker map[string]payouts.TransactionStatus
+
+func (checker transactionChecker) TransactionStatus(ctx context.Context, transactionHash string) (payouts.TransactionStatus, error) {
+	return checker[transactionHash], nil
+}
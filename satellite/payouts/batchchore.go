@@ -0,0 +1,59 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package payouts
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/sync2"
+)
+
+// BatchChore confirms the submitted payout batches, once their transactions
+// are included in a block.
+//
+// architecture: Chore
+type BatchChore struct {
+	log     *zap.Logger
+	service *BatchService
+	config  L2Config
+	Loop    *sync2.Cycle
+}
+
+// NewBatchChore creates a new payout batch chore.
+func NewBatchChore(log *zap.Logger, service *BatchService, config L2Config) *BatchChore {
+	return &BatchChore{
+		log:     log,
+		service: service,
+		config:  config,
+		Loop:    sync2.NewCycle(config.Interval),
+	}
+}
+
+// Run checks the submitted batches until ctx is canceled.
+func (chore *BatchChore) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if !chore.config.Enabled {
+		return nil
+	}
+
+	return chore.Loop.Run(ctx, func(ctx context.Context) error {
+		confirmed, failed, err := chore.service.CheckBatches(ctx)
+		if err != nil {
+			chore.log.Error("failed to check payout batches", zap.Error(err))
+		}
+		if confirmed > 0 || failed > 0 {
+			chore.log.Info("checked payout batches", zap.Int("confirmed", confirmed), zap.Int("failed", failed))
+		}
+		return nil
+	})
+}
+
+// Close stops the chore.
+func (chore *BatchChore) Close() error {
+	chore.Loop.Close()
+	return nil
+}
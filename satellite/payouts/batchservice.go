@@ -0,0 +1,162 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package payouts
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/compensation"
+	"storj.io/storj/satellite/overlay"
+)
+
+// L2Config contains configurable values for the layer 2 payout batches.
+type L2Config struct {
+	Enabled  bool          `help:"whether the satellite tracks the confirmation of the submitted payout batches" default:"false"`
+	Network  string        `help:"layer 2 network, which the payout batches are paid on" default:"zksync-era"`
+	Endpoint string        `help:"JSON-RPC endpoint of the layer 2 network" default:""`
+	Interval time.Duration `help:"how frequently the confirmation of the submitted payout batches is checked" releaseDefault:"5m" devDefault:"1m" testDefault:"$TESTINTERVAL"`
+}
+
+// TransactionChecker returns the status of transactions on the network.
+type TransactionChecker interface {
+	TransactionStatus(ctx context.Context, transactionHash string) (TransactionStatus, error)
+}
+
+// BatchService exports the payments of the nodes, which opted in to a layer 2
+// network, as batches and records the payments, once the transaction of the
+// batch is confirmed.
+//
+// architecture: Service
+type BatchService struct {
+	log       *zap.Logger
+	db        BatchDB
+	overlayDB overlay.DB
+	checker   TransactionChecker
+
+	nowFn func() time.Time
+}
+
+// NewBatchService creates a new payout batch service.
+func NewBatchService(log *zap.Logger, db BatchDB, overlayDB overlay.DB, checker TransactionChecker) *BatchService {
+	return &BatchService{
+		log:       log,
+		db:        db,
+		overlayDB: overlayDB,
+		checker:   checker,
+
+		nowFn: time.Now,
+	}
+}
+
+// ExportBatch creates a pending batch of the payable amounts of the period
+// for the nodes, which opted in to the network with their wallet features.
+func (service *BatchService) ExportBatch(ctx context.Context, period compensation.Period, network Network) (_ Batch, _ []BatchEntry, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	id, err := uuid.New()
+	if err != nil {
+		return Batch{}, nil, Error.Wrap(err)
+	}
+
+	batch := Batch{
+		ID:        id,
+		Period:    period,
+		Network:   network,
+		Status:    BatchPending,
+		CreatedAt: service.nowFn(),
+	}
+
+	entries, err := service.db.CreateBatch(ctx, batch, func(ctx context.Context, payables []Payable) (entries []BatchEntry, err error) {
+		for _, payable := range payables {
+			node, err := service.overlayDB.Get(ctx, storj.NodeID(payable.NodeID))
+			if err != nil {
+				if overlay.ErrNodeNotFound.Has(err) {
+					continue
+				}
+				return nil, Error.Wrap(err)
+			}
+			if !OptedIn(node, network) {
+				continue
+			}
+
+			entries = append(entries, BatchEntry{
+				NodeID: payable.NodeID,
+				Wallet: node.Operator.Wallet,
+				Amount: payable.Amount,
+			})
+		}
+		return entries, nil
+	})
+	if err != nil {
+		return Batch{}, nil, err
+	}
+	return batch, entries, nil
+}
+
+// SubmitBatch records the transaction, which pays a pending batch.
+func (service *BatchService) SubmitBatch(ctx context.Context, id uuid.UUID, transactionHash string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return service.db.SubmitBatch(ctx, id, transactionHash, service.nowFn())
+}
+
+// CheckBatches checks the transactions of the submitted batches, and confirms
+// or fails the batches, whose transactions are included in a block.
+func (service *BatchService) CheckBatches(ctx context.Context) (confirmed, failed int, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	batches, err := service.db.ListBatches(ctx, BatchSubmitted)
+	if err != nil {
+		return 0, 0, Error.Wrap(err)
+	}
+
+	for _, batch := range batches {
+		status, err := service.checker.TransactionStatus(ctx, batch.TransactionHash)
+		if err != nil {
+			return confirmed, failed, Error.Wrap(err)
+		}
+
+		switch status {
+		case TransactionConfirmed:
+			err := service.db.ConfirmBatch(ctx, batch.ID, batch.Network.Receipt(batch.TransactionHash), service.nowFn())
+			if err != nil {
+				return confirmed, failed, Error.Wrap(err)
+			}
+			confirmed++
+		case TransactionFailed:
+			if err := service.db.FailBatch(ctx, batch.ID); err != nil {
+				return confirmed, failed, Error.Wrap(err)
+			}
+			service.log.Warn("payout batch transaction failed",
+				zap.Stringer("batch", batch.ID),
+				zap.String("transaction", batch.TransactionHash))
+			failed++
+		}
+	}
+	return confirmed, failed, nil
+}
+
+// OptedIn returns whether the node opted in to be paid on the network.
+func OptedIn(node *overlay.NodeDossier, network Network) bool {
+	if node.Operator.Wallet == "" {
+		return false
+	}
+	for _, feature := range node.Operator.WalletFeatures {
+		if feature == string(network) {
+			return true
+		}
+	}
+	return false
+}
+
+// SetNow allows tests to have the service act as if the current time is
+// whatever they want.
+func (service *BatchService) SetNow(nowFn func() time.Time) {
+	service.nowFn = nowFn
+}
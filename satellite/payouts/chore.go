@@ -0,0 +1,86 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package payouts
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/sync2"
+	"storj.io/storj/satellite/compensation"
+)
+
+// Chore records the paystubs of the last period, once its usage is rolled up.
+//
+// architecture: Chore
+type Chore struct {
+	log     *zap.Logger
+	service *Service
+	config  Config
+	Loop    *sync2.Cycle
+
+	nowFn func() time.Time
+}
+
+// NewChore creates a new payouts chore.
+func NewChore(log *zap.Logger, service *Service, config Config) *Chore {
+	return &Chore{
+		log:     log,
+		service: service,
+		config:  config,
+		Loop:    sync2.NewCycle(config.Interval),
+
+		nowFn: time.Now,
+	}
+}
+
+// Run records the paystubs of every period until ctx is canceled.
+func (chore *Chore) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if !chore.config.Enabled {
+		return nil
+	}
+
+	return chore.Loop.Run(ctx, func(ctx context.Context) error {
+		if err := chore.RunOnce(ctx); err != nil {
+			chore.log.Error("failed to record paystubs", zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// RunOnce records the paystubs of the last period, which ended at least the
+// configured delay ago, unless they are already recorded.
+func (chore *Chore) RunOnce(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	current := compensation.PeriodFromTime(chore.nowFn().Add(-chore.config.Delay))
+	period := compensation.PeriodFromTime(current.StartDate().AddDate(0, -1, 0))
+
+	count, err := chore.service.RecordPeriod(ctx, period)
+	if err != nil {
+		if ErrPeriodGenerated.Has(err) {
+			return nil
+		}
+		return err
+	}
+
+	chore.log.Info("recorded paystubs", zap.Stringer("period", period), zap.Int("count", count))
+	return nil
+}
+
+// SetNow allows tests to have the chore act as if the current time is
+// whatever they want.
+func (chore *Chore) SetNow(nowFn func() time.Time) {
+	chore.nowFn = nowFn
+}
+
+// Close stops the chore.
+func (chore *Chore) Close() error {
+	chore.Loop.Close()
+	return nil
+}
@@ -0,0 +1,114 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package payouts
+
+import (
+	"context"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"storj.io/common/storj"
+	"storj.io/storj/satellite/compensation"
+)
+
+// HeldStatus describes the amount held back from a node and when it is
+// released. Amounts are in micro-units.
+type HeldStatus struct {
+	NodeID    storj.NodeID `json:"nodeId"`
+	CreatedAt time.Time    `json:"createdAt"`
+
+	// WithheldPercents is the withholding schedule of the satellite by the
+	// age of the node in months.
+	WithheldPercents []int `json:"withheldPercents"`
+	// WithheldPercent is the percent withheld from the current period.
+	WithheldPercent int  `json:"withheldPercent"`
+	InWithholding   bool `json:"inWithholding"`
+	// WithholdingEndsAt is when the node leaves the withholding schedule.
+	WithholdingEndsAt time.Time `json:"withholdingEndsAt"`
+
+	TotalHeld     int64 `json:"totalHeld"`
+	TotalDisposed int64 `json:"totalDisposed"`
+	// CurrentHeld is the held amount, which isn't disposed yet.
+	CurrentHeld int64 `json:"currentHeld"`
+
+	// DisposalEligible is whether the held amount is disposed to the node
+	// with the next paystub.
+	DisposalEligible bool `json:"disposalEligible"`
+	// ProjectedRelease is the amount disposed to the node once it's eligible.
+	ProjectedRelease int64 `json:"projectedRelease"`
+	// ProjectedReleaseAt is when the node becomes eligible for disposal. It's
+	// nil when the node never will, e.g. because it's disqualified.
+	ProjectedReleaseAt *time.Time `json:"projectedReleaseAt"`
+
+	GracefullyExited bool `json:"gracefullyExited"`
+	Disqualified     bool `json:"disqualified"`
+}
+
+// WithheldPercents returns the withholding schedule of the satellite.
+func (service *Service) WithheldPercents() []int {
+	if service.compensation.WithheldPercents == nil {
+		return compensation.DefaultWithheldPercents
+	}
+	return service.compensation.WithheldPercents
+}
+
+// HeldStatus returns the held amount of the node at the time now, and the
+// amount, which is disposed to the node, when it leaves the withholding
+// schedule. The disposal follows the same rules as the paystubs.
+func (service *Service) HeldStatus(ctx context.Context, nodeID storj.NodeID, now time.Time) (_ HeldStatus, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	node, err := service.overlayDB.Get(ctx, nodeID)
+	if err != nil {
+		return HeldStatus{}, Error.Wrap(err)
+	}
+
+	totals, err := service.compensationDB.QueryTotalAmounts(ctx, nodeID)
+	if err != nil {
+		return HeldStatus{}, Error.Wrap(err)
+	}
+
+	withheldPercents := service.WithheldPercents()
+	withheldPercent, inWithholding := compensation.NodeWithheldPercent(withheldPercents, node.CreatedAt, now)
+
+	status := HeldStatus{
+		NodeID:            nodeID,
+		CreatedAt:         node.CreatedAt,
+		WithheldPercents:  withheldPercents,
+		WithheldPercent:   withheldPercent,
+		InWithholding:     inWithholding,
+		WithholdingEndsAt: compensation.WithholdingEndDate(withheldPercents, node.CreatedAt),
+		TotalHeld:         totals.TotalHeld.Value(),
+		TotalDisposed:     totals.TotalDisposed.Value(),
+		CurrentHeld:       totals.TotalHeld.Value() - totals.TotalDisposed.Value(),
+		GracefullyExited:  node.ExitStatus.ExitSuccess,
+	}
+	status.Disqualified = node.Disqualified != nil && !status.GracefullyExited
+	if status.Disqualified {
+		// disqualified nodes forfeit the held amount.
+		return status, nil
+	}
+
+	// a gracefully exited node gets the full held amount, otherwise only the
+	// configured percent of it is disposed.
+	release := totals.TotalHeld.Decimal()
+	releaseAt := status.WithholdingEndsAt
+	if status.GracefullyExited {
+		if node.ExitStatus.ExitFinishedAt != nil {
+			releaseAt = *node.ExitStatus.ExitFinishedAt
+		}
+	} else {
+		release = compensation.PercentOf(release, decimal.NewFromInt(int64(service.compensation.DisposePercent)))
+	}
+	release = release.Sub(totals.TotalDisposed.Decimal())
+	if release.Sign() < 0 {
+		release = decimal.Zero
+	}
+
+	status.DisposalEligible = !inWithholding || status.GracefullyExited
+	status.ProjectedRelease = release.Round(0).IntPart()
+	status.ProjectedReleaseAt = &releaseAt
+	return status, nil
+}
@@ -0,0 +1,106 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package payouts
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/zeebo/errs"
+)
+
+// ClientErr is the error class of the layer 2 network client.
+var ClientErr = errs.Class("payouts l2 client")
+
+// TransactionStatus is the state of a transaction on the network.
+type TransactionStatus int
+
+const (
+	// TransactionPending is a transaction, which isn't included in a block yet.
+	TransactionPending TransactionStatus = iota
+	// TransactionConfirmed is a transaction, which succeeded.
+	TransactionConfirmed
+	// TransactionFailed is a transaction, which was reverted.
+	TransactionFailed
+)
+
+// Client is a JSON-RPC client of a layer 2 network node.
+type Client struct {
+	endpoint string
+	http     http.Client
+}
+
+// NewClient creates a new layer 2 network client.
+func NewClient(endpoint string) *Client {
+	return &Client{
+		endpoint: endpoint,
+		http:     http.Client{},
+	}
+}
+
+// TransactionStatus returns the status of the transaction by its receipt.
+func (client *Client) TransactionStatus(ctx context.Context, transactionHash string) (_ TransactionStatus, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	body, err := json.Marshal(map[string]interface{}{
+		"jsonrpc": "2.0",
+		"id":      1,
+		"method":  "eth_getTransactionReceipt",
+		"params":  []string{transactionHash},
+	})
+	if err != nil {
+		return TransactionPending, ClientErr.Wrap(err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, client.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return TransactionPending, ClientErr.Wrap(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := client.http.Do(req)
+	if err != nil {
+		return TransactionPending, ClientErr.Wrap(err)
+	}
+	defer func() {
+		err = errs.Combine(err, ClientErr.Wrap(resp.Body.Close()))
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		return TransactionPending, ClientErr.New("unexpected status %q", resp.Status)
+	}
+
+	var data struct {
+		Result *struct {
+			Status string `json:"status"`
+		} `json:"result"`
+		Error *struct {
+			Code    int    `json:"code"`
+			Message string `json:"message"`
+		} `json:"error"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&data); err != nil {
+		return TransactionPending, ClientErr.Wrap(err)
+	}
+
+	if data.Error != nil {
+		return TransactionPending, ClientErr.New("%d: %s", data.Error.Code, data.Error.Message)
+	}
+
+	// the receipt is null until the transaction is included in a block.
+	if data.Result == nil {
+		return TransactionPending, nil
+	}
+
+	switch data.Result.Status {
+	case "0x1":
+		return TransactionConfirmed, nil
+	case "0x0":
+		return TransactionFailed, nil
+	default:
+		return TransactionPending, ClientErr.New("unexpected transaction status %q", data.Result.Status)
+	}
+}
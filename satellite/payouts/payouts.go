@@ -0,0 +1,97 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package payouts computes the compensation of the storage nodes for every
+// payment period and records the paystubs, which the nodes retrieve with the
+// paystub RPC of the satellite.
+package payouts
+
+import (
+	"context"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+
+	"storj.io/common/storj"
+	"storj.io/storj/satellite/compensation"
+)
+
+var (
+	// Error is the error class for payouts.
+	Error = errs.Class("payouts")
+	// ErrContractNotFound is returned when a node doesn't have a contract.
+	ErrContractNotFound = errs.Class("payout contract not found")
+	// ErrPeriodGenerated is returned when the paystubs of a period were
+	// already generated.
+	ErrPeriodGenerated = errs.Class("paystubs of period already generated")
+	// ErrBatchNotFound is returned when a payout batch doesn't exist.
+	ErrBatchNotFound = errs.Class("payout batch not found")
+	// ErrBatchStatus is returned when a payout batch doesn't have the status,
+	// which the operation requires.
+	ErrBatchStatus = errs.Class("payout batch status")
+	// ErrBatchConflict is returned when a node is already part of a payout
+	// batch of the period, which didn't fail.
+	ErrBatchConflict = errs.Class("payout batch conflict")
+
+	mon = monkit.Package()
+)
+
+// Config contains configurable values for the payouts.
+type Config struct {
+	Enabled      bool          `help:"whether the satellite generates the paystubs of the nodes at the end of every period" default:"false"`
+	Interval     time.Duration `help:"how frequently the chore checks whether the paystubs of the last period are generated" releaseDefault:"1h" devDefault:"1m" testDefault:"$TESTINTERVAL"`
+	Delay        time.Duration `help:"how long to wait after the end of a period, so that the usage of the nodes is rolled up" default:"48h"`
+	SurgePercent int64         `help:"percent to adjust the owed amounts, zero means no surge" default:"0"`
+
+	L2 L2Config
+}
+
+// Contract overrides the compensation rates of a single node. Rates, which
+// are nil, are taken from the configuration.
+type Contract struct {
+	NodeID storj.NodeID
+
+	AtRestGBHours *compensation.Rate
+	GetTB         *compensation.Rate
+	PutTB         *compensation.Rate
+	GetRepairTB   *compensation.Rate
+	PutRepairTB   *compensation.Rate
+	GetAuditTB    *compensation.Rate
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// Apply returns the rates with the rates of the contract replacing them.
+func (contract *Contract) Apply(rates compensation.Rates) compensation.Rates {
+	override := func(rate *compensation.Rate, with *compensation.Rate) {
+		if with != nil {
+			*rate = *with
+		}
+	}
+	override(&rates.AtRestGBHours, contract.AtRestGBHours)
+	override(&rates.GetTB, contract.GetTB)
+	override(&rates.PutTB, contract.PutTB)
+	override(&rates.GetRepairTB, contract.GetRepairTB)
+	override(&rates.PutRepairTB, contract.PutRepairTB)
+	override(&rates.GetAuditTB, contract.GetAuditTB)
+	return rates
+}
+
+// DB stores the payout contracts of the nodes.
+//
+// architecture: Database
+type DB interface {
+	// SetContract creates or replaces the contract of a node.
+	SetContract(ctx context.Context, contract Contract) error
+	// GetContract returns the contract of a node.
+	GetContract(ctx context.Context, nodeID storj.NodeID) (Contract, error)
+	// DeleteContract removes the contract of a node.
+	DeleteContract(ctx context.Context, nodeID storj.NodeID) error
+	// ListContracts returns the contracts of all nodes.
+	ListContracts(ctx context.Context) ([]Contract, error)
+
+	// HasPaystubs returns whether any paystubs of the period are recorded.
+	HasPaystubs(ctx context.Context, period compensation.Period) (bool, error)
+}
@@ -0,0 +1,84 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package payouts_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/compensation"
+	"storj.io/storj/satellite/payouts"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestContractApply(t *testing.T) {
+	rates := compensation.Rates{
+		AtRestGBHours: compensation.RequireRateFromString("0.00000205"),
+		GetTB:         compensation.RequireRateFromString("20"),
+		PutTB:         compensation.RequireRateFromString("0"),
+		GetRepairTB:   compensation.RequireRateFromString("10"),
+		PutRepairTB:   compensation.RequireRateFromString("0"),
+		GetAuditTB:    compensation.RequireRateFromString("10"),
+	}
+
+	// an empty contract keeps the rates.
+	require.Equal(t, rates, (&payouts.Contract{}).Apply(rates))
+
+	getTB := compensation.RequireRateFromString("25")
+	contract := payouts.Contract{GetTB: &getTB}
+
+	expected := rates
+	expected.GetTB = getTB
+	require.Equal(t, expected, contract.Apply(rates))
+	// the original rates are not modified.
+	require.Equal(t, compensation.RequireRateFromString("20"), rates.GetTB)
+}
+
+func TestContractsDB(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		contracts := db.PayoutContracts()
+		nodeID := testrand.NodeID()
+
+		_, err := contracts.GetContract(ctx, nodeID)
+		require.True(t, payouts.ErrContractNotFound.Has(err))
+
+		atRest := compensation.RequireRateFromString("0.0000025")
+		require.NoError(t, contracts.SetContract(ctx, payouts.Contract{
+			NodeID:        nodeID,
+			AtRestGBHours: &atRest,
+		}))
+
+		contract, err := contracts.GetContract(ctx, nodeID)
+		require.NoError(t, err)
+		require.Equal(t, nodeID, contract.NodeID)
+		require.NotNil(t, contract.AtRestGBHours)
+		require.Equal(t, atRest.String(), contract.AtRestGBHours.String())
+		require.Nil(t, contract.GetTB)
+
+		getTB := compensation.RequireRateFromString("25")
+		require.NoError(t, contracts.SetContract(ctx, payouts.Contract{
+			NodeID: nodeID,
+			GetTB:  &getTB,
+		}))
+
+		list, err := contracts.ListContracts(ctx)
+		require.NoError(t, err)
+		require.Len(t, list, 1)
+		require.Nil(t, list[0].AtRestGBHours)
+		require.NotNil(t, list[0].GetTB)
+		require.Equal(t, getTB.String(), list[0].GetTB.String())
+
+		generated, err := contracts.HasPaystubs(ctx, compensation.Period{Year: 2023, Month: 1})
+		require.NoError(t, err)
+		require.False(t, generated)
+
+		require.NoError(t, contracts.DeleteContract(ctx, nodeID))
+		err = contracts.DeleteContract(ctx, nodeID)
+		require.True(t, payouts.ErrContractNotFound.Has(err))
+	})
+}
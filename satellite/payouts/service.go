@@ -0,0 +1,195 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package payouts
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+	"storj.io/storj/satellite/accounting"
+	"storj.io/storj/satellite/compensation"
+	"storj.io/storj/satellite/overlay"
+)
+
+// Service computes the paystubs of the nodes for a period, with the rates of
+// the contract of the node or the configured rates otherwise.
+//
+// architecture: Service
+type Service struct {
+	log                     *zap.Logger
+	db                      DB
+	compensationDB          compensation.DB
+	storagenodeAccountingDB accounting.StoragenodeAccounting
+	overlayDB               overlay.DB
+	compensation            compensation.Config
+	config                  Config
+}
+
+// NewService creates a new payouts service. The compensation config contains
+// the default rates and the withholding of all nodes.
+func NewService(log *zap.Logger, db DB, compensationDB compensation.DB, storagenodeAccountingDB accounting.StoragenodeAccounting, overlayDB overlay.DB, compensationConfig compensation.Config, config Config) *Service {
+	return &Service{
+		log:                     log,
+		db:                      db,
+		compensationDB:          compensationDB,
+		storagenodeAccountingDB: storagenodeAccountingDB,
+		overlayDB:               overlayDB,
+		compensation:            compensationConfig,
+		config:                  config,
+	}
+}
+
+// GeneratePaystubs computes the paystubs of all nodes for the period without
+// recording them.
+func (service *Service) GeneratePaystubs(ctx context.Context, period compensation.Period) (_ []compensation.Paystub, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	periodUsage, err := service.storagenodeAccountingDB.QueryStorageNodePeriodUsage(ctx, period)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	usageByNode := make(map[storj.NodeID]accounting.StorageNodePeriodUsage, len(periodUsage))
+	for _, usage := range periodUsage {
+		usageByNode[usage.NodeID] = usage
+	}
+
+	contracts, err := service.db.ListContracts(ctx)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	contractByNode := make(map[storj.NodeID]Contract, len(contracts))
+	for _, contract := range contracts {
+		contractByNode[contract.NodeID] = contract
+	}
+
+	var nodes []compensation.NodeInfo
+	err = service.overlayDB.IterateAllNodeDossiers(ctx, func(ctx context.Context, node *overlay.NodeDossier) error {
+		totals, err := service.compensationDB.QueryTotalAmounts(ctx, node.Id)
+		if err != nil {
+			return err
+		}
+
+		var gracefulExit *time.Time
+		if node.ExitStatus.ExitSuccess {
+			gracefulExit = node.ExitStatus.ExitFinishedAt
+		}
+
+		// the zero value of period usage is acceptable for if the node does
+		// not have any usage for the period.
+		usage := usageByNode[node.Id]
+		nodes = append(nodes, compensation.NodeInfo{
+			ID:                 node.Id,
+			CreatedAt:          node.CreatedAt,
+			LastContactSuccess: node.Reputation.LastContactSuccess,
+			Disqualified:       node.Disqualified,
+			GracefulExit:       gracefulExit,
+			UsageAtRest:        usage.AtRestTotal,
+			UsageGet:           usage.GetTotal,
+			UsagePut:           usage.PutTotal,
+			UsageGetRepair:     usage.GetRepairTotal,
+			UsagePutRepair:     usage.PutRepairTotal,
+			UsageGetAudit:      usage.GetAuditTotal,
+			TotalHeld:          totals.TotalHeld,
+			TotalDisposed:      totals.TotalDisposed,
+			TotalPaid:          totals.TotalPaid,
+			TotalDistributed:   totals.TotalDistributed,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	defaultRates := compensation.Rates{
+		AtRestGBHours: service.compensation.Rates.AtRestGBHours,
+		GetTB:         service.compensation.Rates.GetTB,
+		PutTB:         service.compensation.Rates.PutTB,
+		GetRepairTB:   service.compensation.Rates.GetRepairTB,
+		PutRepairTB:   service.compensation.Rates.PutRepairTB,
+		GetAuditTB:    service.compensation.Rates.GetAuditTB,
+	}
+	paystubs := make([]compensation.Paystub, 0, len(nodes))
+	for _, node := range nodes {
+		rates := defaultRates
+		if contract, ok := contractByNode[node.ID]; ok {
+			rates = contract.Apply(rates)
+		}
+
+		statements, err := compensation.GenerateStatements(compensation.PeriodInfo{
+			Period:           period,
+			Nodes:            []compensation.NodeInfo{node},
+			Rates:            &rates,
+			WithheldPercents: service.compensation.WithheldPercents,
+			DisposePercent:   service.compensation.DisposePercent,
+			SurgePercent:     service.config.SurgePercent,
+		})
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+
+		for _, statement := range statements {
+			paystubs = append(paystubs, toPaystub(period, node, statement))
+		}
+	}
+
+	return paystubs, nil
+}
+
+// RecordPeriod generates and records the paystubs of all nodes for the
+// period. The paystubs of a period are only recorded once, because they are
+// part of the withheld amounts of the following periods.
+func (service *Service) RecordPeriod(ctx context.Context, period compensation.Period) (_ int, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	generated, err := service.db.HasPaystubs(ctx, period)
+	if err != nil {
+		return 0, Error.Wrap(err)
+	}
+	if generated {
+		return 0, ErrPeriodGenerated.New("%s", period)
+	}
+
+	paystubs, err := service.GeneratePaystubs(ctx, period)
+	if err != nil {
+		return 0, err
+	}
+
+	err = service.compensationDB.RecordPeriod(ctx, paystubs, nil)
+	if err != nil {
+		return 0, Error.Wrap(err)
+	}
+
+	mon.IntVal("paystubs_recorded").Observe(int64(len(paystubs)))
+	return len(paystubs), nil
+}
+
+// toPaystub converts the statement of a node to its paystub. The owed amount
+// is paid, but it's only distributed when the payment is recorded.
+func toPaystub(period compensation.Period, node compensation.NodeInfo, statement compensation.Statement) compensation.Paystub {
+	return compensation.Paystub{
+		Period:         period,
+		NodeID:         compensation.NodeID(statement.NodeID),
+		Codes:          statement.Codes,
+		UsageAtRest:    node.UsageAtRest,
+		UsageGet:       node.UsageGet,
+		UsagePut:       node.UsagePut,
+		UsageGetRepair: node.UsageGetRepair,
+		UsagePutRepair: node.UsagePutRepair,
+		UsageGetAudit:  node.UsageGetAudit,
+		CompAtRest:     statement.AtRest,
+		CompGet:        statement.Get,
+		CompPut:        statement.Put,
+		CompGetRepair:  statement.GetRepair,
+		CompPutRepair:  statement.PutRepair,
+		CompGetAudit:   statement.GetAudit,
+		SurgePercent:   statement.SurgePercent,
+		Owed:           statement.Owed,
+		Held:           statement.Held,
+		Disposed:       statement.Disposed,
+		Paid:           statement.Owed,
+	}
+}
@@ -15,55 +15,80 @@ import (
 
 	"storj.io/common/identity"
 	"storj.io/private/debug"
+	"storj.io/storj/private/migrate"
+	"storj.io/storj/private/otlp"
 	"storj.io/storj/private/post"
 	"storj.io/storj/private/post/oauth2"
 	"storj.io/storj/private/server"
 	version_checker "storj.io/storj/private/version/checker"
+	"storj.io/storj/satellite/accesslog"
 	"storj.io/storj/satellite/accounting"
+	"storj.io/storj/satellite/accounting/egressabuse"
 	"storj.io/storj/satellite/accounting/live"
 	"storj.io/storj/satellite/accounting/projectbwcleanup"
+	"storj.io/storj/satellite/accounting/projectops"
 	"storj.io/storj/satellite/accounting/rollup"
 	"storj.io/storj/satellite/accounting/rolluparchive"
+	"storj.io/storj/satellite/accounting/rollupexport"
 	"storj.io/storj/satellite/accounting/tally"
 	"storj.io/storj/satellite/admin"
 	"storj.io/storj/satellite/analytics"
+	"storj.io/storj/satellite/attestation"
 	"storj.io/storj/satellite/attribution"
 	"storj.io/storj/satellite/audit"
+	"storj.io/storj/satellite/bucketevents"
+	"storj.io/storj/satellite/bucketlifecycle"
+	"storj.io/storj/satellite/bucketpolicy"
 	"storj.io/storj/satellite/buckets"
 	"storj.io/storj/satellite/compensation"
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/console/consoleauth"
 	"storj.io/storj/satellite/console/consoleweb"
 	"storj.io/storj/satellite/console/emailreminders"
+	"storj.io/storj/satellite/console/objectbrowser"
+	"storj.io/storj/satellite/console/projectdeletion"
 	"storj.io/storj/satellite/console/restkeys"
 	"storj.io/storj/satellite/console/userinfo"
 	"storj.io/storj/satellite/contact"
+	"storj.io/storj/satellite/events"
 	"storj.io/storj/satellite/gc/bloomfilter"
 	"storj.io/storj/satellite/gc/sender"
 	"storj.io/storj/satellite/gracefulexit"
+	"storj.io/storj/satellite/integrity"
 	"storj.io/storj/satellite/mailservice"
 	"storj.io/storj/satellite/mailservice/simulate"
+	"storj.io/storj/satellite/maintenance"
 	"storj.io/storj/satellite/metabase/rangedloop"
 	"storj.io/storj/satellite/metabase/zombiedeletion"
 	"storj.io/storj/satellite/metainfo"
 	"storj.io/storj/satellite/metainfo/expireddeletion"
 	"storj.io/storj/satellite/metrics"
 	"storj.io/storj/satellite/nodeapiversion"
+	"storj.io/storj/satellite/nodedialer"
 	"storj.io/storj/satellite/nodeevents"
 	"storj.io/storj/satellite/oidc"
 	"storj.io/storj/satellite/orders"
 	"storj.io/storj/satellite/overlay"
+	"storj.io/storj/satellite/overlay/inventory"
+	"storj.io/storj/satellite/overlay/nodechurn"
+	"storj.io/storj/satellite/overlay/nodescan"
 	"storj.io/storj/satellite/overlay/offlinenodes"
+	"storj.io/storj/satellite/overlay/placementstats"
 	"storj.io/storj/satellite/overlay/straynodes"
+	"storj.io/storj/satellite/overlay/walletstats"
 	"storj.io/storj/satellite/payments/billing"
 	"storj.io/storj/satellite/payments/paymentsconfig"
 	"storj.io/storj/satellite/payments/storjscan"
 	"storj.io/storj/satellite/payments/stripecoinpayments"
+	"storj.io/storj/satellite/payouts"
 	"storj.io/storj/satellite/repair/checker"
 	"storj.io/storj/satellite/repair/queue"
 	"storj.io/storj/satellite/repair/repairer"
+	"storj.io/storj/satellite/replication"
 	"storj.io/storj/satellite/reputation"
 	"storj.io/storj/satellite/revocation"
+	"storj.io/storj/satellite/sealedaccess"
+	"storj.io/storj/satellite/secrets"
 	"storj.io/storj/satellite/snopayouts"
 )
 
@@ -81,6 +106,8 @@ type DB interface {
 	MigrateToLatest(ctx context.Context) error
 	// CheckVersion checks the database is the correct version
 	CheckVersion(ctx context.Context) error
+	// MigrationPlan returns the migration steps, which MigrateToLatest would apply.
+	MigrationPlan(ctx context.Context) ([]migrate.PlannedStep, error)
 	// Close closes the database
 	Close() error
 
@@ -93,6 +120,10 @@ type DB interface {
 	OverlayCache() overlay.DB
 	// NodeEvents returns a database for node event information
 	NodeEvents() nodeevents.DB
+	// NodeChurn returns database for the daily node churn metrics.
+	NodeChurn() nodechurn.DB
+	// NodeInventory returns database for the inventories of the nodes.
+	NodeInventory() inventory.DB
 	// Reputation returns database for audit reputation information
 	Reputation() reputation.DB
 	// Attribution returns database for partner keys information
@@ -101,6 +132,10 @@ type DB interface {
 	StoragenodeAccounting() accounting.StoragenodeAccounting
 	// ProjectAccounting returns database for storing information about project data use
 	ProjectAccounting() accounting.ProjectAccounting
+	// RollupExport returns database for exporting and restoring old rollups
+	RollupExport() rollupexport.DB
+	// ProjectOperations returns database for the hourly operation counts of projects
+	ProjectOperations() projectops.DB
 	// RepairQueue returns queue for segments that need repairing
 	RepairQueue() queue.RepairQueue
 	// VerifyQueue returns queue for segments chosen for verification
@@ -129,12 +164,26 @@ type DB interface {
 	SNOPayouts() snopayouts.DB
 	// Compensation tracks storage node compensation
 	Compensation() compensation.DB
+	// PayoutContracts returns database for the payout contracts of the nodes.
+	PayoutContracts() payouts.DB
+	// PayoutBatches returns database for the layer 2 payout batches.
+	PayoutBatches() payouts.BatchDB
 	// Revocation tracks revoked macaroons
 	Revocation() revocation.DB
 	// NodeAPIVersion tracks nodes observed api usage
 	NodeAPIVersion() nodeapiversion.DB
 	// StorjscanPayments stores payments retrieved from storjscan.
 	StorjscanPayments() storjscan.PaymentsDB
+	// Maintenance returns database for the maintenance mode state.
+	Maintenance() maintenance.DB
+	// Replication returns database for bucket replication rules.
+	Replication() replication.DB
+	// BucketEvents returns database for bucket event notification configurations.
+	BucketEvents() bucketevents.DB
+	// BucketPolicies returns database for bucket policies.
+	BucketPolicies() bucketpolicy.DB
+	// AccessLog returns database for customer facing access logs.
+	AccessLog() accesslog.DB
 }
 
 // Config is the global config satellite.
@@ -143,16 +192,33 @@ type Config struct {
 	Server   server.Config
 	Debug    debug.Config
 
+	Secrets          secrets.Config
+	AccessEncryption sealedaccess.Config
+
 	Admin admin.Config
 
 	Contact      contact.Config
 	Overlay      overlay.Config
+	NodeDialer   nodedialer.Config
 	OfflineNodes offlinenodes.Config
 	NodeEvents   nodeevents.Config
 	StrayNodes   straynodes.Config
 
-	Metainfo metainfo.Config
-	Orders   orders.Config
+	NodeScan       nodescan.Config
+	PlacementStats placementstats.Config
+	NodeChurn      nodechurn.Config
+	WalletStats    walletstats.Config
+
+	Metainfo     metainfo.Config
+	Revocation   revocation.Config
+	Maintenance  maintenance.Config
+	BucketEvents bucketevents.Config
+	BucketPolicy bucketpolicy.Config
+	AccessLog    accesslog.Config
+	Integrity    integrity.Config
+	Orders       orders.Config
+
+	ObjectBrowser objectbrowser.Config
 
 	Userinfo userinfo.Config
 
@@ -169,33 +235,46 @@ type Config struct {
 
 	ExpiredDeletion expireddeletion.Config
 	ZombieDeletion  zombiedeletion.Config
+	Replication     replication.Config
+	Attestation     attestation.Config
 
 	Tally            tally.Config
 	Rollup           rollup.Config
 	RollupArchive    rolluparchive.Config
+	RollupExport     rollupexport.Config
 	LiveAccounting   live.Config
 	ProjectBWCleanup projectbwcleanup.Config
+	ProjectOps       projectops.Config
+	EgressAbuse      egressabuse.Config
 
 	Mail mailservice.Config
 
 	Payments paymentsconfig.Config
 
-	RESTKeys       restkeys.Config
-	Console        consoleweb.Config
-	ConsoleAuth    consoleauth.Config
-	EmailReminders emailreminders.Config
+	RESTKeys        restkeys.Config
+	Console         consoleweb.Config
+	ConsoleAuth     consoleauth.Config
+	EmailReminders  emailreminders.Config
+	ProjectDeletion projectdeletion.Config
 
 	Version version_checker.Config
 
 	GracefulExit gracefulexit.Config
 
+	BucketLifecycle bucketlifecycle.Config
+
 	Metrics metrics.Config
 
 	Compensation compensation.Config
+	Payouts      payouts.Config
 
 	ProjectLimit accounting.ProjectLimitConfig
 
 	Analytics analytics.Config
+
+	Events events.Config
+
+	OTLP otlp.Config
 }
 
 func setupMailService(log *zap.Logger, config Config) (*mailservice.Service, error) {
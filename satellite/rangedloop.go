@@ -18,7 +18,9 @@ import (
 	"storj.io/common/storj"
 	"storj.io/private/debug"
 	"storj.io/storj/private/lifecycle"
+	"storj.io/storj/private/otlp"
 	"storj.io/storj/satellite/audit"
+	"storj.io/storj/satellite/bucketlifecycle"
 	"storj.io/storj/satellite/gracefulexit"
 	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/metabase/rangedloop"
@@ -45,6 +47,10 @@ type RangedLoop struct {
 		Server   *debug.Server
 	}
 
+	Tracing struct {
+		Exporter *otlp.Exporter
+	}
+
 	Metrics struct {
 		Observer rangedloop.Observer
 	}
@@ -53,6 +59,10 @@ type RangedLoop struct {
 		Observer rangedloop.Observer
 	}
 
+	BucketLifecycle struct {
+		Observer *bucketlifecycle.Observer
+	}
+
 	RangedLoop struct {
 		Service *rangedloop.Service
 	}
@@ -88,8 +98,20 @@ func NewRangedLoop(log *zap.Logger, full *identity.FullIdentity, db DB, metabase
 		})
 	}
 
+	{ // setup trace export
+		var err error
+		peer.Tracing.Exporter, err = otlp.NewExporter(log.Named("tracing"), monkit.Default, config.OTLP, "satellite-rangedloop")
+		if err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
+		peer.Services.Add(lifecycle.Item{
+			Name: "tracing",
+			Run:  peer.Tracing.Exporter.Run,
+		})
+	}
+
 	{ // setup audit observer
-		peer.Audit.Observer = audit.NewObserver(log.Named("audit"), db.VerifyQueue(), config.Audit)
+		peer.Audit.Observer = audit.NewObserver(log.Named("audit"), db.VerifyQueue(), metabaseDB, config.Audit)
 	}
 
 	{ // setup metrics observer
@@ -105,6 +127,14 @@ func NewRangedLoop(log *zap.Logger, full *identity.FullIdentity, db DB, metabase
 		)
 	}
 
+	{ // setup bucket lifecycle
+		peer.BucketLifecycle.Observer = bucketlifecycle.NewObserver(
+			peer.Log.Named("bucketlifecycle:observer"),
+			metabaseDB,
+			config.BucketLifecycle,
+		)
+	}
+
 	{ // setup ranged loop
 		var observers []rangedloop.Observer
 
@@ -120,6 +150,10 @@ func NewRangedLoop(log *zap.Logger, full *identity.FullIdentity, db DB, metabase
 			observers = append(observers, peer.GracefulExit.Observer)
 		}
 
+		if config.BucketLifecycle.Enabled {
+			observers = append(observers, peer.BucketLifecycle.Observer)
+		}
+
 		segments := rangedloop.NewMetabaseRangeSplitter(metabaseDB, config.RangedLoop.BatchSize)
 		peer.RangedLoop.Service = rangedloop.NewService(log.Named("rangedloop"), config.RangedLoop, &segments, observers)
 
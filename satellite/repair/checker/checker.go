@@ -209,14 +209,14 @@ func containsStreamID(a []uuid.UUID, x uuid.UUID) bool {
 	return false
 }
 
-func (obs *checkerObserver) getStatsByRS(redundancy storj.RedundancyScheme) *stats {
-	rsString := getRSString(obs.loadRedundancy(redundancy))
+func (obs *checkerObserver) getStatsByRS(redundancy storj.RedundancyScheme, placement storj.PlacementConstraint) *stats {
+	rsString := getRSString(obs.loadRedundancy(redundancy, placement))
 	return obs.statsCollector.getStatsByRS(rsString)
 }
 
-func (obs *checkerObserver) loadRedundancy(redundancy storj.RedundancyScheme) (int, int, int, int) {
+func (obs *checkerObserver) loadRedundancy(redundancy storj.RedundancyScheme, placement storj.PlacementConstraint) (int, int, int, int) {
 	repair := int(redundancy.RepairShares)
-	overrideValue := obs.repairOverrides.GetOverrideValue(redundancy)
+	overrideValue := obs.repairOverrides.GetOverrideValue(redundancy, placement)
 	if overrideValue != 0 {
 		repair = int(overrideValue)
 	}
@@ -236,7 +236,7 @@ func (obs *checkerObserver) RemoteSegment(ctx context.Context, segment *segmentl
 		return nil
 	}
 
-	stats := obs.getStatsByRS(segment.Redundancy)
+	stats := obs.getStatsByRS(segment.Redundancy, segment.Placement)
 
 	if obs.lastStreamID.Compare(segment.StreamID) != 0 {
 		obs.lastStreamID = segment.StreamID
@@ -270,6 +270,16 @@ func (obs *checkerObserver) RemoteSegment(ctx context.Context, segment *segmentl
 		return errs.Combine(Error.New("error getting missing pieces"), err)
 	}
 
+	// pieces outside of the placement of the segment, e.g. after its bucket
+	// lifecycle changed the placement, are moved by the repair regardless of
+	// the health of the segment.
+	outOfPlacement, err := obs.nodestate.OutOfPlacementPieces(ctx, segment.CreatedAt, segment.Pieces, segment.Placement)
+	if err != nil {
+		obs.monStats.remoteSegmentsFailedToCheck++
+		stats.iterationAggregates.remoteSegmentsFailedToCheck++
+		return errs.Combine(Error.New("error getting pieces out of placement"), err)
+	}
+
 	numHealthy := len(pieces) - len(missingPieces)
 	mon.IntVal("checker_segment_total_count").Observe(int64(len(pieces))) //mon:locked
 	stats.segmentTotalCount.Observe(int64(len(pieces)))
@@ -280,16 +290,16 @@ func (obs *checkerObserver) RemoteSegment(ctx context.Context, segment *segmentl
 	mon.IntVal("checker_segment_age").Observe(int64(segmentAge.Seconds())) //mon:locked
 	stats.segmentAge.Observe(int64(segmentAge.Seconds()))
 
-	required, repairThreshold, successThreshold, _ := obs.loadRedundancy(segment.Redundancy)
+	required, repairThreshold, successThreshold, _ := obs.loadRedundancy(segment.Redundancy, segment.Placement)
 
-	segmentHealth := repair.SegmentHealth(numHealthy, required, totalNumNodes, obs.nodeFailureRate)
+	segmentHealth := repair.SegmentHealth(numHealthy-len(outOfPlacement), required, totalNumNodes, obs.nodeFailureRate)
 	mon.FloatVal("checker_segment_health").Observe(segmentHealth) //mon:locked
 	stats.segmentHealth.Observe(segmentHealth)
 
 	// we repair when the number of healthy pieces is less than or equal to the repair threshold and is greater or equal to
 	// minimum required pieces in redundancy
 	// except for the case when the repair and success thresholds are the same (a case usually seen during testing)
-	if numHealthy <= repairThreshold && numHealthy < successThreshold {
+	if numHealthy <= repairThreshold && numHealthy < successThreshold || len(outOfPlacement) > 0 {
 		mon.FloatVal("checker_injured_segment_health").Observe(segmentHealth) //mon:locked
 		stats.injuredSegmentHealth.Observe(segmentHealth)
 		obs.monStats.remoteSegmentsNeedingRepair++
@@ -86,6 +86,58 @@ func TestIdentifyInjuredSegments(t *testing.T) {
 	})
 }
 
+func TestIdentifyOutOfPlacementSegments(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		checker := satellite.Repair.Checker
+		repairQueue := satellite.DB.RepairQueue()
+
+		checker.Loop.Pause()
+		satellite.Repair.Repairer.Loop.Pause()
+
+		rs := storj.RedundancyScheme{
+			RequiredShares: 1,
+			RepairShares:   2,
+			OptimalShares:  3,
+			TotalShares:    4,
+			ShareSize:      256,
+		}
+
+		err := planet.Uplinks[0].CreateBucket(ctx, satellite, "test-bucket")
+		require.NoError(t, err)
+
+		location := metabase.SegmentLocation{
+			ProjectID:  planet.Uplinks[0].Projects[0].ID,
+			BucketName: "test-bucket",
+			ObjectKey:  "healthy",
+		}
+		streamID := insertSegment(ctx, t, planet, rs, location, createPieces(planet, rs), nil)
+
+		// the segment is healthy within its placement.
+		checker.Loop.TriggerWait()
+		_, err = repairQueue.Select(ctx)
+		require.Error(t, err)
+
+		for _, node := range planet.StorageNodes {
+			require.NoError(t, satellite.Overlay.Service.TestNodeCountryCode(ctx, node.ID(), "US"))
+		}
+		_, err = satellite.Metabase.DB.UpdateStreamPlacement(ctx, metabase.UpdateStreamPlacement{
+			StreamIDs: []uuid.UUID{streamID},
+			Placement: storj.EU,
+		})
+		require.NoError(t, err)
+		require.NoError(t, checker.RefreshReliabilityCache(ctx))
+
+		// the pieces are outside of the new placement.
+		checker.Loop.TriggerWait()
+		injuredSegment, err := repairQueue.Select(ctx)
+		require.NoError(t, err)
+		require.Equal(t, streamID, injuredSegment.StreamID)
+	})
+}
+
 func TestIdentifyIrreparableSegments(t *testing.T) {
 	testplanet.Run(t, testplanet.Config{
 		SatelliteCount: 1, StorageNodeCount: 3, UplinkCount: 1,
@@ -18,7 +18,7 @@ type Config struct {
 	Interval time.Duration `help:"how frequently checker should check for bad segments" releaseDefault:"30s" devDefault:"0h0m10s" testDefault:"$TESTINTERVAL"`
 
 	ReliabilityCacheStaleness time.Duration   `help:"how stale reliable node cache can be" releaseDefault:"5m" devDefault:"5m" testDefault:"1m"`
-	RepairOverrides           RepairOverrides `help:"comma-separated override values for repair threshold in the format k/o/n-override (min/optimal/total-override)" releaseDefault:"29/80/110-52,29/80/95-52,29/80/130-52" devDefault:""`
+	RepairOverrides           RepairOverrides `help:"comma-separated override values for repair threshold in the format [placement:]k/o/n-override (min/optimal/total-override), overrides with a placement take precedence for the segments of the placement" releaseDefault:"29/80/110-52,29/80/95-52,29/80/130-52" devDefault:""`
 	// Node failure rate is an estimation based on a 6 hour checker run interval (4 checker iterations per day), a network of about 9200 nodes, and about 2 nodes churning per day.
 	// This results in `2/9200/4 = 0.00005435` being the probability of any single node going down in the interval of one checker iteration.
 	NodeFailureRate            float64 `help:"the probability of a single node going down within the next checker iteration" default:"0.00005435" `
@@ -26,14 +26,18 @@ type Config struct {
 }
 
 // RepairOverride is a configuration struct that contains an override repair
-// value for a given RS k/o/n (min/success/total).
+// value for a given RS k/o/n (min/success/total), optionally only for the
+// segments of a placement.
 //
 // Can be used as a flag.
 type RepairOverride struct {
-	Min      int
-	Success  int
-	Total    int
-	Override int32
+	// Placement limits the override to the segments of the placement, when
+	// it's not nil.
+	Placement *storj.PlacementConstraint
+	Min       int
+	Success   int
+	Total     int
+	Override  int32
 }
 
 // Type implements pflag.Value.
@@ -41,15 +45,32 @@ func (RepairOverride) Type() string { return "checker.RepairOverride" }
 
 // String is required for pflag.Value.
 func (ro *RepairOverride) String() string {
-	return fmt.Sprintf("%d/%d/%d-%d",
+	var placement string
+	if ro.Placement != nil {
+		placement = fmt.Sprintf("%d:", *ro.Placement)
+	}
+	return fmt.Sprintf("%s%d/%d/%d-%d",
+		placement,
 		ro.Min,
 		ro.Success,
 		ro.Total,
 		ro.Override)
 }
 
-// Set sets the value from a string in the format k/o/n-override (min/optimal/total-repairOverride).
+// Set sets the value from a string in the format [placement:]k/o/n-override (min/optimal/total-repairOverride).
 func (ro *RepairOverride) Set(s string) error {
+	ro.Placement = nil
+	// Split on colon. Expect an optional placement before the RS numbers.
+	if placementString, rest, ok := strings.Cut(s, ":"); ok {
+		placement, err := strconv.ParseUint(placementString, 10, 16)
+		if err != nil {
+			return Error.New("Invalid placement (should be valid integer): %s, %w", placementString, err)
+		}
+		constraint := storj.PlacementConstraint(placement)
+		ro.Placement = &constraint
+		s = rest
+	}
+
 	// Split on dash. Expect two items. First item is RS numbers. Second item is Override.
 	info := strings.Split(s, "-")
 	if len(info) != 2 {
@@ -118,7 +139,7 @@ func (ros *RepairOverrides) String() string {
 	return s.String()
 }
 
-// Set sets the value from a string in the format "k/o/n-override,k/o/n-override,...".
+// Set sets the value from a string in the format "k/o/n-override,placement:k/o/n-override,...".
 func (ros *RepairOverrides) Set(s string) error {
 	ros.List = nil
 	roStrings := strings.Split(s, ",")
@@ -143,7 +164,7 @@ func (ros *RepairOverrides) GetMap() RepairOverridesMap {
 		overrideMap: make(map[string]int32),
 	}
 	for _, ro := range ros.List {
-		key := getRepairOverrideKey(ro.Min, ro.Success, ro.Total)
+		key := getRepairOverrideKey(ro.Placement, ro.Min, ro.Success, ro.Total)
 		newMap.overrideMap[key] = ro.Override
 	}
 	return newMap
@@ -152,22 +173,33 @@ func (ros *RepairOverrides) GetMap() RepairOverridesMap {
 // RepairOverridesMap is derived from the RepairOverrides config, and is used for quickly retrieving
 // repair override values.
 type RepairOverridesMap struct {
-	// map of "k/o/n" -> override value
+	// map of "k/o/n" or "placement:k/o/n" -> override value
 	overrideMap map[string]int32
 }
 
-// GetOverrideValuePB returns the override value for a pb RS scheme if it exists, or 0 otherwise.
-func (rom *RepairOverridesMap) GetOverrideValuePB(rs *pb.RedundancyScheme) int32 {
-	key := getRepairOverrideKey(int(rs.MinReq), int(rs.SuccessThreshold), int(rs.Total))
-	return rom.overrideMap[key]
+// GetOverrideValuePB returns the override value for a pb RS scheme of a segment in the placement
+// if it exists, or 0 otherwise.
+func (rom *RepairOverridesMap) GetOverrideValuePB(rs *pb.RedundancyScheme, placement storj.PlacementConstraint) int32 {
+	return rom.getOverrideValue(placement, int(rs.MinReq), int(rs.SuccessThreshold), int(rs.Total))
 }
 
-// GetOverrideValue returns the override value for an RS scheme if it exists, or 0 otherwise.
-func (rom *RepairOverridesMap) GetOverrideValue(rs storj.RedundancyScheme) int32 {
-	key := getRepairOverrideKey(int(rs.RequiredShares), int(rs.OptimalShares), int(rs.TotalShares))
-	return rom.overrideMap[key]
+// GetOverrideValue returns the override value for an RS scheme of a segment in the placement
+// if it exists, or 0 otherwise.
+func (rom *RepairOverridesMap) GetOverrideValue(rs storj.RedundancyScheme, placement storj.PlacementConstraint) int32 {
+	return rom.getOverrideValue(placement, int(rs.RequiredShares), int(rs.OptimalShares), int(rs.TotalShares))
 }
 
-func getRepairOverrideKey(min, success, total int) string {
+// getOverrideValue prefers the override of the placement over the override for any placement.
+func (rom *RepairOverridesMap) getOverrideValue(placement storj.PlacementConstraint, min, success, total int) int32 {
+	if override, ok := rom.overrideMap[getRepairOverrideKey(&placement, min, success, total)]; ok {
+		return override
+	}
+	return rom.overrideMap[getRepairOverrideKey(nil, min, success, total)]
+}
+
+func getRepairOverrideKey(placement *storj.PlacementConstraint, min, success, total int) string {
+	if placement != nil {
+		return fmt.Sprintf("%d:%d/%d/%d", *placement, min, success, total)
+	}
 	return fmt.Sprintf("%d/%d/%d", min, success, total)
 }
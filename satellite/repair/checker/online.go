@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"storj.io/common/storj"
+	"storj.io/common/storj/location"
 	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/overlay"
 )
@@ -27,7 +28,8 @@ type ReliabilityCache struct {
 
 // reliabilityState.
 type reliabilityState struct {
-	reliable map[storj.NodeID]struct{}
+	// reliable contains the country codes of the reliable nodes.
+	reliable map[storj.NodeID]location.CountryCode
 	created  time.Time
 }
 
@@ -75,6 +77,26 @@ func (cache *ReliabilityCache) MissingPieces(ctx context.Context, created time.T
 	return unreliable, nil
 }
 
+// OutOfPlacementPieces returns the pieces on reliable nodes, which are in a
+// country not allowed by the placement.
+func (cache *ReliabilityCache) OutOfPlacementPieces(ctx context.Context, created time.Time, pieces metabase.Pieces, placement storj.PlacementConstraint) (_ []metabase.Piece, err error) {
+	if placement == storj.EveryCountry {
+		return nil, nil
+	}
+
+	state, err := cache.loadFast(ctx, created)
+	if err != nil {
+		return nil, err
+	}
+	var outOfPlacement []metabase.Piece
+	for _, p := range pieces {
+		if countryCode, ok := state.reliable[p.StorageNode]; ok && !placement.AllowedCountry(countryCode) {
+			outOfPlacement = append(outOfPlacement, p)
+		}
+	}
+	return outOfPlacement, nil
+}
+
 func (cache *ReliabilityCache) loadFast(ctx context.Context, validUpTo time.Time) (_ *reliabilityState, err error) {
 	// This code is designed to be very fast in the case where a refresh is not needed: just an
 	// atomic load from rarely written to bit of shared memory. The general strategy is to first
@@ -121,10 +143,10 @@ func (cache *ReliabilityCache) refreshLocked(ctx context.Context) (_ *reliabilit
 
 	state := &reliabilityState{
 		created:  time.Now(),
-		reliable: make(map[storj.NodeID]struct{}, len(nodes)),
+		reliable: make(map[storj.NodeID]location.CountryCode, len(nodes)),
 	}
-	for _, id := range nodes {
-		state.reliable[id] = struct{}{}
+	for _, node := range nodes {
+		state.reliable[node.ID] = node.CountryCode
 	}
 
 	cache.state.Store(state)
@@ -12,7 +12,6 @@ import (
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
 
-	"storj.io/common/storj"
 	"storj.io/common/testcontext"
 	"storj.io/common/testrand"
 	"storj.io/storj/satellite/metabase"
@@ -24,7 +23,7 @@ func TestReliabilityCache_Concurrent(t *testing.T) {
 	ctx := testcontext.New(t)
 	defer ctx.Cleanup()
 
-	overlayCache, err := overlay.NewService(zap.NewNop(), fakeOverlayDB{}, fakeNodeEvents{}, nil, "", "", overlay.Config{
+	overlayCache, err := overlay.NewService(zap.NewNop(), fakeOverlayDB{}, fakeNodeEvents{}, nil, nil, "", "", overlay.Config{
 		NodeSelectionCache: overlay.UploadSelectionCacheConfig{
 			Staleness: 2 * time.Nanosecond,
 		},
@@ -55,11 +54,11 @@ func TestReliabilityCache_Concurrent(t *testing.T) {
 type fakeOverlayDB struct{ overlay.DB }
 type fakeNodeEvents struct{ nodeevents.DB }
 
-func (fakeOverlayDB) Reliable(context.Context, *overlay.NodeCriteria) (storj.NodeIDList, error) {
-	return storj.NodeIDList{
-		testrand.NodeID(),
-		testrand.NodeID(),
-		testrand.NodeID(),
-		testrand.NodeID(),
+func (fakeOverlayDB) Reliable(context.Context, *overlay.NodeCriteria) ([]*overlay.SelectedNode, error) {
+	return []*overlay.SelectedNode{
+		{ID: testrand.NodeID()},
+		{ID: testrand.NodeID()},
+		{ID: testrand.NodeID()},
+		{ID: testrand.NodeID()},
 	}, nil
 }
@@ -88,6 +88,22 @@ func TestRepairOverrideConfigValidation(t *testing.T) {
 			expectError:    false,
 			size:           2,
 		},
+		{
+			description:    "valid repair override config - placements",
+			overrideConfig: "2/5/20-3,1:2/5/20-4,3:1/4/10-2",
+			expectError:    false,
+			size:           3,
+		},
+		{
+			description:    "invalid repair override config - placement not a number",
+			overrideConfig: "EU:2/5/20-3",
+			expectError:    true,
+		},
+		{
+			description:    "invalid repair override config - negative placement",
+			overrideConfig: "-1:2/5/20-3",
+			expectError:    true,
+		},
 		{
 			description:    "valid repair override config - empty",
 			overrideConfig: "",
@@ -144,16 +160,42 @@ func TestRepairOverride(t *testing.T) {
 	}
 
 	ro := newOverrides.GetMap()
-	require.EqualValues(t, 25, ro.GetOverrideValue(storjSchemes[0]))
-	require.EqualValues(t, 25, ro.GetOverrideValuePB(pbSchemes[0]))
+	require.EqualValues(t, 25, ro.GetOverrideValue(storjSchemes[0], storj.EveryCountry))
+	require.EqualValues(t, 25, ro.GetOverrideValuePB(pbSchemes[0], storj.EveryCountry))
 
 	// second and third schemes should have the same override value (52) despite having a different repair threshold.
-	require.EqualValues(t, 52, ro.GetOverrideValue(storjSchemes[1]))
-	require.EqualValues(t, 52, ro.GetOverrideValuePB(pbSchemes[1]))
-	require.EqualValues(t, 52, ro.GetOverrideValue(storjSchemes[2]))
-	require.EqualValues(t, 52, ro.GetOverrideValuePB(pbSchemes[2]))
+	require.EqualValues(t, 52, ro.GetOverrideValue(storjSchemes[1], storj.EveryCountry))
+	require.EqualValues(t, 52, ro.GetOverrideValuePB(pbSchemes[1], storj.EveryCountry))
+	require.EqualValues(t, 52, ro.GetOverrideValue(storjSchemes[2], storj.EveryCountry))
+	require.EqualValues(t, 52, ro.GetOverrideValuePB(pbSchemes[2], storj.EveryCountry))
 
 	// fourth scheme has no matching override config.
-	require.EqualValues(t, 0, ro.GetOverrideValue(storjSchemes[3]))
-	require.EqualValues(t, 0, ro.GetOverrideValuePB(pbSchemes[3]))
+	require.EqualValues(t, 0, ro.GetOverrideValue(storjSchemes[3], storj.EveryCountry))
+	require.EqualValues(t, 0, ro.GetOverrideValuePB(pbSchemes[3], storj.EveryCountry))
+}
+
+func TestRepairOverridePlacement(t *testing.T) {
+	overrideConfig := "29/80/95-52,1:29/80/95-60,3:10/30/40-25"
+	newOverrides := checker.RepairOverrides{}
+	err := newOverrides.Set(overrideConfig)
+	require.NoError(t, err)
+	require.Equal(t, overrideConfig, newOverrides.String())
+
+	scheme := storj.RedundancyScheme{RequiredShares: 29, RepairShares: 35, OptimalShares: 80, TotalShares: 95}
+	pbScheme := &pb.RedundancyScheme{MinReq: 29, RepairThreshold: 35, SuccessThreshold: 80, Total: 95}
+	otherScheme := storj.RedundancyScheme{RequiredShares: 10, RepairShares: 20, OptimalShares: 30, TotalShares: 40}
+
+	ro := newOverrides.GetMap()
+
+	// the override of the placement takes precedence.
+	require.EqualValues(t, 60, ro.GetOverrideValue(scheme, storj.EU))
+	require.EqualValues(t, 60, ro.GetOverrideValuePB(pbScheme, storj.EU))
+
+	// other placements fall back to the override for any placement.
+	require.EqualValues(t, 52, ro.GetOverrideValue(scheme, storj.EveryCountry))
+	require.EqualValues(t, 52, ro.GetOverrideValuePB(pbScheme, storj.US))
+
+	// overrides of a placement don't apply to other placements.
+	require.EqualValues(t, 25, ro.GetOverrideValue(otherScheme, storj.US))
+	require.EqualValues(t, 0, ro.GetOverrideValue(otherScheme, storj.EU))
 }
@@ -2558,6 +2558,96 @@ func TestECRepairerGetCorrupted(t *testing.T) {
 	})
 }
 
+func TestECRepairerGetSwappedPiece(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount:   1,
+		StorageNodeCount: 6,
+		UplinkCount:      1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: testplanet.ReconfigureRS(3, 3, 6, 6),
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		uplinkPeer := planet.Uplinks[0]
+		satellite := planet.Satellites[0]
+
+		// stop audit to prevent possible interactions i.e. repair timeout problems
+		satellite.Audit.Worker.Loop.Pause()
+		satellite.Repair.Checker.Loop.Pause()
+		satellite.Repair.Repairer.Loop.Pause()
+
+		// upload two objects of the same size, so every node stores a piece
+		// of the same size of both segments.
+		require.NoError(t, uplinkPeer.Upload(ctx, satellite, "testbucket", "first", testrand.Bytes(8*memory.KiB)))
+		require.NoError(t, uplinkPeer.Upload(ctx, satellite, "testbucket", "second", testrand.Bytes(8*memory.KiB)))
+
+		segments, err := satellite.Metabase.DB.TestingAllSegments(ctx)
+		require.NoError(t, err)
+		require.Len(t, segments, 2)
+		segment, other := segments[0], segments[1]
+		require.Equal(t, 6, len(segment.Pieces))
+
+		// the node serves the piece of the other segment with its valid hash
+		// and original order limit in place of the piece of the segment.
+		swappedPiece := segment.Pieces[0]
+		var otherPiece metabase.Piece
+		for _, piece := range other.Pieces {
+			if piece.StorageNode == swappedPiece.StorageNode {
+				otherPiece = piece
+			}
+		}
+		require.False(t, otherPiece.StorageNode.IsZero())
+
+		swappedNode := planet.FindNode(swappedPiece.StorageNode)
+		require.NotNil(t, swappedNode)
+
+		// kill nodes, so the repairer needs the swapped piece
+		for _, piece := range segment.Pieces[len(segment.Pieces)-2:] {
+			err := planet.StopNodeAndUpdate(ctx, planet.FindNode(piece.StorageNode))
+			require.NoError(t, err)
+		}
+
+		namespace := satellite.ID().Bytes()
+		pieceRef := storage.BlobRef{
+			Namespace: namespace,
+			Key:       segment.RootPieceID.Derive(swappedPiece.StorageNode, int32(swappedPiece.Number)).Bytes(),
+		}
+		otherPieceRef := storage.BlobRef{
+			Namespace: namespace,
+			Key:       other.RootPieceID.Derive(otherPiece.StorageNode, int32(otherPiece.Number)).Bytes(),
+		}
+
+		reader, err := swappedNode.Storage2.BlobsCache.Open(ctx, otherPieceRef)
+		require.NoError(t, err)
+		otherPieceData, err := io.ReadAll(reader)
+		require.NoError(t, err)
+		require.NoError(t, reader.Close())
+
+		require.NoError(t, swappedNode.Storage2.BlobsCache.Delete(ctx, pieceRef))
+		writer, err := swappedNode.Storage2.BlobsCache.Create(ctx, pieceRef, int64(len(otherPieceData)))
+		require.NoError(t, err)
+		_, err = writer.Write(otherPieceData)
+		require.NoError(t, err)
+		require.NoError(t, writer.Commit(ctx))
+
+		ecRepairer := satellite.Repairer.EcRepairer
+
+		redundancy, err := eestream.NewRedundancyStrategyFromStorj(segment.Redundancy)
+		require.NoError(t, err)
+		getOrderLimits, getPrivateKey, cachedIPsAndPorts, err := satellite.Orders.Service.CreateGetRepairOrderLimits(ctx, metabase.BucketLocation{}, segment, segment.Pieces)
+		require.NoError(t, err)
+
+		_, piecesReport, err := ecRepairer.Get(ctx, getOrderLimits, cachedIPsAndPorts, getPrivateKey, redundancy, int64(segment.EncryptedSize))
+		require.NoError(t, err)
+		require.Equal(t, 0, len(piecesReport.Offline))
+		require.Equal(t, 0, len(piecesReport.Contained))
+		require.Equal(t, 0, len(piecesReport.Unknown))
+		require.Equal(t, int(segment.Redundancy.RequiredShares), len(piecesReport.Successful))
+		require.Equal(t, 1, len(piecesReport.Failed))
+		require.Equal(t, swappedPiece, piecesReport.Failed[0].Piece)
+		require.True(t, repairer.ErrOrderLimitVerifyFailed.Has(piecesReport.Failed[0].Err), piecesReport.Failed[0].Err)
+	})
+}
+
 func TestECRepairerGetMissingPiece(t *testing.T) {
 	testplanet.Run(t, testplanet.Config{
 		SatelliteCount:   1,
@@ -37,6 +37,9 @@ var (
 	// ErrPieceHashVerifyFailed is the errs class when a piece hash downloaded from storagenode fails to match the original hash.
 	ErrPieceHashVerifyFailed = errs.Class("piece hashes don't match")
 
+	// ErrOrderLimitVerifyFailed is the errs class when the original order limit downloaded from storagenode is invalid or doesn't belong to the piece.
+	ErrOrderLimitVerifyFailed = errs.Class("original order limit is invalid")
+
 	// ErrDialFailed is the errs class when a failure happens during Dial.
 	ErrDialFailed = errs.Class("dial failure")
 )
@@ -156,7 +159,8 @@ func (ec *ECRepairer) Get(ctx context.Context, limits []*pb.AddressedOrderLimit,
 					}
 
 					// gather nodes where the calculated piece hash doesn't match the uplink signed piece hash
-					if ErrPieceHashVerifyFailed.Has(err) {
+					// or which sent an order limit, which isn't the original one of the piece
+					if ErrPieceHashVerifyFailed.Has(err) || ErrOrderLimitVerifyFailed.Has(err) {
 						ec.log.Info("audit failed",
 							zap.Stringer("node ID", limit.GetLimit().StorageNodeId),
 							zap.Stringer("Piece ID", limit.Limit.PieceId),
@@ -325,15 +329,20 @@ func (ec *ECRepairer) downloadAndVerifyPiece(ctx context.Context, limit *pb.Addr
 	// get signed piece hash and original order limit
 	hash, originalLimit = downloader.GetHashAndLimit()
 	if hash == nil {
-		return pieceReadCloser, hash, originalLimit, Error.New("hash was not sent from storagenode")
+		return pieceReadCloser, hash, originalLimit, ErrPieceHashVerifyFailed.New("hash was not sent from storagenode")
 	}
 	if originalLimit == nil {
-		return pieceReadCloser, hash, originalLimit, Error.New("original order limit was not sent from storagenode")
+		return pieceReadCloser, hash, originalLimit, ErrOrderLimitVerifyFailed.New("original order limit was not sent from storagenode")
 	}
 
 	// verify order limit from storage node is signed by the satellite
 	if err := verifyOrderLimitSignature(ctx, ec.satelliteSignee, originalLimit); err != nil {
-		return pieceReadCloser, hash, originalLimit, err
+		return pieceReadCloser, hash, originalLimit, ErrOrderLimitVerifyFailed.Wrap(err)
+	}
+
+	// verify order limit from storage node is the one the piece was uploaded with
+	if err := verifyOriginalOrderLimit(ctx, limit.GetLimit(), originalLimit); err != nil {
+		return pieceReadCloser, hash, originalLimit, ErrOrderLimitVerifyFailed.Wrap(err)
 	}
 
 	// verify the hashes from storage node
@@ -374,6 +383,29 @@ func verifyOrderLimitSignature(ctx context.Context, satellite signing.Signee, li
 	return nil
 }
 
+// verifyOriginalOrderLimit verifies that the original order limit sent by the
+// storage node was issued for uploading the piece, which was requested with the
+// repair order limit.
+func verifyOriginalOrderLimit(ctx context.Context, limit, originalLimit *pb.OrderLimit) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	switch {
+	case originalLimit.PieceId != limit.PieceId:
+		return Error.New("original order limit is for piece %s, expected %s", originalLimit.PieceId, limit.PieceId)
+	case originalLimit.StorageNodeId != limit.StorageNodeId:
+		return Error.New("original order limit is for node %s, expected %s", originalLimit.StorageNodeId, limit.StorageNodeId)
+	case originalLimit.SatelliteId != limit.SatelliteId:
+		return Error.New("original order limit is from satellite %s, expected %s", originalLimit.SatelliteId, limit.SatelliteId)
+	}
+
+	switch originalLimit.Action {
+	case pb.PieceAction_PUT, pb.PieceAction_PUT_REPAIR, pb.PieceAction_PUT_GRACEFUL_EXIT:
+		return nil
+	default:
+		return Error.New("original order limit has action %s, expected an upload", originalLimit.Action)
+	}
+}
+
 // Repair takes a provided segment, encodes it with the provided redundancy strategy,
 // and uploads the pieces in need of repair to new nodes provided by order limits.
 func (ec *ECRepairer) Repair(ctx context.Context, limits []*pb.AddressedOrderLimit, privateKey storj.PiecePrivateKey, rs eestream.RedundancyStrategy, data io.Reader, timeout time.Duration, successfulNeeded int) (successfulNodes []*pb.Node, successfulHashes []*pb.PieceHash, err error) {
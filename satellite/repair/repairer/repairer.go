@@ -35,6 +35,7 @@ type Config struct {
 	MaxExcessRateOptimalThreshold float64       `help:"ratio applied to the optimal threshold to calculate the excess of the maximum number of repaired pieces to upload" default:"0.05"`
 	InMemoryRepair                bool          `help:"whether to download pieces for repair in memory (true) or download to disk (false)" default:"false"`
 	ReputationUpdateEnabled       bool          `help:"whether the audit score of nodes should be updated as a part of repair" default:"false"`
+	SpreadOversampling            float64       `help:"ratio of additional candidate nodes requested for the repaired pieces, to select the ones improving the geographic spread of the segment, zero disables it" default:"1"`
 }
 
 // Service contains the information needed to run the repair service.
@@ -142,7 +143,8 @@ func (service *Service) process(ctx context.Context) (err error) {
 		defer service.JobLimiter.Release(1)
 		defer cancel()
 
-		if err := service.worker(ctx, seg); err != nil {
+		// every repair job is a separate trace, so it can be sampled on its own.
+		if err := service.worker(monkit.ResetContextSpan(ctx), seg); err != nil {
 			service.log.Error("repair worker failed:", zap.Error(err))
 		}
 	}()
@@ -18,10 +18,12 @@ import (
 	"storj.io/common/pb"
 	"storj.io/common/storj"
 	"storj.io/common/sync2"
+	"storj.io/storj/private/nodecapabilities"
 	"storj.io/storj/satellite/audit"
 	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/orders"
 	"storj.io/storj/satellite/overlay"
+	"storj.io/storj/satellite/repair"
 	"storj.io/storj/satellite/repair/checker"
 	"storj.io/storj/satellite/repair/queue"
 	"storj.io/uplink/private/eestream"
@@ -91,6 +93,10 @@ type SegmentRepairer struct {
 	// repaired pieces
 	multiplierOptimalThreshold float64
 
+	// spreadOversampling is the ratio of additional candidate nodes requested
+	// to improve the geographic spread of the repaired segment.
+	spreadOversampling float64
+
 	// repairOverrides is the set of values configured by the checker to override the repair threshold for various RS schemes.
 	repairOverrides checker.RepairOverridesMap
 
@@ -120,6 +126,11 @@ func NewSegmentRepairer(
 		excessOptimalThreshold = 0
 	}
 
+	spreadOversampling := config.SpreadOversampling
+	if spreadOversampling < 0 {
+		spreadOversampling = 0
+	}
+
 	return &SegmentRepairer{
 		log:                        log,
 		statsCollector:             newStatsCollector(),
@@ -129,6 +140,7 @@ func NewSegmentRepairer(
 		ec:                         ecRepairer,
 		timeout:                    config.Timeout,
 		multiplierOptimalThreshold: 1 + excessOptimalThreshold,
+		spreadOversampling:         spreadOversampling,
 		repairOverrides:            repairOverrides.GetMap(),
 		reporter:                   reporter,
 		reputationUpdateEnabled:    config.ReputationUpdateEnabled,
@@ -181,7 +193,7 @@ func (repairer *SegmentRepairer) Repair(ctx context.Context, queueSegment *queue
 		RepairThreshold:  int32(segment.Redundancy.RepairShares),
 		SuccessThreshold: int32(segment.Redundancy.OptimalShares),
 		Total:            int32(segment.Redundancy.TotalShares),
-	})
+	}, segment.Placement)
 
 	mon.Meter("repair_attempts").Mark(1) //mon:locked
 	stats.repairAttempts.Mark(1)
@@ -219,6 +231,21 @@ func (repairer *SegmentRepairer) Repair(ctx context.Context, queueSegment *queue
 
 	numHealthyInExcludedCountries := len(piecesInExcludedCountries)
 
+	// the pieces outside of the placement of the segment are replaced by
+	// pieces on nodes within the placement and removed after a full repair.
+	piecesOutOfPlacement, err := repairer.overlay.GetPiecesOutOfPlacement(ctx, pieces, segment.Placement)
+	if err != nil {
+		return false, overlayQueryError.New("error identifying pieces out of placement: %w", err)
+	}
+	inExcludedCountriesSet := sliceToSet(piecesInExcludedCountries)
+	outOfPlacementSet := make(map[uint16]bool, len(piecesOutOfPlacement))
+	for _, number := range piecesOutOfPlacement {
+		if !inExcludedCountriesSet[number] {
+			outOfPlacementSet[number] = true
+		}
+	}
+	numOutOfPlacement := len(outOfPlacementSet)
+
 	// ensure we get values, even if only zero values, so that redash can have an alert based on this
 	mon.Counter("repairer_segments_below_min_req").Inc(0) //mon:locked
 	stats.repairerSegmentsBelowMinReq.Inc(0)
@@ -231,13 +258,13 @@ func (repairer *SegmentRepairer) Repair(ctx context.Context, queueSegment *queue
 		SuccessThreshold: int32(segment.Redundancy.OptimalShares),
 		Total:            int32(segment.Redundancy.TotalShares),
 	}
-	overrideValue := repairer.repairOverrides.GetOverrideValuePB(pbRedundancy)
+	overrideValue := repairer.repairOverrides.GetOverrideValuePB(pbRedundancy, segment.Placement)
 	if overrideValue != 0 {
 		repairThreshold = overrideValue
 	}
 
 	// repair not needed
-	if numHealthy-numHealthyInExcludedCountries > int(repairThreshold) {
+	if numHealthy-numHealthyInExcludedCountries > int(repairThreshold) && len(piecesOutOfPlacement) == 0 {
 		mon.Meter("repair_unnecessary").Mark(1) //mon:locked
 		stats.repairUnnecessary.Mark(1)
 		repairer.log.Debug("segment above repair threshold", zap.Int("numHealthy", numHealthy), zap.Int32("repairThreshold", repairThreshold))
@@ -300,22 +327,36 @@ func (repairer *SegmentRepairer) Repair(ctx context.Context, queueSegment *queue
 	var minSuccessfulNeeded int
 	{
 		totalNeeded := math.Ceil(float64(redundancy.OptimalThreshold()) * repairer.multiplierOptimalThreshold)
-		requestCount = int(totalNeeded) - len(healthyPieces) + numHealthyInExcludedCountries
-		minSuccessfulNeeded = redundancy.OptimalThreshold() - len(healthyPieces) + numHealthyInExcludedCountries
+		requestCount = int(totalNeeded) - len(healthyPieces) + numHealthyInExcludedCountries + numOutOfPlacement
+		minSuccessfulNeeded = redundancy.OptimalThreshold() - len(healthyPieces) + numHealthyInExcludedCountries + numOutOfPlacement
 	}
 
-	// Request Overlay for n-h new storage nodes
+	// Request Overlay for n-h new storage nodes, with additional candidates
+	// to select the ones improving the spread of the segment.
 	request := overlay.FindStorageNodesRequest{
-		RequestedCount: requestCount,
+		RequestedCount: requestCount + int(math.Ceil(float64(requestCount)*repairer.spreadOversampling)),
 		ExcludedIDs:    excludeNodeIDs,
-	}
-	newNodes, err := repairer.overlay.FindStorageNodesForUpload(ctx, request)
-	if err != nil {
+		Placement:      segment.Placement,
+		Requires: nodecapabilities.Requirements{
+			TTL:       segment.ExpiresAt != nil,
+			PieceSize: eestream.CalcPieceSize(int64(segment.EncryptedSize), redundancy),
+		},
+	}
+	candidates, err := repairer.overlay.FindStorageNodesForUpload(ctx, request)
+	if err != nil && !(overlay.ErrNotEnoughNodes.Has(err) && len(candidates) >= requestCount) {
 		return false, overlayQueryError.Wrap(err)
 	}
 
+	healthyLocations := make([]repair.NodeLocation, 0, len(healthyPieces))
+	for _, piece := range healthyPieces {
+		if info, ok := cachedNodesInfo[piece.StorageNode]; ok {
+			healthyLocations = append(healthyLocations, repair.NodeLocation{CountryCode: info.CountryCode, LastNet: info.LastNet})
+		}
+	}
+	newNodes := selectForSpread(healthyLocations, candidates, requestCount)
+
 	// Create the order limits for the PUT_REPAIR action
-	putLimits, putPrivateKey, err := repairer.orders.CreatePutRepairOrderLimits(ctx, metabase.BucketLocation{}, segment, getOrderLimits, newNodes, repairer.multiplierOptimalThreshold, numHealthyInExcludedCountries)
+	putLimits, putPrivateKey, err := repairer.orders.CreatePutRepairOrderLimits(ctx, metabase.BucketLocation{}, segment, getOrderLimits, newNodes, repairer.multiplierOptimalThreshold, numHealthyInExcludedCountries+numOutOfPlacement)
 	if err != nil {
 		return false, orderLimitFailureError.New("could not create PUT_REPAIR order limits: %w", err)
 	}
@@ -465,6 +506,12 @@ func (repairer *SegmentRepairer) Repair(ctx context.Context, queueSegment *queue
 	pieceSize := eestream.CalcPieceSize(int64(segment.EncryptedSize), redundancy)
 	var bytesRepaired int64
 
+	newNodeLocations := make(map[storj.NodeID]repair.NodeLocation, len(newNodes))
+	for _, node := range newNodes {
+		newNodeLocations[node.ID] = repair.NodeLocation{CountryCode: node.CountryCode, LastNet: node.LastNet}
+	}
+	repairedLocations := append([]repair.NodeLocation(nil), healthyLocations...)
+
 	// Add the successfully uploaded pieces to repairedPieces
 	var repairedPieces metabase.Pieces
 	repairedMap := make(map[uint16]bool)
@@ -472,6 +519,7 @@ func (repairer *SegmentRepairer) Repair(ctx context.Context, queueSegment *queue
 		if node == nil {
 			continue
 		}
+		repairedLocations = append(repairedLocations, newNodeLocations[node.Id])
 		bytesRepaired += pieceSize
 		piece := metabase.Piece{
 			Number:      uint16(i),
@@ -513,6 +561,16 @@ func (repairer *SegmentRepairer) Repair(ctx context.Context, queueSegment *queue
 	if healthyAfterRepair >= int(segment.Redundancy.OptimalShares) {
 		// if full repair, remove all unhealthy pieces
 		toRemove = unhealthyPieces
+
+		// and the pieces out of placement, when the segment is fully
+		// repaired without them.
+		if healthyAfterRepair-numOutOfPlacement >= int(segment.Redundancy.OptimalShares) {
+			for _, piece := range healthyPieces {
+				if outOfPlacementSet[piece.Number] {
+					toRemove = append(toRemove, piece)
+				}
+			}
+		}
 	} else {
 		// if partial repair, leave unrepaired unhealthy pieces in the pointer
 		for _, piece := range unhealthyPieces {
@@ -534,6 +592,12 @@ func (repairer *SegmentRepairer) Repair(ctx context.Context, queueSegment *queue
 		return false, repairPutError.Wrap(err)
 	}
 
+	// the unhealthy pieces, which are kept after a partial repair, aren't
+	// scored, because they don't add to the durability of the segment.
+	spreadScore := repair.SpreadScore(repairedLocations)
+	mon.IntVal("repair_spread_score_before").Observe(int64(repair.SpreadScore(healthyLocations)))
+	mon.IntVal("repair_spread_score_after").Observe(int64(spreadScore))
+
 	err = repairer.metabase.UpdateSegmentPieces(ctx, metabase.UpdateSegmentPieces{
 		StreamID: segment.StreamID,
 		Position: segment.Position,
@@ -543,6 +607,8 @@ func (repairer *SegmentRepairer) Repair(ctx context.Context, queueSegment *queue
 		NewPieces:     newPieces,
 
 		NewRepairedAt: time.Now(),
+
+		NewSpreadScore: int32(spreadScore),
 	})
 	if err != nil {
 		return false, metainfoPutError.Wrap(err)
@@ -597,14 +663,14 @@ func (repairer *SegmentRepairer) checkIfSegmentAltered(ctx context.Context, oldS
 	return nil
 }
 
-func (repairer *SegmentRepairer) getStatsByRS(redundancy *pb.RedundancyScheme) *stats {
-	rsString := getRSString(repairer.loadRedundancy(redundancy))
+func (repairer *SegmentRepairer) getStatsByRS(redundancy *pb.RedundancyScheme, placement storj.PlacementConstraint) *stats {
+	rsString := getRSString(repairer.loadRedundancy(redundancy, placement))
 	return repairer.statsCollector.getStatsByRS(rsString)
 }
 
-func (repairer *SegmentRepairer) loadRedundancy(redundancy *pb.RedundancyScheme) (int, int, int, int) {
+func (repairer *SegmentRepairer) loadRedundancy(redundancy *pb.RedundancyScheme, placement storj.PlacementConstraint) (int, int, int, int) {
 	repair := int(redundancy.RepairThreshold)
-	overrideValue := repairer.repairOverrides.GetOverrideValuePB(redundancy)
+	overrideValue := repairer.repairOverrides.GetOverrideValuePB(redundancy, placement)
 	if overrideValue != 0 {
 		repair = int(overrideValue)
 	}
@@ -709,3 +775,22 @@ type commaSeparatedArray []string
 func (c commaSeparatedArray) String() string {
 	return strings.Join(c, ", ")
 }
+
+// selectForSpread selects count of the candidate nodes, which improve the
+// spread of the pieces stored on the nodes at existing the most.
+func selectForSpread(existing []repair.NodeLocation, candidates []*overlay.SelectedNode, count int) []*overlay.SelectedNode {
+	if len(candidates) <= count {
+		return candidates
+	}
+
+	locations := make([]repair.NodeLocation, len(candidates))
+	for i, node := range candidates {
+		locations[i] = repair.NodeLocation{CountryCode: node.CountryCode, LastNet: node.LastNet}
+	}
+
+	selected := make([]*overlay.SelectedNode, 0, count)
+	for _, i := range repair.SelectForSpread(existing, locations, count) {
+		selected = append(selected, candidates[i])
+	}
+	return selected
+}
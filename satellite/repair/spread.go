@@ -0,0 +1,87 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package repair
+
+import "storj.io/common/storj/location"
+
+// NodeLocation is the location of a node, which stores or may store a piece
+// of a segment.
+//
+// The network of the node is used as an approximation of its autonomous
+// system, which isn't tracked for the nodes.
+type NodeLocation struct {
+	CountryCode location.CountryCode
+	LastNet     string
+}
+
+// SpreadScore returns the geographic spread of the pieces, stored on the
+// nodes at the locations: the number of distinct countries. Nodes in unknown
+// countries aren't counted.
+func SpreadScore(locations []NodeLocation) int {
+	countries := map[location.CountryCode]struct{}{}
+	for _, loc := range locations {
+		if loc.CountryCode != location.None {
+			countries[loc.CountryCode] = struct{}{}
+		}
+	}
+	return len(countries)
+}
+
+// SelectForSpread selects count of the candidates, which improve the spread of
+// the pieces stored on the nodes at existing the most. Candidates in
+// countries without pieces are preferred, then candidates in networks without
+// pieces. The order of the candidates decides between equally good ones, so
+// they should be randomly ordered.
+//
+// It returns the indexes of the selected candidates in the order of
+// selection.
+func SelectForSpread(existing, candidates []NodeLocation, count int) []int {
+	if count > len(candidates) {
+		count = len(candidates)
+	}
+
+	countries := map[location.CountryCode]struct{}{}
+	networks := map[string]struct{}{}
+	add := func(loc NodeLocation) {
+		if loc.CountryCode != location.None {
+			countries[loc.CountryCode] = struct{}{}
+		}
+		if loc.LastNet != "" {
+			networks[loc.LastNet] = struct{}{}
+		}
+	}
+	for _, loc := range existing {
+		add(loc)
+	}
+
+	improvement := func(loc NodeLocation) int {
+		score := 0
+		if _, ok := countries[loc.CountryCode]; !ok && loc.CountryCode != location.None {
+			score += 2
+		}
+		if _, ok := networks[loc.LastNet]; !ok && loc.LastNet != "" {
+			score++
+		}
+		return score
+	}
+
+	selected := make([]int, 0, count)
+	used := make([]bool, len(candidates))
+	for len(selected) < count {
+		best, bestScore := -1, -1
+		for i, loc := range candidates {
+			if used[i] {
+				continue
+			}
+			if score := improvement(loc); score > bestScore {
+				best, bestScore = i, score
+			}
+		}
+
+		used[best] = true
+		add(candidates[best])
+		selected = append(selected, best)
+	}
+	return selected
+}
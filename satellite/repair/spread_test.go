@@ -0,0 +1,46 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package repair_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/storj/location"
+	"storj.io/storj/satellite/repair"
+)
+
+func TestSpreadScore(t *testing.T) {
+	require.Equal(t, 0, repair.SpreadScore(nil))
+	require.Equal(t, 0, repair.SpreadScore([]repair.NodeLocation{{LastNet: "10.0.0.0"}}))
+	require.Equal(t, 2, repair.SpreadScore([]repair.NodeLocation{
+		{CountryCode: location.Germany, LastNet: "10.0.0.0"},
+		{CountryCode: location.Germany, LastNet: "10.0.1.0"},
+		{CountryCode: location.UnitedStates, LastNet: "10.0.2.0"},
+		{LastNet: "10.0.3.0"},
+	}))
+}
+
+func TestSelectForSpread(t *testing.T) {
+	existing := []repair.NodeLocation{
+		{CountryCode: location.Germany, LastNet: "10.0.0.0"},
+		{CountryCode: location.UnitedStates, LastNet: "10.0.1.0"},
+	}
+	candidates := []repair.NodeLocation{
+		{CountryCode: location.Germany, LastNet: "10.0.0.0"},     // 0: nothing new
+		{CountryCode: location.Germany, LastNet: "10.0.9.0"},     // 1: new network
+		{CountryCode: location.France, LastNet: "10.0.1.0"},      // 2: new country
+		{CountryCode: location.France, LastNet: "10.0.8.0"},      // 3: new country and network
+		{CountryCode: location.Netherlands, LastNet: "10.0.7.0"}, // 4: new country and network
+	}
+
+	// the first of the equally good candidates wins, and the selected ones
+	// are taken into account: France isn't new after selecting 3.
+	require.Equal(t, []int{3, 4, 1, 0}, repair.SelectForSpread(existing, candidates, 4))
+	require.Equal(t, []int{3, 4, 1, 0, 2}, repair.SelectForSpread(existing, candidates, 10))
+	require.Empty(t, repair.SelectForSpread(existing, candidates, 0))
+
+	require.Equal(t, 4, repair.SpreadScore(append(existing, candidates[3], candidates[4])))
+}
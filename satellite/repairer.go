@@ -23,17 +23,21 @@ import (
 	"storj.io/private/debug"
 	"storj.io/private/version"
 	"storj.io/storj/private/lifecycle"
+	"storj.io/storj/private/otlp"
 	version_checker "storj.io/storj/private/version/checker"
 	"storj.io/storj/satellite/audit"
 	"storj.io/storj/satellite/buckets"
+	"storj.io/storj/satellite/events"
 	"storj.io/storj/satellite/mailservice"
 	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/nodedialer"
 	"storj.io/storj/satellite/nodeevents"
 	"storj.io/storj/satellite/orders"
 	"storj.io/storj/satellite/overlay"
 	"storj.io/storj/satellite/repair/queue"
 	"storj.io/storj/satellite/repair/repairer"
 	"storj.io/storj/satellite/reputation"
+	"storj.io/storj/satellite/secrets"
 )
 
 // Repairer is the repairer process.
@@ -46,7 +50,8 @@ type Repairer struct {
 	Servers  *lifecycle.Group
 	Services *lifecycle.Group
 
-	Dialer rpc.Dialer
+	Dialer     rpc.Dialer
+	NodeDialer *nodedialer.Dialer
 
 	Version struct {
 		Chore   *version_checker.Chore
@@ -58,7 +63,16 @@ type Repairer struct {
 		Server   *debug.Server
 	}
 
+	Tracing struct {
+		Exporter *otlp.Exporter
+	}
+
+	Secrets struct {
+		Chore *secrets.Chore
+	}
+
 	Mail       *mailservice.Service
+	Events     *events.Bus
 	Overlay    *overlay.Service
 	Reputation *reputation.Service
 	Orders     struct {
@@ -77,7 +91,7 @@ type Repairer struct {
 }
 
 // NewRepairer creates a new repairer peer.
-func NewRepairer(log *zap.Logger, full *identity.FullIdentity,
+func NewRepairer(ctx context.Context, log *zap.Logger, full *identity.FullIdentity,
 	metabaseDB *metabase.DB,
 	revocationDB extensions.RevocationDB,
 	repairQueue queue.RepairQueue,
@@ -116,6 +130,37 @@ func NewRepairer(log *zap.Logger, full *identity.FullIdentity,
 		})
 	}
 
+	var peerSecrets *secretsLoader
+	{ // setup secrets
+		var err error
+		peerSecrets, err = newSecretsLoader(ctx, log.Named("secrets:chore"), config.Secrets)
+		if err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
+		peer.Secrets.Chore = peerSecrets.Chore
+		if peer.Secrets.Chore != nil {
+			peer.Services.Add(lifecycle.Item{
+				Name:  "secrets:chore",
+				Run:   peer.Secrets.Chore.Run,
+				Close: peer.Secrets.Chore.Close,
+			})
+			peer.Debug.Server.Panel.Add(
+				debug.Cycle("Secrets Chore", peer.Secrets.Chore.Loop))
+		}
+	}
+
+	{ // setup trace export
+		var err error
+		peer.Tracing.Exporter, err = otlp.NewExporter(log.Named("tracing"), monkit.Default, config.OTLP, "satellite-repairer")
+		if err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
+		peer.Services.Add(lifecycle.Item{
+			Name: "tracing",
+			Run:  peer.Tracing.Exporter.Run,
+		})
+	}
+
 	{
 		peer.Log.Info("Version info",
 			zap.Stringer("Version", versionInfo.Version.Version),
@@ -140,7 +185,13 @@ func NewRepairer(log *zap.Logger, full *identity.FullIdentity,
 			return nil, errs.Combine(err, peer.Close())
 		}
 
-		peer.Dialer = rpc.NewDefaultDialer(tlsOptions)
+		peer.NodeDialer = nodedialer.New(config.NodeDialer)
+		peer.Services.Add(lifecycle.Item{
+			Name:  "node-dialer",
+			Close: peer.NodeDialer.Close,
+		})
+
+		peer.Dialer = peer.NodeDialer.RPCDialer(tlsOptions)
 	}
 
 	{ // setup mail
@@ -156,9 +207,21 @@ func NewRepairer(log *zap.Logger, full *identity.FullIdentity,
 		})
 	}
 
+	{ // setup events
+		var err error
+		peer.Events, err = events.NewBus(peer.Log.Named("events:bus"), config.Events)
+		if err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
+		peer.Services.Add(lifecycle.Item{
+			Name: "events:bus",
+			Run:  peer.Events.Run,
+		})
+	}
+
 	{ // setup overlay
 		var err error
-		peer.Overlay, err = overlay.NewService(log.Named("overlay"), overlayCache, nodeEvents, peer.Mail, config.Console.ExternalAddress, config.Console.SatelliteName, config.Overlay)
+		peer.Overlay, err = overlay.NewService(log.Named("overlay"), overlayCache, nodeEvents, peer.Events, peer.Mail, config.Console.ExternalAddress, config.Console.SatelliteName, config.Overlay)
 		if err != nil {
 			return nil, errs.Combine(err, peer.Close())
 		}
@@ -201,17 +264,21 @@ func NewRepairer(log *zap.Logger, full *identity.FullIdentity,
 		peer.Debug.Server.Panel.Add(
 			debug.Cycle("Orders Chore", peer.Orders.Chore.Loop))
 
-		var err error
+		ordersConfig, err := peerSecrets.ordersConfig(ctx, config.Orders)
+		if err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
 		peer.Orders.Service, err = orders.NewService(
 			log.Named("orders"),
 			signing.SignerFromFullIdentity(peer.Identity),
 			peer.Overlay,
 			peer.Orders.DB,
-			config.Orders,
+			ordersConfig,
 		)
 		if err != nil {
 			return nil, errs.Combine(err, peer.Close())
 		}
+		peerSecrets.watchOrders(peer.Orders.Service)
 	}
 
 	{ // setup audit
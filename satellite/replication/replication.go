@@ -0,0 +1,98 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package replication implements mirroring of new objects from a bucket to
+// a bucket in another project or on another satellite.
+package replication
+
+import (
+	"context"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+)
+
+var (
+	// Error is the error class for bucket replication.
+	Error = errs.Class("replication")
+	// ErrRuleNotFound is returned when the bucket has no replication rule.
+	ErrRuleNotFound = errs.Class("replication rule not found")
+	// ErrRuleExists is returned when the bucket already has a replication rule.
+	ErrRuleExists = errs.Class("replication rule already exists")
+
+	mon = monkit.Package()
+)
+
+// Access grant purposes, which bind the sealed access grants of a rule to
+// their use.
+const (
+	// SourceAccessPurpose is the purpose of the sealed source access grant.
+	SourceAccessPurpose = "replication-source"
+	// DestinationAccessPurpose is the purpose of the sealed destination
+	// access grant.
+	DestinationAccessPurpose = "replication-destination"
+)
+
+// Rule replicates the objects of a bucket to the destination bucket.
+//
+// The source access must allow listing and downloading the objects of the
+// bucket, the destination access must allow uploading to the destination
+// bucket. The destination may be on another satellite. Both access grants
+// are stored sealed with sealedaccess, see SourceAccessPurpose and
+// DestinationAccessPurpose.
+type Rule struct {
+	ProjectID  uuid.UUID
+	BucketName string
+
+	SourceAccess      string
+	DestinationAccess string
+	DestinationBucket string
+
+	CreatedAt time.Time
+	Status    Status
+}
+
+// Status is the progress of the replication of a bucket.
+//
+// The bucket is replicated in passes over all of its objects in the listing
+// order. A pass compares every object with its replica and copies the
+// missing and outdated ones. A pass may span several runs.
+type Status struct {
+	// Cursor is the key of the last object of the current pass, which has
+	// been replicated. It's empty, when the next run starts a new pass.
+	Cursor string
+	// PassStartedAt is when the current pass has started.
+	PassStartedAt *time.Time
+	// ReplicatedUntil is when the last completed pass has started, i.e. all
+	// the objects committed before it have been replicated.
+	ReplicatedUntil time.Time
+	// ReplicatedObjects is the number of replicated objects.
+	ReplicatedObjects int64
+	// Backlog is the number of objects waiting for replication, which were
+	// found by the last run.
+	Backlog int64
+	// LastRunAt is when the bucket has been replicated the last time.
+	LastRunAt *time.Time
+	// LastError is the error of the last run, when it has failed.
+	LastError string
+}
+
+// DB stores the replication rules and their status.
+//
+// architecture: Database
+type DB interface {
+	// Create adds a rule. Only the objects created after the rule are
+	// replicated.
+	Create(ctx context.Context, rule Rule) error
+	// Get returns the rule of the bucket.
+	Get(ctx context.Context, projectID uuid.UUID, bucketName string) (Rule, error)
+	// Delete removes the rule of the bucket.
+	Delete(ctx context.Context, projectID uuid.UUID, bucketName string) error
+	// List returns all the rules.
+	List(ctx context.Context) ([]Rule, error)
+	// UpdateStatus updates the status of the rule of the bucket.
+	UpdateStatus(ctx context.Context, projectID uuid.UUID, bucketName string, status Status) error
+}
@@ -0,0 +1,297 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package replication
+
+import (
+	"context"
+	"errors"
+	"io"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/sync2"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/sealedaccess"
+	"storj.io/uplink"
+)
+
+// Config contains configurable values for bucket replication.
+type Config struct {
+	Enabled          bool          `help:"whether buckets with a replication rule are replicated" default:"false"`
+	Interval         time.Duration `help:"how often new objects are replicated" releaseDefault:"5m" devDefault:"1m" testDefault:"$TESTINTERVAL"`
+	MaxObjectsPerRun int           `help:"maximum number of objects replicated per bucket and run" default:"1000"`
+	MaxCheckedPerRun int           `help:"maximum number of objects compared with their replica per bucket and run" default:"10000"`
+}
+
+// Service copies new objects of the buckets with a replication rule to their
+// destination bucket.
+//
+// architecture: Chore
+type Service struct {
+	log    *zap.Logger
+	db     DB
+	sealer *sealedaccess.Sealer
+	config Config
+	Loop   *sync2.Cycle
+
+	nowFn func() time.Time
+}
+
+// NewService creates a new bucket replication service.
+func NewService(log *zap.Logger, db DB, sealer *sealedaccess.Sealer, config Config) *Service {
+	return &Service{
+		log:    log,
+		db:     db,
+		sealer: sealer,
+		config: config,
+		Loop:   sync2.NewCycle(config.Interval),
+
+		nowFn: time.Now,
+	}
+}
+
+// Run replicates the buckets until ctx is canceled.
+func (service *Service) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if !service.config.Enabled {
+		return nil
+	}
+
+	return service.Loop.Run(ctx, func(ctx context.Context) error {
+		if err := service.RunOnce(ctx); err != nil {
+			service.log.Error("failed to replicate buckets", zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// SetNow allows tests to have the service act as if the current time is
+// whatever they want.
+func (service *Service) SetNow(nowFn func() time.Time) {
+	service.nowFn = nowFn
+}
+
+// RunOnce replicates the new objects of all buckets with a replication rule.
+// Failures of a single bucket are recorded in its status.
+func (service *Service) RunOnce(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rules, err := service.db.List(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	var backlog, failed int64
+	for _, rule := range rules {
+		status := service.replicate(ctx, rule)
+		if status.LastError != "" {
+			failed++
+		}
+		backlog += status.Backlog
+
+		err := service.db.UpdateStatus(ctx, rule.ProjectID, rule.BucketName, status)
+		if err != nil {
+			service.log.Error("failed to update replication status",
+				zap.Stringer("Project ID", rule.ProjectID),
+				zap.String("Bucket", rule.BucketName),
+				zap.Error(err))
+		}
+	}
+
+	mon.IntVal("replication_rules").Observe(int64(len(rules)))
+	mon.IntVal("replication_backlog").Observe(backlog)
+	mon.IntVal("replication_failed_rules").Observe(failed)
+
+	return ctx.Err()
+}
+
+// replicate copies the new objects of the bucket of the rule and returns the
+// updated status.
+func (service *Service) replicate(ctx context.Context, rule Rule) (status Status) {
+	defer mon.Task()(&ctx)(nil)
+
+	status = rule.Status
+	now := service.nowFn()
+	status.LastRunAt = &now
+	status.LastError = ""
+
+	err := service.replicateObjects(ctx, rule, &status)
+	if err != nil {
+		service.log.Warn("failed to replicate bucket",
+			zap.Stringer("Project ID", rule.ProjectID),
+			zap.String("Bucket", rule.BucketName),
+			zap.Error(err))
+		status.LastError = err.Error()
+	}
+
+	return status
+}
+
+// replicateObjects continues the current pass over the objects of the bucket
+// after the cursor of the status. It copies the objects, which are missing or
+// outdated in the destination, and advances the cursor. It stops at the first
+// object, which can't be copied.
+func (service *Service) replicateObjects(ctx context.Context, rule Rule, status *Status) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	source, err := service.openProject(ctx, rule.ProjectID, SourceAccessPurpose, rule.SourceAccess)
+	if err != nil {
+		return Error.New("source: %w", err)
+	}
+	defer func() { err = errs.Combine(err, source.Close()) }()
+
+	destination, err := service.openProject(ctx, rule.ProjectID, DestinationAccessPurpose, rule.DestinationAccess)
+	if err != nil {
+		return Error.New("destination: %w", err)
+	}
+	defer func() { err = errs.Combine(err, destination.Close()) }()
+
+	if status.Cursor == "" || status.PassStartedAt == nil {
+		now := service.nowFn()
+		status.Cursor = ""
+		status.PassStartedAt = &now
+	}
+	status.Backlog = 0
+
+	iterator := source.ListObjects(ctx, rule.BucketName, &uplink.ListObjectsOptions{
+		Cursor:    status.Cursor,
+		Recursive: true,
+		System:    true,
+	})
+
+	var checked, copied int
+	for iterator.Next() {
+		if checked >= service.config.MaxCheckedPerRun {
+			return nil
+		}
+		checked++
+
+		object := iterator.Item()
+		if object.System.Created.Before(rule.CreatedAt) {
+			if status.Backlog == 0 {
+				status.Cursor = object.Key
+			}
+			continue
+		}
+
+		replicated, err := isReplicated(ctx, destination, rule.DestinationBucket, object)
+		if err != nil {
+			return Error.New("checking %q: %w", object.Key, err)
+		}
+		if replicated {
+			if status.Backlog == 0 {
+				status.Cursor = object.Key
+			}
+			continue
+		}
+
+		// the remaining objects are only counted, the pass continues with
+		// them on the next run.
+		if copied >= service.config.MaxObjectsPerRun || status.Backlog > 0 {
+			status.Backlog++
+			continue
+		}
+
+		size, err := copyObject(ctx, source, rule.BucketName, destination, rule.DestinationBucket, object.Key)
+		if err != nil {
+			mon.Counter("replication_objects_failed").Inc(1)
+			return Error.New("copying %q: %w", object.Key, err)
+		}
+		copied++
+
+		mon.Counter("replication_objects_copied").Inc(1)
+		mon.IntVal("replication_bytes_copied").Observe(size)
+
+		status.Cursor = object.Key
+		status.ReplicatedObjects++
+	}
+	if err := iterator.Err(); err != nil {
+		return Error.New("listing source bucket: %w", err)
+	}
+
+	if status.Backlog == 0 {
+		// the pass is complete.
+		status.ReplicatedUntil = *status.PassStartedAt
+		status.Cursor = ""
+		status.PassStartedAt = nil
+	}
+	return nil
+}
+
+// openProject opens the project of the sealed access grant.
+func (service *Service) openProject(ctx context.Context, projectID uuid.UUID, purpose, sealedAccess string) (*uplink.Project, error) {
+	serializedAccess, err := service.sealer.Open(projectID, purpose, sealedAccess)
+	if err != nil {
+		return nil, err
+	}
+
+	access, err := uplink.ParseAccess(serializedAccess)
+	if err != nil {
+		return nil, err
+	}
+	return uplink.OpenProject(ctx, access)
+}
+
+// isReplicated returns whether the destination has an up to date replica of
+// the object. A replica is uploaded after the object was created, unless the
+// object was overwritten in the meantime.
+func isReplicated(ctx context.Context, destination *uplink.Project, destinationBucket string, object *uplink.Object) (_ bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	replica, err := destination.StatObject(ctx, destinationBucket, object.Key)
+	if err != nil {
+		if errors.Is(err, uplink.ErrObjectNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return replica.System.ContentLength == object.System.ContentLength &&
+		!replica.System.Created.Before(object.System.Created), nil
+}
+
+// copyObject copies the object, including its custom metadata and
+// expiration, to the destination bucket. Objects deleted in the meantime
+// aren't copied.
+func copyObject(ctx context.Context, source *uplink.Project, sourceBucket string, destination *uplink.Project, destinationBucket, key string) (copied int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	download, err := source.DownloadObject(ctx, sourceBucket, key, nil)
+	if err != nil {
+		if errors.Is(err, uplink.ErrObjectNotFound) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	defer func() { err = errs.Combine(err, download.Close()) }()
+
+	info := download.Info()
+
+	upload, err := destination.UploadObject(ctx, destinationBucket, key, &uplink.UploadOptions{
+		Expires: info.System.Expires,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	copied, err = io.Copy(upload, download)
+	if err != nil {
+		return 0, errs.Combine(err, upload.Abort())
+	}
+
+	if err := upload.SetCustomMetadata(ctx, info.Custom); err != nil {
+		return 0, errs.Combine(err, upload.Abort())
+	}
+
+	return copied, upload.Commit()
+}
+
+// Close stops the service.
+func (service *Service) Close() error {
+	service.Loop.Close()
+	return nil
+}
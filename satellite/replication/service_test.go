@@ -0,0 +1,132 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package replication_test
+
+import (
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/memory"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite/replication"
+	"storj.io/storj/satellite/sealedaccess"
+	"storj.io/uplink"
+)
+
+func TestReplication(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 2,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		source, destination := planet.Uplinks[0], planet.Uplinks[1]
+
+		var sealerConfig sealedaccess.Config
+		require.NoError(t, sealerConfig.Key.Set(hex.EncodeToString(testrand.BytesInt(32))))
+		sealer, err := sealedaccess.NewSealer(sealerConfig)
+		require.NoError(t, err)
+
+		projectID := source.Projects[0].ID
+		seal := func(purpose string, access *uplink.Access) string {
+			serialized, err := access.Serialize()
+			require.NoError(t, err)
+			sealed, err := sealer.Seal(projectID, purpose, serialized)
+			require.NoError(t, err)
+			return sealed
+		}
+		sourceAccess := seal(replication.SourceAccessPurpose, source.Access[satellite.ID()])
+		destinationAccess := seal(replication.DestinationAccessPurpose, destination.Access[satellite.ID()])
+
+		// objects created before the rule aren't replicated.
+		require.NoError(t, source.Upload(ctx, satellite, "source", "old", testrand.Bytes(memory.KiB)))
+		require.NoError(t, destination.CreateBucket(ctx, satellite, "backup"))
+
+		db := satellite.DB.Replication()
+		require.NoError(t, db.Create(ctx, replication.Rule{
+			ProjectID:         projectID,
+			BucketName:        "source",
+			SourceAccess:      sourceAccess,
+			DestinationAccess: destinationAccess,
+			DestinationBucket: "backup",
+			CreatedAt:         time.Now(),
+		}))
+
+		first, second := testrand.Bytes(5*memory.KiB), testrand.Bytes(10*memory.KiB)
+		require.NoError(t, source.Upload(ctx, satellite, "source", "first", first))
+		require.NoError(t, source.Upload(ctx, satellite, "source", "second", second))
+
+		service := replication.NewService(zaptest.NewLogger(t), db, sealer, replication.Config{
+			Enabled:          true,
+			Interval:         time.Hour,
+			MaxObjectsPerRun: 1,
+			MaxCheckedPerRun: 100,
+		})
+		defer ctx.Check(service.Close)
+
+		passStarted := time.Now()
+		service.SetNow(func() time.Time { return passStarted })
+
+		// the pass continues on the next run after the limit.
+		require.NoError(t, service.RunOnce(ctx))
+
+		rule, err := db.Get(ctx, projectID, "source")
+		require.NoError(t, err)
+		require.Empty(t, rule.Status.LastError)
+		require.EqualValues(t, 1, rule.Status.ReplicatedObjects)
+		require.EqualValues(t, 1, rule.Status.Backlog)
+		require.NotEmpty(t, rule.Status.Cursor)
+		require.NotNil(t, rule.Status.PassStartedAt)
+		require.True(t, rule.Status.ReplicatedUntil.IsZero())
+
+		service.SetNow(func() time.Time { return passStarted.Add(time.Minute) })
+		require.NoError(t, service.RunOnce(ctx))
+
+		rule, err = db.Get(ctx, projectID, "source")
+		require.NoError(t, err)
+		require.EqualValues(t, 2, rule.Status.ReplicatedObjects)
+		require.Zero(t, rule.Status.Backlog)
+		require.Empty(t, rule.Status.Cursor)
+		require.Nil(t, rule.Status.PassStartedAt)
+		require.WithinDuration(t, passStarted, rule.Status.ReplicatedUntil, time.Millisecond)
+
+		data, err := destination.Download(ctx, satellite, "backup", "first")
+		require.NoError(t, err)
+		require.Equal(t, first, data)
+
+		data, err = destination.Download(ctx, satellite, "backup", "second")
+		require.NoError(t, err)
+		require.Equal(t, second, data)
+
+		_, err = destination.Download(ctx, satellite, "backup", "old")
+		require.Error(t, err)
+
+		// the next pass only copies the missing and outdated objects.
+		require.NoError(t, destination.DeleteObject(ctx, satellite, "backup", "first"))
+		require.NoError(t, service.RunOnce(ctx))
+
+		rule, err = db.Get(ctx, projectID, "source")
+		require.NoError(t, err)
+		require.Empty(t, rule.Status.LastError)
+		require.EqualValues(t, 3, rule.Status.ReplicatedObjects)
+
+		data, err = destination.Download(ctx, satellite, "backup", "first")
+		require.NoError(t, err)
+		require.Equal(t, first, data)
+
+		// failures are recorded and the objects are retried.
+		require.NoError(t, destination.DeleteBucket(ctx, satellite, "backup"))
+		require.NoError(t, source.Upload(ctx, satellite, "source", "third", testrand.Bytes(memory.KiB)))
+		require.NoError(t, service.RunOnce(ctx))
+
+		rule, err = db.Get(ctx, projectID, "source")
+		require.NoError(t, err)
+		require.NotEmpty(t, rule.Status.LastError)
+		require.EqualValues(t, 3, rule.Status.ReplicatedObjects)
+	})
+}
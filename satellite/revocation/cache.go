@@ -0,0 +1,176 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package revocation
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/sync2"
+)
+
+var mon = monkit.Package()
+
+// Error is the default error class for the revocation cache.
+var Error = errs.Class("revocation")
+
+// Config contains configurable values for the revocation cache.
+type Config struct {
+	RefreshInterval time.Duration `help:"how often new revocations are loaded from the database into the revocation cache" default:"5s" testDefault:"$TESTINTERVAL"`
+	RefreshOverlap  time.Duration `help:"how far before the newest cached revocation the revocations are reloaded, to include revocations committed late or created with a skewed clock" default:"1m"`
+	MaxStaleness    time.Duration `help:"how long the revocation cache is used after the last successful refresh, before the revocations are checked in the database" default:"1m"`
+}
+
+// Cache keeps all revoked macaroon tails in memory and loads the new
+// revocations from the database incrementally, so a revocation on any API
+// server is applied by all API servers within the refresh interval.
+//
+// Until the revocations are loaded, or when the cache is stale, the
+// revocations are checked in the database.
+//
+// architecture: Service
+type Cache struct {
+	log    *zap.Logger
+	db     DB
+	config Config
+
+	mu          sync.RWMutex
+	revoked     map[string]struct{}
+	newest      time.Time
+	refreshedAt time.Time
+
+	Loop *sync2.Cycle
+}
+
+var _ DB = (*Cache)(nil)
+
+// NewCache creates a new revocation cache.
+func NewCache(log *zap.Logger, db DB, config Config) *Cache {
+	return &Cache{
+		log:    log,
+		db:     db,
+		config: config,
+
+		revoked: map[string]struct{}{},
+
+		Loop: sync2.NewCycle(config.RefreshInterval),
+	}
+}
+
+// Run periodically loads the new revocations.
+func (cache *Cache) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return cache.Loop.Run(ctx, func(ctx context.Context) error {
+		if err := cache.Refresh(ctx); err != nil {
+			cache.log.Error("failed to refresh revocations", zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// Refresh loads the revocations created since the last refresh, or all
+// revocations on the first refresh.
+func (cache *Cache) Refresh(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	now := time.Now()
+
+	cache.mu.RLock()
+	since := cache.newest
+	loaded := !cache.refreshedAt.IsZero()
+	cache.mu.RUnlock()
+
+	if loaded {
+		since = since.Add(-cache.config.RefreshOverlap)
+	}
+
+	revocations, err := cache.db.ListSince(ctx, since)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	added := 0
+	for _, revocation := range revocations {
+		if _, ok := cache.revoked[string(revocation.Tail)]; !ok {
+			cache.revoked[string(revocation.Tail)] = struct{}{}
+			added++
+		}
+		if revocation.CreatedAt.After(cache.newest) {
+			cache.newest = revocation.CreatedAt
+		}
+	}
+	cache.refreshedAt = now
+
+	mon.IntVal("revocations_added").Observe(int64(added))
+	mon.IntVal("revocations_cached").Observe(int64(len(cache.revoked)))
+	return nil
+}
+
+// Revoke revokes the tail in the database and the cache.
+func (cache *Cache) Revoke(ctx context.Context, tail []byte, apiKeyID []byte) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := cache.db.Revoke(ctx, tail, apiKeyID); err != nil {
+		return err
+	}
+
+	cache.mu.Lock()
+	cache.revoked[string(tail)] = struct{}{}
+	cache.mu.Unlock()
+	return nil
+}
+
+// Check checks whether any of the tails have been revoked.
+func (cache *Cache) Check(ctx context.Context, tails [][]byte) (_ bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(tails) == 0 {
+		return false, Error.New("empty list of tails")
+	}
+
+	revoked, ok := cache.check(tails)
+	if !ok {
+		mon.Event("revocations_cache_stale")
+		return cache.db.Check(ctx, tails)
+	}
+	return revoked, nil
+}
+
+// check checks the tails in the cache, ok is false when the cache is not
+// loaded or stale.
+func (cache *Cache) check(tails [][]byte) (revoked, ok bool) {
+	cache.mu.RLock()
+	defer cache.mu.RUnlock()
+
+	if cache.refreshedAt.IsZero() || time.Since(cache.refreshedAt) > cache.config.MaxStaleness {
+		return false, false
+	}
+
+	for _, tail := range tails {
+		if _, ok := cache.revoked[string(tail)]; ok {
+			return true, true
+		}
+	}
+	return false, true
+}
+
+// ListSince lists the revocations created at or after since from the database.
+func (cache *Cache) ListSince(ctx context.Context, since time.Time) (_ []Revocation, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return cache.db.ListSince(ctx, since)
+}
+
+// Close stops the cache refresh.
+func (cache *Cache) Close() error {
+	cache.Loop.Close()
+	return nil
+}
@@ -0,0 +1,134 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package revocation_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/satellite/revocation"
+)
+
+// memoryDB is a revocation.DB, which counts the checks hitting the database.
+type memoryDB struct {
+	mu          sync.Mutex
+	revocations []revocation.Revocation
+	checks      int
+	now         time.Time
+}
+
+func (db *memoryDB) Revoke(ctx context.Context, tail []byte, apiKeyID []byte) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	db.revocations = append(db.revocations, revocation.Revocation{Tail: tail, APIKeyID: apiKeyID, CreatedAt: db.now})
+	return nil
+}
+
+func (db *memoryDB) Check(ctx context.Context, tails [][]byte) (bool, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	db.checks++
+	for _, revoked := range db.revocations {
+		for _, tail := range tails {
+			if string(revoked.Tail) == string(tail) {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+func (db *memoryDB) ListSince(ctx context.Context, since time.Time) ([]revocation.Revocation, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	var revocations []revocation.Revocation
+	for _, revoked := range db.revocations {
+		if !revoked.CreatedAt.Before(since) {
+			revocations = append(revocations, revoked)
+		}
+	}
+	return revocations, nil
+}
+
+func TestCache(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	db := &memoryDB{now: time.Now()}
+	require.NoError(t, db.Revoke(ctx, []byte("old"), []byte("key")))
+
+	config := revocation.Config{
+		RefreshInterval: time.Hour,
+		RefreshOverlap:  time.Minute,
+		MaxStaleness:    time.Hour,
+	}
+	cache := revocation.NewCache(zaptest.NewLogger(t), db, config)
+	defer ctx.Check(cache.Close)
+
+	// the database is checked until the revocations are loaded.
+	revoked, err := cache.Check(ctx, [][]byte{[]byte("root"), []byte("old")})
+	require.NoError(t, err)
+	require.True(t, revoked)
+	require.Equal(t, 1, db.checks)
+
+	require.NoError(t, cache.Refresh(ctx))
+
+	revoked, err = cache.Check(ctx, [][]byte{[]byte("root"), []byte("old")})
+	require.NoError(t, err)
+	require.True(t, revoked)
+
+	revoked, err = cache.Check(ctx, [][]byte{[]byte("root"), []byte("other")})
+	require.NoError(t, err)
+	require.False(t, revoked)
+	require.Equal(t, 1, db.checks)
+
+	// revocations through the cache are applied immediately.
+	require.NoError(t, cache.Revoke(ctx, []byte("local"), []byte("key")))
+	revoked, err = cache.Check(ctx, [][]byte{[]byte("root"), []byte("local")})
+	require.NoError(t, err)
+	require.True(t, revoked)
+
+	// revocations by other servers are applied on refresh, including the ones
+	// created with a clock behind the newest revocation.
+	db.now = db.now.Add(-30 * time.Second)
+	require.NoError(t, db.Revoke(ctx, []byte("remote"), []byte("key")))
+
+	revoked, err = cache.Check(ctx, [][]byte{[]byte("remote")})
+	require.NoError(t, err)
+	require.False(t, revoked)
+
+	require.NoError(t, cache.Refresh(ctx))
+	revoked, err = cache.Check(ctx, [][]byte{[]byte("remote")})
+	require.NoError(t, err)
+	require.True(t, revoked)
+	require.Equal(t, 1, db.checks)
+
+	_, err = cache.Check(ctx, nil)
+	require.Error(t, err)
+}
+
+func TestCache_Stale(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	db := &memoryDB{now: time.Now()}
+	cache := revocation.NewCache(zaptest.NewLogger(t), db, revocation.Config{
+		RefreshInterval: time.Hour,
+		MaxStaleness:    time.Nanosecond,
+	})
+	defer ctx.Check(cache.Close)
+
+	require.NoError(t, cache.Refresh(ctx))
+	require.NoError(t, db.Revoke(ctx, []byte("remote"), []byte("key")))
+	time.Sleep(time.Millisecond)
+
+	revoked, err := cache.Check(ctx, [][]byte{[]byte("remote")})
+	require.NoError(t, err)
+	require.True(t, revoked)
+	require.Equal(t, 1, db.checks)
+}
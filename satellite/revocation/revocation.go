@@ -3,12 +3,24 @@
 
 package revocation
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 // DB is the interface for a revocation DB.
 type DB interface {
-	// Revoke is the method to revoke the supplied tail
+	// Revoke is the method to revoke the supplied tail, revoking a revoked tail again is a noop
 	Revoke(ctx context.Context, tail []byte, apiKeyID []byte) error
 	// Check will check whether any of the supplied tails have been revoked
 	Check(ctx context.Context, tails [][]byte) (bool, error)
+	// ListSince lists the revocations created at or after since
+	ListSince(ctx context.Context, since time.Time) ([]Revocation, error)
+}
+
+// Revocation is a revoked macaroon tail.
+type Revocation struct {
+	Tail      []byte
+	APIKeyID  []byte
+	CreatedAt time.Time
 }
@@ -5,6 +5,7 @@ package revocation_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -66,5 +67,15 @@ func TestRevocation(t *testing.T) {
 			require.NoError(t, err)
 			assert.False(t, revoked)
 		}
+
+		revocations, err := revocation.ListSince(ctx, time.Time{})
+		require.NoError(t, err)
+		require.Len(t, revocations, 3)
+		require.Equal(t, mac1.Tail(), revocations[0].Tail)
+		require.Equal(t, apiKeyID, revocations[0].APIKeyID)
+
+		revocations, err = revocation.ListSince(ctx, time.Now().Add(time.Hour))
+		require.NoError(t, err)
+		require.Empty(t, revocations)
 	})
 }
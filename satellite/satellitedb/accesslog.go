@@ -0,0 +1,230 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+	"storj.io/private/dbutil/pgutil"
+	"storj.io/storj/satellite/accesslog"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/satellitedb/dbx"
+)
+
+type accessLogDB struct {
+	db *satelliteDB
+}
+
+// CreateExport adds an export. Only accesses after its creation are exported.
+func (db *accessLogDB) CreateExport(ctx context.Context, export accesslog.Export) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = db.db.ExecContext(ctx, `
+		INSERT INTO access_log_exports (
+			project_id, destination_access, destination_bucket, prefix, exported_until, created_at
+		) VALUES ($1, $2, $3, $4, $5, $5)
+	`, export.ProjectID, export.DestinationAccess, []byte(export.DestinationBucket), export.Prefix, export.CreatedAt)
+	if dbx.IsConstraintError(err) {
+		return accesslog.ErrExportExists.New("%s", export.ProjectID)
+	}
+	return accesslog.Error.Wrap(err)
+}
+
+// GetExport returns the export of the project.
+func (db *accessLogDB) GetExport(ctx context.Context, projectID uuid.UUID) (_ accesslog.Export, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	row := db.db.QueryRowContext(ctx, `
+		SELECT `+accessLogExportColumns+`
+		FROM access_log_exports
+		WHERE project_id = $1
+	`, projectID)
+
+	export, err := scanAccessLogExport(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return accesslog.Export{}, accesslog.ErrExportNotFound.New("%s", projectID)
+	}
+	return export, accesslog.Error.Wrap(err)
+}
+
+// DeleteExport removes the export and the pending entries of the project.
+func (db *accessLogDB) DeleteExport(ctx context.Context, projectID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	result, err := db.db.ExecContext(ctx, `
+		DELETE FROM access_log_exports
+		WHERE project_id = $1
+	`, projectID)
+	if err != nil {
+		return accesslog.Error.Wrap(err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return accesslog.Error.Wrap(err)
+	}
+	if affected == 0 {
+		return accesslog.ErrExportNotFound.New("%s", projectID)
+	}
+
+	_, err = db.db.ExecContext(ctx, `
+		DELETE FROM access_log_entries
+		WHERE project_id = $1
+	`, projectID)
+	return accesslog.Error.Wrap(err)
+}
+
+// ListExports returns all the exports.
+func (db *accessLogDB) ListExports(ctx context.Context) (_ []accesslog.Export, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := db.db.QueryContext(ctx, `
+		SELECT `+accessLogExportColumns+`
+		FROM access_log_exports
+		ORDER BY project_id
+	`)
+	if err != nil {
+		return nil, accesslog.Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var exports []accesslog.Export
+	for rows.Next() {
+		export, err := scanAccessLogExport(rows)
+		if err != nil {
+			return nil, accesslog.Error.Wrap(err)
+		}
+		exports = append(exports, export)
+	}
+	return exports, accesslog.Error.Wrap(rows.Err())
+}
+
+// UpdateExportStatus updates the status of the export of the project.
+func (db *accessLogDB) UpdateExportStatus(ctx context.Context, projectID uuid.UUID, status accesslog.Status) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var lastError *string
+	if status.LastError != "" {
+		lastError = &status.LastError
+	}
+
+	_, err = db.db.ExecContext(ctx, `
+		UPDATE access_log_exports SET
+			exported_until = $2,
+			last_run_at = $3,
+			last_error = $4
+		WHERE project_id = $1
+	`, projectID, status.ExportedUntil, status.LastRunAt, lastError)
+	return accesslog.Error.Wrap(err)
+}
+
+// InsertEntries adds access log entries.
+func (db *accessLogDB) InsertEntries(ctx context.Context, entries []accesslog.Entry) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(entries) == 0 {
+		return nil
+	}
+
+	projectIDs := make([]uuid.UUID, len(entries))
+	times := make([]time.Time, len(entries))
+	ids := make([]uuid.UUID, len(entries))
+	apiKeyIDs := make([]uuid.UUID, len(entries))
+	bucketNames := make([][]byte, len(entries))
+	objectKeys := make([][]byte, len(entries))
+	operations := make([]string, len(entries))
+	sizes := make([]int64, len(entries))
+	for i, entry := range entries {
+		projectIDs[i] = entry.ProjectID
+		times[i] = entry.Time
+		ids[i] = entry.ID
+		apiKeyIDs[i] = entry.APIKeyID
+		bucketNames[i] = []byte(entry.BucketName)
+		objectKeys[i] = []byte(entry.ObjectKey)
+		operations[i] = string(entry.Operation)
+		sizes[i] = entry.Bytes
+	}
+
+	_, err = db.db.ExecContext(ctx, `
+		INSERT INTO access_log_entries (
+			project_id, logged_at, id, api_key_id, bucket_name, object_key, operation, bytes
+		) SELECT unnest($1::bytea[]), unnest($2::timestamptz[]), unnest($3::bytea[]), unnest($4::bytea[]),
+			unnest($5::bytea[]), unnest($6::bytea[]), unnest($7::text[]), unnest($8::int8[])
+	`, pgutil.UUIDArray(projectIDs), pgutil.TimestampTZArray(times), pgutil.UUIDArray(ids), pgutil.UUIDArray(apiKeyIDs),
+		pgutil.ByteaArray(bucketNames), pgutil.ByteaArray(objectKeys), pgutil.TextArray(operations), pgutil.Int8Array(sizes))
+	return accesslog.Error.Wrap(err)
+}
+
+// ListEntries returns at most limit entries of the project after from and up
+// to until, in the order of their time.
+func (db *accessLogDB) ListEntries(ctx context.Context, projectID uuid.UUID, from, until time.Time, limit int) (_ []accesslog.Entry, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := db.db.QueryContext(ctx, `
+		SELECT logged_at, id, api_key_id, bucket_name, object_key, operation, bytes
+		FROM access_log_entries
+		WHERE project_id = $1 AND logged_at > $2 AND logged_at <= $3
+		ORDER BY logged_at, id
+		LIMIT $4
+	`, projectID, from, until, limit)
+	if err != nil {
+		return nil, accesslog.Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var entries []accesslog.Entry
+	for rows.Next() {
+		entry := accesslog.Entry{ProjectID: projectID}
+		var bucketName, objectKey []byte
+		var operation string
+		err := rows.Scan(&entry.Time, &entry.ID, &entry.APIKeyID, &bucketName, &objectKey, &operation, &entry.Bytes)
+		if err != nil {
+			return nil, accesslog.Error.Wrap(err)
+		}
+		entry.BucketName = string(bucketName)
+		entry.ObjectKey = metabase.ObjectKey(objectKey)
+		entry.Operation = accesslog.Operation(operation)
+		entries = append(entries, entry)
+	}
+	return entries, accesslog.Error.Wrap(rows.Err())
+}
+
+// DeleteEntries removes the entries of the project up to until.
+func (db *accessLogDB) DeleteEntries(ctx context.Context, projectID uuid.UUID, until time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = db.db.ExecContext(ctx, `
+		DELETE FROM access_log_entries
+		WHERE project_id = $1 AND logged_at <= $2
+	`, projectID, until)
+	return accesslog.Error.Wrap(err)
+}
+
+const accessLogExportColumns = `
+	project_id, destination_access, destination_bucket, prefix,
+	exported_until, last_run_at, last_error, created_at`
+
+func scanAccessLogExport(row rowScanner) (export accesslog.Export, err error) {
+	var destinationBucket []byte
+	var lastError *string
+
+	err = row.Scan(
+		&export.ProjectID, &export.DestinationAccess, &destinationBucket, &export.Prefix,
+		&export.Status.ExportedUntil, &export.Status.LastRunAt, &lastError, &export.CreatedAt)
+	if err != nil {
+		return accesslog.Export{}, err
+	}
+
+	export.DestinationBucket = string(destinationBucket)
+	if lastError != nil {
+		export.Status.LastError = *lastError
+	}
+	return export, nil
+}
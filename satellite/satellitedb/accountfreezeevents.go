@@ -36,6 +36,9 @@ func (events *accountFreezeEvents) Upsert(ctx context.Context, event *console.Ac
 		}
 		createFields.Limits = dbx.AccountFreezeEvent_Limits(limitBytes)
 	}
+	if !event.CreatedAt.IsZero() {
+		createFields.CreatedAt = dbx.AccountFreezeEvent_CreatedAt(event.CreatedAt)
+	}
 
 	dbxEvent, err := events.db.Replace_AccountFreezeEvent(ctx,
 		dbx.AccountFreezeEvent_UserId(event.UserID.Bytes()),
@@ -0,0 +1,96 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/bucketevents"
+	"storj.io/storj/satellite/metabase"
+)
+
+type bucketEventsDB struct {
+	db *satelliteDB
+}
+
+// Get returns the notification configuration of the bucket.
+func (db *bucketEventsDB) Get(ctx context.Context, projectID uuid.UUID, bucketName string) (_ bucketevents.Configuration, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	configuration := bucketevents.Configuration{
+		ProjectID:  projectID,
+		BucketName: bucketName,
+	}
+	var prefix []byte
+	var sink string
+
+	err = db.db.QueryRowContext(ctx, `
+		SELECT object_created, object_deleted, prefix, sink_type, sink_url, sink_topic, sink_auth_token,
+			created_at, updated_at
+		FROM bucket_notifications
+		WHERE project_id = $1 AND bucket_name = $2
+	`, projectID, []byte(bucketName)).Scan(
+		&configuration.ObjectCreated, &configuration.ObjectDeleted, &prefix, &sink,
+		&configuration.URL, &configuration.Topic, &configuration.AuthToken,
+		&configuration.CreatedAt, &configuration.UpdatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return bucketevents.Configuration{}, bucketevents.ErrNotFound.New("%s/%s", projectID, bucketName)
+	}
+	if err != nil {
+		return bucketevents.Configuration{}, bucketevents.Error.Wrap(err)
+	}
+
+	configuration.Prefix = metabase.ObjectKey(prefix)
+	configuration.Sink = bucketevents.SinkType(sink)
+	return configuration, nil
+}
+
+// Set creates or replaces the notification configuration of the bucket.
+func (db *bucketEventsDB) Set(ctx context.Context, configuration bucketevents.Configuration) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = db.db.ExecContext(ctx, `
+		INSERT INTO bucket_notifications (
+			project_id, bucket_name, object_created, object_deleted, prefix,
+			sink_type, sink_url, sink_topic, sink_auth_token, created_at, updated_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, now(), now())
+		ON CONFLICT (project_id, bucket_name) DO UPDATE SET
+			object_created = EXCLUDED.object_created,
+			object_deleted = EXCLUDED.object_deleted,
+			prefix = EXCLUDED.prefix,
+			sink_type = EXCLUDED.sink_type,
+			sink_url = EXCLUDED.sink_url,
+			sink_topic = EXCLUDED.sink_topic,
+			sink_auth_token = EXCLUDED.sink_auth_token,
+			updated_at = now()
+	`, configuration.ProjectID, []byte(configuration.BucketName),
+		configuration.ObjectCreated, configuration.ObjectDeleted, []byte(configuration.Prefix),
+		string(configuration.Sink), configuration.URL, configuration.Topic, configuration.AuthToken)
+	return bucketevents.Error.Wrap(err)
+}
+
+// Delete removes the notification configuration of the bucket.
+func (db *bucketEventsDB) Delete(ctx context.Context, projectID uuid.UUID, bucketName string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	result, err := db.db.ExecContext(ctx, `
+		DELETE FROM bucket_notifications
+		WHERE project_id = $1 AND bucket_name = $2
+	`, projectID, []byte(bucketName))
+	if err != nil {
+		return bucketevents.Error.Wrap(err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return bucketevents.Error.Wrap(err)
+	}
+	if affected == 0 {
+		return bucketevents.ErrNotFound.New("%s/%s", projectID, bucketName)
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/bucketpolicy"
+)
+
+type bucketPoliciesDB struct {
+	db *satelliteDB
+}
+
+// Get returns the policy document of the bucket.
+func (db *bucketPoliciesDB) Get(ctx context.Context, projectID uuid.UUID, bucketName string) (_ []byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var policy string
+	err = db.db.QueryRowContext(ctx, `
+		SELECT policy
+		FROM bucket_policies
+		WHERE project_id = $1 AND bucket_name = $2
+	`, projectID, []byte(bucketName)).Scan(&policy)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, bucketpolicy.ErrNotFound.New("%s/%s", projectID, bucketName)
+	}
+	if err != nil {
+		return nil, bucketpolicy.Error.Wrap(err)
+	}
+	return []byte(policy), nil
+}
+
+// Set creates or replaces the policy document of the bucket.
+func (db *bucketPoliciesDB) Set(ctx context.Context, projectID uuid.UUID, bucketName string, policy []byte) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = db.db.ExecContext(ctx, `
+		INSERT INTO bucket_policies (project_id, bucket_name, policy, created_at, updated_at)
+		VALUES ($1, $2, $3, now(), now())
+		ON CONFLICT (project_id, bucket_name) DO UPDATE SET
+			policy = EXCLUDED.policy,
+			updated_at = now()
+	`, projectID, []byte(bucketName), string(policy))
+	return bucketpolicy.Error.Wrap(err)
+}
+
+// Delete removes the policy of the bucket.
+func (db *bucketPoliciesDB) Delete(ctx context.Context, projectID uuid.UUID, bucketName string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	result, err := db.db.ExecContext(ctx, `
+		DELETE FROM bucket_policies
+		WHERE project_id = $1 AND bucket_name = $2
+	`, projectID, []byte(bucketName))
+	if err != nil {
+		return bucketpolicy.Error.Wrap(err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return bucketpolicy.Error.Wrap(err)
+	}
+	if affected == 0 {
+		return bucketpolicy.ErrNotFound.New("%s/%s", projectID, bucketName)
+	}
+	return nil
+}
@@ -5,11 +5,15 @@ package satellitedb
 
 import (
 	"context"
+	"database/sql"
 	"sync"
 
 	"github.com/zeebo/errs"
 
 	"storj.io/common/lrucache"
+	"storj.io/private/tagsql"
+	"storj.io/storj/satellite/accounting/projectops"
+	"storj.io/storj/satellite/attestation"
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/console/consoleauth"
 	"storj.io/storj/satellite/satellitedb/dbx"
@@ -31,6 +35,22 @@ type ConsoleDB struct {
 	apikeys     *apikeys
 }
 
+// queryer executes the hand written queries of the repositories.
+type queryer interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...interface{}) (tagsql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+// queryer returns the transaction, when the repositories are used within one,
+// otherwise the database.
+func (db *ConsoleDB) queryer() queryer {
+	if db.tx != nil {
+		return db.tx.Tx
+	}
+	return db.db
+}
+
 // Users is getter a for Users repository.
 func (db *ConsoleDB) Users() console.Users {
 	return &users{db.db}
@@ -43,7 +63,12 @@ func (db *ConsoleDB) Projects() console.Projects {
 
 // ProjectMembers is a getter for ProjectMembers repository.
 func (db *ConsoleDB) ProjectMembers() console.ProjectMembers {
-	return &projectMembers{db.methods, db.db}
+	return &projectMembers{db.methods, db.db, db.queryer()}
+}
+
+// ProjectInvitations is a getter for ProjectInvitations repository.
+func (db *ConsoleDB) ProjectInvitations() console.ProjectInvitations {
+	return &projectInvitations{db.queryer()}
 }
 
 // APIKeys is a getter for APIKeys repository.
@@ -79,6 +104,36 @@ func (db *ConsoleDB) AccountFreezeEvents() console.AccountFreezeEvents {
 	return &accountFreezeEvents{db.methods}
 }
 
+// Impersonations is a getter for Impersonations repository.
+func (db *ConsoleDB) Impersonations() console.Impersonations {
+	return &impersonations{db.db}
+}
+
+// DurabilityReports is a getter for the durability reports repository.
+func (db *ConsoleDB) DurabilityReports() attestation.DB {
+	return &durabilityReports{db.db}
+}
+
+// ProjectDeletions is a getter for ProjectDeletions repository.
+func (db *ConsoleDB) ProjectDeletions() console.ProjectDeletions {
+	return &projectDeletions{db.queryer()}
+}
+
+// LimitRequests is a getter for LimitRequests repository.
+func (db *ConsoleDB) LimitRequests() console.LimitRequests {
+	return &limitRequests{db.db}
+}
+
+// ProjectNetworkRules is a getter for ProjectNetworkRules repository.
+func (db *ConsoleDB) ProjectNetworkRules() console.ProjectNetworkRules {
+	return &projectNetworkRules{db.db}
+}
+
+// ProjectOperations is a getter for the hourly operation counts of projects.
+func (db *ConsoleDB) ProjectOperations() projectops.DB {
+	return &projectOperationsDB{db.db}
+}
+
 // WithTx is a method for executing and retrying transaction.
 func (db *ConsoleDB) WithTx(ctx context.Context, fn func(context.Context, console.DBTx) error) error {
 	if db.db == nil {
@@ -14,24 +14,35 @@ import (
 	"storj.io/private/dbutil"
 	"storj.io/private/dbutil/pgutil"
 	"storj.io/private/tagsql"
+	"storj.io/storj/private/dbpool"
 	"storj.io/storj/private/migrate"
 	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/accesslog"
 	"storj.io/storj/satellite/accounting"
+	"storj.io/storj/satellite/accounting/projectops"
+	"storj.io/storj/satellite/accounting/rollupexport"
 	"storj.io/storj/satellite/attribution"
 	"storj.io/storj/satellite/audit"
+	"storj.io/storj/satellite/bucketevents"
+	"storj.io/storj/satellite/bucketpolicy"
 	"storj.io/storj/satellite/buckets"
 	"storj.io/storj/satellite/compensation"
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/gracefulexit"
+	"storj.io/storj/satellite/maintenance"
 	"storj.io/storj/satellite/nodeapiversion"
 	"storj.io/storj/satellite/nodeevents"
 	"storj.io/storj/satellite/oidc"
 	"storj.io/storj/satellite/orders"
 	"storj.io/storj/satellite/overlay"
+	"storj.io/storj/satellite/overlay/inventory"
+	"storj.io/storj/satellite/overlay/nodechurn"
 	"storj.io/storj/satellite/payments/billing"
 	"storj.io/storj/satellite/payments/storjscan"
 	"storj.io/storj/satellite/payments/stripecoinpayments"
+	"storj.io/storj/satellite/payouts"
 	"storj.io/storj/satellite/repair/queue"
+	"storj.io/storj/satellite/replication"
 	"storj.io/storj/satellite/reputation"
 	"storj.io/storj/satellite/revocation"
 	"storj.io/storj/satellite/satellitedb/dbx"
@@ -74,6 +85,10 @@ type Options struct {
 	// How many storage node rollups to save/read in one batch.
 	SaveRollupBatchSize int
 	ReadRollupBatchSize int
+
+	// MigrationMaxLockedRows refuses migration steps, which lock tables with
+	// more estimated rows. Zero disables the check.
+	MigrationMaxLockedRows int64
 }
 
 var _ dbx.DBMethods = &satelliteDB{}
@@ -88,6 +103,16 @@ var safelyPartitionableDBs = map[string]bool{
 	"reverifyqueue": true,
 }
 
+var separatelyPooledDBs = map[string]bool{
+	// These dbs may do cross-db queries, so their urls must refer to the
+	// same database as the default one. They only get their own connection
+	// pool, so their load can't exhaust the connections of the others.
+	"overlaycache":          true,
+	"projectaccounting":     true,
+	"storagenodeaccounting": true,
+	"console":               true,
+}
+
 // Open creates instance of satellite.DB.
 func Open(ctx context.Context, log *zap.Logger, databaseURL string, opts Options) (rv satellite.DB, err error) {
 	dbMapping, err := dbutil.ParseDBMapping(databaseURL)
@@ -122,6 +147,11 @@ func open(ctx context.Context, log *zap.Logger, databaseURL string, opts Options
 		return nil, Error.New("unsupported driver %q", driver)
 	}
 
+	source, poolOpts, err := dbpool.Split(source)
+	if err != nil {
+		return nil, err
+	}
+
 	source, err = pgutil.CheckApplicationName(source, opts.ApplicationName)
 	if err != nil {
 		return nil, err
@@ -139,6 +169,7 @@ func open(ctx context.Context, log *zap.Logger, databaseURL string, opts Options
 		name += ":" + override
 	}
 	dbutil.Configure(ctx, dbxDB.DB, name, mon)
+	poolOpts.Configure(dbxDB.DB)
 
 	core := &satelliteDB{
 		DB: dbxDB,
@@ -156,7 +187,7 @@ func open(ctx context.Context, log *zap.Logger, databaseURL string, opts Options
 }
 
 func (dbc *satelliteDBCollection) getByName(name string) *satelliteDB {
-	if safelyPartitionableDBs[name] {
+	if safelyPartitionableDBs[name] || separatelyPooledDBs[name] {
 		if db, exists := dbc.dbs[name]; exists {
 			return db
 		}
@@ -322,6 +353,61 @@ func (dbc *satelliteDBCollection) Buckets() buckets.DB {
 	return &bucketsDB{db: dbc.getByName("buckets")}
 }
 
+// Maintenance returns database for the maintenance mode state.
+func (dbc *satelliteDBCollection) Maintenance() maintenance.DB {
+	return &maintenanceDB{db: dbc.getByName("maintenance")}
+}
+
+// Replication returns database for bucket replication rules.
+func (dbc *satelliteDBCollection) Replication() replication.DB {
+	return &replicationDB{db: dbc.getByName("replication")}
+}
+
+// BucketEvents returns database for bucket event notification configurations.
+func (dbc *satelliteDBCollection) BucketEvents() bucketevents.DB {
+	return &bucketEventsDB{db: dbc.getByName("bucketevents")}
+}
+
+// BucketPolicies returns database for bucket policies.
+func (dbc *satelliteDBCollection) BucketPolicies() bucketpolicy.DB {
+	return &bucketPoliciesDB{db: dbc.getByName("bucketpolicies")}
+}
+
+// AccessLog returns database for customer facing access logs.
+func (dbc *satelliteDBCollection) AccessLog() accesslog.DB {
+	return &accessLogDB{db: dbc.getByName("accesslog")}
+}
+
+// RollupExport returns database for exporting and restoring old rollups.
+func (dbc *satelliteDBCollection) RollupExport() rollupexport.DB {
+	return &rollupExportDB{db: dbc.getByName("rollupexport")}
+}
+
+// ProjectOperations returns database for the hourly operation counts of projects.
+func (dbc *satelliteDBCollection) ProjectOperations() projectops.DB {
+	return &projectOperationsDB{db: dbc.getByName("projectoperations")}
+}
+
+// NodeChurn returns database for the daily node churn metrics.
+func (dbc *satelliteDBCollection) NodeChurn() nodechurn.DB {
+	return &nodeChurnDB{db: dbc.getByName("nodechurn")}
+}
+
+// NodeInventory returns database for the inventories of the nodes.
+func (dbc *satelliteDBCollection) NodeInventory() inventory.DB {
+	return &nodeInventoriesDB{db: dbc.getByName("nodeinventory")}
+}
+
+// PayoutContracts returns database for the payout contracts of the nodes.
+func (dbc *satelliteDBCollection) PayoutContracts() payouts.DB {
+	return &payoutContractsDB{db: dbc.getByName("payoutcontracts")}
+}
+
+// PayoutBatches returns database for the layer 2 payout batches.
+func (dbc *satelliteDBCollection) PayoutBatches() payouts.BatchDB {
+	return &payoutBatchesDB{db: dbc.getByName("payoutbatches")}
+}
+
 // StorjscanPayments returns database for storjscan payments.
 func (dbc *satelliteDBCollection) StorjscanPayments() storjscan.PaymentsDB {
 	return &storjscanPayments{db: dbc.getByName("storjscan_payments")}
@@ -345,6 +431,18 @@ func (dbc *satelliteDBCollection) MigrateToLatest(ctx context.Context) error {
 	return eg.Err()
 }
 
+// MigrationPlan returns the pending migration steps of all databases.
+func (dbc *satelliteDBCollection) MigrationPlan(ctx context.Context) ([]migrate.PlannedStep, error) {
+	var eg errs.Group
+	var planned []migrate.PlannedStep
+	for _, db := range dbc.dbs {
+		steps, err := db.MigrationPlan(ctx)
+		eg.Add(err)
+		planned = append(planned, steps...)
+	}
+	return planned, eg.Err()
+}
+
 // TestingMigrateToLatest is a method for creating all tables for all database for testing.
 func (dbc *satelliteDBCollection) TestingMigrateToLatest(ctx context.Context) error {
 	var eg errs.Group
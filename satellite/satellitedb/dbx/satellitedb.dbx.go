@@ -1,4 +1,7 @@
 //lint:file-ignore U1000,ST1012 generated file
+//lint:file-ignore U1000,ST1012 generated file
+//lint:file-ignore U1000,ST1012 generated file
+//lint:file-ignore U1000,ST1012 generated file
 // AUTOGENERATED BY storj.io/dbx
 // DO NOT EDIT.
 
@@ -320,7 +323,29 @@ func newpgx(db *DB) *pgxDB {
 }
 
 func (obj *pgxDB) Schema() string {
-	return `CREATE TABLE account_freeze_events (
+	return `CREATE TABLE access_log_entries (
+	project_id bytea NOT NULL,
+	logged_at timestamp with time zone NOT NULL,
+	id bytea NOT NULL,
+	api_key_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	object_key bytea NOT NULL,
+	operation text NOT NULL,
+	bytes bigint NOT NULL,
+	PRIMARY KEY ( project_id, logged_at, id )
+);
+CREATE TABLE access_log_exports (
+	project_id bytea NOT NULL,
+	destination_access text NOT NULL,
+	destination_bucket bytea NOT NULL,
+	prefix text NOT NULL,
+	exported_until timestamp with time zone NOT NULL,
+	last_run_at timestamp with time zone,
+	last_error text,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id )
+);
+CREATE TABLE account_freeze_events (
 	user_id bytea NOT NULL,
 	event integer NOT NULL,
 	limits jsonb,
@@ -386,6 +411,44 @@ CREATE TABLE bucket_bandwidth_rollup_archives (
 	settled bigint NOT NULL,
 	PRIMARY KEY ( bucket_name, project_id, interval_start, action )
 );
+CREATE TABLE bucket_notifications (
+	project_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	object_created boolean NOT NULL,
+	object_deleted boolean NOT NULL,
+	prefix bytea NOT NULL,
+	sink_type text NOT NULL,
+	sink_url text NOT NULL,
+	sink_topic text NOT NULL,
+	sink_auth_token text NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name )
+);
+CREATE TABLE bucket_policies (
+	project_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	policy text NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name )
+);
+CREATE TABLE bucket_replications (
+	project_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	source_access text NOT NULL,
+	destination_access text NOT NULL,
+	destination_bucket bytea NOT NULL,
+	replicated_until timestamp with time zone NOT NULL,
+	replicated_key bytea NOT NULL,
+	pass_started_at timestamp with time zone,
+	replicated_objects bigint NOT NULL,
+	backlog bigint NOT NULL,
+	last_run_at timestamp with time zone,
+	last_error text,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name )
+);
 CREATE TABLE bucket_storage_tallies (
 	bucket_name bytea NOT NULL,
 	project_id bytea NOT NULL,
@@ -443,6 +506,22 @@ CREATE TABLE coupon_usages (
 	period timestamp with time zone NOT NULL,
 	PRIMARY KEY ( coupon_id, period )
 );
+CREATE TABLE durability_reports (
+	id bytea NOT NULL,
+	project_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	data bytea NOT NULL,
+	signature bytea NOT NULL,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE graceful_exit_aborts (
+	node_id bytea NOT NULL,
+	exit_initiated_at timestamp with time zone NOT NULL,
+	reason text,
+	aborted_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+	PRIMARY KEY ( node_id, aborted_at )
+);
 CREATE TABLE graceful_exit_progress (
 	node_id bytea NOT NULL,
 	bytes_transferred bigint NOT NULL,
@@ -467,6 +546,24 @@ CREATE TABLE graceful_exit_segment_transfer_queue (
 	order_limit_send_count integer NOT NULL DEFAULT 0,
 	PRIMARY KEY ( node_id, stream_id, position, piece_num )
 );
+CREATE TABLE impersonation_sessions (
+	id bytea NOT NULL,
+	user_id bytea NOT NULL,
+	admin text NOT NULL,
+	reason text NOT NULL,
+	consented boolean NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	expires_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE maintenance_modes (
+	id integer NOT NULL,
+	enabled boolean NOT NULL,
+	reason text NOT NULL,
+	retry_after integer NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id )
+);
 CREATE TABLE nodes (
 	id bytea NOT NULL,
 	address text NOT NULL DEFAULT '',
@@ -501,9 +598,14 @@ CREATE TABLE nodes (
 	exit_loop_completed_at timestamp with time zone,
 	exit_finished_at timestamp with time zone,
 	exit_success boolean NOT NULL DEFAULT false,
+	exit_failed_reason integer,
 	contained timestamp with time zone,
 	last_offline_email timestamp with time zone,
 	last_software_update_email timestamp with time zone,
+	protocols text NOT NULL DEFAULT '',
+	hashstore boolean NOT NULL DEFAULT false,
+	ttl_support boolean NOT NULL DEFAULT false,
+	max_piece_size bigint NOT NULL DEFAULT 0,
 	PRIMARY KEY ( id )
 );
 CREATE TABLE node_api_versions (
@@ -513,6 +615,17 @@ CREATE TABLE node_api_versions (
 	updated_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE node_churn_metrics (
+	day date NOT NULL,
+	new_nodes integer NOT NULL,
+	exited_nodes integer NOT NULL,
+	disqualified_nodes integer NOT NULL,
+	active_nodes integer NOT NULL,
+	free_disk bigint NOT NULL,
+	capacity_change bigint NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( day )
+);
 CREATE TABLE node_events (
 	id bytea NOT NULL,
 	email text NOT NULL,
@@ -523,6 +636,28 @@ CREATE TABLE node_events (
 	email_sent timestamp with time zone,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE node_inventories (
+	node_id bytea NOT NULL,
+	os text NOT NULL,
+	arch text NOT NULL,
+	runtime text NOT NULL,
+	storage_backend text NOT NULL,
+	filesystem text NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( node_id )
+);
+CREATE TABLE node_payout_contracts (
+	node_id bytea NOT NULL,
+	at_rest_gb_hours text,
+	get_tb text,
+	put_tb text,
+	get_repair_tb text,
+	put_repair_tb text,
+	get_audit_tb text,
+	created_at timestamp with time zone NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( node_id )
+);
 CREATE TABLE oauth_clients (
 	id bytea NOT NULL,
 	encrypted_secret bytea NOT NULL,
@@ -570,6 +705,26 @@ CREATE TABLE offers (
 	type integer NOT NULL,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE payout_batches (
+	id bytea NOT NULL,
+	period text NOT NULL,
+	network text NOT NULL,
+	status text NOT NULL,
+	transaction_hash text,
+	created_at timestamp with time zone NOT NULL,
+	submitted_at timestamp with time zone,
+	confirmed_at timestamp with time zone,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE payout_batch_entries (
+	batch_id bytea NOT NULL,
+	node_id bytea NOT NULL,
+	wallet text NOT NULL,
+	amount bigint NOT NULL,
+	period text NOT NULL,
+	failed boolean NOT NULL,
+	PRIMARY KEY ( batch_id, node_id )
+);
 CREATE TABLE peer_identities (
 	node_id bytea NOT NULL,
 	leaf_serial_number bytea NOT NULL,
@@ -611,6 +766,53 @@ CREATE TABLE project_bandwidth_rollups (
 	egress_allocated bigint NOT NULL,
 	PRIMARY KEY ( project_id, interval_month )
 );
+CREATE TABLE project_deletions (
+	project_id bytea NOT NULL,
+	requested_by bytea NOT NULL,
+	stage text NOT NULL,
+	deleted_buckets bigint NOT NULL DEFAULT 0,
+	deleted_objects bigint NOT NULL DEFAULT 0,
+	deleted_segments bigint NOT NULL DEFAULT 0,
+	deleted_api_keys bigint NOT NULL DEFAULT 0,
+	last_error text NOT NULL DEFAULT '',
+	created_at timestamp with time zone NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	finished_at timestamp with time zone,
+	PRIMARY KEY ( project_id )
+);
+CREATE TABLE project_limit_requests (
+	id bytea NOT NULL,
+	project_id bytea NOT NULL,
+	user_id bytea NOT NULL,
+	kind text NOT NULL,
+	current_limit bigint NOT NULL,
+	requested_limit bigint NOT NULL,
+	current_usage bigint NOT NULL,
+	justification text NOT NULL,
+	status text NOT NULL,
+	reviewer text NOT NULL DEFAULT '',
+	review_comment text NOT NULL DEFAULT '',
+	created_at timestamp with time zone NOT NULL,
+	reviewed_at timestamp with time zone,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE project_network_rules (
+	project_id bytea NOT NULL,
+	network text NOT NULL,
+	allow boolean NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id, network )
+);
+CREATE TABLE project_operation_rollups (
+	project_id bytea NOT NULL,
+	interval_start timestamp with time zone NOT NULL,
+	uploads bigint NOT NULL DEFAULT 0,
+	downloads bigint NOT NULL DEFAULT 0,
+	lists bigint NOT NULL DEFAULT 0,
+	deletes bigint NOT NULL DEFAULT 0,
+	errors bigint NOT NULL DEFAULT 0,
+	PRIMARY KEY ( project_id, interval_start )
+);
 CREATE TABLE registration_tokens (
 	secret bytea NOT NULL,
 	owner_id bytea,
@@ -668,6 +870,7 @@ CREATE TABLE reverification_audits (
 CREATE TABLE revocations (
 	revoked bytea NOT NULL,
 	api_key_id bytea NOT NULL,
+	created_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
 	PRIMARY KEY ( revoked )
 );
 CREATE TABLE segment_pending_audits (
@@ -743,6 +946,15 @@ CREATE TABLE storagenode_paystubs (
 	distributed bigint NOT NULL,
 	PRIMARY KEY ( period, node_id )
 );
+CREATE TABLE storagenode_settlement_claims (
+	storagenode_id bytea NOT NULL,
+	interval_start timestamp with time zone NOT NULL,
+	action integer NOT NULL,
+	amount bigint NOT NULL,
+	status integer NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( storagenode_id, interval_start, action )
+);
 CREATE TABLE storagenode_storage_tallies (
 	node_id bytea NOT NULL,
 	interval_end_time timestamp with time zone NOT NULL,
@@ -889,6 +1101,13 @@ CREATE TABLE bucket_metainfos (
 	PRIMARY KEY ( id ),
 	UNIQUE ( project_id, name )
 );
+CREATE TABLE project_invitations (
+	project_id bytea NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
+	email text NOT NULL,
+	inviter_id bytea REFERENCES users( id ) ON DELETE SET NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id, email )
+);
 CREATE TABLE project_members (
 	member_id bytea NOT NULL REFERENCES users( id ) ON DELETE CASCADE,
 	project_id bytea NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
@@ -901,6 +1120,12 @@ CREATE TABLE stripecoinpayments_apply_balance_intents (
 	created_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( tx_id )
 );
+CREATE TABLE support_access_grants (
+	user_id bytea NOT NULL REFERENCES users( id ) ON DELETE CASCADE,
+	expires_at timestamp with time zone NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( user_id )
+);
 CREATE TABLE user_credits (
 	id serial NOT NULL,
 	user_id bytea NOT NULL REFERENCES users( id ) ON DELETE CASCADE,
@@ -921,7 +1146,9 @@ CREATE INDEX bucket_bandwidth_rollups_action_interval_project_id_index ON bucket
 CREATE INDEX bucket_bandwidth_rollups_archive_project_id_action_interval_index ON bucket_bandwidth_rollup_archives ( project_id, action, interval_start ) ;
 CREATE INDEX bucket_bandwidth_rollups_archive_action_interval_project_id_index ON bucket_bandwidth_rollup_archives ( action, interval_start, project_id ) ;
 CREATE INDEX bucket_storage_tallies_project_id_interval_start_index ON bucket_storage_tallies ( project_id, interval_start ) ;
+CREATE INDEX durability_reports_project_id_bucket_name_created_at_index ON durability_reports ( project_id, bucket_name, created_at ) ;
 CREATE INDEX graceful_exit_segment_transfer_nid_dr_qa_fa_lfa_index ON graceful_exit_segment_transfer_queue ( node_id, durability_ratio, queued_at, finished_at, last_failed_at ) ;
+CREATE INDEX impersonation_sessions_user_id_index ON impersonation_sessions ( user_id ) ;
 CREATE INDEX node_last_ip ON nodes ( last_net ) ;
 CREATE INDEX nodes_dis_unk_off_exit_fin_last_success_index ON nodes ( disqualified, unknown_audit_suspended, offline_suspended, exit_finished_at, last_contact_success ) ;
 CREATE INDEX nodes_type_last_cont_success_free_disk_ma_mi_patch_vetted_partial_index ON nodes ( type, last_contact_success, free_disk, major, minor, patch, vetted_at ) WHERE nodes.disqualified is NULL AND nodes.unknown_audit_suspended is NULL AND nodes.exit_initiated_at is NULL AND nodes.release = true AND nodes.last_net != '' ;
@@ -932,14 +1159,23 @@ CREATE INDEX oauth_codes_user_id_index ON oauth_codes ( user_id ) ;
 CREATE INDEX oauth_codes_client_id_index ON oauth_codes ( client_id ) ;
 CREATE INDEX oauth_tokens_user_id_index ON oauth_tokens ( user_id ) ;
 CREATE INDEX oauth_tokens_client_id_index ON oauth_tokens ( client_id ) ;
+CREATE UNIQUE INDEX payout_batch_entries_period_node_id_index ON payout_batch_entries ( period, node_id ) WHERE payout_batch_entries.failed = false ;
+CREATE INDEX project_deletions_requested_by_index ON project_deletions ( requested_by ) ;
+CREATE INDEX project_deletions_stage_created_at_index ON project_deletions ( stage, created_at ) ;
+CREATE INDEX project_limit_requests_project_id_created_at_index ON project_limit_requests ( project_id, created_at ) ;
+CREATE INDEX project_limit_requests_status_created_at_index ON project_limit_requests ( status, created_at ) ;
+CREATE INDEX project_operation_rollups_interval_start_index ON project_operation_rollups ( interval_start ) ;
+CREATE INDEX project_invitations_email_index ON project_invitations ( email ) ;
 CREATE INDEX projects_public_id_index ON projects ( public_id ) ;
 CREATE INDEX repair_queue_updated_at_index ON repair_queue ( updated_at ) ;
 CREATE INDEX repair_queue_num_healthy_pieces_attempted_at_index ON repair_queue ( segment_health, attempted_at ) ;
 CREATE INDEX reverification_audits_inserted_at_index ON reverification_audits ( inserted_at ) ;
+CREATE INDEX revocations_created_at_index ON revocations ( created_at ) ;
 CREATE INDEX storagenode_bandwidth_rollups_interval_start_index ON storagenode_bandwidth_rollups ( interval_start ) ;
 CREATE INDEX storagenode_bandwidth_rollup_archives_interval_start_index ON storagenode_bandwidth_rollup_archives ( interval_start ) ;
 CREATE INDEX storagenode_payments_node_id_period_index ON storagenode_payments ( node_id, period ) ;
 CREATE INDEX storagenode_paystubs_node_id_index ON storagenode_paystubs ( node_id ) ;
+CREATE INDEX storagenode_settlement_claims_interval_start_index ON storagenode_settlement_claims ( interval_start ) ;
 CREATE INDEX storagenode_storage_tallies_node_id_index ON storagenode_storage_tallies ( node_id ) ;
 CREATE INDEX storjscan_payments_block_number_log_index_index ON storjscan_payments ( block_number, log_index ) ;
 CREATE INDEX storjscan_wallets_wallet_address_index ON storjscan_wallets ( wallet_address ) ;
@@ -1044,7 +1280,29 @@ func newpgxcockroach(db *DB) *pgxcockroachDB {
 }
 
 func (obj *pgxcockroachDB) Schema() string {
-	return `CREATE TABLE account_freeze_events (
+	return `CREATE TABLE access_log_entries (
+	project_id bytea NOT NULL,
+	logged_at timestamp with time zone NOT NULL,
+	id bytea NOT NULL,
+	api_key_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	object_key bytea NOT NULL,
+	operation text NOT NULL,
+	bytes bigint NOT NULL,
+	PRIMARY KEY ( project_id, logged_at, id )
+);
+CREATE TABLE access_log_exports (
+	project_id bytea NOT NULL,
+	destination_access text NOT NULL,
+	destination_bucket bytea NOT NULL,
+	prefix text NOT NULL,
+	exported_until timestamp with time zone NOT NULL,
+	last_run_at timestamp with time zone,
+	last_error text,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id )
+);
+CREATE TABLE account_freeze_events (
 	user_id bytea NOT NULL,
 	event integer NOT NULL,
 	limits jsonb,
@@ -1110,6 +1368,44 @@ CREATE TABLE bucket_bandwidth_rollup_archives (
 	settled bigint NOT NULL,
 	PRIMARY KEY ( bucket_name, project_id, interval_start, action )
 );
+CREATE TABLE bucket_notifications (
+	project_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	object_created boolean NOT NULL,
+	object_deleted boolean NOT NULL,
+	prefix bytea NOT NULL,
+	sink_type text NOT NULL,
+	sink_url text NOT NULL,
+	sink_topic text NOT NULL,
+	sink_auth_token text NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name )
+);
+CREATE TABLE bucket_policies (
+	project_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	policy text NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name )
+);
+CREATE TABLE bucket_replications (
+	project_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	source_access text NOT NULL,
+	destination_access text NOT NULL,
+	destination_bucket bytea NOT NULL,
+	replicated_until timestamp with time zone NOT NULL,
+	replicated_key bytea NOT NULL,
+	pass_started_at timestamp with time zone,
+	replicated_objects bigint NOT NULL,
+	backlog bigint NOT NULL,
+	last_run_at timestamp with time zone,
+	last_error text,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name )
+);
 CREATE TABLE bucket_storage_tallies (
 	bucket_name bytea NOT NULL,
 	project_id bytea NOT NULL,
@@ -1167,6 +1463,22 @@ CREATE TABLE coupon_usages (
 	period timestamp with time zone NOT NULL,
 	PRIMARY KEY ( coupon_id, period )
 );
+CREATE TABLE durability_reports (
+	id bytea NOT NULL,
+	project_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	data bytea NOT NULL,
+	signature bytea NOT NULL,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE graceful_exit_aborts (
+	node_id bytea NOT NULL,
+	exit_initiated_at timestamp with time zone NOT NULL,
+	reason text,
+	aborted_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+	PRIMARY KEY ( node_id, aborted_at )
+);
 CREATE TABLE graceful_exit_progress (
 	node_id bytea NOT NULL,
 	bytes_transferred bigint NOT NULL,
@@ -1191,6 +1503,24 @@ CREATE TABLE graceful_exit_segment_transfer_queue (
 	order_limit_send_count integer NOT NULL DEFAULT 0,
 	PRIMARY KEY ( node_id, stream_id, position, piece_num )
 );
+CREATE TABLE impersonation_sessions (
+	id bytea NOT NULL,
+	user_id bytea NOT NULL,
+	admin text NOT NULL,
+	reason text NOT NULL,
+	consented boolean NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	expires_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE maintenance_modes (
+	id integer NOT NULL,
+	enabled boolean NOT NULL,
+	reason text NOT NULL,
+	retry_after integer NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id )
+);
 CREATE TABLE nodes (
 	id bytea NOT NULL,
 	address text NOT NULL DEFAULT '',
@@ -1225,9 +1555,14 @@ CREATE TABLE nodes (
 	exit_loop_completed_at timestamp with time zone,
 	exit_finished_at timestamp with time zone,
 	exit_success boolean NOT NULL DEFAULT false,
+	exit_failed_reason integer,
 	contained timestamp with time zone,
 	last_offline_email timestamp with time zone,
 	last_software_update_email timestamp with time zone,
+	protocols text NOT NULL DEFAULT '',
+	hashstore boolean NOT NULL DEFAULT false,
+	ttl_support boolean NOT NULL DEFAULT false,
+	max_piece_size bigint NOT NULL DEFAULT 0,
 	PRIMARY KEY ( id )
 );
 CREATE TABLE node_api_versions (
@@ -1237,6 +1572,17 @@ CREATE TABLE node_api_versions (
 	updated_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE node_churn_metrics (
+	day date NOT NULL,
+	new_nodes integer NOT NULL,
+	exited_nodes integer NOT NULL,
+	disqualified_nodes integer NOT NULL,
+	active_nodes integer NOT NULL,
+	free_disk bigint NOT NULL,
+	capacity_change bigint NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( day )
+);
 CREATE TABLE node_events (
 	id bytea NOT NULL,
 	email text NOT NULL,
@@ -1247,6 +1593,28 @@ CREATE TABLE node_events (
 	email_sent timestamp with time zone,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE node_inventories (
+	node_id bytea NOT NULL,
+	os text NOT NULL,
+	arch text NOT NULL,
+	runtime text NOT NULL,
+	storage_backend text NOT NULL,
+	filesystem text NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( node_id )
+);
+CREATE TABLE node_payout_contracts (
+	node_id bytea NOT NULL,
+	at_rest_gb_hours text,
+	get_tb text,
+	put_tb text,
+	get_repair_tb text,
+	put_repair_tb text,
+	get_audit_tb text,
+	created_at timestamp with time zone NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( node_id )
+);
 CREATE TABLE oauth_clients (
 	id bytea NOT NULL,
 	encrypted_secret bytea NOT NULL,
@@ -1294,6 +1662,26 @@ CREATE TABLE offers (
 	type integer NOT NULL,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE payout_batches (
+	id bytea NOT NULL,
+	period text NOT NULL,
+	network text NOT NULL,
+	status text NOT NULL,
+	transaction_hash text,
+	created_at timestamp with time zone NOT NULL,
+	submitted_at timestamp with time zone,
+	confirmed_at timestamp with time zone,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE payout_batch_entries (
+	batch_id bytea NOT NULL,
+	node_id bytea NOT NULL,
+	wallet text NOT NULL,
+	amount bigint NOT NULL,
+	period text NOT NULL,
+	failed boolean NOT NULL,
+	PRIMARY KEY ( batch_id, node_id )
+);
 CREATE TABLE peer_identities (
 	node_id bytea NOT NULL,
 	leaf_serial_number bytea NOT NULL,
@@ -1335,6 +1723,53 @@ CREATE TABLE project_bandwidth_rollups (
 	egress_allocated bigint NOT NULL,
 	PRIMARY KEY ( project_id, interval_month )
 );
+CREATE TABLE project_deletions (
+	project_id bytea NOT NULL,
+	requested_by bytea NOT NULL,
+	stage text NOT NULL,
+	deleted_buckets bigint NOT NULL DEFAULT 0,
+	deleted_objects bigint NOT NULL DEFAULT 0,
+	deleted_segments bigint NOT NULL DEFAULT 0,
+	deleted_api_keys bigint NOT NULL DEFAULT 0,
+	last_error text NOT NULL DEFAULT '',
+	created_at timestamp with time zone NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	finished_at timestamp with time zone,
+	PRIMARY KEY ( project_id )
+);
+CREATE TABLE project_limit_requests (
+	id bytea NOT NULL,
+	project_id bytea NOT NULL,
+	user_id bytea NOT NULL,
+	kind text NOT NULL,
+	current_limit bigint NOT NULL,
+	requested_limit bigint NOT NULL,
+	current_usage bigint NOT NULL,
+	justification text NOT NULL,
+	status text NOT NULL,
+	reviewer text NOT NULL DEFAULT '',
+	review_comment text NOT NULL DEFAULT '',
+	created_at timestamp with time zone NOT NULL,
+	reviewed_at timestamp with time zone,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE project_network_rules (
+	project_id bytea NOT NULL,
+	network text NOT NULL,
+	allow boolean NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id, network )
+);
+CREATE TABLE project_operation_rollups (
+	project_id bytea NOT NULL,
+	interval_start timestamp with time zone NOT NULL,
+	uploads bigint NOT NULL DEFAULT 0,
+	downloads bigint NOT NULL DEFAULT 0,
+	lists bigint NOT NULL DEFAULT 0,
+	deletes bigint NOT NULL DEFAULT 0,
+	errors bigint NOT NULL DEFAULT 0,
+	PRIMARY KEY ( project_id, interval_start )
+);
 CREATE TABLE registration_tokens (
 	secret bytea NOT NULL,
 	owner_id bytea,
@@ -1392,6 +1827,7 @@ CREATE TABLE reverification_audits (
 CREATE TABLE revocations (
 	revoked bytea NOT NULL,
 	api_key_id bytea NOT NULL,
+	created_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
 	PRIMARY KEY ( revoked )
 );
 CREATE TABLE segment_pending_audits (
@@ -1467,6 +1903,15 @@ CREATE TABLE storagenode_paystubs (
 	distributed bigint NOT NULL,
 	PRIMARY KEY ( period, node_id )
 );
+CREATE TABLE storagenode_settlement_claims (
+	storagenode_id bytea NOT NULL,
+	interval_start timestamp with time zone NOT NULL,
+	action integer NOT NULL,
+	amount bigint NOT NULL,
+	status integer NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( storagenode_id, interval_start, action )
+);
 CREATE TABLE storagenode_storage_tallies (
 	node_id bytea NOT NULL,
 	interval_end_time timestamp with time zone NOT NULL,
@@ -1613,6 +2058,13 @@ CREATE TABLE bucket_metainfos (
 	PRIMARY KEY ( id ),
 	UNIQUE ( project_id, name )
 );
+CREATE TABLE project_invitations (
+	project_id bytea NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
+	email text NOT NULL,
+	inviter_id bytea REFERENCES users( id ) ON DELETE SET NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id, email )
+);
 CREATE TABLE project_members (
 	member_id bytea NOT NULL REFERENCES users( id ) ON DELETE CASCADE,
 	project_id bytea NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
@@ -1625,6 +2077,12 @@ CREATE TABLE stripecoinpayments_apply_balance_intents (
 	created_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( tx_id )
 );
+CREATE TABLE support_access_grants (
+	user_id bytea NOT NULL REFERENCES users( id ) ON DELETE CASCADE,
+	expires_at timestamp with time zone NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( user_id )
+);
 CREATE TABLE user_credits (
 	id serial NOT NULL,
 	user_id bytea NOT NULL REFERENCES users( id ) ON DELETE CASCADE,
@@ -1645,7 +2103,9 @@ CREATE INDEX bucket_bandwidth_rollups_action_interval_project_id_index ON bucket
 CREATE INDEX bucket_bandwidth_rollups_archive_project_id_action_interval_index ON bucket_bandwidth_rollup_archives ( project_id, action, interval_start ) ;
 CREATE INDEX bucket_bandwidth_rollups_archive_action_interval_project_id_index ON bucket_bandwidth_rollup_archives ( action, interval_start, project_id ) ;
 CREATE INDEX bucket_storage_tallies_project_id_interval_start_index ON bucket_storage_tallies ( project_id, interval_start ) ;
+CREATE INDEX durability_reports_project_id_bucket_name_created_at_index ON durability_reports ( project_id, bucket_name, created_at ) ;
 CREATE INDEX graceful_exit_segment_transfer_nid_dr_qa_fa_lfa_index ON graceful_exit_segment_transfer_queue ( node_id, durability_ratio, queued_at, finished_at, last_failed_at ) ;
+CREATE INDEX impersonation_sessions_user_id_index ON impersonation_sessions ( user_id ) ;
 CREATE INDEX node_last_ip ON nodes ( last_net ) ;
 CREATE INDEX nodes_dis_unk_off_exit_fin_last_success_index ON nodes ( disqualified, unknown_audit_suspended, offline_suspended, exit_finished_at, last_contact_success ) ;
 CREATE INDEX nodes_type_last_cont_success_free_disk_ma_mi_patch_vetted_partial_index ON nodes ( type, last_contact_success, free_disk, major, minor, patch, vetted_at ) WHERE nodes.disqualified is NULL AND nodes.unknown_audit_suspended is NULL AND nodes.exit_initiated_at is NULL AND nodes.release = true AND nodes.last_net != '' ;
@@ -1656,14 +2116,23 @@ CREATE INDEX oauth_codes_user_id_index ON oauth_codes ( user_id ) ;
 CREATE INDEX oauth_codes_client_id_index ON oauth_codes ( client_id ) ;
 CREATE INDEX oauth_tokens_user_id_index ON oauth_tokens ( user_id ) ;
 CREATE INDEX oauth_tokens_client_id_index ON oauth_tokens ( client_id ) ;
+CREATE UNIQUE INDEX payout_batch_entries_period_node_id_index ON payout_batch_entries ( period, node_id ) WHERE payout_batch_entries.failed = false ;
+CREATE INDEX project_deletions_requested_by_index ON project_deletions ( requested_by ) ;
+CREATE INDEX project_deletions_stage_created_at_index ON project_deletions ( stage, created_at ) ;
+CREATE INDEX project_limit_requests_project_id_created_at_index ON project_limit_requests ( project_id, created_at ) ;
+CREATE INDEX project_limit_requests_status_created_at_index ON project_limit_requests ( status, created_at ) ;
+CREATE INDEX project_operation_rollups_interval_start_index ON project_operation_rollups ( interval_start ) ;
+CREATE INDEX project_invitations_email_index ON project_invitations ( email ) ;
 CREATE INDEX projects_public_id_index ON projects ( public_id ) ;
 CREATE INDEX repair_queue_updated_at_index ON repair_queue ( updated_at ) ;
 CREATE INDEX repair_queue_num_healthy_pieces_attempted_at_index ON repair_queue ( segment_health, attempted_at ) ;
 CREATE INDEX reverification_audits_inserted_at_index ON reverification_audits ( inserted_at ) ;
+CREATE INDEX revocations_created_at_index ON revocations ( created_at ) ;
 CREATE INDEX storagenode_bandwidth_rollups_interval_start_index ON storagenode_bandwidth_rollups ( interval_start ) ;
 CREATE INDEX storagenode_bandwidth_rollup_archives_interval_start_index ON storagenode_bandwidth_rollup_archives ( interval_start ) ;
 CREATE INDEX storagenode_payments_node_id_period_index ON storagenode_payments ( node_id, period ) ;
 CREATE INDEX storagenode_paystubs_node_id_index ON storagenode_paystubs ( node_id ) ;
+CREATE INDEX storagenode_settlement_claims_interval_start_index ON storagenode_settlement_claims ( interval_start ) ;
 CREATE INDEX storagenode_storage_tallies_node_id_index ON storagenode_storage_tallies ( node_id ) ;
 CREATE INDEX storjscan_payments_block_number_log_index_index ON storjscan_payments ( block_number, log_index ) ;
 CREATE INDEX storjscan_wallets_wallet_address_index ON storjscan_wallets ( wallet_address ) ;
@@ -1732,5292 +2201,8767 @@ nextval:
 	fmt.Fprint(f, "]")
 }
 
-type AccountFreezeEvent struct {
-	UserId    []byte
-	Event     int
-	Limits    []byte
-	CreatedAt time.Time
+type AccessLogEntry struct {
+	ProjectId  []byte
+	LoggedAt   time.Time
+	Id         []byte
+	ApiKeyId   []byte
+	BucketName []byte
+	ObjectKey  []byte
+	Operation  string
+	Bytes      int64
 }
 
-func (AccountFreezeEvent) _Table() string { return "account_freeze_events" }
+func (AccessLogEntry) _Table() string { return "access_log_entries" }
 
-type AccountFreezeEvent_Create_Fields struct {
-	Limits    AccountFreezeEvent_Limits_Field
-	CreatedAt AccountFreezeEvent_CreatedAt_Field
+type AccessLogEntry_Create_Fields struct {
+	ProjectId  AccessLogEntry_ProjectId_Field
+	LoggedAt   AccessLogEntry_LoggedAt_Field
+	Id         AccessLogEntry_Id_Field
+	ApiKeyId   AccessLogEntry_ApiKeyId_Field
+	BucketName AccessLogEntry_BucketName_Field
+	ObjectKey  AccessLogEntry_ObjectKey_Field
+	Operation  AccessLogEntry_Operation_Field
+	Bytes      AccessLogEntry_Bytes_Field
 }
 
-type AccountFreezeEvent_Update_Fields struct {
-	Limits AccountFreezeEvent_Limits_Field
+type AccessLogEntry_Update_Fields struct {
 }
 
-type AccountFreezeEvent_UserId_Field struct {
+type AccessLogEntry_ProjectId_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func AccountFreezeEvent_UserId(v []byte) AccountFreezeEvent_UserId_Field {
-	return AccountFreezeEvent_UserId_Field{_set: true, _value: v}
+func AccessLogEntry_ProjectId(v []byte) AccessLogEntry_ProjectId_Field {
+	return AccessLogEntry_ProjectId_Field{_set: true, _value: v}
 }
 
-func (f AccountFreezeEvent_UserId_Field) value() interface{} {
+func (f AccessLogEntry_ProjectId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (AccountFreezeEvent_UserId_Field) _Column() string { return "user_id" }
+func (AccessLogEntry_ProjectId_Field) _Column() string { return "project_id" }
 
-type AccountFreezeEvent_Event_Field struct {
+type AccessLogEntry_LoggedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value time.Time
 }
 
-func AccountFreezeEvent_Event(v int) AccountFreezeEvent_Event_Field {
-	return AccountFreezeEvent_Event_Field{_set: true, _value: v}
+func AccessLogEntry_LoggedAt(v time.Time) AccessLogEntry_LoggedAt_Field {
+	return AccessLogEntry_LoggedAt_Field{_set: true, _value: v}
 }
 
-func (f AccountFreezeEvent_Event_Field) value() interface{} {
+func (f AccessLogEntry_LoggedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (AccountFreezeEvent_Event_Field) _Column() string { return "event" }
+func (AccessLogEntry_LoggedAt_Field) _Column() string { return "logged_at" }
 
-type AccountFreezeEvent_Limits_Field struct {
+type AccessLogEntry_Id_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func AccountFreezeEvent_Limits(v []byte) AccountFreezeEvent_Limits_Field {
-	return AccountFreezeEvent_Limits_Field{_set: true, _value: v}
+func AccessLogEntry_Id(v []byte) AccessLogEntry_Id_Field {
+	return AccessLogEntry_Id_Field{_set: true, _value: v}
 }
 
-func AccountFreezeEvent_Limits_Raw(v []byte) AccountFreezeEvent_Limits_Field {
-	if v == nil {
-		return AccountFreezeEvent_Limits_Null()
+func (f AccessLogEntry_Id_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return AccountFreezeEvent_Limits(v)
+	return f._value
 }
 
-func AccountFreezeEvent_Limits_Null() AccountFreezeEvent_Limits_Field {
-	return AccountFreezeEvent_Limits_Field{_set: true, _null: true}
+func (AccessLogEntry_Id_Field) _Column() string { return "id" }
+
+type AccessLogEntry_ApiKeyId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
 }
 
-func (f AccountFreezeEvent_Limits_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func AccessLogEntry_ApiKeyId(v []byte) AccessLogEntry_ApiKeyId_Field {
+	return AccessLogEntry_ApiKeyId_Field{_set: true, _value: v}
+}
 
-func (f AccountFreezeEvent_Limits_Field) value() interface{} {
+func (f AccessLogEntry_ApiKeyId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (AccountFreezeEvent_Limits_Field) _Column() string { return "limits" }
+func (AccessLogEntry_ApiKeyId_Field) _Column() string { return "api_key_id" }
 
-type AccountFreezeEvent_CreatedAt_Field struct {
+type AccessLogEntry_BucketName_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value []byte
 }
 
-func AccountFreezeEvent_CreatedAt(v time.Time) AccountFreezeEvent_CreatedAt_Field {
-	return AccountFreezeEvent_CreatedAt_Field{_set: true, _value: v}
+func AccessLogEntry_BucketName(v []byte) AccessLogEntry_BucketName_Field {
+	return AccessLogEntry_BucketName_Field{_set: true, _value: v}
 }
 
-func (f AccountFreezeEvent_CreatedAt_Field) value() interface{} {
+func (f AccessLogEntry_BucketName_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (AccountFreezeEvent_CreatedAt_Field) _Column() string { return "created_at" }
+func (AccessLogEntry_BucketName_Field) _Column() string { return "bucket_name" }
 
-type AccountingRollup struct {
-	NodeId          []byte
-	StartTime       time.Time
-	PutTotal        int64
-	GetTotal        int64
-	GetAuditTotal   int64
-	GetRepairTotal  int64
-	PutRepairTotal  int64
-	AtRestTotal     float64
-	IntervalEndTime *time.Time
-}
-
-func (AccountingRollup) _Table() string { return "accounting_rollups" }
-
-type AccountingRollup_Create_Fields struct {
-	IntervalEndTime AccountingRollup_IntervalEndTime_Field
-}
-
-type AccountingRollup_Update_Fields struct {
-	IntervalEndTime AccountingRollup_IntervalEndTime_Field
-}
-
-type AccountingRollup_NodeId_Field struct {
+type AccessLogEntry_ObjectKey_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func AccountingRollup_NodeId(v []byte) AccountingRollup_NodeId_Field {
-	return AccountingRollup_NodeId_Field{_set: true, _value: v}
+func AccessLogEntry_ObjectKey(v []byte) AccessLogEntry_ObjectKey_Field {
+	return AccessLogEntry_ObjectKey_Field{_set: true, _value: v}
 }
 
-func (f AccountingRollup_NodeId_Field) value() interface{} {
+func (f AccessLogEntry_ObjectKey_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (AccountingRollup_NodeId_Field) _Column() string { return "node_id" }
+func (AccessLogEntry_ObjectKey_Field) _Column() string { return "object_key" }
 
-type AccountingRollup_StartTime_Field struct {
+type AccessLogEntry_Operation_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value string
 }
 
-func AccountingRollup_StartTime(v time.Time) AccountingRollup_StartTime_Field {
-	return AccountingRollup_StartTime_Field{_set: true, _value: v}
+func AccessLogEntry_Operation(v string) AccessLogEntry_Operation_Field {
+	return AccessLogEntry_Operation_Field{_set: true, _value: v}
 }
 
-func (f AccountingRollup_StartTime_Field) value() interface{} {
+func (f AccessLogEntry_Operation_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (AccountingRollup_StartTime_Field) _Column() string { return "start_time" }
+func (AccessLogEntry_Operation_Field) _Column() string { return "operation" }
 
-type AccountingRollup_PutTotal_Field struct {
+type AccessLogEntry_Bytes_Field struct {
 	_set   bool
 	_null  bool
 	_value int64
 }
 
-func AccountingRollup_PutTotal(v int64) AccountingRollup_PutTotal_Field {
-	return AccountingRollup_PutTotal_Field{_set: true, _value: v}
+func AccessLogEntry_Bytes(v int64) AccessLogEntry_Bytes_Field {
+	return AccessLogEntry_Bytes_Field{_set: true, _value: v}
 }
 
-func (f AccountingRollup_PutTotal_Field) value() interface{} {
+func (f AccessLogEntry_Bytes_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (AccountingRollup_PutTotal_Field) _Column() string { return "put_total" }
+func (AccessLogEntry_Bytes_Field) _Column() string { return "bytes" }
 
-type AccountingRollup_GetTotal_Field struct {
+type AccessLogExport struct {
+	ProjectId         []byte
+	DestinationAccess string
+	DestinationBucket []byte
+	Prefix            string
+	ExportedUntil     time.Time
+	LastRunAt         *time.Time
+	LastError         *string
+	CreatedAt         time.Time
+}
+
+func (AccessLogExport) _Table() string { return "access_log_exports" }
+
+type AccessLogExport_Create_Fields struct {
+	ProjectId         AccessLogExport_ProjectId_Field
+	DestinationAccess AccessLogExport_DestinationAccess_Field
+	DestinationBucket AccessLogExport_DestinationBucket_Field
+	Prefix            AccessLogExport_Prefix_Field
+	ExportedUntil     AccessLogExport_ExportedUntil_Field
+	LastRunAt         AccessLogExport_LastRunAt_Field
+	LastError         AccessLogExport_LastError_Field
+}
+
+type AccessLogExport_Update_Fields struct {
+	ExportedUntil AccessLogExport_ExportedUntil_Field
+	LastRunAt     AccessLogExport_LastRunAt_Field
+	LastError     AccessLogExport_LastError_Field
+}
+
+type AccessLogExport_ProjectId_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value []byte
 }
 
-func AccountingRollup_GetTotal(v int64) AccountingRollup_GetTotal_Field {
-	return AccountingRollup_GetTotal_Field{_set: true, _value: v}
+func AccessLogExport_ProjectId(v []byte) AccessLogExport_ProjectId_Field {
+	return AccessLogExport_ProjectId_Field{_set: true, _value: v}
 }
 
-func (f AccountingRollup_GetTotal_Field) value() interface{} {
+func (f AccessLogExport_ProjectId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (AccountingRollup_GetTotal_Field) _Column() string { return "get_total" }
+func (AccessLogExport_ProjectId_Field) _Column() string { return "project_id" }
 
-type AccountingRollup_GetAuditTotal_Field struct {
+type AccessLogExport_DestinationAccess_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value string
 }
 
-func AccountingRollup_GetAuditTotal(v int64) AccountingRollup_GetAuditTotal_Field {
-	return AccountingRollup_GetAuditTotal_Field{_set: true, _value: v}
+func AccessLogExport_DestinationAccess(v string) AccessLogExport_DestinationAccess_Field {
+	return AccessLogExport_DestinationAccess_Field{_set: true, _value: v}
 }
 
-func (f AccountingRollup_GetAuditTotal_Field) value() interface{} {
+func (f AccessLogExport_DestinationAccess_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (AccountingRollup_GetAuditTotal_Field) _Column() string { return "get_audit_total" }
+func (AccessLogExport_DestinationAccess_Field) _Column() string { return "destination_access" }
 
-type AccountingRollup_GetRepairTotal_Field struct {
+type AccessLogExport_DestinationBucket_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value []byte
 }
 
-func AccountingRollup_GetRepairTotal(v int64) AccountingRollup_GetRepairTotal_Field {
-	return AccountingRollup_GetRepairTotal_Field{_set: true, _value: v}
+func AccessLogExport_DestinationBucket(v []byte) AccessLogExport_DestinationBucket_Field {
+	return AccessLogExport_DestinationBucket_Field{_set: true, _value: v}
 }
 
-func (f AccountingRollup_GetRepairTotal_Field) value() interface{} {
+func (f AccessLogExport_DestinationBucket_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (AccountingRollup_GetRepairTotal_Field) _Column() string { return "get_repair_total" }
+func (AccessLogExport_DestinationBucket_Field) _Column() string { return "destination_bucket" }
 
-type AccountingRollup_PutRepairTotal_Field struct {
+type AccessLogExport_Prefix_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value string
 }
 
-func AccountingRollup_PutRepairTotal(v int64) AccountingRollup_PutRepairTotal_Field {
-	return AccountingRollup_PutRepairTotal_Field{_set: true, _value: v}
+func AccessLogExport_Prefix(v string) AccessLogExport_Prefix_Field {
+	return AccessLogExport_Prefix_Field{_set: true, _value: v}
 }
 
-func (f AccountingRollup_PutRepairTotal_Field) value() interface{} {
+func (f AccessLogExport_Prefix_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (AccountingRollup_PutRepairTotal_Field) _Column() string { return "put_repair_total" }
+func (AccessLogExport_Prefix_Field) _Column() string { return "prefix" }
 
-type AccountingRollup_AtRestTotal_Field struct {
+type AccessLogExport_ExportedUntil_Field struct {
 	_set   bool
 	_null  bool
-	_value float64
+	_value time.Time
 }
 
-func AccountingRollup_AtRestTotal(v float64) AccountingRollup_AtRestTotal_Field {
-	return AccountingRollup_AtRestTotal_Field{_set: true, _value: v}
+func AccessLogExport_ExportedUntil(v time.Time) AccessLogExport_ExportedUntil_Field {
+	return AccessLogExport_ExportedUntil_Field{_set: true, _value: v}
 }
 
-func (f AccountingRollup_AtRestTotal_Field) value() interface{} {
+func (f AccessLogExport_ExportedUntil_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (AccountingRollup_AtRestTotal_Field) _Column() string { return "at_rest_total" }
+func (AccessLogExport_ExportedUntil_Field) _Column() string { return "exported_until" }
 
-type AccountingRollup_IntervalEndTime_Field struct {
+type AccessLogExport_LastRunAt_Field struct {
 	_set   bool
 	_null  bool
 	_value *time.Time
 }
 
-func AccountingRollup_IntervalEndTime(v time.Time) AccountingRollup_IntervalEndTime_Field {
-	return AccountingRollup_IntervalEndTime_Field{_set: true, _value: &v}
+func AccessLogExport_LastRunAt(v time.Time) AccessLogExport_LastRunAt_Field {
+	return AccessLogExport_LastRunAt_Field{_set: true, _value: &v}
 }
 
-func AccountingRollup_IntervalEndTime_Raw(v *time.Time) AccountingRollup_IntervalEndTime_Field {
+func AccessLogExport_LastRunAt_Raw(v *time.Time) AccessLogExport_LastRunAt_Field {
 	if v == nil {
-		return AccountingRollup_IntervalEndTime_Null()
+		return AccessLogExport_LastRunAt_Null()
 	}
-	return AccountingRollup_IntervalEndTime(*v)
+	return AccessLogExport_LastRunAt(*v)
 }
 
-func AccountingRollup_IntervalEndTime_Null() AccountingRollup_IntervalEndTime_Field {
-	return AccountingRollup_IntervalEndTime_Field{_set: true, _null: true}
+func AccessLogExport_LastRunAt_Null() AccessLogExport_LastRunAt_Field {
+	return AccessLogExport_LastRunAt_Field{_set: true, _null: true}
 }
 
-func (f AccountingRollup_IntervalEndTime_Field) isnull() bool {
-	return !f._set || f._null || f._value == nil
-}
+func (f AccessLogExport_LastRunAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f AccountingRollup_IntervalEndTime_Field) value() interface{} {
+func (f AccessLogExport_LastRunAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (AccountingRollup_IntervalEndTime_Field) _Column() string { return "interval_end_time" }
+func (AccessLogExport_LastRunAt_Field) _Column() string { return "last_run_at" }
 
-type AccountingTimestamps struct {
-	Name  string
-	Value time.Time
+type AccessLogExport_LastError_Field struct {
+	_set   bool
+	_null  bool
+	_value *string
 }
 
-func (AccountingTimestamps) _Table() string { return "accounting_timestamps" }
-
-type AccountingTimestamps_Update_Fields struct {
-	Value AccountingTimestamps_Value_Field
+func AccessLogExport_LastError(v string) AccessLogExport_LastError_Field {
+	return AccessLogExport_LastError_Field{_set: true, _value: &v}
 }
 
-type AccountingTimestamps_Name_Field struct {
-	_set   bool
-	_null  bool
-	_value string
+func AccessLogExport_LastError_Raw(v *string) AccessLogExport_LastError_Field {
+	if v == nil {
+		return AccessLogExport_LastError_Null()
+	}
+	return AccessLogExport_LastError(*v)
 }
 
-func AccountingTimestamps_Name(v string) AccountingTimestamps_Name_Field {
-	return AccountingTimestamps_Name_Field{_set: true, _value: v}
+func AccessLogExport_LastError_Null() AccessLogExport_LastError_Field {
+	return AccessLogExport_LastError_Field{_set: true, _null: true}
 }
 
-func (f AccountingTimestamps_Name_Field) value() interface{} {
+func (f AccessLogExport_LastError_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f AccessLogExport_LastError_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (AccountingTimestamps_Name_Field) _Column() string { return "name" }
+func (AccessLogExport_LastError_Field) _Column() string { return "last_error" }
 
-type AccountingTimestamps_Value_Field struct {
+type AccessLogExport_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
 	_value time.Time
 }
 
-func AccountingTimestamps_Value(v time.Time) AccountingTimestamps_Value_Field {
-	return AccountingTimestamps_Value_Field{_set: true, _value: v}
+func AccessLogExport_CreatedAt(v time.Time) AccessLogExport_CreatedAt_Field {
+	return AccessLogExport_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f AccountingTimestamps_Value_Field) value() interface{} {
+func (f AccessLogExport_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (AccountingTimestamps_Value_Field) _Column() string { return "value" }
+func (AccessLogExport_CreatedAt_Field) _Column() string { return "created_at" }
 
-type BillingBalance struct {
-	UserId      []byte
-	Balance     int64
-	LastUpdated time.Time
+type AccountFreezeEvent struct {
+	UserId    []byte
+	Event     int
+	Limits    []byte
+	CreatedAt time.Time
 }
 
-func (BillingBalance) _Table() string { return "billing_balances" }
+func (AccountFreezeEvent) _Table() string { return "account_freeze_events" }
 
-type BillingBalance_Update_Fields struct {
-	Balance BillingBalance_Balance_Field
+type AccountFreezeEvent_Create_Fields struct {
+	Limits    AccountFreezeEvent_Limits_Field
+	CreatedAt AccountFreezeEvent_CreatedAt_Field
 }
 
-type BillingBalance_UserId_Field struct {
+type AccountFreezeEvent_Update_Fields struct {
+	Limits AccountFreezeEvent_Limits_Field
+}
+
+type AccountFreezeEvent_UserId_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func BillingBalance_UserId(v []byte) BillingBalance_UserId_Field {
-	return BillingBalance_UserId_Field{_set: true, _value: v}
+func AccountFreezeEvent_UserId(v []byte) AccountFreezeEvent_UserId_Field {
+	return AccountFreezeEvent_UserId_Field{_set: true, _value: v}
 }
 
-func (f BillingBalance_UserId_Field) value() interface{} {
+func (f AccountFreezeEvent_UserId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BillingBalance_UserId_Field) _Column() string { return "user_id" }
+func (AccountFreezeEvent_UserId_Field) _Column() string { return "user_id" }
 
-type BillingBalance_Balance_Field struct {
+type AccountFreezeEvent_Event_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value int
 }
 
-func BillingBalance_Balance(v int64) BillingBalance_Balance_Field {
-	return BillingBalance_Balance_Field{_set: true, _value: v}
+func AccountFreezeEvent_Event(v int) AccountFreezeEvent_Event_Field {
+	return AccountFreezeEvent_Event_Field{_set: true, _value: v}
 }
 
-func (f BillingBalance_Balance_Field) value() interface{} {
+func (f AccountFreezeEvent_Event_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BillingBalance_Balance_Field) _Column() string { return "balance" }
+func (AccountFreezeEvent_Event_Field) _Column() string { return "event" }
 
-type BillingBalance_LastUpdated_Field struct {
+type AccountFreezeEvent_Limits_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value []byte
 }
 
-func BillingBalance_LastUpdated(v time.Time) BillingBalance_LastUpdated_Field {
-	return BillingBalance_LastUpdated_Field{_set: true, _value: v}
+func AccountFreezeEvent_Limits(v []byte) AccountFreezeEvent_Limits_Field {
+	return AccountFreezeEvent_Limits_Field{_set: true, _value: v}
 }
 
-func (f BillingBalance_LastUpdated_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
+func AccountFreezeEvent_Limits_Raw(v []byte) AccountFreezeEvent_Limits_Field {
+	if v == nil {
+		return AccountFreezeEvent_Limits_Null()
 	}
-	return f._value
+	return AccountFreezeEvent_Limits(v)
 }
 
-func (BillingBalance_LastUpdated_Field) _Column() string { return "last_updated" }
-
-type BillingTransaction struct {
-	Id          int64
-	UserId      []byte
-	Amount      int64
-	Currency    string
-	Description string
-	Source      string
-	Status      string
-	Type        string
-	Metadata    []byte
-	Timestamp   time.Time
-	CreatedAt   time.Time
+func AccountFreezeEvent_Limits_Null() AccountFreezeEvent_Limits_Field {
+	return AccountFreezeEvent_Limits_Field{_set: true, _null: true}
 }
 
-func (BillingTransaction) _Table() string { return "billing_transactions" }
+func (f AccountFreezeEvent_Limits_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-type BillingTransaction_Update_Fields struct {
-	Status   BillingTransaction_Status_Field
-	Metadata BillingTransaction_Metadata_Field
+func (f AccountFreezeEvent_Limits_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
 }
 
-type BillingTransaction_Id_Field struct {
+func (AccountFreezeEvent_Limits_Field) _Column() string { return "limits" }
+
+type AccountFreezeEvent_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value time.Time
 }
 
-func BillingTransaction_Id(v int64) BillingTransaction_Id_Field {
-	return BillingTransaction_Id_Field{_set: true, _value: v}
+func AccountFreezeEvent_CreatedAt(v time.Time) AccountFreezeEvent_CreatedAt_Field {
+	return AccountFreezeEvent_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f BillingTransaction_Id_Field) value() interface{} {
+func (f AccountFreezeEvent_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BillingTransaction_Id_Field) _Column() string { return "id" }
+func (AccountFreezeEvent_CreatedAt_Field) _Column() string { return "created_at" }
 
-type BillingTransaction_UserId_Field struct {
+type AccountingRollup struct {
+	NodeId          []byte
+	StartTime       time.Time
+	PutTotal        int64
+	GetTotal        int64
+	GetAuditTotal   int64
+	GetRepairTotal  int64
+	PutRepairTotal  int64
+	AtRestTotal     float64
+	IntervalEndTime *time.Time
+}
+
+func (AccountingRollup) _Table() string { return "accounting_rollups" }
+
+type AccountingRollup_Create_Fields struct {
+	IntervalEndTime AccountingRollup_IntervalEndTime_Field
+}
+
+type AccountingRollup_Update_Fields struct {
+	IntervalEndTime AccountingRollup_IntervalEndTime_Field
+}
+
+type AccountingRollup_NodeId_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func BillingTransaction_UserId(v []byte) BillingTransaction_UserId_Field {
-	return BillingTransaction_UserId_Field{_set: true, _value: v}
+func AccountingRollup_NodeId(v []byte) AccountingRollup_NodeId_Field {
+	return AccountingRollup_NodeId_Field{_set: true, _value: v}
 }
 
-func (f BillingTransaction_UserId_Field) value() interface{} {
+func (f AccountingRollup_NodeId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BillingTransaction_UserId_Field) _Column() string { return "user_id" }
+func (AccountingRollup_NodeId_Field) _Column() string { return "node_id" }
 
-type BillingTransaction_Amount_Field struct {
+type AccountingRollup_StartTime_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value time.Time
 }
 
-func BillingTransaction_Amount(v int64) BillingTransaction_Amount_Field {
-	return BillingTransaction_Amount_Field{_set: true, _value: v}
+func AccountingRollup_StartTime(v time.Time) AccountingRollup_StartTime_Field {
+	return AccountingRollup_StartTime_Field{_set: true, _value: v}
 }
 
-func (f BillingTransaction_Amount_Field) value() interface{} {
+func (f AccountingRollup_StartTime_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BillingTransaction_Amount_Field) _Column() string { return "amount" }
+func (AccountingRollup_StartTime_Field) _Column() string { return "start_time" }
 
-type BillingTransaction_Currency_Field struct {
+type AccountingRollup_PutTotal_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value int64
 }
 
-func BillingTransaction_Currency(v string) BillingTransaction_Currency_Field {
-	return BillingTransaction_Currency_Field{_set: true, _value: v}
+func AccountingRollup_PutTotal(v int64) AccountingRollup_PutTotal_Field {
+	return AccountingRollup_PutTotal_Field{_set: true, _value: v}
 }
 
-func (f BillingTransaction_Currency_Field) value() interface{} {
+func (f AccountingRollup_PutTotal_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BillingTransaction_Currency_Field) _Column() string { return "currency" }
+func (AccountingRollup_PutTotal_Field) _Column() string { return "put_total" }
 
-type BillingTransaction_Description_Field struct {
+type AccountingRollup_GetTotal_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value int64
 }
 
-func BillingTransaction_Description(v string) BillingTransaction_Description_Field {
-	return BillingTransaction_Description_Field{_set: true, _value: v}
+func AccountingRollup_GetTotal(v int64) AccountingRollup_GetTotal_Field {
+	return AccountingRollup_GetTotal_Field{_set: true, _value: v}
 }
 
-func (f BillingTransaction_Description_Field) value() interface{} {
+func (f AccountingRollup_GetTotal_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BillingTransaction_Description_Field) _Column() string { return "description" }
+func (AccountingRollup_GetTotal_Field) _Column() string { return "get_total" }
 
-type BillingTransaction_Source_Field struct {
+type AccountingRollup_GetAuditTotal_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value int64
 }
 
-func BillingTransaction_Source(v string) BillingTransaction_Source_Field {
-	return BillingTransaction_Source_Field{_set: true, _value: v}
+func AccountingRollup_GetAuditTotal(v int64) AccountingRollup_GetAuditTotal_Field {
+	return AccountingRollup_GetAuditTotal_Field{_set: true, _value: v}
 }
 
-func (f BillingTransaction_Source_Field) value() interface{} {
+func (f AccountingRollup_GetAuditTotal_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BillingTransaction_Source_Field) _Column() string { return "source" }
+func (AccountingRollup_GetAuditTotal_Field) _Column() string { return "get_audit_total" }
 
-type BillingTransaction_Status_Field struct {
+type AccountingRollup_GetRepairTotal_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value int64
 }
 
-func BillingTransaction_Status(v string) BillingTransaction_Status_Field {
-	return BillingTransaction_Status_Field{_set: true, _value: v}
+func AccountingRollup_GetRepairTotal(v int64) AccountingRollup_GetRepairTotal_Field {
+	return AccountingRollup_GetRepairTotal_Field{_set: true, _value: v}
 }
 
-func (f BillingTransaction_Status_Field) value() interface{} {
+func (f AccountingRollup_GetRepairTotal_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BillingTransaction_Status_Field) _Column() string { return "status" }
+func (AccountingRollup_GetRepairTotal_Field) _Column() string { return "get_repair_total" }
 
-type BillingTransaction_Type_Field struct {
+type AccountingRollup_PutRepairTotal_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value int64
 }
 
-func BillingTransaction_Type(v string) BillingTransaction_Type_Field {
-	return BillingTransaction_Type_Field{_set: true, _value: v}
+func AccountingRollup_PutRepairTotal(v int64) AccountingRollup_PutRepairTotal_Field {
+	return AccountingRollup_PutRepairTotal_Field{_set: true, _value: v}
 }
 
-func (f BillingTransaction_Type_Field) value() interface{} {
+func (f AccountingRollup_PutRepairTotal_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BillingTransaction_Type_Field) _Column() string { return "type" }
+func (AccountingRollup_PutRepairTotal_Field) _Column() string { return "put_repair_total" }
 
-type BillingTransaction_Metadata_Field struct {
+type AccountingRollup_AtRestTotal_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value float64
 }
 
-func BillingTransaction_Metadata(v []byte) BillingTransaction_Metadata_Field {
-	return BillingTransaction_Metadata_Field{_set: true, _value: v}
+func AccountingRollup_AtRestTotal(v float64) AccountingRollup_AtRestTotal_Field {
+	return AccountingRollup_AtRestTotal_Field{_set: true, _value: v}
 }
 
-func (f BillingTransaction_Metadata_Field) value() interface{} {
+func (f AccountingRollup_AtRestTotal_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BillingTransaction_Metadata_Field) _Column() string { return "metadata" }
+func (AccountingRollup_AtRestTotal_Field) _Column() string { return "at_rest_total" }
 
-type BillingTransaction_Timestamp_Field struct {
+type AccountingRollup_IntervalEndTime_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value *time.Time
 }
 
-func BillingTransaction_Timestamp(v time.Time) BillingTransaction_Timestamp_Field {
-	return BillingTransaction_Timestamp_Field{_set: true, _value: v}
+func AccountingRollup_IntervalEndTime(v time.Time) AccountingRollup_IntervalEndTime_Field {
+	return AccountingRollup_IntervalEndTime_Field{_set: true, _value: &v}
 }
 
-func (f BillingTransaction_Timestamp_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
+func AccountingRollup_IntervalEndTime_Raw(v *time.Time) AccountingRollup_IntervalEndTime_Field {
+	if v == nil {
+		return AccountingRollup_IntervalEndTime_Null()
 	}
-	return f._value
+	return AccountingRollup_IntervalEndTime(*v)
 }
 
-func (BillingTransaction_Timestamp_Field) _Column() string { return "timestamp" }
-
-type BillingTransaction_CreatedAt_Field struct {
-	_set   bool
-	_null  bool
-	_value time.Time
+func AccountingRollup_IntervalEndTime_Null() AccountingRollup_IntervalEndTime_Field {
+	return AccountingRollup_IntervalEndTime_Field{_set: true, _null: true}
 }
 
-func BillingTransaction_CreatedAt(v time.Time) BillingTransaction_CreatedAt_Field {
-	return BillingTransaction_CreatedAt_Field{_set: true, _value: v}
+func (f AccountingRollup_IntervalEndTime_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
 }
 
-func (f BillingTransaction_CreatedAt_Field) value() interface{} {
+func (f AccountingRollup_IntervalEndTime_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BillingTransaction_CreatedAt_Field) _Column() string { return "created_at" }
+func (AccountingRollup_IntervalEndTime_Field) _Column() string { return "interval_end_time" }
 
-type BucketBandwidthRollup struct {
-	BucketName      []byte
-	ProjectId       []byte
-	IntervalStart   time.Time
-	IntervalSeconds uint
-	Action          uint
-	Inline          uint64
-	Allocated       uint64
-	Settled         uint64
+type AccountingTimestamps struct {
+	Name  string
+	Value time.Time
 }
 
-func (BucketBandwidthRollup) _Table() string { return "bucket_bandwidth_rollups" }
+func (AccountingTimestamps) _Table() string { return "accounting_timestamps" }
 
-type BucketBandwidthRollup_Update_Fields struct {
-	Inline    BucketBandwidthRollup_Inline_Field
-	Allocated BucketBandwidthRollup_Allocated_Field
-	Settled   BucketBandwidthRollup_Settled_Field
+type AccountingTimestamps_Update_Fields struct {
+	Value AccountingTimestamps_Value_Field
 }
 
-type BucketBandwidthRollup_BucketName_Field struct {
+type AccountingTimestamps_Name_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value string
 }
 
-func BucketBandwidthRollup_BucketName(v []byte) BucketBandwidthRollup_BucketName_Field {
-	return BucketBandwidthRollup_BucketName_Field{_set: true, _value: v}
+func AccountingTimestamps_Name(v string) AccountingTimestamps_Name_Field {
+	return AccountingTimestamps_Name_Field{_set: true, _value: v}
 }
 
-func (f BucketBandwidthRollup_BucketName_Field) value() interface{} {
+func (f AccountingTimestamps_Name_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketBandwidthRollup_BucketName_Field) _Column() string { return "bucket_name" }
+func (AccountingTimestamps_Name_Field) _Column() string { return "name" }
 
-type BucketBandwidthRollup_ProjectId_Field struct {
+type AccountingTimestamps_Value_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value time.Time
 }
 
-func BucketBandwidthRollup_ProjectId(v []byte) BucketBandwidthRollup_ProjectId_Field {
-	return BucketBandwidthRollup_ProjectId_Field{_set: true, _value: v}
+func AccountingTimestamps_Value(v time.Time) AccountingTimestamps_Value_Field {
+	return AccountingTimestamps_Value_Field{_set: true, _value: v}
 }
 
-func (f BucketBandwidthRollup_ProjectId_Field) value() interface{} {
+func (f AccountingTimestamps_Value_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketBandwidthRollup_ProjectId_Field) _Column() string { return "project_id" }
+func (AccountingTimestamps_Value_Field) _Column() string { return "value" }
 
-type BucketBandwidthRollup_IntervalStart_Field struct {
+type BillingBalance struct {
+	UserId      []byte
+	Balance     int64
+	LastUpdated time.Time
+}
+
+func (BillingBalance) _Table() string { return "billing_balances" }
+
+type BillingBalance_Update_Fields struct {
+	Balance BillingBalance_Balance_Field
+}
+
+type BillingBalance_UserId_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value []byte
 }
 
-func BucketBandwidthRollup_IntervalStart(v time.Time) BucketBandwidthRollup_IntervalStart_Field {
-	return BucketBandwidthRollup_IntervalStart_Field{_set: true, _value: v}
+func BillingBalance_UserId(v []byte) BillingBalance_UserId_Field {
+	return BillingBalance_UserId_Field{_set: true, _value: v}
 }
 
-func (f BucketBandwidthRollup_IntervalStart_Field) value() interface{} {
+func (f BillingBalance_UserId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketBandwidthRollup_IntervalStart_Field) _Column() string { return "interval_start" }
+func (BillingBalance_UserId_Field) _Column() string { return "user_id" }
 
-type BucketBandwidthRollup_IntervalSeconds_Field struct {
+type BillingBalance_Balance_Field struct {
 	_set   bool
 	_null  bool
-	_value uint
+	_value int64
 }
 
-func BucketBandwidthRollup_IntervalSeconds(v uint) BucketBandwidthRollup_IntervalSeconds_Field {
-	return BucketBandwidthRollup_IntervalSeconds_Field{_set: true, _value: v}
+func BillingBalance_Balance(v int64) BillingBalance_Balance_Field {
+	return BillingBalance_Balance_Field{_set: true, _value: v}
 }
 
-func (f BucketBandwidthRollup_IntervalSeconds_Field) value() interface{} {
+func (f BillingBalance_Balance_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketBandwidthRollup_IntervalSeconds_Field) _Column() string { return "interval_seconds" }
+func (BillingBalance_Balance_Field) _Column() string { return "balance" }
 
-type BucketBandwidthRollup_Action_Field struct {
+type BillingBalance_LastUpdated_Field struct {
 	_set   bool
 	_null  bool
-	_value uint
+	_value time.Time
 }
 
-func BucketBandwidthRollup_Action(v uint) BucketBandwidthRollup_Action_Field {
-	return BucketBandwidthRollup_Action_Field{_set: true, _value: v}
+func BillingBalance_LastUpdated(v time.Time) BillingBalance_LastUpdated_Field {
+	return BillingBalance_LastUpdated_Field{_set: true, _value: v}
 }
 
-func (f BucketBandwidthRollup_Action_Field) value() interface{} {
+func (f BillingBalance_LastUpdated_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketBandwidthRollup_Action_Field) _Column() string { return "action" }
+func (BillingBalance_LastUpdated_Field) _Column() string { return "last_updated" }
 
-type BucketBandwidthRollup_Inline_Field struct {
+type BillingTransaction struct {
+	Id          int64
+	UserId      []byte
+	Amount      int64
+	Currency    string
+	Description string
+	Source      string
+	Status      string
+	Type        string
+	Metadata    []byte
+	Timestamp   time.Time
+	CreatedAt   time.Time
+}
+
+func (BillingTransaction) _Table() string { return "billing_transactions" }
+
+type BillingTransaction_Update_Fields struct {
+	Status   BillingTransaction_Status_Field
+	Metadata BillingTransaction_Metadata_Field
+}
+
+type BillingTransaction_Id_Field struct {
 	_set   bool
 	_null  bool
-	_value uint64
+	_value int64
 }
 
-func BucketBandwidthRollup_Inline(v uint64) BucketBandwidthRollup_Inline_Field {
-	return BucketBandwidthRollup_Inline_Field{_set: true, _value: v}
+func BillingTransaction_Id(v int64) BillingTransaction_Id_Field {
+	return BillingTransaction_Id_Field{_set: true, _value: v}
 }
 
-func (f BucketBandwidthRollup_Inline_Field) value() interface{} {
+func (f BillingTransaction_Id_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketBandwidthRollup_Inline_Field) _Column() string { return "inline" }
+func (BillingTransaction_Id_Field) _Column() string { return "id" }
 
-type BucketBandwidthRollup_Allocated_Field struct {
+type BillingTransaction_UserId_Field struct {
 	_set   bool
 	_null  bool
-	_value uint64
+	_value []byte
 }
 
-func BucketBandwidthRollup_Allocated(v uint64) BucketBandwidthRollup_Allocated_Field {
-	return BucketBandwidthRollup_Allocated_Field{_set: true, _value: v}
+func BillingTransaction_UserId(v []byte) BillingTransaction_UserId_Field {
+	return BillingTransaction_UserId_Field{_set: true, _value: v}
 }
 
-func (f BucketBandwidthRollup_Allocated_Field) value() interface{} {
+func (f BillingTransaction_UserId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketBandwidthRollup_Allocated_Field) _Column() string { return "allocated" }
+func (BillingTransaction_UserId_Field) _Column() string { return "user_id" }
 
-type BucketBandwidthRollup_Settled_Field struct {
+type BillingTransaction_Amount_Field struct {
 	_set   bool
 	_null  bool
-	_value uint64
+	_value int64
 }
 
-func BucketBandwidthRollup_Settled(v uint64) BucketBandwidthRollup_Settled_Field {
-	return BucketBandwidthRollup_Settled_Field{_set: true, _value: v}
+func BillingTransaction_Amount(v int64) BillingTransaction_Amount_Field {
+	return BillingTransaction_Amount_Field{_set: true, _value: v}
 }
 
-func (f BucketBandwidthRollup_Settled_Field) value() interface{} {
+func (f BillingTransaction_Amount_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketBandwidthRollup_Settled_Field) _Column() string { return "settled" }
-
-type BucketBandwidthRollupArchive struct {
-	BucketName      []byte
-	ProjectId       []byte
-	IntervalStart   time.Time
-	IntervalSeconds uint
-	Action          uint
-	Inline          uint64
-	Allocated       uint64
-	Settled         uint64
-}
-
-func (BucketBandwidthRollupArchive) _Table() string { return "bucket_bandwidth_rollup_archives" }
-
-type BucketBandwidthRollupArchive_Update_Fields struct {
-	Inline    BucketBandwidthRollupArchive_Inline_Field
-	Allocated BucketBandwidthRollupArchive_Allocated_Field
-	Settled   BucketBandwidthRollupArchive_Settled_Field
-}
+func (BillingTransaction_Amount_Field) _Column() string { return "amount" }
 
-type BucketBandwidthRollupArchive_BucketName_Field struct {
+type BillingTransaction_Currency_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value string
 }
 
-func BucketBandwidthRollupArchive_BucketName(v []byte) BucketBandwidthRollupArchive_BucketName_Field {
-	return BucketBandwidthRollupArchive_BucketName_Field{_set: true, _value: v}
+func BillingTransaction_Currency(v string) BillingTransaction_Currency_Field {
+	return BillingTransaction_Currency_Field{_set: true, _value: v}
 }
 
-func (f BucketBandwidthRollupArchive_BucketName_Field) value() interface{} {
+func (f BillingTransaction_Currency_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketBandwidthRollupArchive_BucketName_Field) _Column() string { return "bucket_name" }
+func (BillingTransaction_Currency_Field) _Column() string { return "currency" }
 
-type BucketBandwidthRollupArchive_ProjectId_Field struct {
+type BillingTransaction_Description_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value string
 }
 
-func BucketBandwidthRollupArchive_ProjectId(v []byte) BucketBandwidthRollupArchive_ProjectId_Field {
-	return BucketBandwidthRollupArchive_ProjectId_Field{_set: true, _value: v}
+func BillingTransaction_Description(v string) BillingTransaction_Description_Field {
+	return BillingTransaction_Description_Field{_set: true, _value: v}
 }
 
-func (f BucketBandwidthRollupArchive_ProjectId_Field) value() interface{} {
+func (f BillingTransaction_Description_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketBandwidthRollupArchive_ProjectId_Field) _Column() string { return "project_id" }
+func (BillingTransaction_Description_Field) _Column() string { return "description" }
 
-type BucketBandwidthRollupArchive_IntervalStart_Field struct {
+type BillingTransaction_Source_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value string
 }
 
-func BucketBandwidthRollupArchive_IntervalStart(v time.Time) BucketBandwidthRollupArchive_IntervalStart_Field {
-	return BucketBandwidthRollupArchive_IntervalStart_Field{_set: true, _value: v}
+func BillingTransaction_Source(v string) BillingTransaction_Source_Field {
+	return BillingTransaction_Source_Field{_set: true, _value: v}
 }
 
-func (f BucketBandwidthRollupArchive_IntervalStart_Field) value() interface{} {
+func (f BillingTransaction_Source_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketBandwidthRollupArchive_IntervalStart_Field) _Column() string { return "interval_start" }
+func (BillingTransaction_Source_Field) _Column() string { return "source" }
 
-type BucketBandwidthRollupArchive_IntervalSeconds_Field struct {
+type BillingTransaction_Status_Field struct {
 	_set   bool
 	_null  bool
-	_value uint
+	_value string
 }
 
-func BucketBandwidthRollupArchive_IntervalSeconds(v uint) BucketBandwidthRollupArchive_IntervalSeconds_Field {
-	return BucketBandwidthRollupArchive_IntervalSeconds_Field{_set: true, _value: v}
+func BillingTransaction_Status(v string) BillingTransaction_Status_Field {
+	return BillingTransaction_Status_Field{_set: true, _value: v}
 }
 
-func (f BucketBandwidthRollupArchive_IntervalSeconds_Field) value() interface{} {
+func (f BillingTransaction_Status_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketBandwidthRollupArchive_IntervalSeconds_Field) _Column() string { return "interval_seconds" }
+func (BillingTransaction_Status_Field) _Column() string { return "status" }
 
-type BucketBandwidthRollupArchive_Action_Field struct {
+type BillingTransaction_Type_Field struct {
 	_set   bool
 	_null  bool
-	_value uint
+	_value string
 }
 
-func BucketBandwidthRollupArchive_Action(v uint) BucketBandwidthRollupArchive_Action_Field {
-	return BucketBandwidthRollupArchive_Action_Field{_set: true, _value: v}
+func BillingTransaction_Type(v string) BillingTransaction_Type_Field {
+	return BillingTransaction_Type_Field{_set: true, _value: v}
 }
 
-func (f BucketBandwidthRollupArchive_Action_Field) value() interface{} {
+func (f BillingTransaction_Type_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketBandwidthRollupArchive_Action_Field) _Column() string { return "action" }
+func (BillingTransaction_Type_Field) _Column() string { return "type" }
 
-type BucketBandwidthRollupArchive_Inline_Field struct {
+type BillingTransaction_Metadata_Field struct {
 	_set   bool
 	_null  bool
-	_value uint64
+	_value []byte
 }
 
-func BucketBandwidthRollupArchive_Inline(v uint64) BucketBandwidthRollupArchive_Inline_Field {
-	return BucketBandwidthRollupArchive_Inline_Field{_set: true, _value: v}
+func BillingTransaction_Metadata(v []byte) BillingTransaction_Metadata_Field {
+	return BillingTransaction_Metadata_Field{_set: true, _value: v}
 }
 
-func (f BucketBandwidthRollupArchive_Inline_Field) value() interface{} {
+func (f BillingTransaction_Metadata_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketBandwidthRollupArchive_Inline_Field) _Column() string { return "inline" }
+func (BillingTransaction_Metadata_Field) _Column() string { return "metadata" }
 
-type BucketBandwidthRollupArchive_Allocated_Field struct {
+type BillingTransaction_Timestamp_Field struct {
 	_set   bool
 	_null  bool
-	_value uint64
+	_value time.Time
 }
 
-func BucketBandwidthRollupArchive_Allocated(v uint64) BucketBandwidthRollupArchive_Allocated_Field {
-	return BucketBandwidthRollupArchive_Allocated_Field{_set: true, _value: v}
+func BillingTransaction_Timestamp(v time.Time) BillingTransaction_Timestamp_Field {
+	return BillingTransaction_Timestamp_Field{_set: true, _value: v}
 }
 
-func (f BucketBandwidthRollupArchive_Allocated_Field) value() interface{} {
+func (f BillingTransaction_Timestamp_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketBandwidthRollupArchive_Allocated_Field) _Column() string { return "allocated" }
+func (BillingTransaction_Timestamp_Field) _Column() string { return "timestamp" }
 
-type BucketBandwidthRollupArchive_Settled_Field struct {
+type BillingTransaction_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value uint64
+	_value time.Time
 }
 
-func BucketBandwidthRollupArchive_Settled(v uint64) BucketBandwidthRollupArchive_Settled_Field {
-	return BucketBandwidthRollupArchive_Settled_Field{_set: true, _value: v}
+func BillingTransaction_CreatedAt(v time.Time) BillingTransaction_CreatedAt_Field {
+	return BillingTransaction_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f BucketBandwidthRollupArchive_Settled_Field) value() interface{} {
+func (f BillingTransaction_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketBandwidthRollupArchive_Settled_Field) _Column() string { return "settled" }
+func (BillingTransaction_CreatedAt_Field) _Column() string { return "created_at" }
 
-type BucketStorageTally struct {
-	BucketName          []byte
-	ProjectId           []byte
-	IntervalStart       time.Time
-	TotalBytes          uint64
-	Inline              uint64
-	Remote              uint64
-	TotalSegmentsCount  uint
-	RemoteSegmentsCount uint
-	InlineSegmentsCount uint
-	ObjectCount         uint
-	MetadataSize        uint64
+type BucketBandwidthRollup struct {
+	BucketName      []byte
+	ProjectId       []byte
+	IntervalStart   time.Time
+	IntervalSeconds uint
+	Action          uint
+	Inline          uint64
+	Allocated       uint64
+	Settled         uint64
 }
 
-func (BucketStorageTally) _Table() string { return "bucket_storage_tallies" }
-
-type BucketStorageTally_Create_Fields struct {
-	TotalBytes         BucketStorageTally_TotalBytes_Field
-	TotalSegmentsCount BucketStorageTally_TotalSegmentsCount_Field
-}
+func (BucketBandwidthRollup) _Table() string { return "bucket_bandwidth_rollups" }
 
-type BucketStorageTally_Update_Fields struct {
+type BucketBandwidthRollup_Update_Fields struct {
+	Inline    BucketBandwidthRollup_Inline_Field
+	Allocated BucketBandwidthRollup_Allocated_Field
+	Settled   BucketBandwidthRollup_Settled_Field
 }
 
-type BucketStorageTally_BucketName_Field struct {
+type BucketBandwidthRollup_BucketName_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func BucketStorageTally_BucketName(v []byte) BucketStorageTally_BucketName_Field {
-	return BucketStorageTally_BucketName_Field{_set: true, _value: v}
+func BucketBandwidthRollup_BucketName(v []byte) BucketBandwidthRollup_BucketName_Field {
+	return BucketBandwidthRollup_BucketName_Field{_set: true, _value: v}
 }
 
-func (f BucketStorageTally_BucketName_Field) value() interface{} {
+func (f BucketBandwidthRollup_BucketName_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketStorageTally_BucketName_Field) _Column() string { return "bucket_name" }
+func (BucketBandwidthRollup_BucketName_Field) _Column() string { return "bucket_name" }
 
-type BucketStorageTally_ProjectId_Field struct {
+type BucketBandwidthRollup_ProjectId_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func BucketStorageTally_ProjectId(v []byte) BucketStorageTally_ProjectId_Field {
-	return BucketStorageTally_ProjectId_Field{_set: true, _value: v}
+func BucketBandwidthRollup_ProjectId(v []byte) BucketBandwidthRollup_ProjectId_Field {
+	return BucketBandwidthRollup_ProjectId_Field{_set: true, _value: v}
 }
 
-func (f BucketStorageTally_ProjectId_Field) value() interface{} {
+func (f BucketBandwidthRollup_ProjectId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketStorageTally_ProjectId_Field) _Column() string { return "project_id" }
+func (BucketBandwidthRollup_ProjectId_Field) _Column() string { return "project_id" }
 
-type BucketStorageTally_IntervalStart_Field struct {
+type BucketBandwidthRollup_IntervalStart_Field struct {
 	_set   bool
 	_null  bool
 	_value time.Time
 }
 
-func BucketStorageTally_IntervalStart(v time.Time) BucketStorageTally_IntervalStart_Field {
-	return BucketStorageTally_IntervalStart_Field{_set: true, _value: v}
+func BucketBandwidthRollup_IntervalStart(v time.Time) BucketBandwidthRollup_IntervalStart_Field {
+	return BucketBandwidthRollup_IntervalStart_Field{_set: true, _value: v}
 }
 
-func (f BucketStorageTally_IntervalStart_Field) value() interface{} {
+func (f BucketBandwidthRollup_IntervalStart_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketStorageTally_IntervalStart_Field) _Column() string { return "interval_start" }
+func (BucketBandwidthRollup_IntervalStart_Field) _Column() string { return "interval_start" }
 
-type BucketStorageTally_TotalBytes_Field struct {
+type BucketBandwidthRollup_IntervalSeconds_Field struct {
 	_set   bool
 	_null  bool
-	_value uint64
+	_value uint
 }
 
-func BucketStorageTally_TotalBytes(v uint64) BucketStorageTally_TotalBytes_Field {
-	return BucketStorageTally_TotalBytes_Field{_set: true, _value: v}
+func BucketBandwidthRollup_IntervalSeconds(v uint) BucketBandwidthRollup_IntervalSeconds_Field {
+	return BucketBandwidthRollup_IntervalSeconds_Field{_set: true, _value: v}
 }
 
-func (f BucketStorageTally_TotalBytes_Field) value() interface{} {
+func (f BucketBandwidthRollup_IntervalSeconds_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketStorageTally_TotalBytes_Field) _Column() string { return "total_bytes" }
+func (BucketBandwidthRollup_IntervalSeconds_Field) _Column() string { return "interval_seconds" }
 
-type BucketStorageTally_Inline_Field struct {
+type BucketBandwidthRollup_Action_Field struct {
 	_set   bool
 	_null  bool
-	_value uint64
+	_value uint
 }
 
-func BucketStorageTally_Inline(v uint64) BucketStorageTally_Inline_Field {
-	return BucketStorageTally_Inline_Field{_set: true, _value: v}
+func BucketBandwidthRollup_Action(v uint) BucketBandwidthRollup_Action_Field {
+	return BucketBandwidthRollup_Action_Field{_set: true, _value: v}
 }
 
-func (f BucketStorageTally_Inline_Field) value() interface{} {
+func (f BucketBandwidthRollup_Action_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketStorageTally_Inline_Field) _Column() string { return "inline" }
+func (BucketBandwidthRollup_Action_Field) _Column() string { return "action" }
 
-type BucketStorageTally_Remote_Field struct {
+type BucketBandwidthRollup_Inline_Field struct {
 	_set   bool
 	_null  bool
 	_value uint64
 }
 
-func BucketStorageTally_Remote(v uint64) BucketStorageTally_Remote_Field {
-	return BucketStorageTally_Remote_Field{_set: true, _value: v}
+func BucketBandwidthRollup_Inline(v uint64) BucketBandwidthRollup_Inline_Field {
+	return BucketBandwidthRollup_Inline_Field{_set: true, _value: v}
 }
 
-func (f BucketStorageTally_Remote_Field) value() interface{} {
+func (f BucketBandwidthRollup_Inline_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketStorageTally_Remote_Field) _Column() string { return "remote" }
+func (BucketBandwidthRollup_Inline_Field) _Column() string { return "inline" }
 
-type BucketStorageTally_TotalSegmentsCount_Field struct {
+type BucketBandwidthRollup_Allocated_Field struct {
 	_set   bool
 	_null  bool
-	_value uint
+	_value uint64
 }
 
-func BucketStorageTally_TotalSegmentsCount(v uint) BucketStorageTally_TotalSegmentsCount_Field {
-	return BucketStorageTally_TotalSegmentsCount_Field{_set: true, _value: v}
+func BucketBandwidthRollup_Allocated(v uint64) BucketBandwidthRollup_Allocated_Field {
+	return BucketBandwidthRollup_Allocated_Field{_set: true, _value: v}
 }
 
-func (f BucketStorageTally_TotalSegmentsCount_Field) value() interface{} {
+func (f BucketBandwidthRollup_Allocated_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketStorageTally_TotalSegmentsCount_Field) _Column() string { return "total_segments_count" }
+func (BucketBandwidthRollup_Allocated_Field) _Column() string { return "allocated" }
 
-type BucketStorageTally_RemoteSegmentsCount_Field struct {
+type BucketBandwidthRollup_Settled_Field struct {
 	_set   bool
 	_null  bool
-	_value uint
+	_value uint64
 }
 
-func BucketStorageTally_RemoteSegmentsCount(v uint) BucketStorageTally_RemoteSegmentsCount_Field {
-	return BucketStorageTally_RemoteSegmentsCount_Field{_set: true, _value: v}
+func BucketBandwidthRollup_Settled(v uint64) BucketBandwidthRollup_Settled_Field {
+	return BucketBandwidthRollup_Settled_Field{_set: true, _value: v}
 }
 
-func (f BucketStorageTally_RemoteSegmentsCount_Field) value() interface{} {
+func (f BucketBandwidthRollup_Settled_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketStorageTally_RemoteSegmentsCount_Field) _Column() string { return "remote_segments_count" }
+func (BucketBandwidthRollup_Settled_Field) _Column() string { return "settled" }
 
-type BucketStorageTally_InlineSegmentsCount_Field struct {
-	_set   bool
-	_null  bool
-	_value uint
+type BucketBandwidthRollupArchive struct {
+	BucketName      []byte
+	ProjectId       []byte
+	IntervalStart   time.Time
+	IntervalSeconds uint
+	Action          uint
+	Inline          uint64
+	Allocated       uint64
+	Settled         uint64
 }
 
-func BucketStorageTally_InlineSegmentsCount(v uint) BucketStorageTally_InlineSegmentsCount_Field {
-	return BucketStorageTally_InlineSegmentsCount_Field{_set: true, _value: v}
-}
+func (BucketBandwidthRollupArchive) _Table() string { return "bucket_bandwidth_rollup_archives" }
 
-func (f BucketStorageTally_InlineSegmentsCount_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
-	}
-	return f._value
+type BucketBandwidthRollupArchive_Update_Fields struct {
+	Inline    BucketBandwidthRollupArchive_Inline_Field
+	Allocated BucketBandwidthRollupArchive_Allocated_Field
+	Settled   BucketBandwidthRollupArchive_Settled_Field
 }
 
-func (BucketStorageTally_InlineSegmentsCount_Field) _Column() string { return "inline_segments_count" }
-
-type BucketStorageTally_ObjectCount_Field struct {
+type BucketBandwidthRollupArchive_BucketName_Field struct {
 	_set   bool
 	_null  bool
-	_value uint
+	_value []byte
 }
 
-func BucketStorageTally_ObjectCount(v uint) BucketStorageTally_ObjectCount_Field {
-	return BucketStorageTally_ObjectCount_Field{_set: true, _value: v}
+func BucketBandwidthRollupArchive_BucketName(v []byte) BucketBandwidthRollupArchive_BucketName_Field {
+	return BucketBandwidthRollupArchive_BucketName_Field{_set: true, _value: v}
 }
 
-func (f BucketStorageTally_ObjectCount_Field) value() interface{} {
+func (f BucketBandwidthRollupArchive_BucketName_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketStorageTally_ObjectCount_Field) _Column() string { return "object_count" }
+func (BucketBandwidthRollupArchive_BucketName_Field) _Column() string { return "bucket_name" }
 
-type BucketStorageTally_MetadataSize_Field struct {
+type BucketBandwidthRollupArchive_ProjectId_Field struct {
 	_set   bool
 	_null  bool
-	_value uint64
+	_value []byte
 }
 
-func BucketStorageTally_MetadataSize(v uint64) BucketStorageTally_MetadataSize_Field {
-	return BucketStorageTally_MetadataSize_Field{_set: true, _value: v}
+func BucketBandwidthRollupArchive_ProjectId(v []byte) BucketBandwidthRollupArchive_ProjectId_Field {
+	return BucketBandwidthRollupArchive_ProjectId_Field{_set: true, _value: v}
 }
 
-func (f BucketStorageTally_MetadataSize_Field) value() interface{} {
+func (f BucketBandwidthRollupArchive_ProjectId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (BucketStorageTally_MetadataSize_Field) _Column() string { return "metadata_size" }
-
-type CoinpaymentsTransaction struct {
-	Id              string
-	UserId          []byte
-	Address         string
-	AmountNumeric   int64
-	ReceivedNumeric int64
-	Status          int
-	Key             string
-	Timeout         int
-	CreatedAt       time.Time
-}
-
-func (CoinpaymentsTransaction) _Table() string { return "coinpayments_transactions" }
-
-type CoinpaymentsTransaction_Update_Fields struct {
-	ReceivedNumeric CoinpaymentsTransaction_ReceivedNumeric_Field
-	Status          CoinpaymentsTransaction_Status_Field
-}
+func (BucketBandwidthRollupArchive_ProjectId_Field) _Column() string { return "project_id" }
 
-type CoinpaymentsTransaction_Id_Field struct {
+type BucketBandwidthRollupArchive_IntervalStart_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value time.Time
 }
 
-func CoinpaymentsTransaction_Id(v string) CoinpaymentsTransaction_Id_Field {
-	return CoinpaymentsTransaction_Id_Field{_set: true, _value: v}
+func BucketBandwidthRollupArchive_IntervalStart(v time.Time) BucketBandwidthRollupArchive_IntervalStart_Field {
+	return BucketBandwidthRollupArchive_IntervalStart_Field{_set: true, _value: v}
 }
 
-func (f CoinpaymentsTransaction_Id_Field) value() interface{} {
+func (f BucketBandwidthRollupArchive_IntervalStart_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (CoinpaymentsTransaction_Id_Field) _Column() string { return "id" }
+func (BucketBandwidthRollupArchive_IntervalStart_Field) _Column() string { return "interval_start" }
 
-type CoinpaymentsTransaction_UserId_Field struct {
+type BucketBandwidthRollupArchive_IntervalSeconds_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value uint
 }
 
-func CoinpaymentsTransaction_UserId(v []byte) CoinpaymentsTransaction_UserId_Field {
-	return CoinpaymentsTransaction_UserId_Field{_set: true, _value: v}
+func BucketBandwidthRollupArchive_IntervalSeconds(v uint) BucketBandwidthRollupArchive_IntervalSeconds_Field {
+	return BucketBandwidthRollupArchive_IntervalSeconds_Field{_set: true, _value: v}
 }
 
-func (f CoinpaymentsTransaction_UserId_Field) value() interface{} {
+func (f BucketBandwidthRollupArchive_IntervalSeconds_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (CoinpaymentsTransaction_UserId_Field) _Column() string { return "user_id" }
+func (BucketBandwidthRollupArchive_IntervalSeconds_Field) _Column() string { return "interval_seconds" }
 
-type CoinpaymentsTransaction_Address_Field struct {
+type BucketBandwidthRollupArchive_Action_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value uint
 }
 
-func CoinpaymentsTransaction_Address(v string) CoinpaymentsTransaction_Address_Field {
-	return CoinpaymentsTransaction_Address_Field{_set: true, _value: v}
+func BucketBandwidthRollupArchive_Action(v uint) BucketBandwidthRollupArchive_Action_Field {
+	return BucketBandwidthRollupArchive_Action_Field{_set: true, _value: v}
 }
 
-func (f CoinpaymentsTransaction_Address_Field) value() interface{} {
+func (f BucketBandwidthRollupArchive_Action_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (CoinpaymentsTransaction_Address_Field) _Column() string { return "address" }
+func (BucketBandwidthRollupArchive_Action_Field) _Column() string { return "action" }
 
-type CoinpaymentsTransaction_AmountNumeric_Field struct {
+type BucketBandwidthRollupArchive_Inline_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value uint64
 }
 
-func CoinpaymentsTransaction_AmountNumeric(v int64) CoinpaymentsTransaction_AmountNumeric_Field {
-	return CoinpaymentsTransaction_AmountNumeric_Field{_set: true, _value: v}
+func BucketBandwidthRollupArchive_Inline(v uint64) BucketBandwidthRollupArchive_Inline_Field {
+	return BucketBandwidthRollupArchive_Inline_Field{_set: true, _value: v}
 }
 
-func (f CoinpaymentsTransaction_AmountNumeric_Field) value() interface{} {
+func (f BucketBandwidthRollupArchive_Inline_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (CoinpaymentsTransaction_AmountNumeric_Field) _Column() string { return "amount_numeric" }
+func (BucketBandwidthRollupArchive_Inline_Field) _Column() string { return "inline" }
 
-type CoinpaymentsTransaction_ReceivedNumeric_Field struct {
+type BucketBandwidthRollupArchive_Allocated_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value uint64
 }
 
-func CoinpaymentsTransaction_ReceivedNumeric(v int64) CoinpaymentsTransaction_ReceivedNumeric_Field {
-	return CoinpaymentsTransaction_ReceivedNumeric_Field{_set: true, _value: v}
+func BucketBandwidthRollupArchive_Allocated(v uint64) BucketBandwidthRollupArchive_Allocated_Field {
+	return BucketBandwidthRollupArchive_Allocated_Field{_set: true, _value: v}
 }
 
-func (f CoinpaymentsTransaction_ReceivedNumeric_Field) value() interface{} {
+func (f BucketBandwidthRollupArchive_Allocated_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (CoinpaymentsTransaction_ReceivedNumeric_Field) _Column() string { return "received_numeric" }
+func (BucketBandwidthRollupArchive_Allocated_Field) _Column() string { return "allocated" }
 
-type CoinpaymentsTransaction_Status_Field struct {
+type BucketBandwidthRollupArchive_Settled_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value uint64
 }
 
-func CoinpaymentsTransaction_Status(v int) CoinpaymentsTransaction_Status_Field {
-	return CoinpaymentsTransaction_Status_Field{_set: true, _value: v}
+func BucketBandwidthRollupArchive_Settled(v uint64) BucketBandwidthRollupArchive_Settled_Field {
+	return BucketBandwidthRollupArchive_Settled_Field{_set: true, _value: v}
 }
 
-func (f CoinpaymentsTransaction_Status_Field) value() interface{} {
+func (f BucketBandwidthRollupArchive_Settled_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (CoinpaymentsTransaction_Status_Field) _Column() string { return "status" }
+func (BucketBandwidthRollupArchive_Settled_Field) _Column() string { return "settled" }
 
-type CoinpaymentsTransaction_Key_Field struct {
+type BucketNotification struct {
+	ProjectId     []byte
+	BucketName    []byte
+	ObjectCreated bool
+	ObjectDeleted bool
+	Prefix        []byte
+	SinkType      string
+	SinkUrl       string
+	SinkTopic     string
+	SinkAuthToken string
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+func (BucketNotification) _Table() string { return "bucket_notifications" }
+
+type BucketNotification_Create_Fields struct {
+	ProjectId     BucketNotification_ProjectId_Field
+	BucketName    BucketNotification_BucketName_Field
+	ObjectCreated BucketNotification_ObjectCreated_Field
+	ObjectDeleted BucketNotification_ObjectDeleted_Field
+	Prefix        BucketNotification_Prefix_Field
+	SinkType      BucketNotification_SinkType_Field
+	SinkUrl       BucketNotification_SinkUrl_Field
+	SinkTopic     BucketNotification_SinkTopic_Field
+	SinkAuthToken BucketNotification_SinkAuthToken_Field
+}
+
+type BucketNotification_Update_Fields struct {
+	ObjectCreated BucketNotification_ObjectCreated_Field
+	ObjectDeleted BucketNotification_ObjectDeleted_Field
+	Prefix        BucketNotification_Prefix_Field
+	SinkType      BucketNotification_SinkType_Field
+	SinkUrl       BucketNotification_SinkUrl_Field
+	SinkTopic     BucketNotification_SinkTopic_Field
+	SinkAuthToken BucketNotification_SinkAuthToken_Field
+	UpdatedAt     BucketNotification_UpdatedAt_Field
+}
+
+type BucketNotification_ProjectId_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value []byte
 }
 
-func CoinpaymentsTransaction_Key(v string) CoinpaymentsTransaction_Key_Field {
-	return CoinpaymentsTransaction_Key_Field{_set: true, _value: v}
+func BucketNotification_ProjectId(v []byte) BucketNotification_ProjectId_Field {
+	return BucketNotification_ProjectId_Field{_set: true, _value: v}
 }
 
-func (f CoinpaymentsTransaction_Key_Field) value() interface{} {
+func (f BucketNotification_ProjectId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (CoinpaymentsTransaction_Key_Field) _Column() string { return "key" }
+func (BucketNotification_ProjectId_Field) _Column() string { return "project_id" }
 
-type CoinpaymentsTransaction_Timeout_Field struct {
+type BucketNotification_BucketName_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value []byte
 }
 
-func CoinpaymentsTransaction_Timeout(v int) CoinpaymentsTransaction_Timeout_Field {
-	return CoinpaymentsTransaction_Timeout_Field{_set: true, _value: v}
+func BucketNotification_BucketName(v []byte) BucketNotification_BucketName_Field {
+	return BucketNotification_BucketName_Field{_set: true, _value: v}
 }
 
-func (f CoinpaymentsTransaction_Timeout_Field) value() interface{} {
+func (f BucketNotification_BucketName_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (CoinpaymentsTransaction_Timeout_Field) _Column() string { return "timeout" }
+func (BucketNotification_BucketName_Field) _Column() string { return "bucket_name" }
 
-type CoinpaymentsTransaction_CreatedAt_Field struct {
+type BucketNotification_ObjectCreated_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value bool
 }
 
-func CoinpaymentsTransaction_CreatedAt(v time.Time) CoinpaymentsTransaction_CreatedAt_Field {
-	return CoinpaymentsTransaction_CreatedAt_Field{_set: true, _value: v}
+func BucketNotification_ObjectCreated(v bool) BucketNotification_ObjectCreated_Field {
+	return BucketNotification_ObjectCreated_Field{_set: true, _value: v}
 }
 
-func (f CoinpaymentsTransaction_CreatedAt_Field) value() interface{} {
+func (f BucketNotification_ObjectCreated_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (CoinpaymentsTransaction_CreatedAt_Field) _Column() string { return "created_at" }
+func (BucketNotification_ObjectCreated_Field) _Column() string { return "object_created" }
 
-type Coupon struct {
-	Id             []byte
-	UserId         []byte
-	Amount         int64
-	Description    string
-	Type           int
-	Status         int
-	Duration       int64
-	BillingPeriods *int64
-	CouponCodeName *string
-	CreatedAt      time.Time
+type BucketNotification_ObjectDeleted_Field struct {
+	_set   bool
+	_null  bool
+	_value bool
 }
 
-func (Coupon) _Table() string { return "coupons" }
-
-type Coupon_Create_Fields struct {
-	BillingPeriods Coupon_BillingPeriods_Field
-	CouponCodeName Coupon_CouponCodeName_Field
+func BucketNotification_ObjectDeleted(v bool) BucketNotification_ObjectDeleted_Field {
+	return BucketNotification_ObjectDeleted_Field{_set: true, _value: v}
 }
 
-type Coupon_Update_Fields struct {
-	Status Coupon_Status_Field
+func (f BucketNotification_ObjectDeleted_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
 }
 
-type Coupon_Id_Field struct {
+func (BucketNotification_ObjectDeleted_Field) _Column() string { return "object_deleted" }
+
+type BucketNotification_Prefix_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func Coupon_Id(v []byte) Coupon_Id_Field {
-	return Coupon_Id_Field{_set: true, _value: v}
+func BucketNotification_Prefix(v []byte) BucketNotification_Prefix_Field {
+	return BucketNotification_Prefix_Field{_set: true, _value: v}
 }
 
-func (f Coupon_Id_Field) value() interface{} {
+func (f BucketNotification_Prefix_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Coupon_Id_Field) _Column() string { return "id" }
+func (BucketNotification_Prefix_Field) _Column() string { return "prefix" }
 
-type Coupon_UserId_Field struct {
+type BucketNotification_SinkType_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value string
 }
 
-func Coupon_UserId(v []byte) Coupon_UserId_Field {
-	return Coupon_UserId_Field{_set: true, _value: v}
+func BucketNotification_SinkType(v string) BucketNotification_SinkType_Field {
+	return BucketNotification_SinkType_Field{_set: true, _value: v}
 }
 
-func (f Coupon_UserId_Field) value() interface{} {
+func (f BucketNotification_SinkType_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Coupon_UserId_Field) _Column() string { return "user_id" }
+func (BucketNotification_SinkType_Field) _Column() string { return "sink_type" }
 
-type Coupon_Amount_Field struct {
+type BucketNotification_SinkUrl_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value string
 }
 
-func Coupon_Amount(v int64) Coupon_Amount_Field {
-	return Coupon_Amount_Field{_set: true, _value: v}
+func BucketNotification_SinkUrl(v string) BucketNotification_SinkUrl_Field {
+	return BucketNotification_SinkUrl_Field{_set: true, _value: v}
 }
 
-func (f Coupon_Amount_Field) value() interface{} {
+func (f BucketNotification_SinkUrl_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Coupon_Amount_Field) _Column() string { return "amount" }
+func (BucketNotification_SinkUrl_Field) _Column() string { return "sink_url" }
 
-type Coupon_Description_Field struct {
+type BucketNotification_SinkTopic_Field struct {
 	_set   bool
 	_null  bool
 	_value string
 }
 
-func Coupon_Description(v string) Coupon_Description_Field {
-	return Coupon_Description_Field{_set: true, _value: v}
+func BucketNotification_SinkTopic(v string) BucketNotification_SinkTopic_Field {
+	return BucketNotification_SinkTopic_Field{_set: true, _value: v}
 }
 
-func (f Coupon_Description_Field) value() interface{} {
+func (f BucketNotification_SinkTopic_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Coupon_Description_Field) _Column() string { return "description" }
+func (BucketNotification_SinkTopic_Field) _Column() string { return "sink_topic" }
 
-type Coupon_Type_Field struct {
+type BucketNotification_SinkAuthToken_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value string
 }
 
-func Coupon_Type(v int) Coupon_Type_Field {
-	return Coupon_Type_Field{_set: true, _value: v}
+func BucketNotification_SinkAuthToken(v string) BucketNotification_SinkAuthToken_Field {
+	return BucketNotification_SinkAuthToken_Field{_set: true, _value: v}
 }
 
-func (f Coupon_Type_Field) value() interface{} {
+func (f BucketNotification_SinkAuthToken_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Coupon_Type_Field) _Column() string { return "type" }
+func (BucketNotification_SinkAuthToken_Field) _Column() string { return "sink_auth_token" }
 
-type Coupon_Status_Field struct {
+type BucketNotification_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value time.Time
 }
 
-func Coupon_Status(v int) Coupon_Status_Field {
-	return Coupon_Status_Field{_set: true, _value: v}
+func BucketNotification_CreatedAt(v time.Time) BucketNotification_CreatedAt_Field {
+	return BucketNotification_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f Coupon_Status_Field) value() interface{} {
+func (f BucketNotification_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Coupon_Status_Field) _Column() string { return "status" }
+func (BucketNotification_CreatedAt_Field) _Column() string { return "created_at" }
 
-type Coupon_Duration_Field struct {
+type BucketNotification_UpdatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value time.Time
 }
 
-func Coupon_Duration(v int64) Coupon_Duration_Field {
-	return Coupon_Duration_Field{_set: true, _value: v}
+func BucketNotification_UpdatedAt(v time.Time) BucketNotification_UpdatedAt_Field {
+	return BucketNotification_UpdatedAt_Field{_set: true, _value: v}
 }
 
-func (f Coupon_Duration_Field) value() interface{} {
+func (f BucketNotification_UpdatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Coupon_Duration_Field) _Column() string { return "duration" }
+func (BucketNotification_UpdatedAt_Field) _Column() string { return "updated_at" }
 
-type Coupon_BillingPeriods_Field struct {
-	_set   bool
-	_null  bool
-	_value *int64
+type BucketPolicy struct {
+	ProjectId  []byte
+	BucketName []byte
+	Policy     string
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
 }
 
-func Coupon_BillingPeriods(v int64) Coupon_BillingPeriods_Field {
-	return Coupon_BillingPeriods_Field{_set: true, _value: &v}
+func (BucketPolicy) _Table() string { return "bucket_policies" }
+
+type BucketPolicy_Create_Fields struct {
+	ProjectId  BucketPolicy_ProjectId_Field
+	BucketName BucketPolicy_BucketName_Field
+	Policy     BucketPolicy_Policy_Field
 }
 
-func Coupon_BillingPeriods_Raw(v *int64) Coupon_BillingPeriods_Field {
-	if v == nil {
-		return Coupon_BillingPeriods_Null()
-	}
-	return Coupon_BillingPeriods(*v)
+type BucketPolicy_Update_Fields struct {
+	Policy    BucketPolicy_Policy_Field
+	UpdatedAt BucketPolicy_UpdatedAt_Field
 }
 
-func Coupon_BillingPeriods_Null() Coupon_BillingPeriods_Field {
-	return Coupon_BillingPeriods_Field{_set: true, _null: true}
+type BucketPolicy_ProjectId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
 }
 
-func (f Coupon_BillingPeriods_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func BucketPolicy_ProjectId(v []byte) BucketPolicy_ProjectId_Field {
+	return BucketPolicy_ProjectId_Field{_set: true, _value: v}
+}
 
-func (f Coupon_BillingPeriods_Field) value() interface{} {
+func (f BucketPolicy_ProjectId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Coupon_BillingPeriods_Field) _Column() string { return "billing_periods" }
+func (BucketPolicy_ProjectId_Field) _Column() string { return "project_id" }
 
-type Coupon_CouponCodeName_Field struct {
+type BucketPolicy_BucketName_Field struct {
 	_set   bool
 	_null  bool
-	_value *string
+	_value []byte
 }
 
-func Coupon_CouponCodeName(v string) Coupon_CouponCodeName_Field {
-	return Coupon_CouponCodeName_Field{_set: true, _value: &v}
+func BucketPolicy_BucketName(v []byte) BucketPolicy_BucketName_Field {
+	return BucketPolicy_BucketName_Field{_set: true, _value: v}
 }
 
-func Coupon_CouponCodeName_Raw(v *string) Coupon_CouponCodeName_Field {
-	if v == nil {
-		return Coupon_CouponCodeName_Null()
+func (f BucketPolicy_BucketName_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return Coupon_CouponCodeName(*v)
+	return f._value
 }
 
-func Coupon_CouponCodeName_Null() Coupon_CouponCodeName_Field {
-	return Coupon_CouponCodeName_Field{_set: true, _null: true}
+func (BucketPolicy_BucketName_Field) _Column() string { return "bucket_name" }
+
+type BucketPolicy_Policy_Field struct {
+	_set   bool
+	_null  bool
+	_value string
 }
 
-func (f Coupon_CouponCodeName_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func BucketPolicy_Policy(v string) BucketPolicy_Policy_Field {
+	return BucketPolicy_Policy_Field{_set: true, _value: v}
+}
 
-func (f Coupon_CouponCodeName_Field) value() interface{} {
+func (f BucketPolicy_Policy_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Coupon_CouponCodeName_Field) _Column() string { return "coupon_code_name" }
+func (BucketPolicy_Policy_Field) _Column() string { return "policy" }
 
-type Coupon_CreatedAt_Field struct {
+type BucketPolicy_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
 	_value time.Time
 }
 
-func Coupon_CreatedAt(v time.Time) Coupon_CreatedAt_Field {
-	return Coupon_CreatedAt_Field{_set: true, _value: v}
+func BucketPolicy_CreatedAt(v time.Time) BucketPolicy_CreatedAt_Field {
+	return BucketPolicy_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f Coupon_CreatedAt_Field) value() interface{} {
+func (f BucketPolicy_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Coupon_CreatedAt_Field) _Column() string { return "created_at" }
+func (BucketPolicy_CreatedAt_Field) _Column() string { return "created_at" }
 
-type CouponCode struct {
-	Id             []byte
-	Name           string
-	Amount         int64
-	Description    string
-	Type           int
-	BillingPeriods *int64
-	CreatedAt      time.Time
+type BucketPolicy_UpdatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
 }
 
-func (CouponCode) _Table() string { return "coupon_codes" }
+func BucketPolicy_UpdatedAt(v time.Time) BucketPolicy_UpdatedAt_Field {
+	return BucketPolicy_UpdatedAt_Field{_set: true, _value: v}
+}
 
-type CouponCode_Create_Fields struct {
-	BillingPeriods CouponCode_BillingPeriods_Field
+func (f BucketPolicy_UpdatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
 }
 
-type CouponCode_Update_Fields struct {
+func (BucketPolicy_UpdatedAt_Field) _Column() string { return "updated_at" }
+
+type BucketReplication struct {
+	ProjectId         []byte
+	BucketName        []byte
+	SourceAccess      string
+	DestinationAccess string
+	DestinationBucket []byte
+	ReplicatedUntil   time.Time
+	ReplicatedKey     []byte
+	PassStartedAt     *time.Time
+	ReplicatedObjects int64
+	Backlog           int64
+	LastRunAt         *time.Time
+	LastError         *string
+	CreatedAt         time.Time
 }
 
-type CouponCode_Id_Field struct {
+func (BucketReplication) _Table() string { return "bucket_replications" }
+
+type BucketReplication_Create_Fields struct {
+	PassStartedAt BucketReplication_PassStartedAt_Field
+	LastRunAt     BucketReplication_LastRunAt_Field
+	LastError     BucketReplication_LastError_Field
+}
+
+type BucketReplication_Update_Fields struct {
+	ReplicatedUntil   BucketReplication_ReplicatedUntil_Field
+	ReplicatedKey     BucketReplication_ReplicatedKey_Field
+	PassStartedAt     BucketReplication_PassStartedAt_Field
+	ReplicatedObjects BucketReplication_ReplicatedObjects_Field
+	Backlog           BucketReplication_Backlog_Field
+	LastRunAt         BucketReplication_LastRunAt_Field
+	LastError         BucketReplication_LastError_Field
+}
+
+type BucketReplication_ProjectId_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func CouponCode_Id(v []byte) CouponCode_Id_Field {
-	return CouponCode_Id_Field{_set: true, _value: v}
+func BucketReplication_ProjectId(v []byte) BucketReplication_ProjectId_Field {
+	return BucketReplication_ProjectId_Field{_set: true, _value: v}
 }
 
-func (f CouponCode_Id_Field) value() interface{} {
+func (f BucketReplication_ProjectId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (CouponCode_Id_Field) _Column() string { return "id" }
+func (BucketReplication_ProjectId_Field) _Column() string { return "project_id" }
 
-type CouponCode_Name_Field struct {
+type BucketReplication_BucketName_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value []byte
 }
 
-func CouponCode_Name(v string) CouponCode_Name_Field {
-	return CouponCode_Name_Field{_set: true, _value: v}
+func BucketReplication_BucketName(v []byte) BucketReplication_BucketName_Field {
+	return BucketReplication_BucketName_Field{_set: true, _value: v}
 }
 
-func (f CouponCode_Name_Field) value() interface{} {
+func (f BucketReplication_BucketName_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (CouponCode_Name_Field) _Column() string { return "name" }
+func (BucketReplication_BucketName_Field) _Column() string { return "bucket_name" }
 
-type CouponCode_Amount_Field struct {
+type BucketReplication_SourceAccess_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value string
 }
 
-func CouponCode_Amount(v int64) CouponCode_Amount_Field {
-	return CouponCode_Amount_Field{_set: true, _value: v}
+func BucketReplication_SourceAccess(v string) BucketReplication_SourceAccess_Field {
+	return BucketReplication_SourceAccess_Field{_set: true, _value: v}
 }
 
-func (f CouponCode_Amount_Field) value() interface{} {
+func (f BucketReplication_SourceAccess_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (CouponCode_Amount_Field) _Column() string { return "amount" }
+func (BucketReplication_SourceAccess_Field) _Column() string { return "source_access" }
 
-type CouponCode_Description_Field struct {
+type BucketReplication_DestinationAccess_Field struct {
 	_set   bool
 	_null  bool
 	_value string
 }
 
-func CouponCode_Description(v string) CouponCode_Description_Field {
-	return CouponCode_Description_Field{_set: true, _value: v}
+func BucketReplication_DestinationAccess(v string) BucketReplication_DestinationAccess_Field {
+	return BucketReplication_DestinationAccess_Field{_set: true, _value: v}
 }
 
-func (f CouponCode_Description_Field) value() interface{} {
+func (f BucketReplication_DestinationAccess_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (CouponCode_Description_Field) _Column() string { return "description" }
+func (BucketReplication_DestinationAccess_Field) _Column() string { return "destination_access" }
 
-type CouponCode_Type_Field struct {
+type BucketReplication_DestinationBucket_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value []byte
 }
 
-func CouponCode_Type(v int) CouponCode_Type_Field {
-	return CouponCode_Type_Field{_set: true, _value: v}
+func BucketReplication_DestinationBucket(v []byte) BucketReplication_DestinationBucket_Field {
+	return BucketReplication_DestinationBucket_Field{_set: true, _value: v}
 }
 
-func (f CouponCode_Type_Field) value() interface{} {
+func (f BucketReplication_DestinationBucket_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (CouponCode_Type_Field) _Column() string { return "type" }
+func (BucketReplication_DestinationBucket_Field) _Column() string { return "destination_bucket" }
 
-type CouponCode_BillingPeriods_Field struct {
+type BucketReplication_ReplicatedUntil_Field struct {
 	_set   bool
 	_null  bool
-	_value *int64
+	_value time.Time
 }
 
-func CouponCode_BillingPeriods(v int64) CouponCode_BillingPeriods_Field {
-	return CouponCode_BillingPeriods_Field{_set: true, _value: &v}
+func BucketReplication_ReplicatedUntil(v time.Time) BucketReplication_ReplicatedUntil_Field {
+	return BucketReplication_ReplicatedUntil_Field{_set: true, _value: v}
 }
 
-func CouponCode_BillingPeriods_Raw(v *int64) CouponCode_BillingPeriods_Field {
-	if v == nil {
-		return CouponCode_BillingPeriods_Null()
+func (f BucketReplication_ReplicatedUntil_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return CouponCode_BillingPeriods(*v)
+	return f._value
 }
 
-func CouponCode_BillingPeriods_Null() CouponCode_BillingPeriods_Field {
-	return CouponCode_BillingPeriods_Field{_set: true, _null: true}
+func (BucketReplication_ReplicatedUntil_Field) _Column() string { return "replicated_until" }
+
+type BucketReplication_ReplicatedKey_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
 }
 
-func (f CouponCode_BillingPeriods_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func BucketReplication_ReplicatedKey(v []byte) BucketReplication_ReplicatedKey_Field {
+	return BucketReplication_ReplicatedKey_Field{_set: true, _value: v}
+}
 
-func (f CouponCode_BillingPeriods_Field) value() interface{} {
+func (f BucketReplication_ReplicatedKey_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (CouponCode_BillingPeriods_Field) _Column() string { return "billing_periods" }
+func (BucketReplication_ReplicatedKey_Field) _Column() string { return "replicated_key" }
 
-type CouponCode_CreatedAt_Field struct {
+type BucketReplication_PassStartedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value *time.Time
 }
 
-func CouponCode_CreatedAt(v time.Time) CouponCode_CreatedAt_Field {
-	return CouponCode_CreatedAt_Field{_set: true, _value: v}
+func BucketReplication_PassStartedAt(v time.Time) BucketReplication_PassStartedAt_Field {
+	return BucketReplication_PassStartedAt_Field{_set: true, _value: &v}
 }
 
-func (f CouponCode_CreatedAt_Field) value() interface{} {
+func BucketReplication_PassStartedAt_Raw(v *time.Time) BucketReplication_PassStartedAt_Field {
+	if v == nil {
+		return BucketReplication_PassStartedAt_Null()
+	}
+	return BucketReplication_PassStartedAt(*v)
+}
+
+func BucketReplication_PassStartedAt_Null() BucketReplication_PassStartedAt_Field {
+	return BucketReplication_PassStartedAt_Field{_set: true, _null: true}
+}
+
+func (f BucketReplication_PassStartedAt_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f BucketReplication_PassStartedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (CouponCode_CreatedAt_Field) _Column() string { return "created_at" }
+func (BucketReplication_PassStartedAt_Field) _Column() string { return "pass_started_at" }
 
-type CouponUsage struct {
-	CouponId []byte
-	Amount   int64
-	Status   int
-	Period   time.Time
+type BucketReplication_ReplicatedObjects_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
 }
 
-func (CouponUsage) _Table() string { return "coupon_usages" }
+func BucketReplication_ReplicatedObjects(v int64) BucketReplication_ReplicatedObjects_Field {
+	return BucketReplication_ReplicatedObjects_Field{_set: true, _value: v}
+}
 
-type CouponUsage_Update_Fields struct {
-	Status CouponUsage_Status_Field
+func (f BucketReplication_ReplicatedObjects_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
 }
 
-type CouponUsage_CouponId_Field struct {
+func (BucketReplication_ReplicatedObjects_Field) _Column() string { return "replicated_objects" }
+
+type BucketReplication_Backlog_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value int64
 }
 
-func CouponUsage_CouponId(v []byte) CouponUsage_CouponId_Field {
-	return CouponUsage_CouponId_Field{_set: true, _value: v}
+func BucketReplication_Backlog(v int64) BucketReplication_Backlog_Field {
+	return BucketReplication_Backlog_Field{_set: true, _value: v}
 }
 
-func (f CouponUsage_CouponId_Field) value() interface{} {
+func (f BucketReplication_Backlog_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (CouponUsage_CouponId_Field) _Column() string { return "coupon_id" }
+func (BucketReplication_Backlog_Field) _Column() string { return "backlog" }
 
-type CouponUsage_Amount_Field struct {
+type BucketReplication_LastRunAt_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value *time.Time
 }
 
-func CouponUsage_Amount(v int64) CouponUsage_Amount_Field {
-	return CouponUsage_Amount_Field{_set: true, _value: v}
+func BucketReplication_LastRunAt(v time.Time) BucketReplication_LastRunAt_Field {
+	return BucketReplication_LastRunAt_Field{_set: true, _value: &v}
 }
 
-func (f CouponUsage_Amount_Field) value() interface{} {
+func BucketReplication_LastRunAt_Raw(v *time.Time) BucketReplication_LastRunAt_Field {
+	if v == nil {
+		return BucketReplication_LastRunAt_Null()
+	}
+	return BucketReplication_LastRunAt(*v)
+}
+
+func BucketReplication_LastRunAt_Null() BucketReplication_LastRunAt_Field {
+	return BucketReplication_LastRunAt_Field{_set: true, _null: true}
+}
+
+func (f BucketReplication_LastRunAt_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f BucketReplication_LastRunAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (CouponUsage_Amount_Field) _Column() string { return "amount" }
+func (BucketReplication_LastRunAt_Field) _Column() string { return "last_run_at" }
 
-type CouponUsage_Status_Field struct {
+type BucketReplication_LastError_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value *string
 }
 
-func CouponUsage_Status(v int) CouponUsage_Status_Field {
-	return CouponUsage_Status_Field{_set: true, _value: v}
+func BucketReplication_LastError(v string) BucketReplication_LastError_Field {
+	return BucketReplication_LastError_Field{_set: true, _value: &v}
 }
 
-func (f CouponUsage_Status_Field) value() interface{} {
+func BucketReplication_LastError_Raw(v *string) BucketReplication_LastError_Field {
+	if v == nil {
+		return BucketReplication_LastError_Null()
+	}
+	return BucketReplication_LastError(*v)
+}
+
+func BucketReplication_LastError_Null() BucketReplication_LastError_Field {
+	return BucketReplication_LastError_Field{_set: true, _null: true}
+}
+
+func (f BucketReplication_LastError_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f BucketReplication_LastError_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (CouponUsage_Status_Field) _Column() string { return "status" }
+func (BucketReplication_LastError_Field) _Column() string { return "last_error" }
 
-type CouponUsage_Period_Field struct {
+type BucketReplication_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
 	_value time.Time
 }
 
-func CouponUsage_Period(v time.Time) CouponUsage_Period_Field {
-	return CouponUsage_Period_Field{_set: true, _value: v}
+func BucketReplication_CreatedAt(v time.Time) BucketReplication_CreatedAt_Field {
+	return BucketReplication_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f CouponUsage_Period_Field) value() interface{} {
+func (f BucketReplication_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (CouponUsage_Period_Field) _Column() string { return "period" }
+func (BucketReplication_CreatedAt_Field) _Column() string { return "created_at" }
 
-type GracefulExitProgress struct {
-	NodeId            []byte
-	BytesTransferred  int64
-	PiecesTransferred int64
-	PiecesFailed      int64
-	UpdatedAt         time.Time
+type BucketStorageTally struct {
+	BucketName          []byte
+	ProjectId           []byte
+	IntervalStart       time.Time
+	TotalBytes          uint64
+	Inline              uint64
+	Remote              uint64
+	TotalSegmentsCount  uint
+	RemoteSegmentsCount uint
+	InlineSegmentsCount uint
+	ObjectCount         uint
+	MetadataSize        uint64
 }
 
-func (GracefulExitProgress) _Table() string { return "graceful_exit_progress" }
+func (BucketStorageTally) _Table() string { return "bucket_storage_tallies" }
 
-type GracefulExitProgress_Update_Fields struct {
-	BytesTransferred  GracefulExitProgress_BytesTransferred_Field
-	PiecesTransferred GracefulExitProgress_PiecesTransferred_Field
-	PiecesFailed      GracefulExitProgress_PiecesFailed_Field
+type BucketStorageTally_Create_Fields struct {
+	TotalBytes         BucketStorageTally_TotalBytes_Field
+	TotalSegmentsCount BucketStorageTally_TotalSegmentsCount_Field
 }
 
-type GracefulExitProgress_NodeId_Field struct {
+type BucketStorageTally_Update_Fields struct {
+}
+
+type BucketStorageTally_BucketName_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func GracefulExitProgress_NodeId(v []byte) GracefulExitProgress_NodeId_Field {
-	return GracefulExitProgress_NodeId_Field{_set: true, _value: v}
+func BucketStorageTally_BucketName(v []byte) BucketStorageTally_BucketName_Field {
+	return BucketStorageTally_BucketName_Field{_set: true, _value: v}
 }
 
-func (f GracefulExitProgress_NodeId_Field) value() interface{} {
+func (f BucketStorageTally_BucketName_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (GracefulExitProgress_NodeId_Field) _Column() string { return "node_id" }
+func (BucketStorageTally_BucketName_Field) _Column() string { return "bucket_name" }
 
-type GracefulExitProgress_BytesTransferred_Field struct {
+type BucketStorageTally_ProjectId_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value []byte
 }
 
-func GracefulExitProgress_BytesTransferred(v int64) GracefulExitProgress_BytesTransferred_Field {
-	return GracefulExitProgress_BytesTransferred_Field{_set: true, _value: v}
+func BucketStorageTally_ProjectId(v []byte) BucketStorageTally_ProjectId_Field {
+	return BucketStorageTally_ProjectId_Field{_set: true, _value: v}
 }
 
-func (f GracefulExitProgress_BytesTransferred_Field) value() interface{} {
+func (f BucketStorageTally_ProjectId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (GracefulExitProgress_BytesTransferred_Field) _Column() string { return "bytes_transferred" }
+func (BucketStorageTally_ProjectId_Field) _Column() string { return "project_id" }
 
-type GracefulExitProgress_PiecesTransferred_Field struct {
+type BucketStorageTally_IntervalStart_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value time.Time
 }
 
-func GracefulExitProgress_PiecesTransferred(v int64) GracefulExitProgress_PiecesTransferred_Field {
-	return GracefulExitProgress_PiecesTransferred_Field{_set: true, _value: v}
+func BucketStorageTally_IntervalStart(v time.Time) BucketStorageTally_IntervalStart_Field {
+	return BucketStorageTally_IntervalStart_Field{_set: true, _value: v}
 }
 
-func (f GracefulExitProgress_PiecesTransferred_Field) value() interface{} {
+func (f BucketStorageTally_IntervalStart_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (GracefulExitProgress_PiecesTransferred_Field) _Column() string { return "pieces_transferred" }
+func (BucketStorageTally_IntervalStart_Field) _Column() string { return "interval_start" }
 
-type GracefulExitProgress_PiecesFailed_Field struct {
+type BucketStorageTally_TotalBytes_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value uint64
 }
 
-func GracefulExitProgress_PiecesFailed(v int64) GracefulExitProgress_PiecesFailed_Field {
-	return GracefulExitProgress_PiecesFailed_Field{_set: true, _value: v}
+func BucketStorageTally_TotalBytes(v uint64) BucketStorageTally_TotalBytes_Field {
+	return BucketStorageTally_TotalBytes_Field{_set: true, _value: v}
 }
 
-func (f GracefulExitProgress_PiecesFailed_Field) value() interface{} {
+func (f BucketStorageTally_TotalBytes_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (GracefulExitProgress_PiecesFailed_Field) _Column() string { return "pieces_failed" }
+func (BucketStorageTally_TotalBytes_Field) _Column() string { return "total_bytes" }
 
-type GracefulExitProgress_UpdatedAt_Field struct {
+type BucketStorageTally_Inline_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value uint64
 }
 
-func GracefulExitProgress_UpdatedAt(v time.Time) GracefulExitProgress_UpdatedAt_Field {
-	return GracefulExitProgress_UpdatedAt_Field{_set: true, _value: v}
+func BucketStorageTally_Inline(v uint64) BucketStorageTally_Inline_Field {
+	return BucketStorageTally_Inline_Field{_set: true, _value: v}
 }
 
-func (f GracefulExitProgress_UpdatedAt_Field) value() interface{} {
+func (f BucketStorageTally_Inline_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (GracefulExitProgress_UpdatedAt_Field) _Column() string { return "updated_at" }
+func (BucketStorageTally_Inline_Field) _Column() string { return "inline" }
 
-type GracefulExitSegmentTransfer struct {
-	NodeId              []byte
-	StreamId            []byte
-	Position            uint64
-	PieceNum            int
-	RootPieceId         []byte
-	DurabilityRatio     float64
-	QueuedAt            time.Time
-	RequestedAt         *time.Time
-	LastFailedAt        *time.Time
-	LastFailedCode      *int
-	FailedCount         *int
-	FinishedAt          *time.Time
-	OrderLimitSendCount int
+type BucketStorageTally_Remote_Field struct {
+	_set   bool
+	_null  bool
+	_value uint64
 }
 
-func (GracefulExitSegmentTransfer) _Table() string { return "graceful_exit_segment_transfer_queue" }
-
-type GracefulExitSegmentTransfer_Create_Fields struct {
-	RootPieceId         GracefulExitSegmentTransfer_RootPieceId_Field
-	RequestedAt         GracefulExitSegmentTransfer_RequestedAt_Field
-	LastFailedAt        GracefulExitSegmentTransfer_LastFailedAt_Field
-	LastFailedCode      GracefulExitSegmentTransfer_LastFailedCode_Field
-	FailedCount         GracefulExitSegmentTransfer_FailedCount_Field
-	FinishedAt          GracefulExitSegmentTransfer_FinishedAt_Field
-	OrderLimitSendCount GracefulExitSegmentTransfer_OrderLimitSendCount_Field
+func BucketStorageTally_Remote(v uint64) BucketStorageTally_Remote_Field {
+	return BucketStorageTally_Remote_Field{_set: true, _value: v}
 }
 
-type GracefulExitSegmentTransfer_Update_Fields struct {
-	DurabilityRatio     GracefulExitSegmentTransfer_DurabilityRatio_Field
-	RequestedAt         GracefulExitSegmentTransfer_RequestedAt_Field
-	LastFailedAt        GracefulExitSegmentTransfer_LastFailedAt_Field
-	LastFailedCode      GracefulExitSegmentTransfer_LastFailedCode_Field
-	FailedCount         GracefulExitSegmentTransfer_FailedCount_Field
-	FinishedAt          GracefulExitSegmentTransfer_FinishedAt_Field
-	OrderLimitSendCount GracefulExitSegmentTransfer_OrderLimitSendCount_Field
+func (f BucketStorageTally_Remote_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
 }
 
-type GracefulExitSegmentTransfer_NodeId_Field struct {
+func (BucketStorageTally_Remote_Field) _Column() string { return "remote" }
+
+type BucketStorageTally_TotalSegmentsCount_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value uint
 }
 
-func GracefulExitSegmentTransfer_NodeId(v []byte) GracefulExitSegmentTransfer_NodeId_Field {
-	return GracefulExitSegmentTransfer_NodeId_Field{_set: true, _value: v}
+func BucketStorageTally_TotalSegmentsCount(v uint) BucketStorageTally_TotalSegmentsCount_Field {
+	return BucketStorageTally_TotalSegmentsCount_Field{_set: true, _value: v}
 }
 
-func (f GracefulExitSegmentTransfer_NodeId_Field) value() interface{} {
+func (f BucketStorageTally_TotalSegmentsCount_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (GracefulExitSegmentTransfer_NodeId_Field) _Column() string { return "node_id" }
+func (BucketStorageTally_TotalSegmentsCount_Field) _Column() string { return "total_segments_count" }
 
-type GracefulExitSegmentTransfer_StreamId_Field struct {
+type BucketStorageTally_RemoteSegmentsCount_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value uint
 }
 
-func GracefulExitSegmentTransfer_StreamId(v []byte) GracefulExitSegmentTransfer_StreamId_Field {
-	return GracefulExitSegmentTransfer_StreamId_Field{_set: true, _value: v}
+func BucketStorageTally_RemoteSegmentsCount(v uint) BucketStorageTally_RemoteSegmentsCount_Field {
+	return BucketStorageTally_RemoteSegmentsCount_Field{_set: true, _value: v}
 }
 
-func (f GracefulExitSegmentTransfer_StreamId_Field) value() interface{} {
+func (f BucketStorageTally_RemoteSegmentsCount_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (GracefulExitSegmentTransfer_StreamId_Field) _Column() string { return "stream_id" }
+func (BucketStorageTally_RemoteSegmentsCount_Field) _Column() string { return "remote_segments_count" }
 
-type GracefulExitSegmentTransfer_Position_Field struct {
+type BucketStorageTally_InlineSegmentsCount_Field struct {
 	_set   bool
 	_null  bool
-	_value uint64
+	_value uint
 }
 
-func GracefulExitSegmentTransfer_Position(v uint64) GracefulExitSegmentTransfer_Position_Field {
-	return GracefulExitSegmentTransfer_Position_Field{_set: true, _value: v}
+func BucketStorageTally_InlineSegmentsCount(v uint) BucketStorageTally_InlineSegmentsCount_Field {
+	return BucketStorageTally_InlineSegmentsCount_Field{_set: true, _value: v}
 }
 
-func (f GracefulExitSegmentTransfer_Position_Field) value() interface{} {
+func (f BucketStorageTally_InlineSegmentsCount_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (GracefulExitSegmentTransfer_Position_Field) _Column() string { return "position" }
+func (BucketStorageTally_InlineSegmentsCount_Field) _Column() string { return "inline_segments_count" }
 
-type GracefulExitSegmentTransfer_PieceNum_Field struct {
+type BucketStorageTally_ObjectCount_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value uint
 }
 
-func GracefulExitSegmentTransfer_PieceNum(v int) GracefulExitSegmentTransfer_PieceNum_Field {
-	return GracefulExitSegmentTransfer_PieceNum_Field{_set: true, _value: v}
+func BucketStorageTally_ObjectCount(v uint) BucketStorageTally_ObjectCount_Field {
+	return BucketStorageTally_ObjectCount_Field{_set: true, _value: v}
 }
 
-func (f GracefulExitSegmentTransfer_PieceNum_Field) value() interface{} {
+func (f BucketStorageTally_ObjectCount_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (GracefulExitSegmentTransfer_PieceNum_Field) _Column() string { return "piece_num" }
+func (BucketStorageTally_ObjectCount_Field) _Column() string { return "object_count" }
 
-type GracefulExitSegmentTransfer_RootPieceId_Field struct {
+type BucketStorageTally_MetadataSize_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value uint64
 }
 
-func GracefulExitSegmentTransfer_RootPieceId(v []byte) GracefulExitSegmentTransfer_RootPieceId_Field {
-	return GracefulExitSegmentTransfer_RootPieceId_Field{_set: true, _value: v}
+func BucketStorageTally_MetadataSize(v uint64) BucketStorageTally_MetadataSize_Field {
+	return BucketStorageTally_MetadataSize_Field{_set: true, _value: v}
 }
 
-func GracefulExitSegmentTransfer_RootPieceId_Raw(v []byte) GracefulExitSegmentTransfer_RootPieceId_Field {
-	if v == nil {
-		return GracefulExitSegmentTransfer_RootPieceId_Null()
+func (f BucketStorageTally_MetadataSize_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return GracefulExitSegmentTransfer_RootPieceId(v)
+	return f._value
 }
 
-func GracefulExitSegmentTransfer_RootPieceId_Null() GracefulExitSegmentTransfer_RootPieceId_Field {
-	return GracefulExitSegmentTransfer_RootPieceId_Field{_set: true, _null: true}
+func (BucketStorageTally_MetadataSize_Field) _Column() string { return "metadata_size" }
+
+type CoinpaymentsTransaction struct {
+	Id              string
+	UserId          []byte
+	Address         string
+	AmountNumeric   int64
+	ReceivedNumeric int64
+	Status          int
+	Key             string
+	Timeout         int
+	CreatedAt       time.Time
 }
 
-func (f GracefulExitSegmentTransfer_RootPieceId_Field) isnull() bool {
-	return !f._set || f._null || f._value == nil
+func (CoinpaymentsTransaction) _Table() string { return "coinpayments_transactions" }
+
+type CoinpaymentsTransaction_Update_Fields struct {
+	ReceivedNumeric CoinpaymentsTransaction_ReceivedNumeric_Field
+	Status          CoinpaymentsTransaction_Status_Field
 }
 
-func (f GracefulExitSegmentTransfer_RootPieceId_Field) value() interface{} {
+type CoinpaymentsTransaction_Id_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func CoinpaymentsTransaction_Id(v string) CoinpaymentsTransaction_Id_Field {
+	return CoinpaymentsTransaction_Id_Field{_set: true, _value: v}
+}
+
+func (f CoinpaymentsTransaction_Id_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (GracefulExitSegmentTransfer_RootPieceId_Field) _Column() string { return "root_piece_id" }
+func (CoinpaymentsTransaction_Id_Field) _Column() string { return "id" }
 
-type GracefulExitSegmentTransfer_DurabilityRatio_Field struct {
+type CoinpaymentsTransaction_UserId_Field struct {
 	_set   bool
 	_null  bool
-	_value float64
+	_value []byte
 }
 
-func GracefulExitSegmentTransfer_DurabilityRatio(v float64) GracefulExitSegmentTransfer_DurabilityRatio_Field {
-	return GracefulExitSegmentTransfer_DurabilityRatio_Field{_set: true, _value: v}
+func CoinpaymentsTransaction_UserId(v []byte) CoinpaymentsTransaction_UserId_Field {
+	return CoinpaymentsTransaction_UserId_Field{_set: true, _value: v}
 }
 
-func (f GracefulExitSegmentTransfer_DurabilityRatio_Field) value() interface{} {
+func (f CoinpaymentsTransaction_UserId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (GracefulExitSegmentTransfer_DurabilityRatio_Field) _Column() string { return "durability_ratio" }
+func (CoinpaymentsTransaction_UserId_Field) _Column() string { return "user_id" }
 
-type GracefulExitSegmentTransfer_QueuedAt_Field struct {
+type CoinpaymentsTransaction_Address_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value string
 }
 
-func GracefulExitSegmentTransfer_QueuedAt(v time.Time) GracefulExitSegmentTransfer_QueuedAt_Field {
-	return GracefulExitSegmentTransfer_QueuedAt_Field{_set: true, _value: v}
+func CoinpaymentsTransaction_Address(v string) CoinpaymentsTransaction_Address_Field {
+	return CoinpaymentsTransaction_Address_Field{_set: true, _value: v}
 }
 
-func (f GracefulExitSegmentTransfer_QueuedAt_Field) value() interface{} {
+func (f CoinpaymentsTransaction_Address_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (GracefulExitSegmentTransfer_QueuedAt_Field) _Column() string { return "queued_at" }
+func (CoinpaymentsTransaction_Address_Field) _Column() string { return "address" }
 
-type GracefulExitSegmentTransfer_RequestedAt_Field struct {
+type CoinpaymentsTransaction_AmountNumeric_Field struct {
 	_set   bool
 	_null  bool
-	_value *time.Time
+	_value int64
 }
 
-func GracefulExitSegmentTransfer_RequestedAt(v time.Time) GracefulExitSegmentTransfer_RequestedAt_Field {
-	return GracefulExitSegmentTransfer_RequestedAt_Field{_set: true, _value: &v}
+func CoinpaymentsTransaction_AmountNumeric(v int64) CoinpaymentsTransaction_AmountNumeric_Field {
+	return CoinpaymentsTransaction_AmountNumeric_Field{_set: true, _value: v}
 }
 
-func GracefulExitSegmentTransfer_RequestedAt_Raw(v *time.Time) GracefulExitSegmentTransfer_RequestedAt_Field {
-	if v == nil {
-		return GracefulExitSegmentTransfer_RequestedAt_Null()
+func (f CoinpaymentsTransaction_AmountNumeric_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return GracefulExitSegmentTransfer_RequestedAt(*v)
+	return f._value
 }
 
-func GracefulExitSegmentTransfer_RequestedAt_Null() GracefulExitSegmentTransfer_RequestedAt_Field {
-	return GracefulExitSegmentTransfer_RequestedAt_Field{_set: true, _null: true}
+func (CoinpaymentsTransaction_AmountNumeric_Field) _Column() string { return "amount_numeric" }
+
+type CoinpaymentsTransaction_ReceivedNumeric_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
 }
 
-func (f GracefulExitSegmentTransfer_RequestedAt_Field) isnull() bool {
-	return !f._set || f._null || f._value == nil
+func CoinpaymentsTransaction_ReceivedNumeric(v int64) CoinpaymentsTransaction_ReceivedNumeric_Field {
+	return CoinpaymentsTransaction_ReceivedNumeric_Field{_set: true, _value: v}
 }
 
-func (f GracefulExitSegmentTransfer_RequestedAt_Field) value() interface{} {
+func (f CoinpaymentsTransaction_ReceivedNumeric_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (GracefulExitSegmentTransfer_RequestedAt_Field) _Column() string { return "requested_at" }
+func (CoinpaymentsTransaction_ReceivedNumeric_Field) _Column() string { return "received_numeric" }
 
-type GracefulExitSegmentTransfer_LastFailedAt_Field struct {
+type CoinpaymentsTransaction_Status_Field struct {
 	_set   bool
 	_null  bool
-	_value *time.Time
+	_value int
 }
 
-func GracefulExitSegmentTransfer_LastFailedAt(v time.Time) GracefulExitSegmentTransfer_LastFailedAt_Field {
-	return GracefulExitSegmentTransfer_LastFailedAt_Field{_set: true, _value: &v}
+func CoinpaymentsTransaction_Status(v int) CoinpaymentsTransaction_Status_Field {
+	return CoinpaymentsTransaction_Status_Field{_set: true, _value: v}
 }
 
-func GracefulExitSegmentTransfer_LastFailedAt_Raw(v *time.Time) GracefulExitSegmentTransfer_LastFailedAt_Field {
-	if v == nil {
-		return GracefulExitSegmentTransfer_LastFailedAt_Null()
+func (f CoinpaymentsTransaction_Status_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return GracefulExitSegmentTransfer_LastFailedAt(*v)
+	return f._value
 }
 
-func GracefulExitSegmentTransfer_LastFailedAt_Null() GracefulExitSegmentTransfer_LastFailedAt_Field {
-	return GracefulExitSegmentTransfer_LastFailedAt_Field{_set: true, _null: true}
+func (CoinpaymentsTransaction_Status_Field) _Column() string { return "status" }
+
+type CoinpaymentsTransaction_Key_Field struct {
+	_set   bool
+	_null  bool
+	_value string
 }
 
-func (f GracefulExitSegmentTransfer_LastFailedAt_Field) isnull() bool {
-	return !f._set || f._null || f._value == nil
+func CoinpaymentsTransaction_Key(v string) CoinpaymentsTransaction_Key_Field {
+	return CoinpaymentsTransaction_Key_Field{_set: true, _value: v}
 }
 
-func (f GracefulExitSegmentTransfer_LastFailedAt_Field) value() interface{} {
+func (f CoinpaymentsTransaction_Key_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (GracefulExitSegmentTransfer_LastFailedAt_Field) _Column() string { return "last_failed_at" }
+func (CoinpaymentsTransaction_Key_Field) _Column() string { return "key" }
 
-type GracefulExitSegmentTransfer_LastFailedCode_Field struct {
+type CoinpaymentsTransaction_Timeout_Field struct {
 	_set   bool
 	_null  bool
-	_value *int
+	_value int
 }
 
-func GracefulExitSegmentTransfer_LastFailedCode(v int) GracefulExitSegmentTransfer_LastFailedCode_Field {
-	return GracefulExitSegmentTransfer_LastFailedCode_Field{_set: true, _value: &v}
+func CoinpaymentsTransaction_Timeout(v int) CoinpaymentsTransaction_Timeout_Field {
+	return CoinpaymentsTransaction_Timeout_Field{_set: true, _value: v}
 }
 
-func GracefulExitSegmentTransfer_LastFailedCode_Raw(v *int) GracefulExitSegmentTransfer_LastFailedCode_Field {
-	if v == nil {
-		return GracefulExitSegmentTransfer_LastFailedCode_Null()
+func (f CoinpaymentsTransaction_Timeout_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return GracefulExitSegmentTransfer_LastFailedCode(*v)
+	return f._value
 }
 
-func GracefulExitSegmentTransfer_LastFailedCode_Null() GracefulExitSegmentTransfer_LastFailedCode_Field {
+func (CoinpaymentsTransaction_Timeout_Field) _Column() string { return "timeout" }
+
+type CoinpaymentsTransaction_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func CoinpaymentsTransaction_CreatedAt(v time.Time) CoinpaymentsTransaction_CreatedAt_Field {
+	return CoinpaymentsTransaction_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f CoinpaymentsTransaction_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (CoinpaymentsTransaction_CreatedAt_Field) _Column() string { return "created_at" }
+
+type Coupon struct {
+	Id             []byte
+	UserId         []byte
+	Amount         int64
+	Description    string
+	Type           int
+	Status         int
+	Duration       int64
+	BillingPeriods *int64
+	CouponCodeName *string
+	CreatedAt      time.Time
+}
+
+func (Coupon) _Table() string { return "coupons" }
+
+type Coupon_Create_Fields struct {
+	BillingPeriods Coupon_BillingPeriods_Field
+	CouponCodeName Coupon_CouponCodeName_Field
+}
+
+type Coupon_Update_Fields struct {
+	Status Coupon_Status_Field
+}
+
+type Coupon_Id_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func Coupon_Id(v []byte) Coupon_Id_Field {
+	return Coupon_Id_Field{_set: true, _value: v}
+}
+
+func (f Coupon_Id_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Coupon_Id_Field) _Column() string { return "id" }
+
+type Coupon_UserId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func Coupon_UserId(v []byte) Coupon_UserId_Field {
+	return Coupon_UserId_Field{_set: true, _value: v}
+}
+
+func (f Coupon_UserId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Coupon_UserId_Field) _Column() string { return "user_id" }
+
+type Coupon_Amount_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func Coupon_Amount(v int64) Coupon_Amount_Field {
+	return Coupon_Amount_Field{_set: true, _value: v}
+}
+
+func (f Coupon_Amount_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Coupon_Amount_Field) _Column() string { return "amount" }
+
+type Coupon_Description_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func Coupon_Description(v string) Coupon_Description_Field {
+	return Coupon_Description_Field{_set: true, _value: v}
+}
+
+func (f Coupon_Description_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Coupon_Description_Field) _Column() string { return "description" }
+
+type Coupon_Type_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func Coupon_Type(v int) Coupon_Type_Field {
+	return Coupon_Type_Field{_set: true, _value: v}
+}
+
+func (f Coupon_Type_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Coupon_Type_Field) _Column() string { return "type" }
+
+type Coupon_Status_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func Coupon_Status(v int) Coupon_Status_Field {
+	return Coupon_Status_Field{_set: true, _value: v}
+}
+
+func (f Coupon_Status_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Coupon_Status_Field) _Column() string { return "status" }
+
+type Coupon_Duration_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func Coupon_Duration(v int64) Coupon_Duration_Field {
+	return Coupon_Duration_Field{_set: true, _value: v}
+}
+
+func (f Coupon_Duration_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Coupon_Duration_Field) _Column() string { return "duration" }
+
+type Coupon_BillingPeriods_Field struct {
+	_set   bool
+	_null  bool
+	_value *int64
+}
+
+func Coupon_BillingPeriods(v int64) Coupon_BillingPeriods_Field {
+	return Coupon_BillingPeriods_Field{_set: true, _value: &v}
+}
+
+func Coupon_BillingPeriods_Raw(v *int64) Coupon_BillingPeriods_Field {
+	if v == nil {
+		return Coupon_BillingPeriods_Null()
+	}
+	return Coupon_BillingPeriods(*v)
+}
+
+func Coupon_BillingPeriods_Null() Coupon_BillingPeriods_Field {
+	return Coupon_BillingPeriods_Field{_set: true, _null: true}
+}
+
+func (f Coupon_BillingPeriods_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Coupon_BillingPeriods_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Coupon_BillingPeriods_Field) _Column() string { return "billing_periods" }
+
+type Coupon_CouponCodeName_Field struct {
+	_set   bool
+	_null  bool
+	_value *string
+}
+
+func Coupon_CouponCodeName(v string) Coupon_CouponCodeName_Field {
+	return Coupon_CouponCodeName_Field{_set: true, _value: &v}
+}
+
+func Coupon_CouponCodeName_Raw(v *string) Coupon_CouponCodeName_Field {
+	if v == nil {
+		return Coupon_CouponCodeName_Null()
+	}
+	return Coupon_CouponCodeName(*v)
+}
+
+func Coupon_CouponCodeName_Null() Coupon_CouponCodeName_Field {
+	return Coupon_CouponCodeName_Field{_set: true, _null: true}
+}
+
+func (f Coupon_CouponCodeName_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Coupon_CouponCodeName_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Coupon_CouponCodeName_Field) _Column() string { return "coupon_code_name" }
+
+type Coupon_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func Coupon_CreatedAt(v time.Time) Coupon_CreatedAt_Field {
+	return Coupon_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f Coupon_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Coupon_CreatedAt_Field) _Column() string { return "created_at" }
+
+type CouponCode struct {
+	Id             []byte
+	Name           string
+	Amount         int64
+	Description    string
+	Type           int
+	BillingPeriods *int64
+	CreatedAt      time.Time
+}
+
+func (CouponCode) _Table() string { return "coupon_codes" }
+
+type CouponCode_Create_Fields struct {
+	BillingPeriods CouponCode_BillingPeriods_Field
+}
+
+type CouponCode_Update_Fields struct {
+}
+
+type CouponCode_Id_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func CouponCode_Id(v []byte) CouponCode_Id_Field {
+	return CouponCode_Id_Field{_set: true, _value: v}
+}
+
+func (f CouponCode_Id_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (CouponCode_Id_Field) _Column() string { return "id" }
+
+type CouponCode_Name_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func CouponCode_Name(v string) CouponCode_Name_Field {
+	return CouponCode_Name_Field{_set: true, _value: v}
+}
+
+func (f CouponCode_Name_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (CouponCode_Name_Field) _Column() string { return "name" }
+
+type CouponCode_Amount_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func CouponCode_Amount(v int64) CouponCode_Amount_Field {
+	return CouponCode_Amount_Field{_set: true, _value: v}
+}
+
+func (f CouponCode_Amount_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (CouponCode_Amount_Field) _Column() string { return "amount" }
+
+type CouponCode_Description_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func CouponCode_Description(v string) CouponCode_Description_Field {
+	return CouponCode_Description_Field{_set: true, _value: v}
+}
+
+func (f CouponCode_Description_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (CouponCode_Description_Field) _Column() string { return "description" }
+
+type CouponCode_Type_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func CouponCode_Type(v int) CouponCode_Type_Field {
+	return CouponCode_Type_Field{_set: true, _value: v}
+}
+
+func (f CouponCode_Type_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (CouponCode_Type_Field) _Column() string { return "type" }
+
+type CouponCode_BillingPeriods_Field struct {
+	_set   bool
+	_null  bool
+	_value *int64
+}
+
+func CouponCode_BillingPeriods(v int64) CouponCode_BillingPeriods_Field {
+	return CouponCode_BillingPeriods_Field{_set: true, _value: &v}
+}
+
+func CouponCode_BillingPeriods_Raw(v *int64) CouponCode_BillingPeriods_Field {
+	if v == nil {
+		return CouponCode_BillingPeriods_Null()
+	}
+	return CouponCode_BillingPeriods(*v)
+}
+
+func CouponCode_BillingPeriods_Null() CouponCode_BillingPeriods_Field {
+	return CouponCode_BillingPeriods_Field{_set: true, _null: true}
+}
+
+func (f CouponCode_BillingPeriods_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f CouponCode_BillingPeriods_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (CouponCode_BillingPeriods_Field) _Column() string { return "billing_periods" }
+
+type CouponCode_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func CouponCode_CreatedAt(v time.Time) CouponCode_CreatedAt_Field {
+	return CouponCode_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f CouponCode_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (CouponCode_CreatedAt_Field) _Column() string { return "created_at" }
+
+type CouponUsage struct {
+	CouponId []byte
+	Amount   int64
+	Status   int
+	Period   time.Time
+}
+
+func (CouponUsage) _Table() string { return "coupon_usages" }
+
+type CouponUsage_Update_Fields struct {
+	Status CouponUsage_Status_Field
+}
+
+type CouponUsage_CouponId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func CouponUsage_CouponId(v []byte) CouponUsage_CouponId_Field {
+	return CouponUsage_CouponId_Field{_set: true, _value: v}
+}
+
+func (f CouponUsage_CouponId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (CouponUsage_CouponId_Field) _Column() string { return "coupon_id" }
+
+type CouponUsage_Amount_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func CouponUsage_Amount(v int64) CouponUsage_Amount_Field {
+	return CouponUsage_Amount_Field{_set: true, _value: v}
+}
+
+func (f CouponUsage_Amount_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (CouponUsage_Amount_Field) _Column() string { return "amount" }
+
+type CouponUsage_Status_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func CouponUsage_Status(v int) CouponUsage_Status_Field {
+	return CouponUsage_Status_Field{_set: true, _value: v}
+}
+
+func (f CouponUsage_Status_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (CouponUsage_Status_Field) _Column() string { return "status" }
+
+type CouponUsage_Period_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func CouponUsage_Period(v time.Time) CouponUsage_Period_Field {
+	return CouponUsage_Period_Field{_set: true, _value: v}
+}
+
+func (f CouponUsage_Period_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (CouponUsage_Period_Field) _Column() string { return "period" }
+
+type DurabilityReport struct {
+	Id         []byte
+	ProjectId  []byte
+	BucketName []byte
+	CreatedAt  time.Time
+	Data       []byte
+	Signature  []byte
+}
+
+func (DurabilityReport) _Table() string { return "durability_reports" }
+
+type DurabilityReport_Create_Fields struct {
+}
+
+type DurabilityReport_Update_Fields struct {
+}
+
+type DurabilityReport_Id_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func DurabilityReport_Id(v []byte) DurabilityReport_Id_Field {
+	return DurabilityReport_Id_Field{_set: true, _value: v}
+}
+
+func (f DurabilityReport_Id_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (DurabilityReport_Id_Field) _Column() string { return "id" }
+
+type DurabilityReport_ProjectId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func DurabilityReport_ProjectId(v []byte) DurabilityReport_ProjectId_Field {
+	return DurabilityReport_ProjectId_Field{_set: true, _value: v}
+}
+
+func (f DurabilityReport_ProjectId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (DurabilityReport_ProjectId_Field) _Column() string { return "project_id" }
+
+type DurabilityReport_BucketName_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func DurabilityReport_BucketName(v []byte) DurabilityReport_BucketName_Field {
+	return DurabilityReport_BucketName_Field{_set: true, _value: v}
+}
+
+func (f DurabilityReport_BucketName_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (DurabilityReport_BucketName_Field) _Column() string { return "bucket_name" }
+
+type DurabilityReport_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func DurabilityReport_CreatedAt(v time.Time) DurabilityReport_CreatedAt_Field {
+	return DurabilityReport_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f DurabilityReport_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (DurabilityReport_CreatedAt_Field) _Column() string { return "created_at" }
+
+type DurabilityReport_Data_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func DurabilityReport_Data(v []byte) DurabilityReport_Data_Field {
+	return DurabilityReport_Data_Field{_set: true, _value: v}
+}
+
+func (f DurabilityReport_Data_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (DurabilityReport_Data_Field) _Column() string { return "data" }
+
+type DurabilityReport_Signature_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func DurabilityReport_Signature(v []byte) DurabilityReport_Signature_Field {
+	return DurabilityReport_Signature_Field{_set: true, _value: v}
+}
+
+func (f DurabilityReport_Signature_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (DurabilityReport_Signature_Field) _Column() string { return "signature" }
+
+type GracefulExitAbort struct {
+	NodeId          []byte
+	ExitInitiatedAt time.Time
+	Reason          *string
+	AbortedAt       time.Time
+}
+
+func (GracefulExitAbort) _Table() string { return "graceful_exit_aborts" }
+
+type GracefulExitAbort_Create_Fields struct {
+	Reason    GracefulExitAbort_Reason_Field
+	AbortedAt GracefulExitAbort_AbortedAt_Field
+}
+
+type GracefulExitAbort_Update_Fields struct {
+}
+
+type GracefulExitAbort_NodeId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func GracefulExitAbort_NodeId(v []byte) GracefulExitAbort_NodeId_Field {
+	return GracefulExitAbort_NodeId_Field{_set: true, _value: v}
+}
+
+func (f GracefulExitAbort_NodeId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitAbort_NodeId_Field) _Column() string { return "node_id" }
+
+type GracefulExitAbort_ExitInitiatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func GracefulExitAbort_ExitInitiatedAt(v time.Time) GracefulExitAbort_ExitInitiatedAt_Field {
+	return GracefulExitAbort_ExitInitiatedAt_Field{_set: true, _value: v}
+}
+
+func (f GracefulExitAbort_ExitInitiatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitAbort_ExitInitiatedAt_Field) _Column() string { return "exit_initiated_at" }
+
+type GracefulExitAbort_Reason_Field struct {
+	_set   bool
+	_null  bool
+	_value *string
+}
+
+func GracefulExitAbort_Reason(v string) GracefulExitAbort_Reason_Field {
+	return GracefulExitAbort_Reason_Field{_set: true, _value: &v}
+}
+
+func GracefulExitAbort_Reason_Raw(v *string) GracefulExitAbort_Reason_Field {
+	if v == nil {
+		return GracefulExitAbort_Reason_Null()
+	}
+	return GracefulExitAbort_Reason(*v)
+}
+
+func GracefulExitAbort_Reason_Null() GracefulExitAbort_Reason_Field {
+	return GracefulExitAbort_Reason_Field{_set: true, _null: true}
+}
+
+func (f GracefulExitAbort_Reason_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f GracefulExitAbort_Reason_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitAbort_Reason_Field) _Column() string { return "reason" }
+
+type GracefulExitAbort_AbortedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func GracefulExitAbort_AbortedAt(v time.Time) GracefulExitAbort_AbortedAt_Field {
+	return GracefulExitAbort_AbortedAt_Field{_set: true, _value: v}
+}
+
+func (f GracefulExitAbort_AbortedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitAbort_AbortedAt_Field) _Column() string { return "aborted_at" }
+
+type GracefulExitProgress struct {
+	NodeId            []byte
+	BytesTransferred  int64
+	PiecesTransferred int64
+	PiecesFailed      int64
+	UpdatedAt         time.Time
+}
+
+func (GracefulExitProgress) _Table() string { return "graceful_exit_progress" }
+
+type GracefulExitProgress_Update_Fields struct {
+	BytesTransferred  GracefulExitProgress_BytesTransferred_Field
+	PiecesTransferred GracefulExitProgress_PiecesTransferred_Field
+	PiecesFailed      GracefulExitProgress_PiecesFailed_Field
+}
+
+type GracefulExitProgress_NodeId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func GracefulExitProgress_NodeId(v []byte) GracefulExitProgress_NodeId_Field {
+	return GracefulExitProgress_NodeId_Field{_set: true, _value: v}
+}
+
+func (f GracefulExitProgress_NodeId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitProgress_NodeId_Field) _Column() string { return "node_id" }
+
+type GracefulExitProgress_BytesTransferred_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func GracefulExitProgress_BytesTransferred(v int64) GracefulExitProgress_BytesTransferred_Field {
+	return GracefulExitProgress_BytesTransferred_Field{_set: true, _value: v}
+}
+
+func (f GracefulExitProgress_BytesTransferred_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitProgress_BytesTransferred_Field) _Column() string { return "bytes_transferred" }
+
+type GracefulExitProgress_PiecesTransferred_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func GracefulExitProgress_PiecesTransferred(v int64) GracefulExitProgress_PiecesTransferred_Field {
+	return GracefulExitProgress_PiecesTransferred_Field{_set: true, _value: v}
+}
+
+func (f GracefulExitProgress_PiecesTransferred_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitProgress_PiecesTransferred_Field) _Column() string { return "pieces_transferred" }
+
+type GracefulExitProgress_PiecesFailed_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func GracefulExitProgress_PiecesFailed(v int64) GracefulExitProgress_PiecesFailed_Field {
+	return GracefulExitProgress_PiecesFailed_Field{_set: true, _value: v}
+}
+
+func (f GracefulExitProgress_PiecesFailed_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitProgress_PiecesFailed_Field) _Column() string { return "pieces_failed" }
+
+type GracefulExitProgress_UpdatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func GracefulExitProgress_UpdatedAt(v time.Time) GracefulExitProgress_UpdatedAt_Field {
+	return GracefulExitProgress_UpdatedAt_Field{_set: true, _value: v}
+}
+
+func (f GracefulExitProgress_UpdatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitProgress_UpdatedAt_Field) _Column() string { return "updated_at" }
+
+type GracefulExitSegmentTransfer struct {
+	NodeId              []byte
+	StreamId            []byte
+	Position            uint64
+	PieceNum            int
+	RootPieceId         []byte
+	DurabilityRatio     float64
+	QueuedAt            time.Time
+	RequestedAt         *time.Time
+	LastFailedAt        *time.Time
+	LastFailedCode      *int
+	FailedCount         *int
+	FinishedAt          *time.Time
+	OrderLimitSendCount int
+}
+
+func (GracefulExitSegmentTransfer) _Table() string { return "graceful_exit_segment_transfer_queue" }
+
+type GracefulExitSegmentTransfer_Create_Fields struct {
+	RootPieceId         GracefulExitSegmentTransfer_RootPieceId_Field
+	RequestedAt         GracefulExitSegmentTransfer_RequestedAt_Field
+	LastFailedAt        GracefulExitSegmentTransfer_LastFailedAt_Field
+	LastFailedCode      GracefulExitSegmentTransfer_LastFailedCode_Field
+	FailedCount         GracefulExitSegmentTransfer_FailedCount_Field
+	FinishedAt          GracefulExitSegmentTransfer_FinishedAt_Field
+	OrderLimitSendCount GracefulExitSegmentTransfer_OrderLimitSendCount_Field
+}
+
+type GracefulExitSegmentTransfer_Update_Fields struct {
+	DurabilityRatio     GracefulExitSegmentTransfer_DurabilityRatio_Field
+	RequestedAt         GracefulExitSegmentTransfer_RequestedAt_Field
+	LastFailedAt        GracefulExitSegmentTransfer_LastFailedAt_Field
+	LastFailedCode      GracefulExitSegmentTransfer_LastFailedCode_Field
+	FailedCount         GracefulExitSegmentTransfer_FailedCount_Field
+	FinishedAt          GracefulExitSegmentTransfer_FinishedAt_Field
+	OrderLimitSendCount GracefulExitSegmentTransfer_OrderLimitSendCount_Field
+}
+
+type GracefulExitSegmentTransfer_NodeId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func GracefulExitSegmentTransfer_NodeId(v []byte) GracefulExitSegmentTransfer_NodeId_Field {
+	return GracefulExitSegmentTransfer_NodeId_Field{_set: true, _value: v}
+}
+
+func (f GracefulExitSegmentTransfer_NodeId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitSegmentTransfer_NodeId_Field) _Column() string { return "node_id" }
+
+type GracefulExitSegmentTransfer_StreamId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func GracefulExitSegmentTransfer_StreamId(v []byte) GracefulExitSegmentTransfer_StreamId_Field {
+	return GracefulExitSegmentTransfer_StreamId_Field{_set: true, _value: v}
+}
+
+func (f GracefulExitSegmentTransfer_StreamId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitSegmentTransfer_StreamId_Field) _Column() string { return "stream_id" }
+
+type GracefulExitSegmentTransfer_Position_Field struct {
+	_set   bool
+	_null  bool
+	_value uint64
+}
+
+func GracefulExitSegmentTransfer_Position(v uint64) GracefulExitSegmentTransfer_Position_Field {
+	return GracefulExitSegmentTransfer_Position_Field{_set: true, _value: v}
+}
+
+func (f GracefulExitSegmentTransfer_Position_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitSegmentTransfer_Position_Field) _Column() string { return "position" }
+
+type GracefulExitSegmentTransfer_PieceNum_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func GracefulExitSegmentTransfer_PieceNum(v int) GracefulExitSegmentTransfer_PieceNum_Field {
+	return GracefulExitSegmentTransfer_PieceNum_Field{_set: true, _value: v}
+}
+
+func (f GracefulExitSegmentTransfer_PieceNum_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitSegmentTransfer_PieceNum_Field) _Column() string { return "piece_num" }
+
+type GracefulExitSegmentTransfer_RootPieceId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func GracefulExitSegmentTransfer_RootPieceId(v []byte) GracefulExitSegmentTransfer_RootPieceId_Field {
+	return GracefulExitSegmentTransfer_RootPieceId_Field{_set: true, _value: v}
+}
+
+func GracefulExitSegmentTransfer_RootPieceId_Raw(v []byte) GracefulExitSegmentTransfer_RootPieceId_Field {
+	if v == nil {
+		return GracefulExitSegmentTransfer_RootPieceId_Null()
+	}
+	return GracefulExitSegmentTransfer_RootPieceId(v)
+}
+
+func GracefulExitSegmentTransfer_RootPieceId_Null() GracefulExitSegmentTransfer_RootPieceId_Field {
+	return GracefulExitSegmentTransfer_RootPieceId_Field{_set: true, _null: true}
+}
+
+func (f GracefulExitSegmentTransfer_RootPieceId_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f GracefulExitSegmentTransfer_RootPieceId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitSegmentTransfer_RootPieceId_Field) _Column() string { return "root_piece_id" }
+
+type GracefulExitSegmentTransfer_DurabilityRatio_Field struct {
+	_set   bool
+	_null  bool
+	_value float64
+}
+
+func GracefulExitSegmentTransfer_DurabilityRatio(v float64) GracefulExitSegmentTransfer_DurabilityRatio_Field {
+	return GracefulExitSegmentTransfer_DurabilityRatio_Field{_set: true, _value: v}
+}
+
+func (f GracefulExitSegmentTransfer_DurabilityRatio_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitSegmentTransfer_DurabilityRatio_Field) _Column() string { return "durability_ratio" }
+
+type GracefulExitSegmentTransfer_QueuedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func GracefulExitSegmentTransfer_QueuedAt(v time.Time) GracefulExitSegmentTransfer_QueuedAt_Field {
+	return GracefulExitSegmentTransfer_QueuedAt_Field{_set: true, _value: v}
+}
+
+func (f GracefulExitSegmentTransfer_QueuedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitSegmentTransfer_QueuedAt_Field) _Column() string { return "queued_at" }
+
+type GracefulExitSegmentTransfer_RequestedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func GracefulExitSegmentTransfer_RequestedAt(v time.Time) GracefulExitSegmentTransfer_RequestedAt_Field {
+	return GracefulExitSegmentTransfer_RequestedAt_Field{_set: true, _value: &v}
+}
+
+func GracefulExitSegmentTransfer_RequestedAt_Raw(v *time.Time) GracefulExitSegmentTransfer_RequestedAt_Field {
+	if v == nil {
+		return GracefulExitSegmentTransfer_RequestedAt_Null()
+	}
+	return GracefulExitSegmentTransfer_RequestedAt(*v)
+}
+
+func GracefulExitSegmentTransfer_RequestedAt_Null() GracefulExitSegmentTransfer_RequestedAt_Field {
+	return GracefulExitSegmentTransfer_RequestedAt_Field{_set: true, _null: true}
+}
+
+func (f GracefulExitSegmentTransfer_RequestedAt_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f GracefulExitSegmentTransfer_RequestedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitSegmentTransfer_RequestedAt_Field) _Column() string { return "requested_at" }
+
+type GracefulExitSegmentTransfer_LastFailedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func GracefulExitSegmentTransfer_LastFailedAt(v time.Time) GracefulExitSegmentTransfer_LastFailedAt_Field {
+	return GracefulExitSegmentTransfer_LastFailedAt_Field{_set: true, _value: &v}
+}
+
+func GracefulExitSegmentTransfer_LastFailedAt_Raw(v *time.Time) GracefulExitSegmentTransfer_LastFailedAt_Field {
+	if v == nil {
+		return GracefulExitSegmentTransfer_LastFailedAt_Null()
+	}
+	return GracefulExitSegmentTransfer_LastFailedAt(*v)
+}
+
+func GracefulExitSegmentTransfer_LastFailedAt_Null() GracefulExitSegmentTransfer_LastFailedAt_Field {
+	return GracefulExitSegmentTransfer_LastFailedAt_Field{_set: true, _null: true}
+}
+
+func (f GracefulExitSegmentTransfer_LastFailedAt_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f GracefulExitSegmentTransfer_LastFailedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitSegmentTransfer_LastFailedAt_Field) _Column() string { return "last_failed_at" }
+
+type GracefulExitSegmentTransfer_LastFailedCode_Field struct {
+	_set   bool
+	_null  bool
+	_value *int
+}
+
+func GracefulExitSegmentTransfer_LastFailedCode(v int) GracefulExitSegmentTransfer_LastFailedCode_Field {
+	return GracefulExitSegmentTransfer_LastFailedCode_Field{_set: true, _value: &v}
+}
+
+func GracefulExitSegmentTransfer_LastFailedCode_Raw(v *int) GracefulExitSegmentTransfer_LastFailedCode_Field {
+	if v == nil {
+		return GracefulExitSegmentTransfer_LastFailedCode_Null()
+	}
+	return GracefulExitSegmentTransfer_LastFailedCode(*v)
+}
+
+func GracefulExitSegmentTransfer_LastFailedCode_Null() GracefulExitSegmentTransfer_LastFailedCode_Field {
 	return GracefulExitSegmentTransfer_LastFailedCode_Field{_set: true, _null: true}
 }
 
-func (f GracefulExitSegmentTransfer_LastFailedCode_Field) isnull() bool {
+func (f GracefulExitSegmentTransfer_LastFailedCode_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f GracefulExitSegmentTransfer_LastFailedCode_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitSegmentTransfer_LastFailedCode_Field) _Column() string { return "last_failed_code" }
+
+type GracefulExitSegmentTransfer_FailedCount_Field struct {
+	_set   bool
+	_null  bool
+	_value *int
+}
+
+func GracefulExitSegmentTransfer_FailedCount(v int) GracefulExitSegmentTransfer_FailedCount_Field {
+	return GracefulExitSegmentTransfer_FailedCount_Field{_set: true, _value: &v}
+}
+
+func GracefulExitSegmentTransfer_FailedCount_Raw(v *int) GracefulExitSegmentTransfer_FailedCount_Field {
+	if v == nil {
+		return GracefulExitSegmentTransfer_FailedCount_Null()
+	}
+	return GracefulExitSegmentTransfer_FailedCount(*v)
+}
+
+func GracefulExitSegmentTransfer_FailedCount_Null() GracefulExitSegmentTransfer_FailedCount_Field {
+	return GracefulExitSegmentTransfer_FailedCount_Field{_set: true, _null: true}
+}
+
+func (f GracefulExitSegmentTransfer_FailedCount_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f GracefulExitSegmentTransfer_FailedCount_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitSegmentTransfer_FailedCount_Field) _Column() string { return "failed_count" }
+
+type GracefulExitSegmentTransfer_FinishedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func GracefulExitSegmentTransfer_FinishedAt(v time.Time) GracefulExitSegmentTransfer_FinishedAt_Field {
+	return GracefulExitSegmentTransfer_FinishedAt_Field{_set: true, _value: &v}
+}
+
+func GracefulExitSegmentTransfer_FinishedAt_Raw(v *time.Time) GracefulExitSegmentTransfer_FinishedAt_Field {
+	if v == nil {
+		return GracefulExitSegmentTransfer_FinishedAt_Null()
+	}
+	return GracefulExitSegmentTransfer_FinishedAt(*v)
+}
+
+func GracefulExitSegmentTransfer_FinishedAt_Null() GracefulExitSegmentTransfer_FinishedAt_Field {
+	return GracefulExitSegmentTransfer_FinishedAt_Field{_set: true, _null: true}
+}
+
+func (f GracefulExitSegmentTransfer_FinishedAt_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f GracefulExitSegmentTransfer_FinishedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitSegmentTransfer_FinishedAt_Field) _Column() string { return "finished_at" }
+
+type GracefulExitSegmentTransfer_OrderLimitSendCount_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func GracefulExitSegmentTransfer_OrderLimitSendCount(v int) GracefulExitSegmentTransfer_OrderLimitSendCount_Field {
+	return GracefulExitSegmentTransfer_OrderLimitSendCount_Field{_set: true, _value: v}
+}
+
+func (f GracefulExitSegmentTransfer_OrderLimitSendCount_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitSegmentTransfer_OrderLimitSendCount_Field) _Column() string {
+	return "order_limit_send_count"
+}
+
+type ImpersonationSession struct {
+	Id        []byte
+	UserId    []byte
+	Admin     string
+	Reason    string
+	Consented bool
+	CreatedAt time.Time
+	ExpiresAt time.Time
+}
+
+func (ImpersonationSession) _Table() string { return "impersonation_sessions" }
+
+type ImpersonationSession_Create_Fields struct {
+}
+
+type ImpersonationSession_Update_Fields struct {
+}
+
+type ImpersonationSession_Id_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func ImpersonationSession_Id(v []byte) ImpersonationSession_Id_Field {
+	return ImpersonationSession_Id_Field{_set: true, _value: v}
+}
+
+func (f ImpersonationSession_Id_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ImpersonationSession_Id_Field) _Column() string { return "id" }
+
+type ImpersonationSession_UserId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func ImpersonationSession_UserId(v []byte) ImpersonationSession_UserId_Field {
+	return ImpersonationSession_UserId_Field{_set: true, _value: v}
+}
+
+func (f ImpersonationSession_UserId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ImpersonationSession_UserId_Field) _Column() string { return "user_id" }
+
+type ImpersonationSession_Admin_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func ImpersonationSession_Admin(v string) ImpersonationSession_Admin_Field {
+	return ImpersonationSession_Admin_Field{_set: true, _value: v}
+}
+
+func (f ImpersonationSession_Admin_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ImpersonationSession_Admin_Field) _Column() string { return "admin" }
+
+type ImpersonationSession_Reason_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func ImpersonationSession_Reason(v string) ImpersonationSession_Reason_Field {
+	return ImpersonationSession_Reason_Field{_set: true, _value: v}
+}
+
+func (f ImpersonationSession_Reason_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ImpersonationSession_Reason_Field) _Column() string { return "reason" }
+
+type ImpersonationSession_Consented_Field struct {
+	_set   bool
+	_null  bool
+	_value bool
+}
+
+func ImpersonationSession_Consented(v bool) ImpersonationSession_Consented_Field {
+	return ImpersonationSession_Consented_Field{_set: true, _value: v}
+}
+
+func (f ImpersonationSession_Consented_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ImpersonationSession_Consented_Field) _Column() string { return "consented" }
+
+type ImpersonationSession_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func ImpersonationSession_CreatedAt(v time.Time) ImpersonationSession_CreatedAt_Field {
+	return ImpersonationSession_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f ImpersonationSession_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ImpersonationSession_CreatedAt_Field) _Column() string { return "created_at" }
+
+type ImpersonationSession_ExpiresAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func ImpersonationSession_ExpiresAt(v time.Time) ImpersonationSession_ExpiresAt_Field {
+	return ImpersonationSession_ExpiresAt_Field{_set: true, _value: v}
+}
+
+func (f ImpersonationSession_ExpiresAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ImpersonationSession_ExpiresAt_Field) _Column() string { return "expires_at" }
+
+type MaintenanceMode struct {
+	Id         int
+	Enabled    bool
+	Reason     string
+	RetryAfter int
+	UpdatedAt  time.Time
+}
+
+func (MaintenanceMode) _Table() string { return "maintenance_modes" }
+
+type MaintenanceMode_Update_Fields struct {
+	Enabled    MaintenanceMode_Enabled_Field
+	Reason     MaintenanceMode_Reason_Field
+	RetryAfter MaintenanceMode_RetryAfter_Field
+	UpdatedAt  MaintenanceMode_UpdatedAt_Field
+}
+
+type MaintenanceMode_Id_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func MaintenanceMode_Id(v int) MaintenanceMode_Id_Field {
+	return MaintenanceMode_Id_Field{_set: true, _value: v}
+}
+
+func (f MaintenanceMode_Id_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (MaintenanceMode_Id_Field) _Column() string { return "id" }
+
+type MaintenanceMode_Enabled_Field struct {
+	_set   bool
+	_null  bool
+	_value bool
+}
+
+func MaintenanceMode_Enabled(v bool) MaintenanceMode_Enabled_Field {
+	return MaintenanceMode_Enabled_Field{_set: true, _value: v}
+}
+
+func (f MaintenanceMode_Enabled_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (MaintenanceMode_Enabled_Field) _Column() string { return "enabled" }
+
+type MaintenanceMode_Reason_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func MaintenanceMode_Reason(v string) MaintenanceMode_Reason_Field {
+	return MaintenanceMode_Reason_Field{_set: true, _value: v}
+}
+
+func (f MaintenanceMode_Reason_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (MaintenanceMode_Reason_Field) _Column() string { return "reason" }
+
+type MaintenanceMode_RetryAfter_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func MaintenanceMode_RetryAfter(v int) MaintenanceMode_RetryAfter_Field {
+	return MaintenanceMode_RetryAfter_Field{_set: true, _value: v}
+}
+
+func (f MaintenanceMode_RetryAfter_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (MaintenanceMode_RetryAfter_Field) _Column() string { return "retry_after" }
+
+type MaintenanceMode_UpdatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func MaintenanceMode_UpdatedAt(v time.Time) MaintenanceMode_UpdatedAt_Field {
+	return MaintenanceMode_UpdatedAt_Field{_set: true, _value: v}
+}
+
+func (f MaintenanceMode_UpdatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (MaintenanceMode_UpdatedAt_Field) _Column() string { return "updated_at" }
+
+type Node struct {
+	Id                      []byte
+	Address                 string
+	LastNet                 string
+	LastIpPort              *string
+	CountryCode             *string
+	Protocol                int
+	Type                    int
+	Email                   string
+	Wallet                  string
+	WalletFeatures          string
+	FreeDisk                int64
+	PieceCount              int64
+	Major                   int64
+	Minor                   int64
+	Patch                   int64
+	Hash                    string
+	Timestamp               time.Time
+	Release                 bool
+	Latency90               int64
+	VettedAt                *time.Time
+	CreatedAt               time.Time
+	UpdatedAt               time.Time
+	LastContactSuccess      time.Time
+	LastContactFailure      time.Time
+	Disqualified            *time.Time
+	DisqualificationReason  *int
+	UnknownAuditSuspended   *time.Time
+	OfflineSuspended        *time.Time
+	UnderReview             *time.Time
+	ExitInitiatedAt         *time.Time
+	ExitLoopCompletedAt     *time.Time
+	ExitFinishedAt          *time.Time
+	ExitSuccess             bool
+	ExitFailedReason        *int
+	Contained               *time.Time
+	LastOfflineEmail        *time.Time
+	LastSoftwareUpdateEmail *time.Time
+	Protocols               string
+	Hashstore               bool
+	TtlSupport              bool
+	MaxPieceSize            int64
+}
+
+func (Node) _Table() string { return "nodes" }
+
+type Node_Create_Fields struct {
+	Address                 Node_Address_Field
+	LastIpPort              Node_LastIpPort_Field
+	CountryCode             Node_CountryCode_Field
+	Protocol                Node_Protocol_Field
+	Type                    Node_Type_Field
+	WalletFeatures          Node_WalletFeatures_Field
+	FreeDisk                Node_FreeDisk_Field
+	Major                   Node_Major_Field
+	Minor                   Node_Minor_Field
+	Patch                   Node_Patch_Field
+	Hash                    Node_Hash_Field
+	Timestamp               Node_Timestamp_Field
+	Release                 Node_Release_Field
+	Latency90               Node_Latency90_Field
+	VettedAt                Node_VettedAt_Field
+	LastContactSuccess      Node_LastContactSuccess_Field
+	LastContactFailure      Node_LastContactFailure_Field
+	Disqualified            Node_Disqualified_Field
+	DisqualificationReason  Node_DisqualificationReason_Field
+	UnknownAuditSuspended   Node_UnknownAuditSuspended_Field
+	OfflineSuspended        Node_OfflineSuspended_Field
+	UnderReview             Node_UnderReview_Field
+	ExitInitiatedAt         Node_ExitInitiatedAt_Field
+	ExitLoopCompletedAt     Node_ExitLoopCompletedAt_Field
+	ExitFinishedAt          Node_ExitFinishedAt_Field
+	ExitSuccess             Node_ExitSuccess_Field
+	ExitFailedReason        Node_ExitFailedReason_Field
+	Contained               Node_Contained_Field
+	LastOfflineEmail        Node_LastOfflineEmail_Field
+	LastSoftwareUpdateEmail Node_LastSoftwareUpdateEmail_Field
+	Protocols               Node_Protocols_Field
+	Hashstore               Node_Hashstore_Field
+	TtlSupport              Node_TtlSupport_Field
+	MaxPieceSize            Node_MaxPieceSize_Field
+}
+
+type Node_Update_Fields struct {
+	Address                 Node_Address_Field
+	LastNet                 Node_LastNet_Field
+	LastIpPort              Node_LastIpPort_Field
+	CountryCode             Node_CountryCode_Field
+	Protocol                Node_Protocol_Field
+	Type                    Node_Type_Field
+	Email                   Node_Email_Field
+	Wallet                  Node_Wallet_Field
+	WalletFeatures          Node_WalletFeatures_Field
+	FreeDisk                Node_FreeDisk_Field
+	PieceCount              Node_PieceCount_Field
+	Major                   Node_Major_Field
+	Minor                   Node_Minor_Field
+	Patch                   Node_Patch_Field
+	Hash                    Node_Hash_Field
+	Timestamp               Node_Timestamp_Field
+	Release                 Node_Release_Field
+	Latency90               Node_Latency90_Field
+	VettedAt                Node_VettedAt_Field
+	LastContactSuccess      Node_LastContactSuccess_Field
+	LastContactFailure      Node_LastContactFailure_Field
+	Disqualified            Node_Disqualified_Field
+	DisqualificationReason  Node_DisqualificationReason_Field
+	UnknownAuditSuspended   Node_UnknownAuditSuspended_Field
+	OfflineSuspended        Node_OfflineSuspended_Field
+	UnderReview             Node_UnderReview_Field
+	ExitInitiatedAt         Node_ExitInitiatedAt_Field
+	ExitLoopCompletedAt     Node_ExitLoopCompletedAt_Field
+	ExitFinishedAt          Node_ExitFinishedAt_Field
+	ExitSuccess             Node_ExitSuccess_Field
+	ExitFailedReason        Node_ExitFailedReason_Field
+	Contained               Node_Contained_Field
+	LastOfflineEmail        Node_LastOfflineEmail_Field
+	LastSoftwareUpdateEmail Node_LastSoftwareUpdateEmail_Field
+	Protocols               Node_Protocols_Field
+	Hashstore               Node_Hashstore_Field
+	TtlSupport              Node_TtlSupport_Field
+	MaxPieceSize            Node_MaxPieceSize_Field
+}
+
+type Node_Id_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func Node_Id(v []byte) Node_Id_Field {
+	return Node_Id_Field{_set: true, _value: v}
+}
+
+func (f Node_Id_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_Id_Field) _Column() string { return "id" }
+
+type Node_Address_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func Node_Address(v string) Node_Address_Field {
+	return Node_Address_Field{_set: true, _value: v}
+}
+
+func (f Node_Address_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_Address_Field) _Column() string { return "address" }
+
+type Node_LastNet_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func Node_LastNet(v string) Node_LastNet_Field {
+	return Node_LastNet_Field{_set: true, _value: v}
+}
+
+func (f Node_LastNet_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_LastNet_Field) _Column() string { return "last_net" }
+
+type Node_LastIpPort_Field struct {
+	_set   bool
+	_null  bool
+	_value *string
+}
+
+func Node_LastIpPort(v string) Node_LastIpPort_Field {
+	return Node_LastIpPort_Field{_set: true, _value: &v}
+}
+
+func Node_LastIpPort_Raw(v *string) Node_LastIpPort_Field {
+	if v == nil {
+		return Node_LastIpPort_Null()
+	}
+	return Node_LastIpPort(*v)
+}
+
+func Node_LastIpPort_Null() Node_LastIpPort_Field {
+	return Node_LastIpPort_Field{_set: true, _null: true}
+}
+
+func (f Node_LastIpPort_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Node_LastIpPort_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_LastIpPort_Field) _Column() string { return "last_ip_port" }
+
+type Node_CountryCode_Field struct {
+	_set   bool
+	_null  bool
+	_value *string
+}
+
+func Node_CountryCode(v string) Node_CountryCode_Field {
+	return Node_CountryCode_Field{_set: true, _value: &v}
+}
+
+func Node_CountryCode_Raw(v *string) Node_CountryCode_Field {
+	if v == nil {
+		return Node_CountryCode_Null()
+	}
+	return Node_CountryCode(*v)
+}
+
+func Node_CountryCode_Null() Node_CountryCode_Field {
+	return Node_CountryCode_Field{_set: true, _null: true}
+}
+
+func (f Node_CountryCode_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Node_CountryCode_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_CountryCode_Field) _Column() string { return "country_code" }
+
+type Node_Protocol_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func Node_Protocol(v int) Node_Protocol_Field {
+	return Node_Protocol_Field{_set: true, _value: v}
+}
+
+func (f Node_Protocol_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_Protocol_Field) _Column() string { return "protocol" }
+
+type Node_Type_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func Node_Type(v int) Node_Type_Field {
+	return Node_Type_Field{_set: true, _value: v}
+}
+
+func (f Node_Type_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_Type_Field) _Column() string { return "type" }
+
+type Node_Email_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func Node_Email(v string) Node_Email_Field {
+	return Node_Email_Field{_set: true, _value: v}
+}
+
+func (f Node_Email_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_Email_Field) _Column() string { return "email" }
+
+type Node_Wallet_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func Node_Wallet(v string) Node_Wallet_Field {
+	return Node_Wallet_Field{_set: true, _value: v}
+}
+
+func (f Node_Wallet_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_Wallet_Field) _Column() string { return "wallet" }
+
+type Node_WalletFeatures_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func Node_WalletFeatures(v string) Node_WalletFeatures_Field {
+	return Node_WalletFeatures_Field{_set: true, _value: v}
+}
+
+func (f Node_WalletFeatures_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_WalletFeatures_Field) _Column() string { return "wallet_features" }
+
+type Node_FreeDisk_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func Node_FreeDisk(v int64) Node_FreeDisk_Field {
+	return Node_FreeDisk_Field{_set: true, _value: v}
+}
+
+func (f Node_FreeDisk_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_FreeDisk_Field) _Column() string { return "free_disk" }
+
+type Node_PieceCount_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func Node_PieceCount(v int64) Node_PieceCount_Field {
+	return Node_PieceCount_Field{_set: true, _value: v}
+}
+
+func (f Node_PieceCount_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_PieceCount_Field) _Column() string { return "piece_count" }
+
+type Node_Major_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func Node_Major(v int64) Node_Major_Field {
+	return Node_Major_Field{_set: true, _value: v}
+}
+
+func (f Node_Major_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_Major_Field) _Column() string { return "major" }
+
+type Node_Minor_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func Node_Minor(v int64) Node_Minor_Field {
+	return Node_Minor_Field{_set: true, _value: v}
+}
+
+func (f Node_Minor_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_Minor_Field) _Column() string { return "minor" }
+
+type Node_Patch_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func Node_Patch(v int64) Node_Patch_Field {
+	return Node_Patch_Field{_set: true, _value: v}
+}
+
+func (f Node_Patch_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_Patch_Field) _Column() string { return "patch" }
+
+type Node_Hash_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func Node_Hash(v string) Node_Hash_Field {
+	return Node_Hash_Field{_set: true, _value: v}
+}
+
+func (f Node_Hash_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_Hash_Field) _Column() string { return "hash" }
+
+type Node_Timestamp_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func Node_Timestamp(v time.Time) Node_Timestamp_Field {
+	return Node_Timestamp_Field{_set: true, _value: v}
+}
+
+func (f Node_Timestamp_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_Timestamp_Field) _Column() string { return "timestamp" }
+
+type Node_Release_Field struct {
+	_set   bool
+	_null  bool
+	_value bool
+}
+
+func Node_Release(v bool) Node_Release_Field {
+	return Node_Release_Field{_set: true, _value: v}
+}
+
+func (f Node_Release_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_Release_Field) _Column() string { return "release" }
+
+type Node_Latency90_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func Node_Latency90(v int64) Node_Latency90_Field {
+	return Node_Latency90_Field{_set: true, _value: v}
+}
+
+func (f Node_Latency90_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_Latency90_Field) _Column() string { return "latency_90" }
+
+type Node_VettedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func Node_VettedAt(v time.Time) Node_VettedAt_Field {
+	return Node_VettedAt_Field{_set: true, _value: &v}
+}
+
+func Node_VettedAt_Raw(v *time.Time) Node_VettedAt_Field {
+	if v == nil {
+		return Node_VettedAt_Null()
+	}
+	return Node_VettedAt(*v)
+}
+
+func Node_VettedAt_Null() Node_VettedAt_Field {
+	return Node_VettedAt_Field{_set: true, _null: true}
+}
+
+func (f Node_VettedAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Node_VettedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_VettedAt_Field) _Column() string { return "vetted_at" }
+
+type Node_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func Node_CreatedAt(v time.Time) Node_CreatedAt_Field {
+	return Node_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f Node_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_CreatedAt_Field) _Column() string { return "created_at" }
+
+type Node_UpdatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func Node_UpdatedAt(v time.Time) Node_UpdatedAt_Field {
+	return Node_UpdatedAt_Field{_set: true, _value: v}
+}
+
+func (f Node_UpdatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_UpdatedAt_Field) _Column() string { return "updated_at" }
+
+type Node_LastContactSuccess_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func Node_LastContactSuccess(v time.Time) Node_LastContactSuccess_Field {
+	return Node_LastContactSuccess_Field{_set: true, _value: v}
+}
+
+func (f Node_LastContactSuccess_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_LastContactSuccess_Field) _Column() string { return "last_contact_success" }
+
+type Node_LastContactFailure_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func Node_LastContactFailure(v time.Time) Node_LastContactFailure_Field {
+	return Node_LastContactFailure_Field{_set: true, _value: v}
+}
+
+func (f Node_LastContactFailure_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_LastContactFailure_Field) _Column() string { return "last_contact_failure" }
+
+type Node_Disqualified_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func Node_Disqualified(v time.Time) Node_Disqualified_Field {
+	return Node_Disqualified_Field{_set: true, _value: &v}
+}
+
+func Node_Disqualified_Raw(v *time.Time) Node_Disqualified_Field {
+	if v == nil {
+		return Node_Disqualified_Null()
+	}
+	return Node_Disqualified(*v)
+}
+
+func Node_Disqualified_Null() Node_Disqualified_Field {
+	return Node_Disqualified_Field{_set: true, _null: true}
+}
+
+func (f Node_Disqualified_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Node_Disqualified_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_Disqualified_Field) _Column() string { return "disqualified" }
+
+type Node_DisqualificationReason_Field struct {
+	_set   bool
+	_null  bool
+	_value *int
+}
+
+func Node_DisqualificationReason(v int) Node_DisqualificationReason_Field {
+	return Node_DisqualificationReason_Field{_set: true, _value: &v}
+}
+
+func Node_DisqualificationReason_Raw(v *int) Node_DisqualificationReason_Field {
+	if v == nil {
+		return Node_DisqualificationReason_Null()
+	}
+	return Node_DisqualificationReason(*v)
+}
+
+func Node_DisqualificationReason_Null() Node_DisqualificationReason_Field {
+	return Node_DisqualificationReason_Field{_set: true, _null: true}
+}
+
+func (f Node_DisqualificationReason_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f Node_DisqualificationReason_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_DisqualificationReason_Field) _Column() string { return "disqualification_reason" }
+
+type Node_UnknownAuditSuspended_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func Node_UnknownAuditSuspended(v time.Time) Node_UnknownAuditSuspended_Field {
+	return Node_UnknownAuditSuspended_Field{_set: true, _value: &v}
+}
+
+func Node_UnknownAuditSuspended_Raw(v *time.Time) Node_UnknownAuditSuspended_Field {
+	if v == nil {
+		return Node_UnknownAuditSuspended_Null()
+	}
+	return Node_UnknownAuditSuspended(*v)
+}
+
+func Node_UnknownAuditSuspended_Null() Node_UnknownAuditSuspended_Field {
+	return Node_UnknownAuditSuspended_Field{_set: true, _null: true}
+}
+
+func (f Node_UnknownAuditSuspended_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Node_UnknownAuditSuspended_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_UnknownAuditSuspended_Field) _Column() string { return "unknown_audit_suspended" }
+
+type Node_OfflineSuspended_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func Node_OfflineSuspended(v time.Time) Node_OfflineSuspended_Field {
+	return Node_OfflineSuspended_Field{_set: true, _value: &v}
+}
+
+func Node_OfflineSuspended_Raw(v *time.Time) Node_OfflineSuspended_Field {
+	if v == nil {
+		return Node_OfflineSuspended_Null()
+	}
+	return Node_OfflineSuspended(*v)
+}
+
+func Node_OfflineSuspended_Null() Node_OfflineSuspended_Field {
+	return Node_OfflineSuspended_Field{_set: true, _null: true}
+}
+
+func (f Node_OfflineSuspended_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Node_OfflineSuspended_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_OfflineSuspended_Field) _Column() string { return "offline_suspended" }
+
+type Node_UnderReview_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func Node_UnderReview(v time.Time) Node_UnderReview_Field {
+	return Node_UnderReview_Field{_set: true, _value: &v}
+}
+
+func Node_UnderReview_Raw(v *time.Time) Node_UnderReview_Field {
+	if v == nil {
+		return Node_UnderReview_Null()
+	}
+	return Node_UnderReview(*v)
+}
+
+func Node_UnderReview_Null() Node_UnderReview_Field {
+	return Node_UnderReview_Field{_set: true, _null: true}
+}
+
+func (f Node_UnderReview_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Node_UnderReview_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_UnderReview_Field) _Column() string { return "under_review" }
+
+type Node_ExitInitiatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func Node_ExitInitiatedAt(v time.Time) Node_ExitInitiatedAt_Field {
+	return Node_ExitInitiatedAt_Field{_set: true, _value: &v}
+}
+
+func Node_ExitInitiatedAt_Raw(v *time.Time) Node_ExitInitiatedAt_Field {
+	if v == nil {
+		return Node_ExitInitiatedAt_Null()
+	}
+	return Node_ExitInitiatedAt(*v)
+}
+
+func Node_ExitInitiatedAt_Null() Node_ExitInitiatedAt_Field {
+	return Node_ExitInitiatedAt_Field{_set: true, _null: true}
+}
+
+func (f Node_ExitInitiatedAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Node_ExitInitiatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_ExitInitiatedAt_Field) _Column() string { return "exit_initiated_at" }
+
+type Node_ExitLoopCompletedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func Node_ExitLoopCompletedAt(v time.Time) Node_ExitLoopCompletedAt_Field {
+	return Node_ExitLoopCompletedAt_Field{_set: true, _value: &v}
+}
+
+func Node_ExitLoopCompletedAt_Raw(v *time.Time) Node_ExitLoopCompletedAt_Field {
+	if v == nil {
+		return Node_ExitLoopCompletedAt_Null()
+	}
+	return Node_ExitLoopCompletedAt(*v)
+}
+
+func Node_ExitLoopCompletedAt_Null() Node_ExitLoopCompletedAt_Field {
+	return Node_ExitLoopCompletedAt_Field{_set: true, _null: true}
+}
+
+func (f Node_ExitLoopCompletedAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Node_ExitLoopCompletedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_ExitLoopCompletedAt_Field) _Column() string { return "exit_loop_completed_at" }
+
+type Node_ExitFinishedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func Node_ExitFinishedAt(v time.Time) Node_ExitFinishedAt_Field {
+	return Node_ExitFinishedAt_Field{_set: true, _value: &v}
+}
+
+func Node_ExitFinishedAt_Raw(v *time.Time) Node_ExitFinishedAt_Field {
+	if v == nil {
+		return Node_ExitFinishedAt_Null()
+	}
+	return Node_ExitFinishedAt(*v)
+}
+
+func Node_ExitFinishedAt_Null() Node_ExitFinishedAt_Field {
+	return Node_ExitFinishedAt_Field{_set: true, _null: true}
+}
+
+func (f Node_ExitFinishedAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Node_ExitFinishedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_ExitFinishedAt_Field) _Column() string { return "exit_finished_at" }
+
+type Node_ExitSuccess_Field struct {
+	_set   bool
+	_null  bool
+	_value bool
+}
+
+func Node_ExitSuccess(v bool) Node_ExitSuccess_Field {
+	return Node_ExitSuccess_Field{_set: true, _value: v}
+}
+
+func (f Node_ExitSuccess_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_ExitSuccess_Field) _Column() string { return "exit_success" }
+
+type Node_ExitFailedReason_Field struct {
+	_set   bool
+	_null  bool
+	_value *int
+}
+
+func Node_ExitFailedReason(v int) Node_ExitFailedReason_Field {
+	return Node_ExitFailedReason_Field{_set: true, _value: &v}
+}
+
+func Node_ExitFailedReason_Raw(v *int) Node_ExitFailedReason_Field {
+	if v == nil {
+		return Node_ExitFailedReason_Null()
+	}
+	return Node_ExitFailedReason(*v)
+}
+
+func Node_ExitFailedReason_Null() Node_ExitFailedReason_Field {
+	return Node_ExitFailedReason_Field{_set: true, _null: true}
+}
+
+func (f Node_ExitFailedReason_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f Node_ExitFailedReason_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_ExitFailedReason_Field) _Column() string { return "exit_failed_reason" }
+
+type Node_Contained_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func Node_Contained(v time.Time) Node_Contained_Field {
+	return Node_Contained_Field{_set: true, _value: &v}
+}
+
+func Node_Contained_Raw(v *time.Time) Node_Contained_Field {
+	if v == nil {
+		return Node_Contained_Null()
+	}
+	return Node_Contained(*v)
+}
+
+func Node_Contained_Null() Node_Contained_Field {
+	return Node_Contained_Field{_set: true, _null: true}
+}
+
+func (f Node_Contained_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Node_Contained_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_Contained_Field) _Column() string { return "contained" }
+
+type Node_LastOfflineEmail_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func Node_LastOfflineEmail(v time.Time) Node_LastOfflineEmail_Field {
+	return Node_LastOfflineEmail_Field{_set: true, _value: &v}
+}
+
+func Node_LastOfflineEmail_Raw(v *time.Time) Node_LastOfflineEmail_Field {
+	if v == nil {
+		return Node_LastOfflineEmail_Null()
+	}
+	return Node_LastOfflineEmail(*v)
+}
+
+func Node_LastOfflineEmail_Null() Node_LastOfflineEmail_Field {
+	return Node_LastOfflineEmail_Field{_set: true, _null: true}
+}
+
+func (f Node_LastOfflineEmail_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Node_LastOfflineEmail_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_LastOfflineEmail_Field) _Column() string { return "last_offline_email" }
+
+type Node_LastSoftwareUpdateEmail_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func Node_LastSoftwareUpdateEmail(v time.Time) Node_LastSoftwareUpdateEmail_Field {
+	return Node_LastSoftwareUpdateEmail_Field{_set: true, _value: &v}
+}
+
+func Node_LastSoftwareUpdateEmail_Raw(v *time.Time) Node_LastSoftwareUpdateEmail_Field {
+	if v == nil {
+		return Node_LastSoftwareUpdateEmail_Null()
+	}
+	return Node_LastSoftwareUpdateEmail(*v)
+}
+
+func Node_LastSoftwareUpdateEmail_Null() Node_LastSoftwareUpdateEmail_Field {
+	return Node_LastSoftwareUpdateEmail_Field{_set: true, _null: true}
+}
+
+func (f Node_LastSoftwareUpdateEmail_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f Node_LastSoftwareUpdateEmail_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_LastSoftwareUpdateEmail_Field) _Column() string { return "last_software_update_email" }
+
+type Node_Protocols_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func Node_Protocols(v string) Node_Protocols_Field {
+	return Node_Protocols_Field{_set: true, _value: v}
+}
+
+func (f Node_Protocols_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_Protocols_Field) _Column() string { return "protocols" }
+
+type Node_Hashstore_Field struct {
+	_set   bool
+	_null  bool
+	_value bool
+}
+
+func Node_Hashstore(v bool) Node_Hashstore_Field {
+	return Node_Hashstore_Field{_set: true, _value: v}
+}
+
+func (f Node_Hashstore_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_Hashstore_Field) _Column() string { return "hashstore" }
+
+type Node_TtlSupport_Field struct {
+	_set   bool
+	_null  bool
+	_value bool
+}
+
+func Node_TtlSupport(v bool) Node_TtlSupport_Field {
+	return Node_TtlSupport_Field{_set: true, _value: v}
+}
+
+func (f Node_TtlSupport_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_TtlSupport_Field) _Column() string { return "ttl_support" }
+
+type Node_MaxPieceSize_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func Node_MaxPieceSize(v int64) Node_MaxPieceSize_Field {
+	return Node_MaxPieceSize_Field{_set: true, _value: v}
+}
+
+func (f Node_MaxPieceSize_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_MaxPieceSize_Field) _Column() string { return "max_piece_size" }
+
+type NodeApiVersion struct {
+	Id         []byte
+	ApiVersion int
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+func (NodeApiVersion) _Table() string { return "node_api_versions" }
+
+type NodeApiVersion_Update_Fields struct {
+	ApiVersion NodeApiVersion_ApiVersion_Field
+}
+
+type NodeApiVersion_Id_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func NodeApiVersion_Id(v []byte) NodeApiVersion_Id_Field {
+	return NodeApiVersion_Id_Field{_set: true, _value: v}
+}
+
+func (f NodeApiVersion_Id_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (NodeApiVersion_Id_Field) _Column() string { return "id" }
+
+type NodeApiVersion_ApiVersion_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func NodeApiVersion_ApiVersion(v int) NodeApiVersion_ApiVersion_Field {
+	return NodeApiVersion_ApiVersion_Field{_set: true, _value: v}
+}
+
+func (f NodeApiVersion_ApiVersion_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (NodeApiVersion_ApiVersion_Field) _Column() string { return "api_version" }
+
+type NodeApiVersion_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func NodeApiVersion_CreatedAt(v time.Time) NodeApiVersion_CreatedAt_Field {
+	return NodeApiVersion_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f NodeApiVersion_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (NodeApiVersion_CreatedAt_Field) _Column() string { return "created_at" }
+
+type NodeApiVersion_UpdatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func NodeApiVersion_UpdatedAt(v time.Time) NodeApiVersion_UpdatedAt_Field {
+	return NodeApiVersion_UpdatedAt_Field{_set: true, _value: v}
+}
+
+func (f NodeApiVersion_UpdatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (NodeApiVersion_UpdatedAt_Field) _Column() string { return "updated_at" }
+
+type NodeChurnMetric struct {
+	Day               time.Time
+	NewNodes          int
+	ExitedNodes       int
+	DisqualifiedNodes int
+	ActiveNodes       int
+	FreeDisk          int64
+	CapacityChange    int64
+	UpdatedAt         time.Time
+}
+
+func (NodeChurnMetric) _Table() string { return "node_churn_metrics" }
+
+type NodeChurnMetric_Create_Fields struct {
+}
+
+type NodeChurnMetric_Update_Fields struct {
+	NewNodes          NodeChurnMetric_NewNodes_Field
+	ExitedNodes       NodeChurnMetric_ExitedNodes_Field
+	DisqualifiedNodes NodeChurnMetric_DisqualifiedNodes_Field
+	ActiveNodes       NodeChurnMetric_ActiveNodes_Field
+	FreeDisk          NodeChurnMetric_FreeDisk_Field
+	CapacityChange    NodeChurnMetric_CapacityChange_Field
+	UpdatedAt         NodeChurnMetric_UpdatedAt_Field
+}
+
+type NodeChurnMetric_Day_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func NodeChurnMetric_Day(v time.Time) NodeChurnMetric_Day_Field {
+	return NodeChurnMetric_Day_Field{_set: true, _value: v}
+}
+
+func (f NodeChurnMetric_Day_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (NodeChurnMetric_Day_Field) _Column() string { return "day" }
+
+type NodeChurnMetric_NewNodes_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func NodeChurnMetric_NewNodes(v int) NodeChurnMetric_NewNodes_Field {
+	return NodeChurnMetric_NewNodes_Field{_set: true, _value: v}
+}
+
+func (f NodeChurnMetric_NewNodes_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (NodeChurnMetric_NewNodes_Field) _Column() string { return "new_nodes" }
+
+type NodeChurnMetric_ExitedNodes_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func NodeChurnMetric_ExitedNodes(v int) NodeChurnMetric_ExitedNodes_Field {
+	return NodeChurnMetric_ExitedNodes_Field{_set: true, _value: v}
+}
+
+func (f NodeChurnMetric_ExitedNodes_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (NodeChurnMetric_ExitedNodes_Field) _Column() string { return "exited_nodes" }
+
+type NodeChurnMetric_DisqualifiedNodes_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func NodeChurnMetric_DisqualifiedNodes(v int) NodeChurnMetric_DisqualifiedNodes_Field {
+	return NodeChurnMetric_DisqualifiedNodes_Field{_set: true, _value: v}
+}
+
+func (f NodeChurnMetric_DisqualifiedNodes_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (NodeChurnMetric_DisqualifiedNodes_Field) _Column() string { return "disqualified_nodes" }
+
+type NodeChurnMetric_ActiveNodes_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func NodeChurnMetric_ActiveNodes(v int) NodeChurnMetric_ActiveNodes_Field {
+	return NodeChurnMetric_ActiveNodes_Field{_set: true, _value: v}
+}
+
+func (f NodeChurnMetric_ActiveNodes_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (NodeChurnMetric_ActiveNodes_Field) _Column() string { return "active_nodes" }
+
+type NodeChurnMetric_FreeDisk_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func NodeChurnMetric_FreeDisk(v int64) NodeChurnMetric_FreeDisk_Field {
+	return NodeChurnMetric_FreeDisk_Field{_set: true, _value: v}
+}
+
+func (f NodeChurnMetric_FreeDisk_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (NodeChurnMetric_FreeDisk_Field) _Column() string { return "free_disk" }
+
+type NodeChurnMetric_CapacityChange_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func NodeChurnMetric_CapacityChange(v int64) NodeChurnMetric_CapacityChange_Field {
+	return NodeChurnMetric_CapacityChange_Field{_set: true, _value: v}
+}
+
+func (f NodeChurnMetric_CapacityChange_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (NodeChurnMetric_CapacityChange_Field) _Column() string { return "capacity_change" }
+
+type NodeChurnMetric_UpdatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func NodeChurnMetric_UpdatedAt(v time.Time) NodeChurnMetric_UpdatedAt_Field {
+	return NodeChurnMetric_UpdatedAt_Field{_set: true, _value: v}
+}
+
+func (f NodeChurnMetric_UpdatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (NodeChurnMetric_UpdatedAt_Field) _Column() string { return "updated_at" }
+
+type NodeEvent struct {
+	Id            []byte
+	Email         string
+	NodeId        []byte
+	Event         int
+	CreatedAt     time.Time
+	LastAttempted *time.Time
+	EmailSent     *time.Time
+}
+
+func (NodeEvent) _Table() string { return "node_events" }
+
+type NodeEvent_Create_Fields struct {
+	CreatedAt     NodeEvent_CreatedAt_Field
+	LastAttempted NodeEvent_LastAttempted_Field
+	EmailSent     NodeEvent_EmailSent_Field
+}
+
+type NodeEvent_Update_Fields struct {
+	LastAttempted NodeEvent_LastAttempted_Field
+	EmailSent     NodeEvent_EmailSent_Field
+}
+
+type NodeEvent_Id_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func NodeEvent_Id(v []byte) NodeEvent_Id_Field {
+	return NodeEvent_Id_Field{_set: true, _value: v}
+}
+
+func (f NodeEvent_Id_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (NodeEvent_Id_Field) _Column() string { return "id" }
+
+type NodeEvent_Email_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func NodeEvent_Email(v string) NodeEvent_Email_Field {
+	return NodeEvent_Email_Field{_set: true, _value: v}
+}
+
+func (f NodeEvent_Email_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (NodeEvent_Email_Field) _Column() string { return "email" }
+
+type NodeEvent_NodeId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func NodeEvent_NodeId(v []byte) NodeEvent_NodeId_Field {
+	return NodeEvent_NodeId_Field{_set: true, _value: v}
+}
+
+func (f NodeEvent_NodeId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (NodeEvent_NodeId_Field) _Column() string { return "node_id" }
+
+type NodeEvent_Event_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func NodeEvent_Event(v int) NodeEvent_Event_Field {
+	return NodeEvent_Event_Field{_set: true, _value: v}
+}
+
+func (f NodeEvent_Event_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (NodeEvent_Event_Field) _Column() string { return "event" }
+
+type NodeEvent_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func NodeEvent_CreatedAt(v time.Time) NodeEvent_CreatedAt_Field {
+	return NodeEvent_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f NodeEvent_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (NodeEvent_CreatedAt_Field) _Column() string { return "created_at" }
+
+type NodeEvent_LastAttempted_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func NodeEvent_LastAttempted(v time.Time) NodeEvent_LastAttempted_Field {
+	return NodeEvent_LastAttempted_Field{_set: true, _value: &v}
+}
+
+func NodeEvent_LastAttempted_Raw(v *time.Time) NodeEvent_LastAttempted_Field {
+	if v == nil {
+		return NodeEvent_LastAttempted_Null()
+	}
+	return NodeEvent_LastAttempted(*v)
+}
+
+func NodeEvent_LastAttempted_Null() NodeEvent_LastAttempted_Field {
+	return NodeEvent_LastAttempted_Field{_set: true, _null: true}
+}
+
+func (f NodeEvent_LastAttempted_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f NodeEvent_LastAttempted_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (NodeEvent_LastAttempted_Field) _Column() string { return "last_attempted" }
+
+type NodeEvent_EmailSent_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func NodeEvent_EmailSent(v time.Time) NodeEvent_EmailSent_Field {
+	return NodeEvent_EmailSent_Field{_set: true, _value: &v}
+}
+
+func NodeEvent_EmailSent_Raw(v *time.Time) NodeEvent_EmailSent_Field {
+	if v == nil {
+		return NodeEvent_EmailSent_Null()
+	}
+	return NodeEvent_EmailSent(*v)
+}
+
+func NodeEvent_EmailSent_Null() NodeEvent_EmailSent_Field {
+	return NodeEvent_EmailSent_Field{_set: true, _null: true}
+}
+
+func (f NodeEvent_EmailSent_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f NodeEvent_EmailSent_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (NodeEvent_EmailSent_Field) _Column() string { return "email_sent" }
+
+type NodeInventory struct {
+	NodeId         []byte
+	Os             string
+	Arch           string
+	Runtime        string
+	StorageBackend string
+	Filesystem     string
+	UpdatedAt      time.Time
+}
+
+func (NodeInventory) _Table() string { return "node_inventories" }
+
+type NodeInventory_Create_Fields struct {
+}
+
+type NodeInventory_Update_Fields struct {
+}
+
+type NodeInventory_NodeId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func NodeInventory_NodeId(v []byte) NodeInventory_NodeId_Field {
+	return NodeInventory_NodeId_Field{_set: true, _value: v}
+}
+
+func (f NodeInventory_NodeId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (NodeInventory_NodeId_Field) _Column() string { return "node_id" }
+
+type NodeInventory_Os_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func NodeInventory_Os(v string) NodeInventory_Os_Field {
+	return NodeInventory_Os_Field{_set: true, _value: v}
+}
+
+func (f NodeInventory_Os_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (NodeInventory_Os_Field) _Column() string { return "os" }
+
+type NodeInventory_Arch_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func NodeInventory_Arch(v string) NodeInventory_Arch_Field {
+	return NodeInventory_Arch_Field{_set: true, _value: v}
+}
+
+func (f NodeInventory_Arch_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (NodeInventory_Arch_Field) _Column() string { return "arch" }
+
+type NodeInventory_Runtime_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func NodeInventory_Runtime(v string) NodeInventory_Runtime_Field {
+	return NodeInventory_Runtime_Field{_set: true, _value: v}
+}
+
+func (f NodeInventory_Runtime_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (NodeInventory_Runtime_Field) _Column() string { return "runtime" }
+
+type NodeInventory_StorageBackend_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func NodeInventory_StorageBackend(v string) NodeInventory_StorageBackend_Field {
+	return NodeInventory_StorageBackend_Field{_set: true, _value: v}
+}
+
+func (f NodeInventory_StorageBackend_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (NodeInventory_StorageBackend_Field) _Column() string { return "storage_backend" }
+
+type NodeInventory_Filesystem_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func NodeInventory_Filesystem(v string) NodeInventory_Filesystem_Field {
+	return NodeInventory_Filesystem_Field{_set: true, _value: v}
+}
+
+func (f NodeInventory_Filesystem_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (NodeInventory_Filesystem_Field) _Column() string { return "filesystem" }
+
+type NodeInventory_UpdatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func NodeInventory_UpdatedAt(v time.Time) NodeInventory_UpdatedAt_Field {
+	return NodeInventory_UpdatedAt_Field{_set: true, _value: v}
+}
+
+func (f NodeInventory_UpdatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (NodeInventory_UpdatedAt_Field) _Column() string { return "updated_at" }
+
+type NodePayoutContract struct {
+	NodeId        []byte
+	AtRestGbHours *string
+	GetTb         *string
+	PutTb         *string
+	GetRepairTb   *string
+	PutRepairTb   *string
+	GetAuditTb    *string
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+func (NodePayoutContract) _Table() string { return "node_payout_contracts" }
+
+type NodePayoutContract_Create_Fields struct {
+	NodeId        NodePayoutContract_NodeId_Field
+	AtRestGbHours NodePayoutContract_AtRestGbHours_Field
+	GetTb         NodePayoutContract_GetTb_Field
+	PutTb         NodePayoutContract_PutTb_Field
+	GetRepairTb   NodePayoutContract_GetRepairTb_Field
+	PutRepairTb   NodePayoutContract_PutRepairTb_Field
+	GetAuditTb    NodePayoutContract_GetAuditTb_Field
+}
+
+type NodePayoutContract_Update_Fields struct {
+	AtRestGbHours NodePayoutContract_AtRestGbHours_Field
+	GetTb         NodePayoutContract_GetTb_Field
+	PutTb         NodePayoutContract_PutTb_Field
+	GetRepairTb   NodePayoutContract_GetRepairTb_Field
+	PutRepairTb   NodePayoutContract_PutRepairTb_Field
+	GetAuditTb    NodePayoutContract_GetAuditTb_Field
+	UpdatedAt     NodePayoutContract_UpdatedAt_Field
+}
+
+type NodePayoutContract_NodeId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func NodePayoutContract_NodeId(v []byte) NodePayoutContract_NodeId_Field {
+	return NodePayoutContract_NodeId_Field{_set: true, _value: v}
+}
+
+func (f NodePayoutContract_NodeId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (NodePayoutContract_NodeId_Field) _Column() string { return "node_id" }
+
+type NodePayoutContract_AtRestGbHours_Field struct {
+	_set   bool
+	_null  bool
+	_value *string
+}
+
+func NodePayoutContract_AtRestGbHours(v string) NodePayoutContract_AtRestGbHours_Field {
+	return NodePayoutContract_AtRestGbHours_Field{_set: true, _value: &v}
+}
+
+func NodePayoutContract_AtRestGbHours_Raw(v *string) NodePayoutContract_AtRestGbHours_Field {
+	if v == nil {
+		return NodePayoutContract_AtRestGbHours_Null()
+	}
+	return NodePayoutContract_AtRestGbHours(*v)
+}
+
+func NodePayoutContract_AtRestGbHours_Null() NodePayoutContract_AtRestGbHours_Field {
+	return NodePayoutContract_AtRestGbHours_Field{_set: true, _null: true}
+}
+
+func (f NodePayoutContract_AtRestGbHours_Field) isnull() bool {
 	return !f._set || f._null || f._value == nil
 }
 
-func (f GracefulExitSegmentTransfer_LastFailedCode_Field) value() interface{} {
+func (f NodePayoutContract_AtRestGbHours_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (GracefulExitSegmentTransfer_LastFailedCode_Field) _Column() string { return "last_failed_code" }
+func (NodePayoutContract_AtRestGbHours_Field) _Column() string { return "at_rest_gb_hours" }
 
-type GracefulExitSegmentTransfer_FailedCount_Field struct {
+type NodePayoutContract_GetTb_Field struct {
 	_set   bool
 	_null  bool
-	_value *int
+	_value *string
 }
 
-func GracefulExitSegmentTransfer_FailedCount(v int) GracefulExitSegmentTransfer_FailedCount_Field {
-	return GracefulExitSegmentTransfer_FailedCount_Field{_set: true, _value: &v}
+func NodePayoutContract_GetTb(v string) NodePayoutContract_GetTb_Field {
+	return NodePayoutContract_GetTb_Field{_set: true, _value: &v}
 }
 
-func GracefulExitSegmentTransfer_FailedCount_Raw(v *int) GracefulExitSegmentTransfer_FailedCount_Field {
+func NodePayoutContract_GetTb_Raw(v *string) NodePayoutContract_GetTb_Field {
 	if v == nil {
-		return GracefulExitSegmentTransfer_FailedCount_Null()
+		return NodePayoutContract_GetTb_Null()
 	}
-	return GracefulExitSegmentTransfer_FailedCount(*v)
+	return NodePayoutContract_GetTb(*v)
 }
 
-func GracefulExitSegmentTransfer_FailedCount_Null() GracefulExitSegmentTransfer_FailedCount_Field {
-	return GracefulExitSegmentTransfer_FailedCount_Field{_set: true, _null: true}
+func NodePayoutContract_GetTb_Null() NodePayoutContract_GetTb_Field {
+	return NodePayoutContract_GetTb_Field{_set: true, _null: true}
 }
 
-func (f GracefulExitSegmentTransfer_FailedCount_Field) isnull() bool {
+func (f NodePayoutContract_GetTb_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f NodePayoutContract_GetTb_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (NodePayoutContract_GetTb_Field) _Column() string { return "get_tb" }
+
+type NodePayoutContract_PutTb_Field struct {
+	_set   bool
+	_null  bool
+	_value *string
+}
+
+func NodePayoutContract_PutTb(v string) NodePayoutContract_PutTb_Field {
+	return NodePayoutContract_PutTb_Field{_set: true, _value: &v}
+}
+
+func NodePayoutContract_PutTb_Raw(v *string) NodePayoutContract_PutTb_Field {
+	if v == nil {
+		return NodePayoutContract_PutTb_Null()
+	}
+	return NodePayoutContract_PutTb(*v)
+}
+
+func NodePayoutContract_PutTb_Null() NodePayoutContract_PutTb_Field {
+	return NodePayoutContract_PutTb_Field{_set: true, _null: true}
+}
+
+func (f NodePayoutContract_PutTb_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f NodePayoutContract_PutTb_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (NodePayoutContract_PutTb_Field) _Column() string { return "put_tb" }
+
+type NodePayoutContract_GetRepairTb_Field struct {
+	_set   bool
+	_null  bool
+	_value *string
+}
+
+func NodePayoutContract_GetRepairTb(v string) NodePayoutContract_GetRepairTb_Field {
+	return NodePayoutContract_GetRepairTb_Field{_set: true, _value: &v}
+}
+
+func NodePayoutContract_GetRepairTb_Raw(v *string) NodePayoutContract_GetRepairTb_Field {
+	if v == nil {
+		return NodePayoutContract_GetRepairTb_Null()
+	}
+	return NodePayoutContract_GetRepairTb(*v)
+}
+
+func NodePayoutContract_GetRepairTb_Null() NodePayoutContract_GetRepairTb_Field {
+	return NodePayoutContract_GetRepairTb_Field{_set: true, _null: true}
+}
+
+func (f NodePayoutContract_GetRepairTb_Field) isnull() bool {
 	return !f._set || f._null || f._value == nil
 }
 
-func (f GracefulExitSegmentTransfer_FailedCount_Field) value() interface{} {
+func (f NodePayoutContract_GetRepairTb_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (GracefulExitSegmentTransfer_FailedCount_Field) _Column() string { return "failed_count" }
+func (NodePayoutContract_GetRepairTb_Field) _Column() string { return "get_repair_tb" }
 
-type GracefulExitSegmentTransfer_FinishedAt_Field struct {
+type NodePayoutContract_PutRepairTb_Field struct {
 	_set   bool
 	_null  bool
-	_value *time.Time
+	_value *string
 }
 
-func GracefulExitSegmentTransfer_FinishedAt(v time.Time) GracefulExitSegmentTransfer_FinishedAt_Field {
-	return GracefulExitSegmentTransfer_FinishedAt_Field{_set: true, _value: &v}
+func NodePayoutContract_PutRepairTb(v string) NodePayoutContract_PutRepairTb_Field {
+	return NodePayoutContract_PutRepairTb_Field{_set: true, _value: &v}
 }
 
-func GracefulExitSegmentTransfer_FinishedAt_Raw(v *time.Time) GracefulExitSegmentTransfer_FinishedAt_Field {
+func NodePayoutContract_PutRepairTb_Raw(v *string) NodePayoutContract_PutRepairTb_Field {
 	if v == nil {
-		return GracefulExitSegmentTransfer_FinishedAt_Null()
+		return NodePayoutContract_PutRepairTb_Null()
 	}
-	return GracefulExitSegmentTransfer_FinishedAt(*v)
+	return NodePayoutContract_PutRepairTb(*v)
 }
 
-func GracefulExitSegmentTransfer_FinishedAt_Null() GracefulExitSegmentTransfer_FinishedAt_Field {
-	return GracefulExitSegmentTransfer_FinishedAt_Field{_set: true, _null: true}
+func NodePayoutContract_PutRepairTb_Null() NodePayoutContract_PutRepairTb_Field {
+	return NodePayoutContract_PutRepairTb_Field{_set: true, _null: true}
 }
 
-func (f GracefulExitSegmentTransfer_FinishedAt_Field) isnull() bool {
+func (f NodePayoutContract_PutRepairTb_Field) isnull() bool {
 	return !f._set || f._null || f._value == nil
 }
 
-func (f GracefulExitSegmentTransfer_FinishedAt_Field) value() interface{} {
+func (f NodePayoutContract_PutRepairTb_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (GracefulExitSegmentTransfer_FinishedAt_Field) _Column() string { return "finished_at" }
+func (NodePayoutContract_PutRepairTb_Field) _Column() string { return "put_repair_tb" }
 
-type GracefulExitSegmentTransfer_OrderLimitSendCount_Field struct {
+type NodePayoutContract_GetAuditTb_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value *string
 }
 
-func GracefulExitSegmentTransfer_OrderLimitSendCount(v int) GracefulExitSegmentTransfer_OrderLimitSendCount_Field {
-	return GracefulExitSegmentTransfer_OrderLimitSendCount_Field{_set: true, _value: v}
+func NodePayoutContract_GetAuditTb(v string) NodePayoutContract_GetAuditTb_Field {
+	return NodePayoutContract_GetAuditTb_Field{_set: true, _value: &v}
 }
 
-func (f GracefulExitSegmentTransfer_OrderLimitSendCount_Field) value() interface{} {
+func NodePayoutContract_GetAuditTb_Raw(v *string) NodePayoutContract_GetAuditTb_Field {
+	if v == nil {
+		return NodePayoutContract_GetAuditTb_Null()
+	}
+	return NodePayoutContract_GetAuditTb(*v)
+}
+
+func NodePayoutContract_GetAuditTb_Null() NodePayoutContract_GetAuditTb_Field {
+	return NodePayoutContract_GetAuditTb_Field{_set: true, _null: true}
+}
+
+func (f NodePayoutContract_GetAuditTb_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f NodePayoutContract_GetAuditTb_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (GracefulExitSegmentTransfer_OrderLimitSendCount_Field) _Column() string {
-	return "order_limit_send_count"
+func (NodePayoutContract_GetAuditTb_Field) _Column() string { return "get_audit_tb" }
+
+type NodePayoutContract_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
 }
 
-type Node struct {
-	Id                      []byte
-	Address                 string
-	LastNet                 string
-	LastIpPort              *string
-	CountryCode             *string
-	Protocol                int
-	Type                    int
-	Email                   string
-	Wallet                  string
-	WalletFeatures          string
-	FreeDisk                int64
-	PieceCount              int64
-	Major                   int64
-	Minor                   int64
-	Patch                   int64
-	Hash                    string
-	Timestamp               time.Time
-	Release                 bool
-	Latency90               int64
-	VettedAt                *time.Time
-	CreatedAt               time.Time
-	UpdatedAt               time.Time
-	LastContactSuccess      time.Time
-	LastContactFailure      time.Time
-	Disqualified            *time.Time
-	DisqualificationReason  *int
-	UnknownAuditSuspended   *time.Time
-	OfflineSuspended        *time.Time
-	UnderReview             *time.Time
-	ExitInitiatedAt         *time.Time
-	ExitLoopCompletedAt     *time.Time
-	ExitFinishedAt          *time.Time
-	ExitSuccess             bool
-	Contained               *time.Time
-	LastOfflineEmail        *time.Time
-	LastSoftwareUpdateEmail *time.Time
+func NodePayoutContract_CreatedAt(v time.Time) NodePayoutContract_CreatedAt_Field {
+	return NodePayoutContract_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (Node) _Table() string { return "nodes" }
+func (f NodePayoutContract_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
 
-type Node_Create_Fields struct {
-	Address                 Node_Address_Field
-	LastIpPort              Node_LastIpPort_Field
-	CountryCode             Node_CountryCode_Field
-	Protocol                Node_Protocol_Field
-	Type                    Node_Type_Field
-	WalletFeatures          Node_WalletFeatures_Field
-	FreeDisk                Node_FreeDisk_Field
-	Major                   Node_Major_Field
-	Minor                   Node_Minor_Field
-	Patch                   Node_Patch_Field
-	Hash                    Node_Hash_Field
-	Timestamp               Node_Timestamp_Field
-	Release                 Node_Release_Field
-	Latency90               Node_Latency90_Field
-	VettedAt                Node_VettedAt_Field
-	LastContactSuccess      Node_LastContactSuccess_Field
-	LastContactFailure      Node_LastContactFailure_Field
-	Disqualified            Node_Disqualified_Field
-	DisqualificationReason  Node_DisqualificationReason_Field
-	UnknownAuditSuspended   Node_UnknownAuditSuspended_Field
-	OfflineSuspended        Node_OfflineSuspended_Field
-	UnderReview             Node_UnderReview_Field
-	ExitInitiatedAt         Node_ExitInitiatedAt_Field
-	ExitLoopCompletedAt     Node_ExitLoopCompletedAt_Field
-	ExitFinishedAt          Node_ExitFinishedAt_Field
-	ExitSuccess             Node_ExitSuccess_Field
-	Contained               Node_Contained_Field
-	LastOfflineEmail        Node_LastOfflineEmail_Field
-	LastSoftwareUpdateEmail Node_LastSoftwareUpdateEmail_Field
+func (NodePayoutContract_CreatedAt_Field) _Column() string { return "created_at" }
+
+type NodePayoutContract_UpdatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
 }
 
-type Node_Update_Fields struct {
-	Address                 Node_Address_Field
-	LastNet                 Node_LastNet_Field
-	LastIpPort              Node_LastIpPort_Field
-	CountryCode             Node_CountryCode_Field
-	Protocol                Node_Protocol_Field
-	Type                    Node_Type_Field
-	Email                   Node_Email_Field
-	Wallet                  Node_Wallet_Field
-	WalletFeatures          Node_WalletFeatures_Field
-	FreeDisk                Node_FreeDisk_Field
-	PieceCount              Node_PieceCount_Field
-	Major                   Node_Major_Field
-	Minor                   Node_Minor_Field
-	Patch                   Node_Patch_Field
-	Hash                    Node_Hash_Field
-	Timestamp               Node_Timestamp_Field
-	Release                 Node_Release_Field
-	Latency90               Node_Latency90_Field
-	VettedAt                Node_VettedAt_Field
-	LastContactSuccess      Node_LastContactSuccess_Field
-	LastContactFailure      Node_LastContactFailure_Field
-	Disqualified            Node_Disqualified_Field
-	DisqualificationReason  Node_DisqualificationReason_Field
-	UnknownAuditSuspended   Node_UnknownAuditSuspended_Field
-	OfflineSuspended        Node_OfflineSuspended_Field
-	UnderReview             Node_UnderReview_Field
-	ExitInitiatedAt         Node_ExitInitiatedAt_Field
-	ExitLoopCompletedAt     Node_ExitLoopCompletedAt_Field
-	ExitFinishedAt          Node_ExitFinishedAt_Field
-	ExitSuccess             Node_ExitSuccess_Field
-	Contained               Node_Contained_Field
-	LastOfflineEmail        Node_LastOfflineEmail_Field
-	LastSoftwareUpdateEmail Node_LastSoftwareUpdateEmail_Field
+func NodePayoutContract_UpdatedAt(v time.Time) NodePayoutContract_UpdatedAt_Field {
+	return NodePayoutContract_UpdatedAt_Field{_set: true, _value: v}
 }
 
-type Node_Id_Field struct {
+func (f NodePayoutContract_UpdatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (NodePayoutContract_UpdatedAt_Field) _Column() string { return "updated_at" }
+
+type OauthClient struct {
+	Id              []byte
+	EncryptedSecret []byte
+	RedirectUrl     string
+	UserId          []byte
+	AppName         string
+	AppLogoUrl      string
+}
+
+func (OauthClient) _Table() string { return "oauth_clients" }
+
+type OauthClient_Update_Fields struct {
+	EncryptedSecret OauthClient_EncryptedSecret_Field
+	RedirectUrl     OauthClient_RedirectUrl_Field
+	AppName         OauthClient_AppName_Field
+	AppLogoUrl      OauthClient_AppLogoUrl_Field
+}
+
+type OauthClient_Id_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
-
-func Node_Id(v []byte) Node_Id_Field {
-	return Node_Id_Field{_set: true, _value: v}
+
+func OauthClient_Id(v []byte) OauthClient_Id_Field {
+	return OauthClient_Id_Field{_set: true, _value: v}
 }
 
-func (f Node_Id_Field) value() interface{} {
+func (f OauthClient_Id_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_Id_Field) _Column() string { return "id" }
+func (OauthClient_Id_Field) _Column() string { return "id" }
 
-type Node_Address_Field struct {
+type OauthClient_EncryptedSecret_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value []byte
 }
 
-func Node_Address(v string) Node_Address_Field {
-	return Node_Address_Field{_set: true, _value: v}
+func OauthClient_EncryptedSecret(v []byte) OauthClient_EncryptedSecret_Field {
+	return OauthClient_EncryptedSecret_Field{_set: true, _value: v}
 }
 
-func (f Node_Address_Field) value() interface{} {
+func (f OauthClient_EncryptedSecret_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_Address_Field) _Column() string { return "address" }
+func (OauthClient_EncryptedSecret_Field) _Column() string { return "encrypted_secret" }
 
-type Node_LastNet_Field struct {
+type OauthClient_RedirectUrl_Field struct {
 	_set   bool
 	_null  bool
 	_value string
 }
 
-func Node_LastNet(v string) Node_LastNet_Field {
-	return Node_LastNet_Field{_set: true, _value: v}
+func OauthClient_RedirectUrl(v string) OauthClient_RedirectUrl_Field {
+	return OauthClient_RedirectUrl_Field{_set: true, _value: v}
 }
 
-func (f Node_LastNet_Field) value() interface{} {
+func (f OauthClient_RedirectUrl_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_LastNet_Field) _Column() string { return "last_net" }
+func (OauthClient_RedirectUrl_Field) _Column() string { return "redirect_url" }
 
-type Node_LastIpPort_Field struct {
+type OauthClient_UserId_Field struct {
 	_set   bool
 	_null  bool
-	_value *string
-}
-
-func Node_LastIpPort(v string) Node_LastIpPort_Field {
-	return Node_LastIpPort_Field{_set: true, _value: &v}
-}
-
-func Node_LastIpPort_Raw(v *string) Node_LastIpPort_Field {
-	if v == nil {
-		return Node_LastIpPort_Null()
-	}
-	return Node_LastIpPort(*v)
+	_value []byte
 }
 
-func Node_LastIpPort_Null() Node_LastIpPort_Field {
-	return Node_LastIpPort_Field{_set: true, _null: true}
+func OauthClient_UserId(v []byte) OauthClient_UserId_Field {
+	return OauthClient_UserId_Field{_set: true, _value: v}
 }
 
-func (f Node_LastIpPort_Field) isnull() bool { return !f._set || f._null || f._value == nil }
-
-func (f Node_LastIpPort_Field) value() interface{} {
+func (f OauthClient_UserId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_LastIpPort_Field) _Column() string { return "last_ip_port" }
+func (OauthClient_UserId_Field) _Column() string { return "user_id" }
 
-type Node_CountryCode_Field struct {
+type OauthClient_AppName_Field struct {
 	_set   bool
 	_null  bool
-	_value *string
-}
-
-func Node_CountryCode(v string) Node_CountryCode_Field {
-	return Node_CountryCode_Field{_set: true, _value: &v}
-}
-
-func Node_CountryCode_Raw(v *string) Node_CountryCode_Field {
-	if v == nil {
-		return Node_CountryCode_Null()
-	}
-	return Node_CountryCode(*v)
+	_value string
 }
 
-func Node_CountryCode_Null() Node_CountryCode_Field {
-	return Node_CountryCode_Field{_set: true, _null: true}
+func OauthClient_AppName(v string) OauthClient_AppName_Field {
+	return OauthClient_AppName_Field{_set: true, _value: v}
 }
 
-func (f Node_CountryCode_Field) isnull() bool { return !f._set || f._null || f._value == nil }
-
-func (f Node_CountryCode_Field) value() interface{} {
+func (f OauthClient_AppName_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_CountryCode_Field) _Column() string { return "country_code" }
+func (OauthClient_AppName_Field) _Column() string { return "app_name" }
 
-type Node_Protocol_Field struct {
+type OauthClient_AppLogoUrl_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value string
 }
 
-func Node_Protocol(v int) Node_Protocol_Field {
-	return Node_Protocol_Field{_set: true, _value: v}
+func OauthClient_AppLogoUrl(v string) OauthClient_AppLogoUrl_Field {
+	return OauthClient_AppLogoUrl_Field{_set: true, _value: v}
 }
 
-func (f Node_Protocol_Field) value() interface{} {
+func (f OauthClient_AppLogoUrl_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_Protocol_Field) _Column() string { return "protocol" }
+func (OauthClient_AppLogoUrl_Field) _Column() string { return "app_logo_url" }
 
-type Node_Type_Field struct {
+type OauthCode struct {
+	ClientId        []byte
+	UserId          []byte
+	Scope           string
+	RedirectUrl     string
+	Challenge       string
+	ChallengeMethod string
+	Code            string
+	CreatedAt       time.Time
+	ExpiresAt       time.Time
+	ClaimedAt       *time.Time
+}
+
+func (OauthCode) _Table() string { return "oauth_codes" }
+
+type OauthCode_Create_Fields struct {
+	ClaimedAt OauthCode_ClaimedAt_Field
+}
+
+type OauthCode_Update_Fields struct {
+	ClaimedAt OauthCode_ClaimedAt_Field
+}
+
+type OauthCode_ClientId_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value []byte
 }
 
-func Node_Type(v int) Node_Type_Field {
-	return Node_Type_Field{_set: true, _value: v}
+func OauthCode_ClientId(v []byte) OauthCode_ClientId_Field {
+	return OauthCode_ClientId_Field{_set: true, _value: v}
 }
 
-func (f Node_Type_Field) value() interface{} {
+func (f OauthCode_ClientId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_Type_Field) _Column() string { return "type" }
+func (OauthCode_ClientId_Field) _Column() string { return "client_id" }
 
-type Node_Email_Field struct {
+type OauthCode_UserId_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value []byte
 }
 
-func Node_Email(v string) Node_Email_Field {
-	return Node_Email_Field{_set: true, _value: v}
+func OauthCode_UserId(v []byte) OauthCode_UserId_Field {
+	return OauthCode_UserId_Field{_set: true, _value: v}
 }
 
-func (f Node_Email_Field) value() interface{} {
+func (f OauthCode_UserId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_Email_Field) _Column() string { return "email" }
+func (OauthCode_UserId_Field) _Column() string { return "user_id" }
 
-type Node_Wallet_Field struct {
+type OauthCode_Scope_Field struct {
 	_set   bool
 	_null  bool
 	_value string
 }
 
-func Node_Wallet(v string) Node_Wallet_Field {
-	return Node_Wallet_Field{_set: true, _value: v}
+func OauthCode_Scope(v string) OauthCode_Scope_Field {
+	return OauthCode_Scope_Field{_set: true, _value: v}
 }
 
-func (f Node_Wallet_Field) value() interface{} {
+func (f OauthCode_Scope_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_Wallet_Field) _Column() string { return "wallet" }
+func (OauthCode_Scope_Field) _Column() string { return "scope" }
 
-type Node_WalletFeatures_Field struct {
+type OauthCode_RedirectUrl_Field struct {
 	_set   bool
 	_null  bool
 	_value string
 }
 
-func Node_WalletFeatures(v string) Node_WalletFeatures_Field {
-	return Node_WalletFeatures_Field{_set: true, _value: v}
+func OauthCode_RedirectUrl(v string) OauthCode_RedirectUrl_Field {
+	return OauthCode_RedirectUrl_Field{_set: true, _value: v}
 }
 
-func (f Node_WalletFeatures_Field) value() interface{} {
+func (f OauthCode_RedirectUrl_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_WalletFeatures_Field) _Column() string { return "wallet_features" }
+func (OauthCode_RedirectUrl_Field) _Column() string { return "redirect_url" }
 
-type Node_FreeDisk_Field struct {
+type OauthCode_Challenge_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value string
 }
 
-func Node_FreeDisk(v int64) Node_FreeDisk_Field {
-	return Node_FreeDisk_Field{_set: true, _value: v}
+func OauthCode_Challenge(v string) OauthCode_Challenge_Field {
+	return OauthCode_Challenge_Field{_set: true, _value: v}
 }
 
-func (f Node_FreeDisk_Field) value() interface{} {
+func (f OauthCode_Challenge_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_FreeDisk_Field) _Column() string { return "free_disk" }
+func (OauthCode_Challenge_Field) _Column() string { return "challenge" }
 
-type Node_PieceCount_Field struct {
+type OauthCode_ChallengeMethod_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value string
 }
 
-func Node_PieceCount(v int64) Node_PieceCount_Field {
-	return Node_PieceCount_Field{_set: true, _value: v}
+func OauthCode_ChallengeMethod(v string) OauthCode_ChallengeMethod_Field {
+	return OauthCode_ChallengeMethod_Field{_set: true, _value: v}
 }
 
-func (f Node_PieceCount_Field) value() interface{} {
+func (f OauthCode_ChallengeMethod_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_PieceCount_Field) _Column() string { return "piece_count" }
+func (OauthCode_ChallengeMethod_Field) _Column() string { return "challenge_method" }
 
-type Node_Major_Field struct {
+type OauthCode_Code_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value string
 }
 
-func Node_Major(v int64) Node_Major_Field {
-	return Node_Major_Field{_set: true, _value: v}
+func OauthCode_Code(v string) OauthCode_Code_Field {
+	return OauthCode_Code_Field{_set: true, _value: v}
 }
 
-func (f Node_Major_Field) value() interface{} {
+func (f OauthCode_Code_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_Major_Field) _Column() string { return "major" }
+func (OauthCode_Code_Field) _Column() string { return "code" }
 
-type Node_Minor_Field struct {
+type OauthCode_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value time.Time
 }
 
-func Node_Minor(v int64) Node_Minor_Field {
-	return Node_Minor_Field{_set: true, _value: v}
+func OauthCode_CreatedAt(v time.Time) OauthCode_CreatedAt_Field {
+	return OauthCode_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f Node_Minor_Field) value() interface{} {
+func (f OauthCode_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_Minor_Field) _Column() string { return "minor" }
+func (OauthCode_CreatedAt_Field) _Column() string { return "created_at" }
 
-type Node_Patch_Field struct {
+type OauthCode_ExpiresAt_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value time.Time
 }
 
-func Node_Patch(v int64) Node_Patch_Field {
-	return Node_Patch_Field{_set: true, _value: v}
+func OauthCode_ExpiresAt(v time.Time) OauthCode_ExpiresAt_Field {
+	return OauthCode_ExpiresAt_Field{_set: true, _value: v}
 }
 
-func (f Node_Patch_Field) value() interface{} {
+func (f OauthCode_ExpiresAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_Patch_Field) _Column() string { return "patch" }
+func (OauthCode_ExpiresAt_Field) _Column() string { return "expires_at" }
 
-type Node_Hash_Field struct {
+type OauthCode_ClaimedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value *time.Time
 }
 
-func Node_Hash(v string) Node_Hash_Field {
-	return Node_Hash_Field{_set: true, _value: v}
+func OauthCode_ClaimedAt(v time.Time) OauthCode_ClaimedAt_Field {
+	return OauthCode_ClaimedAt_Field{_set: true, _value: &v}
 }
 
-func (f Node_Hash_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
+func OauthCode_ClaimedAt_Raw(v *time.Time) OauthCode_ClaimedAt_Field {
+	if v == nil {
+		return OauthCode_ClaimedAt_Null()
 	}
-	return f._value
+	return OauthCode_ClaimedAt(*v)
 }
 
-func (Node_Hash_Field) _Column() string { return "hash" }
-
-type Node_Timestamp_Field struct {
-	_set   bool
-	_null  bool
-	_value time.Time
+func OauthCode_ClaimedAt_Null() OauthCode_ClaimedAt_Field {
+	return OauthCode_ClaimedAt_Field{_set: true, _null: true}
 }
 
-func Node_Timestamp(v time.Time) Node_Timestamp_Field {
-	return Node_Timestamp_Field{_set: true, _value: v}
-}
+func (f OauthCode_ClaimedAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f Node_Timestamp_Field) value() interface{} {
+func (f OauthCode_ClaimedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_Timestamp_Field) _Column() string { return "timestamp" }
+func (OauthCode_ClaimedAt_Field) _Column() string { return "claimed_at" }
+
+type OauthToken struct {
+	ClientId  []byte
+	UserId    []byte
+	Scope     string
+	Kind      int
+	Token     []byte
+	CreatedAt time.Time
+	ExpiresAt time.Time
+}
+
+func (OauthToken) _Table() string { return "oauth_tokens" }
 
-type Node_Release_Field struct {
+type OauthToken_Update_Fields struct {
+	ExpiresAt OauthToken_ExpiresAt_Field
+}
+
+type OauthToken_ClientId_Field struct {
 	_set   bool
 	_null  bool
-	_value bool
+	_value []byte
 }
 
-func Node_Release(v bool) Node_Release_Field {
-	return Node_Release_Field{_set: true, _value: v}
+func OauthToken_ClientId(v []byte) OauthToken_ClientId_Field {
+	return OauthToken_ClientId_Field{_set: true, _value: v}
 }
 
-func (f Node_Release_Field) value() interface{} {
+func (f OauthToken_ClientId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_Release_Field) _Column() string { return "release" }
+func (OauthToken_ClientId_Field) _Column() string { return "client_id" }
 
-type Node_Latency90_Field struct {
+type OauthToken_UserId_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value []byte
 }
 
-func Node_Latency90(v int64) Node_Latency90_Field {
-	return Node_Latency90_Field{_set: true, _value: v}
+func OauthToken_UserId(v []byte) OauthToken_UserId_Field {
+	return OauthToken_UserId_Field{_set: true, _value: v}
 }
 
-func (f Node_Latency90_Field) value() interface{} {
+func (f OauthToken_UserId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_Latency90_Field) _Column() string { return "latency_90" }
+func (OauthToken_UserId_Field) _Column() string { return "user_id" }
 
-type Node_VettedAt_Field struct {
+type OauthToken_Scope_Field struct {
 	_set   bool
 	_null  bool
-	_value *time.Time
-}
-
-func Node_VettedAt(v time.Time) Node_VettedAt_Field {
-	return Node_VettedAt_Field{_set: true, _value: &v}
-}
-
-func Node_VettedAt_Raw(v *time.Time) Node_VettedAt_Field {
-	if v == nil {
-		return Node_VettedAt_Null()
-	}
-	return Node_VettedAt(*v)
+	_value string
 }
 
-func Node_VettedAt_Null() Node_VettedAt_Field {
-	return Node_VettedAt_Field{_set: true, _null: true}
+func OauthToken_Scope(v string) OauthToken_Scope_Field {
+	return OauthToken_Scope_Field{_set: true, _value: v}
 }
 
-func (f Node_VettedAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
-
-func (f Node_VettedAt_Field) value() interface{} {
+func (f OauthToken_Scope_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_VettedAt_Field) _Column() string { return "vetted_at" }
+func (OauthToken_Scope_Field) _Column() string { return "scope" }
 
-type Node_CreatedAt_Field struct {
+type OauthToken_Kind_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value int
 }
 
-func Node_CreatedAt(v time.Time) Node_CreatedAt_Field {
-	return Node_CreatedAt_Field{_set: true, _value: v}
+func OauthToken_Kind(v int) OauthToken_Kind_Field {
+	return OauthToken_Kind_Field{_set: true, _value: v}
 }
 
-func (f Node_CreatedAt_Field) value() interface{} {
+func (f OauthToken_Kind_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_CreatedAt_Field) _Column() string { return "created_at" }
+func (OauthToken_Kind_Field) _Column() string { return "kind" }
 
-type Node_UpdatedAt_Field struct {
+type OauthToken_Token_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value []byte
 }
 
-func Node_UpdatedAt(v time.Time) Node_UpdatedAt_Field {
-	return Node_UpdatedAt_Field{_set: true, _value: v}
+func OauthToken_Token(v []byte) OauthToken_Token_Field {
+	return OauthToken_Token_Field{_set: true, _value: v}
 }
 
-func (f Node_UpdatedAt_Field) value() interface{} {
+func (f OauthToken_Token_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_UpdatedAt_Field) _Column() string { return "updated_at" }
+func (OauthToken_Token_Field) _Column() string { return "token" }
 
-type Node_LastContactSuccess_Field struct {
+type OauthToken_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
 	_value time.Time
 }
 
-func Node_LastContactSuccess(v time.Time) Node_LastContactSuccess_Field {
-	return Node_LastContactSuccess_Field{_set: true, _value: v}
+func OauthToken_CreatedAt(v time.Time) OauthToken_CreatedAt_Field {
+	return OauthToken_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f Node_LastContactSuccess_Field) value() interface{} {
+func (f OauthToken_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_LastContactSuccess_Field) _Column() string { return "last_contact_success" }
+func (OauthToken_CreatedAt_Field) _Column() string { return "created_at" }
 
-type Node_LastContactFailure_Field struct {
+type OauthToken_ExpiresAt_Field struct {
 	_set   bool
 	_null  bool
 	_value time.Time
 }
 
-func Node_LastContactFailure(v time.Time) Node_LastContactFailure_Field {
-	return Node_LastContactFailure_Field{_set: true, _value: v}
+func OauthToken_ExpiresAt(v time.Time) OauthToken_ExpiresAt_Field {
+	return OauthToken_ExpiresAt_Field{_set: true, _value: v}
 }
 
-func (f Node_LastContactFailure_Field) value() interface{} {
+func (f OauthToken_ExpiresAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_LastContactFailure_Field) _Column() string { return "last_contact_failure" }
+func (OauthToken_ExpiresAt_Field) _Column() string { return "expires_at" }
 
-type Node_Disqualified_Field struct {
-	_set   bool
-	_null  bool
-	_value *time.Time
+type Offer struct {
+	Id                        int
+	Name                      string
+	Description               string
+	AwardCreditInCents        int
+	InviteeCreditInCents      int
+	AwardCreditDurationDays   *int
+	InviteeCreditDurationDays *int
+	RedeemableCap             *int
+	ExpiresAt                 time.Time
+	CreatedAt                 time.Time
+	Status                    int
+	Type                      int
 }
 
-func Node_Disqualified(v time.Time) Node_Disqualified_Field {
-	return Node_Disqualified_Field{_set: true, _value: &v}
+func (Offer) _Table() string { return "offers" }
+
+type Offer_Create_Fields struct {
+	AwardCreditInCents        Offer_AwardCreditInCents_Field
+	InviteeCreditInCents      Offer_InviteeCreditInCents_Field
+	AwardCreditDurationDays   Offer_AwardCreditDurationDays_Field
+	InviteeCreditDurationDays Offer_InviteeCreditDurationDays_Field
+	RedeemableCap             Offer_RedeemableCap_Field
 }
 
-func Node_Disqualified_Raw(v *time.Time) Node_Disqualified_Field {
-	if v == nil {
-		return Node_Disqualified_Null()
-	}
-	return Node_Disqualified(*v)
+type Offer_Update_Fields struct {
+	Name                      Offer_Name_Field
+	Description               Offer_Description_Field
+	AwardCreditInCents        Offer_AwardCreditInCents_Field
+	InviteeCreditInCents      Offer_InviteeCreditInCents_Field
+	AwardCreditDurationDays   Offer_AwardCreditDurationDays_Field
+	InviteeCreditDurationDays Offer_InviteeCreditDurationDays_Field
+	RedeemableCap             Offer_RedeemableCap_Field
+	ExpiresAt                 Offer_ExpiresAt_Field
+	Status                    Offer_Status_Field
+	Type                      Offer_Type_Field
 }
 
-func Node_Disqualified_Null() Node_Disqualified_Field {
-	return Node_Disqualified_Field{_set: true, _null: true}
+type Offer_Id_Field struct {
+	_set   bool
+	_null  bool
+	_value int
 }
 
-func (f Node_Disqualified_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func Offer_Id(v int) Offer_Id_Field {
+	return Offer_Id_Field{_set: true, _value: v}
+}
 
-func (f Node_Disqualified_Field) value() interface{} {
+func (f Offer_Id_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_Disqualified_Field) _Column() string { return "disqualified" }
+func (Offer_Id_Field) _Column() string { return "id" }
 
-type Node_DisqualificationReason_Field struct {
+type Offer_Name_Field struct {
 	_set   bool
 	_null  bool
-	_value *int
-}
-
-func Node_DisqualificationReason(v int) Node_DisqualificationReason_Field {
-	return Node_DisqualificationReason_Field{_set: true, _value: &v}
-}
-
-func Node_DisqualificationReason_Raw(v *int) Node_DisqualificationReason_Field {
-	if v == nil {
-		return Node_DisqualificationReason_Null()
-	}
-	return Node_DisqualificationReason(*v)
-}
-
-func Node_DisqualificationReason_Null() Node_DisqualificationReason_Field {
-	return Node_DisqualificationReason_Field{_set: true, _null: true}
+	_value string
 }
 
-func (f Node_DisqualificationReason_Field) isnull() bool {
-	return !f._set || f._null || f._value == nil
+func Offer_Name(v string) Offer_Name_Field {
+	return Offer_Name_Field{_set: true, _value: v}
 }
 
-func (f Node_DisqualificationReason_Field) value() interface{} {
+func (f Offer_Name_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_DisqualificationReason_Field) _Column() string { return "disqualification_reason" }
+func (Offer_Name_Field) _Column() string { return "name" }
 
-type Node_UnknownAuditSuspended_Field struct {
+type Offer_Description_Field struct {
 	_set   bool
 	_null  bool
-	_value *time.Time
-}
-
-func Node_UnknownAuditSuspended(v time.Time) Node_UnknownAuditSuspended_Field {
-	return Node_UnknownAuditSuspended_Field{_set: true, _value: &v}
-}
-
-func Node_UnknownAuditSuspended_Raw(v *time.Time) Node_UnknownAuditSuspended_Field {
-	if v == nil {
-		return Node_UnknownAuditSuspended_Null()
-	}
-	return Node_UnknownAuditSuspended(*v)
+	_value string
 }
 
-func Node_UnknownAuditSuspended_Null() Node_UnknownAuditSuspended_Field {
-	return Node_UnknownAuditSuspended_Field{_set: true, _null: true}
+func Offer_Description(v string) Offer_Description_Field {
+	return Offer_Description_Field{_set: true, _value: v}
 }
 
-func (f Node_UnknownAuditSuspended_Field) isnull() bool { return !f._set || f._null || f._value == nil }
-
-func (f Node_UnknownAuditSuspended_Field) value() interface{} {
+func (f Offer_Description_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_UnknownAuditSuspended_Field) _Column() string { return "unknown_audit_suspended" }
+func (Offer_Description_Field) _Column() string { return "description" }
 
-type Node_OfflineSuspended_Field struct {
+type Offer_AwardCreditInCents_Field struct {
 	_set   bool
 	_null  bool
-	_value *time.Time
-}
-
-func Node_OfflineSuspended(v time.Time) Node_OfflineSuspended_Field {
-	return Node_OfflineSuspended_Field{_set: true, _value: &v}
-}
-
-func Node_OfflineSuspended_Raw(v *time.Time) Node_OfflineSuspended_Field {
-	if v == nil {
-		return Node_OfflineSuspended_Null()
-	}
-	return Node_OfflineSuspended(*v)
+	_value int
 }
 
-func Node_OfflineSuspended_Null() Node_OfflineSuspended_Field {
-	return Node_OfflineSuspended_Field{_set: true, _null: true}
+func Offer_AwardCreditInCents(v int) Offer_AwardCreditInCents_Field {
+	return Offer_AwardCreditInCents_Field{_set: true, _value: v}
 }
 
-func (f Node_OfflineSuspended_Field) isnull() bool { return !f._set || f._null || f._value == nil }
-
-func (f Node_OfflineSuspended_Field) value() interface{} {
+func (f Offer_AwardCreditInCents_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_OfflineSuspended_Field) _Column() string { return "offline_suspended" }
+func (Offer_AwardCreditInCents_Field) _Column() string { return "award_credit_in_cents" }
 
-type Node_UnderReview_Field struct {
+type Offer_InviteeCreditInCents_Field struct {
 	_set   bool
 	_null  bool
-	_value *time.Time
-}
-
-func Node_UnderReview(v time.Time) Node_UnderReview_Field {
-	return Node_UnderReview_Field{_set: true, _value: &v}
-}
-
-func Node_UnderReview_Raw(v *time.Time) Node_UnderReview_Field {
-	if v == nil {
-		return Node_UnderReview_Null()
-	}
-	return Node_UnderReview(*v)
+	_value int
 }
 
-func Node_UnderReview_Null() Node_UnderReview_Field {
-	return Node_UnderReview_Field{_set: true, _null: true}
+func Offer_InviteeCreditInCents(v int) Offer_InviteeCreditInCents_Field {
+	return Offer_InviteeCreditInCents_Field{_set: true, _value: v}
 }
 
-func (f Node_UnderReview_Field) isnull() bool { return !f._set || f._null || f._value == nil }
-
-func (f Node_UnderReview_Field) value() interface{} {
+func (f Offer_InviteeCreditInCents_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_UnderReview_Field) _Column() string { return "under_review" }
+func (Offer_InviteeCreditInCents_Field) _Column() string { return "invitee_credit_in_cents" }
 
-type Node_ExitInitiatedAt_Field struct {
+type Offer_AwardCreditDurationDays_Field struct {
 	_set   bool
 	_null  bool
-	_value *time.Time
+	_value *int
 }
 
-func Node_ExitInitiatedAt(v time.Time) Node_ExitInitiatedAt_Field {
-	return Node_ExitInitiatedAt_Field{_set: true, _value: &v}
+func Offer_AwardCreditDurationDays(v int) Offer_AwardCreditDurationDays_Field {
+	return Offer_AwardCreditDurationDays_Field{_set: true, _value: &v}
 }
 
-func Node_ExitInitiatedAt_Raw(v *time.Time) Node_ExitInitiatedAt_Field {
+func Offer_AwardCreditDurationDays_Raw(v *int) Offer_AwardCreditDurationDays_Field {
 	if v == nil {
-		return Node_ExitInitiatedAt_Null()
+		return Offer_AwardCreditDurationDays_Null()
 	}
-	return Node_ExitInitiatedAt(*v)
+	return Offer_AwardCreditDurationDays(*v)
 }
 
-func Node_ExitInitiatedAt_Null() Node_ExitInitiatedAt_Field {
-	return Node_ExitInitiatedAt_Field{_set: true, _null: true}
+func Offer_AwardCreditDurationDays_Null() Offer_AwardCreditDurationDays_Field {
+	return Offer_AwardCreditDurationDays_Field{_set: true, _null: true}
 }
 
-func (f Node_ExitInitiatedAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func (f Offer_AwardCreditDurationDays_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
 
-func (f Node_ExitInitiatedAt_Field) value() interface{} {
+func (f Offer_AwardCreditDurationDays_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_ExitInitiatedAt_Field) _Column() string { return "exit_initiated_at" }
+func (Offer_AwardCreditDurationDays_Field) _Column() string { return "award_credit_duration_days" }
 
-type Node_ExitLoopCompletedAt_Field struct {
+type Offer_InviteeCreditDurationDays_Field struct {
 	_set   bool
 	_null  bool
-	_value *time.Time
+	_value *int
 }
 
-func Node_ExitLoopCompletedAt(v time.Time) Node_ExitLoopCompletedAt_Field {
-	return Node_ExitLoopCompletedAt_Field{_set: true, _value: &v}
+func Offer_InviteeCreditDurationDays(v int) Offer_InviteeCreditDurationDays_Field {
+	return Offer_InviteeCreditDurationDays_Field{_set: true, _value: &v}
 }
 
-func Node_ExitLoopCompletedAt_Raw(v *time.Time) Node_ExitLoopCompletedAt_Field {
+func Offer_InviteeCreditDurationDays_Raw(v *int) Offer_InviteeCreditDurationDays_Field {
 	if v == nil {
-		return Node_ExitLoopCompletedAt_Null()
+		return Offer_InviteeCreditDurationDays_Null()
 	}
-	return Node_ExitLoopCompletedAt(*v)
+	return Offer_InviteeCreditDurationDays(*v)
 }
 
-func Node_ExitLoopCompletedAt_Null() Node_ExitLoopCompletedAt_Field {
-	return Node_ExitLoopCompletedAt_Field{_set: true, _null: true}
+func Offer_InviteeCreditDurationDays_Null() Offer_InviteeCreditDurationDays_Field {
+	return Offer_InviteeCreditDurationDays_Field{_set: true, _null: true}
 }
 
-func (f Node_ExitLoopCompletedAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func (f Offer_InviteeCreditDurationDays_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
 
-func (f Node_ExitLoopCompletedAt_Field) value() interface{} {
+func (f Offer_InviteeCreditDurationDays_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_ExitLoopCompletedAt_Field) _Column() string { return "exit_loop_completed_at" }
+func (Offer_InviteeCreditDurationDays_Field) _Column() string { return "invitee_credit_duration_days" }
 
-type Node_ExitFinishedAt_Field struct {
+type Offer_RedeemableCap_Field struct {
 	_set   bool
 	_null  bool
-	_value *time.Time
+	_value *int
 }
 
-func Node_ExitFinishedAt(v time.Time) Node_ExitFinishedAt_Field {
-	return Node_ExitFinishedAt_Field{_set: true, _value: &v}
+func Offer_RedeemableCap(v int) Offer_RedeemableCap_Field {
+	return Offer_RedeemableCap_Field{_set: true, _value: &v}
 }
 
-func Node_ExitFinishedAt_Raw(v *time.Time) Node_ExitFinishedAt_Field {
+func Offer_RedeemableCap_Raw(v *int) Offer_RedeemableCap_Field {
 	if v == nil {
-		return Node_ExitFinishedAt_Null()
+		return Offer_RedeemableCap_Null()
 	}
-	return Node_ExitFinishedAt(*v)
+	return Offer_RedeemableCap(*v)
 }
 
-func Node_ExitFinishedAt_Null() Node_ExitFinishedAt_Field {
-	return Node_ExitFinishedAt_Field{_set: true, _null: true}
+func Offer_RedeemableCap_Null() Offer_RedeemableCap_Field {
+	return Offer_RedeemableCap_Field{_set: true, _null: true}
 }
 
-func (f Node_ExitFinishedAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func (f Offer_RedeemableCap_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f Node_ExitFinishedAt_Field) value() interface{} {
+func (f Offer_RedeemableCap_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_ExitFinishedAt_Field) _Column() string { return "exit_finished_at" }
+func (Offer_RedeemableCap_Field) _Column() string { return "redeemable_cap" }
 
-type Node_ExitSuccess_Field struct {
+type Offer_ExpiresAt_Field struct {
 	_set   bool
 	_null  bool
-	_value bool
+	_value time.Time
 }
 
-func Node_ExitSuccess(v bool) Node_ExitSuccess_Field {
-	return Node_ExitSuccess_Field{_set: true, _value: v}
+func Offer_ExpiresAt(v time.Time) Offer_ExpiresAt_Field {
+	return Offer_ExpiresAt_Field{_set: true, _value: v}
 }
 
-func (f Node_ExitSuccess_Field) value() interface{} {
+func (f Offer_ExpiresAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_ExitSuccess_Field) _Column() string { return "exit_success" }
+func (Offer_ExpiresAt_Field) _Column() string { return "expires_at" }
 
-type Node_Contained_Field struct {
+type Offer_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value *time.Time
-}
-
-func Node_Contained(v time.Time) Node_Contained_Field {
-	return Node_Contained_Field{_set: true, _value: &v}
-}
-
-func Node_Contained_Raw(v *time.Time) Node_Contained_Field {
-	if v == nil {
-		return Node_Contained_Null()
-	}
-	return Node_Contained(*v)
+	_value time.Time
 }
 
-func Node_Contained_Null() Node_Contained_Field {
-	return Node_Contained_Field{_set: true, _null: true}
+func Offer_CreatedAt(v time.Time) Offer_CreatedAt_Field {
+	return Offer_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f Node_Contained_Field) isnull() bool { return !f._set || f._null || f._value == nil }
-
-func (f Node_Contained_Field) value() interface{} {
+func (f Offer_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_Contained_Field) _Column() string { return "contained" }
+func (Offer_CreatedAt_Field) _Column() string { return "created_at" }
 
-type Node_LastOfflineEmail_Field struct {
+type Offer_Status_Field struct {
 	_set   bool
 	_null  bool
-	_value *time.Time
-}
-
-func Node_LastOfflineEmail(v time.Time) Node_LastOfflineEmail_Field {
-	return Node_LastOfflineEmail_Field{_set: true, _value: &v}
-}
-
-func Node_LastOfflineEmail_Raw(v *time.Time) Node_LastOfflineEmail_Field {
-	if v == nil {
-		return Node_LastOfflineEmail_Null()
-	}
-	return Node_LastOfflineEmail(*v)
+	_value int
 }
 
-func Node_LastOfflineEmail_Null() Node_LastOfflineEmail_Field {
-	return Node_LastOfflineEmail_Field{_set: true, _null: true}
+func Offer_Status(v int) Offer_Status_Field {
+	return Offer_Status_Field{_set: true, _value: v}
 }
 
-func (f Node_LastOfflineEmail_Field) isnull() bool { return !f._set || f._null || f._value == nil }
-
-func (f Node_LastOfflineEmail_Field) value() interface{} {
+func (f Offer_Status_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_LastOfflineEmail_Field) _Column() string { return "last_offline_email" }
+func (Offer_Status_Field) _Column() string { return "status" }
 
-type Node_LastSoftwareUpdateEmail_Field struct {
+type Offer_Type_Field struct {
 	_set   bool
 	_null  bool
-	_value *time.Time
-}
-
-func Node_LastSoftwareUpdateEmail(v time.Time) Node_LastSoftwareUpdateEmail_Field {
-	return Node_LastSoftwareUpdateEmail_Field{_set: true, _value: &v}
-}
-
-func Node_LastSoftwareUpdateEmail_Raw(v *time.Time) Node_LastSoftwareUpdateEmail_Field {
-	if v == nil {
-		return Node_LastSoftwareUpdateEmail_Null()
-	}
-	return Node_LastSoftwareUpdateEmail(*v)
-}
-
-func Node_LastSoftwareUpdateEmail_Null() Node_LastSoftwareUpdateEmail_Field {
-	return Node_LastSoftwareUpdateEmail_Field{_set: true, _null: true}
+	_value int
 }
 
-func (f Node_LastSoftwareUpdateEmail_Field) isnull() bool {
-	return !f._set || f._null || f._value == nil
+func Offer_Type(v int) Offer_Type_Field {
+	return Offer_Type_Field{_set: true, _value: v}
 }
 
-func (f Node_LastSoftwareUpdateEmail_Field) value() interface{} {
+func (f Offer_Type_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_LastSoftwareUpdateEmail_Field) _Column() string { return "last_software_update_email" }
+func (Offer_Type_Field) _Column() string { return "type" }
 
-type NodeApiVersion struct {
-	Id         []byte
-	ApiVersion int
-	CreatedAt  time.Time
-	UpdatedAt  time.Time
+type PayoutBatch struct {
+	Id              []byte
+	Period          string
+	Network         string
+	Status          string
+	TransactionHash *string
+	CreatedAt       time.Time
+	SubmittedAt     *time.Time
+	ConfirmedAt     *time.Time
 }
 
-func (NodeApiVersion) _Table() string { return "node_api_versions" }
+func (PayoutBatch) _Table() string { return "payout_batches" }
 
-type NodeApiVersion_Update_Fields struct {
-	ApiVersion NodeApiVersion_ApiVersion_Field
+type PayoutBatch_Create_Fields struct {
+	TransactionHash PayoutBatch_TransactionHash_Field
+	SubmittedAt     PayoutBatch_SubmittedAt_Field
+	ConfirmedAt     PayoutBatch_ConfirmedAt_Field
 }
 
-type NodeApiVersion_Id_Field struct {
+type PayoutBatch_Update_Fields struct {
+	Status          PayoutBatch_Status_Field
+	TransactionHash PayoutBatch_TransactionHash_Field
+	SubmittedAt     PayoutBatch_SubmittedAt_Field
+	ConfirmedAt     PayoutBatch_ConfirmedAt_Field
+}
+
+type PayoutBatch_Id_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func NodeApiVersion_Id(v []byte) NodeApiVersion_Id_Field {
-	return NodeApiVersion_Id_Field{_set: true, _value: v}
+func PayoutBatch_Id(v []byte) PayoutBatch_Id_Field {
+	return PayoutBatch_Id_Field{_set: true, _value: v}
 }
 
-func (f NodeApiVersion_Id_Field) value() interface{} {
+func (f PayoutBatch_Id_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (NodeApiVersion_Id_Field) _Column() string { return "id" }
+func (PayoutBatch_Id_Field) _Column() string { return "id" }
 
-type NodeApiVersion_ApiVersion_Field struct {
+type PayoutBatch_Period_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value string
 }
 
-func NodeApiVersion_ApiVersion(v int) NodeApiVersion_ApiVersion_Field {
-	return NodeApiVersion_ApiVersion_Field{_set: true, _value: v}
+func PayoutBatch_Period(v string) PayoutBatch_Period_Field {
+	return PayoutBatch_Period_Field{_set: true, _value: v}
 }
 
-func (f NodeApiVersion_ApiVersion_Field) value() interface{} {
+func (f PayoutBatch_Period_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (NodeApiVersion_ApiVersion_Field) _Column() string { return "api_version" }
+func (PayoutBatch_Period_Field) _Column() string { return "period" }
 
-type NodeApiVersion_CreatedAt_Field struct {
+type PayoutBatch_Network_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value string
 }
 
-func NodeApiVersion_CreatedAt(v time.Time) NodeApiVersion_CreatedAt_Field {
-	return NodeApiVersion_CreatedAt_Field{_set: true, _value: v}
+func PayoutBatch_Network(v string) PayoutBatch_Network_Field {
+	return PayoutBatch_Network_Field{_set: true, _value: v}
 }
 
-func (f NodeApiVersion_CreatedAt_Field) value() interface{} {
+func (f PayoutBatch_Network_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (NodeApiVersion_CreatedAt_Field) _Column() string { return "created_at" }
+func (PayoutBatch_Network_Field) _Column() string { return "network" }
 
-type NodeApiVersion_UpdatedAt_Field struct {
+type PayoutBatch_Status_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value string
 }
 
-func NodeApiVersion_UpdatedAt(v time.Time) NodeApiVersion_UpdatedAt_Field {
-	return NodeApiVersion_UpdatedAt_Field{_set: true, _value: v}
+func PayoutBatch_Status(v string) PayoutBatch_Status_Field {
+	return PayoutBatch_Status_Field{_set: true, _value: v}
 }
 
-func (f NodeApiVersion_UpdatedAt_Field) value() interface{} {
+func (f PayoutBatch_Status_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (NodeApiVersion_UpdatedAt_Field) _Column() string { return "updated_at" }
-
-type NodeEvent struct {
-	Id            []byte
-	Email         string
-	NodeId        []byte
-	Event         int
-	CreatedAt     time.Time
-	LastAttempted *time.Time
-	EmailSent     *time.Time
-}
-
-func (NodeEvent) _Table() string { return "node_events" }
-
-type NodeEvent_Create_Fields struct {
-	CreatedAt     NodeEvent_CreatedAt_Field
-	LastAttempted NodeEvent_LastAttempted_Field
-	EmailSent     NodeEvent_EmailSent_Field
-}
-
-type NodeEvent_Update_Fields struct {
-	LastAttempted NodeEvent_LastAttempted_Field
-	EmailSent     NodeEvent_EmailSent_Field
-}
+func (PayoutBatch_Status_Field) _Column() string { return "status" }
 
-type NodeEvent_Id_Field struct {
+type PayoutBatch_TransactionHash_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value *string
 }
 
-func NodeEvent_Id(v []byte) NodeEvent_Id_Field {
-	return NodeEvent_Id_Field{_set: true, _value: v}
+func PayoutBatch_TransactionHash(v string) PayoutBatch_TransactionHash_Field {
+	return PayoutBatch_TransactionHash_Field{_set: true, _value: &v}
 }
 
-func (f NodeEvent_Id_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
+func PayoutBatch_TransactionHash_Raw(v *string) PayoutBatch_TransactionHash_Field {
+	if v == nil {
+		return PayoutBatch_TransactionHash_Null()
 	}
-	return f._value
+	return PayoutBatch_TransactionHash(*v)
 }
 
-func (NodeEvent_Id_Field) _Column() string { return "id" }
-
-type NodeEvent_Email_Field struct {
-	_set   bool
-	_null  bool
-	_value string
+func PayoutBatch_TransactionHash_Null() PayoutBatch_TransactionHash_Field {
+	return PayoutBatch_TransactionHash_Field{_set: true, _null: true}
 }
 
-func NodeEvent_Email(v string) NodeEvent_Email_Field {
-	return NodeEvent_Email_Field{_set: true, _value: v}
+func (f PayoutBatch_TransactionHash_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
 }
 
-func (f NodeEvent_Email_Field) value() interface{} {
+func (f PayoutBatch_TransactionHash_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (NodeEvent_Email_Field) _Column() string { return "email" }
+func (PayoutBatch_TransactionHash_Field) _Column() string { return "transaction_hash" }
 
-type NodeEvent_NodeId_Field struct {
+type PayoutBatch_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value time.Time
 }
 
-func NodeEvent_NodeId(v []byte) NodeEvent_NodeId_Field {
-	return NodeEvent_NodeId_Field{_set: true, _value: v}
+func PayoutBatch_CreatedAt(v time.Time) PayoutBatch_CreatedAt_Field {
+	return PayoutBatch_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f NodeEvent_NodeId_Field) value() interface{} {
+func (f PayoutBatch_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (NodeEvent_NodeId_Field) _Column() string { return "node_id" }
+func (PayoutBatch_CreatedAt_Field) _Column() string { return "created_at" }
 
-type NodeEvent_Event_Field struct {
+type PayoutBatch_SubmittedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value *time.Time
 }
 
-func NodeEvent_Event(v int) NodeEvent_Event_Field {
-	return NodeEvent_Event_Field{_set: true, _value: v}
+func PayoutBatch_SubmittedAt(v time.Time) PayoutBatch_SubmittedAt_Field {
+	return PayoutBatch_SubmittedAt_Field{_set: true, _value: &v}
 }
 
-func (f NodeEvent_Event_Field) value() interface{} {
+func PayoutBatch_SubmittedAt_Raw(v *time.Time) PayoutBatch_SubmittedAt_Field {
+	if v == nil {
+		return PayoutBatch_SubmittedAt_Null()
+	}
+	return PayoutBatch_SubmittedAt(*v)
+}
+
+func PayoutBatch_SubmittedAt_Null() PayoutBatch_SubmittedAt_Field {
+	return PayoutBatch_SubmittedAt_Field{_set: true, _null: true}
+}
+
+func (f PayoutBatch_SubmittedAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f PayoutBatch_SubmittedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (NodeEvent_Event_Field) _Column() string { return "event" }
+func (PayoutBatch_SubmittedAt_Field) _Column() string { return "submitted_at" }
 
-type NodeEvent_CreatedAt_Field struct {
+type PayoutBatch_ConfirmedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value *time.Time
 }
 
-func NodeEvent_CreatedAt(v time.Time) NodeEvent_CreatedAt_Field {
-	return NodeEvent_CreatedAt_Field{_set: true, _value: v}
+func PayoutBatch_ConfirmedAt(v time.Time) PayoutBatch_ConfirmedAt_Field {
+	return PayoutBatch_ConfirmedAt_Field{_set: true, _value: &v}
 }
 
-func (f NodeEvent_CreatedAt_Field) value() interface{} {
+func PayoutBatch_ConfirmedAt_Raw(v *time.Time) PayoutBatch_ConfirmedAt_Field {
+	if v == nil {
+		return PayoutBatch_ConfirmedAt_Null()
+	}
+	return PayoutBatch_ConfirmedAt(*v)
+}
+
+func PayoutBatch_ConfirmedAt_Null() PayoutBatch_ConfirmedAt_Field {
+	return PayoutBatch_ConfirmedAt_Field{_set: true, _null: true}
+}
+
+func (f PayoutBatch_ConfirmedAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f PayoutBatch_ConfirmedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (NodeEvent_CreatedAt_Field) _Column() string { return "created_at" }
+func (PayoutBatch_ConfirmedAt_Field) _Column() string { return "confirmed_at" }
 
-type NodeEvent_LastAttempted_Field struct {
-	_set   bool
-	_null  bool
-	_value *time.Time
+type PayoutBatchEntry struct {
+	BatchId []byte
+	NodeId  []byte
+	Wallet  string
+	Amount  int64
+	Period  string
+	Failed  bool
 }
 
-func NodeEvent_LastAttempted(v time.Time) NodeEvent_LastAttempted_Field {
-	return NodeEvent_LastAttempted_Field{_set: true, _value: &v}
+func (PayoutBatchEntry) _Table() string { return "payout_batch_entries" }
+
+type PayoutBatchEntry_Create_Fields struct {
 }
 
-func NodeEvent_LastAttempted_Raw(v *time.Time) NodeEvent_LastAttempted_Field {
-	if v == nil {
-		return NodeEvent_LastAttempted_Null()
-	}
-	return NodeEvent_LastAttempted(*v)
+type PayoutBatchEntry_Update_Fields struct {
+	Failed PayoutBatchEntry_Failed_Field
 }
 
-func NodeEvent_LastAttempted_Null() NodeEvent_LastAttempted_Field {
-	return NodeEvent_LastAttempted_Field{_set: true, _null: true}
+type PayoutBatchEntry_BatchId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
 }
 
-func (f NodeEvent_LastAttempted_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func PayoutBatchEntry_BatchId(v []byte) PayoutBatchEntry_BatchId_Field {
+	return PayoutBatchEntry_BatchId_Field{_set: true, _value: v}
+}
 
-func (f NodeEvent_LastAttempted_Field) value() interface{} {
+func (f PayoutBatchEntry_BatchId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (NodeEvent_LastAttempted_Field) _Column() string { return "last_attempted" }
+func (PayoutBatchEntry_BatchId_Field) _Column() string { return "batch_id" }
 
-type NodeEvent_EmailSent_Field struct {
+type PayoutBatchEntry_NodeId_Field struct {
 	_set   bool
 	_null  bool
-	_value *time.Time
+	_value []byte
 }
 
-func NodeEvent_EmailSent(v time.Time) NodeEvent_EmailSent_Field {
-	return NodeEvent_EmailSent_Field{_set: true, _value: &v}
+func PayoutBatchEntry_NodeId(v []byte) PayoutBatchEntry_NodeId_Field {
+	return PayoutBatchEntry_NodeId_Field{_set: true, _value: v}
 }
 
-func NodeEvent_EmailSent_Raw(v *time.Time) NodeEvent_EmailSent_Field {
-	if v == nil {
-		return NodeEvent_EmailSent_Null()
+func (f PayoutBatchEntry_NodeId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return NodeEvent_EmailSent(*v)
+	return f._value
 }
 
-func NodeEvent_EmailSent_Null() NodeEvent_EmailSent_Field {
-	return NodeEvent_EmailSent_Field{_set: true, _null: true}
+func (PayoutBatchEntry_NodeId_Field) _Column() string { return "node_id" }
+
+type PayoutBatchEntry_Wallet_Field struct {
+	_set   bool
+	_null  bool
+	_value string
 }
 
-func (f NodeEvent_EmailSent_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func PayoutBatchEntry_Wallet(v string) PayoutBatchEntry_Wallet_Field {
+	return PayoutBatchEntry_Wallet_Field{_set: true, _value: v}
+}
 
-func (f NodeEvent_EmailSent_Field) value() interface{} {
+func (f PayoutBatchEntry_Wallet_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (NodeEvent_EmailSent_Field) _Column() string { return "email_sent" }
+func (PayoutBatchEntry_Wallet_Field) _Column() string { return "wallet" }
 
-type OauthClient struct {
-	Id              []byte
-	EncryptedSecret []byte
-	RedirectUrl     string
-	UserId          []byte
-	AppName         string
-	AppLogoUrl      string
+type PayoutBatchEntry_Amount_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
 }
 
-func (OauthClient) _Table() string { return "oauth_clients" }
+func PayoutBatchEntry_Amount(v int64) PayoutBatchEntry_Amount_Field {
+	return PayoutBatchEntry_Amount_Field{_set: true, _value: v}
+}
 
-type OauthClient_Update_Fields struct {
-	EncryptedSecret OauthClient_EncryptedSecret_Field
-	RedirectUrl     OauthClient_RedirectUrl_Field
-	AppName         OauthClient_AppName_Field
-	AppLogoUrl      OauthClient_AppLogoUrl_Field
+func (f PayoutBatchEntry_Amount_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
 }
 
-type OauthClient_Id_Field struct {
+func (PayoutBatchEntry_Amount_Field) _Column() string { return "amount" }
+
+type PayoutBatchEntry_Period_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value string
 }
 
-func OauthClient_Id(v []byte) OauthClient_Id_Field {
-	return OauthClient_Id_Field{_set: true, _value: v}
+func PayoutBatchEntry_Period(v string) PayoutBatchEntry_Period_Field {
+	return PayoutBatchEntry_Period_Field{_set: true, _value: v}
 }
 
-func (f OauthClient_Id_Field) value() interface{} {
+func (f PayoutBatchEntry_Period_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OauthClient_Id_Field) _Column() string { return "id" }
+func (PayoutBatchEntry_Period_Field) _Column() string { return "period" }
 
-type OauthClient_EncryptedSecret_Field struct {
+type PayoutBatchEntry_Failed_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value bool
 }
 
-func OauthClient_EncryptedSecret(v []byte) OauthClient_EncryptedSecret_Field {
-	return OauthClient_EncryptedSecret_Field{_set: true, _value: v}
+func PayoutBatchEntry_Failed(v bool) PayoutBatchEntry_Failed_Field {
+	return PayoutBatchEntry_Failed_Field{_set: true, _value: v}
 }
 
-func (f OauthClient_EncryptedSecret_Field) value() interface{} {
+func (f PayoutBatchEntry_Failed_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OauthClient_EncryptedSecret_Field) _Column() string { return "encrypted_secret" }
+func (PayoutBatchEntry_Failed_Field) _Column() string { return "failed" }
 
-type OauthClient_RedirectUrl_Field struct {
+type PeerIdentity struct {
+	NodeId           []byte
+	LeafSerialNumber []byte
+	Chain            []byte
+	UpdatedAt        time.Time
+}
+
+func (PeerIdentity) _Table() string { return "peer_identities" }
+
+type PeerIdentity_Update_Fields struct {
+	LeafSerialNumber PeerIdentity_LeafSerialNumber_Field
+	Chain            PeerIdentity_Chain_Field
+}
+
+type PeerIdentity_NodeId_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value []byte
 }
 
-func OauthClient_RedirectUrl(v string) OauthClient_RedirectUrl_Field {
-	return OauthClient_RedirectUrl_Field{_set: true, _value: v}
+func PeerIdentity_NodeId(v []byte) PeerIdentity_NodeId_Field {
+	return PeerIdentity_NodeId_Field{_set: true, _value: v}
 }
 
-func (f OauthClient_RedirectUrl_Field) value() interface{} {
+func (f PeerIdentity_NodeId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OauthClient_RedirectUrl_Field) _Column() string { return "redirect_url" }
+func (PeerIdentity_NodeId_Field) _Column() string { return "node_id" }
 
-type OauthClient_UserId_Field struct {
+type PeerIdentity_LeafSerialNumber_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func OauthClient_UserId(v []byte) OauthClient_UserId_Field {
-	return OauthClient_UserId_Field{_set: true, _value: v}
+func PeerIdentity_LeafSerialNumber(v []byte) PeerIdentity_LeafSerialNumber_Field {
+	return PeerIdentity_LeafSerialNumber_Field{_set: true, _value: v}
 }
 
-func (f OauthClient_UserId_Field) value() interface{} {
+func (f PeerIdentity_LeafSerialNumber_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OauthClient_UserId_Field) _Column() string { return "user_id" }
+func (PeerIdentity_LeafSerialNumber_Field) _Column() string { return "leaf_serial_number" }
 
-type OauthClient_AppName_Field struct {
+type PeerIdentity_Chain_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value []byte
 }
 
-func OauthClient_AppName(v string) OauthClient_AppName_Field {
-	return OauthClient_AppName_Field{_set: true, _value: v}
+func PeerIdentity_Chain(v []byte) PeerIdentity_Chain_Field {
+	return PeerIdentity_Chain_Field{_set: true, _value: v}
 }
 
-func (f OauthClient_AppName_Field) value() interface{} {
+func (f PeerIdentity_Chain_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OauthClient_AppName_Field) _Column() string { return "app_name" }
+func (PeerIdentity_Chain_Field) _Column() string { return "chain" }
 
-type OauthClient_AppLogoUrl_Field struct {
+type PeerIdentity_UpdatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value time.Time
 }
 
-func OauthClient_AppLogoUrl(v string) OauthClient_AppLogoUrl_Field {
-	return OauthClient_AppLogoUrl_Field{_set: true, _value: v}
+func PeerIdentity_UpdatedAt(v time.Time) PeerIdentity_UpdatedAt_Field {
+	return PeerIdentity_UpdatedAt_Field{_set: true, _value: v}
 }
 
-func (f OauthClient_AppLogoUrl_Field) value() interface{} {
+func (f PeerIdentity_UpdatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OauthClient_AppLogoUrl_Field) _Column() string { return "app_logo_url" }
+func (PeerIdentity_UpdatedAt_Field) _Column() string { return "updated_at" }
 
-type OauthCode struct {
-	ClientId        []byte
-	UserId          []byte
-	Scope           string
-	RedirectUrl     string
-	Challenge       string
-	ChallengeMethod string
-	Code            string
-	CreatedAt       time.Time
-	ExpiresAt       time.Time
-	ClaimedAt       *time.Time
+type Project struct {
+	Id                          []byte
+	PublicId                    []byte
+	Name                        string
+	Description                 string
+	UsageLimit                  *int64
+	BandwidthLimit              *int64
+	UserSpecifiedUsageLimit     *int64
+	UserSpecifiedBandwidthLimit *int64
+	SegmentLimit                *int64
+	RateLimit                   *int
+	BurstLimit                  *int
+	MaxBuckets                  *int
+	PartnerId                   []byte
+	UserAgent                   []byte
+	OwnerId                     []byte
+	Salt                        []byte
+	CreatedAt                   time.Time
 }
 
-func (OauthCode) _Table() string { return "oauth_codes" }
+func (Project) _Table() string { return "projects" }
 
-type OauthCode_Create_Fields struct {
-	ClaimedAt OauthCode_ClaimedAt_Field
+type Project_Create_Fields struct {
+	PublicId                    Project_PublicId_Field
+	UsageLimit                  Project_UsageLimit_Field
+	BandwidthLimit              Project_BandwidthLimit_Field
+	UserSpecifiedUsageLimit     Project_UserSpecifiedUsageLimit_Field
+	UserSpecifiedBandwidthLimit Project_UserSpecifiedBandwidthLimit_Field
+	SegmentLimit                Project_SegmentLimit_Field
+	RateLimit                   Project_RateLimit_Field
+	BurstLimit                  Project_BurstLimit_Field
+	MaxBuckets                  Project_MaxBuckets_Field
+	PartnerId                   Project_PartnerId_Field
+	UserAgent                   Project_UserAgent_Field
+	Salt                        Project_Salt_Field
 }
 
-type OauthCode_Update_Fields struct {
-	ClaimedAt OauthCode_ClaimedAt_Field
+type Project_Update_Fields struct {
+	Name                        Project_Name_Field
+	Description                 Project_Description_Field
+	UsageLimit                  Project_UsageLimit_Field
+	BandwidthLimit              Project_BandwidthLimit_Field
+	UserSpecifiedUsageLimit     Project_UserSpecifiedUsageLimit_Field
+	UserSpecifiedBandwidthLimit Project_UserSpecifiedBandwidthLimit_Field
+	SegmentLimit                Project_SegmentLimit_Field
+	RateLimit                   Project_RateLimit_Field
+	BurstLimit                  Project_BurstLimit_Field
+	MaxBuckets                  Project_MaxBuckets_Field
 }
 
-type OauthCode_ClientId_Field struct {
+type Project_Id_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func OauthCode_ClientId(v []byte) OauthCode_ClientId_Field {
-	return OauthCode_ClientId_Field{_set: true, _value: v}
+func Project_Id(v []byte) Project_Id_Field {
+	return Project_Id_Field{_set: true, _value: v}
 }
 
-func (f OauthCode_ClientId_Field) value() interface{} {
+func (f Project_Id_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OauthCode_ClientId_Field) _Column() string { return "client_id" }
+func (Project_Id_Field) _Column() string { return "id" }
 
-type OauthCode_UserId_Field struct {
+type Project_PublicId_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func OauthCode_UserId(v []byte) OauthCode_UserId_Field {
-	return OauthCode_UserId_Field{_set: true, _value: v}
+func Project_PublicId(v []byte) Project_PublicId_Field {
+	return Project_PublicId_Field{_set: true, _value: v}
+}
+
+func Project_PublicId_Raw(v []byte) Project_PublicId_Field {
+	if v == nil {
+		return Project_PublicId_Null()
+	}
+	return Project_PublicId(v)
+}
+
+func Project_PublicId_Null() Project_PublicId_Field {
+	return Project_PublicId_Field{_set: true, _null: true}
 }
 
-func (f OauthCode_UserId_Field) value() interface{} {
+func (f Project_PublicId_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Project_PublicId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OauthCode_UserId_Field) _Column() string { return "user_id" }
+func (Project_PublicId_Field) _Column() string { return "public_id" }
 
-type OauthCode_Scope_Field struct {
+type Project_Name_Field struct {
 	_set   bool
 	_null  bool
 	_value string
 }
 
-func OauthCode_Scope(v string) OauthCode_Scope_Field {
-	return OauthCode_Scope_Field{_set: true, _value: v}
+func Project_Name(v string) Project_Name_Field {
+	return Project_Name_Field{_set: true, _value: v}
 }
 
-func (f OauthCode_Scope_Field) value() interface{} {
+func (f Project_Name_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OauthCode_Scope_Field) _Column() string { return "scope" }
+func (Project_Name_Field) _Column() string { return "name" }
 
-type OauthCode_RedirectUrl_Field struct {
+type Project_Description_Field struct {
 	_set   bool
 	_null  bool
 	_value string
 }
 
-func OauthCode_RedirectUrl(v string) OauthCode_RedirectUrl_Field {
-	return OauthCode_RedirectUrl_Field{_set: true, _value: v}
+func Project_Description(v string) Project_Description_Field {
+	return Project_Description_Field{_set: true, _value: v}
 }
 
-func (f OauthCode_RedirectUrl_Field) value() interface{} {
+func (f Project_Description_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OauthCode_RedirectUrl_Field) _Column() string { return "redirect_url" }
+func (Project_Description_Field) _Column() string { return "description" }
 
-type OauthCode_Challenge_Field struct {
+type Project_UsageLimit_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value *int64
 }
 
-func OauthCode_Challenge(v string) OauthCode_Challenge_Field {
-	return OauthCode_Challenge_Field{_set: true, _value: v}
+func Project_UsageLimit(v int64) Project_UsageLimit_Field {
+	return Project_UsageLimit_Field{_set: true, _value: &v}
 }
 
-func (f OauthCode_Challenge_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
+func Project_UsageLimit_Raw(v *int64) Project_UsageLimit_Field {
+	if v == nil {
+		return Project_UsageLimit_Null()
 	}
-	return f._value
+	return Project_UsageLimit(*v)
 }
 
-func (OauthCode_Challenge_Field) _Column() string { return "challenge" }
-
-type OauthCode_ChallengeMethod_Field struct {
-	_set   bool
-	_null  bool
-	_value string
+func Project_UsageLimit_Null() Project_UsageLimit_Field {
+	return Project_UsageLimit_Field{_set: true, _null: true}
 }
 
-func OauthCode_ChallengeMethod(v string) OauthCode_ChallengeMethod_Field {
-	return OauthCode_ChallengeMethod_Field{_set: true, _value: v}
-}
+func (f Project_UsageLimit_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f OauthCode_ChallengeMethod_Field) value() interface{} {
+func (f Project_UsageLimit_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OauthCode_ChallengeMethod_Field) _Column() string { return "challenge_method" }
+func (Project_UsageLimit_Field) _Column() string { return "usage_limit" }
 
-type OauthCode_Code_Field struct {
+type Project_BandwidthLimit_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value *int64
 }
 
-func OauthCode_Code(v string) OauthCode_Code_Field {
-	return OauthCode_Code_Field{_set: true, _value: v}
+func Project_BandwidthLimit(v int64) Project_BandwidthLimit_Field {
+	return Project_BandwidthLimit_Field{_set: true, _value: &v}
 }
 
-func (f OauthCode_Code_Field) value() interface{} {
+func Project_BandwidthLimit_Raw(v *int64) Project_BandwidthLimit_Field {
+	if v == nil {
+		return Project_BandwidthLimit_Null()
+	}
+	return Project_BandwidthLimit(*v)
+}
+
+func Project_BandwidthLimit_Null() Project_BandwidthLimit_Field {
+	return Project_BandwidthLimit_Field{_set: true, _null: true}
+}
+
+func (f Project_BandwidthLimit_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Project_BandwidthLimit_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OauthCode_Code_Field) _Column() string { return "code" }
+func (Project_BandwidthLimit_Field) _Column() string { return "bandwidth_limit" }
 
-type OauthCode_CreatedAt_Field struct {
+type Project_UserSpecifiedUsageLimit_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value *int64
 }
 
-func OauthCode_CreatedAt(v time.Time) OauthCode_CreatedAt_Field {
-	return OauthCode_CreatedAt_Field{_set: true, _value: v}
+func Project_UserSpecifiedUsageLimit(v int64) Project_UserSpecifiedUsageLimit_Field {
+	return Project_UserSpecifiedUsageLimit_Field{_set: true, _value: &v}
 }
 
-func (f OauthCode_CreatedAt_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
+func Project_UserSpecifiedUsageLimit_Raw(v *int64) Project_UserSpecifiedUsageLimit_Field {
+	if v == nil {
+		return Project_UserSpecifiedUsageLimit_Null()
 	}
-	return f._value
+	return Project_UserSpecifiedUsageLimit(*v)
 }
 
-func (OauthCode_CreatedAt_Field) _Column() string { return "created_at" }
-
-type OauthCode_ExpiresAt_Field struct {
-	_set   bool
-	_null  bool
-	_value time.Time
+func Project_UserSpecifiedUsageLimit_Null() Project_UserSpecifiedUsageLimit_Field {
+	return Project_UserSpecifiedUsageLimit_Field{_set: true, _null: true}
 }
 
-func OauthCode_ExpiresAt(v time.Time) OauthCode_ExpiresAt_Field {
-	return OauthCode_ExpiresAt_Field{_set: true, _value: v}
+func (f Project_UserSpecifiedUsageLimit_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
 }
 
-func (f OauthCode_ExpiresAt_Field) value() interface{} {
+func (f Project_UserSpecifiedUsageLimit_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OauthCode_ExpiresAt_Field) _Column() string { return "expires_at" }
+func (Project_UserSpecifiedUsageLimit_Field) _Column() string { return "user_specified_usage_limit" }
 
-type OauthCode_ClaimedAt_Field struct {
+type Project_UserSpecifiedBandwidthLimit_Field struct {
 	_set   bool
 	_null  bool
-	_value *time.Time
+	_value *int64
 }
 
-func OauthCode_ClaimedAt(v time.Time) OauthCode_ClaimedAt_Field {
-	return OauthCode_ClaimedAt_Field{_set: true, _value: &v}
+func Project_UserSpecifiedBandwidthLimit(v int64) Project_UserSpecifiedBandwidthLimit_Field {
+	return Project_UserSpecifiedBandwidthLimit_Field{_set: true, _value: &v}
 }
 
-func OauthCode_ClaimedAt_Raw(v *time.Time) OauthCode_ClaimedAt_Field {
+func Project_UserSpecifiedBandwidthLimit_Raw(v *int64) Project_UserSpecifiedBandwidthLimit_Field {
 	if v == nil {
-		return OauthCode_ClaimedAt_Null()
+		return Project_UserSpecifiedBandwidthLimit_Null()
 	}
-	return OauthCode_ClaimedAt(*v)
+	return Project_UserSpecifiedBandwidthLimit(*v)
 }
 
-func OauthCode_ClaimedAt_Null() OauthCode_ClaimedAt_Field {
-	return OauthCode_ClaimedAt_Field{_set: true, _null: true}
+func Project_UserSpecifiedBandwidthLimit_Null() Project_UserSpecifiedBandwidthLimit_Field {
+	return Project_UserSpecifiedBandwidthLimit_Field{_set: true, _null: true}
 }
 
-func (f OauthCode_ClaimedAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func (f Project_UserSpecifiedBandwidthLimit_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
 
-func (f OauthCode_ClaimedAt_Field) value() interface{} {
+func (f Project_UserSpecifiedBandwidthLimit_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OauthCode_ClaimedAt_Field) _Column() string { return "claimed_at" }
-
-type OauthToken struct {
-	ClientId  []byte
-	UserId    []byte
-	Scope     string
-	Kind      int
-	Token     []byte
-	CreatedAt time.Time
-	ExpiresAt time.Time
+func (Project_UserSpecifiedBandwidthLimit_Field) _Column() string {
+	return "user_specified_bandwidth_limit"
 }
 
-func (OauthToken) _Table() string { return "oauth_tokens" }
+type Project_SegmentLimit_Field struct {
+	_set   bool
+	_null  bool
+	_value *int64
+}
 
-type OauthToken_Update_Fields struct {
-	ExpiresAt OauthToken_ExpiresAt_Field
+func Project_SegmentLimit(v int64) Project_SegmentLimit_Field {
+	return Project_SegmentLimit_Field{_set: true, _value: &v}
 }
 
-type OauthToken_ClientId_Field struct {
-	_set   bool
-	_null  bool
-	_value []byte
+func Project_SegmentLimit_Raw(v *int64) Project_SegmentLimit_Field {
+	if v == nil {
+		return Project_SegmentLimit_Null()
+	}
+	return Project_SegmentLimit(*v)
 }
 
-func OauthToken_ClientId(v []byte) OauthToken_ClientId_Field {
-	return OauthToken_ClientId_Field{_set: true, _value: v}
+func Project_SegmentLimit_Null() Project_SegmentLimit_Field {
+	return Project_SegmentLimit_Field{_set: true, _null: true}
 }
 
-func (f OauthToken_ClientId_Field) value() interface{} {
+func (f Project_SegmentLimit_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Project_SegmentLimit_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OauthToken_ClientId_Field) _Column() string { return "client_id" }
+func (Project_SegmentLimit_Field) _Column() string { return "segment_limit" }
 
-type OauthToken_UserId_Field struct {
+type Project_RateLimit_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value *int
 }
 
-func OauthToken_UserId(v []byte) OauthToken_UserId_Field {
-	return OauthToken_UserId_Field{_set: true, _value: v}
+func Project_RateLimit(v int) Project_RateLimit_Field {
+	return Project_RateLimit_Field{_set: true, _value: &v}
 }
 
-func (f OauthToken_UserId_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
+func Project_RateLimit_Raw(v *int) Project_RateLimit_Field {
+	if v == nil {
+		return Project_RateLimit_Null()
 	}
-	return f._value
+	return Project_RateLimit(*v)
 }
 
-func (OauthToken_UserId_Field) _Column() string { return "user_id" }
-
-type OauthToken_Scope_Field struct {
-	_set   bool
-	_null  bool
-	_value string
+func Project_RateLimit_Null() Project_RateLimit_Field {
+	return Project_RateLimit_Field{_set: true, _null: true}
 }
 
-func OauthToken_Scope(v string) OauthToken_Scope_Field {
-	return OauthToken_Scope_Field{_set: true, _value: v}
-}
+func (f Project_RateLimit_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f OauthToken_Scope_Field) value() interface{} {
+func (f Project_RateLimit_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OauthToken_Scope_Field) _Column() string { return "scope" }
+func (Project_RateLimit_Field) _Column() string { return "rate_limit" }
 
-type OauthToken_Kind_Field struct {
+type Project_BurstLimit_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value *int
 }
 
-func OauthToken_Kind(v int) OauthToken_Kind_Field {
-	return OauthToken_Kind_Field{_set: true, _value: v}
+func Project_BurstLimit(v int) Project_BurstLimit_Field {
+	return Project_BurstLimit_Field{_set: true, _value: &v}
 }
 
-func (f OauthToken_Kind_Field) value() interface{} {
+func Project_BurstLimit_Raw(v *int) Project_BurstLimit_Field {
+	if v == nil {
+		return Project_BurstLimit_Null()
+	}
+	return Project_BurstLimit(*v)
+}
+
+func Project_BurstLimit_Null() Project_BurstLimit_Field {
+	return Project_BurstLimit_Field{_set: true, _null: true}
+}
+
+func (f Project_BurstLimit_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Project_BurstLimit_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OauthToken_Kind_Field) _Column() string { return "kind" }
+func (Project_BurstLimit_Field) _Column() string { return "burst_limit" }
 
-type OauthToken_Token_Field struct {
+type Project_MaxBuckets_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value *int
 }
 
-func OauthToken_Token(v []byte) OauthToken_Token_Field {
-	return OauthToken_Token_Field{_set: true, _value: v}
+func Project_MaxBuckets(v int) Project_MaxBuckets_Field {
+	return Project_MaxBuckets_Field{_set: true, _value: &v}
+}
+
+func Project_MaxBuckets_Raw(v *int) Project_MaxBuckets_Field {
+	if v == nil {
+		return Project_MaxBuckets_Null()
+	}
+	return Project_MaxBuckets(*v)
+}
+
+func Project_MaxBuckets_Null() Project_MaxBuckets_Field {
+	return Project_MaxBuckets_Field{_set: true, _null: true}
 }
 
-func (f OauthToken_Token_Field) value() interface{} {
+func (f Project_MaxBuckets_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Project_MaxBuckets_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OauthToken_Token_Field) _Column() string { return "token" }
+func (Project_MaxBuckets_Field) _Column() string { return "max_buckets" }
 
-type OauthToken_CreatedAt_Field struct {
+type Project_PartnerId_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value []byte
 }
 
-func OauthToken_CreatedAt(v time.Time) OauthToken_CreatedAt_Field {
-	return OauthToken_CreatedAt_Field{_set: true, _value: v}
+func Project_PartnerId(v []byte) Project_PartnerId_Field {
+	return Project_PartnerId_Field{_set: true, _value: v}
 }
 
-func (f OauthToken_CreatedAt_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
+func Project_PartnerId_Raw(v []byte) Project_PartnerId_Field {
+	if v == nil {
+		return Project_PartnerId_Null()
 	}
-	return f._value
+	return Project_PartnerId(v)
 }
 
-func (OauthToken_CreatedAt_Field) _Column() string { return "created_at" }
-
-type OauthToken_ExpiresAt_Field struct {
-	_set   bool
-	_null  bool
-	_value time.Time
+func Project_PartnerId_Null() Project_PartnerId_Field {
+	return Project_PartnerId_Field{_set: true, _null: true}
 }
 
-func OauthToken_ExpiresAt(v time.Time) OauthToken_ExpiresAt_Field {
-	return OauthToken_ExpiresAt_Field{_set: true, _value: v}
-}
+func (f Project_PartnerId_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f OauthToken_ExpiresAt_Field) value() interface{} {
+func (f Project_PartnerId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (OauthToken_ExpiresAt_Field) _Column() string { return "expires_at" }
+func (Project_PartnerId_Field) _Column() string { return "partner_id" }
 
-type Offer struct {
-	Id                        int
-	Name                      string
-	Description               string
-	AwardCreditInCents        int
-	InviteeCreditInCents      int
-	AwardCreditDurationDays   *int
-	InviteeCreditDurationDays *int
-	RedeemableCap             *int
-	ExpiresAt                 time.Time
-	CreatedAt                 time.Time
-	Status                    int
-	Type                      int
+type Project_UserAgent_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
 }
 
-func (Offer) _Table() string { return "offers" }
-
-type Offer_Create_Fields struct {
-	AwardCreditInCents        Offer_AwardCreditInCents_Field
-	InviteeCreditInCents      Offer_InviteeCreditInCents_Field
-	AwardCreditDurationDays   Offer_AwardCreditDurationDays_Field
-	InviteeCreditDurationDays Offer_InviteeCreditDurationDays_Field
-	RedeemableCap             Offer_RedeemableCap_Field
+func Project_UserAgent(v []byte) Project_UserAgent_Field {
+	return Project_UserAgent_Field{_set: true, _value: v}
 }
 
-type Offer_Update_Fields struct {
-	Name                      Offer_Name_Field
-	Description               Offer_Description_Field
-	AwardCreditInCents        Offer_AwardCreditInCents_Field
-	InviteeCreditInCents      Offer_InviteeCreditInCents_Field
-	AwardCreditDurationDays   Offer_AwardCreditDurationDays_Field
-	InviteeCreditDurationDays Offer_InviteeCreditDurationDays_Field
-	RedeemableCap             Offer_RedeemableCap_Field
-	ExpiresAt                 Offer_ExpiresAt_Field
-	Status                    Offer_Status_Field
-	Type                      Offer_Type_Field
+func Project_UserAgent_Raw(v []byte) Project_UserAgent_Field {
+	if v == nil {
+		return Project_UserAgent_Null()
+	}
+	return Project_UserAgent(v)
 }
 
-type Offer_Id_Field struct {
-	_set   bool
-	_null  bool
-	_value int
+func Project_UserAgent_Null() Project_UserAgent_Field {
+	return Project_UserAgent_Field{_set: true, _null: true}
 }
 
-func Offer_Id(v int) Offer_Id_Field {
-	return Offer_Id_Field{_set: true, _value: v}
-}
+func (f Project_UserAgent_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f Offer_Id_Field) value() interface{} {
+func (f Project_UserAgent_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Offer_Id_Field) _Column() string { return "id" }
+func (Project_UserAgent_Field) _Column() string { return "user_agent" }
 
-type Offer_Name_Field struct {
+type Project_OwnerId_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value []byte
 }
 
-func Offer_Name(v string) Offer_Name_Field {
-	return Offer_Name_Field{_set: true, _value: v}
+func Project_OwnerId(v []byte) Project_OwnerId_Field {
+	return Project_OwnerId_Field{_set: true, _value: v}
 }
 
-func (f Offer_Name_Field) value() interface{} {
+func (f Project_OwnerId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Offer_Name_Field) _Column() string { return "name" }
+func (Project_OwnerId_Field) _Column() string { return "owner_id" }
 
-type Offer_Description_Field struct {
+type Project_Salt_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value []byte
 }
 
-func Offer_Description(v string) Offer_Description_Field {
-	return Offer_Description_Field{_set: true, _value: v}
+func Project_Salt(v []byte) Project_Salt_Field {
+	return Project_Salt_Field{_set: true, _value: v}
 }
 
-func (f Offer_Description_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
+func Project_Salt_Raw(v []byte) Project_Salt_Field {
+	if v == nil {
+		return Project_Salt_Null()
 	}
-	return f._value
+	return Project_Salt(v)
 }
 
-func (Offer_Description_Field) _Column() string { return "description" }
-
-type Offer_AwardCreditInCents_Field struct {
-	_set   bool
-	_null  bool
-	_value int
+func Project_Salt_Null() Project_Salt_Field {
+	return Project_Salt_Field{_set: true, _null: true}
 }
 
-func Offer_AwardCreditInCents(v int) Offer_AwardCreditInCents_Field {
-	return Offer_AwardCreditInCents_Field{_set: true, _value: v}
-}
+func (f Project_Salt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f Offer_AwardCreditInCents_Field) value() interface{} {
+func (f Project_Salt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Offer_AwardCreditInCents_Field) _Column() string { return "award_credit_in_cents" }
+func (Project_Salt_Field) _Column() string { return "salt" }
 
-type Offer_InviteeCreditInCents_Field struct {
+type Project_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value time.Time
 }
 
-func Offer_InviteeCreditInCents(v int) Offer_InviteeCreditInCents_Field {
-	return Offer_InviteeCreditInCents_Field{_set: true, _value: v}
+func Project_CreatedAt(v time.Time) Project_CreatedAt_Field {
+	return Project_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f Offer_InviteeCreditInCents_Field) value() interface{} {
+func (f Project_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Offer_InviteeCreditInCents_Field) _Column() string { return "invitee_credit_in_cents" }
+func (Project_CreatedAt_Field) _Column() string { return "created_at" }
 
-type Offer_AwardCreditDurationDays_Field struct {
-	_set   bool
-	_null  bool
-	_value *int
+type ProjectBandwidthDailyRollup struct {
+	ProjectId       []byte
+	IntervalDay     time.Time
+	EgressAllocated uint64
+	EgressSettled   uint64
+	EgressDead      uint64
 }
 
-func Offer_AwardCreditDurationDays(v int) Offer_AwardCreditDurationDays_Field {
-	return Offer_AwardCreditDurationDays_Field{_set: true, _value: &v}
+func (ProjectBandwidthDailyRollup) _Table() string { return "project_bandwidth_daily_rollups" }
+
+type ProjectBandwidthDailyRollup_Create_Fields struct {
+	EgressDead ProjectBandwidthDailyRollup_EgressDead_Field
 }
 
-func Offer_AwardCreditDurationDays_Raw(v *int) Offer_AwardCreditDurationDays_Field {
-	if v == nil {
-		return Offer_AwardCreditDurationDays_Null()
-	}
-	return Offer_AwardCreditDurationDays(*v)
+type ProjectBandwidthDailyRollup_Update_Fields struct {
+	EgressAllocated ProjectBandwidthDailyRollup_EgressAllocated_Field
+	EgressSettled   ProjectBandwidthDailyRollup_EgressSettled_Field
+	EgressDead      ProjectBandwidthDailyRollup_EgressDead_Field
 }
 
-func Offer_AwardCreditDurationDays_Null() Offer_AwardCreditDurationDays_Field {
-	return Offer_AwardCreditDurationDays_Field{_set: true, _null: true}
+type ProjectBandwidthDailyRollup_ProjectId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
 }
 
-func (f Offer_AwardCreditDurationDays_Field) isnull() bool {
-	return !f._set || f._null || f._value == nil
+func ProjectBandwidthDailyRollup_ProjectId(v []byte) ProjectBandwidthDailyRollup_ProjectId_Field {
+	return ProjectBandwidthDailyRollup_ProjectId_Field{_set: true, _value: v}
 }
 
-func (f Offer_AwardCreditDurationDays_Field) value() interface{} {
+func (f ProjectBandwidthDailyRollup_ProjectId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Offer_AwardCreditDurationDays_Field) _Column() string { return "award_credit_duration_days" }
+func (ProjectBandwidthDailyRollup_ProjectId_Field) _Column() string { return "project_id" }
 
-type Offer_InviteeCreditDurationDays_Field struct {
+type ProjectBandwidthDailyRollup_IntervalDay_Field struct {
 	_set   bool
 	_null  bool
-	_value *int
+	_value time.Time
 }
 
-func Offer_InviteeCreditDurationDays(v int) Offer_InviteeCreditDurationDays_Field {
-	return Offer_InviteeCreditDurationDays_Field{_set: true, _value: &v}
+func ProjectBandwidthDailyRollup_IntervalDay(v time.Time) ProjectBandwidthDailyRollup_IntervalDay_Field {
+	v = toDate(v)
+	return ProjectBandwidthDailyRollup_IntervalDay_Field{_set: true, _value: v}
 }
 
-func Offer_InviteeCreditDurationDays_Raw(v *int) Offer_InviteeCreditDurationDays_Field {
-	if v == nil {
-		return Offer_InviteeCreditDurationDays_Null()
+func (f ProjectBandwidthDailyRollup_IntervalDay_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return Offer_InviteeCreditDurationDays(*v)
+	return f._value
 }
 
-func Offer_InviteeCreditDurationDays_Null() Offer_InviteeCreditDurationDays_Field {
-	return Offer_InviteeCreditDurationDays_Field{_set: true, _null: true}
+func (ProjectBandwidthDailyRollup_IntervalDay_Field) _Column() string { return "interval_day" }
+
+type ProjectBandwidthDailyRollup_EgressAllocated_Field struct {
+	_set   bool
+	_null  bool
+	_value uint64
 }
 
-func (f Offer_InviteeCreditDurationDays_Field) isnull() bool {
-	return !f._set || f._null || f._value == nil
+func ProjectBandwidthDailyRollup_EgressAllocated(v uint64) ProjectBandwidthDailyRollup_EgressAllocated_Field {
+	return ProjectBandwidthDailyRollup_EgressAllocated_Field{_set: true, _value: v}
 }
 
-func (f Offer_InviteeCreditDurationDays_Field) value() interface{} {
+func (f ProjectBandwidthDailyRollup_EgressAllocated_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Offer_InviteeCreditDurationDays_Field) _Column() string { return "invitee_credit_duration_days" }
+func (ProjectBandwidthDailyRollup_EgressAllocated_Field) _Column() string { return "egress_allocated" }
 
-type Offer_RedeemableCap_Field struct {
+type ProjectBandwidthDailyRollup_EgressSettled_Field struct {
 	_set   bool
 	_null  bool
-	_value *int
-}
-
-func Offer_RedeemableCap(v int) Offer_RedeemableCap_Field {
-	return Offer_RedeemableCap_Field{_set: true, _value: &v}
-}
-
-func Offer_RedeemableCap_Raw(v *int) Offer_RedeemableCap_Field {
-	if v == nil {
-		return Offer_RedeemableCap_Null()
-	}
-	return Offer_RedeemableCap(*v)
+	_value uint64
 }
 
-func Offer_RedeemableCap_Null() Offer_RedeemableCap_Field {
-	return Offer_RedeemableCap_Field{_set: true, _null: true}
+func ProjectBandwidthDailyRollup_EgressSettled(v uint64) ProjectBandwidthDailyRollup_EgressSettled_Field {
+	return ProjectBandwidthDailyRollup_EgressSettled_Field{_set: true, _value: v}
 }
 
-func (f Offer_RedeemableCap_Field) isnull() bool { return !f._set || f._null || f._value == nil }
-
-func (f Offer_RedeemableCap_Field) value() interface{} {
+func (f ProjectBandwidthDailyRollup_EgressSettled_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Offer_RedeemableCap_Field) _Column() string { return "redeemable_cap" }
+func (ProjectBandwidthDailyRollup_EgressSettled_Field) _Column() string { return "egress_settled" }
 
-type Offer_ExpiresAt_Field struct {
+type ProjectBandwidthDailyRollup_EgressDead_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value uint64
 }
 
-func Offer_ExpiresAt(v time.Time) Offer_ExpiresAt_Field {
-	return Offer_ExpiresAt_Field{_set: true, _value: v}
+func ProjectBandwidthDailyRollup_EgressDead(v uint64) ProjectBandwidthDailyRollup_EgressDead_Field {
+	return ProjectBandwidthDailyRollup_EgressDead_Field{_set: true, _value: v}
 }
 
-func (f Offer_ExpiresAt_Field) value() interface{} {
+func (f ProjectBandwidthDailyRollup_EgressDead_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Offer_ExpiresAt_Field) _Column() string { return "expires_at" }
+func (ProjectBandwidthDailyRollup_EgressDead_Field) _Column() string { return "egress_dead" }
 
-type Offer_CreatedAt_Field struct {
+type ProjectBandwidthRollup struct {
+	ProjectId       []byte
+	IntervalMonth   time.Time
+	EgressAllocated uint64
+}
+
+func (ProjectBandwidthRollup) _Table() string { return "project_bandwidth_rollups" }
+
+type ProjectBandwidthRollup_Update_Fields struct {
+	EgressAllocated ProjectBandwidthRollup_EgressAllocated_Field
+}
+
+type ProjectBandwidthRollup_ProjectId_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value []byte
 }
 
-func Offer_CreatedAt(v time.Time) Offer_CreatedAt_Field {
-	return Offer_CreatedAt_Field{_set: true, _value: v}
+func ProjectBandwidthRollup_ProjectId(v []byte) ProjectBandwidthRollup_ProjectId_Field {
+	return ProjectBandwidthRollup_ProjectId_Field{_set: true, _value: v}
 }
 
-func (f Offer_CreatedAt_Field) value() interface{} {
+func (f ProjectBandwidthRollup_ProjectId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Offer_CreatedAt_Field) _Column() string { return "created_at" }
+func (ProjectBandwidthRollup_ProjectId_Field) _Column() string { return "project_id" }
 
-type Offer_Status_Field struct {
+type ProjectBandwidthRollup_IntervalMonth_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value time.Time
 }
 
-func Offer_Status(v int) Offer_Status_Field {
-	return Offer_Status_Field{_set: true, _value: v}
+func ProjectBandwidthRollup_IntervalMonth(v time.Time) ProjectBandwidthRollup_IntervalMonth_Field {
+	v = toDate(v)
+	return ProjectBandwidthRollup_IntervalMonth_Field{_set: true, _value: v}
 }
 
-func (f Offer_Status_Field) value() interface{} {
+func (f ProjectBandwidthRollup_IntervalMonth_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Offer_Status_Field) _Column() string { return "status" }
+func (ProjectBandwidthRollup_IntervalMonth_Field) _Column() string { return "interval_month" }
 
-type Offer_Type_Field struct {
+type ProjectBandwidthRollup_EgressAllocated_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value uint64
 }
 
-func Offer_Type(v int) Offer_Type_Field {
-	return Offer_Type_Field{_set: true, _value: v}
+func ProjectBandwidthRollup_EgressAllocated(v uint64) ProjectBandwidthRollup_EgressAllocated_Field {
+	return ProjectBandwidthRollup_EgressAllocated_Field{_set: true, _value: v}
 }
 
-func (f Offer_Type_Field) value() interface{} {
+func (f ProjectBandwidthRollup_EgressAllocated_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Offer_Type_Field) _Column() string { return "type" }
+func (ProjectBandwidthRollup_EgressAllocated_Field) _Column() string { return "egress_allocated" }
 
-type PeerIdentity struct {
-	NodeId           []byte
-	LeafSerialNumber []byte
-	Chain            []byte
-	UpdatedAt        time.Time
+type ProjectDeletion struct {
+	ProjectId       []byte
+	RequestedBy     []byte
+	Stage           string
+	DeletedBuckets  int64
+	DeletedObjects  int64
+	DeletedSegments int64
+	DeletedApiKeys  int64
+	LastError       string
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+	FinishedAt      *time.Time
 }
 
-func (PeerIdentity) _Table() string { return "peer_identities" }
+func (ProjectDeletion) _Table() string { return "project_deletions" }
 
-type PeerIdentity_Update_Fields struct {
-	LeafSerialNumber PeerIdentity_LeafSerialNumber_Field
-	Chain            PeerIdentity_Chain_Field
+type ProjectDeletion_Create_Fields struct {
+	DeletedBuckets  ProjectDeletion_DeletedBuckets_Field
+	DeletedObjects  ProjectDeletion_DeletedObjects_Field
+	DeletedSegments ProjectDeletion_DeletedSegments_Field
+	DeletedApiKeys  ProjectDeletion_DeletedApiKeys_Field
+	LastError       ProjectDeletion_LastError_Field
+	FinishedAt      ProjectDeletion_FinishedAt_Field
 }
 
-type PeerIdentity_NodeId_Field struct {
+type ProjectDeletion_Update_Fields struct {
+	Stage           ProjectDeletion_Stage_Field
+	DeletedBuckets  ProjectDeletion_DeletedBuckets_Field
+	DeletedObjects  ProjectDeletion_DeletedObjects_Field
+	DeletedSegments ProjectDeletion_DeletedSegments_Field
+	DeletedApiKeys  ProjectDeletion_DeletedApiKeys_Field
+	LastError       ProjectDeletion_LastError_Field
+	UpdatedAt       ProjectDeletion_UpdatedAt_Field
+	FinishedAt      ProjectDeletion_FinishedAt_Field
+}
+
+type ProjectDeletion_ProjectId_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func PeerIdentity_NodeId(v []byte) PeerIdentity_NodeId_Field {
-	return PeerIdentity_NodeId_Field{_set: true, _value: v}
+func ProjectDeletion_ProjectId(v []byte) ProjectDeletion_ProjectId_Field {
+	return ProjectDeletion_ProjectId_Field{_set: true, _value: v}
 }
 
-func (f PeerIdentity_NodeId_Field) value() interface{} {
+func (f ProjectDeletion_ProjectId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (PeerIdentity_NodeId_Field) _Column() string { return "node_id" }
+func (ProjectDeletion_ProjectId_Field) _Column() string { return "project_id" }
 
-type PeerIdentity_LeafSerialNumber_Field struct {
+type ProjectDeletion_RequestedBy_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func PeerIdentity_LeafSerialNumber(v []byte) PeerIdentity_LeafSerialNumber_Field {
-	return PeerIdentity_LeafSerialNumber_Field{_set: true, _value: v}
+func ProjectDeletion_RequestedBy(v []byte) ProjectDeletion_RequestedBy_Field {
+	return ProjectDeletion_RequestedBy_Field{_set: true, _value: v}
 }
 
-func (f PeerIdentity_LeafSerialNumber_Field) value() interface{} {
+func (f ProjectDeletion_RequestedBy_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (PeerIdentity_LeafSerialNumber_Field) _Column() string { return "leaf_serial_number" }
+func (ProjectDeletion_RequestedBy_Field) _Column() string { return "requested_by" }
 
-type PeerIdentity_Chain_Field struct {
+type ProjectDeletion_Stage_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value string
 }
 
-func PeerIdentity_Chain(v []byte) PeerIdentity_Chain_Field {
-	return PeerIdentity_Chain_Field{_set: true, _value: v}
+func ProjectDeletion_Stage(v string) ProjectDeletion_Stage_Field {
+	return ProjectDeletion_Stage_Field{_set: true, _value: v}
 }
 
-func (f PeerIdentity_Chain_Field) value() interface{} {
+func (f ProjectDeletion_Stage_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (PeerIdentity_Chain_Field) _Column() string { return "chain" }
+func (ProjectDeletion_Stage_Field) _Column() string { return "stage" }
 
-type PeerIdentity_UpdatedAt_Field struct {
+type ProjectDeletion_DeletedBuckets_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value int64
 }
 
-func PeerIdentity_UpdatedAt(v time.Time) PeerIdentity_UpdatedAt_Field {
-	return PeerIdentity_UpdatedAt_Field{_set: true, _value: v}
+func ProjectDeletion_DeletedBuckets(v int64) ProjectDeletion_DeletedBuckets_Field {
+	return ProjectDeletion_DeletedBuckets_Field{_set: true, _value: v}
 }
 
-func (f PeerIdentity_UpdatedAt_Field) value() interface{} {
+func (f ProjectDeletion_DeletedBuckets_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (PeerIdentity_UpdatedAt_Field) _Column() string { return "updated_at" }
-
-type Project struct {
-	Id                          []byte
-	PublicId                    []byte
-	Name                        string
-	Description                 string
-	UsageLimit                  *int64
-	BandwidthLimit              *int64
-	UserSpecifiedUsageLimit     *int64
-	UserSpecifiedBandwidthLimit *int64
-	SegmentLimit                *int64
-	RateLimit                   *int
-	BurstLimit                  *int
-	MaxBuckets                  *int
-	PartnerId                   []byte
-	UserAgent                   []byte
-	OwnerId                     []byte
-	Salt                        []byte
-	CreatedAt                   time.Time
-}
-
-func (Project) _Table() string { return "projects" }
-
-type Project_Create_Fields struct {
-	PublicId                    Project_PublicId_Field
-	UsageLimit                  Project_UsageLimit_Field
-	BandwidthLimit              Project_BandwidthLimit_Field
-	UserSpecifiedUsageLimit     Project_UserSpecifiedUsageLimit_Field
-	UserSpecifiedBandwidthLimit Project_UserSpecifiedBandwidthLimit_Field
-	SegmentLimit                Project_SegmentLimit_Field
-	RateLimit                   Project_RateLimit_Field
-	BurstLimit                  Project_BurstLimit_Field
-	MaxBuckets                  Project_MaxBuckets_Field
-	PartnerId                   Project_PartnerId_Field
-	UserAgent                   Project_UserAgent_Field
-	Salt                        Project_Salt_Field
-}
-
-type Project_Update_Fields struct {
-	Name                        Project_Name_Field
-	Description                 Project_Description_Field
-	UsageLimit                  Project_UsageLimit_Field
-	BandwidthLimit              Project_BandwidthLimit_Field
-	UserSpecifiedUsageLimit     Project_UserSpecifiedUsageLimit_Field
-	UserSpecifiedBandwidthLimit Project_UserSpecifiedBandwidthLimit_Field
-	SegmentLimit                Project_SegmentLimit_Field
-	RateLimit                   Project_RateLimit_Field
-	BurstLimit                  Project_BurstLimit_Field
-	MaxBuckets                  Project_MaxBuckets_Field
-}
+func (ProjectDeletion_DeletedBuckets_Field) _Column() string { return "deleted_buckets" }
 
-type Project_Id_Field struct {
+type ProjectDeletion_DeletedObjects_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value int64
 }
 
-func Project_Id(v []byte) Project_Id_Field {
-	return Project_Id_Field{_set: true, _value: v}
+func ProjectDeletion_DeletedObjects(v int64) ProjectDeletion_DeletedObjects_Field {
+	return ProjectDeletion_DeletedObjects_Field{_set: true, _value: v}
 }
 
-func (f Project_Id_Field) value() interface{} {
+func (f ProjectDeletion_DeletedObjects_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_Id_Field) _Column() string { return "id" }
+func (ProjectDeletion_DeletedObjects_Field) _Column() string { return "deleted_objects" }
 
-type Project_PublicId_Field struct {
+type ProjectDeletion_DeletedSegments_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
-}
-
-func Project_PublicId(v []byte) Project_PublicId_Field {
-	return Project_PublicId_Field{_set: true, _value: v}
-}
-
-func Project_PublicId_Raw(v []byte) Project_PublicId_Field {
-	if v == nil {
-		return Project_PublicId_Null()
-	}
-	return Project_PublicId(v)
+	_value int64
 }
 
-func Project_PublicId_Null() Project_PublicId_Field {
-	return Project_PublicId_Field{_set: true, _null: true}
+func ProjectDeletion_DeletedSegments(v int64) ProjectDeletion_DeletedSegments_Field {
+	return ProjectDeletion_DeletedSegments_Field{_set: true, _value: v}
 }
 
-func (f Project_PublicId_Field) isnull() bool { return !f._set || f._null || f._value == nil }
-
-func (f Project_PublicId_Field) value() interface{} {
+func (f ProjectDeletion_DeletedSegments_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_PublicId_Field) _Column() string { return "public_id" }
+func (ProjectDeletion_DeletedSegments_Field) _Column() string { return "deleted_segments" }
 
-type Project_Name_Field struct {
+type ProjectDeletion_DeletedApiKeys_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value int64
 }
 
-func Project_Name(v string) Project_Name_Field {
-	return Project_Name_Field{_set: true, _value: v}
+func ProjectDeletion_DeletedApiKeys(v int64) ProjectDeletion_DeletedApiKeys_Field {
+	return ProjectDeletion_DeletedApiKeys_Field{_set: true, _value: v}
 }
 
-func (f Project_Name_Field) value() interface{} {
+func (f ProjectDeletion_DeletedApiKeys_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_Name_Field) _Column() string { return "name" }
+func (ProjectDeletion_DeletedApiKeys_Field) _Column() string { return "deleted_api_keys" }
 
-type Project_Description_Field struct {
+type ProjectDeletion_LastError_Field struct {
 	_set   bool
 	_null  bool
 	_value string
 }
 
-func Project_Description(v string) Project_Description_Field {
-	return Project_Description_Field{_set: true, _value: v}
+func ProjectDeletion_LastError(v string) ProjectDeletion_LastError_Field {
+	return ProjectDeletion_LastError_Field{_set: true, _value: v}
 }
 
-func (f Project_Description_Field) value() interface{} {
+func (f ProjectDeletion_LastError_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_Description_Field) _Column() string { return "description" }
+func (ProjectDeletion_LastError_Field) _Column() string { return "last_error" }
 
-type Project_UsageLimit_Field struct {
+type ProjectDeletion_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value *int64
+	_value time.Time
 }
 
-func Project_UsageLimit(v int64) Project_UsageLimit_Field {
-	return Project_UsageLimit_Field{_set: true, _value: &v}
+func ProjectDeletion_CreatedAt(v time.Time) ProjectDeletion_CreatedAt_Field {
+	return ProjectDeletion_CreatedAt_Field{_set: true, _value: v}
 }
 
-func Project_UsageLimit_Raw(v *int64) Project_UsageLimit_Field {
-	if v == nil {
-		return Project_UsageLimit_Null()
+func (f ProjectDeletion_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return Project_UsageLimit(*v)
+	return f._value
 }
 
-func Project_UsageLimit_Null() Project_UsageLimit_Field {
-	return Project_UsageLimit_Field{_set: true, _null: true}
+func (ProjectDeletion_CreatedAt_Field) _Column() string { return "created_at" }
+
+type ProjectDeletion_UpdatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
 }
 
-func (f Project_UsageLimit_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func ProjectDeletion_UpdatedAt(v time.Time) ProjectDeletion_UpdatedAt_Field {
+	return ProjectDeletion_UpdatedAt_Field{_set: true, _value: v}
+}
 
-func (f Project_UsageLimit_Field) value() interface{} {
+func (f ProjectDeletion_UpdatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_UsageLimit_Field) _Column() string { return "usage_limit" }
+func (ProjectDeletion_UpdatedAt_Field) _Column() string { return "updated_at" }
 
-type Project_BandwidthLimit_Field struct {
+type ProjectDeletion_FinishedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value *int64
+	_value *time.Time
 }
 
-func Project_BandwidthLimit(v int64) Project_BandwidthLimit_Field {
-	return Project_BandwidthLimit_Field{_set: true, _value: &v}
+func ProjectDeletion_FinishedAt(v time.Time) ProjectDeletion_FinishedAt_Field {
+	return ProjectDeletion_FinishedAt_Field{_set: true, _value: &v}
 }
 
-func Project_BandwidthLimit_Raw(v *int64) Project_BandwidthLimit_Field {
+func ProjectDeletion_FinishedAt_Raw(v *time.Time) ProjectDeletion_FinishedAt_Field {
 	if v == nil {
-		return Project_BandwidthLimit_Null()
+		return ProjectDeletion_FinishedAt_Null()
 	}
-	return Project_BandwidthLimit(*v)
+	return ProjectDeletion_FinishedAt(*v)
 }
 
-func Project_BandwidthLimit_Null() Project_BandwidthLimit_Field {
-	return Project_BandwidthLimit_Field{_set: true, _null: true}
+func ProjectDeletion_FinishedAt_Null() ProjectDeletion_FinishedAt_Field {
+	return ProjectDeletion_FinishedAt_Field{_set: true, _null: true}
 }
 
-func (f Project_BandwidthLimit_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func (f ProjectDeletion_FinishedAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
 
-func (f Project_BandwidthLimit_Field) value() interface{} {
+func (f ProjectDeletion_FinishedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_BandwidthLimit_Field) _Column() string { return "bandwidth_limit" }
+func (ProjectDeletion_FinishedAt_Field) _Column() string { return "finished_at" }
 
-type Project_UserSpecifiedUsageLimit_Field struct {
-	_set   bool
-	_null  bool
-	_value *int64
+type ProjectLimitRequest struct {
+	Id             []byte
+	ProjectId      []byte
+	UserId         []byte
+	Kind           string
+	CurrentLimit   int64
+	RequestedLimit int64
+	CurrentUsage   int64
+	Justification  string
+	Status         string
+	Reviewer       string
+	ReviewComment  string
+	CreatedAt      time.Time
+	ReviewedAt     *time.Time
 }
 
-func Project_UserSpecifiedUsageLimit(v int64) Project_UserSpecifiedUsageLimit_Field {
-	return Project_UserSpecifiedUsageLimit_Field{_set: true, _value: &v}
+func (ProjectLimitRequest) _Table() string { return "project_limit_requests" }
+
+type ProjectLimitRequest_Create_Fields struct {
+	Reviewer      ProjectLimitRequest_Reviewer_Field
+	ReviewComment ProjectLimitRequest_ReviewComment_Field
+	ReviewedAt    ProjectLimitRequest_ReviewedAt_Field
 }
 
-func Project_UserSpecifiedUsageLimit_Raw(v *int64) Project_UserSpecifiedUsageLimit_Field {
-	if v == nil {
-		return Project_UserSpecifiedUsageLimit_Null()
-	}
-	return Project_UserSpecifiedUsageLimit(*v)
+type ProjectLimitRequest_Update_Fields struct {
+	Status        ProjectLimitRequest_Status_Field
+	Reviewer      ProjectLimitRequest_Reviewer_Field
+	ReviewComment ProjectLimitRequest_ReviewComment_Field
+	ReviewedAt    ProjectLimitRequest_ReviewedAt_Field
 }
 
-func Project_UserSpecifiedUsageLimit_Null() Project_UserSpecifiedUsageLimit_Field {
-	return Project_UserSpecifiedUsageLimit_Field{_set: true, _null: true}
+type ProjectLimitRequest_Id_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
 }
 
-func (f Project_UserSpecifiedUsageLimit_Field) isnull() bool {
-	return !f._set || f._null || f._value == nil
+func ProjectLimitRequest_Id(v []byte) ProjectLimitRequest_Id_Field {
+	return ProjectLimitRequest_Id_Field{_set: true, _value: v}
 }
 
-func (f Project_UserSpecifiedUsageLimit_Field) value() interface{} {
+func (f ProjectLimitRequest_Id_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_UserSpecifiedUsageLimit_Field) _Column() string { return "user_specified_usage_limit" }
+func (ProjectLimitRequest_Id_Field) _Column() string { return "id" }
 
-type Project_UserSpecifiedBandwidthLimit_Field struct {
+type ProjectLimitRequest_ProjectId_Field struct {
 	_set   bool
 	_null  bool
-	_value *int64
-}
-
-func Project_UserSpecifiedBandwidthLimit(v int64) Project_UserSpecifiedBandwidthLimit_Field {
-	return Project_UserSpecifiedBandwidthLimit_Field{_set: true, _value: &v}
-}
-
-func Project_UserSpecifiedBandwidthLimit_Raw(v *int64) Project_UserSpecifiedBandwidthLimit_Field {
-	if v == nil {
-		return Project_UserSpecifiedBandwidthLimit_Null()
-	}
-	return Project_UserSpecifiedBandwidthLimit(*v)
-}
-
-func Project_UserSpecifiedBandwidthLimit_Null() Project_UserSpecifiedBandwidthLimit_Field {
-	return Project_UserSpecifiedBandwidthLimit_Field{_set: true, _null: true}
+	_value []byte
 }
 
-func (f Project_UserSpecifiedBandwidthLimit_Field) isnull() bool {
-	return !f._set || f._null || f._value == nil
+func ProjectLimitRequest_ProjectId(v []byte) ProjectLimitRequest_ProjectId_Field {
+	return ProjectLimitRequest_ProjectId_Field{_set: true, _value: v}
 }
 
-func (f Project_UserSpecifiedBandwidthLimit_Field) value() interface{} {
+func (f ProjectLimitRequest_ProjectId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_UserSpecifiedBandwidthLimit_Field) _Column() string {
-	return "user_specified_bandwidth_limit"
-}
+func (ProjectLimitRequest_ProjectId_Field) _Column() string { return "project_id" }
 
-type Project_SegmentLimit_Field struct {
+type ProjectLimitRequest_UserId_Field struct {
 	_set   bool
 	_null  bool
-	_value *int64
+	_value []byte
 }
 
-func Project_SegmentLimit(v int64) Project_SegmentLimit_Field {
-	return Project_SegmentLimit_Field{_set: true, _value: &v}
+func ProjectLimitRequest_UserId(v []byte) ProjectLimitRequest_UserId_Field {
+	return ProjectLimitRequest_UserId_Field{_set: true, _value: v}
 }
 
-func Project_SegmentLimit_Raw(v *int64) Project_SegmentLimit_Field {
-	if v == nil {
-		return Project_SegmentLimit_Null()
+func (f ProjectLimitRequest_UserId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return Project_SegmentLimit(*v)
+	return f._value
 }
 
-func Project_SegmentLimit_Null() Project_SegmentLimit_Field {
-	return Project_SegmentLimit_Field{_set: true, _null: true}
+func (ProjectLimitRequest_UserId_Field) _Column() string { return "user_id" }
+
+type ProjectLimitRequest_Kind_Field struct {
+	_set   bool
+	_null  bool
+	_value string
 }
 
-func (f Project_SegmentLimit_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func ProjectLimitRequest_Kind(v string) ProjectLimitRequest_Kind_Field {
+	return ProjectLimitRequest_Kind_Field{_set: true, _value: v}
+}
 
-func (f Project_SegmentLimit_Field) value() interface{} {
+func (f ProjectLimitRequest_Kind_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_SegmentLimit_Field) _Column() string { return "segment_limit" }
+func (ProjectLimitRequest_Kind_Field) _Column() string { return "kind" }
 
-type Project_RateLimit_Field struct {
+type ProjectLimitRequest_CurrentLimit_Field struct {
 	_set   bool
 	_null  bool
-	_value *int
+	_value int64
 }
 
-func Project_RateLimit(v int) Project_RateLimit_Field {
-	return Project_RateLimit_Field{_set: true, _value: &v}
+func ProjectLimitRequest_CurrentLimit(v int64) ProjectLimitRequest_CurrentLimit_Field {
+	return ProjectLimitRequest_CurrentLimit_Field{_set: true, _value: v}
 }
 
-func Project_RateLimit_Raw(v *int) Project_RateLimit_Field {
-	if v == nil {
-		return Project_RateLimit_Null()
+func (f ProjectLimitRequest_CurrentLimit_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return Project_RateLimit(*v)
+	return f._value
 }
 
-func Project_RateLimit_Null() Project_RateLimit_Field {
-	return Project_RateLimit_Field{_set: true, _null: true}
+func (ProjectLimitRequest_CurrentLimit_Field) _Column() string { return "current_limit" }
+
+type ProjectLimitRequest_RequestedLimit_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
 }
 
-func (f Project_RateLimit_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func ProjectLimitRequest_RequestedLimit(v int64) ProjectLimitRequest_RequestedLimit_Field {
+	return ProjectLimitRequest_RequestedLimit_Field{_set: true, _value: v}
+}
 
-func (f Project_RateLimit_Field) value() interface{} {
+func (f ProjectLimitRequest_RequestedLimit_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_RateLimit_Field) _Column() string { return "rate_limit" }
+func (ProjectLimitRequest_RequestedLimit_Field) _Column() string { return "requested_limit" }
 
-type Project_BurstLimit_Field struct {
+type ProjectLimitRequest_CurrentUsage_Field struct {
 	_set   bool
 	_null  bool
-	_value *int
+	_value int64
 }
 
-func Project_BurstLimit(v int) Project_BurstLimit_Field {
-	return Project_BurstLimit_Field{_set: true, _value: &v}
+func ProjectLimitRequest_CurrentUsage(v int64) ProjectLimitRequest_CurrentUsage_Field {
+	return ProjectLimitRequest_CurrentUsage_Field{_set: true, _value: v}
 }
 
-func Project_BurstLimit_Raw(v *int) Project_BurstLimit_Field {
-	if v == nil {
-		return Project_BurstLimit_Null()
+func (f ProjectLimitRequest_CurrentUsage_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return Project_BurstLimit(*v)
+	return f._value
 }
 
-func Project_BurstLimit_Null() Project_BurstLimit_Field {
-	return Project_BurstLimit_Field{_set: true, _null: true}
+func (ProjectLimitRequest_CurrentUsage_Field) _Column() string { return "current_usage" }
+
+type ProjectLimitRequest_Justification_Field struct {
+	_set   bool
+	_null  bool
+	_value string
 }
 
-func (f Project_BurstLimit_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func ProjectLimitRequest_Justification(v string) ProjectLimitRequest_Justification_Field {
+	return ProjectLimitRequest_Justification_Field{_set: true, _value: v}
+}
 
-func (f Project_BurstLimit_Field) value() interface{} {
+func (f ProjectLimitRequest_Justification_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_BurstLimit_Field) _Column() string { return "burst_limit" }
+func (ProjectLimitRequest_Justification_Field) _Column() string { return "justification" }
 
-type Project_MaxBuckets_Field struct {
+type ProjectLimitRequest_Status_Field struct {
 	_set   bool
 	_null  bool
-	_value *int
+	_value string
 }
 
-func Project_MaxBuckets(v int) Project_MaxBuckets_Field {
-	return Project_MaxBuckets_Field{_set: true, _value: &v}
+func ProjectLimitRequest_Status(v string) ProjectLimitRequest_Status_Field {
+	return ProjectLimitRequest_Status_Field{_set: true, _value: v}
 }
 
-func Project_MaxBuckets_Raw(v *int) Project_MaxBuckets_Field {
-	if v == nil {
-		return Project_MaxBuckets_Null()
+func (f ProjectLimitRequest_Status_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return Project_MaxBuckets(*v)
+	return f._value
 }
 
-func Project_MaxBuckets_Null() Project_MaxBuckets_Field {
-	return Project_MaxBuckets_Field{_set: true, _null: true}
+func (ProjectLimitRequest_Status_Field) _Column() string { return "status" }
+
+type ProjectLimitRequest_Reviewer_Field struct {
+	_set   bool
+	_null  bool
+	_value string
 }
 
-func (f Project_MaxBuckets_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func ProjectLimitRequest_Reviewer(v string) ProjectLimitRequest_Reviewer_Field {
+	return ProjectLimitRequest_Reviewer_Field{_set: true, _value: v}
+}
 
-func (f Project_MaxBuckets_Field) value() interface{} {
+func (f ProjectLimitRequest_Reviewer_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_MaxBuckets_Field) _Column() string { return "max_buckets" }
+func (ProjectLimitRequest_Reviewer_Field) _Column() string { return "reviewer" }
 
-type Project_PartnerId_Field struct {
+type ProjectLimitRequest_ReviewComment_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value string
 }
 
-func Project_PartnerId(v []byte) Project_PartnerId_Field {
-	return Project_PartnerId_Field{_set: true, _value: v}
+func ProjectLimitRequest_ReviewComment(v string) ProjectLimitRequest_ReviewComment_Field {
+	return ProjectLimitRequest_ReviewComment_Field{_set: true, _value: v}
 }
 
-func Project_PartnerId_Raw(v []byte) Project_PartnerId_Field {
-	if v == nil {
-		return Project_PartnerId_Null()
+func (f ProjectLimitRequest_ReviewComment_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return Project_PartnerId(v)
+	return f._value
 }
 
-func Project_PartnerId_Null() Project_PartnerId_Field {
-	return Project_PartnerId_Field{_set: true, _null: true}
+func (ProjectLimitRequest_ReviewComment_Field) _Column() string { return "review_comment" }
+
+type ProjectLimitRequest_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
 }
 
-func (f Project_PartnerId_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func ProjectLimitRequest_CreatedAt(v time.Time) ProjectLimitRequest_CreatedAt_Field {
+	return ProjectLimitRequest_CreatedAt_Field{_set: true, _value: v}
+}
 
-func (f Project_PartnerId_Field) value() interface{} {
+func (f ProjectLimitRequest_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_PartnerId_Field) _Column() string { return "partner_id" }
+func (ProjectLimitRequest_CreatedAt_Field) _Column() string { return "created_at" }
 
-type Project_UserAgent_Field struct {
+type ProjectLimitRequest_ReviewedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value *time.Time
 }
 
-func Project_UserAgent(v []byte) Project_UserAgent_Field {
-	return Project_UserAgent_Field{_set: true, _value: v}
+func ProjectLimitRequest_ReviewedAt(v time.Time) ProjectLimitRequest_ReviewedAt_Field {
+	return ProjectLimitRequest_ReviewedAt_Field{_set: true, _value: &v}
 }
 
-func Project_UserAgent_Raw(v []byte) Project_UserAgent_Field {
+func ProjectLimitRequest_ReviewedAt_Raw(v *time.Time) ProjectLimitRequest_ReviewedAt_Field {
 	if v == nil {
-		return Project_UserAgent_Null()
+		return ProjectLimitRequest_ReviewedAt_Null()
 	}
-	return Project_UserAgent(v)
+	return ProjectLimitRequest_ReviewedAt(*v)
 }
 
-func Project_UserAgent_Null() Project_UserAgent_Field {
-	return Project_UserAgent_Field{_set: true, _null: true}
+func ProjectLimitRequest_ReviewedAt_Null() ProjectLimitRequest_ReviewedAt_Field {
+	return ProjectLimitRequest_ReviewedAt_Field{_set: true, _null: true}
 }
 
-func (f Project_UserAgent_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func (f ProjectLimitRequest_ReviewedAt_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
 
-func (f Project_UserAgent_Field) value() interface{} {
+func (f ProjectLimitRequest_ReviewedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_UserAgent_Field) _Column() string { return "user_agent" }
+func (ProjectLimitRequest_ReviewedAt_Field) _Column() string { return "reviewed_at" }
 
-type Project_OwnerId_Field struct {
+type ProjectNetworkRule struct {
+	ProjectId []byte
+	Network   string
+	Allow     bool
+	CreatedAt time.Time
+}
+
+func (ProjectNetworkRule) _Table() string { return "project_network_rules" }
+
+type ProjectNetworkRule_Create_Fields struct {
+}
+
+type ProjectNetworkRule_Update_Fields struct {
+}
+
+type ProjectNetworkRule_ProjectId_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func Project_OwnerId(v []byte) Project_OwnerId_Field {
-	return Project_OwnerId_Field{_set: true, _value: v}
+func ProjectNetworkRule_ProjectId(v []byte) ProjectNetworkRule_ProjectId_Field {
+	return ProjectNetworkRule_ProjectId_Field{_set: true, _value: v}
 }
 
-func (f Project_OwnerId_Field) value() interface{} {
+func (f ProjectNetworkRule_ProjectId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_OwnerId_Field) _Column() string { return "owner_id" }
+func (ProjectNetworkRule_ProjectId_Field) _Column() string { return "project_id" }
 
-type Project_Salt_Field struct {
+type ProjectNetworkRule_Network_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value string
 }
 
-func Project_Salt(v []byte) Project_Salt_Field {
-	return Project_Salt_Field{_set: true, _value: v}
+func ProjectNetworkRule_Network(v string) ProjectNetworkRule_Network_Field {
+	return ProjectNetworkRule_Network_Field{_set: true, _value: v}
 }
 
-func Project_Salt_Raw(v []byte) Project_Salt_Field {
-	if v == nil {
-		return Project_Salt_Null()
+func (f ProjectNetworkRule_Network_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	return Project_Salt(v)
+	return f._value
 }
 
-func Project_Salt_Null() Project_Salt_Field {
-	return Project_Salt_Field{_set: true, _null: true}
+func (ProjectNetworkRule_Network_Field) _Column() string { return "network" }
+
+type ProjectNetworkRule_Allow_Field struct {
+	_set   bool
+	_null  bool
+	_value bool
 }
 
-func (f Project_Salt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+func ProjectNetworkRule_Allow(v bool) ProjectNetworkRule_Allow_Field {
+	return ProjectNetworkRule_Allow_Field{_set: true, _value: v}
+}
 
-func (f Project_Salt_Field) value() interface{} {
+func (f ProjectNetworkRule_Allow_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_Salt_Field) _Column() string { return "salt" }
+func (ProjectNetworkRule_Allow_Field) _Column() string { return "allow" }
 
-type Project_CreatedAt_Field struct {
+type ProjectNetworkRule_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
 	_value time.Time
 }
 
-func Project_CreatedAt(v time.Time) Project_CreatedAt_Field {
-	return Project_CreatedAt_Field{_set: true, _value: v}
+func ProjectNetworkRule_CreatedAt(v time.Time) ProjectNetworkRule_CreatedAt_Field {
+	return ProjectNetworkRule_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f Project_CreatedAt_Field) value() interface{} {
+func (f ProjectNetworkRule_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Project_CreatedAt_Field) _Column() string { return "created_at" }
+func (ProjectNetworkRule_CreatedAt_Field) _Column() string { return "created_at" }
 
-type ProjectBandwidthDailyRollup struct {
-	ProjectId       []byte
-	IntervalDay     time.Time
-	EgressAllocated uint64
-	EgressSettled   uint64
-	EgressDead      uint64
+type ProjectOperationRollup struct {
+	ProjectId     []byte
+	IntervalStart time.Time
+	Uploads       int64
+	Downloads     int64
+	Lists         int64
+	Deletes       int64
+	Errors        int64
 }
 
-func (ProjectBandwidthDailyRollup) _Table() string { return "project_bandwidth_daily_rollups" }
+func (ProjectOperationRollup) _Table() string { return "project_operation_rollups" }
 
-type ProjectBandwidthDailyRollup_Create_Fields struct {
-	EgressDead ProjectBandwidthDailyRollup_EgressDead_Field
+type ProjectOperationRollup_Create_Fields struct {
+	Uploads   ProjectOperationRollup_Uploads_Field
+	Downloads ProjectOperationRollup_Downloads_Field
+	Lists     ProjectOperationRollup_Lists_Field
+	Deletes   ProjectOperationRollup_Deletes_Field
+	Errors    ProjectOperationRollup_Errors_Field
 }
 
-type ProjectBandwidthDailyRollup_Update_Fields struct {
-	EgressAllocated ProjectBandwidthDailyRollup_EgressAllocated_Field
-	EgressSettled   ProjectBandwidthDailyRollup_EgressSettled_Field
-	EgressDead      ProjectBandwidthDailyRollup_EgressDead_Field
+type ProjectOperationRollup_Update_Fields struct {
+	Uploads   ProjectOperationRollup_Uploads_Field
+	Downloads ProjectOperationRollup_Downloads_Field
+	Lists     ProjectOperationRollup_Lists_Field
+	Deletes   ProjectOperationRollup_Deletes_Field
+	Errors    ProjectOperationRollup_Errors_Field
 }
 
-type ProjectBandwidthDailyRollup_ProjectId_Field struct {
+type ProjectOperationRollup_ProjectId_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func ProjectBandwidthDailyRollup_ProjectId(v []byte) ProjectBandwidthDailyRollup_ProjectId_Field {
-	return ProjectBandwidthDailyRollup_ProjectId_Field{_set: true, _value: v}
+func ProjectOperationRollup_ProjectId(v []byte) ProjectOperationRollup_ProjectId_Field {
+	return ProjectOperationRollup_ProjectId_Field{_set: true, _value: v}
 }
 
-func (f ProjectBandwidthDailyRollup_ProjectId_Field) value() interface{} {
+func (f ProjectOperationRollup_ProjectId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ProjectBandwidthDailyRollup_ProjectId_Field) _Column() string { return "project_id" }
+func (ProjectOperationRollup_ProjectId_Field) _Column() string { return "project_id" }
 
-type ProjectBandwidthDailyRollup_IntervalDay_Field struct {
+type ProjectOperationRollup_IntervalStart_Field struct {
 	_set   bool
 	_null  bool
 	_value time.Time
 }
 
-func ProjectBandwidthDailyRollup_IntervalDay(v time.Time) ProjectBandwidthDailyRollup_IntervalDay_Field {
-	v = toDate(v)
-	return ProjectBandwidthDailyRollup_IntervalDay_Field{_set: true, _value: v}
-}
-
-func (f ProjectBandwidthDailyRollup_IntervalDay_Field) value() interface{} {
-	if !f._set || f._null {
-		return nil
-	}
-	return f._value
-}
-
-func (ProjectBandwidthDailyRollup_IntervalDay_Field) _Column() string { return "interval_day" }
-
-type ProjectBandwidthDailyRollup_EgressAllocated_Field struct {
-	_set   bool
-	_null  bool
-	_value uint64
-}
-
-func ProjectBandwidthDailyRollup_EgressAllocated(v uint64) ProjectBandwidthDailyRollup_EgressAllocated_Field {
-	return ProjectBandwidthDailyRollup_EgressAllocated_Field{_set: true, _value: v}
+func ProjectOperationRollup_IntervalStart(v time.Time) ProjectOperationRollup_IntervalStart_Field {
+	return ProjectOperationRollup_IntervalStart_Field{_set: true, _value: v}
 }
 
-func (f ProjectBandwidthDailyRollup_EgressAllocated_Field) value() interface{} {
+func (f ProjectOperationRollup_IntervalStart_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ProjectBandwidthDailyRollup_EgressAllocated_Field) _Column() string { return "egress_allocated" }
+func (ProjectOperationRollup_IntervalStart_Field) _Column() string { return "interval_start" }
 
-type ProjectBandwidthDailyRollup_EgressSettled_Field struct {
+type ProjectOperationRollup_Uploads_Field struct {
 	_set   bool
 	_null  bool
-	_value uint64
+	_value int64
 }
 
-func ProjectBandwidthDailyRollup_EgressSettled(v uint64) ProjectBandwidthDailyRollup_EgressSettled_Field {
-	return ProjectBandwidthDailyRollup_EgressSettled_Field{_set: true, _value: v}
+func ProjectOperationRollup_Uploads(v int64) ProjectOperationRollup_Uploads_Field {
+	return ProjectOperationRollup_Uploads_Field{_set: true, _value: v}
 }
 
-func (f ProjectBandwidthDailyRollup_EgressSettled_Field) value() interface{} {
+func (f ProjectOperationRollup_Uploads_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ProjectBandwidthDailyRollup_EgressSettled_Field) _Column() string { return "egress_settled" }
+func (ProjectOperationRollup_Uploads_Field) _Column() string { return "uploads" }
 
-type ProjectBandwidthDailyRollup_EgressDead_Field struct {
+type ProjectOperationRollup_Downloads_Field struct {
 	_set   bool
 	_null  bool
-	_value uint64
+	_value int64
 }
 
-func ProjectBandwidthDailyRollup_EgressDead(v uint64) ProjectBandwidthDailyRollup_EgressDead_Field {
-	return ProjectBandwidthDailyRollup_EgressDead_Field{_set: true, _value: v}
+func ProjectOperationRollup_Downloads(v int64) ProjectOperationRollup_Downloads_Field {
+	return ProjectOperationRollup_Downloads_Field{_set: true, _value: v}
 }
 
-func (f ProjectBandwidthDailyRollup_EgressDead_Field) value() interface{} {
+func (f ProjectOperationRollup_Downloads_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ProjectBandwidthDailyRollup_EgressDead_Field) _Column() string { return "egress_dead" }
-
-type ProjectBandwidthRollup struct {
-	ProjectId       []byte
-	IntervalMonth   time.Time
-	EgressAllocated uint64
-}
-
-func (ProjectBandwidthRollup) _Table() string { return "project_bandwidth_rollups" }
-
-type ProjectBandwidthRollup_Update_Fields struct {
-	EgressAllocated ProjectBandwidthRollup_EgressAllocated_Field
-}
+func (ProjectOperationRollup_Downloads_Field) _Column() string { return "downloads" }
 
-type ProjectBandwidthRollup_ProjectId_Field struct {
+type ProjectOperationRollup_Lists_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value int64
 }
 
-func ProjectBandwidthRollup_ProjectId(v []byte) ProjectBandwidthRollup_ProjectId_Field {
-	return ProjectBandwidthRollup_ProjectId_Field{_set: true, _value: v}
+func ProjectOperationRollup_Lists(v int64) ProjectOperationRollup_Lists_Field {
+	return ProjectOperationRollup_Lists_Field{_set: true, _value: v}
 }
 
-func (f ProjectBandwidthRollup_ProjectId_Field) value() interface{} {
+func (f ProjectOperationRollup_Lists_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ProjectBandwidthRollup_ProjectId_Field) _Column() string { return "project_id" }
+func (ProjectOperationRollup_Lists_Field) _Column() string { return "lists" }
 
-type ProjectBandwidthRollup_IntervalMonth_Field struct {
+type ProjectOperationRollup_Deletes_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value int64
 }
 
-func ProjectBandwidthRollup_IntervalMonth(v time.Time) ProjectBandwidthRollup_IntervalMonth_Field {
-	v = toDate(v)
-	return ProjectBandwidthRollup_IntervalMonth_Field{_set: true, _value: v}
+func ProjectOperationRollup_Deletes(v int64) ProjectOperationRollup_Deletes_Field {
+	return ProjectOperationRollup_Deletes_Field{_set: true, _value: v}
 }
 
-func (f ProjectBandwidthRollup_IntervalMonth_Field) value() interface{} {
+func (f ProjectOperationRollup_Deletes_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ProjectBandwidthRollup_IntervalMonth_Field) _Column() string { return "interval_month" }
+func (ProjectOperationRollup_Deletes_Field) _Column() string { return "deletes" }
 
-type ProjectBandwidthRollup_EgressAllocated_Field struct {
+type ProjectOperationRollup_Errors_Field struct {
 	_set   bool
 	_null  bool
-	_value uint64
+	_value int64
 }
 
-func ProjectBandwidthRollup_EgressAllocated(v uint64) ProjectBandwidthRollup_EgressAllocated_Field {
-	return ProjectBandwidthRollup_EgressAllocated_Field{_set: true, _value: v}
+func ProjectOperationRollup_Errors(v int64) ProjectOperationRollup_Errors_Field {
+	return ProjectOperationRollup_Errors_Field{_set: true, _value: v}
 }
 
-func (f ProjectBandwidthRollup_EgressAllocated_Field) value() interface{} {
+func (f ProjectOperationRollup_Errors_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (ProjectBandwidthRollup_EgressAllocated_Field) _Column() string { return "egress_allocated" }
+func (ProjectOperationRollup_Errors_Field) _Column() string { return "errors" }
 
 type RegistrationToken struct {
 	Secret       []byte
@@ -7982,8 +11926,9 @@ func (f ReverificationAudits_ReverifyCount_Field) value() interface{} {
 func (ReverificationAudits_ReverifyCount_Field) _Column() string { return "reverify_count" }
 
 type Revocation struct {
-	Revoked  []byte
-	ApiKeyId []byte
+	Revoked   []byte
+	ApiKeyId  []byte
+	CreatedAt time.Time
 }
 
 func (Revocation) _Table() string { return "revocations" }
@@ -8029,6 +11974,25 @@ func (f Revocation_ApiKeyId_Field) value() interface{} {
 
 func (Revocation_ApiKeyId_Field) _Column() string { return "api_key_id" }
 
+type Revocation_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func Revocation_CreatedAt(v time.Time) Revocation_CreatedAt_Field {
+	return Revocation_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f Revocation_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Revocation_CreatedAt_Field) _Column() string { return "created_at" }
+
 type SegmentPendingAudits struct {
 	NodeId            []byte
 	StreamId          []byte
@@ -9284,6 +13248,140 @@ func (f StoragenodePaystub_Distributed_Field) value() interface{} {
 
 func (StoragenodePaystub_Distributed_Field) _Column() string { return "distributed" }
 
+type StoragenodeSettlementClaim struct {
+	StoragenodeId []byte
+	IntervalStart time.Time
+	Action        int
+	Amount        int64
+	Status        int
+	UpdatedAt     time.Time
+}
+
+func (StoragenodeSettlementClaim) _Table() string { return "storagenode_settlement_claims" }
+
+type StoragenodeSettlementClaim_Create_Fields struct {
+}
+
+type StoragenodeSettlementClaim_Update_Fields struct {
+	Amount    StoragenodeSettlementClaim_Amount_Field
+	Status    StoragenodeSettlementClaim_Status_Field
+	UpdatedAt StoragenodeSettlementClaim_UpdatedAt_Field
+}
+
+type StoragenodeSettlementClaim_StoragenodeId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func StoragenodeSettlementClaim_StoragenodeId(v []byte) StoragenodeSettlementClaim_StoragenodeId_Field {
+	return StoragenodeSettlementClaim_StoragenodeId_Field{_set: true, _value: v}
+}
+
+func (f StoragenodeSettlementClaim_StoragenodeId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (StoragenodeSettlementClaim_StoragenodeId_Field) _Column() string { return "storagenode_id" }
+
+type StoragenodeSettlementClaim_IntervalStart_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func StoragenodeSettlementClaim_IntervalStart(v time.Time) StoragenodeSettlementClaim_IntervalStart_Field {
+	return StoragenodeSettlementClaim_IntervalStart_Field{_set: true, _value: v}
+}
+
+func (f StoragenodeSettlementClaim_IntervalStart_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (StoragenodeSettlementClaim_IntervalStart_Field) _Column() string { return "interval_start" }
+
+type StoragenodeSettlementClaim_Action_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func StoragenodeSettlementClaim_Action(v int) StoragenodeSettlementClaim_Action_Field {
+	return StoragenodeSettlementClaim_Action_Field{_set: true, _value: v}
+}
+
+func (f StoragenodeSettlementClaim_Action_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (StoragenodeSettlementClaim_Action_Field) _Column() string { return "action" }
+
+type StoragenodeSettlementClaim_Amount_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func StoragenodeSettlementClaim_Amount(v int64) StoragenodeSettlementClaim_Amount_Field {
+	return StoragenodeSettlementClaim_Amount_Field{_set: true, _value: v}
+}
+
+func (f StoragenodeSettlementClaim_Amount_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (StoragenodeSettlementClaim_Amount_Field) _Column() string { return "amount" }
+
+type StoragenodeSettlementClaim_Status_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func StoragenodeSettlementClaim_Status(v int) StoragenodeSettlementClaim_Status_Field {
+	return StoragenodeSettlementClaim_Status_Field{_set: true, _value: v}
+}
+
+func (f StoragenodeSettlementClaim_Status_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (StoragenodeSettlementClaim_Status_Field) _Column() string { return "status" }
+
+type StoragenodeSettlementClaim_UpdatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func StoragenodeSettlementClaim_UpdatedAt(v time.Time) StoragenodeSettlementClaim_UpdatedAt_Field {
+	return StoragenodeSettlementClaim_UpdatedAt_Field{_set: true, _value: v}
+}
+
+func (f StoragenodeSettlementClaim_UpdatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (StoragenodeSettlementClaim_UpdatedAt_Field) _Column() string { return "updated_at" }
+
 type StoragenodeStorageTally struct {
 	NodeId          []byte
 	IntervalEndTime time.Time
@@ -11918,6 +16016,115 @@ func (f BucketMetainfo_Placement_Field) value() interface{} {
 
 func (BucketMetainfo_Placement_Field) _Column() string { return "placement" }
 
+type ProjectInvitation struct {
+	ProjectId []byte
+	Email     string
+	InviterId *[]byte
+	CreatedAt time.Time
+}
+
+func (ProjectInvitation) _Table() string { return "project_invitations" }
+
+type ProjectInvitation_Create_Fields struct {
+	InviterId ProjectInvitation_InviterId_Field
+}
+
+type ProjectInvitation_Update_Fields struct {
+	InviterId ProjectInvitation_InviterId_Field
+	CreatedAt ProjectInvitation_CreatedAt_Field
+}
+
+type ProjectInvitation_ProjectId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func ProjectInvitation_ProjectId(v []byte) ProjectInvitation_ProjectId_Field {
+	return ProjectInvitation_ProjectId_Field{_set: true, _value: v}
+}
+
+func (f ProjectInvitation_ProjectId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ProjectInvitation_ProjectId_Field) _Column() string { return "project_id" }
+
+type ProjectInvitation_Email_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func ProjectInvitation_Email(v string) ProjectInvitation_Email_Field {
+	return ProjectInvitation_Email_Field{_set: true, _value: v}
+}
+
+func (f ProjectInvitation_Email_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ProjectInvitation_Email_Field) _Column() string { return "email" }
+
+type ProjectInvitation_InviterId_Field struct {
+	_set   bool
+	_null  bool
+	_value *[]byte
+}
+
+func ProjectInvitation_InviterId(v []byte) ProjectInvitation_InviterId_Field {
+	return ProjectInvitation_InviterId_Field{_set: true, _value: &v}
+}
+
+func ProjectInvitation_InviterId_Raw(v *[]byte) ProjectInvitation_InviterId_Field {
+	if v == nil {
+		return ProjectInvitation_InviterId_Null()
+	}
+	return ProjectInvitation_InviterId(*v)
+}
+
+func ProjectInvitation_InviterId_Null() ProjectInvitation_InviterId_Field {
+	return ProjectInvitation_InviterId_Field{_set: true, _null: true}
+}
+
+func (f ProjectInvitation_InviterId_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f ProjectInvitation_InviterId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ProjectInvitation_InviterId_Field) _Column() string { return "inviter_id" }
+
+type ProjectInvitation_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func ProjectInvitation_CreatedAt(v time.Time) ProjectInvitation_CreatedAt_Field {
+	return ProjectInvitation_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f ProjectInvitation_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ProjectInvitation_CreatedAt_Field) _Column() string { return "created_at" }
+
 type ProjectMember struct {
 	MemberId  []byte
 	ProjectId []byte
@@ -11992,70 +16199,143 @@ type StripecoinpaymentsApplyBalanceIntent struct {
 	CreatedAt time.Time
 }
 
-func (StripecoinpaymentsApplyBalanceIntent) _Table() string {
-	return "stripecoinpayments_apply_balance_intents"
+func (StripecoinpaymentsApplyBalanceIntent) _Table() string {
+	return "stripecoinpayments_apply_balance_intents"
+}
+
+type StripecoinpaymentsApplyBalanceIntent_Update_Fields struct {
+	State StripecoinpaymentsApplyBalanceIntent_State_Field
+}
+
+type StripecoinpaymentsApplyBalanceIntent_TxId_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func StripecoinpaymentsApplyBalanceIntent_TxId(v string) StripecoinpaymentsApplyBalanceIntent_TxId_Field {
+	return StripecoinpaymentsApplyBalanceIntent_TxId_Field{_set: true, _value: v}
+}
+
+func (f StripecoinpaymentsApplyBalanceIntent_TxId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (StripecoinpaymentsApplyBalanceIntent_TxId_Field) _Column() string { return "tx_id" }
+
+type StripecoinpaymentsApplyBalanceIntent_State_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func StripecoinpaymentsApplyBalanceIntent_State(v int) StripecoinpaymentsApplyBalanceIntent_State_Field {
+	return StripecoinpaymentsApplyBalanceIntent_State_Field{_set: true, _value: v}
+}
+
+func (f StripecoinpaymentsApplyBalanceIntent_State_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (StripecoinpaymentsApplyBalanceIntent_State_Field) _Column() string { return "state" }
+
+type StripecoinpaymentsApplyBalanceIntent_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func StripecoinpaymentsApplyBalanceIntent_CreatedAt(v time.Time) StripecoinpaymentsApplyBalanceIntent_CreatedAt_Field {
+	return StripecoinpaymentsApplyBalanceIntent_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f StripecoinpaymentsApplyBalanceIntent_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (StripecoinpaymentsApplyBalanceIntent_CreatedAt_Field) _Column() string { return "created_at" }
+
+type SupportAccessGrant struct {
+	UserId    []byte
+	ExpiresAt time.Time
+	CreatedAt time.Time
+}
+
+func (SupportAccessGrant) _Table() string { return "support_access_grants" }
+
+type SupportAccessGrant_Create_Fields struct {
 }
 
-type StripecoinpaymentsApplyBalanceIntent_Update_Fields struct {
-	State StripecoinpaymentsApplyBalanceIntent_State_Field
+type SupportAccessGrant_Update_Fields struct {
+	ExpiresAt SupportAccessGrant_ExpiresAt_Field
+	CreatedAt SupportAccessGrant_CreatedAt_Field
 }
 
-type StripecoinpaymentsApplyBalanceIntent_TxId_Field struct {
+type SupportAccessGrant_UserId_Field struct {
 	_set   bool
 	_null  bool
-	_value string
+	_value []byte
 }
 
-func StripecoinpaymentsApplyBalanceIntent_TxId(v string) StripecoinpaymentsApplyBalanceIntent_TxId_Field {
-	return StripecoinpaymentsApplyBalanceIntent_TxId_Field{_set: true, _value: v}
+func SupportAccessGrant_UserId(v []byte) SupportAccessGrant_UserId_Field {
+	return SupportAccessGrant_UserId_Field{_set: true, _value: v}
 }
 
-func (f StripecoinpaymentsApplyBalanceIntent_TxId_Field) value() interface{} {
+func (f SupportAccessGrant_UserId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StripecoinpaymentsApplyBalanceIntent_TxId_Field) _Column() string { return "tx_id" }
+func (SupportAccessGrant_UserId_Field) _Column() string { return "user_id" }
 
-type StripecoinpaymentsApplyBalanceIntent_State_Field struct {
+type SupportAccessGrant_ExpiresAt_Field struct {
 	_set   bool
 	_null  bool
-	_value int
+	_value time.Time
 }
 
-func StripecoinpaymentsApplyBalanceIntent_State(v int) StripecoinpaymentsApplyBalanceIntent_State_Field {
-	return StripecoinpaymentsApplyBalanceIntent_State_Field{_set: true, _value: v}
+func SupportAccessGrant_ExpiresAt(v time.Time) SupportAccessGrant_ExpiresAt_Field {
+	return SupportAccessGrant_ExpiresAt_Field{_set: true, _value: v}
 }
 
-func (f StripecoinpaymentsApplyBalanceIntent_State_Field) value() interface{} {
+func (f SupportAccessGrant_ExpiresAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StripecoinpaymentsApplyBalanceIntent_State_Field) _Column() string { return "state" }
+func (SupportAccessGrant_ExpiresAt_Field) _Column() string { return "expires_at" }
 
-type StripecoinpaymentsApplyBalanceIntent_CreatedAt_Field struct {
+type SupportAccessGrant_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
 	_value time.Time
 }
 
-func StripecoinpaymentsApplyBalanceIntent_CreatedAt(v time.Time) StripecoinpaymentsApplyBalanceIntent_CreatedAt_Field {
-	return StripecoinpaymentsApplyBalanceIntent_CreatedAt_Field{_set: true, _value: v}
+func SupportAccessGrant_CreatedAt(v time.Time) SupportAccessGrant_CreatedAt_Field {
+	return SupportAccessGrant_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f StripecoinpaymentsApplyBalanceIntent_CreatedAt_Field) value() interface{} {
+func (f SupportAccessGrant_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (StripecoinpaymentsApplyBalanceIntent_CreatedAt_Field) _Column() string { return "created_at" }
+func (SupportAccessGrant_CreatedAt_Field) _Column() string { return "created_at" }
 
 type UserCredit struct {
 	Id                   int
@@ -13259,6 +17539,7 @@ func (obj *pgxImpl) Create_WebappSession(ctx context.Context,
 	webapp_session_user_id WebappSession_UserId_Field,
 	webapp_session_ip_address WebappSession_IpAddress_Field,
 	webapp_session_user_agent WebappSession_UserAgent_Field,
+	webapp_session_status WebappSession_Status_Field,
 	webapp_session_expires_at WebappSession_ExpiresAt_Field) (
 	webapp_session *WebappSession, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -13266,7 +17547,7 @@ func (obj *pgxImpl) Create_WebappSession(ctx context.Context,
 	__user_id_val := webapp_session_user_id.value()
 	__ip_address_val := webapp_session_ip_address.value()
 	__user_agent_val := webapp_session_user_agent.value()
-	__status_val := int(0)
+	__status_val := webapp_session_status.value()
 	__expires_at_val := webapp_session_expires_at.value()
 
 	var __embed_stmt = __sqlbundle_Literal("INSERT INTO webapp_sessions ( id, user_id, ip_address, user_agent, status, expires_at ) VALUES ( ?, ?, ?, ?, ?, ? ) RETURNING webapp_sessions.id, webapp_sessions.user_id, webapp_sessions.ip_address, webapp_sessions.user_agent, webapp_sessions.status, webapp_sessions.expires_at")
@@ -13421,13 +17702,16 @@ func (obj *pgxImpl) CreateNoReturn_Revocation(ctx context.Context,
 	revocation_api_key_id Revocation_ApiKeyId_Field) (
 	err error) {
 	defer mon.Task()(&ctx)(&err)
+
+	__now := obj.db.Hooks.Now().UTC()
 	__revoked_val := revocation_revoked.value()
 	__api_key_id_val := revocation_api_key_id.value()
+	__created_at_val := __now
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO revocations ( revoked, api_key_id ) VALUES ( ?, ? )")
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO revocations ( revoked, api_key_id, created_at ) VALUES ( ?, ?, ? )")
 
 	var __values []interface{}
-	__values = append(__values, __revoked_val, __api_key_id_val)
+	__values = append(__values, __revoked_val, __api_key_id_val, __created_at_val)
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
@@ -14440,7 +18724,7 @@ func (obj *pgxImpl) Get_Node_By_Id(ctx context.Context,
 	node *Node, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email FROM nodes WHERE nodes.id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.exit_failed_reason, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email, nodes.protocols, nodes.hashstore, nodes.ttl_support, nodes.max_piece_size FROM nodes WHERE nodes.id = ?")
 
 	var __values []interface{}
 	__values = append(__values, node_id.value())
@@ -14449,7 +18733,7 @@ func (obj *pgxImpl) Get_Node_By_Id(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	node = &Node{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.Hash, &node.Timestamp, &node.Release, &node.Latency90, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Disqualified, &node.DisqualificationReason, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess, &node.Contained, &node.LastOfflineEmail, &node.LastSoftwareUpdateEmail)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.Hash, &node.Timestamp, &node.Release, &node.Latency90, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Disqualified, &node.DisqualificationReason, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess, &node.ExitFailedReason, &node.Contained, &node.LastOfflineEmail, &node.LastSoftwareUpdateEmail, &node.Protocols, &node.Hashstore, &node.TtlSupport, &node.MaxPieceSize)
 	if err != nil {
 		return (*Node)(nil), obj.makeErr(err)
 	}
@@ -14505,9 +18789,9 @@ func (obj *pgxImpl) Paged_Node(ctx context.Context,
 	rows []*Node, next *Paged_Node_Continuation, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email, nodes.id FROM nodes WHERE (nodes.id) > ? ORDER BY nodes.id LIMIT ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.exit_failed_reason, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email, nodes.protocols, nodes.hashstore, nodes.ttl_support, nodes.max_piece_size, nodes.id FROM nodes WHERE (nodes.id) > ? ORDER BY nodes.id LIMIT ?")
 
-	var __embed_first_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email, nodes.id FROM nodes ORDER BY nodes.id LIMIT ?")
+	var __embed_first_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.exit_failed_reason, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email, nodes.protocols, nodes.hashstore, nodes.ttl_support, nodes.max_piece_size, nodes.id FROM nodes ORDER BY nodes.id LIMIT ?")
 
 	var __values []interface{}
 
@@ -14534,7 +18818,7 @@ func (obj *pgxImpl) Paged_Node(ctx context.Context,
 
 			for __rows.Next() {
 				node := &Node{}
-				err = __rows.Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.Hash, &node.Timestamp, &node.Release, &node.Latency90, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Disqualified, &node.DisqualificationReason, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess, &node.Contained, &node.LastOfflineEmail, &node.LastSoftwareUpdateEmail, &__continuation._value_id)
+				err = __rows.Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.Hash, &node.Timestamp, &node.Release, &node.Latency90, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Disqualified, &node.DisqualificationReason, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess, &node.ExitFailedReason, &node.Contained, &node.LastOfflineEmail, &node.LastSoftwareUpdateEmail, &node.Protocols, &node.Hashstore, &node.TtlSupport, &node.MaxPieceSize, &__continuation._value_id)
 				if err != nil {
 					return nil, nil, err
 				}
@@ -17806,7 +22090,7 @@ func (obj *pgxImpl) Update_Node_By_Id(ctx context.Context,
 	defer mon.Task()(&ctx)(&err)
 	var __sets = &__sqlbundle_Hole{}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE nodes SET "), __sets, __sqlbundle_Literal(" WHERE nodes.id = ? RETURNING nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email")}}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE nodes SET "), __sets, __sqlbundle_Literal(" WHERE nodes.id = ? RETURNING nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.exit_failed_reason, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email, nodes.protocols, nodes.hashstore, nodes.ttl_support, nodes.max_piece_size")}}
 
 	__sets_sql := __sqlbundle_Literals{Join: ", "}
 	var __values []interface{}
@@ -17962,6 +22246,11 @@ func (obj *pgxImpl) Update_Node_By_Id(ctx context.Context,
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("exit_success = ?"))
 	}
 
+	if update.ExitFailedReason._set {
+		__values = append(__values, update.ExitFailedReason.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("exit_failed_reason = ?"))
+	}
+
 	if update.Contained._set {
 		__values = append(__values, update.Contained.value())
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("contained = ?"))
@@ -17977,6 +22266,26 @@ func (obj *pgxImpl) Update_Node_By_Id(ctx context.Context,
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_software_update_email = ?"))
 	}
 
+	if update.Protocols._set {
+		__values = append(__values, update.Protocols.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("protocols = ?"))
+	}
+
+	if update.Hashstore._set {
+		__values = append(__values, update.Hashstore.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("hashstore = ?"))
+	}
+
+	if update.TtlSupport._set {
+		__values = append(__values, update.TtlSupport.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("ttl_support = ?"))
+	}
+
+	if update.MaxPieceSize._set {
+		__values = append(__values, update.MaxPieceSize.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("max_piece_size = ?"))
+	}
+
 	__now := obj.db.Hooks.Now().UTC()
 
 	__values = append(__values, __now)
@@ -17991,7 +22300,7 @@ func (obj *pgxImpl) Update_Node_By_Id(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	node = &Node{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.Hash, &node.Timestamp, &node.Release, &node.Latency90, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Disqualified, &node.DisqualificationReason, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess, &node.Contained, &node.LastOfflineEmail, &node.LastSoftwareUpdateEmail)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.Hash, &node.Timestamp, &node.Release, &node.Latency90, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Disqualified, &node.DisqualificationReason, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess, &node.ExitFailedReason, &node.Contained, &node.LastOfflineEmail, &node.LastSoftwareUpdateEmail, &node.Protocols, &node.Hashstore, &node.TtlSupport, &node.MaxPieceSize)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
@@ -18164,6 +22473,11 @@ func (obj *pgxImpl) UpdateNoReturn_Node_By_Id(ctx context.Context,
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("exit_success = ?"))
 	}
 
+	if update.ExitFailedReason._set {
+		__values = append(__values, update.ExitFailedReason.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("exit_failed_reason = ?"))
+	}
+
 	if update.Contained._set {
 		__values = append(__values, update.Contained.value())
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("contained = ?"))
@@ -18179,6 +22493,26 @@ func (obj *pgxImpl) UpdateNoReturn_Node_By_Id(ctx context.Context,
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_software_update_email = ?"))
 	}
 
+	if update.Protocols._set {
+		__values = append(__values, update.Protocols.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("protocols = ?"))
+	}
+
+	if update.Hashstore._set {
+		__values = append(__values, update.Hashstore.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("hashstore = ?"))
+	}
+
+	if update.TtlSupport._set {
+		__values = append(__values, update.TtlSupport.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("ttl_support = ?"))
+	}
+
+	if update.MaxPieceSize._set {
+		__values = append(__values, update.MaxPieceSize.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("max_piece_size = ?"))
+	}
+
 	__now := obj.db.Hooks.Now().UTC()
 
 	__values = append(__values, __now)
@@ -18362,6 +22696,11 @@ func (obj *pgxImpl) UpdateNoReturn_Node_By_Id_And_Disqualified_Is_Null_And_ExitF
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("exit_success = ?"))
 	}
 
+	if update.ExitFailedReason._set {
+		__values = append(__values, update.ExitFailedReason.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("exit_failed_reason = ?"))
+	}
+
 	if update.Contained._set {
 		__values = append(__values, update.Contained.value())
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("contained = ?"))
@@ -18377,6 +22716,26 @@ func (obj *pgxImpl) UpdateNoReturn_Node_By_Id_And_Disqualified_Is_Null_And_ExitF
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_software_update_email = ?"))
 	}
 
+	if update.Protocols._set {
+		__values = append(__values, update.Protocols.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("protocols = ?"))
+	}
+
+	if update.Hashstore._set {
+		__values = append(__values, update.Hashstore.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("hashstore = ?"))
+	}
+
+	if update.TtlSupport._set {
+		__values = append(__values, update.TtlSupport.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("ttl_support = ?"))
+	}
+
+	if update.MaxPieceSize._set {
+		__values = append(__values, update.MaxPieceSize.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("max_piece_size = ?"))
+	}
+
 	__now := obj.db.Hooks.Now().UTC()
 
 	__values = append(__values, __now)
@@ -20261,6 +24620,16 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM support_access_grants;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -20281,6 +24650,16 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM project_invitations;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -20401,6 +24780,16 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM storagenode_settlement_claims;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -20521,6 +24910,46 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM project_operation_rollups;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM project_network_rules;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM project_limit_requests;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM project_deletions;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -20561,6 +24990,26 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM payout_batch_entries;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM payout_batches;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -20576,7 +25025,127 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM oauth_tokens;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM oauth_tokens;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM oauth_codes;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM oauth_clients;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM node_payout_contracts;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM node_inventories;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM node_events;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM node_churn_metrics;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM node_api_versions;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM nodes;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM maintenance_modes;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM impersonation_sessions;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM graceful_exit_segment_transfer_queue;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM graceful_exit_progress;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -20586,7 +25155,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM oauth_codes;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM graceful_exit_aborts;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -20596,7 +25165,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM oauth_clients;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM durability_reports;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -20606,7 +25175,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM node_events;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM coupon_usages;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -20616,7 +25185,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM node_api_versions;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM coupon_codes;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -20626,7 +25195,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM nodes;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM coupons;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -20636,7 +25205,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM graceful_exit_segment_transfer_queue;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM coinpayments_transactions;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -20646,7 +25215,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM graceful_exit_progress;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM bucket_storage_tallies;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -20656,7 +25225,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM coupon_usages;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM bucket_replications;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -20666,7 +25235,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM coupon_codes;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM bucket_policies;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -20676,7 +25245,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM coupons;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM bucket_notifications;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -20686,7 +25255,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM coinpayments_transactions;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM bucket_bandwidth_rollup_archives;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -20696,7 +25265,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM bucket_storage_tallies;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM bucket_bandwidth_rollups;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -20706,7 +25275,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM bucket_bandwidth_rollup_archives;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM billing_transactions;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -20716,7 +25285,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM bucket_bandwidth_rollups;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM billing_balances;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -20726,7 +25295,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM billing_transactions;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM accounting_timestamps;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -20736,7 +25305,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM billing_balances;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM accounting_rollups;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -20746,7 +25315,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM accounting_timestamps;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM account_freeze_events;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -20756,7 +25325,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM accounting_rollups;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM access_log_exports;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -20766,7 +25335,7 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM account_freeze_events;")
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM access_log_entries;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -20776,7 +25345,6 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 	count += __count
-
 	return count, nil
 
 }
@@ -21210,6 +25778,7 @@ func (obj *pgxcockroachImpl) Create_WebappSession(ctx context.Context,
 	webapp_session_user_id WebappSession_UserId_Field,
 	webapp_session_ip_address WebappSession_IpAddress_Field,
 	webapp_session_user_agent WebappSession_UserAgent_Field,
+	webapp_session_status WebappSession_Status_Field,
 	webapp_session_expires_at WebappSession_ExpiresAt_Field) (
 	webapp_session *WebappSession, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -21217,7 +25786,7 @@ func (obj *pgxcockroachImpl) Create_WebappSession(ctx context.Context,
 	__user_id_val := webapp_session_user_id.value()
 	__ip_address_val := webapp_session_ip_address.value()
 	__user_agent_val := webapp_session_user_agent.value()
-	__status_val := int(0)
+	__status_val := webapp_session_status.value()
 	__expires_at_val := webapp_session_expires_at.value()
 
 	var __embed_stmt = __sqlbundle_Literal("INSERT INTO webapp_sessions ( id, user_id, ip_address, user_agent, status, expires_at ) VALUES ( ?, ?, ?, ?, ?, ? ) RETURNING webapp_sessions.id, webapp_sessions.user_id, webapp_sessions.ip_address, webapp_sessions.user_agent, webapp_sessions.status, webapp_sessions.expires_at")
@@ -21372,13 +25941,16 @@ func (obj *pgxcockroachImpl) CreateNoReturn_Revocation(ctx context.Context,
 	revocation_api_key_id Revocation_ApiKeyId_Field) (
 	err error) {
 	defer mon.Task()(&ctx)(&err)
+
+	__now := obj.db.Hooks.Now().UTC()
 	__revoked_val := revocation_revoked.value()
 	__api_key_id_val := revocation_api_key_id.value()
+	__created_at_val := __now
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO revocations ( revoked, api_key_id ) VALUES ( ?, ? )")
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO revocations ( revoked, api_key_id, created_at ) VALUES ( ?, ?, ? )")
 
 	var __values []interface{}
-	__values = append(__values, __revoked_val, __api_key_id_val)
+	__values = append(__values, __revoked_val, __api_key_id_val, __created_at_val)
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
@@ -22391,7 +26963,7 @@ func (obj *pgxcockroachImpl) Get_Node_By_Id(ctx context.Context,
 	node *Node, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email FROM nodes WHERE nodes.id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.exit_failed_reason, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email, nodes.protocols, nodes.hashstore, nodes.ttl_support, nodes.max_piece_size FROM nodes WHERE nodes.id = ?")
 
 	var __values []interface{}
 	__values = append(__values, node_id.value())
@@ -22400,7 +26972,7 @@ func (obj *pgxcockroachImpl) Get_Node_By_Id(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	node = &Node{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.Hash, &node.Timestamp, &node.Release, &node.Latency90, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Disqualified, &node.DisqualificationReason, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess, &node.Contained, &node.LastOfflineEmail, &node.LastSoftwareUpdateEmail)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.Hash, &node.Timestamp, &node.Release, &node.Latency90, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Disqualified, &node.DisqualificationReason, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess, &node.ExitFailedReason, &node.Contained, &node.LastOfflineEmail, &node.LastSoftwareUpdateEmail, &node.Protocols, &node.Hashstore, &node.TtlSupport, &node.MaxPieceSize)
 	if err != nil {
 		return (*Node)(nil), obj.makeErr(err)
 	}
@@ -22456,9 +27028,9 @@ func (obj *pgxcockroachImpl) Paged_Node(ctx context.Context,
 	rows []*Node, next *Paged_Node_Continuation, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email, nodes.id FROM nodes WHERE (nodes.id) > ? ORDER BY nodes.id LIMIT ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.exit_failed_reason, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email, nodes.protocols, nodes.hashstore, nodes.ttl_support, nodes.max_piece_size, nodes.id FROM nodes WHERE (nodes.id) > ? ORDER BY nodes.id LIMIT ?")
 
-	var __embed_first_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email, nodes.id FROM nodes ORDER BY nodes.id LIMIT ?")
+	var __embed_first_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.exit_failed_reason, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email, nodes.protocols, nodes.hashstore, nodes.ttl_support, nodes.max_piece_size, nodes.id FROM nodes ORDER BY nodes.id LIMIT ?")
 
 	var __values []interface{}
 
@@ -22485,7 +27057,7 @@ func (obj *pgxcockroachImpl) Paged_Node(ctx context.Context,
 
 			for __rows.Next() {
 				node := &Node{}
-				err = __rows.Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.Hash, &node.Timestamp, &node.Release, &node.Latency90, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Disqualified, &node.DisqualificationReason, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess, &node.Contained, &node.LastOfflineEmail, &node.LastSoftwareUpdateEmail, &__continuation._value_id)
+				err = __rows.Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.Hash, &node.Timestamp, &node.Release, &node.Latency90, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Disqualified, &node.DisqualificationReason, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess, &node.ExitFailedReason, &node.Contained, &node.LastOfflineEmail, &node.LastSoftwareUpdateEmail, &node.Protocols, &node.Hashstore, &node.TtlSupport, &node.MaxPieceSize, &__continuation._value_id)
 				if err != nil {
 					return nil, nil, err
 				}
@@ -25757,7 +30329,7 @@ func (obj *pgxcockroachImpl) Update_Node_By_Id(ctx context.Context,
 	defer mon.Task()(&ctx)(&err)
 	var __sets = &__sqlbundle_Hole{}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE nodes SET "), __sets, __sqlbundle_Literal(" WHERE nodes.id = ? RETURNING nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email")}}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE nodes SET "), __sets, __sqlbundle_Literal(" WHERE nodes.id = ? RETURNING nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.type, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.hash, nodes.timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.exit_failed_reason, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email, nodes.protocols, nodes.hashstore, nodes.ttl_support, nodes.max_piece_size")}}
 
 	__sets_sql := __sqlbundle_Literals{Join: ", "}
 	var __values []interface{}
@@ -25913,6 +30485,11 @@ func (obj *pgxcockroachImpl) Update_Node_By_Id(ctx context.Context,
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("exit_success = ?"))
 	}
 
+	if update.ExitFailedReason._set {
+		__values = append(__values, update.ExitFailedReason.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("exit_failed_reason = ?"))
+	}
+
 	if update.Contained._set {
 		__values = append(__values, update.Contained.value())
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("contained = ?"))
@@ -25928,6 +30505,26 @@ func (obj *pgxcockroachImpl) Update_Node_By_Id(ctx context.Context,
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_software_update_email = ?"))
 	}
 
+	if update.Protocols._set {
+		__values = append(__values, update.Protocols.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("protocols = ?"))
+	}
+
+	if update.Hashstore._set {
+		__values = append(__values, update.Hashstore.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("hashstore = ?"))
+	}
+
+	if update.TtlSupport._set {
+		__values = append(__values, update.TtlSupport.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("ttl_support = ?"))
+	}
+
+	if update.MaxPieceSize._set {
+		__values = append(__values, update.MaxPieceSize.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("max_piece_size = ?"))
+	}
+
 	__now := obj.db.Hooks.Now().UTC()
 
 	__values = append(__values, __now)
@@ -25942,7 +30539,7 @@ func (obj *pgxcockroachImpl) Update_Node_By_Id(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	node = &Node{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.Hash, &node.Timestamp, &node.Release, &node.Latency90, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Disqualified, &node.DisqualificationReason, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess, &node.Contained, &node.LastOfflineEmail, &node.LastSoftwareUpdateEmail)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.Protocol, &node.Type, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.Hash, &node.Timestamp, &node.Release, &node.Latency90, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Disqualified, &node.DisqualificationReason, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess, &node.ExitFailedReason, &node.Contained, &node.LastOfflineEmail, &node.LastSoftwareUpdateEmail, &node.Protocols, &node.Hashstore, &node.TtlSupport, &node.MaxPieceSize)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
@@ -26115,6 +30712,11 @@ func (obj *pgxcockroachImpl) UpdateNoReturn_Node_By_Id(ctx context.Context,
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("exit_success = ?"))
 	}
 
+	if update.ExitFailedReason._set {
+		__values = append(__values, update.ExitFailedReason.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("exit_failed_reason = ?"))
+	}
+
 	if update.Contained._set {
 		__values = append(__values, update.Contained.value())
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("contained = ?"))
@@ -26130,6 +30732,26 @@ func (obj *pgxcockroachImpl) UpdateNoReturn_Node_By_Id(ctx context.Context,
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_software_update_email = ?"))
 	}
 
+	if update.Protocols._set {
+		__values = append(__values, update.Protocols.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("protocols = ?"))
+	}
+
+	if update.Hashstore._set {
+		__values = append(__values, update.Hashstore.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("hashstore = ?"))
+	}
+
+	if update.TtlSupport._set {
+		__values = append(__values, update.TtlSupport.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("ttl_support = ?"))
+	}
+
+	if update.MaxPieceSize._set {
+		__values = append(__values, update.MaxPieceSize.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("max_piece_size = ?"))
+	}
+
 	__now := obj.db.Hooks.Now().UTC()
 
 	__values = append(__values, __now)
@@ -26313,6 +30935,11 @@ func (obj *pgxcockroachImpl) UpdateNoReturn_Node_By_Id_And_Disqualified_Is_Null_
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("exit_success = ?"))
 	}
 
+	if update.ExitFailedReason._set {
+		__values = append(__values, update.ExitFailedReason.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("exit_failed_reason = ?"))
+	}
+
 	if update.Contained._set {
 		__values = append(__values, update.Contained.value())
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("contained = ?"))
@@ -26328,6 +30955,26 @@ func (obj *pgxcockroachImpl) UpdateNoReturn_Node_By_Id_And_Disqualified_Is_Null_
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_software_update_email = ?"))
 	}
 
+	if update.Protocols._set {
+		__values = append(__values, update.Protocols.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("protocols = ?"))
+	}
+
+	if update.Hashstore._set {
+		__values = append(__values, update.Hashstore.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("hashstore = ?"))
+	}
+
+	if update.TtlSupport._set {
+		__values = append(__values, update.TtlSupport.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("ttl_support = ?"))
+	}
+
+	if update.MaxPieceSize._set {
+		__values = append(__values, update.MaxPieceSize.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("max_piece_size = ?"))
+	}
+
 	__now := obj.db.Hooks.Now().UTC()
 
 	__values = append(__values, __now)
@@ -28212,6 +32859,16 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM support_access_grants;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -28232,6 +32889,16 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM project_invitations;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -28352,6 +33019,16 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM storagenode_settlement_claims;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -28472,6 +33149,46 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM project_operation_rollups;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM project_network_rules;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM project_limit_requests;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM project_deletions;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -28512,6 +33229,26 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM payout_batch_entries;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM payout_batches;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -28552,6 +33289,26 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM node_payout_contracts;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM node_inventories;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -28562,6 +33319,16 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM node_churn_metrics;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -28582,6 +33349,26 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM maintenance_modes;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM impersonation_sessions;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -28602,6 +33389,26 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM graceful_exit_aborts;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM durability_reports;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -28652,6 +33459,36 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM bucket_replications;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM bucket_policies;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM bucket_notifications;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -28727,7 +33564,26 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM access_log_exports;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM access_log_entries;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
 	return count, nil
 
 }
@@ -29484,13 +34340,14 @@ func (rx *Rx) Create_WebappSession(ctx context.Context,
 	webapp_session_user_id WebappSession_UserId_Field,
 	webapp_session_ip_address WebappSession_IpAddress_Field,
 	webapp_session_user_agent WebappSession_UserAgent_Field,
+	webapp_session_status WebappSession_Status_Field,
 	webapp_session_expires_at WebappSession_ExpiresAt_Field) (
 	webapp_session *WebappSession, err error) {
 	var tx *Tx
 	if tx, err = rx.getTx(ctx); err != nil {
 		return
 	}
-	return tx.Create_WebappSession(ctx, webapp_session_id, webapp_session_user_id, webapp_session_ip_address, webapp_session_user_agent, webapp_session_expires_at)
+	return tx.Create_WebappSession(ctx, webapp_session_id, webapp_session_user_id, webapp_session_ip_address, webapp_session_user_agent, webapp_session_status, webapp_session_expires_at)
 
 }
 
@@ -31162,6 +36019,7 @@ type Methods interface {
 		webapp_session_user_id WebappSession_UserId_Field,
 		webapp_session_ip_address WebappSession_IpAddress_Field,
 		webapp_session_user_agent WebappSession_UserAgent_Field,
+		webapp_session_status WebappSession_Status_Field,
 		webapp_session_expires_at WebappSession_ExpiresAt_Field) (
 		webapp_session *WebappSession, err error)
 
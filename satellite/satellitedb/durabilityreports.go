@@ -0,0 +1,102 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/attestation"
+)
+
+// ensures that durabilityReports implements attestation.DB.
+var _ attestation.DB = (*durabilityReports)(nil)
+
+// durabilityReports is an implementation of attestation.DB.
+type durabilityReports struct {
+	db *satelliteDB
+}
+
+// Insert stores the signed report.
+func (db *durabilityReports) Insert(ctx context.Context, report attestation.SignedReport) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = db.db.ExecContext(ctx, `
+		INSERT INTO durability_reports (id, project_id, bucket_name, created_at, data, signature)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`, report.ID, report.ProjectID, []byte(report.BucketName), report.CreatedAt, []byte(report.Data), report.Signature)
+	return Error.Wrap(err)
+}
+
+// Get returns the report of the bucket with the ID.
+func (db *durabilityReports) Get(ctx context.Context, projectID uuid.UUID, bucketName string, id uuid.UUID) (_ attestation.SignedReport, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	report := attestation.SignedReport{
+		ID:         id,
+		ProjectID:  projectID,
+		BucketName: bucketName,
+	}
+	err = db.db.QueryRowContext(ctx, `
+		SELECT created_at, data, signature
+		FROM durability_reports
+		WHERE id = $1 AND project_id = $2 AND bucket_name = $3
+	`, id, projectID, []byte(bucketName)).Scan(&report.CreatedAt, &report.Data, &report.Signature)
+	if err != nil {
+		if errs.Is(err, sql.ErrNoRows) {
+			return attestation.SignedReport{}, attestation.ErrReportNotFound.New("%s", id)
+		}
+		return attestation.SignedReport{}, Error.Wrap(err)
+	}
+	return report, nil
+}
+
+// List returns the reports of the bucket, the most recent first.
+func (db *durabilityReports) List(ctx context.Context, projectID uuid.UUID, bucketName string, limit int) (_ []attestation.SignedReport, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := db.db.QueryContext(ctx, `
+		SELECT id, created_at, data, signature
+		FROM durability_reports
+		WHERE project_id = $1 AND bucket_name = $2
+		ORDER BY created_at DESC, id
+		LIMIT $3
+	`, projectID, []byte(bucketName), limit)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var reports []attestation.SignedReport
+	for rows.Next() {
+		report := attestation.SignedReport{
+			ProjectID:  projectID,
+			BucketName: bucketName,
+		}
+		err := rows.Scan(&report.ID, &report.CreatedAt, &report.Data, &report.Signature)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		reports = append(reports, report)
+	}
+	return reports, Error.Wrap(rows.Err())
+}
+
+// DeleteBefore deletes the reports created before the time.
+func (db *durabilityReports) DeleteBefore(ctx context.Context, before time.Time) (_ int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	result, err := db.db.ExecContext(ctx, `
+		DELETE FROM durability_reports WHERE created_at < $1
+	`, before)
+	if err != nil {
+		return 0, Error.Wrap(err)
+	}
+	deleted, err := result.RowsAffected()
+	return deleted, Error.Wrap(err)
+}
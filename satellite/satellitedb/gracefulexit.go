@@ -486,6 +486,24 @@ func (db *gracefulexitDB) GetIncompleteFailed(ctx context.Context, nodeID storj.
 	return transferQueueItemRows, nil
 }
 
+// GetTransferQueueStats gets the number of remaining and failing transfer queue items of a node.
+func (db *gracefulexitDB) GetTransferQueueStats(ctx context.Context, nodeID storj.NodeID) (stats gracefulexit.TransferQueueStats, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	sql := `
+			SELECT
+				count(*),
+				count(last_failed_at)
+			FROM graceful_exit_segment_transfer_queue
+			WHERE node_id = ?
+				AND finished_at IS NULL`
+	err = db.db.QueryRowContext(ctx, db.db.Rebind(sql), nodeID.Bytes()).Scan(&stats.Remaining, &stats.Failing)
+	if err != nil {
+		return stats, Error.Wrap(err)
+	}
+	return stats, nil
+}
+
 // IncrementOrderLimitSendCount increments the number of times a node has been sent an order limit for transferring.
 func (db *gracefulexitDB) IncrementOrderLimitSendCount(ctx context.Context, nodeID storj.NodeID, streamID uuid.UUID, position metabase.SegmentPosition, pieceNum int32) (err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -539,6 +557,99 @@ func (db *gracefulexitDB) CountFinishedTransferQueueItemsByNode(ctx context.Cont
 	return nodesItemsCount, Error.Wrap(rows.Err())
 }
 
+// AbortExit resets the exit status of a node which has initiated but not finished a graceful exit,
+// deletes its transfer queue items and progress, and records the abort.
+func (db *gracefulexitDB) AbortExit(ctx context.Context, nodeID storj.NodeID, reason string, abortedAt time.Time) (_ *gracefulexit.Abort, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	abort := &gracefulexit.Abort{
+		NodeID:    nodeID,
+		Reason:    reason,
+		AbortedAt: abortedAt.UTC(),
+	}
+
+	err = db.db.WithTx(ctx, func(ctx context.Context, tx *dbx.Tx) error {
+		var exitInitiatedAt *time.Time
+		err := tx.Tx.QueryRowContext(ctx, `
+			SELECT exit_initiated_at FROM nodes
+			WHERE id = $1 AND exit_finished_at IS NULL
+		`, nodeID).Scan(&exitInitiatedAt)
+		if errors.Is(err, sql.ErrNoRows) {
+			return gracefulexit.ErrNotExiting.New("%s", nodeID)
+		}
+		if err != nil {
+			return err
+		}
+		if exitInitiatedAt == nil {
+			return gracefulexit.ErrNotExiting.New("%s", nodeID)
+		}
+		abort.ExitInitiatedAt = exitInitiatedAt.UTC()
+
+		result, err := tx.Tx.ExecContext(ctx, `
+			UPDATE nodes SET exit_initiated_at = NULL, exit_loop_completed_at = NULL
+			WHERE id = $1 AND exit_initiated_at = $2 AND exit_finished_at IS NULL
+		`, nodeID, *exitInitiatedAt)
+		if err != nil {
+			return err
+		}
+		affected, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if affected == 0 {
+			return gracefulexit.ErrNotExiting.New("%s", nodeID)
+		}
+
+		_, err = tx.Tx.ExecContext(ctx, `DELETE FROM graceful_exit_segment_transfer_queue WHERE node_id = $1`, nodeID)
+		if err != nil {
+			return err
+		}
+		_, err = tx.Tx.ExecContext(ctx, `DELETE FROM graceful_exit_progress WHERE node_id = $1`, nodeID)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.Tx.ExecContext(ctx, `
+			INSERT INTO graceful_exit_aborts (node_id, exit_initiated_at, reason, aborted_at)
+			VALUES ($1, $2, $3, $4)
+		`, nodeID, abort.ExitInitiatedAt, reason, abort.AbortedAt)
+		return err
+	})
+	if err != nil {
+		if gracefulexit.ErrNotExiting.Has(err) {
+			return nil, err
+		}
+		return nil, Error.Wrap(err)
+	}
+
+	return abort, nil
+}
+
+// GetLatestAbort returns the most recent graceful exit abort of a node.
+func (db *gracefulexitDB) GetLatestAbort(ctx context.Context, nodeID storj.NodeID) (_ *gracefulexit.Abort, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	abort := &gracefulexit.Abort{NodeID: nodeID}
+	var reason *string
+	err = db.db.QueryRowContext(ctx, `
+		SELECT exit_initiated_at, reason, aborted_at FROM graceful_exit_aborts
+		WHERE node_id = $1
+		ORDER BY aborted_at DESC
+		LIMIT 1
+	`, nodeID).Scan(&abort.ExitInitiatedAt, &reason, &abort.AbortedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, gracefulexit.ErrNodeNotFound.Wrap(err)
+	}
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	if reason != nil {
+		abort.Reason = *reason
+	}
+
+	return abort, nil
+}
+
 func scanRows(rows tagsql.Rows) (transferQueueItemRows []*gracefulexit.TransferQueueItem, err error) {
 	for rows.Next() {
 		transferQueueItem := &gracefulexit.TransferQueueItem{}
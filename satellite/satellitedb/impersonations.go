@@ -0,0 +1,140 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/console"
+)
+
+// ensures that impersonations implements console.Impersonations.
+var _ console.Impersonations = (*impersonations)(nil)
+
+// impersonations is an implementation of console.Impersonations.
+type impersonations struct {
+	db *satelliteDB
+}
+
+// Insert records an impersonation session.
+func (db *impersonations) Insert(ctx context.Context, session *console.ImpersonationSession) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if session == nil {
+		return Error.New("impersonation session is nil")
+	}
+
+	_, err = db.db.ExecContext(ctx, `
+		INSERT INTO impersonation_sessions (id, user_id, admin, reason, consented, created_at, expires_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`, session.ID, session.UserID, session.Admin, session.Reason, session.Consented, session.CreatedAt, session.ExpiresAt)
+	return Error.Wrap(err)
+}
+
+// Get returns the impersonation session with the given ID. It returns
+// sql.ErrNoRows unwrapped, when the session doesn't exist.
+func (db *impersonations) Get(ctx context.Context, id uuid.UUID) (_ *console.ImpersonationSession, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var session console.ImpersonationSession
+	err = db.db.QueryRowContext(ctx, `
+		SELECT id, user_id, admin, reason, consented, created_at, expires_at
+		FROM impersonation_sessions
+		WHERE id = $1
+	`, id).Scan(&session.ID, &session.UserID, &session.Admin, &session.Reason, &session.Consented, &session.CreatedAt, &session.ExpiresAt)
+	if err != nil {
+		if errs.Is(err, sql.ErrNoRows) {
+			return nil, err
+		}
+		return nil, Error.Wrap(err)
+	}
+	return &session, nil
+}
+
+// GetByUserID returns the impersonation sessions of the user, the most recent first.
+func (db *impersonations) GetByUserID(ctx context.Context, userID uuid.UUID) (_ []console.ImpersonationSession, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := db.db.QueryContext(ctx, `
+		SELECT id, user_id, admin, reason, consented, created_at, expires_at
+		FROM impersonation_sessions
+		WHERE user_id = $1
+		ORDER BY created_at DESC, id
+	`, userID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var sessions []console.ImpersonationSession
+	for rows.Next() {
+		var session console.ImpersonationSession
+		err := rows.Scan(&session.ID, &session.UserID, &session.Admin, &session.Reason, &session.Consented, &session.CreatedAt, &session.ExpiresAt)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		sessions = append(sessions, session)
+	}
+
+	return sessions, Error.Wrap(rows.Err())
+}
+
+// UpsertSupportAccess grants support access to the account of the user until
+// the grant expires, it replaces the previous grant of the user.
+func (db *impersonations) UpsertSupportAccess(ctx context.Context, grant *console.SupportAccessGrant) (_ *console.SupportAccessGrant, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if grant == nil {
+		return nil, Error.New("support access grant is nil")
+	}
+
+	var upserted console.SupportAccessGrant
+	err = db.db.QueryRowContext(ctx, `
+		INSERT INTO support_access_grants (user_id, expires_at, created_at)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (user_id) DO UPDATE SET
+			expires_at = EXCLUDED.expires_at,
+			created_at = EXCLUDED.created_at
+		RETURNING user_id, expires_at, created_at
+	`, grant.UserID, grant.ExpiresAt, grant.CreatedAt).Scan(&upserted.UserID, &upserted.ExpiresAt, &upserted.CreatedAt)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return &upserted, nil
+}
+
+// GetSupportAccess returns the support access grant of the user. It returns
+// sql.ErrNoRows unwrapped, when the user hasn't granted support access.
+func (db *impersonations) GetSupportAccess(ctx context.Context, userID uuid.UUID) (_ *console.SupportAccessGrant, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var grant console.SupportAccessGrant
+	err = db.db.QueryRowContext(ctx, `
+		SELECT user_id, expires_at, created_at
+		FROM support_access_grants
+		WHERE user_id = $1
+	`, userID).Scan(&grant.UserID, &grant.ExpiresAt, &grant.CreatedAt)
+	if err != nil {
+		if errs.Is(err, sql.ErrNoRows) {
+			return nil, err
+		}
+		return nil, Error.Wrap(err)
+	}
+	return &grant, nil
+}
+
+// DeleteSupportAccess removes the support access grant of the user.
+func (db *impersonations) DeleteSupportAccess(ctx context.Context, userID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = db.db.ExecContext(ctx, `
+		DELETE FROM support_access_grants
+		WHERE user_id = $1
+	`, userID)
+	return Error.Wrap(err)
+}
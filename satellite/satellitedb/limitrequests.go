@@ -0,0 +1,199 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+	"storj.io/private/tagsql"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/satellitedb/dbx"
+)
+
+// ensures that limitRequests implements console.LimitRequests.
+var _ console.LimitRequests = (*limitRequests)(nil)
+
+// limitRequests is an implementation of console.LimitRequests.
+type limitRequests struct {
+	db *satelliteDB
+}
+
+const limitRequestColumns = `id, project_id, user_id,
+	kind, current_limit, requested_limit, current_usage, justification,
+	status, reviewer, review_comment, created_at, reviewed_at`
+
+// Insert records a limit increase request.
+func (db *limitRequests) Insert(ctx context.Context, request *console.LimitRequest) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if request == nil {
+		return Error.New("limit request is nil")
+	}
+
+	_, err = db.db.ExecContext(ctx, `
+		INSERT INTO project_limit_requests (`+limitRequestColumns+`)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+	`, request.ID, request.ProjectID, request.UserID,
+		string(request.Kind), request.CurrentLimit, request.RequestedLimit, request.CurrentUsage, request.Justification,
+		string(request.Status), request.Reviewer, request.ReviewComment, request.CreatedAt, request.ReviewedAt)
+	return Error.Wrap(err)
+}
+
+// Get returns the limit increase request with the given ID. It returns
+// sql.ErrNoRows unwrapped, when the request doesn't exist.
+func (db *limitRequests) Get(ctx context.Context, id uuid.UUID) (_ *console.LimitRequest, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	request, err := scanLimitRequest(db.db.QueryRowContext(ctx, `
+		SELECT `+limitRequestColumns+`
+		FROM project_limit_requests
+		WHERE id = $1
+	`, id))
+	if err != nil {
+		if errs.Is(err, sql.ErrNoRows) {
+			return nil, err
+		}
+		return nil, Error.Wrap(err)
+	}
+	return request, nil
+}
+
+// GetByProjectID returns the limit increase requests of the project, the most recent first.
+func (db *limitRequests) GetByProjectID(ctx context.Context, projectID uuid.UUID) (_ []console.LimitRequest, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := db.db.QueryContext(ctx, `
+		SELECT `+limitRequestColumns+`
+		FROM project_limit_requests
+		WHERE project_id = $1
+		ORDER BY created_at DESC, id
+	`, projectID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return scanLimitRequests(rows)
+}
+
+// ListPending returns the requests, which aren't reviewed yet, the oldest first.
+func (db *limitRequests) ListPending(ctx context.Context, limit int) (_ []console.LimitRequest, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := db.db.QueryContext(ctx, `
+		SELECT `+limitRequestColumns+`
+		FROM project_limit_requests
+		WHERE status = $1
+		ORDER BY created_at, id
+		LIMIT $2
+	`, string(console.LimitRequestPending), limit)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return scanLimitRequests(rows)
+}
+
+// Review stores the outcome of the review of a pending request and applies
+// the limit of an approved request to the project in the same transaction.
+// It returns sql.ErrNoRows unwrapped, when the request doesn't exist or was
+// reviewed already.
+func (db *limitRequests) Review(ctx context.Context, request *console.LimitRequest) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if request == nil {
+		return Error.New("limit request is nil")
+	}
+
+	var limitColumn string
+	if request.Status == console.LimitRequestApproved {
+		switch request.Kind {
+		case console.LimitKindStorage:
+			limitColumn = "usage_limit"
+		case console.LimitKindBandwidth:
+			limitColumn = "bandwidth_limit"
+		case console.LimitKindSegment:
+			limitColumn = "segment_limit"
+		default:
+			return Error.New("unknown limit kind %q", request.Kind)
+		}
+	}
+
+	err = db.db.WithTx(ctx, func(ctx context.Context, tx *dbx.Tx) error {
+		result, err := tx.Tx.ExecContext(ctx, `
+			UPDATE project_limit_requests SET
+				status = $3,
+				reviewer = $4,
+				review_comment = $5,
+				reviewed_at = $6
+			WHERE id = $1 AND status = $2
+		`, request.ID, string(console.LimitRequestPending),
+			string(request.Status), request.Reviewer, request.ReviewComment, request.ReviewedAt)
+		if err != nil {
+			return err
+		}
+
+		affected, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if affected == 0 {
+			return sql.ErrNoRows
+		}
+
+		if limitColumn == "" {
+			return nil
+		}
+
+		result, err = tx.Tx.ExecContext(ctx, `
+			UPDATE projects SET `+limitColumn+` = $2 WHERE id = $1
+		`, request.ProjectID, request.RequestedLimit)
+		if err != nil {
+			return err
+		}
+
+		affected, err = result.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if affected == 0 {
+			return errs.New("project %s not found", request.ProjectID)
+		}
+		return nil
+	})
+	if errs.Is(err, sql.ErrNoRows) {
+		return sql.ErrNoRows
+	}
+	return Error.Wrap(err)
+}
+
+func scanLimitRequests(rows tagsql.Rows) (_ []console.LimitRequest, err error) {
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var requests []console.LimitRequest
+	for rows.Next() {
+		request, err := scanLimitRequest(rows)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		requests = append(requests, *request)
+	}
+
+	return requests, Error.Wrap(rows.Err())
+}
+
+func scanLimitRequest(row rowScanner) (*console.LimitRequest, error) {
+	var request console.LimitRequest
+	var kind, status string
+	err := row.Scan(&request.ID, &request.ProjectID, &request.UserID,
+		&kind, &request.CurrentLimit, &request.RequestedLimit, &request.CurrentUsage, &request.Justification,
+		&status, &request.Reviewer, &request.ReviewComment, &request.CreatedAt, &request.ReviewedAt)
+	if err != nil {
+		return nil, err
+	}
+	request.Kind = console.LimitKind(kind)
+	request.Status = console.LimitRequestStatus(status)
+	return &request, nil
+}
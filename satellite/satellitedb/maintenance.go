@@ -0,0 +1,57 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"storj.io/storj/satellite/maintenance"
+)
+
+// maintenanceModeID is the id of the single row holding the state.
+const maintenanceModeID = 1
+
+type maintenanceDB struct {
+	db *satelliteDB
+}
+
+// Get returns the current maintenance mode state.
+func (db *maintenanceDB) Get(ctx context.Context) (state maintenance.State, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var retryAfter int
+	err = db.db.QueryRowContext(ctx, `
+		SELECT enabled, reason, retry_after, updated_at
+		FROM maintenance_modes
+		WHERE id = $1
+	`, maintenanceModeID).Scan(&state.Enabled, &state.Reason, &retryAfter, &state.UpdatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return maintenance.State{}, nil
+	}
+	if err != nil {
+		return maintenance.State{}, maintenance.Error.Wrap(err)
+	}
+
+	state.RetryAfter = time.Duration(retryAfter) * time.Second
+	return state, nil
+}
+
+// Set replaces the current maintenance mode state.
+func (db *maintenanceDB) Set(ctx context.Context, state maintenance.State) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = db.db.ExecContext(ctx, `
+		INSERT INTO maintenance_modes (id, enabled, reason, retry_after, updated_at)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (id) DO UPDATE SET
+			enabled = EXCLUDED.enabled,
+			reason = EXCLUDED.reason,
+			retry_after = EXCLUDED.retry_after,
+			updated_at = EXCLUDED.updated_at
+	`, maintenanceModeID, state.Enabled, state.Reason, int(state.RetryAfter/time.Second), state.UpdatedAt)
+	return maintenance.Error.Wrap(err)
+}
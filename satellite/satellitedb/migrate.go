@@ -142,6 +142,51 @@ func (db *satelliteDB) CheckVersion(ctx context.Context) error {
 	}
 }
 
+// MigrationPlan returns the migration steps, which MigrateToLatest would apply.
+func (db *satelliteDB) MigrationPlan(ctx context.Context) ([]migrate.PlannedStep, error) {
+	switch db.impl {
+	case dbutil.Postgres, dbutil.Cockroach:
+		migration := db.PostgresMigration()
+		return migration.Plan(ctx, db.log.Named("migrate"))
+
+	default:
+		return nil, ErrMigrate.New("unsupported database: %v", db.impl)
+	}
+}
+
+// migrationGuard returns the guard against long locking migration steps.
+func (db *satelliteDB) migrationGuard() *migrate.LockGuard {
+	if db.opts.MigrationMaxLockedRows <= 0 {
+		return nil
+	}
+	return &migrate.LockGuard{
+		MaxRows:      db.opts.MigrationMaxLockedRows,
+		EstimateRows: db.estimateRows,
+	}
+}
+
+// estimateRows returns the estimated number of rows of the table from the
+// table statistics.
+func (db *satelliteDB) estimateRows(ctx context.Context, conn tagsql.DB, table string) (rows int64, err error) {
+	switch db.impl {
+	case dbutil.Cockroach:
+		err = conn.QueryRowContext(ctx, `
+			SELECT COALESCE(MAX(estimated_row_count), 0)::INT8
+			FROM crdb_internal.table_row_statistics
+			WHERE table_name = $1
+		`, table).Scan(&rows)
+	case dbutil.Postgres:
+		err = conn.QueryRowContext(ctx, `
+			SELECT COALESCE(MAX(reltuples), 0)::INT8
+			FROM pg_class
+			WHERE oid = to_regclass($1)
+		`, table).Scan(&rows)
+	default:
+		return 0, ErrMigrate.New("unsupported database: %v", db.impl)
+	}
+	return rows, errs.Wrap(err)
+}
+
 // TestPostgresMigration returns steps needed for migrating test postgres database.
 func (db *satelliteDB) TestPostgresMigration() *migrate.Migration {
 	return db.testMigration()
@@ -151,6 +196,7 @@ func (db *satelliteDB) TestPostgresMigration() *migrate.Migration {
 func (db *satelliteDB) PostgresMigration() *migrate.Migration {
 	return &migrate.Migration{
 		Table: "versions",
+		Guard: db.migrationGuard(),
 		Steps: []*migrate.Step{
 			{
 				DB:          &db.migrationDB,
@@ -2187,6 +2233,422 @@ func (db *satelliteDB) PostgresMigration() *migrate.Migration {
 					);`,
 				},
 			},
+			{
+				DB:          &db.migrationDB,
+				Description: "Create graceful_exit_aborts table",
+				Version:     220,
+				Action: migrate.SQL{
+					`CREATE TABLE graceful_exit_aborts (
+						node_id bytea NOT NULL,
+						exit_initiated_at timestamp with time zone NOT NULL,
+						reason text,
+						aborted_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+						PRIMARY KEY ( node_id, aborted_at )
+					);`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "Create maintenance_modes table",
+				Version:     221,
+				Action: migrate.SQL{
+					`CREATE TABLE maintenance_modes (
+						id integer NOT NULL,
+						enabled boolean NOT NULL,
+						reason text NOT NULL,
+						retry_after integer NOT NULL,
+						updated_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( id )
+					);`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "Create bucket_replications table",
+				Version:     222,
+				Action: migrate.SQL{
+					`CREATE TABLE bucket_replications (
+						project_id bytea NOT NULL,
+						bucket_name bytea NOT NULL,
+						source_access text NOT NULL,
+						destination_access text NOT NULL,
+						destination_bucket bytea NOT NULL,
+						replicated_until timestamp with time zone NOT NULL,
+						replicated_key bytea NOT NULL,
+						replicated_objects bigint NOT NULL,
+						backlog bigint NOT NULL,
+						last_run_at timestamp with time zone,
+						last_error text,
+						created_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( project_id, bucket_name )
+					);`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "Create bucket_notifications table",
+				Version:     223,
+				Action: migrate.SQL{
+					`CREATE TABLE bucket_notifications (
+						project_id bytea NOT NULL,
+						bucket_name bytea NOT NULL,
+						object_created boolean NOT NULL,
+						object_deleted boolean NOT NULL,
+						prefix bytea NOT NULL,
+						sink_type text NOT NULL,
+						sink_url text NOT NULL,
+						sink_topic text NOT NULL,
+						sink_auth_token text NOT NULL,
+						created_at timestamp with time zone NOT NULL,
+						updated_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( project_id, bucket_name )
+					);`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "Create bucket_policies table",
+				Version:     224,
+				Action: migrate.SQL{
+					`CREATE TABLE bucket_policies (
+						project_id bytea NOT NULL,
+						bucket_name bytea NOT NULL,
+						policy text NOT NULL,
+						created_at timestamp with time zone NOT NULL,
+						updated_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( project_id, bucket_name )
+					);`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "Create access_log_entries and access_log_exports tables",
+				Version:     225,
+				Action: migrate.SQL{
+					`CREATE TABLE access_log_entries (
+						project_id bytea NOT NULL,
+						logged_at timestamp with time zone NOT NULL,
+						id bytea NOT NULL,
+						api_key_id bytea NOT NULL,
+						bucket_name bytea NOT NULL,
+						object_key bytea NOT NULL,
+						operation text NOT NULL,
+						bytes bigint NOT NULL,
+						PRIMARY KEY ( project_id, logged_at, id )
+					);`,
+					`CREATE TABLE access_log_exports (
+						project_id bytea NOT NULL,
+						destination_access text NOT NULL,
+						destination_bucket bytea NOT NULL,
+						prefix text NOT NULL,
+						exported_until timestamp with time zone NOT NULL,
+						last_run_at timestamp with time zone,
+						last_error text,
+						created_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( project_id )
+					);`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "Create node_payout_contracts table",
+				Version:     226,
+				Action: migrate.SQL{
+					`CREATE TABLE node_payout_contracts (
+						node_id bytea NOT NULL,
+						at_rest_gb_hours text,
+						get_tb text,
+						put_tb text,
+						get_repair_tb text,
+						put_repair_tb text,
+						get_audit_tb text,
+						created_at timestamp with time zone NOT NULL,
+						updated_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( node_id )
+					);`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "Create payout_batches and payout_batch_entries tables",
+				Version:     227,
+				Action: migrate.SQL{
+					`CREATE TABLE payout_batches (
+						id bytea NOT NULL,
+						period text NOT NULL,
+						network text NOT NULL,
+						status text NOT NULL,
+						transaction_hash text,
+						created_at timestamp with time zone NOT NULL,
+						submitted_at timestamp with time zone,
+						confirmed_at timestamp with time zone,
+						PRIMARY KEY ( id )
+					);`,
+					`CREATE TABLE payout_batch_entries (
+						batch_id bytea NOT NULL,
+						node_id bytea NOT NULL,
+						wallet text NOT NULL,
+						amount bigint NOT NULL,
+						PRIMARY KEY ( batch_id, node_id )
+					);`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "Add check-in capabilities to nodes",
+				Version:     228,
+				Action: migrate.SQL{
+					`ALTER TABLE nodes ADD COLUMN protocols text NOT NULL DEFAULT '';`,
+					`ALTER TABLE nodes ADD COLUMN hashstore boolean NOT NULL DEFAULT false;`,
+					`ALTER TABLE nodes ADD COLUMN ttl_support boolean NOT NULL DEFAULT false;`,
+					`ALTER TABLE nodes ADD COLUMN max_piece_size bigint NOT NULL DEFAULT 0;`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "Add storagenode_settlement_claims table",
+				Version:     229,
+				Action: migrate.SQL{
+					`CREATE TABLE storagenode_settlement_claims (
+						storagenode_id bytea NOT NULL,
+						interval_start timestamp with time zone NOT NULL,
+						action integer NOT NULL,
+						amount bigint NOT NULL,
+						status integer NOT NULL,
+						updated_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( storagenode_id, interval_start, action )
+					);`,
+					`CREATE INDEX storagenode_settlement_claims_interval_start_index ON storagenode_settlement_claims ( interval_start );`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add created_at to revocations for incremental revocation list updates",
+				Version:     230,
+				Action: migrate.SQL{
+					`ALTER TABLE revocations ADD COLUMN created_at timestamp with time zone NOT NULL DEFAULT current_timestamp;`,
+					`CREATE INDEX revocations_created_at_index ON revocations ( created_at );`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "Add node_churn_metrics table",
+				Version:     231,
+				Action: migrate.SQL{
+					`CREATE TABLE node_churn_metrics (
+						day date NOT NULL,
+						new_nodes integer NOT NULL,
+						exited_nodes integer NOT NULL,
+						disqualified_nodes integer NOT NULL,
+						active_nodes integer NOT NULL,
+						free_disk bigint NOT NULL,
+						capacity_change bigint NOT NULL,
+						updated_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( day )
+					);`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "Add project_invitations table",
+				Version:     232,
+				Action: migrate.SQL{
+					`CREATE TABLE project_invitations (
+						project_id bytea NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
+						email text NOT NULL,
+						inviter_id bytea REFERENCES users( id ) ON DELETE SET NULL,
+						created_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( project_id, email )
+					);`,
+					`CREATE INDEX project_invitations_email_index ON project_invitations ( email );`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "Add impersonation_sessions and support_access_grants tables",
+				Version:     233,
+				Action: migrate.SQL{
+					`CREATE TABLE impersonation_sessions (
+						id bytea NOT NULL,
+						user_id bytea NOT NULL,
+						admin text NOT NULL,
+						reason text NOT NULL,
+						consented boolean NOT NULL,
+						created_at timestamp with time zone NOT NULL,
+						expires_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( id )
+					);`,
+					`CREATE INDEX impersonation_sessions_user_id_index ON impersonation_sessions ( user_id );`,
+					`CREATE TABLE support_access_grants (
+						user_id bytea NOT NULL REFERENCES users( id ) ON DELETE CASCADE,
+						expires_at timestamp with time zone NOT NULL,
+						created_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( user_id )
+					);`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "Add durability_reports table",
+				Version:     234,
+				Action: migrate.SQL{
+					`CREATE TABLE durability_reports (
+						id bytea NOT NULL,
+						project_id bytea NOT NULL,
+						bucket_name bytea NOT NULL,
+						created_at timestamp with time zone NOT NULL,
+						data bytea NOT NULL,
+						signature bytea NOT NULL,
+						PRIMARY KEY ( id )
+					);`,
+					`CREATE INDEX durability_reports_project_id_bucket_name_created_at_index ON durability_reports ( project_id, bucket_name, created_at );`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "Add project_deletions table",
+				Version:     235,
+				Action: migrate.SQL{
+					`CREATE TABLE project_deletions (
+						project_id bytea NOT NULL,
+						requested_by bytea NOT NULL,
+						stage text NOT NULL,
+						deleted_buckets bigint NOT NULL DEFAULT 0,
+						deleted_objects bigint NOT NULL DEFAULT 0,
+						deleted_segments bigint NOT NULL DEFAULT 0,
+						deleted_api_keys bigint NOT NULL DEFAULT 0,
+						last_error text NOT NULL DEFAULT '',
+						created_at timestamp with time zone NOT NULL,
+						updated_at timestamp with time zone NOT NULL,
+						finished_at timestamp with time zone,
+						PRIMARY KEY ( project_id )
+					);`,
+					`CREATE INDEX project_deletions_requested_by_index ON project_deletions ( requested_by );`,
+					`CREATE INDEX project_deletions_stage_created_at_index ON project_deletions ( stage, created_at );`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "Add project_limit_requests table",
+				Version:     236,
+				Action: migrate.SQL{
+					`CREATE TABLE project_limit_requests (
+						id bytea NOT NULL,
+						project_id bytea NOT NULL,
+						user_id bytea NOT NULL,
+						kind text NOT NULL,
+						current_limit bigint NOT NULL,
+						requested_limit bigint NOT NULL,
+						current_usage bigint NOT NULL,
+						justification text NOT NULL,
+						status text NOT NULL,
+						reviewer text NOT NULL DEFAULT '',
+						review_comment text NOT NULL DEFAULT '',
+						created_at timestamp with time zone NOT NULL,
+						reviewed_at timestamp with time zone,
+						PRIMARY KEY ( id )
+					);`,
+					`CREATE INDEX project_limit_requests_project_id_created_at_index ON project_limit_requests ( project_id, created_at );`,
+					`CREATE INDEX project_limit_requests_status_created_at_index ON project_limit_requests ( status, created_at );`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "Add project_operation_rollups table",
+				Version:     237,
+				Action: migrate.SQL{
+					`CREATE TABLE project_operation_rollups (
+						project_id bytea NOT NULL,
+						interval_start timestamp with time zone NOT NULL,
+						uploads bigint NOT NULL DEFAULT 0,
+						downloads bigint NOT NULL DEFAULT 0,
+						lists bigint NOT NULL DEFAULT 0,
+						deletes bigint NOT NULL DEFAULT 0,
+						errors bigint NOT NULL DEFAULT 0,
+						PRIMARY KEY ( project_id, interval_start )
+					);`,
+					`CREATE INDEX project_operation_rollups_interval_start_index ON project_operation_rollups ( interval_start );`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "Add project_network_rules table",
+				Version:     238,
+				Action: migrate.SQL{
+					`CREATE TABLE project_network_rules (
+						project_id bytea NOT NULL,
+						network text NOT NULL,
+						allow boolean NOT NULL,
+						created_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( project_id, network )
+					);`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "Add node_inventories table",
+				Version:     239,
+				Action: migrate.SQL{
+					`CREATE TABLE node_inventories (
+						node_id bytea NOT NULL,
+						os text NOT NULL,
+						arch text NOT NULL,
+						runtime text NOT NULL,
+						storage_backend text NOT NULL,
+						filesystem text NOT NULL,
+						updated_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( node_id )
+					);`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "Add pass_started_at to bucket_replications",
+				Version:     240,
+				Action: migrate.SQL{
+					`ALTER TABLE bucket_replications ADD COLUMN pass_started_at timestamp with time zone;`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "Add period and failed to payout_batch_entries",
+				Version:     241,
+				Action: migrate.SQL{
+					`ALTER TABLE payout_batch_entries ADD COLUMN period text NOT NULL DEFAULT '';`,
+					`ALTER TABLE payout_batch_entries ADD COLUMN failed boolean NOT NULL DEFAULT false;`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "back fill period and failed of payout_batch_entries from their batches",
+				Version:     242,
+				SeparateTx:  true,
+				Action: migrate.SQL{
+					`UPDATE payout_batch_entries
+						SET period = payout_batches.period, failed = (payout_batches.status = 'failed')
+						FROM payout_batches
+						WHERE payout_batches.id = payout_batch_entries.batch_id;`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "drop defaults of payout_batch_entries and allow a node only in a single batch of a period, which didn't fail",
+				Version:     243,
+				SeparateTx:  true,
+				Action: migrate.SQL{
+					`ALTER TABLE payout_batch_entries ALTER COLUMN period DROP DEFAULT;`,
+					`ALTER TABLE payout_batch_entries ALTER COLUMN failed DROP DEFAULT;`,
+					`CREATE UNIQUE INDEX payout_batch_entries_period_node_id_index ON payout_batch_entries ( period, node_id ) WHERE payout_batch_entries.failed = false;`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add exit_failed_reason to nodes",
+				Version:     244,
+				Action: migrate.SQL{
+					`ALTER TABLE nodes ADD COLUMN exit_failed_reason integer;`,
+				},
+			},
 			// NB: after updating testdata in `testdata`, run
 			//     `go generate` to update `migratez.go`.
 		},
@@ -13,9 +13,31 @@ func (db *satelliteDB) testMigration() *migrate.Migration {
 			{
 				DB:          &db.migrationDB,
 				Description: "Testing setup",
-				Version:     219,
+				Version:     244,
 				Action: migrate.SQL{`-- AUTOGENERATED BY storj.io/dbx
 -- DO NOT EDIT
+CREATE TABLE access_log_entries (
+	project_id bytea NOT NULL,
+	logged_at timestamp with time zone NOT NULL,
+	id bytea NOT NULL,
+	api_key_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	object_key bytea NOT NULL,
+	operation text NOT NULL,
+	bytes bigint NOT NULL,
+	PRIMARY KEY ( project_id, logged_at, id )
+);
+CREATE TABLE access_log_exports (
+	project_id bytea NOT NULL,
+	destination_access text NOT NULL,
+	destination_bucket bytea NOT NULL,
+	prefix text NOT NULL,
+	exported_until timestamp with time zone NOT NULL,
+	last_run_at timestamp with time zone,
+	last_error text,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id )
+);
 CREATE TABLE account_freeze_events (
 	user_id bytea NOT NULL,
 	event integer NOT NULL,
@@ -82,6 +104,44 @@ CREATE TABLE bucket_bandwidth_rollup_archives (
 	settled bigint NOT NULL,
 	PRIMARY KEY ( bucket_name, project_id, interval_start, action )
 );
+CREATE TABLE bucket_notifications (
+	project_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	object_created boolean NOT NULL,
+	object_deleted boolean NOT NULL,
+	prefix bytea NOT NULL,
+	sink_type text NOT NULL,
+	sink_url text NOT NULL,
+	sink_topic text NOT NULL,
+	sink_auth_token text NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name )
+);
+CREATE TABLE bucket_policies (
+	project_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	policy text NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name )
+);
+CREATE TABLE bucket_replications (
+	project_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	source_access text NOT NULL,
+	destination_access text NOT NULL,
+	destination_bucket bytea NOT NULL,
+	replicated_until timestamp with time zone NOT NULL,
+	replicated_key bytea NOT NULL,
+	pass_started_at timestamp with time zone,
+	replicated_objects bigint NOT NULL,
+	backlog bigint NOT NULL,
+	last_run_at timestamp with time zone,
+	last_error text,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name )
+);
 CREATE TABLE bucket_storage_tallies (
 	bucket_name bytea NOT NULL,
 	project_id bytea NOT NULL,
@@ -139,6 +199,22 @@ CREATE TABLE coupon_usages (
 	period timestamp with time zone NOT NULL,
 	PRIMARY KEY ( coupon_id, period )
 );
+CREATE TABLE durability_reports (
+	id bytea NOT NULL,
+	project_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	data bytea NOT NULL,
+	signature bytea NOT NULL,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE graceful_exit_aborts (
+	node_id bytea NOT NULL,
+	exit_initiated_at timestamp with time zone NOT NULL,
+	reason text,
+	aborted_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+	PRIMARY KEY ( node_id, aborted_at )
+);
 CREATE TABLE graceful_exit_progress (
 	node_id bytea NOT NULL,
 	bytes_transferred bigint NOT NULL,
@@ -163,6 +239,24 @@ CREATE TABLE graceful_exit_segment_transfer_queue (
 	order_limit_send_count integer NOT NULL DEFAULT 0,
 	PRIMARY KEY ( node_id, stream_id, position, piece_num )
 );
+CREATE TABLE impersonation_sessions (
+	id bytea NOT NULL,
+	user_id bytea NOT NULL,
+	admin text NOT NULL,
+	reason text NOT NULL,
+	consented boolean NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	expires_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE maintenance_modes (
+	id integer NOT NULL,
+	enabled boolean NOT NULL,
+	reason text NOT NULL,
+	retry_after integer NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id )
+);
 CREATE TABLE nodes (
 	id bytea NOT NULL,
 	address text NOT NULL DEFAULT '',
@@ -197,11 +291,27 @@ CREATE TABLE nodes (
 	exit_loop_completed_at timestamp with time zone,
 	exit_finished_at timestamp with time zone,
 	exit_success boolean NOT NULL DEFAULT false,
+	exit_failed_reason integer,
 	contained timestamp with time zone,
 	last_offline_email timestamp with time zone,
 	last_software_update_email timestamp with time zone,
+	protocols text NOT NULL DEFAULT '',
+	hashstore boolean NOT NULL DEFAULT false,
+	ttl_support boolean NOT NULL DEFAULT false,
+	max_piece_size bigint NOT NULL DEFAULT 0,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE node_churn_metrics (
+	day date NOT NULL,
+	new_nodes integer NOT NULL,
+	exited_nodes integer NOT NULL,
+	disqualified_nodes integer NOT NULL,
+	active_nodes integer NOT NULL,
+	free_disk bigint NOT NULL,
+	capacity_change bigint NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( day )
+);
 CREATE TABLE node_events (
 	id bytea NOT NULL,
 	email text NOT NULL,
@@ -219,6 +329,28 @@ CREATE TABLE node_api_versions (
 	updated_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE node_inventories (
+	node_id bytea NOT NULL,
+	os text NOT NULL,
+	arch text NOT NULL,
+	runtime text NOT NULL,
+	storage_backend text NOT NULL,
+	filesystem text NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( node_id )
+);
+CREATE TABLE node_payout_contracts (
+	node_id bytea NOT NULL,
+	at_rest_gb_hours text,
+	get_tb text,
+	put_tb text,
+	get_repair_tb text,
+	put_repair_tb text,
+	get_audit_tb text,
+	created_at timestamp with time zone NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( node_id )
+);
 CREATE TABLE oauth_clients (
 	id bytea NOT NULL,
 	encrypted_secret bytea NOT NULL,
@@ -266,6 +398,26 @@ CREATE TABLE offers (
 	type integer NOT NULL,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE payout_batches (
+	id bytea NOT NULL,
+	period text NOT NULL,
+	network text NOT NULL,
+	status text NOT NULL,
+	transaction_hash text,
+	created_at timestamp with time zone NOT NULL,
+	submitted_at timestamp with time zone,
+	confirmed_at timestamp with time zone,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE payout_batch_entries (
+	batch_id bytea NOT NULL,
+	node_id bytea NOT NULL,
+	wallet text NOT NULL,
+	amount bigint NOT NULL,
+	period text NOT NULL,
+	failed boolean NOT NULL,
+	PRIMARY KEY ( batch_id, node_id )
+);
 CREATE TABLE peer_identities (
 	node_id bytea NOT NULL,
 	leaf_serial_number bytea NOT NULL,
@@ -307,6 +459,53 @@ CREATE TABLE project_bandwidth_rollups (
 	egress_allocated bigint NOT NULL,
 	PRIMARY KEY ( project_id, interval_month )
 );
+CREATE TABLE project_deletions (
+	project_id bytea NOT NULL,
+	requested_by bytea NOT NULL,
+	stage text NOT NULL,
+	deleted_buckets bigint NOT NULL DEFAULT 0,
+	deleted_objects bigint NOT NULL DEFAULT 0,
+	deleted_segments bigint NOT NULL DEFAULT 0,
+	deleted_api_keys bigint NOT NULL DEFAULT 0,
+	last_error text NOT NULL DEFAULT '',
+	created_at timestamp with time zone NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	finished_at timestamp with time zone,
+	PRIMARY KEY ( project_id )
+);
+CREATE TABLE project_limit_requests (
+	id bytea NOT NULL,
+	project_id bytea NOT NULL,
+	user_id bytea NOT NULL,
+	kind text NOT NULL,
+	current_limit bigint NOT NULL,
+	requested_limit bigint NOT NULL,
+	current_usage bigint NOT NULL,
+	justification text NOT NULL,
+	status text NOT NULL,
+	reviewer text NOT NULL DEFAULT '',
+	review_comment text NOT NULL DEFAULT '',
+	created_at timestamp with time zone NOT NULL,
+	reviewed_at timestamp with time zone,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE project_network_rules (
+	project_id bytea NOT NULL,
+	network text NOT NULL,
+	allow boolean NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id, network )
+);
+CREATE TABLE project_operation_rollups (
+	project_id bytea NOT NULL,
+	interval_start timestamp with time zone NOT NULL,
+	uploads bigint NOT NULL DEFAULT 0,
+	downloads bigint NOT NULL DEFAULT 0,
+	lists bigint NOT NULL DEFAULT 0,
+	deletes bigint NOT NULL DEFAULT 0,
+	errors bigint NOT NULL DEFAULT 0,
+	PRIMARY KEY ( project_id, interval_start )
+);
 CREATE TABLE registration_tokens (
 	secret bytea NOT NULL,
 	owner_id bytea,
@@ -364,6 +563,7 @@ CREATE TABLE reverification_audits (
 CREATE TABLE revocations (
 	revoked bytea NOT NULL,
 	api_key_id bytea NOT NULL,
+	created_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
 	PRIMARY KEY ( revoked )
 );
 CREATE TABLE segment_pending_audits (
@@ -439,6 +639,15 @@ CREATE TABLE storagenode_paystubs (
 	distributed bigint NOT NULL,
 	PRIMARY KEY ( period, node_id )
 );
+CREATE TABLE storagenode_settlement_claims (
+	storagenode_id bytea NOT NULL,
+	interval_start timestamp with time zone NOT NULL,
+	action integer NOT NULL,
+	amount bigint NOT NULL,
+	status integer NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( storagenode_id, interval_start, action )
+);
 CREATE TABLE storagenode_storage_tallies (
 	node_id bytea NOT NULL,
 	interval_end_time timestamp with time zone NOT NULL,
@@ -585,6 +794,13 @@ CREATE TABLE bucket_metainfos (
 	PRIMARY KEY ( id ),
 	UNIQUE ( project_id, name )
 );
+CREATE TABLE project_invitations (
+	project_id bytea NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
+	email text NOT NULL,
+	inviter_id bytea REFERENCES users( id ) ON DELETE SET NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id, email )
+);
 CREATE TABLE project_members (
 	member_id bytea NOT NULL REFERENCES users( id ) ON DELETE CASCADE,
 	project_id bytea NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
@@ -597,6 +813,12 @@ CREATE TABLE stripecoinpayments_apply_balance_intents (
 	created_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( tx_id )
 );
+CREATE TABLE support_access_grants (
+	user_id bytea NOT NULL REFERENCES users( id ) ON DELETE CASCADE,
+	expires_at timestamp with time zone NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( user_id )
+);
 CREATE TABLE user_credits (
 	id serial NOT NULL,
 	user_id bytea NOT NULL REFERENCES users( id ) ON DELETE CASCADE,
@@ -617,7 +839,9 @@ CREATE INDEX bucket_bandwidth_rollups_action_interval_project_id_index ON bucket
 CREATE INDEX bucket_bandwidth_rollups_archive_project_id_action_interval_index ON bucket_bandwidth_rollup_archives ( project_id, action, interval_start ) ;
 CREATE INDEX bucket_bandwidth_rollups_archive_action_interval_project_id_index ON bucket_bandwidth_rollup_archives ( action, interval_start, project_id ) ;
 CREATE INDEX bucket_storage_tallies_project_id_interval_start_index ON bucket_storage_tallies ( project_id, interval_start ) ;
+CREATE INDEX durability_reports_project_id_bucket_name_created_at_index ON durability_reports ( project_id, bucket_name, created_at ) ;
 CREATE INDEX graceful_exit_segment_transfer_nid_dr_qa_fa_lfa_index ON graceful_exit_segment_transfer_queue ( node_id, durability_ratio, queued_at, finished_at, last_failed_at ) ;
+CREATE INDEX impersonation_sessions_user_id_index ON impersonation_sessions ( user_id ) ;
 CREATE INDEX node_last_ip ON nodes ( last_net ) ;
 CREATE INDEX nodes_dis_unk_off_exit_fin_last_success_index ON nodes ( disqualified, unknown_audit_suspended, offline_suspended, exit_finished_at, last_contact_success ) ;
 CREATE INDEX nodes_type_last_cont_success_free_disk_ma_mi_patch_vetted_partial_index ON nodes ( type, last_contact_success, free_disk, major, minor, patch, vetted_at ) WHERE nodes.disqualified is NULL AND nodes.unknown_audit_suspended is NULL AND nodes.exit_initiated_at is NULL AND nodes.release = true AND nodes.last_net != '' ;
@@ -628,14 +852,23 @@ CREATE INDEX oauth_codes_user_id_index ON oauth_codes ( user_id ) ;
 CREATE INDEX oauth_codes_client_id_index ON oauth_codes ( client_id ) ;
 CREATE INDEX oauth_tokens_user_id_index ON oauth_tokens ( user_id ) ;
 CREATE INDEX oauth_tokens_client_id_index ON oauth_tokens ( client_id ) ;
+CREATE UNIQUE INDEX payout_batch_entries_period_node_id_index ON payout_batch_entries ( period, node_id ) WHERE payout_batch_entries.failed = false ;
+CREATE INDEX project_deletions_requested_by_index ON project_deletions ( requested_by ) ;
+CREATE INDEX project_deletions_stage_created_at_index ON project_deletions ( stage, created_at ) ;
+CREATE INDEX project_limit_requests_project_id_created_at_index ON project_limit_requests ( project_id, created_at ) ;
+CREATE INDEX project_limit_requests_status_created_at_index ON project_limit_requests ( status, created_at ) ;
+CREATE INDEX project_operation_rollups_interval_start_index ON project_operation_rollups ( interval_start ) ;
+CREATE INDEX project_invitations_email_index ON project_invitations ( email ) ;
 CREATE INDEX projects_public_id_index ON projects ( public_id ) ;
 CREATE INDEX repair_queue_updated_at_index ON repair_queue ( updated_at ) ;
 CREATE INDEX repair_queue_num_healthy_pieces_attempted_at_index ON repair_queue ( segment_health, attempted_at ) ;
 CREATE INDEX reverification_audits_inserted_at_index ON reverification_audits ( inserted_at ) ;
+CREATE INDEX revocations_created_at_index ON revocations ( created_at ) ;
 CREATE INDEX storagenode_bandwidth_rollups_interval_start_index ON storagenode_bandwidth_rollups ( interval_start ) ;
 CREATE INDEX storagenode_bandwidth_rollup_archives_interval_start_index ON storagenode_bandwidth_rollup_archives ( interval_start ) ;
 CREATE INDEX storagenode_payments_node_id_period_index ON storagenode_payments ( node_id, period ) ;
 CREATE INDEX storagenode_paystubs_node_id_index ON storagenode_paystubs ( node_id ) ;
+CREATE INDEX storagenode_settlement_claims_interval_start_index ON storagenode_settlement_claims ( interval_start ) ;
 CREATE INDEX storagenode_storage_tallies_node_id_index ON storagenode_storage_tallies ( node_id ) ;
 CREATE INDEX storjscan_payments_block_number_log_index_index ON storjscan_payments ( block_number, log_index ) ;
 CREATE INDEX storjscan_wallets_wallet_address_index ON storjscan_wallets ( wallet_address ) ;
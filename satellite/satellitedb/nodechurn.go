@@ -0,0 +1,71 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/satellite/overlay/nodechurn"
+)
+
+type nodeChurnDB struct {
+	db *satelliteDB
+}
+
+// Upsert stores the metrics, replacing the metrics of the same day.
+func (db *nodeChurnDB) Upsert(ctx context.Context, metrics nodechurn.DailyMetrics) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = db.db.ExecContext(ctx, `
+		INSERT INTO node_churn_metrics (
+			day, new_nodes, exited_nodes, disqualified_nodes,
+			active_nodes, free_disk, capacity_change, updated_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, now())
+		ON CONFLICT (day) DO UPDATE SET
+			new_nodes          = EXCLUDED.new_nodes,
+			exited_nodes       = EXCLUDED.exited_nodes,
+			disqualified_nodes = EXCLUDED.disqualified_nodes,
+			active_nodes       = EXCLUDED.active_nodes,
+			free_disk          = EXCLUDED.free_disk,
+			capacity_change    = EXCLUDED.capacity_change,
+			updated_at         = EXCLUDED.updated_at
+	`, nodechurn.StartOfDay(metrics.Day), metrics.NewNodes, metrics.ExitedNodes, metrics.DisqualifiedNodes,
+		metrics.ActiveNodes, metrics.FreeDisk, metrics.CapacityChange)
+	return nodechurn.Error.Wrap(err)
+}
+
+// List returns the metrics of the days within [from, to) ordered by day.
+func (db *nodeChurnDB) List(ctx context.Context, from, to time.Time) (_ []nodechurn.DailyMetrics, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := db.db.QueryContext(ctx, `
+		SELECT
+			day, new_nodes, exited_nodes, disqualified_nodes,
+			active_nodes, free_disk, capacity_change, updated_at
+		FROM node_churn_metrics
+		WHERE day >= $1 AND day < $2
+		ORDER BY day
+	`, from.UTC(), to.UTC())
+	if err != nil {
+		return nil, nodechurn.Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var list []nodechurn.DailyMetrics
+	for rows.Next() {
+		var metrics nodechurn.DailyMetrics
+		err := rows.Scan(
+			&metrics.Day, &metrics.NewNodes, &metrics.ExitedNodes, &metrics.DisqualifiedNodes,
+			&metrics.ActiveNodes, &metrics.FreeDisk, &metrics.CapacityChange, &metrics.UpdatedAt)
+		if err != nil {
+			return nil, nodechurn.Error.Wrap(err)
+		}
+		metrics.Day = nodechurn.StartOfDay(metrics.Day)
+		list = append(list, metrics)
+	}
+	return list, nodechurn.Error.Wrap(rows.Err())
+}
@@ -0,0 +1,87 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/storj"
+	"storj.io/storj/private/nodeinventory"
+	"storj.io/storj/satellite/overlay/inventory"
+)
+
+type nodeInventoriesDB struct {
+	db *satelliteDB
+}
+
+// Update stores the inventory of the node, when it differs from the stored
+// one, so the check-ins of unchanged nodes don't write.
+func (db *nodeInventoriesDB) Update(ctx context.Context, nodeID storj.NodeID, inv nodeinventory.Inventory, now time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = db.db.ExecContext(ctx, `
+		INSERT INTO node_inventories (
+			node_id, os, arch, runtime, storage_backend, filesystem, updated_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (node_id) DO UPDATE SET
+			os              = EXCLUDED.os,
+			arch            = EXCLUDED.arch,
+			runtime         = EXCLUDED.runtime,
+			storage_backend = EXCLUDED.storage_backend,
+			filesystem      = EXCLUDED.filesystem,
+			updated_at      = EXCLUDED.updated_at
+		WHERE (node_inventories.os, node_inventories.arch, node_inventories.runtime,
+				node_inventories.storage_backend, node_inventories.filesystem)
+			IS DISTINCT FROM
+			(EXCLUDED.os, EXCLUDED.arch, EXCLUDED.runtime,
+				EXCLUDED.storage_backend, EXCLUDED.filesystem)
+	`, nodeID.Bytes(), inv.OS, inv.Arch, inv.Runtime, inv.StorageBackend, inv.Filesystem, now.UTC())
+	return inventory.Error.Wrap(err)
+}
+
+// Groups groups the nodes, which are neither disqualified nor exited and were
+// contacted successfully since contactedSince, by their inventory and version.
+func (db *nodeInventoriesDB) Groups(ctx context.Context, contactedSince time.Time) (_ []inventory.Group, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := db.db.QueryContext(ctx, `
+		SELECT
+			COALESCE(node_inventories.os, ''),
+			COALESCE(node_inventories.arch, ''),
+			COALESCE(node_inventories.runtime, ''),
+			COALESCE(node_inventories.storage_backend, ''),
+			COALESCE(node_inventories.filesystem, ''),
+			nodes.major, nodes.minor, nodes.patch,
+			count(*)
+		FROM nodes
+		LEFT JOIN node_inventories ON node_inventories.node_id = nodes.id
+		WHERE nodes.disqualified IS NULL
+			AND nodes.exit_finished_at IS NULL
+			AND nodes.last_contact_success > $1
+		GROUP BY 1, 2, 3, 4, 5, 6, 7, 8
+	`, contactedSince.UTC())
+	if err != nil {
+		return nil, inventory.Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var groups []inventory.Group
+	for rows.Next() {
+		var group inventory.Group
+		var major, minor, patch int64
+		err := rows.Scan(
+			&group.OS, &group.Arch, &group.Runtime, &group.StorageBackend, &group.Filesystem,
+			&major, &minor, &patch, &group.Nodes)
+		if err != nil {
+			return nil, inventory.Error.Wrap(err)
+		}
+		group.Version = fmt.Sprintf("v%d.%d.%d", major, minor, patch)
+		groups = append(groups, group)
+	}
+	return groups, inventory.Error.Wrap(rows.Err())
+}
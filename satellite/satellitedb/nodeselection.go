@@ -171,6 +171,12 @@ func (cache *overlaycache) selectStorageNodesOnce(ctx context.Context, reputable
 	return reputableNodes, newNodes, Error.Wrap(rows.Err())
 }
 
+// containedCondition excludes the contained nodes, which have more pending
+// reverification audits than the threshold.
+const containedCondition = `(contained IS NULL OR (
+	SELECT count(*) FROM reverification_audits WHERE reverification_audits.node_id = nodes.id
+) <= ?)`
+
 // nodeSelectionCondition creates a condition with arguments that corresponds to the arguments.
 func nodeSelectionCondition(ctx context.Context, criteria *overlay.NodeCriteria, excludedIDs []storj.NodeID, excludedNetworks []string, isNewNodeQuery bool) (condition, error) {
 	var conds conditions
@@ -210,6 +216,23 @@ func nodeSelectionCondition(ctx context.Context, criteria *overlay.NodeCriteria,
 			pgutil.NodeIDArray(excludedIDs),
 		)
 	}
+	for _, protocol := range criteria.Requires.Protocols {
+		conds.add(`? = ANY(string_to_array(protocols, ','))`, protocol)
+	}
+	if criteria.Requires.Hashstore {
+		conds.add(`hashstore`)
+	}
+	if criteria.Requires.TTL {
+		conds.add(`ttl_support`)
+	}
+	if criteria.Requires.PieceSize > 0 {
+		conds.add(`(max_piece_size = 0 OR max_piece_size >= ?)`, criteria.Requires.PieceSize)
+	}
+
+	if criteria.ExcludeContained {
+		conds.add(containedCondition, criteria.ContainmentThreshold)
+	}
+
 	if criteria.DistinctIP {
 		if len(excludedNetworks) > 0 {
 			conds.add(
@@ -23,6 +23,7 @@ import (
 	"storj.io/private/dbutil/pgutil"
 	"storj.io/private/tagsql"
 	"storj.io/private/version"
+	"storj.io/storj/private/nodecapabilities"
 	"storj.io/storj/satellite/overlay"
 	"storj.io/storj/satellite/satellitedb/dbx"
 )
@@ -57,7 +58,8 @@ func (cache *overlaycache) selectAllStorageNodesUpload(ctx context.Context, sele
 	defer mon.Task()(&ctx)(&err)
 
 	query := `
-		SELECT id, address, last_net, last_ip_port, vetted_at, country_code
+		SELECT id, address, last_net, last_ip_port, vetted_at, country_code,
+			protocols, hashstore, ttl_support, max_piece_size
 			FROM nodes
 			` + cache.db.impl.AsOfSystemInterval(selectionCfg.AsOfSystemTime.Interval()) + `
 			WHERE disqualified IS NULL
@@ -87,6 +89,12 @@ func (cache *overlaycache) selectAllStorageNodesUpload(ctx context.Context, sele
 			version.Major, version.Major, version.Minor, version.Minor, version.Patch,
 		)
 	}
+	if selectionCfg.ExcludeContained {
+		query += fmt.Sprintf(` AND (contained IS NULL OR (
+			SELECT count(*) FROM reverification_audits WHERE reverification_audits.node_id = nodes.id
+		) <= $%d)`, len(args)+1)
+		args = append(args, selectionCfg.ContainmentThreshold)
+	}
 
 	rows, err := cache.db.Query(ctx, query, args...)
 	if err != nil {
@@ -101,13 +109,16 @@ func (cache *overlaycache) selectAllStorageNodesUpload(ctx context.Context, sele
 		node.Address = &pb.NodeAddress{}
 		var lastIPPort sql.NullString
 		var vettedAt *time.Time
-		err = rows.Scan(&node.ID, &node.Address.Address, &node.LastNet, &lastIPPort, &vettedAt, &node.CountryCode)
+		var protocols string
+		err = rows.Scan(&node.ID, &node.Address.Address, &node.LastNet, &lastIPPort, &vettedAt, &node.CountryCode,
+			&protocols, &node.Capabilities.Hashstore, &node.Capabilities.TTL, &node.Capabilities.MaxPieceSize)
 		if err != nil {
 			return nil, nil, err
 		}
 		if lastIPPort.Valid {
 			node.LastIPPort = lastIPPort.String
 		}
+		node.Capabilities.Protocols = nodecapabilities.DecodeProtocols(protocols)
 
 		if vettedAt == nil {
 			newNodes = append(newNodes, &node)
@@ -310,7 +321,7 @@ func (cache *overlaycache) getOnlineNodesForAuditRepair(ctx context.Context, nod
 	var rows tagsql.Rows
 	rows, err = cache.db.Query(ctx, cache.db.Rebind(`
 		SELECT last_net, id, address, email, last_ip_port, vetted_at,
-			unknown_audit_suspended, offline_suspended
+			unknown_audit_suspended, offline_suspended, country_code
 		FROM nodes
 		WHERE id = any($1::bytea[])
 			AND disqualified IS NULL
@@ -328,7 +339,7 @@ func (cache *overlaycache) getOnlineNodesForAuditRepair(ctx context.Context, nod
 		node.Address = &pb.NodeAddress{Transport: pb.NodeTransport_TCP_TLS_GRPC}
 
 		var lastIPPort sql.NullString
-		err = rows.Scan(&node.LastNet, &node.ID, &node.Address.Address, &node.Reputation.Email, &lastIPPort, &node.Reputation.VettedAt, &node.Reputation.UnknownAuditSuspended, &node.Reputation.OfflineSuspended)
+		err = rows.Scan(&node.LastNet, &node.ID, &node.Address.Address, &node.Reputation.Email, &lastIPPort, &node.Reputation.VettedAt, &node.Reputation.UnknownAuditSuspended, &node.Reputation.OfflineSuspended, &node.CountryCode)
 		if err != nil {
 			return nil, err
 		}
@@ -630,7 +641,7 @@ func (cache *overlaycache) knownReliable(ctx context.Context, onlineWindow time.
 }
 
 // Reliable returns all reliable nodes.
-func (cache *overlaycache) Reliable(ctx context.Context, criteria *overlay.NodeCriteria) (nodes storj.NodeIDList, err error) {
+func (cache *overlaycache) Reliable(ctx context.Context, criteria *overlay.NodeCriteria) (nodes []*overlay.SelectedNode, err error) {
 	for {
 		nodes, err = cache.reliable(ctx, criteria)
 		if err != nil {
@@ -645,7 +656,7 @@ func (cache *overlaycache) Reliable(ctx context.Context, criteria *overlay.NodeC
 	return nodes, err
 }
 
-func (cache *overlaycache) reliable(ctx context.Context, criteria *overlay.NodeCriteria) (nodes storj.NodeIDList, err error) {
+func (cache *overlaycache) reliable(ctx context.Context, criteria *overlay.NodeCriteria) (nodes []*overlay.SelectedNode, err error) {
 	args := []interface{}{
 		time.Now().Add(-criteria.OnlineWindow),
 	}
@@ -660,7 +671,7 @@ func (cache *overlaycache) reliable(ctx context.Context, criteria *overlay.NodeC
 
 	// get reliable and online nodes
 	rows, err := cache.db.Query(ctx, cache.db.Rebind(`
-		SELECT id
+		SELECT id, last_net, country_code
 		FROM nodes
 		`+cache.db.impl.AsOfSystemInterval(criteria.AsOfSystemInterval)+`
 		WHERE disqualified IS NULL
@@ -678,12 +689,12 @@ func (cache *overlaycache) reliable(ctx context.Context, criteria *overlay.NodeC
 	}()
 
 	for rows.Next() {
-		var id storj.NodeID
-		err = rows.Scan(&id)
+		var node overlay.SelectedNode
+		err = rows.Scan(&node.ID, &node.LastNet, &node.CountryCode)
 		if err != nil {
 			return nil, err
 		}
-		nodes = append(nodes, id)
+		nodes = append(nodes, &node)
 	}
 	return nodes, Error.Wrap(rows.Err())
 }
@@ -929,7 +940,7 @@ func (cache *overlaycache) getExitStatus(ctx context.Context, nodeID storj.NodeI
 	defer mon.Task()(&ctx)(&err)
 
 	rows, err := cache.db.Query(ctx, cache.db.Rebind(`
-		SELECT id, exit_initiated_at, exit_loop_completed_at, exit_finished_at, exit_success
+		SELECT id, exit_initiated_at, exit_loop_completed_at, exit_finished_at, exit_success, exit_failed_reason
 		FROM nodes
 		WHERE id = ?
 	`), nodeID)
@@ -940,10 +951,12 @@ func (cache *overlaycache) getExitStatus(ctx context.Context, nodeID storj.NodeI
 
 	exitStatus := &overlay.ExitStatus{}
 	if rows.Next() {
-		err = rows.Scan(&exitStatus.NodeID, &exitStatus.ExitInitiatedAt, &exitStatus.ExitLoopCompletedAt, &exitStatus.ExitFinishedAt, &exitStatus.ExitSuccess)
+		var exitFailedReason *int
+		err = rows.Scan(&exitStatus.NodeID, &exitStatus.ExitInitiatedAt, &exitStatus.ExitLoopCompletedAt, &exitStatus.ExitFinishedAt, &exitStatus.ExitSuccess, &exitFailedReason)
 		if err != nil {
 			return nil, err
 		}
+		exitStatus.ExitFailedReason = convertExitFailedReason(exitFailedReason)
 	}
 
 	return exitStatus, Error.Wrap(rows.Err())
@@ -1071,10 +1084,21 @@ func populateExitStatusFields(req *overlay.ExitStatusRequest) dbx.Node_Update_Fi
 		dbxUpdateFields.ExitFinishedAt = dbx.Node_ExitFinishedAt(req.ExitFinishedAt)
 	}
 	dbxUpdateFields.ExitSuccess = dbx.Node_ExitSuccess(req.ExitSuccess)
+	if req.ExitFailedReason != nil {
+		dbxUpdateFields.ExitFailedReason = dbx.Node_ExitFailedReason(int(*req.ExitFailedReason))
+	}
 
 	return dbxUpdateFields
 }
 
+func convertExitFailedReason(reason *int) *pb.ExitFailed_Reason {
+	if reason == nil {
+		return nil
+	}
+	converted := pb.ExitFailed_Reason(*reason)
+	return &converted
+}
+
 func convertDBNode(ctx context.Context, info *dbx.Node) (_ *overlay.NodeDossier, err error) {
 	if info == nil {
 		return nil, Error.New("missing info")
@@ -1094,6 +1118,7 @@ func convertDBNode(ctx context.Context, info *dbx.Node) (_ *overlay.NodeDossier,
 	exitStatus.ExitLoopCompletedAt = info.ExitLoopCompletedAt
 	exitStatus.ExitFinishedAt = info.ExitFinishedAt
 	exitStatus.ExitSuccess = info.ExitSuccess
+	exitStatus.ExitFailedReason = convertExitFailedReason(info.ExitFailedReason)
 
 	node := &overlay.NodeDossier{
 		Node: pb.Node{
@@ -1130,6 +1155,12 @@ func convertDBNode(ctx context.Context, info *dbx.Node) (_ *overlay.NodeDossier,
 		LastNet:                 info.LastNet,
 		LastOfflineEmail:        info.LastOfflineEmail,
 		LastSoftwareUpdateEmail: info.LastSoftwareUpdateEmail,
+		Capabilities: nodecapabilities.Capabilities{
+			Protocols:    nodecapabilities.DecodeProtocols(info.Protocols),
+			Hashstore:    info.Hashstore,
+			TTL:          info.TtlSupport,
+			MaxPieceSize: info.MaxPieceSize,
+		},
 	}
 	if info.LastIpPort != nil {
 		node.LastIPPort = *info.LastIpPort
@@ -1301,7 +1332,8 @@ func (cache *overlaycache) updateCheckInDirectUpdate(ctx context.Context, node o
 			last_offline_email = CASE WHEN $8::bool IS TRUE
 				THEN NULL
 				ELSE nodes.last_offline_email
-			END
+			END,
+			protocols=$21, hashstore=$22, ttl_support=$23, max_piece_size=$24
 		WHERE id = $1
 	`, // args $1 - $4
 		node.NodeID.Bytes(), node.Address.GetAddress(), node.LastNet, node.Address.GetTransport(),
@@ -1321,6 +1353,8 @@ func (cache *overlaycache) updateCheckInDirectUpdate(ctx context.Context, node o
 		node.CountryCode.String(),
 		// args $19 - $20
 		node.SoftwareUpdateEmailSent, node.VersionBelowMin,
+		// args $21 - $24
+		nodecapabilities.EncodeProtocols(node.Capabilities.Protocols), node.Capabilities.Hashstore, node.Capabilities.TTL, node.Capabilities.MaxPieceSize,
 	)
 
 	if err != nil {
@@ -1369,7 +1403,8 @@ func (cache *overlaycache) UpdateCheckIn(ctx context.Context, node overlay.NodeC
 				major, minor, patch, hash, timestamp, release,
 				last_ip_port,
 				wallet_features,
-				country_code
+				country_code,
+				protocols, hashstore, ttl_support, max_piece_size
 			)
 			VALUES (
 				$1, $2, $3, $4, $5,
@@ -1383,7 +1418,8 @@ func (cache *overlaycache) UpdateCheckIn(ctx context.Context, node overlay.NodeC
 				$10, $11, $12, $13, $14, $15,
 				$17,
 				$18,
-				$19
+				$19,
+				$22, $23, $24, $25
 			)
 			ON CONFLICT (id)
 			DO UPDATE
@@ -1413,7 +1449,8 @@ func (cache *overlaycache) UpdateCheckIn(ctx context.Context, node overlay.NodeC
 				last_offline_email = CASE WHEN $9::bool IS TRUE
 					THEN NULL
 					ELSE nodes.last_offline_email
-				END;
+				END,
+				protocols=$22, hashstore=$23, ttl_support=$24, max_piece_size=$25;
 			`,
 		// args $1 - $5
 		node.NodeID.Bytes(), node.Address.GetAddress(), node.LastNet, node.Address.GetTransport(), int(pb.NodeType_STORAGE),
@@ -1433,6 +1470,8 @@ func (cache *overlaycache) UpdateCheckIn(ctx context.Context, node overlay.NodeC
 		node.CountryCode.String(),
 		// args $20 - $21
 		node.SoftwareUpdateEmailSent, node.VersionBelowMin,
+		// args $22 - $25
+		nodecapabilities.EncodeProtocols(node.Capabilities.Protocols), node.Capabilities.Hashstore, node.Capabilities.TTL, node.Capabilities.MaxPieceSize,
 	)
 	if err != nil {
 		return Error.Wrap(err)
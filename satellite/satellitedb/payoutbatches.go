@@ -0,0 +1,372 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/storj/private/currency"
+	"storj.io/storj/satellite/compensation"
+	"storj.io/storj/satellite/payouts"
+	"storj.io/storj/satellite/satellitedb/dbx"
+)
+
+type payoutBatchesDB struct {
+	db *satelliteDB
+}
+
+// ListPayable returns the amounts of the period, which are paid but not
+// distributed yet, for the nodes, which aren't part of a batch of the period,
+// unless the batch failed.
+func (db *payoutBatchesDB) ListPayable(ctx context.Context, period compensation.Period) (payables []payouts.Payable, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = db.db.WithTx(ctx, func(ctx context.Context, tx *dbx.Tx) (err error) {
+		payables, err = listPayable(ctx, tx, period)
+		return err
+	})
+	return payables, payouts.Error.Wrap(err)
+}
+
+// CreateBatch creates a pending batch of the amounts of the period, which are
+// payable. The entries of the batch are selected from them by selectEntries.
+// Listing the amounts and creating the batch happen in a single transaction.
+func (db *payoutBatchesDB) CreateBatch(ctx context.Context, batch payouts.Batch, selectEntries payouts.SelectEntries) (entries []payouts.BatchEntry, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = db.db.WithTx(ctx, func(ctx context.Context, tx *dbx.Tx) error {
+		payables, err := listPayable(ctx, tx, batch.Period)
+		if err != nil {
+			return err
+		}
+
+		entries, err = selectEntries(ctx, payables)
+		if err != nil {
+			return err
+		}
+		if err := checkBatchEntries(payables, entries); err != nil {
+			return err
+		}
+		if len(entries) == 0 {
+			return nil
+		}
+
+		_, err = tx.Tx.ExecContext(ctx, `
+			INSERT INTO payout_batches (id, period, network, status, created_at)
+			VALUES ($1, $2, $3, $4, $5)
+		`, batch.ID, batch.Period.String(), string(batch.Network), payouts.BatchPending, batch.CreatedAt)
+		if err != nil {
+			return err
+		}
+
+		for _, entry := range entries {
+			_, err := tx.Tx.ExecContext(ctx, `
+				INSERT INTO payout_batch_entries (batch_id, node_id, wallet, amount, period, failed)
+				VALUES ($1, $2, $3, $4, $5, false)
+			`, batch.ID, storj.NodeID(entry.NodeID), entry.Wallet, entry.Amount.Value(), batch.Period.String())
+			if dbx.IsConstraintError(err) {
+				return payouts.ErrBatchConflict.New("%s is already part of a batch of %s", storj.NodeID(entry.NodeID), batch.Period)
+			}
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		if payouts.ErrBatchConflict.Has(err) || payouts.Error.Has(err) {
+			return nil, err
+		}
+		return nil, payouts.Error.Wrap(err)
+	}
+	return entries, nil
+}
+
+// listPayable returns the amounts of the period, which are payable.
+func listPayable(ctx context.Context, tx *dbx.Tx, period compensation.Period) (_ []payouts.Payable, err error) {
+	rows, err := tx.Tx.QueryContext(ctx, `
+		SELECT ps.node_id, ps.paid - coalesce(pm.amount, 0)
+		FROM storagenode_paystubs ps
+		LEFT JOIN (
+			SELECT node_id, SUM(amount) AS amount
+			FROM storagenode_payments
+			WHERE period = $1
+			GROUP BY node_id
+		) pm ON pm.node_id = ps.node_id
+		WHERE ps.period = $1
+			AND ps.paid > coalesce(pm.amount, 0)
+			AND NOT EXISTS (
+				SELECT 1
+				FROM payout_batch_entries e
+				WHERE e.node_id = ps.node_id
+					AND e.period = $1
+					AND NOT e.failed
+			)
+		ORDER BY ps.node_id
+	`, period.String())
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var payables []payouts.Payable
+	for rows.Next() {
+		var nodeID storj.NodeID
+		var amount int64
+		if err := rows.Scan(&nodeID, &amount); err != nil {
+			return nil, err
+		}
+		payables = append(payables, payouts.Payable{
+			NodeID: compensation.NodeID(nodeID),
+			Amount: currency.NewMicroUnit(amount),
+		})
+	}
+	return payables, rows.Err()
+}
+
+// checkBatchEntries returns an error, when an entry doesn't pay exactly the
+// payable amount of its node.
+func checkBatchEntries(payables []payouts.Payable, entries []payouts.BatchEntry) error {
+	amounts := make(map[compensation.NodeID]currency.MicroUnit, len(payables))
+	for _, payable := range payables {
+		amounts[payable.NodeID] = payable.Amount
+	}
+	for _, entry := range entries {
+		amount, ok := amounts[entry.NodeID]
+		if !ok {
+			return payouts.ErrBatchConflict.New("%s is not payable for the period", storj.NodeID(entry.NodeID))
+		}
+		if entry.Amount != amount {
+			return payouts.Error.New("%s is payable %s, not %s", storj.NodeID(entry.NodeID), amount.FloatString(), entry.Amount.FloatString())
+		}
+		delete(amounts, entry.NodeID)
+	}
+	return nil
+}
+
+// GetBatch returns a batch.
+func (db *payoutBatchesDB) GetBatch(ctx context.Context, id uuid.UUID) (_ payouts.Batch, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	row := db.db.QueryRowContext(ctx, `
+		SELECT `+payoutBatchColumns+`
+		FROM payout_batches
+		WHERE id = $1
+	`, id)
+
+	batch, err := scanPayoutBatch(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return payouts.Batch{}, payouts.ErrBatchNotFound.New("%s", id)
+	}
+	return batch, payouts.Error.Wrap(err)
+}
+
+// ListBatches returns the batches with the status, oldest first.
+func (db *payoutBatchesDB) ListBatches(ctx context.Context, status payouts.BatchStatus) (_ []payouts.Batch, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := db.db.QueryContext(ctx, `
+		SELECT `+payoutBatchColumns+`
+		FROM payout_batches
+		WHERE status = $1
+		ORDER BY created_at, id
+	`, status)
+	if err != nil {
+		return nil, payouts.Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var batches []payouts.Batch
+	for rows.Next() {
+		batch, err := scanPayoutBatch(rows)
+		if err != nil {
+			return nil, payouts.Error.Wrap(err)
+		}
+		batches = append(batches, batch)
+	}
+	return batches, payouts.Error.Wrap(rows.Err())
+}
+
+// ListBatchEntries returns the entries of a batch.
+func (db *payoutBatchesDB) ListBatchEntries(ctx context.Context, id uuid.UUID) (_ []payouts.BatchEntry, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := db.db.QueryContext(ctx, `
+		SELECT node_id, wallet, amount
+		FROM payout_batch_entries
+		WHERE batch_id = $1
+		ORDER BY node_id
+	`, id)
+	if err != nil {
+		return nil, payouts.Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var entries []payouts.BatchEntry
+	for rows.Next() {
+		var nodeID storj.NodeID
+		var wallet string
+		var amount int64
+		if err := rows.Scan(&nodeID, &wallet, &amount); err != nil {
+			return nil, payouts.Error.Wrap(err)
+		}
+		entries = append(entries, payouts.BatchEntry{
+			NodeID: compensation.NodeID(nodeID),
+			Wallet: wallet,
+			Amount: currency.NewMicroUnit(amount),
+		})
+	}
+	return entries, payouts.Error.Wrap(rows.Err())
+}
+
+// SubmitBatch marks a pending batch as submitted with the transaction.
+func (db *payoutBatchesDB) SubmitBatch(ctx context.Context, id uuid.UUID, transactionHash string, submittedAt time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	result, err := db.db.ExecContext(ctx, `
+		UPDATE payout_batches
+		SET status = $2, transaction_hash = $3, submitted_at = $4
+		WHERE id = $1 AND status = $5
+	`, id, payouts.BatchSubmitted, transactionHash, submittedAt, payouts.BatchPending)
+	if err != nil {
+		return payouts.Error.Wrap(err)
+	}
+	return db.checkStatusUpdate(ctx, result, id, payouts.BatchPending)
+}
+
+// ConfirmBatch marks a submitted batch as confirmed, and records the payments
+// of its entries with the receipt against the paystubs.
+func (db *payoutBatchesDB) ConfirmBatch(ctx context.Context, id uuid.UUID, receipt string, confirmedAt time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = db.db.WithTx(ctx, func(ctx context.Context, tx *dbx.Tx) error {
+		var period string
+		err := tx.Tx.QueryRowContext(ctx, `
+			UPDATE payout_batches
+			SET status = $2, confirmed_at = $3
+			WHERE id = $1 AND status = $4
+			RETURNING period
+		`, id, payouts.BatchConfirmed, confirmedAt, payouts.BatchSubmitted).Scan(&period)
+		if errors.Is(err, sql.ErrNoRows) {
+			return payouts.ErrBatchStatus.New("%s is not %s", id, payouts.BatchSubmitted)
+		}
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.Tx.ExecContext(ctx, `
+			INSERT INTO storagenode_payments (created_at, node_id, period, amount, receipt, notes)
+			SELECT $2, node_id, $3, amount, $4, $5
+			FROM payout_batch_entries
+			WHERE batch_id = $1
+		`, id, confirmedAt, period, receipt, "payout batch "+id.String())
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.Tx.ExecContext(ctx, `
+			UPDATE storagenode_paystubs
+			SET distributed = storagenode_paystubs.distributed + e.amount
+			FROM payout_batch_entries e
+			WHERE e.batch_id = $1
+				AND storagenode_paystubs.node_id = e.node_id
+				AND storagenode_paystubs.period = $2
+		`, id, period)
+		return err
+	})
+	if payouts.ErrBatchStatus.Has(err) {
+		return err
+	}
+	return payouts.Error.Wrap(err)
+}
+
+// FailBatch marks a submitted batch as failed, so that its nodes may be part
+// of a new batch of the period.
+func (db *payoutBatchesDB) FailBatch(ctx context.Context, id uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = db.db.WithTx(ctx, func(ctx context.Context, tx *dbx.Tx) error {
+		result, err := tx.Tx.ExecContext(ctx, `
+			UPDATE payout_batches
+			SET status = $2
+			WHERE id = $1 AND status = $3
+		`, id, payouts.BatchFailed, payouts.BatchSubmitted)
+		if err != nil {
+			return err
+		}
+		affected, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if affected == 0 {
+			return payouts.ErrBatchStatus.New("%s is not %s", id, payouts.BatchSubmitted)
+		}
+
+		_, err = tx.Tx.ExecContext(ctx, `
+			UPDATE payout_batch_entries
+			SET failed = true
+			WHERE batch_id = $1
+		`, id)
+		return err
+	})
+	if payouts.ErrBatchStatus.Has(err) {
+		if _, getErr := db.GetBatch(ctx, id); getErr != nil {
+			return getErr
+		}
+		return err
+	}
+	return payouts.Error.Wrap(err)
+}
+
+// checkStatusUpdate returns an error, when the update of the status of a
+// batch didn't affect it, because it doesn't exist or has another status.
+func (db *payoutBatchesDB) checkStatusUpdate(ctx context.Context, result sql.Result, id uuid.UUID, status payouts.BatchStatus) error {
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return payouts.Error.Wrap(err)
+	}
+	if affected > 0 {
+		return nil
+	}
+
+	if _, err := db.GetBatch(ctx, id); err != nil {
+		return err
+	}
+	return payouts.ErrBatchStatus.New("%s is not %s", id, status)
+}
+
+const payoutBatchColumns = `
+	id, period, network, status, transaction_hash,
+	created_at, submitted_at, confirmed_at
+`
+
+func scanPayoutBatch(row rowScanner) (batch payouts.Batch, err error) {
+	var period, network, status string
+	var transactionHash *string
+	err = row.Scan(
+		&batch.ID, &period, &network, &status, &transactionHash,
+		&batch.CreatedAt, &batch.SubmittedAt, &batch.ConfirmedAt,
+	)
+	if err != nil {
+		return payouts.Batch{}, err
+	}
+
+	batch.Period, err = compensation.PeriodFromString(period)
+	if err != nil {
+		return payouts.Batch{}, err
+	}
+	batch.Network = payouts.Network(network)
+	batch.Status = payouts.BatchStatus(status)
+	if transactionHash != nil {
+		batch.TransactionHash = *transactionHash
+	}
+	return batch, nil
+}
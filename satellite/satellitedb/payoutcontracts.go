@@ -0,0 +1,168 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/storj"
+	"storj.io/storj/satellite/compensation"
+	"storj.io/storj/satellite/payouts"
+)
+
+type payoutContractsDB struct {
+	db *satelliteDB
+}
+
+// SetContract creates or replaces the contract of a node.
+func (db *payoutContractsDB) SetContract(ctx context.Context, contract payouts.Contract) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = db.db.ExecContext(ctx, `
+		INSERT INTO node_payout_contracts (
+			node_id,
+			at_rest_gb_hours, get_tb, put_tb, get_repair_tb, put_repair_tb, get_audit_tb,
+			created_at, updated_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, now(), now())
+		ON CONFLICT (node_id) DO UPDATE SET
+			at_rest_gb_hours = EXCLUDED.at_rest_gb_hours,
+			get_tb           = EXCLUDED.get_tb,
+			put_tb           = EXCLUDED.put_tb,
+			get_repair_tb    = EXCLUDED.get_repair_tb,
+			put_repair_tb    = EXCLUDED.put_repair_tb,
+			get_audit_tb     = EXCLUDED.get_audit_tb,
+			updated_at       = now()
+	`, contract.NodeID,
+		rateToString(contract.AtRestGBHours), rateToString(contract.GetTB), rateToString(contract.PutTB),
+		rateToString(contract.GetRepairTB), rateToString(contract.PutRepairTB), rateToString(contract.GetAuditTB))
+	return payouts.Error.Wrap(err)
+}
+
+// GetContract returns the contract of a node.
+func (db *payoutContractsDB) GetContract(ctx context.Context, nodeID storj.NodeID) (_ payouts.Contract, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	row := db.db.QueryRowContext(ctx, `
+		SELECT `+payoutContractColumns+`
+		FROM node_payout_contracts
+		WHERE node_id = $1
+	`, nodeID)
+
+	contract, err := scanPayoutContract(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return payouts.Contract{}, payouts.ErrContractNotFound.New("%s", nodeID)
+	}
+	return contract, payouts.Error.Wrap(err)
+}
+
+// DeleteContract removes the contract of a node.
+func (db *payoutContractsDB) DeleteContract(ctx context.Context, nodeID storj.NodeID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	result, err := db.db.ExecContext(ctx, `
+		DELETE FROM node_payout_contracts
+		WHERE node_id = $1
+	`, nodeID)
+	if err != nil {
+		return payouts.Error.Wrap(err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return payouts.Error.Wrap(err)
+	}
+	if affected == 0 {
+		return payouts.ErrContractNotFound.New("%s", nodeID)
+	}
+	return nil
+}
+
+// ListContracts returns the contracts of all nodes.
+func (db *payoutContractsDB) ListContracts(ctx context.Context) (_ []payouts.Contract, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := db.db.QueryContext(ctx, `
+		SELECT `+payoutContractColumns+`
+		FROM node_payout_contracts
+		ORDER BY node_id
+	`)
+	if err != nil {
+		return nil, payouts.Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var contracts []payouts.Contract
+	for rows.Next() {
+		contract, err := scanPayoutContract(rows)
+		if err != nil {
+			return nil, payouts.Error.Wrap(err)
+		}
+		contracts = append(contracts, contract)
+	}
+	return contracts, payouts.Error.Wrap(rows.Err())
+}
+
+// HasPaystubs returns whether any paystubs of the period are recorded.
+func (db *payoutContractsDB) HasPaystubs(ctx context.Context, period compensation.Period) (_ bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var exists bool
+	err = db.db.QueryRowContext(ctx, `
+		SELECT EXISTS (
+			SELECT 1 FROM storagenode_paystubs WHERE period = $1
+		)
+	`, period.String()).Scan(&exists)
+	return exists, payouts.Error.Wrap(err)
+}
+
+const payoutContractColumns = `
+	node_id,
+	at_rest_gb_hours, get_tb, put_tb, get_repair_tb, put_repair_tb, get_audit_tb,
+	created_at, updated_at
+`
+
+func scanPayoutContract(row rowScanner) (contract payouts.Contract, err error) {
+	var atRest, get, put, getRepair, putRepair, getAudit *string
+	err = row.Scan(
+		&contract.NodeID,
+		&atRest, &get, &put, &getRepair, &putRepair, &getAudit,
+		&contract.CreatedAt, &contract.UpdatedAt,
+	)
+	if err != nil {
+		return payouts.Contract{}, err
+	}
+
+	var group errs.Group
+	parse := func(value *string) *compensation.Rate {
+		if value == nil {
+			return nil
+		}
+		rate, err := compensation.RateFromString(*value)
+		if err != nil {
+			group.Add(err)
+			return nil
+		}
+		return &rate
+	}
+	contract.AtRestGBHours = parse(atRest)
+	contract.GetTB = parse(get)
+	contract.PutTB = parse(put)
+	contract.GetRepairTB = parse(getRepair)
+	contract.PutRepairTB = parse(putRepair)
+	contract.GetAuditTB = parse(getAudit)
+
+	return contract, group.Err()
+}
+
+func rateToString(rate *compensation.Rate) *string {
+	if rate == nil {
+		return nil
+	}
+	value := rate.String()
+	return &value
+}
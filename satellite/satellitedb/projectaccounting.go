@@ -372,6 +372,26 @@ func (db *ProjectAccounting) DeleteProjectBandwidthBefore(ctx context.Context, b
 	return err
 }
 
+// DeleteProjectRecords deletes the storage tallies and the bandwidth rollups of the project.
+func (db *ProjectAccounting) DeleteProjectRecords(ctx context.Context, projectID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	for _, table := range []string{
+		"bucket_storage_tallies",
+		"bucket_bandwidth_rollups",
+		"bucket_bandwidth_rollup_archives",
+		"project_bandwidth_daily_rollups",
+		"project_bandwidth_rollups",
+	} {
+		_, err = db.db.DB.ExecContext(ctx, db.db.Rebind("DELETE FROM "+table+" WHERE project_id = ?"), projectID[:])
+		if err != nil {
+			return Error.Wrap(err)
+		}
+	}
+
+	return nil
+}
+
 // UpdateProjectUsageLimit updates project usage limit.
 func (db *ProjectAccounting) UpdateProjectUsageLimit(ctx context.Context, projectID uuid.UUID, limit memory.Size) (err error) {
 	defer mon.Task()(&ctx)(&err)
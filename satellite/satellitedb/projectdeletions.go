@@ -0,0 +1,149 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+	"storj.io/private/tagsql"
+	"storj.io/storj/satellite/console"
+)
+
+// ensures that projectDeletions implements console.ProjectDeletions.
+var _ console.ProjectDeletions = (*projectDeletions)(nil)
+
+// projectDeletions is an implementation of console.ProjectDeletions.
+type projectDeletions struct {
+	db queryer
+}
+
+const projectDeletionColumns = `project_id, requested_by, stage,
+	deleted_buckets, deleted_objects, deleted_segments, deleted_api_keys,
+	last_error, created_at, updated_at, finished_at`
+
+// Insert records the deletion of a project.
+func (db *projectDeletions) Insert(ctx context.Context, deletion *console.ProjectDeletion) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if deletion == nil {
+		return Error.New("project deletion is nil")
+	}
+
+	_, err = db.db.ExecContext(ctx, `
+		INSERT INTO project_deletions (`+projectDeletionColumns+`)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+	`, deletion.ProjectID, deletion.RequestedBy, string(deletion.Stage),
+		deletion.DeletedBuckets, deletion.DeletedObjects, deletion.DeletedSegments, deletion.DeletedAPIKeys,
+		deletion.LastError, deletion.CreatedAt, deletion.UpdatedAt, deletion.FinishedAt)
+	return Error.Wrap(err)
+}
+
+// Get returns the deletion of the project. It returns sql.ErrNoRows
+// unwrapped, when the project isn't being deleted.
+func (db *projectDeletions) Get(ctx context.Context, projectID uuid.UUID) (_ *console.ProjectDeletion, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	deletion, err := scanProjectDeletion(db.db.QueryRowContext(ctx, `
+		SELECT `+projectDeletionColumns+`
+		FROM project_deletions
+		WHERE project_id = $1
+	`, projectID))
+	if err != nil {
+		if errs.Is(err, sql.ErrNoRows) {
+			return nil, err
+		}
+		return nil, Error.Wrap(err)
+	}
+	return deletion, nil
+}
+
+// GetByUserID returns the deletions requested by the user, the most recent first.
+func (db *projectDeletions) GetByUserID(ctx context.Context, userID uuid.UUID) (_ []console.ProjectDeletion, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := db.db.QueryContext(ctx, `
+		SELECT `+projectDeletionColumns+`
+		FROM project_deletions
+		WHERE requested_by = $1
+		ORDER BY created_at DESC, project_id
+	`, userID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return scanProjectDeletions(rows)
+}
+
+// ListUnfinished returns the deletions, which aren't done yet, the oldest first.
+func (db *projectDeletions) ListUnfinished(ctx context.Context, limit int) (_ []console.ProjectDeletion, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := db.db.QueryContext(ctx, `
+		SELECT `+projectDeletionColumns+`
+		FROM project_deletions
+		WHERE stage <> $1
+		ORDER BY created_at, project_id
+		LIMIT $2
+	`, string(console.ProjectDeletionStageDone), limit)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return scanProjectDeletions(rows)
+}
+
+// Update updates the stage, the progress and the error of the deletion.
+func (db *projectDeletions) Update(ctx context.Context, deletion *console.ProjectDeletion) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if deletion == nil {
+		return Error.New("project deletion is nil")
+	}
+
+	_, err = db.db.ExecContext(ctx, `
+		UPDATE project_deletions SET
+			stage = $2,
+			deleted_buckets = $3,
+			deleted_objects = $4,
+			deleted_segments = $5,
+			deleted_api_keys = $6,
+			last_error = $7,
+			updated_at = $8,
+			finished_at = $9
+		WHERE project_id = $1
+	`, deletion.ProjectID, string(deletion.Stage),
+		deletion.DeletedBuckets, deletion.DeletedObjects, deletion.DeletedSegments, deletion.DeletedAPIKeys,
+		deletion.LastError, deletion.UpdatedAt, deletion.FinishedAt)
+	return Error.Wrap(err)
+}
+
+func scanProjectDeletions(rows tagsql.Rows) (_ []console.ProjectDeletion, err error) {
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var deletions []console.ProjectDeletion
+	for rows.Next() {
+		deletion, err := scanProjectDeletion(rows)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		deletions = append(deletions, *deletion)
+	}
+
+	return deletions, Error.Wrap(rows.Err())
+}
+
+func scanProjectDeletion(row rowScanner) (*console.ProjectDeletion, error) {
+	var deletion console.ProjectDeletion
+	var stage string
+	err := row.Scan(&deletion.ProjectID, &deletion.RequestedBy, &stage,
+		&deletion.DeletedBuckets, &deletion.DeletedObjects, &deletion.DeletedSegments, &deletion.DeletedAPIKeys,
+		&deletion.LastError, &deletion.CreatedAt, &deletion.UpdatedAt, &deletion.FinishedAt)
+	if err != nil {
+		return nil, err
+	}
+	deletion.Stage = console.ProjectDeletionStage(stage)
+	return &deletion, nil
+}
@@ -0,0 +1,146 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+	"storj.io/private/tagsql"
+	"storj.io/storj/satellite/console"
+)
+
+// ensures that projectInvitations implements console.ProjectInvitations.
+var _ console.ProjectInvitations = (*projectInvitations)(nil)
+
+// projectInvitations is an implementation of console.ProjectInvitations.
+type projectInvitations struct {
+	db queryer
+}
+
+// Upsert inserts a project member invitation, or refreshes its inviter and creation time if it exists.
+func (invites *projectInvitations) Upsert(ctx context.Context, invite *console.ProjectInvitation) (_ *console.ProjectInvitation, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if invite == nil {
+		return nil, Error.New("invitation is nil")
+	}
+
+	var inviterID []byte
+	if invite.InviterID != nil {
+		inviterID = invite.InviterID.Bytes()
+	}
+
+	row := invites.db.QueryRowContext(ctx, `
+		INSERT INTO project_invitations (project_id, email, inviter_id, created_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (project_id, email) DO UPDATE SET
+			inviter_id = EXCLUDED.inviter_id,
+			created_at = EXCLUDED.created_at
+		RETURNING project_id, email, inviter_id, created_at
+	`, invite.ProjectID, invite.Email, inviterID, invite.CreatedAt)
+
+	return scanProjectInvitation(row)
+}
+
+// Get returns a project member invitation from the database.
+func (invites *projectInvitations) Get(ctx context.Context, projectID uuid.UUID, email string) (_ *console.ProjectInvitation, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	row := invites.db.QueryRowContext(ctx, `
+		SELECT project_id, email, inviter_id, created_at
+		FROM project_invitations
+		WHERE project_id = $1 AND email = $2
+	`, projectID, email)
+
+	return scanProjectInvitation(row)
+}
+
+// GetByProjectID returns all of the project member invitations for the project specified by the given ID.
+func (invites *projectInvitations) GetByProjectID(ctx context.Context, projectID uuid.UUID) (_ []console.ProjectInvitation, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := invites.db.QueryContext(ctx, `
+		SELECT project_id, email, inviter_id, created_at
+		FROM project_invitations
+		WHERE project_id = $1
+		ORDER BY created_at, email
+	`, projectID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return scanProjectInvitations(rows)
+}
+
+// GetByEmail returns all of the project member invitations for the specified email address.
+func (invites *projectInvitations) GetByEmail(ctx context.Context, email string) (_ []console.ProjectInvitation, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := invites.db.QueryContext(ctx, `
+		SELECT project_id, email, inviter_id, created_at
+		FROM project_invitations
+		WHERE email = $1
+		ORDER BY created_at, project_id
+	`, email)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return scanProjectInvitations(rows)
+}
+
+// Delete removes a project member invitation from the database.
+func (invites *projectInvitations) Delete(ctx context.Context, projectID uuid.UUID, email string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = invites.db.ExecContext(ctx, `
+		DELETE FROM project_invitations
+		WHERE project_id = $1 AND email = $2
+	`, projectID, email)
+	return Error.Wrap(err)
+}
+
+// scanProjectInvitation scans a single project member invitation. It returns
+// sql.ErrNoRows unwrapped, when the invitation doesn't exist.
+func scanProjectInvitation(row *sql.Row) (*console.ProjectInvitation, error) {
+	var invite console.ProjectInvitation
+	var inviterID uuid.NullUUID
+	err := row.Scan(&invite.ProjectID, &invite.Email, &inviterID, &invite.CreatedAt)
+	if err != nil {
+		if errs.Is(err, sql.ErrNoRows) {
+			return nil, err
+		}
+		return nil, Error.Wrap(err)
+	}
+	if inviterID.Valid {
+		invite.InviterID = &inviterID.UUID
+	}
+	return &invite, nil
+}
+
+// scanProjectInvitations scans all of the project member invitations and
+// closes the rows.
+func scanProjectInvitations(rows tagsql.Rows) (_ []console.ProjectInvitation, err error) {
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var invitations []console.ProjectInvitation
+	for rows.Next() {
+		var invite console.ProjectInvitation
+		var inviterID uuid.NullUUID
+		err := rows.Scan(&invite.ProjectID, &invite.Email, &inviterID, &invite.CreatedAt)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		if inviterID.Valid {
+			invite.InviterID = &inviterID.UUID
+		}
+		invitations = append(invitations, invite)
+	}
+
+	return invitations, Error.Wrap(rows.Err())
+}
@@ -21,6 +21,7 @@ var _ console.ProjectMembers = (*projectMembers)(nil)
 type projectMembers struct {
 	methods dbx.Methods
 	db      *satelliteDB
+	queryer queryer
 }
 
 // GetByMemberID is a method for querying project member from the database by memberID.
@@ -66,7 +67,7 @@ func (pm *projectMembers) GetPagedByProjectID(ctx context.Context, projectID uui
 			  u.short_name LIKE ? 
 		)`)
 
-	countRow := pm.db.QueryRowContext(ctx,
+	countRow := pm.queryer.QueryRowContext(ctx,
 		countQuery,
 		projectID[:],
 		search,
@@ -96,7 +97,7 @@ func (pm *projectMembers) GetPagedByProjectID(ctx context.Context, projectID uui
 					` + sanitizeOrderDirectionName(page.OrderDirection) + `	
 					LIMIT ? OFFSET ?`)
 
-	rows, err := pm.db.QueryContext(ctx,
+	rows, err := pm.queryer.QueryContext(ctx,
 		reboundQuery,
 		projectID[:],
 		search,
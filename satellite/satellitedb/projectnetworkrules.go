@@ -0,0 +1,70 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/satellitedb/dbx"
+)
+
+// ensures that projectNetworkRules implements console.ProjectNetworkRules.
+var _ console.ProjectNetworkRules = (*projectNetworkRules)(nil)
+
+// projectNetworkRules is an implementation of console.ProjectNetworkRules.
+type projectNetworkRules struct {
+	db *satelliteDB
+}
+
+// List returns the network rules of the project.
+func (db *projectNetworkRules) List(ctx context.Context, projectID uuid.UUID) (_ console.NetworkRules, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := db.db.QueryContext(ctx, `
+		SELECT network, allow, created_at
+		FROM project_network_rules
+		WHERE project_id = $1
+		ORDER BY network
+	`, projectID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var rules console.NetworkRules
+	for rows.Next() {
+		rule := console.NetworkRule{ProjectID: projectID}
+		if err := rows.Scan(&rule.Network, &rule.Allow, &rule.CreatedAt); err != nil {
+			return nil, Error.Wrap(err)
+		}
+		rules = append(rules, rule)
+	}
+	return rules, Error.Wrap(rows.Err())
+}
+
+// Set replaces the network rules of the project.
+func (db *projectNetworkRules) Set(ctx context.Context, projectID uuid.UUID, rules console.NetworkRules) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return Error.Wrap(db.db.WithTx(ctx, func(ctx context.Context, tx *dbx.Tx) error {
+		_, err := tx.Tx.ExecContext(ctx, `DELETE FROM project_network_rules WHERE project_id = $1`, projectID)
+		if err != nil {
+			return err
+		}
+		for _, rule := range rules {
+			_, err := tx.Tx.ExecContext(ctx, `
+				INSERT INTO project_network_rules (project_id, network, allow, created_at)
+				VALUES ($1, $2, $3, $4)
+			`, projectID, rule.Network, rule.Allow, rule.CreatedAt)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}))
+}
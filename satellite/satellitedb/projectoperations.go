@@ -0,0 +1,137 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+	"storj.io/private/dbutil"
+	"storj.io/private/dbutil/pgutil"
+	"storj.io/storj/satellite/accounting/projectops"
+)
+
+// ensures that projectOperationsDB implements projectops.DB.
+var _ projectops.DB = (*projectOperationsDB)(nil)
+
+// projectOperationsDB stores the hourly operation rollups of the projects.
+type projectOperationsDB struct {
+	db *satelliteDB
+}
+
+// Add adds the counts to the rollups of the projects in the interval.
+func (db *projectOperationsDB) Add(ctx context.Context, intervalStart time.Time, counts map[uuid.UUID]projectops.Counts) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(counts) == 0 {
+		return nil
+	}
+
+	projectIDs := make([]uuid.UUID, 0, len(counts))
+	for projectID := range counts {
+		projectIDs = append(projectIDs, projectID)
+	}
+	// sort the projects, so concurrent upserts lock the rows in the same order.
+	sort.Slice(projectIDs, func(i, j int) bool {
+		return projectIDs[i].Less(projectIDs[j])
+	})
+
+	uploads := make([]int64, len(projectIDs))
+	downloads := make([]int64, len(projectIDs))
+	lists := make([]int64, len(projectIDs))
+	deletes := make([]int64, len(projectIDs))
+	errors := make([]int64, len(projectIDs))
+	for i, projectID := range projectIDs {
+		c := counts[projectID]
+		uploads[i], downloads[i], lists[i], deletes[i], errors[i] = c.Uploads, c.Downloads, c.Lists, c.Deletes, c.Errors
+	}
+
+	_, err = db.db.ExecContext(ctx, `
+		INSERT INTO project_operation_rollups (
+			project_id, interval_start,
+			uploads, downloads, lists, deletes, errors
+		)
+		SELECT
+			unnest($1::bytea[]), $2,
+			unnest($3::int8[]), unnest($4::int8[]), unnest($5::int8[]), unnest($6::int8[]), unnest($7::int8[])
+		ON CONFLICT ( project_id, interval_start )
+		DO UPDATE SET
+			uploads   = project_operation_rollups.uploads + EXCLUDED.uploads,
+			downloads = project_operation_rollups.downloads + EXCLUDED.downloads,
+			lists     = project_operation_rollups.lists + EXCLUDED.lists,
+			deletes   = project_operation_rollups.deletes + EXCLUDED.deletes,
+			errors    = project_operation_rollups.errors + EXCLUDED.errors
+	`, pgutil.UUIDArray(projectIDs), intervalStart,
+		pgutil.Int8Array(uploads), pgutil.Int8Array(downloads), pgutil.Int8Array(lists),
+		pgutil.Int8Array(deletes), pgutil.Int8Array(errors))
+	return Error.Wrap(err)
+}
+
+// List returns the rollups of the project with an interval start in
+// [since, before), the oldest first.
+func (db *projectOperationsDB) List(ctx context.Context, projectID uuid.UUID, since, before time.Time) (_ []projectops.Rollup, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := db.db.QueryContext(ctx, `
+		SELECT interval_start, uploads, downloads, lists, deletes, errors
+		FROM project_operation_rollups
+		WHERE project_id = $1 AND interval_start >= $2 AND interval_start < $3
+		ORDER BY interval_start
+	`, projectID, since, before)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var rollups []projectops.Rollup
+	for rows.Next() {
+		rollup := projectops.Rollup{ProjectID: projectID}
+		err := rows.Scan(&rollup.IntervalStart,
+			&rollup.Uploads, &rollup.Downloads, &rollup.Lists, &rollup.Deletes, &rollup.Errors)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		rollups = append(rollups, rollup)
+	}
+	return rollups, Error.Wrap(rows.Err())
+}
+
+// DeleteBefore deletes at most batchSize rollups with an interval start
+// before the given time and returns the number of deleted rollups.
+func (db *projectOperationsDB) DeleteBefore(ctx context.Context, before time.Time, batchSize int) (_ int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var query string
+	switch db.db.impl {
+	case dbutil.Cockroach:
+		query = `
+			DELETE FROM project_operation_rollups
+			WHERE interval_start < $1
+			LIMIT $2
+		`
+	case dbutil.Postgres:
+		query = `
+			DELETE FROM project_operation_rollups
+			WHERE (project_id, interval_start) IN (
+				SELECT project_id, interval_start
+				FROM project_operation_rollups
+				WHERE interval_start < $1
+				LIMIT $2
+			)
+		`
+	default:
+		return 0, Error.New("unsupported database: %v", db.db.impl)
+	}
+
+	result, err := db.db.ExecContext(ctx, query, before, batchSize)
+	if err != nil {
+		return 0, Error.Wrap(err)
+	}
+	deleted, err := result.RowsAffected()
+	return deleted, Error.Wrap(err)
+}
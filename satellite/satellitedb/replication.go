@@ -0,0 +1,157 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/replication"
+	"storj.io/storj/satellite/satellitedb/dbx"
+)
+
+type replicationDB struct {
+	db *satelliteDB
+}
+
+// Create adds a replication rule. Only the objects created after the rule
+// are replicated.
+func (db *replicationDB) Create(ctx context.Context, rule replication.Rule) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = db.db.ExecContext(ctx, `
+		INSERT INTO bucket_replications (
+			project_id, bucket_name, source_access, destination_access, destination_bucket,
+			replicated_until, replicated_key, replicated_objects, backlog, created_at
+		) VALUES ($1, $2, $3, $4, $5, $6, '', 0, 0, $6)
+	`, rule.ProjectID, []byte(rule.BucketName), rule.SourceAccess, rule.DestinationAccess, []byte(rule.DestinationBucket),
+		rule.CreatedAt)
+	if dbx.IsConstraintError(err) {
+		return replication.ErrRuleExists.New("%s/%s", rule.ProjectID, rule.BucketName)
+	}
+	return replication.Error.Wrap(err)
+}
+
+// Get returns the replication rule of the bucket.
+func (db *replicationDB) Get(ctx context.Context, projectID uuid.UUID, bucketName string) (_ replication.Rule, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	row := db.db.QueryRowContext(ctx, `
+		SELECT `+replicationRuleColumns+`
+		FROM bucket_replications
+		WHERE project_id = $1 AND bucket_name = $2
+	`, projectID, []byte(bucketName))
+
+	rule, err := scanReplicationRule(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return replication.Rule{}, replication.ErrRuleNotFound.New("%s/%s", projectID, bucketName)
+	}
+	return rule, replication.Error.Wrap(err)
+}
+
+// Delete removes the replication rule of the bucket.
+func (db *replicationDB) Delete(ctx context.Context, projectID uuid.UUID, bucketName string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	result, err := db.db.ExecContext(ctx, `
+		DELETE FROM bucket_replications
+		WHERE project_id = $1 AND bucket_name = $2
+	`, projectID, []byte(bucketName))
+	if err != nil {
+		return replication.Error.Wrap(err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return replication.Error.Wrap(err)
+	}
+	if affected == 0 {
+		return replication.ErrRuleNotFound.New("%s/%s", projectID, bucketName)
+	}
+	return nil
+}
+
+// List returns all the replication rules.
+func (db *replicationDB) List(ctx context.Context) (_ []replication.Rule, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := db.db.QueryContext(ctx, `
+		SELECT `+replicationRuleColumns+`
+		FROM bucket_replications
+		ORDER BY project_id, bucket_name
+	`)
+	if err != nil {
+		return nil, replication.Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var rules []replication.Rule
+	for rows.Next() {
+		rule, err := scanReplicationRule(rows)
+		if err != nil {
+			return nil, replication.Error.Wrap(err)
+		}
+		rules = append(rules, rule)
+	}
+	return rules, replication.Error.Wrap(rows.Err())
+}
+
+// UpdateStatus updates the status of the replication rule of the bucket.
+func (db *replicationDB) UpdateStatus(ctx context.Context, projectID uuid.UUID, bucketName string, status replication.Status) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var lastError *string
+	if status.LastError != "" {
+		lastError = &status.LastError
+	}
+
+	_, err = db.db.ExecContext(ctx, `
+		UPDATE bucket_replications SET
+			replicated_until = $3,
+			replicated_key = $4,
+			pass_started_at = $5,
+			replicated_objects = $6,
+			backlog = $7,
+			last_run_at = $8,
+			last_error = $9
+		WHERE project_id = $1 AND bucket_name = $2
+	`, projectID, []byte(bucketName),
+		status.ReplicatedUntil, []byte(status.Cursor), status.PassStartedAt, status.ReplicatedObjects, status.Backlog,
+		status.LastRunAt, lastError)
+	return replication.Error.Wrap(err)
+}
+
+// rowScanner is implemented by both *sql.Row and *sql.Rows.
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+const replicationRuleColumns = `
+	project_id, bucket_name, source_access, destination_access, destination_bucket,
+	replicated_until, replicated_key, pass_started_at, replicated_objects, backlog, last_run_at, last_error, created_at`
+
+func scanReplicationRule(row rowScanner) (rule replication.Rule, err error) {
+	var bucketName, destinationBucket, replicatedKey []byte
+	var lastError *string
+
+	err = row.Scan(
+		&rule.ProjectID, &bucketName, &rule.SourceAccess, &rule.DestinationAccess, &destinationBucket,
+		&rule.Status.ReplicatedUntil, &replicatedKey, &rule.Status.PassStartedAt, &rule.Status.ReplicatedObjects, &rule.Status.Backlog,
+		&rule.Status.LastRunAt, &lastError, &rule.CreatedAt)
+	if err != nil {
+		return replication.Rule{}, err
+	}
+
+	rule.BucketName = string(bucketName)
+	rule.DestinationBucket = string(destinationBucket)
+	rule.Status.Cursor = string(replicatedKey)
+	if lastError != nil {
+		rule.Status.LastError = *lastError
+	}
+	return rule, nil
+}
@@ -6,10 +6,12 @@ package satellitedb
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/zeebo/errs"
 
 	"storj.io/common/lrucache"
+	"storj.io/storj/satellite/revocation"
 	"storj.io/storj/satellite/satellitedb/dbx"
 )
 
@@ -19,9 +21,13 @@ type revocationDB struct {
 	methods dbx.Methods
 }
 
-// Revoke will revoke the supplied tail.
+// Revoke will revoke the supplied tail. Revoking a revoked tail again is a noop.
 func (db *revocationDB) Revoke(ctx context.Context, tail []byte, apiKeyID []byte) error {
-	return errs.Wrap(db.methods.CreateNoReturn_Revocation(ctx, dbx.Revocation_Revoked(tail), dbx.Revocation_ApiKeyId(apiKeyID)))
+	_, err := db.db.ExecContext(ctx, `
+		INSERT INTO revocations (revoked, api_key_id) VALUES ($1, $2)
+		ON CONFLICT (revoked) DO NOTHING
+	`, tail, apiKeyID)
+	return errs.Wrap(err)
 }
 
 // Check will check whether any of the supplied tails have been revoked.
@@ -73,3 +79,29 @@ func (db *revocationDB) Check(ctx context.Context, tails [][]byte) (bool, error)
 
 	return revoked, nil
 }
+
+// ListSince lists the revocations created at or after since.
+func (db *revocationDB) ListSince(ctx context.Context, since time.Time) (_ []revocation.Revocation, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := db.db.QueryContext(ctx, `
+		SELECT revoked, api_key_id, created_at
+		FROM revocations
+		WHERE created_at >= $1
+		ORDER BY created_at
+	`, since)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var revocations []revocation.Revocation
+	for rows.Next() {
+		var revoked revocation.Revocation
+		if err := rows.Scan(&revoked.Tail, &revoked.APIKeyID, &revoked.CreatedAt); err != nil {
+			return nil, errs.Wrap(err)
+		}
+		revocations = append(revocations, revoked)
+	}
+	return revocations, errs.Wrap(rows.Err())
+}
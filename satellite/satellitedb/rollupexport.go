@@ -0,0 +1,405 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/private/dbutil"
+	"storj.io/private/dbutil/pgutil"
+	"storj.io/storj/satellite/accounting/rollupexport"
+)
+
+// ensures that rollupExportDB implements rollupexport.DB.
+var _ rollupexport.DB = (*rollupExportDB)(nil)
+
+// rollupExportDB is an implementation of rollupexport.DB.
+type rollupExportDB struct {
+	db *satelliteDB
+}
+
+// exportedTable checks that the table is one of the exported tables, so that
+// its name can be used in queries.
+func exportedTable(table rollupexport.Table) error {
+	for _, exported := range rollupexport.Tables {
+		if table == exported {
+			return nil
+		}
+	}
+	return Error.New("unknown rollup table %q", table)
+}
+
+// Oldest returns the oldest interval start of the table.
+func (db *rollupExportDB) Oldest(ctx context.Context, table rollupexport.Table) (_ time.Time, ok bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := exportedTable(table); err != nil {
+		return time.Time{}, false, err
+	}
+
+	var oldest *time.Time
+	err = db.db.QueryRowContext(ctx, `SELECT MIN(interval_start) FROM `+string(table)).Scan(&oldest)
+	if err != nil {
+		return time.Time{}, false, Error.Wrap(err)
+	}
+	if oldest == nil {
+		return time.Time{}, false, nil
+	}
+	return *oldest, true, nil
+}
+
+// Delete deletes the rows of the table with an interval start within [from, to).
+func (db *rollupExportDB) Delete(ctx context.Context, table rollupexport.Table, from, to time.Time, batchSize int) (deleted int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := exportedTable(table); err != nil {
+		return 0, err
+	}
+
+	switch db.db.impl {
+	case dbutil.Cockroach:
+		// large deletes are slow on cockroach without a limit.
+		for {
+			result, err := db.db.ExecContext(ctx, `
+				DELETE FROM `+string(table)+`
+				WHERE interval_start >= $1 AND interval_start < $2
+				LIMIT $3
+			`, from, to, batchSize)
+			if err != nil {
+				return deleted, Error.Wrap(err)
+			}
+			count, err := result.RowsAffected()
+			if err != nil {
+				return deleted, Error.Wrap(err)
+			}
+			deleted += count
+			if count < int64(batchSize) {
+				return deleted, nil
+			}
+		}
+	case dbutil.Postgres:
+		result, err := db.db.ExecContext(ctx, `
+			DELETE FROM `+string(table)+`
+			WHERE interval_start >= $1 AND interval_start < $2
+		`, from, to)
+		if err != nil {
+			return 0, Error.Wrap(err)
+		}
+		deleted, err = result.RowsAffected()
+		return deleted, Error.Wrap(err)
+	default:
+		return 0, Error.New("unsupported database: %v", db.db.impl)
+	}
+}
+
+// IterateStorageTallies calls fn for the storage tallies with an interval start within [from, to).
+func (db *rollupExportDB) IterateStorageTallies(ctx context.Context, from, to time.Time, batchSize int, fn func(rollupexport.StorageTally) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var last *rollupexport.StorageTally
+	for {
+		args := []interface{}{from, to, batchSize}
+		cursor := ""
+		if last != nil {
+			cursor = `AND (bucket_name, project_id, interval_start) > ($4, $5, $6)`
+			args = append(args, []byte(last.BucketName), last.ProjectID, last.IntervalStart)
+		}
+
+		tallies, err := db.storageTalliesPage(ctx, cursor, args)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+
+		for _, tally := range tallies {
+			if err := fn(tally); err != nil {
+				return err
+			}
+		}
+		if len(tallies) < batchSize {
+			return nil
+		}
+		last = &tallies[len(tallies)-1]
+	}
+}
+
+// IterateBucketBandwidth calls fn for the bucket bandwidth rollups with an interval start within [from, to).
+func (db *rollupExportDB) IterateBucketBandwidth(ctx context.Context, from, to time.Time, batchSize int, fn func(rollupexport.BucketBandwidth) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var last *rollupexport.BucketBandwidth
+	for {
+		args := []interface{}{from, to, batchSize}
+		cursor := ""
+		if last != nil {
+			cursor = `AND (bucket_name, project_id, interval_start, action) > ($4, $5, $6, $7)`
+			args = append(args, []byte(last.BucketName), last.ProjectID, last.IntervalStart, last.Action)
+		}
+
+		rollups, err := db.bucketBandwidthPage(ctx, cursor, args)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+
+		for _, rollup := range rollups {
+			if err := fn(rollup); err != nil {
+				return err
+			}
+		}
+		if len(rollups) < batchSize {
+			return nil
+		}
+		last = &rollups[len(rollups)-1]
+	}
+}
+
+// IterateNodeBandwidth calls fn for the storage node bandwidth rollups with an interval start within [from, to).
+func (db *rollupExportDB) IterateNodeBandwidth(ctx context.Context, from, to time.Time, batchSize int, fn func(rollupexport.NodeBandwidth) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var last *rollupexport.NodeBandwidth
+	for {
+		args := []interface{}{from, to, batchSize}
+		cursor := ""
+		if last != nil {
+			cursor = `AND (storagenode_id, interval_start, action) > ($4, $5, $6)`
+			args = append(args, last.NodeID, last.IntervalStart, last.Action)
+		}
+
+		rollups, err := db.nodeBandwidthPage(ctx, cursor, args)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+
+		for _, rollup := range rollups {
+			if err := fn(rollup); err != nil {
+				return err
+			}
+		}
+		if len(rollups) < batchSize {
+			return nil
+		}
+		last = &rollups[len(rollups)-1]
+	}
+}
+
+// InsertStorageTallies inserts the storage tallies, which don't exist.
+func (db *rollupExportDB) InsertStorageTallies(ctx context.Context, tallies []rollupexport.StorageTally) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(tallies) == 0 {
+		return nil
+	}
+
+	var bucketNames [][]byte
+	var projectIDs []uuid.UUID
+	var intervalStarts []time.Time
+	var totalBytes, inline, remote []int64
+	var totalSegments, remoteSegments, inlineSegments []int64
+	var objectCounts, metadataSizes []int64
+	for _, tally := range tallies {
+		bucketNames = append(bucketNames, []byte(tally.BucketName))
+		projectIDs = append(projectIDs, tally.ProjectID)
+		intervalStarts = append(intervalStarts, tally.IntervalStart)
+		totalBytes = append(totalBytes, tally.TotalBytes)
+		inline = append(inline, tally.Inline)
+		remote = append(remote, tally.Remote)
+		totalSegments = append(totalSegments, tally.TotalSegments)
+		remoteSegments = append(remoteSegments, tally.RemoteSegments)
+		inlineSegments = append(inlineSegments, tally.InlineSegments)
+		objectCounts = append(objectCounts, tally.ObjectCount)
+		metadataSizes = append(metadataSizes, tally.MetadataSize)
+	}
+
+	_, err = db.db.ExecContext(ctx, `
+		INSERT INTO bucket_storage_tallies (
+			bucket_name, project_id, interval_start,
+			total_bytes, inline, remote,
+			total_segments_count, remote_segments_count, inline_segments_count,
+			object_count, metadata_size)
+		SELECT
+			unnest($1::bytea[]), unnest($2::bytea[]), unnest($3::timestamptz[]),
+			unnest($4::int8[]), unnest($5::int8[]), unnest($6::int8[]),
+			unnest($7::int8[]), unnest($8::int8[]), unnest($9::int8[]),
+			unnest($10::int8[]), unnest($11::int8[])
+		ON CONFLICT DO NOTHING
+	`, pgutil.ByteaArray(bucketNames), pgutil.UUIDArray(projectIDs), pgutil.TimestampTZArray(intervalStarts),
+		pgutil.Int8Array(totalBytes), pgutil.Int8Array(inline), pgutil.Int8Array(remote),
+		pgutil.Int8Array(totalSegments), pgutil.Int8Array(remoteSegments), pgutil.Int8Array(inlineSegments),
+		pgutil.Int8Array(objectCounts), pgutil.Int8Array(metadataSizes))
+	return Error.Wrap(err)
+}
+
+// InsertBucketBandwidth inserts the bucket bandwidth rollups, which don't exist.
+func (db *rollupExportDB) InsertBucketBandwidth(ctx context.Context, rollups []rollupexport.BucketBandwidth) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(rollups) == 0 {
+		return nil
+	}
+
+	var bucketNames [][]byte
+	var projectIDs []uuid.UUID
+	var intervalStarts []time.Time
+	var intervalSeconds, actions []int64
+	var inline, allocated, settled []int64
+	for _, rollup := range rollups {
+		bucketNames = append(bucketNames, []byte(rollup.BucketName))
+		projectIDs = append(projectIDs, rollup.ProjectID)
+		intervalStarts = append(intervalStarts, rollup.IntervalStart)
+		intervalSeconds = append(intervalSeconds, int64(rollup.IntervalSeconds))
+		actions = append(actions, int64(rollup.Action))
+		inline = append(inline, rollup.Inline)
+		allocated = append(allocated, rollup.Allocated)
+		settled = append(settled, rollup.Settled)
+	}
+
+	_, err = db.db.ExecContext(ctx, `
+		INSERT INTO bucket_bandwidth_rollup_archives (
+			bucket_name, project_id, interval_start, interval_seconds,
+			action, inline, allocated, settled)
+		SELECT
+			unnest($1::bytea[]), unnest($2::bytea[]), unnest($3::timestamptz[]), unnest($4::int4[]),
+			unnest($5::int4[]), unnest($6::int8[]), unnest($7::int8[]), unnest($8::int8[])
+		ON CONFLICT DO NOTHING
+	`, pgutil.ByteaArray(bucketNames), pgutil.UUIDArray(projectIDs), pgutil.TimestampTZArray(intervalStarts), pgutil.Int8Array(intervalSeconds),
+		pgutil.Int8Array(actions), pgutil.Int8Array(inline), pgutil.Int8Array(allocated), pgutil.Int8Array(settled))
+	return Error.Wrap(err)
+}
+
+// InsertNodeBandwidth inserts the storage node bandwidth rollups, which don't exist.
+func (db *rollupExportDB) InsertNodeBandwidth(ctx context.Context, rollups []rollupexport.NodeBandwidth) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(rollups) == 0 {
+		return nil
+	}
+
+	var nodeIDs []storj.NodeID
+	var intervalStarts []time.Time
+	var intervalSeconds, actions []int64
+	var allocated, settled []int64
+	for _, rollup := range rollups {
+		nodeIDs = append(nodeIDs, rollup.NodeID)
+		intervalStarts = append(intervalStarts, rollup.IntervalStart)
+		intervalSeconds = append(intervalSeconds, int64(rollup.IntervalSeconds))
+		actions = append(actions, int64(rollup.Action))
+		allocated = append(allocated, rollup.Allocated)
+		settled = append(settled, rollup.Settled)
+	}
+
+	_, err = db.db.ExecContext(ctx, `
+		INSERT INTO storagenode_bandwidth_rollup_archives (
+			storagenode_id, interval_start, interval_seconds,
+			action, allocated, settled)
+		SELECT
+			unnest($1::bytea[]), unnest($2::timestamptz[]), unnest($3::int4[]),
+			unnest($4::int4[]), unnest($5::int8[]), unnest($6::int8[])
+		ON CONFLICT DO NOTHING
+	`, pgutil.NodeIDArray(nodeIDs), pgutil.TimestampTZArray(intervalStarts), pgutil.Int8Array(intervalSeconds),
+		pgutil.Int8Array(actions), pgutil.Int8Array(allocated), pgutil.Int8Array(settled))
+	return Error.Wrap(err)
+}
+
+// storageTalliesPage reads a page of the rows, which match the cursor condition.
+func (db *rollupExportDB) storageTalliesPage(ctx context.Context, cursor string, args []interface{}) (tallies []rollupexport.StorageTally, err error) {
+	rows, err := db.db.QueryContext(ctx, `
+		SELECT
+			bucket_name, project_id, interval_start,
+			total_bytes, inline, remote,
+			total_segments_count, remote_segments_count, inline_segments_count,
+			object_count, metadata_size
+		FROM bucket_storage_tallies
+		WHERE interval_start >= $1 AND interval_start < $2
+		`+cursor+`
+		ORDER BY bucket_name, project_id, interval_start
+		LIMIT $3
+	`, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	for rows.Next() {
+		var tally rollupexport.StorageTally
+		var bucketName []byte
+		err := rows.Scan(
+			&bucketName, &tally.ProjectID, &tally.IntervalStart,
+			&tally.TotalBytes, &tally.Inline, &tally.Remote,
+			&tally.TotalSegments, &tally.RemoteSegments, &tally.InlineSegments,
+			&tally.ObjectCount, &tally.MetadataSize)
+		if err != nil {
+			return nil, err
+		}
+		tally.BucketName = string(bucketName)
+		tallies = append(tallies, tally)
+	}
+	return tallies, rows.Err()
+}
+
+// bucketBandwidthPage reads a page of the rows, which match the cursor condition.
+func (db *rollupExportDB) bucketBandwidthPage(ctx context.Context, cursor string, args []interface{}) (rollups []rollupexport.BucketBandwidth, err error) {
+	rows, err := db.db.QueryContext(ctx, `
+		SELECT
+			bucket_name, project_id, interval_start, interval_seconds,
+			action, inline, allocated, settled
+		FROM bucket_bandwidth_rollup_archives
+		WHERE interval_start >= $1 AND interval_start < $2
+		`+cursor+`
+		ORDER BY bucket_name, project_id, interval_start, action
+		LIMIT $3
+	`, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	for rows.Next() {
+		var rollup rollupexport.BucketBandwidth
+		var bucketName []byte
+		err := rows.Scan(
+			&bucketName, &rollup.ProjectID, &rollup.IntervalStart, &rollup.IntervalSeconds,
+			&rollup.Action, &rollup.Inline, &rollup.Allocated, &rollup.Settled)
+		if err != nil {
+			return nil, err
+		}
+		rollup.BucketName = string(bucketName)
+		rollups = append(rollups, rollup)
+	}
+	return rollups, rows.Err()
+}
+
+// nodeBandwidthPage reads a page of the rows, which match the cursor condition.
+func (db *rollupExportDB) nodeBandwidthPage(ctx context.Context, cursor string, args []interface{}) (rollups []rollupexport.NodeBandwidth, err error) {
+	rows, err := db.db.QueryContext(ctx, `
+		SELECT
+			storagenode_id, interval_start, interval_seconds,
+			action, COALESCE(allocated, 0), settled
+		FROM storagenode_bandwidth_rollup_archives
+		WHERE interval_start >= $1 AND interval_start < $2
+		`+cursor+`
+		ORDER BY storagenode_id, interval_start, action
+		LIMIT $3
+	`, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	for rows.Next() {
+		var rollup rollupexport.NodeBandwidth
+		err := rows.Scan(
+			&rollup.NodeID, &rollup.IntervalStart, &rollup.IntervalSeconds,
+			&rollup.Action, &rollup.Allocated, &rollup.Settled)
+		if err != nil {
+			return nil, err
+		}
+		rollups = append(rollups, rollup)
+	}
+	return rollups, rows.Err()
+}
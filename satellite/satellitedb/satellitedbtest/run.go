@@ -25,6 +25,7 @@ import (
 	"storj.io/private/dbutil/pgutil"
 	"storj.io/private/dbutil/tempdb"
 	"storj.io/private/tagsql"
+	"storj.io/storj/private/dbpool"
 	"storj.io/storj/satellite"
 	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/satellitedb"
@@ -53,8 +54,18 @@ type Database struct {
 	Name    string
 	URL     string
 	Message string
+
+	// TransactionPooling is whether URL points to a transaction pooling
+	// proxy in front of the database.
+	TransactionPooling bool
 }
 
+// pooledPostgres is the connection string of a transaction pooling proxy,
+// e.g. PgBouncer with pool_mode=transaction, in front of postgres. The
+// proxy has to accept the options startup parameter, which sets the
+// search_path of the test schemas.
+var pooledPostgres = flag.String("postgres-pooled-test-db", os.Getenv("STORJ_TEST_POSTGRES_POOLED"), "Postgres behind a transaction pooling proxy, tests are skipped when it's empty")
+
 type ignoreSkip struct{}
 
 func (ignoreSkip) Skip(...interface{}) {}
@@ -67,8 +78,16 @@ func Databases() []SatelliteDatabases {
 	if !strings.EqualFold(postgresConnStr, "omit") {
 		dbs = append(dbs, SatelliteDatabases{
 			Name:       "Postgres",
-			MasterDB:   Database{"Postgres", postgresConnStr, "Postgres flag missing, example: -postgres-test-db=" + pgtest.DefaultPostgres + " or use STORJ_TEST_POSTGRES environment variable."},
-			MetabaseDB: Database{"Postgres", postgresConnStr, ""},
+			MasterDB:   Database{Name: "Postgres", URL: postgresConnStr, Message: "Postgres flag missing, example: -postgres-test-db=" + pgtest.DefaultPostgres + " or use STORJ_TEST_POSTGRES environment variable."},
+			MetabaseDB: Database{Name: "Postgres", URL: postgresConnStr},
+		})
+	}
+
+	if *pooledPostgres != "" && !strings.EqualFold(*pooledPostgres, "omit") {
+		dbs = append(dbs, SatelliteDatabases{
+			Name:       "PostgresPooled",
+			MasterDB:   Database{Name: "PostgresPooled", URL: *pooledPostgres, TransactionPooling: true},
+			MetabaseDB: Database{Name: "PostgresPooled", URL: *pooledPostgres, TransactionPooling: true},
 		})
 	}
 
@@ -76,8 +95,8 @@ func Databases() []SatelliteDatabases {
 	if !strings.EqualFold(cockroachConnStr, "omit") {
 		dbs = append(dbs, SatelliteDatabases{
 			Name:       "Cockroach",
-			MasterDB:   Database{"Cockroach", cockroachConnStr, "Cockroach flag missing, example: -cockroach-test-db=" + pgtest.DefaultCockroach + " or use STORJ_TEST_COCKROACH environment variable."},
-			MetabaseDB: Database{"Cockroach", cockroachConnStr, ""},
+			MasterDB:   Database{Name: "Cockroach", URL: cockroachConnStr, Message: "Cockroach flag missing, example: -cockroach-test-db=" + pgtest.DefaultCockroach + " or use STORJ_TEST_COCKROACH environment variable."},
+			MetabaseDB: Database{Name: "Cockroach", URL: cockroachConnStr},
 		})
 	}
 
@@ -151,6 +170,12 @@ func CreateMasterDB(ctx context.Context, log *zap.Logger, name string, category
 	if *cockroachNoDrop && tempDB.Driver == "cockroach" {
 		tempDB.Cleanup = func(d tagsql.DB) error { return nil }
 	}
+	if dbInfo.TransactionPooling {
+		tempDB.ConnStr, err = dbpool.WithTransactionPooling(tempDB.ConnStr)
+		if err != nil {
+			return nil, errs.Combine(err, tempDB.Close())
+		}
+	}
 
 	return CreateMasterDBOnTopOf(ctx, log, tempDB)
 }
@@ -180,6 +205,12 @@ func CreateMetabaseDB(ctx context.Context, log *zap.Logger, name string, categor
 	if *cockroachNoDrop && tempDB.Driver == "cockroach" {
 		tempDB.Cleanup = func(d tagsql.DB) error { return nil }
 	}
+	if dbInfo.TransactionPooling {
+		tempDB.ConnStr, err = dbpool.WithTransactionPooling(tempDB.ConnStr)
+		if err != nil {
+			return nil, errs.Combine(err, tempDB.Close())
+		}
+	}
 
 	return CreateMetabaseDBOnTopOf(ctx, log, tempDB, config)
 }
@@ -0,0 +1,144 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/pb"
+	"storj.io/common/storj"
+	"storj.io/private/dbutil/pgutil"
+	"storj.io/storj/satellite/orders"
+	"storj.io/storj/satellite/satellitedb/dbx"
+)
+
+// RecordSettlementClaim records the amounts, which a storage node claimed for
+// a window, with the outcome of settling them. The claim replaces the previous
+// claim of the window.
+func (db *ordersDB) RecordSettlementClaim(ctx context.Context, storageNodeID storj.NodeID, actionAmounts map[int32]int64, window time.Time, status orders.ClaimStatus) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	actions := make([]int32, 0, len(actionAmounts))
+	amounts := make([]int64, 0, len(actionAmounts))
+	for action, amount := range actionAmounts {
+		actions = append(actions, action)
+		amounts = append(amounts, amount)
+	}
+
+	return db.db.WithTx(ctx, func(ctx context.Context, tx *dbx.Tx) error {
+		_, err := tx.Tx.ExecContext(ctx, `
+			DELETE FROM storagenode_settlement_claims
+			WHERE storagenode_id = $1 AND interval_start = $2
+		`, storageNodeID.Bytes(), window.UTC())
+		if err != nil {
+			return Error.Wrap(err)
+		}
+
+		_, err = tx.Tx.ExecContext(ctx, `
+			INSERT INTO storagenode_settlement_claims (
+				storagenode_id, interval_start, action, amount, status, updated_at
+			)
+			SELECT $1, $2, unnest($3::INT4[]), unnest($4::INT8[]), $5, now()
+		`, storageNodeID.Bytes(), window.UTC(), pgutil.Int4Array(actions), pgutil.Int8Array(amounts), int(status))
+		return Error.Wrap(err)
+	})
+}
+
+// ListSettlementDiscrepancies lists the actions of windows starting within
+// [from, to), whose claimed amounts differ from the settled amounts.
+//
+// Settled windows are only compared starting with the first recorded claim,
+// so the windows settled before claims were recorded aren't reported.
+func (db *ordersDB) ListSettlementDiscrepancies(ctx context.Context, from, to time.Time) (_ []orders.Discrepancy, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := db.db.QueryContext(ctx, `
+		SELECT
+			COALESCE(c.storagenode_id, r.storagenode_id),
+			COALESCE(c.interval_start, r.interval_start),
+			COALESCE(c.action, r.action),
+			COALESCE(c.amount, 0),
+			COALESCE(r.settled, 0),
+			COALESCE(c.status, 0)
+		FROM (
+			SELECT storagenode_id, interval_start, action, amount, status
+			FROM storagenode_settlement_claims
+			WHERE interval_start >= $1 AND interval_start < $2
+		) AS c
+		FULL OUTER JOIN (
+			SELECT storagenode_id, interval_start, action, settled
+			FROM storagenode_bandwidth_rollups
+			WHERE interval_start >= $1 AND interval_start < $2
+				AND interval_start >= (SELECT MIN(interval_start) FROM storagenode_settlement_claims)
+		) AS r
+		ON c.storagenode_id = r.storagenode_id
+			AND c.interval_start = r.interval_start
+			AND c.action = r.action
+		WHERE c.amount IS DISTINCT FROM r.settled
+	`, from.UTC(), to.UTC())
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var discrepancies []orders.Discrepancy
+	for rows.Next() {
+		var discrepancy orders.Discrepancy
+		var action, status int
+		err := rows.Scan(&discrepancy.NodeID, &discrepancy.Window, &action,
+			&discrepancy.Claimed, &discrepancy.Settled, &status)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		discrepancy.Action = pb.PieceAction(action)
+		discrepancy.Status = orders.ClaimStatus(status)
+		discrepancies = append(discrepancies, discrepancy)
+	}
+	return discrepancies, Error.Wrap(rows.Err())
+}
+
+// SettleClaimedWindow replaces the settled amounts of the window with the
+// amounts, which the storage node claimed, and marks the claim as accepted.
+func (db *ordersDB) SettleClaimedWindow(ctx context.Context, storageNodeID storj.NodeID, window time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return db.db.WithTx(ctx, func(ctx context.Context, tx *dbx.Tx) error {
+		_, err := tx.Tx.ExecContext(ctx, `
+			DELETE FROM storagenode_bandwidth_rollups
+			WHERE storagenode_id = $1 AND interval_start = $2
+		`, storageNodeID.Bytes(), window.UTC())
+		if err != nil {
+			return Error.Wrap(err)
+		}
+
+		result, err := tx.Tx.ExecContext(ctx, `
+			INSERT INTO storagenode_bandwidth_rollups (
+				storagenode_id, interval_start, interval_seconds, action, allocated, settled
+			)
+			SELECT storagenode_id, interval_start, $3, action, 0, amount
+			FROM storagenode_settlement_claims
+			WHERE storagenode_id = $1 AND interval_start = $2
+		`, storageNodeID.Bytes(), window.UTC(), defaultIntervalSeconds)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		inserted, err := result.RowsAffected()
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		if inserted == 0 {
+			return Error.New("no claim of node %s for window %s", storageNodeID, window)
+		}
+
+		_, err = tx.Tx.ExecContext(ctx, `
+			UPDATE storagenode_settlement_claims
+			SET status = $3, updated_at = now()
+			WHERE storagenode_id = $1 AND interval_start = $2
+		`, storageNodeID.Bytes(), window.UTC(), int(orders.ClaimAccepted))
+		return Error.Wrap(err)
+	})
+}
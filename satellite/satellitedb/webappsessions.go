@@ -20,11 +20,11 @@ type webappSessions struct {
 }
 
 // Create creates a webapp session and returns the session info.
-func (db *webappSessions) Create(ctx context.Context, id, userID uuid.UUID, address, userAgent string, expiresAt time.Time) (session consoleauth.WebappSession, err error) {
+func (db *webappSessions) Create(ctx context.Context, id, userID uuid.UUID, address, userAgent string, status consoleauth.SessionStatus, expiresAt time.Time) (session consoleauth.WebappSession, err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	dbxSession, err := db.db.Create_WebappSession(ctx, dbx.WebappSession_Id(id.Bytes()), dbx.WebappSession_UserId(userID.Bytes()),
-		dbx.WebappSession_IpAddress(address), dbx.WebappSession_UserAgent(userAgent), dbx.WebappSession_ExpiresAt(expiresAt))
+		dbx.WebappSession_IpAddress(address), dbx.WebappSession_UserAgent(userAgent), dbx.WebappSession_Status(int(status)), dbx.WebappSession_ExpiresAt(expiresAt))
 	if err != nil {
 		return session, err
 	}
@@ -105,7 +105,7 @@ func getSessionFromDBX(dbxSession *dbx.WebappSession) (consoleauth.WebappSession
 		UserID:    userID,
 		Address:   dbxSession.IpAddress,
 		UserAgent: dbxSession.UserAgent,
-		Status:    dbxSession.Status,
+		Status:    consoleauth.SessionStatus(dbxSession.Status),
 		ExpiresAt: dbxSession.ExpiresAt,
 	}, nil
 }
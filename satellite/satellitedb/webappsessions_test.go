@@ -12,6 +12,7 @@ import (
 	"storj.io/common/testcontext"
 	"storj.io/common/testrand"
 	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/console/consoleauth"
 	"storj.io/storj/satellite/satellitedb/satellitedbtest"
 )
 
@@ -25,16 +26,17 @@ func TestWebappSessionsCreate(t *testing.T) {
 		userAgent := "test_user_agent"
 		expiresAt := time.Now().Add(24 * time.Hour).Truncate(time.Second)
 
-		session, err := sessions.Create(ctx, id, userID, address, userAgent, expiresAt)
+		session, err := sessions.Create(ctx, id, userID, address, userAgent, consoleauth.SessionStatusActive, expiresAt)
 		require.NoError(t, err)
 
 		require.Equal(t, id, session.ID)
 		require.Equal(t, userID, session.UserID)
 		require.Equal(t, address, session.Address)
 		require.Equal(t, userAgent, session.UserAgent)
+		require.Equal(t, consoleauth.SessionStatusActive, session.Status)
 		require.Equal(t, expiresAt, session.ExpiresAt)
 
-		_, err = sessions.Create(ctx, id, userID, address, userAgent, expiresAt)
+		_, err = sessions.Create(ctx, id, userID, address, userAgent, consoleauth.SessionStatusActive, expiresAt)
 		require.Error(t, err)
 	})
 }
@@ -49,7 +51,7 @@ func TestWebappSessionsGetBySessionID(t *testing.T) {
 		userAgent := "test_user_agent"
 		expiresAt := time.Now().Add(24 * time.Hour).Truncate(time.Second)
 
-		session, err := sessions.Create(ctx, id, userID, address, userAgent, expiresAt)
+		session, err := sessions.Create(ctx, id, userID, address, userAgent, consoleauth.SessionStatusActive, expiresAt)
 		require.NoError(t, err)
 
 		require.Equal(t, id, session.ID)
@@ -79,7 +81,7 @@ func TestWebappSessionsGetAllByUserID(t *testing.T) {
 		userAgent := "test_user_agent"
 		expiresAt := time.Now().Add(24 * time.Hour).Truncate(time.Second)
 
-		session1, err := sessions.Create(ctx, id1, userID, address, userAgent, expiresAt)
+		session1, err := sessions.Create(ctx, id1, userID, address, userAgent, consoleauth.SessionStatusActive, expiresAt)
 		require.NoError(t, err)
 		require.Equal(t, id1, session1.ID)
 		require.Equal(t, userID, session1.UserID)
@@ -89,7 +91,7 @@ func TestWebappSessionsGetAllByUserID(t *testing.T) {
 
 		id2 := testrand.UUID()
 
-		session2, err := sessions.Create(ctx, id2, userID, address, userAgent, expiresAt)
+		session2, err := sessions.Create(ctx, id2, userID, address, userAgent, consoleauth.SessionStatusActive, expiresAt)
 		require.NoError(t, err)
 		require.Equal(t, id2, session2.ID)
 		require.Equal(t, userID, session2.UserID)
@@ -131,12 +133,12 @@ func TestWebappSessionsDeleteBySessionID(t *testing.T) {
 		userAgent := "test_user_agent"
 		expiresAt := time.Now().Add(24 * time.Hour).Truncate(time.Second)
 
-		session1, err := sessions.Create(ctx, id1, userID, address, userAgent, expiresAt)
+		session1, err := sessions.Create(ctx, id1, userID, address, userAgent, consoleauth.SessionStatusActive, expiresAt)
 		require.NoError(t, err)
 
 		id2 := testrand.UUID()
 
-		session2, err := sessions.Create(ctx, id2, userID, address, userAgent, expiresAt)
+		session2, err := sessions.Create(ctx, id2, userID, address, userAgent, consoleauth.SessionStatusActive, expiresAt)
 		require.NoError(t, err)
 
 		require.NoError(t, sessions.DeleteBySessionID(ctx, session1.ID))
@@ -159,7 +161,7 @@ func TestWebappSessionsDeleteAllByUserID(t *testing.T) {
 		userAgent := "test_user_agent"
 		expiresAt := time.Now().Add(24 * time.Hour).Truncate(time.Second)
 
-		session1, err := sessions.Create(ctx, id1, userID, address, userAgent, expiresAt)
+		session1, err := sessions.Create(ctx, id1, userID, address, userAgent, consoleauth.SessionStatusActive, expiresAt)
 		require.NoError(t, err)
 		require.Equal(t, id1, session1.ID)
 		require.Equal(t, userID, session1.UserID)
@@ -169,7 +171,7 @@ func TestWebappSessionsDeleteAllByUserID(t *testing.T) {
 
 		id2 := testrand.UUID()
 
-		session2, err := sessions.Create(ctx, id2, userID, address, userAgent, expiresAt)
+		session2, err := sessions.Create(ctx, id2, userID, address, userAgent, consoleauth.SessionStatusActive, expiresAt)
 		require.NoError(t, err)
 		require.Equal(t, id2, session2.ID)
 		require.Equal(t, userID, session2.UserID)
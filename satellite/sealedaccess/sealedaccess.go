@@ -0,0 +1,145 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package sealedaccess encrypts the access grants of the users, which the
+// satellite stores to act on their behalf, e.g. to replicate a bucket.
+package sealedaccess
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"strings"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+)
+
+var (
+	// Error is the error class for sealed access grants.
+	Error = errs.Class("sealed access")
+	// ErrNoKey is returned when no key is configured for sealing the access
+	// grants.
+	ErrNoKey = errs.Class("sealed access: no key configured")
+)
+
+// prefix marks the sealed access grants. It cannot be the start of a
+// serialized access grant, which is base58 encoded.
+const prefix = "sealed1:"
+
+// Config contains the configurable values for sealing the access grants.
+type Config struct {
+	Key Key `default:"" help:"hex-encoded 32 byte key for encrypting the access grants, which are stored to act on behalf of the users, e.g. for bucket replication. Features storing access grants are unavailable without it"`
+}
+
+// Key is the key the access grants are sealed with.
+//
+// Can be used as a flag.
+type Key struct {
+	key *[32]byte
+}
+
+// Type implements pflag.Value.
+func (Key) Type() string { return "sealedaccess.Key" }
+
+// String is required for pflag.Value.
+func (key *Key) String() string {
+	if key == nil || key.key == nil {
+		return ""
+	}
+	return hex.EncodeToString(key.key[:])
+}
+
+// Set sets the value from a hex-encoded 32 byte key.
+func (key *Key) Set(s string) error {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		key.key = nil
+		return nil
+	}
+
+	decoded, err := hex.DecodeString(s)
+	if err != nil {
+		return Error.New("invalid key: %w", err)
+	}
+	if len(decoded) != 32 {
+		return Error.New("invalid key length (expected 32 bytes, got %d)", len(decoded))
+	}
+
+	key.key = new([32]byte)
+	copy(key.key[:], decoded)
+	return nil
+}
+
+// Sealer encrypts and decrypts the stored access grants.
+type Sealer struct {
+	aead cipher.AEAD
+}
+
+// NewSealer creates a sealer using the configured key. The sealer fails with
+// ErrNoKey, when the key isn't set.
+func NewSealer(config Config) (*Sealer, error) {
+	if config.Key.key == nil {
+		return &Sealer{}, nil
+	}
+
+	block, err := aes.NewCipher(config.Key.key[:])
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return &Sealer{aead: aead}, nil
+}
+
+// Seal encrypts the serialized access grant of the project. The purpose
+// binds the sealed value to where it's stored, so it cannot be opened for
+// another purpose or project.
+func (sealer *Sealer) Seal(projectID uuid.UUID, purpose, access string) (string, error) {
+	if sealer.aead == nil {
+		return "", ErrNoKey.New("")
+	}
+
+	nonce := make([]byte, sealer.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", Error.Wrap(err)
+	}
+
+	sealed := sealer.aead.Seal(nonce, nonce, []byte(access), additionalData(projectID, purpose))
+	return prefix + base64.RawURLEncoding.EncodeToString(sealed), nil
+}
+
+// Open decrypts an access grant, which was sealed with the same project and
+// purpose.
+func (sealer *Sealer) Open(projectID uuid.UUID, purpose, sealed string) (string, error) {
+	if sealer.aead == nil {
+		return "", ErrNoKey.New("")
+	}
+	if !strings.HasPrefix(sealed, prefix) {
+		return "", Error.New("access grant is not sealed")
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(strings.TrimPrefix(sealed, prefix))
+	if err != nil {
+		return "", Error.Wrap(err)
+	}
+	if len(data) < sealer.aead.NonceSize() {
+		return "", Error.New("sealed access grant too short")
+	}
+
+	nonce, ciphertext := data[:sealer.aead.NonceSize()], data[sealer.aead.NonceSize():]
+	access, err := sealer.aead.Open(nil, nonce, ciphertext, additionalData(projectID, purpose))
+	if err != nil {
+		return "", Error.Wrap(err)
+	}
+	return string(access), nil
+}
+
+func additionalData(projectID uuid.UUID, purpose string) []byte {
+	return append(projectID[:], purpose...)
+}
@@ -0,0 +1,66 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package sealedaccess_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite/sealedaccess"
+)
+
+func TestSealer(t *testing.T) {
+	var config sealedaccess.Config
+	require.NoError(t, config.Key.Set(hex.EncodeToString(testrand.BytesInt(32))))
+
+	sealer, err := sealedaccess.NewSealer(config)
+	require.NoError(t, err)
+
+	projectID := testrand.UUID()
+	sealed, err := sealer.Seal(projectID, "replication-source", "access-grant")
+	require.NoError(t, err)
+	require.NotContains(t, sealed, "access-grant")
+
+	access, err := sealer.Open(projectID, "replication-source", sealed)
+	require.NoError(t, err)
+	require.Equal(t, "access-grant", access)
+
+	// the sealed value is bound to the project and the purpose.
+	_, err = sealer.Open(testrand.UUID(), "replication-source", sealed)
+	require.Error(t, err)
+	_, err = sealer.Open(projectID, "replication-destination", sealed)
+	require.Error(t, err)
+
+	// plain access grants are rejected.
+	_, err = sealer.Open(projectID, "replication-source", "access-grant")
+	require.Error(t, err)
+
+	// modified values are rejected.
+	_, err = sealer.Open(projectID, "replication-source", sealed[:len(sealed)-4])
+	require.Error(t, err)
+}
+
+func TestSealer_NoKey(t *testing.T) {
+	sealer, err := sealedaccess.NewSealer(sealedaccess.Config{})
+	require.NoError(t, err)
+
+	_, err = sealer.Seal(testrand.UUID(), "replication-source", "access-grant")
+	require.True(t, sealedaccess.ErrNoKey.Has(err))
+}
+
+func TestKey(t *testing.T) {
+	var key sealedaccess.Key
+	require.Error(t, key.Set("not hex"))
+	require.Error(t, key.Set("abcd"))
+
+	encoded := hex.EncodeToString(testrand.BytesInt(32))
+	require.NoError(t, key.Set(encoded))
+	require.Equal(t, encoded, key.String())
+
+	require.NoError(t, key.Set(""))
+	require.Empty(t, key.String())
+}
@@ -0,0 +1,119 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellite
+
+import (
+	"context"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/storj/satellite/console/consoleauth"
+	"storj.io/storj/satellite/orders"
+	"storj.io/storj/satellite/payments/stripecoinpayments"
+	"storj.io/storj/satellite/secrets"
+)
+
+// secretsLoader loads the secrets of a peer from the secret store and rotates
+// them when they change in the secret store.
+type secretsLoader struct {
+	config   secrets.Config
+	provider secrets.Provider
+
+	// Chore is nil, when the secrets are taken from the configuration.
+	Chore *secrets.Chore
+
+	ordersKeys *secrets.Secret
+}
+
+func newSecretsLoader(ctx context.Context, log *zap.Logger, config secrets.Config) (*secretsLoader, error) {
+	provider, err := secrets.NewProvider(ctx, config)
+	if err != nil {
+		return nil, err
+	}
+
+	loader := &secretsLoader{
+		config:   config,
+		provider: provider,
+	}
+	if provider != nil {
+		loader.Chore = secrets.NewChore(log, provider, config.RefreshInterval)
+	}
+	return loader, nil
+}
+
+// ordersConfig returns config with the order encryption keys from the secret
+// store.
+func (s *secretsLoader) ordersConfig(ctx context.Context, config orders.Config) (_ orders.Config, err error) {
+	name := s.config.Names.OrdersEncryptionKeys
+	if s.provider == nil || name == "" {
+		return config, nil
+	}
+
+	secret, err := s.provider.Secret(ctx, name)
+	if err != nil {
+		return config, err
+	}
+
+	config.EncryptionKeys = orders.EncryptionKeys{}
+	if err := config.EncryptionKeys.Set(string(secret.Value)); err != nil {
+		return config, err
+	}
+	s.ordersKeys = &secret
+	return config, nil
+}
+
+// watchOrders rotates the order encryption keys of service, which was created
+// with the config from ordersConfig.
+func (s *secretsLoader) watchOrders(service *orders.Service) {
+	if s.Chore == nil || s.ordersKeys == nil {
+		return
+	}
+
+	s.Chore.Watch(s.config.Names.OrdersEncryptionKeys, *s.ordersKeys, func(ctx context.Context, secret secrets.Secret) error {
+		var keys orders.EncryptionKeys
+		if err := keys.Set(string(secret.Value)); err != nil {
+			return err
+		}
+		return service.SetEncryptionKeys(keys)
+	})
+}
+
+// consoleAuthSigner returns the signer for the console auth tokens, which
+// follows the rotations of the secret in the secret store.
+func (s *secretsLoader) consoleAuthSigner(ctx context.Context, fallback string) (consoleauth.Signer, error) {
+	name := s.config.Names.ConsoleAuthTokenSecret
+	secret, err := secrets.Load(ctx, s.provider, name, fallback)
+	if err != nil {
+		return nil, err
+	}
+	if len(secret.Value) == 0 {
+		return nil, errs.New("Auth token secret required")
+	}
+
+	if s.Chore == nil || name == "" {
+		return &consoleauth.Hmac{Secret: secret.Value}, nil
+	}
+
+	signer := consoleauth.NewRotatingHmac(secret.Value)
+	s.Chore.Watch(name, secret, func(ctx context.Context, secret secrets.Secret) error {
+		if len(secret.Value) == 0 {
+			return errs.New("Auth token secret required")
+		}
+		signer.Rotate(secret.Value)
+		return nil
+	})
+	return signer, nil
+}
+
+// stripeConfig returns config with the stripe API secret key from the secret
+// store. The stripe client is not recreated, so the key is only loaded once.
+func (s *secretsLoader) stripeConfig(ctx context.Context, config stripecoinpayments.Config) (_ stripecoinpayments.Config, err error) {
+	secret, err := secrets.Load(ctx, s.provider, s.config.Names.StripeSecretKey, config.StripeSecretKey)
+	if err != nil {
+		return config, err
+	}
+	config.StripeSecretKey = string(secret.Value)
+	return config, nil
+}
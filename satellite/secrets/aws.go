@@ -0,0 +1,195 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package secrets
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/zeebo/errs"
+)
+
+// AWSConfig configures the provider reading the secrets from AWS Secrets
+// Manager. The credentials are taken from the AWS_ACCESS_KEY_ID,
+// AWS_SECRET_ACCESS_KEY and AWS_SESSION_TOKEN environment variables.
+type AWSConfig struct {
+	Region         string        `help:"aws region of the secrets" default:"us-east-1"`
+	Endpoint       string        `help:"secrets manager api endpoint, derived from the region when empty" default:""`
+	RequestTimeout time.Duration `help:"timeout for the http request to secrets manager" default:"10s"`
+}
+
+// AWSProvider reads the current version of the secrets from AWS Secrets
+// Manager.
+type AWSProvider struct {
+	config AWSConfig
+	client *http.Client
+	now    func() time.Time
+}
+
+// NewAWSProvider is a constructor for AWSProvider.
+func NewAWSProvider(config AWSConfig) *AWSProvider {
+	return NewAWSProviderWithClient(&http.Client{Timeout: config.RequestTimeout}, config)
+}
+
+// NewAWSProviderWithClient creates an AWSProvider using client for the requests.
+func NewAWSProviderWithClient(client *http.Client, config AWSConfig) *AWSProvider {
+	return &AWSProvider{
+		config: config,
+		client: client,
+		now:    time.Now,
+	}
+}
+
+// Name implements Provider.
+func (provider *AWSProvider) Name() string { return "aws" }
+
+// Secret implements Provider.
+func (provider *AWSProvider) Secret(ctx context.Context, name string) (_ Secret, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	body, err := json.Marshal(struct {
+		SecretID string `json:"SecretId"`
+	}{SecretID: name})
+	if err != nil {
+		return Secret{}, Error.Wrap(err)
+	}
+
+	endpoint := provider.config.Endpoint
+	if endpoint == "" {
+		endpoint = "https://secretsmanager." + provider.config.Region + ".amazonaws.com"
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return Secret{}, Error.Wrap(err)
+	}
+	req.Header.Set("Content-Type", "application/x-amz-json-1.1")
+	req.Header.Set("X-Amz-Target", "secretsmanager.GetSecretValue")
+
+	if err := provider.sign(req, body); err != nil {
+		return Secret{}, err
+	}
+
+	resp, err := provider.client.Do(req)
+	if err != nil {
+		return Secret{}, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, resp.Body.Close()) }()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		data, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		var failure struct {
+			Type string `json:"__type"`
+		}
+		if json.Unmarshal(data, &failure) == nil && strings.HasSuffix(failure.Type, "ResourceNotFoundException") {
+			return Secret{}, ErrNotFound.New("%q", name)
+		}
+		return Secret{}, Error.New("unexpected status code %d: %s", resp.StatusCode, data)
+	}
+
+	var response struct {
+		SecretString string `json:"SecretString"`
+		SecretBinary []byte `json:"SecretBinary"`
+		VersionID    string `json:"VersionId"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		return Secret{}, Error.Wrap(err)
+	}
+
+	value := response.SecretBinary
+	if response.SecretString != "" {
+		value = []byte(response.SecretString)
+	}
+
+	return Secret{
+		Value:   value,
+		Version: response.VersionID,
+	}, nil
+}
+
+// sign adds the signature version 4 authorization to the request.
+func (provider *AWSProvider) sign(req *http.Request, body []byte) error {
+	accessKeyID, secretAccessKey := os.Getenv("AWS_ACCESS_KEY_ID"), os.Getenv("AWS_SECRET_ACCESS_KEY")
+	if accessKeyID == "" || secretAccessKey == "" {
+		return Error.New("aws credentials are missing")
+	}
+
+	const service = "secretsmanager"
+
+	now := provider.now().UTC()
+	amzDate := now.Format("20060102T150405Z")
+	scope := now.Format("20060102") + "/" + provider.config.Region + "/" + service + "/aws4_request"
+
+	req.Header.Set("X-Amz-Date", amzDate)
+	if token := os.Getenv("AWS_SESSION_TOKEN"); token != "" {
+		req.Header.Set("X-Amz-Security-Token", token)
+	}
+
+	signedHeaders := []string{"content-type", "host", "x-amz-date", "x-amz-target"}
+	if req.Header.Get("X-Amz-Security-Token") != "" {
+		signedHeaders = append(signedHeaders, "x-amz-security-token")
+		// the headers must be sorted.
+		signedHeaders[3], signedHeaders[4] = signedHeaders[4], signedHeaders[3]
+	}
+
+	var canonicalHeaders strings.Builder
+	for _, header := range signedHeaders {
+		value := req.Header.Get(header)
+		if header == "host" {
+			value = req.URL.Host
+		}
+		canonicalHeaders.WriteString(header + ":" + strings.TrimSpace(value) + "\n")
+	}
+
+	canonicalPath := req.URL.EscapedPath()
+	if canonicalPath == "" {
+		canonicalPath = "/"
+	}
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		canonicalPath,
+		req.URL.RawQuery,
+		canonicalHeaders.String(),
+		strings.Join(signedHeaders, ";"),
+		hashHex(body),
+	}, "\n")
+
+	stringToSign := strings.Join([]string{
+		"AWS4-HMAC-SHA256",
+		amzDate,
+		scope,
+		hashHex([]byte(canonicalRequest)),
+	}, "\n")
+
+	key := []byte("AWS4" + secretAccessKey)
+	for _, part := range []string{now.Format("20060102"), provider.config.Region, service, "aws4_request"} {
+		key = hmacSHA256(key, part)
+	}
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", "AWS4-HMAC-SHA256 Credential="+accessKeyID+"/"+scope+
+		", SignedHeaders="+strings.Join(signedHeaders, ";")+", Signature="+signature)
+	return nil
+}
+
+func hashHex(data []byte) string {
+	hash := sha256.Sum256(data)
+	return hex.EncodeToString(hash[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	_, _ = mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
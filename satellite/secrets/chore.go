@@ -0,0 +1,111 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package secrets
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/sync2"
+)
+
+// Chore periodically reloads the watched secrets and notifies the watchers
+// about the rotated secrets.
+//
+// architecture: Chore
+type Chore struct {
+	log      *zap.Logger
+	provider Provider
+
+	mu      sync.Mutex
+	watches []*watch
+
+	Loop *sync2.Cycle
+}
+
+type watch struct {
+	name    string
+	version string
+	rotate  func(ctx context.Context, secret Secret) error
+}
+
+// NewChore creates a new chore reloading the secrets from provider.
+func NewChore(log *zap.Logger, provider Provider, interval time.Duration) *Chore {
+	return &Chore{
+		log:      log,
+		provider: provider,
+
+		Loop: sync2.NewCycle(interval),
+	}
+}
+
+// Watch registers rotate to be called when the version of the named secret
+// differs from the version of current. The version is only updated when rotate
+// succeeds, so a failed rotation is retried on the next cycle.
+func (chore *Chore) Watch(name string, current Secret, rotate func(ctx context.Context, secret Secret) error) {
+	chore.mu.Lock()
+	defer chore.mu.Unlock()
+
+	chore.watches = append(chore.watches, &watch{
+		name:    name,
+		version: current.Version,
+		rotate:  rotate,
+	})
+}
+
+// Run starts the chore.
+func (chore *Chore) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return chore.Loop.Run(ctx, func(ctx context.Context) error {
+		chore.RunOnce(ctx)
+		return nil
+	})
+}
+
+// RunOnce reloads the watched secrets once.
+func (chore *Chore) RunOnce(ctx context.Context) {
+	defer mon.Task()(&ctx)(nil)
+
+	chore.mu.Lock()
+	defer chore.mu.Unlock()
+
+	for _, watch := range chore.watches {
+		secret, err := chore.provider.Secret(ctx, watch.name)
+		if err != nil {
+			chore.log.Error("unable to reload secret",
+				zap.String("provider", chore.provider.Name()),
+				zap.String("name", watch.name),
+				zap.Error(err))
+			continue
+		}
+		if secret.Version == watch.version {
+			continue
+		}
+
+		if err := watch.rotate(ctx, secret); err != nil {
+			chore.log.Error("unable to rotate secret",
+				zap.String("provider", chore.provider.Name()),
+				zap.String("name", watch.name),
+				zap.String("version", secret.Version),
+				zap.Error(err))
+			continue
+		}
+
+		chore.log.Info("rotated secret",
+			zap.String("provider", chore.provider.Name()),
+			zap.String("name", watch.name),
+			zap.String("version", secret.Version))
+		mon.Event("secret_rotated")
+		watch.version = secret.Version
+	}
+}
+
+// Close stops the chore.
+func (chore *Chore) Close() error {
+	chore.Loop.Close()
+	return nil
+}
@@ -0,0 +1,61 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package secrets
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// FileConfig configures the provider reading the secrets from files.
+type FileConfig struct {
+	Dir string `help:"directory containing a file for each secret, e.g. a mounted kubernetes secret" default:""`
+}
+
+// FileProvider reads each secret from a file in a directory. The version of a
+// secret is the hash of its contents.
+type FileProvider struct {
+	config FileConfig
+}
+
+// NewFileProvider is a constructor for FileProvider.
+func NewFileProvider(config FileConfig) *FileProvider {
+	return &FileProvider{config: config}
+}
+
+// Name implements Provider.
+func (provider *FileProvider) Name() string { return "file" }
+
+// Secret implements Provider.
+func (provider *FileProvider) Secret(ctx context.Context, name string) (_ Secret, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if name == "" || name != filepath.Base(name) || strings.HasPrefix(name, ".") {
+		return Secret{}, Error.New("invalid secret name %q", name)
+	}
+
+	data, err := os.ReadFile(filepath.Join(provider.config.Dir, name))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return Secret{}, ErrNotFound.New("%q", name)
+		}
+		return Secret{}, Error.Wrap(err)
+	}
+
+	// editors and kubectl usually add a trailing newline.
+	data = bytes.TrimRight(data, "\r\n")
+
+	hash := sha256.Sum256(data)
+	return Secret{
+		Value:   data,
+		Version: hex.EncodeToString(hash[:8]),
+	}, nil
+}
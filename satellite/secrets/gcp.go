@@ -0,0 +1,89 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package secrets
+
+import (
+	"context"
+	"encoding/base64"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"golang.org/x/oauth2/google"
+)
+
+// GCPConfig configures the provider reading the secrets from Google Secret
+// Manager.
+type GCPConfig struct {
+	ProjectID      string        `help:"google cloud project of the secrets" default:""`
+	Endpoint       string        `help:"secret manager api endpoint" default:"https://secretmanager.googleapis.com"`
+	RequestTimeout time.Duration `help:"timeout for the http request to secret manager" default:"10s"`
+}
+
+// GCPProvider reads the latest version of the secrets from Google Secret
+// Manager.
+type GCPProvider struct {
+	config GCPConfig
+	client *http.Client
+}
+
+// NewGCPProviderFromCredentials creates a GCPProvider authenticated with the
+// application default credentials.
+func NewGCPProviderFromCredentials(ctx context.Context, config GCPConfig) (*GCPProvider, error) {
+	client, err := google.DefaultClient(ctx, "https://www.googleapis.com/auth/cloud-platform")
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	client.Timeout = config.RequestTimeout
+	return NewGCPProvider(client, config), nil
+}
+
+// NewGCPProvider creates a GCPProvider using client for the requests.
+func NewGCPProvider(client *http.Client, config GCPConfig) *GCPProvider {
+	return &GCPProvider{
+		config: config,
+		client: client,
+	}
+}
+
+// Name implements Provider.
+func (provider *GCPProvider) Name() string { return "gcp" }
+
+// Secret implements Provider.
+func (provider *GCPProvider) Secret(ctx context.Context, name string) (_ Secret, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	accessURL := strings.TrimSuffix(provider.config.Endpoint, "/") +
+		"/v1/projects/" + url.PathEscape(provider.config.ProjectID) +
+		"/secrets/" + url.PathEscape(name) + "/versions/latest:access"
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, accessURL, nil)
+	if err != nil {
+		return Secret{}, Error.Wrap(err)
+	}
+
+	var response struct {
+		// Name is the resource name of the accessed version, which ends with
+		// the version number.
+		Name    string `json:"name"`
+		Payload struct {
+			Data string `json:"data"`
+		} `json:"payload"`
+	}
+	if err := getJSON(provider.client, req, name, &response); err != nil {
+		return Secret{}, err
+	}
+
+	value, err := base64.StdEncoding.DecodeString(response.Payload.Data)
+	if err != nil {
+		return Secret{}, Error.Wrap(err)
+	}
+
+	return Secret{
+		Value:   value,
+		Version: path.Base(response.Name),
+	}, nil
+}
@@ -0,0 +1,118 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package secrets implements loading the secrets of the satellite, such as the
+// order encryption keys, from a secret store instead of the configuration.
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+)
+
+var (
+	// Error is the standard error class for secrets.
+	Error = errs.Class("secrets")
+	// ErrNotFound is returned when the secret does not exist.
+	ErrNotFound = errs.Class("secret not found")
+
+	mon = monkit.Package()
+)
+
+// Secret is a single version of a secret.
+type Secret struct {
+	Value []byte
+	// Version identifies the version of the secret, it changes when the
+	// secret is rotated.
+	Version string
+}
+
+// Provider loads secrets from a secret store.
+type Provider interface {
+	// Name returns the name of the provider used for logging.
+	Name() string
+	// Secret returns the current version of the named secret.
+	Secret(ctx context.Context, name string) (Secret, error)
+}
+
+// Config contains the configuration of the secret store.
+type Config struct {
+	Provider        string        `help:"secret store the secrets are loaded from, one of file, vault, gcp or aws, the secrets are taken from the configuration when empty" default:""`
+	RefreshInterval time.Duration `help:"how often the secrets are reloaded from the secret store to pick up rotated secrets" default:"5m" testDefault:"$TESTINTERVAL"`
+
+	Names Names
+
+	File  FileConfig
+	Vault VaultConfig
+	GCP   GCPConfig
+	AWS   AWSConfig
+}
+
+// Names contains the names of the secrets in the secret store. A secret with
+// an empty name is taken from the configuration.
+type Names struct {
+	OrdersEncryptionKeys   string `help:"name of the secret with the order encryption keys, in the same format as --orders.encryption-keys" default:""`
+	ConsoleAuthTokenSecret string `help:"name of the secret used to sign the console auth tokens, tokens signed with the previous secret are accepted after a rotation" default:""`
+	StripeSecretKey        string `help:"name of the secret with the stripe API secret key, which is loaded only at startup" default:""`
+}
+
+// NewProvider returns the provider selected in the config, or nil when the
+// secrets are taken from the configuration.
+func NewProvider(ctx context.Context, config Config) (Provider, error) {
+	switch config.Provider {
+	case "":
+		return nil, nil
+	case "file":
+		return NewFileProvider(config.File), nil
+	case "vault":
+		return NewVaultProvider(config.Vault), nil
+	case "gcp":
+		return NewGCPProviderFromCredentials(ctx, config.GCP)
+	case "aws":
+		return NewAWSProvider(config.AWS), nil
+	default:
+		return nil, Error.New("unknown provider %q", config.Provider)
+	}
+}
+
+// getJSON sends the request and decodes the json response into v. A missing
+// secret is reported with ErrNotFound.
+func getJSON(client *http.Client, req *http.Request, name string, v interface{}) (err error) {
+	resp, err := client.Do(req)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, resp.Body.Close()) }()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return ErrNotFound.New("%q", name)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return Error.New("unexpected status code %d: %s", resp.StatusCode, body)
+	}
+
+	return Error.Wrap(json.NewDecoder(resp.Body).Decode(v))
+}
+
+// Load returns the named secret from provider. The fallback value from the
+// configuration is returned, when provider is nil or the name is empty.
+func Load(ctx context.Context, provider Provider, name, fallback string) (_ Secret, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if provider == nil || name == "" {
+		return Secret{Value: []byte(fallback)}, nil
+	}
+
+	secret, err := provider.Secret(ctx, name)
+	if err != nil {
+		return Secret{}, err
+	}
+	return secret, nil
+}
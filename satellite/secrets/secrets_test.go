@@ -0,0 +1,208 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package secrets_test
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/satellite/secrets"
+)
+
+func TestFileProvider(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	dir := ctx.Dir("secrets")
+	provider := secrets.NewFileProvider(secrets.FileConfig{Dir: dir})
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "key"), []byte("first\n"), 0600))
+	first, err := provider.Secret(ctx, "key")
+	require.NoError(t, err)
+	require.Equal(t, []byte("first"), first.Value)
+
+	unchanged, err := provider.Secret(ctx, "key")
+	require.NoError(t, err)
+	require.Equal(t, first, unchanged)
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "key"), []byte("second"), 0600))
+	second, err := provider.Secret(ctx, "key")
+	require.NoError(t, err)
+	require.Equal(t, []byte("second"), second.Value)
+	require.NotEqual(t, first.Version, second.Version)
+
+	_, err = provider.Secret(ctx, "missing")
+	require.True(t, secrets.ErrNotFound.Has(err))
+
+	_, err = provider.Secret(ctx, "../key")
+	require.Error(t, err)
+	require.False(t, secrets.ErrNotFound.Has(err))
+}
+
+func TestVaultProvider(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Vault-Token") != "token" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		if r.URL.Path != "/v1/kv/data/satellite/key" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(`{"data":{"data":{"value":"secret"},"metadata":{"version":3}}}`))
+	}))
+	defer server.Close()
+
+	tokenFile := ctx.File("token")
+	require.NoError(t, os.WriteFile(tokenFile, []byte("token\n"), 0600))
+
+	provider := secrets.NewVaultProvider(secrets.VaultConfig{
+		Address:   server.URL,
+		Mount:     "kv",
+		Field:     "value",
+		TokenFile: tokenFile,
+	})
+
+	secret, err := provider.Secret(ctx, "satellite/key")
+	require.NoError(t, err)
+	require.Equal(t, secrets.Secret{Value: []byte("secret"), Version: "3"}, secret)
+
+	_, err = provider.Secret(ctx, "missing")
+	require.True(t, secrets.ErrNotFound.Has(err))
+
+	require.NoError(t, os.WriteFile(tokenFile, []byte("invalid"), 0600))
+	_, err = provider.Secret(ctx, "satellite/key")
+	require.Error(t, err)
+}
+
+func TestGCPProvider(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/projects/project/secrets/key/versions/latest:access" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"name": "projects/123/secrets/key/versions/5",
+			"payload": map[string]string{
+				"data": base64.StdEncoding.EncodeToString([]byte("secret")),
+			},
+		})
+	}))
+	defer server.Close()
+
+	provider := secrets.NewGCPProvider(server.Client(), secrets.GCPConfig{
+		ProjectID: "project",
+		Endpoint:  server.URL,
+	})
+
+	secret, err := provider.Secret(ctx, "key")
+	require.NoError(t, err)
+	require.Equal(t, secrets.Secret{Value: []byte("secret"), Version: "5"}, secret)
+
+	_, err = provider.Secret(ctx, "missing")
+	require.True(t, secrets.ErrNotFound.Has(err))
+}
+
+func TestAWSProvider(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	t.Setenv("AWS_ACCESS_KEY_ID", "access")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "secret")
+	t.Setenv("AWS_SESSION_TOKEN", "")
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization := r.Header.Get("Authorization")
+		if !strings.HasPrefix(authorization, "AWS4-HMAC-SHA256 Credential=access/") ||
+			!strings.Contains(authorization, "/eu-west-1/secretsmanager/aws4_request, SignedHeaders=content-type;host;x-amz-date;x-amz-target, Signature=") ||
+			r.Header.Get("X-Amz-Target") != "secretsmanager.GetSecretValue" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+
+		var request struct {
+			SecretID string `json:"SecretId"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil || request.SecretID != "key" {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"__type":"ResourceNotFoundException"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"SecretString":"secret","VersionId":"v2"}`))
+	}))
+	defer server.Close()
+
+	provider := secrets.NewAWSProvider(secrets.AWSConfig{
+		Region:   "eu-west-1",
+		Endpoint: server.URL,
+	})
+
+	secret, err := provider.Secret(ctx, "key")
+	require.NoError(t, err)
+	require.Equal(t, secrets.Secret{Value: []byte("secret"), Version: "v2"}, secret)
+
+	_, err = provider.Secret(ctx, "missing")
+	require.True(t, secrets.ErrNotFound.Has(err))
+
+	t.Setenv("AWS_ACCESS_KEY_ID", "")
+	_, err = provider.Secret(ctx, "key")
+	require.Error(t, err)
+}
+
+func TestChore(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	dir := ctx.Dir("secrets")
+	provider := secrets.NewFileProvider(secrets.FileConfig{Dir: dir})
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "key"), []byte("first"), 0600))
+
+	current, err := secrets.Load(ctx, provider, "key", "fallback")
+	require.NoError(t, err)
+	require.Equal(t, []byte("first"), current.Value)
+
+	chore := secrets.NewChore(zaptest.NewLogger(t), provider, time.Hour)
+	defer ctx.Check(chore.Close)
+
+	var rotated [][]byte
+	failing := true
+	chore.Watch("key", current, func(ctx context.Context, secret secrets.Secret) error {
+		if failing {
+			return secrets.Error.New("rotation failed")
+		}
+		rotated = append(rotated, secret.Value)
+		return nil
+	})
+
+	chore.RunOnce(ctx)
+	require.Empty(t, rotated)
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "key"), []byte("second"), 0600))
+
+	// the failed rotation is retried.
+	chore.RunOnce(ctx)
+	require.Empty(t, rotated)
+
+	failing = false
+	chore.RunOnce(ctx)
+	chore.RunOnce(ctx)
+	require.Equal(t, [][]byte{[]byte("second")}, rotated)
+
+	fallback, err := secrets.Load(ctx, nil, "key", "fallback")
+	require.NoError(t, err)
+	require.Equal(t, []byte("fallback"), fallback.Value)
+}
@@ -0,0 +1,115 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package secrets
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// VaultConfig configures the provider reading the secrets from the KV version
+// 2 secrets engine of HashiCorp Vault.
+type VaultConfig struct {
+	Address        string        `help:"address of the vault server" default:"http://127.0.0.1:8200"`
+	Mount          string        `help:"mount path of the kv version 2 secrets engine" default:"secret"`
+	Field          string        `help:"field of the kv secret containing the value" default:"value"`
+	TokenFile      string        `help:"file containing the vault token, the VAULT_TOKEN environment variable is used when empty" default:""`
+	RequestTimeout time.Duration `help:"timeout for the http request to vault" default:"10s"`
+}
+
+// VaultProvider reads the secrets from the KV version 2 secrets engine of
+// HashiCorp Vault. The version of a secret is the version of the kv secret.
+type VaultProvider struct {
+	config VaultConfig
+	client *http.Client
+}
+
+// NewVaultProvider is a constructor for VaultProvider.
+func NewVaultProvider(config VaultConfig) *VaultProvider {
+	return NewVaultProviderWithClient(&http.Client{Timeout: config.RequestTimeout}, config)
+}
+
+// NewVaultProviderWithClient creates a VaultProvider using client for the requests.
+func NewVaultProviderWithClient(client *http.Client, config VaultConfig) *VaultProvider {
+	return &VaultProvider{
+		config: config,
+		client: client,
+	}
+}
+
+// Name implements Provider.
+func (provider *VaultProvider) Name() string { return "vault" }
+
+// Secret implements Provider.
+func (provider *VaultProvider) Secret(ctx context.Context, name string) (_ Secret, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// the token is read for every request, so it can be renewed by an agent.
+	token, err := provider.token()
+	if err != nil {
+		return Secret{}, err
+	}
+
+	secretURL := strings.TrimSuffix(provider.config.Address, "/") +
+		"/v1/" + strings.Trim(provider.config.Mount, "/") + "/data/" + escapePath(name)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, secretURL, nil)
+	if err != nil {
+		return Secret{}, Error.Wrap(err)
+	}
+	req.Header.Set("X-Vault-Token", token)
+
+	var response struct {
+		Data struct {
+			Data     map[string]string `json:"data"`
+			Metadata struct {
+				Version int `json:"version"`
+			} `json:"metadata"`
+		} `json:"data"`
+	}
+	if err := getJSON(provider.client, req, name, &response); err != nil {
+		return Secret{}, err
+	}
+
+	value, ok := response.Data.Data[provider.config.Field]
+	if !ok {
+		return Secret{}, Error.New("secret %q has no field %q", name, provider.config.Field)
+	}
+
+	return Secret{
+		Value:   []byte(value),
+		Version: strconv.Itoa(response.Data.Metadata.Version),
+	}, nil
+}
+
+func (provider *VaultProvider) token() (string, error) {
+	if provider.config.TokenFile == "" {
+		token := os.Getenv("VAULT_TOKEN")
+		if token == "" {
+			return "", Error.New("vault token is missing")
+		}
+		return token, nil
+	}
+
+	data, err := os.ReadFile(provider.config.TokenFile)
+	if err != nil {
+		return "", Error.Wrap(err)
+	}
+	return string(bytes.TrimSpace(data)), nil
+}
+
+// escapePath escapes each segment of a slash separated secret name.
+func escapePath(name string) string {
+	segments := strings.Split(name, "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+	return strings.Join(segments, "/")
+}
@@ -85,6 +85,10 @@ type Blobs interface {
 	DeleteNamespace(ctx context.Context, ref []byte) (err error)
 	// Trash marks a file for pending deletion.
 	Trash(ctx context.Context, ref BlobRef) error
+	// Quarantine moves a corrupt file out of the storage, keeping it for inspection.
+	Quarantine(ctx context.Context, ref BlobRef) error
+	// EmptyQuarantine removes all files in the quarantine that were quarantined prior to quarantinedBefore and returns the total bytes emptied.
+	EmptyQuarantine(ctx context.Context, namespace []byte, quarantinedBefore time.Time) (int64, error)
 	// RestoreTrash restores all files in the trash for a given namespace and returns the keys restored.
 	RestoreTrash(ctx context.Context, namespace []byte) ([][]byte, error)
 	// EmptyTrash removes all files in trash that were moved to trash prior to trashedBefore and returns the total bytes emptied and keys deleted.
@@ -99,7 +103,7 @@ type Blobs interface {
 	FreeSpace(ctx context.Context) (int64, error)
 	// CheckWritability tests writability of the storage directory by creating and deleting a file.
 	CheckWritability(ctx context.Context) error
-	// SpaceUsedForTrash returns the total space used by the trash.
+	// SpaceUsedForTrash returns the total space used by the trash, including the quarantined files.
 	SpaceUsedForTrash(ctx context.Context) (int64, error)
 	// SpaceUsedForBlobs adds up how much is used in all namespaces.
 	SpaceUsedForBlobs(ctx context.Context) (int64, error)
@@ -112,6 +116,11 @@ type Blobs interface {
 	// error, WalkNamespace will stop iterating and return the error immediately. The ctx
 	// parameter is intended to allow canceling iteration early.
 	WalkNamespace(ctx context.Context, namespace []byte, walkFunc func(BlobInfo) error) error
+	// WalkNamespaceFrom executes walkFunc for each locally stored blob in the given
+	// namespace, whose key is greater than the cursor, in the order of the keys. If
+	// walkFunc returns a non-nil error, WalkNamespaceFrom will stop iterating and return
+	// the error immediately.
+	WalkNamespaceFrom(ctx context.Context, namespace, cursor []byte, walkFunc func(BlobInfo) error) error
 	// CreateVerificationFile creates a file to be used for storage directory verification.
 	CreateVerificationFile(ctx context.Context, id storj.NodeID) error
 	// VerifyStorageDir verifies that the storage directory is correct by checking for the existence and validity
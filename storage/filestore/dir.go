@@ -12,6 +12,7 @@ import (
 	"math"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -80,6 +81,7 @@ func NewDir(log *zap.Logger, path string) (*Dir, error) {
 		os.MkdirAll(dir.tempdir(), dirPermission),
 		os.MkdirAll(dir.garbagedir(), dirPermission),
 		os.MkdirAll(dir.trashdir(), dirPermission),
+		os.MkdirAll(dir.quarantinedir(), dirPermission),
 	)
 }
 
@@ -98,6 +100,9 @@ func (dir *Dir) garbagedir() string { return filepath.Join(dir.path, "garbage")
 // trashdir contains files staged for deletion for a period of time.
 func (dir *Dir) trashdir() string { return filepath.Join(dir.path, "trash") }
 
+// quarantinedir contains corrupt files, which are kept for inspection.
+func (dir *Dir) quarantinedir() string { return filepath.Join(dir.path, "quarantine") }
+
 // CreateVerificationFile creates a file to be used for storage directory verification.
 func (dir *Dir) CreateVerificationFile(ctx context.Context, id storj.NodeID) error {
 	f, err := os.Create(filepath.Join(dir.path, verificationFileName))
@@ -416,6 +421,85 @@ func (dir *Dir) TrashWithStorageFormat(ctx context.Context, ref storage.BlobRef,
 	return err
 }
 
+// Quarantine moves the piece specified by ref to the quarantinedir for every format version.
+func (dir *Dir) Quarantine(ctx context.Context, ref storage.BlobRef) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return dir.iterateStorageFormatVersions(ctx, ref, dir.quarantineWithStorageFormat)
+}
+
+// quarantineWithStorageFormat moves the piece specified by ref to the
+// quarantinedir for the specified format version.
+func (dir *Dir) quarantineWithStorageFormat(ctx context.Context, ref storage.BlobRef, formatVer storage.FormatVersion) (err error) {
+	blobsBasePath, err := dir.blobToBasePath(ref)
+	if err != nil {
+		return err
+	}
+
+	blobsVerPath := blobPathForFormatVersion(blobsBasePath, formatVer)
+
+	quarantineBasePath, err := dir.refToDirPath(ref, dir.quarantinedir())
+	if err != nil {
+		return err
+	}
+
+	quarantineVerPath := blobPathForFormatVersion(quarantineBasePath, formatVer)
+
+	// ensure the dirs exist for quarantine path, it's missing for
+	// directories created by older versions.
+	err = os.MkdirAll(filepath.Dir(quarantineVerPath), dirPermission)
+	if err != nil && !os.IsExist(err) {
+		return err
+	}
+
+	// Change mtime to now, like Trash does, so EmptyQuarantine knows how long
+	// the file has been in the quarantine.
+	now := dir.trashnow()
+	err = os.Chtimes(blobsVerPath, now, now)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	err = rename(blobsVerPath, quarantineVerPath)
+	if os.IsNotExist(err) {
+		// no piece at that path; either it has a different storage format
+		// version or there was a concurrent call.
+		return nil
+	}
+	return err
+}
+
+// EmptyQuarantine walks the quarantined files for the given namespace and
+// deletes any file whose mtime is older than quarantinedBefore. The mtime is
+// modified when Quarantine is called.
+func (dir *Dir) EmptyQuarantine(ctx context.Context, namespace []byte, quarantinedBefore time.Time) (bytesEmptied int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+	err = dir.walkNamespaceInPath(ctx, namespace, dir.quarantinedir(), func(blobInfo storage.BlobInfo) error {
+		fileInfo, err := blobInfo.Stat(ctx)
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+
+		if fileInfo.ModTime().Before(quarantinedBefore) {
+			err = dir.deleteWithStorageFormatInPath(ctx, dir.quarantinedir(), blobInfo.BlobRef(), blobInfo.StorageFormatVersion())
+			if err != nil {
+				return err
+			}
+			bytesEmptied += fileInfo.Size()
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return bytesEmptied, nil
+}
+
 // ReplaceTrashnow is a helper for tests to replace the trashnow function used
 // when moving files to the trash.
 func (dir *Dir) ReplaceTrashnow(trashnow func() time.Time) {
@@ -735,6 +819,139 @@ func (dir *Dir) walkNamespaceInPath(ctx context.Context, namespace []byte, path
 	}
 }
 
+// WalkNamespaceFrom executes walkFunc for each locally stored blob in the given namespace,
+// whose key is greater than the cursor, in the order of the keys. If walkFunc returns a
+// non-nil error, WalkNamespaceFrom will stop iterating and return the error immediately.
+//
+// The key prefix directories before the cursor are skipped and only the keys of a single
+// directory are sorted at once, so a walk, which stops early, doesn't read all keys.
+func (dir *Dir) WalkNamespaceFrom(ctx context.Context, namespace, cursor []byte, walkFunc func(storage.BlobInfo) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	nsDir := filepath.Join(dir.blobsdir(), pathEncoding.EncodeToString(namespace))
+	keyPrefixes, err := readDirNames(nsDir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+
+	// the encoding keeps the order of the keys, when the characters are
+	// compared by their position in the alphabet.
+	cursorPrefix := pathEncoding.EncodeToString(cursor)
+	if len(cursorPrefix) > 2 {
+		cursorPrefix = cursorPrefix[:2]
+	}
+	prefixes := keyPrefixes[:0]
+	for _, keyPrefix := range keyPrefixes {
+		if len(keyPrefix) == 2 && !encodedLess(keyPrefix, cursorPrefix) {
+			prefixes = append(prefixes, keyPrefix)
+		}
+	}
+	sort.Slice(prefixes, func(i, k int) bool {
+		return encodedLess(prefixes[i], prefixes[k])
+	})
+
+	for _, keyPrefix := range prefixes {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := walkNamespaceWithPrefixFrom(ctx, namespace, nsDir, keyPrefix, cursor, walkFunc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// walkNamespaceWithPrefixFrom executes walkFunc for the blobs of the key prefix directory,
+// whose key is greater than the cursor, in the order of the keys.
+func walkNamespaceWithPrefixFrom(ctx context.Context, namespace []byte, nsDir, keyPrefix string, cursor []byte, walkFunc func(storage.BlobInfo) error) (err error) {
+	keyDir := filepath.Join(nsDir, keyPrefix)
+	names, err := readDirNames(keyDir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+
+	type entry struct {
+		key  []byte
+		name string
+	}
+	entries := make([]entry, 0, len(names))
+	for _, name := range names {
+		encodedKey := keyPrefix + strings.TrimSuffix(name, v1PieceFileSuffix)
+		key, err := pathEncoding.DecodeString(encodedKey)
+		if err != nil || bytes.Compare(key, cursor) <= 0 {
+			continue
+		}
+		entries = append(entries, entry{key: key, name: name})
+	}
+	sort.Slice(entries, func(i, k int) bool {
+		return bytes.Compare(entries[i].key, entries[k].key) < 0
+	})
+
+	for _, entry := range entries {
+		info, err := os.Lstat(filepath.Join(keyDir, entry.name))
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return errs.Wrap(err)
+		}
+		if info.Mode().IsDir() {
+			continue
+		}
+		blobInfo, ok := decodeBlobInfo(namespace, keyPrefix, keyDir, info)
+		if !ok {
+			continue
+		}
+		if err := walkFunc(blobInfo); err != nil {
+			return err
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readDirNames returns the names of all entries of the directory.
+func readDirNames(path string) (_ []string, err error) {
+	openDir, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = errs.Combine(err, openDir.Close()) }()
+	return openDir.Readdirnames(-1)
+}
+
+// encodedLess compares two strings of the path encoding by the position of
+// their characters in the alphabet, which is the order of the encoded keys.
+func encodedLess(a, b string) bool {
+	for i := 0; i < len(a) && i < len(b); i++ {
+		x, y := encodingPosition(a[i]), encodingPosition(b[i])
+		if x != y {
+			return x < y
+		}
+	}
+	return len(a) < len(b)
+}
+
+// encodingPosition returns the position of the character in the alphabet of
+// the path encoding.
+func encodingPosition(ch byte) int {
+	switch {
+	case 'a' <= ch && ch <= 'z':
+		return int(ch - 'a')
+	case '2' <= ch && ch <= '7':
+		return 26 + int(ch-'2')
+	}
+	return -1
+}
+
 func decodeBlobInfo(namespace []byte, keyPrefix, keyDir string, keyInfo os.FileInfo) (info storage.BlobInfo, ok bool) {
 	blobFileName := keyInfo.Name()
 	encodedKey := keyPrefix + blobFileName
@@ -139,6 +139,19 @@ func (store *blobStore) Trash(ctx context.Context, ref storage.BlobRef) (err err
 	return Error.Wrap(store.dir.Trash(ctx, ref))
 }
 
+// Quarantine moves the ref to a quarantine directory, where it's kept for inspection.
+func (store *blobStore) Quarantine(ctx context.Context, ref storage.BlobRef) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return Error.Wrap(store.dir.Quarantine(ctx, ref))
+}
+
+// EmptyQuarantine removes all files in the quarantine that were quarantined prior to quarantinedBefore.
+func (store *blobStore) EmptyQuarantine(ctx context.Context, namespace []byte, quarantinedBefore time.Time) (bytesEmptied int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+	bytesEmptied, err = store.dir.EmptyQuarantine(ctx, namespace, quarantinedBefore)
+	return bytesEmptied, Error.Wrap(err)
+}
+
 // RestoreTrash moves every piece in the trash back into the regular location.
 func (store *blobStore) RestoreTrash(ctx context.Context, namespace []byte) (keysRestored [][]byte, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -226,7 +239,8 @@ func (store *blobStore) TrashIsEmpty() (_ bool, err error) {
 	return false, err
 }
 
-// SpaceUsedForTrash returns the total space used by the trash.
+// SpaceUsedForTrash returns the total space used by the trash. The quarantined
+// files are counted as trash, because they're deleted after a while as well.
 func (store *blobStore) SpaceUsedForTrash(ctx context.Context) (total int64, err error) {
 	defer mon.Task()(&ctx)(&err)
 
@@ -234,11 +248,21 @@ func (store *blobStore) SpaceUsedForTrash(ctx context.Context) (total int64, err
 	if err != nil {
 		return total, err
 	}
-	if empty {
-		return 0, nil
+	if !empty {
+		total, err = spaceUsedInDir(store.dir.trashdir())
 	}
-	err = filepath.Walk(store.dir.trashdir(), func(_ string, info os.FileInfo, walkErr error) error {
+
+	quarantined, quarantineErr := spaceUsedInDir(store.dir.quarantinedir())
+	return total + quarantined, errs.Combine(err, quarantineErr)
+}
+
+// spaceUsedInDir returns the total size of the files in the directory.
+func spaceUsedInDir(path string) (total int64, err error) {
+	err = filepath.Walk(path, func(_ string, info os.FileInfo, walkErr error) error {
 		if walkErr != nil {
+			if os.IsNotExist(walkErr) {
+				return nil
+			}
 			err = errs.Combine(err, walkErr)
 			return filepath.SkipDir
 		}
@@ -283,6 +307,13 @@ func (store *blobStore) WalkNamespace(ctx context.Context, namespace []byte, wal
 	return store.dir.WalkNamespace(ctx, namespace, walkFunc)
 }
 
+// WalkNamespaceFrom executes walkFunc for each locally stored blob in the given namespace,
+// whose key is greater than the cursor, in the order of the keys. If walkFunc returns a
+// non-nil error, WalkNamespaceFrom will stop iterating and return the error immediately.
+func (store *blobStore) WalkNamespaceFrom(ctx context.Context, namespace, cursor []byte, walkFunc func(storage.BlobInfo) error) (err error) {
+	return store.dir.WalkNamespaceFrom(ctx, namespace, cursor, walkFunc)
+}
+
 // TestCreateV0 creates a new V0 blob that can be written. This is ONLY appropriate in test situations.
 func (store *blobStore) TestCreateV0(ctx context.Context, ref storage.BlobRef) (_ storage.BlobWriter, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -532,6 +532,72 @@ func TestStoreTraversals(t *testing.T) {
 	assert.Equal(t, 2, iterations)
 }
 
+func TestWalkNamespaceFrom(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	store, err := filestore.NewAt(zaptest.NewLogger(t), ctx.Dir("store"), filestore.DefaultConfig)
+	require.NoError(t, err)
+	defer ctx.Check(store.Close)
+
+	namespace := testrand.Bytes(namespaceSize)
+
+	// keys of the first and the last key prefix directories.
+	keys := [][]byte{make([]byte, keySize), bytes.Repeat([]byte{0xff}, keySize)}
+	keys[0][keySize-1] = 1
+	for i := 0; i < 200; i++ {
+		keys = append(keys, testrand.Bytes(keySize))
+	}
+	for _, key := range keys {
+		writeABlob(ctx, t, store, storage.BlobRef{Namespace: namespace, Key: key}, testrand.BytesInt(10), filestore.FormatV1)
+	}
+	// blobs of other namespaces aren't walked.
+	writeABlob(ctx, t, store, storage.BlobRef{Namespace: testrand.Bytes(namespaceSize), Key: testrand.Bytes(keySize)}, testrand.BytesInt(10), filestore.FormatV1)
+
+	sort.Slice(keys, func(i, k int) bool {
+		return bytes.Compare(keys[i], keys[k]) < 0
+	})
+
+	walk := func(cursor []byte, limit int) (walked [][]byte) {
+		err := store.WalkNamespaceFrom(ctx, namespace, cursor, func(info storage.BlobInfo) error {
+			walked = append(walked, info.BlobRef().Key)
+			if len(walked) == limit {
+				return io.EOF
+			}
+			return nil
+		})
+		if limit > 0 && len(walked) == limit {
+			require.ErrorIs(t, err, io.EOF)
+		} else {
+			require.NoError(t, err)
+		}
+		return walked
+	}
+
+	require.Equal(t, keys, walk(nil, 0))
+	require.Equal(t, keys[51:], walk(keys[50], 0))
+	require.Empty(t, walk(keys[len(keys)-1], 0))
+
+	// walking in pages returns all keys.
+	var paged [][]byte
+	var cursor []byte
+	for {
+		page := walk(cursor, 7)
+		paged = append(paged, page...)
+		if len(page) < 7 {
+			break
+		}
+		cursor = page[len(page)-1]
+	}
+	require.Equal(t, keys, paged)
+
+	// a namespace without blobs.
+	err = store.WalkNamespaceFrom(ctx, testrand.Bytes(namespaceSize), nil, func(info storage.BlobInfo) error {
+		return errs.New("unexpected blob")
+	})
+	require.NoError(t, err)
+}
+
 func TestEmptyTrash(t *testing.T) {
 	ctx := testcontext.New(t)
 	defer ctx.Cleanup()
@@ -0,0 +1,67 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package auditfailures keeps the recent local audit read errors, i.e. the
+// audits, which the node failed to serve because the piece was missing or
+// couldn't be read or sent, so operators can correlate them with local disk
+// errors.
+//
+// The node doesn't learn the verdicts of the satellites. Pieces, which were
+// sent but failed the verification on the satellite, e.g. because they're
+// corrupted, aren't recorded, and neither is whether the satellite put the
+// node in containment.
+package auditfailures
+
+import (
+	"context"
+	"time"
+
+	"storj.io/common/storj"
+)
+
+// DB tells how application works with audit failures database.
+//
+// architecture: Database
+type DB interface {
+	// Insert stores the failure.
+	Insert(ctx context.Context, failure Failure) error
+	// List returns the failures of the satellite since the given time, the
+	// newest first.
+	List(ctx context.Context, satelliteID storj.NodeID, since time.Time, limit int) ([]Failure, error)
+	// Summarize counts the failures since the given time by satellite and
+	// category.
+	Summarize(ctx context.Context, since time.Time) ([]Summary, error)
+	// DeleteBefore deletes the failures before the given time.
+	DeleteBefore(ctx context.Context, before time.Time) error
+}
+
+// Category is the kind of an audit failure.
+type Category string
+
+const (
+	// CategoryNotFound means that the piece didn't exist.
+	CategoryNotFound Category = "not_found"
+	// CategoryCanceled means that the audit was canceled before the piece was
+	// sent, usually because the node was too slow.
+	CategoryCanceled Category = "canceled"
+	// CategoryOther means that the piece couldn't be read or sent, e.g.
+	// because of a disk error.
+	CategoryOther Category = "other"
+)
+
+// Failure is a local read error of an audited piece.
+type Failure struct {
+	SatelliteID storj.NodeID  `json:"satelliteId"`
+	PieceID     storj.PieceID `json:"pieceId"`
+	Category    Category      `json:"category"`
+	Error       string        `json:"error"`
+	FailedAt    time.Time     `json:"failedAt"`
+}
+
+// Summary is the number of failures of a satellite and category.
+type Summary struct {
+	SatelliteID storj.NodeID `json:"satelliteId"`
+	Category    Category     `json:"category"`
+	Count       int64        `json:"count"`
+	LastFailure time.Time    `json:"lastFailure"`
+}
@@ -0,0 +1,88 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package auditfailures_test
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/storagenode"
+	"storj.io/storj/storagenode/auditfailures"
+	"storj.io/storj/storagenode/storagenodedb/storagenodedbtest"
+)
+
+func TestService(t *testing.T) {
+	storagenodedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db storagenode.DB) {
+		service := auditfailures.NewService(zaptest.NewLogger(t), db.AuditFailures(), auditfailures.Config{
+			MaxAge: 24 * time.Hour,
+			Limit:  2,
+		})
+
+		now := time.Now().UTC()
+
+		satellite0 := testrand.NodeID()
+		satellite1 := testrand.NodeID()
+
+		pieceIDs := []storj.PieceID{testrand.PieceID(), testrand.PieceID(), testrand.PieceID()}
+
+		// an old failure, which is deleted by the next record.
+		service.SetNow(func() time.Time { return now.Add(-48 * time.Hour) })
+		require.NoError(t, service.Record(ctx, satellite0, pieceIDs[0], errs.New("old")))
+
+		service.SetNow(func() time.Time { return now.Add(-2 * time.Minute) })
+		require.NoError(t, service.Record(ctx, satellite0, pieceIDs[0], rpcstatus.Error(rpcstatus.NotFound, "file does not exist")))
+		service.SetNow(func() time.Time { return now.Add(-time.Minute) })
+		require.NoError(t, service.Record(ctx, satellite0, pieceIDs[1], errs.New("%s", strings.Repeat("x", 2000))))
+		service.SetNow(func() time.Time { return now })
+		require.NoError(t, service.Record(ctx, satellite0, pieceIDs[2], context.Canceled))
+		require.NoError(t, service.Record(ctx, satellite1, pieceIDs[0], errs.New("disk error")))
+
+		failures, err := service.List(ctx, satellite0)
+		require.NoError(t, err)
+		require.Len(t, failures, 2)
+		require.Equal(t, pieceIDs[2], failures[0].PieceID)
+		require.Equal(t, auditfailures.CategoryCanceled, failures[0].Category)
+		require.Equal(t, pieceIDs[1], failures[1].PieceID)
+		require.Equal(t, auditfailures.CategoryOther, failures[1].Category)
+		require.Len(t, failures[1].Error, 1024)
+
+		summaries, err := service.Summary(ctx)
+		require.NoError(t, err)
+		require.Len(t, summaries, 4)
+
+		counts := map[auditfailures.Category]int64{}
+		for _, summary := range summaries {
+			if summary.SatelliteID == satellite0 {
+				counts[summary.Category] += summary.Count
+			}
+		}
+		require.Equal(t, map[auditfailures.Category]int64{
+			auditfailures.CategoryNotFound: 1,
+			auditfailures.CategoryOther:    1,
+			auditfailures.CategoryCanceled: 1,
+		}, counts)
+
+		failures, err = service.List(ctx, testrand.NodeID())
+		require.NoError(t, err)
+		require.Empty(t, failures)
+	})
+}
+
+func TestCategorize(t *testing.T) {
+	require.Equal(t, auditfailures.CategoryCanceled, auditfailures.Categorize(context.Canceled))
+	require.Equal(t, auditfailures.CategoryNotFound, auditfailures.Categorize(rpcstatus.Error(rpcstatus.NotFound, "missing")))
+	require.Equal(t, auditfailures.CategoryNotFound, auditfailures.Categorize(errs.Wrap(os.ErrNotExist)))
+	require.Equal(t, auditfailures.CategoryOther, auditfailures.Categorize(errs.New("input/output error")))
+}
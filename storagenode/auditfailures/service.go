@@ -0,0 +1,121 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package auditfailures
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/errs2"
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/common/storj"
+)
+
+var (
+	// Error is the default error class for audit failures.
+	Error = errs.Class("audit failures")
+
+	mon = monkit.Package()
+)
+
+// maxErrorLength is the maximum length of the stored error messages.
+const maxErrorLength = 1024
+
+// Config defines parameters for the audit failures.
+type Config struct {
+	MaxAge time.Duration `help:"how long the local audit read errors are kept" default:"720h0m0s"`
+	Limit  int           `help:"maximum number of local audit read errors listed for a satellite" default:"100"`
+}
+
+// Service records the local audit read errors and reports them to the
+// dashboard.
+//
+// architecture: Service
+type Service struct {
+	log    *zap.Logger
+	db     DB
+	config Config
+
+	nowFn func() time.Time
+}
+
+// NewService creates a new audit failures service.
+func NewService(log *zap.Logger, db DB, config Config) *Service {
+	return &Service{
+		log:    log,
+		db:     db,
+		config: config,
+
+		nowFn: time.Now,
+	}
+}
+
+// SetNow allows tests to have the service act as if the current time is
+// whatever they want.
+func (service *Service) SetNow(nowFn func() time.Time) {
+	service.nowFn = nowFn
+}
+
+// Record stores the error, which the node got serving the audit of the piece,
+// and deletes the failures, which are older than the configured age.
+func (service *Service) Record(ctx context.Context, satelliteID storj.NodeID, pieceID storj.PieceID, failure error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	now := service.nowFn().UTC()
+
+	message := failure.Error()
+	if len(message) > maxErrorLength {
+		message = message[:maxErrorLength]
+	}
+
+	category := Categorize(failure)
+	mon.Counter("audit_failures", monkit.NewSeriesTag("category", string(category))).Inc(1)
+
+	err = service.db.Insert(ctx, Failure{
+		SatelliteID: satelliteID,
+		PieceID:     pieceID,
+		Category:    category,
+		Error:       message,
+		FailedAt:    now,
+	})
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	return Error.Wrap(service.db.DeleteBefore(ctx, now.Add(-service.config.MaxAge)))
+}
+
+// Categorize returns the category of the audit failure.
+func Categorize(failure error) Category {
+	switch {
+	case errs2.IsCanceled(failure):
+		return CategoryCanceled
+	case errs2.IsRPC(failure, rpcstatus.NotFound) || errs.Is(failure, os.ErrNotExist):
+		return CategoryNotFound
+	default:
+		return CategoryOther
+	}
+}
+
+// Summary counts the failures of the configured age by satellite and
+// category.
+func (service *Service) Summary(ctx context.Context) (_ []Summary, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	summaries, err := service.db.Summarize(ctx, service.nowFn().Add(-service.config.MaxAge))
+	return summaries, Error.Wrap(err)
+}
+
+// List returns the recent failures of the satellite, the newest first.
+func (service *Service) List(ctx context.Context, satelliteID storj.NodeID) (_ []Failure, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	failures, err := service.db.List(ctx, satelliteID, service.nowFn().Add(-service.config.MaxAge), service.config.Limit)
+	return failures, Error.Wrap(err)
+}
@@ -0,0 +1,112 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoleapi
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+	"storj.io/storj/storagenode/auditfailures"
+)
+
+// ErrAuditFailuresAPI - console audit failures api error type.
+var ErrAuditFailuresAPI = errs.Class("consoleapi audit failures")
+
+// AuditFailures is an api controller that exposes the recent local audit read
+// errors.
+type AuditFailures struct {
+	service *auditfailures.Service
+
+	log *zap.Logger
+}
+
+// NewAuditFailures is a constructor for audit failures controller.
+func NewAuditFailures(log *zap.Logger, service *auditfailures.Service) *AuditFailures {
+	return &AuditFailures{
+		log:     log,
+		service: service,
+	}
+}
+
+// Summary returns the number of the recent local audit read errors by
+// satellite and category.
+func (controller *AuditFailures) Summary(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set(contentType, applicationJSON)
+
+	summaries, err := controller.service.Summary(ctx)
+	if err != nil {
+		controller.serveJSONError(w, http.StatusInternalServerError, ErrAuditFailuresAPI.Wrap(err))
+		return
+	}
+	if summaries == nil {
+		summaries = []auditfailures.Summary{}
+	}
+
+	if err := json.NewEncoder(w).Encode(summaries); err != nil {
+		controller.log.Error("failed to encode json response", zap.Error(ErrAuditFailuresAPI.Wrap(err)))
+		return
+	}
+}
+
+// Satellite returns the recent local audit read errors of the satellite, the
+// newest first.
+func (controller *AuditFailures) Satellite(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set(contentType, applicationJSON)
+
+	id, ok := mux.Vars(r)["id"]
+	if !ok {
+		controller.serveJSONError(w, http.StatusBadRequest, ErrAuditFailuresAPI.New("satellite id is missing"))
+		return
+	}
+
+	satelliteID, err := storj.NodeIDFromString(id)
+	if err != nil {
+		controller.serveJSONError(w, http.StatusBadRequest, ErrAuditFailuresAPI.Wrap(err))
+		return
+	}
+
+	failures, err := controller.service.List(ctx, satelliteID)
+	if err != nil {
+		controller.serveJSONError(w, http.StatusInternalServerError, ErrAuditFailuresAPI.Wrap(err))
+		return
+	}
+	if failures == nil {
+		failures = []auditfailures.Failure{}
+	}
+
+	if err := json.NewEncoder(w).Encode(failures); err != nil {
+		controller.log.Error("failed to encode json response", zap.Error(ErrAuditFailuresAPI.Wrap(err)))
+		return
+	}
+}
+
+// serveJSONError writes JSON error to response output stream.
+func (controller *AuditFailures) serveJSONError(w http.ResponseWriter, status int, err error) {
+	w.WriteHeader(status)
+
+	var response struct {
+		Error string `json:"error"`
+	}
+
+	response.Error = err.Error()
+
+	err = json.NewEncoder(w).Encode(response)
+	if err != nil {
+		controller.log.Error("failed to write json error response", zap.Error(ErrAuditFailuresAPI.Wrap(err)))
+		return
+	}
+}
@@ -172,6 +172,27 @@ func (payout *Payout) HeldHistory(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// HeldStatus returns the held amount, which isn't disposed yet, and its
+// projected release for all satellites.
+func (payout *Payout) HeldStatus(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set(contentType, applicationJSON)
+
+	heldStatus, err := payout.service.AllHeldStatus(ctx)
+	if err != nil {
+		payout.serveJSONError(w, http.StatusInternalServerError, ErrPayoutAPI.Wrap(err))
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(heldStatus); err != nil {
+		payout.log.Error("failed to encode json response", zap.Error(ErrPayoutAPI.Wrap(err)))
+		return
+	}
+}
+
 // PayoutHistory retrieves paystubs for specific period from all satellites and transaction receipts if exists.
 func (payout *Payout) PayoutHistory(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -0,0 +1,54 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoleapi
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/storj/storagenode/scrubber"
+)
+
+// ErrScrubberAPI - console scrubber api error type.
+var ErrScrubberAPI = errs.Class("consoleapi scrubber")
+
+// Scrubber is an api controller that exposes the counts of the piece scrubber.
+type Scrubber struct {
+	chore *scrubber.Chore
+
+	log *zap.Logger
+}
+
+// NewScrubber is a constructor for scrubber controller.
+func NewScrubber(log *zap.Logger, chore *scrubber.Chore) *Scrubber {
+	return &Scrubber{
+		log:   log,
+		chore: chore,
+	}
+}
+
+// Stats returns the verified, corrupt, skipped and failed pieces of the current or
+// last scrub.
+func (controller *Scrubber) Stats(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set(contentType, applicationJSON)
+
+	var response struct {
+		Enabled bool `json:"enabled"`
+		scrubber.Stats
+	}
+	response.Enabled = controller.chore.Enabled()
+	response.Stats = controller.chore.Stats()
+
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		controller.log.Error("failed to encode json response", zap.Error(ErrScrubberAPI.Wrap(err)))
+		return
+	}
+}
@@ -19,10 +19,12 @@ import (
 
 	"storj.io/common/errs2"
 	"storj.io/storj/private/web"
+	"storj.io/storj/storagenode/auditfailures"
 	"storj.io/storj/storagenode/console"
 	"storj.io/storj/storagenode/console/consoleapi"
 	"storj.io/storj/storagenode/notifications"
 	"storj.io/storj/storagenode/payouts"
+	"storj.io/storj/storagenode/scrubber"
 )
 
 var (
@@ -46,6 +48,8 @@ type Server struct {
 	service       *console.Service
 	notifications *notifications.Service
 	payout        *payouts.Service
+	auditFailures *auditfailures.Service
+	scrubber      *scrubber.Chore
 	listener      net.Listener
 	assets        fs.FS
 
@@ -53,7 +57,7 @@ type Server struct {
 }
 
 // NewServer creates new instance of storagenode console web server.
-func NewServer(logger *zap.Logger, assets fs.FS, notifications *notifications.Service, service *console.Service, payout *payouts.Service, listener net.Listener) *Server {
+func NewServer(logger *zap.Logger, assets fs.FS, notifications *notifications.Service, service *console.Service, payout *payouts.Service, auditFailures *auditfailures.Service, scrubber *scrubber.Chore, listener net.Listener) *Server {
 	server := Server{
 		log:           logger,
 		service:       service,
@@ -61,6 +65,8 @@ func NewServer(logger *zap.Logger, assets fs.FS, notifications *notifications.Se
 		assets:        assets,
 		notifications: notifications,
 		payout:        payout,
+		auditFailures: auditFailures,
+		scrubber:      scrubber,
 	}
 
 	router := mux.NewRouter()
@@ -87,9 +93,19 @@ func NewServer(logger *zap.Logger, assets fs.FS, notifications *notifications.Se
 	payoutRouter.HandleFunc("/paystubs/{period}", payoutController.PayStubMonthly).Methods(http.MethodGet)
 	payoutRouter.HandleFunc("/paystubs/{start}/{end}", payoutController.PayStubPeriod).Methods(http.MethodGet)
 	payoutRouter.HandleFunc("/held-history", payoutController.HeldHistory).Methods(http.MethodGet)
+	payoutRouter.HandleFunc("/held-status", payoutController.HeldStatus).Methods(http.MethodGet)
 	payoutRouter.HandleFunc("/periods", payoutController.HeldAmountPeriods).Methods(http.MethodGet)
 	payoutRouter.HandleFunc("/payout-history/{period}", payoutController.PayoutHistory).Methods(http.MethodGet)
 
+	auditFailuresController := consoleapi.NewAuditFailures(server.log, server.auditFailures)
+	auditFailuresRouter := router.PathPrefix("/api/audit-failures").Subrouter()
+	auditFailuresRouter.StrictSlash(true)
+	auditFailuresRouter.HandleFunc("/", auditFailuresController.Summary).Methods(http.MethodGet)
+	auditFailuresRouter.HandleFunc("/satellite/{id}", auditFailuresController.Satellite).Methods(http.MethodGet)
+
+	scrubberController := consoleapi.NewScrubber(server.log, server.scrubber)
+	router.HandleFunc("/api/scrubber", scrubberController.Stats).Methods(http.MethodGet)
+
 	staticServer := http.FileServer(http.FS(server.assets))
 	router.PathPrefix("/static/").Handler(web.CacheHandler(staticServer))
 	router.PathPrefix("/").HandlerFunc(server.appHandler)
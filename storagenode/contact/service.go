@@ -14,10 +14,17 @@ import (
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
 
+	"storj.io/common/errs2"
 	"storj.io/common/pb"
 	"storj.io/common/rpc"
+	"storj.io/common/rpc/rpcstatus"
 	"storj.io/common/storj"
 	"storj.io/common/sync2"
+	"storj.io/storj/private/contactpb"
+	"storj.io/storj/private/nodecapabilities"
+	"storj.io/storj/private/nodeinventory"
+	"storj.io/storj/private/satellitedecommission"
+	"storj.io/storj/private/satellitesuccessor"
 	"storj.io/storj/storagenode/trust"
 )
 
@@ -47,6 +54,11 @@ type NodeInfo struct {
 	Version  pb.NodeVersion
 	Capacity pb.NodeCapacity
 	Operator pb.NodeOperator
+	// Capabilities are announced with the second version of the check-in
+	// protocol.
+	Capabilities nodecapabilities.Capabilities
+	// Inventory is sent as metadata of the check-in.
+	Inventory nodeinventory.Inventory
 }
 
 // Service is the contact service between storage nodes and satellites.
@@ -128,12 +140,30 @@ func (service *Service) pingSatelliteOnce(ctx context.Context, id storj.NodeID)
 	defer func() { err = errs.Combine(err, conn.Close()) }()
 
 	self := service.Local()
-	resp, err := pb.NewDRPCNodeClient(conn).CheckIn(ctx, &pb.CheckInRequest{
+	req := &pb.CheckInRequest{
 		Address:  self.Address,
 		Version:  &self.Version,
 		Capacity: &self.Capacity,
 		Operator: &self.Operator,
+	}
+
+	ctx = nodeinventory.Attach(ctx, self.Inventory)
+	respV2, err := contactpb.NewDRPCNodeClient(conn).CheckIn(ctx, &contactpb.CheckInRequest{
+		CheckIn:      req,
+		Capabilities: self.Capabilities.Proto(),
 	})
+	var resp *pb.CheckInResponse
+	if errs2.IsRPC(err, rpcstatus.Unimplemented) {
+		// satellites without the second version of the check-in don't know
+		// the capabilities and don't announce anything.
+		respV2 = nil
+		resp, err = pb.NewDRPCNodeClient(conn).CheckIn(ctx, req)
+	} else if err == nil {
+		resp = respV2.CheckIn
+		if resp == nil {
+			err = Error.New("check-in response is missing")
+		}
+	}
 	service.quicStats.SetStatus(false)
 	if err != nil {
 		return errPingSatellite.Wrap(err)
@@ -148,9 +178,49 @@ func (service *Service) pingSatelliteOnce(ctx context.Context, id storj.NodeID)
 	if resp.PingErrorMessage != "" {
 		service.log.Warn("Your node is still considered to be online but encountered an error.", zap.Stringer("Satellite ID", id), zap.String("Error", resp.GetPingErrorMessage()))
 	}
+
+	if respV2 != nil {
+		service.applyAnnouncements(ctx, id, respV2)
+	}
 	return nil
 }
 
+// applyAnnouncements applies the shutdown and the successor, which the
+// satellite announced with the check-in.
+func (service *Service) applyAnnouncements(ctx context.Context, id storj.NodeID, resp *contactpb.CheckInResponse) {
+	// satellites, which shut down, announce when the nodes can stop trusting
+	// them.
+	if resp.Decommission != nil {
+		notice, err := satellitedecommission.FromProto(resp.Decommission)
+		switch {
+		case err != nil:
+			service.log.Warn("ignoring invalid satellite decommission notice", zap.Stringer("Satellite ID", id), zap.Error(err))
+		case notice.Satellite != id:
+			service.log.Warn("ignoring decommission notice of another satellite", zap.Stringer("Satellite ID", id), zap.Stringer("Decommissioned", notice.Satellite))
+		default:
+			if err := service.trust.ApplyDecommission(ctx, notice); err != nil {
+				service.log.Warn("failed to apply satellite decommission notice", zap.Stringer("Satellite ID", id), zap.Error(err))
+			}
+		}
+	}
+
+	// satellites, which rotate their identity or migrate, announce their
+	// successor.
+	if resp.Successor != nil {
+		pointer, err := satellitesuccessor.FromProto(resp.Successor)
+		switch {
+		case err != nil:
+			service.log.Warn("ignoring invalid satellite successor", zap.Stringer("Satellite ID", id), zap.Error(err))
+		case pointer.Predecessor != id:
+			service.log.Warn("ignoring satellite successor of another satellite", zap.Stringer("Satellite ID", id), zap.Stringer("Predecessor", pointer.Predecessor))
+		default:
+			if err := service.trust.FollowSuccessor(ctx, pointer); err != nil {
+				service.log.Warn("failed to follow satellite successor", zap.Stringer("Satellite ID", id), zap.Error(err))
+			}
+		}
+	}
+}
+
 // RequestPingMeQUIC sends pings request to satellite for a pingBack via QUIC.
 func (service *Service) RequestPingMeQUIC(ctx context.Context) (stats *QUICStats, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -0,0 +1,102 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package forgetsatellite
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/sync2"
+	"storj.io/storj/storagenode/trust"
+)
+
+// Config defines parameters for removing the data of decommissioned satellites.
+type Config struct {
+	Interval    time.Duration `help:"how frequently to check for decommissioned satellites, whose data can be removed" default:"24h0m0s"`
+	GracePeriod time.Duration `help:"how long to keep the data of a decommissioned satellite after its shutdown" default:"720h0m0s"`
+}
+
+// Chore removes the data of the satellites, which announced their shutdown,
+// once the grace period after the shutdown passed.
+//
+// architecture: Chore
+type Chore struct {
+	log     *zap.Logger
+	trust   *trust.Pool
+	cleaner *Cleaner
+	config  Config
+	nowFn   func() time.Time
+
+	Loop *sync2.Cycle
+}
+
+// NewChore creates a new chore for removing the data of decommissioned satellites.
+func NewChore(log *zap.Logger, trust *trust.Pool, cleaner *Cleaner, config Config) *Chore {
+	return &Chore{
+		log:     log,
+		trust:   trust,
+		cleaner: cleaner,
+		config:  config,
+		nowFn:   time.Now,
+
+		Loop: sync2.NewCycle(config.Interval),
+	}
+}
+
+// Run runs the chore.
+func (chore *Chore) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return chore.Loop.Run(ctx, func(ctx context.Context) error {
+		if err := chore.CleanUp(ctx); err != nil {
+			chore.log.Error("failed to remove data of decommissioned satellites", zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// CleanUp removes the data of the decommissioned satellites, whose grace
+// period passed.
+func (chore *Chore) CleanUp(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	now := chore.nowFn()
+	for _, decommission := range chore.trust.Decommissions(ctx) {
+		if decommission.CleanedUpAt != nil {
+			continue
+		}
+		if now.Before(decommission.Notice.ShutdownAt.Add(chore.config.GracePeriod)) {
+			continue
+		}
+
+		satelliteID := decommission.Notice.Satellite
+		chore.log.Info("removing data of decommissioned satellite",
+			zap.Stringer("Satellite ID", satelliteID),
+			zap.Time("shutdown at", decommission.Notice.ShutdownAt),
+		)
+		summary, err := chore.cleaner.Forget(ctx, satelliteID)
+		if err != nil {
+			return err
+		}
+		mon.Counter("decommissioned_satellite_pieces_deleted").Inc(summary.Pieces)
+
+		if err := chore.trust.SetCleanedUp(ctx, satelliteID, now); err != nil {
+			return Error.Wrap(err)
+		}
+	}
+	return nil
+}
+
+// SetNow allows tests to have the chore act as if the current time is t.
+func (chore *Chore) SetNow(nowFn func() time.Time) {
+	chore.nowFn = nowFn
+}
+
+// Close stops the chore.
+func (chore *Chore) Close() error {
+	chore.Loop.Close()
+	return nil
+}
@@ -0,0 +1,203 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package forgetsatellite removes the data stored for a satellite, which the
+// node doesn't work with anymore.
+package forgetsatellite
+
+import (
+	"context"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+	"storj.io/storj/storagenode/orders"
+	"storj.io/storj/storagenode/pieces"
+	"storj.io/storj/storagenode/pricing"
+	"storj.io/storj/storagenode/reputation"
+	"storj.io/storj/storagenode/satellites"
+	"storj.io/storj/storagenode/storageusage"
+)
+
+var (
+	// Error is the default error class for forgetting a satellite.
+	Error = errs.Class("forget satellite")
+	// ErrTrusted is returned when the satellite is still trusted, and the
+	// node didn't exit it gracefully.
+	ErrTrusted = errs.Class("satellite is trusted")
+
+	mon = monkit.Package()
+)
+
+// progressInterval is the number of deleted pieces, after which the progress
+// is logged.
+const progressInterval = 10000
+
+// DB contains the databases, which keep records for the satellites.
+type DB struct {
+	Satellites   satellites.DB
+	Reputation   reputation.DB
+	Pricing      pricing.DB
+	StorageUsage storageusage.DB
+	SpaceUsed    pieces.PieceSpaceUsedDB
+}
+
+// TrustedSatellites returns the satellites, which the node trusts.
+type TrustedSatellites interface {
+	GetSatellites(ctx context.Context) []storj.NodeID
+}
+
+// Summary contains the amount of data stored for a satellite.
+type Summary struct {
+	Pieces      int64
+	ContentSize int64
+	OrderFiles  int
+}
+
+// Cleaner removes the pieces, the orders and the local database records of
+// a satellite.
+//
+// The bandwidth usage and the payout history are kept, because they are the
+// earnings history of the node.
+type Cleaner struct {
+	log     *zap.Logger
+	store   *pieces.Store
+	orders  *orders.FileStore
+	trusted TrustedSatellites
+	db      DB
+}
+
+// NewCleaner creates a new cleaner.
+func NewCleaner(log *zap.Logger, store *pieces.Store, orders *orders.FileStore, trusted TrustedSatellites, db DB) *Cleaner {
+	return &Cleaner{
+		log:     log,
+		store:   store,
+		orders:  orders,
+		trusted: trusted,
+		db:      db,
+	}
+}
+
+// CheckForgettable returns ErrTrusted, when the satellite is trusted and the
+// node didn't exit it gracefully. A trusted satellite would keep storing
+// pieces on the node, and auditing the forgotten ones would disqualify it.
+// Satellites, which announced their shutdown, aren't trusted anymore.
+func (cleaner *Cleaner) CheckForgettable(ctx context.Context, satelliteID storj.NodeID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	satellite, err := cleaner.db.Satellites.GetSatellite(ctx, satelliteID)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if satellite.Status == satellites.ExitSucceeded {
+		return nil
+	}
+
+	for _, trustedID := range cleaner.trusted.GetSatellites(ctx) {
+		if trustedID == satelliteID {
+			return ErrTrusted.New("%s; remove it from the trusted satellites first", satelliteID)
+		}
+	}
+	return nil
+}
+
+// Estimate returns the number and the size of the pieces, which are stored
+// for the satellite, without deleting anything.
+func (cleaner *Cleaner) Estimate(ctx context.Context, satelliteID storj.NodeID) (summary Summary, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = cleaner.store.WalkSatellitePieces(ctx, satelliteID, func(piece pieces.StoredPieceAccess) error {
+		_, contentSize, err := piece.Size(ctx)
+		if err != nil {
+			return err
+		}
+		summary.Pieces++
+		summary.ContentSize += contentSize
+		return nil
+	})
+	return summary, Error.Wrap(err)
+}
+
+// Forget deletes everything stored for the satellite. It fails with
+// ErrTrusted, unless the satellite may be forgotten, see CheckForgettable.
+// The node must not be running, while the satellite is forgotten.
+func (cleaner *Cleaner) Forget(ctx context.Context, satelliteID storj.NodeID) (summary Summary, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := cleaner.CheckForgettable(ctx, satelliteID); err != nil {
+		return summary, err
+	}
+
+	log := cleaner.log.With(zap.Stringer("Satellite ID", satelliteID))
+
+	err = cleaner.store.WalkSatellitePieces(ctx, satelliteID, func(piece pieces.StoredPieceAccess) error {
+		_, contentSize, err := piece.Size(ctx)
+		if err != nil {
+			log.Warn("failed to get piece size", zap.Stringer("Piece ID", piece.PieceID()), zap.Error(err))
+		}
+		if err := cleaner.store.Delete(ctx, satelliteID, piece.PieceID()); err != nil {
+			return err
+		}
+
+		summary.Pieces++
+		summary.ContentSize += contentSize
+		if summary.Pieces%progressInterval == 0 {
+			log.Info("deleting pieces", zap.Int64("Pieces", summary.Pieces), zap.Int64("Bytes", summary.ContentSize))
+		}
+		return nil
+	})
+	if err != nil {
+		return summary, Error.Wrap(err)
+	}
+	log.Info("pieces deleted", zap.Int64("Pieces", summary.Pieces), zap.Int64("Bytes", summary.ContentSize))
+
+	// a zero trashedBefore would keep everything, so use a time in the future.
+	if err := cleaner.store.EmptyTrash(ctx, satelliteID, time.Now().Add(time.Hour)); err != nil {
+		return summary, Error.Wrap(err)
+	}
+	if err := cleaner.store.DeleteSatelliteBlobs(ctx, satelliteID); err != nil {
+		return summary, Error.Wrap(err)
+	}
+
+	summary.OrderFiles, err = cleaner.orders.DeleteSatellite(satelliteID)
+	if err != nil {
+		return summary, Error.Wrap(err)
+	}
+	log.Info("orders deleted", zap.Int("Files", summary.OrderFiles))
+
+	if err := cleaner.deleteRecords(ctx, satelliteID); err != nil {
+		return summary, Error.Wrap(err)
+	}
+	log.Info("database records deleted")
+
+	return summary, nil
+}
+
+// deleteRecords removes the local database records of the satellite.
+func (cleaner *Cleaner) deleteRecords(ctx context.Context, satelliteID storj.NodeID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := cleaner.db.Reputation.Delete(ctx, satelliteID); err != nil {
+		return err
+	}
+	if err := cleaner.db.Pricing.Delete(ctx, satelliteID); err != nil {
+		return err
+	}
+	if err := cleaner.db.StorageUsage.DeleteSatellite(ctx, satelliteID); err != nil {
+		return err
+	}
+
+	// zero totals remove the satellite from the space used cache. the
+	// aggregate totals are recalculated by the piece scan on the next start.
+	err = cleaner.db.SpaceUsed.UpdatePieceTotalsForAllSatellites(ctx, map[storj.NodeID]pieces.SatelliteUsage{
+		satelliteID: {},
+	})
+	if err != nil {
+		return err
+	}
+
+	return cleaner.db.Satellites.DeleteSatellite(ctx, satelliteID)
+}
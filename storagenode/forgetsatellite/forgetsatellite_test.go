@@ -0,0 +1,141 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package forgetsatellite_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/pb"
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/storagenode"
+	"storj.io/storj/storagenode/forgetsatellite"
+	"storj.io/storj/storagenode/orders"
+	"storj.io/storj/storagenode/orders/ordersfile"
+	"storj.io/storj/storagenode/pieces"
+	"storj.io/storj/storagenode/pricing"
+	"storj.io/storj/storagenode/reputation"
+	"storj.io/storj/storagenode/satellites"
+	"storj.io/storj/storagenode/storagenodedb/storagenodedbtest"
+	"storj.io/storj/storagenode/storageusage"
+)
+
+func TestForget(t *testing.T) {
+	storagenodedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db storagenode.DB) {
+		log := zaptest.NewLogger(t)
+		now := time.Now()
+
+		store := pieces.NewStore(log, db.Pieces(), db.V0PieceInfo(), db.PieceExpirationDB(), db.PieceSpaceUsedDB(), pieces.DefaultConfig)
+		ordersStore, err := orders.NewFileStore(log, ctx.Dir("orders"), time.Hour)
+		require.NoError(t, err)
+
+		countPieces := func(satelliteID storj.NodeID) (count int) {
+			require.NoError(t, store.WalkSatellitePieces(ctx, satelliteID, func(pieces.StoredPieceAccess) error {
+				count++
+				return nil
+			}))
+			return count
+		}
+
+		forgotten, kept := testrand.NodeID(), testrand.NodeID()
+		for _, satelliteID := range []storj.NodeID{forgotten, kept} {
+			for i := 0; i < 3; i++ {
+				writer, err := store.Writer(ctx, satelliteID, testrand.PieceID(), pb.PieceHashAlgorithm_SHA256)
+				require.NoError(t, err)
+				_, err = writer.Write(testrand.Bytes(100))
+				require.NoError(t, err)
+				require.NoError(t, writer.Commit(ctx, &pb.PieceHeader{}))
+			}
+
+			serialNumber := testrand.SerialNumber()
+			require.NoError(t, ordersStore.Enqueue(&ordersfile.Info{
+				Limit: &pb.OrderLimit{
+					SerialNumber:    serialNumber,
+					SatelliteId:     satelliteID,
+					Action:          pb.PieceAction_GET,
+					OrderCreation:   now,
+					OrderExpiration: now.Add(time.Hour),
+				},
+				Order: &pb.Order{
+					SerialNumber: serialNumber,
+					Amount:       10,
+				},
+			}))
+
+			require.NoError(t, db.Satellites().SetAddress(ctx, satelliteID, "127.0.0.1:0"))
+			require.NoError(t, db.Reputation().Store(ctx, reputation.Stats{SatelliteID: satelliteID}))
+			require.NoError(t, db.Pricing().Store(ctx, pricing.Pricing{SatelliteID: satelliteID}))
+			require.NoError(t, db.StorageUsage().Store(ctx, []storageusage.Stamp{{
+				SatelliteID:     satelliteID,
+				AtRestTotal:     100,
+				IntervalStart:   now.Add(-time.Hour),
+				IntervalEndTime: now,
+			}}))
+		}
+
+		trusted := trustedSatellites{forgotten, kept}
+		cleaner := forgetsatellite.NewCleaner(log, store, ordersStore, &trusted, forgetsatellite.DB{
+			Satellites:   db.Satellites(),
+			Reputation:   db.Reputation(),
+			Pricing:      db.Pricing(),
+			StorageUsage: db.StorageUsage(),
+			SpaceUsed:    db.PieceSpaceUsedDB(),
+		})
+
+		estimate, err := cleaner.Estimate(ctx, forgotten)
+		require.NoError(t, err)
+		require.Equal(t, forgetsatellite.Summary{Pieces: 3, ContentSize: 300}, estimate)
+
+		// a trusted satellite can't be forgotten.
+		_, err = cleaner.Forget(ctx, forgotten)
+		require.True(t, forgetsatellite.ErrTrusted.Has(err))
+		require.Equal(t, 3, countPieces(forgotten))
+
+		// unless the node exited it.
+		exited := testrand.NodeID()
+		require.NoError(t, db.Satellites().InitiateGracefulExit(ctx, exited, now, 0))
+		require.NoError(t, db.Satellites().CompleteGracefulExit(ctx, exited, now, satellites.ExitSucceeded, nil))
+		require.NoError(t, cleaner.CheckForgettable(ctx, exited))
+		require.NoError(t, db.Satellites().DeleteSatellite(ctx, exited))
+
+		trusted = trustedSatellites{kept}
+		summary, err := cleaner.Forget(ctx, forgotten)
+		require.NoError(t, err)
+		require.Equal(t, forgetsatellite.Summary{Pieces: 3, ContentSize: 300, OrderFiles: 1}, summary)
+
+		require.Zero(t, countPieces(forgotten))
+		require.Equal(t, 3, countPieces(kept))
+
+		urls, err := db.Satellites().GetSatellitesUrls(ctx)
+		require.NoError(t, err)
+		require.Len(t, urls, 1)
+		require.Equal(t, kept, urls[0].ID)
+
+		stats, err := db.Reputation().All(ctx)
+		require.NoError(t, err)
+		require.Len(t, stats, 1)
+		require.Equal(t, kept, stats[0].SatelliteID)
+
+		stamps, err := db.StorageUsage().GetDaily(ctx, forgotten, now.Add(-24*time.Hour), now.Add(time.Hour))
+		require.NoError(t, err)
+		require.Empty(t, stamps)
+
+		// the orders of the kept satellite are still there.
+		removed, err := ordersStore.DeleteSatellite(kept)
+		require.NoError(t, err)
+		require.Equal(t, 1, removed)
+	})
+}
+
+type trustedSatellites []storj.NodeID
+
+func (trusted *trustedSatellites) GetSatellites(ctx context.Context) []storj.NodeID {
+	return *trusted
+}
@@ -13,6 +13,7 @@ import (
 	"storj.io/common/pb"
 	"storj.io/common/rpc"
 	"storj.io/common/rpc/rpcstatus"
+	"storj.io/storj/private/gracefulexitpb"
 	"storj.io/storj/storagenode/internalpb"
 	"storj.io/storj/storagenode/pieces"
 	"storj.io/storj/storagenode/satellites"
@@ -188,3 +189,44 @@ func (e *Endpoint) GracefulExitFeasibility(ctx context.Context, request *interna
 	response := (internalpb.GracefulExitFeasibilityResponse)(*feasibility)
 	return &response, nil
 }
+
+// AbortGracefulExit aborts the graceful exit from a satellite, which is in progress.
+func (e *Endpoint) AbortGracefulExit(ctx context.Context, req *internalpb.AbortGracefulExitRequest) (_ *internalpb.AbortGracefulExitResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	e.log.Debug("abort graceful exit: start", zap.Stringer("Satellite ID", req.NodeId))
+
+	nodeurl, err := e.trust.GetNodeURL(ctx, req.NodeId)
+	if err != nil {
+		return nil, rpcstatus.Error(rpcstatus.NotFound, err.Error())
+	}
+
+	conn, err := e.dialer.DialNodeURL(ctx, nodeurl)
+	if err != nil {
+		return nil, rpcstatus.Error(rpcstatus.Unavailable, err.Error())
+	}
+	defer func() {
+		err = errs.Combine(err, conn.Close())
+	}()
+
+	client := gracefulexitpb.NewDRPCGracefulExitAbortClient(conn)
+
+	abort, err := client.Abort(ctx, &gracefulexitpb.AbortRequest{
+		Reason: req.Reason,
+	})
+	if err != nil {
+		e.log.Debug("abort graceful exit: satellite rejected the abort", zap.Stringer("Satellite ID", req.NodeId), zap.Error(err))
+		return nil, err
+	}
+
+	// the satellite has reset the exit, so the local exit state is dropped,
+	// which stops the graceful exit worker for the satellite.
+	if err := e.satellites.CancelGracefulExit(ctx, req.NodeId); err != nil {
+		e.log.Debug("abort graceful exit: delete info from satellites table", zap.Stringer("Satellite ID", req.NodeId), zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+
+	return &internalpb.AbortGracefulExitResponse{
+		CooldownUntil: abort.CooldownUntil,
+	}, nil
+}
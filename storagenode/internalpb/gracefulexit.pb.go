@@ -386,6 +386,83 @@ func (m *GracefulExitFeasibilityResponse) GetIsAllowed() bool {
 	return false
 }
 
+type AbortGracefulExitRequest struct {
+	NodeId               NodeID   `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3,customtype=NodeID" json:"node_id"`
+	Reason               string   `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AbortGracefulExitRequest) Reset()         { *m = AbortGracefulExitRequest{} }
+func (m *AbortGracefulExitRequest) String() string { return proto.CompactTextString(m) }
+func (*AbortGracefulExitRequest) ProtoMessage()    {}
+func (*AbortGracefulExitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f0acbf2ce5fa631, []int{9}
+}
+func (m *AbortGracefulExitRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AbortGracefulExitRequest.Unmarshal(m, b)
+}
+func (m *AbortGracefulExitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AbortGracefulExitRequest.Marshal(b, m, deterministic)
+}
+func (m *AbortGracefulExitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AbortGracefulExitRequest.Merge(m, src)
+}
+func (m *AbortGracefulExitRequest) XXX_Size() int {
+	return xxx_messageInfo_AbortGracefulExitRequest.Size(m)
+}
+func (m *AbortGracefulExitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AbortGracefulExitRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AbortGracefulExitRequest proto.InternalMessageInfo
+
+func (m *AbortGracefulExitRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+type AbortGracefulExitResponse struct {
+	CooldownUntil        time.Time `protobuf:"bytes,1,opt,name=cooldown_until,json=cooldownUntil,proto3,stdtime" json:"cooldown_until"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *AbortGracefulExitResponse) Reset()         { *m = AbortGracefulExitResponse{} }
+func (m *AbortGracefulExitResponse) String() string { return proto.CompactTextString(m) }
+func (*AbortGracefulExitResponse) ProtoMessage()    {}
+func (*AbortGracefulExitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f0acbf2ce5fa631, []int{10}
+}
+func (m *AbortGracefulExitResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AbortGracefulExitResponse.Unmarshal(m, b)
+}
+func (m *AbortGracefulExitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AbortGracefulExitResponse.Marshal(b, m, deterministic)
+}
+func (m *AbortGracefulExitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AbortGracefulExitResponse.Merge(m, src)
+}
+func (m *AbortGracefulExitResponse) XXX_Size() int {
+	return xxx_messageInfo_AbortGracefulExitResponse.Size(m)
+}
+func (m *AbortGracefulExitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AbortGracefulExitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AbortGracefulExitResponse proto.InternalMessageInfo
+
+func (m *AbortGracefulExitResponse) GetCooldownUntil() time.Time {
+	if m != nil {
+		return m.CooldownUntil
+	}
+	return time.Time{}
+}
+
 func init() {
 	proto.RegisterType((*GetNonExitingSatellitesRequest)(nil), "storagenode.gracefulexit.GetNonExitingSatellitesRequest")
 	proto.RegisterType((*GetNonExitingSatellitesResponse)(nil), "storagenode.gracefulexit.GetNonExitingSatellitesResponse")
@@ -396,49 +473,55 @@ func init() {
 	proto.RegisterType((*ExitProgress)(nil), "storagenode.gracefulexit.ExitProgress")
 	proto.RegisterType((*GracefulExitFeasibilityRequest)(nil), "storagenode.gracefulexit.GracefulExitFeasibilityRequest")
 	proto.RegisterType((*GracefulExitFeasibilityResponse)(nil), "storagenode.gracefulexit.GracefulExitFeasibilityResponse")
+	proto.RegisterType((*AbortGracefulExitRequest)(nil), "storagenode.gracefulexit.AbortGracefulExitRequest")
+	proto.RegisterType((*AbortGracefulExitResponse)(nil), "storagenode.gracefulexit.AbortGracefulExitResponse")
 }
 
 func init() { proto.RegisterFile("gracefulexit.proto", fileDescriptor_8f0acbf2ce5fa631) }
 
 var fileDescriptor_8f0acbf2ce5fa631 = []byte{
-	// 612 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x53, 0x4f, 0x6f, 0xd3, 0x4e,
-	0x10, 0xfd, 0xb9, 0xff, 0x7e, 0xc9, 0xb4, 0x6a, 0xcb, 0x82, 0xc0, 0x0a, 0xa2, 0x8e, 0x2c, 0x41,
-	0xc3, 0xa1, 0x0e, 0x14, 0x21, 0xc1, 0xb1, 0x01, 0x5a, 0xe5, 0x40, 0x85, 0x16, 0xb8, 0x20, 0x21,
-	0x6b, 0x63, 0x4f, 0xcd, 0x56, 0xf6, 0xae, 0xeb, 0x5d, 0x43, 0xb9, 0xf0, 0x11, 0x10, 0xdf, 0x81,
-	0x2b, 0x1f, 0x84, 0x33, 0x47, 0x0e, 0xe5, 0xab, 0x20, 0xdb, 0xdb, 0x60, 0xda, 0xd8, 0x6a, 0xb9,
-	0x25, 0x6f, 0x66, 0x9e, 0x67, 0xde, 0xbe, 0x07, 0x24, 0xca, 0x58, 0x80, 0x07, 0x79, 0x8c, 0xc7,
-	0x5c, 0x7b, 0x69, 0x26, 0xb5, 0x24, 0xb6, 0xd2, 0x32, 0x63, 0x11, 0x0a, 0x19, 0xa2, 0x57, 0xaf,
-	0xf7, 0x20, 0x92, 0x91, 0xac, 0xba, 0x7a, 0x4e, 0x24, 0x65, 0x14, 0xe3, 0xb0, 0xfc, 0x37, 0xc9,
-	0x0f, 0x86, 0x9a, 0x27, 0xa8, 0x34, 0x4b, 0xd2, 0xaa, 0xc1, 0xed, 0xc3, 0xc6, 0x1e, 0xea, 0x7d,
-	0x29, 0x9e, 0x1d, 0x73, 0xcd, 0x45, 0xf4, 0x92, 0x69, 0x8c, 0x63, 0xae, 0x51, 0x51, 0x3c, 0xca,
-	0x51, 0x69, 0x37, 0x05, 0xa7, 0xb1, 0x43, 0xa5, 0x52, 0x28, 0x24, 0xcf, 0x01, 0xd4, 0x14, 0xb5,
-	0xad, 0xfe, 0xfc, 0x60, 0x79, 0x7b, 0xcb, 0x6b, 0x5a, 0xd0, 0x9b, 0xc1, 0x45, 0x6b, 0x04, 0xee,
-	0x27, 0xb8, 0x3a, 0xa3, 0x85, 0x6c, 0xc2, 0xff, 0x05, 0x97, 0xcf, 0x43, 0xdb, 0xea, 0x5b, 0x83,
-	0x95, 0xd1, 0xea, 0xf7, 0x13, 0xe7, 0xbf, 0x9f, 0x27, 0xce, 0xd2, 0xbe, 0x0c, 0x71, 0xfc, 0x94,
-	0x2e, 0x15, 0xe5, 0x71, 0x48, 0x1c, 0x58, 0x0e, 0x65, 0xc2, 0xb8, 0xf0, 0x05, 0x4b, 0xd0, 0x9e,
-	0xeb, 0x5b, 0x83, 0x2e, 0x85, 0x0a, 0xda, 0x67, 0x09, 0x92, 0x5b, 0x00, 0x2a, 0x65, 0x01, 0xfa,
-	0xb9, 0xc2, 0xd0, 0x9e, 0xef, 0x5b, 0x03, 0x8b, 0x76, 0x4b, 0xe4, 0xb5, 0xc2, 0xd0, 0xdd, 0x85,
-	0x9b, 0x63, 0xc1, 0x35, 0x67, 0x1a, 0xf7, 0xcc, 0xde, 0xc5, 0x32, 0x46, 0x90, 0x0b, 0xef, 0xe1,
-	0xda, 0x70, 0x7d, 0x0f, 0x75, 0x31, 0xfa, 0x22, 0x93, 0x51, 0x86, 0x6a, 0xaa, 0xe9, 0x5b, 0xb8,
-	0x71, 0xae, 0x62, 0xb4, 0x1c, 0x41, 0x27, 0x35, 0x98, 0x51, 0xf2, 0x4e, 0xb3, 0x92, 0x7f, 0x31,
-	0x4c, 0xe7, 0xdc, 0x1f, 0x16, 0xac, 0xd4, 0x4b, 0x67, 0x15, 0xb1, 0xce, 0x29, 0x52, 0xbb, 0x69,
-	0xae, 0x55, 0xdb, 0xbb, 0xb0, 0x9e, 0x62, 0x16, 0xa0, 0xd0, 0x7e, 0x20, 0x93, 0x34, 0x46, 0x8d,
-	0xa5, 0x80, 0x73, 0x74, 0xcd, 0xe0, 0x4f, 0x0c, 0x4c, 0x36, 0x00, 0x54, 0x1e, 0x04, 0xa8, 0xd4,
-	0x41, 0x1e, 0xdb, 0x0b, 0x7d, 0x6b, 0xd0, 0xa1, 0x35, 0x84, 0x6c, 0x01, 0x31, 0x14, 0x5c, 0x0a,
-	0x3f, 0xc3, 0x00, 0x79, 0xaa, 0xed, 0xc5, 0xe2, 0xf3, 0xf4, 0xca, 0x9f, 0x0a, 0xad, 0x0a, 0xee,
-	0x18, 0x36, 0xea, 0xaf, 0xb1, 0x8b, 0x4c, 0xf1, 0x09, 0x8f, 0xb9, 0xfe, 0x78, 0xe9, 0x87, 0xf9,
-	0x66, 0x81, 0xd3, 0xc8, 0x65, 0xde, 0x61, 0x07, 0xba, 0x87, 0x92, 0x0b, 0x0c, 0x7d, 0xa6, 0x4b,
-	0xba, 0xe5, 0xed, 0x9e, 0x57, 0xa5, 0xc9, 0x3b, 0x4d, 0x93, 0xf7, 0xea, 0x34, 0x4d, 0xa3, 0x4e,
-	0xf1, 0xa9, 0x2f, 0xbf, 0x1c, 0x8b, 0x76, 0xaa, 0xb1, 0x9d, 0x62, 0x9f, 0xb5, 0x44, 0x0a, 0xfd,
-	0x4e, 0xf9, 0x19, 0x1e, 0xe5, 0x3c, 0xc3, 0x4a, 0xdc, 0x45, 0xba, 0x5a, 0xc1, 0xd4, 0xa0, 0x85,
-	0x1f, 0xb9, 0xf2, 0x59, 0x1c, 0xcb, 0x0f, 0xc6, 0x8f, 0x1d, 0xda, 0xe5, 0x6a, 0xa7, 0x02, 0xb6,
-	0xbf, 0x2e, 0xc0, 0x7a, 0x71, 0x41, 0x7d, 0x65, 0xf2, 0xd9, 0x2a, 0x3d, 0x34, 0x2b, 0x97, 0xe4,
-	0x51, 0xb3, 0x63, 0xda, 0xc3, 0xde, 0x7b, 0xfc, 0x0f, 0x93, 0x46, 0xb0, 0x1c, 0xae, 0xcd, 0x4a,
-	0x0d, 0x79, 0xd8, 0x4c, 0xd9, 0x92, 0xb2, 0xde, 0x05, 0x5d, 0x4f, 0xde, 0xc3, 0xda, 0x99, 0x28,
-	0x91, 0x7b, 0xad, 0x47, 0xcc, 0xc8, 0x63, 0xef, 0xfe, 0x25, 0x26, 0xcc, 0xb9, 0xa5, 0xfe, 0xb3,
-	0x3d, 0xd4, 0xaa, 0x7f, 0xab, 0x85, 0x5b, 0xf5, 0x6f, 0x37, 0xec, 0x68, 0xf3, 0xcd, 0xed, 0x62,
-	0xf6, 0xd0, 0xe3, 0x72, 0x58, 0xfe, 0x18, 0xd6, 0xa8, 0x86, 0x5c, 0x68, 0xcc, 0x04, 0x8b, 0xd3,
-	0xc9, 0x64, 0xa9, 0xb4, 0xef, 0x83, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x0e, 0x75, 0x55, 0x23,
-	0x56, 0x06, 0x00, 0x00,
+	// 680 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0x5d, 0x4f, 0xd4, 0x40,
+	0x14, 0xb5, 0x20, 0xeb, 0xee, 0x05, 0xf9, 0x18, 0x0d, 0xd6, 0x1a, 0xd9, 0x4d, 0x13, 0x65, 0x7d,
+	0xa0, 0xab, 0x4b, 0x4c, 0xe4, 0x71, 0x57, 0x85, 0x6c, 0x8c, 0xc4, 0x54, 0x79, 0xd1, 0x98, 0xa6,
+	0xdb, 0x5e, 0xca, 0x90, 0xee, 0x4c, 0xe9, 0x4c, 0x05, 0x13, 0xe3, 0x4f, 0x30, 0xfe, 0x05, 0xdf,
+	0xfd, 0x21, 0x3e, 0xfb, 0xe8, 0x03, 0xfe, 0x15, 0xd3, 0x76, 0xc0, 0x0a, 0xed, 0x86, 0xc5, 0xb7,
+	0xf6, 0xdc, 0x39, 0x67, 0xee, 0x9c, 0xb9, 0x67, 0x80, 0x04, 0xb1, 0xeb, 0xe1, 0x6e, 0x12, 0xe2,
+	0x11, 0x95, 0x56, 0x14, 0x73, 0xc9, 0x89, 0x2e, 0x24, 0x8f, 0xdd, 0x00, 0x19, 0xf7, 0xd1, 0x2a,
+	0xd6, 0x0d, 0x08, 0x78, 0xc0, 0xf3, 0x55, 0x46, 0x33, 0xe0, 0x3c, 0x08, 0xb1, 0x93, 0xfd, 0x0d,
+	0x93, 0xdd, 0x8e, 0xa4, 0x23, 0x14, 0xd2, 0x1d, 0x45, 0xf9, 0x02, 0xb3, 0x05, 0x2b, 0x5b, 0x28,
+	0xb7, 0x39, 0x7b, 0x7e, 0x44, 0x25, 0x65, 0xc1, 0x6b, 0x57, 0x62, 0x18, 0x52, 0x89, 0xc2, 0xc6,
+	0x83, 0x04, 0x85, 0x34, 0x23, 0x68, 0x56, 0xae, 0x10, 0x11, 0x67, 0x02, 0xc9, 0x4b, 0x00, 0x71,
+	0x8a, 0xea, 0x5a, 0x6b, 0xba, 0x3d, 0xdb, 0x5d, 0xb3, 0xaa, 0x1a, 0xb4, 0x4a, 0xb4, 0xec, 0x82,
+	0x80, 0xf9, 0x19, 0x6e, 0x94, 0x2c, 0x21, 0xab, 0x70, 0x2d, 0xd5, 0x72, 0xa8, 0xaf, 0x6b, 0x2d,
+	0xad, 0x3d, 0xd7, 0x9f, 0xff, 0x71, 0xdc, 0xbc, 0xf2, 0xeb, 0xb8, 0x59, 0xdb, 0xe6, 0x3e, 0x0e,
+	0x9e, 0xd9, 0xb5, 0xb4, 0x3c, 0xf0, 0x49, 0x13, 0x66, 0x7d, 0x3e, 0x72, 0x29, 0x73, 0x98, 0x3b,
+	0x42, 0x7d, 0xaa, 0xa5, 0xb5, 0x1b, 0x36, 0xe4, 0xd0, 0xb6, 0x3b, 0x42, 0x72, 0x17, 0x40, 0x44,
+	0xae, 0x87, 0x4e, 0x22, 0xd0, 0xd7, 0xa7, 0x5b, 0x5a, 0x5b, 0xb3, 0x1b, 0x19, 0xb2, 0x23, 0xd0,
+	0x37, 0x37, 0xe1, 0xce, 0x80, 0x51, 0x49, 0x5d, 0x89, 0x5b, 0xaa, 0xef, 0xb4, 0x19, 0x65, 0xc8,
+	0x85, 0xfb, 0x30, 0x75, 0x58, 0xde, 0x42, 0x99, 0x52, 0x5f, 0xc5, 0x3c, 0x88, 0x51, 0x9c, 0x7a,
+	0xfa, 0x1e, 0x6e, 0x9d, 0xab, 0x28, 0x2f, 0xfb, 0x50, 0x8f, 0x14, 0xa6, 0x9c, 0xbc, 0x5f, 0xed,
+	0xe4, 0x3f, 0x0a, 0xa7, 0x3c, 0xf3, 0xa7, 0x06, 0x73, 0xc5, 0xd2, 0x59, 0x47, 0xb4, 0x73, 0x8e,
+	0x14, 0xce, 0x34, 0x35, 0xd6, 0xdb, 0x07, 0xb0, 0x18, 0x61, 0xec, 0x21, 0x93, 0x8e, 0xc7, 0x47,
+	0x51, 0x88, 0x12, 0x33, 0x03, 0xa7, 0xec, 0x05, 0x85, 0x3f, 0x55, 0x30, 0x59, 0x01, 0x10, 0x89,
+	0xe7, 0xa1, 0x10, 0xbb, 0x49, 0xa8, 0x5f, 0x6d, 0x69, 0xed, 0xba, 0x5d, 0x40, 0xc8, 0x1a, 0x10,
+	0x25, 0x41, 0x39, 0x73, 0x62, 0xf4, 0x90, 0x46, 0x52, 0x9f, 0x49, 0xb7, 0xb7, 0x97, 0xfe, 0x56,
+	0xec, 0xbc, 0x60, 0x0e, 0x60, 0xa5, 0x78, 0x1b, 0x9b, 0xe8, 0x0a, 0x3a, 0xa4, 0x21, 0x95, 0x1f,
+	0x27, 0xbe, 0x98, 0xef, 0x1a, 0x34, 0x2b, 0xb5, 0xd4, 0x3d, 0xf4, 0xa0, 0xb1, 0xcf, 0x29, 0x43,
+	0xdf, 0x71, 0x65, 0x26, 0x37, 0xdb, 0x35, 0xac, 0x3c, 0x4d, 0xd6, 0x49, 0x9a, 0xac, 0x37, 0x27,
+	0x69, 0xea, 0xd7, 0xd3, 0xad, 0xbe, 0xfe, 0x6e, 0x6a, 0x76, 0x3d, 0xa7, 0xf5, 0xd2, 0x7e, 0x16,
+	0x46, 0x9c, 0xc9, 0x3d, 0xe1, 0xc4, 0x78, 0x90, 0xd0, 0x18, 0x73, 0x73, 0x67, 0xec, 0xf9, 0x1c,
+	0xb6, 0x15, 0x9a, 0xce, 0x23, 0x15, 0x8e, 0x1b, 0x86, 0xfc, 0x50, 0xcd, 0x63, 0xdd, 0x6e, 0x50,
+	0xd1, 0xcb, 0x01, 0xf3, 0x1d, 0xe8, 0xbd, 0x21, 0x8f, 0xe5, 0xff, 0x0c, 0x23, 0x59, 0x86, 0x5a,
+	0x8c, 0xae, 0xe0, 0x4c, 0xe5, 0x41, 0xfd, 0x99, 0x7b, 0x70, 0xbb, 0x44, 0x5c, 0x99, 0xf0, 0x02,
+	0xe6, 0x3d, 0xce, 0x43, 0x9f, 0x1f, 0x32, 0x27, 0x61, 0x92, 0x86, 0x13, 0x39, 0x71, 0xfd, 0x84,
+	0xbb, 0x93, 0x52, 0xbb, 0xdf, 0x66, 0x60, 0x31, 0x6d, 0xaa, 0xb8, 0x13, 0xf9, 0xa2, 0x65, 0x51,
+	0x28, 0x7b, 0x5e, 0xc8, 0x93, 0xea, 0xc1, 0x1f, 0xff, 0x66, 0x19, 0x1b, 0x97, 0x60, 0xaa, 0x23,
+	0x27, 0x70, 0xb3, 0x2c, 0xfc, 0xe4, 0x71, 0xb5, 0xe4, 0x98, 0xc7, 0xc2, 0xb8, 0x60, 0x78, 0xc9,
+	0x07, 0x58, 0x38, 0xf3, 0x22, 0x90, 0x87, 0x63, 0x0f, 0x51, 0xf2, 0xac, 0x18, 0x8f, 0x26, 0x60,
+	0xa8, 0xe3, 0x66, 0xfe, 0x97, 0x47, 0x61, 0xac, 0xff, 0x63, 0x93, 0x68, 0x6c, 0x5c, 0x82, 0xa9,
+	0x1a, 0xfa, 0x04, 0x4b, 0xe7, 0xe6, 0x91, 0x74, 0xab, 0xf5, 0xaa, 0x92, 0x61, 0xac, 0x4f, 0xc4,
+	0xc9, 0x77, 0xef, 0xaf, 0xbe, 0xbd, 0x97, 0xb2, 0xf6, 0x2d, 0xca, 0x3b, 0xd9, 0x47, 0xa7, 0x20,
+	0xd2, 0xa1, 0x4c, 0x62, 0xcc, 0xdc, 0x30, 0x1a, 0x0e, 0x6b, 0xd9, 0xe4, 0xaf, 0xff, 0x19, 0x00,
+	0xd5, 0x33, 0x14, 0xe3, 0x9b, 0x07, 0x00, 0x00,
 }
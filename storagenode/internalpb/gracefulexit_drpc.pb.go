@@ -1,5 +1,5 @@
 // Code generated by protoc-gen-go-drpc. DO NOT EDIT.
-// protoc-gen-go-drpc version: v0.0.20
+// protoc-gen-go-drpc version: v0.0.32
 // source: gracefulexit.proto
 
 package internalpb
@@ -46,6 +46,7 @@ type DRPCNodeGracefulExitClient interface {
 	InitiateGracefulExit(ctx context.Context, in *InitiateGracefulExitRequest) (*ExitProgress, error)
 	GetExitProgress(ctx context.Context, in *GetExitProgressRequest) (*GetExitProgressResponse, error)
 	GracefulExitFeasibility(ctx context.Context, in *GracefulExitFeasibilityRequest) (*GracefulExitFeasibilityResponse, error)
+	AbortGracefulExit(ctx context.Context, in *AbortGracefulExitRequest) (*AbortGracefulExitResponse, error)
 }
 
 type drpcNodeGracefulExitClient struct {
@@ -94,34 +95,48 @@ func (c *drpcNodeGracefulExitClient) GracefulExitFeasibility(ctx context.Context
 	return out, nil
 }
 
+func (c *drpcNodeGracefulExitClient) AbortGracefulExit(ctx context.Context, in *AbortGracefulExitRequest) (*AbortGracefulExitResponse, error) {
+	out := new(AbortGracefulExitResponse)
+	err := c.cc.Invoke(ctx, "/storagenode.gracefulexit.NodeGracefulExit/AbortGracefulExit", drpcEncoding_File_gracefulexit_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 type DRPCNodeGracefulExitServer interface {
 	GetNonExitingSatellites(context.Context, *GetNonExitingSatellitesRequest) (*GetNonExitingSatellitesResponse, error)
 	InitiateGracefulExit(context.Context, *InitiateGracefulExitRequest) (*ExitProgress, error)
 	GetExitProgress(context.Context, *GetExitProgressRequest) (*GetExitProgressResponse, error)
 	GracefulExitFeasibility(context.Context, *GracefulExitFeasibilityRequest) (*GracefulExitFeasibilityResponse, error)
+	AbortGracefulExit(context.Context, *AbortGracefulExitRequest) (*AbortGracefulExitResponse, error)
 }
 
 type DRPCNodeGracefulExitUnimplementedServer struct{}
 
 func (s *DRPCNodeGracefulExitUnimplementedServer) GetNonExitingSatellites(context.Context, *GetNonExitingSatellitesRequest) (*GetNonExitingSatellitesResponse, error) {
-	return nil, drpcerr.WithCode(errors.New("Unimplemented"), 12)
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
 }
 
 func (s *DRPCNodeGracefulExitUnimplementedServer) InitiateGracefulExit(context.Context, *InitiateGracefulExitRequest) (*ExitProgress, error) {
-	return nil, drpcerr.WithCode(errors.New("Unimplemented"), 12)
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
 }
 
 func (s *DRPCNodeGracefulExitUnimplementedServer) GetExitProgress(context.Context, *GetExitProgressRequest) (*GetExitProgressResponse, error) {
-	return nil, drpcerr.WithCode(errors.New("Unimplemented"), 12)
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
 }
 
 func (s *DRPCNodeGracefulExitUnimplementedServer) GracefulExitFeasibility(context.Context, *GracefulExitFeasibilityRequest) (*GracefulExitFeasibilityResponse, error) {
-	return nil, drpcerr.WithCode(errors.New("Unimplemented"), 12)
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+func (s *DRPCNodeGracefulExitUnimplementedServer) AbortGracefulExit(context.Context, *AbortGracefulExitRequest) (*AbortGracefulExitResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
 }
 
 type DRPCNodeGracefulExitDescription struct{}
 
-func (DRPCNodeGracefulExitDescription) NumMethods() int { return 4 }
+func (DRPCNodeGracefulExitDescription) NumMethods() int { return 5 }
 
 func (DRPCNodeGracefulExitDescription) Method(n int) (string, drpc.Encoding, drpc.Receiver, interface{}, bool) {
 	switch n {
@@ -161,6 +176,15 @@ func (DRPCNodeGracefulExitDescription) Method(n int) (string, drpc.Encoding, drp
 						in1.(*GracefulExitFeasibilityRequest),
 					)
 			}, DRPCNodeGracefulExitServer.GracefulExitFeasibility, true
+	case 4:
+		return "/storagenode.gracefulexit.NodeGracefulExit/AbortGracefulExit", drpcEncoding_File_gracefulexit_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCNodeGracefulExitServer).
+					AbortGracefulExit(
+						ctx,
+						in1.(*AbortGracefulExitRequest),
+					)
+			}, DRPCNodeGracefulExitServer.AbortGracefulExit, true
 	default:
 		return "", nil, nil, nil, false
 	}
@@ -233,3 +257,19 @@ func (x *drpcNodeGracefulExit_GracefulExitFeasibilityStream) SendAndClose(m *Gra
 	}
 	return x.CloseSend()
 }
+
+type DRPCNodeGracefulExit_AbortGracefulExitStream interface {
+	drpc.Stream
+	SendAndClose(*AbortGracefulExitResponse) error
+}
+
+type drpcNodeGracefulExit_AbortGracefulExitStream struct {
+	drpc.Stream
+}
+
+func (x *drpcNodeGracefulExit_AbortGracefulExitStream) SendAndClose(m *AbortGracefulExitResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_gracefulexit_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
@@ -6,6 +6,7 @@ package orders
 import (
 	"context"
 	"math/rand"
+	"strings"
 	"sync"
 	"time"
 
@@ -18,10 +19,15 @@ import (
 	"storj.io/common/rpc"
 	"storj.io/common/storj"
 	"storj.io/common/sync2"
+	"storj.io/storj/private/settlementpb"
 	"storj.io/storj/storagenode/orders/ordersfile"
 	"storj.io/storj/storagenode/trust"
 )
 
+// maxSettlementWindows is the maximum number of windows of a satellite, which
+// are settled at once.
+const maxSettlementWindows = 24
+
 var (
 	// OrderError represents errors with orders.
 	OrderError = errs.Class("order")
@@ -180,11 +186,11 @@ func (service *Service) SendOrders(ctx context.Context, now time.Time) {
 
 	// Continue sending until there are no more windows to send, or all relevant satellites are offline.
 	for {
-		ordersBySatellite, err := service.ordersStore.ListUnsentBySatellite(ctx, now)
+		windowsBySatellite, err := service.ordersStore.ListUnsentWindowsBySatellite(ctx, now, maxSettlementWindows)
 		if err != nil {
 			service.log.Error("listing orders", zap.Error(err))
 		}
-		if len(ordersBySatellite) == 0 {
+		if len(windowsBySatellite) == 0 {
 			service.log.Debug("no orders to send")
 			break
 		}
@@ -193,8 +199,8 @@ func (service *Service) SendOrders(ctx context.Context, now time.Time) {
 		attemptedSatellites := 0
 		ctx, cancel := context.WithTimeout(ctx, service.config.SenderTimeout)
 
-		for satelliteID, unsentInfo := range ordersBySatellite {
-			satelliteID, unsentInfo := satelliteID, unsentInfo
+		for satelliteID, unsentWindows := range windowsBySatellite {
+			satelliteID, unsentWindows := satelliteID, unsentWindows
 			if _, ok := errorSatellites[satelliteID]; ok {
 				continue
 			}
@@ -202,7 +208,7 @@ func (service *Service) SendOrders(ctx context.Context, now time.Time) {
 
 			group.Go(func() error {
 				log := service.log.Named(satelliteID.String())
-				status, err := service.settleWindow(ctx, log, satelliteID, unsentInfo.InfoList)
+				statuses, err := service.settleWindows(ctx, log, satelliteID, unsentWindows)
 				if err != nil {
 					// satellite returned an error, but settlement was not explicitly rejected; we want to retry later
 					errorSatellitesMu.Lock()
@@ -212,10 +218,12 @@ func (service *Service) SendOrders(ctx context.Context, now time.Time) {
 					return nil
 				}
 
-				err = service.ordersStore.Archive(satelliteID, unsentInfo, time.Now().UTC(), status)
-				if err != nil {
-					log.Error("failed to archive orders", zap.Error(err))
-					return nil
+				for i, unsentInfo := range unsentWindows {
+					err = service.ordersStore.Archive(satelliteID, unsentInfo, time.Now().UTC(), statuses[i])
+					if err != nil {
+						log.Error("failed to archive orders", zap.Error(err))
+						return nil
+					}
 				}
 
 				return nil
@@ -232,23 +240,99 @@ func (service *Service) SendOrders(ctx context.Context, now time.Time) {
 	}
 }
 
-func (service *Service) settleWindow(ctx context.Context, log *zap.Logger, satelliteID storj.NodeID, orders []*ordersfile.Info) (status pb.SettlementWithWindowResponse_Status, err error) {
+// settleWindows settles the windows of orders of the satellite and returns
+// the status of every window in the same order.
+func (service *Service) settleWindows(ctx context.Context, log *zap.Logger, satelliteID storj.NodeID, windows []UnsentInfo) (statuses []pb.SettlementWithWindowResponse_Status, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	log.Info("sending", zap.Int("count", len(orders)))
-	defer log.Info("finished")
-
 	nodeurl, err := service.trust.GetNodeURL(ctx, satelliteID)
 	if err != nil {
-		return 0, OrderError.New("unable to get satellite address: %w", err)
+		return nil, OrderError.New("unable to get satellite address: %w", err)
 	}
 
 	conn, err := service.dialer.DialNodeURL(ctx, nodeurl)
 	if err != nil {
-		return 0, OrderError.New("unable to connect to the satellite: %w", err)
+		return nil, OrderError.New("unable to connect to the satellite: %w", err)
 	}
 	defer func() { err = errs.Combine(err, conn.Close()) }()
 
+	statuses, err = service.settleWindowsBatch(ctx, log, conn, windows)
+	if err == nil || !isUnknownRPC(err) {
+		return statuses, err
+	}
+
+	// the satellite doesn't support settling multiple windows at once yet.
+	log.Debug("settling windows one at a time", zap.Error(err))
+	statuses = statuses[:0]
+	for _, window := range windows {
+		status, err := service.settleWindow(ctx, log, conn, window.InfoList)
+		if err != nil {
+			return nil, err
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses, nil
+}
+
+// settleWindowsBatch sends the orders of all windows in a single stream. The
+// windows without any valid orders aren't part of the response, they are
+// rejected.
+func (service *Service) settleWindowsBatch(ctx context.Context, log *zap.Logger, conn *rpc.Conn, windows []UnsentInfo) (statuses []pb.SettlementWithWindowResponse_Status, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	log.Info("sending", zap.Int("windows", len(windows)))
+	defer log.Info("finished")
+
+	stream, err := settlementpb.NewDRPCSettlementClient(conn).SettleWindows(ctx)
+	if err != nil {
+		return nil, OrderError.New("failed to start settlement: %w", err)
+	}
+
+	for _, window := range windows {
+		for _, order := range window.InfoList {
+			err := stream.Send(&pb.SettlementRequest{
+				Limit: order.Limit,
+				Order: order.Order,
+			})
+			if err != nil {
+				_ = stream.Close()
+				return nil, OrderError.New("sending settlement agreements returned an error: %w", err)
+			}
+		}
+	}
+
+	res, err := stream.CloseAndRecv()
+	if err != nil {
+		return nil, OrderError.New("CloseAndRecv settlement agreements returned an error: %w", err)
+	}
+
+	settled := make(map[int64]pb.SettlementWithWindowResponse_Status, len(res.Windows))
+	for _, window := range res.Windows {
+		settled[window.Window.Truncate(time.Hour).UnixNano()] = window.Status
+	}
+
+	statuses = make([]pb.SettlementWithWindowResponse_Status, 0, len(windows))
+	for _, window := range windows {
+		status, ok := settled[window.CreatedAtHour.Truncate(time.Hour).UnixNano()]
+		if !ok {
+			status = pb.SettlementWithWindowResponse_REJECTED
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses, nil
+}
+
+// isUnknownRPC returns whether the satellite doesn't know the called method.
+func isUnknownRPC(err error) bool {
+	return strings.Contains(err.Error(), "unknown rpc")
+}
+
+func (service *Service) settleWindow(ctx context.Context, log *zap.Logger, conn *rpc.Conn, orders []*ordersfile.Info) (status pb.SettlementWithWindowResponse_Status, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	log.Info("sending", zap.Int("count", len(orders)))
+	defer log.Info("finished")
+
 	stream, err := pb.NewDRPCOrdersClient(conn).SettlementWithWindow(ctx)
 	if err != nil {
 		return 0, OrderError.New("failed to start settlement: %w", err)
@@ -183,12 +183,27 @@ type UnsentInfo struct {
 // needs to be called twice, with calls to `Archive` in between each call, to see all unsent orders.
 func (store *FileStore) ListUnsentBySatellite(ctx context.Context, now time.Time) (infoMap map[storj.NodeID]UnsentInfo, err error) {
 	defer mon.Task()(&ctx)(&err)
+
+	windowsMap, err := store.ListUnsentWindowsBySatellite(ctx, now, 1)
+
+	infoMap = make(map[storj.NodeID]UnsentInfo, len(windowsMap))
+	for satelliteID, windows := range windowsMap {
+		infoMap[satelliteID] = windows[0]
+	}
+	return infoMap, err
+}
+
+// ListUnsentWindowsBySatellite returns up to maxWindows windows of orders that haven't been sent yet,
+// grouped by satellite. Like ListUnsentBySatellite, it only reads files where the order limit grace
+// period has passed.
+func (store *FileStore) ListUnsentWindowsBySatellite(ctx context.Context, now time.Time, maxWindows int) (windowsMap map[storj.NodeID][]UnsentInfo, err error) {
+	defer mon.Task()(&ctx)(&err)
 	// shouldn't be necessary, but acquire archiveMu to ensure we do not attempt to archive files during list
 	store.archiveMu.Lock()
 	defer store.archiveMu.Unlock()
 
 	var errList error
-	infoMap = make(map[storj.NodeID]UnsentInfo)
+	windowsMap = make(map[storj.NodeID][]UnsentInfo)
 
 	err = filepath.Walk(store.unsentDir, func(path string, info os.FileInfo, err error) error {
 		if err != nil {
@@ -204,8 +219,8 @@ func (store *FileStore) ListUnsentBySatellite(ctx context.Context, now time.Time
 			return nil //nolint: nilerr // errors are collected separately
 		}
 
-		// if we already have orders for this satellite, ignore the file
-		if _, ok := infoMap[fileInfo.SatelliteID]; ok {
+		// if we already have enough windows for this satellite, ignore the file
+		if len(windowsMap[fileInfo.SatelliteID]) >= maxWindows {
 			return nil
 		}
 
@@ -258,14 +273,14 @@ func (store *FileStore) ListUnsentBySatellite(ctx context.Context, now time.Time
 			newUnsentInfo.InfoList = append(newUnsentInfo.InfoList, newInfo)
 		}
 
-		infoMap[fileInfo.SatelliteID] = newUnsentInfo
+		windowsMap[fileInfo.SatelliteID] = append(windowsMap[fileInfo.SatelliteID], newUnsentInfo)
 		return nil
 	})
 	if err != nil {
 		errList = errs.Combine(errList, err)
 	}
 
-	return infoMap, errList
+	return windowsMap, errList
 }
 
 // Archive moves a file from "unsent" to "archive".
@@ -382,6 +397,61 @@ func (store *FileStore) CleanArchive(deleteBefore time.Time) error {
 	return errs.Combine(errList, err)
 }
 
+// DeleteSatellite removes the unsent and the archived orders of the satellite.
+// It returns the number of the removed order files.
+func (store *FileStore) DeleteSatellite(satelliteID storj.NodeID) (removed int, err error) {
+	store.unsentMu.Lock()
+	defer store.unsentMu.Unlock()
+	store.archiveMu.Lock()
+	defer store.archiveMu.Unlock()
+
+	var errList error
+	remove := func(getSatelliteID func(os.FileInfo) (storj.NodeID, error)) filepath.WalkFunc {
+		return func(path string, info os.FileInfo, err error) error {
+			if err != nil {
+				errList = errs.Combine(errList, OrderError.Wrap(err))
+				return nil //nolint: nilerr // errors are collected separately
+			}
+			if info.IsDir() {
+				return nil
+			}
+			fileSatelliteID, err := getSatelliteID(info)
+			if err != nil {
+				errList = errs.Combine(errList, OrderError.Wrap(err))
+				return nil //nolint: nilerr // errors are collected separately
+			}
+			if fileSatelliteID != satelliteID {
+				return nil
+			}
+			if err := os.Remove(path); err != nil {
+				return OrderError.Wrap(err)
+			}
+			removed++
+			return nil
+		}
+	}
+
+	err = filepath.Walk(store.unsentDir, remove(func(info os.FileInfo) (storj.NodeID, error) {
+		fileInfo, err := ordersfile.GetUnsentInfo(info)
+		if err != nil {
+			return storj.NodeID{}, err
+		}
+		return fileInfo.SatelliteID, nil
+	}))
+	if err != nil {
+		return removed, errs.Combine(errList, err)
+	}
+
+	err = filepath.Walk(store.archiveDir, remove(func(info os.FileInfo) (storj.NodeID, error) {
+		fileInfo, err := ordersfile.GetArchivedInfo(info)
+		if err != nil {
+			return storj.NodeID{}, err
+		}
+		return fileInfo.SatelliteID, nil
+	}))
+	return removed, errs.Combine(errList, err)
+}
+
 // ensureDirectories checks for the existence of the unsent and archived directories, and creates them if they do not exist.
 func (store *FileStore) ensureDirectories() error {
 	if _, err := os.Stat(store.unsentDir); os.IsNotExist(err) {
@@ -177,6 +177,39 @@ func TestOrdersStore_ListUnsentBySatellite(t *testing.T) {
 	require.Len(t, archived, 0)
 }
 
+func TestOrdersStore_ListUnsentWindowsBySatellite(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+	dirName := ctx.Dir("test-orders")
+	now := time.Now()
+	later := now.Add(48 * time.Hour)
+
+	ordersStore, err := orders.NewFileStore(zaptest.NewLogger(t), dirName, 12*time.Hour)
+	require.NoError(t, err)
+
+	createdAtTimes := []time.Time{now.Add(-3 * time.Hour), now.Add(-2 * time.Hour), now}
+	_, err = storeNewOrders(ordersStore, 2, 3, createdAtTimes)
+	require.NoError(t, err)
+
+	unsent, err := ordersStore.ListUnsentWindowsBySatellite(ctx, later, 2)
+	require.NoError(t, err)
+	require.Len(t, unsent, 2)
+	for _, windows := range unsent {
+		require.Len(t, windows, 2)
+		require.False(t, windows[0].CreatedAtHour.Equal(windows[1].CreatedAtHour))
+		for _, window := range windows {
+			require.Len(t, window.InfoList, 3)
+		}
+	}
+
+	unsent, err = ordersStore.ListUnsentWindowsBySatellite(ctx, later, 10)
+	require.NoError(t, err)
+	require.Len(t, unsent, 2)
+	for _, windows := range unsent {
+		require.Len(t, windows, len(createdAtTimes))
+	}
+}
+
 func TestOrdersStore_ListUnsentBySatellite_Ongoing(t *testing.T) {
 	ctx := testcontext.New(t)
 	defer ctx.Cleanup()
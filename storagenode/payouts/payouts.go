@@ -5,6 +5,8 @@ package payouts
 
 import (
 	"context"
+	"math"
+	"strings"
 	"time"
 
 	"github.com/zeebo/errs"
@@ -95,6 +97,42 @@ func (paystub *PayStub) GetEarnedWithSurge() (earned int64, surge int64) {
 	return earned, surge
 }
 
+// HeldPercent returns the percent of the earned amount with surge, which the
+// satellite held back. It's false when nothing was earned in the period.
+func (paystub *PayStub) HeldPercent() (float64, bool) {
+	earned, _ := paystub.GetEarnedWithSurge()
+	surgePercent := paystub.SurgePercent
+	if surgePercent == 0 {
+		surgePercent = 100
+	}
+	surge := earned * surgePercent / 100
+	if surge <= 0 {
+		return 0, false
+	}
+	return math.Round(float64(paystub.Held) * 100 / float64(surge)), true
+}
+
+// InWithholding returns whether the node was in the withholding period of the
+// satellite, according to the codes of the paystub.
+func (paystub *PayStub) InWithholding() bool {
+	return paystub.hasCode("E")
+}
+
+// Disqualified returns whether the node was disqualified, according to the
+// codes of the paystub.
+func (paystub *PayStub) Disqualified() bool {
+	return paystub.hasCode("D")
+}
+
+func (paystub *PayStub) hasCode(code string) bool {
+	for _, value := range strings.Split(paystub.Codes, ":") {
+		if value == code {
+			return true
+		}
+	}
+	return false
+}
+
 // UsageAtRestTbM converts paystub's usage_at_rest from tbh to tbm.
 func (paystub *PayStub) UsageAtRestTbM() {
 	paystub.UsageAtRest /= 720
@@ -123,6 +161,28 @@ type SatelliteHeldHistory struct {
 	JoinedAt            time.Time    `json:"joinedAt"`
 }
 
+// SatelliteHeldStatus contains the held amount of a satellite, which isn't
+// disposed yet, and when it's released.
+type SatelliteHeldStatus struct {
+	SatelliteID   storj.NodeID `json:"satelliteID"`
+	SatelliteName string       `json:"satelliteName"`
+	JoinedAt      time.Time    `json:"joinedAt"`
+	// Period is the period of the latest paystub.
+	Period        string  `json:"period"`
+	HeldPercent   float64 `json:"heldPercent"`
+	InWithholding bool    `json:"inWithholding"`
+	TotalHeld     int64   `json:"totalHeld"`
+	TotalDisposed int64   `json:"totalDisposed"`
+	CurrentHeld   int64   `json:"currentHeld"`
+	// DisposalEligible is whether the satellite disposes the held amount
+	// with the next paystub.
+	DisposalEligible bool `json:"disposalEligible"`
+	// ProjectedRelease is the estimated amount, which is disposed once the
+	// node leaves the withholding period or exits gracefully.
+	ProjectedRelease int64 `json:"projectedRelease"`
+	IsExitComplete   bool  `json:"isExitComplete"`
+}
+
 // SatellitePayoutForPeriod contains payouts information for specific period for specific satellite.
 type SatellitePayoutForPeriod struct {
 	SatelliteID    string  `json:"satelliteID"`
@@ -40,3 +40,28 @@ func TestParsePeriodRange(t *testing.T) {
 		}
 	}
 }
+
+func TestPayStubHeldPercent(t *testing.T) {
+	paystub := PayStub{
+		Codes:      "E",
+		CompAtRest: 600,
+		CompGet:    400,
+		Held:       500,
+	}
+
+	percent, ok := paystub.HeldPercent()
+	require.True(t, ok)
+	require.Equal(t, float64(50), percent)
+	require.True(t, paystub.InWithholding())
+	require.False(t, paystub.Disqualified())
+
+	paystub.SurgePercent = 200
+	percent, ok = paystub.HeldPercent()
+	require.True(t, ok)
+	require.Equal(t, float64(25), percent)
+
+	_, ok = (&PayStub{Codes: "D:O"}).HeldPercent()
+	require.False(t, ok)
+	require.True(t, (&PayStub{Codes: "O:D"}).Disqualified())
+	require.False(t, (&PayStub{Codes: "X"}).InWithholding())
+}
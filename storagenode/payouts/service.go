@@ -30,6 +30,10 @@ var (
 	// ErrBadPeriod defines that period has wrong format.
 	ErrBadPeriod = errs.Class("wrong period format")
 
+	// DisposePercent is the percent of the held amount, which the satellites
+	// dispose to the node after it leaves the withholding period.
+	DisposePercent int64 = 50
+
 	mon = monkit.Package()
 )
 
@@ -232,6 +236,93 @@ func (service *Service) AllHeldbackHistory(ctx context.Context) (result []Satell
 	return result, nil
 }
 
+// AllHeldStatus retrieves the held amount, which isn't disposed yet, for all
+// satellites from the storagenode database. The withholding state is taken
+// from the latest paystub of the satellite.
+func (service *Service) AllHeldStatus(ctx context.Context) (result []SatelliteHeldStatus, err error) {
+	defer mon.Task()(&ctx)(&err)
+	satelliteIDs := service.trust.GetSatellites(ctx)
+
+	satelliteIDs = append(satelliteIDs, service.stefanSatellite)
+	for _, satelliteID := range satelliteIDs {
+		periods, err := service.db.SatellitePeriods(ctx, satelliteID)
+		if err != nil {
+			return nil, ErrPayoutService.Wrap(err)
+		}
+		if len(periods) == 0 {
+			continue
+		}
+
+		status := SatelliteHeldStatus{
+			SatelliteID:   satelliteID,
+			SatelliteName: "stefan-benten",
+			Period:        periods[len(periods)-1],
+		}
+
+		paystub, err := service.db.GetPayStub(ctx, satelliteID, status.Period)
+		if err != nil {
+			return nil, ErrPayoutService.Wrap(err)
+		}
+
+		helds, err := service.db.SatellitesHeldbackHistory(ctx, satelliteID)
+		if err != nil {
+			return nil, ErrPayoutService.Wrap(err)
+		}
+		for _, held := range helds {
+			status.TotalHeld += held.Amount
+		}
+
+		status.TotalDisposed, err = service.db.SatellitesDisposedHistory(ctx, satelliteID)
+		if err != nil {
+			return nil, ErrPayoutService.Wrap(err)
+		}
+
+		if satelliteID != service.stefanSatellite {
+			url, err := service.trust.GetNodeURL(ctx, satelliteID)
+			if err != nil {
+				return nil, ErrPayoutService.Wrap(err)
+			}
+			status.SatelliteName = url.Address
+		}
+
+		satellite, err := service.satellitesDB.GetSatellite(ctx, satelliteID)
+		if err != nil {
+			return nil, ErrPayoutService.Wrap(err)
+		}
+		status.IsExitComplete = satellite.Status == satellites.ExitSucceeded
+
+		stats, err := service.reputationDB.Get(ctx, satelliteID)
+		if err != nil {
+			return nil, ErrPayoutService.Wrap(err)
+		}
+		status.JoinedAt = stats.JoinedAt.Round(time.Minute)
+
+		status.HeldPercent, _ = paystub.HeldPercent()
+		status.InWithholding = paystub.InWithholding()
+		status.CurrentHeld = status.TotalHeld - status.TotalDisposed
+		if status.CurrentHeld < 0 {
+			status.CurrentHeld = 0
+		}
+
+		if !paystub.Disqualified() {
+			status.DisposalEligible = !status.InWithholding || status.IsExitComplete
+			// a gracefully exited node gets the full held amount, otherwise
+			// only a part of it is disposed.
+			release := status.CurrentHeld
+			if !status.IsExitComplete {
+				release = status.TotalHeld*DisposePercent/100 - status.TotalDisposed
+			}
+			if release > 0 {
+				status.ProjectedRelease = release
+			}
+		}
+
+		result = append(result, status)
+	}
+
+	return result, nil
+}
+
 // AllSatellitesPayoutPeriod retrieves paystub and payment receipt for specific month from all satellites.
 func (service *Service) AllSatellitesPayoutPeriod(ctx context.Context, period string) (result []SatellitePayoutForPeriod, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -296,7 +387,12 @@ func (service *Service) AllSatellitesPayoutPeriod(ctx context.Context, period st
 			return nil, ErrPayoutService.Wrap(err)
 		}
 
-		heldPercent := GetHeldRate(stats.JoinedAt, heldPeriod)
+		// the paystub reflects the withholding schedule of the satellite,
+		// which may differ from the default one.
+		heldPercent, ok := paystub.HeldPercent()
+		if !ok {
+			heldPercent = GetHeldRate(stats.JoinedAt, heldPeriod)
+		}
 		payoutForPeriod.Held = paystub.Held
 		payoutForPeriod.Receipt = receipt
 		payoutForPeriod.Surge = surge
@@ -29,16 +29,21 @@ import (
 	"storj.io/private/version"
 	"storj.io/storj/private/lifecycle"
 	"storj.io/storj/private/multinodepb"
+	"storj.io/storj/private/nodecapabilities"
+	"storj.io/storj/private/nodeinventory"
+	"storj.io/storj/private/piecelistpb"
 	"storj.io/storj/private/server"
 	"storj.io/storj/private/version/checker"
 	"storj.io/storj/storage"
 	"storj.io/storj/storage/filestore"
 	"storj.io/storj/storagenode/apikeys"
+	"storj.io/storj/storagenode/auditfailures"
 	"storj.io/storj/storagenode/bandwidth"
 	"storj.io/storj/storagenode/collector"
 	"storj.io/storj/storagenode/console"
 	"storj.io/storj/storagenode/console/consoleserver"
 	"storj.io/storj/storagenode/contact"
+	"storj.io/storj/storagenode/forgetsatellite"
 	"storj.io/storj/storagenode/gracefulexit"
 	"storj.io/storj/storagenode/healthcheck"
 	"storj.io/storj/storagenode/inspector"
@@ -51,6 +56,7 @@ import (
 	"storj.io/storj/storagenode/orders"
 	"storj.io/storj/storagenode/payouts"
 	"storj.io/storj/storagenode/payouts/estimatedpayouts"
+	"storj.io/storj/storagenode/piecelist"
 	"storj.io/storj/storagenode/pieces"
 	"storj.io/storj/storagenode/piecestore"
 	"storj.io/storj/storagenode/piecestore/usedserials"
@@ -60,6 +66,7 @@ import (
 	"storj.io/storj/storagenode/reputation"
 	"storj.io/storj/storagenode/retain"
 	"storj.io/storj/storagenode/satellites"
+	"storj.io/storj/storagenode/scrubber"
 	"storj.io/storj/storagenode/storagenodedb"
 	"storj.io/storj/storagenode/storageusage"
 	"storj.io/storj/storagenode/trust"
@@ -95,6 +102,7 @@ type DB interface {
 	Payout() payouts.DB
 	Pricing() pricing.DB
 	APIKeys() apikeys.DB
+	AuditFailures() auditfailures.DB
 
 	Preflight(ctx context.Context) error
 }
@@ -132,6 +140,12 @@ type Config struct {
 	Bandwidth bandwidth.Config
 
 	GracefulExit gracefulexit.Config
+
+	ForgetSatellite forgetsatellite.Config
+
+	AuditFailures auditfailures.Config
+
+	Scrubber scrubber.Config
 }
 
 // DatabaseConfig returns the storagenodedb.Config that should be used with this Config.
@@ -251,7 +265,9 @@ type Peer struct {
 		RetainService *retain.Service
 		PieceDeleter  *pieces.Deleter
 		Endpoint      *piecestore.Endpoint
+		PieceList     *piecelist.Endpoint
 		Inspector     *inspector.Endpoint
+		Scrubber      *scrubber.Chore
 		Monitor       *monitor.Service
 		Orders        *orders.Service
 	}
@@ -281,10 +297,18 @@ type Peer struct {
 		BlobsCleaner *gracefulexit.BlobsCleaner
 	}
 
+	ForgetSatellite struct {
+		Chore *forgetsatellite.Chore
+	}
+
 	Notifications struct {
 		Service *notifications.Service
 	}
 
+	AuditFailures struct {
+		Service *auditfailures.Service
+	}
+
 	Payout struct {
 		Service  *payouts.Service
 		Endpoint *payouts.Endpoint
@@ -317,6 +341,10 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 		peer.Notifications.Service = notifications.NewService(peer.Log, peer.DB.Notifications())
 	}
 
+	{ // setup audit failures service.
+		peer.AuditFailures.Service = auditfailures.NewService(peer.Log.Named("auditfailures"), peer.DB.AuditFailures(), config.AuditFailures)
+	}
+
 	{ // setup debug
 		var err error
 		if config.Debug.Address != "" {
@@ -429,6 +457,15 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 				WalletFeatures: config.Operator.WalletFeatures,
 			},
 			Version: *pbVersion,
+			Capabilities: nodecapabilities.Capabilities{
+				Protocols: []string{nodecapabilities.ProtocolTCP},
+				// expired pieces are deleted by the collector.
+				TTL: true,
+			},
+			Inventory: nodeinventory.Detect(nodeinventory.StorageBackendFilestore, config.Storage.Path),
+		}
+		if peer.Server.IsQUICEnabled() {
+			self.Capabilities.Protocols = append(self.Capabilities.Protocols, nodecapabilities.ProtocolQUIC)
 		}
 		peer.Contact.PingStats = new(contact.PingStats)
 		peer.Contact.QUICStats = contact.NewQUICStats(peer.Server.IsQUICEnabled())
@@ -478,6 +515,20 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 			Close: peer.Storage2.TrashChore.Close,
 		})
 
+		peer.Storage2.Scrubber = scrubber.NewChore(
+			log.Named("pieces:scrubber"),
+			peer.Storage2.Trust,
+			peer.Storage2.Store,
+			config.Scrubber,
+		)
+		peer.Services.Add(lifecycle.Item{
+			Name:  "pieces:scrubber",
+			Run:   peer.Storage2.Scrubber.Run,
+			Close: peer.Storage2.Scrubber.Close,
+		})
+		peer.Debug.Server.Panel.Add(
+			debug.Cycle("Pieces Scrubber", peer.Storage2.Scrubber.Loop))
+
 		peer.Storage2.CacheService = pieces.NewService(
 			log.Named("piecestore:cache"),
 			peer.Storage2.BlobsCache,
@@ -541,6 +592,7 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 			peer.Storage2.Monitor,
 			peer.Storage2.RetainService,
 			peer.Contact.PingStats,
+			peer.AuditFailures.Service,
 			peer.Storage2.Store,
 			peer.Storage2.TrashChore,
 			peer.Storage2.PieceDeleter,
@@ -556,6 +608,12 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 		if err := pb.DRPCRegisterPiecestore(peer.Server.DRPC(), peer.Storage2.Endpoint); err != nil {
 			return nil, errs.Combine(err, peer.Close())
 		}
+		peer.Server.SetMaxRequestTimeout("piecestore.Piecestore", config.Storage2.MaxRequestTimeout)
+
+		peer.Storage2.PieceList = piecelist.NewEndpoint(peer.Log.Named("piecelist"), peer.Storage2.Trust, peer.Storage2.Store)
+		if err := piecelistpb.DRPCRegisterPieceList(peer.Server.DRPC(), peer.Storage2.PieceList); err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
 
 		// TODO workaround for custom timeout for order sending request (read/write)
 		sc := config.Server
@@ -705,6 +763,8 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 			peer.Notifications.Service,
 			peer.Console.Service,
 			peer.Payout.Service,
+			peer.AuditFailures.Service,
+			peer.Storage2.Scrubber,
 			peer.Console.Listener,
 		)
 
@@ -792,6 +852,24 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 			debug.Cycle("Graceful Exit", peer.GracefulExit.Chore.Loop))
 	}
 
+	{ // setup removal of the data of decommissioned satellites
+		cleaner := forgetsatellite.NewCleaner(peer.Log.Named("forgetsatellite:cleaner"), peer.Storage2.Store, peer.OrdersStore, peer.Storage2.Trust, forgetsatellite.DB{
+			Satellites:   peer.DB.Satellites(),
+			Reputation:   peer.DB.Reputation(),
+			Pricing:      peer.DB.Pricing(),
+			StorageUsage: peer.DB.StorageUsage(),
+			SpaceUsed:    peer.DB.PieceSpaceUsedDB(),
+		})
+		peer.ForgetSatellite.Chore = forgetsatellite.NewChore(peer.Log.Named("forgetsatellite:chore"), peer.Storage2.Trust, cleaner, config.ForgetSatellite)
+		peer.Services.Add(lifecycle.Item{
+			Name:  "forgetsatellite:chore",
+			Run:   peer.ForgetSatellite.Chore.Run,
+			Close: peer.ForgetSatellite.Chore.Close,
+		})
+		peer.Debug.Server.Panel.Add(
+			debug.Cycle("Forget Satellite", peer.ForgetSatellite.Chore.Loop))
+	}
+
 	peer.Collector = collector.NewService(peer.Log.Named("collector"), peer.Storage2.Store, peer.UsedSerials, config.Collector)
 	peer.Services.Add(lifecycle.Item{
 		Name:  "collector",
@@ -0,0 +1,121 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package piecelist implements the service, which lets the satellites enumerate
+// the pieces stored for them.
+package piecelist
+
+import (
+	"context"
+	"errors"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"go.uber.org/zap"
+
+	"storj.io/common/identity"
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/storj/private/piecelistpb"
+	"storj.io/storj/storagenode/pieces"
+	"storj.io/storj/storagenode/trust"
+)
+
+var mon = monkit.Package()
+
+// errPageFull stops the walk, when the page has all its pieces.
+var errPageFull = errors.New("page full")
+
+const (
+	// DefaultLimit is the page size used, when the request doesn't specify it.
+	DefaultLimit = 1000
+	// MaxLimit is the largest page size, which can be requested.
+	MaxLimit = 10000
+)
+
+// Endpoint implements the piece list service, which allows the satellites to
+// enumerate the pieces stored for them.
+//
+// architecture: Endpoint
+type Endpoint struct {
+	piecelistpb.DRPCPieceListUnimplementedServer
+
+	log   *zap.Logger
+	trust *trust.Pool
+	store *pieces.Store
+}
+
+// NewEndpoint returns a new piece list endpoint.
+func NewEndpoint(log *zap.Logger, trust *trust.Pool, store *pieces.Store) *Endpoint {
+	return &Endpoint{
+		log:   log,
+		trust: trust,
+		store: store,
+	}
+}
+
+// List returns a page of the pieces stored for the calling satellite, ordered
+// by piece ID and starting after the cursor.
+//
+// The blob store walks the pieces in order from the cursor, so a page only
+// reads the key prefix directories it covers. Pieces stored with the V0
+// storage format aren't listed.
+func (endpoint *Endpoint) List(ctx context.Context, req *piecelistpb.ListRequest) (_ *piecelistpb.ListResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	peer, err := identity.PeerIdentityFromContext(ctx)
+	if err != nil {
+		return nil, rpcstatus.Error(rpcstatus.Unauthenticated, err.Error())
+	}
+	if err := endpoint.trust.VerifySatelliteID(ctx, peer.ID); err != nil {
+		return nil, rpcstatus.Error(rpcstatus.PermissionDenied, "piece list called with untrusted ID")
+	}
+
+	limit := int(req.Limit)
+	switch {
+	case limit < 0:
+		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, "limit is negative")
+	case limit == 0:
+		limit = DefaultLimit
+	case limit > MaxLimit:
+		limit = MaxLimit
+	}
+
+	// keep one piece more than the limit, to know whether there are more pages.
+	var page []pieces.StoredPieceAccess
+	err = endpoint.store.WalkSatellitePiecesFrom(ctx, peer.ID, req.Cursor, func(access pieces.StoredPieceAccess) error {
+		page = append(page, access)
+		if len(page) > limit {
+			return errPageFull
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, errPageFull) {
+		endpoint.log.Error("unable to list pieces", zap.Stringer("Satellite ID", peer.ID), zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+
+	response := &piecelistpb.ListResponse{}
+	if len(page) > limit {
+		page = page[:limit]
+		response.More = true
+	}
+
+	response.Pieces = make([]*piecelistpb.PieceInfo, 0, len(page))
+	for _, access := range page {
+		_, contentSize, err := access.Size(ctx)
+		if err != nil {
+			return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+		}
+		modTime, err := access.ModTime(ctx)
+		if err != nil {
+			return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+		}
+		response.Pieces = append(response.Pieces, &piecelistpb.PieceInfo{
+			PieceId:          access.PieceID(),
+			ContentSize:      contentSize,
+			ModificationTime: modTime,
+		})
+	}
+
+	mon.IntVal("piecelist_listed_pieces").Observe(int64(len(response.Pieces)))
+	return response, nil
+}
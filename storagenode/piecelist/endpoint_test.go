@@ -0,0 +1,80 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package piecelist_test
+
+import (
+	"bytes"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/errs2"
+	"storj.io/common/pb"
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/piecelistpb"
+	"storj.io/storj/private/testplanet"
+)
+
+func TestList(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		node := planet.StorageNodes[0]
+
+		// use non satellite dialer to check if request will be rejected
+		uplinkConn, err := planet.Uplinks[0].Dialer.DialNodeURL(ctx, node.NodeURL())
+		require.NoError(t, err)
+		defer ctx.Check(uplinkConn.Close)
+		_, err = piecelistpb.NewDRPCPieceListClient(uplinkConn).List(ctx, &piecelistpb.ListRequest{})
+		require.True(t, errs2.IsRPC(err, rpcstatus.PermissionDenied))
+
+		var pieceIDs []storj.PieceID
+		for i := 0; i < 5; i++ {
+			pieceID := testrand.PieceID()
+			writer, err := node.Storage2.Store.Writer(ctx, satellite.ID(), pieceID, pb.PieceHashAlgorithm_SHA256)
+			require.NoError(t, err)
+			_, err = writer.Write(testrand.BytesInt(100 + i))
+			require.NoError(t, err)
+			require.NoError(t, writer.Commit(ctx, &pb.PieceHeader{}))
+			pieceIDs = append(pieceIDs, pieceID)
+		}
+		sort.Slice(pieceIDs, func(i, k int) bool {
+			return bytes.Compare(pieceIDs[i][:], pieceIDs[k][:]) < 0
+		})
+
+		conn, err := satellite.Dialer.DialNodeURL(ctx, node.NodeURL())
+		require.NoError(t, err)
+		defer ctx.Check(conn.Close)
+		client := piecelistpb.NewDRPCPieceListClient(conn)
+
+		_, err = client.List(ctx, &piecelistpb.ListRequest{Limit: -1})
+		require.True(t, errs2.IsRPC(err, rpcstatus.InvalidArgument))
+
+		var listed []storj.PieceID
+		var cursor storj.PieceID
+		for pages := 0; ; pages++ {
+			require.Less(t, pages, 3)
+
+			response, err := client.List(ctx, &piecelistpb.ListRequest{Cursor: cursor, Limit: 2})
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(response.Pieces), 2)
+
+			for _, piece := range response.Pieces {
+				require.Greater(t, piece.ContentSize, int64(99))
+				require.False(t, piece.ModificationTime.IsZero())
+				listed = append(listed, piece.PieceId)
+				cursor = piece.PieceId
+			}
+			if !response.More {
+				break
+			}
+		}
+		require.Equal(t, pieceIDs, listed)
+	})
+}
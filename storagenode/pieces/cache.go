@@ -306,6 +306,45 @@ func (blobs *BlobsUsageCache) Trash(ctx context.Context, blobRef storage.BlobRef
 	return nil
 }
 
+// Quarantine moves the ref to the quarantine and updates the cache. The
+// quarantined piece is counted as trash.
+func (blobs *BlobsUsageCache) Quarantine(ctx context.Context, blobRef storage.BlobRef) error {
+	pieceTotal, pieceContentSize, err := blobs.pieceSizes(ctx, blobRef)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	err = blobs.Blobs.Quarantine(ctx, blobRef)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	satelliteID, err := storj.NodeIDFromBytes(blobRef.Namespace)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	blobs.Update(ctx, satelliteID, -pieceTotal, -pieceContentSize, pieceTotal)
+	return nil
+}
+
+// EmptyQuarantine empties the quarantine and updates the cache.
+func (blobs *BlobsUsageCache) EmptyQuarantine(ctx context.Context, namespace []byte, quarantinedBefore time.Time) (int64, error) {
+	satelliteID, err := storj.NodeIDFromBytes(namespace)
+	if err != nil {
+		return 0, err
+	}
+
+	bytesEmptied, err := blobs.Blobs.EmptyQuarantine(ctx, namespace, quarantinedBefore)
+	if err != nil {
+		return 0, err
+	}
+
+	blobs.Update(ctx, satelliteID, 0, 0, -bytesEmptied)
+
+	return bytesEmptied, nil
+}
+
 // EmptyTrash empties the trash and updates the cache.
 func (blobs *BlobsUsageCache) EmptyTrash(ctx context.Context, namespace []byte, trashedBefore time.Time) (int64, [][]byte, error) {
 	satelliteID, err := storj.NodeIDFromBytes(namespace)
@@ -315,6 +315,42 @@ func (store *Store) Delete(ctx context.Context, satellite storj.NodeID, pieceID
 	return Error.Wrap(err)
 }
 
+// Quarantine moves the specified piece out of the blob store, so it's no
+// longer served, and keeps it for inspection.
+func (store *Store) Quarantine(ctx context.Context, satellite storj.NodeID, pieceID storj.PieceID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	err = store.blobs.Quarantine(ctx, storage.BlobRef{
+		Namespace: satellite.Bytes(),
+		Key:       pieceID.Bytes(),
+	})
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	err = store.DeleteExpired(ctx, satellite, pieceID)
+	if err == nil {
+		store.log.Warn("quarantined piece", zap.String("Satellite ID", satellite.String()),
+			zap.String("Piece ID", pieceID.String()))
+	}
+
+	return Error.Wrap(err)
+}
+
+// EmptyQuarantine deletes the pieces of the satellite, which were
+// quarantined before quarantinedBefore.
+func (store *Store) EmptyQuarantine(ctx context.Context, satelliteID storj.NodeID, quarantinedBefore time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	bytesEmptied, err := store.blobs.EmptyQuarantine(ctx, satelliteID.Bytes(), quarantinedBefore)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if bytesEmptied > 0 {
+		store.log.Info("emptied quarantine", zap.String("Satellite ID", satelliteID.String()), zap.Int64("Bytes", bytesEmptied))
+	}
+	return nil
+}
+
 // DeleteExpired deletes records in both the piece_expirations and pieceinfo DBs, wherever we find it.
 // Should return no error if the requested record is not found in any of the DBs.
 func (store *Store) DeleteExpired(ctx context.Context, satellite storj.NodeID, pieceID storj.PieceID) (err error) {
@@ -530,6 +566,27 @@ func (store *Store) WalkSatellitePieces(ctx context.Context, satellite storj.Nod
 	return err
 }
 
+// WalkSatellitePiecesFrom executes walkFunc for each locally stored piece in the namespace of
+// the given satellite, whose ID is greater than the cursor, in the order of the piece IDs. If
+// walkFunc returns a non-nil error, WalkSatellitePiecesFrom will stop iterating and return the
+// error immediately.
+//
+// Unlike WalkSatellitePieces, it doesn't include the pieces stored with the V0 storage format.
+func (store *Store) WalkSatellitePiecesFrom(ctx context.Context, satellite storj.NodeID, cursor storj.PieceID, walkFunc func(StoredPieceAccess) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return store.blobs.WalkNamespaceFrom(ctx, satellite.Bytes(), cursor.Bytes(), func(blobInfo storage.BlobInfo) error {
+		if blobInfo.StorageFormatVersion() < filestore.FormatV1 {
+			return nil
+		}
+		pieceAccess, err := newStoredPieceAccess(store, blobInfo)
+		if err != nil {
+			// this is not a real piece blob; see WalkSatellitePieces.
+			return nil //nolint: nilerr // we ignore other files
+		}
+		return walkFunc(pieceAccess)
+	})
+}
+
 // GetExpired gets piece IDs that are expired and were created before the given time.
 func (store *Store) GetExpired(ctx context.Context, expiredAt time.Time, limit int64) (_ []ExpiredInfo, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -29,6 +29,7 @@ import (
 	"storj.io/common/storj"
 	"storj.io/common/sync2"
 	"storj.io/drpc"
+	"storj.io/storj/storagenode/auditfailures"
 	"storj.io/storj/storagenode/bandwidth"
 	"storj.io/storj/storagenode/monitor"
 	"storj.io/storj/storagenode/orders"
@@ -64,6 +65,7 @@ type Config struct {
 	CacheSyncInterval       time.Duration `help:"how often the space used cache is synced to persistent storage" releaseDefault:"1h0m0s" devDefault:"0h1m0s"`
 	PieceScanOnStartup      bool          `help:"if set to true, all pieces disk usage is recalculated on startup" default:"true"`
 	StreamOperationTimeout  time.Duration `help:"how long to spend waiting for a stream operation before canceling" default:"30m"`
+	MaxRequestTimeout       time.Duration `help:"maximum time a piecestore request is handled, before it's canceled. clients can send shorter timeouts. 0 only applies the timeouts of the clients" default:"0"`
 	RetainTimeBuffer        time.Duration `help:"allows for small differences in the satellite and storagenode clocks" default:"48h0m0s"`
 	ReportCapacityThreshold memory.Size   `help:"threshold below which to immediately notify satellite of capacity" default:"500MB" hidden:"true"`
 	MaxUsedSerialsSize      memory.Size   `help:"amount of memory allowed for used serials store - once surpassed, serials will be dropped at random" default:"1MB"`
@@ -97,6 +99,8 @@ type Endpoint struct {
 	retain    *retain.Service
 	pingStats pingStatsSource
 
+	auditFailures *auditfailures.Service
+
 	store        *pieces.Store
 	trashChore   *pieces.TrashChore
 	ordersStore  *orders.FileStore
@@ -108,7 +112,7 @@ type Endpoint struct {
 }
 
 // NewEndpoint creates a new piecestore endpoint.
-func NewEndpoint(log *zap.Logger, signer signing.Signer, trust *trust.Pool, monitor *monitor.Service, retain *retain.Service, pingStats pingStatsSource, store *pieces.Store, trashChore *pieces.TrashChore, pieceDeleter *pieces.Deleter, ordersStore *orders.FileStore, usage bandwidth.DB, usedSerials *usedserials.Table, config Config) (*Endpoint, error) {
+func NewEndpoint(log *zap.Logger, signer signing.Signer, trust *trust.Pool, monitor *monitor.Service, retain *retain.Service, pingStats pingStatsSource, auditFailures *auditfailures.Service, store *pieces.Store, trashChore *pieces.TrashChore, pieceDeleter *pieces.Deleter, ordersStore *orders.FileStore, usage bandwidth.DB, usedSerials *usedserials.Table, config Config) (*Endpoint, error) {
 	return &Endpoint{
 		log:    log,
 		config: config,
@@ -119,6 +123,8 @@ func NewEndpoint(log *zap.Logger, signer signing.Signer, trust *trust.Pool, moni
 		retain:    retain,
 		pingStats: pingStats,
 
+		auditFailures: auditFailures,
+
 		store:        store,
 		trashChore:   trashChore,
 		ordersStore:  ordersStore,
@@ -599,6 +605,7 @@ func (endpoint *Endpoint) Download(stream pb.DRPCPiecestore_DownloadStream) (err
 			mon.IntVal("download_cancel_duration_ns", actionSeriesTag).Observe(downloadDuration)
 			mon.FloatVal("download_cancel_rate_bytes_per_sec", actionSeriesTag).Observe(downloadRate)
 			endpoint.log.Info("download canceled", zap.Stringer("Piece ID", limit.PieceId), zap.Stringer("Satellite ID", limit.SatelliteId), zap.Stringer("Action", limit.Action))
+			endpoint.recordAuditFailure(ctx, limit, err)
 		} else if err != nil {
 			mon.Counter("download_failure_count", actionSeriesTag).Inc(1)
 			mon.Meter("download_failure_byte_meter", actionSeriesTag).Mark64(downloadSize)
@@ -606,6 +613,7 @@ func (endpoint *Endpoint) Download(stream pb.DRPCPiecestore_DownloadStream) (err
 			mon.IntVal("download_failure_duration_ns", actionSeriesTag).Observe(downloadDuration)
 			mon.FloatVal("download_failure_rate_bytes_per_sec", actionSeriesTag).Observe(downloadRate)
 			endpoint.log.Error("download failed", zap.Stringer("Piece ID", limit.PieceId), zap.Stringer("Satellite ID", limit.SatelliteId), zap.Stringer("Action", limit.Action), zap.Error(err))
+			endpoint.recordAuditFailure(ctx, limit, err)
 		} else {
 			mon.Counter("download_success_count", actionSeriesTag).Inc(1)
 			mon.Meter("download_success_byte_meter", actionSeriesTag).Mark64(downloadSize)
@@ -635,9 +643,10 @@ func (endpoint *Endpoint) Download(stream pb.DRPCPiecestore_DownloadStream) (err
 		}
 	}()
 
-	// for repair traffic, send along the PieceHash and original OrderLimit for validation
-	// before sending the piece itself
-	if message.Limit.Action == pb.PieceAction_GET_REPAIR {
+	// for repair traffic and audits of whole pieces, send along the PieceHash and original
+	// OrderLimit for validation before sending the piece itself
+	wholePiece := chunk.Offset == 0 && chunk.ChunkSize == pieceReader.Size()
+	if message.Limit.Action == pb.PieceAction_GET_REPAIR || (message.Limit.Action == pb.PieceAction_GET_AUDIT && wholePiece) {
 		pieceHash, orderLimit, err := endpoint.store.GetHashAndLimit(ctx, limit.SatelliteId, limit.PieceId, pieceReader)
 		if err != nil {
 			endpoint.log.Error("could not get hash and order limit", zap.Error(err))
@@ -767,6 +776,21 @@ func (endpoint *Endpoint) Download(stream pb.DRPCPiecestore_DownloadStream) (err
 	return rpcstatus.Wrap(rpcstatus.Internal, errs.Combine(sendErr, recvErr))
 }
 
+// recordAuditFailure stores the failure of the download, when it was an audit.
+// Only local errors are known here, a piece, which was sent but fails the
+// verification on the satellite, isn't recorded.
+func (endpoint *Endpoint) recordAuditFailure(ctx context.Context, limit *pb.OrderLimit, failure error) {
+	if limit.Action != pb.PieceAction_GET_AUDIT {
+		return
+	}
+
+	// the download may have failed because ctx was canceled.
+	ctx = context2.WithoutCancellation(ctx)
+	if err := endpoint.auditFailures.Record(ctx, limit.SatelliteId, limit.PieceId, failure); err != nil {
+		endpoint.log.Error("failed to record audit failure", zap.Stringer("Piece ID", limit.PieceId), zap.Stringer("Satellite ID", limit.SatelliteId), zap.Error(err))
+	}
+}
+
 // beginSaveOrder saves the order with all necessary information. It assumes it has been already verified.
 func (endpoint *Endpoint) beginSaveOrder(limit *pb.OrderLimit) (_commit func(ctx context.Context, order *pb.Order), err error) {
 	defer mon.Task()(nil)(&err)
@@ -64,4 +64,6 @@ type DB interface {
 	CompleteGracefulExit(ctx context.Context, satelliteID storj.NodeID, finishedAt time.Time, exitStatus Status, completionReceipt []byte) error
 	// ListGracefulExits lists all graceful exit records
 	ListGracefulExits(ctx context.Context) ([]ExitProgress, error)
+	// DeleteSatellite removes the satellite and its graceful exit record
+	DeleteSatellite(ctx context.Context, satelliteID storj.NodeID) error
 }
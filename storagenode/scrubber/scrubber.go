@@ -0,0 +1,253 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package scrubber implements verifying the integrity of the stored pieces.
+package scrubber
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/memory"
+	"storj.io/common/pb"
+	"storj.io/common/storj"
+	"storj.io/common/sync2"
+	"storj.io/storj/storage/filestore"
+	"storj.io/storj/storagenode/pieces"
+	"storj.io/storj/storagenode/trust"
+)
+
+var (
+	// Error is the default error class for the scrubber.
+	Error = errs.Class("scrubber")
+
+	mon = monkit.Package()
+)
+
+// Config defines parameters for the scrubber.
+type Config struct {
+	Enabled  bool          `help:"whether the stored pieces are periodically verified" default:"false"`
+	Interval time.Duration `help:"how frequently all stored pieces are verified" default:"720h0m0s"`
+	ReadRate memory.Size   `help:"how many bytes per second are read while verifying pieces" default:"1MiB"`
+
+	QuarantineRetention time.Duration `help:"how long corrupt pieces are kept in the quarantine for inspection" default:"168h0m0s"`
+}
+
+// readBufferSize is the size of the chunks, which are read before throttling.
+const readBufferSize = 256 * memory.KiB
+
+// Stats contains the counts of the current or last scrub.
+type Stats struct {
+	Verified  int64 `json:"verified"`
+	Corrupt   int64 `json:"corrupt"`
+	Skipped   int64 `json:"skipped"`
+	Failed    int64 `json:"failed"`
+	BytesRead int64 `json:"bytesRead"`
+
+	LastStarted  time.Time `json:"lastStarted"`
+	LastFinished time.Time `json:"lastFinished"`
+}
+
+// Chore periodically reads the stored pieces, verifies them against the
+// hash in their header and quarantines the corrupt ones, so disk corruption
+// is noticed before audits fail.
+//
+// architecture: Chore
+type Chore struct {
+	log    *zap.Logger
+	config Config
+
+	trust *trust.Pool
+	store *pieces.Store
+
+	mu    sync.Mutex
+	stats Stats
+
+	Loop *sync2.Cycle
+}
+
+// NewChore creates a new scrubber chore.
+func NewChore(log *zap.Logger, trust *trust.Pool, store *pieces.Store, config Config) *Chore {
+	return &Chore{
+		log:    log,
+		config: config,
+		trust:  trust,
+		store:  store,
+		Loop:   sync2.NewCycle(config.Interval),
+	}
+}
+
+// Run runs the scrubber chore.
+func (chore *Chore) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if !chore.config.Enabled {
+		return nil
+	}
+
+	return chore.Loop.Run(ctx, func(ctx context.Context) error {
+		chore.update(func(stats *Stats) {
+			*stats = Stats{LastStarted: time.Now(), LastFinished: stats.LastFinished}
+		})
+
+		for _, satellite := range chore.trust.GetSatellites(ctx) {
+			if err := chore.ScrubSatellite(ctx, satellite); err != nil {
+				if errs.Is(err, context.Canceled) {
+					return nil
+				}
+				chore.log.Error("failed to scrub pieces", zap.Stringer("Satellite ID", satellite), zap.Error(err))
+			}
+
+			quarantinedBefore := time.Now().Add(-chore.config.QuarantineRetention)
+			if err := chore.store.EmptyQuarantine(ctx, satellite, quarantinedBefore); err != nil {
+				chore.log.Error("failed to empty quarantine", zap.Stringer("Satellite ID", satellite), zap.Error(err))
+			}
+		}
+
+		chore.update(func(stats *Stats) {
+			stats.LastFinished = time.Now()
+		})
+		return nil
+	})
+}
+
+// Close stops the scrubber chore.
+func (chore *Chore) Close() error {
+	chore.Loop.Close()
+	return nil
+}
+
+// Enabled returns whether the stored pieces are verified.
+func (chore *Chore) Enabled() bool { return chore.config.Enabled }
+
+// Stats returns the counts of the current or last scrub.
+func (chore *Chore) Stats() Stats {
+	chore.mu.Lock()
+	defer chore.mu.Unlock()
+	return chore.stats
+}
+
+func (chore *Chore) update(fn func(stats *Stats)) {
+	chore.mu.Lock()
+	defer chore.mu.Unlock()
+	fn(&chore.stats)
+}
+
+// ScrubSatellite verifies all pieces stored for the satellite and quarantines
+// the corrupt ones. Pieces, which can't be read or quarantined, are logged and
+// counted as failed, without stopping the walk.
+func (chore *Chore) ScrubSatellite(ctx context.Context, satellite storj.NodeID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	buffer := make([]byte, readBufferSize)
+	return chore.store.WalkSatellitePieces(ctx, satellite, func(access pieces.StoredPieceAccess) error {
+		if access.StorageFormatVersion() < filestore.FormatV1 {
+			// V0 pieces don't have a header with the hash.
+			chore.update(func(stats *Stats) { stats.Skipped++ })
+			return nil
+		}
+
+		corrupt, bytesRead, err := chore.verify(ctx, satellite, access.PieceID(), buffer)
+		chore.update(func(stats *Stats) { stats.BytesRead += bytesRead })
+		mon.Counter("scrubber_bytes_read").Inc(bytesRead)
+		switch {
+		case errs.Is(err, context.Canceled):
+			return err
+		case errs.IsFunc(err, os.IsNotExist):
+			// the piece was deleted in the meantime.
+			chore.update(func(stats *Stats) { stats.Skipped++ })
+			return nil
+		case err != nil:
+			chore.failed(satellite, access.PieceID(), err)
+			return nil
+		}
+
+		if corrupt == nil {
+			chore.update(func(stats *Stats) { stats.Verified++ })
+			mon.Counter("scrubber_verified_pieces").Inc(1)
+			return nil
+		}
+
+		chore.log.Warn("piece is corrupt",
+			zap.Stringer("Satellite ID", satellite),
+			zap.Stringer("Piece ID", access.PieceID()),
+			zap.Error(corrupt))
+		chore.update(func(stats *Stats) { stats.Corrupt++ })
+		mon.Counter("scrubber_corrupt_pieces").Inc(1)
+
+		if err := chore.store.Quarantine(ctx, satellite, access.PieceID()); err != nil {
+			if errs.Is(err, context.Canceled) {
+				return err
+			}
+			chore.failed(satellite, access.PieceID(), err)
+		}
+		return nil
+	})
+}
+
+// failed records that the piece couldn't be verified or quarantined.
+func (chore *Chore) failed(satellite storj.NodeID, pieceID storj.PieceID, err error) {
+	chore.log.Error("failed to scrub piece",
+		zap.Stringer("Satellite ID", satellite),
+		zap.Stringer("Piece ID", pieceID),
+		zap.Error(err))
+	chore.update(func(stats *Stats) { stats.Failed++ })
+	mon.Counter("scrubber_failed_pieces").Inc(1)
+}
+
+// errCorrupt is returned by verify, when the piece doesn't match its header.
+var errCorrupt = errs.Class("corrupt piece")
+
+// verify reads the piece and compares it with the hash in its header. It
+// returns a non-nil corrupt error when the piece is corrupt and err when the
+// piece couldn't be read.
+func (chore *Chore) verify(ctx context.Context, satellite storj.NodeID, pieceID storj.PieceID, buffer []byte) (corrupt error, bytesRead int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	reader, err := chore.store.Reader(ctx, satellite, pieceID)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer func() { err = errs.Combine(err, reader.Close()) }()
+
+	header, err := reader.GetPieceHeader()
+	if err != nil {
+		return errCorrupt.Wrap(err), 0, nil
+	}
+	bytesRead = pieces.V1PieceHeaderReservedArea
+
+	hash := pb.NewHashFromAlgorithm(header.HashAlgorithm)
+	for {
+		n, readErr := reader.Read(buffer)
+		_, _ = hash.Write(buffer[:n])
+		bytesRead += int64(n)
+
+		if errors.Is(readErr, io.EOF) {
+			break
+		}
+		if readErr != nil {
+			return nil, bytesRead, readErr
+		}
+
+		if chore.config.ReadRate > 0 {
+			delay := time.Duration(n) * time.Second / time.Duration(chore.config.ReadRate)
+			if !sync2.Sleep(ctx, delay) {
+				return nil, bytesRead, ctx.Err()
+			}
+		}
+	}
+
+	if !bytes.Equal(hash.Sum(nil), header.Hash) {
+		return errCorrupt.New("hash mismatch"), bytesRead, nil
+	}
+	return nil, bytesRead, nil
+}
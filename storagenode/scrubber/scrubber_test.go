@@ -0,0 +1,109 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package scrubber_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/pb"
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/storage/filestore"
+	"storj.io/storj/storagenode/pieces"
+	"storj.io/storj/storagenode/scrubber"
+)
+
+func TestScrubSatellite(t *testing.T) {
+	ctx := testcontext.New(t)
+	log := zaptest.NewLogger(t)
+
+	dir, err := filestore.NewDir(log, ctx.Dir("pieces"))
+	require.NoError(t, err)
+
+	blobs := filestore.New(log, dir, filestore.DefaultConfig)
+	defer ctx.Check(blobs.Close)
+
+	store := pieces.NewStore(log, blobs, nil, nil, nil, pieces.DefaultConfig)
+
+	satellite := testrand.NodeID()
+	writePiece := func(pieceID storj.PieceID, hash func(actual []byte) []byte) {
+		writer, err := store.Writer(ctx, satellite, pieceID, pb.PieceHashAlgorithm_SHA256)
+		require.NoError(t, err)
+
+		_, err = writer.Write(testrand.BytesInt(10 * 1024))
+		require.NoError(t, err)
+
+		require.NoError(t, writer.Commit(ctx, &pb.PieceHeader{
+			Hash:          hash(writer.Hash()),
+			HashAlgorithm: pb.PieceHashAlgorithm_SHA256,
+			CreationTime:  time.Now(),
+		}))
+	}
+
+	// replace the first piece with a symlink loop, so reading it fails.
+	writePiece(testrand.PieceID(), func(actual []byte) []byte { return actual })
+	unreadable, err := filepath.Glob(filepath.Join(dir.Path(), "blobs", "*", "*", "*.sj1"))
+	require.NoError(t, err)
+	require.Len(t, unreadable, 1)
+	require.NoError(t, os.Remove(unreadable[0]))
+	require.NoError(t, os.Symlink(unreadable[0], unreadable[0]))
+
+	good, corrupt := testrand.PieceID(), testrand.PieceID()
+	writePiece(good, func(actual []byte) []byte { return actual })
+	writePiece(corrupt, func(actual []byte) []byte { return testrand.BytesInt(len(actual)) })
+
+	chore := scrubber.NewChore(log, nil, store, scrubber.Config{Enabled: true, Interval: time.Hour})
+	require.NoError(t, chore.ScrubSatellite(ctx, satellite))
+
+	// the unreadable piece doesn't stop the walk.
+	stats := chore.Stats()
+	require.EqualValues(t, 1, stats.Verified)
+	require.EqualValues(t, 1, stats.Corrupt)
+	require.EqualValues(t, 1, stats.Failed)
+	require.Zero(t, stats.Skipped)
+
+	reader, err := store.Reader(ctx, satellite, good)
+	require.NoError(t, err)
+	require.NoError(t, reader.Close())
+
+	_, err = store.Reader(ctx, satellite, corrupt)
+	require.True(t, os.IsNotExist(err), err)
+
+	// the corrupt piece is kept in the quarantine.
+	quarantined, err := filepath.Glob(filepath.Join(dir.Path(), "quarantine", "*", "*", "*.sj1"))
+	require.NoError(t, err)
+	require.Len(t, quarantined, 1)
+
+	// the quarantined piece isn't verified again.
+	chore = scrubber.NewChore(log, nil, store, scrubber.Config{Enabled: true, Interval: time.Hour})
+	require.NoError(t, chore.ScrubSatellite(ctx, satellite))
+	require.EqualValues(t, 1, chore.Stats().Verified)
+	require.Zero(t, chore.Stats().Corrupt)
+
+	// the quarantine is counted as trash until it's emptied.
+	trash, err := blobs.SpaceUsedForTrash(ctx)
+	require.NoError(t, err)
+	require.Positive(t, trash)
+
+	require.NoError(t, store.EmptyQuarantine(ctx, satellite, time.Now().Add(-time.Hour)))
+	quarantined, err = filepath.Glob(filepath.Join(dir.Path(), "quarantine", "*", "*", "*.sj1"))
+	require.NoError(t, err)
+	require.Len(t, quarantined, 1)
+
+	require.NoError(t, store.EmptyQuarantine(ctx, satellite, time.Now().Add(time.Hour)))
+	quarantined, err = filepath.Glob(filepath.Join(dir.Path(), "quarantine", "*", "*", "*.sj1"))
+	require.NoError(t, err)
+	require.Empty(t, quarantined)
+
+	emptied, err := blobs.SpaceUsedForTrash(ctx)
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, trash-emptied, int64(10*1024))
+}
@@ -0,0 +1,160 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package storagenodedb
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/storj"
+	"storj.io/storj/storagenode/auditfailures"
+)
+
+// ensures that auditFailuresDB implements auditfailures.DB interface.
+var _ auditfailures.DB = (*auditFailuresDB)(nil)
+
+// ErrAuditFailuresDB represents errors from the audit failures database.
+var ErrAuditFailuresDB = errs.Class("auditfailuresdb")
+
+// AuditFailuresDBName represents the database name.
+const AuditFailuresDBName = "audit_failures"
+
+// auditFailuresDB works with the local audit read errors of the pieces.
+//
+// architecture: Database
+type auditFailuresDB struct {
+	dbContainerImpl
+}
+
+// Insert stores the failure.
+func (db *auditFailuresDB) Insert(ctx context.Context, failure auditfailures.Failure) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	query := `INSERT INTO audit_failures (
+			satellite_id,
+			piece_id,
+			category,
+			error,
+			failed_at
+		) VALUES(?,?,?,?,?)`
+
+	_, err = db.ExecContext(ctx, query,
+		failure.SatelliteID,
+		failure.PieceID,
+		string(failure.Category),
+		failure.Error,
+		failure.FailedAt.UTC(),
+	)
+
+	return ErrAuditFailuresDB.Wrap(err)
+}
+
+// List returns the failures of the satellite since the given time, the newest
+// first.
+func (db *auditFailuresDB) List(ctx context.Context, satelliteID storj.NodeID, since time.Time, limit int) (_ []auditfailures.Failure, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	query := `SELECT piece_id, category, error, failed_at
+		FROM audit_failures
+		WHERE satellite_id = ? AND failed_at >= ?
+		ORDER BY failed_at DESC
+		LIMIT ?`
+
+	rows, err := db.QueryContext(ctx, query, satelliteID, since.UTC(), limit)
+	if err != nil {
+		return nil, ErrAuditFailuresDB.Wrap(err)
+	}
+	defer func() {
+		err = errs.Combine(err, ErrAuditFailuresDB.Wrap(rows.Close()))
+	}()
+
+	var failures []auditfailures.Failure
+	for rows.Next() {
+		failure := auditfailures.Failure{SatelliteID: satelliteID}
+
+		var category string
+		err := rows.Scan(&failure.PieceID, &category, &failure.Error, &failure.FailedAt)
+		if err != nil {
+			return nil, ErrAuditFailuresDB.Wrap(err)
+		}
+		failure.Category = auditfailures.Category(category)
+
+		failures = append(failures, failure)
+	}
+
+	return failures, ErrAuditFailuresDB.Wrap(rows.Err())
+}
+
+// Summarize counts the failures since the given time by satellite and
+// category.
+func (db *auditFailuresDB) Summarize(ctx context.Context, since time.Time) (_ []auditfailures.Summary, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// the times are aggregated here, because sqlite returns the aggregated
+	// timestamps as text.
+	query := `SELECT satellite_id, category, failed_at
+		FROM audit_failures
+		WHERE failed_at >= ?`
+
+	rows, err := db.QueryContext(ctx, query, since.UTC())
+	if err != nil {
+		return nil, ErrAuditFailuresDB.Wrap(err)
+	}
+	defer func() {
+		err = errs.Combine(err, ErrAuditFailuresDB.Wrap(rows.Close()))
+	}()
+
+	type key struct {
+		satelliteID storj.NodeID
+		category    auditfailures.Category
+	}
+	summaries := map[key]*auditfailures.Summary{}
+
+	for rows.Next() {
+		var satelliteID storj.NodeID
+		var category string
+		var failedAt time.Time
+		if err := rows.Scan(&satelliteID, &category, &failedAt); err != nil {
+			return nil, ErrAuditFailuresDB.Wrap(err)
+		}
+
+		k := key{satelliteID: satelliteID, category: auditfailures.Category(category)}
+		summary, ok := summaries[k]
+		if !ok {
+			summary = &auditfailures.Summary{SatelliteID: k.satelliteID, Category: k.category}
+			summaries[k] = summary
+		}
+		summary.Count++
+		if failedAt.After(summary.LastFailure) {
+			summary.LastFailure = failedAt
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, ErrAuditFailuresDB.Wrap(err)
+	}
+
+	list := make([]auditfailures.Summary, 0, len(summaries))
+	for _, summary := range summaries {
+		list = append(list, *summary)
+	}
+	sort.Slice(list, func(i, k int) bool {
+		if list[i].SatelliteID != list[k].SatelliteID {
+			return list[i].SatelliteID.Less(list[k].SatelliteID)
+		}
+		return list[i].Category < list[k].Category
+	})
+
+	return list, nil
+}
+
+// DeleteBefore deletes the failures before the given time.
+func (db *auditFailuresDB) DeleteBefore(ctx context.Context, before time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = db.ExecContext(ctx, `DELETE FROM audit_failures WHERE failed_at < ?`, before.UTC())
+	return ErrAuditFailuresDB.Wrap(err)
+}
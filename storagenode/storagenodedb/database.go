@@ -26,6 +26,7 @@ import (
 	"storj.io/storj/storage"
 	"storj.io/storj/storage/filestore"
 	"storj.io/storj/storagenode/apikeys"
+	"storj.io/storj/storagenode/auditfailures"
 	"storj.io/storj/storagenode/bandwidth"
 	"storj.io/storj/storagenode/notifications"
 	"storj.io/storj/storagenode/orders"
@@ -110,6 +111,7 @@ type DB struct {
 	payoutDB          *payoutDB
 	pricingDB         *pricingDB
 	apiKeysDB         *apiKeysDB
+	auditFailuresDB   *auditFailuresDB
 
 	SQLDBs map[string]DBContainer
 }
@@ -137,6 +139,7 @@ func OpenNew(ctx context.Context, log *zap.Logger, config Config) (*DB, error) {
 	payoutDB := &payoutDB{}
 	pricingDB := &pricingDB{}
 	apiKeysDB := &apiKeysDB{}
+	auditFailuresDB := &auditFailuresDB{}
 
 	db := &DB{
 		log:    log,
@@ -160,6 +163,7 @@ func OpenNew(ctx context.Context, log *zap.Logger, config Config) (*DB, error) {
 		payoutDB:          payoutDB,
 		pricingDB:         pricingDB,
 		apiKeysDB:         apiKeysDB,
+		auditFailuresDB:   auditFailuresDB,
 
 		SQLDBs: map[string]DBContainer{
 			DeprecatedInfoDBName:  deprecatedInfoDB,
@@ -176,6 +180,7 @@ func OpenNew(ctx context.Context, log *zap.Logger, config Config) (*DB, error) {
 			HeldAmountDBName:      payoutDB,
 			PricingDBName:         pricingDB,
 			APIKeysDBName:         apiKeysDB,
+			AuditFailuresDBName:   auditFailuresDB,
 		},
 	}
 
@@ -205,6 +210,7 @@ func OpenExisting(ctx context.Context, log *zap.Logger, config Config) (*DB, err
 	payoutDB := &payoutDB{}
 	pricingDB := &pricingDB{}
 	apiKeysDB := &apiKeysDB{}
+	auditFailuresDB := &auditFailuresDB{}
 
 	db := &DB{
 		log:    log,
@@ -228,6 +234,7 @@ func OpenExisting(ctx context.Context, log *zap.Logger, config Config) (*DB, err
 		payoutDB:          payoutDB,
 		pricingDB:         pricingDB,
 		apiKeysDB:         apiKeysDB,
+		auditFailuresDB:   auditFailuresDB,
 
 		SQLDBs: map[string]DBContainer{
 			DeprecatedInfoDBName:  deprecatedInfoDB,
@@ -244,6 +251,7 @@ func OpenExisting(ctx context.Context, log *zap.Logger, config Config) (*DB, err
 			HeldAmountDBName:      payoutDB,
 			PricingDBName:         pricingDB,
 			APIKeysDBName:         apiKeysDB,
+			AuditFailuresDBName:   auditFailuresDB,
 		},
 	}
 
@@ -277,6 +285,7 @@ func (db *DB) openDatabases(ctx context.Context) error {
 		HeldAmountDBName,
 		PricingDBName,
 		APIKeysDBName,
+		AuditFailuresDBName,
 	}
 
 	for _, dbName := range dbs {
@@ -561,6 +570,11 @@ func (db *DB) APIKeys() apikeys.DB {
 	return db.apiKeysDB
 }
 
+// AuditFailures returns instance of the AuditFailures database.
+func (db *DB) AuditFailures() auditfailures.DB {
+	return db.auditFailuresDB
+}
+
 // RawDatabases are required for testing purposes.
 func (db *DB) RawDatabases() map[string]DBContainer {
 	return db.SQLDBs
@@ -2040,6 +2054,29 @@ func (db *DB) Migration(ctx context.Context) *migrate.Migration {
 					return errs.Wrap(err)
 				}),
 			},
+			{
+				DB:          &db.auditFailuresDB.DB,
+				Description: "Create audit_failures table",
+				Version:     55,
+				CreateDB: func(ctx context.Context, log *zap.Logger) error {
+					if err := db.openDatabase(ctx, AuditFailuresDBName); err != nil {
+						return ErrDatabase.Wrap(err)
+					}
+
+					return nil
+				},
+				Action: migrate.SQL{
+					`CREATE TABLE audit_failures (
+						satellite_id BLOB NOT NULL,
+						piece_id BLOB NOT NULL,
+						category TEXT NOT NULL,
+						error TEXT NOT NULL,
+						failed_at TIMESTAMP NOT NULL
+					);
+					CREATE INDEX idx_audit_failures_satellite_id_failed_at ON audit_failures(satellite_id, failed_at);
+					CREATE INDEX idx_audit_failures_failed_at ON audit_failures(failed_at);`,
+				},
+			},
 		},
 	}
 }
@@ -83,3 +83,11 @@ func (db *pricingDB) Get(ctx context.Context, satelliteID storj.NodeID) (_ *pric
 
 	return &pricingModel, ErrPricing.Wrap(err)
 }
+
+// Delete removes pricing model for specific satellite.
+func (db *pricingDB) Delete(ctx context.Context, satelliteID storj.NodeID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = db.ExecContext(ctx, "DELETE FROM pricing WHERE satellite_id = ?", satelliteID)
+	return ErrPricing.Wrap(err)
+}
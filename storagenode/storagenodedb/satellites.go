@@ -163,3 +163,16 @@ func (db *satellitesDB) ListGracefulExits(ctx context.Context) (exitList []satel
 
 	return exitList, rows.Err()
 }
+
+// DeleteSatellite removes the satellite and its graceful exit record.
+func (db *satellitesDB) DeleteSatellite(ctx context.Context, satelliteID storj.NodeID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return ErrSatellitesDB.Wrap(withTx(ctx, db.GetDB(), func(tx tagsql.Tx) error {
+		_, err = tx.ExecContext(ctx, "DELETE FROM satellite_exit_progress WHERE satellite_id = ?", satelliteID)
+		if err != nil {
+			return err
+		}
+		_, err = tx.ExecContext(ctx, "DELETE FROM satellites WHERE node_id = ?", satelliteID)
+		return err
+	}))
+}
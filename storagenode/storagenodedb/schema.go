@@ -8,6 +8,44 @@ import "storj.io/private/dbutil/dbschema"
 
 func Schema() map[string]*dbschema.Schema {
 	return map[string]*dbschema.Schema{
+		"audit_failures": {
+			Tables: []*dbschema.Table{
+				{
+					Name: "audit_failures",
+					Columns: []*dbschema.Column{
+						{
+							Name:       "category",
+							Type:       "TEXT",
+							IsNullable: false,
+						},
+						{
+							Name:       "error",
+							Type:       "TEXT",
+							IsNullable: false,
+						},
+						{
+							Name:       "failed_at",
+							Type:       "TIMESTAMP",
+							IsNullable: false,
+						},
+						{
+							Name:       "piece_id",
+							Type:       "BLOB",
+							IsNullable: false,
+						},
+						{
+							Name:       "satellite_id",
+							Type:       "BLOB",
+							IsNullable: false,
+						},
+					},
+				},
+			},
+			Indexes: []*dbschema.Index{
+				{Name: "idx_audit_failures_failed_at", Table: "audit_failures", Columns: []string{"failed_at"}, Unique: false, Partial: ""},
+				{Name: "idx_audit_failures_satellite_id_failed_at", Table: "audit_failures", Columns: []string{"satellite_id", "failed_at"}, Unique: false, Partial: ""},
+			},
+		},
 		"bandwidth": {
 			Tables: []*dbschema.Table{
 				{
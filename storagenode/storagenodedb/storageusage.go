@@ -235,3 +235,11 @@ func (db *storageUsageDB) SatelliteSummary(ctx context.Context, satelliteID stor
 	err = db.QueryRowContext(ctx, query, satelliteID, from.UTC(), to.UTC()).Scan(&summary, &averageUsageInBytes)
 	return summary.Float64, averageUsageInBytes.Float64, err
 }
+
+// DeleteSatellite removes all storage usage stamps for a particular satellite.
+func (db *storageUsageDB) DeleteSatellite(ctx context.Context, satelliteID storj.NodeID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = db.ExecContext(ctx, "DELETE FROM storage_usage WHERE satellite_id = ?", satelliteID)
+	return err
+}
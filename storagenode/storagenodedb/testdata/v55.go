@@ -0,0 +1,44 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package testdata
+
+import "storj.io/storj/storagenode/storagenodedb"
+
+var v55 = MultiDBState{
+	Version: 55,
+	DBStates: DBStates{
+		storagenodedb.UsedSerialsDBName:     v54.DBStates[storagenodedb.UsedSerialsDBName],
+		storagenodedb.StorageUsageDBName:    v54.DBStates[storagenodedb.StorageUsageDBName],
+		storagenodedb.ReputationDBName:      v54.DBStates[storagenodedb.ReputationDBName],
+		storagenodedb.PieceSpaceUsedDBName:  v54.DBStates[storagenodedb.PieceSpaceUsedDBName],
+		storagenodedb.PieceInfoDBName:       v54.DBStates[storagenodedb.PieceInfoDBName],
+		storagenodedb.PieceExpirationDBName: v54.DBStates[storagenodedb.PieceExpirationDBName],
+		storagenodedb.OrdersDBName:          v54.DBStates[storagenodedb.OrdersDBName],
+		storagenodedb.BandwidthDBName:       v54.DBStates[storagenodedb.BandwidthDBName],
+		storagenodedb.SatellitesDBName:      v54.DBStates[storagenodedb.SatellitesDBName],
+		storagenodedb.DeprecatedInfoDBName:  v54.DBStates[storagenodedb.DeprecatedInfoDBName],
+		storagenodedb.NotificationsDBName:   v54.DBStates[storagenodedb.NotificationsDBName],
+		storagenodedb.HeldAmountDBName:      v54.DBStates[storagenodedb.HeldAmountDBName],
+		storagenodedb.PricingDBName:         v54.DBStates[storagenodedb.PricingDBName],
+		storagenodedb.APIKeysDBName:         v54.DBStates[storagenodedb.APIKeysDBName],
+		storagenodedb.AuditFailuresDBName: &DBState{
+			SQL: `
+				-- table to hold the recent failed audits
+				CREATE TABLE audit_failures (
+					satellite_id BLOB NOT NULL,
+					piece_id BLOB NOT NULL,
+					category TEXT NOT NULL,
+					error TEXT NOT NULL,
+					failed_at TIMESTAMP NOT NULL
+				);
+				CREATE INDEX idx_audit_failures_satellite_id_failed_at ON audit_failures(satellite_id, failed_at);
+				CREATE INDEX idx_audit_failures_failed_at ON audit_failures(failed_at);
+			`,
+			NewData: `
+				INSERT INTO audit_failures (satellite_id,                                                        piece_id,                                                            category,    error,              failed_at) VALUES
+										   (X'0ed28abb2813e184a1e98b0f6605c4911ea468c7e8433eb583e0fca7ceac3000', X'd5e757fd8d207d1c46583fb58330f803dc961b71147308ff75ff1e72a0df6b0b', 'not_found', 'file not found', '2019-07-19 20:00:00+00:00');
+			`,
+		},
+	},
+}
@@ -26,6 +26,8 @@ type DB interface {
 	Summary(ctx context.Context, from, to time.Time) (float64, float64, error)
 	// SatelliteSummary returns aggregated storage usage for a particular satellite.
 	SatelliteSummary(ctx context.Context, satelliteID storj.NodeID, from, to time.Time) (float64, float64, error)
+	// DeleteSatellite removes all storage usage stamps for a particular satellite.
+	DeleteSatellite(ctx context.Context, satelliteID storj.NodeID) error
 }
 
 // Stamp is storage usage stamp for satellite from interval start till next interval.
@@ -8,10 +8,15 @@ import (
 	"encoding/json"
 	"os"
 	"path/filepath"
+	"sort"
+	"time"
 
 	"github.com/zeebo/errs"
 
 	"storj.io/common/fpath"
+	"storj.io/common/storj"
+	"storj.io/storj/private/satellitedecommission"
+	"storj.io/storj/private/satellitesuccessor"
 )
 
 // Cache caches source information about trusted satellites.
@@ -65,6 +70,56 @@ func (cache *Cache) Set(key string, entries []Entry) {
 	cache.data.Entries[key] = entries
 }
 
+// LookupSuccessor returns the pointer to the successor of the satellite. If
+// the satellite doesn't have a successor, false is returned for ok.
+func (cache *Cache) LookupSuccessor(predecessor storj.NodeID) (pointer satellitesuccessor.Pointer, ok bool) {
+	pointer, ok = cache.data.Successors[predecessor.String()]
+	return pointer, ok
+}
+
+// SetSuccessor sets the pointer to the successor of the satellite.
+func (cache *Cache) SetSuccessor(pointer satellitesuccessor.Pointer) {
+	if cache.data.Successors == nil {
+		cache.data.Successors = make(map[string]satellitesuccessor.Pointer)
+	}
+	cache.data.Successors[pointer.Predecessor.String()] = pointer
+}
+
+// Decommission is the notice, which a satellite announced before shutting
+// down, and the state of the removal of the data stored for it.
+type Decommission struct {
+	Notice      satellitedecommission.Notice `json:"notice"`
+	CleanedUpAt *time.Time                   `json:"cleaned_up_at,omitempty"`
+}
+
+// LookupDecommission returns the decommission of the satellite. If the
+// satellite didn't announce a shutdown, false is returned for ok.
+func (cache *Cache) LookupDecommission(id storj.NodeID) (decommission Decommission, ok bool) {
+	decommission, ok = cache.data.Decommissions[id.String()]
+	return decommission, ok
+}
+
+// SetDecommission sets the decommission of the satellite.
+func (cache *Cache) SetDecommission(decommission Decommission) {
+	if cache.data.Decommissions == nil {
+		cache.data.Decommissions = make(map[string]Decommission)
+	}
+	cache.data.Decommissions[decommission.Notice.Satellite.String()] = decommission
+}
+
+// ListDecommissions returns the decommissions of all satellites ordered by
+// the shutdown time.
+func (cache *Cache) ListDecommissions() []Decommission {
+	decommissions := make([]Decommission, 0, len(cache.data.Decommissions))
+	for _, decommission := range cache.data.Decommissions {
+		decommissions = append(decommissions, decommission)
+	}
+	sort.Slice(decommissions, func(i, k int) bool {
+		return decommissions[i].Notice.ShutdownAt.Before(decommissions[k].Notice.ShutdownAt)
+	})
+	return decommissions
+}
+
 // Save persists the cache to disk.
 func (cache *Cache) Save(ctx context.Context) (err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -74,6 +129,14 @@ func (cache *Cache) Save(ctx context.Context) (err error) {
 // CacheData represents the data stored in the cache.
 type CacheData struct {
 	Entries map[string][]Entry `json:"entries"`
+
+	// Successors are the pointers, which the satellites announced, keyed by
+	// the ID of the predecessor.
+	Successors map[string]satellitesuccessor.Pointer `json:"successors,omitempty"`
+
+	// Decommissions are the notices, which the satellites announced before
+	// shutting down, keyed by the ID of the satellite.
+	Decommissions map[string]Decommission `json:"decommissions,omitempty"`
 }
 
 // NewCacheData returns an new CacheData.
@@ -5,6 +5,8 @@ package trust
 
 import (
 	"context"
+	"net"
+	"strconv"
 
 	"go.uber.org/zap"
 
@@ -80,6 +82,23 @@ func (list *List) FetchURLs(ctx context.Context) ([]storj.NodeURL, error) {
 	return urls, nil
 }
 
+// IsTrusted returns whether the rules of the list trust the satellite.
+func (list *List) IsTrusted(url storj.NodeURL) bool {
+	host, portString, err := net.SplitHostPort(url.Address)
+	if err != nil {
+		return false
+	}
+	port, err := strconv.Atoi(portString)
+	if err != nil {
+		return false
+	}
+	return list.rules.IsTrusted(SatelliteURL{
+		ID:   url.ID,
+		Host: host,
+		Port: port,
+	})
+}
+
 func (list *List) fetchEntries(ctx context.Context) (_ []Entry, err error) {
 	defer mon.Task()(&ctx)(&err)
 
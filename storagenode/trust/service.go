@@ -20,6 +20,8 @@ import (
 	"storj.io/common/signing"
 	"storj.io/common/storj"
 	"storj.io/common/sync2"
+	"storj.io/storj/private/satellitedecommission"
+	"storj.io/storj/private/satellitesuccessor"
 	"storj.io/storj/storagenode/satellites"
 )
 
@@ -69,6 +71,8 @@ type Pool struct {
 
 	listMu sync.Mutex
 	list   *List
+	cache  *Cache
+	listed []storj.NodeURL
 
 	satellitesDB satellites.DB
 
@@ -102,6 +106,7 @@ func NewPool(log *zap.Logger, resolver IdentityResolver, config Config, satellit
 		resolver:        resolver,
 		refreshInterval: config.RefreshInterval,
 		list:            list,
+		cache:           cache,
 		satellitesDB:    satellitesDB,
 		satellites:      make(map[storj.NodeID]*satelliteInfoCache),
 	}, nil
@@ -190,6 +195,109 @@ func (pool *Pool) Refresh(ctx context.Context) error {
 		return err
 	}
 
+	pool.update(urls)
+	return nil
+}
+
+// FollowSuccessor verifies the pointer, which a trusted satellite announced,
+// and trusts the successor as long as the predecessor is trusted. Pointers,
+// which are older than the known pointer of the predecessor, are ignored.
+func (pool *Pool) FollowSuccessor(ctx context.Context, pointer satellitesuccessor.Pointer) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	signee, err := pool.GetSignee(ctx, pointer.Predecessor)
+	if err != nil {
+		return err
+	}
+	if err := satellitesuccessor.Verify(ctx, signee, pointer); err != nil {
+		return Error.Wrap(err)
+	}
+
+	pool.listMu.Lock()
+	defer pool.listMu.Unlock()
+
+	known, ok := pool.cache.LookupSuccessor(pointer.Predecessor)
+	if ok && !pointer.CreatedAt.After(known.CreatedAt) {
+		return nil
+	}
+
+	pool.log.Info("Satellite announced a successor",
+		zap.Stringer("id", pointer.Predecessor),
+		zap.String("successor", pointer.Successor),
+	)
+	pool.cache.SetSuccessor(pointer)
+	if err := pool.cache.Save(ctx); err != nil {
+		pool.log.Warn("Unable to save successor in cache", zap.Error(err))
+	}
+
+	pool.update(pool.trustedURLs(pool.listed))
+	return nil
+}
+
+// ApplyDecommission verifies the notice, which a trusted satellite announced
+// before shutting down. The satellite isn't trusted anymore after the shutdown
+// time. Notices, which are older than the known notice of the satellite, are
+// ignored.
+func (pool *Pool) ApplyDecommission(ctx context.Context, notice satellitedecommission.Notice) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	signee, err := pool.GetSignee(ctx, notice.Satellite)
+	if err != nil {
+		return err
+	}
+	if err := satellitedecommission.Verify(ctx, signee, notice); err != nil {
+		return Error.Wrap(err)
+	}
+
+	pool.listMu.Lock()
+	defer pool.listMu.Unlock()
+
+	known, ok := pool.cache.LookupDecommission(notice.Satellite)
+	if ok && !notice.CreatedAt.After(known.Notice.CreatedAt) {
+		return nil
+	}
+
+	pool.log.Info("Satellite announced its shutdown",
+		zap.Stringer("id", notice.Satellite),
+		zap.Time("shutdown at", notice.ShutdownAt),
+	)
+	pool.cache.SetDecommission(Decommission{Notice: notice})
+	if err := pool.cache.Save(ctx); err != nil {
+		pool.log.Warn("Unable to save decommission in cache", zap.Error(err))
+	}
+
+	pool.update(pool.trustedURLs(pool.listed))
+	return nil
+}
+
+// Decommissions returns the decommissions, which the satellites announced.
+func (pool *Pool) Decommissions(ctx context.Context) []Decommission {
+	defer mon.Task()(&ctx)(nil)
+
+	pool.listMu.Lock()
+	defer pool.listMu.Unlock()
+	return pool.cache.ListDecommissions()
+}
+
+// SetCleanedUp records that the data stored for the decommissioned satellite
+// was removed.
+func (pool *Pool) SetCleanedUp(ctx context.Context, id storj.NodeID, cleanedUpAt time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	pool.listMu.Lock()
+	defer pool.listMu.Unlock()
+
+	decommission, ok := pool.cache.LookupDecommission(id)
+	if !ok {
+		return Error.New("satellite %q didn't announce a shutdown", id)
+	}
+	decommission.CleanedUpAt = &cleanedUpAt
+	pool.cache.SetDecommission(decommission)
+	return pool.cache.Save(ctx)
+}
+
+// update sets the trusted satellites of the pool.
+func (pool *Pool) update(urls []storj.NodeURL) {
 	pool.satellitesMu.Lock()
 	defer pool.satellitesMu.Unlock()
 
@@ -226,8 +334,6 @@ func (pool *Pool) Refresh(ctx context.Context) error {
 			delete(pool.satellites, id)
 		}
 	}
-
-	return nil
 }
 
 func (pool *Pool) getInfo(id storj.NodeID) (*satelliteInfoCache, error) {
@@ -248,7 +354,72 @@ func (pool *Pool) fetchURLs(ctx context.Context) ([]storj.NodeURL, error) {
 	// on the cache, etc).
 	pool.listMu.Lock()
 	defer pool.listMu.Unlock()
-	return pool.list.FetchURLs(ctx)
+
+	urls, err := pool.list.FetchURLs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	pool.listed = urls
+	return pool.trustedURLs(urls), nil
+}
+
+// trustedURLs returns the urls with the successors of the satellites and
+// without the satellites, which already shut down. The caller must hold listMu.
+func (pool *Pool) trustedURLs(urls []storj.NodeURL) []storj.NodeURL {
+	followed := pool.followSuccessors(urls)
+
+	now := time.Now()
+	trusted := followed[:0]
+	for _, url := range followed {
+		if decommission, ok := pool.cache.LookupDecommission(url.ID); ok && !now.Before(decommission.Notice.ShutdownAt) {
+			continue
+		}
+		trusted = append(trusted, url)
+	}
+	return trusted
+}
+
+// followSuccessors adds the successors of the satellites to the urls. A
+// successor with the same ID as its predecessor replaces the address of the
+// predecessor. Successors, which are excluded by the rules of the list, are
+// skipped. The caller must hold listMu.
+func (pool *Pool) followSuccessors(urls []storj.NodeURL) []storj.NodeURL {
+	followed := append([]storj.NodeURL(nil), urls...)
+
+	trusted := make(map[storj.NodeID]struct{}, len(followed))
+	for _, url := range followed {
+		trusted[url.ID] = struct{}{}
+	}
+
+	// successors are appended while iterating to follow chains of pointers.
+	for i := 0; i < len(followed); i++ {
+		pointer, ok := pool.cache.LookupSuccessor(followed[i].ID)
+		if !ok {
+			continue
+		}
+		successor, err := pointer.SuccessorURL()
+		if err != nil {
+			continue
+		}
+		if !pool.list.IsTrusted(successor) {
+			pool.log.Debug("Satellite successor is excluded",
+				zap.Stringer("id", pointer.Predecessor),
+				zap.String("successor", pointer.Successor),
+			)
+			continue
+		}
+
+		if successor.ID == followed[i].ID {
+			followed[i].Address = successor.Address
+			continue
+		}
+		if _, ok := trusted[successor.ID]; ok {
+			continue
+		}
+		trusted[successor.ID] = struct{}{}
+		followed = append(followed, successor)
+	}
+	return followed
 }
 
 func jitter(t time.Duration) time.Duration {
@@ -10,15 +10,20 @@ import (
 	"fmt"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap/zaptest"
 
 	"storj.io/common/identity"
+	"storj.io/common/identity/testidentity"
+	"storj.io/common/signing"
 	"storj.io/common/storj"
 	"storj.io/common/testcontext"
 	"storj.io/common/testrand"
+	"storj.io/storj/private/satellitedecommission"
+	"storj.io/storj/private/satellitesuccessor"
 	"storj.io/storj/storagenode/trust"
 )
 
@@ -192,6 +197,128 @@ func TestPoolGetAddress(t *testing.T) {
 	require.Equal(t, "bar.test:7777", nodeurl.Address)
 }
 
+func TestPoolFollowSuccessor(t *testing.T) {
+	ctx, pool, source, resolver := newPoolTest(t)
+	defer ctx.Cleanup()
+
+	predecessor := testidentity.MustPregeneratedSignedIdentity(0, storj.LatestIDVersion())
+	successor := testidentity.MustPregeneratedSignedIdentity(1, storj.LatestIDVersion())
+
+	predecessorURL := trust.SatelliteURL{
+		ID:   predecessor.ID,
+		Host: "foo.test",
+		Port: 7777,
+	}
+	successorURL := storj.NodeURL{
+		ID:      successor.ID,
+		Address: "bar.test:7777",
+	}
+
+	source.entries = []trust.Entry{{SatelliteURL: predecessorURL}}
+	require.NoError(t, pool.Refresh(ctx))
+	resolver.SetIdentity(predecessorURL.NodeURL(), predecessor.PeerIdentity())
+
+	// a pointer signed by another satellite is rejected.
+	forged, err := satellitesuccessor.Sign(ctx, signing.SignerFromFullIdentity(successor), successorURL, time.Now())
+	require.NoError(t, err)
+	forged.Predecessor = predecessor.ID
+	require.Error(t, pool.FollowSuccessor(ctx, forged))
+	require.Error(t, pool.VerifySatelliteID(ctx, successor.ID))
+
+	pointer, err := satellitesuccessor.Sign(ctx, signing.SignerFromFullIdentity(predecessor), successorURL, time.Now())
+	require.NoError(t, err)
+	require.NoError(t, pool.FollowSuccessor(ctx, pointer))
+
+	// the successor is trusted in addition to the predecessor.
+	assert.ElementsMatch(t, []storj.NodeID{predecessor.ID, successor.ID}, pool.GetSatellites(ctx))
+	nodeurl, err := pool.GetNodeURL(ctx, successor.ID)
+	require.NoError(t, err)
+	require.Equal(t, successorURL, nodeurl)
+
+	// the successor stays trusted after refreshing.
+	require.NoError(t, pool.Refresh(ctx))
+	require.NoError(t, pool.VerifySatelliteID(ctx, successor.ID))
+
+	// the successor is loaded from the cache.
+	reloaded, err := trust.NewPool(zaptest.NewLogger(t), resolver, trust.Config{
+		Sources:   []trust.Source{source},
+		CachePath: ctx.File("trust-cache.json"),
+	}, nil)
+	require.NoError(t, err)
+	require.NoError(t, reloaded.Refresh(ctx))
+	require.NoError(t, reloaded.VerifySatelliteID(ctx, successor.ID))
+
+	// the successor isn't trusted anymore, when the predecessor is removed.
+	source.entries = nil
+	require.NoError(t, pool.Refresh(ctx))
+	require.Error(t, pool.VerifySatelliteID(ctx, successor.ID))
+}
+
+func TestPoolApplyDecommission(t *testing.T) {
+	ctx, pool, source, resolver := newPoolTest(t)
+	defer ctx.Cleanup()
+
+	satellite := testidentity.MustPregeneratedSignedIdentity(0, storj.LatestIDVersion())
+	other := testidentity.MustPregeneratedSignedIdentity(1, storj.LatestIDVersion())
+
+	satelliteURL := trust.SatelliteURL{
+		ID:   satellite.ID,
+		Host: "foo.test",
+		Port: 7777,
+	}
+
+	source.entries = []trust.Entry{{SatelliteURL: satelliteURL}}
+	require.NoError(t, pool.Refresh(ctx))
+	resolver.SetIdentity(satelliteURL.NodeURL(), satellite.PeerIdentity())
+
+	// a notice signed by another satellite is rejected.
+	forged, err := satellitedecommission.Sign(ctx, signing.SignerFromFullIdentity(other), time.Now(), time.Now())
+	require.NoError(t, err)
+	forged.Satellite = satellite.ID
+	require.Error(t, pool.ApplyDecommission(ctx, forged))
+	require.Empty(t, pool.Decommissions(ctx))
+
+	// the satellite stays trusted until it shuts down.
+	future, err := satellitedecommission.Sign(ctx, signing.SignerFromFullIdentity(satellite), time.Now().Add(time.Hour), time.Now().Add(-time.Minute))
+	require.NoError(t, err)
+	require.NoError(t, pool.ApplyDecommission(ctx, future))
+	require.NoError(t, pool.VerifySatelliteID(ctx, satellite.ID))
+
+	decommissions := pool.Decommissions(ctx)
+	require.Len(t, decommissions, 1)
+	require.Equal(t, future, decommissions[0].Notice)
+	require.Nil(t, decommissions[0].CleanedUpAt)
+
+	// a newer notice replaces the shutdown time.
+	past, err := satellitedecommission.Sign(ctx, signing.SignerFromFullIdentity(satellite), time.Now().Add(-time.Hour), time.Now())
+	require.NoError(t, err)
+	require.NoError(t, pool.ApplyDecommission(ctx, past))
+	require.Error(t, pool.VerifySatelliteID(ctx, satellite.ID))
+
+	// the satellite isn't trusted after refreshing.
+	require.NoError(t, pool.Refresh(ctx))
+	require.Error(t, pool.VerifySatelliteID(ctx, satellite.ID))
+
+	cleanedUpAt := time.Now().UTC()
+	require.NoError(t, pool.SetCleanedUp(ctx, satellite.ID, cleanedUpAt))
+	require.Error(t, pool.SetCleanedUp(ctx, other.ID, cleanedUpAt))
+
+	// the decommission is loaded from the cache.
+	reloaded, err := trust.NewPool(zaptest.NewLogger(t), resolver, trust.Config{
+		Sources:   []trust.Source{source},
+		CachePath: ctx.File("trust-cache.json"),
+	}, nil)
+	require.NoError(t, err)
+	require.NoError(t, reloaded.Refresh(ctx))
+	require.Error(t, reloaded.VerifySatelliteID(ctx, satellite.ID))
+
+	decommissions = reloaded.Decommissions(ctx)
+	require.Len(t, decommissions, 1)
+	require.Equal(t, past, decommissions[0].Notice)
+	require.NotNil(t, decommissions[0].CleanedUpAt)
+	require.True(t, cleanedUpAt.Equal(*decommissions[0].CleanedUpAt))
+}
+
 func newPoolTest(t *testing.T) (*testcontext.Context, *trust.Pool, *fakeSource, *fakeIdentityResolver) {
 	ctx := testcontext.New(t)
 
@@ -11,8 +11,10 @@ import (
 	"fmt"
 	"net"
 	"net/http"
+	"os"
 	"reflect"
 	"strings"
+	"sync"
 
 	"github.com/gorilla/mux"
 	"github.com/zeebo/errs"
@@ -20,7 +22,9 @@ import (
 	"golang.org/x/sync/errgroup"
 
 	"storj.io/common/errs2"
+	"storj.io/common/storj"
 	"storj.io/private/version"
+	"storj.io/storj/private/version/checker"
 )
 
 // seedLength is the number of bytes in a rollout seed.
@@ -39,6 +43,17 @@ type Config struct {
 	Versions OldVersionConfig
 
 	Binary ProcessesConfig
+
+	Admin AdminConfig
+
+	ReleaseManifest string `user:"true" help:"path to the release manifest, which is signed offline and served as is" default:""`
+}
+
+// AdminConfig configures the endpoints for adjusting rollouts and pins.
+type AdminConfig struct {
+	AuthToken     string `user:"true" help:"token required to adjust rollouts and pins, the admin endpoints are disabled when empty" default:""`
+	MaxCursorStep int    `user:"true" help:"maximum percentage a rollout cursor can be increased by at once, unless forced" default:"25"`
+	StatePath     string `user:"true" help:"path to the file persisting the adjusted rollouts and pins, which override the configured ones on start" default:""`
 }
 
 // OldVersionConfig provides a list of allowed Versions per process.
@@ -67,6 +82,7 @@ type ProcessConfig struct {
 	Minimum   VersionConfig
 	Suggested VersionConfig
 	Rollout   RolloutConfig
+	Pins      string `user:"true" help:"comma separated list of node-id=minimum or node-id=suggested, pinning the nodes to the version regardless of the rollout" default:""`
 }
 
 // VersionConfig single version configuration.
@@ -94,10 +110,19 @@ type Peer struct {
 		Listener net.Listener
 	}
 
+	config AdminConfig
+
+	mu       sync.RWMutex
 	Versions version.AllowedVersions
+	// cursors contains the rollout percentage of each process.
+	cursors map[string]int
+	// pins contains the nodes pinned to a version of each process.
+	pins map[string]map[storj.NodeID]string
 
 	// response contains the byte version of current allowed versions
 	response []byte
+	// manifest contains the signed release manifest.
+	manifest []byte
 }
 
 // New creates a new VersionControl Server.
@@ -107,7 +132,18 @@ func New(log *zap.Logger, config *Config) (peer *Peer, err error) {
 	}
 
 	peer = &Peer{
-		Log: log,
+		Log:     log,
+		config:  config.Admin,
+		cursors: make(map[string]int),
+		pins:    make(map[string]map[storj.NodeID]string),
+	}
+
+	for service, process := range config.Binary.byService() {
+		peer.cursors[service] = process.Rollout.Cursor
+		peer.pins[service], err = parsePins(process.Pins)
+		if err != nil {
+			return nil, RolloutErr.New("invalid %s pins: %v", service, err)
+		}
 	}
 
 	// Convert each Service's VersionConfig String to SemVer
@@ -166,6 +202,10 @@ func New(log *zap.Logger, config *Config) (peer *Peer, err error) {
 		return nil, RolloutErr.Wrap(err)
 	}
 
+	if err := peer.loadState(); err != nil {
+		return nil, RolloutErr.Wrap(err)
+	}
+
 	peer.response, err = json.Marshal(peer.Versions)
 	if err != nil {
 		peer.Log.Error("Error marshalling version info.", zap.Error(err))
@@ -174,10 +214,34 @@ func New(log *zap.Logger, config *Config) (peer *Peer, err error) {
 
 	peer.Log.Debug("Setting version info.", zap.ByteString("Value", peer.response))
 
+	if config.ReleaseManifest != "" {
+		peer.manifest, err = os.ReadFile(config.ReleaseManifest)
+		if err != nil {
+			return nil, errs.New("unable to read release manifest: %w", err)
+		}
+
+		// the signature is verified by the clients, the server doesn't
+		// have the key.
+		var signed checker.SignedManifest
+		if err := json.Unmarshal(peer.manifest, &signed); err != nil {
+			return nil, errs.New("invalid release manifest: %w", err)
+		}
+	}
+
 	{
 		router := mux.NewRouter()
 		router.HandleFunc("/", peer.versionHandle).Methods(http.MethodGet)
+		router.HandleFunc("/manifest", peer.manifestHandle).Methods(http.MethodGet)
 		router.HandleFunc("/processes/{service}/{version}/url", peer.processURLHandle).Methods(http.MethodGet)
+		router.HandleFunc("/processes/{service}/nodes/{nodeid}/version", peer.nodeVersionHandle).Methods(http.MethodGet)
+
+		if config.Admin.AuthToken != "" {
+			admin := router.PathPrefix("/admin").Subrouter()
+			admin.Use(peer.authorize)
+			admin.HandleFunc("/processes/{service}/rollout", peer.updateRolloutHandle).Methods(http.MethodPut)
+			admin.HandleFunc("/processes/{service}/pins/{nodeid}", peer.pinHandle).Methods(http.MethodPut)
+			admin.HandleFunc("/processes/{service}/pins/{nodeid}", peer.unpinHandle).Methods(http.MethodDelete)
+		}
 
 		peer.Server.Endpoint = http.Server{
 			Handler: router,
@@ -194,9 +258,28 @@ func New(log *zap.Logger, config *Config) (peer *Peer, err error) {
 
 // versionHandle handles all process versions request.
 func (peer *Peer) versionHandle(w http.ResponseWriter, r *http.Request) {
+	peer.mu.RLock()
+	response := peer.response
+	peer.mu.RUnlock()
+
+	w.Header().Set("Content-Type", "application/json")
+
+	_, err := w.Write(response)
+	if err != nil {
+		peer.Log.Error("Error writing response to client.", zap.Error(err))
+	}
+}
+
+// manifestHandle serves the signed release manifest.
+func (peer *Peer) manifestHandle(w http.ResponseWriter, r *http.Request) {
+	if peer.manifest == nil {
+		http.Error(w, "release manifest is not configured", http.StatusNotFound)
+		return
+	}
+
 	w.Header().Set("Content-Type", "application/json")
 
-	_, err := w.Write(peer.response)
+	_, err := w.Write(peer.manifest)
 	if err != nil {
 		peer.Log.Error("Error writing response to client.", zap.Error(err))
 	}
@@ -208,21 +291,15 @@ func (peer *Peer) processURLHandle(w http.ResponseWriter, r *http.Request) {
 	service := params["service"]
 	versionType := params["version"]
 
+	peer.mu.RLock()
+	processPtr, ok := peer.process(service)
 	var process version.Process
-	switch service {
-	case "satellite":
-		process = peer.Versions.Processes.Satellite
-	case "storagenode":
-		process = peer.Versions.Processes.Storagenode
-	case "storagenode-updater":
-		process = peer.Versions.Processes.StoragenodeUpdater
-	case "uplink":
-		process = peer.Versions.Processes.Uplink
-	case "gateway":
-		process = peer.Versions.Processes.Gateway
-	case "identity":
-		process = peer.Versions.Processes.Identity
-	default:
+	if ok {
+		process = *processPtr
+	}
+	peer.mu.RUnlock()
+
+	if !ok {
 		http.Error(w, "service does not exists", http.StatusNotFound)
 		return
 	}
@@ -257,8 +334,7 @@ func (peer *Peer) processURLHandle(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	url = strings.Replace(url, "{os}", os, 1)
-	url = strings.Replace(url, "{arch}", arch, 1)
+	url = binaryURL(url, os, arch)
 
 	w.Header().Set("Content-Type", "text/plain")
 	_, err := w.Write([]byte(url))
@@ -267,6 +343,34 @@ func (peer *Peer) processURLHandle(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// process returns the versions of the service. It must be called with the
+// mutex held.
+func (peer *Peer) process(service string) (*version.Process, bool) {
+	switch service {
+	case "satellite":
+		return &peer.Versions.Processes.Satellite, true
+	case "storagenode":
+		return &peer.Versions.Processes.Storagenode, true
+	case "storagenode-updater":
+		return &peer.Versions.Processes.StoragenodeUpdater, true
+	case "uplink":
+		return &peer.Versions.Processes.Uplink, true
+	case "gateway":
+		return &peer.Versions.Processes.Gateway, true
+	case "identity":
+		return &peer.Versions.Processes.Identity, true
+	default:
+		return nil, false
+	}
+}
+
+// binaryURL fills in the os and arch of the binary url template.
+func binaryURL(url, os, arch string) string {
+	url = strings.Replace(url, "{os}", os, 1)
+	url = strings.Replace(url, "{arch}", arch, 1)
+	return url
+}
+
 // Run runs versioncontrol server until it's either closed or it errors.
 func (peer *Peer) Run(ctx context.Context) (err error) {
 	ctx, cancel := context.WithCancel(ctx)
@@ -339,6 +443,18 @@ func (rollout RolloutConfig) Validate() error {
 	return nil
 }
 
+// byService returns the process configs keyed by the service name.
+func (versions ProcessesConfig) byService() map[string]ProcessConfig {
+	return map[string]ProcessConfig{
+		"satellite":           versions.Satellite,
+		"storagenode":         versions.Storagenode,
+		"storagenode-updater": versions.StoragenodeUpdater,
+		"uplink":              versions.Uplink,
+		"gateway":             versions.Gateway,
+		"identity":            versions.Identity,
+	}
+}
+
 func configToProcess(binary ProcessConfig) (version.Process, error) {
 	process := version.Process{
 		Minimum: version.Version{
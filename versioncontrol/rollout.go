@@ -0,0 +1,379 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package versioncontrol
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+	"storj.io/private/version"
+	"storj.io/storj/private/version/checker"
+)
+
+const (
+	// PinMinimum pins a node to the minimum version of the process.
+	PinMinimum = "minimum"
+	// PinSuggested pins a node to the suggested version of the process.
+	PinSuggested = "suggested"
+)
+
+// NodeVersion is the version a specific node should run.
+type NodeVersion = checker.NodeVersion
+
+// RolloutUpdate is the request for adjusting the rollout of a process.
+type RolloutUpdate struct {
+	// Cursor is the percentage of nodes which should roll-out to the
+	// suggested version.
+	Cursor int `json:"cursor"`
+}
+
+// PinUpdate is the request for pinning a node to a version of a process.
+type PinUpdate struct {
+	// Version is either "minimum" or "suggested".
+	Version string `json:"version"`
+}
+
+// adminState is the state of the rollouts and pins, which is adjusted
+// through the admin endpoints and persisted across restarts.
+type adminState struct {
+	// Cursors contains the rollout percentage of each process.
+	Cursors map[string]int `json:"cursors"`
+	// Pins contains the nodes pinned to a version of each process.
+	Pins map[string]map[storj.NodeID]string `json:"pins"`
+}
+
+// loadState overrides the configured rollouts and pins with the persisted
+// state. It must be called before the server is started.
+func (peer *Peer) loadState() error {
+	if peer.config.StatePath == "" {
+		return nil
+	}
+
+	data, err := os.ReadFile(peer.config.StatePath)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil
+		}
+		return errs.New("unable to read admin state: %w", err)
+	}
+
+	var state adminState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return errs.New("invalid admin state: %w", err)
+	}
+
+	for service, cursor := range state.Cursors {
+		process, ok := peer.process(service)
+		if !ok {
+			return errs.New("invalid admin state: unknown service %q", service)
+		}
+		if cursor < 0 || cursor > 100 {
+			return errs.New("invalid admin state: invalid %s cursor %d", service, cursor)
+		}
+		process.Rollout.Cursor = version.PercentageToCursor(cursor)
+		peer.cursors[service] = cursor
+	}
+	for service, pins := range state.Pins {
+		if _, ok := peer.process(service); !ok {
+			return errs.New("invalid admin state: unknown service %q", service)
+		}
+		for _, target := range pins {
+			if err := validatePin(target); err != nil {
+				return errs.New("invalid admin state: %w", err)
+			}
+		}
+		peer.pins[service] = pins
+	}
+	return nil
+}
+
+// saveState persists the rollouts and pins, so that they survive restarts.
+// It must be called with the mutex held.
+func (peer *Peer) saveState() error {
+	if peer.config.StatePath == "" {
+		return nil
+	}
+
+	data, err := json.Marshal(adminState{
+		Cursors: peer.cursors,
+		Pins:    peer.pins,
+	})
+	if err != nil {
+		return err
+	}
+
+	// write to a temporary file first, so that a crash doesn't leave
+	// a partially written state behind.
+	tmpPath := peer.config.StatePath + ".tmp"
+	if err := os.WriteFile(tmpPath, data, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmpPath, peer.config.StatePath)
+}
+
+// parsePins parses the comma separated list of node-id=version pins.
+func parsePins(s string) (map[storj.NodeID]string, error) {
+	pins := make(map[storj.NodeID]string)
+	for _, pin := range strings.Split(s, ",") {
+		pin = strings.TrimSpace(pin)
+		if pin == "" {
+			continue
+		}
+
+		nodeIDString, target, ok := strings.Cut(pin, "=")
+		if !ok {
+			return nil, errs.New("invalid pin %q", pin)
+		}
+		nodeID, err := storj.NodeIDFromString(strings.TrimSpace(nodeIDString))
+		if err != nil {
+			return nil, errs.New("invalid node id in pin %q: %v", pin, err)
+		}
+		target = strings.TrimSpace(target)
+		if err := validatePin(target); err != nil {
+			return nil, err
+		}
+		pins[nodeID] = target
+	}
+	return pins, nil
+}
+
+// validatePin checks whether target is a version a node can be pinned to.
+func validatePin(target string) error {
+	switch target {
+	case PinMinimum, PinSuggested:
+		return nil
+	default:
+		return errs.New("invalid pinned version %q, must be %q or %q", target, PinMinimum, PinSuggested)
+	}
+}
+
+// nodeVersion returns the version the node should run. It must be called
+// with the mutex held.
+func (peer *Peer) nodeVersion(service string, nodeID storj.NodeID) (NodeVersion, bool) {
+	process, ok := peer.process(service)
+	if !ok {
+		return NodeVersion{}, false
+	}
+
+	switch peer.pins[service][nodeID] {
+	case PinMinimum:
+		return NodeVersion{Version: process.Minimum, Pinned: true}, true
+	case PinSuggested:
+		return NodeVersion{Version: process.Suggested, Pinned: true}, true
+	}
+
+	if version.ShouldUpdate(process.Rollout, nodeID) {
+		return NodeVersion{Version: process.Suggested}, true
+	}
+	return NodeVersion{Version: process.Minimum}, true
+}
+
+// updateResponse regenerates the response of all allowed versions. It must
+// be called with the mutex held.
+func (peer *Peer) updateResponse() error {
+	response, err := json.Marshal(peer.Versions)
+	if err != nil {
+		return err
+	}
+	peer.response = response
+	return nil
+}
+
+// authorize restricts the handler to requests with the admin auth token.
+func (peer *Peer) authorize(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token := r.Header.Get("Authorization")
+		if subtle.ConstantTimeCompare([]byte(token), []byte(peer.config.AuthToken)) != 1 {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// nodeVersionHandle handles the request for the version a node should run.
+func (peer *Peer) nodeVersionHandle(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+
+	nodeID, err := storj.NodeIDFromString(params["nodeid"])
+	if err != nil {
+		http.Error(w, "invalid node id", http.StatusBadRequest)
+		return
+	}
+
+	peer.mu.RLock()
+	nodeVersion, ok := peer.nodeVersion(params["service"], nodeID)
+	peer.mu.RUnlock()
+
+	if !ok {
+		http.Error(w, "service does not exists", http.StatusNotFound)
+		return
+	}
+
+	query := r.URL.Query()
+	if os, arch := query.Get("os"), query.Get("arch"); os != "" && arch != "" {
+		nodeVersion.URL = binaryURL(nodeVersion.URL, os, arch)
+	}
+
+	peer.writeJSON(w, nodeVersion)
+}
+
+// updateRolloutHandle handles the request for adjusting the rollout cursor
+// of a process. Increasing the cursor by more than the maximum step requires
+// the force query parameter, while decreasing it is always allowed.
+func (peer *Peer) updateRolloutHandle(w http.ResponseWriter, r *http.Request) {
+	service := mux.Vars(r)["service"]
+
+	var update RolloutUpdate
+	if err := json.NewDecoder(r.Body).Decode(&update); err != nil {
+		http.Error(w, "invalid request", http.StatusBadRequest)
+		return
+	}
+	if update.Cursor < 0 || update.Cursor > 100 {
+		http.Error(w, "invalid cursor percentage", http.StatusBadRequest)
+		return
+	}
+	force, _ := strconv.ParseBool(r.URL.Query().Get("force"))
+
+	peer.mu.Lock()
+	defer peer.mu.Unlock()
+
+	process, ok := peer.process(service)
+	if !ok {
+		http.Error(w, "service does not exists", http.StatusNotFound)
+		return
+	}
+
+	previous := peer.cursors[service]
+	if !force && peer.config.MaxCursorStep > 0 && update.Cursor-previous > peer.config.MaxCursorStep {
+		http.Error(w, "cursor increase exceeds the maximum step", http.StatusBadRequest)
+		return
+	}
+
+	previousCursor := process.Rollout.Cursor
+	process.Rollout.Cursor = version.PercentageToCursor(update.Cursor)
+	peer.cursors[service] = update.Cursor
+	if err := errs.Combine(peer.saveState(), peer.updateResponse()); err != nil {
+		process.Rollout.Cursor = previousCursor
+		peer.cursors[service] = previous
+		// the response is regenerated from the previous versions, which
+		// have been marshaled successfully before.
+		_ = peer.updateResponse()
+		peer.Log.Error("Error updating rollout.", zap.Error(err))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	peer.Log.Info("Rollout updated.",
+		zap.String("Service", service),
+		zap.Int("Previous", previous),
+		zap.Int("Cursor", update.Cursor),
+		zap.Bool("Forced", force))
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// pinHandle handles the request for pinning a node to a version of a process.
+func (peer *Peer) pinHandle(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+
+	nodeID, err := storj.NodeIDFromString(params["nodeid"])
+	if err != nil {
+		http.Error(w, "invalid node id", http.StatusBadRequest)
+		return
+	}
+
+	var update PinUpdate
+	if err := json.NewDecoder(r.Body).Decode(&update); err != nil {
+		http.Error(w, "invalid request", http.StatusBadRequest)
+		return
+	}
+	if err := validatePin(update.Version); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	peer.mu.Lock()
+	defer peer.mu.Unlock()
+
+	if _, ok := peer.process(params["service"]); !ok {
+		http.Error(w, "service does not exists", http.StatusNotFound)
+		return
+	}
+	pins := peer.pins[params["service"]]
+	previous, pinned := pins[nodeID]
+	pins[nodeID] = update.Version
+	if err := peer.saveState(); err != nil {
+		if pinned {
+			pins[nodeID] = previous
+		} else {
+			delete(pins, nodeID)
+		}
+		peer.Log.Error("Error pinning node.", zap.Error(err))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	peer.Log.Info("Node pinned.",
+		zap.String("Service", params["service"]),
+		zap.Stringer("Node ID", nodeID),
+		zap.String("Version", update.Version))
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// unpinHandle handles the request for removing the pinned version of a node.
+func (peer *Peer) unpinHandle(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+
+	nodeID, err := storj.NodeIDFromString(params["nodeid"])
+	if err != nil {
+		http.Error(w, "invalid node id", http.StatusBadRequest)
+		return
+	}
+
+	peer.mu.Lock()
+	defer peer.mu.Unlock()
+
+	if _, ok := peer.process(params["service"]); !ok {
+		http.Error(w, "service does not exists", http.StatusNotFound)
+		return
+	}
+	pins := peer.pins[params["service"]]
+	previous, pinned := pins[nodeID]
+	delete(pins, nodeID)
+	if err := peer.saveState(); err != nil {
+		if pinned {
+			pins[nodeID] = previous
+		}
+		peer.Log.Error("Error unpinning node.", zap.Error(err))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	peer.Log.Info("Node unpinned.",
+		zap.String("Service", params["service"]),
+		zap.Stringer("Node ID", nodeID))
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// writeJSON writes out v as the response.
+func (peer *Peer) writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		peer.Log.Error("Error writing response to client.", zap.Error(err))
+	}
+}
@@ -0,0 +1,251 @@
+// Copyright (C) 2023 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package versioncontrol_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+	"golang.org/x/sync/errgroup"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/private/version"
+	"storj.io/storj/private/version/checker"
+	"storj.io/storj/versioncontrol"
+)
+
+func TestNodeVersionRollout(t *testing.T) {
+	pinnedNode, node := testrand.NodeID(), testrand.NodeID()
+
+	config := &versioncontrol.Config{
+		Address: "127.0.0.1:0",
+		Versions: versioncontrol.OldVersionConfig{
+			Satellite:   "v0.0.1",
+			Storagenode: "v0.0.1",
+			Uplink:      "v0.0.1",
+			Gateway:     "v0.0.1",
+			Identity:    "v0.0.1",
+		},
+		Binary: versioncontrol.ProcessesConfig{
+			Storagenode: versioncontrol.ProcessConfig{
+				Minimum: versioncontrol.VersionConfig{
+					Version: "v0.0.1",
+					URL:     "http://example.com/v0.0.1/storagenode_{os}_{arch}",
+				},
+				Suggested: versioncontrol.VersionConfig{
+					Version: "v0.0.2",
+					URL:     "http://example.com/v0.0.2/storagenode_{os}_{arch}",
+				},
+				Rollout: versioncontrol.RolloutConfig{
+					Seed: randSeedString(t),
+				},
+				Pins: pinnedNode.String() + "=suggested",
+			},
+		},
+		Admin: versioncontrol.AdminConfig{
+			AuthToken:     "secret",
+			MaxCursorStep: 25,
+		},
+	}
+
+	peer, err := versioncontrol.New(zaptest.NewLogger(t), config)
+	require.NoError(t, err)
+
+	testCtx := testcontext.New(t)
+	ctx, cancel := context.WithCancel(testCtx)
+
+	var group errgroup.Group
+	group.Go(func() error {
+		return peer.Run(ctx)
+	})
+
+	defer testCtx.Check(peer.Close)
+	defer cancel()
+
+	baseURL := "http://" + peer.Addr()
+
+	do := func(t *testing.T, method, path, token, body string) *http.Response {
+		req, err := http.NewRequestWithContext(ctx, method, baseURL+path, strings.NewReader(body))
+		require.NoError(t, err)
+		if token != "" {
+			req.Header.Set("Authorization", token)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = resp.Body.Close() })
+		return resp
+	}
+
+	nodeVersion := func(t *testing.T, path string) versioncontrol.NodeVersion {
+		resp := do(t, http.MethodGet, path, "", "")
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var nodeVersion versioncontrol.NodeVersion
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&nodeVersion))
+		return nodeVersion
+	}
+
+	pinnedPath := "/processes/storagenode/nodes/" + pinnedNode.String() + "/version"
+	nodePath := "/processes/storagenode/nodes/" + node.String() + "/version"
+
+	t.Run("query", func(t *testing.T) {
+		pinned := nodeVersion(t, pinnedPath+"?os=linux&arch=amd64")
+		require.Equal(t, "v0.0.2", pinned.Version.Version)
+		require.Equal(t, "http://example.com/v0.0.2/storagenode_linux_amd64", pinned.URL)
+		require.True(t, pinned.Pinned)
+
+		other := nodeVersion(t, nodePath)
+		require.Equal(t, "v0.0.1", other.Version.Version)
+		require.False(t, other.Pinned)
+
+		require.Equal(t, http.StatusBadRequest, do(t, http.MethodGet, "/processes/storagenode/nodes/invalid/version", "", "").StatusCode)
+		require.Equal(t, http.StatusNotFound, do(t, http.MethodGet, "/processes/unknown/nodes/"+node.String()+"/version", "", "").StatusCode)
+	})
+
+	t.Run("rollout", func(t *testing.T) {
+		path := "/admin/processes/storagenode/rollout"
+
+		require.Equal(t, http.StatusUnauthorized, do(t, http.MethodPut, path, "", `{"cursor":25}`).StatusCode)
+		require.Equal(t, http.StatusUnauthorized, do(t, http.MethodPut, path, "wrong", `{"cursor":25}`).StatusCode)
+		require.Equal(t, http.StatusBadRequest, do(t, http.MethodPut, path, "secret", `{"cursor":101}`).StatusCode)
+		require.Equal(t, http.StatusBadRequest, do(t, http.MethodPut, path, "secret", `{"cursor":50}`).StatusCode)
+
+		require.Equal(t, http.StatusNoContent, do(t, http.MethodPut, path, "secret", `{"cursor":25}`).StatusCode)
+		require.Equal(t, http.StatusNoContent, do(t, http.MethodPut, path+"?force=true", "secret", `{"cursor":100}`).StatusCode)
+
+		resp := do(t, http.MethodGet, "/", "", "")
+		var versions version.AllowedVersions
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&versions))
+		require.Equal(t, version.PercentageToCursor(100), versions.Processes.Storagenode.Rollout.Cursor)
+
+		require.Equal(t, "v0.0.2", nodeVersion(t, nodePath).Version.Version)
+	})
+
+	t.Run("pins", func(t *testing.T) {
+		path := "/admin/processes/storagenode/pins/" + node.String()
+
+		require.Equal(t, http.StatusUnauthorized, do(t, http.MethodPut, path, "", `{"version":"minimum"}`).StatusCode)
+		require.Equal(t, http.StatusBadRequest, do(t, http.MethodPut, path, "secret", `{"version":"v0.0.3"}`).StatusCode)
+
+		require.Equal(t, http.StatusNoContent, do(t, http.MethodPut, path, "secret", `{"version":"minimum"}`).StatusCode)
+		pinned := nodeVersion(t, nodePath)
+		require.Equal(t, "v0.0.1", pinned.Version.Version)
+		require.True(t, pinned.Pinned)
+
+		require.Equal(t, http.StatusNoContent, do(t, http.MethodDelete, path, "secret", "").StatusCode)
+		unpinned := nodeVersion(t, nodePath)
+		require.Equal(t, "v0.0.2", unpinned.Version.Version)
+		require.False(t, unpinned.Pinned)
+	})
+}
+
+func TestPeer_InvalidPins(t *testing.T) {
+	for _, pins := range []string{
+		"invalid",
+		"invalid=minimum",
+		testrand.NodeID().String() + "=v0.0.1",
+	} {
+		config := &versioncontrol.Config{
+			Address: "127.0.0.1:0",
+			Binary: versioncontrol.ProcessesConfig{
+				Storagenode: versioncontrol.ProcessConfig{
+					Pins: pins,
+				},
+			},
+		}
+
+		peer, err := versioncontrol.New(zaptest.NewLogger(t), config)
+		require.Nil(t, peer)
+		require.Error(t, err)
+	}
+}
+
+func TestAdminStatePersisted(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	node := testrand.NodeID()
+
+	config := &versioncontrol.Config{
+		Address: "127.0.0.1:0",
+		Versions: versioncontrol.OldVersionConfig{
+			Satellite:   "v0.0.1",
+			Storagenode: "v0.0.1",
+			Uplink:      "v0.0.1",
+			Gateway:     "v0.0.1",
+			Identity:    "v0.0.1",
+		},
+		Binary: versioncontrol.ProcessesConfig{
+			Storagenode: versioncontrol.ProcessConfig{
+				Minimum: versioncontrol.VersionConfig{
+					Version: "v0.0.1",
+				},
+				Suggested: versioncontrol.VersionConfig{
+					Version: "v0.0.2",
+				},
+				Rollout: versioncontrol.RolloutConfig{
+					Seed: randSeedString(t),
+				},
+			},
+		},
+		Admin: versioncontrol.AdminConfig{
+			AuthToken: "secret",
+			StatePath: ctx.File("state.json"),
+		},
+	}
+
+	start := func(t *testing.T) (*versioncontrol.Peer, func()) {
+		peer, err := versioncontrol.New(zaptest.NewLogger(t), config)
+		require.NoError(t, err)
+
+		runCtx, cancel := context.WithCancel(ctx)
+		var group errgroup.Group
+		group.Go(func() error {
+			return peer.Run(runCtx)
+		})
+		return peer, func() {
+			cancel()
+			require.NoError(t, group.Wait())
+			require.NoError(t, peer.Close())
+		}
+	}
+
+	put := func(t *testing.T, peer *versioncontrol.Peer, path, body string) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodPut, "http://"+peer.Addr()+path, strings.NewReader(body))
+		require.NoError(t, err)
+		req.Header.Set("Authorization", "secret")
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+		require.Equal(t, http.StatusNoContent, resp.StatusCode)
+	}
+
+	peer, stop := start(t)
+	put(t, peer, "/admin/processes/storagenode/rollout", `{"cursor":20}`)
+	put(t, peer, "/admin/processes/storagenode/pins/"+node.String(), `{"version":"suggested"}`)
+	stop()
+
+	// the adjusted state overrides the configuration after a restart.
+	peer, stop = start(t)
+	defer stop()
+
+	client := checker.New(checker.ClientConfig{
+		ServerAddress: "http://" + peer.Addr(),
+	})
+
+	process, err := client.Process(ctx, "storagenode")
+	require.NoError(t, err)
+	require.Equal(t, version.PercentageToCursor(20), process.Rollout.Cursor)
+
+	nodeVersion, err := client.NodeVersion(ctx, "storagenode", node)
+	require.NoError(t, err)
+	require.Equal(t, "v0.0.2", nodeVersion.Version.Version)
+	require.True(t, nodeVersion.Pinned)
+}
@@ -2,31 +2,29 @@
 // See LICENSE for copying information.
 
 import { BaseGql } from '@/api/baseGql';
+import { HttpClient } from '@/utils/httpClient';
 import { ProjectMember, ProjectMemberCursor, ProjectMembersApi, ProjectMembersPage } from '@/types/projectMembers';
 
 export class ProjectMembersApiGql extends BaseGql implements ProjectMembersApi {
+    private readonly http: HttpClient = new HttpClient();
+    private readonly ROOT_PATH: string = '/api/v0/projects';
 
     /**
-     * Used for adding team members to project.
+     * Used for inviting team members to project.
      *
      * @param projectId
      * @param emails
+     * @throws Error
      */
     public async add(projectId: string, emails: string[]): Promise<void> {
-        const query =
-            `mutation($projectId: String!, $emails:[String!]!) {
-                addProjectMembers(
-                    projectID: $projectId,
-                    email: $emails
-                ) {id}
-            }`;
-
-        const variables = {
-            projectId,
-            emails,
-        };
+        const path = `${this.ROOT_PATH}/${projectId}/invite`;
+        const response = await this.http.post(path, JSON.stringify({ emails }));
+        if (response.ok) {
+            return;
+        }
 
-        await this.mutate(query, variables);
+        const result = await response.json();
+        throw new Error(result.error || 'Can not invite project members');
     }
 
     /**
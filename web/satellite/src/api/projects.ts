@@ -11,6 +11,7 @@ import {
     ProjectsCursor,
     ProjectsPage,
     ProjectsStorageBandwidthDaily,
+    ServerSideEncryption,
 } from '@/types/projects';
 import { HttpClient } from '@/utils/httpClient';
 import { Time } from '@/utils/time';
@@ -156,6 +157,8 @@ export class ProjectsApiGql extends BaseGql implements ProjectsApi {
             limits.storageUsed,
             limits.objectCount,
             limits.segmentCount,
+            limits.segmentLimit,
+            limits.segmentUsed,
         );
 
     }
@@ -181,6 +184,10 @@ export class ProjectsApiGql extends BaseGql implements ProjectsApi {
             limits.bandwidthUsed,
             limits.storageLimit,
             limits.storageUsed,
+            0,
+            0,
+            limits.segmentLimit,
+            limits.segmentUsed,
         );
     }
 
@@ -234,6 +241,56 @@ export class ProjectsApiGql extends BaseGql implements ProjectsApi {
         throw new Error('Can not get project salt');
     }
 
+    /**
+     * Get server-side encryption mode of the project.
+     *
+     * @param projectId - project ID
+     * @throws Error
+     */
+    public async getServerSideEncryption(projectId: string): Promise<ServerSideEncryption> {
+        const path = `${this.ROOT_PATH}/${projectId}/server-side-encryption`;
+        const response = await this.http.get(path);
+        if (response.ok) {
+            return this.toServerSideEncryption(await response.json());
+        }
+
+        throw new Error('Can not get server-side encryption mode');
+    }
+
+    /**
+     * Opts the project into server-side encryption mode.
+     *
+     * @param projectId - project ID
+     * @throws Error
+     */
+    public async enableServerSideEncryption(projectId: string): Promise<ServerSideEncryption> {
+        const path = `${this.ROOT_PATH}/${projectId}/server-side-encryption`;
+        const response = await this.http.post(path, null);
+        const result = await response.json();
+        if (response.ok) {
+            return this.toServerSideEncryption(result);
+        }
+
+        throw new Error(result.error || 'Can not enable server-side encryption');
+    }
+
+    /**
+     * Get the satellite managed passphrase of a project in server-side encryption mode.
+     *
+     * @param projectId - project ID
+     * @throws Error
+     */
+    public async getManagedPassphrase(projectId: string): Promise<string> {
+        const path = `${this.ROOT_PATH}/${projectId}/encryption-key`;
+        const response = await this.http.get(path);
+        if (response.ok) {
+            const result = await response.json();
+            return result.passphrase;
+        }
+
+        throw new Error('Can not get project passphrase');
+    }
+
     /**
      * Fetch owned projects.
      *
@@ -293,4 +350,11 @@ export class ProjectsApiGql extends BaseGql implements ProjectsApi {
         return new ProjectsPage(projects, page.limit, page.offset, page.pageCount, page.currentPage, page.totalCount);
     }
 
+
+    /**
+     * Converts the server-side encryption response.
+     */
+    private toServerSideEncryption(result: { enabled: boolean, enabledAt?: string }): ServerSideEncryption {
+        return new ServerSideEncryption(result.enabled, result.enabledAt ? new Date(result.enabledAt) : null);
+    }
 }
@@ -11,6 +11,7 @@ import {
     ProjectsPage,
     ProjectsStorageBandwidthDaily,
     ProjectUsageDateRange,
+    ServerSideEncryption,
 } from '@/types/projects';
 import { StoreModule } from '@/types/store';
 
@@ -30,6 +31,9 @@ export const PROJECTS_ACTIONS = {
     GET_LIMITS: 'getProjectLimits',
     GET_TOTAL_LIMITS: 'getTotalLimits',
     GET_SALT: 'getSalt',
+    GET_SERVER_SIDE_ENCRYPTION: 'getServerSideEncryption',
+    ENABLE_SERVER_SIDE_ENCRYPTION: 'enableServerSideEncryption',
+    GET_MANAGED_PASSPHRASE: 'getManagedPassphrase',
 };
 
 export const PROJECTS_MUTATIONS = {
@@ -93,6 +97,9 @@ const {
     GET_TOTAL_LIMITS,
     FETCH_OWNED,
     GET_SALT,
+    GET_SERVER_SIDE_ENCRYPTION,
+    ENABLE_SERVER_SIDE_ENCRYPTION,
+    GET_MANAGED_PASSPHRASE,
 } = PROJECTS_ACTIONS;
 
 const {
@@ -338,6 +345,15 @@ export function makeProjectsModule(api: ProjectsApi): StoreModule<ProjectsState,
             [GET_SALT]: async function (_, projectID: string): Promise<string> {
                 return await api.getSalt(projectID);
             },
+            [GET_SERVER_SIDE_ENCRYPTION]: async function (_, projectID: string): Promise<ServerSideEncryption> {
+                return await api.getServerSideEncryption(projectID);
+            },
+            [ENABLE_SERVER_SIDE_ENCRYPTION]: async function (_, projectID: string): Promise<ServerSideEncryption> {
+                return await api.enableServerSideEncryption(projectID);
+            },
+            [GET_MANAGED_PASSPHRASE]: async function (_, projectID: string): Promise<string> {
+                return await api.getManagedPassphrase(projectID);
+            },
             [CLEAR]: function({ commit }: ProjectsContext): void {
                 commit(CLEAR_PROJECTS);
             },
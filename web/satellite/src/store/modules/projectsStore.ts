@@ -14,6 +14,7 @@ import {
     ProjectsPage,
     ProjectsStorageBandwidthDaily,
     ProjectUsageDateRange,
+    ServerSideEncryption,
 } from '@/types/projects';
 import { ProjectsApiGql } from '@/api/projects';
 import { useUsersStore } from '@/store/modules/usersStore';
@@ -227,6 +228,18 @@ export const useProjectsStore = defineStore('projects', () => {
         return await api.getSalt(projectID);
     }
 
+    async function getServerSideEncryption(projectID: string): Promise<ServerSideEncryption> {
+        return await api.getServerSideEncryption(projectID);
+    }
+
+    async function enableServerSideEncryption(projectID: string): Promise<ServerSideEncryption> {
+        return await api.enableServerSideEncryption(projectID);
+    }
+
+    async function getManagedPassphrase(projectID: string): Promise<string> {
+        return await api.getManagedPassphrase(projectID);
+    }
+
     function clearProjectState(): void {
         projectsStore.projects = [];
         projectsStore.selectedProject = defaultSelectedProject;
@@ -285,6 +298,9 @@ export const useProjectsStore = defineStore('projects', () => {
         fetchProjectLimits,
         fetchTotalLimits,
         getProjectSalt,
+        getServerSideEncryption,
+        enableServerSideEncryption,
+        getManagedPassphrase,
         clearProjectState,
         projects,
         projectsWithoutSelected,
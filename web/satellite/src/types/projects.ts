@@ -52,6 +52,30 @@ export interface ProjectsApi {
      * throws Error
      */
     getSalt(projectID: string): Promise<string>;
+
+    /**
+     * Get server-side encryption mode of the project.
+     *
+     * @param projectID - project ID
+     * throws Error
+     */
+    getServerSideEncryption(projectID: string): Promise<ServerSideEncryption>;
+
+    /**
+     * Opts the project into server-side encryption mode.
+     *
+     * @param projectID - project ID
+     * throws Error
+     */
+    enableServerSideEncryption(projectID: string): Promise<ServerSideEncryption>;
+
+    /**
+     * Get the satellite managed passphrase of a project in server-side encryption mode.
+     *
+     * @param projectID - project ID
+     * throws Error
+     */
+    getManagedPassphrase(projectID: string): Promise<string>;
     
     /**
      * Get project limits.
@@ -109,6 +133,16 @@ export class Project {
     }
 }
 
+/**
+ * ServerSideEncryption describes whether the satellite manages the passphrase of a project.
+ */
+export class ServerSideEncryption {
+    public constructor(
+        public enabled: boolean = false,
+        public enabledAt: Date | null = null,
+    ) {}
+}
+
 /**
  * ProjectFields is a type, used for creating and updating project.
  */
@@ -153,6 +187,8 @@ export class ProjectLimits {
         public storageUsed: number = 0,
         public objectCount: number = 0,
         public segmentCount: number = 0,
+        public segmentLimit: number = 0,
+        public segmentUsed: number = 0,
     ) {}
 }
 
@@ -9,6 +9,7 @@ import {
     ProjectsCursor,
     ProjectsPage,
     ProjectsStorageBandwidthDaily,
+    ServerSideEncryption,
 } from '@/types/projects';
 
 /**
@@ -59,6 +60,18 @@ export class ProjectsApiMock implements ProjectsApi {
         throw new Error('not implemented');
     }
 
+    getServerSideEncryption(): Promise<ServerSideEncryption> {
+        return Promise.resolve(new ServerSideEncryption());
+    }
+
+    enableServerSideEncryption(): Promise<ServerSideEncryption> {
+        throw new Error('not implemented');
+    }
+
+    getManagedPassphrase(): Promise<string> {
+        throw new Error('not implemented');
+    }
+
     getDailyUsage(_projectId: string, _start: Date, _end: Date): Promise<ProjectsStorageBandwidthDaily> {
         throw new Error('not implemented');
     }
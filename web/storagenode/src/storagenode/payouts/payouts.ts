@@ -304,6 +304,10 @@ export class SatellitePayoutForPeriod {
             return `https://etherscan.io/tx/${prefixed(this.receipt.slice(4))}`;
         }
 
+        if (this.receipt.indexOf('zksync-era') !== -1) {
+            return `https://explorer.zksync.io/tx/${prefixed(this.receipt.slice(11))}`;
+        }
+
         {
             const zkScanUrl = 'https://zkscan.io/explorer/transactions';
 